@@ -23,12 +23,16 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 
 	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/frontend"
+	"github.com/matrixorigin/matrixone/pkg/incrservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/rpcserver"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 )
@@ -43,6 +47,7 @@ const (
 	ShutdownExit      = 15
 	CreateTaeExit     = 16
 	InitCatalogExit   = 17
+	CreateSpillExit   = 18
 )
 
 var (
@@ -60,6 +65,21 @@ var (
 func createMOServer() {
 	address := fmt.Sprintf("%s:%d", config.GlobalSystemVariables.GetHost(), config.GlobalSystemVariables.GetPort())
 	pu := config.NewParameterUnit(&config.GlobalSystemVariables, config.HostMmu, config.Mempool, config.StorageEngine, config.ClusterNodes)
+	if dir := config.GlobalSystemVariables.GetSpillPath(); dir != "" {
+		// the runs left by the last run of the server are useless, only
+		// the directory of the runs is removed as others may share dir
+		if err := os.RemoveAll(filepath.Join(dir, spill.Dir)); err != nil {
+			logutil.Infof("Remove spill dir error:%v\n", err)
+			os.Exit(CreateSpillExit)
+		}
+		fs, err := fileservice.NewLocalFS(dir)
+		if err != nil {
+			logutil.Infof("Create spill file service error:%v\n", err)
+			os.Exit(CreateSpillExit)
+		}
+		pu.FileService = fs
+	}
+	pu.IncrService = incrservice.New(frontend.NewIncrStore(config.StorageEngine), incrservice.DefaultStep)
 	mo = frontend.NewMOServer(address, pu)
 	if config.GlobalSystemVariables.GetEnableMetric() {
 		ieFactory := func() ie.InternalExecutor {
@@ -179,7 +199,7 @@ func main() {
 	if len(args) == 2 && args[1] == "initdb" {
 		fmt.Println("Initialize the TAE engine ...")
 		taeWrapper := initTae()
-		err := frontend.InitDB(taeWrapper.eng)
+		err := frontend.InitDB(taeWrapper.eng, config.GlobalSystemVariables.GetDumpuser(), config.GlobalSystemVariables.GetDumppassword())
 		if err != nil {
 			logutil.Infof("Initialize catalog failed. error:%v", err)
 			os.Exit(InitCatalogExit)
@@ -209,7 +229,7 @@ func main() {
 	if engineName == "tae" {
 		fmt.Println("Initialize the TAE engine ...")
 		tae = initTae()
-		err := frontend.InitDB(tae.eng)
+		err := frontend.InitDB(tae.eng, config.GlobalSystemVariables.GetDumpuser(), config.GlobalSystemVariables.GetDumppassword())
 		if err != nil {
 			logutil.Infof("Initialize catalog failed. error:%v", err)
 			os.Exit(InitCatalogExit)
@@ -76,6 +76,8 @@ func (m *StrHashMap) InsertValue(val any) bool {
 		m.keys[0] = append(m.keys[0], encoding.EncodeFixed(v)...)
 	case types.Decimal64:
 		m.keys[0] = append(m.keys[0], encoding.EncodeFixed(v)...)
+	case types.Uuid:
+		m.keys[0] = append(m.keys[0], encoding.EncodeFixed(v)...)
 	}
 	if l := len(m.keys[0]); l < 16 {
 		m.keys[0] = append(m.keys[0], hashtable.StrKeyPadding[l:]...)
@@ -102,6 +104,10 @@ func (m *StrHashMap) Insert(vecs []*vector.Vector, row int) bool {
 
 func (m *StrHashMap) encodeHashKeysWithScale(vecs []*vector.Vector, start, count int, scales []int32) {
 	for i, vec := range vecs {
+		if vec.IsScalarNull() {
+			fillNullGroupStr(m, count)
+			continue
+		}
 		switch typLen := vec.Typ.TypeSize(); typLen {
 		case 1:
 			fillGroupStr[uint8](m, vec, count, 1, start, scales[i])
@@ -126,6 +132,10 @@ func (m *StrHashMap) encodeHashKeysWithScale(vecs []*vector.Vector, start, count
 
 func (m *StrHashMap) encodeHashKeys(vecs []*vector.Vector, start, count int) {
 	for _, vec := range vecs {
+		if vec.IsScalarNull() {
+			fillNullGroupStr(m, count)
+			continue
+		}
 		switch typLen := vec.Typ.TypeSize(); typLen {
 		case 1:
 			fillGroupStr[uint8](m, vec, count, 1, start, 0)
@@ -148,6 +158,17 @@ func (m *StrHashMap) encodeHashKeys(vecs []*vector.Vector, start, count int) {
 	}
 }
 
+// fillNullGroupStr encodes the rows of a scalar null vector.
+func fillNullGroupStr(m *StrHashMap, n int) {
+	for i := 0; i < n; i++ {
+		if m.hasNull {
+			m.keys[i] = append(m.keys[i], byte(1))
+		} else {
+			m.zValues[i] = 0
+		}
+	}
+}
+
 func fillStringGroupStr(m *StrHashMap, vec *vector.Vector, n int, start int) {
 	vs := vector.GetStrColumn(vec)
 	if !vec.GetNulls().Any() {
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
@@ -69,3 +70,20 @@ func TestIterator(t *testing.T) {
 	bat.Clean(m)
 	require.Equal(t, int64(0), mheap.Size(m))
 }
+
+func TestScalarNull(t *testing.T) {
+	mp := NewStrMap(true)
+	ts := []types.Type{
+		types.New(types.T_int64, 0, 0, 0),
+	}
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	bat := testutil.NewBatch(ts, false, Rows, m)
+	vecs := []*vector.Vector{bat.Vecs[0], vector.NewConstNull(ts[0], Rows)}
+	itr := mp.NewIterator()
+	vs, _ := itr.Insert(0, Rows, vecs, make([]int32, Rows))
+	require.Equal(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, vs[:Rows])
+	vs, _ = itr.Find(0, Rows, vecs, make([]int32, Rows))
+	require.Equal(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, vs[:Rows])
+	bat.Clean(m)
+	require.Equal(t, int64(0), mheap.Size(m))
+}
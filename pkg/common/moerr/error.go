@@ -40,6 +40,15 @@ const (
 	// Group 3: invalid input
 	BAD_CONFIGURATION = 3000
 	INVALID_INPUT     = 3001
+	DUPLICATE_ENTRY   = 3002
+	BAD_NULL_VALUE    = 3003
+	CHECK_VIOLATION   = 3004
+	ROW_IS_REFERENCED = 3005
+	NO_REFERENCED_ROW = 3006
+	FK_DEPTH_EXCEEDED = 3007
+	FK_DROP_PARENT    = 3008
+	NO_PARTITION      = 3009
+	SUBQUERY_NO_1_ROW = 3010
 
 	// Group 4: unexpected state
 	INVALID_STATE = 4000
@@ -125,3 +134,54 @@ func NewPanicError(v interface{}) *Error {
 func NewError(code int32, msg string) *Error {
 	return &Error{code, msg}
 }
+
+// NewDuplicateEntry reports the entry duplicating a unique key
+func NewDuplicateEntry(entry, key string) *Error {
+	return &Error{DUPLICATE_ENTRY, fmt.Sprintf("Duplicate entry '%s' for key '%s'", entry, key)}
+}
+
+// NewBadNullValue reports a null value written to a NOT NULL column
+func NewBadNullValue(column string) *Error {
+	return &Error{BAD_NULL_VALUE, fmt.Sprintf("Column '%s' cannot be null", column)}
+}
+
+// NewCheckViolation reports a row violating a CHECK constraint
+func NewCheckViolation(name string) *Error {
+	return &Error{CHECK_VIOLATION, fmt.Sprintf("Check constraint '%s' is violated.", name)}
+}
+
+// NewRowIsReferenced reports the delete or update of a key referred by a
+// foreign key, the detail describes the foreign key
+func NewRowIsReferenced(detail string) *Error {
+	return &Error{ROW_IS_REFERENCED, fmt.Sprintf("Cannot delete or update a parent row: a foreign key constraint fails (%s)", detail)}
+}
+
+// NewNoReferencedRow reports a row referring to a missing key by a foreign
+// key, the detail describes the foreign key
+func NewNoReferencedRow(detail string) *Error {
+	return &Error{NO_REFERENCED_ROW, fmt.Sprintf("Cannot add or update a child row: a foreign key constraint fails (%s)", detail)}
+}
+
+// NewForeignKeyDepthExceeded reports the cascading actions of foreign keys
+// nested too deeply
+func NewForeignKeyDepthExceeded(depth int) *Error {
+	return &Error{FK_DEPTH_EXCEEDED, fmt.Sprintf("Foreign key cascade delete/update exceeds max depth of %d.", depth)}
+}
+
+// NewForeignKeyDropParent reports the drop of a table referred by a foreign
+// key of another table
+func NewForeignKeyDropParent(table, fk, child string) *Error {
+	return &Error{FK_DROP_PARENT, fmt.Sprintf("Cannot drop table '%s' referenced by a foreign key constraint '%s' on table '%s'.", table, fk, child)}
+}
+
+// NewNoPartitionForValue reports a row whose partition key matches no
+// partition of a partitioned table
+func NewNoPartitionForValue(value string) *Error {
+	return &Error{NO_PARTITION, fmt.Sprintf("Table has no partition for value %s", value)}
+}
+
+// NewSubqueryNoOneRow reports a scalar subquery returning more than one row
+// for a row of the outer query
+func NewSubqueryNoOneRow() *Error {
+	return &Error{SUBQUERY_NO_1_ROW, "Subquery returns more than 1 row"}
+}
@@ -53,7 +53,7 @@ func New(typ types.Type, desc bool) Compare {
 			return newCompare(genericDescCompare[uint8], genericCopy[uint8])
 		}
 		return newCompare(genericCompare[uint8], genericCopy[uint8])
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		if desc {
 			return newCompare(genericDescCompare[uint16], genericCopy[uint16])
 		}
@@ -63,7 +63,7 @@ func New(typ types.Type, desc bool) Compare {
 			return newCompare(genericDescCompare[uint32], genericCopy[uint32])
 		}
 		return newCompare(genericCompare[uint32], genericCopy[uint32])
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		if desc {
 			return newCompare(genericDescCompare[uint64], genericCopy[uint64])
 		}
@@ -103,7 +103,13 @@ func New(typ types.Type, desc bool) Compare {
 			return newCompare(decimal128DescCompare, decimal128Copy)
 		}
 		return newCompare(decimal128Compare, decimal128Copy)
-	case types.T_char, types.T_varchar:
+	case types.T_uuid:
+		if desc {
+			return newCompare(uuidDescCompare, uuidCopy)
+		}
+		return newCompare(uuidCompare, uuidCopy)
+	case types.T_char, types.T_varchar, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		return &strCompare{
 			desc: desc,
 			vs:   make([]*vector.Vector, 2),
@@ -130,6 +136,10 @@ func decimal128Compare(x, y types.Decimal128) int {
 	return x.Compare(y)
 }
 
+func uuidCompare(x, y types.Uuid) int {
+	return x.Compare(y)
+}
+
 func genericCompare[T types.Generic](x, y T) int {
 	if x == y {
 		return 0
@@ -156,6 +166,9 @@ func decimal64DescCompare(x, y types.Decimal64) int {
 func decimal128DescCompare(x, y types.Decimal128) int {
 	return -x.Compare(y)
 }
+func uuidDescCompare(x, y types.Uuid) int {
+	return -x.Compare(y)
+}
 
 func genericDescCompare[T types.Generic](x, y T) int {
 	if x == y {
@@ -179,6 +192,10 @@ func decimal128Copy(vecDst, vecSrc []types.Decimal128, dst, src int64) {
 	vecDst[dst] = vecSrc[src]
 }
 
+func uuidCopy(vecDst, vecSrc []types.Uuid, dst, src int64) {
+	vecDst[dst] = vecSrc[src]
+}
+
 func genericCopy[T types.Generic](vecDst, vecSrc []T, dst, src int64) {
 	vecDst[dst] = vecSrc[src]
 }
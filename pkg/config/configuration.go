@@ -15,6 +15,8 @@
 package config
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/incrservice"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
@@ -84,6 +86,12 @@ type ParameterUnit struct {
 
 	//Cluster Nodes
 	ClusterNodes engine.Nodes
+
+	//FileService stores the temporary files spilled by the operators, nil if spilling is disabled
+	FileService fileservice.FileService
+
+	//IncrService allocates the values of the auto increment columns
+	IncrService *incrservice.Service
 }
 
 func NewParameterUnit(sv *SystemVariables, hostMmu *host.Mmu, mempool *mempool.Mempool, storageEngine engine.Engine, clusterNodes engine.Nodes) *ParameterUnit {
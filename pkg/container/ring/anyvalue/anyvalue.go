@@ -31,7 +31,7 @@ import (
 
 type ts1 interface {
 	constraints.Integer | bool | types.Date | types.Datetime |
-		constraints.Float | types.Decimal64 | types.Decimal128 | types.Timestamp
+		constraints.Float | types.Decimal64 | types.Decimal128 | types.Timestamp | types.Uuid
 }
 
 // AnyVRing1 for bool / int / uint / float / date / datetime
@@ -200,7 +200,7 @@ func NewAnyValueRingWithTypeCheck(typ types.Type) (ring.Ring, error) {
 		return &AnyVRing1[float64]{Typ: typ}, nil
 	case types.T_char:
 		return &AnyVRing2{Typ: typ}, nil
-	case types.T_varchar:
+	case types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 		return &AnyVRing2{Typ: typ}, nil
 	case types.T_date:
 		return &AnyVRing1[types.Date]{Typ: typ}, nil
@@ -210,6 +210,8 @@ func NewAnyValueRingWithTypeCheck(typ types.Type) (ring.Ring, error) {
 		return &AnyVRing1[types.Decimal64]{Typ: typ}, nil
 	case types.T_decimal128:
 		return &AnyVRing1[types.Decimal128]{Typ: typ}, nil
+	case types.T_uuid:
+		return &AnyVRing1[types.Uuid]{Typ: typ}, nil
 	case types.T_timestamp:
 		return &AnyVRing1[types.Timestamp]{Typ: typ}, nil
 	}
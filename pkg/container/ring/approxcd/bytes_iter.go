@@ -137,7 +137,8 @@ func newBytesIterSolo(vec *vector.Vector) bytesIter {
 	var data []byte
 	var stride int
 	switch vec.Typ.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		return newVarBytes(vec)
 	case types.T_int8:
 		data, stride = encoding.EncodeInt8Slice(vec.Col.([]int8)), 1
@@ -167,6 +168,8 @@ func newBytesIterSolo(vec *vector.Vector) bytesIter {
 		data, stride = encoding.EncodeDecimal64Slice(vec.Col.([]types.Decimal64)), encoding.Decimal64Size
 	case types.T_decimal128:
 		data, stride = encoding.EncodeDecimal128Slice(vec.Col.([]types.Decimal128)), encoding.Decimal128Size
+	case types.T_uuid:
+		data, stride = encoding.EncodeUuidSlice(vec.Col.([]types.Uuid)), encoding.UuidSize
 	}
 	if data == nil {
 		panic(fmt.Sprintf("not support for type %s", vec.Typ.Oid))
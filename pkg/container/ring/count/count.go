@@ -224,6 +224,7 @@ func (r *DistCountRing) Free(m *mheap.Mheap) {
 		r.Da = nil
 		r.Vs = nil
 		r.Ns = nil
+		r.Ms = nil
 	}
 }
 
@@ -323,12 +324,13 @@ func (r *DistCountRing) Grows(size int, m *mheap.Mheap) error {
 	return nil
 }
 
+// Fill counts the value of the row once, however many times the row occurs.
 func (r *DistCountRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
 	if nulls.Contains(vec.Nsp, uint64(sel)) {
 		r.Ns[i] += z
 	} else {
 		if insertIntoMap(r.Ms[i], getValue(vec, sel)) {
-			r.Vs[i] += z
+			r.Vs[i]++
 		}
 	}
 }
@@ -340,14 +342,14 @@ func (r *DistCountRing) BatchFill(start int64, os []uint8, vps []uint64, zs []in
 				r.Ns[vps[i]-1] += zs[int64(i)+start]
 			} else {
 				if insertIntoMap(r.Ms[vps[i]-1], getValue(vec, int64(i)+start)) {
-					r.Vs[vps[i]-1] += zs[int64(i)+start]
+					r.Vs[vps[i]-1]++
 				}
 			}
 		}
 	} else {
 		for i := range os {
 			if insertIntoMap(r.Ms[vps[i]-1], getValue(vec, int64(i)+start)) {
-				r.Vs[vps[i]-1] += zs[int64(i)+start]
+				r.Vs[vps[i]-1]++
 			}
 		}
 	}
@@ -360,49 +362,55 @@ func (r *DistCountRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
 				r.Ns[i] += z
 			} else {
 				if insertIntoMap(r.Ms[i], getValue(vec, int64(j))) {
-					r.Vs[i] += z
+					r.Vs[i]++
 				}
 			}
 		}
 	} else {
-		for j, z := range zs {
+		for j := range zs {
 			if insertIntoMap(r.Ms[i], getValue(vec, int64(j))) {
-				r.Vs[i] += z
+				r.Vs[i]++
 			}
 		}
 	}
 }
 
+// Add merges the distinct values of group y of a into group x of r.
 func (r *DistCountRing) Add(a interface{}, x, y int64) {
 	ar := a.(*DistCountRing)
-	if insertIntoMap(r.Ms[x], ar.Vs[y]) {
-		r.Vs[x] += ar.Vs[y]
-	}
+	r.merge(x, ar.Ms[y])
 	r.Ns[x] += ar.Ns[y]
 }
 
 func (r *DistCountRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
 	ar := a.(*DistCountRing)
 	for i := range os {
-		if insertIntoMap(r.Ms[vps[i]-1], ar.Vs[int64(i)+start]) {
-			r.Vs[vps[i]-1] += ar.Vs[int64(i)+start]
-		}
+		r.merge(int64(vps[i]-1), ar.Ms[int64(i)+start])
 		r.Ns[vps[i]-1] += ar.Ns[int64(i)+start]
 	}
 }
 
-// Mul r[x] += a[y] * z
+// Mul r[x] += a[y] * z, the distinct values are the same however many times they occur
 func (r *DistCountRing) Mul(a interface{}, x, y, z int64) {
 	ar := a.(*DistCountRing)
-	r.Vs[x] += ar.Vs[y] * z
+	r.merge(x, ar.Ms[y])
 	r.Ns[x] += ar.Ns[y] * z
 }
 
+func (r *DistCountRing) merge(x int64, mp map[any]uint8) {
+	for v := range mp {
+		if insertIntoMap(r.Ms[x], v) {
+			r.Vs[x]++
+		}
+	}
+}
+
 func (r *DistCountRing) Eval(_ []int64) *vector.Vector {
 	defer func() {
 		r.Da = nil
 		r.Vs = nil
 		r.Ns = nil
+		r.Ms = nil
 	}()
 	nsp := new(nulls.Nulls)
 	return &vector.Vector{
@@ -456,7 +464,10 @@ func getValue(vec *vector.Vector, sel int64) any {
 		return vec.Col.([]types.Decimal64)[sel]
 	case types.T_decimal128:
 		return vec.Col.([]types.Decimal128)[sel]
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_uuid:
+		return vec.Col.([]types.Uuid)[sel]
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		vs := vec.Col.(*types.Bytes)
 		return string(vs.Get(sel))
 	default:
@@ -0,0 +1,41 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package count
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistinctCount(t *testing.T) {
+	proc := testutil.NewProc()
+	r1 := NewDistinctCount(types.T_varchar.ToType())
+	require.NoError(t, r1.Grows(2, proc.Mp))
+	// group 0 gets {a, b, a, null} and group 1 gets {c}
+	vec := testutil.MakeVarcharVector([]string{"a", "b", "a", "", "c"}, []uint64{3})
+	r1.BatchFill(0, make([]uint8, 5), []uint64{1, 1, 1, 1, 2}, []int64{1, 2, 1, 1, 1}, vec)
+
+	// another ring with {b, d} of group 0 and {c} of group 1 is merged into r1
+	r2 := r1.Dup().(*DistCountRing)
+	require.NoError(t, r2.Grows(2, proc.Mp))
+	r2.BulkFill(0, []int64{1, 1}, testutil.MakeVarcharVector([]string{"b", "d"}, nil))
+	r2.Fill(1, 0, 3, testutil.MakeVarcharVector([]string{"c"}, nil))
+	r1.BatchAdd(r2, 0, make([]uint8, 2), []uint64{1, 2})
+
+	require.Equal(t, []int64{3, 1}, r1.Eval(nil).Col)
+}
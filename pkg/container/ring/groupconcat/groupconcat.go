@@ -0,0 +1,281 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupconcat
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+// GroupConcatRing keeps the strings of each group, which are joined by the
+// separator in the order of their keys by Eval.
+type GroupConcatRing struct {
+	Typ types.Type
+	Vs  [][]item
+	Ms  []map[string]struct{} // strings of each group, only for DISTINCT
+
+	// Separator is put between the strings of a group
+	Separator string
+	// Descs are the directions of the ORDER BY keys, if any, the rows are
+	// then the comparable tuples of a string and its keys.
+	Descs []bool
+	// Dist keeps only the first of the equal strings of a group
+	Dist bool
+}
+
+type item struct {
+	value []byte
+	keys  [][]byte
+}
+
+// NewGroupConcat makes the ring of GROUP_CONCAT.
+func NewGroupConcat(typ types.Type, dist bool, separator string, descs []bool) (*GroupConcatRing, error) {
+	switch typ.Oid {
+	case types.T_char, types.T_varchar, types.T_text:
+	default:
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("'%v' not support group_concat", typ))
+	}
+	return &GroupConcatRing{
+		Typ:       typ,
+		Separator: separator,
+		Descs:     descs,
+		Dist:      dist,
+	}, nil
+}
+
+func (r *GroupConcatRing) String() string {
+	return fmt.Sprintf("group_concat(separator %q)", r.Separator)
+}
+
+func (r *GroupConcatRing) Free(_ *mheap.Mheap) {
+	r.Vs = nil
+	r.Ms = nil
+}
+
+func (r *GroupConcatRing) Count() int {
+	return len(r.Vs)
+}
+
+func (r *GroupConcatRing) Size() int {
+	size := 0
+	for _, items := range r.Vs {
+		for _, it := range items {
+			size += len(it.value)
+			for _, key := range it.keys {
+				size += len(key)
+			}
+		}
+	}
+	return size
+}
+
+func (r *GroupConcatRing) Dup() ring.Ring {
+	return &GroupConcatRing{
+		Typ:       r.Typ,
+		Separator: r.Separator,
+		Descs:     r.Descs,
+		Dist:      r.Dist,
+	}
+}
+
+func (r *GroupConcatRing) Type() types.Type {
+	return r.Typ
+}
+
+func (r *GroupConcatRing) SetLength(n int) {
+	r.Vs = r.Vs[:n]
+	if r.Dist {
+		r.Ms = r.Ms[:n]
+	}
+}
+
+func (r *GroupConcatRing) Shrink(sels []int64) {
+	for i, sel := range sels {
+		r.Vs[i] = r.Vs[sel]
+		if r.Dist {
+			r.Ms[i] = r.Ms[sel]
+		}
+	}
+	r.Vs = r.Vs[:len(sels)]
+	if r.Dist {
+		r.Ms = r.Ms[:len(sels)]
+	}
+}
+
+func (r *GroupConcatRing) Shuffle(_ []int64, _ *mheap.Mheap) error {
+	return nil
+}
+
+func (r *GroupConcatRing) Grow(m *mheap.Mheap) error {
+	return r.Grows(1, m)
+}
+
+func (r *GroupConcatRing) Grows(size int, _ *mheap.Mheap) error {
+	for i := 0; i < size; i++ {
+		r.Vs = append(r.Vs, nil)
+		if r.Dist {
+			r.Ms = append(r.Ms, make(map[string]struct{}))
+		}
+	}
+	return nil
+}
+
+func (r *GroupConcatRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(sel)) {
+		return
+	}
+	it, ok := r.decode(vec.Col.(*types.Bytes).Get(sel))
+	if !ok {
+		return
+	}
+	for k := int64(0); k < z; k++ {
+		if !r.insert(i, it) {
+			break
+		}
+	}
+}
+
+func (r *GroupConcatRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
+	for i := range os {
+		r.Fill(int64(vps[i]-1), int64(i)+start, zs[int64(i)+start], vec)
+	}
+}
+
+func (r *GroupConcatRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	for j, z := range zs {
+		r.Fill(i, int64(j), z, vec)
+	}
+}
+
+func (r *GroupConcatRing) Add(a interface{}, x, y int64) {
+	ar := a.(*GroupConcatRing)
+	for _, it := range ar.Vs[y] {
+		r.insert(x, it)
+	}
+}
+
+func (r *GroupConcatRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
+	ar := a.(*GroupConcatRing)
+	for i := range os {
+		for _, it := range ar.Vs[int64(i)+start] {
+			r.insert(int64(vps[i]-1), it)
+		}
+	}
+}
+
+func (r *GroupConcatRing) Mul(a interface{}, x, y, z int64) {
+	ar := a.(*GroupConcatRing)
+	items := ar.Vs[y]
+	for k := int64(0); k < z; k++ {
+		for _, it := range items {
+			r.insert(x, it)
+		}
+	}
+}
+
+func (r *GroupConcatRing) Eval(_ []int64) *vector.Vector {
+	defer func() {
+		r.Vs = nil
+		r.Ms = nil
+	}()
+	nsp := new(nulls.Nulls)
+	var data []byte
+	os, ls := make([]uint32, len(r.Vs)), make([]uint32, len(r.Vs)) // offsets and lengths
+	for i, items := range r.Vs {
+		os[i] = uint32(len(data))
+		if len(items) == 0 {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		if len(r.Descs) > 0 {
+			sort.SliceStable(items, func(a, b int) bool {
+				return r.compare(items[a], items[b]) < 0
+			})
+		}
+		for j, it := range items {
+			if j > 0 {
+				data = append(data, r.Separator...)
+			}
+			data = append(data, it.value...)
+		}
+		ls[i] = uint32(len(data)) - os[i]
+	}
+	return &vector.Vector{
+		Nsp: nsp,
+		Col: &types.Bytes{
+			Data:    data,
+			Offsets: os,
+			Lengths: ls,
+		},
+		Or:  false,
+		Typ: types.T_varchar.ToType(),
+	}
+}
+
+// insert appends the string to the group, false if it is a duplicate of DISTINCT
+func (r *GroupConcatRing) insert(i int64, it item) bool {
+	if r.Dist {
+		if _, ok := r.Ms[i][string(it.value)]; ok {
+			return false
+		}
+		r.Ms[i][string(it.value)] = struct{}{}
+	}
+	r.Vs[i] = append(r.Vs[i], it)
+	return true
+}
+
+// decode splits a row into its string and its keys, false if the string is null
+func (r *GroupConcatRing) decode(row []byte) (item, bool) {
+	if len(r.Descs) == 0 {
+		return item{value: append([]byte{}, row...)}, true
+	}
+	row = append([]byte{}, row...)
+	value, row, err := encoding.SplitComparable(row)
+	if err != nil {
+		return item{}, false
+	}
+	it := item{keys: make([][]byte, len(r.Descs))}
+	if it.value, err = encoding.DecodeComparableBytes(value); err != nil || it.value == nil {
+		return item{}, false
+	}
+	for i := range it.keys {
+		if it.keys[i], row, err = encoding.SplitComparable(row); err != nil {
+			return item{}, false
+		}
+	}
+	return it, true
+}
+
+func (r *GroupConcatRing) compare(a, b item) int {
+	for i, desc := range r.Descs {
+		if c := bytes.Compare(a.keys[i], b.keys[i]); c != 0 {
+			if desc {
+				return -c
+			}
+			return c
+		}
+	}
+	return 0
+}
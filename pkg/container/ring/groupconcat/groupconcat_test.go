@@ -0,0 +1,71 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package groupconcat
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupConcat(t *testing.T) {
+	proc := testutil.NewProc()
+	r, err := NewGroupConcat(types.T_varchar.ToType(), false, ";", nil)
+	require.NoError(t, err)
+	require.NoError(t, r.Grows(3, proc.Mp))
+	// group 0 gets {a, b, null, a}, group 1 gets {c} twice and group 2 nothing
+	vec := testutil.MakeVarcharVector([]string{"a", "b", "", "c", "a"}, []uint64{2})
+	r.BatchFill(0, make([]uint8, 5), []uint64{1, 1, 1, 2, 1}, []int64{1, 1, 1, 2, 1}, vec)
+
+	res := r.Eval(nil)
+	values := vector.MustBytesCols(res)
+	require.Equal(t, "a;b;a", string(values.Get(0)))
+	require.Equal(t, "c;c", string(values.Get(1)))
+	require.True(t, nulls.Contains(res.Nsp, 2))
+}
+
+func TestGroupConcatOrderBy(t *testing.T) {
+	proc := testutil.NewProc()
+	// the rows are the tuples of the string, the first key in descending
+	// order and the second key in ascending order
+	tuple := func(s string, k1 int64, k2 string) string {
+		row := encoding.EncodeComparableBytes(nil, []byte(s))
+		row = encoding.EncodeComparableInt64(row, k1)
+		return string(encoding.EncodeComparableBytes(row, []byte(k2)))
+	}
+	rows := []string{tuple("x", 1, "b"), tuple("y", 2, "a"), tuple("z", 1, "a"), tuple("y", 0, "a")}
+
+	r1, err := NewGroupConcat(types.T_varchar.ToType(), true, ",", []bool{true, false})
+	require.NoError(t, err)
+	require.NoError(t, r1.Grow(proc.Mp))
+	r1.BulkFill(0, []int64{1, 1}, testutil.MakeVarcharVector(rows[:2], nil))
+
+	// the other half is merged from another ring
+	r2 := r1.Dup().(*GroupConcatRing)
+	require.NoError(t, r2.Grow(proc.Mp))
+	r2.BulkFill(0, []int64{1, 1}, testutil.MakeVarcharVector(rows[2:], nil))
+	r1.Add(r2, 0, 0)
+
+	// the second y is dropped by DISTINCT
+	require.Equal(t, "y,z,x", string(vector.MustBytesCols(r1.Eval(nil)).Get(0)))
+
+	_, err = NewGroupConcat(types.T_int64.ToType(), false, ",", nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,284 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package percentile
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"golang.org/x/exp/constraints"
+)
+
+type ts interface {
+	constraints.Integer | constraints.Float | types.Date | types.Datetime | types.Timestamp
+}
+
+// PercentileRing keeps all the values of each group, which are sorted by Eval
+// to find the value at the fraction of the group.
+type PercentileRing[T ts] struct {
+	Typ types.Type
+	Mp  *mheap.Mheap
+	Vs  [][]T
+	// Da is the memory of the results of the groups, which grows with the
+	// groups so that Eval doesn't allocate
+	Da []byte
+
+	// Fraction is the percentile to find, between 0 and 1
+	Fraction float64
+	// Cont interpolates between the two values around the fraction as
+	// PERCENTILE_CONT does, otherwise the first value whose cumulative
+	// distribution reaches the fraction is returned as PERCENTILE_DISC does.
+	Cont bool
+}
+
+// NewPercentileCont makes the ring of PERCENTILE_CONT, whose result is a float64.
+func NewPercentileCont(typ types.Type, fraction float64) (ring.Ring, error) {
+	switch typ.Oid {
+	case types.T_int8:
+		return &PercentileRing[int8]{Typ: typ, Fraction: fraction, Cont: true}, nil
+	case types.T_int16:
+		return &PercentileRing[int16]{Typ: typ, Fraction: fraction, Cont: true}, nil
+	case types.T_int32:
+		return &PercentileRing[int32]{Typ: typ, Fraction: fraction, Cont: true}, nil
+	case types.T_int64:
+		return &PercentileRing[int64]{Typ: typ, Fraction: fraction, Cont: true}, nil
+	case types.T_uint8:
+		return &PercentileRing[uint8]{Typ: typ, Fraction: fraction, Cont: true}, nil
+	case types.T_uint16:
+		return &PercentileRing[uint16]{Typ: typ, Fraction: fraction, Cont: true}, nil
+	case types.T_uint32:
+		return &PercentileRing[uint32]{Typ: typ, Fraction: fraction, Cont: true}, nil
+	case types.T_uint64:
+		return &PercentileRing[uint64]{Typ: typ, Fraction: fraction, Cont: true}, nil
+	case types.T_float32:
+		return &PercentileRing[float32]{Typ: typ, Fraction: fraction, Cont: true}, nil
+	case types.T_float64:
+		return &PercentileRing[float64]{Typ: typ, Fraction: fraction, Cont: true}, nil
+	}
+	return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("'%v' not support percentile_cont", typ))
+}
+
+// NewPercentileDisc makes the ring of PERCENTILE_DISC, whose result has the type of its values.
+func NewPercentileDisc(typ types.Type, fraction float64) (ring.Ring, error) {
+	switch typ.Oid {
+	case types.T_int8:
+		return &PercentileRing[int8]{Typ: typ, Fraction: fraction}, nil
+	case types.T_int16:
+		return &PercentileRing[int16]{Typ: typ, Fraction: fraction}, nil
+	case types.T_int32:
+		return &PercentileRing[int32]{Typ: typ, Fraction: fraction}, nil
+	case types.T_int64:
+		return &PercentileRing[int64]{Typ: typ, Fraction: fraction}, nil
+	case types.T_uint8:
+		return &PercentileRing[uint8]{Typ: typ, Fraction: fraction}, nil
+	case types.T_uint16:
+		return &PercentileRing[uint16]{Typ: typ, Fraction: fraction}, nil
+	case types.T_uint32:
+		return &PercentileRing[uint32]{Typ: typ, Fraction: fraction}, nil
+	case types.T_uint64:
+		return &PercentileRing[uint64]{Typ: typ, Fraction: fraction}, nil
+	case types.T_float32:
+		return &PercentileRing[float32]{Typ: typ, Fraction: fraction}, nil
+	case types.T_float64:
+		return &PercentileRing[float64]{Typ: typ, Fraction: fraction}, nil
+	case types.T_date:
+		return &PercentileRing[types.Date]{Typ: typ, Fraction: fraction}, nil
+	case types.T_datetime:
+		return &PercentileRing[types.Datetime]{Typ: typ, Fraction: fraction}, nil
+	case types.T_timestamp:
+		return &PercentileRing[types.Timestamp]{Typ: typ, Fraction: fraction}, nil
+	}
+	return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("'%v' not support percentile_disc", typ))
+}
+
+func (r *PercentileRing[T]) String() string {
+	if r.Cont {
+		return fmt.Sprintf("percentile_cont(%v)", r.Fraction)
+	}
+	return fmt.Sprintf("percentile_disc(%v)", r.Fraction)
+}
+
+func (r *PercentileRing[T]) Free(m *mheap.Mheap) {
+	if r.Da != nil {
+		mheap.Free(m, r.Da)
+		r.Da = nil
+	}
+	r.Vs = nil
+}
+
+func (r *PercentileRing[T]) Count() int {
+	return len(r.Vs)
+}
+
+func (r *PercentileRing[T]) Size() int {
+	size := 0
+	for _, vs := range r.Vs {
+		size += cap(vs)
+	}
+	return size * r.Typ.Oid.TypeLen()
+}
+
+func (r *PercentileRing[T]) resultType() types.Type {
+	if r.Cont {
+		return types.Type{Oid: types.T_float64, Size: 8}
+	}
+	return r.Typ
+}
+
+func (r *PercentileRing[T]) Dup() ring.Ring {
+	return &PercentileRing[T]{
+		Typ:      r.Typ,
+		Fraction: r.Fraction,
+		Cont:     r.Cont,
+	}
+}
+
+func (r *PercentileRing[T]) Type() types.Type {
+	return r.Typ
+}
+
+func (r *PercentileRing[T]) SetLength(n int) {
+	r.Vs = r.Vs[:n]
+}
+
+func (r *PercentileRing[T]) Shrink(sels []int64) {
+	for i, sel := range sels {
+		r.Vs[i] = r.Vs[sel]
+	}
+	r.Vs = r.Vs[:len(sels)]
+}
+
+func (r *PercentileRing[T]) Shuffle(_ []int64, _ *mheap.Mheap) error {
+	return nil
+}
+
+func (r *PercentileRing[T]) Grow(m *mheap.Mheap) error {
+	return r.Grows(1, m)
+}
+
+func (r *PercentileRing[T]) Grows(size int, m *mheap.Mheap) error {
+	if r.Mp == nil {
+		r.Mp = m
+	}
+	n, width := len(r.Vs), r.resultType().Oid.TypeLen()
+	if r.Da == nil {
+		data, err := mheap.Alloc(m, int64(size*width))
+		if err != nil {
+			return err
+		}
+		r.Da = data
+	} else if (n+size)*width > cap(r.Da) {
+		data, err := mheap.Grow(m, r.Da[:n*width], int64((n+size)*width))
+		if err != nil {
+			return err
+		}
+		mheap.Free(m, r.Da)
+		r.Da = data
+	}
+	r.Da = r.Da[:(n+size)*width]
+	for i := 0; i < size; i++ {
+		r.Vs = append(r.Vs, nil)
+	}
+	return nil
+}
+
+func (r *PercentileRing[T]) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(sel)) {
+		return
+	}
+	v := vec.Col.([]T)[sel]
+	for k := int64(0); k < z; k++ {
+		r.Vs[i] = append(r.Vs[i], v)
+	}
+}
+
+func (r *PercentileRing[T]) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
+	for i := range os {
+		r.Fill(int64(vps[i]-1), int64(i)+start, zs[int64(i)+start], vec)
+	}
+}
+
+func (r *PercentileRing[T]) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	for j, z := range zs {
+		r.Fill(i, int64(j), z, vec)
+	}
+}
+
+func (r *PercentileRing[T]) Add(a interface{}, x, y int64) {
+	ar := a.(*PercentileRing[T])
+	r.Vs[x] = append(r.Vs[x], ar.Vs[y]...)
+}
+
+func (r *PercentileRing[T]) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
+	ar := a.(*PercentileRing[T])
+	for i := range os {
+		j := vps[i] - 1
+		r.Vs[j] = append(r.Vs[j], ar.Vs[int64(i)+start]...)
+	}
+}
+
+func (r *PercentileRing[T]) Mul(a interface{}, x, y, z int64) {
+	ar := a.(*PercentileRing[T])
+	for k := int64(0); k < z; k++ {
+		r.Vs[x] = append(r.Vs[x], ar.Vs[y]...)
+	}
+}
+
+func (r *PercentileRing[T]) Eval(_ []int64) *vector.Vector {
+	defer func() {
+		r.Da = nil
+		r.Vs = nil
+	}()
+	typ := r.resultType()
+	data := r.Da[:len(r.Vs)*typ.Oid.TypeLen()]
+	nsp := new(nulls.Nulls)
+	if r.Cont {
+		rs := encoding.DecodeFloat64Slice(data)
+		for i, vs := range r.Vs {
+			if len(vs) == 0 {
+				nulls.Add(nsp, uint64(i))
+				continue
+			}
+			sort.Slice(vs, func(a, b int) bool { return vs[a] < vs[b] })
+			pos := r.Fraction * float64(len(vs)-1)
+			lo, hi := int(math.Floor(pos)), int(math.Ceil(pos))
+			rs[i] = float64(vs[lo]) + (pos-float64(lo))*(float64(vs[hi])-float64(vs[lo]))
+		}
+		return vector.NewWithData(typ, data, rs, nsp)
+	}
+	rs := encoding.DecodeFixedSlice[T](data, typ.Oid.TypeLen())
+	for i, vs := range r.Vs {
+		if len(vs) == 0 {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		sort.Slice(vs, func(a, b int) bool { return vs[a] < vs[b] })
+		pos := int(math.Ceil(r.Fraction*float64(len(vs)))) - 1
+		if pos < 0 {
+			pos = 0
+		}
+		rs[i] = vs[pos]
+	}
+	return vector.NewWithData(typ, data, rs, nsp)
+}
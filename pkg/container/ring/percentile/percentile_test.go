@@ -0,0 +1,79 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package percentile
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPercentileCont(t *testing.T) {
+	proc := testutil.NewProc()
+	// group 0 gets {4, 1, 3, 2}, group 1 gets {5} and group 2 only a null
+	vec := testutil.MakeInt32Vector([]int32{4, 5, 1, 0, 3, 2}, []uint64{3})
+	r, err := NewPercentileCont(types.T_int32.ToType(), 0.3)
+	require.NoError(t, err)
+	require.NoError(t, r.Grows(3, proc.Mp))
+	r.BatchFill(0, make([]uint8, 6), []uint64{1, 2, 1, 3, 1, 1}, []int64{1, 1, 1, 1, 1, 1}, vec)
+
+	// merge another ring holding {6} of group 0
+	r2 := r.Dup()
+	require.NoError(t, r2.Grows(1, proc.Mp))
+	r2.Fill(0, 0, 1, testutil.MakeInt32Vector([]int32{6}, nil))
+	r.Add(r2, 0, 0)
+	r2.Free(proc.Mp)
+
+	// the results are allocated as the groups grow, Eval hands them to the vector
+	size := mheap.Size(proc.Mp)
+	res := r.Eval(nil)
+	require.Equal(t, size, mheap.Size(proc.Mp))
+	// 0.3 of {1, 2, 3, 4, 6} is between 2 and 3
+	vs := res.Col.([]float64)
+	require.InDelta(t, 2.2, vs[0], 1e-9)
+	require.Equal(t, 5.0, vs[1])
+	require.True(t, nulls.Contains(res.Nsp, 2))
+	require.False(t, nulls.Contains(res.Nsp, 1))
+}
+
+func TestPercentileDisc(t *testing.T) {
+	proc := testutil.NewProc()
+	vec := testutil.MakeDateVector([]string{"2022-01-03", "2022-01-01", "2022-01-04", "2022-01-02"}, nil)
+	for _, c := range []struct {
+		fraction float64
+		expected string
+	}{
+		{0, "2022-01-01"},
+		{0.25, "2022-01-01"},
+		{0.5, "2022-01-02"},
+		{0.6, "2022-01-03"},
+		{1, "2022-01-04"},
+	} {
+		r, err := NewPercentileDisc(types.T_date.ToType(), c.fraction)
+		require.NoError(t, err)
+		require.NoError(t, r.Grow(proc.Mp))
+		r.BulkFill(0, []int64{1, 1, 1, 1}, vec)
+		res := r.Eval(nil)
+		require.Equal(t, types.T_date, res.Typ.Oid)
+		require.Equal(t, c.expected, res.Col.([]types.Date)[0].String())
+	}
+
+	_, err := NewPercentileDisc(types.T_varchar.ToType(), 0.5)
+	require.Error(t, err)
+}
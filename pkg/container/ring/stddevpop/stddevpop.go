@@ -37,6 +37,9 @@ type StdDevPopRing struct {
 	SumX2 []float64 // sum of x^2
 
 	NullCounts []int64 // group to record number of the null value
+
+	// Sample is true for the sample standard deviation, which divides by n-1 instead of n
+	Sample bool
 }
 
 func NewStdDevPopRingWithTypeCheck(typ types.Type) (*StdDevPopRing, error) {
@@ -54,6 +57,16 @@ func NewStdDevPopRingWithTypeCheck(typ types.Type) (*StdDevPopRing, error) {
 func NewStdDevPopRing(typ types.Type) *StdDevPopRing {
 	return &StdDevPopRing{Typ: typ}
 }
+
+// NewStdDevSampRingWithTypeCheck makes a StdDevPopRing computing the sample standard deviation.
+func NewStdDevSampRingWithTypeCheck(typ types.Type) (*StdDevPopRing, error) {
+	v, err := NewStdDevPopRingWithTypeCheck(typ)
+	if err != nil {
+		return nil, err
+	}
+	v.Sample = true
+	return v, nil
+}
 func (v *StdDevPopRing) Count() int {
 	return len(v.SumX)
 }
@@ -63,7 +76,7 @@ func (v *StdDevPopRing) Size() int {
 }
 
 func (v *StdDevPopRing) Dup() ring.Ring {
-	return NewStdDevPopRing(v.Typ)
+	return &StdDevPopRing{Typ: v.Typ, Sample: v.Sample}
 }
 
 func (v *StdDevPopRing) Type() types.Type {
@@ -71,6 +84,9 @@ func (v *StdDevPopRing) Type() types.Type {
 }
 
 func (v *StdDevPopRing) String() string {
+	if v.Sample {
+		return "stddev_samp ring"
+	}
 	return "stddev_pop ring"
 }
 
@@ -183,13 +199,16 @@ func (v *StdDevPopRing) Eval(zs []int64) *vector.Vector {
 
 	nsp := new(nulls.Nulls)
 	for i, z := range zs {
-		if n := z - v.NullCounts[i]; n == 0 {
+		if n := z - v.NullCounts[i]; n == 0 || (v.Sample && n == 1) {
 			nulls.Add(nsp, uint64(i))
 		} else {
 			v.SumX[i] /= float64(n)  // compute E(x)
 			v.SumX2[i] /= float64(n) // compute E(x^2)
 
 			variance := v.SumX2[i] - math.Pow(v.SumX[i], 2)
+			if v.Sample {
+				variance = variance * float64(n) / float64(n-1)
+			}
 
 			v.SumX[i] = math.Sqrt(variance) // using v.SumX to record the result and return.
 		}
@@ -206,6 +225,11 @@ func (v *StdDevPopRing) Eval(zs []int64) *vector.Vector {
 }
 
 func (v *StdDevPopRing) Fill(i, j int64, z int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(j)) {
+		v.NullCounts[i] += z
+		return
+	}
+
 	var value float64 = 0
 	switch vec.Typ.Oid {
 	case types.T_int8:
@@ -232,10 +256,6 @@ func (v *StdDevPopRing) Fill(i, j int64, z int64, vec *vector.Vector) {
 
 	v.SumX[i] += value * float64(z)
 	v.SumX2[i] += math.Pow(value, 2) * float64(z)
-
-	if nulls.Contains(vec.Nsp, uint64(z)) {
-		v.NullCounts[i] += z
-	}
 }
 
 func (v *StdDevPopRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
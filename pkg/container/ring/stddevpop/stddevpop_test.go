@@ -69,3 +69,18 @@ func TestStdDevPopRing(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestStdDevSampRing(t *testing.T) {
+	// the sample standard deviation of {1, 2, null, 0, 3, 4} and {7}
+	v, err := NewStdDevSampRingWithTypeCheck(types.Type{Oid: types.T_float64})
+	require.NoError(t, err)
+	v.SumX = []float64{1 + 2 + 0 + 3 + 4, 7}
+	v.SumX2 = []float64{1*1 + 2*2 + 3*3 + 4*4, 7 * 7}
+	v.NullCounts = []int64{1, 0}
+
+	dup := v.Dup().(*StdDevPopRing)
+	require.True(t, dup.Sample)
+	vec := v.Eval([]int64{6, 1})
+	require.InDelta(t, math.Sqrt(2.5), vec.Col.([]float64)[0], 1e-9)
+	require.True(t, vec.Nsp.Contains(1))
+}
@@ -41,6 +41,9 @@ type VarRing struct {
 	SumX2 []float64 // sum of x^2
 
 	NullCounts []int64 // group to record number of the null value
+
+	// Sample is true for the sample variance, which divides by n-1 instead of n
+	Sample bool
 }
 
 func NewVarianceRingWithTypeCheck(typ types.Type) (*VarRing, error) {
@@ -59,6 +62,16 @@ func NewVarianceRing(typ types.Type) *VarRing {
 	return &VarRing{Typ: typ}
 }
 
+// NewVarSampRingWithTypeCheck makes a VarRing computing the sample variance.
+func NewVarSampRingWithTypeCheck(typ types.Type) (*VarRing, error) {
+	v, err := NewVarianceRingWithTypeCheck(typ)
+	if err != nil {
+		return nil, err
+	}
+	v.Sample = true
+	return v, nil
+}
+
 func (v *VarRing) Free(m *mheap.Mheap) {
 	if v.Data != nil {
 		mheap.Free(m, v.Data)
@@ -90,7 +103,7 @@ func (v *VarRing) Type() types.Type {
 
 // Dup will make a new VarRing with the same type.
 func (v *VarRing) Dup() ring.Ring {
-	return NewVarianceRing(v.Typ)
+	return &VarRing{Typ: v.Typ, Sample: v.Sample}
 }
 
 func (v *VarRing) SetLength(n int) {
@@ -184,6 +197,11 @@ func (v *VarRing) Grows(N int, m *mheap.Mheap) error {
 
 // Fill use row j of vector to update the ring's group i
 func (v *VarRing) Fill(i, j int64, z int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(j)) {
+		v.NullCounts[i] += z
+		return
+	}
+
 	var value float64 = 0
 
 	switch vec.Typ.Oid {
@@ -211,10 +229,6 @@ func (v *VarRing) Fill(i, j int64, z int64, vec *vector.Vector) {
 
 	v.SumX[i] += value * float64(z)
 	v.SumX2[i] += math.Pow(value, 2) * float64(z)
-
-	if nulls.Contains(vec.Nsp, uint64(z)) {
-		v.NullCounts[i] += z
-	}
 }
 
 // BatchFill use parts of vector to update the ring
@@ -494,7 +508,8 @@ func (v *VarRing) Mul(a interface{}, x, y, z int64) {
 	}
 }
 
-// Eval returns the variance result using result = E(x^2) - E(x)^2
+// Eval returns the variance result using result = E(x^2) - E(x)^2,
+// which is multiplied by n/(n-1) for the sample variance
 func (v *VarRing) Eval(zs []int64) *vector.Vector {
 	defer func() {
 		v.SumX = nil
@@ -505,13 +520,16 @@ func (v *VarRing) Eval(zs []int64) *vector.Vector {
 
 	nsp := new(nulls.Nulls)
 	for i, z := range zs {
-		if n := z - v.NullCounts[i]; n == 0 {
+		if n := z - v.NullCounts[i]; n == 0 || (v.Sample && n == 1) {
 			nulls.Add(nsp, uint64(i))
 		} else {
 			v.SumX[i] /= float64(n)  // compute E(x)
 			v.SumX2[i] /= float64(n) // compute E(x^2)
 
 			variance := v.SumX2[i] - math.Pow(v.SumX[i], 2)
+			if v.Sample {
+				variance = variance * float64(n) / float64(n-1)
+			}
 
 			v.SumX[i] = variance // using v.SumX to record the result and return.
 		}
@@ -16,6 +16,7 @@ package variance
 
 import (
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/stretchr/testify/require"
 	"reflect"
@@ -72,3 +73,26 @@ func TestVariance(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestVarSamp(t *testing.T) {
+	// the sample variance of {1, 2, null, 0, 3, 4}, {2, 3, null, null, 4, 5} and {7}
+	v1, err := NewVarSampRingWithTypeCheck(types.Type{Oid: types.T_int64})
+	require.NoError(t, err)
+	v2 := v1.Dup().(*VarRing)
+	require.True(t, v2.Sample)
+	v1.SumX = []float64{1 + 2, 2 + 3, 7}
+	v1.SumX2 = []float64{1*1 + 2*2, 2*2 + 3*3, 7 * 7}
+	v1.NullCounts = []int64{1, 1, 0}
+	v2.SumX = []float64{0 + 3 + 4, 4 + 5, 0}
+	v2.SumX2 = []float64{3*3 + 4*4, 4*4 + 5*5, 0}
+	v2.NullCounts = []int64{0, 1, 0}
+	for i := int64(0); i < 3; i++ {
+		v1.Add(v2, i, i)
+	}
+
+	result := v1.Eval([]int64{6, 6, 1})
+	vs := result.Col.([]float64)
+	require.InDelta(t, 2.5, vs[0], 1e-9)
+	require.InDelta(t, 5.0/3, vs[1], 1e-9)
+	require.True(t, nulls.Contains(result.Nsp, 2))
+}
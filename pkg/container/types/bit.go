@@ -0,0 +1,60 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// CheckBit checks that v fits in BIT(width), a width out of [1, 64] has no limit
+func CheckBit(v uint64, width int32) error {
+	if width > 0 && width < MaxBitWidth && v>>uint(width) != 0 {
+		return errors.New(errno.DataException, fmt.Sprintf("Data too long for BIT(%d) value %d", width, v))
+	}
+	return nil
+}
+
+// ParseBitBytes converts a binary string to BIT(width), the first byte is the
+// most significant one as mysql does.
+func ParseBitBytes(b []byte, width int32) (uint64, error) {
+	if len(b) > 8 {
+		return 0, errors.New(errno.DataException, fmt.Sprintf("Data too long for BIT(%d) value '%s'", width, b))
+	}
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	if err := CheckBit(v, width); err != nil {
+		return 0, err
+	}
+	return v, nil
+}
+
+// BitBytes returns the (width+7)/8 big endian bytes of v, which is how BIT(width)
+// is sent to the client.
+func BitBytes(v uint64, width int32) []byte {
+	if width <= 0 || width > MaxBitWidth {
+		width = MaxBitWidth
+	}
+	b := make([]byte, (width+7)/8)
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i] = byte(v)
+		v >>= 8
+	}
+	return b
+}
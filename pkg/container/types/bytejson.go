@@ -0,0 +1,501 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// TpCode is the type code of a json value in the binary encoding.
+type TpCode byte
+
+const (
+	TpCodeObject  TpCode = 0x01
+	TpCodeArray   TpCode = 0x03
+	TpCodeLiteral TpCode = 0x04
+	TpCodeInt64   TpCode = 0x09
+	TpCodeUint64  TpCode = 0x0a
+	TpCodeFloat64 TpCode = 0x0b
+	TpCodeString  TpCode = 0x0c
+)
+
+const (
+	LiteralNull  byte = 0x00
+	LiteralTrue  byte = 0x01
+	LiteralFalse byte = 0x02
+)
+
+const (
+	// an object or array starts with the element count and the data size
+	headerSize = 8
+	// an object key entry is the key offset and the key length
+	keyEntrySize = 6
+	// a value entry is the type code and the value offset
+	valEntrySize = 5
+)
+
+var (
+	ErrInvalidJson     = errors.New(errno.DataException, "Invalid JSON text")
+	ErrInvalidJsonPath = errors.New(errno.DataException, "Invalid JSON path expression")
+)
+
+// ByteJson is a json value in binary form. The elements of an object or an
+// array can be read without decoding the whole value, object keys are kept
+// sorted by length and then by bytes so a key is found by binary search.
+//
+// object: count uint32 | size uint32 | key entries | value entries | keys | values
+// array:  count uint32 | size uint32 | value entries | values
+type ByteJson struct {
+	Type TpCode
+	Data []byte
+}
+
+// ParseByteJson validates json text and converts it into the binary form.
+func ParseByteJson(s []byte) (ByteJson, error) {
+	dec := json.NewDecoder(bytes.NewReader(s))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return ByteJson{}, ErrInvalidJson
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return ByteJson{}, ErrInvalidJson
+	}
+	return CreateByteJson(v)
+}
+
+// CreateByteJson converts a go value into the binary form, it accepts the
+// values produced by encoding/json and ByteJson itself.
+func CreateByteJson(v interface{}) (ByteJson, error) {
+	typ, data, err := encodeJson(v)
+	if err != nil {
+		return ByteJson{}, err
+	}
+	return ByteJson{Type: typ, Data: data}, nil
+}
+
+// DecodeByteJson reads a value stored by Encode.
+func DecodeByteJson(buf []byte) ByteJson {
+	if len(buf) == 0 {
+		return ByteJson{Type: TpCodeLiteral, Data: []byte{LiteralNull}}
+	}
+	return ByteJson{Type: TpCode(buf[0]), Data: buf[1:]}
+}
+
+// Encode returns the stored form of bj, which is its type code followed by its data.
+func (bj ByteJson) Encode() []byte {
+	buf := make([]byte, 0, len(bj.Data)+1)
+	buf = append(buf, byte(bj.Type))
+	return append(buf, bj.Data...)
+}
+
+func encodeJson(v interface{}) (TpCode, []byte, error) {
+	switch x := v.(type) {
+	case nil:
+		return TpCodeLiteral, []byte{LiteralNull}, nil
+	case bool:
+		if x {
+			return TpCodeLiteral, []byte{LiteralTrue}, nil
+		}
+		return TpCodeLiteral, []byte{LiteralFalse}, nil
+	case int:
+		return encodeJson(int64(x))
+	case int64:
+		return TpCodeInt64, encodeUint64(uint64(x)), nil
+	case uint64:
+		return TpCodeUint64, encodeUint64(x), nil
+	case float64:
+		return TpCodeFloat64, encodeUint64(math.Float64bits(x)), nil
+	case json.Number:
+		if i, err := strconv.ParseInt(string(x), 10, 64); err == nil {
+			return encodeJson(i)
+		}
+		if u, err := strconv.ParseUint(string(x), 10, 64); err == nil {
+			return encodeJson(u)
+		}
+		f, err := strconv.ParseFloat(string(x), 64)
+		if err != nil {
+			return 0, nil, ErrInvalidJson
+		}
+		return encodeJson(f)
+	case string:
+		buf := make([]byte, binary.MaxVarintLen64+len(x))
+		n := binary.PutUvarint(buf, uint64(len(x)))
+		return TpCodeString, append(buf[:n], x...), nil
+	case ByteJson:
+		return x.Type, x.Data, nil
+	case []interface{}:
+		return encodeArray(x)
+	case map[string]interface{}:
+		return encodeObject(x)
+	}
+	return 0, nil, errors.New(errno.DataException, fmt.Sprintf("unsupported json value type %T", v))
+}
+
+func encodeUint64(v uint64) []byte {
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, v)
+	return buf
+}
+
+func encodeArray(elems []interface{}) (TpCode, []byte, error) {
+	types := make([]TpCode, len(elems))
+	vals := make([][]byte, len(elems))
+	for i, e := range elems {
+		typ, data, err := encodeJson(e)
+		if err != nil {
+			return 0, nil, err
+		}
+		types[i], vals[i] = typ, data
+	}
+	buf := make([]byte, headerSize+len(elems)*valEntrySize)
+	buf = appendValues(buf, headerSize, types, vals)
+	binary.LittleEndian.PutUint32(buf, uint32(len(elems)))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(len(buf)))
+	return TpCodeArray, buf, nil
+}
+
+func encodeObject(m map[string]interface{}) (TpCode, []byte, error) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return compareJsonKey([]byte(keys[i]), []byte(keys[j])) < 0
+	})
+	types := make([]TpCode, len(keys))
+	vals := make([][]byte, len(keys))
+	for i, k := range keys {
+		typ, data, err := encodeJson(m[k])
+		if err != nil {
+			return 0, nil, err
+		}
+		types[i], vals[i] = typ, data
+	}
+	buf := make([]byte, headerSize+len(keys)*(keyEntrySize+valEntrySize))
+	for i, k := range keys {
+		entry := headerSize + i*keyEntrySize
+		binary.LittleEndian.PutUint32(buf[entry:], uint32(len(buf)))
+		binary.LittleEndian.PutUint16(buf[entry+4:], uint16(len(k)))
+		buf = append(buf, k...)
+	}
+	buf = appendValues(buf, headerSize+len(keys)*keyEntrySize, types, vals)
+	binary.LittleEndian.PutUint32(buf, uint32(len(keys)))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(len(buf)))
+	return TpCodeObject, buf, nil
+}
+
+// appendValues fills the value entries which start at entries and appends the values to buf.
+func appendValues(buf []byte, entries int, types []TpCode, vals [][]byte) []byte {
+	for i := range vals {
+		entry := entries + i*valEntrySize
+		buf[entry] = byte(types[i])
+		binary.LittleEndian.PutUint32(buf[entry+1:], uint32(len(buf)))
+		buf = append(buf, vals[i]...)
+	}
+	return buf
+}
+
+// compareJsonKey orders object keys by length first, then by bytes.
+func compareJsonKey(a, b []byte) int {
+	if len(a) != len(b) {
+		return len(a) - len(b)
+	}
+	return bytes.Compare(a, b)
+}
+
+// jsonValueSize returns the length of the value of typ at the start of data.
+func jsonValueSize(typ TpCode, data []byte) int {
+	switch typ {
+	case TpCodeObject, TpCodeArray:
+		return int(binary.LittleEndian.Uint32(data[4:]))
+	case TpCodeLiteral:
+		return 1
+	case TpCodeInt64, TpCodeUint64, TpCodeFloat64:
+		return 8
+	case TpCodeString:
+		n, l := binary.Uvarint(data)
+		return int(n) + l
+	}
+	return len(data)
+}
+
+// GetElemCount returns the number of elements of an object or an array.
+func (bj ByteJson) GetElemCount() int {
+	return int(binary.LittleEndian.Uint32(bj.Data))
+}
+
+func (bj ByteJson) getValEntry(entries, i int) ByteJson {
+	entry := entries + i*valEntrySize
+	typ := TpCode(bj.Data[entry])
+	off := int(binary.LittleEndian.Uint32(bj.Data[entry+1:]))
+	data := bj.Data[off:]
+	return ByteJson{Type: typ, Data: data[:jsonValueSize(typ, data)]}
+}
+
+// GetArrayElem returns the i-th element of an array.
+func (bj ByteJson) GetArrayElem(i int) ByteJson {
+	return bj.getValEntry(headerSize, i)
+}
+
+// GetObjectKey returns the i-th key of an object.
+func (bj ByteJson) GetObjectKey(i int) []byte {
+	entry := headerSize + i*keyEntrySize
+	off := int(binary.LittleEndian.Uint32(bj.Data[entry:]))
+	n := int(binary.LittleEndian.Uint16(bj.Data[entry+4:]))
+	return bj.Data[off : off+n]
+}
+
+// GetObjectVal returns the value of the i-th key of an object.
+func (bj ByteJson) GetObjectVal(i int) ByteJson {
+	return bj.getValEntry(headerSize+bj.GetElemCount()*keyEntrySize, i)
+}
+
+// GetKey returns the value of key in an object.
+func (bj ByteJson) GetKey(key []byte) (ByteJson, bool) {
+	n := bj.GetElemCount()
+	i := sort.Search(n, func(i int) bool {
+		return compareJsonKey(bj.GetObjectKey(i), key) >= 0
+	})
+	if i < n && bytes.Equal(bj.GetObjectKey(i), key) {
+		return bj.GetObjectVal(i), true
+	}
+	return ByteJson{}, false
+}
+
+func (bj ByteJson) GetInt64() int64 {
+	return int64(binary.LittleEndian.Uint64(bj.Data))
+}
+
+func (bj ByteJson) GetUint64() uint64 {
+	return binary.LittleEndian.Uint64(bj.Data)
+}
+
+func (bj ByteJson) GetFloat64() float64 {
+	return math.Float64frombits(binary.LittleEndian.Uint64(bj.Data))
+}
+
+func (bj ByteJson) GetString() []byte {
+	n, l := binary.Uvarint(bj.Data)
+	return bj.Data[l : l+int(n)]
+}
+
+// IsNull reports whether bj is the json null literal.
+func (bj ByteJson) IsNull() bool {
+	return bj.Type == TpCodeLiteral && bj.Data[0] == LiteralNull
+}
+
+// Len returns the length of the value as JSON_LENGTH does,
+// an object or an array counts its elements and a scalar counts 1.
+func (bj ByteJson) Len() int {
+	if bj.Type == TpCodeObject || bj.Type == TpCodeArray {
+		return bj.GetElemCount()
+	}
+	return 1
+}
+
+// Keys returns the keys of an object as a json array.
+func (bj ByteJson) Keys() ByteJson {
+	keys := make([]interface{}, bj.GetElemCount())
+	for i := range keys {
+		keys[i] = string(bj.GetObjectKey(i))
+	}
+	_, data, _ := encodeArray(keys)
+	return ByteJson{Type: TpCodeArray, Data: data}
+}
+
+// Unquote returns the content of a json string and the text of other values.
+func (bj ByteJson) Unquote() string {
+	if bj.Type == TpCodeString {
+		return string(bj.GetString())
+	}
+	return bj.String()
+}
+
+// Contains reports whether candidate is contained in bj as JSON_CONTAINS defines it:
+// a scalar contains an equal scalar, an array contains a value which is contained
+// by one of its elements or an array whose elements are all contained, and an object
+// contains an object whose keys all exist with contained values.
+func (bj ByteJson) Contains(candidate ByteJson) bool {
+	switch bj.Type {
+	case TpCodeObject:
+		if candidate.Type != TpCodeObject {
+			return false
+		}
+		for i := 0; i < candidate.GetElemCount(); i++ {
+			v, ok := bj.GetKey(candidate.GetObjectKey(i))
+			if !ok || !v.Contains(candidate.GetObjectVal(i)) {
+				return false
+			}
+		}
+		return true
+	case TpCodeArray:
+		if candidate.Type == TpCodeArray {
+			for i := 0; i < candidate.GetElemCount(); i++ {
+				if !bj.Contains(candidate.GetArrayElem(i)) {
+					return false
+				}
+			}
+			return true
+		}
+		for i := 0; i < bj.GetElemCount(); i++ {
+			if bj.GetArrayElem(i).Contains(candidate) {
+				return true
+			}
+		}
+		return false
+	}
+	return bj.scalarEqual(candidate)
+}
+
+func (bj ByteJson) isNumber() bool {
+	return bj.Type == TpCodeInt64 || bj.Type == TpCodeUint64 || bj.Type == TpCodeFloat64
+}
+
+func (bj ByteJson) toFloat64() float64 {
+	switch bj.Type {
+	case TpCodeInt64:
+		return float64(bj.GetInt64())
+	case TpCodeUint64:
+		return float64(bj.GetUint64())
+	}
+	return bj.GetFloat64()
+}
+
+func (bj ByteJson) scalarEqual(other ByteJson) bool {
+	if bj.isNumber() && other.isNumber() {
+		if bj.Type == other.Type {
+			return bytes.Equal(bj.Data, other.Data)
+		}
+		return bj.toFloat64() == other.toFloat64()
+	}
+	if bj.Type != other.Type || bj.Type == TpCodeObject || bj.Type == TpCodeArray {
+		return false
+	}
+	return bytes.Equal(bj.Data, other.Data)
+}
+
+// String returns the json text of bj formatted as MySQL does.
+func (bj ByteJson) String() string {
+	return string(bj.appendText(nil))
+}
+
+func (bj ByteJson) appendText(buf []byte) []byte {
+	switch bj.Type {
+	case TpCodeObject:
+		buf = append(buf, '{')
+		for i := 0; i < bj.GetElemCount(); i++ {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = appendJsonString(buf, bj.GetObjectKey(i))
+			buf = append(buf, ": "...)
+			buf = bj.GetObjectVal(i).appendText(buf)
+		}
+		return append(buf, '}')
+	case TpCodeArray:
+		buf = append(buf, '[')
+		for i := 0; i < bj.GetElemCount(); i++ {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = bj.GetArrayElem(i).appendText(buf)
+		}
+		return append(buf, ']')
+	case TpCodeLiteral:
+		switch bj.Data[0] {
+		case LiteralTrue:
+			return append(buf, "true"...)
+		case LiteralFalse:
+			return append(buf, "false"...)
+		}
+		return append(buf, "null"...)
+	case TpCodeInt64:
+		return strconv.AppendInt(buf, bj.GetInt64(), 10)
+	case TpCodeUint64:
+		return strconv.AppendUint(buf, bj.GetUint64(), 10)
+	case TpCodeFloat64:
+		return appendJsonFloat(buf, bj.GetFloat64())
+	case TpCodeString:
+		return appendJsonString(buf, bj.GetString())
+	}
+	return buf
+}
+
+func appendJsonFloat(buf []byte, f float64) []byte {
+	abs := math.Abs(f)
+	if abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		// 1e+21 and 1e-07 are written as 1e21 and 1e-7
+		s := strconv.FormatFloat(f, 'e', -1, 64)
+		i := strings.IndexByte(s, 'e')
+		exp, _ := strconv.Atoi(s[i+1:])
+		return strconv.AppendInt(append(buf, s[:i+1]...), int64(exp), 10)
+	}
+	start := len(buf)
+	buf = strconv.AppendFloat(buf, f, 'f', -1, 64)
+	// keep a float looking like a float
+	if bytes.IndexByte(buf[start:], '.') < 0 {
+		buf = append(buf, ".0"...)
+	}
+	return buf
+}
+
+func appendJsonString(buf []byte, s []byte) []byte {
+	const hex = "0123456789abcdef"
+	buf = append(buf, '"')
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c >= utf8.RuneSelf {
+			_, n := utf8.DecodeRune(s[i:])
+			buf = append(buf, s[i:i+n]...)
+			i += n
+			continue
+		}
+		switch c {
+		case '"', '\\':
+			buf = append(buf, '\\', c)
+		case '\b':
+			buf = append(buf, '\\', 'b')
+		case '\f':
+			buf = append(buf, '\\', 'f')
+		case '\n':
+			buf = append(buf, '\\', 'n')
+		case '\r':
+			buf = append(buf, '\\', 'r')
+		case '\t':
+			buf = append(buf, '\\', 't')
+		default:
+			if c < 0x20 {
+				buf = append(buf, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			} else {
+				buf = append(buf, c)
+			}
+		}
+		i++
+	}
+	return append(buf, '"')
+}
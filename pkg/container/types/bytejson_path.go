@@ -0,0 +1,236 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type pathLegType byte
+
+const (
+	pathLegKey pathLegType = iota
+	pathLegIndex
+	pathLegDoubleWildcard
+)
+
+const (
+	// pathIndexWildcard is the index of [*]
+	pathIndexWildcard = -1
+	// pathIndexLast is the index of [last]
+	pathIndexLast = -2
+)
+
+type pathLeg struct {
+	typ      pathLegType
+	key      string
+	wildcard bool
+	index    int
+}
+
+// JsonPath is a parsed json path expression such as $.a[0].*
+type JsonPath struct {
+	legs []pathLeg
+}
+
+// ParseJsonPath parses a path expression made of `$` followed by legs of
+// `.key`, `."key"`, `.*`, `[n]`, `[last]`, `[*]` and `**`.
+func ParseJsonPath(s string) (*JsonPath, error) {
+	s = strings.TrimSpace(s)
+	if len(s) == 0 || s[0] != '$' {
+		return nil, ErrInvalidJsonPath
+	}
+	p := &JsonPath{}
+	for i := 1; i < len(s); {
+		switch {
+		case s[i] == ' ' || s[i] == '\t':
+			i++
+		case s[i] == '.':
+			i++
+			for i < len(s) && (s[i] == ' ' || s[i] == '\t') {
+				i++
+			}
+			if i == len(s) {
+				return nil, ErrInvalidJsonPath
+			}
+			switch {
+			case s[i] == '*':
+				p.legs = append(p.legs, pathLeg{typ: pathLegKey, wildcard: true})
+				i++
+			case s[i] == '"':
+				j := i + 1
+				for ; j < len(s) && s[j] != '"'; j++ {
+					if s[j] == '\\' {
+						j++
+					}
+				}
+				if j >= len(s) {
+					return nil, ErrInvalidJsonPath
+				}
+				var key string
+				if err := json.Unmarshal([]byte(s[i:j+1]), &key); err != nil {
+					return nil, ErrInvalidJsonPath
+				}
+				p.legs = append(p.legs, pathLeg{typ: pathLegKey, key: key})
+				i = j + 1
+			default:
+				j := i
+				for j < len(s) && isPathKeyChar(s, j) {
+					j++
+				}
+				if j == i || unicode.IsDigit(rune(s[i])) {
+					return nil, ErrInvalidJsonPath
+				}
+				p.legs = append(p.legs, pathLeg{typ: pathLegKey, key: s[i:j]})
+				i = j
+			}
+		case s[i] == '[':
+			j := strings.IndexByte(s[i:], ']')
+			if j < 0 {
+				return nil, ErrInvalidJsonPath
+			}
+			leg := pathLeg{typ: pathLegIndex}
+			switch idx := strings.TrimSpace(s[i+1 : i+j]); idx {
+			case "*":
+				leg.index = pathIndexWildcard
+				leg.wildcard = true
+			case "last":
+				leg.index = pathIndexLast
+			default:
+				n, err := strconv.ParseUint(idx, 10, 31)
+				if err != nil {
+					return nil, ErrInvalidJsonPath
+				}
+				leg.index = int(n)
+			}
+			p.legs = append(p.legs, leg)
+			i += j + 1
+		case strings.HasPrefix(s[i:], "**"):
+			p.legs = append(p.legs, pathLeg{typ: pathLegDoubleWildcard, wildcard: true})
+			i += 2
+		default:
+			return nil, ErrInvalidJsonPath
+		}
+	}
+	// a path can not end with **
+	if n := len(p.legs); n > 0 && p.legs[n-1].typ == pathLegDoubleWildcard {
+		return nil, ErrInvalidJsonPath
+	}
+	return p, nil
+}
+
+func isPathKeyChar(s string, i int) bool {
+	c := s[i]
+	return c == '_' || c == '$' || c >= 0x80 ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// HasWildcard reports whether the path may match more than one value.
+func (p *JsonPath) HasWildcard() bool {
+	for _, leg := range p.legs {
+		if leg.wildcard {
+			return true
+		}
+	}
+	return false
+}
+
+// Query returns the values of bj matched by the path in document order.
+func (bj ByteJson) Query(p *JsonPath) []ByteJson {
+	return bj.query(p.legs, nil)
+}
+
+func (bj ByteJson) query(legs []pathLeg, result []ByteJson) []ByteJson {
+	if len(legs) == 0 {
+		return append(result, bj)
+	}
+	leg, rest := legs[0], legs[1:]
+	switch leg.typ {
+	case pathLegKey:
+		if bj.Type != TpCodeObject {
+			return result
+		}
+		if leg.wildcard {
+			for i := 0; i < bj.GetElemCount(); i++ {
+				result = bj.GetObjectVal(i).query(rest, result)
+			}
+			return result
+		}
+		if v, ok := bj.GetKey([]byte(leg.key)); ok {
+			result = v.query(rest, result)
+		}
+	case pathLegIndex:
+		// a scalar or an object works as an array of one element
+		if bj.Type != TpCodeArray {
+			if leg.index == 0 || leg.index == pathIndexLast {
+				result = bj.query(rest, result)
+			}
+			return result
+		}
+		n := bj.GetElemCount()
+		switch leg.index {
+		case pathIndexWildcard:
+			for i := 0; i < n; i++ {
+				result = bj.GetArrayElem(i).query(rest, result)
+			}
+		case pathIndexLast:
+			if n > 0 {
+				result = bj.GetArrayElem(n-1).query(rest, result)
+			}
+		default:
+			if leg.index < n {
+				result = bj.GetArrayElem(leg.index).query(rest, result)
+			}
+		}
+	case pathLegDoubleWildcard:
+		result = bj.query(rest, result)
+		switch bj.Type {
+		case TpCodeObject:
+			for i := 0; i < bj.GetElemCount(); i++ {
+				result = bj.GetObjectVal(i).query(legs, result)
+			}
+		case TpCodeArray:
+			for i := 0; i < bj.GetElemCount(); i++ {
+				result = bj.GetArrayElem(i).query(legs, result)
+			}
+		}
+	}
+	return result
+}
+
+// Extract returns the values matched by the paths as JSON_EXTRACT does, a single
+// match of a single path without wildcards is returned as is and other matches
+// are wrapped into an array. It returns false if nothing matches.
+func (bj ByteJson) Extract(paths []*JsonPath) (ByteJson, bool) {
+	var result []ByteJson
+	for _, p := range paths {
+		result = bj.query(p.legs, result)
+	}
+	if len(result) == 0 {
+		return ByteJson{}, false
+	}
+	if len(paths) == 1 && !paths[0].HasWildcard() {
+		return result[0], true
+	}
+	elems := make([]interface{}, len(result))
+	for i := range result {
+		elems[i] = result[i]
+	}
+	_, data, _ := encodeArray(elems)
+	return ByteJson{Type: TpCodeArray, Data: data}, true
+}
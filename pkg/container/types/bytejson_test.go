@@ -0,0 +1,136 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseByteJson(t *testing.T) {
+	kases := []struct {
+		text string
+		want string
+	}{
+		{`null`, `null`},
+		{` true `, `true`},
+		{`-12`, `-12`},
+		{`18446744073709551615`, `18446744073709551615`},
+		{`1.50`, `1.5`},
+		{`100.0`, `100.0`},
+		{`1e21`, `1e21`},
+		{`1e-7`, `1e-7`},
+		{`"a\"b\né"`, `"a\"b\né"`},
+		{`[1, "x", [], {}]`, `[1, "x", [], {}]`},
+		{`{"bb": 1, "a": {"c": [true, false]}, "ab": null}`, `{"a": {"c": [true, false]}, "ab": null, "bb": 1}`},
+		{`{"a": 1, "a": 2}`, `{"a": 2}`},
+	}
+	for _, k := range kases {
+		bj, err := ParseByteJson([]byte(k.text))
+		require.NoError(t, err, k.text)
+		require.Equal(t, k.want, bj.String(), k.text)
+		require.Equal(t, k.want, DecodeByteJson(bj.Encode()).String(), k.text)
+	}
+
+	for _, text := range []string{``, `{`, `[1,]`, `{"a" 1}`, `1 2`, `abc`} {
+		_, err := ParseByteJson([]byte(text))
+		require.Error(t, err, text)
+	}
+}
+
+func TestByteJsonExtract(t *testing.T) {
+	bj, err := ParseByteJson([]byte(`{"a": [1, 2, {"b": 3}], "c": {"d": "x", "b": 4}, "e f": 5}`))
+	require.NoError(t, err)
+	kases := []struct {
+		paths []string
+		want  string
+	}{
+		{[]string{`$`}, bj.String()},
+		{[]string{`$.a[1]`}, `2`},
+		{[]string{`$.a[last].b`}, `3`},
+		{[]string{`$.c.d`}, `"x"`},
+		{[]string{`$."e f"`}, `5`},
+		{[]string{`$.c.d[0]`}, `"x"`},
+		{[]string{`$.a[*]`}, `[1, 2, {"b": 3}]`},
+		{[]string{`$.c.*`}, `[4, "x"]`},
+		{[]string{`$**.b`}, `[3, 4]`},
+		{[]string{`$.a[0]`, `$.c.b`}, `[1, 4]`},
+		{[]string{`$.x`}, ``},
+		{[]string{`$.a[3]`}, ``},
+	}
+	for _, k := range kases {
+		paths := make([]*JsonPath, len(k.paths))
+		for i, s := range k.paths {
+			paths[i], err = ParseJsonPath(s)
+			require.NoError(t, err, s)
+		}
+		res, ok := bj.Extract(paths)
+		if k.want == "" {
+			require.False(t, ok, k.paths)
+			continue
+		}
+		require.True(t, ok, k.paths)
+		require.Equal(t, k.want, res.String(), k.paths)
+	}
+
+	for _, s := range []string{``, `a`, `$.`, `$[`, `$[-1]`, `$.1a`, `$**`, `$.a b`} {
+		_, err := ParseJsonPath(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestByteJsonContains(t *testing.T) {
+	kases := []struct {
+		target, candidate string
+		want              bool
+	}{
+		{`1`, `1.0`, true},
+		{`"a"`, `"a"`, true},
+		{`"a"`, `["a"]`, false},
+		{`[1, [2, 3]]`, `3`, true},
+		{`[1, 2, 3]`, `[3, 1]`, true},
+		{`[1, 2, 3]`, `[3, 4]`, false},
+		{`{"a": 1, "b": [1, 2]}`, `{"b": 2}`, true},
+		{`{"a": 1, "b": [1, 2]}`, `{"c": 2}`, false},
+		{`{"a": 1}`, `1`, false},
+	}
+	for _, k := range kases {
+		target, err := ParseByteJson([]byte(k.target))
+		require.NoError(t, err)
+		candidate, err := ParseByteJson([]byte(k.candidate))
+		require.NoError(t, err)
+		require.Equal(t, k.want, target.Contains(candidate), k.target+" "+k.candidate)
+	}
+}
+
+func TestByteJsonKeysAndLen(t *testing.T) {
+	bj, err := ParseByteJson([]byte(`{"b": 1, "a": [1, 2], "aa": {}}`))
+	require.NoError(t, err)
+	require.Equal(t, 3, bj.Len())
+	require.Equal(t, `["a", "b", "aa"]`, bj.Keys().String())
+
+	v, ok := bj.GetKey([]byte("a"))
+	require.True(t, ok)
+	require.Equal(t, 2, v.Len())
+	v, _ = v.Extract([]*JsonPath{{legs: []pathLeg{{typ: pathLegIndex}}}})
+	require.Equal(t, 1, v.Len())
+
+	s, err := CreateByteJson("a\tb")
+	require.NoError(t, err)
+	require.Equal(t, `"a\tb"`, s.String())
+	require.Equal(t, "a\tb", s.Unquote())
+	require.Equal(t, `[1, 2]`, bj.GetObjectVal(0).Unquote())
+}
@@ -0,0 +1,152 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+const (
+	// MaxEnumMembers is the max number of members of an ENUM
+	MaxEnumMembers = 65535
+	// MaxSetMembers is the max number of members of a SET
+	MaxSetMembers = 64
+	// MaxBitWidth is the max number of bits of a BIT
+	MaxBitWidth = 64
+
+	// enumSeparator separates the members of ENUM and SET in the column
+	// definition, so a member can not contain it
+	enumSeparator = ","
+)
+
+// JoinEnumMembers returns the members of ENUM or SET as they are kept in the
+// column definition. Trailing spaces of members are removed as mysql does.
+func JoinEnumMembers(oid T, members []string) (string, error) {
+	limit := MaxEnumMembers
+	if oid == T_set {
+		limit = MaxSetMembers
+	}
+	if len(members) == 0 || len(members) > limit {
+		return "", errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("%s column must have 1 to %d members", oid, limit))
+	}
+	trimmed := make([]string, len(members))
+	for i, m := range members {
+		m = strings.TrimRight(m, " ")
+		if strings.Contains(m, enumSeparator) {
+			return "", errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Illegal %s column member '%s' containing ','", oid, m))
+		}
+		for _, prev := range trimmed[:i] {
+			if strings.EqualFold(prev, m) {
+				return "", errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Column has duplicated value '%s' in %s", m, oid))
+			}
+		}
+		trimmed[i] = m
+	}
+	return strings.Join(trimmed, enumSeparator), nil
+}
+
+// SplitEnumMembers returns the members of ENUM or SET kept in the column definition
+func SplitEnumMembers(s string) []string {
+	return strings.Split(s, enumSeparator)
+}
+
+func findEnumMember(members []string, s string) int {
+	s = strings.TrimRight(s, " ")
+	for i, m := range members {
+		if strings.EqualFold(m, s) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ParseEnum returns the ordinal of s in members which starts from 1. A number
+// which is not a member is taken as the ordinal.
+func ParseEnum(members []string, s string) (uint16, error) {
+	if i := findEnumMember(members, s); i >= 0 {
+		return uint16(i + 1), nil
+	}
+	if n, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64); err == nil {
+		return ParseEnumIndex(members, n)
+	}
+	return 0, errors.New(errno.DataException, fmt.Sprintf("Data truncated for ENUM value '%s'", s))
+}
+
+// ParseEnumIndex checks the ordinal of an ENUM member
+func ParseEnumIndex(members []string, n uint64) (uint16, error) {
+	if n == 0 || n > uint64(len(members)) {
+		return 0, errors.New(errno.DataException, fmt.Sprintf("Data truncated for ENUM value %d", n))
+	}
+	return uint16(n), nil
+}
+
+// EnumString returns the member of the ordinal, the ordinal 0 is the empty string
+func EnumString(members []string, v uint16) string {
+	if v == 0 || int(v) > len(members) {
+		return ""
+	}
+	return members[v-1]
+}
+
+// ParseSet returns the bitmap of the comma separated members in s, the first
+// member is the lowest bit. A number which is not a member list is taken as the
+// bitmap.
+func ParseSet(members []string, s string) (uint64, error) {
+	var v uint64
+	if len(s) == 0 {
+		return v, nil
+	}
+	for _, e := range strings.Split(s, enumSeparator) {
+		i := findEnumMember(members, e)
+		if i < 0 {
+			if n, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64); err == nil {
+				return ParseSetIndex(members, n)
+			}
+			return 0, errors.New(errno.DataException, fmt.Sprintf("Data truncated for SET value '%s'", s))
+		}
+		v |= 1 << uint(i)
+	}
+	return v, nil
+}
+
+// ParseSetIndex checks the bitmap of SET members
+func ParseSetIndex(members []string, n uint64) (uint64, error) {
+	if len(members) < MaxSetMembers && n>>uint(len(members)) != 0 {
+		return 0, errors.New(errno.DataException, fmt.Sprintf("Data truncated for SET value %d", n))
+	}
+	return n, nil
+}
+
+// SetString returns the members in the bitmap in the order of definition
+func SetString(members []string, v uint64) string {
+	var buf strings.Builder
+	first := true
+	for i, m := range members {
+		if v&(1<<uint(i)) == 0 {
+			continue
+		}
+		if !first {
+			buf.WriteString(enumSeparator)
+		}
+		buf.WriteString(m)
+		first = false
+	}
+	return buf.String()
+}
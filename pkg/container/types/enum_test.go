@@ -0,0 +1,100 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJoinEnumMembers(t *testing.T) {
+	s, err := JoinEnumMembers(T_enum, []string{"a ", "B", ""})
+	require.NoError(t, err)
+	require.Equal(t, "a,B,", s)
+	require.Equal(t, []string{"a", "B", ""}, SplitEnumMembers(s))
+
+	_, err = JoinEnumMembers(T_enum, []string{"a", "A"})
+	require.Error(t, err)
+	_, err = JoinEnumMembers(T_set, []string{"a,b"})
+	require.Error(t, err)
+	_, err = JoinEnumMembers(T_set, make([]string, MaxSetMembers+1))
+	require.Error(t, err)
+}
+
+func TestParseEnum(t *testing.T) {
+	members := []string{"small", "medium", "large"}
+	kases := []struct {
+		s    string
+		want uint16
+	}{
+		{"small", 1},
+		{"LARGE", 3},
+		{"medium  ", 2},
+		{"2", 2},
+	}
+	for _, k := range kases {
+		v, err := ParseEnum(members, k.s)
+		require.NoError(t, err, k.s)
+		require.Equal(t, k.want, v, k.s)
+	}
+	for _, s := range []string{"", "huge", "0", "4"} {
+		_, err := ParseEnum(members, s)
+		require.Error(t, err, s)
+	}
+	require.Equal(t, "medium", EnumString(members, 2))
+	require.Equal(t, "", EnumString(members, 0))
+}
+
+func TestParseSet(t *testing.T) {
+	members := []string{"a", "b", "c"}
+	kases := []struct {
+		s    string
+		want uint64
+	}{
+		{"", 0},
+		{"a", 1},
+		{"c,A", 5},
+		{"b,b", 2},
+		{"7", 7},
+	}
+	for _, k := range kases {
+		v, err := ParseSet(members, k.s)
+		require.NoError(t, err, k.s)
+		require.Equal(t, k.want, v, k.s)
+	}
+	for _, s := range []string{"d", "a,d", "8"} {
+		_, err := ParseSet(members, s)
+		require.Error(t, err, s)
+	}
+	require.Equal(t, "a,c", SetString(members, 5))
+	require.Equal(t, "", SetString(members, 0))
+	require.Equal(t, ",b", SetString([]string{"", "b"}, 3))
+}
+
+func TestBit(t *testing.T) {
+	require.NoError(t, CheckBit(7, 3))
+	require.Error(t, CheckBit(8, 3))
+	require.NoError(t, CheckBit(1<<63, 64))
+
+	v, err := ParseBitBytes([]byte("ab"), 16)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0x6162), v)
+	_, err = ParseBitBytes([]byte("ab"), 8)
+	require.Error(t, err)
+
+	require.Equal(t, []byte{0x61, 0x62}, BitBytes(0x6162, 10))
+	require.Equal(t, []byte{0x1}, BitBytes(1, 1))
+}
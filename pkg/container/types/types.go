@@ -31,6 +31,9 @@ const (
 	// bool family
 	T_bool T = T(plan.Type_BOOL)
 
+	// bit family, stored as uint64 and the number of bits is kept in Width
+	T_bit T = T(plan.Type_BIT)
+
 	// numeric/integer family
 	T_int8   T = T(plan.Type_INT8)
 	T_int16  T = T(plan.Type_INT16)
@@ -55,10 +58,25 @@ const (
 	// string family
 	T_char    T = T(plan.Type_CHAR)
 	T_varchar T = T(plan.Type_VARCHAR)
+	T_text    T = T(plan.Type_TEXT)
+
+	// binary family, compared byte by byte
+	T_binary    T = T(plan.Type_BINARY)
+	T_varbinary T = T(plan.Type_VARBINARY)
+	T_blob      T = T(plan.Type_BLOB)
 
 	// json family
 	T_json T = T(plan.Type_JSON)
 
+	// enum family, ENUM is stored as the uint16 ordinal of the member and SET
+	// as an uint64 bitmap of the members, the members are kept in the column
+	// definition
+	T_enum T = T(plan.Type_ENUM)
+	T_set  T = T(plan.Type_SET)
+
+	// uuid family, 16 bytes compared byte by byte
+	T_uuid T = T(plan.Type_UUID)
+
 	// numeric/decimal family - unsigned attribute is deprecated
 	T_decimal64  = T(plan.Type_DECIMAL64)
 	T_decimal128 = T(plan.Type_DECIMAL128)
@@ -94,6 +112,8 @@ type Timestamp int64
 type Decimal64 [8]byte
 type Decimal128 [16]byte
 
+type Uuid [16]byte
+
 type Ints interface {
 	int8 | int16 | int32 | int64
 }
@@ -151,7 +171,25 @@ var Types map[string]T = map[string]T{
 	"char":    T_char,
 	"varchar": T_varchar,
 
+	"tinytext":   T_text,
+	"text":       T_text,
+	"mediumtext": T_text,
+	"longtext":   T_text,
+
+	"binary":     T_binary,
+	"varbinary":  T_varbinary,
+	"tinyblob":   T_blob,
+	"blob":       T_blob,
+	"mediumblob": T_blob,
+	"longblob":   T_blob,
+
 	"json": T_json,
+
+	"bit":  T_bit,
+	"enum": T_enum,
+	"set":  T_set,
+
+	"uuid": T_uuid,
 }
 
 func New(oid T, width, scale, precision int32) Type {
@@ -177,7 +215,29 @@ func (t Type) IsBoolean() bool {
 }
 
 func (t Type) IsString() bool {
-	return t.Oid == T_char || t.Oid == T_varchar
+	return t.Oid.IsString()
+}
+
+// IsString returns true for all the types stored as variable length bytes,
+// including the binary ones.
+func (t T) IsString() bool {
+	switch t {
+	case T_char, T_varchar, T_text, T_binary, T_varbinary, T_blob:
+		return true
+	}
+	return false
+}
+
+// IsBinary returns true if the type uses the binary collation, which means
+// values are compared byte by byte and returned with the binary charset.
+func (t T) IsBinary() bool {
+	return t == T_binary || t == T_varbinary || t == T_blob
+}
+
+// IsLob returns true for large object types. Values of these types can be
+// much larger than a varchar and need smaller blocks in storage.
+func (t T) IsLob() bool {
+	return t == T_text || t == T_blob
 }
 
 func (t Type) String() string {
@@ -205,11 +265,11 @@ func (t T) ToType() Type {
 		typ.Size = 8
 	case T_uint8:
 		typ.Size = 1
-	case T_uint16:
+	case T_uint16, T_enum:
 		typ.Size = 2
 	case T_uint32:
 		typ.Size = 4
-	case T_uint64:
+	case T_uint64, T_set, T_bit:
 		typ.Size = 8
 	case T_float32:
 		typ.Size = 4
@@ -217,13 +277,13 @@ func (t T) ToType() Type {
 		typ.Size = 8
 	case T_char:
 		typ.Size = 24
-	case T_varchar:
+	case T_varchar, T_text, T_binary, T_varbinary, T_blob, T_json:
 		typ.Size = 24
 	case T_sel:
 		typ.Size = 8
 	case T_decimal64:
 		typ.Size = 8
-	case T_decimal128:
+	case T_decimal128, T_uuid:
 		typ.Size = 16
 	}
 	return typ
@@ -267,6 +327,20 @@ func (t T) String() string {
 		return "VARCHAR"
 	case T_json:
 		return "JSON"
+	case T_text:
+		return "TEXT"
+	case T_binary:
+		return "BINARY"
+	case T_varbinary:
+		return "VARBINARY"
+	case T_blob:
+		return "BLOB"
+	case T_bit:
+		return "BIT"
+	case T_enum:
+		return "ENUM"
+	case T_set:
+		return "SET"
 	case T_sel:
 		return "SEL"
 	case T_tuple:
@@ -275,6 +349,8 @@ func (t T) String() string {
 		return "DECIMAL64"
 	case T_decimal128:
 		return "DECIMAL128"
+	case T_uuid:
+		return "UUID"
 	}
 	return fmt.Sprintf("unexpected type: %d", t)
 }
@@ -312,6 +388,22 @@ func (t T) OidString() string {
 		return "T_char"
 	case T_varchar:
 		return "T_varchar"
+	case T_text:
+		return "T_text"
+	case T_binary:
+		return "T_binary"
+	case T_varbinary:
+		return "T_varbinary"
+	case T_blob:
+		return "T_blob"
+	case T_json:
+		return "T_json"
+	case T_bit:
+		return "T_bit"
+	case T_enum:
+		return "T_enum"
+	case T_set:
+		return "T_set"
 	case T_date:
 		return "T_date"
 	case T_datetime:
@@ -322,6 +414,8 @@ func (t T) OidString() string {
 		return "T_decimal64"
 	case T_decimal128:
 		return "T_decimal128"
+	case T_uuid:
+		return "T_uuid"
 	}
 	return "unknown_type"
 }
@@ -345,17 +439,17 @@ func (t T) GoType() string {
 		return "float32"
 	case T_uint8:
 		return "uint8"
-	case T_uint16:
+	case T_uint16, T_enum:
 		return "uint16"
 	case T_uint32:
 		return "uint32"
-	case T_uint64:
+	case T_uint64, T_set, T_bit:
 		return "uint64"
 	case T_sel:
 		return "int64"
 	case T_char:
 		return "string"
-	case T_varchar:
+	case T_varchar, T_text, T_binary, T_varbinary, T_blob, T_json:
 		return "string"
 	case T_date:
 		return "date"
@@ -367,13 +461,15 @@ func (t T) GoType() string {
 		return "decimal64"
 	case T_decimal128:
 		return "decimal128"
+	case T_uuid:
+		return "uuid"
 	}
 	return "unknown type"
 }
 
 // GoGoType returns special go type string for T
 func (t T) GoGoType() string {
-	if t == T_char || t == T_varchar {
+	if t.IsString() {
 		return "Str"
 	}
 	k := t.GoType()
@@ -393,11 +489,11 @@ func (t T) TypeLen() int {
 		return 8
 	case T_uint8:
 		return 1
-	case T_uint16:
+	case T_uint16, T_enum:
 		return 2
 	case T_uint32:
 		return 4
-	case T_uint64:
+	case T_uint64, T_set, T_bit:
 		return 8
 	case T_float32:
 		return 4
@@ -405,13 +501,13 @@ func (t T) TypeLen() int {
 		return 8
 	case T_char:
 		return 24
-	case T_varchar:
+	case T_varchar, T_text, T_binary, T_varbinary, T_blob, T_json:
 		return 24
 	case T_sel:
 		return 8
 	case T_decimal64:
 		return 8
-	case T_decimal128:
+	case T_decimal128, T_uuid:
 		return 16
 	}
 	panic(moerr.NewInternalError("Unknow type %s", t))
@@ -422,19 +518,19 @@ func (t T) FixedLength() int {
 	switch t {
 	case T_int8, T_uint8, T_bool:
 		return 1
-	case T_int16, T_uint16:
+	case T_int16, T_uint16, T_enum:
 		return 2
 	case T_int32, T_uint32, T_date, T_float32:
 		return 4
-	case T_int64, T_uint64, T_datetime, T_float64, T_timestamp:
+	case T_int64, T_uint64, T_set, T_bit, T_datetime, T_float64, T_timestamp:
 		return 8
 	case T_decimal64:
 		return -8
-	case T_decimal128:
+	case T_decimal128, T_uuid:
 		return -16
 	case T_char:
 		return -24
-	case T_varchar:
+	case T_varchar, T_text, T_binary, T_varbinary, T_blob, T_json:
 		return -24
 	case T_sel:
 		return 8
@@ -51,6 +51,20 @@ func TestT_String(t *testing.T) {
 	require.Equal(t, "INT", T_int32.String())
 }
 
+func TestT_IsString(t *testing.T) {
+	for _, typ := range []T{T_char, T_varchar, T_text, T_binary, T_varbinary, T_blob} {
+		require.True(t, typ.IsString())
+		require.Equal(t, 24, typ.TypeLen())
+	}
+	require.False(t, T_int32.IsString())
+	require.True(t, T_blob.IsBinary())
+	require.False(t, T_text.IsBinary())
+	require.True(t, T_text.IsLob())
+	require.False(t, T_varbinary.IsLob())
+	require.Equal(t, T_text, Types["mediumtext"])
+	require.Equal(t, T_blob, Types["longblob"])
+}
+
 func TestT_OidString(t *testing.T) {
 	require.Equal(t, "T_int8", T_int8.OidString())
 	require.Equal(t, "T_int16", T_int16.OidString())
@@ -0,0 +1,110 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// UuidLen is the length of a uuid in bytes
+const UuidLen = 16
+
+// NewUuid returns a random (version 4) uuid
+func NewUuid() (Uuid, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return Uuid{}, err
+	}
+	return Uuid(id), nil
+}
+
+// ParseUuid parses a uuid from its text form, both the 36 characters form
+// with dashes and the 32 hex digits form are accepted, with or without braces.
+func ParseUuid(s string) (Uuid, error) {
+	id, err := uuid.Parse(s)
+	if err != nil || (len(s) != 32 && len(s) != 36 && len(s) != 38) {
+		return Uuid{}, errors.New(errno.DataException, fmt.Sprintf("Incorrect string value: '%s' for uuid", s))
+	}
+	return Uuid(id), nil
+}
+
+// UuidFromBytes converts the 16 bytes form of a uuid, if swap is true the
+// time-low and time-high parts are swapped back as mysql BIN_TO_UUID does.
+func UuidFromBytes(b []byte, swap bool) (Uuid, error) {
+	var u Uuid
+	if len(b) != UuidLen {
+		return u, errors.New(errno.DataException, fmt.Sprintf("Incorrect string value: '%s' for uuid", hex.EncodeToString(b)))
+	}
+	if !swap {
+		copy(u[:], b)
+		return u, nil
+	}
+	copy(u[0:4], b[4:8])
+	copy(u[4:6], b[2:4])
+	copy(u[6:8], b[0:2])
+	copy(u[8:], b[8:])
+	return u, nil
+}
+
+// Bytes returns the 16 bytes form of the uuid, if swap is true the time-low
+// and time-high parts are swapped so that time based uuids are ordered by
+// time, which is what mysql UUID_TO_BIN does.
+func (u Uuid) Bytes(swap bool) []byte {
+	b := make([]byte, UuidLen)
+	if !swap {
+		copy(b, u[:])
+		return b
+	}
+	copy(b[0:2], u[6:8])
+	copy(b[2:4], u[4:6])
+	copy(b[4:8], u[0:4])
+	copy(b[8:], u[8:])
+	return b
+}
+
+// InitUuid returns a uuid made of the big endian v, uuids made this way are
+// ordered as v is.
+func InitUuid(v uint64) Uuid {
+	var u Uuid
+	binary.BigEndian.PutUint64(u[8:], v)
+	return u
+}
+
+func (u Uuid) String() string {
+	return uuid.UUID(u).String()
+}
+
+func (u Uuid) Compare(other Uuid) int {
+	return bytes.Compare(u[:], other[:])
+}
+
+func (u Uuid) Lt(other Uuid) bool {
+	return u.Compare(other) < 0
+}
+
+func (u Uuid) Eq(other Uuid) bool {
+	return u == other
+}
+
+func CompareUuid(a, b Uuid) int {
+	return a.Compare(b)
+}
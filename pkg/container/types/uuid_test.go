@@ -0,0 +1,58 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUuid(t *testing.T) {
+	want := "6ccd780c-baba-1026-9564-5b8c656024db"
+	for _, s := range []string{want, "6CCD780CBABA102695645B8C656024DB", "{6ccd780c-baba-1026-9564-5b8c656024db}"} {
+		u, err := ParseUuid(s)
+		require.NoError(t, err, s)
+		require.Equal(t, want, u.String(), s)
+	}
+	for _, s := range []string{"", "6ccd780c", "urn:uuid:6ccd780c-baba-1026-9564-5b8c656024db", "6ccd780c-baba-1026-9564-5b8c656024dx"} {
+		_, err := ParseUuid(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestUuidBytes(t *testing.T) {
+	u, err := ParseUuid("6ccd780c-baba-1026-9564-5b8c656024db")
+	require.NoError(t, err)
+	for _, swap := range []bool{false, true} {
+		v, err := UuidFromBytes(u.Bytes(swap), swap)
+		require.NoError(t, err)
+		require.Equal(t, u, v)
+	}
+	require.Equal(t, []byte{0x10, 0x26, 0xba, 0xba, 0x6c, 0xcd, 0x78, 0x0c}, u.Bytes(true)[:8])
+	_, err = UuidFromBytes([]byte{1, 2, 3}, false)
+	require.Error(t, err)
+}
+
+func TestCompareUuid(t *testing.T) {
+	a, b := InitUuid(1), InitUuid(256)
+	require.True(t, a.Lt(b))
+	require.Equal(t, -1, CompareUuid(a, b))
+	require.Equal(t, 1, CompareUuid(b, a))
+	require.True(t, a.Eq(InitUuid(1)))
+	u, err := NewUuid()
+	require.NoError(t, err)
+	require.Equal(t, byte(4), u[6]>>4)
+}
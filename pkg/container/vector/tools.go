@@ -15,13 +15,16 @@
 package vector
 
 import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"golang.org/x/exp/constraints"
 )
 
 type ref interface {
 	constraints.Integer | constraints.Float | bool |
-		types.Date | types.Datetime | types.Timestamp | types.Decimal64 | types.Decimal128
+		types.Date | types.Datetime | types.Timestamp | types.Decimal64 | types.Decimal128 | types.Uuid
 }
 
 func MustTCols[T ref](v *Vector) []T {
@@ -43,3 +46,107 @@ func MustBytesCols(v *Vector) *types.Bytes {
 	}
 	panic("unexpected parameter types were received")
 }
+
+// GetValue returns the value of the row in the vector, nil for null
+func GetValue(vec *Vector, row int) interface{} {
+	if nulls.Contains(vec.Nsp, uint64(row)) {
+		return nil
+	}
+	switch vec.Typ.Oid {
+	case types.T_bool:
+		return GetColumn[bool](vec)[row]
+	case types.T_int8:
+		return GetColumn[int8](vec)[row]
+	case types.T_int16:
+		return GetColumn[int16](vec)[row]
+	case types.T_int32:
+		return GetColumn[int32](vec)[row]
+	case types.T_int64:
+		return GetColumn[int64](vec)[row]
+	case types.T_uint8:
+		return GetColumn[uint8](vec)[row]
+	case types.T_uint16, types.T_enum:
+		return GetColumn[uint16](vec)[row]
+	case types.T_uint32:
+		return GetColumn[uint32](vec)[row]
+	case types.T_uint64, types.T_set, types.T_bit:
+		return GetColumn[uint64](vec)[row]
+	case types.T_float32:
+		return GetColumn[float32](vec)[row]
+	case types.T_float64:
+		return GetColumn[float64](vec)[row]
+	case types.T_char, types.T_varchar, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
+		return append([]byte{}, GetStrColumn(vec).Get(int64(row))...)
+	case types.T_date:
+		return GetColumn[types.Date](vec)[row]
+	case types.T_datetime:
+		return GetColumn[types.Datetime](vec)[row]
+	case types.T_timestamp:
+		return GetColumn[types.Timestamp](vec)[row]
+	case types.T_decimal64:
+		return GetColumn[types.Decimal64](vec)[row]
+	case types.T_decimal128:
+		return GetColumn[types.Decimal128](vec)[row]
+	case types.T_uuid:
+		return GetColumn[types.Uuid](vec)[row]
+	}
+	return nil
+}
+
+// AppendValues appends the values got by GetValue to the vector
+func AppendValues(vec *Vector, values []interface{}) error {
+	switch vec.Typ.Oid {
+	case types.T_bool:
+		return appendValues[bool](vec, values)
+	case types.T_int8:
+		return appendValues[int8](vec, values)
+	case types.T_int16:
+		return appendValues[int16](vec, values)
+	case types.T_int32:
+		return appendValues[int32](vec, values)
+	case types.T_int64:
+		return appendValues[int64](vec, values)
+	case types.T_uint8:
+		return appendValues[uint8](vec, values)
+	case types.T_uint16, types.T_enum:
+		return appendValues[uint16](vec, values)
+	case types.T_uint32:
+		return appendValues[uint32](vec, values)
+	case types.T_uint64, types.T_set, types.T_bit:
+		return appendValues[uint64](vec, values)
+	case types.T_float32:
+		return appendValues[float32](vec, values)
+	case types.T_float64:
+		return appendValues[float64](vec, values)
+	case types.T_char, types.T_varchar, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
+		return appendValues[[]byte](vec, values)
+	case types.T_date:
+		return appendValues[types.Date](vec, values)
+	case types.T_datetime:
+		return appendValues[types.Datetime](vec, values)
+	case types.T_timestamp:
+		return appendValues[types.Timestamp](vec, values)
+	case types.T_decimal64:
+		return appendValues[types.Decimal64](vec, values)
+	case types.T_decimal128:
+		return appendValues[types.Decimal128](vec, values)
+	case types.T_uuid:
+		return appendValues[types.Uuid](vec, values)
+	}
+	return fmt.Errorf("unexpect type %s for function vector.AppendValues", vec.Typ)
+}
+
+func appendValues[T any](vec *Vector, values []interface{}) error {
+	n := Length(vec)
+	vs := make([]T, len(values))
+	for i, v := range values {
+		if v == nil {
+			nulls.Add(vec.Nsp, uint64(n+i))
+			continue
+		}
+		vs[i] = v.(T)
+	}
+	return Append(vec, vs)
+}
@@ -112,11 +112,11 @@ func (v *Vector) FillDefaultValue() {
 		fillDefaultValue[int64](v)
 	case types.T_uint8:
 		fillDefaultValue[uint8](v)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		fillDefaultValue[uint16](v)
 	case types.T_uint32:
 		fillDefaultValue[uint32](v)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		fillDefaultValue[uint64](v)
 	case types.T_float32:
 		fillDefaultValue[uint64](v)
@@ -132,7 +132,10 @@ func (v *Vector) FillDefaultValue() {
 		fillDefaultValue[types.Decimal64](v)
 	case types.T_decimal128:
 		fillDefaultValue[types.Decimal128](v)
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_uuid:
+		fillDefaultValue[types.Uuid](v)
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		col := v.Col.(*types.Bytes)
 		rows := v.Nsp.Np.ToArray()
 		for _, row := range rows {
@@ -159,11 +162,11 @@ func (v *Vector) ToConst(row int) *Vector {
 		return toConstVector[int64](v, row)
 	case types.T_uint8:
 		return toConstVector[uint8](v, row)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return toConstVector[uint16](v, row)
 	case types.T_uint32:
 		return toConstVector[uint32](v, row)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return toConstVector[uint64](v, row)
 	case types.T_float32:
 		return toConstVector[float32](v, row)
@@ -179,7 +182,10 @@ func (v *Vector) ToConst(row int) *Vector {
 		return toConstVector[types.Decimal64](v, row)
 	case types.T_decimal128:
 		return toConstVector[types.Decimal128](v, row)
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_uuid:
+		return toConstVector[types.Uuid](v, row)
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		col := v.Col.(*types.Bytes)
 		src := col.Data[col.Offsets[row] : col.Offsets[row]+col.Lengths[row]]
 		data := make([]byte, len(src))
@@ -224,11 +230,11 @@ func (v *Vector) ConstExpand(m *mheap.Mheap) *Vector {
 		expandVector[int64](v, 8, m)
 	case types.T_uint8:
 		expandVector[uint8](v, 1, m)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		expandVector[uint16](v, 2, m)
 	case types.T_uint32:
 		expandVector[uint32](v, 4, m)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		expandVector[uint64](v, 8, m)
 	case types.T_float32:
 		expandVector[float32](v, 4, m)
@@ -244,7 +250,10 @@ func (v *Vector) ConstExpand(m *mheap.Mheap) *Vector {
 		expandVector[types.Decimal64](v, 8, m)
 	case types.T_decimal128:
 		expandVector[types.Decimal128](v, 16, m)
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_uuid:
+		expandVector[types.Uuid](v, 16, m)
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		col := v.Col.(*types.Bytes)
 		if nulls.Any(v.Nsp) {
 			col.Offsets = col.Offsets[:0]
@@ -384,7 +393,7 @@ func New(typ types.Type) *Vector {
 			Col: []uint8{},
 			Nsp: &nulls.Nulls{},
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		return &Vector{
 			Typ: typ,
 			Col: []uint16{},
@@ -396,7 +405,7 @@ func New(typ types.Type) *Vector {
 			Col: []uint32{},
 			Nsp: &nulls.Nulls{},
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		return &Vector{
 			Typ: typ,
 			Col: []uint64{},
@@ -444,7 +453,8 @@ func New(typ types.Type) *Vector {
 			Nsp: &nulls.Nulls{},
 			Col: [][]interface{}{},
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		return &Vector{
 			Typ: typ,
 			Col: &types.Bytes{},
@@ -462,6 +472,12 @@ func New(typ types.Type) *Vector {
 			Col: []types.Decimal128{},
 			Nsp: &nulls.Nulls{},
 		}
+	case types.T_uuid:
+		return &Vector{
+			Typ: typ,
+			Col: []types.Uuid{},
+			Nsp: &nulls.Nulls{},
+		}
 	default:
 		panic(fmt.Sprintf("unexpect type %s for function vector.New", typ))
 	}
@@ -498,11 +514,11 @@ func (v *Vector) initConst(typ types.Type) {
 		v.Col = []int64{0}
 	case types.T_uint8:
 		v.Col = []uint8{0}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		v.Col = []uint16{0}
 	case types.T_uint32:
 		v.Col = []uint32{0}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		v.Col = []uint64{0}
 	case types.T_float32:
 		v.Col = []float32{0}
@@ -518,7 +534,10 @@ func (v *Vector) initConst(typ types.Type) {
 		v.Col = make([]types.Decimal64, 1)
 	case types.T_decimal128:
 		v.Col = make([]types.Decimal128, 1)
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_uuid:
+		v.Col = make([]types.Uuid, 1)
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		v.Col = &types.Bytes{
 			Offsets: []uint32{0},
 			Lengths: []uint32{0},
@@ -574,11 +593,11 @@ func (v *Vector) Realloc(size int, m *mheap.Mheap) error {
 		v.Col = encoding.DecodeSlice[int64](v.Data[:len(data)], size)[:oldLen/size]
 	case types.T_uint8:
 		v.Col = encoding.DecodeSlice[uint8](v.Data[:len(data)], size)[:oldLen/size]
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		v.Col = encoding.DecodeSlice[uint16](v.Data[:len(data)], size)[:oldLen/size]
 	case types.T_uint32:
 		v.Col = encoding.DecodeSlice[uint32](v.Data[:len(data)], size)[:oldLen/size]
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		v.Col = encoding.DecodeSlice[uint64](v.Data[:len(data)], size)[:oldLen/size]
 	case types.T_date:
 		v.Col = encoding.DecodeSlice[types.Date](v.Data[:len(data)], size)[:oldLen/size]
@@ -590,6 +609,8 @@ func (v *Vector) Realloc(size int, m *mheap.Mheap) error {
 		v.Col = encoding.DecodeSlice[types.Decimal64](v.Data[:len(data)], size)[:oldLen/size]
 	case types.T_decimal128:
 		v.Col = encoding.DecodeSlice[types.Decimal128](v.Data[:len(data)], size)[:oldLen/size]
+	case types.T_uuid:
+		v.Col = encoding.DecodeSlice[types.Uuid](v.Data[:len(data)], size)[:oldLen/size]
 	}
 	return nil
 }
@@ -674,7 +695,7 @@ func (v *Vector) Append(w any, m *mheap.Mheap) error {
 		col = append(col, wv)
 		v.Col = col
 		v.Data = v.Data[:(n + 1)]
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		wv := w.(uint16)
 		col := v.Col.([]uint16)
 		n := len(col)
@@ -700,7 +721,7 @@ func (v *Vector) Append(w any, m *mheap.Mheap) error {
 		col = append(col, wv)
 		v.Col = col
 		v.Data = v.Data[:(n+1)*4]
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		wv := w.(uint64)
 		col := v.Col.([]uint64)
 		n := len(col)
@@ -804,7 +825,21 @@ func (v *Vector) Append(w any, m *mheap.Mheap) error {
 		col = append(col, wv)
 		v.Col = col
 		v.Data = v.Data[:(n+1)*16]
-	case types.T_char, types.T_varchar:
+	case types.T_uuid:
+		wv := w.(types.Uuid)
+		col := v.Col.([]types.Uuid)
+		n := len(col)
+		if n+1 >= cap(col) {
+			if err := v.Realloc(16, m); err != nil {
+				return err
+			}
+			col = v.Col.([]types.Uuid)
+		}
+		col = append(col, wv)
+		v.Col = col
+		v.Data = v.Data[:(n+1)*16]
+	case types.T_char, types.T_varchar, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		wv := w.([]byte)
 		n := len(v.Data)
 		if n+len(wv) >= cap(v.Data) {
@@ -824,7 +859,8 @@ func (v *Vector) Append(w any, m *mheap.Mheap) error {
 
 func Reset(v *Vector) {
 	switch v.Typ.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		v.Col.(*types.Bytes).Reset()
 	default:
 		*(*int)(unsafe.Pointer(uintptr((*(*emptyInterface)(unsafe.Pointer(&v.Col))).word) + uintptr(strconv.IntSize>>3))) = 0
@@ -855,7 +891,7 @@ func SetCol(v *Vector, col interface{}) {
 func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 	defer func() {
 		size := v.Typ.Oid.TypeLen()
-		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar {
+		if !v.Typ.IsString() {
 			v.Data = v.Data[:reflect.ValueOf(v.Col).Len()*size]
 		}
 	}()
@@ -896,7 +932,7 @@ func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 		}
 		v.Data = data
 		v.Col = encoding.DecodeUint8Slice(v.Data)[:0]
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		data, err := mheap.Alloc(m, int64(rows*2))
 		if err != nil {
 			return
@@ -910,7 +946,7 @@ func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 		}
 		v.Data = data
 		v.Col = encoding.DecodeUint32Slice(v.Data)[:0]
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		data, err := mheap.Alloc(m, int64(rows*8))
 		if err != nil {
 			return
@@ -952,7 +988,8 @@ func PreAlloc(v, w *Vector, rows int, m *mheap.Mheap) {
 		}
 		v.Data = data
 		v.Col = encoding.DecodeTimestampSlice(v.Data)[:0]
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		data, err := mheap.Alloc(m, int64(rows*len(ws.Data)/len(ws.Offsets)))
 		if err != nil {
@@ -970,7 +1007,8 @@ func Length(v *Vector) int {
 		return v.Length
 	}
 	switch v.Typ.Oid {
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		return len(v.Col.(*types.Bytes).Offsets)
 	default:
 		return reflect.ValueOf(v.Col).Len()
@@ -1015,13 +1053,13 @@ func SetVectorLength(v *Vector, n int) {
 	case types.T_uint8:
 		v.Data = v.Data[:n*1]
 		setLengthFixed[uint8](v, n)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		v.Data = v.Data[:n*2]
 		setLengthFixed[uint16](v, n)
 	case types.T_uint32:
 		v.Data = v.Data[:n*4]
 		setLengthFixed[uint32](v, n)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		v.Data = v.Data[:n*8]
 		setLengthFixed[uint64](v, n)
 	case types.T_float32:
@@ -1045,6 +1083,9 @@ func SetVectorLength(v *Vector, n int) {
 	case types.T_decimal128:
 		v.Data = v.Data[:n*16]
 		setLengthFixed[types.Decimal128](v, n)
+	case types.T_uuid:
+		v.Data = v.Data[:n*16]
+		setLengthFixed[types.Uuid](v, n)
 
 	case types.T_sel:
 		vs := v.Col.([]int64)
@@ -1056,7 +1097,8 @@ func SetVectorLength(v *Vector, n int) {
 		m := len(vs)
 		v.Col = vs[:n]
 		nulls.RemoveRange(v.Nsp, uint64(n), uint64(m))
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		vs := v.Col.(*types.Bytes)
 		m := len(vs.Offsets)
 		vs.Data = vs.Data[:vs.Offsets[n-1]+vs.Lengths[n-1]]
@@ -1071,7 +1113,7 @@ func SetVectorLength(v *Vector, n int) {
 func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 	defer func() {
 		size := v.Typ.Oid.TypeLen()
-		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar {
+		if !v.Typ.IsString() {
 			v.Data = v.Data[:reflect.ValueOf(v.Col).Len()*size]
 		}
 	}()
@@ -1172,7 +1214,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		vs := v.Col.([]uint16)
 		data, err := mheap.Alloc(m, int64(len(vs)*2))
 		if err != nil {
@@ -1204,7 +1246,7 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		vs := v.Col.([]uint64)
 		data, err := mheap.Alloc(m, int64(len(vs)*8))
 		if err != nil {
@@ -1252,7 +1294,8 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		var err error
 		var data []byte
 
@@ -1360,6 +1403,22 @@ func Dup(v *Vector, m *mheap.Mheap) (*Vector, error) {
 			Ref:  v.Ref,
 			Link: v.Link,
 		}, nil
+	case types.T_uuid:
+		vs := v.Col.([]types.Uuid)
+		data, err := mheap.Alloc(m, int64(len(vs)*16))
+		if err != nil {
+			return nil, err
+		}
+		ws := encoding.DecodeUuidSlice(data)
+		copy(ws, vs)
+		return &Vector{
+			Col:  ws,
+			Data: data,
+			Typ:  v.Typ,
+			Nsp:  v.Nsp,
+			Ref:  v.Ref,
+			Link: v.Link,
+		}, nil
 	}
 	return nil, fmt.Errorf("unsupport type %v", v.Typ)
 }
@@ -1385,13 +1444,13 @@ func Window(v *Vector, start, end int, w *Vector) *Vector {
 	case types.T_uint8:
 		w.Col = v.Col.([]uint8)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		w.Col = v.Col.([]uint16)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	case types.T_uint32:
 		w.Col = v.Col.([]uint32)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		w.Col = v.Col.([]uint64)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	case types.T_float32:
@@ -1406,7 +1465,8 @@ func Window(v *Vector, start, end int, w *Vector) *Vector {
 	case types.T_tuple:
 		w.Col = v.Col.([][]interface{})[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		w.Col = v.Col.(*types.Bytes).Window(start, end)
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	case types.T_date:
@@ -1424,6 +1484,9 @@ func Window(v *Vector, start, end int, w *Vector) *Vector {
 	case types.T_decimal128:
 		w.Col = v.Col.([]types.Decimal128)[start:end]
 		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
+	case types.T_uuid:
+		w.Col = v.Col.([]types.Uuid)[start:end]
+		w.Nsp = nulls.Range(v.Nsp, uint64(start), uint64(end), w.Nsp)
 	default:
 		panic(fmt.Sprintf("unexpect type %s for function vector.Window", v.Typ))
 	}
@@ -1450,13 +1513,13 @@ func Append(v *Vector, arg interface{}) error {
 	case types.T_uint8:
 		v.Col = append(v.Col.([]uint8), arg.([]uint8)...)
 		v.Data = encoding.EncodeFixedSlice(v.Col.([]uint8), 1)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		v.Col = append(v.Col.([]uint16), arg.([]uint16)...)
 		v.Data = encoding.EncodeFixedSlice(v.Col.([]uint16), 2)
 	case types.T_uint32:
 		v.Col = append(v.Col.([]uint32), arg.([]uint32)...)
 		v.Data = encoding.EncodeFixedSlice(v.Col.([]uint32), 4)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		v.Col = append(v.Col.([]uint64), arg.([]uint64)...)
 		v.Data = encoding.EncodeFixedSlice(v.Col.([]uint64), 8)
 	case types.T_float32:
@@ -1478,7 +1541,8 @@ func Append(v *Vector, arg interface{}) error {
 		v.Col = append(v.Col.([]int64), arg.([]int64)...)
 	case types.T_tuple:
 		v.Col = append(v.Col.([][]interface{}), arg.([][]interface{})...)
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		return v.Col.(*types.Bytes).Append(arg.([][]byte))
 	case types.T_decimal64:
 		v.Col = append(v.Col.([]types.Decimal64), arg.([]types.Decimal64)...)
@@ -1486,6 +1550,9 @@ func Append(v *Vector, arg interface{}) error {
 	case types.T_decimal128:
 		v.Col = append(v.Col.([]types.Decimal128), arg.([]types.Decimal128)...)
 		v.Data = encoding.EncodeFixedSlice(v.Col.([]types.Decimal128), 16)
+	case types.T_uuid:
+		v.Col = append(v.Col.([]types.Uuid), arg.([]types.Uuid)...)
+		v.Data = encoding.EncodeFixedSlice(v.Col.([]types.Uuid), 16)
 	default:
 		return fmt.Errorf("unexpect type %s for function vector.Append", v.Typ)
 	}
@@ -1499,7 +1566,7 @@ func Shrink(v *Vector, sels []int64) {
 	}
 	defer func() {
 		size := v.Typ.Oid.TypeLen()
-		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar {
+		if !v.Typ.IsString() {
 			v.Data = v.Data[:reflect.ValueOf(v.Col).Len()*size]
 		}
 	}()
@@ -1551,7 +1618,7 @@ func Shrink(v *Vector, sels []int64) {
 		v.Col = vs[:len(sels)]
 		v.Data = v.Data[:len(sels)*1]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		vs := v.Col.([]uint16)
 		for i, sel := range sels {
 			vs[i] = vs[sel]
@@ -1567,7 +1634,7 @@ func Shrink(v *Vector, sels []int64) {
 		v.Col = vs[:len(sels)]
 		v.Data = v.Data[:len(sels)*4]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		vs := v.Col.([]uint64)
 		for i, sel := range sels {
 			vs[i] = vs[sel]
@@ -1606,7 +1673,8 @@ func Shrink(v *Vector, sels []int64) {
 		}
 		v.Col = vs[:len(sels)]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		vs := v.Col.(*types.Bytes)
 		for i, sel := range sels {
 			vs.Offsets[i] = vs.Offsets[sel]
@@ -1655,6 +1723,14 @@ func Shrink(v *Vector, sels []int64) {
 		v.Col = vs[:len(sels)]
 		v.Data = v.Data[:len(sels)*16]
 		v.Nsp = nulls.Filter(v.Nsp, sels)
+	case types.T_uuid:
+		vs := v.Col.([]types.Uuid)
+		for i, sel := range sels {
+			vs[i] = vs[sel]
+		}
+		v.Col = vs[:len(sels)]
+		v.Data = v.Data[:len(sels)*16]
+		v.Nsp = nulls.Filter(v.Nsp, sels)
 	}
 }
 
@@ -1665,7 +1741,7 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 	}
 	defer func() {
 		size := v.Typ.Oid.TypeLen()
-		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar {
+		if !v.Typ.IsString() {
 			v.Data = v.Data[:reflect.ValueOf(v.Col).Len()*size]
 		}
 	}()
@@ -1735,7 +1811,7 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		v.Nsp = nulls.Filter(v.Nsp, sels)
 		v.Data = v.Data[:len(sels)*1]
 		mheap.Free(m, data)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		vs := v.Col.([]uint16)
 		data, err := mheap.Alloc(m, int64(len(vs)*2))
 		if err != nil {
@@ -1757,7 +1833,7 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		v.Nsp = nulls.Filter(v.Nsp, sels)
 		v.Data = v.Data[:len(sels)*4]
 		mheap.Free(m, data)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		vs := v.Col.([]uint64)
 		data, err := mheap.Alloc(m, int64(len(vs)*8))
 		if err != nil {
@@ -1806,7 +1882,8 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		ws := make([][]interface{}, len(vs))
 		v.Col = shuffle.TupleShuffle(vs, ws, sels)
 		v.Nsp = nulls.Filter(v.Nsp, sels)
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		vs := v.Col.(*types.Bytes)
 		odata, err := mheap.Alloc(m, int64(len(vs.Offsets)*4))
 		if err != nil {
@@ -1878,6 +1955,17 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 		v.Nsp = nulls.Filter(v.Nsp, sels)
 		v.Data = v.Data[:len(sels)*1]
 		mheap.Free(m, data)
+	case types.T_uuid:
+		vs := v.Col.([]types.Uuid)
+		data, err := mheap.Alloc(m, int64(len(vs)*16))
+		if err != nil {
+			return err
+		}
+		ws := encoding.DecodeUuidSlice(data)
+		v.Col = shuffle.UuidShuffle(vs, ws, sels)
+		v.Nsp = nulls.Filter(v.Nsp, sels)
+		v.Data = v.Data[:len(sels)*1]
+		mheap.Free(m, data)
 	default:
 		panic(fmt.Sprintf("unexpect type %s for function vector.Shuffle", v.Typ))
 	}
@@ -1888,7 +1976,7 @@ func Shuffle(v *Vector, sels []int64, m *mheap.Mheap) error {
 func Copy(v, w *Vector, vi, wi int64, m *mheap.Mheap) error {
 	defer func() {
 		size := v.Typ.Oid.TypeLen()
-		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar {
+		if !v.Typ.IsString() {
 			v.Data = v.Data[:reflect.ValueOf(v.Col).Len()*size]
 		}
 	}()
@@ -1924,7 +2012,7 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 	}
 	defer func() {
 		size := v.Typ.Oid.TypeLen()
-		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar {
+		if !v.Typ.IsString() {
 			v.Data = v.Data[:reflect.ValueOf(v.Col).Len()*size]
 		}
 	}()
@@ -2096,7 +2184,7 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*1]
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 2*8)
 			if err != nil {
@@ -2152,7 +2240,7 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*4]
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 8*8)
 			if err != nil {
@@ -2241,7 +2329,8 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 		vs, ws := v.Col.([][]interface{}), w.Col.([][]interface{})
 		vs = append(vs, ws[sel])
 		v.Col = vs
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		from := ws.Get(sel)
 		if len(v.Data) == 0 {
@@ -2413,6 +2502,34 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*16]
 		}
+	case types.T_uuid:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 16*8)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeUuidSlice(data)
+			vs[0] = w.Col.([]types.Uuid)[sel]
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Uuid)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*16], int64(n+1)*16)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeUuidSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, w.Col.([]types.Uuid)[sel])
+			v.Col = vs
+			v.Data = v.Data[:len(vs)*16]
+		}
 	}
 	if nulls.Any(w.Nsp) && nulls.Contains(w.Nsp, uint64(sel)) {
 		nulls.Add(v.Nsp, uint64(Length(v)-1))
@@ -2426,7 +2543,7 @@ func UnionNull(v, _ *Vector, m *mheap.Mheap) error {
 	}
 	defer func() {
 		size := v.Typ.Oid.TypeLen()
-		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar {
+		if !v.Typ.IsString() {
 			v.Data = v.Data[:reflect.ValueOf(v.Col).Len()*size]
 		}
 	}()
@@ -2586,7 +2703,7 @@ func UnionNull(v, _ *Vector, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*1]
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 2*8)
 			if err != nil {
@@ -2638,7 +2755,7 @@ func UnionNull(v, _ *Vector, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*4]
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		if len(v.Data) == 0 {
 			data, err := mheap.Alloc(m, 8*8)
 			if err != nil {
@@ -2716,7 +2833,8 @@ func UnionNull(v, _ *Vector, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*8]
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		vs := v.Col.(*types.Bytes)
 		vs.Offsets = append(vs.Offsets, 0)
 		vs.Lengths = append(vs.Lengths, 0)
@@ -2851,6 +2969,32 @@ func UnionNull(v, _ *Vector, m *mheap.Mheap) error {
 			v.Col = vs
 			v.Data = v.Data[:len(vs)*16]
 		}
+	case types.T_uuid:
+		if len(v.Data) == 0 {
+			data, err := mheap.Alloc(m, 16*8)
+			if err != nil {
+				return err
+			}
+			vs := encoding.DecodeUuidSlice(data)
+			v.Col = vs[:1]
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Uuid)
+			if n := len(vs); n+1 >= cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*16], int64(n+1)*16)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeUuidSlice(data)
+				vs = vs[:n]
+				v.Col = vs
+				v.Data = data
+			}
+			vs = append(vs, vs[0])
+			v.Col = vs
+			v.Data = v.Data[:len(vs)*16]
+		}
 	}
 	nulls.Add(v.Nsp, uint64(Length(v)-1))
 	return nil
@@ -2862,7 +3006,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 	}
 	defer func() {
 		size := v.Typ.Oid.TypeLen()
-		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar {
+		if !v.Typ.IsString() {
 			v.Data = v.Data[:reflect.ValueOf(v.Col).Len()*size]
 		}
 	}()
@@ -2994,7 +3138,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 			j++
 		}
 		v.Col = vs
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		cnt := len(sels)
 		ws := w.Col.([]uint16)
 		vs := v.Col.([]uint16)
@@ -3036,7 +3180,7 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 			j++
 		}
 		v.Col = vs
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		cnt := len(sels)
 		ws := w.Col.([]uint64)
 		vs := v.Col.([]uint64)
@@ -3099,7 +3243,8 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 			j++
 		}
 		v.Col = vs
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		incSize := 0
 		for _, sel := range sels {
@@ -3184,14 +3329,71 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 			j++
 		}
 		v.Col = vs
+	case types.T_decimal64:
+		cnt := len(sels)
+		ws := w.Col.([]types.Decimal64)
+		vs := v.Col.([]types.Decimal64)
+		n := len(vs)
+		if n+cnt >= cap(vs) {
+			data, err := mheap.Grow(m, v.Data[:n*8], int64(n+cnt)*8)
+			if err != nil {
+				return err
+			}
+			mheap.Free(m, v.Data)
+			vs = encoding.DecodeDecimal64Slice(data)
+			v.Data = data
+		}
+		vs = vs[:n+cnt]
+		for i, sel := range sels {
+			vs[n+i] = ws[sel]
+		}
+		v.Col = vs
+	case types.T_decimal128:
+		cnt := len(sels)
+		ws := w.Col.([]types.Decimal128)
+		vs := v.Col.([]types.Decimal128)
+		n := len(vs)
+		if n+cnt >= cap(vs) {
+			data, err := mheap.Grow(m, v.Data[:n*16], int64(n+cnt)*16)
+			if err != nil {
+				return err
+			}
+			mheap.Free(m, v.Data)
+			vs = encoding.DecodeDecimal128Slice(data)
+			v.Data = data
+		}
+		vs = vs[:n+cnt]
+		for i, sel := range sels {
+			vs[n+i] = ws[sel]
+		}
+		v.Col = vs
+	case types.T_uuid:
+		cnt := len(sels)
+		ws := w.Col.([]types.Uuid)
+		vs := v.Col.([]types.Uuid)
+		n := len(vs)
+		if n+cnt >= cap(vs) {
+			data, err := mheap.Grow(m, v.Data[:n*16], int64(n+cnt)*16)
+			if err != nil {
+				return err
+			}
+			mheap.Free(m, v.Data)
+			vs = encoding.DecodeUuidSlice(data)
+			v.Data = data
+		}
+		vs = vs[:n+cnt]
+		for i, sel := range sels {
+			vs[n+i] = ws[sel]
+		}
+		v.Col = vs
 	}
 	if nulls.Any(w.Nsp) {
 		j := uint64(oldLen)
 		for _, sel := range sels {
 			if nulls.Contains(w.Nsp, uint64(sel)) {
 				nulls.Add(v.Nsp, j)
-				j++
 			}
+			j++
 		}
 	}
 	return nil
@@ -3203,7 +3405,7 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 	}
 	defer func() {
 		size := v.Typ.Oid.TypeLen()
-		if v.Typ.Oid != types.T_char && v.Typ.Oid != types.T_varchar {
+		if !v.Typ.IsString() {
 			v.Data = v.Data[:reflect.ValueOf(v.Col).Len()*size]
 		}
 	}()
@@ -3468,7 +3670,7 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 			v.Col = vs
 		}
 
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		col := w.Col.([]uint16)
 		if len(v.Data) == 0 {
 			newSize := 8
@@ -3555,7 +3757,7 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 			v.Col = vs
 		}
 
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		col := w.Col.([]uint64)
 		if len(v.Data) == 0 {
 			newSize := 8
@@ -3694,7 +3896,8 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 		}
 		v.Col = vs
 
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		vs, ws := v.Col.(*types.Bytes), w.Col.(*types.Bytes)
 		incSize := 0
 		for i, flag := range flags {
@@ -3948,6 +4151,48 @@ func UnionBatch(v, w *Vector, offset int64, cnt int, flags []uint8, m *mheap.Mhe
 			}
 			v.Col = vs
 		}
+	case types.T_uuid:
+		col := w.Col.([]types.Uuid)
+		if len(v.Data) == 0 {
+			newSize := 8
+			for newSize < cnt {
+				newSize <<= 1
+			}
+			data, err := mheap.Alloc(m, int64(newSize)*16)
+			if err != nil {
+				return err
+			}
+			v.Ref = w.Ref
+			vs := encoding.DecodeUuidSlice(data)[:cnt]
+			for i, j := 0, 0; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+			v.Data = data
+		} else {
+			vs := v.Col.([]types.Uuid)
+			n := len(vs)
+			if n+cnt > cap(vs) {
+				data, err := mheap.Grow(m, v.Data[:n*16], int64(n+cnt)*16)
+				if err != nil {
+					return err
+				}
+				mheap.Free(m, v.Data)
+				vs = encoding.DecodeUuidSlice(data)
+				v.Data = data
+			}
+			vs = vs[:n+cnt]
+			for i, j := 0, n; i < len(flags); i++ {
+				if flags[i] > 0 {
+					vs[j] = col[int(offset)+i]
+					j++
+				}
+			}
+			v.Col = vs
+		}
 
 	}
 
@@ -4038,7 +4283,7 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(encoding.EncodeUint8Slice(v.Col.([]uint8)))
 		return buf.Bytes(), nil
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		buf.Write(encoding.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
 		if err != nil {
@@ -4062,7 +4307,7 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(encoding.EncodeUint32Slice(v.Col.([]uint32)))
 		return buf.Bytes(), nil
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		buf.Write(encoding.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
 		if err != nil {
@@ -4146,7 +4391,8 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(encoding.EncodeInt64Slice(v.Col.([]int64)))
 		return buf.Bytes(), nil
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		buf.Write(encoding.EncodeType(v.Typ))
 		nb, err := v.Nsp.Show()
 		if err != nil {
@@ -4205,6 +4451,18 @@ func (v *Vector) Show() ([]byte, error) {
 		}
 		buf.Write(encoding.EncodeDecimal128Slice(v.Col.([]types.Decimal128)))
 		return buf.Bytes(), nil
+	case types.T_uuid:
+		buf.Write(encoding.EncodeType(v.Typ))
+		nb, err := v.Nsp.Show()
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(nb))))
+		if len(nb) > 0 {
+			buf.Write(nb)
+		}
+		buf.Write(encoding.EncodeUuidSlice(v.Col.([]types.Uuid)))
+		return buf.Bytes(), nil
 	default:
 		return nil, fmt.Errorf("unsupport encoding type %s", v.Typ.Oid)
 	}
@@ -4295,7 +4553,7 @@ func (v *Vector) Read(data []byte) error {
 			v.Data = data[size:]
 			v.Col = encoding.DecodeUint8Slice(data[size:])
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		size := encoding.DecodeUint32(data)
 		if size == 0 {
 			v.Data = data[4:]
@@ -4321,7 +4579,7 @@ func (v *Vector) Read(data []byte) error {
 			v.Data = data[size:]
 			v.Col = encoding.DecodeUint32Slice(data[size:])
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		size := encoding.DecodeUint32(data)
 		if size == 0 {
 			v.Data = data[4:]
@@ -4399,7 +4657,8 @@ func (v *Vector) Read(data []byte) error {
 			v.Data = data[size:]
 			v.Col = encoding.DecodeTimestampSlice(data[size:])
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		Col := v.Col.(*types.Bytes)
 		Col.Reset()
 		size := encoding.DecodeUint32(data)
@@ -4469,6 +4728,19 @@ func (v *Vector) Read(data []byte) error {
 			v.Data = data[size:]
 			v.Col = encoding.DecodeDecimal128Slice(data[size:])
 		}
+	case types.T_uuid:
+		size := encoding.DecodeUint32(data)
+		if size == 0 {
+			v.Data = data[4:]
+			v.Col = encoding.DecodeUuidSlice(data[4:])
+		} else {
+			data = data[4:]
+			if err := v.Nsp.Read(data[:size]); err != nil {
+				return err
+			}
+			v.Data = data[size:]
+			v.Col = encoding.DecodeUuidSlice(data[size:])
+		}
 	}
 	return nil
 }
@@ -4529,7 +4801,7 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		col := v.Col.([]uint16)
 		if len(col) == 1 {
 			if nulls.Contains(v.Nsp, 0) {
@@ -4547,7 +4819,7 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		col := v.Col.([]uint64)
 		if len(col) == 1 {
 			if nulls.Contains(v.Nsp, 0) {
@@ -4619,7 +4891,8 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
-	case types.T_char, types.T_varchar, types.T_json:
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		col := v.Col.(*types.Bytes)
 		if len(col.Offsets) == 1 {
 			if nulls.Contains(v.Nsp, 0) {
@@ -4646,6 +4919,15 @@ func (v *Vector) String() string {
 				return fmt.Sprintf("%v", col[0])
 			}
 		}
+	case types.T_uuid:
+		col := v.Col.([]types.Uuid)
+		if len(col) == 1 {
+			if nulls.Contains(v.Nsp, 0) {
+				return "null"
+			} else {
+				return fmt.Sprintf("%v", col[0])
+			}
+		}
 	}
 	return fmt.Sprintf("%v-%s", v.Col, v.Nsp)
 }
@@ -4821,7 +5103,7 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		vs := v.Col.([]uint16)
 		for i := 0; i < rows; i++ {
 			index := i
@@ -4875,7 +5157,7 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		vs := v.Col.([]uint64)
 		for i := 0; i < rows; i++ {
 			index := i
@@ -4956,7 +5238,8 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		vs := v.Col.(*types.Bytes)
 		var i int64
 		for i = 0; i < int64(rows); i++ {
@@ -5119,6 +5402,33 @@ func (v *Vector) GetColumnData(selectIndexs []int64, occurCounts []int64, rs []s
 				rs[i] = rs[i-1]
 			}
 		}
+	case types.T_uuid:
+		vs := v.Col.([]types.Uuid)
+		for i := 0; i < rows; i++ {
+			index := i
+			count := occurCounts[i]
+			if count <= 0 {
+				i--
+				continue
+			}
+			if ifSel {
+				index = int(selectIndexs[i])
+			}
+			if allData {
+				rs[i] = fmt.Sprintf("%d", vs[index])
+			} else {
+				if nulls.Contains(v.Nsp, uint64(index)) {
+					rs[i] = nullStr
+				} else {
+					rs[i] = fmt.Sprintf("%d", vs[index])
+				}
+			}
+			for count > 1 {
+				count--
+				i++
+				rs[i] = rs[i-1]
+			}
+		}
 	default:
 		return fmt.Errorf("unexpect type %v for function vector.GetColumnData", typ)
 	}
@@ -20,4 +20,5 @@ const (
 	ErrHeader         byte = 0xff
 	EOFHeader         byte = 0xfe
 	LocalInFileHeader byte = 0xfb
+	AuthMoreData      byte = 0x01
 )
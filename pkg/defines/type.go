@@ -39,6 +39,7 @@ const (
 	MYSQL_TYPE_TIME2       uint8 = 0x13 /**< Internal to MySQL. Not used in protocol */
 	MYSQL_TYPE_TYPED_ARRAY uint8 = 0x14 /**< Used for replication only */
 
+	MYSQL_TYPE_UUID        uint8 = 242 /**< Not a mysql type, uuids are sent as MYSQL_TYPE_STRING */
 	MYSQL_TYPE_INVALID     uint8 = 243
 	MYSQL_TYPE_BOOL        uint8 = 244 /**< Currently just a placeholder */
 	MYSQL_TYPE_JSON        uint8 = 0xf5
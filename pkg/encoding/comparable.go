@@ -0,0 +1,193 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encoding
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// The comparable encoding writes the values of a tuple one after another so
+// that comparing two encoded tuples byte by byte compares their values in
+// order, the nulls being the smallest. Each value starts with a byte telling
+// its kind, so that the tuple can be split back into its values without
+// knowing their types.
+const (
+	comparableNull    = 0x00
+	comparableFixed8  = 0x01
+	comparableBytes   = 0x02
+	comparableFixed16 = 0x03
+	comparableDecimal = 0x04
+)
+
+var errComparable = errors.New("invalid comparable encoding")
+
+// EncodeComparableNull appends a null to the tuple.
+func EncodeComparableNull(dst []byte) []byte {
+	return append(dst, comparableNull)
+}
+
+// EncodeComparableUint64 appends an unsigned integer to the tuple.
+func EncodeComparableUint64(dst []byte, v uint64) []byte {
+	var buf [9]byte
+	buf[0] = comparableFixed8
+	binary.BigEndian.PutUint64(buf[1:], v)
+	return append(dst, buf[:]...)
+}
+
+// EncodeComparableInt64 appends a signed integer to the tuple.
+func EncodeComparableInt64(dst []byte, v int64) []byte {
+	return EncodeComparableUint64(dst, uint64(v)^(1<<63))
+}
+
+// EncodeComparableFloat64 appends a float to the tuple.
+func EncodeComparableFloat64(dst []byte, v float64) []byte {
+	if v == 0 {
+		v = 0 // -0 equals to 0
+	}
+	b := math.Float64bits(v)
+	if b&(1<<63) != 0 {
+		b = ^b
+	} else {
+		b |= 1 << 63
+	}
+	return EncodeComparableUint64(dst, b)
+}
+
+// EncodeComparableBytes appends a string to the tuple, its zero bytes are
+// escaped so that the string is ended by 0x00 0x01.
+func EncodeComparableBytes(dst []byte, v []byte) []byte {
+	dst = append(dst, comparableBytes)
+	for _, c := range v {
+		if c == 0 {
+			dst = append(dst, 0, 0xff)
+		} else {
+			dst = append(dst, c)
+		}
+	}
+	return append(dst, 0, 1)
+}
+
+// EncodeComparableFixed16 appends a value of 16 bytes compared bytewise,
+// such as an uuid, to the tuple.
+func EncodeComparableFixed16(dst []byte, v [16]byte) []byte {
+	dst = append(dst, comparableFixed16)
+	return append(dst, v[:]...)
+}
+
+// EncodeComparableDecimal appends a decimal to the tuple, s is its text with
+// the scale of its type, e.g. "-12.30", so that the decimals of a type are
+// ordered by their digits once the point is removed.
+func EncodeComparableDecimal(dst []byte, s string) []byte {
+	neg := len(s) > 0 && s[0] == '-'
+	if neg || (len(s) > 0 && s[0] == '+') {
+		s = s[1:]
+	}
+	digits := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] == '.' || (len(digits) == 0 && s[i] == '0') {
+			continue
+		}
+		digits = append(digits, s[i])
+	}
+	if len(digits) == 0 {
+		neg = false
+	}
+	if !neg {
+		dst = append(dst, comparableDecimal, 1, byte(len(digits)))
+		return append(dst, digits...)
+	}
+	dst = append(dst, comparableDecimal, 0, byte(0xff-len(digits)))
+	for _, c := range digits {
+		dst = append(dst, 0xff-c)
+	}
+	return dst
+}
+
+// SplitComparable returns the first value of a tuple and the rest of it.
+func SplitComparable(src []byte) ([]byte, []byte, error) {
+	if len(src) == 0 {
+		return nil, nil, errComparable
+	}
+	n := 0
+	switch src[0] {
+	case comparableNull:
+		n = 1
+	case comparableFixed8:
+		n = 9
+	case comparableFixed16:
+		n = 17
+	case comparableDecimal:
+		if len(src) < 3 {
+			return nil, nil, errComparable
+		}
+		if src[1] == 0 {
+			n = 3 + 0xff - int(src[2])
+		} else {
+			n = 3 + int(src[2])
+		}
+	case comparableBytes:
+		for i := 1; i+1 < len(src); i++ {
+			if src[i] == 0 {
+				if src[i+1] == 1 {
+					n = i + 2
+					break
+				}
+				i++
+			}
+		}
+		if n == 0 {
+			return nil, nil, errComparable
+		}
+	default:
+		return nil, nil, errComparable
+	}
+	if n > len(src) {
+		return nil, nil, errComparable
+	}
+	return src[:n], src[n:], nil
+}
+
+// DecodeComparableBytes decodes a string appended by EncodeComparableBytes,
+// the string is nil for a null.
+func DecodeComparableBytes(src []byte) ([]byte, error) {
+	if len(src) == 0 {
+		return nil, errComparable
+	}
+	switch src[0] {
+	case comparableNull:
+		return nil, nil
+	case comparableBytes:
+	default:
+		return nil, errComparable
+	}
+	v := make([]byte, 0, len(src))
+	for i := 1; i < len(src); i++ {
+		if src[i] != 0 {
+			v = append(v, src[i])
+			continue
+		}
+		if i+1 == len(src) {
+			return nil, errComparable
+		}
+		if src[i+1] == 1 {
+			return v, nil
+		}
+		v = append(v, 0)
+		i++
+	}
+	return nil, errComparable
+}
@@ -0,0 +1,130 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encoding
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComparableOrder(t *testing.T) {
+	ordered := [][]byte{
+		EncodeComparableNull(nil),
+		EncodeComparableInt64(nil, math.MinInt64),
+		EncodeComparableInt64(nil, -1),
+		EncodeComparableInt64(nil, 0),
+		EncodeComparableInt64(nil, 1),
+		EncodeComparableInt64(nil, math.MaxInt64),
+	}
+	checkOrder(t, ordered)
+
+	ordered = [][]byte{
+		EncodeComparableNull(nil),
+		EncodeComparableFloat64(nil, math.Inf(-1)),
+		EncodeComparableFloat64(nil, -2.5),
+		EncodeComparableFloat64(nil, -0.5),
+		EncodeComparableFloat64(nil, 0),
+		EncodeComparableFloat64(nil, 0.5),
+		EncodeComparableFloat64(nil, 2.5),
+		EncodeComparableFloat64(nil, math.Inf(1)),
+	}
+	checkOrder(t, ordered)
+	require.Equal(t, EncodeComparableFloat64(nil, math.Copysign(0, -1)), EncodeComparableFloat64(nil, 0))
+
+	ordered = [][]byte{
+		EncodeComparableNull(nil),
+		EncodeComparableBytes(nil, []byte("")),
+		EncodeComparableBytes(nil, []byte("a")),
+		EncodeComparableBytes(nil, []byte("a\x00")),
+		EncodeComparableBytes(nil, []byte("a\x00b")),
+		EncodeComparableBytes(nil, []byte("ab")),
+		EncodeComparableBytes(nil, []byte("b")),
+	}
+	checkOrder(t, ordered)
+
+	ordered = [][]byte{
+		EncodeComparableNull(nil),
+		EncodeComparableDecimal(nil, "-123.40"),
+		EncodeComparableDecimal(nil, "-12.30"),
+		EncodeComparableDecimal(nil, "-12.20"),
+		EncodeComparableDecimal(nil, "-0.01"),
+		EncodeComparableDecimal(nil, "0.00"),
+		EncodeComparableDecimal(nil, "0.01"),
+		EncodeComparableDecimal(nil, "9.99"),
+		EncodeComparableDecimal(nil, "10.00"),
+	}
+	checkOrder(t, ordered)
+	require.Equal(t, EncodeComparableDecimal(nil, "-0.00"), EncodeComparableDecimal(nil, "0.00"))
+
+	// tuples are ordered by their first values, then by the next ones
+	ordered = [][]byte{
+		EncodeComparableInt64(EncodeComparableNull(nil), 2),
+		EncodeComparableNull(EncodeComparableBytes(nil, []byte("a"))),
+		EncodeComparableInt64(EncodeComparableBytes(nil, []byte("a")), 1),
+		EncodeComparableInt64(EncodeComparableBytes(nil, []byte("a\x00")), 0),
+	}
+	checkOrder(t, ordered)
+}
+
+func TestComparableSplit(t *testing.T) {
+	var uuid [16]byte
+	uuid[3] = 7
+	values := [][]byte{
+		EncodeComparableNull(nil),
+		EncodeComparableUint64(nil, 42),
+		EncodeComparableBytes(nil, []byte("x\x00\x01y")),
+		EncodeComparableBytes(nil, nil),
+		EncodeComparableFixed16(nil, uuid),
+		EncodeComparableDecimal(nil, "-1.50"),
+		EncodeComparableDecimal(nil, "31.41"),
+	}
+	var tuple []byte
+	for _, v := range values {
+		tuple = append(tuple, v...)
+	}
+	for _, v := range values {
+		var value []byte
+		var err error
+		value, tuple, err = SplitComparable(tuple)
+		require.NoError(t, err)
+		require.Equal(t, v, value)
+	}
+	require.Equal(t, 0, len(tuple))
+
+	s, err := DecodeComparableBytes(values[2])
+	require.NoError(t, err)
+	require.Equal(t, []byte("x\x00\x01y"), s)
+	s, err = DecodeComparableBytes(values[3])
+	require.NoError(t, err)
+	require.NotNil(t, s)
+	require.Equal(t, 0, len(s))
+	s, err = DecodeComparableBytes(values[0])
+	require.NoError(t, err)
+	require.Nil(t, s)
+
+	_, _, err = SplitComparable([]byte{comparableBytes, 'a'})
+	require.Error(t, err)
+	_, err = DecodeComparableBytes(values[1])
+	require.Error(t, err)
+}
+
+func checkOrder(t *testing.T, ordered [][]byte) {
+	for i := 1; i < len(ordered); i++ {
+		require.Equal(t, -1, bytes.Compare(ordered[i-1], ordered[i]), "%d: %v, %v", i, ordered[i-1], ordered[i])
+	}
+}
@@ -31,6 +31,7 @@ var DatetimeSize int
 var TimestampSize int
 var Decimal64Size int
 var Decimal128Size int
+var UuidSize int
 
 func init() {
 	TypeSize = int(unsafe.Sizeof(types.Type{}))
@@ -39,6 +40,7 @@ func init() {
 	TimestampSize = int(unsafe.Sizeof(types.Timestamp(0)))
 	Decimal64Size = int(unsafe.Sizeof(types.Decimal64{}))
 	Decimal128Size = int(unsafe.Sizeof(types.Decimal128{}))
+	UuidSize = int(unsafe.Sizeof(types.Uuid{}))
 }
 
 func EncodeSlice[T any](v []T, sz int) (ret []byte) {
@@ -212,6 +214,14 @@ func DecodeDecimal128(v []byte) types.Decimal128 {
 	return *(*types.Decimal128)(unsafe.Pointer(&v[0]))
 }
 
+func EncodeUuid(v types.Uuid) []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(&v)), UuidSize)
+}
+
+func DecodeUuid(v []byte) types.Uuid {
+	return *(*types.Uuid)(unsafe.Pointer(&v[0]))
+}
+
 func EncodeFixedSlice[T any](v []T, sz int) (ret []byte) {
 	if len(v) > 0 {
 		ret = unsafe.Slice((*byte)(unsafe.Pointer(&v[0])), cap(v)*sz)[:len(v)*sz]
@@ -368,6 +378,14 @@ func DecodeDecimal128Slice(v []byte) (ret []types.Decimal128) {
 	return DecodeFixedSlice[types.Decimal128](v, Decimal128Size)
 }
 
+func EncodeUuidSlice(v []types.Uuid) []byte {
+	return EncodeFixedSlice(v, UuidSize)
+}
+
+func DecodeUuidSlice(v []byte) (ret []types.Uuid) {
+	return DecodeFixedSlice[types.Uuid](v, UuidSize)
+}
+
 func EncodeStringSlice(vs []string) []byte {
 	var o int32
 	var buf bytes.Buffer
@@ -0,0 +1,139 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/incrservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+)
+
+const (
+	moIncrementColumnsTableName = "mo_increment_columns"
+
+	// the times an update of mo_increment_columns is tried when it
+	// conflicts with the updates of other servers
+	maxIncrStoreRetries = 10
+)
+
+// catalogIncrStore keeps the offsets of the auto increment columns in
+// mo_increment_columns. Every update runs in its own txn, so the offsets
+// handed out survive the rollback of the statements allocating them.
+type catalogIncrStore struct {
+	sync.Mutex
+	storage engine.Engine
+}
+
+var _ incrservice.Store = new(catalogIncrStore)
+
+// NewIncrStore returns the store of the auto increment columns in the catalog
+func NewIncrStore(storage engine.Engine) incrservice.Store {
+	return &catalogIncrStore{storage: storage}
+}
+
+func (s *catalogIncrStore) Allocate(key string, count, min uint64) (uint64, error) {
+	var ret uint64
+	err := s.update(key, func(offset uint64) (uint64, bool) {
+		if offset < min {
+			offset = min
+		}
+		ret = offset
+		return offset + count, true
+	})
+	return ret, err
+}
+
+func (s *catalogIncrStore) Reset(key string, offset uint64) error {
+	return s.update(key, func(uint64) (uint64, bool) {
+		return offset, true
+	})
+}
+
+func (s *catalogIncrStore) Delete(key string) error {
+	return s.update(key, func(uint64) (uint64, bool) {
+		return 0, false
+	})
+}
+
+// update replaces the offset of the column with the one returned by fn,
+// the column is removed if fn returns false
+func (s *catalogIncrStore) update(key string, fn func(offset uint64) (uint64, bool)) error {
+	s.Lock()
+	defer s.Unlock()
+	taeEngine, ok := s.storage.(moengine.TxnEngine)
+	if !ok {
+		return errorIsNotTaeEngine
+	}
+	var err error
+	for i := 0; i < maxIncrStoreRetries; i++ {
+		var txnCtx moengine.Txn
+		if txnCtx, err = taeEngine.StartTxn(nil); err != nil {
+			return err
+		}
+		if err = updateIncrementColumn(s.storage, txnCtx.GetCtx(), key, fn); err != nil {
+			if err2 := txnCtx.Rollback(); err2 != nil {
+				logutil.Errorf("txnCtx rollback failed. error:%v", err2)
+			}
+			continue
+		}
+		if err = txnCtx.Commit(); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+func updateIncrementColumn(storage engine.Engine, snapshot engine.Snapshot, key string, fn func(offset uint64) (uint64, bool)) error {
+	var old []string
+	attrs := []string{"name", "offset"}
+	err := scanCatalogTable(storage, snapshot, moIncrementColumnsTableName, attrs, func(row []string) bool {
+		if row[0] != key {
+			return true
+		}
+		old = []string{row[0], row[1]}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	var offset uint64
+	if old != nil {
+		if offset, err = strconv.ParseUint(old[1], 10, 64); err != nil {
+			return err
+		}
+	}
+	newOffset, keep := fn(offset)
+	if old != nil && keep && newOffset == offset {
+		return nil
+	}
+	table, err := getCatalogTable(storage, snapshot, moIncrementColumnsTableName)
+	if err != nil {
+		return err
+	}
+	sch := DefineSchemaForMoIncrementColumns()
+	if old != nil {
+		if err = deleteCatalogRows(table, snapshot, sch, [][]string{old}); err != nil {
+			return err
+		}
+	}
+	if !keep {
+		return nil
+	}
+	return writeCatalogRows(table, snapshot, sch, [][]string{{key, strconv.FormatUint(newOffset, 10)}})
+}
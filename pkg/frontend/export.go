@@ -310,7 +310,8 @@ func exportDataToCSVFile(oq *outputQueue) error {
 					}
 				}
 			}
-		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING:
+		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING,
+			defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_JSON, defines.MYSQL_TYPE_BIT:
 			value, err := oq.mrs.GetValue(0, i)
 			if err != nil {
 				return err
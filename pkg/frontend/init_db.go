@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
 )
@@ -229,7 +230,7 @@ func PrepareInitialDataForMoTables() [][]string {
 		mo_database,mo_tables,mo_columns
 
 		tables created in the initdb step:
-		mo_global_variables,mo_user
+		mo_global_variables,mo_user,mo_role,mo_role_grant,mo_privilege
 	*/
 	data := [][]string{
 		{"mo_database", "mo_catalog", "p", "r", "tae hardcode", "databases"},
@@ -418,9 +419,10 @@ func extractColumnsInfoFromAttribute(schema *CatalogSchema, i int) []string {
 	//| attnum                | int           | UK    | The number of the column. Ordinary columns are numbered from 1 up.                                                                                                              |
 	ret[4] = fmt.Sprintf("%d", i)
 	//| att_length            | int           |       | bytes count for the type.                                                                                                                                                       |
-	if attr.GetType().Oid == types.T_varchar || attr.GetType().Oid == types.T_char {
+	switch attr.GetType().Oid {
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary:
 		ret[5] = fmt.Sprintf("%d", attr.GetType().Width)
-	} else {
+	default:
 		ret[5] = fmt.Sprintf("%d", attr.GetType().Size)
 	}
 	//| attnotnull            | tinyint(1)    |       | This represents a not-null constraint.                                                                                                                                          |
@@ -546,24 +548,32 @@ func DefineSchemaForMoUser() *CatalogSchema {
 		mo_user schema
 		| Attribute        | Type         | Primary Key | Note        |
 		| --------- | ------------ | ---- | --------- |
-		| user_host | varchar(256) | PK   | user host |
-		| user_name | varchar(256) | PK   | user name |
-		| authentication_string | varchar(4096) |     | password |
+		| user_key  | varchar(1024) | PK  | 'user_name'@'user_host' |
+		| user_host | varchar(256) |      | user host |
+		| user_name | varchar(256) |      | user name |
+		| authentication_string | varchar(4096) |     | the hash of the password, see user.go |
 	*/
+	userKeyAttr := &CatalogSchemaAttribute{
+		AttributeName: "user_key",
+		AttributeType: types.T_varchar.ToType(),
+		// Note: TAE now only support single PK. (user_name, user_host) is the primary key actually.
+		IsPrimaryKey: true,
+		Comment:      "'user_name'@'user_host'",
+	}
+	userKeyAttr.AttributeType.Width = 1024
+
 	userHostAttr := &CatalogSchemaAttribute{
 		AttributeName: "user_host",
 		AttributeType: types.T_varchar.ToType(),
-		// Note: TAE now only support single PK. It should be part of primary key
-		// TODO: Set it as true if composite pk is ready
-		IsPrimaryKey: false,
-		Comment:      "user host",
+		IsPrimaryKey:  false,
+		Comment:       "user host",
 	}
 	userHostAttr.AttributeType.Width = 256
 
 	userNameAttr := &CatalogSchemaAttribute{
 		AttributeName: "user_name",
 		AttributeType: types.T_varchar.ToType(),
-		IsPrimaryKey:  true,
+		IsPrimaryKey:  false,
 		Comment:       "user name",
 	}
 	userNameAttr.AttributeType.Width = 256
@@ -577,6 +587,7 @@ func DefineSchemaForMoUser() *CatalogSchema {
 	passwordAttr.AttributeType.Width = 256
 
 	attrs := []*CatalogSchemaAttribute{
+		userKeyAttr,
 		userHostAttr,
 		userNameAttr,
 		passwordAttr,
@@ -584,22 +595,316 @@ func DefineSchemaForMoUser() *CatalogSchema {
 	return &CatalogSchema{Name: "mo_user", Attributes: attrs}
 }
 
-func PrepareInitialDataForMoUser() [][]string {
-	data := [][]string{
-		{"localhost", "root", "''"},
-		{"localhost", "dump", "111"},
+// initialUserAccounts returns the accounts that mo_user starts with: root on localhost
+// without the password, and the dump user in the configuration on any host
+func initialUserAccounts(dumpUser, dumpPassword string) []*userAccount {
+	accounts := []*userAccount{{host: "localhost", name: "root"}}
+	if dumpUser == "root" {
+		accounts = nil
+	}
+	if dumpUser != "" {
+		accounts = append(accounts, &userAccount{host: "%", name: dumpUser, authString: encodeNativePassword([]byte(dumpPassword))})
+	}
+	return accounts
+}
+
+func PrepareInitialDataForMoUser(dumpUser, dumpPassword string) [][]string {
+	var data [][]string
+	for _, account := range initialUserAccounts(dumpUser, dumpPassword) {
+		data = append(data, account.row())
 	}
 	return data
 }
 
-func FillInitialDataForMoUser() *batch.Batch {
+func FillInitialDataForMoUser(dumpUser, dumpPassword string) *batch.Batch {
 	schema := DefineSchemaForMoUser()
-	data := PrepareInitialDataForMoUser()
+	data := PrepareInitialDataForMoUser(dumpUser, dumpPassword)
 	return PrepareInitialDataForSchema(schema, data)
 }
 
-// InitDB setups the initial catalog tables in tae
-func InitDB(tae engine.Engine) error {
+// DefineSchemaForMoRole decides the schema of the mo_role
+func DefineSchemaForMoRole() *CatalogSchema {
+	/*
+		mo_role schema
+		| Attribute | Type         | Primary Key | Note      |
+		| --------- | ------------ | ---- | --------- |
+		| role_key  | varchar(1024) | PK  | 'role_name'@'role_host' |
+		| role_host | varchar(256) |      | role host |
+		| role_name | varchar(256) |      | role name |
+	*/
+	roleKeyAttr := &CatalogSchemaAttribute{
+		AttributeName: "role_key",
+		AttributeType: types.T_varchar.ToType(),
+		// Note: TAE now only support single PK. (role_name, role_host) is the primary key actually.
+		IsPrimaryKey: true,
+		Comment:      "'role_name'@'role_host'",
+	}
+	roleKeyAttr.AttributeType.Width = 1024
+
+	roleHostAttr := &CatalogSchemaAttribute{
+		AttributeName: "role_host",
+		AttributeType: types.T_varchar.ToType(),
+		IsPrimaryKey:  false,
+		Comment:       "role host",
+	}
+	roleHostAttr.AttributeType.Width = 256
+
+	roleNameAttr := &CatalogSchemaAttribute{
+		AttributeName: "role_name",
+		AttributeType: types.T_varchar.ToType(),
+		IsPrimaryKey:  false,
+		Comment:       "role name",
+	}
+	roleNameAttr.AttributeType.Width = 256
+
+	attrs := []*CatalogSchemaAttribute{
+		roleKeyAttr,
+		roleHostAttr,
+		roleNameAttr,
+	}
+	return &CatalogSchema{Name: moRoleTableName, Attributes: attrs}
+}
+
+// DefineSchemaForMoRoleGrant decides the schema of the mo_role_grant
+func DefineSchemaForMoRoleGrant() *CatalogSchema {
+	/*
+		mo_role_grant schema
+		| Attribute | Type         | Primary Key | Note                                 |
+		| --------- | ------------ | ---- | ------------------------------------ |
+		| grant_key | varchar(1024) | PK  | the role and the grantee, quoted     |
+		| role_name | varchar(256) |      | the granted role, 'name'@'host'      |
+		| grantee   | varchar(256) |      | the user or the role given the role, 'name'@'host' |
+	*/
+	grantKeyAttr := &CatalogSchemaAttribute{
+		AttributeName: "grant_key",
+		AttributeType: types.T_varchar.ToType(),
+		// Note: TAE now only support single PK. (role_name, grantee) is the primary key actually.
+		IsPrimaryKey: true,
+		Comment:      "the role and the grantee, quoted",
+	}
+	grantKeyAttr.AttributeType.Width = 1024
+
+	roleNameAttr := &CatalogSchemaAttribute{
+		AttributeName: "role_name",
+		AttributeType: types.T_varchar.ToType(),
+		IsPrimaryKey:  false,
+		Comment:       "the granted role",
+	}
+	roleNameAttr.AttributeType.Width = 256
+
+	granteeAttr := &CatalogSchemaAttribute{
+		AttributeName: "grantee",
+		AttributeType: types.T_varchar.ToType(),
+		IsPrimaryKey:  false,
+		Comment:       "the user or the role given the role",
+	}
+	granteeAttr.AttributeType.Width = 256
+
+	attrs := []*CatalogSchemaAttribute{
+		grantKeyAttr,
+		roleNameAttr,
+		granteeAttr,
+	}
+	return &CatalogSchema{Name: moRoleGrantTableName, Attributes: attrs}
+}
+
+// DefineSchemaForMoPrivilege decides the schema of the mo_privilege
+func DefineSchemaForMoPrivilege() *CatalogSchema {
+	/*
+		mo_privilege schema
+		| Attribute      | Type          | Primary Key | Note                                          |
+		| -------------- | ------------- | ---- | --------------------------------------------- |
+		| privilege_key  | varchar(1024) | PK   | the grantee, the object and the privilege, quoted |
+		| grantee        | varchar(256)  |      | the user or the role given the privilege, 'name'@'host' |
+		| database_name  | varchar(256)  |      | * for the privileges on *.*                   |
+		| table_name     | varchar(256)  |      | * for the privileges on *.* and db.*          |
+		| privilege_type | varchar(64)   |      | the name of the privilege, like select        |
+	*/
+	privilegeKeyAttr := &CatalogSchemaAttribute{
+		AttributeName: "privilege_key",
+		AttributeType: types.T_varchar.ToType(),
+		// Note: TAE now only support single PK.
+		// (grantee, database_name, table_name, privilege_type) is the primary key actually.
+		IsPrimaryKey: true,
+		Comment:      "the grantee, the object and the privilege, quoted",
+	}
+	privilegeKeyAttr.AttributeType.Width = 1024
+
+	granteeAttr := &CatalogSchemaAttribute{
+		AttributeName: "grantee",
+		AttributeType: types.T_varchar.ToType(),
+		IsPrimaryKey:  false,
+		Comment:       "the user or the role given the privilege",
+	}
+	granteeAttr.AttributeType.Width = 256
+
+	databaseNameAttr := &CatalogSchemaAttribute{
+		AttributeName: "database_name",
+		AttributeType: types.T_varchar.ToType(),
+		IsPrimaryKey:  false,
+		Comment:       "* for the privileges on *.*",
+	}
+	databaseNameAttr.AttributeType.Width = 256
+
+	tableNameAttr := &CatalogSchemaAttribute{
+		AttributeName: "table_name",
+		AttributeType: types.T_varchar.ToType(),
+		IsPrimaryKey:  false,
+		Comment:       "* for the privileges on *.* and db.*",
+	}
+	tableNameAttr.AttributeType.Width = 256
+
+	privilegeTypeAttr := &CatalogSchemaAttribute{
+		AttributeName: "privilege_type",
+		AttributeType: types.T_varchar.ToType(),
+		IsPrimaryKey:  false,
+		Comment:       "the name of the privilege",
+	}
+	privilegeTypeAttr.AttributeType.Width = 64
+
+	attrs := []*CatalogSchemaAttribute{
+		privilegeKeyAttr,
+		granteeAttr,
+		databaseNameAttr,
+		tableNameAttr,
+		privilegeTypeAttr,
+	}
+	return &CatalogSchema{Name: moPrivilegeTableName, Attributes: attrs}
+}
+
+// PrepareInitialDataForMoPrivilege grants all privileges on *.* with grant option to the initial users
+func PrepareInitialDataForMoPrivilege(dumpUser, dumpPassword string) [][]string {
+	var data [][]string
+	for _, account := range initialUserAccounts(dumpUser, dumpPassword) {
+		for _, priv := range append(privilegesOfLevel(tree.PRIVILEGE_LEVEL_TYPE_GLOBAL), tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION) {
+			grant := &privilegeGrant{
+				grantee:   account.key(),
+				dbName:    privilegeLevelAny,
+				tableName: privilegeLevelAny,
+				privilege: priv.ToString(),
+			}
+			data = append(data, grant.row())
+		}
+	}
+	return data
+}
+
+func FillInitialDataForMoPrivilege(dumpUser, dumpPassword string) *batch.Batch {
+	schema := DefineSchemaForMoPrivilege()
+	data := PrepareInitialDataForMoPrivilege(dumpUser, dumpPassword)
+	return PrepareInitialDataForSchema(schema, data)
+}
+
+// infoSchemaViewsDefinition defines information_schema.views over the views in mo_catalog.mo_tables
+// DefineSchemaForMoIncrementColumns decides the schema of the mo_increment_columns
+func DefineSchemaForMoIncrementColumns() *CatalogSchema {
+	/*
+		mo_increment_columns schema
+		| Attribute | Type          | Primary Key | Note                                  |
+		| --------- | ------------- | ---- | ------------------------------------- |
+		| name      | varchar(1024) | PK   | the database, the table and the column |
+		| offset    | varchar(32)   |      | the largest value allocated           |
+	*/
+	nameAttr := &CatalogSchemaAttribute{
+		AttributeName: "name",
+		AttributeType: types.T_varchar.ToType(),
+		IsPrimaryKey:  true,
+		Comment:       "the database, the table and the column",
+	}
+	nameAttr.AttributeType.Width = 1024
+
+	offsetAttr := &CatalogSchemaAttribute{
+		AttributeName: "offset",
+		AttributeType: types.T_varchar.ToType(),
+		IsPrimaryKey:  false,
+		Comment:       "the largest value allocated",
+	}
+	offsetAttr.AttributeType.Width = 32
+
+	attrs := []*CatalogSchemaAttribute{
+		nameAttr,
+		offsetAttr,
+	}
+	return &CatalogSchema{Name: moIncrementColumnsTableName, Attributes: attrs}
+}
+
+const infoSchemaViewsDefinition = "create view views (table_catalog, table_schema, table_name, view_definition, " +
+	"check_option, is_updatable, definer, security_type, character_set_client, collation_connection) as " +
+	"select 'def', reldatabase, relname, rel_createsql, 'NONE', 'NO', 'root@localhost', 'INVOKER', 'utf8mb4', 'utf8mb4_general_ci' " +
+	"from mo_catalog.mo_tables where relkind = 'v'"
+
+// DefineSchemaForInfoSchemaViews decides the schema of the information_schema.views
+func DefineSchemaForInfoSchemaViews() *CatalogSchema {
+	/*
+		views schema
+		| Attribute            | Type          | Note                                  |
+		| -------------------- | ------------- | ------------------------------------- |
+		| table_catalog        | varchar(64)   | always def                            |
+		| table_schema         | varchar(64)   | the database of the view              |
+		| table_name           | varchar(64)   | the name of the view                  |
+		| view_definition      | varchar(4096) | the create view statement of the view |
+		| check_option         | varchar(8)    | always NONE                           |
+		| is_updatable         | varchar(3)    | always NO                             |
+		| definer              | varchar(288)  |                                       |
+		| security_type        | varchar(7)    | always INVOKER                        |
+		| character_set_client | varchar(64)   |                                       |
+		| collation_connection | varchar(64)   |                                       |
+	*/
+	columns := []struct {
+		name  string
+		width int32
+	}{
+		{"table_catalog", 64},
+		{"table_schema", 64},
+		{"table_name", 64},
+		{"view_definition", 4096},
+		{"check_option", 8},
+		{"is_updatable", 3},
+		{"definer", 288},
+		{"security_type", 7},
+		{"character_set_client", 64},
+		{"collation_connection", 64},
+	}
+	attrs := make([]*CatalogSchemaAttribute, len(columns))
+	for i, col := range columns {
+		attrs[i] = &CatalogSchemaAttribute{
+			AttributeName: col.name,
+			AttributeType: types.T_varchar.ToType(),
+		}
+		attrs[i].AttributeType.Width = col.width
+	}
+	return &CatalogSchema{Name: "views", Attributes: attrs}
+}
+
+// createCatalogTable creates the table in mo_catalog and writes the initial data into it.
+// It does nothing if the table exists.
+func createCatalogTable(catalogDB engine.Database, sch *CatalogSchema, data func() *batch.Batch, snapshot engine.Snapshot) error {
+	if rel, _ := catalogDB.Relation(sch.GetName(), snapshot); rel != nil {
+		return nil
+	}
+	err := catalogDB.Create(0, sch.GetName(), convertCatalogSchemaToTableDef(sch), snapshot)
+	if err != nil {
+		logutil.Infof("create table %v failed.error:%v", sch.GetName(), err)
+		return err
+	}
+	if data == nil {
+		return nil
+	}
+	table, err := catalogDB.Relation(sch.GetName(), snapshot)
+	if err != nil {
+		logutil.Infof("get table %v failed.error:%v", sch.GetName(), err)
+		return err
+	}
+	if err = table.Write(0, data(), snapshot); err != nil {
+		logutil.Infof("write into table %v failed.error:%v", sch.GetName(), err)
+		return err
+	}
+	return nil
+}
+
+// InitDB setups the initial catalog tables in tae.
+// The dump user and its password in the configuration are the initial account besides root.
+func InitDB(tae engine.Engine, dumpUser, dumpPassword string) error {
 	taeEngine, ok := tae.(moengine.TxnEngine)
 	if !ok {
 		return errorIsNotTaeEngine
@@ -709,7 +1014,7 @@ func InitDB(tae engine.Engine) error {
 			return err
 		}
 
-		userBatch := FillInitialDataForMoUser()
+		userBatch := FillInitialDataForMoUser(dumpUser, dumpPassword)
 		err = userTable.Write(0, userBatch, txnCtx.GetCtx())
 		if err != nil {
 			logutil.Infof("write into table %v failed.error:%v", userSch.GetName(), err)
@@ -722,6 +1027,27 @@ func InitDB(tae engine.Engine) error {
 		}
 	}
 
+	//4. create tables mo_role, mo_role_grant, mo_privilege, mo_increment_columns
+	for _, table := range []struct {
+		sch  *CatalogSchema
+		data func() *batch.Batch
+	}{
+		{DefineSchemaForMoRole(), nil},
+		{DefineSchemaForMoRoleGrant(), nil},
+		{DefineSchemaForMoPrivilege(), func() *batch.Batch { return FillInitialDataForMoPrivilege(dumpUser, dumpPassword) }},
+		{DefineSchemaForMoIncrementColumns(), nil},
+	} {
+		err = createCatalogTable(catalogDB, table.sch, table.data, txnCtx.GetCtx())
+		if err != nil {
+			err2 := txnCtx.Rollback()
+			if err2 != nil {
+				logutil.Infof("txnCtx rollback failed. error:%v", err2)
+				return err2
+			}
+			return err
+		}
+	}
+
 	/*
 		stage 2: create information_schema database.
 		Views in the information_schema need to created by 'create view'
@@ -743,7 +1069,25 @@ func InitDB(tae engine.Engine) error {
 		}
 	}
 
-	//TODO: create views after the computation engine is ready
+	//2. create the views in information_schema
+	infoSchemaDB, err := tae.Database(infoSchemaName, txnCtx.GetCtx())
+	if err == nil {
+		sch := DefineSchemaForInfoSchemaViews()
+		if rel, _ := infoSchemaDB.Relation(sch.GetName(), txnCtx.GetCtx()); rel == nil {
+			defs := append(convertCatalogSchemaToTableDef(sch), &engine.ViewDef{View: infoSchemaViewsDefinition})
+			err = infoSchemaDB.Create(0, sch.GetName(), defs, txnCtx.GetCtx())
+		}
+	}
+	if err != nil {
+		logutil.Infof("create views of %v failed.error:%v", infoSchemaName, err)
+		err2 := txnCtx.Rollback()
+		if err2 != nil {
+			logutil.Infof("txnCtx rollback failed. error:%v", err2)
+			return err2
+		}
+		return err
+	}
+
 	err = txnCtx.Commit()
 	if err != nil {
 		logutil.Infof("txnCtx commit failed.error:%v", err)
@@ -772,14 +1116,20 @@ func sanityCheck(tae engine.Engine) error {
 		return errorMissingCatalogDatabases
 	}
 
-	// database mo_catalog has tables:mo_database,mo_tables,mo_columns,mo_global_variables, mo_user
-	wantTablesOfMoCatalog := []string{"mo_database", "mo_tables", "mo_columns", "mo_global_variables", "mo_user"}
+	// database mo_catalog has tables:mo_database,mo_tables,mo_columns,mo_global_variables,
+	// mo_user,mo_role,mo_role_grant,mo_privilege
+	wantTablesOfMoCatalog := []string{"mo_database", "mo_tables", "mo_columns", "mo_global_variables", "mo_user",
+		"mo_role", "mo_role_grant", "mo_privilege", "mo_increment_columns"}
 	wantSchemasOfCatalog := []*CatalogSchema{
 		DefineSchemaForMoDatabase(),
 		DefineSchemaForMoTables(),
 		DefineSchemaForMoColumns(),
 		DefineSchemaForMoGlobalVariables(),
 		DefineSchemaForMoUser(),
+		DefineSchemaForMoRole(),
+		DefineSchemaForMoRoleGrant(),
+		DefineSchemaForMoPrivilege(),
+		DefineSchemaForMoIncrementColumns(),
 	}
 	catalogDbName := "mo_catalog"
 	err = isWantedDatabase(taeEngine, txnCtx, catalogDbName, wantTablesOfMoCatalog, wantSchemasOfCatalog)
@@ -914,6 +1264,10 @@ func isWantedTable(db engine.Database, txnCtx moengine.Txn,
 	if err != nil {
 		return err
 	}
+	//the table is empty
+	if result == nil {
+		return nil
+	}
 	for i := 0; i < vector.Length(result.Vecs[0]); i++ {
 		line := FormatLineInBatch(result, i)
 		fmt.Println(line)
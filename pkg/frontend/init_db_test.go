@@ -91,8 +91,8 @@ func TestPrepareInitialData(t *testing.T) {
 
 	convey.Convey("mo_user", t, func() {
 		sch := DefineSchemaForMoUser()
-		data := PrepareInitialDataForMoUser()
-		bat := FillInitialDataForMoUser()
+		data := PrepareInitialDataForMoUser("dump", "111")
+		bat := FillInitialDataForMoUser("dump", "111")
 		convey.So(bat, convey.ShouldNotBeNil)
 		convey.So(batch.Length(bat), convey.ShouldEqual, len(data))
 		convey.So(len(bat.Vecs), convey.ShouldEqual, len(data[0]))
@@ -100,9 +100,24 @@ func TestPrepareInitialData(t *testing.T) {
 		for i, attr := range sch.GetAttributes() {
 			convey.So(attr.AttributeType.Eq(bat.Vecs[i].Typ), convey.ShouldBeTrue)
 		}
-		for i, line := range data {
-			s := FormatLineInBatch(bat, i)
-			convey.So(line, convey.ShouldResemble, s)
+		//root has no password. its empty authentication string is written as null.
+		want := [][]string{
+			{"'root'@'localhost'", "localhost", "root", "<nil>"},
+			{"'dump'@'%'", "%", "dump", encodeNativePassword([]byte("111"))},
 		}
+		for i := range want {
+			convey.So(FormatLineInBatch(bat, i), convey.ShouldResemble, want[i])
+		}
+
+		//the dump user and its password come from the configuration
+		data = PrepareInitialDataForMoUser("admin", "secret")
+		convey.So(data, convey.ShouldResemble, [][]string{
+			{"'root'@'localhost'", "localhost", "root", ""},
+			{"'admin'@'%'", "%", "admin", encodeNativePassword([]byte("secret"))},
+		})
+		data = PrepareInitialDataForMoUser("root", "secret")
+		convey.So(data, convey.ShouldResemble, [][]string{
+			{"'root'@'%'", "%", "root", encodeNativePassword([]byte("secret"))},
+		})
 	})
 }
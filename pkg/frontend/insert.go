@@ -27,8 +27,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/incrservice"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
@@ -38,22 +40,67 @@ type InsertValues struct {
 	currentDb string
 	dataBatch *batch.Batch
 	relation  engine.Relation
+	// autoIncrCol is the name of the auto increment column, "" if none
+	autoIncrCol string
+	// constraints checks the rows before they are written, nil if the table
+	// has no constraint to check
+	constraints *tableConstraints
+	// enumValues are the members of the ENUM and SET columns by name
+	enumValues map[string]string
 }
 
-func (mce *MysqlCmdExecutor) handleInsertValues(stmt *tree.Insert, ts uint64) (uint64, error) {
+// handleInsertValues returns the number of rows affected and the first auto
+// increment value allocated
+func (mce *MysqlCmdExecutor) handleInsertValues(stmt *tree.Insert, ts uint64) (uint64, uint64, error) {
 	snapshot := mce.GetSession().GetTxnHandler().GetTxn().GetCtx()
 
 	plan := &InsertValues{currentDb: mce.GetSession().GetDatabaseName()}
 
 	if err := buildInsertValues(stmt, plan, mce.GetSession().GetStorage(), snapshot); err != nil {
-		return 0, err
+		return 0, 0, err
 	}
 	defer plan.relation.Close(snapshot)
+	privs := []*plan2.RequiredPrivilege{{Type: tree.PRIVILEGE_TYPE_STATIC_INSERT, DbName: plan.dbName, TableName: plan.tblName}}
+	if err := mce.GetSession().CheckPrivileges(privs); err != nil {
+		return 0, 0, err
+	}
+	lastInsertID, err := mce.fillAutoIncrement(plan)
+	if err != nil {
+		return 0, 0, err
+	}
+	if plan.constraints, err = mce.getTableConstraints(plan.dbName, plan.tblName, plan.relation, snapshot); err != nil {
+		return 0, 0, err
+	}
+	if len(stmt.OnDuplicateUpdate) > 0 {
+		affected, err := handleOnDuplicateKeyUpdate(stmt.OnDuplicateUpdate, plan, ts, snapshot)
+		return affected, lastInsertID, err
+	}
+	if err := plan.constraints.check(plan.dataBatch); err != nil {
+		return 0, 0, err
+	}
 	if err := plan.relation.Write(ts, plan.dataBatch, snapshot); err != nil {
-		return 0, err
+		return 0, 0, err
 	}
 
-	return uint64(vector.Length(plan.dataBatch.Vecs[0])), nil
+	return uint64(vector.Length(plan.dataBatch.Vecs[0])), lastInsertID, nil
+}
+
+// fillAutoIncrement allocates the values of the auto increment column for the
+// rows inserting nulls or zeros into it
+func (mce *MysqlCmdExecutor) fillAutoIncrement(plan *InsertValues) (uint64, error) {
+	if plan.autoIncrCol == "" || len(plan.dataBatch.Vecs) == 0 || vector.Length(plan.dataBatch.Vecs[0]) == 0 {
+		return 0, nil
+	}
+	incrService := mce.GetSession().Pu.IncrService
+	if incrService == nil {
+		return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("auto increment column '%s' is not supported", plan.autoIncrCol))
+	}
+	for i, attr := range plan.dataBatch.Attrs {
+		if attr == plan.autoIncrCol {
+			return incrService.Fill(incrservice.Key(plan.dbName, plan.tblName, attr), plan.dataBatch.Vecs[i])
+		}
+	}
+	return 0, nil
 }
 
 func getTableRef(tbl *tree.TableName, currentDB string, eg engine.Engine, snapshot engine.Snapshot) (string, string, engine.Relation, error) {
@@ -95,16 +142,25 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 	attrType := make(map[string]types.Type)   // Map from relation's attribute name to its type
 	attrDefault := make(map[string]tree.Expr) // Map from relation's attribute name to its default value
 	orderAttr := make([]string, 0, 32)        // order relation's attribute names
+	plan.enumValues = make(map[string]string)
 	{
 		count := 0
 		for _, def := range relation.TableDefs(snapshot) {
 			if v, ok := def.(*engine.AttributeDef); ok {
 				attrType[v.Attr.Name] = v.Attr.Type
 				orderAttr = append(orderAttr, v.Attr.Name)
+				if v.Attr.EnumValues != "" {
+					plan.enumValues[v.Attr.Name] = v.Attr.EnumValues
+				}
 				if v.Attr.HasDefaultExpr() {
 					value, null := v.Attr.GetDefaultExpr()
 					attrDefault[v.Attr.Name] = makeExprFromVal(v.Attr.Type, value, null)
 				}
+				// the values of the auto increment column are allocated for nulls
+				if v.Attr.AutoIncrement {
+					attrDefault[v.Attr.Name] = makeExprFromVal(v.Attr.Type, nil, true)
+					plan.autoIncrCol = v.Attr.Name
+				}
 				count++
 			}
 		}
@@ -146,12 +202,75 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 	}
 
 	// insert values for columns
+	if err = fillInsertValues(bat, rows.Rows, plan.enumValues); err != nil {
+		return err
+	}
+	// insert Null for other columns
+	for k, v := range attrType {
+		bat.Attrs = append(bat.Attrs, k)
+		vec := vector.New(v)
+		for i, j := 0, len(rows.Rows); i < j; i++ {
+			nulls.Add(vec.Nsp, uint64(i))
+		}
+		switch vec.Typ.Oid {
+		case types.T_bool:
+			vec.Col = make([]bool, len(rows.Rows))
+		case types.T_int8:
+			vec.Col = make([]int8, len(rows.Rows))
+		case types.T_int16:
+			vec.Col = make([]int16, len(rows.Rows))
+		case types.T_int32:
+			vec.Col = make([]int32, len(rows.Rows))
+		case types.T_int64:
+			vec.Col = make([]int64, len(rows.Rows))
+		case types.T_uint8:
+			vec.Col = make([]uint8, len(rows.Rows))
+		case types.T_uint16, types.T_enum:
+			vec.Col = make([]uint16, len(rows.Rows))
+		case types.T_uint32:
+			vec.Col = make([]uint32, len(rows.Rows))
+		case types.T_uint64, types.T_set, types.T_bit:
+			vec.Col = make([]uint64, len(rows.Rows))
+		case types.T_float32:
+			vec.Col = make([]float32, len(rows.Rows))
+		case types.T_float64:
+			vec.Col = make([]float64, len(rows.Rows))
+		case types.T_char, types.T_varchar, types.T_text,
+			types.T_binary, types.T_varbinary, types.T_blob, types.T_json:
+			col := &types.Bytes{}
+			if err = col.Append(make([][]byte, len(rows.Rows))); err != nil {
+				return err
+			}
+			vec.Col = col
+		case types.T_date:
+			vec.Col = make([]types.Date, len(rows.Rows))
+		case types.T_datetime:
+			vec.Col = make([]types.Datetime, len(rows.Rows))
+		case types.T_decimal64:
+			vec.Col = make([]types.Decimal64, len(rows.Rows))
+		case types.T_decimal128:
+			vec.Col = make([]types.Decimal128, len(rows.Rows))
+		case types.T_uuid:
+			vec.Col = make([]types.Uuid, len(rows.Rows))
+		default:
+			return errors.New(errno.DatatypeMismatch, fmt.Sprintf("insert for type '%v' not implement now", vec.Typ))
+		}
+		bat.Vecs = append(bat.Vecs, vec)
+	}
+	batch.Reorder(bat, orderAttr)
+	plan.dataBatch = bat
+	return nil
+}
+
+// fillInsertValues appends the values of the rows to the vectors of the batch,
+// enumValues are the members of the ENUM and SET columns by name
+func fillInsertValues(bat *batch.Batch, rows []tree.Exprs, enumValues map[string]string) error {
 	for i, vec := range bat.Vecs {
 		switch vec.Typ.Oid {
 		case types.T_bool:
-			vs := make([]bool, len(rows.Rows))
+			vs := make([]bool, len(rows))
 			{
-				for j, row := range rows.Rows {
+				for j, row := range rows {
 					v, err := buildConstant(vec.Typ, row[i])
 					if err != nil {
 						return fmt.Errorf("data truncation: %s for column '%s' at row %v", err.Error(), bat.Attrs[i], j)
@@ -171,9 +290,9 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 				return err
 			}
 		case types.T_int8:
-			vs := make([]int8, len(rows.Rows))
+			vs := make([]int8, len(rows))
 			{
-				for j, row := range rows.Rows {
+				for j, row := range rows {
 					v, err := buildConstant(vec.Typ, row[i])
 					if err != nil {
 						return fmt.Errorf("data truncation: %s for column '%s' at row %v", err.Error(), bat.Attrs[i], j)
@@ -193,9 +312,9 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 				return err
 			}
 		case types.T_int16:
-			vs := make([]int16, len(rows.Rows))
+			vs := make([]int16, len(rows))
 			{
-				for j, row := range rows.Rows {
+				for j, row := range rows {
 					v, err := buildConstant(vec.Typ, row[i])
 					if err != nil {
 						return fmt.Errorf("data truncation: %s for column '%s' at row %v", err.Error(), bat.Attrs[i], j)
@@ -215,9 +334,9 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 				return err
 			}
 		case types.T_int32:
-			vs := make([]int32, len(rows.Rows))
+			vs := make([]int32, len(rows))
 			{
-				for j, row := range rows.Rows {
+				for j, row := range rows {
 					v, err := buildConstant(vec.Typ, row[i])
 					if err != nil {
 						return fmt.Errorf("data truncation: %s for column '%s' at row %v", err.Error(), bat.Attrs[i], j)
@@ -237,9 +356,9 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 				return err
 			}
 		case types.T_int64:
-			vs := make([]int64, len(rows.Rows))
+			vs := make([]int64, len(rows))
 			{
-				for j, row := range rows.Rows {
+				for j, row := range rows {
 					v, err := buildConstant(vec.Typ, row[i])
 					if err != nil {
 						return fmt.Errorf("data truncation: %s for column '%s' at row %v", err.Error(), bat.Attrs[i], j)
@@ -259,9 +378,9 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 				return err
 			}
 		case types.T_uint8:
-			vs := make([]uint8, len(rows.Rows))
+			vs := make([]uint8, len(rows))
 			{
-				for j, row := range rows.Rows {
+				for j, row := range rows {
 					v, err := buildConstant(vec.Typ, row[i])
 					if err != nil {
 						return fmt.Errorf("data truncation: %s for column '%s' at row %v", err.Error(), bat.Attrs[i], j)
@@ -281,9 +400,9 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 				return err
 			}
 		case types.T_uint16:
-			vs := make([]uint16, len(rows.Rows))
+			vs := make([]uint16, len(rows))
 			{
-				for j, row := range rows.Rows {
+				for j, row := range rows {
 					v, err := buildConstant(vec.Typ, row[i])
 					if err != nil {
 						return fmt.Errorf("data truncation: %s for column '%s' at row %v", err.Error(), bat.Attrs[i], j)
@@ -303,9 +422,9 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 				return err
 			}
 		case types.T_uint32:
-			vs := make([]uint32, len(rows.Rows))
+			vs := make([]uint32, len(rows))
 			{
-				for j, row := range rows.Rows {
+				for j, row := range rows {
 					v, err := buildConstant(vec.Typ, row[i])
 					if err != nil {
 						return fmt.Errorf("data truncation: %s for column '%s' at row %v", err.Error(), bat.Attrs[i], j)
@@ -324,10 +443,10 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			if err := vector.Append(vec, vs); err != nil {
 				return err
 			}
-		case types.T_uint64:
-			vs := make([]uint64, len(rows.Rows))
+		case types.T_uint64, types.T_bit:
+			vs := make([]uint64, len(rows))
 			{
-				for j, row := range rows.Rows {
+				for j, row := range rows {
 					v, err := buildConstant(vec.Typ, row[i])
 					if err != nil {
 						return fmt.Errorf("data truncation: %s for column '%s' at row %v", err.Error(), bat.Attrs[i], j)
@@ -347,9 +466,9 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 				return err
 			}
 		case types.T_float32:
-			vs := make([]float32, len(rows.Rows))
+			vs := make([]float32, len(rows))
 			{
-				for j, row := range rows.Rows {
+				for j, row := range rows {
 					v, err := buildConstant(vec.Typ, row[i])
 					if err != nil {
 						return fmt.Errorf("data truncation: %s for column '%s' at row %v", err.Error(), bat.Attrs[i], j)
@@ -369,9 +488,9 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 				return err
 			}
 		case types.T_float64:
-			vs := make([]float64, len(rows.Rows))
+			vs := make([]float64, len(rows))
 			{
-				for j, row := range rows.Rows {
+				for j, row := range rows {
 					v, err := buildConstant(vec.Typ, row[i])
 					if err != nil {
 						return fmt.Errorf("data truncation: %s for column '%s' at row %v", err.Error(), bat.Attrs[i], j)
@@ -390,10 +509,11 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			if err := vector.Append(vec, vs); err != nil {
 				return err
 			}
-		case types.T_char, types.T_varchar:
-			vs := make([][]byte, len(rows.Rows))
+		case types.T_char, types.T_varchar, types.T_text,
+			types.T_binary, types.T_varbinary, types.T_blob:
+			vs := make([][]byte, len(rows))
 			{
-				for j, row := range rows.Rows {
+				for j, row := range rows {
 					v, err := buildConstant(vec.Typ, row[i])
 					if err != nil {
 						return fmt.Errorf("data truncation: %s for column '%s' at row %v", err.Error(), bat.Attrs[i], j)
@@ -412,10 +532,74 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			if err := vector.Append(vec, vs); err != nil {
 				return err
 			}
+		case types.T_enum, types.T_set:
+			members := types.SplitEnumMembers(enumValues[bat.Attrs[i]])
+			vs := make([]uint64, len(rows))
+			for j, row := range rows {
+				v, err := buildConstant(vec.Typ, row[i])
+				if err == nil && v != nil {
+					vs[j], err = parseEnumValue(vec.Typ, members, v)
+				}
+				if err != nil {
+					return fmt.Errorf("%s for column '%s' at row %v", err.Error(), bat.Attrs[i], j+1)
+				}
+				if v == nil {
+					nulls.Add(vec.Nsp, uint64(j))
+				}
+			}
+			if vec.Typ.Oid == types.T_set {
+				if err := vector.Append(vec, vs); err != nil {
+					return err
+				}
+				break
+			}
+			ordinals := make([]uint16, len(vs))
+			for j, v := range vs {
+				ordinals[j] = uint16(v)
+			}
+			if err := vector.Append(vec, ordinals); err != nil {
+				return err
+			}
+		case types.T_json:
+			vs := make([][]byte, len(rows))
+			{
+				for j, row := range rows {
+					v, err := buildConstant(vec.Typ, row[i])
+					if err != nil {
+						return fmt.Errorf("%s for column '%s' at row %v", err.Error(), bat.Attrs[i], j+1)
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						vs[j] = v.(types.ByteJson).Encode()
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
+		case types.T_uuid:
+			vs := make([]types.Uuid, len(rows))
+			{
+				for j, row := range rows {
+					v, err := buildConstant(vec.Typ, row[i])
+					if err != nil {
+						return fmt.Errorf("%s for column '%s' at row %v", err.Error(), bat.Attrs[i], j+1)
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						vs[j] = v.(types.Uuid)
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
 		case types.T_date:
-			vs := make([]types.Date, len(rows.Rows))
+			vs := make([]types.Date, len(rows))
 			{
-				for j, row := range rows.Rows {
+				for j, row := range rows {
 					v, err := buildConstant(vec.Typ, row[i])
 					if err != nil {
 						return fmt.Errorf("data truncation: %s for column '%s' at row %v", err.Error(), bat.Attrs[i], j)
@@ -435,9 +619,9 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 				return err
 			}
 		case types.T_datetime:
-			vs := make([]types.Datetime, len(rows.Rows))
+			vs := make([]types.Datetime, len(rows))
 			{
-				for j, row := range rows.Rows {
+				for j, row := range rows {
 					v, err := buildConstant(vec.Typ, row[i])
 					if err != nil {
 						return fmt.Errorf("data truncation: %s for column '%s' at row %v", err.Error(), bat.Attrs[i], j)
@@ -457,9 +641,9 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 				return err
 			}
 		case types.T_timestamp:
-			vs := make([]types.Timestamp, len(rows.Rows))
+			vs := make([]types.Timestamp, len(rows))
 			{
-				for j, row := range rows.Rows {
+				for j, row := range rows {
 					v, err := buildConstant(vec.Typ, row[i])
 					if err != nil {
 						return fmt.Errorf("data truncation: %s for column '%s' at row %v", err.Error(), bat.Attrs[i], j)
@@ -479,9 +663,9 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 				return err
 			}
 		case types.T_decimal64:
-			vs := make([]types.Decimal64, len(rows.Rows))
+			vs := make([]types.Decimal64, len(rows))
 			{
-				for j, row := range rows.Rows {
+				for j, row := range rows {
 					v, err := buildConstant(vec.Typ, row[i])
 					if err != nil {
 						return fmt.Errorf("data truncation: %s for column '%s' at row %v", err.Error(), bat.Attrs[i], j)
@@ -501,9 +685,9 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 				return err
 			}
 		case types.T_decimal128:
-			vs := make([]types.Decimal128, len(rows.Rows))
+			vs := make([]types.Decimal128, len(rows))
 			{
-				for j, row := range rows.Rows {
+				for j, row := range rows {
 					v, err := buildConstant(vec.Typ, row[i])
 					if err != nil {
 						return fmt.Errorf("data truncation: %s for column '%s' at row %v", err.Error(), bat.Attrs[i], j)
@@ -526,57 +710,6 @@ func buildInsertValues(stmt *tree.Insert, plan *InsertValues, eg engine.Engine,
 			return fmt.Errorf("data truncation: type of '%v' does not implement now", vec.Typ)
 		}
 	}
-	// insert Null for other columns
-	for k, v := range attrType {
-		bat.Attrs = append(bat.Attrs, k)
-		vec := vector.New(v)
-		for i, j := 0, len(rows.Rows); i < j; i++ {
-			nulls.Add(vec.Nsp, uint64(i))
-		}
-		switch vec.Typ.Oid {
-		case types.T_bool:
-			vec.Col = make([]bool, len(rows.Rows))
-		case types.T_int8:
-			vec.Col = make([]int8, len(rows.Rows))
-		case types.T_int16:
-			vec.Col = make([]int16, len(rows.Rows))
-		case types.T_int32:
-			vec.Col = make([]int32, len(rows.Rows))
-		case types.T_int64:
-			vec.Col = make([]int64, len(rows.Rows))
-		case types.T_uint8:
-			vec.Col = make([]uint8, len(rows.Rows))
-		case types.T_uint16:
-			vec.Col = make([]uint16, len(rows.Rows))
-		case types.T_uint32:
-			vec.Col = make([]uint32, len(rows.Rows))
-		case types.T_uint64:
-			vec.Col = make([]uint64, len(rows.Rows))
-		case types.T_float32:
-			vec.Col = make([]float32, len(rows.Rows))
-		case types.T_float64:
-			vec.Col = make([]float64, len(rows.Rows))
-		case types.T_char, types.T_varchar:
-			col := &types.Bytes{}
-			if err = col.Append(make([][]byte, len(rows.Rows))); err != nil {
-				return err
-			}
-			vec.Col = col
-		case types.T_date:
-			vec.Col = make([]types.Date, len(rows.Rows))
-		case types.T_datetime:
-			vec.Col = make([]types.Datetime, len(rows.Rows))
-		case types.T_decimal64:
-			vec.Col = make([]types.Decimal64, len(rows.Rows))
-		case types.T_decimal128:
-			vec.Col = make([]types.Decimal128, len(rows.Rows))
-		default:
-			return errors.New(errno.DatatypeMismatch, fmt.Sprintf("insert for type '%v' not implement now", vec.Typ))
-		}
-		bat.Vecs = append(bat.Vecs, vec)
-	}
-	batch.Reorder(bat, orderAttr)
-	plan.dataBatch = bat
 	return nil
 }
 
@@ -630,10 +763,21 @@ func makeExprFromVal(typ types.Type, value interface{}, isNull bool) tree.Expr {
 		res := uint64(value.(uint32))
 		str := strconv.FormatUint(res, 10)
 		return tree.NewNumVal(constant.MakeUint64(res), str, false)
-	case types.T_uint64:
+	case types.T_uint64, types.T_bit:
 		res := value.(uint64)
 		str := strconv.FormatUint(res, 10)
 		return tree.NewNumVal(constant.MakeUint64(res), str, false)
+	case types.T_enum, types.T_set:
+		// the defaults of ENUM and SET are the names of their members, and
+		// the stored values are the ordinals or the bitmaps
+		switch res := value.(type) {
+		case string:
+			return tree.NewNumVal(constant.MakeString(res), res, false)
+		case uint16:
+			return tree.NewNumVal(constant.MakeUint64(uint64(res)), strconv.FormatUint(uint64(res), 10), false)
+		case uint64:
+			return tree.NewNumVal(constant.MakeUint64(res), strconv.FormatUint(res, 10), false)
+		}
 	case types.T_float32:
 		res := float64(value.(float32))
 		str := strconv.FormatFloat(res, 'f', 10, 64)
@@ -642,9 +786,13 @@ func makeExprFromVal(typ types.Type, value interface{}, isNull bool) tree.Expr {
 		res := value.(float64)
 		str := strconv.FormatFloat(res, 'f', 10, 64)
 		return tree.NewNumVal(constant.MakeFloat64(res), str, res < 0)
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		res := string(value.([]byte)[:])
 		return tree.NewNumVal(constant.MakeString(res), res, false)
+	case types.T_json:
+		res := types.DecodeByteJson(value.([]byte)).String()
+		return tree.NewNumVal(constant.MakeString(res), res, false)
 	case types.T_date:
 		res := value.(types.Date).String()
 		return tree.NewNumVal(constant.MakeString(res), res, false)
@@ -662,10 +810,115 @@ func makeExprFromVal(typ types.Type, value interface{}, isNull bool) tree.Expr {
 		val, _ := value.(types.Decimal128)
 		res := val.ToString()
 		return tree.NewNumVal(constant.MakeString(res), res, false)
+	case types.T_uuid:
+		res := value.(types.Uuid).String()
+		return tree.NewNumVal(constant.MakeString(res), res, false)
 	}
 	return tree.NewNumVal(constant.MakeUnknown(), "NULL", false)
 }
 
+// parseEnumValue returns the ordinal of ENUM or the bitmap of SET of a value,
+// which is the names of the members or the number itself
+func parseEnumValue(typ types.Type, members []string, v interface{}) (uint64, error) {
+	if typ.Oid == types.T_enum {
+		var ordinal uint16
+		var err error
+		if n, ok := v.(uint64); ok {
+			ordinal, err = types.ParseEnumIndex(members, n)
+		} else {
+			ordinal, err = types.ParseEnum(members, v.(string))
+		}
+		return uint64(ordinal), err
+	}
+	if n, ok := v.(uint64); ok {
+		return types.ParseSetIndex(members, n)
+	}
+	return types.ParseSet(members, v.(string))
+}
+
+// getInsertValues returns the insert statement if it is INSERT ... VALUES which is not planned
+func getInsertValues(stmt tree.Statement) (*tree.Insert, bool) {
+	st, ok := stmt.(*tree.Insert)
+	if !ok || st.Rows == nil {
+		return nil, false
+	}
+	if _, ok = st.Rows.Select.(*tree.ValuesClause); !ok {
+		return nil, false
+	}
+	return st, true
+}
+
+// countParamsOfInsertValues returns the number of the parameters in the VALUES
+func countParamsOfInsertValues(stmt *tree.Insert) int {
+	count := 0
+	for _, row := range stmt.Rows.Select.(*tree.ValuesClause).Rows {
+		for _, expr := range row {
+			if _, ok := expr.(*tree.ParamExpr); ok {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// makeExprFromParamValue makes the constant expression of the value of the parameter
+func makeExprFromParamValue(value interface{}) (tree.Expr, error) {
+	switch v := value.(type) {
+	case nil:
+		return tree.NewNumVal(constant.MakeUnknown(), "NULL", false), nil
+	case bool:
+		return tree.NewNumVal(constant.MakeBool(v), strconv.FormatBool(v), false), nil
+	case int64:
+		str := strconv.FormatInt(v, 10)
+		if v < 0 {
+			return tree.NewNumVal(constant.MakeUint64(uint64(-v)), str, true), nil
+		}
+		return tree.NewNumVal(constant.MakeInt64(v), str, false), nil
+	case uint64:
+		return tree.NewNumVal(constant.MakeUint64(v), strconv.FormatUint(v, 10), false), nil
+	case float64:
+		return tree.NewNumVal(constant.MakeFloat64(v), strconv.FormatFloat(v, 'f', -1, 64), v < 0), nil
+	case string:
+		return tree.NewNumVal(constant.MakeString(v), v, false), nil
+	}
+	return nil, fmt.Errorf("type %T of the parameter is not supported now", value)
+}
+
+// bindParamsOfInsertValues makes the insert statement with the parameters replaced by their values.
+// The prepared statement is not changed. It is used by the later executions.
+func bindParamsOfInsertValues(stmt *tree.Insert, values []interface{}) (*tree.Insert, error) {
+	if len(values) != countParamsOfInsertValues(stmt) {
+		return nil, NewMysqlError(ER_WRONG_ARGUMENTS, "EXECUTE")
+	}
+	var err error
+	idx := 0
+	rows := stmt.Rows.Select.(*tree.ValuesClause).Rows
+	newRows := make([]tree.Exprs, len(rows))
+	for i, row := range rows {
+		newRows[i] = make(tree.Exprs, len(row))
+		for j, expr := range row {
+			if _, ok := expr.(*tree.ParamExpr); ok {
+				if expr, err = makeExprFromParamValue(values[idx]); err != nil {
+					return nil, err
+				}
+				idx++
+			}
+			newRows[i][j] = expr
+		}
+	}
+
+	//the table name and the rows are rewritten by buildInsertValues
+	newStmt := *stmt
+	if tbl, ok := stmt.Table.(*tree.TableName); ok {
+		newTbl := *tbl
+		newStmt.Table = &newTbl
+	}
+	newSelect := *stmt.Rows
+	newSelect.Select = &tree.ValuesClause{Rows: newRows}
+	newStmt.Rows = &newSelect
+	return &newStmt, nil
+}
+
 // rewriteInsertRows rewrite default expressions in valueClause's Rows
 // and convert them to be column-default-expression.
 func rewriteInsertRows(noInsertTarget bool, finalInsertTargets []string, relationAttrs []string, rows []tree.Exprs, defaultExprs map[string]tree.Expr) ([]tree.Exprs, []string, error) {
@@ -820,6 +1073,10 @@ func buildConstant(typ types.Type, n tree.Expr) (interface{}, error) {
 		if err != nil {
 			return nil, err
 		}
+		// the result is null if any operand is null
+		if left == nil || right == nil {
+			return nil, nil
+		}
 		// evaluate the result and make sure binary result is within range of float64.
 		lf, rf := left.(float64), right.(float64)
 		switch e.Op {
@@ -985,7 +1242,8 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 				}
 			}
 			return res, nil
-		case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+			types.T_enum, types.T_set, types.T_bit:
 			v, _ := constant.Uint64Val(val)
 			if num.Negative() {
 				if v != 0 {
@@ -1089,6 +1347,10 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 		return nil, fmt.Errorf("incorrect %s value: '%s'", typ.Oid.String(), str)
 	case constant.String:
 		switch typ.Oid {
+		case types.T_enum, types.T_set:
+			return str, nil
+		case types.T_bit:
+			return types.ParseBitBytes([]byte(str), typ.Width)
 		case types.T_bool:
 			switch strings.ToLower(str) {
 			case "false":
@@ -1176,8 +1438,17 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 		}
 		if !num.Negative() {
 			switch typ.Oid {
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_text,
+				types.T_binary, types.T_varbinary, types.T_blob:
 				return str, nil
+			case types.T_json:
+				res, err := types.ParseByteJson([]byte(str))
+				if err != nil {
+					return nil, fmt.Errorf("invalid JSON text: '%s'", str)
+				}
+				return res, nil
+			case types.T_uuid:
+				return types.ParseUuid(str)
 			case types.T_date:
 				res, err := types.ParseDate(str)
 				if err != nil {
@@ -1243,6 +1514,10 @@ func rangeCheck(value interface{}, typ types.Type, columnName string, rowNumber
 			}
 		case types.T_uint64:
 			return v, nil
+		case types.T_bit:
+			if types.CheckBit(v, typ.Width) == nil {
+				return v, nil
+			}
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 		}
@@ -1266,13 +1541,17 @@ func rangeCheck(value interface{}, typ types.Type, columnName string, rowNumber
 		return nil, errors.New(errno.DataException, fmt.Sprintf(errString, columnName, rowNumber))
 	case string:
 		switch typ.Oid {
-		case types.T_char, types.T_varchar: // string family should compare the length but not value
+		case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary: // string family should compare the length but not value
 			if len(v) > math.MaxUint16 {
 				return nil, errors.New(errno.DataException, "length out of uint16 is unexpected for char / varchar value")
 			}
 			if len(v) <= int(typ.Width) {
 				return v, nil
 			}
+		case types.T_text, types.T_blob: // the width of the lob types is the max length, like 65535 for text and blob
+			if len(v) <= int(typ.Width) {
+				return v, nil
+			}
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 		}
@@ -0,0 +1,160 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/foreignkey"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// tableConstraints checks the rows written by INSERT ... VALUES and LOAD DATA
+// against the NOT NULL columns, the CHECK constraints and the foreign keys of
+// the table
+type tableConstraints struct {
+	tblName string
+	// cols are the columns of the table, the check expressions refer to them
+	// by position
+	cols    []string
+	notNull map[string]bool
+	names   []string
+	checks  []*plan2.Expr
+	// foreignKeys is nil if foreign_key_checks is off
+	foreignKeys *foreignkey.Checker
+	// newProc makes the process evaluating the checks, the batches may be
+	// checked concurrently
+	newProc func() *process.Process
+}
+
+// getTableConstraints returns nil if the table has no constraint to check
+func (mce *MysqlCmdExecutor) getTableConstraints(dbName, tblName string, relation engine.Relation, snapshot engine.Snapshot) (*tableConstraints, error) {
+	ses := mce.GetSession()
+	tableDef := engineDefsToTableDef(tblName, relation.TableDefs(snapshot))
+	c := &tableConstraints{tblName: tblName, notNull: make(map[string]bool)}
+	for _, col := range tableDef.Cols {
+		c.cols = append(c.cols, col.Name)
+		// the auto increment column gets a value for null
+		if (col.Primary || col.NotNull) && !col.AutoIncr {
+			c.notNull[col.Name] = true
+		}
+	}
+	for _, def := range tableDef.Defs {
+		if check := def.GetCheck(); check != nil {
+			c.names = append(c.names, check.Name)
+		}
+	}
+	checks, err := plan2.BuildCheckExprs(ses.GetTxnCompilerContext(), tableDef)
+	if err != nil {
+		return nil, err
+	}
+	if ses.ForeignKeyChecks() {
+		db, err := ses.Pu.StorageEngine.Database(dbName, snapshot)
+		if err != nil {
+			return nil, err
+		}
+		c.foreignKeys = foreignkey.New(db, dbName, snapshot)
+	}
+	if len(c.notNull) == 0 && len(checks) == 0 && c.foreignKeys == nil {
+		return nil, nil
+	}
+	c.checks = checks
+	c.newProc = func() *process.Process {
+		return process.New(mheap.New(ses.GuestMmu))
+	}
+	return c, nil
+}
+
+// check returns the error of the first constraint violated by the rows of
+// the batch. A row violates a CHECK constraint only if the result is false.
+func (c *tableConstraints) check(bat *batch.Batch) error {
+	if c == nil || len(bat.Vecs) == 0 {
+		return nil
+	}
+	for i, attr := range bat.Attrs {
+		if c.notNull[attr] && nulls.Any(bat.Vecs[i].Nsp) {
+			return moerr.NewBadNullValue(attr)
+		}
+	}
+	if err := c.foreignKeys.CheckInsert(c.tblName, bat); err != nil {
+		return err
+	}
+	if len(c.checks) == 0 {
+		return nil
+	}
+
+	// arrange the vectors in the order of the table columns
+	rows := vector.Length(bat.Vecs[0])
+	evalBat := batch.New(true, c.cols)
+	evalBat.Zs = make([]int64, rows)
+	for i := range evalBat.Zs {
+		evalBat.Zs[i] = 1
+	}
+	for i, col := range c.cols {
+		for j, attr := range bat.Attrs {
+			if attr == col {
+				evalBat.Vecs[i] = bat.Vecs[j]
+			}
+		}
+	}
+	proc := c.newProc()
+	for i, expr := range c.checks {
+		vec, err := colexec.EvalExpr(evalBat, proc, expr)
+		if err != nil {
+			return err
+		}
+		violated := false
+		if !vec.IsScalarNull() {
+			for j, ok := range vector.MustTCols[bool](vec) {
+				if !ok && !nulls.Contains(vec.Nsp, uint64(j)) {
+					violated = true
+					break
+				}
+			}
+		}
+		if !isBatchVector(bat, vec) {
+			vector.Clean(vec, proc.Mp)
+		}
+		if violated {
+			return moerr.NewCheckViolation(c.names[i])
+		}
+	}
+	return nil
+}
+
+// onUpdate applies the foreign keys to the stored rows updated, the i-th row of
+// the batch holds the new values of the row whose column keyName takes the
+// i-th value of keys
+func (c *tableConstraints) onUpdate(ts uint64, keyName string, keys *vector.Vector, bat *batch.Batch) error {
+	if c == nil {
+		return nil
+	}
+	return c.foreignKeys.OnUpdate(ts, c.tblName, keyName, keys, bat)
+}
+
+func isBatchVector(bat *batch.Batch, vec *vector.Vector) bool {
+	for _, v := range bat.Vecs {
+		if v == vec {
+			return true
+		}
+	}
+	return false
+}
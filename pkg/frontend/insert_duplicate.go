@@ -0,0 +1,415 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// duplicateKey is the primary key or a unique key of the table
+type duplicateKey struct {
+	name string
+	cols []int
+}
+
+// duplicateRow is a row of the table. The values are nil for nulls
+type duplicateRow struct {
+	values []interface{}
+	// hiddenKey is the hidden key of the stored row, nil if the row is not written yet
+	hiddenKey interface{}
+}
+
+// duplicateKeyUpdater applies the ON DUPLICATE KEY UPDATE clause of an insert.
+// A row duplicating a key of a stored or a former row updates that row instead
+type duplicateKeyUpdater struct {
+	plan    *InsertValues
+	attrs   []string
+	types   []types.Type
+	attrIdx map[string]int
+	keys    []duplicateKey
+	indexes []map[string]*duplicateRow
+	// deletes are the hidden keys of the stored rows being updated, and
+	// updates are the rows written for them
+	deletes []interface{}
+	updates []*duplicateRow
+	// writes are the rows inserted or updated, in order
+	writes []*duplicateRow
+}
+
+func newDuplicateKeyUpdater(plan *InsertValues, snapshot engine.Snapshot) *duplicateKeyUpdater {
+	bat := plan.dataBatch
+	u := &duplicateKeyUpdater{
+		plan:    plan,
+		attrs:   bat.Attrs,
+		types:   make([]types.Type, len(bat.Vecs)),
+		attrIdx: make(map[string]int, len(bat.Attrs)),
+	}
+	for i, attr := range bat.Attrs {
+		u.types[i] = bat.Vecs[i].Typ
+		u.attrIdx[attr] = i
+	}
+	addKey := func(name string, colNames []string) {
+		key := duplicateKey{name: name, cols: make([]int, len(colNames))}
+		for i, colName := range colNames {
+			idx, ok := u.attrIdx[colName]
+			if !ok {
+				return
+			}
+			key.cols[i] = idx
+		}
+		u.keys = append(u.keys, key)
+		u.indexes = append(u.indexes, make(map[string]*duplicateRow))
+	}
+	defs := plan.relation.TableDefs(snapshot)
+	for _, def := range defs {
+		if pk, ok := def.(*engine.PrimaryIndexDef); ok && len(pk.Names) > 0 {
+			addKey("PRIMARY", pk.Names)
+		}
+	}
+	for _, def := range defs {
+		if idx, ok := def.(*engine.IndexTableDef); ok && idx.Typ == engine.Unique {
+			addKey(idx.Name, idx.ColNames)
+		}
+	}
+	return u
+}
+
+// handleOnDuplicateKeyUpdate writes the rows of the insert and returns the
+// affected rows: 1 for every row inserted, 2 for every row updated
+func handleOnDuplicateKeyUpdate(exprs tree.UpdateExprs, plan *InsertValues, ts uint64, snapshot engine.Snapshot) (uint64, error) {
+	bat := plan.dataBatch
+	rows := 0
+	if len(bat.Vecs) > 0 {
+		rows = vector.Length(bat.Vecs[0])
+	}
+	u := newDuplicateKeyUpdater(plan, snapshot)
+	if err := u.checkUpdateExprs(exprs); err != nil {
+		return 0, err
+	}
+	if len(u.keys) == 0 || rows == 0 {
+		if err := plan.constraints.check(bat); err != nil {
+			return 0, err
+		}
+		if err := plan.relation.Write(ts, bat, snapshot); err != nil {
+			return 0, err
+		}
+		return uint64(rows), nil
+	}
+	if err := u.loadStoredRows(snapshot); err != nil {
+		return 0, err
+	}
+	var affected uint64
+	for i := 0; i < rows; i++ {
+		row := &duplicateRow{values: make([]interface{}, len(bat.Vecs))}
+		for j, vec := range bat.Vecs {
+			row.values[j] = vector.GetValue(vec, i)
+		}
+		dup, _ := u.findDuplicate(row, nil)
+		if dup == nil {
+			u.addKeys(row)
+			u.writes = append(u.writes, row)
+			affected++
+			continue
+		}
+		values, err := u.updateValues(dup, row, exprs)
+		if err != nil {
+			return 0, err
+		}
+		if reflect.DeepEqual(values, dup.values) {
+			continue
+		}
+		if err = u.replace(dup, values); err != nil {
+			return 0, err
+		}
+		affected += 2
+	}
+	return affected, u.flush(ts, snapshot)
+}
+
+func (u *duplicateKeyUpdater) checkUpdateExprs(exprs tree.UpdateExprs) error {
+	for _, expr := range exprs {
+		if expr.Tuple || len(expr.Names) != 1 {
+			return NewMysqlError(ER_NOT_SUPPORTED_YET, "tuple assignment in ON DUPLICATE KEY UPDATE")
+		}
+		if _, ok := u.attrIdx[expr.Names[0].Parts[0]]; !ok {
+			return NewMysqlError(ER_BAD_FIELD_ERROR, expr.Names[0].Parts[0], "field list")
+		}
+	}
+	return nil
+}
+
+// loadStoredRows reads the stored rows duplicating a key of the rows to
+// insert into the key indexes. The whole table is read if the relation can't
+// look up the keys
+func (u *duplicateKeyUpdater) loadStoredRows(snapshot engine.Snapshot) error {
+	hiddenKey := u.plan.relation.GetHideKey(snapshot)
+	attrs := append(append([]string{}, u.attrs...), hiddenKey.Name)
+	rel, ok := u.plan.relation.(engine.KeyRelation)
+	if !ok {
+		return u.readStoredRows(u.plan.relation.NewReader(1, nil, nil, snapshot), attrs, nil)
+	}
+	// A row may duplicate several keys, it is loaded once
+	loaded := make(map[interface{}]struct{})
+	for _, key := range u.keys {
+		keys := batch.New(true, make([]string, len(key.cols)))
+		for i, col := range key.cols {
+			keys.Attrs[i] = u.attrs[col]
+			keys.Vecs[i] = u.plan.dataBatch.Vecs[col]
+		}
+		readers, err := rel.NewKeyReader(1, keys, snapshot)
+		if err != nil {
+			return err
+		}
+		if err = u.readStoredRows(readers, attrs, loaded); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readStoredRows reads the rows into the key indexes. The rows whose hidden
+// key is in loaded are skipped if loaded is not nil
+func (u *duplicateKeyUpdater) readStoredRows(readers []engine.Reader, attrs []string, loaded map[interface{}]struct{}) error {
+	refCnts := make([]uint64, len(attrs))
+	for i := range refCnts {
+		refCnts[i] = 1
+	}
+	for _, reader := range readers {
+		for {
+			bat, err := reader.Read(refCnts, attrs)
+			if err != nil {
+				return err
+			}
+			if bat == nil {
+				break
+			}
+			n := vector.Length(bat.Vecs[0])
+			for i := 0; i < n; i++ {
+				row := &duplicateRow{values: make([]interface{}, len(u.attrs))}
+				row.hiddenKey = vector.GetValue(bat.Vecs[len(u.attrs)], i)
+				if loaded != nil {
+					if _, ok := loaded[row.hiddenKey]; ok {
+						continue
+					}
+					loaded[row.hiddenKey] = struct{}{}
+				}
+				for j := range u.attrs {
+					row.values[j] = vector.GetValue(bat.Vecs[j], i)
+				}
+				u.addKeys(row)
+			}
+		}
+	}
+	return nil
+}
+
+// encodeKey returns the string of the key of the row, false if any part is null
+func (u *duplicateKeyUpdater) encodeKey(key duplicateKey, values []interface{}) (string, bool) {
+	var b strings.Builder
+	for _, col := range key.cols {
+		if values[col] == nil {
+			return "", false
+		}
+		part := makeExprFromVal(u.types[col], values[col], false).String()
+		b.WriteString(strconv.Itoa(len(part)))
+		b.WriteByte(':')
+		b.WriteString(part)
+	}
+	return b.String(), true
+}
+
+// findDuplicate returns the first row other than self duplicating a key of
+// the row, and the index of the key
+func (u *duplicateKeyUpdater) findDuplicate(row, self *duplicateRow) (*duplicateRow, int) {
+	for i, key := range u.keys {
+		k, ok := u.encodeKey(key, row.values)
+		if !ok {
+			continue
+		}
+		if dup, ok := u.indexes[i][k]; ok && dup != self {
+			return dup, i
+		}
+	}
+	return nil, -1
+}
+
+func (u *duplicateKeyUpdater) addKeys(row *duplicateRow) {
+	for i, key := range u.keys {
+		if k, ok := u.encodeKey(key, row.values); ok {
+			u.indexes[i][k] = row
+		}
+	}
+}
+
+func (u *duplicateKeyUpdater) removeKeys(row *duplicateRow) {
+	for i, key := range u.keys {
+		if k, ok := u.encodeKey(key, row.values); ok && u.indexes[i][k] == row {
+			delete(u.indexes[i], k)
+		}
+	}
+}
+
+// updateValues evaluates the assignments on the duplicated row. The columns
+// refer to the values of the duplicated row updated by the former assignments,
+// and VALUES(col) refers to the value of the row to insert
+func (u *duplicateKeyUpdater) updateValues(dup, row *duplicateRow, exprs tree.UpdateExprs) ([]interface{}, error) {
+	curr := make(tree.Exprs, len(u.attrs))
+	for i, v := range dup.values {
+		curr[i] = makeExprFromVal(u.types[i], v, v == nil)
+	}
+	for _, expr := range exprs {
+		e, err := u.substitute(expr.Expr, curr, row)
+		if err != nil {
+			return nil, err
+		}
+		curr[u.attrIdx[expr.Names[0].Parts[0]]] = e
+	}
+	bat := batch.New(true, u.attrs)
+	for i := range bat.Vecs {
+		bat.Vecs[i] = vector.New(u.types[i])
+	}
+	if err := fillInsertValues(bat, []tree.Exprs{curr}, u.plan.enumValues); err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(u.attrs))
+	for i, vec := range bat.Vecs {
+		values[i] = vector.GetValue(vec, 0)
+	}
+	return values, nil
+}
+
+func (u *duplicateKeyUpdater) substitute(expr tree.Expr, curr tree.Exprs, row *duplicateRow) (tree.Expr, error) {
+	var err error
+	switch e := expr.(type) {
+	case *tree.UnresolvedName:
+		idx, ok := u.attrIdx[e.Parts[0]]
+		if !ok {
+			return nil, NewMysqlError(ER_BAD_FIELD_ERROR, e.Parts[0], "field list")
+		}
+		return &tree.ParenExpr{Expr: curr[idx]}, nil
+	case *tree.FuncExpr:
+		name, ok := e.Func.FunctionReference.(*tree.UnresolvedName)
+		if !ok || !strings.EqualFold(name.Parts[0], "values") || len(e.Exprs) != 1 {
+			return expr, nil
+		}
+		col, ok := e.Exprs[0].(*tree.UnresolvedName)
+		if !ok {
+			return expr, nil
+		}
+		idx, ok := u.attrIdx[col.Parts[0]]
+		if !ok {
+			return nil, NewMysqlError(ER_BAD_FIELD_ERROR, col.Parts[0], "field list")
+		}
+		v := row.values[idx]
+		return makeExprFromVal(u.types[idx], v, v == nil), nil
+	case *tree.ParenExpr:
+		n := *e
+		n.Expr, err = u.substitute(e.Expr, curr, row)
+		return &n, err
+	case *tree.UnaryExpr:
+		n := *e
+		n.Expr, err = u.substitute(e.Expr, curr, row)
+		return &n, err
+	case *tree.BinaryExpr:
+		n := *e
+		if n.Left, err = u.substitute(e.Left, curr, row); err != nil {
+			return nil, err
+		}
+		n.Right, err = u.substitute(e.Right, curr, row)
+		return &n, err
+	}
+	return expr, nil
+}
+
+// replace updates the values of the duplicated row. A stored row is deleted
+// and written again
+func (u *duplicateKeyUpdater) replace(dup *duplicateRow, values []interface{}) error {
+	u.removeKeys(dup)
+	row := dup
+	if dup.hiddenKey != nil {
+		u.deletes = append(u.deletes, dup.hiddenKey)
+		row = &duplicateRow{}
+		u.writes = append(u.writes, row)
+		u.updates = append(u.updates, row)
+	}
+	row.values = values
+	if other, i := u.findDuplicate(row, row); other != nil {
+		key := u.keys[i]
+		parts := make([]string, len(key.cols))
+		for j, col := range key.cols {
+			parts[j] = makeExprFromVal(u.types[col], values[col], false).String()
+		}
+		return NewMysqlError(ER_DUP_ENTRY, strings.Join(parts, "-"), u.plan.tblName+"."+key.name)
+	}
+	u.addKeys(row)
+	return nil
+}
+
+// flush deletes the stored rows updated and writes the rows inserted or updated
+func (u *duplicateKeyUpdater) flush(ts uint64, snapshot engine.Snapshot) error {
+	relation := u.plan.relation
+	bat, err := u.makeBatch(u.writes)
+	if err != nil {
+		return err
+	}
+	if err := u.plan.constraints.check(bat); err != nil {
+		return err
+	}
+	if len(u.deletes) > 0 {
+		hiddenKey := relation.GetHideKey(snapshot)
+		vec := vector.New(hiddenKey.Type)
+		if err := vector.AppendValues(vec, u.deletes); err != nil {
+			return err
+		}
+		updates, err := u.makeBatch(u.updates)
+		if err != nil {
+			return err
+		}
+		if err := u.plan.constraints.onUpdate(ts, hiddenKey.Name, vec, updates); err != nil {
+			return err
+		}
+		if err := relation.Delete(ts, vec, hiddenKey.Name, snapshot); err != nil {
+			return err
+		}
+	}
+	if len(u.writes) == 0 {
+		return nil
+	}
+	return relation.Write(ts, bat, snapshot)
+}
+
+// makeBatch returns the batch of the rows
+func (u *duplicateKeyUpdater) makeBatch(rows []*duplicateRow) (*batch.Batch, error) {
+	bat := batch.New(true, u.attrs)
+	for i := range bat.Vecs {
+		bat.Vecs[i] = vector.New(u.types[i])
+		values := make([]interface{}, len(rows))
+		for j, row := range rows {
+			values[j] = row.values[i]
+		}
+		if err := vector.AppendValues(bat.Vecs[i], values); err != nil {
+			return nil, err
+		}
+	}
+	return bat, nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func makeInt64Batch(attrs []string, rows ...[]int64) *batch.Batch {
+	bat := batch.New(true, attrs)
+	for i := range bat.Vecs {
+		bat.Vecs[i] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		vs := make([]int64, len(rows))
+		for j, row := range rows {
+			vs[j] = row[i]
+		}
+		_ = vector.Append(bat.Vecs[i], vs)
+	}
+	return bat
+}
+
+func Test_handleOnDuplicateKeyUpdate(t *testing.T) {
+	convey.Convey("handleOnDuplicateKeyUpdate succ", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		int64Type := types.Type{Oid: types.T_int64, Size: 8}
+		defs := []engine.TableDef{
+			&engine.AttributeDef{Attr: engine.Attribute{Name: "a", Type: int64Type, Primary: true}},
+			&engine.AttributeDef{Attr: engine.Attribute{Name: "b", Type: int64Type}},
+			&engine.AttributeDef{Attr: engine.Attribute{Name: "c", Type: int64Type}},
+			&engine.PrimaryIndexDef{Names: []string{"a"}},
+			&engine.IndexTableDef{Typ: engine.Unique, Name: "b", ColNames: []string{"b"}},
+		}
+		stored := makeInt64Batch([]string{"a", "b", "c", "PADDR"}, []int64{1, 10, 100, 0}, []int64{2, 20, 200, 1})
+		reader := mock_frontend.NewMockReader(ctrl)
+		reader.EXPECT().Read(gomock.Any(), gomock.Any()).Return(stored, nil).Times(1)
+		reader.EXPECT().Read(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+
+		var written *batch.Batch
+		var deleted *vector.Vector
+		rel := mock_frontend.NewMockRelation(ctrl)
+		rel.EXPECT().TableDefs(nil).Return(defs).AnyTimes()
+		rel.EXPECT().GetHideKey(nil).Return(&engine.Attribute{Name: "PADDR", Type: int64Type}).AnyTimes()
+		rel.EXPECT().NewReader(1, nil, nil, nil).Return([]engine.Reader{reader}).Times(1)
+		rel.EXPECT().Delete(gomock.Any(), gomock.Any(), "PADDR", nil).DoAndReturn(
+			func(_ uint64, vec *vector.Vector, _ string, _ engine.Snapshot) error {
+				deleted = vec
+				return nil
+			}).Times(1)
+		rel.EXPECT().Write(gomock.Any(), gomock.Any(), nil).DoAndReturn(
+			func(_ uint64, bat *batch.Batch, _ engine.Snapshot) error {
+				written = bat
+				return nil
+			}).Times(1)
+
+		stmt, err := parsers.ParseOne(dialect.MYSQL, "insert into t values (1, 30, 0), (3, 40, 0), (4, 20, 5) on duplicate key update c = c + values(c) + 1")
+		convey.So(err, convey.ShouldBeNil)
+		plan := &InsertValues{
+			tblName:   "t",
+			relation:  rel,
+			dataBatch: makeInt64Batch([]string{"a", "b", "c"}, []int64{1, 30, 0}, []int64{3, 40, 0}, []int64{4, 20, 5}),
+		}
+		affected, err := handleOnDuplicateKeyUpdate(stmt.(*tree.Insert).OnDuplicateUpdate, plan, 0, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(affected, convey.ShouldEqual, 5)
+		convey.So(vector.GetColumn[int64](deleted), convey.ShouldResemble, []int64{0, 1})
+		convey.So(vector.GetColumn[int64](written.Vecs[0]), convey.ShouldResemble, []int64{1, 3, 2})
+		convey.So(vector.GetColumn[int64](written.Vecs[1]), convey.ShouldResemble, []int64{10, 40, 20})
+		convey.So(vector.GetColumn[int64](written.Vecs[2]), convey.ShouldResemble, []int64{101, 0, 206})
+	})
+
+	convey.Convey("handleOnDuplicateKeyUpdate duplicate entry", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		int64Type := types.Type{Oid: types.T_int64, Size: 8}
+		defs := []engine.TableDef{
+			&engine.AttributeDef{Attr: engine.Attribute{Name: "a", Type: int64Type}},
+			&engine.AttributeDef{Attr: engine.Attribute{Name: "b", Type: int64Type}},
+			&engine.IndexTableDef{Typ: engine.Unique, Name: "a", ColNames: []string{"a"}},
+			&engine.IndexTableDef{Typ: engine.Unique, Name: "b", ColNames: []string{"b"}},
+		}
+		stored := makeInt64Batch([]string{"a", "b", "PADDR"}, []int64{1, 10, 0}, []int64{2, 20, 1})
+		reader := mock_frontend.NewMockReader(ctrl)
+		reader.EXPECT().Read(gomock.Any(), gomock.Any()).Return(stored, nil).Times(1)
+		reader.EXPECT().Read(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+		rel := mock_frontend.NewMockRelation(ctrl)
+		rel.EXPECT().TableDefs(nil).Return(defs).AnyTimes()
+		rel.EXPECT().GetHideKey(nil).Return(&engine.Attribute{Name: "PADDR", Type: int64Type}).AnyTimes()
+		rel.EXPECT().NewReader(1, nil, nil, nil).Return([]engine.Reader{reader}).Times(1)
+
+		stmt, err := parsers.ParseOne(dialect.MYSQL, "insert into t values (1, 30) on duplicate key update b = 20")
+		convey.So(err, convey.ShouldBeNil)
+		plan := &InsertValues{
+			tblName:   "t",
+			relation:  rel,
+			dataBatch: makeInt64Batch([]string{"a", "b"}, []int64{1, 30}),
+		}
+		_, err = handleOnDuplicateKeyUpdate(stmt.(*tree.Insert).OnDuplicateUpdate, plan, 0, nil)
+		convey.So(err, convey.ShouldNotBeNil)
+		convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, ER_DUP_ENTRY)
+		convey.So(err.Error(), convey.ShouldEqual, "Duplicate entry '20' for key 't.b'")
+	})
+}
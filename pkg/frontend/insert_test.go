@@ -19,10 +19,14 @@ import (
 	"go/constant"
 	"math"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -650,8 +654,34 @@ func Test_makeExprFromVal(t *testing.T) {
 		convey.So(tmp.Negative(), convey.ShouldBeFalse)
 	})
 
-	convey.Convey("makeExprFromVal decimal128", t, func() {
+	convey.Convey("makeExprFromVal json", t, func() {
 		typ.Oid = types.T_json
+		bj, err := types.ParseByteJson([]byte(`{"b": [1, "x"], "a": null}`))
+		convey.So(err, convey.ShouldBeNil)
+		value = bj.Encode()
+		ret = makeExprFromVal(typ, value, isNull)
+		tmp, ok := ret.(*tree.NumVal)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(tmp.Value, convey.ShouldResemble, constant.MakeString(`{"a": null, "b": [1, "x"]}`))
+		convey.So(tmp.String(), convey.ShouldEqual, `{"a": null, "b": [1, "x"]}`)
+		convey.So(tmp.Negative(), convey.ShouldBeFalse)
+	})
+
+	convey.Convey("makeExprFromVal enum", t, func() {
+		typ.Oid = types.T_enum
+		ret = makeExprFromVal(typ, uint16(2), isNull)
+		tmp, ok := ret.(*tree.NumVal)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(tmp.String(), convey.ShouldEqual, "2")
+
+		ret = makeExprFromVal(typ, "b", isNull)
+		tmp, ok = ret.(*tree.NumVal)
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(tmp.Value, convey.ShouldResemble, constant.MakeString("b"))
+	})
+
+	convey.Convey("makeExprFromVal unknown", t, func() {
+		typ.Oid = types.T_tuple
 		ret = makeExprFromVal(typ, value, isNull)
 		tmp, ok := ret.(*tree.NumVal)
 		convey.So(ok, convey.ShouldBeTrue)
@@ -661,6 +691,79 @@ func Test_makeExprFromVal(t *testing.T) {
 	})
 }
 
+func Test_fillInsertValuesEnum(t *testing.T) {
+	convey.Convey("fillInsertValues enum, set and bit", t, func() {
+		newBatch := func() *batch.Batch {
+			bat := batch.New(true, []string{"e", "s", "b"})
+			bat.Vecs[0] = vector.New(types.T_enum.ToType())
+			bat.Vecs[1] = vector.New(types.T_set.ToType())
+			bat.Vecs[2] = vector.New(types.Type{Oid: types.T_bit, Size: 8, Width: 4})
+			return bat
+		}
+		str := func(s string) tree.Expr {
+			return tree.NewNumVal(constant.MakeString(s), s, false)
+		}
+		num := func(v uint64) tree.Expr {
+			return tree.NewNumVal(constant.MakeUint64(v), strconv.FormatUint(v, 10), false)
+		}
+		enumValues := map[string]string{"e": "x,y", "s": "a,b,c"}
+
+		bat := newBatch()
+		rows := []tree.Exprs{
+			{str("Y"), str("c,a"), num(5)},
+			{num(1), num(2), tree.NewNumVal(constant.MakeUnknown(), "NULL", false)},
+		}
+		err := fillInsertValues(bat, rows, enumValues)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(bat.Vecs[0].Col, convey.ShouldResemble, []uint16{2, 1})
+		convey.So(bat.Vecs[1].Col, convey.ShouldResemble, []uint64{5, 2})
+		convey.So(bat.Vecs[2].Col.([]uint64)[0], convey.ShouldEqual, 5)
+		convey.So(nulls.Contains(bat.Vecs[2].Nsp, 1), convey.ShouldBeTrue)
+
+		for _, row := range []tree.Exprs{
+			{str("z"), str("a"), num(1)},
+			{num(3), str("a"), num(1)},
+			{str("x"), str("a,d"), num(1)},
+			{str("x"), str("a"), num(16)},
+		} {
+			err = fillInsertValues(newBatch(), []tree.Exprs{row}, enumValues)
+			convey.So(err, convey.ShouldNotBeNil)
+		}
+	})
+}
+
+func Test_fillInsertValuesLob(t *testing.T) {
+	convey.Convey("fillInsertValues text and blob over 64 KB", t, func() {
+		newBatch := func(width int32) *batch.Batch {
+			bat := batch.New(true, []string{"t", "b"})
+			bat.Vecs[0] = vector.New(types.Type{Oid: types.T_text, Size: 24, Width: width})
+			bat.Vecs[1] = vector.New(types.Type{Oid: types.T_blob, Size: 24, Width: width})
+			return bat
+		}
+		str := func(s string) tree.Expr {
+			return tree.NewNumVal(constant.MakeString(s), s, false)
+		}
+		large := strings.Repeat("x", 100*1024)
+
+		//mediumtext and mediumblob
+		bat := newBatch(1<<24 - 1)
+		err := fillInsertValues(bat, []tree.Exprs{{str(large), str(large)}}, nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(string(bat.Vecs[0].Col.(*types.Bytes).Get(0)), convey.ShouldEqual, large)
+		convey.So(string(bat.Vecs[1].Col.(*types.Bytes).Get(0)), convey.ShouldEqual, large)
+
+		//longtext and longblob
+		err = fillInsertValues(newBatch(math.MaxInt32), []tree.Exprs{{str(large), str(large)}}, nil)
+		convey.So(err, convey.ShouldBeNil)
+
+		//text and blob keep 65535 bytes at most
+		err = fillInsertValues(newBatch(math.MaxUint16), []tree.Exprs{{str(large), str("")}}, nil)
+		convey.So(err, convey.ShouldNotBeNil)
+		err = fillInsertValues(newBatch(math.MaxUint16), []tree.Exprs{{str(""), str(large)}}, nil)
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
 func Test_rewriteInsertRows(t *testing.T) {
 	var noInsertTarget = true
 	var finalInsertTargets []string
@@ -1606,6 +1709,19 @@ func Test_rangeCheck(t *testing.T) {
 		convey.So(ret, convey.ShouldBeNil)
 		convey.So(err, convey.ShouldNotBeNil)
 
+		//the lob types are checked by their widths
+		value = strings.Repeat("x", math.MaxUint16+1)
+		typ = types.Type{Oid: types.T_text, Width: 1<<24 - 1}
+		ret, err = rangeCheck(value, typ, columnName, rowNumber)
+		convey.So(ret, convey.ShouldEqual, value)
+		convey.So(err, convey.ShouldBeNil)
+
+		typ = types.Type{Oid: types.T_blob, Width: math.MaxUint16}
+		ret, err = rangeCheck(value, typ, columnName, rowNumber)
+		convey.So(ret, convey.ShouldBeNil)
+		convey.So(err, convey.ShouldNotBeNil)
+		value = "123"
+
 		typ = types.Type{Oid: types.T_bool}
 		ret, err = rangeCheck(value, typ, columnName, rowNumber)
 		convey.So(ret, convey.ShouldBeNil)
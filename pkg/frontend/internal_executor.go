@@ -78,6 +78,7 @@ func (ie *internalExecutor) ApplySessionOverride(opts ie.SessionOverrideOptions)
 type internalProtocol struct {
 	database string
 	username string
+	userHost string
 }
 
 func (ip *internalProtocol) IsEstablished() bool {
@@ -121,6 +122,14 @@ func (ip *internalProtocol) SetUserName(username string) {
 	ip.username = username
 }
 
+func (ip *internalProtocol) GetUserHost() string {
+	return ip.userHost
+}
+
+func (ip *internalProtocol) SetUserHost(userHost string) {
+	ip.userHost = userHost
+}
+
 func (ip *internalProtocol) Quit() {}
 
 //the server send group row of the result set as an independent packet thread safe
@@ -132,6 +141,18 @@ func (ip *internalProtocol) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSet
 	return nil
 }
 
+func (ip *internalProtocol) SendResultSetBinaryBatchRow(mrs *MysqlResultSet, cnt uint64) error {
+	return nil
+}
+
+func (ip *internalProtocol) SendPrepareResponse(stmtID uint32, paramCount int, columns []interface{}) error {
+	return nil
+}
+
+func (ip *internalProtocol) ParseExecuteData(stmt *PrepareStmt, data []byte) ([]interface{}, error) {
+	return nil, nil
+}
+
 //SendColumnDefinitionPacket the server send the column definition to the client
 func (ip *internalProtocol) SendColumnDefinitionPacket(column Column, cmd int) error {
 	return nil
@@ -35,6 +35,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/incrservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -116,6 +117,10 @@ type SharePart struct {
 	tableName      string
 	txnHandler     *TxnHandler
 	oneTxnPerBatch bool
+	//allocates the values of the auto increment column
+	incrService *incrservice.Service
+	//checks the rows before they are written
+	constraints *tableConstraints
 
 	//result of load
 	result *LoadResult
@@ -374,17 +379,18 @@ func makeBatch(handler *ParseLineHandler, id int) *PoolElement {
 			vec.Col = make([]int64, batchSize)
 		case types.T_uint8:
 			vec.Col = make([]uint8, batchSize)
-		case types.T_uint16:
+		case types.T_uint16, types.T_enum:
 			vec.Col = make([]uint16, batchSize)
 		case types.T_uint32:
 			vec.Col = make([]uint32, batchSize)
-		case types.T_uint64:
+		case types.T_uint64, types.T_set, types.T_bit:
 			vec.Col = make([]uint64, batchSize)
 		case types.T_float32:
 			vec.Col = make([]float32, batchSize)
 		case types.T_float64:
 			vec.Col = make([]float64, batchSize)
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_text,
+			types.T_binary, types.T_varbinary, types.T_blob, types.T_json:
 			vBytes := &types.Bytes{
 				Offsets: make([]uint32, batchSize),
 				Lengths: make([]uint32, batchSize),
@@ -399,6 +405,8 @@ func makeBatch(handler *ParseLineHandler, id int) *PoolElement {
 			vec.Col = make([]types.Decimal64, batchSize)
 		case types.T_decimal128:
 			vec.Col = make([]types.Decimal128, batchSize)
+		case types.T_uuid:
+			vec.Col = make([]types.Uuid, batchSize)
 		case types.T_timestamp:
 			vec.Col = make([]types.Timestamp, batchSize)
 		default:
@@ -493,7 +501,8 @@ func releaseBatch(handler *ParseLineHandler, pl *PoolElement) {
 	for _, vec := range pl.bat.Vecs {
 		vec.Nsp = &nulls.Nulls{}
 		switch vec.Typ.Oid {
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_text,
+			types.T_binary, types.T_varbinary, types.T_blob, types.T_json:
 			vBytes := vec.Col.(*types.Bytes)
 			vBytes.Data = vBytes.Data[:0]
 		}
@@ -517,6 +526,7 @@ func initWriteBatchHandler(handler *ParseLineHandler, wHandler *WriteBatchHandle
 	wHandler.tableName = handler.tableName
 	wHandler.txnHandler = handler.txnHandler
 	wHandler.oneTxnPerBatch = handler.oneTxnPerBatch
+	wHandler.incrService = handler.incrService
 	wHandler.timestamp = handler.timestamp
 	wHandler.result = &LoadResult{}
 	wHandler.closeRef = handler.closeRef
@@ -535,6 +545,24 @@ func initWriteBatchHandler(handler *ParseLineHandler, wHandler *WriteBatchHandle
 	return nil
 }
 
+/*
+fillLoadAutoIncrement allocates the values of the auto increment column for the
+rows loading nulls or zeros into it
+*/
+func fillLoadAutoIncrement(handler *SharePart, bat *batch.Batch) error {
+	for i, col := range handler.cols {
+		if !col.Attr.AutoIncrement {
+			continue
+		}
+		if handler.incrService == nil {
+			return fmt.Errorf("auto increment column '%s' is not supported", col.Attr.Name)
+		}
+		_, err := handler.incrService.Fill(incrservice.Key(handler.dbName, handler.tableName, col.Attr.Name), bat.Vecs[i])
+		return err
+	}
+	return nil
+}
+
 func collectWriteBatchResult(handler *ParseLineHandler, wh *WriteBatchHandler, err error) {
 	//logutil.Infof("++++> %d %d %d %d",
 	//	wh.result.Skipped,
@@ -601,6 +629,29 @@ func isWriteBatchTimeoutError(err error) bool {
 	return errors.Is(err, context.DeadlineExceeded)
 }
 
+// parseEnumOrBitField returns the ordinal of ENUM, the bitmap of SET or the
+// value of BIT of a field. BIT is loaded from its bytes as mysql does, which is
+// how it is exported.
+func parseEnumOrBitField(attr engine.Attribute, field string) (uint64, error) {
+	members := types.SplitEnumMembers(attr.EnumValues)
+	switch attr.Type.Oid {
+	case types.T_enum:
+		d, err := types.ParseEnum(members, field)
+		return uint64(d), err
+	case types.T_set:
+		return types.ParseSet(members, field)
+	}
+	return types.ParseBitBytes([]byte(field), attr.Type.Width)
+}
+
+func setEnumOrBitValue(vec *vector.Vector, row int, d uint64) {
+	if vec.Typ.Oid == types.T_enum {
+		vec.Col.([]uint16)[row] = uint16(d)
+	} else {
+		vec.Col.([]uint64)[row] = d
+	}
+}
+
 func judgeInterge(field string) bool {
 	for i := 0; i < len(field); i++ {
 		if field[i] > '9' || field[i] < '0' {
@@ -984,7 +1035,8 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						}
 						cols[rowIdx] = d
 					}
-				case types.T_char, types.T_varchar:
+				case types.T_char, types.T_varchar, types.T_text,
+					types.T_binary, types.T_varbinary, types.T_blob:
 					vBytes := vec.Col.(*types.Bytes)
 					if isNullOrEmpty {
 						nulls.Add(vec.Nsp, uint64(rowIdx))
@@ -995,6 +1047,43 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						vBytes.Data = append(vBytes.Data, field...)
 						vBytes.Lengths[rowIdx] = uint32(len(field))
 					}
+				case types.T_enum, types.T_set, types.T_bit:
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						d, err := parseEnumOrBitField(handler.cols[colIdx].Attr, field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							nulls.Add(vec.Nsp, uint64(rowIdx))
+						} else {
+							setEnumOrBitValue(vec, rowIdx, d)
+						}
+					}
+				case types.T_json:
+					vBytes := vec.Col.(*types.Bytes)
+					vBytes.Offsets[rowIdx] = uint32(len(vBytes.Data))
+					vBytes.Lengths[rowIdx] = 0
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						bj, err := types.ParseByteJson([]byte(field))
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							nulls.Add(vec.Nsp, uint64(rowIdx))
+						} else {
+							v := bj.Encode()
+							vBytes.Data = append(vBytes.Data, v...)
+							vBytes.Lengths[rowIdx] = uint32(len(v))
+						}
+					}
 				case types.T_date:
 					cols := vec.Col.([]types.Date)
 					if isNullOrEmpty {
@@ -1069,6 +1158,22 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						}
 						cols[rowIdx] = d
 					}
+				case types.T_uuid:
+					cols := vec.Col.([]types.Uuid)
+					if isNullOrEmpty {
+						nulls.Add(vec.Nsp, uint64(rowIdx))
+					} else {
+						d, err := types.ParseUuid(field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return makeParsedFailedError(vec.Typ.String(), field, vecAttr, base, offset)
+							}
+							result.Warnings++
+							nulls.Add(vec.Nsp, uint64(rowIdx))
+						}
+						cols[rowIdx] = d
+					}
 				case types.T_timestamp:
 					cols := vec.Col.([]types.Timestamp)
 					if isNullOrEmpty {
@@ -1098,7 +1203,8 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 				if columnFLags[k] == 0 {
 					vec := batchData.Vecs[k]
 					switch vec.Typ.Oid {
-					case types.T_char, types.T_varchar:
+					case types.T_char, types.T_varchar, types.T_text,
+						types.T_binary, types.T_varbinary, types.T_blob, types.T_json:
 						vBytes := vec.Col.(*types.Bytes)
 						vBytes.Offsets[rowIdx] = uint32(len(vBytes.Data))
 						vBytes.Lengths[rowIdx] = uint32(0)
@@ -1474,7 +1580,8 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						cols[i] = d
 					}
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_text,
+				types.T_binary, types.T_varbinary, types.T_blob:
 				vBytes := vec.Col.(*types.Bytes)
 				//row
 				for i := 0; i < countOfLineArray; i++ {
@@ -1490,6 +1597,53 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						vBytes.Lengths[i] = uint32(len(field))
 					}
 				}
+			case types.T_enum, types.T_set, types.T_bit:
+				//row
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						d, err := parseEnumOrBitField(handler.cols[colIdx].Attr, field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							nulls.Add(vec.Nsp, uint64(i))
+						} else {
+							setEnumOrBitValue(vec, i, d)
+						}
+					}
+				}
+			case types.T_json:
+				vBytes := vec.Col.(*types.Bytes)
+				//row
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					vBytes.Offsets[i] = uint32(len(vBytes.Data))
+					vBytes.Lengths[i] = 0
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						bj, err := types.ParseByteJson([]byte(field))
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							nulls.Add(vec.Nsp, uint64(i))
+						} else {
+							v := bj.Encode()
+							vBytes.Data = append(vBytes.Data, v...)
+							vBytes.Lengths[i] = uint32(len(v))
+						}
+					}
+				}
 			case types.T_date:
 				cols := vec.Col.([]types.Date)
 				//row
@@ -1579,6 +1733,26 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 						cols[i] = d
 					}
 				}
+			case types.T_uuid:
+				cols := vec.Col.([]types.Uuid)
+				for i := 0; i < countOfLineArray; i++ {
+					line := fetchLines[i]
+					if j >= len(line) || len(line[j]) == 0 {
+						nulls.Add(vec.Nsp, uint64(i))
+					} else {
+						field := line[j]
+						d, err := types.ParseUuid(field)
+						if err != nil {
+							logutil.Errorf("parse field[%v] err:%v", field, err)
+							if !ignoreFieldError {
+								return err
+							}
+							result.Warnings++
+							nulls.Add(vec.Nsp, uint64(i))
+						}
+						cols[i] = d
+					}
+				}
 			case types.T_timestamp:
 				cols := vec.Col.([]types.Timestamp)
 				for i := 0; i < countOfLineArray; i++ {
@@ -1615,7 +1789,8 @@ func rowToColumnAndSaveToStorage(handler *WriteBatchHandler, forceConvert bool,
 				//row
 				for i := 0; i < countOfLineArray; i++ {
 					switch vec.Typ.Oid {
-					case types.T_char, types.T_varchar:
+					case types.T_char, types.T_varchar, types.T_text,
+						types.T_binary, types.T_varbinary, types.T_blob, types.T_json:
 						vBytes := vec.Col.(*types.Bytes)
 						vBytes.Offsets[i] = uint32(len(vBytes.Data))
 						vBytes.Lengths[i] = uint32(0)
@@ -1723,6 +1898,14 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 					goto handleError
 				}
 			}
+			err = fillLoadAutoIncrement(&handler.SharePart, handler.batchData)
+			if err != nil {
+				goto handleError
+			}
+			err = handler.constraints.check(handler.batchData)
+			if err != nil {
+				goto handleError
+			}
 			err = tableHandler.Write(handler.timestamp, handler.batchData, txnHandler.GetTxn().GetCtx())
 			if handler.oneTxnPerBatch {
 				if err != nil {
@@ -1764,7 +1947,8 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 		for _, vec := range handler.batchData.Vecs {
 			vec.Nsp = &nulls.Nulls{}
 			switch vec.Typ.Oid {
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_text,
+				types.T_binary, types.T_varbinary, types.T_blob, types.T_json:
 				vBytes := vec.Col.(*types.Bytes)
 				vBytes.Data = vBytes.Data[:0]
 			}
@@ -1804,13 +1988,13 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 					case types.T_uint8:
 						cols := vec.Col.([]uint8)
 						vec.Col = cols[:needLen]
-					case types.T_uint16:
+					case types.T_uint16, types.T_enum:
 						cols := vec.Col.([]uint16)
 						vec.Col = cols[:needLen]
 					case types.T_uint32:
 						cols := vec.Col.([]uint32)
 						vec.Col = cols[:needLen]
-					case types.T_uint64:
+					case types.T_uint64, types.T_set, types.T_bit:
 						cols := vec.Col.([]uint64)
 						vec.Col = cols[:needLen]
 					case types.T_float32:
@@ -1819,7 +2003,8 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 					case types.T_float64:
 						cols := vec.Col.([]float64)
 						vec.Col = cols[:needLen]
-					case types.T_char, types.T_varchar: //bytes is different
+					case types.T_char, types.T_varchar, types.T_text,
+						types.T_binary, types.T_varbinary, types.T_blob, types.T_json: //bytes is different
 						vBytes := vec.Col.(*types.Bytes)
 						//logutil.Infof("saveBatchToStorage before data %s ",vBytes.String())
 						if len(vBytes.Offsets) > needLen {
@@ -1839,6 +2024,9 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 					case types.T_decimal128:
 						cols := vec.Col.([]types.Decimal128)
 						vec.Col = cols[:needLen]
+					case types.T_uuid:
+						cols := vec.Col.([]types.Uuid)
+						vec.Col = cols[:needLen]
 					case types.T_timestamp:
 						cols := vec.Col.([]types.Timestamp)
 						vec.Col = cols[:needLen]
@@ -1873,6 +2061,14 @@ func writeBatchToStorage(handler *WriteBatchHandler, force bool) error {
 							goto handleError2
 						}
 					}
+					err = fillLoadAutoIncrement(&handler.SharePart, handler.batchData)
+					if err != nil {
+						goto handleError2
+					}
+					err = handler.constraints.check(handler.batchData)
+					if err != nil {
+						goto handleError2
+					}
 					err = tableHandler.Write(handler.timestamp, handler.batchData, txnHandler.GetTxn().GetCtx())
 					if handler.oneTxnPerBatch {
 						if err != nil {
@@ -1984,6 +2180,11 @@ func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database
 
 	result := &LoadResult{}
 
+	constraints, err := mce.getTableConstraints(dbName, string(load.Table.Name()), tableHandler, ses.GetTxnHandler().GetTxn().GetCtx())
+	if err != nil {
+		return nil, err
+	}
+
 	/*
 		step1 : read block from file
 	*/
@@ -2017,6 +2218,8 @@ func (mce *MysqlCmdExecutor) LoadLoop(load *tree.Load, dbHandler engine.Database
 			dbName:               dbName,
 			txnHandler:           ses.GetTxnHandler(),
 			oneTxnPerBatch:       ses.Pu.SV.GetOneTxnPerBatchDuringLoad(),
+			incrService:          ses.Pu.IncrService,
+			constraints:          constraints,
 			lineCount:            0,
 			batchSize:            curBatchSize,
 			result:               result,
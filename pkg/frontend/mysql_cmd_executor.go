@@ -15,6 +15,7 @@
 package frontend
 
 import (
+	"encoding/binary"
 	goErrors "errors"
 	"fmt"
 	"os"
@@ -119,6 +120,8 @@ type outputQueue struct {
 	ep           *tree.ExportParam
 	lineStr      []byte
 	showStmtType ShowStatementType
+	//send the rows in the binary format for COM_STMT_EXECUTE
+	binary bool
 
 	getEmptyRowTime time.Duration
 	flushTime       time.Duration
@@ -188,7 +191,13 @@ func (o *outputQueue) flush() error {
 			return nil
 		}
 
-		if err := o.proto.SendResultSetTextBatchRowSpeedup(o.mrs, o.rowIdx); err != nil {
+		var err error
+		if o.binary {
+			err = o.proto.SendResultSetBinaryBatchRow(o.mrs, o.rowIdx)
+		} else {
+			err = o.proto.SendResultSetTextBatchRowSpeedup(o.mrs, o.rowIdx)
+		}
+		if err != nil {
 			logutil.Errorf("flush error %v \n", err)
 			return err
 		}
@@ -244,7 +253,8 @@ func handleShowCreateTable(ses *Session) error {
 		}
 		typ := types.Type{Oid: types.T(d[attrTypPos].(int32))}
 		typeStr := typ.String()
-		if typ.Oid == types.T_varchar || typ.Oid == types.T_char {
+		if typ.Oid == types.T_varchar || typ.Oid == types.T_char ||
+			typ.Oid == types.T_binary || typ.Oid == types.T_varbinary {
 			typeStr += fmt.Sprintf("(%d)", d[charWidthPos].(int32))
 		}
 		createStr += fmt.Sprintf("`%s` %s %s%s", colName, typeStr, nullOrNot, hasAttrComment)
@@ -387,6 +397,7 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 	allocateOutBufferTime := time.Since(begin3)
 
 	oq := NewOuputQueue(proto, mrs, uint64(countOfResultSet), ses.ep, ses.showStmtType)
+	oq.binary = ses.Cmd == int(COM_STMT_EXECUTE)
 	oq.reset()
 
 	row2colTime := time.Duration(0)
@@ -581,7 +592,7 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 						row[i] = vs.Get(rowIndex)
 					}
 				}
-			case types.T_varchar:
+			case types.T_varchar, types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
 				if !nulls.Any(vec.Nsp) { //all data in this column are not null
 					vs := vec.Col.(*types.Bytes)
 					row[i] = vs.Get(rowIndex)
@@ -593,6 +604,30 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 						row[i] = vs.Get(rowIndex)
 					}
 				}
+			case types.T_bit:
+				// BIT is sent as its big endian bytes
+				if nulls.Contains(vec.Nsp, uint64(rowIndex)) {
+					row[i] = nil
+				} else {
+					vs := vec.Col.([]uint64)
+					row[i] = types.BitBytes(vs[rowIndex], vec.Typ.Width)
+				}
+			case types.T_json:
+				// the binary json is sent as its text
+				if nulls.Contains(vec.Nsp, uint64(rowIndex)) {
+					row[i] = nil
+				} else {
+					vs := vec.Col.(*types.Bytes)
+					row[i] = []byte(types.DecodeByteJson(vs.Get(rowIndex)).String())
+				}
+			case types.T_uuid:
+				// the uuid is sent as its text
+				if nulls.Contains(vec.Nsp, uint64(rowIndex)) {
+					row[i] = nil
+				} else {
+					vs := vec.Col.([]types.Uuid)
+					row[i] = vs[rowIndex].String()
+				}
 			case types.T_date:
 				if !nulls.Any(vec.Nsp) { //all data in this column are not null
 					vs := vec.Col.([]types.Date)
@@ -848,6 +883,11 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 		logutil.Infof("User %s change database from [%s] to [%s] in LOAD DATA\n", proto.GetUserName(), oldDB, proto.GetDatabaseName())
 	}
 
+	privs := []*plan2.RequiredPrivilege{{Type: tree.PRIVILEGE_TYPE_STATIC_INSERT, DbName: loadDb, TableName: loadTable}}
+	if err = ses.CheckPrivileges(privs); err != nil {
+		return err
+	}
+
 	/*
 		check table
 	*/
@@ -1197,6 +1237,10 @@ func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt) error {
 	if err != nil {
 		return err
 	}
+	// the plan to explain is not compiled, check its privileges here
+	if err = plan2.CheckPlanPrivileges(mce.ses.txnCompileCtx, buildPlan); err != nil {
+		return err
+	}
 
 	if err != nil {
 		logutil.Errorf("build query plan and optimize failed, error: %v", err)
@@ -1264,34 +1308,79 @@ func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt) error {
 
 // handlePrepareStmt
 func (mce *MysqlCmdExecutor) handlePrepareStmt(st *tree.PrepareStmt) error {
+	if ins, ok := getInsertValues(st.Stmt); ok {
+		return mce.ses.SetPrepareStmt(string(st.Name), newPrepareStmtOfInsertValues(string(st.Name), ins))
+	}
+	mce.setQueryTypeOfPrepare(st.Stmt)
 	preparePlan, err := buildPlan(mce.ses.txnCompileCtx, st)
 	if err != nil {
 		return err
 	}
 
-	return mce.ses.SetPrepareStmt(preparePlan.GetDcl().GetPrepare().GetName(), &PrepareStmt{
-		Name:        preparePlan.GetDcl().GetPrepare().GetName(),
-		PreparePlan: preparePlan,
-		PrepareStmt: st.Stmt,
-	})
+	prepareStmt, err := newPrepareStmt(preparePlan, st.Stmt)
+	if err != nil {
+		return err
+	}
+	return mce.ses.SetPrepareStmt(prepareStmt.Name, prepareStmt)
 }
 
 // handlePrepareString
 func (mce *MysqlCmdExecutor) handlePrepareString(st *tree.PrepareString) error {
+	stmts, err := mysql.Parse(st.Sql)
+	if err != nil {
+		return err
+	}
+	if ins, ok := getInsertValues(stmts[0]); ok && len(stmts) == 1 {
+		return mce.ses.SetPrepareStmt(string(st.Name), newPrepareStmtOfInsertValues(string(st.Name), ins))
+	}
+	mce.setQueryTypeOfPrepare(stmts[0])
 	preparePlan, err := buildPlan(mce.ses.txnCompileCtx, st)
 	if err != nil {
 		return err
 	}
-	stmts, err := mysql.Parse(st.Sql)
+
+	prepareStmt, err := newPrepareStmt(preparePlan, stmts[0])
 	if err != nil {
 		return err
 	}
+	return mce.ses.SetPrepareStmt(prepareStmt.Name, prepareStmt)
+}
+
+// setQueryTypeOfPrepare sets the query type that the plan of the prepared statement needs
+func (mce *MysqlCmdExecutor) setQueryTypeOfPrepare(stmt tree.Statement) {
+	switch stmt.(type) {
+	case *tree.Delete:
+		mce.ses.GetTxnCompileCtx().SetQueryType(TXN_DELETE)
+	case *tree.Update:
+		mce.ses.GetTxnCompileCtx().SetQueryType(TXN_UPDATE)
+	default:
+		mce.ses.GetTxnCompileCtx().SetQueryType(TXN_DEFAULT)
+	}
+}
 
-	return mce.ses.SetPrepareStmt(preparePlan.GetDcl().GetPrepare().GetName(), &PrepareStmt{
-		Name:        preparePlan.GetDcl().GetPrepare().GetName(),
+// newPrepareStmt makes the prepared statement from the plan of PREPARE
+func newPrepareStmt(preparePlan *plan2.Plan, stmt tree.Statement) (*PrepareStmt, error) {
+	prepare := preparePlan.GetDcl().GetPrepare()
+	paramCount, err := plan2.GetPrepareParamCount(prepare)
+	if err != nil {
+		return nil, err
+	}
+	return &PrepareStmt{
+		Name:        prepare.GetName(),
 		PreparePlan: preparePlan,
-		PrepareStmt: stmts[0],
-	})
+		PrepareStmt: stmt,
+		ParamCount:  paramCount,
+	}, nil
+}
+
+// newPrepareStmtOfInsertValues makes the prepared statement of INSERT ... VALUES.
+// It has no plan. The values are bound when it is executed and it runs as the insert values.
+func newPrepareStmtOfInsertValues(name string, stmt *tree.Insert) *PrepareStmt {
+	return &PrepareStmt{
+		Name:        name,
+		PrepareStmt: stmt,
+		ParamCount:  countParamsOfInsertValues(stmt),
+	}
 }
 
 // handleDeallocate
@@ -1352,6 +1441,8 @@ type TxnComputationWrapper struct {
 	proc    *process.Process
 	ses     *Session
 	compile *compile.Compile
+	//the values of the parameters from COM_STMT_EXECUTE
+	paramValues []interface{}
 }
 
 func InitTxnComputationWrapper(ses *Session, stmt tree.Statement, proc *process.Process) *TxnComputationWrapper {
@@ -1406,6 +1497,10 @@ func (cwft *TxnComputationWrapper) GetAffectedRows() uint64 {
 	return cwft.compile.GetAffectedRows()
 }
 
+func (cwft *TxnComputationWrapper) GetLastInsertID() uint64 {
+	return cwft.compile.GetLastInsertID()
+}
+
 func (cwft *TxnComputationWrapper) Compile(u interface{}, fill func(interface{}, *batch.Batch) error) (interface{}, error) {
 	var err error
 	cwft.plan, err = buildPlan(cwft.ses.GetTxnCompilerContext(), cwft.stmt)
@@ -1431,10 +1526,24 @@ func (cwft *TxnComputationWrapper) Compile(u interface{}, fill func(interface{},
 		// 	}
 		// }
 
+		// the values of the binary protocol take the place of the USING variables
+		args := executePlan.Args
+		if cwft.paramValues != nil {
+			args = make([]*plan2.Expr, len(cwft.paramValues))
+			for i, value := range cwft.paramValues {
+				if args[i], err = plan2.MakeConstExprByValue(value); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if len(args) != prepareStmt.ParamCount {
+			return nil, NewMysqlError(ER_WRONG_ARGUMENTS, "EXECUTE")
+		}
+
 		query := plan2.DeepCopyQuery(preparePlan.Plan.GetQuery())
 
 		// replace ? and @var with their values
-		resetParamRule := plan2.NewResetParamRefRule(executePlan.Args)
+		resetParamRule := plan2.NewResetParamRefRule(args)
 		resetVarRule := plan2.NewResetVarRefRule(cwft.ses.GetTxnCompilerContext())
 		VisitQuery := plan2.NewVisitQuery(query, []plan2.VisitRule{resetParamRule, resetVarRule})
 		err = VisitQuery.Visit()
@@ -1463,6 +1572,7 @@ func (cwft *TxnComputationWrapper) Compile(u interface{}, fill func(interface{},
 	cwft.proc.UnixTime = time.Now().UnixNano()
 	txnHandler := cwft.ses.GetTxnHandler()
 	cwft.proc.Snapshot = txnHandler.GetTxn().GetCtx()
+	cwft.proc.SessionInfo.NoForeignKeyChecks = !cwft.ses.ForeignKeyChecks()
 	cwft.compile = compile.New(cwft.ses.GetDatabaseName(), cwft.ses.GetSql(), cwft.ses.GetUserName(), cwft.ses.GetStorage(), cwft.proc)
 	err = cwft.compile.Compile(cwft.plan, cwft.ses, fill)
 	if err != nil {
@@ -1475,6 +1585,34 @@ func (cwft *TxnComputationWrapper) Run(ts uint64) error {
 	return nil
 }
 
+// bindInsertValues turns EXECUTE of the prepared INSERT ... VALUES into the insert values with the arguments
+func (cwft *TxnComputationWrapper) bindInsertValues() error {
+	st, ok := cwft.stmt.(*tree.Execute)
+	if !ok {
+		return nil
+	}
+	prepareStmt, err := cwft.ses.GetPrepareStmt(string(st.Name))
+	if err != nil {
+		return err
+	}
+	ins, ok := getInsertValues(prepareStmt.PrepareStmt)
+	if !ok || prepareStmt.PreparePlan != nil {
+		return nil
+	}
+
+	values := cwft.paramValues
+	if values == nil {
+		values = make([]interface{}, len(st.Variables))
+		for i, v := range st.Variables {
+			if values[i], err = cwft.ses.GetTxnCompilerContext().ResolveVariable(v.Name, v.System, v.Global); err != nil {
+				return err
+			}
+		}
+	}
+	cwft.stmt, err = bindParamsOfInsertValues(ins, values)
+	return err
+}
+
 func buildPlan(ctx plan2.CompilerContext, stmt tree.Statement) (*plan2.Plan, error) {
 	switch stmt := stmt.(type) {
 	case *tree.Select, *tree.ParenSelect,
@@ -1555,29 +1693,36 @@ func (mce *MysqlCmdExecutor) afterRun(stmt tree.Statement, beginInstant time.Tim
 
 }
 
-//execute query
-func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
-	beginInstant := time.Now()
+//newProcess makes the process for running the statements of the request
+func (mce *MysqlCmdExecutor) newProcess() *process.Process {
 	ses := mce.GetSession()
-	ses.showStmtType = NotShowStatement
 	proto := ses.GetMysqlProtocol()
-	txnHandler := ses.GetTxnHandler()
-	ses.SetSql(sql)
-	ses.ep.Outfile = false
-
 	proc := process.New(mheap.New(ses.GuestMmu))
 	proc.Id = mce.getNextProcessId()
 	proc.Lim.Size = ses.Pu.SV.GetProcessLimitationSize()
 	proc.Lim.BatchRows = ses.Pu.SV.GetProcessLimitationBatchRows()
 	proc.Lim.PartitionRows = ses.Pu.SV.GetProcessLimitationPartitionRows()
+	proc.FileService = ses.Pu.FileService
+	proc.IncrService = ses.Pu.IncrService
 	proc.SessionInfo = process.SessionInfo{
-		User:         ses.GetUserName(),
-		Host:         ses.Pu.SV.GetHost(),
-		ConnectionID: uint64(proto.ConnectionID()),
-		Database:     ses.GetDatabaseName(),
-		Version:      serverVersion,
-	}
+		User:               ses.GetUserName(),
+		Host:               ses.Pu.SV.GetHost(),
+		ConnectionID:       uint64(proto.ConnectionID()),
+		Database:           ses.GetDatabaseName(),
+		Version:            serverVersion,
+		LastInsertID:       ses.GetLastInsertID(),
+		NoForeignKeyChecks: !ses.ForeignKeyChecks(),
+	}
+	return proc
+}
 
+//execute query
+func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
+	beginInstant := time.Now()
+	ses := mce.GetSession()
+	ses.SetSql(sql)
+
+	proc := mce.newProcess()
 	cws, err := GetComputationWrapper(ses.GetDatabaseName(),
 		sql,
 		ses.GetUserName(),
@@ -1586,10 +1731,33 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 	if err != nil {
 		return NewMysqlError(ER_PARSE_ERROR, err, "")
 	}
+	return mce.executeComputationWrappers(cws, beginInstant)
+}
+
+//execute the prepared statement with the values of the parameters from COM_STMT_EXECUTE
+func (mce *MysqlCmdExecutor) doComStmtExecute(prepareStmt *PrepareStmt, paramValues []interface{}) error {
+	beginInstant := time.Now()
+	ses := mce.GetSession()
+	ses.SetSql(tree.String(prepareStmt.PrepareStmt, dialect.MYSQL))
+
+	cw := InitTxnComputationWrapper(ses, &tree.Execute{Name: tree.Identifier(prepareStmt.Name)}, mce.newProcess())
+	cw.paramValues = paramValues
+	return mce.executeComputationWrappers([]ComputationWrapper{cw}, beginInstant)
+}
+
+//executeComputationWrappers runs the statements and sends their results to the client
+func (mce *MysqlCmdExecutor) executeComputationWrappers(cws []ComputationWrapper, beginInstant time.Time) (retErr error) {
+	ses := mce.GetSession()
+	ses.showStmtType = NotShowStatement
+	proto := ses.GetMysqlProtocol()
+	txnHandler := ses.GetTxnHandler()
+	ses.ep.Outfile = false
+	var err error
 
 	defer func() {
 		ses.Mrs = nil
 		_ = txnHandler.CleanTxn()
+		ses.invalidatePrivilegesAfterTxn()
 	}()
 
 	var cmpBegin time.Time
@@ -1599,6 +1767,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 	var fromLoadData = false
 	var txnErr error
 	var rspLen uint64
+	var lastInsertID uint64
 
 	stmt := cws[0].GetAst()
 	mce.beforeRun(stmt)
@@ -1615,6 +1784,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 	for _, cw := range cws {
 		ses.Mrs = &MysqlResultSet{}
 		stmt := cw.GetAst()
+		lastInsertID = 0
 
 		var fromTxnCommand = TxnNoCommand
 		//check transaction states
@@ -1645,6 +1815,14 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			logutil.Infof("start autocommit txn in default")
 		}
 
+		// EXECUTE of the prepared insert values runs as the insert values
+		if txnCw, ok := cw.(*TxnComputationWrapper); ok {
+			if err = txnCw.bindInsertValues(); err != nil {
+				goto handleFailed
+			}
+			stmt = cw.GetAst()
+		}
+
 		switch st := stmt.(type) {
 		case *tree.Select:
 			if st.Ep != nil {
@@ -1677,15 +1855,16 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			_, ok := st.Rows.Select.(*tree.ValuesClause)
 			if ok {
 				selfHandle = true
-				rspLen, err = mce.handleInsertValues(st, 0)
+				rspLen, lastInsertID, err = mce.handleInsertValues(st, 0)
 				if err != nil {
 					goto handleFailed
 				}
+				ses.SetLastInsertID(lastInsertID)
 			}
 		case *tree.DropDatabase:
 			// if the droped database is the same as the one in use, database must be reseted to empty.
 			if string(st.Name) == ses.GetDatabaseName() {
-				ses.SetUserName("")
+				ses.SetDatabaseName("")
 			}
 		case *tree.Load:
 			fromLoadData = true
@@ -1720,6 +1899,41 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			if err != nil {
 				goto handleFailed
 			}
+		case *tree.CreateUser:
+			selfHandle = true
+			if err = mce.handleCreateUser(st); err != nil {
+				goto handleFailed
+			}
+		case *tree.DropUser:
+			selfHandle = true
+			if err = mce.handleDropUser(st); err != nil {
+				goto handleFailed
+			}
+		case *tree.AlterUser:
+			selfHandle = true
+			if err = mce.handleAlterUser(st); err != nil {
+				goto handleFailed
+			}
+		case *tree.CreateRole:
+			selfHandle = true
+			if err = mce.handleCreateRole(st); err != nil {
+				goto handleFailed
+			}
+		case *tree.DropRole:
+			selfHandle = true
+			if err = mce.handleDropRole(st); err != nil {
+				goto handleFailed
+			}
+		case *tree.Grant:
+			selfHandle = true
+			if err = mce.handleGrant(st); err != nil {
+				goto handleFailed
+			}
+		case *tree.Revoke:
+			selfHandle = true
+			if err = mce.handleRevoke(st); err != nil {
+				goto handleFailed
+			}
 		case *tree.ShowVariables:
 			selfHandle = true
 			err = mce.handleShowVariables(st)
@@ -1780,6 +1994,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 		//produce result set
 		case *tree.Select,
 			*tree.ShowCreateTable, *tree.ShowCreateDatabase, *tree.ShowTables, *tree.ShowDatabases, *tree.ShowColumns,
+			*tree.ShowCreateView,
 			*tree.ShowProcessList, *tree.ShowErrors, *tree.ShowWarnings, *tree.ShowVariables, *tree.ShowStatus,
 			*tree.ShowIndex,
 			*tree.ExplainFor, *tree.ExplainAnalyze, *tree.ExplainStmt:
@@ -1875,8 +2090,9 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 				goto handleFailed
 			}
 		//just status, no result set
-		case *tree.CreateTable, *tree.DropTable, *tree.CreateDatabase, *tree.DropDatabase,
+		case *tree.CreateTable, *tree.DropTable, *tree.AlterTable, *tree.CreateDatabase, *tree.DropDatabase,
 			*tree.CreateIndex, *tree.DropIndex,
+			*tree.CreateView, *tree.DropView,
 			*tree.Insert, *tree.Update,
 			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
 			*tree.SetVar,
@@ -1900,6 +2116,10 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			}
 
 			rspLen = cw.GetAffectedRows()
+			if _, ok := stmt.(*tree.Insert); ok {
+				lastInsertID = cw.GetLastInsertID()
+				ses.SetLastInsertID(lastInsertID)
+			}
 			echoTime := time.Now()
 			if ses.Pu.SV.GetRecordTimeElapsedOfSqlRequest() {
 				logutil.Infof("time of SendResponse %s", time.Since(echoTime).String())
@@ -1916,14 +2136,15 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) (retErr error) {
 			if txnErr != nil {
 				return txnErr
 			}
-			switch stmt.(type) {
-			case *tree.CreateTable, *tree.DropTable, *tree.CreateDatabase, *tree.DropDatabase,
-				*tree.CreateIndex, *tree.DropIndex, *tree.Insert, *tree.Update,
+			// EXECUTE responds as the prepared statement
+			switch cw.GetAst().(type) {
+			case *tree.CreateTable, *tree.DropTable, *tree.AlterTable, *tree.CreateDatabase, *tree.DropDatabase,
+				*tree.CreateIndex, *tree.DropIndex, *tree.CreateView, *tree.DropView, *tree.Insert, *tree.Update,
 				*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
 				*tree.CreateRole, *tree.DropRole, *tree.Revoke, *tree.Grant,
 				*tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword, *tree.Delete,
 				*tree.PrepareStmt, *tree.PrepareString, *tree.Deallocate:
-				resp := NewOkResponse(rspLen, 0, 0, 0, int(COM_QUERY), "")
+				resp := NewOkResponse(rspLen, lastInsertID, 0, 0, int(COM_QUERY), "")
 				if err := mce.GetSession().protocol.SendResponse(resp); err != nil {
 					return fmt.Errorf("routine send response failed. error:%v ", err)
 				}
@@ -1996,6 +2217,124 @@ func (mce *MysqlCmdExecutor) handleDDl(ses *Session, stmt tree.Statement, epoch
 }
 */
 
+// getStmtIdOfStmtCommand gets the statement id at the beginning of the payload of COM_STMT_XXX
+func getStmtIdOfStmtCommand(data []byte) (uint32, error) {
+	if len(data) < 4 {
+		return 0, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	return binary.LittleEndian.Uint32(data), nil
+}
+
+// getPrepareStmtOfStmtCommand gets the prepared statement that COM_STMT_XXX works on
+func (mce *MysqlCmdExecutor) getPrepareStmtOfStmtCommand(data []byte, command string) (*PrepareStmt, error) {
+	stmtID, err := getStmtIdOfStmtCommand(data)
+	if err != nil {
+		return nil, err
+	}
+	prepareStmt, err := mce.GetSession().GetPrepareStmt(GetPrepareStmtNameOfId(stmtID))
+	if err != nil {
+		id := strconv.FormatUint(uint64(stmtID), 10)
+		return nil, NewMysqlError(ER_UNKNOWN_STMT_HANDLER, len(id), id, command)
+	}
+	return prepareStmt, nil
+}
+
+// handleStmtPrepare prepares the statement of COM_STMT_PREPARE and responds the id, the parameters and the columns of it
+func (mce *MysqlCmdExecutor) handleStmtPrepare(sql string) error {
+	ses := mce.GetSession()
+	stmts, err := mysql.Parse(sql)
+	if err != nil {
+		return NewMysqlError(ER_PARSE_ERROR, err, "")
+	}
+	if len(stmts) != 1 {
+		return NewMysqlError(ER_UNSUPPORTED_PS)
+	}
+
+	stmtID := ses.GenNewStmtId()
+	st := tree.NewPrepareStmt(tree.Identifier(GetPrepareStmtNameOfId(stmtID)), stmts[0])
+
+	//the plan is built in the txn
+	txnHandler := ses.GetTxnHandler()
+	if _, err = txnHandler.StartByAutocommitIfNeeded(); err != nil {
+		return err
+	}
+	if err = mce.handlePrepareStmt(st); err != nil {
+		if txnErr := txnHandler.RollbackAfterAutocommitOnly(); txnErr != nil {
+			return txnErr
+		}
+		return err
+	}
+	if err = txnHandler.CommitAfterAutocommitOnly(); err != nil {
+		return err
+	}
+
+	prepareStmt, err := ses.GetPrepareStmt(string(st.Name))
+	if err != nil {
+		return err
+	}
+	var cols []*plan2.ColDef
+	if prepareStmt.PreparePlan != nil {
+		cols = plan2.GetResultColumnsFromPlan(prepareStmt.PreparePlan.GetDcl().GetPrepare().GetPlan())
+	}
+	columns := make([]interface{}, len(cols))
+	for i, col := range cols {
+		c := new(MysqlColumn)
+		c.SetName(col.Name)
+		if err = convertEngineTypeToMysqlType(types.T(col.Typ.Id), c); err != nil {
+			return err
+		}
+		columns[i] = c
+	}
+	return ses.GetMysqlProtocol().SendPrepareResponse(stmtID, prepareStmt.ParamCount, columns)
+}
+
+// handleStmtExecute executes the prepared statement with the parameters in the payload of COM_STMT_EXECUTE
+func (mce *MysqlCmdExecutor) handleStmtExecute(data []byte) error {
+	prepareStmt, err := mce.getPrepareStmtOfStmtCommand(data, "mysqld_stmt_execute")
+	if err != nil {
+		return err
+	}
+	paramValues, err := mce.GetSession().GetMysqlProtocol().ParseExecuteData(prepareStmt, data)
+	if err != nil {
+		return err
+	}
+	//the long data is used by one execution only
+	prepareStmt.LongData = nil
+	return mce.doComStmtExecute(prepareStmt, paramValues)
+}
+
+// handleStmtSendLongData appends the data of the parameter in the payload of COM_STMT_SEND_LONG_DATA
+func (mce *MysqlCmdExecutor) handleStmtSendLongData(data []byte) error {
+	prepareStmt, err := mce.getPrepareStmtOfStmtCommand(data, "mysqld_stmt_send_long_data")
+	if err != nil {
+		return err
+	}
+	//int<4> stmt_id, int<2> param_id, string<EOF> data
+	if len(data) < 6 {
+		return NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	paramID := int(binary.LittleEndian.Uint16(data[4:]))
+	if paramID >= prepareStmt.ParamCount {
+		return NewMysqlError(ER_WRONG_ARGUMENTS, "mysqld_stmt_send_long_data")
+	}
+	if prepareStmt.LongData == nil {
+		prepareStmt.LongData = make(map[int][]byte)
+	}
+	//the payload is reused by the next request. copy it.
+	prepareStmt.LongData[paramID] = append(prepareStmt.LongData[paramID], data[6:]...)
+	return nil
+}
+
+// handleStmtReset drops the long data of the prepared statement of COM_STMT_RESET
+func (mce *MysqlCmdExecutor) handleStmtReset(data []byte) error {
+	prepareStmt, err := mce.getPrepareStmtOfStmtCommand(data, "mysqld_stmt_reset")
+	if err != nil {
+		return err
+	}
+	prepareStmt.LongData = nil
+	return nil
+}
+
 // ExecRequest the server execute the commands from the client following the mysql's routine
 func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (resp *Response, err error) {
 	defer func() {
@@ -2008,6 +2347,7 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (resp *Response, err erro
 	logutil.Infof("cmd %v", req.GetCmd())
 
 	ses := mce.GetSession()
+	ses.Cmd = req.GetCmd()
 	switch uint8(req.GetCmd()) {
 	case COM_QUIT:
 		/*resp = NewResponse(
@@ -2081,6 +2421,48 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (resp *Response, err erro
 	case COM_PING:
 		resp = NewGeneralOkResponse(COM_PING)
 
+		return resp, nil
+	case COM_STMT_PREPARE:
+		var sql = string(req.GetData().([]byte))
+		mce.addSqlCount(1)
+		logutil.Infof("connection id %d prepare:%s", ses.GetConnectionID(), SubStringFromBegin(sql, int(ses.Pu.SV.GetLengthOfQueryPrinted())))
+		err := mce.handleStmtPrepare(sql)
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_PREPARE, err)
+		}
+		return resp, nil
+	case COM_STMT_EXECUTE:
+		data := req.GetData().([]byte)
+		mce.addSqlCount(1)
+		err := mce.handleStmtExecute(data)
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_EXECUTE, err)
+		}
+		return resp, nil
+	case COM_STMT_SEND_LONG_DATA:
+		//no response for COM_STMT_SEND_LONG_DATA. the error is reported by COM_STMT_EXECUTE.
+		data := req.GetData().([]byte)
+		err := mce.handleStmtSendLongData(data)
+		if err != nil {
+			logutil.Errorf("connection id %d send long data failed. error:%v", ses.GetConnectionID(), err)
+		}
+		return nil, nil
+	case COM_STMT_CLOSE:
+		//no response for COM_STMT_CLOSE
+		data := req.GetData().([]byte)
+		stmtID, err := getStmtIdOfStmtCommand(data)
+		if err == nil {
+			ses.RemovePrepareStmt(GetPrepareStmtNameOfId(stmtID))
+		}
+		return nil, nil
+	case COM_STMT_RESET:
+		data := req.GetData().([]byte)
+		err := mce.handleStmtReset(data)
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_RESET, err)
+		} else {
+			resp = NewGeneralOkResponse(COM_STMT_RESET)
+		}
 		return resp, nil
 	default:
 		err := fmt.Errorf("unsupported command. 0x%x", req.GetCmd())
@@ -2141,6 +2523,27 @@ func convertEngineTypeToMysqlType(engineType types.T, col *MysqlColumn) error {
 		col.SetColumnType(defines.MYSQL_TYPE_STRING)
 	case types.T_varchar:
 		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	case types.T_text:
+		col.SetColumnType(defines.MYSQL_TYPE_BLOB)
+	case types.T_binary:
+		col.SetColumnType(defines.MYSQL_TYPE_STRING)
+		col.SetCharset(uint16(binaryCollationID))
+	case types.T_varbinary:
+		col.SetColumnType(defines.MYSQL_TYPE_VAR_STRING)
+		col.SetCharset(uint16(binaryCollationID))
+	case types.T_blob:
+		col.SetColumnType(defines.MYSQL_TYPE_BLOB)
+		col.SetCharset(uint16(binaryCollationID))
+	case types.T_json:
+		col.SetColumnType(defines.MYSQL_TYPE_JSON)
+	case types.T_bit:
+		col.SetColumnType(defines.MYSQL_TYPE_BIT)
+		col.SetSigned(false)
+	case types.T_enum, types.T_set:
+		// the values of ENUM and SET are sent as the names of their members
+		col.SetColumnType(defines.MYSQL_TYPE_STRING)
+	case types.T_uuid:
+		col.SetColumnType(defines.MYSQL_TYPE_STRING)
 	case types.T_date:
 		col.SetColumnType(defines.MYSQL_TYPE_DATE)
 	case types.T_datetime:
@@ -16,9 +16,10 @@ package frontend
 
 import (
 	"bytes"
-	"crypto/sha1"
+	"crypto/tls"
 	"encoding/binary"
 	"fmt"
+	"math"
 	"math/rand"
 	"strconv"
 	"sync"
@@ -30,6 +31,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
 )
 
 // DefaultCapability means default capabilities of the server
@@ -123,8 +126,19 @@ const (
 
 	Utf8mb4CollationID uint8 = 45
 
+	// binaryCollationID is the binary charset used by binary strings
+	binaryCollationID uint8 = 63
+
 	AuthNativePassword string = "mysql_native_password"
 
+	AuthCachingSha2Password string = "caching_sha2_password"
+
+	//the status of the caching_sha2_password authentication that follows the AuthMoreData header
+	cachingSha2FastAuthSuccess uint8 = 3
+
+	//the length of the SSL request packet: capabilities(4) + max packet size(4) + character set(1) + filler(23)
+	sslRequestPacketLength int = 32
+
 	//the length of the mysql protocol header
 	HeaderLengthOfTheProtocol int = 4
 	HeaderOffset              int = 0
@@ -145,6 +159,15 @@ type MysqlProtocol interface {
 
 	SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSet, cnt uint64) error
 
+	//the server send group row of the result set in the binary format as an independent packet thread safe
+	SendResultSetBinaryBatchRow(mrs *MysqlResultSet, cnt uint64) error
+
+	//SendPrepareResponse the server send the response of COM_STMT_PREPARE to the client
+	SendPrepareResponse(stmtID uint32, paramCount int, columns []interface{}) error
+
+	//ParseExecuteData gets the values of the parameters from the payload of COM_STMT_EXECUTE
+	ParseExecuteData(stmt *PrepareStmt, data []byte) ([]interface{}, error)
+
 	//SendColumnDefinitionPacket the server send the column definition to the client
 	SendColumnDefinitionPacket(column Column, cmd int) error
 
@@ -265,6 +288,9 @@ type MysqlProtocolImpl struct {
 	//the user of the client
 	username string
 
+	//the host of the account that the user logged in as
+	userHost string
+
 	//the default database for the client
 	database string
 
@@ -281,9 +307,34 @@ type MysqlProtocolImpl struct {
 
 	SV *config.SystemVariables
 
+	//the storage keeps the accounts of the users
+	storage engine.Engine
+
+	//the TLS configuration of the server. nil means TLS is disabled
+	tlsConfig *tls.Config
+
+	//the connection has been switched to TLS
+	isTLS bool
+
 	m sync.Mutex
 }
 
+func (mp *MysqlProtocolImpl) SetStorage(storage engine.Engine) {
+	mp.storage = storage
+}
+
+func (mp *MysqlProtocolImpl) SetTLSConfig(tlsConfig *tls.Config) {
+	mp.tlsConfig = tlsConfig
+}
+
+//the capabilities advertised by the server
+func (mp *MysqlProtocolImpl) getServerCapability() uint32 {
+	if mp.tlsConfig != nil {
+		return DefaultCapability | CLIENT_SSL
+	}
+	return DefaultCapability
+}
+
 func (mp *MysqlProtocolImpl) GetDatabaseName() string {
 	return mp.database
 }
@@ -300,6 +351,14 @@ func (mp *MysqlProtocolImpl) SetUserName(s string) {
 	mp.username = s
 }
 
+func (mp *MysqlProtocolImpl) GetUserHost() string {
+	return mp.userHost
+}
+
+func (mp *MysqlProtocolImpl) SetUserHost(s string) {
+	mp.userHost = s
+}
+
 func (mp *MysqlProtocolImpl) GetStats() string {
 	return fmt.Sprintf("flushCount %d %s",
 		mp.flushCount,
@@ -551,6 +610,24 @@ func (mp *MysqlProtocolImpl) appendUint8(data []byte, e uint8) []byte {
 	return mp.append(data, e)
 }
 
+func (mp *MysqlProtocolImpl) appendUint16(data []byte, e uint16) []byte {
+	mp.lenEncBuffer = mp.lenEncBuffer[:9]
+	pos := mp.io.WriteUint16(mp.lenEncBuffer, 0, e)
+	return mp.append(data, mp.lenEncBuffer[:pos]...)
+}
+
+func (mp *MysqlProtocolImpl) appendUint32(data []byte, e uint32) []byte {
+	mp.lenEncBuffer = mp.lenEncBuffer[:9]
+	pos := mp.io.WriteUint32(mp.lenEncBuffer, 0, e)
+	return mp.append(data, mp.lenEncBuffer[:pos]...)
+}
+
+func (mp *MysqlProtocolImpl) appendUint64(data []byte, e uint64) []byte {
+	mp.lenEncBuffer = mp.lenEncBuffer[:9]
+	pos := mp.io.WriteUint64(mp.lenEncBuffer, 0, e)
+	return mp.append(data, mp.lenEncBuffer[:pos]...)
+}
+
 //write the count of zeros into the buffer at the position
 //return pos + count
 func (mp *MysqlProtocolImpl) writeZeros(data []byte, pos int, count int) int {
@@ -560,71 +637,77 @@ func (mp *MysqlProtocolImpl) writeZeros(data []byte, pos int, count int) int {
 	return pos + count
 }
 
-//the server calculates the hash value of the password with the algorithm
-//and judges it with the authentication data from the client.
-//Algorithm: SHA1( password ) XOR SHA1( slat + SHA1( SHA1( password ) ) )
-func (mp *MysqlProtocolImpl) checkPassword(password, salt, auth []byte) bool {
-	if len(password) == 0 {
-		return false
-	}
-	//hash1 = SHA1(password)
-	sha := sha1.New()
-	_, err := sha.Write(password)
-	if err != nil {
-		logutil.Errorf("SHA1(password) failed.")
-		return false
+//getAuthString gets the authentication string of the account that the client user logs in as.
+//The account is chosen by the user name and the host of the connection.
+//It returns false if no account matches.
+func (mp *MysqlProtocolImpl) getAuthString() (string, bool, error) {
+	if _, ok := mp.storage.(moengine.TxnEngine); ok {
+		clientHost, _ := mp.Peer()
+		account, err := getUserAccountInTxn(mp.storage, mp.username, clientHost)
+		if err != nil || account == nil {
+			return "", false, err
+		}
+		mp.userHost = account.host
+		return account.authString, true, nil
 	}
-	hash1 := sha.Sum(nil)
 
-	//hash2 = SHA1(SHA1(password))
-	sha.Reset()
-	_, err = sha.Write(hash1)
-	if err != nil {
-		logutil.Errorf("SHA1(SHA1(password)) failed.")
-		return false
+	//the storage does not keep the accounts. only the dump user in the configuration is accepted.
+	if mp.SV != nil && len(mp.username) != 0 && mp.username == mp.SV.GetDumpuser() {
+		mp.userHost = "%"
+		return encodeNativePassword([]byte(mp.SV.GetDumppassword())), true, nil
 	}
-	hash2 := sha.Sum(nil)
+	return "", false, nil
+}
 
-	//hash3 = SHA1(salt + SHA1(SHA1(password)))
-	sha.Reset()
-	_, err = sha.Write(salt)
+//the server authenticate that the client can connect and use the database.
+//authPlugin is the plugin that the client used to make the authResponse.
+func (mp *MysqlProtocolImpl) authenticateUser(authPlugin string, authResponse []byte) error {
+	authString, exists, err := mp.getAuthString()
 	if err != nil {
-		logutil.Errorf("write salt failed.")
-		return false
+		return err
 	}
-	_, err = sha.Write(hash2)
-	if err != nil {
-		logutil.Errorf("write SHA1(SHA1(password)) failed.")
-		return false
+	if !exists {
+		return fmt.Errorf("user %s does not exist", mp.username)
 	}
-	hash3 := sha.Sum(nil)
 
-	//SHA1(password) XOR SHA1(salt + SHA1(SHA1(password)))
-	for i := range hash1 {
-		hash1[i] ^= hash3[i]
+	plugin, hash, err := authPluginOfAuthString(authString)
+	if err != nil {
+		return err
 	}
 
-	logutil.Infof("server calculated %v\n", hash1)
-	logutil.Infof("client calculated %v\n", auth)
-
-	return bytes.Equal(hash1, auth)
-}
+	//the user without the password
+	if plugin == "" {
+		if len(authResponse) != 0 {
+			return fmt.Errorf("check password failed")
+		}
+		return nil
+	}
 
-//the server authenticate that the client can connect and use the database
-func (mp *MysqlProtocolImpl) authenticateUser(authResponse []byte) error {
-	//TODO:check the user and the connection
-	//TODO:get the user's password
-	var psw []byte
-	if mp.username == mp.SV.GetDumpuser() { //the user dump for test
-		psw = []byte(mp.SV.GetDumppassword())
+	//ask the client to authenticate with the plugin of the user
+	if plugin != authPlugin {
+		if mp.capability&CLIENT_PLUGIN_AUTH == 0 {
+			return fmt.Errorf("the client does not support the authentication method %s", plugin)
+		}
+		if authResponse, err = mp.negotiateAuthenticationMethod(plugin); err != nil {
+			return fmt.Errorf("negotiate authentication method failed. error:%v", err)
+		}
 	}
 
-	//TO Check password
-	if mp.checkPassword(psw, mp.salt, authResponse) {
-		logutil.Infof("check password succeeded\n")
-	} else {
-		return fmt.Errorf("check password failed")
+	switch plugin {
+	case AuthNativePassword:
+		if !checkNativePassword(hash, mp.salt, authResponse) {
+			return fmt.Errorf("check password failed")
+		}
+	case AuthCachingSha2Password:
+		if !checkCachingSha2Password(hash, mp.salt, authResponse) {
+			return fmt.Errorf("check password failed")
+		}
+		//the fast authentication succeeded. the OK packet follows it.
+		if err = mp.writePackets(mp.makeFastAuthSuccessPayload()); err != nil {
+			return err
+		}
 	}
+	logutil.Infof("check password succeeded\n")
 	return nil
 }
 
@@ -632,12 +715,49 @@ func (mp *MysqlProtocolImpl) setSequenceID(value uint8) {
 	mp.sequenceId = value
 }
 
+//isSSLRequest checks if the payload is the SSL request packet.
+//It is the truncated handshake response41 which ends at the filler.
+func (mp *MysqlProtocolImpl) isSSLRequest(payload []byte) bool {
+	if len(payload) != sslRequestPacketLength {
+		return false
+	}
+	capabilities, _, ok := mp.io.ReadUint32(payload, 0)
+	return ok && capabilities&CLIENT_PROTOCOL_41 != 0 && capabilities&CLIENT_SSL != 0
+}
+
+//upgradeToTLS switches the connection to TLS after the SSL request packet
+func (mp *MysqlProtocolImpl) upgradeToTLS() error {
+	if mp.tlsConfig == nil {
+		return fmt.Errorf("the client asks for TLS, but TLS is not enabled")
+	}
+	raw, err := mp.tcpConn.RawConn()
+	if err != nil {
+		return err
+	}
+	conn, ok := raw.(*upgradableConn)
+	if !ok {
+		return fmt.Errorf("the connection can not be switched to TLS")
+	}
+	//the client starts the TLS handshake without waiting for the server.
+	//its beginning may have been read into the buffer of the session.
+	_, buffered, err := mp.tcpConn.InBuf().ReadAll()
+	if err != nil {
+		return err
+	}
+	if err = conn.upgrade(mp.tlsConfig, buffered); err != nil {
+		return fmt.Errorf("TLS handshake failed. error:%v", err)
+	}
+	mp.isTLS = true
+	return nil
+}
+
 func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 	if len(payload) < 2 {
 		return fmt.Errorf("received a broken response packet")
 	}
 
 	var authResponse []byte
+	var authPlugin string
 	if capabilities, _, ok := mp.io.ReadUint16(payload, 0); !ok {
 		return fmt.Errorf("read capabilities from response packet failed")
 	} else if uint32(capabilities)&CLIENT_PROTOCOL_41 != 0 {
@@ -649,7 +769,8 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 		}
 
 		authResponse = resp41.authResponse
-		mp.capability = DefaultCapability & resp41.capabilities
+		authPlugin = resp41.clientPluginName
+		mp.capability = mp.getServerCapability() & resp41.capabilities
 
 		if nameAndCharset, ok := collationID2CharsetAndName[int(resp41.collationID)]; !ok {
 			return fmt.Errorf("get collationName and charset failed")
@@ -671,7 +792,8 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 		}
 
 		authResponse = resp320.authResponse
-		mp.capability = DefaultCapability & resp320.capabilities
+		authPlugin = AuthNativePassword
+		mp.capability = mp.getServerCapability() & resp320.capabilities
 		mp.collationID = int(Utf8mb4CollationID)
 		mp.collationName = "utf8mb4_general_ci"
 		mp.charset = "utf8mb4"
@@ -681,7 +803,13 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 		mp.database = resp320.database
 	}
 
-	if err := mp.authenticateUser(authResponse); err != nil {
+	if !mp.isTLS && mp.SV != nil && mp.SV.GetRequireSecureTransport() {
+		fail := errorMsgRefer[ER_SECURE_TRANSPORT_REQUIRED]
+		_ = mp.sendErrPacket(fail.errorCode, fail.sqlStates[0], fail.errorMsgOrFormat)
+		return fmt.Errorf("the connection of user %s does not use TLS", mp.username)
+	}
+
+	if err := mp.authenticateUser(authPlugin, authResponse); err != nil {
 		fail := errorMsgRefer[ER_ACCESS_DENIED_ERROR]
 		_ = mp.sendErrPacket(fail.errorCode, fail.sqlStates[0], "Access denied for user")
 		return err
@@ -699,6 +827,7 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	var data = make([]byte, HeaderOffset+256)
 	var pos = HeaderOffset
+	var capability = mp.getServerCapability()
 	//int<1> protocol version
 	pos = mp.io.WriteUint8(data, pos, clientProtocolVersion)
 
@@ -715,7 +844,7 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	pos = mp.io.WriteUint8(data, pos, 0)
 
 	//int<2>              capabilities flags (lower 2 bytes)
-	pos = mp.io.WriteUint16(data, pos, uint16(DefaultCapability&0xFFFF))
+	pos = mp.io.WriteUint16(data, pos, uint16(capability&0xFFFF))
 
 	//int<1>              character set
 	pos = mp.io.WriteUint8(data, pos, utf8mb4BinCollationID)
@@ -724,9 +853,9 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	pos = mp.io.WriteUint16(data, pos, DefaultClientConnStatus)
 
 	//int<2>              capabilities flags (upper 2 bytes)
-	pos = mp.io.WriteUint16(data, pos, uint16((DefaultCapability>>16)&0xFFFF))
+	pos = mp.io.WriteUint16(data, pos, uint16((capability>>16)&0xFFFF))
 
-	if (DefaultCapability & CLIENT_PLUGIN_AUTH) != 0 {
+	if (capability & CLIENT_PLUGIN_AUTH) != 0 {
 		//int<1>              length of auth-plugin-data
 		//set 21 always
 		pos = mp.io.WriteUint8(data, pos, uint8(len(mp.salt)+1))
@@ -739,15 +868,16 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	//string[10]     reserved (all [00])
 	pos = mp.writeZeros(data, pos, 10)
 
-	if (DefaultCapability & CLIENT_SECURE_CONNECTION) != 0 {
+	if (capability & CLIENT_SECURE_CONNECTION) != 0 {
 		//string[$len]   auth-plugin-data-part-2 ($len=MAX(13, length of auth-plugin-data - 8))
 		pos = mp.writeCountOfBytes(data, pos, mp.salt[8:])
 		pos = mp.io.WriteUint8(data, pos, 0)
 	}
 
-	if (DefaultCapability & CLIENT_PLUGIN_AUTH) != 0 {
+	if (capability & CLIENT_PLUGIN_AUTH) != 0 {
 		//string[NUL]    auth-plugin name
-		pos = mp.writeStringNUL(data, pos, AuthNativePassword)
+		//the client is asked to switch to the plugin of the user if it is a different one
+		pos = mp.writeStringNUL(data, pos, defaultAuthPlugin)
 	}
 
 	return data[:pos]
@@ -845,19 +975,11 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(data []byte) (bool, resp
 	}
 
 	if (info.capabilities & CLIENT_PLUGIN_AUTH) != 0 {
+		//the authenticate method is switched to the one of the user if they are different
 		info.clientPluginName, _, ok = mp.readStringNUL(data, pos)
 		if !ok {
 			return false, info, fmt.Errorf("get auth plugin name failed")
 		}
-
-		//to switch authenticate method
-		if info.clientPluginName != AuthNativePassword {
-			var err error
-			if info.authResponse, err = mp.negotiateAuthenticationMethod(); err != nil {
-				return false, info, fmt.Errorf("negotiate authentication method failed. error:%v", err)
-			}
-			info.clientPluginName = AuthNativePassword
-		}
 	}
 
 	//drop client connection attributes
@@ -876,7 +998,7 @@ func (mp *MysqlProtocolImpl) handleClientResponse41(resp41 response41) error {
 	//logutil.Infof("username %s\n", resp41.username)
 	//logutil.Infof("authResponse: \n")
 	//update the capabilities with client's capabilities
-	mp.capability = DefaultCapability & resp41.capabilities
+	mp.capability = mp.getServerCapability() & resp41.capabilities
 
 	//character set
 	if nameAndCharset, ok := collationID2CharsetAndName[int(resp41.collationID)]; !ok {
@@ -962,7 +1084,7 @@ func (mp *MysqlProtocolImpl) handleClientResponse320(resp320 response320) error
 	//logutil.Infof("authResponse: \n")
 
 	//update the capabilities with client's capabilities
-	mp.capability = DefaultCapability & resp320.capabilities
+	mp.capability = mp.getServerCapability() & resp320.capabilities
 
 	//if the client does not notice its default charset, the server gives a default charset.
 	//Run the sql in mysql 8.0.23 to get the charset
@@ -992,12 +1114,21 @@ func (mp *MysqlProtocolImpl) makeAuthSwitchRequestPayload(authMethodName string)
 	return data[:pos]
 }
 
+//the server makes a AuthMoreData packet that tells the client the caching_sha2_password fast authentication succeeded
+func (mp *MysqlProtocolImpl) makeFastAuthSuccessPayload() []byte {
+	data := make([]byte, HeaderOffset+2)
+	pos := HeaderOffset
+	pos = mp.io.WriteUint8(data, pos, defines.AuthMoreData)
+	pos = mp.io.WriteUint8(data, pos, cachingSha2FastAuthSuccess)
+	return data[:pos]
+}
+
 //the server can send AuthSwitchRequest to ask client to use designated authentication method,
 //if both server and client support CLIENT_PLUGIN_AUTH capability.
 //return data authenticated with new method
-func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod() ([]byte, error) {
+func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(authMethodName string) ([]byte, error) {
 	var err error
-	aswPkt := mp.makeAuthSwitchRequestPayload(AuthNativePassword)
+	aswPkt := mp.makeAuthSwitchRequestPayload(authMethodName)
 	err = mp.writePackets(aswPkt)
 	if err != nil {
 		return nil, err
@@ -1311,7 +1442,8 @@ func (mp *MysqlProtocolImpl) makeResultSetTextRow(data []byte, mrs *MysqlResultS
 					data = mp.appendStringLenEncOfInt64(data, value)
 				}
 			}
-		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING:
+		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING,
+			defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_JSON, defines.MYSQL_TYPE_BIT:
 			if value, err2 := mrs.GetString(r, i); err2 != nil {
 				return nil, err2
 			} else {
@@ -1615,6 +1747,418 @@ func (mp *MysqlProtocolImpl) sendResultSetTextRow(mrs *MysqlResultSet, r uint64)
 	return nil
 }
 
+//append the date in the binary format of MYSQL_TYPE_DATE
+func (mp *MysqlProtocolImpl) appendDate(data []byte, value types.Date) []byte {
+	year, month, day, _ := value.Calendar(true)
+	data = mp.appendUint8(data, 4)
+	data = mp.appendUint16(data, uint16(year))
+	data = mp.appendUint8(data, month)
+	return mp.appendUint8(data, day)
+}
+
+//append the datetime in the binary format of MYSQL_TYPE_DATETIME and MYSQL_TYPE_TIMESTAMP
+func (mp *MysqlProtocolImpl) appendDatetime(data []byte, value types.Datetime) []byte {
+	year, month, day, _ := value.ToDate().Calendar(true)
+	hour, minute, second := value.Clock()
+	msec := value.MicroSec()
+	if msec != 0 {
+		data = mp.appendUint8(data, 11)
+	} else {
+		data = mp.appendUint8(data, 7)
+	}
+	data = mp.appendUint16(data, uint16(year))
+	data = mp.appendUint8(data, month)
+	data = mp.appendUint8(data, day)
+	data = mp.appendUint8(data, uint8(hour))
+	data = mp.appendUint8(data, uint8(minute))
+	data = mp.appendUint8(data, uint8(second))
+	if msec != 0 {
+		data = mp.appendUint32(data, uint32(msec))
+	}
+	return data
+}
+
+//the server convert every row of the result set into the binary format that mysql protocol needs
+//the routine follows the article: https://dev.mysql.com/doc/internals/en/binary-protocol-resultset-row.html
+func (mp *MysqlProtocolImpl) makeResultSetBinaryRow(data []byte, mrs *MysqlResultSet, r uint64) ([]byte, error) {
+	//packet header [00]
+	data = mp.appendUint8(data, defines.OKHeader)
+
+	//NULL bitmap, length= (column_count + 7 + 2) / 8. the offset of the bits is 2.
+	columnCount := mrs.GetColumnCount()
+	nullBitmap := make([]byte, (columnCount+7+2)/8)
+	for i := uint64(0); i < columnCount; i++ {
+		isNil, err := mrs.ColumnIsNull(r, i)
+		if err != nil {
+			return nil, err
+		}
+		if isNil {
+			nullBitmap[(i+2)/8] |= 1 << ((i + 2) % 8)
+		}
+	}
+	data = mp.appendCountOfBytes(data, nullBitmap)
+
+	//values of the columns that are not NULL
+	for i := uint64(0); i < columnCount; i++ {
+		if nullBitmap[(i+2)/8]&(1<<((i+2)%8)) != 0 {
+			continue
+		}
+		column, err := mrs.GetColumn(i)
+		if err != nil {
+			return nil, err
+		}
+		mysqlColumn, ok := column.(*MysqlColumn)
+		if !ok {
+			return nil, fmt.Errorf("sendColumn need MysqlColumn")
+		}
+
+		switch mysqlColumn.ColumnType() {
+		case defines.MYSQL_TYPE_BOOL, defines.MYSQL_TYPE_DECIMAL,
+			defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING,
+			defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_JSON, defines.MYSQL_TYPE_BIT:
+			if value, err2 := mrs.GetString(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendStringLenEnc(data, value)
+			}
+		case defines.MYSQL_TYPE_TINY:
+			if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendUint8(data, uint8(value))
+			}
+		case defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_YEAR:
+			if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendUint16(data, uint16(value))
+			}
+		case defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG:
+			if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendUint32(data, uint32(value))
+			}
+		case defines.MYSQL_TYPE_LONGLONG:
+			if uint32(mysqlColumn.Flag())&defines.UNSIGNED_FLAG != 0 {
+				if value, err2 := mrs.GetUint64(r, i); err2 != nil {
+					return nil, err2
+				} else {
+					data = mp.appendUint64(data, value)
+				}
+			} else {
+				if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+					return nil, err2
+				} else {
+					data = mp.appendUint64(data, uint64(value))
+				}
+			}
+		case defines.MYSQL_TYPE_FLOAT:
+			if value, err2 := mrs.GetFloat64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendUint32(data, math.Float32bits(float32(value)))
+			}
+		case defines.MYSQL_TYPE_DOUBLE:
+			if value, err2 := mrs.GetFloat64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendUint64(data, math.Float64bits(value))
+			}
+		case defines.MYSQL_TYPE_DATE:
+			if value, err2 := mrs.GetValue(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendDate(data, value.(types.Date))
+			}
+		case defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP:
+			value, err2 := mrs.GetString(r, i)
+			if err2 != nil {
+				return nil, err2
+			}
+			dt, err2 := types.ParseDatetime(value, 6)
+			if err2 != nil {
+				return nil, err2
+			}
+			data = mp.appendDatetime(data, dt)
+		case defines.MYSQL_TYPE_TIME:
+			return nil, fmt.Errorf("unsupported MYSQL_TYPE_TIME")
+		default:
+			return nil, fmt.Errorf("unsupported column type %d ", mysqlColumn.ColumnType())
+		}
+	}
+	return data, nil
+}
+
+//the server send group row of the result set in the binary format as an independent packet
+//thread safe
+func (mp *MysqlProtocolImpl) SendResultSetBinaryBatchRow(mrs *MysqlResultSet, cnt uint64) error {
+	if cnt == 0 {
+		return nil
+	}
+
+	mp.GetLock().Lock()
+	defer mp.GetLock().Unlock()
+	var err error = nil
+
+	for i := uint64(0); i < cnt; i++ {
+		err = mp.openRow(nil)
+		if err != nil {
+			return err
+		}
+
+		_, err = mp.makeResultSetBinaryRow(nil, mrs, i)
+		if err != nil {
+			//ERR_Packet in case of error
+			err1 := mp.sendErrPacket(ER_UNKNOWN_ERROR, DefaultMySQLState, err.Error())
+			if err1 != nil {
+				return err1
+			}
+			return err
+		}
+
+		err = mp.closeRow(nil)
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+//make the COM_STMT_PREPARE_OK packet
+func (mp *MysqlProtocolImpl) makePrepareOKPayload(stmtID uint32, columnCount, paramCount uint16) []byte {
+	data := make([]byte, HeaderOffset+12)
+	pos := HeaderOffset
+	pos = mp.io.WriteUint8(data, pos, defines.OKHeader)
+	pos = mp.io.WriteUint32(data, pos, stmtID)
+	pos = mp.io.WriteUint16(data, pos, columnCount)
+	pos = mp.io.WriteUint16(data, pos, paramCount)
+	//int<1>    reserved_1 [00] filler
+	pos = mp.io.WriteUint8(data, pos, 0)
+	//int<2>    warning_count
+	pos = mp.io.WriteUint16(data, pos, 0)
+	return data[:pos]
+}
+
+//SendPrepareResponse the server send the response of COM_STMT_PREPARE to the client
+//the routine follows the article: https://dev.mysql.com/doc/internals/en/com-stmt-prepare-response.html
+func (mp *MysqlProtocolImpl) SendPrepareResponse(stmtID uint32, paramCount int, columns []interface{}) error {
+	mp.GetLock().Lock()
+	defer mp.GetLock().Unlock()
+
+	err := mp.writePackets(mp.makePrepareOKPayload(stmtID, uint16(len(columns)), uint16(paramCount)))
+	if err != nil {
+		return err
+	}
+
+	if paramCount > 0 {
+		//the types of the parameters are decided by the client when it executes the statement
+		for i := 0; i < paramCount; i++ {
+			param := new(MysqlColumn)
+			param.SetName("?")
+			param.SetColumnType(defines.MYSQL_TYPE_VAR_STRING)
+			if err = mp.SendColumnDefinitionPacket(param, int(COM_STMT_PREPARE)); err != nil {
+				return err
+			}
+		}
+		if err = mp.SendEOFPacketIf(0, 0); err != nil {
+			return err
+		}
+	}
+
+	if len(columns) > 0 {
+		for _, column := range columns {
+			if err = mp.SendColumnDefinitionPacket(column.(Column), int(COM_STMT_PREPARE)); err != nil {
+				return err
+			}
+		}
+		if err = mp.SendEOFPacketIf(0, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//read the datetime in the binary format of MYSQL_TYPE_DATE, MYSQL_TYPE_DATETIME and MYSQL_TYPE_TIMESTAMP
+//return the datetime as string; position
+func (mp *MysqlProtocolImpl) readDatetime(data []byte, pos int) (string, int, bool) {
+	length, pos, ok := mp.io.ReadUint8(data, pos)
+	if !ok || pos+int(length) > len(data) {
+		return "", 0, false
+	}
+	var year uint16
+	var month, day, hour, minute, second uint8
+	var msec uint32
+	if length >= 4 {
+		year, _, _ = mp.io.ReadUint16(data, pos)
+		month, day = data[pos+2], data[pos+3]
+	}
+	if length >= 7 {
+		hour, minute, second = data[pos+4], data[pos+5], data[pos+6]
+	}
+	if length >= 11 {
+		msec, _, _ = mp.io.ReadUint32(data, pos+7)
+	}
+	pos += int(length)
+
+	switch {
+	case length <= 4:
+		return fmt.Sprintf("%04d-%02d-%02d", year, month, day), pos, true
+	case length < 11:
+		return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d", year, month, day, hour, minute, second), pos, true
+	default:
+		return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d.%06d", year, month, day, hour, minute, second, msec), pos, true
+	}
+}
+
+//read the time in the binary format of MYSQL_TYPE_TIME
+//return the time as string; position
+func (mp *MysqlProtocolImpl) readTime(data []byte, pos int) (string, int, bool) {
+	length, pos, ok := mp.io.ReadUint8(data, pos)
+	if !ok || pos+int(length) > len(data) {
+		return "", 0, false
+	}
+	var sign string
+	var days, msec uint32
+	var hour, minute, second uint8
+	if length >= 8 {
+		if data[pos] == 1 {
+			sign = "-"
+		}
+		days, _, _ = mp.io.ReadUint32(data, pos+1)
+		hour, minute, second = data[pos+5], data[pos+6], data[pos+7]
+	}
+	if length >= 12 {
+		msec, _, _ = mp.io.ReadUint32(data, pos+8)
+	}
+	pos += int(length)
+
+	hours := days*24 + uint32(hour)
+	if length >= 12 {
+		return fmt.Sprintf("%s%02d:%02d:%02d.%06d", sign, hours, minute, second, msec), pos, true
+	}
+	return fmt.Sprintf("%s%02d:%02d:%02d", sign, hours, minute, second), pos, true
+}
+
+//ParseExecuteData gets the values of the parameters from the payload of COM_STMT_EXECUTE
+//the routine follows the article: https://dev.mysql.com/doc/internals/en/com-stmt-execute.html
+func (mp *MysqlProtocolImpl) ParseExecuteData(stmt *PrepareStmt, data []byte) ([]interface{}, error) {
+	//int<4> stmt_id, int<1> flags, int<4> iteration_count
+	pos := 9
+	if len(data) < pos {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	paramCount := stmt.ParamCount
+	values := make([]interface{}, paramCount)
+	if paramCount == 0 {
+		return values, nil
+	}
+
+	//NULL-bitmap, length: (num-params+7)/8
+	var nullBitmap []byte
+	var ok bool
+	if nullBitmap, pos, ok = mp.readCountOfBytes(data, pos, (paramCount+7)/8); !ok {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+
+	//new-params-bound-flag. the types are sent with the first execution only.
+	var newParamsBound uint8
+	if newParamsBound, pos, ok = mp.io.ReadUint8(data, pos); !ok {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	if newParamsBound == 1 {
+		var paramTypes []byte
+		if paramTypes, pos, ok = mp.readCountOfBytes(data, pos, paramCount*2); !ok {
+			return nil, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+		stmt.ParamTypes = append(stmt.ParamTypes[:0], paramTypes...)
+	}
+	if len(stmt.ParamTypes) != paramCount*2 {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+
+	for i := 0; i < paramCount; i++ {
+		if nullBitmap[i/8]&(1<<(i%8)) != 0 {
+			values[i] = nil
+			continue
+		}
+
+		//the value sent by COM_STMT_SEND_LONG_DATA is not in the payload
+		if longData, has := stmt.LongData[i]; has {
+			values[i] = string(longData)
+			continue
+		}
+
+		typ := stmt.ParamTypes[i*2]
+		unsigned := stmt.ParamTypes[i*2+1]&0x80 != 0
+		switch typ {
+		case defines.MYSQL_TYPE_NULL:
+			values[i] = nil
+		case defines.MYSQL_TYPE_TINY:
+			var v uint8
+			if v, pos, ok = mp.io.ReadUint8(data, pos); ok {
+				if unsigned {
+					values[i] = uint64(v)
+				} else {
+					values[i] = int64(int8(v))
+				}
+			}
+		case defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_YEAR:
+			var v uint16
+			if v, pos, ok = mp.io.ReadUint16(data, pos); ok {
+				if unsigned {
+					values[i] = uint64(v)
+				} else {
+					values[i] = int64(int16(v))
+				}
+			}
+		case defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG:
+			var v uint32
+			if v, pos, ok = mp.io.ReadUint32(data, pos); ok {
+				if unsigned {
+					values[i] = uint64(v)
+				} else {
+					values[i] = int64(int32(v))
+				}
+			}
+		case defines.MYSQL_TYPE_LONGLONG:
+			var v uint64
+			if v, pos, ok = mp.io.ReadUint64(data, pos); ok {
+				if unsigned {
+					values[i] = v
+				} else {
+					values[i] = int64(v)
+				}
+			}
+		case defines.MYSQL_TYPE_FLOAT:
+			var v uint32
+			if v, pos, ok = mp.io.ReadUint32(data, pos); ok {
+				values[i] = float64(math.Float32frombits(v))
+			}
+		case defines.MYSQL_TYPE_DOUBLE:
+			var v uint64
+			if v, pos, ok = mp.io.ReadUint64(data, pos); ok {
+				values[i] = math.Float64frombits(v)
+			}
+		case defines.MYSQL_TYPE_DATE, defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP:
+			values[i], pos, ok = mp.readDatetime(data, pos)
+		case defines.MYSQL_TYPE_TIME:
+			values[i], pos, ok = mp.readTime(data, pos)
+		case defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_NEWDECIMAL,
+			defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING,
+			defines.MYSQL_TYPE_TINY_BLOB, defines.MYSQL_TYPE_MEDIUM_BLOB, defines.MYSQL_TYPE_LONG_BLOB, defines.MYSQL_TYPE_BLOB,
+			defines.MYSQL_TYPE_BIT, defines.MYSQL_TYPE_ENUM, defines.MYSQL_TYPE_SET, defines.MYSQL_TYPE_JSON:
+			values[i], pos, ok = mp.readStringLenEnc(data, pos)
+		default:
+			return nil, fmt.Errorf("unsupported parameter type %d", typ)
+		}
+		if !ok {
+			return nil, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+	}
+	return values, nil
+}
+
 //the server send the result set of execution the client
 //the routine follows the article: https://dev.mysql.com/doc/internals/en/com-query-response.html
 func (mp *MysqlProtocolImpl) sendResultSet(set ResultSet, cmd int, warnings, status uint16) error {
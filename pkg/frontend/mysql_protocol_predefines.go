@@ -5870,7 +5870,7 @@ var errorMsgRefer = map[uint16]errorMsgItem{
 	ER_TOO_LONG_IDENT:            {1059, []string{"42000", "S1009"}, "Identifier name '%-.100s' is too long"},
 	ER_DUP_FIELDNAME:             {1060, []string{"42S21", "S1009"}, "Duplicate column name '%-.192s'"},
 	ER_DUP_KEYNAME:               {1061, []string{"42000", "S1009"}, "Duplicate key name '%-.192s'"},
-	ER_DUP_ENTRY:                 {1062, []string{"23000", "S1009"}, "Duplicate entry '%-.192s' for key %d"},
+	ER_DUP_ENTRY:                 {1062, []string{"23000", "S1009"}, "Duplicate entry '%-.192s' for key '%-.192s'"},
 	ER_WRONG_FIELD_SPEC:          {1063, []string{"42000", "S1009"}, "Incorrect column specifier for column '%-.192s'"},
 	ER_PARSE_ERROR:               {1064, []string{"42000", "s1009"}, "%s %s"},
 	ER_EMPTY_QUERY:               {1065, []string{"42000"}, "Query was empty"},
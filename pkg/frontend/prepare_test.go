@@ -0,0 +1,170 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/fagongzi/goetty"
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExecuteData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	_, _, proto := newTaeSession(t, ctrl)
+
+	stmt := &PrepareStmt{ParamCount: 5}
+	data := []byte{
+		1, 0, 0, 0, //stmt_id
+		0,          //flags
+		1, 0, 0, 0, //iteration_count
+		0x08,                                                       //NULL-bitmap: the 4th parameter is NULL
+		1,                                                          //new-params-bound-flag
+		0x08, 0x00, 0x03, 0x80, 0xfd, 0x00, 0x06, 0x00, 0x0c, 0x00, //types
+		0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, //-2
+		0x10, 0x00, 0x00, 0x00, //16
+		3, 'a', 'b', 'c',
+		7, 0xe6, 0x07, 10, 18, 9, 30, 5, //2022-10-18 09:30:05
+	}
+	values, err := proto.ParseExecuteData(stmt, data)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{int64(-2), uint64(16), "abc", nil, "2022-10-18 09:30:05"}, values)
+
+	//the types are bound by the first execution
+	data = []byte{
+		1, 0, 0, 0,
+		0,
+		1, 0, 0, 0,
+		0x0a, //the 2nd and the 4th parameters are NULL
+		0,
+		0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0,
+		4, 0xe6, 0x07, 1, 2,
+	}
+	values, err = proto.ParseExecuteData(stmt, data)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{int64(1), nil, "", nil, "2022-01-02"}, values)
+
+	//the value is cut off
+	_, err = proto.ParseExecuteData(stmt, data[:len(data)-2])
+	require.Error(t, err)
+}
+
+func TestPreparedStatementProtocol(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	eng, _, _ := newTaeSession(t, ctrl)
+
+	//the sessions of the connections use the storage engine in the config
+	storage := config.StorageEngine
+	config.StorageEngine = eng
+	defer func() {
+		config.StorageEngine = storage
+	}()
+
+	pu, err := getParameterUnit("test/system_vars_config.toml", eng)
+	require.NoError(t, err)
+	rm := NewRoutineManager(pu)
+	encoder, decoder := NewSqlCodec()
+	app, err := goetty.NewTCPApplication("127.0.0.1:6003", rm.Handler,
+		goetty.WithAppSessionOptions(
+			goetty.WithCodec(encoder, decoder),
+			goetty.WithLogger(logutil.GetGlobalLogger())),
+		goetty.WithAppSessionAware(rm))
+	require.NoError(t, err)
+	require.NoError(t, app.Start())
+	defer func() {
+		require.NoError(t, app.Stop())
+	}()
+
+	open := func(database string) *sql.DB {
+		//the arguments are sent by COM_STMT_EXECUTE without interpolateParams
+		db, err := sql.Open("mysql", "root:@tcp(127.0.0.1:6003)/"+database+"?readTimeout=30s&timeout=30s&writeTimeout=30s")
+		require.NoError(t, err)
+		return db
+	}
+	db := open("")
+	_, err = db.Exec("create database db1")
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	db = open("db1")
+	defer db.Close()
+	//the prepared statements belong to the session of the connection
+	db.SetMaxOpenConns(1)
+	_, err = db.Exec("create table t1 (a int, b varchar(20), c double, d date)")
+	require.NoError(t, err)
+
+	stmt, err := db.Prepare("insert into t1 values (?, ?, ?, ?)")
+	require.NoError(t, err)
+	for i := 1; i <= 3; i++ {
+		_, err = stmt.Exec(i, "row", float64(i)/2, "2022-10-18")
+		require.NoError(t, err)
+	}
+	require.NoError(t, stmt.Close())
+
+	res, err := db.Exec("update t1 set b = ? where a = ?", "updated", 2)
+	require.NoError(t, err)
+	affected, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), affected)
+
+	rows, err := db.Query("select a, b, c, d from t1 where a >= ? order by a", 2)
+	require.NoError(t, err)
+	type row struct {
+		a int64
+		b string
+		c float64
+		d string
+	}
+	var got []row
+	for rows.Next() {
+		var r row
+		require.NoError(t, rows.Scan(&r.a, &r.b, &r.c, &r.d))
+		got = append(got, r)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+	require.Equal(t, []row{{2, "updated", 1, "2022-10-18"}, {3, "row", 1.5, "2022-10-18"}}, got)
+
+	res, err = db.Exec("delete from t1 where a = ?", 1)
+	require.NoError(t, err)
+	affected, err = res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), affected)
+
+	//EXECUTE of the prepared insert values in sql
+	for _, sql := range []string{
+		"prepare s1 from 'insert into t1 (a, b) values (?, ?)'",
+		"set @a = 4",
+		"set @b = 'row'",
+		"execute s1 using @a, @b",
+	} {
+		_, err = db.Exec(sql)
+		require.NoError(t, err)
+	}
+	//the count of the arguments does not match the parameters
+	_, err = db.Exec("execute s1 using @a")
+	require.Error(t, err)
+
+	var count int64
+	require.NoError(t, db.QueryRow("select count(*) from t1 where b = ?", "row").Scan(&count))
+	require.Equal(t, int64(2), count)
+}
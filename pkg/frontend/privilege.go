@@ -0,0 +1,839 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+)
+
+var errorMissingPrimaryKey = errors.New("missing primary key in the catalog schema")
+
+/*
+The roles, the role memberships and the privileges are kept in mo_catalog:
+
+| table         | row                                                  |
+| ------------- | ---------------------------------------------------- |
+| mo_role       | a role created by CREATE ROLE                        |
+| mo_role_grant | a role granted to a user or another role             |
+| mo_privilege  | a privilege granted to a user or a role on an object |
+
+The users and the roles are named 'name'@'host' in them, like the primary key of mo_user.
+A user has the privileges granted to it and to the roles granted to it,
+directly or through other roles. All the granted roles are active.
+GRANT ALL is kept as the privileges that ALL stands for at the level, so that
+REVOKE of one of them works as in MySQL.
+*/
+const (
+	moRoleTableName      = "mo_role"
+	moRoleGrantTableName = "mo_role_grant"
+	moPrivilegeTableName = "mo_privilege"
+
+	// the database and the table name of the privileges on *.* and db.*
+	privilegeLevelAny = "*"
+)
+
+var (
+	tablePrivileges = []tree.PrivilegeType{
+		tree.PRIVILEGE_TYPE_STATIC_ALTER,
+		tree.PRIVILEGE_TYPE_STATIC_CREATE,
+		tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW,
+		tree.PRIVILEGE_TYPE_STATIC_DELETE,
+		tree.PRIVILEGE_TYPE_STATIC_DROP,
+		tree.PRIVILEGE_TYPE_STATIC_INDEX,
+		tree.PRIVILEGE_TYPE_STATIC_INSERT,
+		tree.PRIVILEGE_TYPE_STATIC_REFERENCES,
+		tree.PRIVILEGE_TYPE_STATIC_SELECT,
+		tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW,
+		tree.PRIVILEGE_TYPE_STATIC_TRIGGER,
+		tree.PRIVILEGE_TYPE_STATIC_UPDATE,
+	}
+	// the privileges on db.* besides the table privileges
+	databaseOnlyPrivileges = []tree.PrivilegeType{
+		tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE,
+		tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE,
+		tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES,
+		tree.PRIVILEGE_TYPE_STATIC_EVENT,
+		tree.PRIVILEGE_TYPE_STATIC_EXECUTE,
+		tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES,
+	}
+	// the privileges on *.* besides the database privileges
+	globalOnlyPrivileges = []tree.PrivilegeType{
+		tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE,
+		tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE,
+		tree.PRIVILEGE_TYPE_STATIC_CREATE_USER,
+		tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE,
+		tree.PRIVILEGE_TYPE_STATIC_FILE,
+		tree.PRIVILEGE_TYPE_STATIC_PROCESS,
+		tree.PRIVILEGE_TYPE_STATIC_RELOAD,
+		tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT,
+		tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE,
+		tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES,
+		tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN,
+		tree.PRIVILEGE_TYPE_STATIC_SUPER,
+	}
+)
+
+// privilegesOfLevel returns the privileges that can be granted at the level.
+// They are also the privileges ALL stands for.
+func privilegesOfLevel(level tree.PrivilegeLevelType) []tree.PrivilegeType {
+	var privs []tree.PrivilegeType
+	switch level {
+	case tree.PRIVILEGE_LEVEL_TYPE_GLOBAL:
+		privs = append(privs, globalOnlyPrivileges...)
+		fallthrough
+	case tree.PRIVILEGE_LEVEL_TYPE_DATABASE:
+		privs = append(privs, databaseOnlyPrivileges...)
+		fallthrough
+	case tree.PRIVILEGE_LEVEL_TYPE_TABLE:
+		privs = append(privs, tablePrivileges...)
+	}
+	return privs
+}
+
+// isPublicCatalogTable returns true if everyone can read the table.
+// The catalog of the databases, the tables and the columns is public like
+// the information_schema in MySQL.
+func isPublicCatalogTable(dbName, tableName string) bool {
+	if dbName != moCatalogDbName {
+		return false
+	}
+	switch tableName {
+	case "mo_database", "mo_tables", "mo_columns":
+		return true
+	}
+	return false
+}
+
+// privilegeGrant is a row of mo_privilege
+type privilegeGrant struct {
+	grantee   string
+	dbName    string
+	tableName string
+	privilege string
+}
+
+func (g *privilegeGrant) key() string {
+	return fmt.Sprintf("%q %q %q %q", g.grantee, g.dbName, g.tableName, g.privilege)
+}
+
+func (g *privilegeGrant) row() []string {
+	return []string{g.key(), g.grantee, g.dbName, g.tableName, g.privilege}
+}
+
+// roleGrant is a row of mo_role_grant
+type roleGrant struct {
+	role    string
+	grantee string
+}
+
+func (g *roleGrant) key() string {
+	return fmt.Sprintf("%q %q", g.role, g.grantee)
+}
+
+func (g *roleGrant) row() []string {
+	return []string{g.key(), g.role, g.grantee}
+}
+
+func getRoleGrants(storage engine.Engine, snapshot engine.Snapshot) ([]*roleGrant, error) {
+	var grants []*roleGrant
+	err := scanCatalogTable(storage, snapshot, moRoleGrantTableName, []string{"role_name", "grantee"}, func(row []string) bool {
+		grants = append(grants, &roleGrant{role: row[0], grantee: row[1]})
+		return true
+	})
+	return grants, err
+}
+
+func getPrivilegeGrants(storage engine.Engine, snapshot engine.Snapshot) ([]*privilegeGrant, error) {
+	var grants []*privilegeGrant
+	attrs := []string{"grantee", "database_name", "table_name", "privilege_type"}
+	err := scanCatalogTable(storage, snapshot, moPrivilegeTableName, attrs, func(row []string) bool {
+		grants = append(grants, &privilegeGrant{grantee: row[0], dbName: row[1], tableName: row[2], privilege: row[3]})
+		return true
+	})
+	return grants, err
+}
+
+// roleExists returns true if the role 'name'@'host' is in mo_role. The empty host is %.
+func roleExists(storage engine.Engine, snapshot engine.Snapshot, name, host string) (bool, error) {
+	key := formatAuthID(name, host)
+	exists := false
+	err := scanCatalogTable(storage, snapshot, moRoleTableName, []string{"role_key"}, func(row []string) bool {
+		exists = row[0] == key
+		return !exists
+	})
+	return exists, err
+}
+
+// authIDExists returns true if 'name'@'host' is a user or a role. They share the names.
+func authIDExists(storage engine.Engine, snapshot engine.Snapshot, name, host string) (bool, error) {
+	account, err := getUserAccount(storage, snapshot, name, host)
+	if err != nil || account != nil {
+		return account != nil, err
+	}
+	return roleExists(storage, snapshot, name, host)
+}
+
+// getGrantedRoles returns the roles granted to the grantee, directly or through other roles.
+// The grantee itself is in the result.
+func getGrantedRoles(grants []*roleGrant, grantee string) map[string]bool {
+	rolesOf := make(map[string][]string)
+	for _, grant := range grants {
+		rolesOf[grant.grantee] = append(rolesOf[grant.grantee], grant.role)
+	}
+	roles := map[string]bool{grantee: true}
+	queue := []string{grantee}
+	for len(queue) != 0 {
+		name := queue[0]
+		queue = queue[1:]
+		for _, role := range rolesOf[name] {
+			if !roles[role] {
+				roles[role] = true
+				queue = append(queue, role)
+			}
+		}
+	}
+	return roles
+}
+
+// privilegeSet is the set of the privileges of a user
+type privilegeSet map[privilegeGrant]bool
+
+// loadPrivileges loads the privileges of the user 'name'@'host' and the roles granted to the user
+func loadPrivileges(storage engine.Engine, snapshot engine.Snapshot, user string) (privilegeSet, error) {
+	roleGrants, err := getRoleGrants(storage, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	grantees := getGrantedRoles(roleGrants, user)
+	grants, err := getPrivilegeGrants(storage, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	privs := make(privilegeSet)
+	for _, grant := range grants {
+		if grantees[grant.grantee] {
+			privs[privilegeGrant{dbName: grant.dbName, tableName: grant.tableName, privilege: grant.privilege}] = true
+		}
+	}
+	return privs, nil
+}
+
+// has returns true if the privilege is granted on the object or on the levels above it
+func (ps privilegeSet) has(typ tree.PrivilegeType, dbName, tableName string) bool {
+	name := typ.ToString()
+	if ps[privilegeGrant{dbName: privilegeLevelAny, tableName: privilegeLevelAny, privilege: name}] {
+		return true
+	}
+	if dbName == privilegeLevelAny {
+		return false
+	}
+	if ps[privilegeGrant{dbName: dbName, tableName: privilegeLevelAny, privilege: name}] {
+		return true
+	}
+	return tableName != privilegeLevelAny && ps[privilegeGrant{dbName: dbName, tableName: tableName, privilege: name}]
+}
+
+// privilegesAreChecked returns true if the privileges of the session user are checked.
+// The privileges are kept in tae only. The internal sessions of the server are trusted.
+func (ses *Session) privilegesAreChecked() bool {
+	if ses.IsInternal || ses.Pu == nil {
+		return false
+	}
+	_, ok := ses.Pu.StorageEngine.(moengine.TxnEngine)
+	return ok
+}
+
+// privilegeVersion is increased whenever the privileges of any user may change.
+// The sessions load the privileges again once it changes.
+var privilegeVersion uint64
+
+// privilegeCache is the privileges of a user loaded at a privilegeVersion
+type privilegeCache struct {
+	user    string
+	version uint64
+	privs   privilegeSet
+	//the txn of the session changed the privileges
+	changed bool
+}
+
+// loadPrivileges returns the privileges of the session user, loaded only if they
+// are not loaded since the last change of the privileges
+func (ses *Session) loadPrivileges() (privilegeSet, error) {
+	user := formatAuthID(ses.GetUserName(), ses.GetUserHost())
+	version := atomic.LoadUint64(&privilegeVersion)
+	cache := &ses.privileges
+	if cache.privs != nil && cache.user == user && cache.version == version {
+		return cache.privs, nil
+	}
+	privs, err := loadPrivileges(ses.Pu.StorageEngine, ses.GetTxnHandler().GetTxn().GetCtx(), user)
+	if err != nil {
+		return nil, err
+	}
+	cache.user, cache.version, cache.privs = user, version, privs
+	return privs, nil
+}
+
+// invalidatePrivileges is called after GRANT, REVOKE, DROP USER and DROP ROLE change the privileges.
+// The other sessions may load the privileges before the changes are committed,
+// so they are invalidated again by invalidatePrivilegesAfterTxn.
+func (ses *Session) invalidatePrivileges() {
+	atomic.AddUint64(&privilegeVersion, 1)
+	ses.privileges.changed = true
+}
+
+// invalidatePrivilegesAfterTxn invalidates the privileges changed by the txn once it ends
+func (ses *Session) invalidatePrivilegesAfterTxn() {
+	if !ses.privileges.changed || ses.GetTxnHandler().IsInTaeTxn() {
+		return
+	}
+	ses.privileges.changed = false
+	atomic.AddUint64(&privilegeVersion, 1)
+}
+
+// CheckPrivileges implements the plan2.PrivilegeChecker.
+// It returns ER_TABLEACCESS_DENIED_ERROR or ER_DBACCESS_DENIED_ERROR for the first missing privilege.
+func (ses *Session) CheckPrivileges(privs []*plan2.RequiredPrivilege) error {
+	if len(privs) == 0 || !ses.privilegesAreChecked() {
+		return nil
+	}
+	granted, err := ses.loadPrivileges()
+	if err != nil {
+		return err
+	}
+	for _, priv := range privs {
+		dbName := priv.DbName
+		if dbName == "" {
+			dbName = ses.GetDatabaseName()
+		}
+		if priv.Type == tree.PRIVILEGE_TYPE_STATIC_SELECT && isPublicCatalogTable(dbName, priv.TableName) {
+			continue
+		}
+		if priv.TableName == "" {
+			if !granted.has(priv.Type, dbName, privilegeLevelAny) {
+				return NewMysqlError(ER_DBACCESS_DENIED_ERROR, ses.GetUserName(), ses.GetUserHost(), dbName)
+			}
+			continue
+		}
+		if !granted.has(priv.Type, dbName, priv.TableName) {
+			return NewMysqlError(ER_TABLEACCESS_DENIED_ERROR, strings.ToUpper(priv.Type.ToString()),
+				ses.GetUserName(), ses.GetUserHost(), priv.TableName)
+		}
+	}
+	return nil
+}
+
+// checkGlobalPrivilege checks the session user has at least one of the privileges on *.*
+func (ses *Session) checkGlobalPrivilege(typs ...tree.PrivilegeType) error {
+	if !ses.privilegesAreChecked() {
+		return nil
+	}
+	granted, err := ses.loadPrivileges()
+	if err != nil {
+		return err
+	}
+	names := make([]string, len(typs))
+	for i, typ := range typs {
+		if granted.has(typ, privilegeLevelAny, privilegeLevelAny) {
+			return nil
+		}
+		names[i] = strings.ToUpper(typ.ToString())
+	}
+	return NewMysqlError(ER_SPECIFIC_ACCESS_DENIED_ERROR, strings.Join(names, ", "))
+}
+
+// checkGrantable checks the session user can grant or revoke the privileges on the object.
+// It needs both the privilege and the GRANT OPTION.
+func (ses *Session) checkGrantable(privs []string, dbName, tableName string) error {
+	if !ses.privilegesAreChecked() {
+		return nil
+	}
+	granted, err := ses.loadPrivileges()
+	if err != nil {
+		return err
+	}
+	for _, priv := range append(privs, privilegeName(tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION)) {
+		if granted.has(privilegeTypeOfName(priv), dbName, tableName) {
+			continue
+		}
+		if dbName == privilegeLevelAny {
+			return NewMysqlError(ER_SPECIFIC_ACCESS_DENIED_ERROR, strings.ToUpper(priv))
+		}
+		if tableName == privilegeLevelAny {
+			return NewMysqlError(ER_DBACCESS_DENIED_ERROR, ses.GetUserName(), ses.GetUserHost(), dbName)
+		}
+		return NewMysqlError(ER_TABLEACCESS_DENIED_ERROR, strings.ToUpper(priv), ses.GetUserName(), ses.GetUserHost(), tableName)
+	}
+	return nil
+}
+
+func privilegeName(typ tree.PrivilegeType) string {
+	return typ.ToString()
+}
+
+// privilegeTypeOfName returns the privilege named by the ToString of tree.PrivilegeType
+func privilegeTypeOfName(name string) tree.PrivilegeType {
+	for typ := tree.PRIVILEGE_TYPE_STATIC_ALL; typ <= tree.PRIVILEGE_TYPE_STATIC_USAGE; typ++ {
+		if typ.ToString() == name {
+			return typ
+		}
+	}
+	return tree.PRIVILEGE_TYPE_STATIC_USAGE
+}
+
+// resolvePrivilegeLevel returns the database and the table of the privilege level.
+// They are * for the levels above.
+func (ses *Session) resolvePrivilegeLevel(objType tree.ObjectType, level *tree.PrivilegeLevel) (string, string, error) {
+	if objType != tree.OBJECT_TYPE_NONE && objType != tree.OBJECT_TYPE_TABLE {
+		return "", "", NewMysqlError(ER_NOT_SUPPORTED_YET, "privileges on "+objType.ToString())
+	}
+	dbName := level.DbName
+	if level.Level != tree.PRIVILEGE_LEVEL_TYPE_GLOBAL && dbName == "" {
+		//* or tbl means the current database
+		if dbName = ses.GetDatabaseName(); dbName == "" {
+			return "", "", NewMysqlError(ER_NO_DB_ERROR)
+		}
+	}
+	switch level.Level {
+	case tree.PRIVILEGE_LEVEL_TYPE_GLOBAL:
+		return privilegeLevelAny, privilegeLevelAny, nil
+	case tree.PRIVILEGE_LEVEL_TYPE_DATABASE:
+		return dbName, privilegeLevelAny, nil
+	case tree.PRIVILEGE_LEVEL_TYPE_TABLE:
+		return dbName, level.TabName, nil
+	}
+	return "", "", NewMysqlError(ER_ILLEGAL_GRANT_FOR_TABLE)
+}
+
+// expandPrivileges returns the names of the privileges in the GRANT or the REVOKE.
+// ALL is expanded to the privileges of the level.
+func expandPrivileges(privs []*tree.Privilege, level tree.PrivilegeLevelType, grantOption bool) ([]string, error) {
+	valid := privilegesOfLevel(level)
+	var names []string
+	add := func(typ tree.PrivilegeType) {
+		name := typ.ToString()
+		for _, n := range names {
+			if n == name {
+				return
+			}
+		}
+		names = append(names, name)
+	}
+	for _, priv := range privs {
+		if len(priv.ColumnList) != 0 {
+			return nil, NewMysqlError(ER_NOT_SUPPORTED_YET, "column privileges")
+		}
+		switch priv.Type {
+		case tree.PRIVILEGE_TYPE_STATIC_ALL:
+			for _, typ := range valid {
+				add(typ)
+			}
+		case tree.PRIVILEGE_TYPE_STATIC_USAGE:
+		case tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION:
+			add(priv.Type)
+		default:
+			found := false
+			for _, typ := range valid {
+				found = found || typ == priv.Type
+			}
+			if !found {
+				return nil, NewMysqlError(ER_ILLEGAL_GRANT_FOR_TABLE)
+			}
+			add(priv.Type)
+		}
+	}
+	if grantOption {
+		add(tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION)
+	}
+	return names, nil
+}
+
+// writeCatalogRows writes the rows into the catalog table
+func writeCatalogRows(table engine.Relation, snapshot engine.Snapshot, sch *CatalogSchema, rows [][]string) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	return table.Write(0, PrepareInitialDataForSchema(sch, rows), snapshot)
+}
+
+// deleteCatalogRows deletes the rows from the catalog table by their primary keys
+func deleteCatalogRows(table engine.Relation, snapshot engine.Snapshot, sch *CatalogSchema, rows [][]string) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	bat := PrepareInitialDataForSchema(sch, rows)
+	for i, attr := range sch.GetAttributes() {
+		if attr.GetIsPrimaryKey() {
+			return table.Delete(0, bat.GetVector(int32(i)), attr.GetName(), snapshot)
+		}
+	}
+	return errorMissingPrimaryKey
+}
+
+// formatAuthID formats the user or the role like 'name'@'host'
+func formatAuthID(name, host string) string {
+	if host == "" {
+		host = "%"
+	}
+	return fmt.Sprintf("'%s'@'%s'", name, host)
+}
+
+// deleteGrantsOf deletes the privileges and the roles granted to the user or the role,
+// and the grants of the role
+func deleteGrantsOf(storage engine.Engine, snapshot engine.Snapshot, name string) error {
+	grants, err := getPrivilegeGrants(storage, snapshot)
+	if err != nil {
+		return err
+	}
+	var rows [][]string
+	for _, grant := range grants {
+		if grant.grantee == name {
+			rows = append(rows, grant.row())
+		}
+	}
+	table, err := getCatalogTable(storage, snapshot, moPrivilegeTableName)
+	if err != nil {
+		return err
+	}
+	if err = deleteCatalogRows(table, snapshot, DefineSchemaForMoPrivilege(), rows); err != nil {
+		return err
+	}
+
+	roleGrants, err := getRoleGrants(storage, snapshot)
+	if err != nil {
+		return err
+	}
+	rows = nil
+	for _, grant := range roleGrants {
+		if grant.grantee == name || grant.role == name {
+			rows = append(rows, grant.row())
+		}
+	}
+	table, err = getCatalogTable(storage, snapshot, moRoleGrantTableName)
+	if err != nil {
+		return err
+	}
+	return deleteCatalogRows(table, snapshot, DefineSchemaForMoRoleGrant(), rows)
+}
+
+/*
+handle CREATE ROLE
+*/
+func (mce *MysqlCmdExecutor) handleCreateRole(cr *tree.CreateRole) error {
+	ses := mce.GetSession()
+	if err := ses.checkGlobalPrivilege(tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE, tree.PRIVILEGE_TYPE_STATIC_CREATE_USER); err != nil {
+		return err
+	}
+	snapshot := ses.GetTxnHandler().GetTxn().GetCtx()
+	table, err := getCatalogTable(ses.Pu.StorageEngine, snapshot, moRoleTableName)
+	if err != nil {
+		return err
+	}
+	for _, role := range cr.Roles {
+		exists, err := authIDExists(ses.Pu.StorageEngine, snapshot, role.UserName, role.HostName)
+		if err != nil {
+			return err
+		}
+		if exists {
+			if cr.IfNotExists {
+				continue
+			}
+			return NewMysqlError(ER_CANNOT_USER, "CREATE ROLE", formatAuthID(role.UserName, role.HostName))
+		}
+		host := role.HostName
+		if host == "" {
+			host = "%"
+		}
+		row := []string{formatAuthID(role.UserName, host), host, role.UserName}
+		if err = writeCatalogRows(table, snapshot, DefineSchemaForMoRole(), [][]string{row}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+handle DROP ROLE
+*/
+func (mce *MysqlCmdExecutor) handleDropRole(dr *tree.DropRole) error {
+	ses := mce.GetSession()
+	if err := ses.checkGlobalPrivilege(tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE, tree.PRIVILEGE_TYPE_STATIC_CREATE_USER); err != nil {
+		return err
+	}
+	snapshot := ses.GetTxnHandler().GetTxn().GetCtx()
+	table, err := getCatalogTable(ses.Pu.StorageEngine, snapshot, moRoleTableName)
+	if err != nil {
+		return err
+	}
+	for _, role := range dr.Roles {
+		exists, err := roleExists(ses.Pu.StorageEngine, snapshot, role.UserName, role.HostName)
+		if err != nil {
+			return err
+		}
+		key := formatAuthID(role.UserName, role.HostName)
+		if !exists {
+			if dr.IfExists {
+				continue
+			}
+			return NewMysqlError(ER_CANNOT_USER, "DROP ROLE", key)
+		}
+		if err = deleteCatalogRows(table, snapshot, DefineSchemaForMoRole(), [][]string{{key, role.HostName, role.UserName}}); err != nil {
+			return err
+		}
+		if err = deleteGrantsOf(ses.Pu.StorageEngine, snapshot, key); err != nil {
+			return err
+		}
+		ses.invalidatePrivileges()
+	}
+	return nil
+}
+
+/*
+handle GRANT
+*/
+func (mce *MysqlCmdExecutor) handleGrant(g *tree.Grant) error {
+	if g.IsProxy {
+		return NewMysqlError(ER_NOT_SUPPORTED_YET, "GRANT PROXY")
+	}
+	if g.IsGrantRole {
+		return mce.handleGrantRole(g.RolesInGrantRole, g.Users)
+	}
+	ses := mce.GetSession()
+	privs, dbName, tableName, err := ses.resolvePrivileges(g.Privileges, g.ObjType, g.Level, g.GrantOption)
+	if err != nil {
+		return err
+	}
+	if err = ses.checkGrantable(privs, dbName, tableName); err != nil {
+		return err
+	}
+
+	snapshot := ses.GetTxnHandler().GetTxn().GetCtx()
+	grants, err := getPrivilegeGrants(ses.Pu.StorageEngine, snapshot)
+	if err != nil {
+		return err
+	}
+	granted := make(map[privilegeGrant]bool, len(grants))
+	for _, grant := range grants {
+		granted[*grant] = true
+	}
+	var rows [][]string
+	for _, user := range g.Users {
+		exists, err := authIDExists(ses.Pu.StorageEngine, snapshot, user.Username, user.Hostname)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return NewMysqlError(ER_CANT_CREATE_USER_WITH_GRANT)
+		}
+		for _, priv := range privs {
+			grant := privilegeGrant{grantee: formatAuthID(user.Username, user.Hostname), dbName: dbName, tableName: tableName, privilege: priv}
+			if !granted[grant] {
+				granted[grant] = true
+				rows = append(rows, grant.row())
+			}
+		}
+	}
+	table, err := getCatalogTable(ses.Pu.StorageEngine, snapshot, moPrivilegeTableName)
+	if err != nil {
+		return err
+	}
+	if err = writeCatalogRows(table, snapshot, DefineSchemaForMoPrivilege(), rows); err != nil {
+		return err
+	}
+	ses.invalidatePrivileges()
+	return nil
+}
+
+/*
+handle REVOKE
+*/
+func (mce *MysqlCmdExecutor) handleRevoke(r *tree.Revoke) error {
+	if r.IsRevokeRole {
+		return mce.handleRevokeRole(r.RolesInRevokeRole, r.Users)
+	}
+	ses := mce.GetSession()
+	privs, dbName, tableName, err := ses.resolvePrivileges(r.Privileges, r.ObjType, r.Level, false)
+	if err != nil {
+		return err
+	}
+	if err = ses.checkGrantable(privs, dbName, tableName); err != nil {
+		return err
+	}
+
+	snapshot := ses.GetTxnHandler().GetTxn().GetCtx()
+	grants, err := getPrivilegeGrants(ses.Pu.StorageEngine, snapshot)
+	if err != nil {
+		return err
+	}
+	granted := make(map[privilegeGrant]bool, len(grants))
+	for _, grant := range grants {
+		granted[*grant] = true
+	}
+	var rows [][]string
+	for _, user := range r.Users {
+		revoked := false
+		for _, priv := range privs {
+			grant := privilegeGrant{grantee: formatAuthID(user.Username, user.Hostname), dbName: dbName, tableName: tableName, privilege: priv}
+			if granted[grant] {
+				delete(granted, grant)
+				rows = append(rows, grant.row())
+				revoked = true
+			}
+		}
+		if revoked || len(privs) == 0 {
+			continue
+		}
+		host := user.Hostname
+		if host == "" {
+			host = "%"
+		}
+		if tableName == privilegeLevelAny {
+			return NewMysqlError(ER_NONEXISTING_GRANT, user.Username, host)
+		}
+		return NewMysqlError(ER_NONEXISTING_TABLE_GRANT, user.Username, host, tableName)
+	}
+	table, err := getCatalogTable(ses.Pu.StorageEngine, snapshot, moPrivilegeTableName)
+	if err != nil {
+		return err
+	}
+	if err = deleteCatalogRows(table, snapshot, DefineSchemaForMoPrivilege(), rows); err != nil {
+		return err
+	}
+	ses.invalidatePrivileges()
+	return nil
+}
+
+// resolvePrivileges returns the names of the privileges in GRANT or REVOKE and the object of them
+func (ses *Session) resolvePrivileges(privs []*tree.Privilege, objType tree.ObjectType, level *tree.PrivilegeLevel, grantOption bool) ([]string, string, string, error) {
+	dbName, tableName, err := ses.resolvePrivilegeLevel(objType, level)
+	if err != nil {
+		return nil, "", "", err
+	}
+	names, err := expandPrivileges(privs, level.Level, grantOption)
+	if err != nil {
+		return nil, "", "", err
+	}
+	return names, dbName, tableName, nil
+}
+
+/*
+handle GRANT role TO user.
+Granting roles needs the SUPER privilege.
+*/
+func (mce *MysqlCmdExecutor) handleGrantRole(roles []*tree.Role, users []*tree.User) error {
+	ses := mce.GetSession()
+	if err := ses.checkGlobalPrivilege(tree.PRIVILEGE_TYPE_STATIC_SUPER); err != nil {
+		return err
+	}
+	snapshot := ses.GetTxnHandler().GetTxn().GetCtx()
+	for _, role := range roles {
+		exists, err := roleExists(ses.Pu.StorageEngine, snapshot, role.UserName, role.HostName)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return NewMysqlError(ER_UNKNOWN_AUTHID, role.UserName, role.HostName)
+		}
+	}
+	for _, user := range users {
+		exists, err := authIDExists(ses.Pu.StorageEngine, snapshot, user.Username, user.Hostname)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return NewMysqlError(ER_UNKNOWN_AUTHID, user.Username, user.Hostname)
+		}
+	}
+
+	grants, err := getRoleGrants(ses.Pu.StorageEngine, snapshot)
+	if err != nil {
+		return err
+	}
+	granted := make(map[roleGrant]bool, len(grants))
+	for _, grant := range grants {
+		granted[*grant] = true
+	}
+	var rows [][]string
+	for _, role := range roles {
+		for _, user := range users {
+			grant := roleGrant{role: formatAuthID(role.UserName, role.HostName), grantee: formatAuthID(user.Username, user.Hostname)}
+			if granted[grant] {
+				continue
+			}
+			//the grantee must not be the role or one of the roles granted to the role
+			if getGrantedRoles(grants, grant.role)[grant.grantee] {
+				return NewMysqlError(ER_ROLE_GRANTED_TO_ITSELF, formatAuthID(user.Username, user.Hostname), formatAuthID(role.UserName, role.HostName))
+			}
+			granted[grant] = true
+			grants = append(grants, &grant)
+			rows = append(rows, grant.row())
+		}
+	}
+	table, err := getCatalogTable(ses.Pu.StorageEngine, snapshot, moRoleGrantTableName)
+	if err != nil {
+		return err
+	}
+	if err = writeCatalogRows(table, snapshot, DefineSchemaForMoRoleGrant(), rows); err != nil {
+		return err
+	}
+	ses.invalidatePrivileges()
+	return nil
+}
+
+/*
+handle REVOKE role FROM user
+*/
+func (mce *MysqlCmdExecutor) handleRevokeRole(roles []*tree.Role, users []*tree.User) error {
+	ses := mce.GetSession()
+	if err := ses.checkGlobalPrivilege(tree.PRIVILEGE_TYPE_STATIC_SUPER); err != nil {
+		return err
+	}
+	snapshot := ses.GetTxnHandler().GetTxn().GetCtx()
+	grants, err := getRoleGrants(ses.Pu.StorageEngine, snapshot)
+	if err != nil {
+		return err
+	}
+	granted := make(map[roleGrant]bool, len(grants))
+	for _, grant := range grants {
+		granted[*grant] = true
+	}
+	var rows [][]string
+	for _, role := range roles {
+		for _, user := range users {
+			grant := roleGrant{role: formatAuthID(role.UserName, role.HostName), grantee: formatAuthID(user.Username, user.Hostname)}
+			if !granted[grant] {
+				return NewMysqlError(ER_ROLE_NOT_GRANTED, role.UserName, role.HostName, user.Username, user.Hostname)
+			}
+			delete(granted, grant)
+			rows = append(rows, grant.row())
+		}
+	}
+	table, err := getCatalogTable(ses.Pu.StorageEngine, snapshot, moRoleGrantTableName)
+	if err != nil {
+		return err
+	}
+	if err = deleteCatalogRows(table, snapshot, DefineSchemaForMoRoleGrant(), rows); err != nil {
+		return err
+	}
+	ses.invalidatePrivileges()
+	return nil
+}
@@ -0,0 +1,209 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func mysqlErrorCode(err error) uint16 {
+	if me, ok := err.(*MysqlError); ok {
+		return me.ErrorCode
+	}
+	return 0
+}
+
+func TestExpandPrivileges(t *testing.T) {
+	convey.Convey("expand privileges", t, func() {
+		all := []*tree.Privilege{{Type: tree.PRIVILEGE_TYPE_STATIC_ALL}}
+		names, err := expandPrivileges(all, tree.PRIVILEGE_LEVEL_TYPE_TABLE, true)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(names, convey.ShouldHaveLength, len(tablePrivileges)+1)
+		convey.So(names, convey.ShouldContain, "grant option")
+
+		names, err = expandPrivileges(all, tree.PRIVILEGE_LEVEL_TYPE_GLOBAL, false)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(names, convey.ShouldHaveLength, len(privilegesOfLevel(tree.PRIVILEGE_LEVEL_TYPE_GLOBAL)))
+		convey.So(names, convey.ShouldNotContain, "grant option")
+
+		names, err = expandPrivileges([]*tree.Privilege{
+			{Type: tree.PRIVILEGE_TYPE_STATIC_SELECT},
+			{Type: tree.PRIVILEGE_TYPE_STATIC_SELECT},
+			{Type: tree.PRIVILEGE_TYPE_STATIC_USAGE},
+		}, tree.PRIVILEGE_LEVEL_TYPE_DATABASE, false)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(names, convey.ShouldResemble, []string{"select"})
+
+		_, err = expandPrivileges([]*tree.Privilege{{Type: tree.PRIVILEGE_TYPE_STATIC_CREATE_USER}}, tree.PRIVILEGE_LEVEL_TYPE_DATABASE, false)
+		convey.So(mysqlErrorCode(err), convey.ShouldEqual, ER_ILLEGAL_GRANT_FOR_TABLE)
+		_, err = expandPrivileges([]*tree.Privilege{{Type: tree.PRIVILEGE_TYPE_STATIC_SELECT, ColumnList: []*tree.UnresolvedName{{}}}}, tree.PRIVILEGE_LEVEL_TYPE_TABLE, false)
+		convey.So(mysqlErrorCode(err), convey.ShouldEqual, ER_NOT_SUPPORTED_YET)
+	})
+}
+
+func TestPrivilege(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	eng, ses, proto := newTaeSession(t, ctrl)
+	mce := NewMysqlCmdExecutor()
+	mce.PrepareSessionBeforeExecRequest(ses)
+	exec := func(sql string) error {
+		return execSelfHandledStmt(t, mce, sql)
+	}
+	check := func(typ tree.PrivilegeType, dbName, tableName string) error {
+		if err := ses.GetTxnHandler().StartByAutocommit(); err != nil {
+			return err
+		}
+		defer func() {
+			_ = ses.GetTxnHandler().CommitAfterAutocommitOnly()
+		}()
+		return ses.CheckPrivileges([]*plan2.RequiredPrivilege{{Type: typ, DbName: dbName, TableName: tableName}})
+	}
+	//checkPlan checks the privileges of the plan as Compile does
+	checkPlan := func(sql string) error {
+		if err := ses.GetTxnHandler().StartByAutocommit(); err != nil {
+			return err
+		}
+		defer func() {
+			_ = ses.GetTxnHandler().CommitAfterAutocommitOnly()
+		}()
+		p, err := plan2.BuildPlan(ses.GetTxnCompilerContext(), parseUserStmt(t, sql))
+		if err != nil {
+			return err
+		}
+		return plan2.CheckPlanPrivileges(ses.GetTxnCompilerContext(), p)
+	}
+
+	convey.Convey("grant privileges and roles", t, func() {
+		setSessionUser(proto, "root", "localhost")
+		convey.So(exec("create user u1 identified by '1', u2 identified by '2'"), convey.ShouldBeNil)
+		convey.So(exec("create role r1, r2"), convey.ShouldBeNil)
+		convey.So(mysqlErrorCode(exec("create role u1")), convey.ShouldEqual, ER_CANNOT_USER)
+		convey.So(mysqlErrorCode(exec("create user r1")), convey.ShouldEqual, ER_CANNOT_USER)
+		convey.So(exec("create role if not exists r1"), convey.ShouldBeNil)
+
+		convey.So(exec("grant select on db1.* to r1"), convey.ShouldBeNil)
+		convey.So(exec("grant r1 to r2"), convey.ShouldBeNil)
+		convey.So(exec("grant r2 to u1"), convey.ShouldBeNil)
+		convey.So(exec("grant insert on db1.t1 to u1"), convey.ShouldBeNil)
+		convey.So(exec("grant insert on db1.t1 to u1"), convey.ShouldBeNil)
+		convey.So(mysqlErrorCode(exec("grant r2 to r1")), convey.ShouldEqual, ER_ROLE_GRANTED_TO_ITSELF)
+		convey.So(mysqlErrorCode(exec("grant r3 to u1")), convey.ShouldEqual, ER_UNKNOWN_AUTHID)
+		convey.So(mysqlErrorCode(exec("grant select on db1.* to u3")), convey.ShouldEqual, ER_CANT_CREATE_USER_WITH_GRANT)
+		convey.So(mysqlErrorCode(exec("grant create user on db1.* to u1")), convey.ShouldEqual, ER_ILLEGAL_GRANT_FOR_TABLE)
+		convey.So(mysqlErrorCode(exec("grant select on * to u1")), convey.ShouldEqual, ER_NO_DB_ERROR)
+
+		setSessionUser(proto, "u1", "%")
+		convey.So(check(tree.PRIVILEGE_TYPE_STATIC_SELECT, "db1", "t2"), convey.ShouldBeNil)
+		convey.So(check(tree.PRIVILEGE_TYPE_STATIC_INSERT, "db1", "t1"), convey.ShouldBeNil)
+		convey.So(mysqlErrorCode(check(tree.PRIVILEGE_TYPE_STATIC_INSERT, "db1", "t2")), convey.ShouldEqual, ER_TABLEACCESS_DENIED_ERROR)
+		convey.So(mysqlErrorCode(check(tree.PRIVILEGE_TYPE_STATIC_SELECT, "db2", "t1")), convey.ShouldEqual, ER_TABLEACCESS_DENIED_ERROR)
+		convey.So(mysqlErrorCode(check(tree.PRIVILEGE_TYPE_STATIC_CREATE, "db1", "")), convey.ShouldEqual, ER_DBACCESS_DENIED_ERROR)
+		convey.So(check(tree.PRIVILEGE_TYPE_STATIC_SELECT, moCatalogDbName, "mo_tables"), convey.ShouldBeNil)
+		convey.So(mysqlErrorCode(check(tree.PRIVILEGE_TYPE_STATIC_SELECT, moCatalogDbName, moUserTableName)), convey.ShouldEqual, ER_TABLEACCESS_DENIED_ERROR)
+
+		convey.So(mysqlErrorCode(checkPlan("create database db2")), convey.ShouldEqual, ER_DBACCESS_DENIED_ERROR)
+		convey.So(mysqlErrorCode(checkPlan("drop table db1.t1")), convey.ShouldEqual, ER_TABLEACCESS_DENIED_ERROR)
+
+		//u1 can not manage the accounts and the privileges
+		convey.So(mysqlErrorCode(exec("create user u3")), convey.ShouldEqual, ER_SPECIFIC_ACCESS_DENIED_ERROR)
+		convey.So(mysqlErrorCode(exec("create role r3")), convey.ShouldEqual, ER_SPECIFIC_ACCESS_DENIED_ERROR)
+		convey.So(mysqlErrorCode(exec("grant r1 to u2")), convey.ShouldEqual, ER_SPECIFIC_ACCESS_DENIED_ERROR)
+		convey.So(mysqlErrorCode(exec("grant select on db1.* to u2")), convey.ShouldEqual, ER_DBACCESS_DENIED_ERROR)
+	})
+
+	convey.Convey("grant option", t, func() {
+		setSessionUser(proto, "root", "localhost")
+		convey.So(exec("grant all on db1.* to u2 with grant option"), convey.ShouldBeNil)
+
+		setSessionUser(proto, "u2", "%")
+		convey.So(checkPlan("create database db1"), convey.ShouldBeNil)
+		convey.So(exec("grant delete on db1.t2 to u1"), convey.ShouldBeNil)
+		convey.So(mysqlErrorCode(exec("grant delete on db2.t2 to u1")), convey.ShouldEqual, ER_TABLEACCESS_DENIED_ERROR)
+
+		setSessionUser(proto, "u1", "%")
+		convey.So(check(tree.PRIVILEGE_TYPE_STATIC_DELETE, "db1", "t2"), convey.ShouldBeNil)
+	})
+
+	convey.Convey("revoke privileges and roles", t, func() {
+		setSessionUser(proto, "root", "localhost")
+		convey.So(exec("revoke select on db1.* from u2"), convey.ShouldBeNil)
+		convey.So(mysqlErrorCode(exec("revoke select on db1.* from u2")), convey.ShouldEqual, ER_NONEXISTING_GRANT)
+		convey.So(mysqlErrorCode(exec("revoke select on db1.t1 from u2")), convey.ShouldEqual, ER_NONEXISTING_TABLE_GRANT)
+		convey.So(exec("revoke r2 from u1"), convey.ShouldBeNil)
+		convey.So(mysqlErrorCode(exec("revoke r2 from u1")), convey.ShouldEqual, ER_ROLE_NOT_GRANTED)
+
+		setSessionUser(proto, "u2", "%")
+		convey.So(check(tree.PRIVILEGE_TYPE_STATIC_INSERT, "db1", "t1"), convey.ShouldBeNil)
+		convey.So(mysqlErrorCode(check(tree.PRIVILEGE_TYPE_STATIC_SELECT, "db1", "t1")), convey.ShouldEqual, ER_TABLEACCESS_DENIED_ERROR)
+		setSessionUser(proto, "u1", "%")
+		convey.So(mysqlErrorCode(check(tree.PRIVILEGE_TYPE_STATIC_SELECT, "db1", "t2")), convey.ShouldEqual, ER_TABLEACCESS_DENIED_ERROR)
+	})
+
+	convey.Convey("drop the grantees", t, func() {
+		setSessionUser(proto, "root", "localhost")
+		convey.So(exec("grant r1 to u1"), convey.ShouldBeNil)
+		convey.So(exec("drop role r1"), convey.ShouldBeNil)
+		convey.So(mysqlErrorCode(exec("drop role r1")), convey.ShouldEqual, ER_CANNOT_USER)
+		convey.So(exec("drop user u2"), convey.ShouldBeNil)
+
+		txnCtx, err := eng.StartTxn(nil)
+		convey.So(err, convey.ShouldBeNil)
+		grants, err := getPrivilegeGrants(eng, txnCtx.GetCtx())
+		convey.So(err, convey.ShouldBeNil)
+		for _, grant := range grants {
+			convey.So(grant.grantee, convey.ShouldNotBeIn, "'r1'@'%'", "'u2'@'%'")
+		}
+		roleGrants, err := getRoleGrants(eng, txnCtx.GetCtx())
+		convey.So(err, convey.ShouldBeNil)
+		for _, grant := range roleGrants {
+			convey.So(grant.role, convey.ShouldNotEqual, "'r1'@'%'")
+		}
+		convey.So(txnCtx.Commit(), convey.ShouldBeNil)
+
+		setSessionUser(proto, "u1", "%")
+		convey.So(mysqlErrorCode(check(tree.PRIVILEGE_TYPE_STATIC_SELECT, "db1", "t2")), convey.ShouldEqual, ER_TABLEACCESS_DENIED_ERROR)
+	})
+
+	convey.Convey("cache the privileges", t, func() {
+		setSessionUser(proto, "root", "localhost")
+		convey.So(exec("grant select on db1.t3 to u1"), convey.ShouldBeNil)
+		setSessionUser(proto, "u1", "%")
+		convey.So(check(tree.PRIVILEGE_TYPE_STATIC_SELECT, "db1", "t3"), convey.ShouldBeNil)
+
+		//the privileges changed without GRANT or REVOKE are not seen
+		txnCtx, err := eng.StartTxn(nil)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(deleteGrantsOf(eng, txnCtx.GetCtx(), "'u1'@'%'"), convey.ShouldBeNil)
+		convey.So(txnCtx.Commit(), convey.ShouldBeNil)
+		convey.So(check(tree.PRIVILEGE_TYPE_STATIC_SELECT, "db1", "t3"), convey.ShouldBeNil)
+
+		//until the privileges are changed by any session
+		version := atomic.LoadUint64(&privilegeVersion)
+		ses.invalidatePrivileges()
+		convey.So(mysqlErrorCode(check(tree.PRIVILEGE_TYPE_STATIC_SELECT, "db1", "t3")), convey.ShouldEqual, ER_TABLEACCESS_DENIED_ERROR)
+		//and once more after the txn of the change ends
+		ses.invalidatePrivilegesAfterTxn()
+		convey.So(atomic.LoadUint64(&privilegeVersion), convey.ShouldEqual, version+2)
+		ses.invalidatePrivilegesAfterTxn()
+		convey.So(atomic.LoadUint64(&privilegeVersion), convey.ShouldEqual, version+2)
+	})
+}
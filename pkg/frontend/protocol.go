@@ -15,11 +15,13 @@
 package frontend
 
 import (
+	"errors"
 	"fmt"
 	"net"
 	"sync"
 
 	"github.com/fagongzi/goetty"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 )
 
@@ -157,6 +159,11 @@ type Protocol interface {
 
 	SetUserName(string)
 
+	// GetUserHost gets the host of the account that the user logged in as
+	GetUserHost() string
+
+	SetUserHost(string)
+
 	// Quit
 	Quit()
 }
@@ -228,6 +235,20 @@ func (mp *MysqlProtocolImpl) GetRequest(payload []byte) *Request {
 	return req
 }
 
+// moErrorCodes maps the errors raised while reading or writing rows to MySQL
+// error codes
+var moErrorCodes = map[int32]uint16{
+	moerr.DUPLICATE_ENTRY:   ER_DUP_ENTRY,
+	moerr.BAD_NULL_VALUE:    ER_BAD_NULL_ERROR,
+	moerr.CHECK_VIOLATION:   ER_CHECK_CONSTRAINT_VIOLATED,
+	moerr.ROW_IS_REFERENCED: ER_ROW_IS_REFERENCED_2,
+	moerr.NO_REFERENCED_ROW: ER_NO_REFERENCED_ROW_2,
+	moerr.FK_DEPTH_EXCEEDED: ER_FK_DEPTH_EXCEEDED,
+	moerr.FK_DROP_PARENT:    ER_FK_CANNOT_DROP_PARENT,
+	moerr.NO_PARTITION:      ER_NO_PARTITION_FOR_GIVEN_VALUE,
+	moerr.SUBQUERY_NO_1_ROW: ER_SUBQUERY_NO_1_ROW,
+}
+
 func (mp *MysqlProtocolImpl) SendResponse(resp *Response) error {
 	mp.GetLock().Lock()
 	defer mp.GetLock().Unlock()
@@ -250,6 +271,12 @@ func (mp *MysqlProtocolImpl) SendResponse(resp *Response) error {
 		case *MysqlError:
 			return mp.sendErrPacket(myerr.ErrorCode, myerr.SqlState, myerr.Error())
 		}
+		var moErr *moerr.Error
+		if errors.As(err, &moErr) {
+			if code, ok := moErrorCodes[moErr.Code]; ok {
+				return mp.sendErrPacket(code, errorMsgRefer[code].sqlStates[0], moErr.Error())
+			}
+		}
 		return mp.sendErrPacket(ER_UNKNOWN_ERROR, DefaultMySQLState, fmt.Sprintf("%v", err))
 	case ResultResponse:
 		mer := resp.data.(*MysqlExecutionResult)
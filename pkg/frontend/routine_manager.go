@@ -15,6 +15,7 @@
 package frontend
 
 import (
+	"crypto/tls"
 	"errors"
 	"sync"
 
@@ -27,6 +28,9 @@ type RoutineManager struct {
 	rwlock  sync.RWMutex
 	clients map[goetty.IOSession]*Routine
 	pu      *config.ParameterUnit
+
+	//the TLS configuration of the server. nil means TLS is disabled
+	tlsConfig *tls.Config
 }
 
 func (rm *RoutineManager) getParameterUnit() *config.ParameterUnit {
@@ -35,6 +39,8 @@ func (rm *RoutineManager) getParameterUnit() *config.ParameterUnit {
 
 func (rm *RoutineManager) Created(rs goetty.IOSession) {
 	pro := NewMysqlClientProtocol(nextConnectionID(), rs, int(rm.pu.SV.GetMaxBytesInOutbufToFlush()), rm.pu.SV)
+	pro.SetStorage(rm.pu.StorageEngine)
+	pro.SetTLSConfig(rm.tlsConfig)
 	exe := NewMysqlCmdExecutor()
 	exe.SetRoutineManager(rm)
 
@@ -139,6 +145,11 @@ func (rm *RoutineManager) Handler(rs goetty.IOSession, msg interface{}, received
 			logutil.Infof("RP[%v] Payload80[%v]",rs.RemoteAddr(),di)
 		*/
 
+		//the client asks for TLS before sending the handshake response
+		if protocol.isSSLRequest(payload) {
+			return protocol.upgradeToTLS()
+		}
+
 		err := protocol.handleHandshake(payload)
 		if err != nil {
 			return err
@@ -16,6 +16,7 @@ package frontend
 
 import (
 	"fmt"
+	"net"
 	"sync/atomic"
 
 	"github.com/fagongzi/goetty"
@@ -60,13 +61,29 @@ func nextConnectionID() uint32 {
 func NewMOServer(addr string, pu *config.ParameterUnit) *MOServer {
 	encoder, decoder := NewSqlCodec()
 	rm := NewRoutineManager(pu)
+	tlsConfig, err := newTLSConfig(pu.SV)
+	if err != nil {
+		logutil.Panicf("start server failed with %+v", err)
+	}
+	rm.tlsConfig = tlsConfig
 	// TODO asyncFlushBatch
-	app, err := goetty.NewTCPApplication(addr, rm.Handler,
+	opts := []goetty.AppOption{
 		goetty.WithAppSessionOptions(
 			goetty.WithCodec(encoder, decoder),
 			goetty.WithLogger(logutil.GetGlobalLogger()),
 			goetty.WithBufSize(1024*1024, 1024*1024)),
-		goetty.WithAppSessionAware(rm))
+		goetty.WithAppSessionAware(rm),
+	}
+	var app goetty.NetApplication
+	if tlsConfig == nil {
+		app, err = goetty.NewTCPApplication(addr, rm.Handler, opts...)
+	} else {
+		//the connections are switched to TLS during the handshake
+		var listener net.Listener
+		if listener, err = net.Listen("tcp4", addr); err == nil {
+			app, err = goetty.NewApplication(&upgradableListener{Listener: listener}, rm.Handler, opts...)
+		}
+	}
 	if err != nil {
 		logutil.Panicf("start server failed with %+v", err)
 	}
@@ -55,6 +55,9 @@ const (
 
 const MaxPrepareNumberInOneSession = 64
 
+// the prefix of the names of the statements prepared by COM_STMT_PREPARE
+const prefixPrepareStmtName = "__mo_stmt_id"
+
 // TxnState represents for Transaction Machine
 type TxnState struct {
 	state     int
@@ -189,6 +192,13 @@ type Session struct {
 	gSysVars        *GlobalSystemVariables
 
 	prepareStmts map[string]*PrepareStmt
+	lastStmtId   uint32
+
+	//the first auto increment value allocated by the last insert
+	lastInsertID uint64
+
+	//the privileges of the session user
+	privileges privilegeCache
 }
 
 func NewSession(proto Protocol, gm *guest.Mmu, mp *mempool.Mempool, PU *config.ParameterUnit, gSysVars *GlobalSystemVariables) *Session {
@@ -238,6 +248,17 @@ func (ses *Session) RemovePrepareStmt(name string) {
 	delete(ses.prepareStmts, name)
 }
 
+// GenNewStmtId generates the id of the statement prepared by COM_STMT_PREPARE
+func (ses *Session) GenNewStmtId() uint32 {
+	ses.lastStmtId++
+	return ses.lastStmtId
+}
+
+// GetPrepareStmtNameOfId returns the name of the statement prepared by COM_STMT_PREPARE
+func GetPrepareStmtNameOfId(stmtID uint32) string {
+	return fmt.Sprintf("%s_%d", prefixPrepareStmtName, stmtID)
+}
+
 // SetGlobalVar sets the value of system variable in global.
 //used by SET GLOBAL
 func (ses *Session) SetGlobalVar(name string, value interface{}) error {
@@ -347,6 +368,28 @@ func (ses *Session) GetDatabaseName() string {
 	return ses.protocol.GetDatabaseName()
 }
 
+func (ses *Session) GetLastInsertID() uint64 {
+	return ses.lastInsertID
+}
+
+// SetLastInsertID records the first auto increment value allocated by a
+// statement, the statements allocating nothing keep the previous one
+func (ses *Session) SetLastInsertID(id uint64) {
+	if id != 0 {
+		ses.lastInsertID = id
+	}
+}
+
+// ForeignKeyChecks returns whether @@foreign_key_checks is on in the session
+func (ses *Session) ForeignKeyChecks() bool {
+	val, err := ses.GetSessionVar("foreign_key_checks")
+	if err != nil {
+		return true
+	}
+	cv, err := InitSystemVariableBoolType("foreign_key_checks").Convert(val)
+	return err != nil || cv == int8(1)
+}
+
 func (ses *Session) SetDatabaseName(db string) {
 	ses.protocol.SetDatabaseName(db)
 	ses.txnCompileCtx.SetDatabase(db)
@@ -364,6 +407,10 @@ func (ses *Session) SetUserName(uname string) {
 	ses.protocol.SetUserName(uname)
 }
 
+func (ses *Session) GetUserHost() string {
+	return ses.protocol.GetUserHost()
+}
+
 func (ses *Session) GetConnectionID() uint32 {
 	return ses.protocol.ConnectionID()
 }
@@ -735,27 +782,10 @@ func (tcc *TxnCompilerContext) Resolve(dbName string, tableName string) (*plan2.
 	if err != nil {
 		return nil, nil
 	}
-	engineDefs := table.TableDefs(tcc.txnHandler.GetTxn().GetCtx())
-
-	var defs []*plan2.ColDef
-	for _, def := range engineDefs {
-		if attr, ok := def.(*engine.AttributeDef); ok {
-			defs = append(defs, &plan2.ColDef{
-				Name: attr.Attr.Name,
-				Typ: &plan2.Type{
-					Id:        plan.Type_TypeId(attr.Attr.Type.Oid),
-					Width:     attr.Attr.Type.Width,
-					Precision: attr.Attr.Type.Precision,
-					Scale:     attr.Attr.Type.Scale,
-				},
-				Primary: attr.Attr.Primary,
-				Default: plan2.MakePlan2DefaultExpr(attr.Attr.Default),
-			})
-		}
-	}
-	if tcc.QryTyp != TXN_DEFAULT {
+	tableDef := engineDefsToTableDef(tableName, table.TableDefs(tcc.txnHandler.GetTxn().GetCtx()))
+	if tcc.QryTyp != TXN_DEFAULT && tableDef.View == nil {
 		hideKey := table.GetHideKey(tcc.txnHandler.GetTxn().GetCtx())
-		defs = append(defs, &plan2.ColDef{
+		tableDef.Cols = append(tableDef.Cols, &plan2.ColDef{
 			Name: hideKey.Name,
 			Typ: &plan2.Type{
 				Id:        plan.Type_TypeId(hideKey.Type.Oid),
@@ -772,12 +802,79 @@ func (tcc *TxnCompilerContext) Resolve(dbName string, tableName string) (*plan2.
 		SchemaName: dbName,
 		ObjName:    tableName,
 	}
+	return obj, tableDef
+}
 
-	tableDef := &plan2.TableDef{
+// engineDefsToTableDef converts the definitions of a relation to the table
+// definition of the plan
+func engineDefsToTableDef(tableName string, engineDefs []engine.TableDef) *plan2.TableDef {
+	var defs []*plan2.ColDef
+	var idxDefs, checkDefs, fkDefs, partDefs []*plan.TableDef_DefType
+	var view *plan2.ViewDef
+	for _, def := range engineDefs {
+		if v, ok := def.(*engine.ViewDef); ok {
+			view = &plan2.ViewDef{View: v.View}
+		} else if idx, ok := def.(*engine.IndexTableDef); ok && (idx.Typ == engine.Unique || idx.Typ == engine.FullText) {
+			idxDefs = append(idxDefs, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_Idx{
+					Idx: &plan.IndexDef{
+						Typ:      plan.IndexDef_IndexType(idx.Typ),
+						Name:     idx.Name,
+						ColNames: idx.ColNames,
+					},
+				},
+			})
+		} else if check, ok := def.(*engine.CheckDef); ok {
+			checkDefs = append(checkDefs, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_Check{
+					Check: &plan.CheckDef{
+						Name: check.Name,
+						Expr: check.Expr,
+					},
+				},
+			})
+		} else if fk, ok := def.(*engine.ForeignKeyDef); ok {
+			fkDefs = append(fkDefs, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_Fk{
+					Fk: &plan.ForeignKeyDef{
+						Name:        fk.Name,
+						Cols:        fk.Cols,
+						ParentTable: fk.ParentTable,
+						ParentCols:  fk.ParentCols,
+						OnDelete:    plan.ForeignKeyDef_RefAction(fk.OnDelete),
+						OnUpdate:    plan.ForeignKeyDef_RefAction(fk.OnUpdate),
+					},
+				},
+			})
+		} else if part, ok := def.(*engine.PartitionDef); ok {
+			partDefs = append(partDefs, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_Partition{
+					Partition: plan2.MakePlan2PartitionDef(part),
+				},
+			})
+		} else if attr, ok := def.(*engine.AttributeDef); ok {
+			defs = append(defs, &plan2.ColDef{
+				Name: attr.Attr.Name,
+				Typ: &plan2.Type{
+					Id:         plan.Type_TypeId(attr.Attr.Type.Oid),
+					Width:      attr.Attr.Type.Width,
+					Precision:  attr.Attr.Type.Precision,
+					Scale:      attr.Attr.Type.Scale,
+					Enumvalues: attr.Attr.EnumValues,
+				},
+				Primary:  attr.Attr.Primary,
+				Default:  plan2.MakePlan2DefaultExpr(attr.Attr.Default),
+				AutoIncr: attr.Attr.AutoIncrement,
+				NotNull:  attr.Attr.NotNull,
+			})
+		}
+	}
+	return &plan2.TableDef{
 		Name: tableName,
 		Cols: defs,
+		Defs: append(append(append(idxDefs, checkDefs...), fkDefs...), partDefs...),
+		View: view,
 	}
-	return obj, tableDef
 }
 
 func (tcc *TxnCompilerContext) ResolveVariable(varName string, isSystemVar, isGlobalVar bool) (interface{}, error) {
@@ -868,3 +965,11 @@ func (tcc *TxnCompilerContext) Cost(obj *plan2.ObjectRef, e *plan2.Expr) *plan2.
 	rows := table.Rows()
 	return &plan2.Cost{Card: float64(rows)}
 }
+
+// CheckPrivileges implements the plan2.PrivilegeChecker
+func (tcc *TxnCompilerContext) CheckPrivileges(privs []*plan2.RequiredPrivilege) error {
+	if tcc.ses == nil {
+		return nil
+	}
+	return tcc.ses.CheckPrivileges(privs)
+}
@@ -130,6 +130,20 @@ func (mr *MockComputationWrapperMockRecorder) GetColumns() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetColumns", reflect.TypeOf((*MockComputationWrapper)(nil).GetColumns))
 }
 
+// GetLastInsertID mocks base method.
+func (m *MockComputationWrapper) GetLastInsertID() uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastInsertID")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetLastInsertID indicates an expected call of GetLastInsertID.
+func (mr *MockComputationWrapperMockRecorder) GetLastInsertID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastInsertID", reflect.TypeOf((*MockComputationWrapper)(nil).GetLastInsertID))
+}
+
 // Run mocks base method.
 func (m *MockComputationWrapper) Run(ts uint64) error {
 	m.ctrl.T.Helper()
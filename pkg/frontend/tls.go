@@ -0,0 +1,100 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+)
+
+/*
+The client asks for TLS by sending the SSL request packet instead of the
+handshake response. Then the TLS handshake is done on the same connection
+and the handshake response follows it over TLS.
+
+The goetty session can not replace its connection. So the connections
+accepted by the server are wrapped into the upgradableConn that switches
+to TLS in place.
+*/
+
+// newTLSConfig makes the TLS configuration of the server.
+// It returns nil if TLS is not configured.
+func newTLSConfig(sv *config.SystemVariables) (*tls.Config, error) {
+	certFile, keyFile := sv.GetTlsCertFile(), sv.GetTlsKeyFile()
+	if certFile == "" && keyFile == "" {
+		if sv.GetRequireSecureTransport() {
+			return nil, NewMysqlError(ER_NO_SECURE_TRANSPORTS_CONFIGURED)
+		}
+		return nil, nil
+	}
+	if certFile == "" || keyFile == "" {
+		return nil, fmt.Errorf("both tlsCertFile and tlsKeyFile are needed to enable TLS")
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load the certificate of the server failed. error:%v", err)
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// upgradableListener wraps the accepted connections into the upgradableConn
+type upgradableListener struct {
+	net.Listener
+}
+
+func (l *upgradableListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return &upgradableConn{Conn: conn}, nil
+}
+
+// upgradableConn is a connection that can be switched to TLS
+type upgradableConn struct {
+	net.Conn
+}
+
+// upgrade does the TLS handshake as the server and switches the connection to TLS.
+// buffered holds the bytes that have been read from the connection but not consumed.
+func (c *upgradableConn) upgrade(tlsConfig *tls.Config, buffered []byte) error {
+	conn := tls.Server(&bufferedConn{Conn: c.Conn, buffered: buffered}, tlsConfig)
+	if err := conn.Handshake(); err != nil {
+		return err
+	}
+	c.Conn = conn
+	return nil
+}
+
+// bufferedConn reads the buffered bytes before reading the connection
+type bufferedConn struct {
+	net.Conn
+	buffered []byte
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	if len(c.buffered) != 0 {
+		n := copy(b, c.buffered)
+		c.buffered = c.buffered[n:]
+		return n, nil
+	}
+	return c.Conn.Read(b)
+}
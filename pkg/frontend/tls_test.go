@@ -0,0 +1,137 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"database/sql"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/goetty"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/stretchr/testify/require"
+)
+
+// writeSelfSignedCertificate writes a certificate for 127.0.0.1 and its key into dir
+func writeSelfSignedCertificate(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "matrixone"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile, keyFile := filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return certFile, keyFile
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	pu, err := getParameterUnit("test/system_vars_config.toml", nil)
+	require.NoError(t, err)
+	sv := pu.SV
+
+	tlsConfig, err := newTLSConfig(sv)
+	require.NoError(t, err)
+	require.Nil(t, tlsConfig)
+
+	require.NoError(t, sv.SetRequireSecureTransport(true))
+	_, err = newTLSConfig(sv)
+	require.Equal(t, ER_NO_SECURE_TRANSPORTS_CONFIGURED, err.(*MysqlError).ErrorCode)
+
+	certFile, keyFile := writeSelfSignedCertificate(t, t.TempDir())
+	require.NoError(t, sv.SetTlsCertFile(certFile))
+	_, err = newTLSConfig(sv)
+	require.Error(t, err)
+
+	require.NoError(t, sv.SetTlsKeyFile(certFile))
+	_, err = newTLSConfig(sv)
+	require.Error(t, err)
+
+	require.NoError(t, sv.SetTlsKeyFile(keyFile))
+	tlsConfig, err = newTLSConfig(sv)
+	require.NoError(t, err)
+	require.Len(t, tlsConfig.Certificates, 1)
+}
+
+func TestTLSConnection(t *testing.T) {
+	pu, err := getParameterUnit("test/system_vars_config.toml", nil)
+	require.NoError(t, err)
+	certFile, keyFile := writeSelfSignedCertificate(t, t.TempDir())
+	require.NoError(t, pu.SV.SetTlsCertFile(certFile))
+	require.NoError(t, pu.SV.SetTlsKeyFile(keyFile))
+	require.NoError(t, pu.SV.SetRequireSecureTransport(true))
+
+	rm := NewRoutineManager(pu)
+	rm.tlsConfig, err = newTLSConfig(pu.SV)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp4", "127.0.0.1:6002")
+	require.NoError(t, err)
+	encoder, decoder := NewSqlCodec()
+	app, err := goetty.NewApplication(&upgradableListener{Listener: listener}, rm.Handler,
+		goetty.WithAppSessionOptions(
+			goetty.WithCodec(encoder, decoder),
+			goetty.WithLogger(logutil.GetGlobalLogger())),
+		goetty.WithAppSessionAware(rm))
+	require.NoError(t, err)
+	require.NoError(t, app.Start())
+	defer func() {
+		require.NoError(t, app.Stop())
+	}()
+
+	connect := func(tlsMode string) error {
+		dsn := fmt.Sprintf("dump:111@tcp(127.0.0.1:6002)/?tls=%s&readTimeout=10s&timeout=10s&writeTimeout=10s", tlsMode)
+		conn, err := sql.Open("mysql", dsn)
+		require.NoError(t, err)
+		defer conn.Close()
+		return conn.Ping()
+	}
+
+	//the handshake response is sent over TLS
+	require.NoError(t, connect("skip-verify"))
+	//the plaintext connection is refused
+	require.Error(t, connect("false"))
+
+	require.NoError(t, pu.SV.SetRequireSecureTransport(false))
+	require.NoError(t, connect("false"))
+	require.NoError(t, connect("skip-verify"))
+
+	//the server does not advertise TLS without the certificate
+	rm.tlsConfig = nil
+	require.Error(t, connect("skip-verify"))
+	require.NoError(t, connect("false"))
+}
@@ -36,6 +36,8 @@ type ComputationWrapper interface {
 
 	GetAffectedRows() uint64
 
+	GetLastInsertID() uint64
+
 	Compile(u interface{}, fill func(interface{}, *batch.Batch) error) (interface{}, error)
 }
 
@@ -68,4 +70,11 @@ type PrepareStmt struct {
 	Name        string
 	PreparePlan *plan.Plan
 	PrepareStmt tree.Statement
+	ParamCount  int
+
+	//for the binary protocol.
+	//ParamTypes holds the types of the parameters bound by the last COM_STMT_EXECUTE.
+	//LongData holds the values of the parameters sent by COM_STMT_SEND_LONG_DATA.
+	ParamTypes []byte
+	LongData   map[int][]byte
 }
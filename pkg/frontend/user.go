@@ -0,0 +1,506 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+)
+
+/*
+The accounts are kept in the table mo_catalog.mo_user.
+An account is 'name'@'host'. A user can have the accounts on different hosts, and the client logs in as
+the one whose host matches the address of the connection most specifically.
+The authentication_string of an account decides the plugin that authenticates it:
+
+| authentication_string  | plugin                | hash                          |
+| ---------------------- | --------------------- | ----------------------------- |
+| empty or null          | any                   | the account has no password   |
+| * + 40 hex digits      | mysql_native_password | HEX(SHA1(SHA1(password)))     |
+| $SHA2$ + 64 hex digits | caching_sha2_password | HEX(SHA256(SHA256(password))) |
+
+Both hashes are enough for the server to check the scramble from the client,
+so the password itself is never stored.
+*/
+const (
+	nativePasswordPrefix      = "*"
+	cachingSha2PasswordPrefix = "$SHA2$"
+
+	// the plugin of the accounts created without designating one
+	defaultAuthPlugin = AuthCachingSha2Password
+
+	moCatalogDbName = "mo_catalog"
+	moUserTableName = "mo_user"
+)
+
+// encodeNativePassword returns the mysql_native_password authentication string of the password
+func encodeNativePassword(password []byte) string {
+	if len(password) == 0 {
+		return ""
+	}
+	hash1 := sha1.Sum(password)
+	hash2 := sha1.Sum(hash1[:])
+	return nativePasswordPrefix + strings.ToUpper(hex.EncodeToString(hash2[:]))
+}
+
+// encodeCachingSha2Password returns the caching_sha2_password authentication string of the password
+func encodeCachingSha2Password(password []byte) string {
+	if len(password) == 0 {
+		return ""
+	}
+	hash1 := sha256.Sum256(password)
+	hash2 := sha256.Sum256(hash1[:])
+	return cachingSha2PasswordPrefix + strings.ToUpper(hex.EncodeToString(hash2[:]))
+}
+
+// authPluginOfAuthString returns the plugin that authenticates the account
+// and the hash in the authentication string
+func authPluginOfAuthString(authString string) (string, []byte, error) {
+	var plugin string
+	var digits string
+	var size int
+	switch {
+	case authString == "":
+		return "", nil, nil
+	case strings.HasPrefix(authString, nativePasswordPrefix):
+		plugin, digits, size = AuthNativePassword, authString[len(nativePasswordPrefix):], sha1.Size
+	case strings.HasPrefix(authString, cachingSha2PasswordPrefix):
+		plugin, digits, size = AuthCachingSha2Password, authString[len(cachingSha2PasswordPrefix):], sha256.Size
+	default:
+		return "", nil, NewMysqlError(ER_PASSWORD_FORMAT)
+	}
+	hash, err := hex.DecodeString(digits)
+	if err != nil || len(hash) != size {
+		return "", nil, NewMysqlError(ER_PASSWORD_FORMAT)
+	}
+	return plugin, hash, nil
+}
+
+// makeAuthString makes the authentication string from the auth option of the user.
+// The password is hashed with the plugin. The hash is checked against the plugin.
+func makeAuthString(user *tree.User) (string, error) {
+	plugin := strings.ToLower(user.AuthPlugin)
+	if plugin == "" {
+		plugin = defaultAuthPlugin
+		if user.HashString != "" {
+			//IDENTIFIED BY PASSWORD 'hash'
+			plugin = AuthNativePassword
+		}
+	}
+
+	var encode func([]byte) string
+	switch plugin {
+	case AuthNativePassword:
+		encode = encodeNativePassword
+	case AuthCachingSha2Password:
+		encode = encodeCachingSha2Password
+	default:
+		return "", NewMysqlError(ER_PLUGIN_IS_NOT_LOADED, user.AuthPlugin)
+	}
+
+	if user.ByAuth || user.HashString == "" {
+		return encode([]byte(user.AuthString)), nil
+	}
+
+	hashPlugin, _, err := authPluginOfAuthString(user.HashString)
+	if err != nil {
+		return "", err
+	}
+	if hashPlugin != plugin {
+		return "", NewMysqlError(ER_PASSWORD_FORMAT)
+	}
+	return user.HashString, nil
+}
+
+// checkNativePassword checks the scramble from the client with the hash of the password.
+// Algorithm: the client sends SHA1( password ) XOR SHA1( salt + SHA1( SHA1( password ) ) ).
+// The server XORs it with SHA1( salt + hash ) and the SHA1 of the result must be the hash.
+func checkNativePassword(hash, salt, auth []byte) bool {
+	if len(auth) != sha1.Size {
+		return false
+	}
+	sha := sha1.New()
+	sha.Write(salt)
+	sha.Write(hash)
+	hash1 := sha.Sum(nil)
+
+	//SHA1(password) = auth XOR SHA1(salt + SHA1(SHA1(password)))
+	for i := range hash1 {
+		hash1[i] ^= auth[i]
+	}
+	hash2 := sha1.Sum(hash1)
+	return bytes.Equal(hash2[:], hash)
+}
+
+// checkCachingSha2Password checks the scramble from the client with the hash of the password.
+// Algorithm: the client sends SHA256( password ) XOR SHA256( SHA256( SHA256( password ) ) + salt ).
+// The server XORs it with SHA256( hash + salt ) and the SHA256 of the result must be the hash.
+func checkCachingSha2Password(hash, salt, auth []byte) bool {
+	if len(auth) != sha256.Size {
+		return false
+	}
+	sha := sha256.New()
+	sha.Write(hash)
+	sha.Write(salt)
+	hash1 := sha.Sum(nil)
+
+	//SHA256(password) = auth XOR SHA256(SHA256(SHA256(password)) + salt)
+	for i := range hash1 {
+		hash1[i] ^= auth[i]
+	}
+	hash2 := sha256.Sum256(hash1)
+	return bytes.Equal(hash2[:], hash)
+}
+
+// formatUser formats the user like 'name'@'host'
+func formatUser(user *tree.User) string {
+	host := user.Hostname
+	if host == "" {
+		host = "%"
+	}
+	return fmt.Sprintf("'%s'@'%s'", user.Username, host)
+}
+
+// getCatalogTable opens the table in mo_catalog
+func getCatalogTable(storage engine.Engine, snapshot engine.Snapshot, name string) (engine.Relation, error) {
+	db, err := storage.Database(moCatalogDbName, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return db.Relation(name, snapshot)
+}
+
+// scanCatalogTable calls fn with the values of the attributes in every row of the table in mo_catalog.
+// The attributes must be strings. The scan stops if fn returns false.
+func scanCatalogTable(storage engine.Engine, snapshot engine.Snapshot, name string, attrs []string, fn func(row []string) bool) error {
+	table, err := getCatalogTable(storage, snapshot, name)
+	if err != nil {
+		return err
+	}
+	refCnts := make([]uint64, len(attrs))
+	for i := range refCnts {
+		refCnts[i] = 1
+	}
+	row := make([]string, len(attrs))
+	for _, reader := range table.NewReader(1, nil, nil, snapshot) {
+		for {
+			bat, err := reader.Read(refCnts, attrs)
+			if err != nil {
+				return err
+			}
+			if bat == nil {
+				break
+			}
+			n := vector.Length(bat.Vecs[0])
+			for i := 0; i < n; i++ {
+				for j, vec := range bat.Vecs {
+					row[j] = string(vec.Col.(*types.Bytes).Get(int64(i)))
+				}
+				if !fn(row) {
+					return nil
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// userAccount is a row of mo_user
+type userAccount struct {
+	host       string
+	name       string
+	authString string
+}
+
+// key returns the primary key of the account in mo_user. It is also the grantee of the account.
+func (account *userAccount) key() string {
+	return formatAuthID(account.name, account.host)
+}
+
+func (account *userAccount) row() []string {
+	return []string{account.key(), account.host, account.name, account.authString}
+}
+
+// getUserAccounts reads the accounts of the user on all the hosts from mo_user
+func getUserAccounts(storage engine.Engine, snapshot engine.Snapshot, name string) ([]*userAccount, error) {
+	var accounts []*userAccount
+	attrs := []string{"user_host", "user_name", "authentication_string"}
+	err := scanCatalogTable(storage, snapshot, moUserTableName, attrs, func(row []string) bool {
+		if row[1] == name {
+			accounts = append(accounts, &userAccount{host: row[0], name: row[1], authString: row[2]})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// getUserAccount reads the account 'name'@'host' from mo_user.
+// The empty host is %. It returns nil if the account does not exist.
+func getUserAccount(storage engine.Engine, snapshot engine.Snapshot, name, host string) (*userAccount, error) {
+	accounts, err := getUserAccounts(storage, snapshot, name)
+	if err != nil {
+		return nil, err
+	}
+	if host == "" {
+		host = "%"
+	}
+	for _, account := range accounts {
+		if strings.EqualFold(account.host, host) {
+			return account, nil
+		}
+	}
+	return nil, nil
+}
+
+// findUserAccount returns the account that the user connecting from the client host logs in as.
+// Like MySQL, the account with the most specific host that matches the client host is chosen.
+// It returns nil if no account matches.
+func findUserAccount(storage engine.Engine, snapshot engine.Snapshot, name, clientHost string) (*userAccount, error) {
+	accounts, err := getUserAccounts(storage, snapshot, name)
+	if err != nil {
+		return nil, err
+	}
+	var found *userAccount
+	for _, account := range accounts {
+		if !matchHost(account.host, clientHost) {
+			continue
+		}
+		if found == nil || hostSpecificity(account.host) > hostSpecificity(found.host) {
+			found = account
+		}
+	}
+	return found, nil
+}
+
+// matchHost returns true if the client host matches the host of the account.
+// The host of the account is a pattern like LIKE: % matches any characters and _ matches one.
+// localhost matches the loopback addresses, as the client host is the ip address of the connection.
+func matchHost(pattern, clientHost string) bool {
+	pattern, clientHost = strings.ToLower(pattern), strings.ToLower(clientHost)
+	if pattern == "localhost" {
+		if ip := net.ParseIP(clientHost); ip != nil && ip.IsLoopback() {
+			return true
+		}
+	}
+	p, s := 0, 0
+	//the position after the last % and the position in the client host it matches up to
+	star, mark := -1, 0
+	for s < len(clientHost) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '_' || pattern[p] == clientHost[s]):
+			p++
+			s++
+		case p < len(pattern) && pattern[p] == '%':
+			star, mark = p+1, s
+			p++
+		case star != -1:
+			//let the last % match one more character
+			mark++
+			p, s = star, mark
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '%' {
+		p++
+	}
+	return p == len(pattern)
+}
+
+// hostSpecificity orders the hosts of the accounts from the most specific.
+// The literal hosts come first. The patterns are ordered by the length of the literal prefix.
+func hostSpecificity(host string) int {
+	if i := strings.IndexAny(host, "%_"); i != -1 {
+		return i
+	}
+	return math.MaxInt32
+}
+
+// getUserAccountInTxn reads the account that the user connecting from the client host logs in as in a new txn
+func getUserAccountInTxn(storage engine.Engine, name, clientHost string) (*userAccount, error) {
+	taeEngine, ok := storage.(moengine.TxnEngine)
+	if !ok {
+		return nil, errorIsNotTaeEngine
+	}
+	txnCtx, err := taeEngine.StartTxn(nil)
+	if err != nil {
+		return nil, err
+	}
+	account, err := findUserAccount(storage, txnCtx.GetCtx(), name, clientHost)
+	if err != nil {
+		if err2 := txnCtx.Rollback(); err2 != nil {
+			logutil.Errorf("txnCtx rollback failed. error:%v", err2)
+		}
+		return nil, err
+	}
+	return account, txnCtx.Commit()
+}
+
+// writeUserAccount writes the account into mo_user
+func writeUserAccount(table engine.Relation, snapshot engine.Snapshot, account *userAccount) error {
+	return writeCatalogRows(table, snapshot, DefineSchemaForMoUser(), [][]string{account.row()})
+}
+
+// deleteUserAccount deletes the account from mo_user
+func deleteUserAccount(table engine.Relation, snapshot engine.Snapshot, account *userAccount) error {
+	return deleteCatalogRows(table, snapshot, DefineSchemaForMoUser(), [][]string{account.row()})
+}
+
+/*
+handle CREATE USER
+*/
+func (mce *MysqlCmdExecutor) handleCreateUser(cu *tree.CreateUser) error {
+	ses := mce.GetSession()
+	if err := ses.checkGlobalPrivilege(tree.PRIVILEGE_TYPE_STATIC_CREATE_USER); err != nil {
+		return err
+	}
+	snapshot := ses.GetTxnHandler().GetTxn().GetCtx()
+	table, err := getCatalogTable(ses.Pu.StorageEngine, snapshot, moUserTableName)
+	if err != nil {
+		return err
+	}
+	for _, user := range cu.Users {
+		exists, err := authIDExists(ses.Pu.StorageEngine, snapshot, user.Username, user.Hostname)
+		if err != nil {
+			return err
+		}
+		if exists {
+			if cu.IfNotExists {
+				continue
+			}
+			return NewMysqlError(ER_CANNOT_USER, "CREATE USER", formatUser(user))
+		}
+		authString, err := makeAuthString(user)
+		if err != nil {
+			return err
+		}
+		host := user.Hostname
+		if host == "" {
+			host = "%"
+		}
+		account := &userAccount{host: host, name: user.Username, authString: authString}
+		if err = writeUserAccount(table, snapshot, account); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+handle DROP USER
+*/
+func (mce *MysqlCmdExecutor) handleDropUser(du *tree.DropUser) error {
+	ses := mce.GetSession()
+	if err := ses.checkGlobalPrivilege(tree.PRIVILEGE_TYPE_STATIC_CREATE_USER); err != nil {
+		return err
+	}
+	snapshot := ses.GetTxnHandler().GetTxn().GetCtx()
+	table, err := getCatalogTable(ses.Pu.StorageEngine, snapshot, moUserTableName)
+	if err != nil {
+		return err
+	}
+	for _, user := range du.Users {
+		account, err := getUserAccount(ses.Pu.StorageEngine, snapshot, user.Username, user.Hostname)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			if du.IfExists {
+				continue
+			}
+			return NewMysqlError(ER_CANNOT_USER, "DROP USER", formatUser(user))
+		}
+		if err = deleteUserAccount(table, snapshot, account); err != nil {
+			return err
+		}
+		if err = deleteGrantsOf(ses.Pu.StorageEngine, snapshot, account.key()); err != nil {
+			return err
+		}
+		ses.invalidatePrivileges()
+	}
+	return nil
+}
+
+/*
+handle ALTER USER
+*/
+func (mce *MysqlCmdExecutor) handleAlterUser(au *tree.AlterUser) error {
+	ses := mce.GetSession()
+	snapshot := ses.GetTxnHandler().GetTxn().GetCtx()
+	table, err := getCatalogTable(ses.Pu.StorageEngine, snapshot, moUserTableName)
+	if err != nil {
+		return err
+	}
+	users := au.Users
+	if au.IsUserFunc {
+		//ALTER USER USER() IDENTIFIED BY 'auth_string' changes the password of the current user
+		current := *au.UserFunc
+		current.Username = ses.GetUserName()
+		current.Hostname = ses.GetUserHost()
+		users = []*tree.User{&current}
+	} else if err = ses.checkGlobalPrivilege(tree.PRIVILEGE_TYPE_STATIC_CREATE_USER); err != nil {
+		return err
+	}
+	for _, user := range users {
+		account, err := getUserAccount(ses.Pu.StorageEngine, snapshot, user.Username, user.Hostname)
+		if err != nil {
+			return err
+		}
+		if account == nil {
+			if au.IfExists {
+				continue
+			}
+			return NewMysqlError(ER_CANNOT_USER, "ALTER USER", formatUser(user))
+		}
+		//the account is kept if no auth option is designated
+		if user.AuthPlugin == "" && !user.ByAuth && user.HashString == "" {
+			continue
+		}
+		if user.AuthPlugin == "" && user.HashString == "" {
+			//keep the plugin of the account
+			if user.AuthPlugin, _, err = authPluginOfAuthString(account.authString); err != nil {
+				return err
+			}
+		}
+		authString, err := makeAuthString(user)
+		if err != nil {
+			return err
+		}
+		if err = deleteUserAccount(table, snapshot, account); err != nil {
+			return err
+		}
+		account.authString = authString
+		if err = writeUserAccount(table, snapshot, account); err != nil {
+			return err
+		}
+	}
+	return nil
+}
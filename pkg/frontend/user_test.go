@@ -0,0 +1,389 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"database/sql"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/require"
+)
+
+// scrambleNativePassword computes the auth response of mysql_native_password like a client
+func scrambleNativePassword(password, salt []byte) []byte {
+	hash1 := sha1.Sum(password)
+	hash2 := sha1.Sum(hash1[:])
+	hash3 := sha1.Sum(append(append([]byte{}, salt...), hash2[:]...))
+	for i := range hash1 {
+		hash1[i] ^= hash3[i]
+	}
+	return hash1[:]
+}
+
+// scrambleCachingSha2Password computes the auth response of caching_sha2_password like a client
+func scrambleCachingSha2Password(password, salt []byte) []byte {
+	hash1 := sha256.Sum256(password)
+	hash2 := sha256.Sum256(hash1[:])
+	hash3 := sha256.Sum256(append(hash2[:], salt...))
+	for i := range hash1 {
+		hash1[i] ^= hash3[i]
+	}
+	return hash1[:]
+}
+
+func parseUserStmt(t *testing.T, sql string) tree.Statement {
+	stmts, err := parsers.Parse(dialect.MYSQL, sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stmts[0]
+}
+
+func TestMakeAuthString(t *testing.T) {
+	convey.Convey("make auth string", t, func() {
+		nativeHash := encodeNativePassword([]byte("123"))
+		sha2Hash := encodeCachingSha2Password([]byte("123"))
+		convey.So(nativeHash, convey.ShouldEqual, "*23AE809DDACAF96AF0FD78ED04B6A265E05AA257")
+		convey.So(len(sha2Hash), convey.ShouldEqual, len(cachingSha2PasswordPrefix)+2*sha256.Size)
+
+		kases := []struct {
+			user       tree.User
+			authString string
+			fail       bool
+		}{
+			{user: tree.User{}, authString: ""},
+			{user: tree.User{AuthString: "123", ByAuth: true}, authString: sha2Hash},
+			{user: tree.User{AuthPlugin: AuthNativePassword, AuthString: "123", ByAuth: true}, authString: nativeHash},
+			{user: tree.User{AuthPlugin: "CACHING_SHA2_PASSWORD", AuthString: "123", ByAuth: true}, authString: sha2Hash},
+			{user: tree.User{AuthPlugin: "sha256_password", AuthString: "123", ByAuth: true}, fail: true},
+			{user: tree.User{HashString: nativeHash}, authString: nativeHash},
+			{user: tree.User{HashString: sha2Hash}, fail: true},
+			{user: tree.User{AuthPlugin: AuthCachingSha2Password, HashString: sha2Hash}, authString: sha2Hash},
+			{user: tree.User{AuthPlugin: AuthNativePassword, HashString: "*123"}, fail: true},
+			{user: tree.User{AuthPlugin: AuthNativePassword, HashString: "123"}, fail: true},
+		}
+		for _, k := range kases {
+			authString, err := makeAuthString(&k.user)
+			if k.fail {
+				convey.So(err, convey.ShouldNotBeNil)
+				continue
+			}
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(authString, convey.ShouldEqual, k.authString)
+		}
+	})
+}
+
+func TestCheckPassword(t *testing.T) {
+	convey.Convey("check password", t, func() {
+		salt := generate_salt(20)
+		_, nativeHash, err := authPluginOfAuthString(encodeNativePassword([]byte("111")))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(checkNativePassword(nativeHash, salt, scrambleNativePassword([]byte("111"), salt)), convey.ShouldBeTrue)
+		convey.So(checkNativePassword(nativeHash, salt, scrambleNativePassword([]byte("112"), salt)), convey.ShouldBeFalse)
+		convey.So(checkNativePassword(nativeHash, salt, nil), convey.ShouldBeFalse)
+
+		_, sha2Hash, err := authPluginOfAuthString(encodeCachingSha2Password([]byte("111")))
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(checkCachingSha2Password(sha2Hash, salt, scrambleCachingSha2Password([]byte("111"), salt)), convey.ShouldBeTrue)
+		convey.So(checkCachingSha2Password(sha2Hash, salt, scrambleCachingSha2Password([]byte("112"), salt)), convey.ShouldBeFalse)
+		convey.So(checkCachingSha2Password(sha2Hash, salt, scrambleNativePassword([]byte("111"), salt)), convey.ShouldBeFalse)
+	})
+}
+
+// newTaeSession returns a session of root on a new tae with the catalog tables
+func newTaeSession(t *testing.T, ctrl *gomock.Controller) (moengine.TxnEngine, *Session, *MysqlProtocolImpl) {
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+	ioses.EXPECT().RemoteAddr().Return("127.0.0.1:50001").AnyTimes()
+
+	dir := testutils.InitTestEnv("frontend", t)
+	tae, err := db.Open(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { tae.Close() })
+	eng := moengine.NewEngine(tae)
+	pu, err := getParameterUnit("test/system_vars_config.toml", eng)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = InitDB(eng, pu.SV.GetDumpuser(), pu.SV.GetDumppassword()); err != nil {
+		t.Fatal(err)
+	}
+
+	proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+	proto.SetStorage(eng)
+	setSessionUser(proto, "root", "localhost")
+	var gSys GlobalSystemVariables
+	InitGlobalSystemVariables(&gSys)
+	ses := NewSession(proto, nil, pu.Mempool, pu, &gSys)
+	ses.txnHandler = InitTxnHandler(eng)
+	ses.txnCompileCtx = InitTxnCompilerContext(ses.txnHandler, "")
+	ses.txnCompileCtx.SetSession(ses)
+	return eng, ses, proto
+}
+
+// setSessionUser makes the session run as the account 'name'@'host'
+func setSessionUser(proto *MysqlProtocolImpl, name, host string) {
+	proto.SetUserName(name)
+	proto.SetUserHost(host)
+}
+
+// execSelfHandledStmt runs the statement handled by the MysqlCmdExecutor itself in an autocommit txn
+func execSelfHandledStmt(t *testing.T, mce *MysqlCmdExecutor, sql string) error {
+	ses := mce.GetSession()
+	if err := ses.GetTxnHandler().StartByAutocommit(); err != nil {
+		return err
+	}
+	var err error
+	switch st := parseUserStmt(t, sql).(type) {
+	case *tree.CreateUser:
+		err = mce.handleCreateUser(st)
+	case *tree.DropUser:
+		err = mce.handleDropUser(st)
+	case *tree.AlterUser:
+		err = mce.handleAlterUser(st)
+	case *tree.CreateRole:
+		err = mce.handleCreateRole(st)
+	case *tree.DropRole:
+		err = mce.handleDropRole(st)
+	case *tree.Grant:
+		err = mce.handleGrant(st)
+	case *tree.Revoke:
+		err = mce.handleRevoke(st)
+	default:
+		t.Fatalf("unexpected statement %s", sql)
+	}
+	if err != nil {
+		_ = ses.GetTxnHandler().RollbackAfterAutocommitOnly()
+		return err
+	}
+	return ses.GetTxnHandler().CommitAfterAutocommitOnly()
+}
+
+func TestUserAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	eng, ses, proto := newTaeSession(t, ctrl)
+	mce := NewMysqlCmdExecutor()
+	mce.PrepareSessionBeforeExecRequest(ses)
+	exec := func(sql string) error {
+		return execSelfHandledStmt(t, mce, sql)
+	}
+
+	authenticate := func(user, plugin string, authResponse []byte) error {
+		name, host := proto.GetUserName(), proto.GetUserHost()
+		defer setSessionUser(proto, name, host)
+		proto.username = user
+		proto.capability = DefaultCapability
+		return proto.authenticateUser(plugin, authResponse)
+	}
+
+	convey.Convey("initial users", t, func() {
+		convey.So(authenticate("root", AuthNativePassword, nil), convey.ShouldBeNil)
+		convey.So(authenticate("root", AuthNativePassword, []byte("x")), convey.ShouldNotBeNil)
+		convey.So(authenticate("dump", AuthNativePassword, scrambleNativePassword([]byte("111"), proto.salt)), convey.ShouldBeNil)
+		convey.So(authenticate("dump", AuthNativePassword, scrambleNativePassword([]byte("112"), proto.salt)), convey.ShouldNotBeNil)
+		convey.So(authenticate("nobody", AuthNativePassword, nil), convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("create user", t, func() {
+		convey.So(exec("create user u1 identified by '123', 'u2'@'localhost' identified with mysql_native_password by '456'"), convey.ShouldBeNil)
+		convey.So(exec("create user u1 identified by '123'"), convey.ShouldNotBeNil)
+		convey.So(exec("create user if not exists u1 identified by '789'"), convey.ShouldBeNil)
+		convey.So(exec("create user u3 identified with auth_socket"), convey.ShouldNotBeNil)
+
+		account, err := getUserAccountInTxn(eng, "u2", "127.0.0.1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(account.host, convey.ShouldEqual, "localhost")
+		convey.So(account.authString, convey.ShouldEqual, encodeNativePassword([]byte("456")))
+
+		convey.So(authenticate("u1", AuthCachingSha2Password, scrambleCachingSha2Password([]byte("123"), proto.salt)), convey.ShouldBeNil)
+		convey.So(authenticate("u1", AuthCachingSha2Password, scrambleCachingSha2Password([]byte("789"), proto.salt)), convey.ShouldNotBeNil)
+		convey.So(authenticate("u2", AuthNativePassword, scrambleNativePassword([]byte("456"), proto.salt)), convey.ShouldBeNil)
+		convey.So(authenticate("u3", AuthNativePassword, nil), convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("the accounts of a user on different hosts", t, func() {
+		//the client connects from 127.0.0.1
+		convey.So(exec("create user 'u4'@'10.0.0.1' identified by 'a'"), convey.ShouldBeNil)
+		convey.So(authenticate("u4", AuthCachingSha2Password, scrambleCachingSha2Password([]byte("a"), proto.salt)), convey.ShouldNotBeNil)
+
+		convey.So(exec("create user 'u4'@'127.0.0.%' identified by 'b'"), convey.ShouldBeNil)
+		convey.So(mysqlErrorCode(exec("create user 'u4'@'127.0.0.%'")), convey.ShouldEqual, ER_CANNOT_USER)
+		convey.So(authenticate("u4", AuthCachingSha2Password, scrambleCachingSha2Password([]byte("b"), proto.salt)), convey.ShouldBeNil)
+		account, err := getUserAccountInTxn(eng, "u4", "127.0.0.1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(account.host, convey.ShouldEqual, "127.0.0.%")
+
+		//the most specific host is chosen
+		convey.So(exec("create user 'u4'@'127.0.0.1' identified by 'c'"), convey.ShouldBeNil)
+		convey.So(authenticate("u4", AuthCachingSha2Password, scrambleCachingSha2Password([]byte("b"), proto.salt)), convey.ShouldNotBeNil)
+		convey.So(authenticate("u4", AuthCachingSha2Password, scrambleCachingSha2Password([]byte("c"), proto.salt)), convey.ShouldBeNil)
+
+		convey.So(exec("drop user 'u4'@'127.0.0.1'"), convey.ShouldBeNil)
+		convey.So(authenticate("u4", AuthCachingSha2Password, scrambleCachingSha2Password([]byte("b"), proto.salt)), convey.ShouldBeNil)
+		convey.So(exec("drop user 'u4'@'127.0.0.%', 'u4'@'10.0.0.1'"), convey.ShouldBeNil)
+		convey.So(exec("drop user 'u4'"), convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("alter user", t, func() {
+		convey.So(exec("alter user u1 identified by 'abc'"), convey.ShouldBeNil)
+		convey.So(exec("alter user 'u2'@'localhost' identified with caching_sha2_password by 'def'"), convey.ShouldBeNil)
+		convey.So(exec("alter user u3 identified by 'abc'"), convey.ShouldNotBeNil)
+		convey.So(exec("alter user if exists u3 identified by 'abc'"), convey.ShouldBeNil)
+
+		account, err := getUserAccountInTxn(eng, "u1", "127.0.0.1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(account.authString, convey.ShouldEqual, encodeCachingSha2Password([]byte("abc")))
+		account, err = getUserAccountInTxn(eng, "u2", "127.0.0.1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(account.host, convey.ShouldEqual, "localhost")
+		convey.So(account.authString, convey.ShouldEqual, encodeCachingSha2Password([]byte("def")))
+
+		setSessionUser(proto, "u1", "%")
+		convey.So(exec("alter user user() identified by 'xyz'"), convey.ShouldBeNil)
+		account, err = getUserAccountInTxn(eng, "u1", "127.0.0.1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(account.authString, convey.ShouldEqual, encodeCachingSha2Password([]byte("xyz")))
+	})
+
+	convey.Convey("drop user", t, func() {
+		//u1 has no privilege to drop users
+		convey.So(exec("drop user 'u2'@'localhost'"), convey.ShouldNotBeNil)
+		setSessionUser(proto, "root", "localhost")
+		convey.So(exec("drop user u1, 'u2'@'localhost'"), convey.ShouldBeNil)
+		convey.So(exec("drop user u1"), convey.ShouldNotBeNil)
+		convey.So(exec("drop user if exists u1"), convey.ShouldBeNil)
+
+		account, err := getUserAccountInTxn(eng, "u1", "127.0.0.1")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(account, convey.ShouldBeNil)
+		convey.So(authenticate("u2", AuthCachingSha2Password, scrambleCachingSha2Password([]byte("def"), proto.salt)), convey.ShouldNotBeNil)
+	})
+}
+
+func TestMatchHost(t *testing.T) {
+	convey.Convey("match the client host with the host of the account", t, func() {
+		kases := []struct {
+			pattern, host string
+			want          bool
+		}{
+			{"%", "10.0.0.1", true},
+			{"10.0.0.1", "10.0.0.1", true},
+			{"10.0.0.1", "10.0.0.10", false},
+			{"10.0.0.%", "10.0.0.10", true},
+			{"10.0.0.%", "10.0.1.1", false},
+			{"10.0.0._", "10.0.0.1", true},
+			{"10.0.0._", "10.0.0.10", false},
+			{"10.%.1", "10.0.0.1", true},
+			{"10.%.1", "10.0.0.2", false},
+			{"localhost", "127.0.0.1", true},
+			{"localhost", "::1", true},
+			{"localhost", "10.0.0.1", false},
+			{"LOCALHOST", "localhost", true},
+		}
+		for _, kase := range kases {
+			convey.So(matchHost(kase.pattern, kase.host), convey.ShouldEqual, kase.want)
+		}
+		convey.So(hostSpecificity("10.0.0.1"), convey.ShouldBeGreaterThan, hostSpecificity("10.0.0.%"))
+		convey.So(hostSpecificity("10.0.0.%"), convey.ShouldBeGreaterThan, hostSpecificity("10.%"))
+		convey.So(hostSpecificity("10.%"), convey.ShouldBeGreaterThan, hostSpecificity("%"))
+	})
+}
+
+func TestUserAuthentication(t *testing.T) {
+	dir := testutils.InitTestEnv("frontend", t)
+	tae, err := db.Open(dir, nil)
+	require.NoError(t, err)
+	defer tae.Close()
+	eng := moengine.NewEngine(tae)
+	require.NoError(t, InitDB(eng, "dump", "111"))
+
+	//prepare the users
+	txnCtx, err := eng.StartTxn(nil)
+	require.NoError(t, err)
+	table, err := getCatalogTable(eng, txnCtx.GetCtx(), moUserTableName)
+	require.NoError(t, err)
+	for _, account := range []*userAccount{
+		{host: "%", name: "u1", authString: encodeCachingSha2Password([]byte("123"))},
+		{host: "%", name: "u2", authString: encodeNativePassword([]byte("456"))},
+		{host: "10.0.0.1", name: "u4"},
+	} {
+		require.NoError(t, writeUserAccount(table, txnCtx.GetCtx(), account))
+	}
+	require.NoError(t, txnCtx.Commit())
+
+	pu, err := getParameterUnit("test/system_vars_config.toml", eng)
+	require.NoError(t, err)
+	rm := NewRoutineManager(pu)
+	encoder, decoder := NewSqlCodec()
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		echoServer(rm.Handler, rm, encoder, decoder)
+	}()
+	to := NewTimeout(1*time.Minute, false)
+	for isClosed() && !to.isTimeout() {
+	}
+
+	connect := func(user, password string) error {
+		dsn := fmt.Sprintf("%s:%s@tcp(127.0.0.1:6001)/?readTimeout=10s&timeout=10s&writeTimeout=10s", user, password)
+		conn, err := sql.Open("mysql", dsn)
+		require.NoError(t, err)
+		defer conn.Close()
+		return conn.Ping()
+	}
+
+	//wait the server to be ready
+	for i := 0; i < 100; i++ {
+		if err = connect("dump", "111"); err == nil {
+			break
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+	require.NoError(t, err)
+
+	require.NoError(t, connect("root", ""))
+	require.NoError(t, connect("u1", "123"))
+	require.NoError(t, connect("u2", "456"))
+	require.Error(t, connect("root", "1"))
+	require.Error(t, connect("u1", "456"))
+	require.Error(t, connect("u2", "123"))
+	require.Error(t, connect("u3", ""))
+	//u4 can log in from 10.0.0.1 only
+	require.Error(t, connect("u4", ""))
+
+	//close server
+	setServer(1)
+	wg.Wait()
+}
@@ -398,7 +398,8 @@ func AllocateBatchBasedOnEngineAttributeDefinition(attributeDefs []*engine.Attri
 		case types.T_float64:
 			vec.Data = make([]byte, rowCount*int(toTypesType(types.T_float64).Size))
 			vec.Col = encoding.DecodeFloat64Slice(vec.Data)
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_text,
+			types.T_binary, types.T_varbinary, types.T_blob:
 			vBytes := &types.Bytes{
 				Offsets: make([]uint32, rowCount),
 				Lengths: make([]uint32, rowCount),
@@ -563,7 +564,8 @@ func FillBatchWithData(data [][]string, batch *batch.Batch) {
 					}
 					cols[rowIdx] = d
 				}
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_text,
+				types.T_binary, types.T_varbinary, types.T_blob:
 				vBytes := vec.Col.(*types.Bytes)
 				if isNullOrEmpty {
 					nulls.Add(vec.Nsp, uint64(rowIdx))
@@ -745,7 +747,8 @@ func FormatLineInBatch(bat *batch.Batch, rowIndex int) []string {
 					row[i] = vs[rowIndex]
 				}
 			}
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_text,
+			types.T_binary, types.T_varbinary, types.T_blob:
 			if !nulls.Any(vec.Nsp) { //all data in this column are not null
 				vs := vec.Col.(*types.Bytes)
 				row[i] = string(vs.Get(int64(rowIndex)))
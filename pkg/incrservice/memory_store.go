@@ -0,0 +1,57 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package incrservice
+
+import "sync"
+
+// MemoryStore keeps the offsets in memory, it is used by the tests and the
+// engines without a catalog
+type MemoryStore struct {
+	sync.Mutex
+	offsets map[string]uint64
+}
+
+var _ Store = new(MemoryStore)
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		offsets: make(map[string]uint64),
+	}
+}
+
+func (m *MemoryStore) Allocate(key string, count, min uint64) (uint64, error) {
+	m.Lock()
+	defer m.Unlock()
+	offset := m.offsets[key]
+	if offset < min {
+		offset = min
+	}
+	m.offsets[key] = offset + count
+	return offset, nil
+}
+
+func (m *MemoryStore) Reset(key string, offset uint64) error {
+	m.Lock()
+	defer m.Unlock()
+	m.offsets[key] = offset
+	return nil
+}
+
+func (m *MemoryStore) Delete(key string) error {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.offsets, key)
+	return nil
+}
@@ -0,0 +1,210 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package incrservice
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"golang.org/x/exp/constraints"
+)
+
+// Service hands out the values of the auto increment columns. The values
+// are reserved from the store in ranges of step, so that the sessions of
+// different servers sharing the store never collide, and most allocations
+// are served from memory.
+type Service struct {
+	sync.Mutex
+	step   uint64
+	store  Store
+	ranges map[string]*idRange
+}
+
+// idRange is the reserved range [next, end] of a column
+type idRange struct {
+	next uint64
+	end  uint64
+}
+
+func New(store Store, step uint64) *Service {
+	if step == 0 {
+		step = DefaultStep
+	}
+	return &Service{
+		step:   step,
+		store:  store,
+		ranges: make(map[string]*idRange),
+	}
+}
+
+// Key returns the key of an auto increment column in the store
+func Key(db, tbl, col string) string {
+	return fmt.Sprintf("`%s`.`%s`.`%s`", db, tbl, col)
+}
+
+// Alloc returns the first of n consecutive values of the column
+func (s *Service) Alloc(key string, n uint64) (uint64, error) {
+	s.Lock()
+	defer s.Unlock()
+	if r, ok := s.ranges[key]; ok && r.end-r.next+1 >= n {
+		v := r.next
+		r.next += n
+		return v, nil
+	}
+	// a large allocation is served by the store directly and leaves
+	// the cached range untouched
+	if n >= s.step {
+		offset, err := s.store.Allocate(key, n, 0)
+		if err != nil {
+			return 0, err
+		}
+		return offset + 1, nil
+	}
+	count := s.step
+	offset, err := s.store.Allocate(key, count, 0)
+	if err != nil {
+		return 0, err
+	}
+	s.ranges[key] = &idRange{
+		next: offset + 1 + n,
+		end:  offset + count,
+	}
+	return offset + 1, nil
+}
+
+// Update records an explicit value of the column, the values allocated
+// afterwards are greater than it
+func (s *Service) Update(key string, v uint64) error {
+	s.Lock()
+	defer s.Unlock()
+	r, ok := s.ranges[key]
+	if ok && v < r.next {
+		return nil
+	}
+	if ok && v <= r.end {
+		r.next = v + 1
+		return nil
+	}
+	delete(s.ranges, key)
+	_, err := s.store.Allocate(key, 0, v)
+	return err
+}
+
+// Reset drops the cached range of the column and sets its offset
+func (s *Service) Reset(key string, offset uint64) error {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.ranges, key)
+	return s.store.Reset(key, offset)
+}
+
+// Delete removes the column from the service and the store
+func (s *Service) Delete(key string) error {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.ranges, key)
+	return s.store.Delete(key)
+}
+
+// Rename moves the offset of a column to a new key
+func (s *Service) Rename(oldKey, newKey string) error {
+	s.Lock()
+	defer s.Unlock()
+	delete(s.ranges, oldKey)
+	delete(s.ranges, newKey)
+	offset, err := s.store.Allocate(oldKey, 0, 0)
+	if err != nil {
+		return err
+	}
+	if err := s.store.Reset(newKey, offset); err != nil {
+		return err
+	}
+	return s.store.Delete(oldKey)
+}
+
+// Fill replaces the nulls and zeros of vec with the values allocated from
+// the column and records the explicit values, row by row. It returns the
+// first value allocated, 0 if there is none.
+func (s *Service) Fill(key string, vec *vector.Vector) (uint64, error) {
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		return fill[int8](s, key, vec, math.MaxInt8)
+	case types.T_int16:
+		return fill[int16](s, key, vec, math.MaxInt16)
+	case types.T_int32:
+		return fill[int32](s, key, vec, math.MaxInt32)
+	case types.T_int64:
+		return fill[int64](s, key, vec, math.MaxInt64)
+	case types.T_uint8:
+		return fill[uint8](s, key, vec, math.MaxUint8)
+	case types.T_uint16:
+		return fill[uint16](s, key, vec, math.MaxUint16)
+	case types.T_uint32:
+		return fill[uint32](s, key, vec, math.MaxUint32)
+	case types.T_uint64:
+		return fill[uint64](s, key, vec, math.MaxUint64)
+	default:
+		return 0, moerr.NewInternalError("auto increment column can not be of type '%s'", vec.Typ)
+	}
+}
+
+func fill[T constraints.Integer](s *Service, key string, vec *vector.Vector, max uint64) (uint64, error) {
+	vs := vector.GetColumn[T](vec)
+	isAuto := func(i int) bool {
+		return nulls.Contains(vec.Nsp, uint64(i)) || vs[i] == 0
+	}
+	// the rows are taken in order, so a value allocated after an explicit
+	// value is greater than it
+	first := uint64(0)
+	for i := 0; i < len(vs); {
+		if !isAuto(i) {
+			if vs[i] > 0 {
+				if err := s.Update(key, uint64(vs[i])); err != nil {
+					return 0, err
+				}
+			}
+			i++
+			continue
+		}
+		j := i + 1
+		for j < len(vs) && isAuto(j) {
+			j++
+		}
+		n := uint64(j - i)
+		id, err := s.Alloc(key, n)
+		if err != nil {
+			return 0, err
+		}
+		if id+n-1 > max || id+n-1 < id {
+			return 0, moerr.NewError(moerr.OUT_OF_RANGE, fmt.Sprintf("auto increment value of '%s' is out of range", key))
+		}
+		if first == 0 {
+			first = id
+		}
+		for ; i < j; i++ {
+			vs[i] = T(id)
+			id++
+		}
+	}
+	if vec.Nsp != nil && vec.Nsp.Np != nil {
+		vec.Nsp.Np = nil
+	}
+	return first, nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package incrservice
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlloc(t *testing.T) {
+	store := NewMemoryStore()
+	s0 := New(store, 10)
+	s1 := New(store, 10)
+	key := Key("db", "t", "a")
+
+	v, err := s0.Alloc(key, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), v)
+	v, err = s0.Alloc(key, 3)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), v)
+	// the second service reserves the next range
+	v, err = s1.Alloc(key, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), v)
+	// larger than the step
+	v, err = s0.Alloc(key, 20)
+	require.NoError(t, err)
+	require.Equal(t, uint64(21), v)
+	v, err = s0.Alloc(key, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), v)
+}
+
+func TestUpdate(t *testing.T) {
+	store := NewMemoryStore()
+	s0 := New(store, 10)
+	s1 := New(store, 10)
+	key := Key("db", "t", "a")
+
+	_, err := s0.Alloc(key, 1)
+	require.NoError(t, err)
+	require.NoError(t, s0.Update(key, 5))
+	v, err := s0.Alloc(key, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), v)
+	require.NoError(t, s0.Update(key, 100))
+	v, err = s0.Alloc(key, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(101), v)
+	v, err = s1.Alloc(key, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(111), v)
+
+	require.NoError(t, s0.Rename(key, Key("db", "t1", "a")))
+	v, err = s0.Alloc(Key("db", "t1", "a"), 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(121), v)
+	require.NoError(t, s0.Reset(key, 0))
+	v, err = s0.Alloc(key, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), v)
+}
+
+func TestFill(t *testing.T) {
+	s := New(NewMemoryStore(), 0)
+	key := Key("db", "t", "a")
+
+	vec := vector.New(types.Type{Oid: types.T_int32})
+	vec.Col = []int32{0, 7, 0, 3}
+	nulls.Add(vec.Nsp, 2)
+	first, err := s.Fill(key, vec)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), first)
+	require.Equal(t, []int32{1, 7, 8, 3}, vec.Col)
+	require.False(t, nulls.Any(vec.Nsp))
+
+	// the values are allocated in the order of the rows
+	vec = vector.New(types.Type{Oid: types.T_int64})
+	vec.Col = []int64{0, 5, 0}
+	nulls.Add(vec.Nsp, 0)
+	nulls.Add(vec.Nsp, 2)
+	first, err = s.Fill(Key("db", "t", "c"), vec)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), first)
+	require.Equal(t, []int64{1, 5, 6}, vec.Col)
+
+	vec = vector.New(types.Type{Oid: types.T_int8})
+	vec.Col = []int8{127}
+	_, err = s.Fill(Key("db", "t", "b"), vec)
+	require.NoError(t, err)
+	vec.Col = []int8{0}
+	_, err = s.Fill(Key("db", "t", "b"), vec)
+	require.Error(t, err)
+}
@@ -0,0 +1,32 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package incrservice
+
+// DefaultStep is the number of values reserved from the store at a time
+const DefaultStep = 100
+
+// Store persists the offsets of the auto increment columns. The offset of
+// a column is the largest value handed out of it, 0 if there is none.
+// The sessions allocating the values of a column may share the store, so
+// every method of it must be atomic.
+type Store interface {
+	// Allocate moves the offset of the column to max(offset, min) + count
+	// and returns max(offset, min). The offset is 0 if the column has none.
+	Allocate(key string, count, min uint64) (uint64, error)
+	// Reset sets the offset of the column
+	Reset(key string, offset uint64) error
+	// Delete removes the offset of the column
+	Delete(key string) error
+}
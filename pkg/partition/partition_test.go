@@ -137,6 +137,22 @@ func TestPartition(t *testing.T) {
 	Partition([]int64{1, 3, 5}, []bool{false, false, false}, partitions, v10)
 	require.Equal(t, []int64{0, 1}, partitions)
 
+	v12 := vector.New(types.Type{Oid: types.T(types.T_bool)})
+	v12.Data = encoding.EncodeBoolSlice([]bool{true, true, false, true, false, false})
+	v12.Col = encoding.DecodeBoolSlice(v12.Data)
+	v12.Nsp = &nulls.Nulls{}
+	require.Equal(t, []int64{0, 2}, Partition([]int64{0, 1, 2}, []bool{false, false, false}, partitions, v12))
+	nulls.Add(v12.Nsp, 1)
+	require.Equal(t, []int64{0, 1, 2}, Partition([]int64{0, 1, 2}, []bool{false, false, false}, partitions, v12))
+
+	v13 := vector.New(types.Type{Oid: types.T(types.T_timestamp)})
+	v13.Data = encoding.EncodeTimestampSlice([]types.Timestamp{3, 3, 5, 6, 7, 8})
+	v13.Col = encoding.DecodeTimestampSlice(v13.Data)
+	v13.Nsp = &nulls.Nulls{}
+	require.Equal(t, []int64{0, 2}, Partition([]int64{0, 1, 2}, []bool{false, false, false}, partitions, v13))
+	nulls.Add(v13.Nsp, 1)
+	require.Equal(t, []int64{0, 1, 2}, Partition([]int64{0, 1, 2}, []bool{false, false, false}, partitions, v13))
+
 	v11 := vector.New(types.Type{Oid: types.T(types.T_char)})
 	v11.Col = &types.Bytes{
 		Data:    []byte("helloGutkonichiwanihaonihaoniahonihao"),
@@ -84,6 +84,7 @@ const (
 	Type_ANY  Type_TypeId = 0
 	Type_STAR Type_TypeId = 1
 	Type_BOOL Type_TypeId = 10
+	Type_BIT  Type_TypeId = 11
 	// INTs
 	Type_INT8   Type_TypeId = 20
 	Type_INT16  Type_TypeId = 21
@@ -122,8 +123,12 @@ const (
 	Type_CHAR      Type_TypeId = 60
 	Type_VARCHAR   Type_TypeId = 61
 	Type_JSON      Type_TypeId = 62
+	Type_TEXT      Type_TypeId = 63
 	Type_BINARY    Type_TypeId = 70
 	Type_VARBINARY Type_TypeId = 71
+	Type_BLOB      Type_TypeId = 72
+	Type_ENUM      Type_TypeId = 64
+	Type_SET       Type_TypeId = 65
 	// Special
 	Type_ARRAY      Type_TypeId = 90
 	Type_FLEXBUFFER Type_TypeId = 91
@@ -140,6 +145,7 @@ var Type_TypeId_name = map[int32]string{
 	0:   "ANY",
 	1:   "STAR",
 	10:  "BOOL",
+	11:  "BIT",
 	20:  "INT8",
 	21:  "INT16",
 	22:  "INT32",
@@ -173,8 +179,12 @@ var Type_TypeId_name = map[int32]string{
 	60:  "CHAR",
 	61:  "VARCHAR",
 	62:  "JSON",
+	63:  "TEXT",
 	70:  "BINARY",
 	71:  "VARBINARY",
+	72:  "BLOB",
+	64:  "ENUM",
+	65:  "SET",
 	90:  "ARRAY",
 	91:  "FLEXBUFFER",
 	100: "BYTEA8",
@@ -188,6 +198,7 @@ var Type_TypeId_value = map[string]int32{
 	"ANY":        0,
 	"STAR":       1,
 	"BOOL":       10,
+	"BIT":        11,
 	"INT8":       20,
 	"INT16":      21,
 	"INT32":      22,
@@ -221,8 +232,12 @@ var Type_TypeId_value = map[string]int32{
 	"CHAR":       60,
 	"VARCHAR":    61,
 	"JSON":       62,
+	"TEXT":       63,
 	"BINARY":     70,
 	"VARBINARY":  71,
+	"BLOB":       72,
+	"ENUM":       64,
+	"SET":        65,
 	"ARRAY":      90,
 	"FLEXBUFFER": 91,
 	"BYTEA8":     100,
@@ -339,21 +354,27 @@ func (Function_FuncFlag) EnumDescriptor() ([]byte, []int) {
 type IndexDef_IndexType int32
 
 const (
-	IndexDef_INVAILD IndexDef_IndexType = 0
-	IndexDef_ZONEMAP IndexDef_IndexType = 1
-	IndexDef_BSI     IndexDef_IndexType = 2
+	IndexDef_INVAILD  IndexDef_IndexType = 0
+	IndexDef_ZONEMAP  IndexDef_IndexType = 1
+	IndexDef_BSI      IndexDef_IndexType = 2
+	IndexDef_UNIQUE   IndexDef_IndexType = 3
+	IndexDef_FULLTEXT IndexDef_IndexType = 4
 )
 
 var IndexDef_IndexType_name = map[int32]string{
 	0: "INVAILD",
 	1: "ZONEMAP",
 	2: "BSI",
+	3: "UNIQUE",
+	4: "FULLTEXT",
 }
 
 var IndexDef_IndexType_value = map[string]int32{
-	"INVAILD": 0,
-	"ZONEMAP": 1,
-	"BSI":     2,
+	"INVAILD":  0,
+	"ZONEMAP":  1,
+	"BSI":      2,
+	"UNIQUE":   3,
+	"FULLTEXT": 4,
 }
 
 func (x IndexDef_IndexType) String() string {
@@ -364,6 +385,67 @@ func (IndexDef_IndexType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{17, 0}
 }
 
+// the referential action on the delete or update of the parent key,
+// NO ACTION is the same as RESTRICT
+type ForeignKeyDef_RefAction int32
+
+const (
+	ForeignKeyDef_RESTRICT ForeignKeyDef_RefAction = 0
+	ForeignKeyDef_CASCADE  ForeignKeyDef_RefAction = 1
+	ForeignKeyDef_SET_NULL ForeignKeyDef_RefAction = 2
+)
+
+var ForeignKeyDef_RefAction_name = map[int32]string{
+	0: "RESTRICT",
+	1: "CASCADE",
+	2: "SET_NULL",
+}
+
+var ForeignKeyDef_RefAction_value = map[string]int32{
+	"RESTRICT": 0,
+	"CASCADE":  1,
+	"SET_NULL": 2,
+}
+
+func (x ForeignKeyDef_RefAction) String() string {
+	return proto.EnumName(ForeignKeyDef_RefAction_name, int32(x))
+}
+
+func (ForeignKeyDef_RefAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{23, 0}
+}
+
+type PartitionDef_PartitionType int32
+
+const (
+	PartitionDef_RANGE PartitionDef_PartitionType = 0
+	PartitionDef_LIST  PartitionDef_PartitionType = 1
+	PartitionDef_HASH  PartitionDef_PartitionType = 2
+	PartitionDef_KEY   PartitionDef_PartitionType = 3
+)
+
+var PartitionDef_PartitionType_name = map[int32]string{
+	0: "RANGE",
+	1: "LIST",
+	2: "HASH",
+	3: "KEY",
+}
+
+var PartitionDef_PartitionType_value = map[string]int32{
+	"RANGE": 0,
+	"LIST":  1,
+	"HASH":  2,
+	"KEY":   3,
+}
+
+func (x PartitionDef_PartitionType) String() string {
+	return proto.EnumName(PartitionDef_PartitionType_name, int32(x))
+}
+
+func (PartitionDef_PartitionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{24, 0}
+}
+
 type OrderBySpec_OrderByFlag int32
 
 const (
@@ -398,7 +480,60 @@ func (x OrderBySpec_OrderByFlag) String() string {
 }
 
 func (OrderBySpec_OrderByFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{25, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{29, 0}
+}
+
+type FrameBound_BoundType int32
+
+const (
+	FrameBound_PRECEDING   FrameBound_BoundType = 0
+	FrameBound_CURRENT_ROW FrameBound_BoundType = 1
+	FrameBound_FOLLOWING   FrameBound_BoundType = 2
+)
+
+var FrameBound_BoundType_name = map[int32]string{
+	0: "PRECEDING",
+	1: "CURRENT_ROW",
+	2: "FOLLOWING",
+}
+
+var FrameBound_BoundType_value = map[string]int32{
+	"PRECEDING":   0,
+	"CURRENT_ROW": 1,
+	"FOLLOWING":   2,
+}
+
+func (x FrameBound_BoundType) String() string {
+	return proto.EnumName(FrameBound_BoundType_name, int32(x))
+}
+
+func (FrameBound_BoundType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{30, 0}
+}
+
+type FrameClause_FrameType int32
+
+const (
+	FrameClause_ROWS  FrameClause_FrameType = 0
+	FrameClause_RANGE FrameClause_FrameType = 1
+)
+
+var FrameClause_FrameType_name = map[int32]string{
+	0: "ROWS",
+	1: "RANGE",
+}
+
+var FrameClause_FrameType_value = map[string]int32{
+	"ROWS":  0,
+	"RANGE": 1,
+}
+
+func (x FrameClause_FrameType) String() string {
+	return proto.EnumName(FrameClause_FrameType_name, int32(x))
+}
+
+func (FrameClause_FrameType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{31, 0}
 }
 
 type Node_NodeType int32
@@ -441,6 +576,11 @@ const (
 	Node_INSERT Node_NodeType = 51
 	Node_UPDATE Node_NodeType = 52
 	Node_DELETE Node_NodeType = 53
+	// Set operations
+	Node_INTERSECT     Node_NodeType = 54
+	Node_INTERSECT_ALL Node_NodeType = 55
+	Node_MINUS         Node_NodeType = 56
+	Node_MINUS_ALL     Node_NodeType = 57
 )
 
 var Node_NodeType_name = map[int32]string{
@@ -473,6 +613,10 @@ var Node_NodeType_name = map[int32]string{
 	51: "INSERT",
 	52: "UPDATE",
 	53: "DELETE",
+	54: "INTERSECT",
+	55: "INTERSECT_ALL",
+	56: "MINUS",
+	57: "MINUS_ALL",
 }
 
 var Node_NodeType_value = map[string]int32{
@@ -505,6 +649,10 @@ var Node_NodeType_value = map[string]int32{
 	"INSERT":            51,
 	"UPDATE":            52,
 	"DELETE":            53,
+	"INTERSECT":         54,
+	"INTERSECT_ALL":     55,
+	"MINUS":             56,
+	"MINUS_ALL":         57,
 }
 
 func (x Node_NodeType) String() string {
@@ -512,7 +660,7 @@ func (x Node_NodeType) String() string {
 }
 
 func (Node_NodeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{29, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{35, 0}
 }
 
 type Node_JoinFlag int32
@@ -558,7 +706,7 @@ func (x Node_JoinFlag) String() string {
 }
 
 func (Node_JoinFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{29, 1}
+	return fileDescriptor_2d655ab2f7683c23, []int{35, 1}
 }
 
 type Node_AggMode int32
@@ -586,7 +734,32 @@ func (x Node_AggMode) String() string {
 }
 
 func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{29, 2}
+	return fileDescriptor_2d655ab2f7683c23, []int{35, 2}
+}
+
+type FullTextScan_SearchMode int32
+
+const (
+	FullTextScan_NATURAL_LANGUAGE FullTextScan_SearchMode = 0
+	FullTextScan_BOOLEAN          FullTextScan_SearchMode = 1
+)
+
+var FullTextScan_SearchMode_name = map[int32]string{
+	0: "NATURAL_LANGUAGE",
+	1: "BOOLEAN",
+}
+
+var FullTextScan_SearchMode_value = map[string]int32{
+	"NATURAL_LANGUAGE": 0,
+	"BOOLEAN":          1,
+}
+
+func (x FullTextScan_SearchMode) String() string {
+	return proto.EnumName(FullTextScan_SearchMode_name, int32(x))
+}
+
+func (FullTextScan_SearchMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{36, 0}
 }
 
 type Query_StatementType int32
@@ -623,7 +796,7 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{31, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{38, 0}
 }
 
 type TransationControl_TclType int32
@@ -651,7 +824,7 @@ func (x TransationControl_TclType) String() string {
 }
 
 func (TransationControl_TclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{32, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{39, 0}
 }
 
 type TransationBegin_TransationMode int32
@@ -679,7 +852,7 @@ func (x TransationBegin_TransationMode) String() string {
 }
 
 func (TransationBegin_TransationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{33, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{40, 0}
 }
 
 type DataControl_DclType int32
@@ -719,7 +892,7 @@ func (x DataControl_DclType) String() string {
 }
 
 func (DataControl_DclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{37, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{44, 0}
 }
 
 type DataDefinition_DdlType int32
@@ -746,6 +919,9 @@ const (
 	DataDefinition_SHOW_ERRORS         DataDefinition_DdlType = 18
 	DataDefinition_SHOW_STATUS         DataDefinition_DdlType = 19
 	DataDefinition_SHOW_PROCESSLIST    DataDefinition_DdlType = 20
+	DataDefinition_CREATE_VIEW         DataDefinition_DdlType = 21
+	DataDefinition_DROP_VIEW           DataDefinition_DdlType = 22
+	DataDefinition_SHOW_CREATEVIEW     DataDefinition_DdlType = 23
 )
 
 var DataDefinition_DdlType_name = map[int32]string{
@@ -770,6 +946,9 @@ var DataDefinition_DdlType_name = map[int32]string{
 	18: "SHOW_ERRORS",
 	19: "SHOW_STATUS",
 	20: "SHOW_PROCESSLIST",
+	21: "CREATE_VIEW",
+	22: "DROP_VIEW",
+	23: "SHOW_CREATEVIEW",
 }
 
 var DataDefinition_DdlType_value = map[string]int32{
@@ -794,6 +973,9 @@ var DataDefinition_DdlType_value = map[string]int32{
 	"SHOW_ERRORS":         18,
 	"SHOW_STATUS":         19,
 	"SHOW_PROCESSLIST":    20,
+	"CREATE_VIEW":         21,
+	"DROP_VIEW":           22,
+	"SHOW_CREATEVIEW":     23,
 }
 
 func (x DataDefinition_DdlType) String() string {
@@ -801,19 +983,21 @@ func (x DataDefinition_DdlType) String() string {
 }
 
 func (DataDefinition_DdlType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{38, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{45, 0}
 }
 
 type Type struct {
-	Id                   Type_TypeId `protobuf:"varint,1,opt,name=id,proto3,enum=plan.Type_TypeId" json:"id,omitempty"`
-	Nullable             bool        `protobuf:"varint,2,opt,name=nullable,proto3" json:"nullable,omitempty"`
-	Width                int32       `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
-	Precision            int32       `protobuf:"varint,4,opt,name=precision,proto3" json:"precision,omitempty"`
-	Size                 int32       `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
-	Scale                int32       `protobuf:"varint,6,opt,name=scale,proto3" json:"scale,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Id        Type_TypeId `protobuf:"varint,1,opt,name=id,proto3,enum=plan.Type_TypeId" json:"id,omitempty"`
+	Nullable  bool        `protobuf:"varint,2,opt,name=nullable,proto3" json:"nullable,omitempty"`
+	Width     int32       `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Precision int32       `protobuf:"varint,4,opt,name=precision,proto3" json:"precision,omitempty"`
+	Size      int32       `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Scale     int32       `protobuf:"varint,6,opt,name=scale,proto3" json:"scale,omitempty"`
+	// members of ENUM and SET separated by comma
+	Enumvalues           string   `protobuf:"bytes,7,opt,name=enumvalues,proto3" json:"enumvalues,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Type) Reset()         { *m = Type{} }
@@ -891,6 +1075,13 @@ func (m *Type) GetScale() int32 {
 	return 0
 }
 
+func (m *Type) GetEnumvalues() string {
+	if m != nil {
+		return m.Enumvalues
+	}
+	return ""
+}
+
 // Const: if a const value can be reprensented by int64 or
 // double, use that, otherwise store a string representation.
 type Const struct {
@@ -2262,6 +2453,8 @@ type ColDef struct {
 	Primary              bool         `protobuf:"varint,5,opt,name=primary,proto3" json:"primary,omitempty"`
 	Pkidx                int32        `protobuf:"varint,6,opt,name=pkidx,proto3" json:"pkidx,omitempty"`
 	Comment              string       `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+	AutoIncr             bool         `protobuf:"varint,8,opt,name=auto_incr,json=autoIncr,proto3" json:"auto_incr,omitempty"`
+	NotNull              bool         `protobuf:"varint,9,opt,name=not_null,json=notNull,proto3" json:"not_null,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -2349,6 +2542,20 @@ func (m *ColDef) GetComment() string {
 	return ""
 }
 
+func (m *ColDef) GetAutoIncr() bool {
+	if m != nil {
+		return m.AutoIncr
+	}
+	return false
+}
+
+func (m *ColDef) GetNotNull() bool {
+	if m != nil {
+		return m.NotNull
+	}
+	return false
+}
+
 type IndexDef struct {
 	Typ                  IndexDef_IndexType `protobuf:"varint,1,opt,name=typ,proto3,enum=plan.IndexDef_IndexType" json:"typ,omitempty"`
 	Name                 string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -2562,12 +2769,14 @@ func (m *PropertiesDef) GetProperties() []*Property {
 }
 
 type TableDef struct {
-	Name                 string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Cols                 []*ColDef           `protobuf:"bytes,2,rep,name=cols,proto3" json:"cols,omitempty"`
-	Defs                 []*TableDef_DefType `protobuf:"bytes,3,rep,name=defs,proto3" json:"defs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	Name string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cols []*ColDef           `protobuf:"bytes,2,rep,name=cols,proto3" json:"cols,omitempty"`
+	Defs []*TableDef_DefType `protobuf:"bytes,3,rep,name=defs,proto3" json:"defs,omitempty"`
+	// view is set if the table is a view
+	View                 *ViewDef `protobuf:"bytes,4,opt,name=view,proto3" json:"view,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *TableDef) Reset()         { *m = TableDef{} }
@@ -2624,12 +2833,22 @@ func (m *TableDef) GetDefs() []*TableDef_DefType {
 	return nil
 }
 
+func (m *TableDef) GetView() *ViewDef {
+	if m != nil {
+		return m.View
+	}
+	return nil
+}
+
 type TableDef_DefType struct {
 	// Types that are valid to be assigned to Def:
 	//
 	//	*TableDef_DefType_Pk
 	//	*TableDef_DefType_Idx
 	//	*TableDef_DefType_Properties
+	//	*TableDef_DefType_Check
+	//	*TableDef_DefType_Fk
+	//	*TableDef_DefType_Partition
 	Def                  isTableDef_DefType_Def `protobuf_oneof:"def"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
@@ -2684,10 +2903,22 @@ type TableDef_DefType_Idx struct {
 type TableDef_DefType_Properties struct {
 	Properties *PropertiesDef `protobuf:"bytes,3,opt,name=properties,proto3,oneof" json:"properties,omitempty"`
 }
+type TableDef_DefType_Check struct {
+	Check *CheckDef `protobuf:"bytes,4,opt,name=check,proto3,oneof" json:"check,omitempty"`
+}
+type TableDef_DefType_Fk struct {
+	Fk *ForeignKeyDef `protobuf:"bytes,5,opt,name=fk,proto3,oneof" json:"fk,omitempty"`
+}
+type TableDef_DefType_Partition struct {
+	Partition *PartitionDef `protobuf:"bytes,6,opt,name=partition,proto3,oneof" json:"partition,omitempty"`
+}
 
 func (*TableDef_DefType_Pk) isTableDef_DefType_Def()         {}
 func (*TableDef_DefType_Idx) isTableDef_DefType_Def()        {}
 func (*TableDef_DefType_Properties) isTableDef_DefType_Def() {}
+func (*TableDef_DefType_Check) isTableDef_DefType_Def()      {}
+func (*TableDef_DefType_Fk) isTableDef_DefType_Def()         {}
+func (*TableDef_DefType_Partition) isTableDef_DefType_Def()  {}
 
 func (m *TableDef_DefType) GetDef() isTableDef_DefType_Def {
 	if m != nil {
@@ -2717,38 +2948,60 @@ func (m *TableDef_DefType) GetProperties() *PropertiesDef {
 	return nil
 }
 
+func (m *TableDef_DefType) GetCheck() *CheckDef {
+	if x, ok := m.GetDef().(*TableDef_DefType_Check); ok {
+		return x.Check
+	}
+	return nil
+}
+
+func (m *TableDef_DefType) GetFk() *ForeignKeyDef {
+	if x, ok := m.GetDef().(*TableDef_DefType_Fk); ok {
+		return x.Fk
+	}
+	return nil
+}
+
+func (m *TableDef_DefType) GetPartition() *PartitionDef {
+	if x, ok := m.GetDef().(*TableDef_DefType_Partition); ok {
+		return x.Partition
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*TableDef_DefType) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*TableDef_DefType_Pk)(nil),
 		(*TableDef_DefType_Idx)(nil),
 		(*TableDef_DefType_Properties)(nil),
+		(*TableDef_DefType_Check)(nil),
+		(*TableDef_DefType_Fk)(nil),
+		(*TableDef_DefType_Partition)(nil),
 	}
 }
 
-type Cost struct {
-	Card                 float64  `protobuf:"fixed64,1,opt,name=card,proto3" json:"card,omitempty"`
-	Rowsize              float64  `protobuf:"fixed64,2,opt,name=rowsize,proto3" json:"rowsize,omitempty"`
-	Ndv                  float64  `protobuf:"fixed64,3,opt,name=ndv,proto3" json:"ndv,omitempty"`
-	Start                float64  `protobuf:"fixed64,4,opt,name=start,proto3" json:"start,omitempty"`
-	Total                float64  `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`
+type CheckDef struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the text of the check expression
+	Expr                 string   `protobuf:"bytes,2,opt,name=expr,proto3" json:"expr,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Cost) Reset()         { *m = Cost{} }
-func (m *Cost) String() string { return proto.CompactTextString(m) }
-func (*Cost) ProtoMessage()    {}
-func (*Cost) Descriptor() ([]byte, []int) {
+func (m *CheckDef) Reset()         { *m = CheckDef{} }
+func (m *CheckDef) String() string { return proto.CompactTextString(m) }
+func (*CheckDef) ProtoMessage()    {}
+func (*CheckDef) Descriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{22}
 }
-func (m *Cost) XXX_Unmarshal(b []byte) error {
+func (m *CheckDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Cost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CheckDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Cost.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CheckDef.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2758,79 +3011,57 @@ func (m *Cost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Cost) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Cost.Merge(m, src)
+func (m *CheckDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckDef.Merge(m, src)
 }
-func (m *Cost) XXX_Size() int {
+func (m *CheckDef) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *Cost) XXX_DiscardUnknown() {
-	xxx_messageInfo_Cost.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Cost proto.InternalMessageInfo
-
-func (m *Cost) GetCard() float64 {
-	if m != nil {
-		return m.Card
-	}
-	return 0
-}
-
-func (m *Cost) GetRowsize() float64 {
-	if m != nil {
-		return m.Rowsize
-	}
-	return 0
+func (m *CheckDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckDef.DiscardUnknown(m)
 }
 
-func (m *Cost) GetNdv() float64 {
-	if m != nil {
-		return m.Ndv
-	}
-	return 0
-}
+var xxx_messageInfo_CheckDef proto.InternalMessageInfo
 
-func (m *Cost) GetStart() float64 {
+func (m *CheckDef) GetName() string {
 	if m != nil {
-		return m.Start
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func (m *Cost) GetTotal() float64 {
+func (m *CheckDef) GetExpr() string {
 	if m != nil {
-		return m.Total
+		return m.Expr
 	}
-	return 0
+	return ""
 }
 
-type ColData struct {
-	RowCount             int32     `protobuf:"varint,1,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
-	NullCount            int32     `protobuf:"varint,2,opt,name=null_count,json=nullCount,proto3" json:"null_count,omitempty"`
-	Nulls                []bool    `protobuf:"varint,3,rep,packed,name=nulls,proto3" json:"nulls,omitempty"`
-	I32                  []int32   `protobuf:"varint,4,rep,packed,name=i32,proto3" json:"i32,omitempty"`
-	I64                  []int64   `protobuf:"varint,5,rep,packed,name=i64,proto3" json:"i64,omitempty"`
-	F32                  []float32 `protobuf:"fixed32,6,rep,packed,name=f32,proto3" json:"f32,omitempty"`
-	F64                  []float64 `protobuf:"fixed64,7,rep,packed,name=f64,proto3" json:"f64,omitempty"`
-	S                    []string  `protobuf:"bytes,8,rep,name=s,proto3" json:"s,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type ForeignKeyDef struct {
+	Name string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cols []string `protobuf:"bytes,2,rep,name=cols,proto3" json:"cols,omitempty"`
+	// the parent table is in the database of the child table
+	ParentTable          string                  `protobuf:"bytes,3,opt,name=parent_table,json=parentTable,proto3" json:"parent_table,omitempty"`
+	ParentCols           []string                `protobuf:"bytes,4,rep,name=parent_cols,json=parentCols,proto3" json:"parent_cols,omitempty"`
+	OnDelete             ForeignKeyDef_RefAction `protobuf:"varint,5,opt,name=on_delete,json=onDelete,proto3,enum=plan.ForeignKeyDef_RefAction" json:"on_delete,omitempty"`
+	OnUpdate             ForeignKeyDef_RefAction `protobuf:"varint,6,opt,name=on_update,json=onUpdate,proto3,enum=plan.ForeignKeyDef_RefAction" json:"on_update,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (m *ColData) Reset()         { *m = ColData{} }
-func (m *ColData) String() string { return proto.CompactTextString(m) }
-func (*ColData) ProtoMessage()    {}
-func (*ColData) Descriptor() ([]byte, []int) {
+func (m *ForeignKeyDef) Reset()         { *m = ForeignKeyDef{} }
+func (m *ForeignKeyDef) String() string { return proto.CompactTextString(m) }
+func (*ForeignKeyDef) ProtoMessage()    {}
+func (*ForeignKeyDef) Descriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{23}
 }
-func (m *ColData) XXX_Unmarshal(b []byte) error {
+func (m *ForeignKeyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ColData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ForeignKeyDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ColData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ForeignKeyDef.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2840,94 +3071,83 @@ func (m *ColData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ColData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ColData.Merge(m, src)
+func (m *ForeignKeyDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForeignKeyDef.Merge(m, src)
 }
-func (m *ColData) XXX_Size() int {
+func (m *ForeignKeyDef) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *ColData) XXX_DiscardUnknown() {
-	xxx_messageInfo_ColData.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ColData proto.InternalMessageInfo
-
-func (m *ColData) GetRowCount() int32 {
-	if m != nil {
-		return m.RowCount
-	}
-	return 0
+func (m *ForeignKeyDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForeignKeyDef.DiscardUnknown(m)
 }
 
-func (m *ColData) GetNullCount() int32 {
-	if m != nil {
-		return m.NullCount
-	}
-	return 0
-}
+var xxx_messageInfo_ForeignKeyDef proto.InternalMessageInfo
 
-func (m *ColData) GetNulls() []bool {
+func (m *ForeignKeyDef) GetName() string {
 	if m != nil {
-		return m.Nulls
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *ColData) GetI32() []int32 {
+func (m *ForeignKeyDef) GetCols() []string {
 	if m != nil {
-		return m.I32
+		return m.Cols
 	}
 	return nil
 }
 
-func (m *ColData) GetI64() []int64 {
+func (m *ForeignKeyDef) GetParentTable() string {
 	if m != nil {
-		return m.I64
+		return m.ParentTable
 	}
-	return nil
+	return ""
 }
 
-func (m *ColData) GetF32() []float32 {
+func (m *ForeignKeyDef) GetParentCols() []string {
 	if m != nil {
-		return m.F32
+		return m.ParentCols
 	}
 	return nil
 }
 
-func (m *ColData) GetF64() []float64 {
+func (m *ForeignKeyDef) GetOnDelete() ForeignKeyDef_RefAction {
 	if m != nil {
-		return m.F64
+		return m.OnDelete
 	}
-	return nil
+	return ForeignKeyDef_RESTRICT
 }
 
-func (m *ColData) GetS() []string {
+func (m *ForeignKeyDef) GetOnUpdate() ForeignKeyDef_RefAction {
 	if m != nil {
-		return m.S
+		return m.OnUpdate
 	}
-	return nil
+	return ForeignKeyDef_RESTRICT
 }
 
-type RowsetData struct {
-	Schema               *TableDef  `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
-	Cols                 []*ColData `protobuf:"bytes,2,rep,name=cols,proto3" json:"cols,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type PartitionDef struct {
+	Type PartitionDef_PartitionType `protobuf:"varint,1,opt,name=type,proto3,enum=plan.PartitionDef_PartitionType" json:"type,omitempty"`
+	// the partitioning function applied to the column, empty if none
+	Func                 string                    `protobuf:"bytes,2,opt,name=func,proto3" json:"func,omitempty"`
+	Cols                 []string                  `protobuf:"bytes,3,rep,name=cols,proto3" json:"cols,omitempty"`
+	Partitions           []*PartitionDef_Partition `protobuf:"bytes,4,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *RowsetData) Reset()         { *m = RowsetData{} }
-func (m *RowsetData) String() string { return proto.CompactTextString(m) }
-func (*RowsetData) ProtoMessage()    {}
-func (*RowsetData) Descriptor() ([]byte, []int) {
+func (m *PartitionDef) Reset()         { *m = PartitionDef{} }
+func (m *PartitionDef) String() string { return proto.CompactTextString(m) }
+func (*PartitionDef) ProtoMessage()    {}
+func (*PartitionDef) Descriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{24}
 }
-func (m *RowsetData) XXX_Unmarshal(b []byte) error {
+func (m *PartitionDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RowsetData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PartitionDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RowsetData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PartitionDef.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2937,53 +3157,68 @@ func (m *RowsetData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *RowsetData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RowsetData.Merge(m, src)
+func (m *PartitionDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionDef.Merge(m, src)
 }
-func (m *RowsetData) XXX_Size() int {
+func (m *PartitionDef) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *RowsetData) XXX_DiscardUnknown() {
-	xxx_messageInfo_RowsetData.DiscardUnknown(m)
+func (m *PartitionDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionDef.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RowsetData proto.InternalMessageInfo
+var xxx_messageInfo_PartitionDef proto.InternalMessageInfo
 
-func (m *RowsetData) GetSchema() *TableDef {
+func (m *PartitionDef) GetType() PartitionDef_PartitionType {
 	if m != nil {
-		return m.Schema
+		return m.Type
 	}
-	return nil
+	return PartitionDef_RANGE
 }
 
-func (m *RowsetData) GetCols() []*ColData {
+func (m *PartitionDef) GetFunc() string {
+	if m != nil {
+		return m.Func
+	}
+	return ""
+}
+
+func (m *PartitionDef) GetCols() []string {
 	if m != nil {
 		return m.Cols
 	}
 	return nil
 }
 
-type OrderBySpec struct {
-	Expr                 *Expr                   `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
-	Collation            string                  `protobuf:"bytes,2,opt,name=collation,proto3" json:"collation,omitempty"`
-	Flag                 OrderBySpec_OrderByFlag `protobuf:"varint,3,opt,name=flag,proto3,enum=plan.OrderBySpec_OrderByFlag" json:"flag,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+func (m *PartitionDef) GetPartitions() []*PartitionDef_Partition {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
 }
 
-func (m *OrderBySpec) Reset()         { *m = OrderBySpec{} }
-func (m *OrderBySpec) String() string { return proto.CompactTextString(m) }
-func (*OrderBySpec) ProtoMessage()    {}
-func (*OrderBySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{25}
+type PartitionDef_Partition struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the upper bound of a RANGE partition, none for MAXVALUE, or the
+	// values of a LIST partition
+	Values               []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *OrderBySpec) XXX_Unmarshal(b []byte) error {
+
+func (m *PartitionDef_Partition) Reset()         { *m = PartitionDef_Partition{} }
+func (m *PartitionDef_Partition) String() string { return proto.CompactTextString(m) }
+func (*PartitionDef_Partition) ProtoMessage()    {}
+func (*PartitionDef_Partition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{24, 0}
+}
+func (m *PartitionDef_Partition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OrderBySpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PartitionDef_Partition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OrderBySpec.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PartitionDef_Partition.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2993,61 +3228,52 @@ func (m *OrderBySpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *OrderBySpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OrderBySpec.Merge(m, src)
+func (m *PartitionDef_Partition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionDef_Partition.Merge(m, src)
 }
-func (m *OrderBySpec) XXX_Size() int {
+func (m *PartitionDef_Partition) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *OrderBySpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_OrderBySpec.DiscardUnknown(m)
+func (m *PartitionDef_Partition) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionDef_Partition.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OrderBySpec proto.InternalMessageInfo
-
-func (m *OrderBySpec) GetExpr() *Expr {
-	if m != nil {
-		return m.Expr
-	}
-	return nil
-}
+var xxx_messageInfo_PartitionDef_Partition proto.InternalMessageInfo
 
-func (m *OrderBySpec) GetCollation() string {
+func (m *PartitionDef_Partition) GetName() string {
 	if m != nil {
-		return m.Collation
+		return m.Name
 	}
 	return ""
 }
 
-func (m *OrderBySpec) GetFlag() OrderBySpec_OrderByFlag {
+func (m *PartitionDef_Partition) GetValues() []string {
 	if m != nil {
-		return m.Flag
+		return m.Values
 	}
-	return OrderBySpec_ASC
+	return nil
 }
 
-type WindowSpec struct {
-	PartitionBy          []*Expr        `protobuf:"bytes,1,rep,name=partition_by,json=partitionBy,proto3" json:"partition_by,omitempty"`
-	OrderBy              []*OrderBySpec `protobuf:"bytes,2,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Lead                 int32          `protobuf:"varint,3,opt,name=lead,proto3" json:"lead,omitempty"`
-	Lag                  int32          `protobuf:"varint,4,opt,name=lag,proto3" json:"lag,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type ViewDef struct {
+	// the create view statement which defines the view
+	View                 string   `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WindowSpec) Reset()         { *m = WindowSpec{} }
-func (m *WindowSpec) String() string { return proto.CompactTextString(m) }
-func (*WindowSpec) ProtoMessage()    {}
-func (*WindowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{26}
+func (m *ViewDef) Reset()         { *m = ViewDef{} }
+func (m *ViewDef) String() string { return proto.CompactTextString(m) }
+func (*ViewDef) ProtoMessage()    {}
+func (*ViewDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{25}
 }
-func (m *WindowSpec) XXX_Unmarshal(b []byte) error {
+func (m *ViewDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WindowSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ViewDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WindowSpec.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ViewDef.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3057,70 +3283,48 @@ func (m *WindowSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *WindowSpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WindowSpec.Merge(m, src)
+func (m *ViewDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ViewDef.Merge(m, src)
 }
-func (m *WindowSpec) XXX_Size() int {
+func (m *ViewDef) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *WindowSpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_WindowSpec.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_WindowSpec proto.InternalMessageInfo
-
-func (m *WindowSpec) GetPartitionBy() []*Expr {
-	if m != nil {
-		return m.PartitionBy
-	}
-	return nil
-}
-
-func (m *WindowSpec) GetOrderBy() []*OrderBySpec {
-	if m != nil {
-		return m.OrderBy
-	}
-	return nil
+func (m *ViewDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_ViewDef.DiscardUnknown(m)
 }
 
-func (m *WindowSpec) GetLead() int32 {
-	if m != nil {
-		return m.Lead
-	}
-	return 0
-}
+var xxx_messageInfo_ViewDef proto.InternalMessageInfo
 
-func (m *WindowSpec) GetLag() int32 {
+func (m *ViewDef) GetView() string {
 	if m != nil {
-		return m.Lag
+		return m.View
 	}
-	return 0
+	return ""
 }
 
-type UpdateInfo struct {
-	PriKey               string   `protobuf:"bytes,1,opt,name=pri_key,json=priKey,proto3" json:"pri_key,omitempty"`
-	PriKeyIdx            int32    `protobuf:"varint,2,opt,name=pri_key_idx,json=priKeyIdx,proto3" json:"pri_key_idx,omitempty"`
-	HideKey              string   `protobuf:"bytes,3,opt,name=hide_key,json=hideKey,proto3" json:"hide_key,omitempty"`
-	UpdateAttrs          []string `protobuf:"bytes,4,rep,name=update_attrs,json=updateAttrs,proto3" json:"update_attrs,omitempty"`
-	OtherAttrs           []string `protobuf:"bytes,5,rep,name=other_attrs,json=otherAttrs,proto3" json:"other_attrs,omitempty"`
-	AttrOrders           []string `protobuf:"bytes,6,rep,name=attr_orders,json=attrOrders,proto3" json:"attr_orders,omitempty"`
+type Cost struct {
+	Card                 float64  `protobuf:"fixed64,1,opt,name=card,proto3" json:"card,omitempty"`
+	Rowsize              float64  `protobuf:"fixed64,2,opt,name=rowsize,proto3" json:"rowsize,omitempty"`
+	Ndv                  float64  `protobuf:"fixed64,3,opt,name=ndv,proto3" json:"ndv,omitempty"`
+	Start                float64  `protobuf:"fixed64,4,opt,name=start,proto3" json:"start,omitempty"`
+	Total                float64  `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateInfo) Reset()         { *m = UpdateInfo{} }
-func (m *UpdateInfo) String() string { return proto.CompactTextString(m) }
-func (*UpdateInfo) ProtoMessage()    {}
-func (*UpdateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{27}
+func (m *Cost) Reset()         { *m = Cost{} }
+func (m *Cost) String() string { return proto.CompactTextString(m) }
+func (*Cost) ProtoMessage()    {}
+func (*Cost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{26}
 }
-func (m *UpdateInfo) XXX_Unmarshal(b []byte) error {
+func (m *Cost) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Cost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Cost.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3130,84 +3334,79 @@ func (m *UpdateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *UpdateInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateInfo.Merge(m, src)
+func (m *Cost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Cost.Merge(m, src)
 }
-func (m *UpdateInfo) XXX_Size() int {
+func (m *Cost) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *UpdateInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateInfo.DiscardUnknown(m)
+func (m *Cost) XXX_DiscardUnknown() {
+	xxx_messageInfo_Cost.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateInfo proto.InternalMessageInfo
-
-func (m *UpdateInfo) GetPriKey() string {
-	if m != nil {
-		return m.PriKey
-	}
-	return ""
-}
+var xxx_messageInfo_Cost proto.InternalMessageInfo
 
-func (m *UpdateInfo) GetPriKeyIdx() int32 {
+func (m *Cost) GetCard() float64 {
 	if m != nil {
-		return m.PriKeyIdx
+		return m.Card
 	}
 	return 0
 }
 
-func (m *UpdateInfo) GetHideKey() string {
+func (m *Cost) GetRowsize() float64 {
 	if m != nil {
-		return m.HideKey
+		return m.Rowsize
 	}
-	return ""
+	return 0
 }
 
-func (m *UpdateInfo) GetUpdateAttrs() []string {
+func (m *Cost) GetNdv() float64 {
 	if m != nil {
-		return m.UpdateAttrs
+		return m.Ndv
 	}
-	return nil
+	return 0
 }
 
-func (m *UpdateInfo) GetOtherAttrs() []string {
+func (m *Cost) GetStart() float64 {
 	if m != nil {
-		return m.OtherAttrs
+		return m.Start
 	}
-	return nil
+	return 0
 }
 
-func (m *UpdateInfo) GetAttrOrders() []string {
+func (m *Cost) GetTotal() float64 {
 	if m != nil {
-		return m.AttrOrders
+		return m.Total
 	}
-	return nil
+	return 0
 }
 
-type AnalyzeInfo struct {
-	InputRows            int64    `protobuf:"varint,1,opt,name=input_rows,json=inputRows,proto3" json:"input_rows,omitempty"`
-	OutputRows           int64    `protobuf:"varint,2,opt,name=output_rows,json=outputRows,proto3" json:"output_rows,omitempty"`
-	InputSize            int64    `protobuf:"varint,3,opt,name=input_size,json=inputSize,proto3" json:"input_size,omitempty"`
-	OutputSize           int64    `protobuf:"varint,4,opt,name=output_size,json=outputSize,proto3" json:"output_size,omitempty"`
-	TimeConsumed         int64    `protobuf:"varint,5,opt,name=time_consumed,json=timeConsumed,proto3" json:"time_consumed,omitempty"`
-	MemorySize           int64    `protobuf:"varint,6,opt,name=memory_size,json=memorySize,proto3" json:"memory_size,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ColData struct {
+	RowCount             int32     `protobuf:"varint,1,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	NullCount            int32     `protobuf:"varint,2,opt,name=null_count,json=nullCount,proto3" json:"null_count,omitempty"`
+	Nulls                []bool    `protobuf:"varint,3,rep,packed,name=nulls,proto3" json:"nulls,omitempty"`
+	I32                  []int32   `protobuf:"varint,4,rep,packed,name=i32,proto3" json:"i32,omitempty"`
+	I64                  []int64   `protobuf:"varint,5,rep,packed,name=i64,proto3" json:"i64,omitempty"`
+	F32                  []float32 `protobuf:"fixed32,6,rep,packed,name=f32,proto3" json:"f32,omitempty"`
+	F64                  []float64 `protobuf:"fixed64,7,rep,packed,name=f64,proto3" json:"f64,omitempty"`
+	S                    []string  `protobuf:"bytes,8,rep,name=s,proto3" json:"s,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *AnalyzeInfo) Reset()         { *m = AnalyzeInfo{} }
-func (m *AnalyzeInfo) String() string { return proto.CompactTextString(m) }
-func (*AnalyzeInfo) ProtoMessage()    {}
-func (*AnalyzeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{28}
+func (m *ColData) Reset()         { *m = ColData{} }
+func (m *ColData) String() string { return proto.CompactTextString(m) }
+func (*ColData) ProtoMessage()    {}
+func (*ColData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{27}
 }
-func (m *AnalyzeInfo) XXX_Unmarshal(b []byte) error {
+func (m *ColData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AnalyzeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ColData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AnalyzeInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ColData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3217,101 +3416,94 @@ func (m *AnalyzeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *AnalyzeInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AnalyzeInfo.Merge(m, src)
+func (m *ColData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ColData.Merge(m, src)
 }
-func (m *AnalyzeInfo) XXX_Size() int {
+func (m *ColData) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *AnalyzeInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_AnalyzeInfo.DiscardUnknown(m)
+func (m *ColData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ColData.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AnalyzeInfo proto.InternalMessageInfo
+var xxx_messageInfo_ColData proto.InternalMessageInfo
 
-func (m *AnalyzeInfo) GetInputRows() int64 {
+func (m *ColData) GetRowCount() int32 {
 	if m != nil {
-		return m.InputRows
+		return m.RowCount
 	}
 	return 0
 }
 
-func (m *AnalyzeInfo) GetOutputRows() int64 {
+func (m *ColData) GetNullCount() int32 {
 	if m != nil {
-		return m.OutputRows
+		return m.NullCount
 	}
 	return 0
 }
 
-func (m *AnalyzeInfo) GetInputSize() int64 {
+func (m *ColData) GetNulls() []bool {
 	if m != nil {
-		return m.InputSize
+		return m.Nulls
 	}
-	return 0
+	return nil
 }
 
-func (m *AnalyzeInfo) GetOutputSize() int64 {
+func (m *ColData) GetI32() []int32 {
 	if m != nil {
-		return m.OutputSize
+		return m.I32
 	}
-	return 0
+	return nil
 }
 
-func (m *AnalyzeInfo) GetTimeConsumed() int64 {
+func (m *ColData) GetI64() []int64 {
 	if m != nil {
-		return m.TimeConsumed
+		return m.I64
 	}
-	return 0
+	return nil
 }
 
-func (m *AnalyzeInfo) GetMemorySize() int64 {
+func (m *ColData) GetF32() []float32 {
 	if m != nil {
-		return m.MemorySize
+		return m.F32
 	}
-	return 0
+	return nil
 }
 
-type Node struct {
-	NodeType             Node_NodeType     `protobuf:"varint,1,opt,name=node_type,json=nodeType,proto3,enum=plan.Node_NodeType" json:"node_type,omitempty"`
-	NodeId               int32             `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Cost                 *Cost             `protobuf:"bytes,3,opt,name=cost,proto3" json:"cost,omitempty"`
-	ProjectList          []*Expr           `protobuf:"bytes,4,rep,name=project_list,json=projectList,proto3" json:"project_list,omitempty"`
-	Children             []int32           `protobuf:"varint,5,rep,packed,name=children,proto3" json:"children,omitempty"`
-	JoinType             Node_JoinFlag     `protobuf:"varint,6,opt,name=join_type,json=joinType,proto3,enum=plan.Node_JoinFlag" json:"join_type,omitempty"`
-	OnList               []*Expr           `protobuf:"bytes,7,rep,name=on_list,json=onList,proto3" json:"on_list,omitempty"`
-	FilterList           []*Expr           `protobuf:"bytes,8,rep,name=filter_list,json=filterList,proto3" json:"filter_list,omitempty"`
-	GroupBy              []*Expr           `protobuf:"bytes,9,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
-	GroupingSet          []*Expr           `protobuf:"bytes,10,rep,name=grouping_set,json=groupingSet,proto3" json:"grouping_set,omitempty"`
-	AggList              []*Expr           `protobuf:"bytes,11,rep,name=agg_list,json=aggList,proto3" json:"agg_list,omitempty"`
-	OrderBy              []*OrderBySpec    `protobuf:"bytes,12,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	UpdateInfo           *UpdateInfo       `protobuf:"bytes,13,opt,name=update_info,json=updateInfo,proto3" json:"update_info,omitempty"`
-	WinSpec              *WindowSpec       `protobuf:"bytes,14,opt,name=win_spec,json=winSpec,proto3" json:"win_spec,omitempty"`
-	Limit                *Expr             `protobuf:"bytes,15,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset               *Expr             `protobuf:"bytes,16,opt,name=offset,proto3" json:"offset,omitempty"`
-	TableDef             *TableDef         `protobuf:"bytes,17,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
-	ObjRef               *ObjectRef        `protobuf:"bytes,18,opt,name=obj_ref,json=objRef,proto3" json:"obj_ref,omitempty"`
-	RowsetData           *RowsetData       `protobuf:"bytes,19,opt,name=rowset_data,json=rowsetData,proto3" json:"rowset_data,omitempty"`
-	ExtraOptions         string            `protobuf:"bytes,20,opt,name=extra_options,json=extraOptions,proto3" json:"extra_options,omitempty"`
-	DeleteTablesCtx      []*DeleteTableCtx `protobuf:"bytes,21,rep,name=deleteTablesCtx,proto3" json:"deleteTablesCtx,omitempty"`
-	BindingTags          []int32           `protobuf:"varint,22,rep,packed,name=binding_tags,json=bindingTags,proto3" json:"binding_tags,omitempty"`
-	AnalyzeInfo          *AnalyzeInfo      `protobuf:"bytes,23,opt,name=analyze_info,json=analyzeInfo,proto3" json:"analyze_info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *ColData) GetF64() []float64 {
+	if m != nil {
+		return m.F64
+	}
+	return nil
 }
 
-func (m *Node) Reset()         { *m = Node{} }
-func (m *Node) String() string { return proto.CompactTextString(m) }
-func (*Node) ProtoMessage()    {}
-func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{29}
-}
-func (m *Node) XXX_Unmarshal(b []byte) error {
+func (m *ColData) GetS() []string {
+	if m != nil {
+		return m.S
+	}
+	return nil
+}
+
+type RowsetData struct {
+	Schema               *TableDef  `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Cols                 []*ColData `protobuf:"bytes,2,rep,name=cols,proto3" json:"cols,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *RowsetData) Reset()         { *m = RowsetData{} }
+func (m *RowsetData) String() string { return proto.CompactTextString(m) }
+func (*RowsetData) ProtoMessage()    {}
+func (*RowsetData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{28}
+}
+func (m *RowsetData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Node) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RowsetData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Node.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RowsetData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3321,201 +3513,326 @@ func (m *Node) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Node) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Node.Merge(m, src)
+func (m *RowsetData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RowsetData.Merge(m, src)
 }
-func (m *Node) XXX_Size() int {
+func (m *RowsetData) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *Node) XXX_DiscardUnknown() {
-	xxx_messageInfo_Node.DiscardUnknown(m)
+func (m *RowsetData) XXX_DiscardUnknown() {
+	xxx_messageInfo_RowsetData.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Node proto.InternalMessageInfo
+var xxx_messageInfo_RowsetData proto.InternalMessageInfo
 
-func (m *Node) GetNodeType() Node_NodeType {
+func (m *RowsetData) GetSchema() *TableDef {
 	if m != nil {
-		return m.NodeType
+		return m.Schema
 	}
-	return Node_UNKNOWN
+	return nil
 }
 
-func (m *Node) GetNodeId() int32 {
+func (m *RowsetData) GetCols() []*ColData {
 	if m != nil {
-		return m.NodeId
+		return m.Cols
 	}
-	return 0
+	return nil
 }
 
-func (m *Node) GetCost() *Cost {
-	if m != nil {
-		return m.Cost
-	}
-	return nil
+type OrderBySpec struct {
+	Expr                 *Expr                   `protobuf:"bytes,1,opt,name=expr,proto3" json:"expr,omitempty"`
+	Collation            string                  `protobuf:"bytes,2,opt,name=collation,proto3" json:"collation,omitempty"`
+	Flag                 OrderBySpec_OrderByFlag `protobuf:"varint,3,opt,name=flag,proto3,enum=plan.OrderBySpec_OrderByFlag" json:"flag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (m *Node) GetProjectList() []*Expr {
-	if m != nil {
-		return m.ProjectList
+func (m *OrderBySpec) Reset()         { *m = OrderBySpec{} }
+func (m *OrderBySpec) String() string { return proto.CompactTextString(m) }
+func (*OrderBySpec) ProtoMessage()    {}
+func (*OrderBySpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{29}
+}
+func (m *OrderBySpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBySpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBySpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *OrderBySpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBySpec.Merge(m, src)
+}
+func (m *OrderBySpec) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *OrderBySpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBySpec.DiscardUnknown(m)
 }
 
-func (m *Node) GetChildren() []int32 {
+var xxx_messageInfo_OrderBySpec proto.InternalMessageInfo
+
+func (m *OrderBySpec) GetExpr() *Expr {
 	if m != nil {
-		return m.Children
+		return m.Expr
 	}
 	return nil
 }
 
-func (m *Node) GetJoinType() Node_JoinFlag {
+func (m *OrderBySpec) GetCollation() string {
 	if m != nil {
-		return m.JoinType
+		return m.Collation
 	}
-	return Node_INNER
+	return ""
 }
 
-func (m *Node) GetOnList() []*Expr {
+func (m *OrderBySpec) GetFlag() OrderBySpec_OrderByFlag {
 	if m != nil {
-		return m.OnList
+		return m.Flag
 	}
-	return nil
+	return OrderBySpec_ASC
 }
 
-func (m *Node) GetFilterList() []*Expr {
-	if m != nil {
-		return m.FilterList
-	}
-	return nil
+type FrameBound struct {
+	Type                 FrameBound_BoundType `protobuf:"varint,1,opt,name=type,proto3,enum=plan.FrameBound_BoundType" json:"type,omitempty"`
+	Unbounded            bool                 `protobuf:"varint,2,opt,name=unbounded,proto3" json:"unbounded,omitempty"`
+	Val                  *Expr                `protobuf:"bytes,3,opt,name=val,proto3" json:"val,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *Node) GetGroupBy() []*Expr {
-	if m != nil {
-		return m.GroupBy
+func (m *FrameBound) Reset()         { *m = FrameBound{} }
+func (m *FrameBound) String() string { return proto.CompactTextString(m) }
+func (*FrameBound) ProtoMessage()    {}
+func (*FrameBound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{30}
+}
+func (m *FrameBound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrameBound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrameBound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *FrameBound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrameBound.Merge(m, src)
+}
+func (m *FrameBound) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *FrameBound) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrameBound.DiscardUnknown(m)
 }
 
-func (m *Node) GetGroupingSet() []*Expr {
+var xxx_messageInfo_FrameBound proto.InternalMessageInfo
+
+func (m *FrameBound) GetType() FrameBound_BoundType {
 	if m != nil {
-		return m.GroupingSet
+		return m.Type
 	}
-	return nil
+	return FrameBound_PRECEDING
 }
 
-func (m *Node) GetAggList() []*Expr {
+func (m *FrameBound) GetUnbounded() bool {
 	if m != nil {
-		return m.AggList
+		return m.Unbounded
 	}
-	return nil
+	return false
 }
 
-func (m *Node) GetOrderBy() []*OrderBySpec {
+func (m *FrameBound) GetVal() *Expr {
 	if m != nil {
-		return m.OrderBy
+		return m.Val
 	}
 	return nil
 }
 
-func (m *Node) GetUpdateInfo() *UpdateInfo {
-	if m != nil {
-		return m.UpdateInfo
-	}
-	return nil
+type FrameClause struct {
+	Type                 FrameClause_FrameType `protobuf:"varint,1,opt,name=type,proto3,enum=plan.FrameClause_FrameType" json:"type,omitempty"`
+	Start                *FrameBound           `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End                  *FrameBound           `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *Node) GetWinSpec() *WindowSpec {
-	if m != nil {
-		return m.WinSpec
+func (m *FrameClause) Reset()         { *m = FrameClause{} }
+func (m *FrameClause) String() string { return proto.CompactTextString(m) }
+func (*FrameClause) ProtoMessage()    {}
+func (*FrameClause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{31}
+}
+func (m *FrameClause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrameClause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrameClause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *FrameClause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrameClause.Merge(m, src)
+}
+func (m *FrameClause) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *FrameClause) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrameClause.DiscardUnknown(m)
 }
 
-func (m *Node) GetLimit() *Expr {
+var xxx_messageInfo_FrameClause proto.InternalMessageInfo
+
+func (m *FrameClause) GetType() FrameClause_FrameType {
 	if m != nil {
-		return m.Limit
+		return m.Type
 	}
-	return nil
+	return FrameClause_ROWS
 }
 
-func (m *Node) GetOffset() *Expr {
+func (m *FrameClause) GetStart() *FrameBound {
 	if m != nil {
-		return m.Offset
+		return m.Start
 	}
 	return nil
 }
 
-func (m *Node) GetTableDef() *TableDef {
+func (m *FrameClause) GetEnd() *FrameBound {
 	if m != nil {
-		return m.TableDef
+		return m.End
 	}
 	return nil
 }
 
-func (m *Node) GetObjRef() *ObjectRef {
-	if m != nil {
-		return m.ObjRef
+type WindowSpec struct {
+	PartitionBy          []*Expr        `protobuf:"bytes,1,rep,name=partition_by,json=partitionBy,proto3" json:"partition_by,omitempty"`
+	OrderBy              []*OrderBySpec `protobuf:"bytes,2,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Lead                 int32          `protobuf:"varint,3,opt,name=lead,proto3" json:"lead,omitempty"`
+	Lag                  int32          `protobuf:"varint,4,opt,name=lag,proto3" json:"lag,omitempty"`
+	Frame                *FrameClause   `protobuf:"bytes,5,opt,name=frame,proto3" json:"frame,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *WindowSpec) Reset()         { *m = WindowSpec{} }
+func (m *WindowSpec) String() string { return proto.CompactTextString(m) }
+func (*WindowSpec) ProtoMessage()    {}
+func (*WindowSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{32}
+}
+func (m *WindowSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WindowSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WindowSpec.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *WindowSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WindowSpec.Merge(m, src)
+}
+func (m *WindowSpec) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *WindowSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_WindowSpec.DiscardUnknown(m)
 }
 
-func (m *Node) GetRowsetData() *RowsetData {
+var xxx_messageInfo_WindowSpec proto.InternalMessageInfo
+
+func (m *WindowSpec) GetPartitionBy() []*Expr {
 	if m != nil {
-		return m.RowsetData
+		return m.PartitionBy
 	}
 	return nil
 }
 
-func (m *Node) GetExtraOptions() string {
+func (m *WindowSpec) GetOrderBy() []*OrderBySpec {
 	if m != nil {
-		return m.ExtraOptions
+		return m.OrderBy
 	}
-	return ""
+	return nil
 }
 
-func (m *Node) GetDeleteTablesCtx() []*DeleteTableCtx {
+func (m *WindowSpec) GetLead() int32 {
 	if m != nil {
-		return m.DeleteTablesCtx
+		return m.Lead
 	}
-	return nil
+	return 0
 }
 
-func (m *Node) GetBindingTags() []int32 {
+func (m *WindowSpec) GetLag() int32 {
 	if m != nil {
-		return m.BindingTags
+		return m.Lag
 	}
-	return nil
+	return 0
 }
 
-func (m *Node) GetAnalyzeInfo() *AnalyzeInfo {
+func (m *WindowSpec) GetFrame() *FrameClause {
 	if m != nil {
-		return m.AnalyzeInfo
+		return m.Frame
 	}
 	return nil
 }
 
-type DeleteTableCtx struct {
-	DbName               string   `protobuf:"bytes,1,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	TblName              string   `protobuf:"bytes,2,opt,name=tblName,proto3" json:"tblName,omitempty"`
-	UseDeleteKey         string   `protobuf:"bytes,3,opt,name=useDeleteKey,proto3" json:"useDeleteKey,omitempty"`
-	CanTruncate          bool     `protobuf:"varint,4,opt,name=canTruncate,proto3" json:"canTruncate,omitempty"`
+type UpdateInfo struct {
+	PriKey      string   `protobuf:"bytes,1,opt,name=pri_key,json=priKey,proto3" json:"pri_key,omitempty"`
+	PriKeyIdx   int32    `protobuf:"varint,2,opt,name=pri_key_idx,json=priKeyIdx,proto3" json:"pri_key_idx,omitempty"`
+	HideKey     string   `protobuf:"bytes,3,opt,name=hide_key,json=hideKey,proto3" json:"hide_key,omitempty"`
+	UpdateAttrs []string `protobuf:"bytes,4,rep,name=update_attrs,json=updateAttrs,proto3" json:"update_attrs,omitempty"`
+	OtherAttrs  []string `protobuf:"bytes,5,rep,name=other_attrs,json=otherAttrs,proto3" json:"other_attrs,omitempty"`
+	AttrOrders  []string `protobuf:"bytes,6,rep,name=attr_orders,json=attrOrders,proto3" json:"attr_orders,omitempty"`
+	// the columns of the table are read by WHERE or the right-hand side of SET
+	ReadColumns          bool     `protobuf:"varint,7,opt,name=read_columns,json=readColumns,proto3" json:"read_columns,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteTableCtx) Reset()         { *m = DeleteTableCtx{} }
-func (m *DeleteTableCtx) String() string { return proto.CompactTextString(m) }
-func (*DeleteTableCtx) ProtoMessage()    {}
-func (*DeleteTableCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{30}
+func (m *UpdateInfo) Reset()         { *m = UpdateInfo{} }
+func (m *UpdateInfo) String() string { return proto.CompactTextString(m) }
+func (*UpdateInfo) ProtoMessage()    {}
+func (*UpdateInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{33}
 }
-func (m *DeleteTableCtx) XXX_Unmarshal(b []byte) error {
+func (m *UpdateInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeleteTableCtx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeleteTableCtx.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3525,75 +3842,91 @@ func (m *DeleteTableCtx) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *DeleteTableCtx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteTableCtx.Merge(m, src)
+func (m *UpdateInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateInfo.Merge(m, src)
 }
-func (m *DeleteTableCtx) XXX_Size() int {
+func (m *UpdateInfo) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *DeleteTableCtx) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteTableCtx.DiscardUnknown(m)
+func (m *UpdateInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteTableCtx proto.InternalMessageInfo
+var xxx_messageInfo_UpdateInfo proto.InternalMessageInfo
 
-func (m *DeleteTableCtx) GetDbName() string {
+func (m *UpdateInfo) GetPriKey() string {
 	if m != nil {
-		return m.DbName
+		return m.PriKey
 	}
 	return ""
 }
 
-func (m *DeleteTableCtx) GetTblName() string {
+func (m *UpdateInfo) GetPriKeyIdx() int32 {
 	if m != nil {
-		return m.TblName
+		return m.PriKeyIdx
 	}
-	return ""
+	return 0
 }
 
-func (m *DeleteTableCtx) GetUseDeleteKey() string {
+func (m *UpdateInfo) GetHideKey() string {
 	if m != nil {
-		return m.UseDeleteKey
+		return m.HideKey
 	}
 	return ""
 }
 
-func (m *DeleteTableCtx) GetCanTruncate() bool {
+func (m *UpdateInfo) GetUpdateAttrs() []string {
 	if m != nil {
-		return m.CanTruncate
+		return m.UpdateAttrs
+	}
+	return nil
+}
+
+func (m *UpdateInfo) GetOtherAttrs() []string {
+	if m != nil {
+		return m.OtherAttrs
+	}
+	return nil
+}
+
+func (m *UpdateInfo) GetAttrOrders() []string {
+	if m != nil {
+		return m.AttrOrders
+	}
+	return nil
+}
+
+func (m *UpdateInfo) GetReadColumns() bool {
+	if m != nil {
+		return m.ReadColumns
 	}
 	return false
 }
 
-type Query struct {
-	StmtType Query_StatementType `protobuf:"varint,1,opt,name=stmt_type,json=stmtType,proto3,enum=plan.Query_StatementType" json:"stmt_type,omitempty"`
-	// Each step is simply a root node.  Root node refers to other
-	// node as children and the whole step is a DAG.
-	Steps []int32 `protobuf:"varint,2,rep,packed,name=steps,proto3" json:"steps,omitempty"`
-	// All the nodes.  It is OK to have dangle nodes, we only excute nodes
-	// reachable from step roots.
-	Nodes []*Node `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	// Bound Parameter for the query.
-	Params []*Expr `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty"`
-	// return head
-	Headings             []string `protobuf:"bytes,5,rep,name=headings,proto3" json:"headings,omitempty"`
+type AnalyzeInfo struct {
+	InputRows            int64    `protobuf:"varint,1,opt,name=input_rows,json=inputRows,proto3" json:"input_rows,omitempty"`
+	OutputRows           int64    `protobuf:"varint,2,opt,name=output_rows,json=outputRows,proto3" json:"output_rows,omitempty"`
+	InputSize            int64    `protobuf:"varint,3,opt,name=input_size,json=inputSize,proto3" json:"input_size,omitempty"`
+	OutputSize           int64    `protobuf:"varint,4,opt,name=output_size,json=outputSize,proto3" json:"output_size,omitempty"`
+	TimeConsumed         int64    `protobuf:"varint,5,opt,name=time_consumed,json=timeConsumed,proto3" json:"time_consumed,omitempty"`
+	MemorySize           int64    `protobuf:"varint,6,opt,name=memory_size,json=memorySize,proto3" json:"memory_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Query) Reset()         { *m = Query{} }
-func (m *Query) String() string { return proto.CompactTextString(m) }
-func (*Query) ProtoMessage()    {}
-func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{31}
+func (m *AnalyzeInfo) Reset()         { *m = AnalyzeInfo{} }
+func (m *AnalyzeInfo) String() string { return proto.CompactTextString(m) }
+func (*AnalyzeInfo) ProtoMessage()    {}
+func (*AnalyzeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{34}
 }
-func (m *Query) XXX_Unmarshal(b []byte) error {
+func (m *AnalyzeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Query) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AnalyzeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Query.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AnalyzeInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3603,79 +3936,108 @@ func (m *Query) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Query) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Query.Merge(m, src)
+func (m *AnalyzeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnalyzeInfo.Merge(m, src)
 }
-func (m *Query) XXX_Size() int {
+func (m *AnalyzeInfo) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *Query) XXX_DiscardUnknown() {
-	xxx_messageInfo_Query.DiscardUnknown(m)
+func (m *AnalyzeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnalyzeInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Query proto.InternalMessageInfo
+var xxx_messageInfo_AnalyzeInfo proto.InternalMessageInfo
 
-func (m *Query) GetStmtType() Query_StatementType {
+func (m *AnalyzeInfo) GetInputRows() int64 {
 	if m != nil {
-		return m.StmtType
+		return m.InputRows
 	}
-	return Query_UNKNOWN
+	return 0
 }
 
-func (m *Query) GetSteps() []int32 {
+func (m *AnalyzeInfo) GetOutputRows() int64 {
 	if m != nil {
-		return m.Steps
+		return m.OutputRows
 	}
-	return nil
+	return 0
 }
 
-func (m *Query) GetNodes() []*Node {
+func (m *AnalyzeInfo) GetInputSize() int64 {
 	if m != nil {
-		return m.Nodes
+		return m.InputSize
 	}
-	return nil
+	return 0
 }
 
-func (m *Query) GetParams() []*Expr {
+func (m *AnalyzeInfo) GetOutputSize() int64 {
 	if m != nil {
-		return m.Params
+		return m.OutputSize
 	}
-	return nil
+	return 0
 }
 
-func (m *Query) GetHeadings() []string {
+func (m *AnalyzeInfo) GetTimeConsumed() int64 {
 	if m != nil {
-		return m.Headings
+		return m.TimeConsumed
 	}
-	return nil
+	return 0
 }
 
-type TransationControl struct {
-	// TransationControl type
-	TclType TransationControl_TclType `protobuf:"varint,1,opt,name=tcl_type,json=tclType,proto3,enum=plan.TransationControl_TclType" json:"tcl_type,omitempty"`
-	// Types that are valid to be assigned to Action:
-	//
-	//	*TransationControl_Begin
-	//	*TransationControl_Commit
-	//	*TransationControl_Rollback
-	Action               isTransationControl_Action `protobuf_oneof:"action"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+func (m *AnalyzeInfo) GetMemorySize() int64 {
+	if m != nil {
+		return m.MemorySize
+	}
+	return 0
 }
 
-func (m *TransationControl) Reset()         { *m = TransationControl{} }
-func (m *TransationControl) String() string { return proto.CompactTextString(m) }
-func (*TransationControl) ProtoMessage()    {}
-func (*TransationControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{32}
+type Node struct {
+	NodeType    Node_NodeType `protobuf:"varint,1,opt,name=node_type,json=nodeType,proto3,enum=plan.Node_NodeType" json:"node_type,omitempty"`
+	NodeId      int32         `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Cost        *Cost         `protobuf:"bytes,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	ProjectList []*Expr       `protobuf:"bytes,4,rep,name=project_list,json=projectList,proto3" json:"project_list,omitempty"`
+	Children    []int32       `protobuf:"varint,5,rep,packed,name=children,proto3" json:"children,omitempty"`
+	JoinType    Node_JoinFlag `protobuf:"varint,6,opt,name=join_type,json=joinType,proto3,enum=plan.Node_JoinFlag" json:"join_type,omitempty"`
+	OnList      []*Expr       `protobuf:"bytes,7,rep,name=on_list,json=onList,proto3" json:"on_list,omitempty"`
+	FilterList  []*Expr       `protobuf:"bytes,8,rep,name=filter_list,json=filterList,proto3" json:"filter_list,omitempty"`
+	GroupBy     []*Expr       `protobuf:"bytes,9,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	// the positions of group_by rolled up to null by each grouping set of an
+	// AGG, as a list of constants, group_by[0] is then the index of the
+	// grouping set of each row
+	GroupingSet     []*Expr           `protobuf:"bytes,10,rep,name=grouping_set,json=groupingSet,proto3" json:"grouping_set,omitempty"`
+	AggList         []*Expr           `protobuf:"bytes,11,rep,name=agg_list,json=aggList,proto3" json:"agg_list,omitempty"`
+	OrderBy         []*OrderBySpec    `protobuf:"bytes,12,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	UpdateInfo      *UpdateInfo       `protobuf:"bytes,13,opt,name=update_info,json=updateInfo,proto3" json:"update_info,omitempty"`
+	WinSpec         *WindowSpec       `protobuf:"bytes,14,opt,name=win_spec,json=winSpec,proto3" json:"win_spec,omitempty"`
+	Limit           *Expr             `protobuf:"bytes,15,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset          *Expr             `protobuf:"bytes,16,opt,name=offset,proto3" json:"offset,omitempty"`
+	TableDef        *TableDef         `protobuf:"bytes,17,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
+	ObjRef          *ObjectRef        `protobuf:"bytes,18,opt,name=obj_ref,json=objRef,proto3" json:"obj_ref,omitempty"`
+	RowsetData      *RowsetData       `protobuf:"bytes,19,opt,name=rowset_data,json=rowsetData,proto3" json:"rowset_data,omitempty"`
+	ExtraOptions    string            `protobuf:"bytes,20,opt,name=extra_options,json=extraOptions,proto3" json:"extra_options,omitempty"`
+	DeleteTablesCtx []*DeleteTableCtx `protobuf:"bytes,21,rep,name=deleteTablesCtx,proto3" json:"deleteTablesCtx,omitempty"`
+	BindingTags     []int32           `protobuf:"varint,22,rep,packed,name=binding_tags,json=bindingTags,proto3" json:"binding_tags,omitempty"`
+	AnalyzeInfo     *AnalyzeInfo      `protobuf:"bytes,23,opt,name=analyze_info,json=analyzeInfo,proto3" json:"analyze_info,omitempty"`
+	// the partitions scanned by the TABLE_SCAN of a partitioned table
+	Partitions []string `protobuf:"bytes,24,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	// the full-text searches of the TABLE_SCAN
+	FullText             []*FullTextScan `protobuf:"bytes,25,rep,name=full_text,json=fullText,proto3" json:"full_text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
-func (m *TransationControl) XXX_Unmarshal(b []byte) error {
+
+func (m *Node) Reset()         { *m = Node{} }
+func (m *Node) String() string { return proto.CompactTextString(m) }
+func (*Node) ProtoMessage()    {}
+func (*Node) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{35}
+}
+func (m *Node) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TransationControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Node) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TransationControl.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Node.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3685,195 +4047,219 @@ func (m *TransationControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *TransationControl) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransationControl.Merge(m, src)
+func (m *Node) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Node.Merge(m, src)
 }
-func (m *TransationControl) XXX_Size() int {
+func (m *Node) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *TransationControl) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransationControl.DiscardUnknown(m)
+func (m *Node) XXX_DiscardUnknown() {
+	xxx_messageInfo_Node.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TransationControl proto.InternalMessageInfo
+var xxx_messageInfo_Node proto.InternalMessageInfo
 
-type isTransationControl_Action interface {
-	isTransationControl_Action()
-	MarshalTo([]byte) (int, error)
-	ProtoSize() int
+func (m *Node) GetNodeType() Node_NodeType {
+	if m != nil {
+		return m.NodeType
+	}
+	return Node_UNKNOWN
 }
 
-type TransationControl_Begin struct {
-	Begin *TransationBegin `protobuf:"bytes,2,opt,name=begin,proto3,oneof" json:"begin,omitempty"`
-}
-type TransationControl_Commit struct {
-	Commit *TransationCommit `protobuf:"bytes,3,opt,name=commit,proto3,oneof" json:"commit,omitempty"`
-}
-type TransationControl_Rollback struct {
-	Rollback *TransationRollback `protobuf:"bytes,4,opt,name=rollback,proto3,oneof" json:"rollback,omitempty"`
+func (m *Node) GetNodeId() int32 {
+	if m != nil {
+		return m.NodeId
+	}
+	return 0
 }
 
-func (*TransationControl_Begin) isTransationControl_Action()    {}
-func (*TransationControl_Commit) isTransationControl_Action()   {}
-func (*TransationControl_Rollback) isTransationControl_Action() {}
-
-func (m *TransationControl) GetAction() isTransationControl_Action {
+func (m *Node) GetCost() *Cost {
 	if m != nil {
-		return m.Action
+		return m.Cost
 	}
 	return nil
 }
 
-func (m *TransationControl) GetTclType() TransationControl_TclType {
+func (m *Node) GetProjectList() []*Expr {
 	if m != nil {
-		return m.TclType
+		return m.ProjectList
 	}
-	return TransationControl_BEGIN
+	return nil
 }
 
-func (m *TransationControl) GetBegin() *TransationBegin {
-	if x, ok := m.GetAction().(*TransationControl_Begin); ok {
-		return x.Begin
+func (m *Node) GetChildren() []int32 {
+	if m != nil {
+		return m.Children
 	}
 	return nil
 }
 
-func (m *TransationControl) GetCommit() *TransationCommit {
-	if x, ok := m.GetAction().(*TransationControl_Commit); ok {
-		return x.Commit
+func (m *Node) GetJoinType() Node_JoinFlag {
+	if m != nil {
+		return m.JoinType
 	}
-	return nil
+	return Node_INNER
 }
 
-func (m *TransationControl) GetRollback() *TransationRollback {
-	if x, ok := m.GetAction().(*TransationControl_Rollback); ok {
-		return x.Rollback
+func (m *Node) GetOnList() []*Expr {
+	if m != nil {
+		return m.OnList
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*TransationControl) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*TransationControl_Begin)(nil),
-		(*TransationControl_Commit)(nil),
-		(*TransationControl_Rollback)(nil),
+func (m *Node) GetFilterList() []*Expr {
+	if m != nil {
+		return m.FilterList
 	}
+	return nil
 }
 
-type TransationBegin struct {
-	Mode                 TransationBegin_TransationMode `protobuf:"varint,1,opt,name=mode,proto3,enum=plan.TransationBegin_TransationMode" json:"mode,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
+func (m *Node) GetGroupBy() []*Expr {
+	if m != nil {
+		return m.GroupBy
+	}
+	return nil
 }
 
-func (m *TransationBegin) Reset()         { *m = TransationBegin{} }
-func (m *TransationBegin) String() string { return proto.CompactTextString(m) }
-func (*TransationBegin) ProtoMessage()    {}
-func (*TransationBegin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{33}
-}
-func (m *TransationBegin) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *Node) GetGroupingSet() []*Expr {
+	if m != nil {
+		return m.GroupingSet
+	}
+	return nil
 }
-func (m *TransationBegin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_TransationBegin.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Node) GetAggList() []*Expr {
+	if m != nil {
+		return m.AggList
 	}
+	return nil
 }
-func (m *TransationBegin) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransationBegin.Merge(m, src)
+
+func (m *Node) GetOrderBy() []*OrderBySpec {
+	if m != nil {
+		return m.OrderBy
+	}
+	return nil
 }
-func (m *TransationBegin) XXX_Size() int {
-	return m.ProtoSize()
+
+func (m *Node) GetUpdateInfo() *UpdateInfo {
+	if m != nil {
+		return m.UpdateInfo
+	}
+	return nil
 }
-func (m *TransationBegin) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransationBegin.DiscardUnknown(m)
+
+func (m *Node) GetWinSpec() *WindowSpec {
+	if m != nil {
+		return m.WinSpec
+	}
+	return nil
 }
 
-var xxx_messageInfo_TransationBegin proto.InternalMessageInfo
+func (m *Node) GetLimit() *Expr {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
 
-func (m *TransationBegin) GetMode() TransationBegin_TransationMode {
+func (m *Node) GetOffset() *Expr {
 	if m != nil {
-		return m.Mode
+		return m.Offset
 	}
-	return TransationBegin_NONE
+	return nil
 }
 
-type TransationCommit struct {
-	CompletionType       TransationCompletionType `protobuf:"varint,1,opt,name=completion_type,json=completionType,proto3,enum=plan.TransationCompletionType" json:"completion_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *Node) GetTableDef() *TableDef {
+	if m != nil {
+		return m.TableDef
+	}
+	return nil
 }
 
-func (m *TransationCommit) Reset()         { *m = TransationCommit{} }
-func (m *TransationCommit) String() string { return proto.CompactTextString(m) }
-func (*TransationCommit) ProtoMessage()    {}
-func (*TransationCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{34}
+func (m *Node) GetObjRef() *ObjectRef {
+	if m != nil {
+		return m.ObjRef
+	}
+	return nil
 }
-func (m *TransationCommit) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *Node) GetRowsetData() *RowsetData {
+	if m != nil {
+		return m.RowsetData
+	}
+	return nil
 }
-func (m *TransationCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_TransationCommit.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Node) GetExtraOptions() string {
+	if m != nil {
+		return m.ExtraOptions
 	}
+	return ""
 }
-func (m *TransationCommit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransationCommit.Merge(m, src)
+
+func (m *Node) GetDeleteTablesCtx() []*DeleteTableCtx {
+	if m != nil {
+		return m.DeleteTablesCtx
+	}
+	return nil
 }
-func (m *TransationCommit) XXX_Size() int {
-	return m.ProtoSize()
+
+func (m *Node) GetBindingTags() []int32 {
+	if m != nil {
+		return m.BindingTags
+	}
+	return nil
 }
-func (m *TransationCommit) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransationCommit.DiscardUnknown(m)
+
+func (m *Node) GetAnalyzeInfo() *AnalyzeInfo {
+	if m != nil {
+		return m.AnalyzeInfo
+	}
+	return nil
 }
 
-var xxx_messageInfo_TransationCommit proto.InternalMessageInfo
+func (m *Node) GetPartitions() []string {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
 
-func (m *TransationCommit) GetCompletionType() TransationCompletionType {
+func (m *Node) GetFullText() []*FullTextScan {
 	if m != nil {
-		return m.CompletionType
+		return m.FullText
 	}
-	return TransationCompletionType_CHAIN
+	return nil
 }
 
-type TransationRollback struct {
-	CompletionType       TransationCompletionType `protobuf:"varint,1,opt,name=completion_type,json=completionType,proto3,enum=plan.TransationCompletionType" json:"completion_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+// FullTextScan is a MATCH ... AGAINST search on a full-text index, the
+// relevance of each row is read as the column named attr
+type FullTextScan struct {
+	Attr     string                  `protobuf:"bytes,1,opt,name=attr,proto3" json:"attr,omitempty"`
+	ColNames []string                `protobuf:"bytes,2,rep,name=col_names,json=colNames,proto3" json:"col_names,omitempty"`
+	Query    string                  `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	Mode     FullTextScan_SearchMode `protobuf:"varint,4,opt,name=mode,proto3,enum=plan.FullTextScan_SearchMode" json:"mode,omitempty"`
+	// filter is set if only the rows matching the search are needed
+	Filter               bool     `protobuf:"varint,5,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TransationRollback) Reset()         { *m = TransationRollback{} }
-func (m *TransationRollback) String() string { return proto.CompactTextString(m) }
-func (*TransationRollback) ProtoMessage()    {}
-func (*TransationRollback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{35}
+func (m *FullTextScan) Reset()         { *m = FullTextScan{} }
+func (m *FullTextScan) String() string { return proto.CompactTextString(m) }
+func (*FullTextScan) ProtoMessage()    {}
+func (*FullTextScan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{36}
 }
-func (m *TransationRollback) XXX_Unmarshal(b []byte) error {
+func (m *FullTextScan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TransationRollback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FullTextScan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TransationRollback.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FullTextScan.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3883,50 +4269,77 @@ func (m *TransationRollback) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *TransationRollback) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransationRollback.Merge(m, src)
+func (m *FullTextScan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FullTextScan.Merge(m, src)
 }
-func (m *TransationRollback) XXX_Size() int {
+func (m *FullTextScan) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *TransationRollback) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransationRollback.DiscardUnknown(m)
+func (m *FullTextScan) XXX_DiscardUnknown() {
+	xxx_messageInfo_FullTextScan.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TransationRollback proto.InternalMessageInfo
+var xxx_messageInfo_FullTextScan proto.InternalMessageInfo
 
-func (m *TransationRollback) GetCompletionType() TransationCompletionType {
+func (m *FullTextScan) GetAttr() string {
 	if m != nil {
-		return m.CompletionType
+		return m.Attr
 	}
-	return TransationCompletionType_CHAIN
+	return ""
 }
 
-type Plan struct {
-	// Types that are valid to be assigned to Plan:
-	//
-	//	*Plan_Query
-	//	*Plan_Tcl
-	//	*Plan_Ddl
-	//	*Plan_Dcl
-	Plan                 isPlan_Plan `protobuf_oneof:"plan"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *FullTextScan) GetColNames() []string {
+	if m != nil {
+		return m.ColNames
+	}
+	return nil
 }
 
-func (m *Plan) Reset()         { *m = Plan{} }
-func (m *Plan) String() string { return proto.CompactTextString(m) }
-func (*Plan) ProtoMessage()    {}
-func (*Plan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{36}
+func (m *FullTextScan) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
 }
-func (m *Plan) XXX_Unmarshal(b []byte) error {
+
+func (m *FullTextScan) GetMode() FullTextScan_SearchMode {
+	if m != nil {
+		return m.Mode
+	}
+	return FullTextScan_NATURAL_LANGUAGE
+}
+
+func (m *FullTextScan) GetFilter() bool {
+	if m != nil {
+		return m.Filter
+	}
+	return false
+}
+
+type DeleteTableCtx struct {
+	DbName       string `protobuf:"bytes,1,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	TblName      string `protobuf:"bytes,2,opt,name=tblName,proto3" json:"tblName,omitempty"`
+	UseDeleteKey string `protobuf:"bytes,3,opt,name=useDeleteKey,proto3" json:"useDeleteKey,omitempty"`
+	CanTruncate  bool   `protobuf:"varint,4,opt,name=canTruncate,proto3" json:"canTruncate,omitempty"`
+	// the columns of the table are read by WHERE or the join conditions
+	ReadColumns          bool     `protobuf:"varint,5,opt,name=readColumns,proto3" json:"readColumns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTableCtx) Reset()         { *m = DeleteTableCtx{} }
+func (m *DeleteTableCtx) String() string { return proto.CompactTextString(m) }
+func (*DeleteTableCtx) ProtoMessage()    {}
+func (*DeleteTableCtx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{37}
+}
+func (m *DeleteTableCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Plan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeleteTableCtx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Plan.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeleteTableCtx.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3936,114 +4349,164 @@ func (m *Plan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Plan) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Plan.Merge(m, src)
+func (m *DeleteTableCtx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTableCtx.Merge(m, src)
 }
-func (m *Plan) XXX_Size() int {
+func (m *DeleteTableCtx) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *Plan) XXX_DiscardUnknown() {
-	xxx_messageInfo_Plan.DiscardUnknown(m)
+func (m *DeleteTableCtx) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTableCtx.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Plan proto.InternalMessageInfo
+var xxx_messageInfo_DeleteTableCtx proto.InternalMessageInfo
 
-type isPlan_Plan interface {
-	isPlan_Plan()
-	MarshalTo([]byte) (int, error)
-	ProtoSize() int
+func (m *DeleteTableCtx) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
 }
 
-type Plan_Query struct {
-	Query *Query `protobuf:"bytes,1,opt,name=query,proto3,oneof" json:"query,omitempty"`
-}
-type Plan_Tcl struct {
-	Tcl *TransationControl `protobuf:"bytes,2,opt,name=tcl,proto3,oneof" json:"tcl,omitempty"`
-}
-type Plan_Ddl struct {
-	Ddl *DataDefinition `protobuf:"bytes,3,opt,name=ddl,proto3,oneof" json:"ddl,omitempty"`
+func (m *DeleteTableCtx) GetTblName() string {
+	if m != nil {
+		return m.TblName
+	}
+	return ""
 }
-type Plan_Dcl struct {
-	Dcl *DataControl `protobuf:"bytes,4,opt,name=dcl,proto3,oneof" json:"dcl,omitempty"`
+
+func (m *DeleteTableCtx) GetUseDeleteKey() string {
+	if m != nil {
+		return m.UseDeleteKey
+	}
+	return ""
 }
 
-func (*Plan_Query) isPlan_Plan() {}
-func (*Plan_Tcl) isPlan_Plan()   {}
-func (*Plan_Ddl) isPlan_Plan()   {}
-func (*Plan_Dcl) isPlan_Plan()   {}
+func (m *DeleteTableCtx) GetCanTruncate() bool {
+	if m != nil {
+		return m.CanTruncate
+	}
+	return false
+}
 
-func (m *Plan) GetPlan() isPlan_Plan {
+func (m *DeleteTableCtx) GetReadColumns() bool {
 	if m != nil {
-		return m.Plan
+		return m.ReadColumns
 	}
-	return nil
+	return false
 }
 
-func (m *Plan) GetQuery() *Query {
-	if x, ok := m.GetPlan().(*Plan_Query); ok {
-		return x.Query
+type Query struct {
+	StmtType Query_StatementType `protobuf:"varint,1,opt,name=stmt_type,json=stmtType,proto3,enum=plan.Query_StatementType" json:"stmt_type,omitempty"`
+	// Each step is simply a root node.  Root node refers to other
+	// node as children and the whole step is a DAG.
+	Steps []int32 `protobuf:"varint,2,rep,packed,name=steps,proto3" json:"steps,omitempty"`
+	// All the nodes.  It is OK to have dangle nodes, we only excute nodes
+	// reachable from step roots.
+	Nodes []*Node `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// Bound Parameter for the query.
+	Params []*Expr `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty"`
+	// return head
+	Headings             []string `protobuf:"bytes,5,rep,name=headings,proto3" json:"headings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Query) Reset()         { *m = Query{} }
+func (m *Query) String() string { return proto.CompactTextString(m) }
+func (*Query) ProtoMessage()    {}
+func (*Query) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{38}
+}
+func (m *Query) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Query) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Query.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *Query) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Query.Merge(m, src)
+}
+func (m *Query) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Query) XXX_DiscardUnknown() {
+	xxx_messageInfo_Query.DiscardUnknown(m)
 }
 
-func (m *Plan) GetTcl() *TransationControl {
-	if x, ok := m.GetPlan().(*Plan_Tcl); ok {
-		return x.Tcl
+var xxx_messageInfo_Query proto.InternalMessageInfo
+
+func (m *Query) GetStmtType() Query_StatementType {
+	if m != nil {
+		return m.StmtType
+	}
+	return Query_UNKNOWN
+}
+
+func (m *Query) GetSteps() []int32 {
+	if m != nil {
+		return m.Steps
 	}
 	return nil
 }
 
-func (m *Plan) GetDdl() *DataDefinition {
-	if x, ok := m.GetPlan().(*Plan_Ddl); ok {
-		return x.Ddl
+func (m *Query) GetNodes() []*Node {
+	if m != nil {
+		return m.Nodes
 	}
 	return nil
 }
 
-func (m *Plan) GetDcl() *DataControl {
-	if x, ok := m.GetPlan().(*Plan_Dcl); ok {
-		return x.Dcl
+func (m *Query) GetParams() []*Expr {
+	if m != nil {
+		return m.Params
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*Plan) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*Plan_Query)(nil),
-		(*Plan_Tcl)(nil),
-		(*Plan_Ddl)(nil),
-		(*Plan_Dcl)(nil),
+func (m *Query) GetHeadings() []string {
+	if m != nil {
+		return m.Headings
 	}
+	return nil
 }
 
-type DataControl struct {
-	// DataDefinition type
-	DclType DataControl_DclType `protobuf:"varint,1,opt,name=dcl_type,json=dclType,proto3,enum=plan.DataControl_DclType" json:"dcl_type,omitempty"`
-	// Types that are valid to be assigned to Control:
+type TransationControl struct {
+	// TransationControl type
+	TclType TransationControl_TclType `protobuf:"varint,1,opt,name=tcl_type,json=tclType,proto3,enum=plan.TransationControl_TclType" json:"tcl_type,omitempty"`
+	// Types that are valid to be assigned to Action:
 	//
-	//	*DataControl_SetVariables
-	//	*DataControl_Prepare
-	//	*DataControl_Execute
-	//	*DataControl_Deallocate
-	Control              isDataControl_Control `protobuf_oneof:"control"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	//	*TransationControl_Begin
+	//	*TransationControl_Commit
+	//	*TransationControl_Rollback
+	Action               isTransationControl_Action `protobuf_oneof:"action"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
-func (m *DataControl) Reset()         { *m = DataControl{} }
-func (m *DataControl) String() string { return proto.CompactTextString(m) }
-func (*DataControl) ProtoMessage()    {}
-func (*DataControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{37}
+func (m *TransationControl) Reset()         { *m = TransationControl{} }
+func (m *TransationControl) String() string { return proto.CompactTextString(m) }
+func (*TransationControl) ProtoMessage()    {}
+func (*TransationControl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{39}
 }
-func (m *DataControl) XXX_Unmarshal(b []byte) error {
+func (m *TransationControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TransationControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataControl.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TransationControl.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4053,132 +4516,101 @@ func (m *DataControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *DataControl) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataControl.Merge(m, src)
+func (m *TransationControl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransationControl.Merge(m, src)
 }
-func (m *DataControl) XXX_Size() int {
+func (m *TransationControl) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *DataControl) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataControl.DiscardUnknown(m)
+func (m *TransationControl) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransationControl.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataControl proto.InternalMessageInfo
+var xxx_messageInfo_TransationControl proto.InternalMessageInfo
 
-type isDataControl_Control interface {
-	isDataControl_Control()
+type isTransationControl_Action interface {
+	isTransationControl_Action()
 	MarshalTo([]byte) (int, error)
 	ProtoSize() int
 }
 
-type DataControl_SetVariables struct {
-	SetVariables *SetVariables `protobuf:"bytes,2,opt,name=set_variables,json=setVariables,proto3,oneof" json:"set_variables,omitempty"`
-}
-type DataControl_Prepare struct {
-	Prepare *Prepare `protobuf:"bytes,3,opt,name=prepare,proto3,oneof" json:"prepare,omitempty"`
+type TransationControl_Begin struct {
+	Begin *TransationBegin `protobuf:"bytes,2,opt,name=begin,proto3,oneof" json:"begin,omitempty"`
 }
-type DataControl_Execute struct {
-	Execute *Execute `protobuf:"bytes,4,opt,name=execute,proto3,oneof" json:"execute,omitempty"`
+type TransationControl_Commit struct {
+	Commit *TransationCommit `protobuf:"bytes,3,opt,name=commit,proto3,oneof" json:"commit,omitempty"`
 }
-type DataControl_Deallocate struct {
-	Deallocate *Deallocate `protobuf:"bytes,5,opt,name=deallocate,proto3,oneof" json:"deallocate,omitempty"`
+type TransationControl_Rollback struct {
+	Rollback *TransationRollback `protobuf:"bytes,4,opt,name=rollback,proto3,oneof" json:"rollback,omitempty"`
 }
 
-func (*DataControl_SetVariables) isDataControl_Control() {}
-func (*DataControl_Prepare) isDataControl_Control()      {}
-func (*DataControl_Execute) isDataControl_Control()      {}
-func (*DataControl_Deallocate) isDataControl_Control()   {}
+func (*TransationControl_Begin) isTransationControl_Action()    {}
+func (*TransationControl_Commit) isTransationControl_Action()   {}
+func (*TransationControl_Rollback) isTransationControl_Action() {}
 
-func (m *DataControl) GetControl() isDataControl_Control {
+func (m *TransationControl) GetAction() isTransationControl_Action {
 	if m != nil {
-		return m.Control
+		return m.Action
 	}
 	return nil
 }
 
-func (m *DataControl) GetDclType() DataControl_DclType {
+func (m *TransationControl) GetTclType() TransationControl_TclType {
 	if m != nil {
-		return m.DclType
-	}
-	return DataControl_SET_VARIABLES
-}
-
-func (m *DataControl) GetSetVariables() *SetVariables {
-	if x, ok := m.GetControl().(*DataControl_SetVariables); ok {
-		return x.SetVariables
+		return m.TclType
 	}
-	return nil
+	return TransationControl_BEGIN
 }
 
-func (m *DataControl) GetPrepare() *Prepare {
-	if x, ok := m.GetControl().(*DataControl_Prepare); ok {
-		return x.Prepare
+func (m *TransationControl) GetBegin() *TransationBegin {
+	if x, ok := m.GetAction().(*TransationControl_Begin); ok {
+		return x.Begin
 	}
 	return nil
 }
 
-func (m *DataControl) GetExecute() *Execute {
-	if x, ok := m.GetControl().(*DataControl_Execute); ok {
-		return x.Execute
+func (m *TransationControl) GetCommit() *TransationCommit {
+	if x, ok := m.GetAction().(*TransationControl_Commit); ok {
+		return x.Commit
 	}
 	return nil
 }
 
-func (m *DataControl) GetDeallocate() *Deallocate {
-	if x, ok := m.GetControl().(*DataControl_Deallocate); ok {
-		return x.Deallocate
+func (m *TransationControl) GetRollback() *TransationRollback {
+	if x, ok := m.GetAction().(*TransationControl_Rollback); ok {
+		return x.Rollback
 	}
 	return nil
 }
 
 // XXX_OneofWrappers is for the internal use of the proto package.
-func (*DataControl) XXX_OneofWrappers() []interface{} {
+func (*TransationControl) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
-		(*DataControl_SetVariables)(nil),
-		(*DataControl_Prepare)(nil),
-		(*DataControl_Execute)(nil),
-		(*DataControl_Deallocate)(nil),
+		(*TransationControl_Begin)(nil),
+		(*TransationControl_Commit)(nil),
+		(*TransationControl_Rollback)(nil),
 	}
 }
 
-type DataDefinition struct {
-	// DataDefinition type
-	DdlType DataDefinition_DdlType `protobuf:"varint,1,opt,name=ddl_type,json=ddlType,proto3,enum=plan.DataDefinition_DdlType" json:"ddl_type,omitempty"`
-	// other show statement we will rewrite to a select statement
-	// then we will get a Query
-	// eg: 'show databases' will rewrite to 'select md.datname as `Database` from mo_database md'
-	Query *Query `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
-	// Types that are valid to be assigned to Definition:
-	//
-	//	*DataDefinition_CreateDatabase
-	//	*DataDefinition_AlterDatabase
-	//	*DataDefinition_DropDatabase
-	//	*DataDefinition_CreateTable
-	//	*DataDefinition_AlterTable
-	//	*DataDefinition_DropTable
-	//	*DataDefinition_CreateIndex
-	//	*DataDefinition_AlterIndex
-	//	*DataDefinition_DropIndex
-	//	*DataDefinition_TruncateTable
-	//	*DataDefinition_ShowVariables
-	Definition           isDataDefinition_Definition `protobuf_oneof:"definition"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+type TransationBegin struct {
+	Mode                 TransationBegin_TransationMode `protobuf:"varint,1,opt,name=mode,proto3,enum=plan.TransationBegin_TransationMode" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
-func (m *DataDefinition) Reset()         { *m = DataDefinition{} }
-func (m *DataDefinition) String() string { return proto.CompactTextString(m) }
-func (*DataDefinition) ProtoMessage()    {}
-func (*DataDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{38}
+func (m *TransationBegin) Reset()         { *m = TransationBegin{} }
+func (m *TransationBegin) String() string { return proto.CompactTextString(m) }
+func (*TransationBegin) ProtoMessage()    {}
+func (*TransationBegin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{40}
 }
-func (m *DataDefinition) XXX_Unmarshal(b []byte) error {
+func (m *TransationBegin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataDefinition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TransationBegin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataDefinition.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TransationBegin.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4188,205 +4620,396 @@ func (m *DataDefinition) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *DataDefinition) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataDefinition.Merge(m, src)
+func (m *TransationBegin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransationBegin.Merge(m, src)
 }
-func (m *DataDefinition) XXX_Size() int {
+func (m *TransationBegin) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *DataDefinition) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataDefinition.DiscardUnknown(m)
+func (m *TransationBegin) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransationBegin.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataDefinition proto.InternalMessageInfo
+var xxx_messageInfo_TransationBegin proto.InternalMessageInfo
 
-type isDataDefinition_Definition interface {
-	isDataDefinition_Definition()
-	MarshalTo([]byte) (int, error)
-	ProtoSize() int
+func (m *TransationBegin) GetMode() TransationBegin_TransationMode {
+	if m != nil {
+		return m.Mode
+	}
+	return TransationBegin_NONE
 }
 
-type DataDefinition_CreateDatabase struct {
-	CreateDatabase *CreateDatabase `protobuf:"bytes,3,opt,name=create_database,json=createDatabase,proto3,oneof" json:"create_database,omitempty"`
+type TransationCommit struct {
+	CompletionType       TransationCompletionType `protobuf:"varint,1,opt,name=completion_type,json=completionType,proto3,enum=plan.TransationCompletionType" json:"completion_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
-type DataDefinition_AlterDatabase struct {
-	AlterDatabase *AlterDatabase `protobuf:"bytes,4,opt,name=alter_database,json=alterDatabase,proto3,oneof" json:"alter_database,omitempty"`
+
+func (m *TransationCommit) Reset()         { *m = TransationCommit{} }
+func (m *TransationCommit) String() string { return proto.CompactTextString(m) }
+func (*TransationCommit) ProtoMessage()    {}
+func (*TransationCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{41}
 }
-type DataDefinition_DropDatabase struct {
-	DropDatabase *DropDatabase `protobuf:"bytes,5,opt,name=drop_database,json=dropDatabase,proto3,oneof" json:"drop_database,omitempty"`
+func (m *TransationCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-type DataDefinition_CreateTable struct {
-	CreateTable *CreateTable `protobuf:"bytes,6,opt,name=create_table,json=createTable,proto3,oneof" json:"create_table,omitempty"`
+func (m *TransationCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransationCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-type DataDefinition_AlterTable struct {
-	AlterTable *AlterTable `protobuf:"bytes,7,opt,name=alter_table,json=alterTable,proto3,oneof" json:"alter_table,omitempty"`
+func (m *TransationCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransationCommit.Merge(m, src)
 }
-type DataDefinition_DropTable struct {
-	DropTable *DropTable `protobuf:"bytes,8,opt,name=drop_table,json=dropTable,proto3,oneof" json:"drop_table,omitempty"`
+func (m *TransationCommit) XXX_Size() int {
+	return m.ProtoSize()
 }
-type DataDefinition_CreateIndex struct {
-	CreateIndex *CreateIndex `protobuf:"bytes,9,opt,name=create_index,json=createIndex,proto3,oneof" json:"create_index,omitempty"`
+func (m *TransationCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransationCommit.DiscardUnknown(m)
 }
-type DataDefinition_AlterIndex struct {
-	AlterIndex *AlterIndex `protobuf:"bytes,10,opt,name=alter_index,json=alterIndex,proto3,oneof" json:"alter_index,omitempty"`
+
+var xxx_messageInfo_TransationCommit proto.InternalMessageInfo
+
+func (m *TransationCommit) GetCompletionType() TransationCompletionType {
+	if m != nil {
+		return m.CompletionType
+	}
+	return TransationCompletionType_CHAIN
 }
-type DataDefinition_DropIndex struct {
-	DropIndex *DropIndex `protobuf:"bytes,11,opt,name=drop_index,json=dropIndex,proto3,oneof" json:"drop_index,omitempty"`
+
+type TransationRollback struct {
+	CompletionType       TransationCompletionType `protobuf:"varint,1,opt,name=completion_type,json=completionType,proto3,enum=plan.TransationCompletionType" json:"completion_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
-type DataDefinition_TruncateTable struct {
-	TruncateTable *TruncateTable `protobuf:"bytes,12,opt,name=truncate_table,json=truncateTable,proto3,oneof" json:"truncate_table,omitempty"`
+
+func (m *TransationRollback) Reset()         { *m = TransationRollback{} }
+func (m *TransationRollback) String() string { return proto.CompactTextString(m) }
+func (*TransationRollback) ProtoMessage()    {}
+func (*TransationRollback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{42}
 }
-type DataDefinition_ShowVariables struct {
-	ShowVariables *ShowVariables `protobuf:"bytes,13,opt,name=show_variables,json=showVariables,proto3,oneof" json:"show_variables,omitempty"`
+func (m *TransationRollback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransationRollback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransationRollback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransationRollback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransationRollback.Merge(m, src)
+}
+func (m *TransationRollback) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TransationRollback) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransationRollback.DiscardUnknown(m)
 }
 
-func (*DataDefinition_CreateDatabase) isDataDefinition_Definition() {}
-func (*DataDefinition_AlterDatabase) isDataDefinition_Definition()  {}
-func (*DataDefinition_DropDatabase) isDataDefinition_Definition()   {}
-func (*DataDefinition_CreateTable) isDataDefinition_Definition()    {}
-func (*DataDefinition_AlterTable) isDataDefinition_Definition()     {}
-func (*DataDefinition_DropTable) isDataDefinition_Definition()      {}
-func (*DataDefinition_CreateIndex) isDataDefinition_Definition()    {}
-func (*DataDefinition_AlterIndex) isDataDefinition_Definition()     {}
-func (*DataDefinition_DropIndex) isDataDefinition_Definition()      {}
-func (*DataDefinition_TruncateTable) isDataDefinition_Definition()  {}
-func (*DataDefinition_ShowVariables) isDataDefinition_Definition()  {}
+var xxx_messageInfo_TransationRollback proto.InternalMessageInfo
 
-func (m *DataDefinition) GetDefinition() isDataDefinition_Definition {
+func (m *TransationRollback) GetCompletionType() TransationCompletionType {
 	if m != nil {
-		return m.Definition
+		return m.CompletionType
 	}
-	return nil
+	return TransationCompletionType_CHAIN
 }
 
-func (m *DataDefinition) GetDdlType() DataDefinition_DdlType {
-	if m != nil {
-		return m.DdlType
+type Plan struct {
+	// Types that are valid to be assigned to Plan:
+	//
+	//	*Plan_Query
+	//	*Plan_Tcl
+	//	*Plan_Ddl
+	//	*Plan_Dcl
+	Plan                 isPlan_Plan `protobuf_oneof:"plan"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Plan) Reset()         { *m = Plan{} }
+func (m *Plan) String() string { return proto.CompactTextString(m) }
+func (*Plan) ProtoMessage()    {}
+func (*Plan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{43}
+}
+func (m *Plan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Plan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Plan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return DataDefinition_CREATE_DATABASE
+}
+func (m *Plan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Plan.Merge(m, src)
+}
+func (m *Plan) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Plan) XXX_DiscardUnknown() {
+	xxx_messageInfo_Plan.DiscardUnknown(m)
 }
 
-func (m *DataDefinition) GetQuery() *Query {
+var xxx_messageInfo_Plan proto.InternalMessageInfo
+
+type isPlan_Plan interface {
+	isPlan_Plan()
+	MarshalTo([]byte) (int, error)
+	ProtoSize() int
+}
+
+type Plan_Query struct {
+	Query *Query `protobuf:"bytes,1,opt,name=query,proto3,oneof" json:"query,omitempty"`
+}
+type Plan_Tcl struct {
+	Tcl *TransationControl `protobuf:"bytes,2,opt,name=tcl,proto3,oneof" json:"tcl,omitempty"`
+}
+type Plan_Ddl struct {
+	Ddl *DataDefinition `protobuf:"bytes,3,opt,name=ddl,proto3,oneof" json:"ddl,omitempty"`
+}
+type Plan_Dcl struct {
+	Dcl *DataControl `protobuf:"bytes,4,opt,name=dcl,proto3,oneof" json:"dcl,omitempty"`
+}
+
+func (*Plan_Query) isPlan_Plan() {}
+func (*Plan_Tcl) isPlan_Plan()   {}
+func (*Plan_Ddl) isPlan_Plan()   {}
+func (*Plan_Dcl) isPlan_Plan()   {}
+
+func (m *Plan) GetPlan() isPlan_Plan {
 	if m != nil {
-		return m.Query
+		return m.Plan
 	}
 	return nil
 }
 
-func (m *DataDefinition) GetCreateDatabase() *CreateDatabase {
-	if x, ok := m.GetDefinition().(*DataDefinition_CreateDatabase); ok {
-		return x.CreateDatabase
+func (m *Plan) GetQuery() *Query {
+	if x, ok := m.GetPlan().(*Plan_Query); ok {
+		return x.Query
 	}
 	return nil
 }
 
-func (m *DataDefinition) GetAlterDatabase() *AlterDatabase {
-	if x, ok := m.GetDefinition().(*DataDefinition_AlterDatabase); ok {
-		return x.AlterDatabase
+func (m *Plan) GetTcl() *TransationControl {
+	if x, ok := m.GetPlan().(*Plan_Tcl); ok {
+		return x.Tcl
 	}
 	return nil
 }
 
-func (m *DataDefinition) GetDropDatabase() *DropDatabase {
-	if x, ok := m.GetDefinition().(*DataDefinition_DropDatabase); ok {
-		return x.DropDatabase
+func (m *Plan) GetDdl() *DataDefinition {
+	if x, ok := m.GetPlan().(*Plan_Ddl); ok {
+		return x.Ddl
 	}
 	return nil
 }
 
-func (m *DataDefinition) GetCreateTable() *CreateTable {
-	if x, ok := m.GetDefinition().(*DataDefinition_CreateTable); ok {
-		return x.CreateTable
+func (m *Plan) GetDcl() *DataControl {
+	if x, ok := m.GetPlan().(*Plan_Dcl); ok {
+		return x.Dcl
 	}
 	return nil
 }
 
-func (m *DataDefinition) GetAlterTable() *AlterTable {
-	if x, ok := m.GetDefinition().(*DataDefinition_AlterTable); ok {
-		return x.AlterTable
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Plan) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Plan_Query)(nil),
+		(*Plan_Tcl)(nil),
+		(*Plan_Ddl)(nil),
+		(*Plan_Dcl)(nil),
 	}
-	return nil
 }
 
-func (m *DataDefinition) GetDropTable() *DropTable {
-	if x, ok := m.GetDefinition().(*DataDefinition_DropTable); ok {
-		return x.DropTable
+type DataControl struct {
+	// DataDefinition type
+	DclType DataControl_DclType `protobuf:"varint,1,opt,name=dcl_type,json=dclType,proto3,enum=plan.DataControl_DclType" json:"dcl_type,omitempty"`
+	// Types that are valid to be assigned to Control:
+	//
+	//	*DataControl_SetVariables
+	//	*DataControl_Prepare
+	//	*DataControl_Execute
+	//	*DataControl_Deallocate
+	Control              isDataControl_Control `protobuf_oneof:"control"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *DataControl) Reset()         { *m = DataControl{} }
+func (m *DataControl) String() string { return proto.CompactTextString(m) }
+func (*DataControl) ProtoMessage()    {}
+func (*DataControl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{44}
+}
+func (m *DataControl) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataControl.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *DataControl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataControl.Merge(m, src)
+}
+func (m *DataControl) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DataControl) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataControl.DiscardUnknown(m)
 }
 
-func (m *DataDefinition) GetCreateIndex() *CreateIndex {
-	if x, ok := m.GetDefinition().(*DataDefinition_CreateIndex); ok {
-		return x.CreateIndex
+var xxx_messageInfo_DataControl proto.InternalMessageInfo
+
+type isDataControl_Control interface {
+	isDataControl_Control()
+	MarshalTo([]byte) (int, error)
+	ProtoSize() int
+}
+
+type DataControl_SetVariables struct {
+	SetVariables *SetVariables `protobuf:"bytes,2,opt,name=set_variables,json=setVariables,proto3,oneof" json:"set_variables,omitempty"`
+}
+type DataControl_Prepare struct {
+	Prepare *Prepare `protobuf:"bytes,3,opt,name=prepare,proto3,oneof" json:"prepare,omitempty"`
+}
+type DataControl_Execute struct {
+	Execute *Execute `protobuf:"bytes,4,opt,name=execute,proto3,oneof" json:"execute,omitempty"`
+}
+type DataControl_Deallocate struct {
+	Deallocate *Deallocate `protobuf:"bytes,5,opt,name=deallocate,proto3,oneof" json:"deallocate,omitempty"`
+}
+
+func (*DataControl_SetVariables) isDataControl_Control() {}
+func (*DataControl_Prepare) isDataControl_Control()      {}
+func (*DataControl_Execute) isDataControl_Control()      {}
+func (*DataControl_Deallocate) isDataControl_Control()   {}
+
+func (m *DataControl) GetControl() isDataControl_Control {
+	if m != nil {
+		return m.Control
 	}
 	return nil
 }
 
-func (m *DataDefinition) GetAlterIndex() *AlterIndex {
-	if x, ok := m.GetDefinition().(*DataDefinition_AlterIndex); ok {
-		return x.AlterIndex
+func (m *DataControl) GetDclType() DataControl_DclType {
+	if m != nil {
+		return m.DclType
+	}
+	return DataControl_SET_VARIABLES
+}
+
+func (m *DataControl) GetSetVariables() *SetVariables {
+	if x, ok := m.GetControl().(*DataControl_SetVariables); ok {
+		return x.SetVariables
 	}
 	return nil
 }
 
-func (m *DataDefinition) GetDropIndex() *DropIndex {
-	if x, ok := m.GetDefinition().(*DataDefinition_DropIndex); ok {
-		return x.DropIndex
+func (m *DataControl) GetPrepare() *Prepare {
+	if x, ok := m.GetControl().(*DataControl_Prepare); ok {
+		return x.Prepare
 	}
 	return nil
 }
 
-func (m *DataDefinition) GetTruncateTable() *TruncateTable {
-	if x, ok := m.GetDefinition().(*DataDefinition_TruncateTable); ok {
-		return x.TruncateTable
+func (m *DataControl) GetExecute() *Execute {
+	if x, ok := m.GetControl().(*DataControl_Execute); ok {
+		return x.Execute
 	}
 	return nil
 }
 
-func (m *DataDefinition) GetShowVariables() *ShowVariables {
-	if x, ok := m.GetDefinition().(*DataDefinition_ShowVariables); ok {
-		return x.ShowVariables
+func (m *DataControl) GetDeallocate() *Deallocate {
+	if x, ok := m.GetControl().(*DataControl_Deallocate); ok {
+		return x.Deallocate
 	}
 	return nil
 }
 
 // XXX_OneofWrappers is for the internal use of the proto package.
-func (*DataDefinition) XXX_OneofWrappers() []interface{} {
+func (*DataControl) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
-		(*DataDefinition_CreateDatabase)(nil),
-		(*DataDefinition_AlterDatabase)(nil),
-		(*DataDefinition_DropDatabase)(nil),
-		(*DataDefinition_CreateTable)(nil),
-		(*DataDefinition_AlterTable)(nil),
-		(*DataDefinition_DropTable)(nil),
-		(*DataDefinition_CreateIndex)(nil),
-		(*DataDefinition_AlterIndex)(nil),
-		(*DataDefinition_DropIndex)(nil),
-		(*DataDefinition_TruncateTable)(nil),
-		(*DataDefinition_ShowVariables)(nil),
+		(*DataControl_SetVariables)(nil),
+		(*DataControl_Prepare)(nil),
+		(*DataControl_Execute)(nil),
+		(*DataControl_Deallocate)(nil),
 	}
 }
 
-type CreateDatabase struct {
-	IfNotExists          bool     `protobuf:"varint,1,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
-	Database             string   `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type DataDefinition struct {
+	// DataDefinition type
+	DdlType DataDefinition_DdlType `protobuf:"varint,1,opt,name=ddl_type,json=ddlType,proto3,enum=plan.DataDefinition_DdlType" json:"ddl_type,omitempty"`
+	// other show statement we will rewrite to a select statement
+	// then we will get a Query
+	// eg: 'show databases' will rewrite to 'select md.datname as `Database` from mo_database md'
+	Query *Query `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// Types that are valid to be assigned to Definition:
+	//
+	//	*DataDefinition_CreateDatabase
+	//	*DataDefinition_AlterDatabase
+	//	*DataDefinition_DropDatabase
+	//	*DataDefinition_CreateTable
+	//	*DataDefinition_AlterTable
+	//	*DataDefinition_DropTable
+	//	*DataDefinition_CreateIndex
+	//	*DataDefinition_AlterIndex
+	//	*DataDefinition_DropIndex
+	//	*DataDefinition_TruncateTable
+	//	*DataDefinition_ShowVariables
+	Definition           isDataDefinition_Definition `protobuf_oneof:"definition"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
-func (m *CreateDatabase) Reset()         { *m = CreateDatabase{} }
-func (m *CreateDatabase) String() string { return proto.CompactTextString(m) }
-func (*CreateDatabase) ProtoMessage()    {}
-func (*CreateDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{39}
+func (m *DataDefinition) Reset()         { *m = DataDefinition{} }
+func (m *DataDefinition) String() string { return proto.CompactTextString(m) }
+func (*DataDefinition) ProtoMessage()    {}
+func (*DataDefinition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{45}
 }
-func (m *CreateDatabase) XXX_Unmarshal(b []byte) error {
+func (m *DataDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateDatabase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DataDefinition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateDatabase.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DataDefinition.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4396,66 +5019,274 @@ func (m *CreateDatabase) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *CreateDatabase) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateDatabase.Merge(m, src)
+func (m *DataDefinition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataDefinition.Merge(m, src)
 }
-func (m *CreateDatabase) XXX_Size() int {
+func (m *DataDefinition) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *CreateDatabase) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateDatabase.DiscardUnknown(m)
+func (m *DataDefinition) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataDefinition.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateDatabase proto.InternalMessageInfo
+var xxx_messageInfo_DataDefinition proto.InternalMessageInfo
 
-func (m *CreateDatabase) GetIfNotExists() bool {
-	if m != nil {
-		return m.IfNotExists
-	}
-	return false
+type isDataDefinition_Definition interface {
+	isDataDefinition_Definition()
+	MarshalTo([]byte) (int, error)
+	ProtoSize() int
 }
 
-func (m *CreateDatabase) GetDatabase() string {
-	if m != nil {
-		return m.Database
-	}
-	return ""
+type DataDefinition_CreateDatabase struct {
+	CreateDatabase *CreateDatabase `protobuf:"bytes,3,opt,name=create_database,json=createDatabase,proto3,oneof" json:"create_database,omitempty"`
 }
-
-type AlterDatabase struct {
-	IfExists             bool     `protobuf:"varint,1,opt,name=if_exists,json=ifExists,proto3" json:"if_exists,omitempty"`
-	Database             string   `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type DataDefinition_AlterDatabase struct {
+	AlterDatabase *AlterDatabase `protobuf:"bytes,4,opt,name=alter_database,json=alterDatabase,proto3,oneof" json:"alter_database,omitempty"`
 }
-
-func (m *AlterDatabase) Reset()         { *m = AlterDatabase{} }
-func (m *AlterDatabase) String() string { return proto.CompactTextString(m) }
-func (*AlterDatabase) ProtoMessage()    {}
-func (*AlterDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{40}
+type DataDefinition_DropDatabase struct {
+	DropDatabase *DropDatabase `protobuf:"bytes,5,opt,name=drop_database,json=dropDatabase,proto3,oneof" json:"drop_database,omitempty"`
 }
-func (m *AlterDatabase) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+type DataDefinition_CreateTable struct {
+	CreateTable *CreateTable `protobuf:"bytes,6,opt,name=create_table,json=createTable,proto3,oneof" json:"create_table,omitempty"`
 }
-func (m *AlterDatabase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_AlterDatabase.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
+type DataDefinition_AlterTable struct {
+	AlterTable *AlterTable `protobuf:"bytes,7,opt,name=alter_table,json=alterTable,proto3,oneof" json:"alter_table,omitempty"`
 }
-func (m *AlterDatabase) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AlterDatabase.Merge(m, src)
+type DataDefinition_DropTable struct {
+	DropTable *DropTable `protobuf:"bytes,8,opt,name=drop_table,json=dropTable,proto3,oneof" json:"drop_table,omitempty"`
 }
-func (m *AlterDatabase) XXX_Size() int {
-	return m.ProtoSize()
+type DataDefinition_CreateIndex struct {
+	CreateIndex *CreateIndex `protobuf:"bytes,9,opt,name=create_index,json=createIndex,proto3,oneof" json:"create_index,omitempty"`
+}
+type DataDefinition_AlterIndex struct {
+	AlterIndex *AlterIndex `protobuf:"bytes,10,opt,name=alter_index,json=alterIndex,proto3,oneof" json:"alter_index,omitempty"`
+}
+type DataDefinition_DropIndex struct {
+	DropIndex *DropIndex `protobuf:"bytes,11,opt,name=drop_index,json=dropIndex,proto3,oneof" json:"drop_index,omitempty"`
+}
+type DataDefinition_TruncateTable struct {
+	TruncateTable *TruncateTable `protobuf:"bytes,12,opt,name=truncate_table,json=truncateTable,proto3,oneof" json:"truncate_table,omitempty"`
+}
+type DataDefinition_ShowVariables struct {
+	ShowVariables *ShowVariables `protobuf:"bytes,13,opt,name=show_variables,json=showVariables,proto3,oneof" json:"show_variables,omitempty"`
+}
+
+func (*DataDefinition_CreateDatabase) isDataDefinition_Definition() {}
+func (*DataDefinition_AlterDatabase) isDataDefinition_Definition()  {}
+func (*DataDefinition_DropDatabase) isDataDefinition_Definition()   {}
+func (*DataDefinition_CreateTable) isDataDefinition_Definition()    {}
+func (*DataDefinition_AlterTable) isDataDefinition_Definition()     {}
+func (*DataDefinition_DropTable) isDataDefinition_Definition()      {}
+func (*DataDefinition_CreateIndex) isDataDefinition_Definition()    {}
+func (*DataDefinition_AlterIndex) isDataDefinition_Definition()     {}
+func (*DataDefinition_DropIndex) isDataDefinition_Definition()      {}
+func (*DataDefinition_TruncateTable) isDataDefinition_Definition()  {}
+func (*DataDefinition_ShowVariables) isDataDefinition_Definition()  {}
+
+func (m *DataDefinition) GetDefinition() isDataDefinition_Definition {
+	if m != nil {
+		return m.Definition
+	}
+	return nil
+}
+
+func (m *DataDefinition) GetDdlType() DataDefinition_DdlType {
+	if m != nil {
+		return m.DdlType
+	}
+	return DataDefinition_CREATE_DATABASE
+}
+
+func (m *DataDefinition) GetQuery() *Query {
+	if m != nil {
+		return m.Query
+	}
+	return nil
+}
+
+func (m *DataDefinition) GetCreateDatabase() *CreateDatabase {
+	if x, ok := m.GetDefinition().(*DataDefinition_CreateDatabase); ok {
+		return x.CreateDatabase
+	}
+	return nil
+}
+
+func (m *DataDefinition) GetAlterDatabase() *AlterDatabase {
+	if x, ok := m.GetDefinition().(*DataDefinition_AlterDatabase); ok {
+		return x.AlterDatabase
+	}
+	return nil
+}
+
+func (m *DataDefinition) GetDropDatabase() *DropDatabase {
+	if x, ok := m.GetDefinition().(*DataDefinition_DropDatabase); ok {
+		return x.DropDatabase
+	}
+	return nil
+}
+
+func (m *DataDefinition) GetCreateTable() *CreateTable {
+	if x, ok := m.GetDefinition().(*DataDefinition_CreateTable); ok {
+		return x.CreateTable
+	}
+	return nil
+}
+
+func (m *DataDefinition) GetAlterTable() *AlterTable {
+	if x, ok := m.GetDefinition().(*DataDefinition_AlterTable); ok {
+		return x.AlterTable
+	}
+	return nil
+}
+
+func (m *DataDefinition) GetDropTable() *DropTable {
+	if x, ok := m.GetDefinition().(*DataDefinition_DropTable); ok {
+		return x.DropTable
+	}
+	return nil
+}
+
+func (m *DataDefinition) GetCreateIndex() *CreateIndex {
+	if x, ok := m.GetDefinition().(*DataDefinition_CreateIndex); ok {
+		return x.CreateIndex
+	}
+	return nil
+}
+
+func (m *DataDefinition) GetAlterIndex() *AlterIndex {
+	if x, ok := m.GetDefinition().(*DataDefinition_AlterIndex); ok {
+		return x.AlterIndex
+	}
+	return nil
+}
+
+func (m *DataDefinition) GetDropIndex() *DropIndex {
+	if x, ok := m.GetDefinition().(*DataDefinition_DropIndex); ok {
+		return x.DropIndex
+	}
+	return nil
+}
+
+func (m *DataDefinition) GetTruncateTable() *TruncateTable {
+	if x, ok := m.GetDefinition().(*DataDefinition_TruncateTable); ok {
+		return x.TruncateTable
+	}
+	return nil
+}
+
+func (m *DataDefinition) GetShowVariables() *ShowVariables {
+	if x, ok := m.GetDefinition().(*DataDefinition_ShowVariables); ok {
+		return x.ShowVariables
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*DataDefinition) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*DataDefinition_CreateDatabase)(nil),
+		(*DataDefinition_AlterDatabase)(nil),
+		(*DataDefinition_DropDatabase)(nil),
+		(*DataDefinition_CreateTable)(nil),
+		(*DataDefinition_AlterTable)(nil),
+		(*DataDefinition_DropTable)(nil),
+		(*DataDefinition_CreateIndex)(nil),
+		(*DataDefinition_AlterIndex)(nil),
+		(*DataDefinition_DropIndex)(nil),
+		(*DataDefinition_TruncateTable)(nil),
+		(*DataDefinition_ShowVariables)(nil),
+	}
+}
+
+type CreateDatabase struct {
+	IfNotExists          bool     `protobuf:"varint,1,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
+	Database             string   `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateDatabase) Reset()         { *m = CreateDatabase{} }
+func (m *CreateDatabase) String() string { return proto.CompactTextString(m) }
+func (*CreateDatabase) ProtoMessage()    {}
+func (*CreateDatabase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{46}
+}
+func (m *CreateDatabase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateDatabase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateDatabase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateDatabase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDatabase.Merge(m, src)
+}
+func (m *CreateDatabase) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CreateDatabase) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDatabase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDatabase proto.InternalMessageInfo
+
+func (m *CreateDatabase) GetIfNotExists() bool {
+	if m != nil {
+		return m.IfNotExists
+	}
+	return false
+}
+
+func (m *CreateDatabase) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+type AlterDatabase struct {
+	IfExists             bool     `protobuf:"varint,1,opt,name=if_exists,json=ifExists,proto3" json:"if_exists,omitempty"`
+	Database             string   `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterDatabase) Reset()         { *m = AlterDatabase{} }
+func (m *AlterDatabase) String() string { return proto.CompactTextString(m) }
+func (*AlterDatabase) ProtoMessage()    {}
+func (*AlterDatabase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{47}
+}
+func (m *AlterDatabase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterDatabase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterDatabase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterDatabase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterDatabase.Merge(m, src)
+}
+func (m *AlterDatabase) XXX_Size() int {
+	return m.ProtoSize()
 }
 func (m *AlterDatabase) XXX_DiscardUnknown() {
 	xxx_messageInfo_AlterDatabase.DiscardUnknown(m)
@@ -4489,7 +5320,7 @@ func (m *DropDatabase) Reset()         { *m = DropDatabase{} }
 func (m *DropDatabase) String() string { return proto.CompactTextString(m) }
 func (*DropDatabase) ProtoMessage()    {}
 func (*DropDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{41}
+	return fileDescriptor_2d655ab2f7683c23, []int{48}
 }
 func (m *DropDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4533,20 +5364,22 @@ func (m *DropDatabase) GetDatabase() string {
 }
 
 type CreateTable struct {
-	IfNotExists          bool      `protobuf:"varint,1,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
-	Temporary            bool      `protobuf:"varint,2,opt,name=temporary,proto3" json:"temporary,omitempty"`
-	Database             string    `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
-	TableDef             *TableDef `protobuf:"bytes,4,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	IfNotExists bool      `protobuf:"varint,1,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
+	Temporary   bool      `protobuf:"varint,2,opt,name=temporary,proto3" json:"temporary,omitempty"`
+	Database    string    `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	TableDef    *TableDef `protobuf:"bytes,4,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
+	// replace the view of the same name if it exists
+	Replace              bool     `protobuf:"varint,5,opt,name=replace,proto3" json:"replace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CreateTable) Reset()         { *m = CreateTable{} }
 func (m *CreateTable) String() string { return proto.CompactTextString(m) }
 func (*CreateTable) ProtoMessage()    {}
 func (*CreateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{42}
+	return fileDescriptor_2d655ab2f7683c23, []int{49}
 }
 func (m *CreateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4603,19 +5436,28 @@ func (m *CreateTable) GetTableDef() *TableDef {
 	return nil
 }
 
+func (m *CreateTable) GetReplace() bool {
+	if m != nil {
+		return m.Replace
+	}
+	return false
+}
+
 type AlterTable struct {
-	Table                string    `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
-	TableDef             *TableDef `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	Table                string               `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	TableDef             *TableDef            `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
+	Database             string               `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	Actions              []*AlterTable_Action `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *AlterTable) Reset()         { *m = AlterTable{} }
 func (m *AlterTable) String() string { return proto.CompactTextString(m) }
 func (*AlterTable) ProtoMessage()    {}
 func (*AlterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{43}
+	return fileDescriptor_2d655ab2f7683c23, []int{50}
 }
 func (m *AlterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4658,27 +5500,48 @@ func (m *AlterTable) GetTableDef() *TableDef {
 	return nil
 }
 
-type DropTable struct {
-	IfExists             bool     `protobuf:"varint,1,opt,name=if_exists,json=ifExists,proto3" json:"if_exists,omitempty"`
-	Database             string   `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
-	Table                string   `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *AlterTable) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
 }
 
-func (m *DropTable) Reset()         { *m = DropTable{} }
-func (m *DropTable) String() string { return proto.CompactTextString(m) }
-func (*DropTable) ProtoMessage()    {}
-func (*DropTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44}
+func (m *AlterTable) GetActions() []*AlterTable_Action {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
 }
-func (m *DropTable) XXX_Unmarshal(b []byte) error {
+
+type AlterTable_Action struct {
+	// Types that are valid to be assigned to Action:
+	//
+	//	*AlterTable_Action_AddColumn
+	//	*AlterTable_Action_DropColumn
+	//	*AlterTable_Action_RenameColumn
+	//	*AlterTable_Action_RenameTable
+	//	*AlterTable_Action_AddPartition
+	//	*AlterTable_Action_DropPartition
+	//	*AlterTable_Action_TruncatePartition
+	Action               isAlterTable_Action_Action `protobuf_oneof:"action"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *AlterTable_Action) Reset()         { *m = AlterTable_Action{} }
+func (m *AlterTable_Action) String() string { return proto.CompactTextString(m) }
+func (*AlterTable_Action) ProtoMessage()    {}
+func (*AlterTable_Action) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{50, 0}
+}
+func (m *AlterTable_Action) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DropTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AlterTable_Action) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DropTable.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AlterTable_Action.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4688,59 +5551,142 @@ func (m *DropTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *DropTable) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropTable.Merge(m, src)
+func (m *AlterTable_Action) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTable_Action.Merge(m, src)
 }
-func (m *DropTable) XXX_Size() int {
+func (m *AlterTable_Action) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *DropTable) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropTable.DiscardUnknown(m)
+func (m *AlterTable_Action) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTable_Action.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropTable proto.InternalMessageInfo
+var xxx_messageInfo_AlterTable_Action proto.InternalMessageInfo
 
-func (m *DropTable) GetIfExists() bool {
+type isAlterTable_Action_Action interface {
+	isAlterTable_Action_Action()
+	MarshalTo([]byte) (int, error)
+	ProtoSize() int
+}
+
+type AlterTable_Action_AddColumn struct {
+	AddColumn *AlterTableAddColumn `protobuf:"bytes,1,opt,name=add_column,json=addColumn,proto3,oneof" json:"add_column,omitempty"`
+}
+type AlterTable_Action_DropColumn struct {
+	DropColumn *AlterTableDropColumn `protobuf:"bytes,2,opt,name=drop_column,json=dropColumn,proto3,oneof" json:"drop_column,omitempty"`
+}
+type AlterTable_Action_RenameColumn struct {
+	RenameColumn *AlterTableRenameColumn `protobuf:"bytes,3,opt,name=rename_column,json=renameColumn,proto3,oneof" json:"rename_column,omitempty"`
+}
+type AlterTable_Action_RenameTable struct {
+	RenameTable *AlterTableRenameTable `protobuf:"bytes,4,opt,name=rename_table,json=renameTable,proto3,oneof" json:"rename_table,omitempty"`
+}
+type AlterTable_Action_AddPartition struct {
+	AddPartition *AlterTableAddPartition `protobuf:"bytes,5,opt,name=add_partition,json=addPartition,proto3,oneof" json:"add_partition,omitempty"`
+}
+type AlterTable_Action_DropPartition struct {
+	DropPartition *AlterTableDropPartition `protobuf:"bytes,6,opt,name=drop_partition,json=dropPartition,proto3,oneof" json:"drop_partition,omitempty"`
+}
+type AlterTable_Action_TruncatePartition struct {
+	TruncatePartition *AlterTableTruncatePartition `protobuf:"bytes,7,opt,name=truncate_partition,json=truncatePartition,proto3,oneof" json:"truncate_partition,omitempty"`
+}
+
+func (*AlterTable_Action_AddColumn) isAlterTable_Action_Action()         {}
+func (*AlterTable_Action_DropColumn) isAlterTable_Action_Action()        {}
+func (*AlterTable_Action_RenameColumn) isAlterTable_Action_Action()      {}
+func (*AlterTable_Action_RenameTable) isAlterTable_Action_Action()       {}
+func (*AlterTable_Action_AddPartition) isAlterTable_Action_Action()      {}
+func (*AlterTable_Action_DropPartition) isAlterTable_Action_Action()     {}
+func (*AlterTable_Action_TruncatePartition) isAlterTable_Action_Action() {}
+
+func (m *AlterTable_Action) GetAction() isAlterTable_Action_Action {
 	if m != nil {
-		return m.IfExists
+		return m.Action
 	}
-	return false
+	return nil
 }
 
-func (m *DropTable) GetDatabase() string {
-	if m != nil {
-		return m.Database
+func (m *AlterTable_Action) GetAddColumn() *AlterTableAddColumn {
+	if x, ok := m.GetAction().(*AlterTable_Action_AddColumn); ok {
+		return x.AddColumn
 	}
-	return ""
+	return nil
 }
 
-func (m *DropTable) GetTable() string {
-	if m != nil {
-		return m.Table
+func (m *AlterTable_Action) GetDropColumn() *AlterTableDropColumn {
+	if x, ok := m.GetAction().(*AlterTable_Action_DropColumn); ok {
+		return x.DropColumn
 	}
-	return ""
+	return nil
 }
 
-type CreateIndex struct {
-	IfNotExists          bool     `protobuf:"varint,1,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
-	Index                string   `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+func (m *AlterTable_Action) GetRenameColumn() *AlterTableRenameColumn {
+	if x, ok := m.GetAction().(*AlterTable_Action_RenameColumn); ok {
+		return x.RenameColumn
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetRenameTable() *AlterTableRenameTable {
+	if x, ok := m.GetAction().(*AlterTable_Action_RenameTable); ok {
+		return x.RenameTable
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetAddPartition() *AlterTableAddPartition {
+	if x, ok := m.GetAction().(*AlterTable_Action_AddPartition); ok {
+		return x.AddPartition
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetDropPartition() *AlterTableDropPartition {
+	if x, ok := m.GetAction().(*AlterTable_Action_DropPartition); ok {
+		return x.DropPartition
+	}
+	return nil
+}
+
+func (m *AlterTable_Action) GetTruncatePartition() *AlterTableTruncatePartition {
+	if x, ok := m.GetAction().(*AlterTable_Action_TruncatePartition); ok {
+		return x.TruncatePartition
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*AlterTable_Action_AddColumn)(nil),
+		(*AlterTable_Action_DropColumn)(nil),
+		(*AlterTable_Action_RenameColumn)(nil),
+		(*AlterTable_Action_RenameTable)(nil),
+		(*AlterTable_Action_AddPartition)(nil),
+		(*AlterTable_Action_DropPartition)(nil),
+		(*AlterTable_Action_TruncatePartition)(nil),
+	}
+}
+
+type AlterTableAddColumn struct {
+	Column               *ColDef  `protobuf:"bytes,1,opt,name=column,proto3" json:"column,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateIndex) Reset()         { *m = CreateIndex{} }
-func (m *CreateIndex) String() string { return proto.CompactTextString(m) }
-func (*CreateIndex) ProtoMessage()    {}
-func (*CreateIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45}
+func (m *AlterTableAddColumn) Reset()         { *m = AlterTableAddColumn{} }
+func (m *AlterTableAddColumn) String() string { return proto.CompactTextString(m) }
+func (*AlterTableAddColumn) ProtoMessage()    {}
+func (*AlterTableAddColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{51}
 }
-func (m *CreateIndex) XXX_Unmarshal(b []byte) error {
+func (m *AlterTableAddColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AlterTableAddColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateIndex.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AlterTableAddColumn.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4750,51 +5696,44 @@ func (m *CreateIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *CreateIndex) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateIndex.Merge(m, src)
+func (m *AlterTableAddColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableAddColumn.Merge(m, src)
 }
-func (m *CreateIndex) XXX_Size() int {
+func (m *AlterTableAddColumn) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *CreateIndex) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateIndex.DiscardUnknown(m)
+func (m *AlterTableAddColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableAddColumn.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateIndex proto.InternalMessageInfo
-
-func (m *CreateIndex) GetIfNotExists() bool {
-	if m != nil {
-		return m.IfNotExists
-	}
-	return false
-}
+var xxx_messageInfo_AlterTableAddColumn proto.InternalMessageInfo
 
-func (m *CreateIndex) GetIndex() string {
+func (m *AlterTableAddColumn) GetColumn() *ColDef {
 	if m != nil {
-		return m.Index
+		return m.Column
 	}
-	return ""
+	return nil
 }
 
-type AlterIndex struct {
-	Index                string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+type AlterTableDropColumn struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AlterIndex) Reset()         { *m = AlterIndex{} }
-func (m *AlterIndex) String() string { return proto.CompactTextString(m) }
-func (*AlterIndex) ProtoMessage()    {}
-func (*AlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{46}
+func (m *AlterTableDropColumn) Reset()         { *m = AlterTableDropColumn{} }
+func (m *AlterTableDropColumn) String() string { return proto.CompactTextString(m) }
+func (*AlterTableDropColumn) ProtoMessage()    {}
+func (*AlterTableDropColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{52}
 }
-func (m *AlterIndex) XXX_Unmarshal(b []byte) error {
+func (m *AlterTableDropColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AlterIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AlterTableDropColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AlterIndex.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AlterTableDropColumn.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4804,45 +5743,45 @@ func (m *AlterIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *AlterIndex) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AlterIndex.Merge(m, src)
+func (m *AlterTableDropColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableDropColumn.Merge(m, src)
 }
-func (m *AlterIndex) XXX_Size() int {
+func (m *AlterTableDropColumn) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *AlterIndex) XXX_DiscardUnknown() {
-	xxx_messageInfo_AlterIndex.DiscardUnknown(m)
+func (m *AlterTableDropColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableDropColumn.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AlterIndex proto.InternalMessageInfo
+var xxx_messageInfo_AlterTableDropColumn proto.InternalMessageInfo
 
-func (m *AlterIndex) GetIndex() string {
+func (m *AlterTableDropColumn) GetName() string {
 	if m != nil {
-		return m.Index
+		return m.Name
 	}
 	return ""
 }
 
-type DropIndex struct {
-	IfExists             bool     `protobuf:"varint,1,opt,name=if_exists,json=ifExists,proto3" json:"if_exists,omitempty"`
-	Index                string   `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+type AlterTableRenameColumn struct {
+	OldName              string   `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DropIndex) Reset()         { *m = DropIndex{} }
-func (m *DropIndex) String() string { return proto.CompactTextString(m) }
-func (*DropIndex) ProtoMessage()    {}
-func (*DropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{47}
+func (m *AlterTableRenameColumn) Reset()         { *m = AlterTableRenameColumn{} }
+func (m *AlterTableRenameColumn) String() string { return proto.CompactTextString(m) }
+func (*AlterTableRenameColumn) ProtoMessage()    {}
+func (*AlterTableRenameColumn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{53}
 }
-func (m *DropIndex) XXX_Unmarshal(b []byte) error {
+func (m *AlterTableRenameColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DropIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AlterTableRenameColumn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DropIndex.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AlterTableRenameColumn.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4852,51 +5791,52 @@ func (m *DropIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *DropIndex) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropIndex.Merge(m, src)
+func (m *AlterTableRenameColumn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableRenameColumn.Merge(m, src)
 }
-func (m *DropIndex) XXX_Size() int {
+func (m *AlterTableRenameColumn) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *DropIndex) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropIndex.DiscardUnknown(m)
+func (m *AlterTableRenameColumn) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableRenameColumn.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropIndex proto.InternalMessageInfo
+var xxx_messageInfo_AlterTableRenameColumn proto.InternalMessageInfo
 
-func (m *DropIndex) GetIfExists() bool {
+func (m *AlterTableRenameColumn) GetOldName() string {
 	if m != nil {
-		return m.IfExists
+		return m.OldName
 	}
-	return false
+	return ""
 }
 
-func (m *DropIndex) GetIndex() string {
+func (m *AlterTableRenameColumn) GetNewName() string {
 	if m != nil {
-		return m.Index
+		return m.NewName
 	}
 	return ""
 }
 
-type TruncateTable struct {
-	Table                string   `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+type AlterTableRenameTable struct {
+	Database             string   `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Table                string   `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TruncateTable) Reset()         { *m = TruncateTable{} }
-func (m *TruncateTable) String() string { return proto.CompactTextString(m) }
-func (*TruncateTable) ProtoMessage()    {}
-func (*TruncateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{48}
+func (m *AlterTableRenameTable) Reset()         { *m = AlterTableRenameTable{} }
+func (m *AlterTableRenameTable) String() string { return proto.CompactTextString(m) }
+func (*AlterTableRenameTable) ProtoMessage()    {}
+func (*AlterTableRenameTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{54}
 }
-func (m *TruncateTable) XXX_Unmarshal(b []byte) error {
+func (m *AlterTableRenameTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TruncateTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AlterTableRenameTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TruncateTable.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AlterTableRenameTable.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4906,45 +5846,51 @@ func (m *TruncateTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *TruncateTable) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TruncateTable.Merge(m, src)
+func (m *AlterTableRenameTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableRenameTable.Merge(m, src)
 }
-func (m *TruncateTable) XXX_Size() int {
+func (m *AlterTableRenameTable) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *TruncateTable) XXX_DiscardUnknown() {
-	xxx_messageInfo_TruncateTable.DiscardUnknown(m)
+func (m *AlterTableRenameTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableRenameTable.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TruncateTable proto.InternalMessageInfo
+var xxx_messageInfo_AlterTableRenameTable proto.InternalMessageInfo
 
-func (m *TruncateTable) GetTable() string {
+func (m *AlterTableRenameTable) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *AlterTableRenameTable) GetTable() string {
 	if m != nil {
 		return m.Table
 	}
 	return ""
 }
 
-type ShowVariables struct {
-	Global               bool     `protobuf:"varint,1,opt,name=global,proto3" json:"global,omitempty"`
-	Where                []*Expr  `protobuf:"bytes,2,rep,name=where,proto3" json:"where,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type AlterTableAddPartition struct {
+	Partitions           []*PartitionDef_Partition `protobuf:"bytes,1,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
-func (m *ShowVariables) Reset()         { *m = ShowVariables{} }
-func (m *ShowVariables) String() string { return proto.CompactTextString(m) }
-func (*ShowVariables) ProtoMessage()    {}
-func (*ShowVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49}
+func (m *AlterTableAddPartition) Reset()         { *m = AlterTableAddPartition{} }
+func (m *AlterTableAddPartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTableAddPartition) ProtoMessage()    {}
+func (*AlterTableAddPartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{55}
 }
-func (m *ShowVariables) XXX_Unmarshal(b []byte) error {
+func (m *AlterTableAddPartition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ShowVariables) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AlterTableAddPartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ShowVariables.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AlterTableAddPartition.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4954,51 +5900,44 @@ func (m *ShowVariables) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *ShowVariables) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowVariables.Merge(m, src)
+func (m *AlterTableAddPartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableAddPartition.Merge(m, src)
 }
-func (m *ShowVariables) XXX_Size() int {
+func (m *AlterTableAddPartition) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *ShowVariables) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowVariables.DiscardUnknown(m)
+func (m *AlterTableAddPartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableAddPartition.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowVariables proto.InternalMessageInfo
-
-func (m *ShowVariables) GetGlobal() bool {
-	if m != nil {
-		return m.Global
-	}
-	return false
-}
+var xxx_messageInfo_AlterTableAddPartition proto.InternalMessageInfo
 
-func (m *ShowVariables) GetWhere() []*Expr {
+func (m *AlterTableAddPartition) GetPartitions() []*PartitionDef_Partition {
 	if m != nil {
-		return m.Where
+		return m.Partitions
 	}
 	return nil
 }
 
-type SetVariables struct {
-	Items                []*SetVariablesItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type AlterTableDropPartition struct {
+	Names                []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SetVariables) Reset()         { *m = SetVariables{} }
-func (m *SetVariables) String() string { return proto.CompactTextString(m) }
-func (*SetVariables) ProtoMessage()    {}
-func (*SetVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50}
+func (m *AlterTableDropPartition) Reset()         { *m = AlterTableDropPartition{} }
+func (m *AlterTableDropPartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTableDropPartition) ProtoMessage()    {}
+func (*AlterTableDropPartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{56}
 }
-func (m *SetVariables) XXX_Unmarshal(b []byte) error {
+func (m *AlterTableDropPartition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SetVariables) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AlterTableDropPartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SetVariables.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AlterTableDropPartition.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5008,48 +5947,44 @@ func (m *SetVariables) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *SetVariables) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetVariables.Merge(m, src)
+func (m *AlterTableDropPartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableDropPartition.Merge(m, src)
 }
-func (m *SetVariables) XXX_Size() int {
+func (m *AlterTableDropPartition) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *SetVariables) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetVariables.DiscardUnknown(m)
+func (m *AlterTableDropPartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableDropPartition.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetVariables proto.InternalMessageInfo
+var xxx_messageInfo_AlterTableDropPartition proto.InternalMessageInfo
 
-func (m *SetVariables) GetItems() []*SetVariablesItem {
+func (m *AlterTableDropPartition) GetNames() []string {
 	if m != nil {
-		return m.Items
+		return m.Names
 	}
 	return nil
 }
 
-type SetVariablesItem struct {
-	System               bool     `protobuf:"varint,1,opt,name=system,proto3" json:"system,omitempty"`
-	Global               bool     `protobuf:"varint,2,opt,name=global,proto3" json:"global,omitempty"`
-	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Value                *Expr    `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
-	Reserved             *Expr    `protobuf:"bytes,5,opt,name=reserved,proto3" json:"reserved,omitempty"`
+type AlterTableTruncatePartition struct {
+	Names                []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SetVariablesItem) Reset()         { *m = SetVariablesItem{} }
-func (m *SetVariablesItem) String() string { return proto.CompactTextString(m) }
-func (*SetVariablesItem) ProtoMessage()    {}
-func (*SetVariablesItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{51}
+func (m *AlterTableTruncatePartition) Reset()         { *m = AlterTableTruncatePartition{} }
+func (m *AlterTableTruncatePartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTableTruncatePartition) ProtoMessage()    {}
+func (*AlterTableTruncatePartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{57}
 }
-func (m *SetVariablesItem) XXX_Unmarshal(b []byte) error {
+func (m *AlterTableTruncatePartition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SetVariablesItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AlterTableTruncatePartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SetVariablesItem.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AlterTableTruncatePartition.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5059,74 +5994,108 @@ func (m *SetVariablesItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *SetVariablesItem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetVariablesItem.Merge(m, src)
+func (m *AlterTableTruncatePartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTableTruncatePartition.Merge(m, src)
 }
-func (m *SetVariablesItem) XXX_Size() int {
+func (m *AlterTableTruncatePartition) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *SetVariablesItem) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetVariablesItem.DiscardUnknown(m)
+func (m *AlterTableTruncatePartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTableTruncatePartition.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetVariablesItem proto.InternalMessageInfo
+var xxx_messageInfo_AlterTableTruncatePartition proto.InternalMessageInfo
 
-func (m *SetVariablesItem) GetSystem() bool {
+func (m *AlterTableTruncatePartition) GetNames() []string {
 	if m != nil {
-		return m.System
+		return m.Names
 	}
-	return false
+	return nil
 }
 
-func (m *SetVariablesItem) GetGlobal() bool {
-	if m != nil {
-		return m.Global
+type DropTable struct {
+	IfExists             bool     `protobuf:"varint,1,opt,name=if_exists,json=ifExists,proto3" json:"if_exists,omitempty"`
+	Database             string   `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	Table                string   `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropTable) Reset()         { *m = DropTable{} }
+func (m *DropTable) String() string { return proto.CompactTextString(m) }
+func (*DropTable) ProtoMessage()    {}
+func (*DropTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{58}
+}
+func (m *DropTable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DropTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DropTable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
+}
+func (m *DropTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropTable.Merge(m, src)
+}
+func (m *DropTable) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DropTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropTable.DiscardUnknown(m)
 }
 
-func (m *SetVariablesItem) GetName() string {
+var xxx_messageInfo_DropTable proto.InternalMessageInfo
+
+func (m *DropTable) GetIfExists() bool {
 	if m != nil {
-		return m.Name
+		return m.IfExists
 	}
-	return ""
+	return false
 }
 
-func (m *SetVariablesItem) GetValue() *Expr {
+func (m *DropTable) GetDatabase() string {
 	if m != nil {
-		return m.Value
+		return m.Database
 	}
-	return nil
+	return ""
 }
 
-func (m *SetVariablesItem) GetReserved() *Expr {
+func (m *DropTable) GetTable() string {
 	if m != nil {
-		return m.Reserved
+		return m.Table
 	}
-	return nil
+	return ""
 }
 
-type Prepare struct {
-	Name                 string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Schemas              []*ObjectRef `protobuf:"bytes,2,rep,name=schemas,proto3" json:"schemas,omitempty"`
-	Plan                 *Plan        `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type CreateIndex struct {
+	IfNotExists          bool     `protobuf:"varint,1,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
+	Index                string   `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Prepare) Reset()         { *m = Prepare{} }
-func (m *Prepare) String() string { return proto.CompactTextString(m) }
-func (*Prepare) ProtoMessage()    {}
-func (*Prepare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{52}
+func (m *CreateIndex) Reset()         { *m = CreateIndex{} }
+func (m *CreateIndex) String() string { return proto.CompactTextString(m) }
+func (*CreateIndex) ProtoMessage()    {}
+func (*CreateIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{59}
 }
-func (m *Prepare) XXX_Unmarshal(b []byte) error {
+func (m *CreateIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Prepare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CreateIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Prepare.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CreateIndex.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5136,59 +6105,99 @@ func (m *Prepare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Prepare) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Prepare.Merge(m, src)
+func (m *CreateIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateIndex.Merge(m, src)
 }
-func (m *Prepare) XXX_Size() int {
+func (m *CreateIndex) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *Prepare) XXX_DiscardUnknown() {
-	xxx_messageInfo_Prepare.DiscardUnknown(m)
+func (m *CreateIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateIndex.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Prepare proto.InternalMessageInfo
+var xxx_messageInfo_CreateIndex proto.InternalMessageInfo
 
-func (m *Prepare) GetName() string {
+func (m *CreateIndex) GetIfNotExists() bool {
 	if m != nil {
-		return m.Name
+		return m.IfNotExists
 	}
-	return ""
+	return false
 }
 
-func (m *Prepare) GetSchemas() []*ObjectRef {
+func (m *CreateIndex) GetIndex() string {
 	if m != nil {
-		return m.Schemas
+		return m.Index
 	}
-	return nil
+	return ""
 }
 
-func (m *Prepare) GetPlan() *Plan {
+type AlterIndex struct {
+	Index                string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterIndex) Reset()         { *m = AlterIndex{} }
+func (m *AlterIndex) String() string { return proto.CompactTextString(m) }
+func (*AlterIndex) ProtoMessage()    {}
+func (*AlterIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{60}
+}
+func (m *AlterIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterIndex.Merge(m, src)
+}
+func (m *AlterIndex) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterIndex proto.InternalMessageInfo
+
+func (m *AlterIndex) GetIndex() string {
 	if m != nil {
-		return m.Plan
+		return m.Index
 	}
-	return nil
+	return ""
 }
 
-type Execute struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Args                 []*Expr  `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+type DropIndex struct {
+	IfExists             bool     `protobuf:"varint,1,opt,name=if_exists,json=ifExists,proto3" json:"if_exists,omitempty"`
+	Index                string   `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Execute) Reset()         { *m = Execute{} }
-func (m *Execute) String() string { return proto.CompactTextString(m) }
-func (*Execute) ProtoMessage()    {}
-func (*Execute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{53}
+func (m *DropIndex) Reset()         { *m = DropIndex{} }
+func (m *DropIndex) String() string { return proto.CompactTextString(m) }
+func (*DropIndex) ProtoMessage()    {}
+func (*DropIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{61}
 }
-func (m *Execute) XXX_Unmarshal(b []byte) error {
+func (m *DropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Execute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DropIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Execute.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DropIndex.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5198,51 +6207,51 @@ func (m *Execute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Execute) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Execute.Merge(m, src)
+func (m *DropIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropIndex.Merge(m, src)
 }
-func (m *Execute) XXX_Size() int {
+func (m *DropIndex) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *Execute) XXX_DiscardUnknown() {
-	xxx_messageInfo_Execute.DiscardUnknown(m)
+func (m *DropIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropIndex.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Execute proto.InternalMessageInfo
+var xxx_messageInfo_DropIndex proto.InternalMessageInfo
 
-func (m *Execute) GetName() string {
+func (m *DropIndex) GetIfExists() bool {
 	if m != nil {
-		return m.Name
+		return m.IfExists
 	}
-	return ""
+	return false
 }
 
-func (m *Execute) GetArgs() []*Expr {
+func (m *DropIndex) GetIndex() string {
 	if m != nil {
-		return m.Args
+		return m.Index
 	}
-	return nil
+	return ""
 }
 
-type Deallocate struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+type TruncateTable struct {
+	Table                string   `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Deallocate) Reset()         { *m = Deallocate{} }
-func (m *Deallocate) String() string { return proto.CompactTextString(m) }
-func (*Deallocate) ProtoMessage()    {}
-func (*Deallocate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{54}
+func (m *TruncateTable) Reset()         { *m = TruncateTable{} }
+func (m *TruncateTable) String() string { return proto.CompactTextString(m) }
+func (*TruncateTable) ProtoMessage()    {}
+func (*TruncateTable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{62}
 }
-func (m *Deallocate) XXX_Unmarshal(b []byte) error {
+func (m *TruncateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Deallocate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TruncateTable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Deallocate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TruncateTable.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -5252,758 +6261,835 @@ func (m *Deallocate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Deallocate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Deallocate.Merge(m, src)
+func (m *TruncateTable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncateTable.Merge(m, src)
 }
-func (m *Deallocate) XXX_Size() int {
+func (m *TruncateTable) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *Deallocate) XXX_DiscardUnknown() {
-	xxx_messageInfo_Deallocate.DiscardUnknown(m)
+func (m *TruncateTable) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncateTable.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Deallocate proto.InternalMessageInfo
+var xxx_messageInfo_TruncateTable proto.InternalMessageInfo
 
-func (m *Deallocate) GetName() string {
+func (m *TruncateTable) GetTable() string {
 	if m != nil {
-		return m.Name
+		return m.Table
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("plan.CompressType", CompressType_name, CompressType_value)
-	proto.RegisterEnum("plan.TransationCompletionType", TransationCompletionType_name, TransationCompletionType_value)
-	proto.RegisterEnum("plan.Type_TypeId", Type_TypeId_name, Type_TypeId_value)
-	proto.RegisterEnum("plan.SubqueryRef_Type", SubqueryRef_Type_name, SubqueryRef_Type_value)
-	proto.RegisterEnum("plan.Function_FuncFlag", Function_FuncFlag_name, Function_FuncFlag_value)
-	proto.RegisterEnum("plan.IndexDef_IndexType", IndexDef_IndexType_name, IndexDef_IndexType_value)
-	proto.RegisterEnum("plan.OrderBySpec_OrderByFlag", OrderBySpec_OrderByFlag_name, OrderBySpec_OrderByFlag_value)
-	proto.RegisterEnum("plan.Node_NodeType", Node_NodeType_name, Node_NodeType_value)
-	proto.RegisterEnum("plan.Node_JoinFlag", Node_JoinFlag_name, Node_JoinFlag_value)
-	proto.RegisterEnum("plan.Node_AggMode", Node_AggMode_name, Node_AggMode_value)
-	proto.RegisterEnum("plan.Query_StatementType", Query_StatementType_name, Query_StatementType_value)
-	proto.RegisterEnum("plan.TransationControl_TclType", TransationControl_TclType_name, TransationControl_TclType_value)
-	proto.RegisterEnum("plan.TransationBegin_TransationMode", TransationBegin_TransationMode_name, TransationBegin_TransationMode_value)
-	proto.RegisterEnum("plan.DataControl_DclType", DataControl_DclType_name, DataControl_DclType_value)
-	proto.RegisterEnum("plan.DataDefinition_DdlType", DataDefinition_DdlType_name, DataDefinition_DdlType_value)
-	proto.RegisterType((*Type)(nil), "plan.Type")
-	proto.RegisterType((*Const)(nil), "plan.Const")
-	proto.RegisterType((*ParamRef)(nil), "plan.ParamRef")
-	proto.RegisterType((*VarRef)(nil), "plan.VarRef")
-	proto.RegisterType((*ColRef)(nil), "plan.ColRef")
-	proto.RegisterType((*CorrColRef)(nil), "plan.CorrColRef")
-	proto.RegisterType((*ExprList)(nil), "plan.ExprList")
-	proto.RegisterType((*TargetType)(nil), "plan.TargetType")
-	proto.RegisterType((*SubqueryRef)(nil), "plan.SubqueryRef")
-	proto.RegisterType((*ObjectRef)(nil), "plan.ObjectRef")
-	proto.RegisterType((*Function)(nil), "plan.Function")
-	proto.RegisterType((*Expr)(nil), "plan.Expr")
-	proto.RegisterType((*DefaultExpr)(nil), "plan.DefaultExpr")
-	proto.RegisterType((*ConstantValue)(nil), "plan.ConstantValue")
-	proto.RegisterType((*Decimal64)(nil), "plan.decimal64")
-	proto.RegisterType((*Decimal128)(nil), "plan.decimal128")
-	proto.RegisterType((*ColDef)(nil), "plan.ColDef")
-	proto.RegisterType((*IndexDef)(nil), "plan.IndexDef")
-	proto.RegisterType((*PrimaryKeyDef)(nil), "plan.PrimaryKeyDef")
-	proto.RegisterType((*Property)(nil), "plan.Property")
-	proto.RegisterType((*PropertiesDef)(nil), "plan.PropertiesDef")
-	proto.RegisterType((*TableDef)(nil), "plan.TableDef")
-	proto.RegisterType((*TableDef_DefType)(nil), "plan.TableDef.DefType")
-	proto.RegisterType((*Cost)(nil), "plan.Cost")
-	proto.RegisterType((*ColData)(nil), "plan.ColData")
-	proto.RegisterType((*RowsetData)(nil), "plan.RowsetData")
-	proto.RegisterType((*OrderBySpec)(nil), "plan.OrderBySpec")
-	proto.RegisterType((*WindowSpec)(nil), "plan.WindowSpec")
-	proto.RegisterType((*UpdateInfo)(nil), "plan.UpdateInfo")
-	proto.RegisterType((*AnalyzeInfo)(nil), "plan.AnalyzeInfo")
-	proto.RegisterType((*Node)(nil), "plan.Node")
-	proto.RegisterType((*DeleteTableCtx)(nil), "plan.DeleteTableCtx")
-	proto.RegisterType((*Query)(nil), "plan.Query")
-	proto.RegisterType((*TransationControl)(nil), "plan.TransationControl")
-	proto.RegisterType((*TransationBegin)(nil), "plan.TransationBegin")
-	proto.RegisterType((*TransationCommit)(nil), "plan.TransationCommit")
-	proto.RegisterType((*TransationRollback)(nil), "plan.TransationRollback")
-	proto.RegisterType((*Plan)(nil), "plan.Plan")
-	proto.RegisterType((*DataControl)(nil), "plan.DataControl")
-	proto.RegisterType((*DataDefinition)(nil), "plan.DataDefinition")
-	proto.RegisterType((*CreateDatabase)(nil), "plan.CreateDatabase")
-	proto.RegisterType((*AlterDatabase)(nil), "plan.AlterDatabase")
-	proto.RegisterType((*DropDatabase)(nil), "plan.DropDatabase")
-	proto.RegisterType((*CreateTable)(nil), "plan.CreateTable")
-	proto.RegisterType((*AlterTable)(nil), "plan.AlterTable")
-	proto.RegisterType((*DropTable)(nil), "plan.DropTable")
-	proto.RegisterType((*CreateIndex)(nil), "plan.CreateIndex")
-	proto.RegisterType((*AlterIndex)(nil), "plan.AlterIndex")
-	proto.RegisterType((*DropIndex)(nil), "plan.DropIndex")
-	proto.RegisterType((*TruncateTable)(nil), "plan.TruncateTable")
-	proto.RegisterType((*ShowVariables)(nil), "plan.ShowVariables")
-	proto.RegisterType((*SetVariables)(nil), "plan.SetVariables")
-	proto.RegisterType((*SetVariablesItem)(nil), "plan.SetVariablesItem")
-	proto.RegisterType((*Prepare)(nil), "plan.Prepare")
-	proto.RegisterType((*Execute)(nil), "plan.Execute")
-	proto.RegisterType((*Deallocate)(nil), "plan.Deallocate")
+type ShowVariables struct {
+	Global               bool     `protobuf:"varint,1,opt,name=global,proto3" json:"global,omitempty"`
+	Where                []*Expr  `protobuf:"bytes,2,rep,name=where,proto3" json:"where,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
-
-var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 4781 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x3a, 0x4d, 0x8f, 0x1b, 0x47,
-	0x76, 0xd3, 0xfc, 0x6c, 0x3e, 0x92, 0xa3, 0x52, 0x49, 0x96, 0x28, 0x5b, 0x96, 0x47, 0x6d, 0xcb,
-	0x3b, 0x96, 0xd7, 0xb2, 0x45, 0x8d, 0x27, 0xda, 0xc4, 0xfb, 0xd1, 0xe4, 0xb4, 0x66, 0xb8, 0xe2,
-	0x34, 0x67, 0x8b, 0x3d, 0x23, 0xcb, 0x8b, 0x80, 0x68, 0xb2, 0x9b, 0x54, 0x4b, 0x24, 0x9b, 0x69,
-	0x36, 0x47, 0x33, 0x3e, 0x6d, 0x10, 0x24, 0x08, 0x90, 0x43, 0x82, 0x20, 0x40, 0x92, 0xdb, 0x22,
-	0x40, 0x7e, 0xc0, 0x22, 0xbf, 0x21, 0xc0, 0x06, 0xb9, 0x04, 0xc8, 0x21, 0x87, 0x5c, 0x36, 0x9b,
-	0x9f, 0x90, 0x6b, 0x0e, 0xc1, 0x7b, 0x55, 0x4d, 0x36, 0x35, 0xe3, 0xdd, 0x85, 0x91, 0x0b, 0x51,
-	0xef, 0xb3, 0x5e, 0x55, 0xbd, 0xaf, 0xaa, 0x26, 0xc0, 0x6c, 0xec, 0x4e, 0x1f, 0xcc, 0xa2, 0x30,
-	0x0e, 0x79, 0x0e, 0xc7, 0x6f, 0x7f, 0x32, 0x0a, 0xe2, 0x17, 0x8b, 0xfe, 0x83, 0x41, 0x38, 0xf9,
-	0x74, 0x14, 0x8e, 0xc2, 0x4f, 0x89, 0xd8, 0x5f, 0x0c, 0x09, 0x22, 0x80, 0x46, 0x52, 0xc8, 0xf8,
-	0xd7, 0x3c, 0xe4, 0x9c, 0xf3, 0x99, 0xcf, 0xef, 0x42, 0x26, 0xf0, 0x6a, 0xda, 0x96, 0xb6, 0xbd,
-	0x59, 0xbf, 0xfa, 0x80, 0xd4, 0x22, 0x9e, 0x7e, 0x5a, 0x9e, 0xc8, 0x04, 0x1e, 0x7f, 0x1b, 0xf4,
-	0xe9, 0x62, 0x3c, 0x76, 0xfb, 0x63, 0xbf, 0x96, 0xd9, 0xd2, 0xb6, 0x75, 0xb1, 0x84, 0xf9, 0x75,
-	0xc8, 0xbf, 0x0e, 0xbc, 0xf8, 0x45, 0x2d, 0xbb, 0xa5, 0x6d, 0xe7, 0x85, 0x04, 0xf8, 0x6d, 0x28,
-	0xcd, 0x22, 0x7f, 0x10, 0xcc, 0x83, 0x70, 0x5a, 0xcb, 0x11, 0x65, 0x85, 0xe0, 0x1c, 0x72, 0xf3,
-	0xe0, 0x6b, 0xbf, 0x96, 0x27, 0x02, 0x8d, 0x51, 0xcf, 0x7c, 0xe0, 0x8e, 0xfd, 0x5a, 0x41, 0xea,
-	0x21, 0xc0, 0xf8, 0xc7, 0x1c, 0x14, 0xa4, 0x21, 0xbc, 0x08, 0x59, 0xd3, 0x7e, 0xce, 0x36, 0xb8,
-	0x0e, 0xb9, 0xae, 0x63, 0x0a, 0xa6, 0xe1, 0xa8, 0xd1, 0xe9, 0xb4, 0x19, 0xe0, 0xa8, 0x65, 0x3b,
-	0x8f, 0xd9, 0x75, 0x5e, 0x82, 0x7c, 0xcb, 0x76, 0x1e, 0xee, 0xb2, 0xb7, 0xd4, 0xf0, 0x51, 0x9d,
-	0xdd, 0x50, 0xc3, 0xdd, 0x1d, 0x76, 0x93, 0x03, 0x14, 0x90, 0xa1, 0xfe, 0x98, 0xd5, 0x10, 0x7d,
-	0x4c, 0x72, 0xb7, 0x10, 0x7d, 0x2c, 0x05, 0xdf, 0x4e, 0xc6, 0x8f, 0xea, 0xec, 0x9d, 0x64, 0xbc,
-	0xbb, 0xc3, 0x6e, 0xf3, 0x32, 0x14, 0x8f, 0x95, 0xec, 0xbb, 0x08, 0x3c, 0x69, 0x77, 0x4c, 0xe4,
-	0xba, 0xb3, 0x04, 0x76, 0x77, 0xd8, 0x7b, 0xbc, 0x0a, 0xa5, 0x3d, 0xab, 0xd9, 0x3a, 0x34, 0xdb,
-	0xbb, 0x3b, 0x6c, 0x8b, 0x6f, 0x02, 0x28, 0x10, 0x05, 0xef, 0x22, 0xaf, 0x82, 0x99, 0x81, 0xea,
-	0x4d, 0xfb, 0x79, 0xcb, 0x76, 0xd8, 0x3d, 0x5e, 0x01, 0xdd, 0xb4, 0x9f, 0x93, 0x1e, 0xf6, 0x21,
-	0x6a, 0x31, 0xed, 0xe7, 0xf6, 0xf1, 0x61, 0xc3, 0x12, 0xec, 0x3b, 0xb8, 0xc2, 0xe3, 0xe3, 0xd6,
-	0x1e, 0xdb, 0x26, 0xa3, 0x1b, 0x0f, 0x77, 0x3f, 0x63, 0x1f, 0xa9, 0xe1, 0xe3, 0x1d, 0x76, 0x5f,
-	0x0d, 0xbf, 0x57, 0x67, 0x1f, 0xcb, 0x61, 0xbd, 0xbe, 0xc3, 0xbe, 0xab, 0x86, 0x9f, 0xef, 0xb2,
-	0x4f, 0x50, 0xc1, 0x9e, 0xe9, 0x58, 0xac, 0x8e, 0x23, 0xa7, 0x75, 0x68, 0xb1, 0x47, 0x38, 0x23,
-	0xe2, 0x08, 0xda, 0xc1, 0x19, 0x71, 0xd4, 0x75, 0xcc, 0xc3, 0x23, 0xf6, 0x39, 0x12, 0x5b, 0xb6,
-	0x63, 0x89, 0x13, 0xb3, 0xcd, 0x76, 0xd1, 0x6a, 0xd3, 0x7e, 0x4e, 0x9c, 0x7f, 0x80, 0x1a, 0x9a,
-	0x07, 0xa6, 0x60, 0x5f, 0x20, 0xfa, 0xc4, 0x14, 0x04, 0x7c, 0x1f, 0xd1, 0x3f, 0xee, 0x76, 0x6c,
-	0xf6, 0x03, 0x5c, 0x56, 0xa3, 0x65, 0x9b, 0xe2, 0x39, 0x7b, 0x82, 0x6a, 0x4f, 0x4c, 0xa1, 0xc0,
-	0x7d, 0x34, 0xc9, 0x14, 0xc2, 0x7c, 0xce, 0xbe, 0xc2, 0x9d, 0x79, 0xd2, 0xb6, 0xbe, 0x6c, 0x1c,
-	0x3f, 0x79, 0x62, 0x09, 0xf6, 0x53, 0x92, 0x7a, 0xee, 0x58, 0xe6, 0x63, 0xe6, 0xa1, 0x62, 0x1a,
-	0x3f, 0xdc, 0x65, 0x3e, 0xca, 0x10, 0xc0, 0x86, 0x5c, 0x87, 0x6c, 0xd7, 0x6a, 0xb3, 0x5f, 0x6a,
-	0x1c, 0x20, 0xef, 0x1c, 0x1f, 0xb5, 0x2d, 0xf6, 0x2f, 0x9a, 0xf1, 0x27, 0x59, 0xc8, 0x37, 0xc3,
-	0xe9, 0x3c, 0xe6, 0x37, 0xa0, 0x10, 0xcc, 0xd1, 0x3b, 0xc9, 0xa5, 0x75, 0xa1, 0x20, 0x7e, 0x1d,
-	0x72, 0xc1, 0xa9, 0x3b, 0x26, 0xff, 0xcd, 0x1e, 0x6c, 0x08, 0x82, 0x10, 0xeb, 0x21, 0x16, 0x9d,
-	0x57, 0x43, 0xac, 0xa7, 0xb0, 0x73, 0xc4, 0xa2, 0xe3, 0x96, 0x10, 0x3b, 0x57, 0xd8, 0x3e, 0x62,
-	0xd1, 0x6b, 0x75, 0xc4, 0xf6, 0x15, 0x76, 0x81, 0x58, 0x74, 0xdb, 0x1c, 0x62, 0x17, 0x0a, 0x3b,
-	0x44, 0x6c, 0x71, 0x4b, 0xdb, 0xce, 0x20, 0x16, 0x21, 0xfe, 0x36, 0x14, 0x3d, 0x37, 0xf6, 0x91,
-	0xa0, 0xa3, 0x97, 0x1f, 0x6c, 0x88, 0x04, 0xc1, 0x0d, 0x28, 0xe3, 0x30, 0x0e, 0x26, 0x44, 0x2f,
-	0x29, 0x33, 0xd3, 0x48, 0xfe, 0x39, 0x54, 0x3c, 0x7f, 0x10, 0x4c, 0xdc, 0xf1, 0xee, 0x0e, 0x32,
-	0xc1, 0x96, 0xb6, 0x5d, 0xae, 0x5f, 0x91, 0x41, 0xbb, 0xa4, 0x1c, 0x6c, 0x88, 0x35, 0x36, 0xfe,
-	0x18, 0xaa, 0x0a, 0x7e, 0x58, 0x7f, 0x8c, 0x72, 0x65, 0x92, 0x63, 0x6b, 0x72, 0x0f, 0xeb, 0x8f,
-	0x0f, 0x36, 0xc4, 0x3a, 0x23, 0xff, 0x00, 0x2a, 0x38, 0xf7, 0x3c, 0x76, 0x27, 0x33, 0x14, 0xac,
-	0x28, 0xab, 0xd6, 0xb0, 0x8d, 0x22, 0xe4, 0x4f, 0xdd, 0xf1, 0xc2, 0x37, 0x6e, 0x83, 0x7e, 0xe4,
-	0x46, 0xee, 0x44, 0xf8, 0x43, 0xce, 0x20, 0x3b, 0x0b, 0xe7, 0x74, 0x08, 0x79, 0x81, 0x43, 0xa3,
-	0x0d, 0x85, 0x13, 0x37, 0x42, 0x1a, 0x87, 0xdc, 0xd4, 0x9d, 0xf8, 0x44, 0x2c, 0x09, 0x1a, 0xe3,
-	0xb9, 0xcd, 0xcf, 0xe7, 0xb1, 0x3f, 0x51, 0x19, 0x46, 0x41, 0x88, 0x1f, 0x8d, 0xc3, 0xbe, 0x3a,
-	0x23, 0x5d, 0x28, 0xc8, 0xb0, 0xa1, 0xd0, 0x0c, 0xc7, 0xa8, 0xed, 0x26, 0x14, 0x23, 0x7f, 0xdc,
-	0x5b, 0xcd, 0x56, 0x88, 0xfc, 0xf1, 0x51, 0x38, 0x47, 0xc2, 0x20, 0x94, 0x84, 0x8c, 0x24, 0x0c,
-	0x42, 0x22, 0x24, 0xf3, 0x67, 0x57, 0xf3, 0x1b, 0x0e, 0x40, 0x33, 0x8c, 0xa2, 0x6f, 0xad, 0xf3,
-	0x3a, 0xe4, 0x3d, 0x7f, 0xb6, 0xca, 0x83, 0x04, 0x18, 0xf7, 0x41, 0xb7, 0xce, 0x66, 0x51, 0x3b,
-	0x98, 0xc7, 0xfc, 0x0e, 0xe4, 0xc6, 0xc1, 0x3c, 0xae, 0x69, 0x5b, 0xd9, 0xed, 0x72, 0x1d, 0xe4,
-	0xee, 0x23, 0x55, 0x10, 0xde, 0xb8, 0x0f, 0xe0, 0xb8, 0xd1, 0xc8, 0x8f, 0x29, 0x2d, 0xdf, 0x86,
-	0x6c, 0x7c, 0x3e, 0xa3, 0xd9, 0x97, 0xcc, 0x48, 0x10, 0x88, 0x36, 0xfe, 0x47, 0x83, 0x72, 0x77,
-	0xd1, 0xff, 0xa3, 0x85, 0x1f, 0x9d, 0xa3, 0xbd, 0xdb, 0x2b, 0xee, 0xcd, 0xfa, 0x0d, 0xc9, 0x9d,
-	0xa2, 0xaf, 0x24, 0x71, 0x01, 0xd3, 0xd0, 0xf3, 0x7b, 0x81, 0x97, 0x2c, 0x00, 0xc1, 0x96, 0xc7,
-	0x37, 0x21, 0x13, 0xce, 0xd4, 0x96, 0x64, 0xc2, 0x19, 0xdf, 0x82, 0xfc, 0xe0, 0x45, 0x30, 0xf6,
-	0x28, 0x0a, 0xd6, 0xed, 0x95, 0x04, 0x7e, 0x0b, 0xf4, 0x28, 0x7c, 0xdd, 0x4b, 0xa5, 0xf2, 0x62,
-	0x14, 0xbe, 0xee, 0x06, 0x5f, 0xe3, 0x6e, 0xca, 0xe2, 0x02, 0x50, 0xe8, 0x36, 0xcd, 0xb6, 0x29,
-	0xd8, 0x06, 0x8e, 0xad, 0x2f, 0x5b, 0x5d, 0xa7, 0xcb, 0x34, 0x8c, 0x7c, 0xbb, 0xe3, 0xf4, 0x14,
-	0x9c, 0xe1, 0x05, 0xc8, 0xb4, 0x6c, 0x96, 0x45, 0x1e, 0xc4, 0xb7, 0x6c, 0x96, 0x4b, 0x12, 0x7e,
-	0x9e, 0x06, 0xed, 0x36, 0x2b, 0x18, 0xff, 0xae, 0x41, 0xa9, 0xd3, 0x7f, 0xe9, 0x0f, 0x62, 0x5c,
-	0x33, 0x7a, 0x8c, 0x1f, 0x9d, 0xfa, 0x11, 0x2d, 0x3b, 0x2b, 0x14, 0x84, 0x0b, 0xf1, 0xfa, 0x32,
-	0xce, 0x45, 0xc6, 0xeb, 0x13, 0xdf, 0xe0, 0x85, 0x3f, 0x71, 0x69, 0x71, 0xc8, 0x47, 0x10, 0x7a,
-	0x68, 0xd8, 0x7f, 0x49, 0xcb, 0xcb, 0x0a, 0x1c, 0xf2, 0xf7, 0xa0, 0x2c, 0x75, 0xf4, 0xc8, 0x3d,
-	0xf2, 0xb4, 0x17, 0x20, 0x51, 0x36, 0x3a, 0xe9, 0x4d, 0x28, 0x7a, 0x7d, 0x49, 0x2c, 0x10, 0xb1,
-	0xe0, 0xf5, 0x89, 0x80, 0x92, 0xa4, 0x55, 0x12, 0x8b, 0x4a, 0x92, 0x50, 0xc4, 0x70, 0x0b, 0xf4,
-	0xb0, 0xff, 0x52, 0x52, 0x75, 0xa2, 0x16, 0xc3, 0xfe, 0x4b, 0x24, 0x19, 0xff, 0xa5, 0x81, 0xfe,
-	0x64, 0x31, 0x1d, 0xc4, 0x58, 0x1a, 0xdf, 0x87, 0xdc, 0x70, 0x31, 0x1d, 0xa8, 0x73, 0x57, 0xa1,
-	0xbd, 0x5c, 0xb3, 0x20, 0x22, 0x7a, 0x92, 0x1b, 0x8d, 0xd0, 0x03, 0x2f, 0x78, 0x12, 0xe2, 0x8d,
-	0xbf, 0x54, 0x1a, 0x9f, 0x8c, 0xdd, 0x11, 0x26, 0x65, 0xbb, 0x63, 0x5b, 0x6c, 0x63, 0x99, 0xd0,
-	0x6d, 0xb3, 0xcd, 0x34, 0x3a, 0x1a, 0xc7, 0x6c, 0xb4, 0x2d, 0x96, 0x41, 0xca, 0x49, 0xa7, 0x6d,
-	0x3a, 0xad, 0xb6, 0xc5, 0x72, 0x92, 0x22, 0x5a, 0x4d, 0x87, 0xe9, 0x9c, 0x41, 0xe5, 0x48, 0x74,
-	0xf6, 0x8e, 0x9b, 0x56, 0xcf, 0x3e, 0x6e, 0xb7, 0x19, 0xe3, 0xd7, 0xe0, 0xca, 0x12, 0xd3, 0x91,
-	0xc8, 0x2d, 0x14, 0x39, 0x31, 0x85, 0x29, 0xf6, 0xd9, 0x8f, 0x30, 0x43, 0x9b, 0xfb, 0xfb, 0xec,
-	0x67, 0x58, 0x9f, 0xb3, 0xcf, 0x5a, 0x36, 0xfb, 0x59, 0xc6, 0xf8, 0x55, 0x06, 0x72, 0x68, 0xe0,
-	0x6f, 0x76, 0x6b, 0xfe, 0x0e, 0x68, 0x03, 0x3a, 0xb9, 0x72, 0xbd, 0x2c, 0x69, 0x94, 0xd4, 0x0f,
-	0x36, 0x84, 0x86, 0xab, 0xd6, 0xa4, 0x7f, 0x96, 0xeb, 0x9b, 0x92, 0x98, 0x24, 0x1b, 0xa4, 0xcf,
-	0xf8, 0x6d, 0xd0, 0x4e, 0x95, 0xb3, 0x56, 0x24, 0x5d, 0xa6, 0x1b, 0xa4, 0x9e, 0xf2, 0x2d, 0xc8,
-	0x0e, 0x42, 0x99, 0xbc, 0x97, 0x74, 0x19, 0xec, 0x07, 0x1b, 0x02, 0x49, 0xa8, 0x7f, 0x48, 0xc7,
-	0xba, 0xd4, 0x9f, 0x9c, 0x0a, 0x6a, 0x18, 0xf2, 0x7b, 0x90, 0x9d, 0x2f, 0xfa, 0x74, 0xb6, 0xe5,
-	0xa4, 0x53, 0x4a, 0xc5, 0x18, 0xaa, 0x99, 0x2f, 0xfa, 0xfc, 0x43, 0xc8, 0x0d, 0xc2, 0x28, 0xa2,
-	0x53, 0x5e, 0x26, 0xd9, 0x55, 0x6a, 0xc1, 0x62, 0x80, 0x74, 0xbe, 0x05, 0x5a, 0x4c, 0x69, 0x7e,
-	0xc9, 0xb4, 0x8a, 0x7e, 0x9c, 0x30, 0xe6, 0x1f, 0xa8, 0x84, 0x01, 0x69, 0x9b, 0x92, 0x74, 0x82,
-	0x7a, 0x90, 0xda, 0x28, 0x40, 0xce, 0x3f, 0x9b, 0x45, 0xc6, 0x08, 0xca, 0x7b, 0xfe, 0xd0, 0x5d,
-	0x8c, 0x63, 0xda, 0xe8, 0xeb, 0x90, 0xf7, 0xcf, 0x64, 0xba, 0xc1, 0xb4, 0x29, 0x01, 0xfe, 0x91,
-	0x4a, 0xd5, 0x6a, 0x93, 0xaf, 0xa5, 0x36, 0xd9, 0x9d, 0xc6, 0x27, 0x48, 0x12, 0x92, 0x03, 0x7d,
-	0x3d, 0x98, 0xf7, 0xa8, 0x92, 0x66, 0x93, 0x4a, 0x6a, 0x2f, 0xc6, 0x63, 0xe3, 0x9f, 0xb2, 0x50,
-	0x5d, 0x93, 0xe0, 0xef, 0x42, 0x69, 0x31, 0x7d, 0x35, 0x0d, 0x5f, 0x4f, 0x7b, 0xa7, 0x32, 0x5f,
-	0x1e, 0x6c, 0x08, 0x5d, 0xa1, 0x4e, 0xf8, 0x2d, 0x28, 0x06, 0xd3, 0x78, 0x77, 0xa7, 0x77, 0xba,
-	0xac, 0xbe, 0x05, 0x42, 0x9c, 0xf0, 0x3a, 0x94, 0x97, 0xa5, 0xaa, 0x77, 0xaa, 0x4e, 0xf7, 0x92,
-	0x82, 0x06, 0x4b, 0xe0, 0x24, 0x55, 0x05, 0x1f, 0xd6, 0x1f, 0xf7, 0x92, 0x23, 0xbf, 0xac, 0x9a,
-	0x95, 0x57, 0xd0, 0x09, 0x7f, 0x07, 0xf4, 0x45, 0x62, 0x46, 0x5e, 0x15, 0xeb, 0xe2, 0x42, 0xd9,
-	0xf1, 0x2e, 0x94, 0x86, 0xe3, 0xd0, 0x8d, 0x1f, 0xd5, 0x7b, 0xa7, 0xe4, 0x03, 0x58, 0xb4, 0x75,
-	0x85, 0x5a, 0x91, 0x49, 0xb8, 0xa8, 0x7a, 0x05, 0x5d, 0xa1, 0x4e, 0xf8, 0x4d, 0x28, 0x60, 0x99,
-	0xee, 0x9d, 0x2e, 0xcb, 0x7a, 0x1e, 0xe1, 0x13, 0xfe, 0x1e, 0x00, 0x0e, 0x9c, 0x60, 0x82, 0xc4,
-	0xa4, 0xa6, 0x97, 0x12, 0xdc, 0x09, 0xbf, 0x0b, 0x65, 0x2c, 0xa5, 0x5d, 0x2c, 0xa5, 0xbd, 0x53,
-	0x3a, 0x69, 0xe4, 0x80, 0x25, 0x92, 0xec, 0x9e, 0xc7, 0x51, 0x30, 0x1d, 0xf5, 0x4e, 0xa9, 0x70,
-	0x63, 0x43, 0x52, 0x94, 0x18, 0x9a, 0xb9, 0x1f, 0x86, 0xe3, 0xde, 0x29, 0x95, 0x66, 0xec, 0x4a,
-	0xf2, 0x08, 0x9f, 0x34, 0xae, 0x40, 0x75, 0x90, 0x3e, 0x23, 0xe3, 0x16, 0x94, 0x96, 0x7b, 0xc8,
-	0x2b, 0xa0, 0xb9, 0x2a, 0x6b, 0x6a, 0xae, 0xb1, 0x0d, 0xb0, 0xda, 0xa8, 0x75, 0x1a, 0x42, 0x49,
-	0x2e, 0xd5, 0xfa, 0xc6, 0x7f, 0x68, 0x54, 0x75, 0xf7, 0xbe, 0xa1, 0x86, 0x7f, 0x00, 0x59, 0x77,
-	0x3c, 0x22, 0xf6, 0xcd, 0x3a, 0x4f, 0x7c, 0x6b, 0x32, 0x8b, 0xfc, 0xf9, 0x5c, 0x06, 0xb9, 0x3b,
-	0x1e, 0x25, 0x29, 0x20, 0x7b, 0x79, 0x0a, 0xf8, 0x18, 0x8a, 0x9e, 0x74, 0x63, 0x75, 0xb0, 0x2a,
-	0xd2, 0x52, 0xbe, 0x2d, 0x12, 0x0e, 0x5e, 0x83, 0xe2, 0x2c, 0x0a, 0x26, 0x6e, 0x74, 0x2e, 0xbb,
-	0x32, 0x91, 0x80, 0xe8, 0xfe, 0xb3, 0x57, 0x81, 0x77, 0x96, 0x5c, 0x27, 0x08, 0x40, 0xfe, 0x41,
-	0x38, 0x99, 0xf8, 0xd3, 0x58, 0xa5, 0xe8, 0x04, 0x34, 0xfe, 0x56, 0x03, 0xbd, 0x35, 0xf5, 0xfc,
-	0x33, 0x5c, 0xdb, 0xfd, 0x74, 0x35, 0xad, 0xc9, 0xf9, 0x13, 0xa2, 0x1c, 0xac, 0xec, 0x4d, 0xf6,
-	0x21, 0x93, 0xda, 0x87, 0x77, 0xa0, 0x84, 0x4d, 0x02, 0x8e, 0xe7, 0xb5, 0xec, 0x56, 0x76, 0xbb,
-	0x24, 0xf4, 0x41, 0x38, 0xc6, 0x6c, 0x3f, 0x37, 0x1e, 0x40, 0x69, 0xa9, 0x02, 0xbb, 0xdc, 0x96,
-	0x7d, 0x62, 0xb6, 0xda, 0x7b, 0x6c, 0x03, 0x81, 0xaf, 0x3a, 0xb6, 0x75, 0x68, 0x1e, 0x31, 0x0d,
-	0x8b, 0x5e, 0xa3, 0xdb, 0x62, 0x19, 0xe3, 0x1e, 0x54, 0x8f, 0xe4, 0xa2, 0x9e, 0xfa, 0xe7, 0x68,
-	0xdd, 0x75, 0xc8, 0x4b, 0xcd, 0x1a, 0x69, 0x96, 0x80, 0x51, 0x07, 0xfd, 0x28, 0x0a, 0x67, 0x7e,
-	0x14, 0x9f, 0x63, 0x65, 0x7b, 0xe5, 0x9f, 0xab, 0xa3, 0xc1, 0x21, 0xca, 0xac, 0xe2, 0xbe, 0xa4,
-	0x42, 0xdc, 0xf8, 0x21, 0xaa, 0x26, 0x99, 0xc0, 0x9f, 0xa3, 0xea, 0x07, 0x00, 0xb3, 0x25, 0x42,
-	0x35, 0x2a, 0x49, 0xae, 0x55, 0xca, 0x45, 0x8a, 0xc3, 0xf8, 0xe3, 0x0c, 0xe8, 0x0e, 0x5e, 0x03,
-	0xbf, 0xc9, 0x23, 0xb6, 0x30, 0x19, 0x8e, 0x93, 0x4a, 0xb5, 0x4a, 0xbb, 0x7b, 0x58, 0xcb, 0x90,
-	0xc2, 0xef, 0x43, 0xce, 0xf3, 0x87, 0x72, 0x9b, 0xca, 0x49, 0xeb, 0x92, 0xe8, 0xc4, 0x53, 0xa7,
-	0xad, 0x26, 0x9e, 0xb7, 0xff, 0x5a, 0x83, 0xa2, 0xc2, 0xf0, 0x7b, 0x90, 0x99, 0xbd, 0x52, 0x75,
-	0xe4, 0x5a, 0x62, 0x62, 0x6a, 0x9b, 0x0e, 0x36, 0x44, 0x66, 0xf6, 0x8a, 0x1b, 0x90, 0x45, 0x2f,
-	0xc8, 0xa4, 0x53, 0x68, 0x72, 0x94, 0x98, 0xb1, 0xd1, 0x2b, 0x3e, 0x5f, 0x5b, 0x75, 0x76, 0x5d,
-	0x65, 0x6a, 0x7b, 0x30, 0x30, 0x57, 0x8c, 0x8d, 0x3c, 0x64, 0x3d, 0x7f, 0x68, 0x44, 0x90, 0x6b,
-	0x86, 0xf3, 0x18, 0x97, 0x3f, 0x70, 0x23, 0x79, 0x93, 0xd6, 0x04, 0x8d, 0xd1, 0xdf, 0xa2, 0xf0,
-	0x35, 0x35, 0x48, 0x19, 0x42, 0x27, 0x20, 0x1e, 0xd1, 0xd4, 0x93, 0x09, 0x4f, 0x13, 0x38, 0xa4,
-	0x0b, 0x70, 0xec, 0x46, 0xd2, 0xed, 0x35, 0x21, 0x01, 0xc4, 0xc6, 0x61, 0xac, 0x6e, 0x1d, 0x9a,
-	0x90, 0x80, 0xf1, 0x0b, 0x0d, 0x8a, 0xb8, 0x8b, 0x6e, 0xec, 0xa2, 0xb3, 0x61, 0x17, 0x36, 0x08,
-	0x17, 0xd3, 0x58, 0x35, 0xab, 0xd8, 0x96, 0x35, 0x11, 0xe6, 0xef, 0x02, 0x60, 0x06, 0x57, 0x54,
-	0xd9, 0xf0, 0x95, 0x10, 0x23, 0xc9, 0xe8, 0x4a, 0x8b, 0xf1, 0x58, 0xee, 0xbe, 0x2e, 0x24, 0x80,
-	0xb6, 0x05, 0x8f, 0xea, 0xb5, 0xdc, 0x56, 0x16, 0x5b, 0xf7, 0xe0, 0x51, 0x9d, 0x30, 0xbb, 0x3b,
-	0xb5, 0xfc, 0x56, 0x16, 0x5b, 0xa5, 0x60, 0x77, 0x07, 0x31, 0xc3, 0x47, 0xf5, 0x5a, 0x61, 0x2b,
-	0xbb, 0x9d, 0x11, 0x38, 0x24, 0xcc, 0xee, 0x4e, 0xad, 0xb8, 0x95, 0xc5, 0x15, 0x0d, 0x65, 0x96,
-	0x99, 0xd7, 0x74, 0x72, 0x52, 0x6d, 0x6e, 0x3c, 0x03, 0x10, 0xe1, 0xeb, 0xb9, 0x1f, 0x93, 0xd5,
-	0x1f, 0x2e, 0x9b, 0x32, 0x2d, 0x7d, 0x34, 0xc9, 0xc1, 0x2f, 0x9b, 0xb4, 0xbb, 0x6b, 0x0e, 0x54,
-	0x5d, 0x39, 0x90, 0x1b, 0xbb, 0xd2, 0x83, 0x8c, 0xff, 0xd4, 0xa0, 0xdc, 0x89, 0x3c, 0x3f, 0x6a,
-	0x9c, 0x77, 0x67, 0x3e, 0x75, 0x47, 0x58, 0x10, 0xd7, 0x7b, 0x0c, 0xd9, 0x1d, 0xf9, 0xb2, 0x05,
-	0xc1, 0xe8, 0x1c, 0xbb, 0x58, 0xd9, 0x55, 0x3c, 0xac, 0x10, 0xfc, 0x21, 0xe4, 0x86, 0x63, 0x77,
-	0x44, 0x27, 0xb3, 0x59, 0x7f, 0x57, 0x35, 0x60, 0x2b, 0xf5, 0xc9, 0x18, 0x7b, 0x2b, 0x41, 0xac,
-	0xc6, 0x4f, 0x97, 0xf3, 0x53, 0xc3, 0x85, 0xed, 0x6a, 0xb7, 0x29, 0x1f, 0x2a, 0xf6, 0xac, 0x6e,
-	0x93, 0x69, 0xfc, 0x0a, 0x94, 0xb1, 0x51, 0xea, 0xf6, 0x9e, 0xb4, 0x44, 0xd7, 0x61, 0x19, 0xea,
-	0x7f, 0x09, 0xd1, 0x36, 0xbb, 0x8e, 0x6c, 0xb9, 0x8e, 0xed, 0xd6, 0x4f, 0x8e, 0x2d, 0xa6, 0xaf,
-	0xb5, 0x69, 0x0c, 0x7b, 0x39, 0x78, 0x16, 0x4c, 0xbd, 0xf0, 0x35, 0x2d, 0xee, 0x13, 0xa8, 0xcc,
-	0xdc, 0x28, 0x0e, 0xd0, 0xd6, 0x5e, 0xff, 0xfc, 0x92, 0xcb, 0x44, 0x79, 0x49, 0x6f, 0x9c, 0xf3,
-	0xef, 0x82, 0x1e, 0xa2, 0x69, 0xc8, 0x2a, 0xb7, 0xf0, 0xea, 0x85, 0x15, 0x89, 0x62, 0x28, 0x01,
-	0x74, 0xe1, 0xb1, 0xef, 0x7a, 0xea, 0x0a, 0x43, 0x63, 0x3c, 0x56, 0xdc, 0x0e, 0xf9, 0x86, 0x83,
-	0x43, 0xe3, 0x9f, 0x35, 0x80, 0xe3, 0x19, 0xd6, 0xb0, 0xd6, 0x74, 0x18, 0x62, 0x9f, 0x30, 0x8b,
-	0x82, 0xde, 0x2a, 0xe1, 0x14, 0x66, 0x51, 0xf0, 0xd4, 0x3f, 0xe7, 0x77, 0xa0, 0xac, 0x08, 0xbd,
-	0x24, 0x04, 0xe9, 0x15, 0x08, 0x89, 0x2d, 0xef, 0x0c, 0x5b, 0xe2, 0x17, 0x81, 0xe7, 0x93, 0xa4,
-	0xbc, 0x76, 0x14, 0x11, 0x46, 0xd1, 0xbb, 0x50, 0x59, 0xd0, 0x0c, 0x3d, 0x37, 0x8e, 0xa3, 0x39,
-	0xb9, 0x62, 0x49, 0x94, 0x25, 0xce, 0x44, 0x14, 0x76, 0xdc, 0x61, 0xfc, 0xc2, 0x8f, 0x14, 0x47,
-	0x9e, 0x38, 0x80, 0x50, 0x4b, 0x06, 0x24, 0xf5, 0x68, 0x71, 0x73, 0xf2, 0xd4, 0x92, 0x00, 0x44,
-	0xd1, 0xda, 0xe7, 0x78, 0x9b, 0x28, 0x9b, 0x53, 0x77, 0x7c, 0xfe, 0xb5, 0x5c, 0xc8, 0xbb, 0x00,
-	0xc1, 0x74, 0xb6, 0x88, 0x7b, 0x18, 0xa3, 0xaa, 0x02, 0x96, 0x08, 0x83, 0x7e, 0x4b, 0x13, 0x2e,
-	0xe2, 0x25, 0x5d, 0xd6, 0x44, 0x90, 0x28, 0x62, 0x58, 0xca, 0x53, 0xbc, 0x67, 0x53, 0xf2, 0x78,
-	0x25, 0x4a, 0xc9, 0x13, 0x3d, 0x97, 0x96, 0x27, 0x86, 0xf7, 0xa1, 0x8a, 0x65, 0xbf, 0x87, 0x75,
-	0x7b, 0x31, 0xf1, 0x3d, 0x0a, 0xf9, 0xac, 0xbc, 0x6b, 0x37, 0x15, 0x0e, 0xb5, 0x4c, 0xfc, 0x49,
-	0x18, 0x9d, 0x4b, 0x2d, 0x05, 0xa9, 0x45, 0xa2, 0xe8, 0xe6, 0xf5, 0x17, 0x15, 0xc8, 0xd9, 0xa1,
-	0xe7, 0xf3, 0xcf, 0xa0, 0x44, 0x17, 0xbd, 0xf8, 0x7c, 0xe6, 0xab, 0x52, 0xa6, 0x92, 0x1a, 0x92,
-	0xe9, 0x87, 0x52, 0xab, 0x3e, 0x55, 0xa3, 0x6f, 0xbe, 0x1a, 0xde, 0xc1, 0x20, 0x9c, 0xc7, 0xeb,
-	0x25, 0x1b, 0x93, 0x9e, 0x20, 0x3c, 0x39, 0x65, 0x14, 0xe2, 0x1d, 0xa5, 0x47, 0x0d, 0x6b, 0xee,
-	0x12, 0xa7, 0x94, 0x74, 0xba, 0x08, 0xbf, 0x0d, 0x3a, 0x5d, 0x20, 0x23, 0x7f, 0x4a, 0xe7, 0x96,
-	0x17, 0x4b, 0x18, 0xad, 0x7e, 0x19, 0x06, 0x53, 0x69, 0x75, 0xe1, 0x82, 0xd5, 0x3f, 0x0e, 0x83,
-	0x29, 0x45, 0x9e, 0x8e, 0x5c, 0x64, 0xf5, 0xfb, 0x50, 0x0c, 0xa7, 0x72, 0xde, 0xe2, 0x85, 0x79,
-	0x0b, 0xe1, 0x94, 0xa6, 0xfc, 0x18, 0xca, 0xc3, 0x60, 0x1c, 0xfb, 0x91, 0x64, 0xd4, 0x2f, 0x30,
-	0x82, 0x24, 0x13, 0xf3, 0x3d, 0xd0, 0x47, 0x51, 0xb8, 0x98, 0x61, 0xd0, 0x94, 0x2e, 0x70, 0x16,
-	0x89, 0xd6, 0x38, 0xc7, 0x55, 0xd3, 0x10, 0x5b, 0xb3, 0xb9, 0x8f, 0x6d, 0xfa, 0x85, 0x55, 0x27,
-	0xf4, 0xae, 0x4f, 0x5a, 0xdd, 0xd1, 0x48, 0xce, 0x5f, 0xbe, 0xa8, 0xd5, 0x1d, 0x8d, 0x68, 0xf2,
-	0x74, 0xc4, 0x56, 0x7e, 0x6b, 0xc4, 0x3e, 0x04, 0x15, 0x14, 0xbd, 0x60, 0x3a, 0x0c, 0x6b, 0xd5,
-	0x74, 0x2b, 0xbc, 0x8a, 0x51, 0x01, 0x8b, 0x55, 0xbc, 0x7e, 0x0c, 0xfa, 0xeb, 0x60, 0xda, 0x9b,
-	0xcf, 0xfc, 0x41, 0x6d, 0x33, 0xcd, 0xbf, 0xca, 0x32, 0xa2, 0xf8, 0x3a, 0x98, 0x52, 0xba, 0xd9,
-	0x82, 0xfc, 0x38, 0x98, 0x04, 0x71, 0xed, 0xca, 0xc5, 0x47, 0x00, 0x22, 0x70, 0x03, 0x0a, 0xe1,
-	0x70, 0x88, 0xeb, 0x67, 0x17, 0x58, 0x14, 0x85, 0x7f, 0x0c, 0xa5, 0x18, 0x13, 0x7b, 0xcf, 0xf3,
-	0x87, 0xb5, 0xab, 0x97, 0xe6, 0x7b, 0x3d, 0x4e, 0xda, 0x88, 0x6d, 0xc0, 0x9b, 0x71, 0x2f, 0xf2,
-	0x87, 0x35, 0x7e, 0xf9, 0x25, 0xb8, 0x10, 0xf6, 0x5f, 0x0a, 0x7f, 0x88, 0x8b, 0x8f, 0xa8, 0xa2,
-	0xf4, 0x3c, 0x37, 0x76, 0x6b, 0xd7, 0xd2, 0x8b, 0x59, 0x95, 0x1a, 0x01, 0xd1, 0xaa, 0xec, 0xbc,
-	0x0f, 0x55, 0xff, 0x2c, 0x8e, 0xdc, 0x5e, 0x38, 0xc3, 0x0c, 0x39, 0xaf, 0x5d, 0xa7, 0xc4, 0x53,
-	0x21, 0x64, 0x47, 0xe2, 0xf8, 0x0f, 0xe0, 0x8a, 0xe7, 0x8f, 0xfd, 0xd8, 0x27, 0xeb, 0xe6, 0xcd,
-	0xf8, 0xac, 0xf6, 0x16, 0x9d, 0xc4, 0xf5, 0xa4, 0x15, 0x5d, 0x12, 0x9b, 0xf1, 0x99, 0x78, 0x93,
-	0x19, 0xb3, 0x57, 0x3f, 0x98, 0x7a, 0xe8, 0x17, 0xb1, 0x3b, 0x9a, 0xd7, 0x6e, 0x90, 0x8f, 0x97,
-	0x15, 0xce, 0x71, 0x47, 0x73, 0xbe, 0x03, 0x15, 0x57, 0xa6, 0x1e, 0x79, 0x70, 0x37, 0xd3, 0xad,
-	0x6e, 0x2a, 0x29, 0x89, 0xb2, 0xbb, 0x02, 0x8c, 0xbf, 0xcf, 0x82, 0x9e, 0xc4, 0x2d, 0x3d, 0x46,
-	0xdb, 0x4f, 0xed, 0xce, 0x33, 0x9b, 0x6d, 0x60, 0x3d, 0x39, 0x31, 0xdb, 0xc7, 0x56, 0xaf, 0xdb,
-	0x34, 0x6d, 0xf9, 0xbe, 0x42, 0x77, 0x7b, 0x09, 0x67, 0xf8, 0x55, 0xa8, 0x3e, 0x39, 0xb6, 0x9b,
-	0x4e, 0xab, 0x63, 0x4b, 0x54, 0x16, 0x51, 0xd6, 0x97, 0xb2, 0xcc, 0x48, 0x54, 0x0e, 0x51, 0x87,
-	0xa6, 0x63, 0x89, 0x56, 0x82, 0xca, 0xe3, 0x2c, 0x47, 0xa2, 0xf3, 0x63, 0xab, 0xe9, 0x30, 0xe0,
-	0x6f, 0xc1, 0xd5, 0xa5, 0x48, 0xa2, 0x8e, 0x95, 0xb1, 0x60, 0x25, 0x62, 0xec, 0x3a, 0x2a, 0x11,
-	0x56, 0xf3, 0x58, 0x74, 0x5b, 0x27, 0x56, 0xaf, 0xe9, 0x58, 0xec, 0x2d, 0x7a, 0xb1, 0x6f, 0xd9,
-	0x4f, 0xd9, 0x0d, 0x5e, 0x85, 0x12, 0x8e, 0xa4, 0xf6, 0x9b, 0x54, 0x2a, 0xf7, 0xf7, 0xd9, 0x1d,
-	0x7a, 0x88, 0x6e, 0x75, 0x9d, 0x96, 0xdd, 0x74, 0xd8, 0x7b, 0x58, 0x0d, 0x9f, 0xb4, 0xda, 0x8e,
-	0x25, 0xd8, 0x16, 0xbd, 0x29, 0x77, 0x5a, 0x36, 0xbb, 0x4b, 0xcf, 0x12, 0xe6, 0xe1, 0x51, 0xdb,
-	0x62, 0x06, 0x69, 0xec, 0x08, 0x87, 0xbd, 0x4f, 0x2f, 0xdc, 0x36, 0xda, 0xf1, 0x01, 0x2a, 0xa7,
-	0x61, 0xcf, 0x6c, 0xb7, 0xd9, 0xbd, 0x54, 0x4d, 0xfd, 0x10, 0xc7, 0xcf, 0x5a, 0xf6, 0x5e, 0xe7,
-	0x19, 0xfb, 0x0e, 0xb2, 0x35, 0x44, 0xc7, 0xdc, 0x6b, 0x62, 0xe9, 0xa5, 0xe7, 0xf4, 0xee, 0x51,
-	0xbb, 0xe5, 0xb0, 0x8f, 0x90, 0x6b, 0xdf, 0x74, 0x0e, 0x2c, 0xc1, 0xee, 0xd3, 0xc3, 0x7c, 0xb7,
-	0x6b, 0x09, 0x87, 0xd5, 0xe5, 0x27, 0x03, 0x1a, 0x3f, 0x22, 0xad, 0x47, 0xf4, 0x90, 0xbe, 0x83,
-	0xe3, 0x3d, 0xab, 0x6d, 0x39, 0x16, 0xfb, 0xdc, 0x78, 0x09, 0x7a, 0x92, 0x9c, 0xe4, 0xd7, 0x06,
-	0xdb, 0x12, 0xb2, 0x07, 0x68, 0x5b, 0x4f, 0x1c, 0xa6, 0x21, 0x52, 0xb4, 0xf6, 0x0f, 0xb0, 0xfa,
-	0x97, 0x20, 0xdf, 0x39, 0xc6, 0xe5, 0x65, 0x69, 0x21, 0xd6, 0x61, 0x8b, 0xe5, 0x70, 0x64, 0xda,
-	0x4e, 0x8b, 0xe5, 0x69, 0xa1, 0x2d, 0x7b, 0xbf, 0x6d, 0xb1, 0x02, 0x62, 0x0f, 0x4d, 0xf1, 0x94,
-	0x15, 0xe9, 0xdd, 0xfc, 0xe8, 0xa8, 0xfd, 0x9c, 0xe9, 0xc6, 0x36, 0x14, 0xcd, 0xd1, 0xe8, 0x10,
-	0xb3, 0xbc, 0x0e, 0xb9, 0x27, 0xc7, 0xed, 0xb6, 0x7c, 0x5e, 0x6b, 0x74, 0x1c, 0xa7, 0x73, 0x28,
-	0x2f, 0x0f, 0x4e, 0xe7, 0x88, 0x65, 0x8c, 0x3f, 0xd7, 0x60, 0x73, 0xdd, 0x5d, 0xf9, 0x0d, 0x50,
-	0x8f, 0x56, 0x49, 0xb9, 0x56, 0x4f, 0x58, 0x35, 0x28, 0xc6, 0x7d, 0xba, 0xa3, 0xa8, 0xa6, 0x28,
-	0x01, 0xb9, 0x01, 0x95, 0xc5, 0xdc, 0x97, 0x6a, 0x9e, 0x2e, 0x8b, 0xf5, 0x1a, 0x8e, 0x6f, 0x41,
-	0x79, 0xe0, 0x4e, 0x9d, 0x68, 0x31, 0x1d, 0xb8, 0xb1, 0xac, 0x6e, 0xba, 0x48, 0xa3, 0x8c, 0xbf,
-	0xca, 0x40, 0xfe, 0x27, 0x0b, 0x3f, 0x3a, 0xe7, 0xbb, 0x50, 0x9a, 0xc7, 0x93, 0x38, 0x5d, 0x99,
-	0x6e, 0x49, 0xcf, 0x27, 0xfa, 0x83, 0x6e, 0xec, 0xc6, 0x3e, 0xde, 0xca, 0x64, 0x7d, 0x42, 0x5e,
-	0xf2, 0x78, 0xea, 0x90, 0xfd, 0x99, 0x6c, 0x06, 0xf3, 0x42, 0x02, 0x98, 0xa2, 0xb0, 0x4c, 0x25,
-	0x37, 0x08, 0x58, 0x55, 0x0b, 0x21, 0x09, 0x98, 0xa2, 0x66, 0x6e, 0xe4, 0x4e, 0xe6, 0x97, 0x14,
-	0x26, 0x45, 0xc1, 0x9a, 0xf4, 0xc2, 0x77, 0x31, 0x3e, 0x93, 0x5e, 0x62, 0x09, 0x1b, 0xcf, 0xa0,
-	0xba, 0x66, 0xd2, 0x7a, 0xe8, 0xe1, 0x69, 0x59, 0x6d, 0x0c, 0x10, 0x2d, 0xe5, 0x28, 0x99, 0x94,
-	0x73, 0x64, 0x53, 0x4e, 0x93, 0xc3, 0x73, 0x3c, 0xb4, 0xc4, 0xbe, 0xc5, 0xf2, 0xc6, 0x3f, 0x64,
-	0xe0, 0xaa, 0x13, 0xb9, 0xd3, 0x39, 0xb5, 0x9e, 0xcd, 0x70, 0x1a, 0x47, 0xe1, 0x98, 0xff, 0x3e,
-	0xe8, 0xf1, 0x60, 0x9c, 0xde, 0x9d, 0xf7, 0x54, 0xb2, 0x7c, 0x93, 0xf5, 0x81, 0x33, 0x18, 0xd3,
-	0x1e, 0x15, 0x63, 0x39, 0xe0, 0x9f, 0x40, 0xbe, 0xef, 0x8f, 0x82, 0xa9, 0xba, 0xf0, 0xbc, 0xf5,
-	0xa6, 0x60, 0x03, 0x89, 0xf4, 0x4a, 0x80, 0x03, 0xfe, 0x19, 0x14, 0xf0, 0x02, 0x1c, 0x24, 0xa5,
-	0xfd, 0xc6, 0xc5, 0x89, 0x90, 0x7a, 0xb0, 0x21, 0x14, 0x1f, 0xdf, 0x05, 0x3d, 0x0a, 0xc7, 0xe3,
-	0xbe, 0x3b, 0x78, 0xa5, 0xee, 0xe7, 0xb5, 0x37, 0x65, 0x84, 0xa2, 0x1f, 0x6c, 0x88, 0x25, 0xaf,
-	0xf1, 0x00, 0x8a, 0xca, 0x58, 0xfa, 0x98, 0x63, 0xed, 0xb7, 0xd4, 0xde, 0x35, 0x3b, 0x87, 0x87,
-	0x2d, 0xdc, 0xbb, 0x0a, 0xe8, 0xa2, 0xd3, 0x6e, 0x37, 0xcc, 0xe6, 0x53, 0x96, 0x69, 0xe8, 0x50,
-	0x70, 0xe9, 0xed, 0xcd, 0xf8, 0x33, 0x0d, 0xae, 0xbc, 0xb1, 0x00, 0xfe, 0x18, 0x72, 0x93, 0xd0,
-	0x4b, 0xb6, 0xe7, 0x83, 0x4b, 0x57, 0x99, 0x82, 0x31, 0x52, 0x04, 0x49, 0x18, 0xdf, 0x83, 0xcd,
-	0x75, 0x7c, 0xea, 0x7d, 0xb4, 0x0a, 0x25, 0x61, 0x99, 0x7b, 0xbd, 0x8e, 0xdd, 0x7e, 0x2e, 0x73,
-	0x28, 0x81, 0xcf, 0x44, 0xcb, 0xb1, 0x58, 0xc6, 0xf8, 0x29, 0xb0, 0x37, 0x37, 0x86, 0xef, 0xc3,
-	0x95, 0x41, 0x38, 0x99, 0x8d, 0x7d, 0xea, 0xc7, 0x53, 0x47, 0x76, 0xe7, 0x92, 0x9d, 0x54, 0x6c,
-	0x74, 0x62, 0x9b, 0x83, 0x35, 0xd8, 0xf8, 0x43, 0xe0, 0x17, 0x77, 0xf0, 0xff, 0x4f, 0xfd, 0x2f,
-	0x34, 0xc8, 0x1d, 0x8d, 0xdd, 0x29, 0x7f, 0x1f, 0xf2, 0xf4, 0x60, 0xa9, 0x6e, 0x47, 0xe5, 0x54,
-	0xdc, 0xa1, 0x5b, 0x10, 0x8d, 0x7f, 0x0c, 0xd9, 0x78, 0x30, 0x56, 0x3e, 0x74, 0xf3, 0x1b, 0x9c,
-	0x0f, 0x6f, 0xcf, 0xf1, 0x60, 0xcc, 0xb7, 0x21, 0xeb, 0x79, 0x63, 0xe5, 0x40, 0x49, 0x85, 0x74,
-	0x63, 0x77, 0xcf, 0x1f, 0x06, 0xd3, 0x40, 0x3d, 0x9f, 0x22, 0x0b, 0xbf, 0x07, 0x59, 0x6f, 0x30,
-	0x7e, 0xe3, 0x59, 0xc7, 0x8d, 0xdd, 0x94, 0x42, 0x6f, 0x30, 0x6e, 0x14, 0x80, 0x3e, 0x68, 0x1b,
-	0xff, 0x9b, 0x81, 0x72, 0x8a, 0xcc, 0x77, 0x40, 0xf7, 0xd6, 0xe3, 0xe2, 0xd6, 0x05, 0x1d, 0x0f,
-	0xf6, 0x92, 0x88, 0xf0, 0x94, 0xb7, 0x7d, 0x0f, 0xaa, 0xd8, 0x21, 0x9c, 0xba, 0x51, 0x40, 0x05,
-	0x5a, 0xad, 0x4a, 0xbd, 0x4e, 0x75, 0xfd, 0xf8, 0x24, 0xa1, 0x1c, 0x6c, 0x88, 0xca, 0x3c, 0x05,
-	0xf3, 0x8f, 0xf0, 0x66, 0xe3, 0xcf, 0xdc, 0xc8, 0x57, 0xab, 0xab, 0x26, 0x8f, 0x02, 0x84, 0x3c,
-	0xd8, 0x10, 0x09, 0x1d, 0x59, 0xfd, 0x33, 0x7f, 0xb0, 0x50, 0xa9, 0x6f, 0xc9, 0x6a, 0x49, 0x24,
-	0xb2, 0x2a, 0x3a, 0xaf, 0x03, 0x78, 0xbe, 0x3b, 0x1e, 0x87, 0x94, 0x28, 0xf3, 0xe9, 0xa6, 0x65,
-	0x6f, 0x89, 0x97, 0x4f, 0x9e, 0x09, 0x64, 0x8c, 0xa0, 0xa8, 0x16, 0x86, 0xe5, 0xb5, 0x6b, 0x39,
-	0xbd, 0x13, 0x53, 0xb4, 0xb0, 0xc0, 0x77, 0xd9, 0x06, 0x06, 0xd4, 0xbe, 0x30, 0x6d, 0x95, 0x80,
-	0x84, 0x75, 0xd2, 0x79, 0x6a, 0xb1, 0x8c, 0xbc, 0x7e, 0xda, 0xcf, 0x59, 0x56, 0x16, 0x71, 0xeb,
-	0xc8, 0x14, 0x98, 0x7f, 0xca, 0x50, 0xb4, 0xbe, 0xb4, 0x9a, 0xc7, 0x8e, 0xc5, 0xf2, 0xf2, 0xdb,
-	0xb4, 0xd9, 0x6e, 0x77, 0x9a, 0x98, 0x9c, 0x0a, 0x8d, 0x12, 0x14, 0x07, 0x72, 0x27, 0x8d, 0x3f,
-	0x2d, 0xc1, 0xe6, 0xfa, 0x39, 0xf2, 0xdf, 0x03, 0xdd, 0xf3, 0xd6, 0x4e, 0xe0, 0xf6, 0x65, 0xe7,
-	0xfd, 0x60, 0xcf, 0x4b, 0x0e, 0x41, 0x0e, 0xf8, 0xdd, 0xc4, 0xeb, 0x32, 0x17, 0xbc, 0x2e, 0xf1,
-	0xb9, 0x1f, 0xc2, 0x95, 0x41, 0xe4, 0x63, 0x27, 0x8b, 0xcd, 0x5c, 0xdf, 0x9d, 0xfb, 0xeb, 0x2e,
-	0xd5, 0x24, 0xe2, 0x9e, 0xa2, 0x1d, 0x6c, 0x88, 0xcd, 0xc1, 0x1a, 0x86, 0x7f, 0x01, 0x9b, 0x2e,
-	0x75, 0xf8, 0x4b, 0xf9, 0x5c, 0xfa, 0x25, 0xc7, 0x44, 0x5a, 0x4a, 0xbc, 0xea, 0xa6, 0x11, 0xe8,
-	0x26, 0x5e, 0x14, 0xce, 0x56, 0xc2, 0xf9, 0xb4, 0x9b, 0xec, 0x45, 0xe1, 0x2c, 0x25, 0x5b, 0xf1,
-	0x52, 0x30, 0xdf, 0x85, 0x8a, 0xb2, 0x9c, 0x7a, 0x58, 0xf5, 0x09, 0xe1, 0x6a, 0xda, 0x6c, 0x2a,
-	0xbe, 0x07, 0x1b, 0xa2, 0x3c, 0x58, 0x81, 0xfc, 0x11, 0x94, 0xa5, 0xc1, 0x52, 0xac, 0x98, 0xf6,
-	0x04, 0xb2, 0x36, 0x91, 0x02, 0x77, 0x09, 0xf1, 0xcf, 0x00, 0xc8, 0x4e, 0x29, 0xa3, 0xa7, 0x1b,
-	0x64, 0x34, 0x32, 0x11, 0x29, 0x79, 0x09, 0x90, 0x32, 0x2f, 0x98, 0x7a, 0xfe, 0x99, 0xfa, 0xe4,
-	0xb0, 0x66, 0x1e, 0x3d, 0x88, 0xad, 0xcc, 0x23, 0x70, 0x65, 0x9e, 0x14, 0x83, 0x0b, 0xe6, 0x25,
-	0x52, 0xd2, 0x3c, 0x29, 0x94, 0x98, 0x27, 0x65, 0xca, 0x6f, 0x9a, 0x97, 0x88, 0x90, 0x79, 0x52,
-	0xe2, 0x0b, 0xd8, 0x8c, 0x55, 0x8b, 0xa0, 0x16, 0x55, 0x49, 0x1f, 0x5b, 0xd2, 0x3e, 0x24, 0x0b,
-	0xab, 0xc6, 0x69, 0x04, 0x4a, 0xcf, 0x5f, 0x84, 0xaf, 0x53, 0xe1, 0x5d, 0x4d, 0x4b, 0x77, 0x5f,
-	0x84, 0xaf, 0xd3, 0xf1, 0x5d, 0x9d, 0xa7, 0x11, 0xc6, 0xdf, 0x64, 0xa1, 0xa8, 0x7c, 0x95, 0x5f,
-	0x83, 0x2b, 0x4d, 0x61, 0x99, 0x8e, 0xd5, 0xdb, 0x33, 0x1d, 0xb3, 0x61, 0x76, 0xb1, 0x22, 0x70,
-	0xd8, 0x34, 0xb1, 0x0f, 0x5d, 0xe1, 0x34, 0x0c, 0xc0, 0x3d, 0xd1, 0x39, 0x5a, 0xa1, 0x32, 0x9c,
-	0x41, 0x45, 0xc9, 0xca, 0x0f, 0x6a, 0x59, 0x7e, 0x05, 0xca, 0x52, 0x50, 0x22, 0x72, 0x14, 0x68,
-	0x28, 0x25, 0xe1, 0x7c, 0x4a, 0xa4, 0x65, 0xef, 0x59, 0x5f, 0xb2, 0xc2, 0x4a, 0x44, 0x22, 0x8a,
-	0x4b, 0x11, 0x09, 0xeb, 0x68, 0x8c, 0x23, 0x8e, 0xed, 0xe6, 0x6a, 0x9e, 0x12, 0xbf, 0x09, 0xd7,
-	0xba, 0x07, 0x9d, 0x67, 0x3d, 0xa9, 0x6b, 0x69, 0x12, 0xf0, 0xeb, 0xc0, 0x52, 0x04, 0xc9, 0x5e,
-	0x46, 0x15, 0x84, 0x4d, 0x18, 0xbb, 0xac, 0x82, 0xf3, 0x12, 0xce, 0x91, 0xe9, 0xa4, 0x8a, 0xa6,
-	0x49, 0xd1, 0x4e, 0xfb, 0xf8, 0xd0, 0xee, 0xb2, 0x4d, 0xb4, 0x84, 0x30, 0xd2, 0x92, 0x2b, 0x4b,
-	0x35, 0xab, 0x24, 0xc4, 0x28, 0x2f, 0x21, 0xee, 0x99, 0x29, 0xec, 0x96, 0xbd, 0xdf, 0x65, 0x57,
-	0x97, 0x9a, 0x2d, 0x21, 0x3a, 0xa2, 0xcb, 0xf8, 0x12, 0xd1, 0x75, 0x4c, 0xe7, 0xb8, 0xcb, 0xae,
-	0x2d, 0xad, 0x3c, 0x12, 0x9d, 0xa6, 0xd5, 0xed, 0xb6, 0x5b, 0x5d, 0x87, 0x5d, 0x6f, 0x54, 0x30,
-	0x43, 0x26, 0xc9, 0xc4, 0x38, 0x82, 0xcd, 0xf5, 0xd8, 0xe7, 0x06, 0x54, 0x83, 0x61, 0x6f, 0x1a,
-	0xc6, 0x3d, 0xfa, 0xa8, 0x35, 0x57, 0x9f, 0xb8, 0xca, 0xc1, 0xd0, 0x0e, 0x63, 0x8b, 0x50, 0xd8,
-	0xcf, 0x2d, 0x43, 0x59, 0xb6, 0xb3, 0x4b, 0xd8, 0x38, 0x80, 0xea, 0x5a, 0x36, 0xe0, 0xef, 0x40,
-	0x29, 0x18, 0xae, 0x2b, 0xd3, 0x83, 0xe1, 0xef, 0xa0, 0x69, 0x1f, 0x2a, 0xe9, 0xd4, 0xf0, 0xed,
-	0x15, 0xfd, 0x9d, 0x06, 0xe5, 0x54, 0xaa, 0xf8, 0x9d, 0x96, 0x78, 0x1b, 0x4a, 0xb1, 0x3f, 0x99,
-	0x85, 0x91, 0xab, 0x12, 0xab, 0x2e, 0x56, 0x88, 0xb5, 0xd9, 0xb2, 0xeb, 0xb3, 0xad, 0xdf, 0xc7,
-	0x73, 0xbf, 0xf9, 0x3e, 0x6e, 0x74, 0x00, 0x56, 0xd9, 0x88, 0xde, 0xa3, 0x29, 0x4a, 0xe5, 0xe5,
-	0x41, 0x02, 0xeb, 0x0a, 0x33, 0xbf, 0x45, 0xe1, 0x57, 0x50, 0x5a, 0xa6, 0xaa, 0x6f, 0xbd, 0x63,
-	0x2b, 0x43, 0xb2, 0x29, 0x43, 0x8c, 0xfd, 0x64, 0x1b, 0x65, 0x72, 0xf9, 0x5d, 0xb6, 0xf1, 0x3a,
-	0xe4, 0x65, 0xb6, 0x52, 0x9f, 0x46, 0x08, 0x30, 0x0c, 0xb5, 0x6a, 0xa9, 0x67, 0xc9, 0xa3, 0xa5,
-	0x79, 0x7e, 0x20, 0x17, 0x22, 0x59, 0x7e, 0xe3, 0x42, 0x2e, 0x9f, 0xe3, 0x1e, 0x54, 0xd7, 0xd2,
-	0xdb, 0xe5, 0x9b, 0x6b, 0xb4, 0xa0, 0xba, 0x96, 0xc7, 0x52, 0x7f, 0x89, 0xd1, 0xd2, 0x7f, 0x89,
-	0xc1, 0x9b, 0xd0, 0xeb, 0x17, 0x7e, 0xe4, 0x5f, 0xf2, 0xbf, 0x00, 0x49, 0x30, 0xbe, 0x80, 0x4a,
-	0xba, 0xe3, 0xe1, 0xdf, 0x85, 0x7c, 0x10, 0xfb, 0x93, 0xe4, 0x53, 0xcf, 0x8d, 0x8b, 0x4d, 0x51,
-	0x2b, 0xf6, 0x27, 0x42, 0x32, 0x19, 0x3f, 0xd7, 0x80, 0xbd, 0x49, 0x4b, 0xfd, 0x6f, 0x47, 0xfb,
-	0x86, 0xff, 0xed, 0x64, 0xd6, 0x8c, 0xbc, 0xe4, 0xbf, 0x37, 0x68, 0xb8, 0xfc, 0x3a, 0x75, 0xc9,
-	0x5f, 0x4d, 0xe4, 0xc7, 0xe8, 0x0f, 0x41, 0x8f, 0x7c, 0xfa, 0x23, 0x86, 0xa7, 0x2a, 0x73, 0x9a,
-	0x69, 0x49, 0x33, 0x5e, 0x40, 0x51, 0x75, 0x67, 0x97, 0x7e, 0x8e, 0xfa, 0x08, 0x8a, 0xf2, 0xbb,
-	0x42, 0xf2, 0x41, 0xe1, 0xc2, 0xdb, 0x52, 0x42, 0xe7, 0x77, 0x64, 0x17, 0xba, 0xfe, 0xe6, 0x89,
-	0x2d, 0xb4, 0x90, 0xdd, 0xe9, 0xf7, 0xa1, 0xa8, 0x9a, 0xbb, 0x4b, 0x67, 0xfa, 0x6d, 0x7f, 0xd1,
-	0xd8, 0x02, 0x58, 0x75, 0x7b, 0x97, 0x69, 0xb8, 0x7f, 0x17, 0x2a, 0xe9, 0x6f, 0xa7, 0x74, 0x4f,
-	0x09, 0xa7, 0x3e, 0xdb, 0xc0, 0xdb, 0x7d, 0xfb, 0xeb, 0x1d, 0xa6, 0xdd, 0xff, 0x11, 0xd4, 0xbe,
-	0xe9, 0x06, 0x80, 0x4d, 0x61, 0xf3, 0xc0, 0xa4, 0x5b, 0x56, 0x05, 0x74, 0xbb, 0xd3, 0x93, 0x90,
-	0x86, 0xfd, 0x9f, 0xb0, 0xda, 0x16, 0x55, 0xae, 0xc6, 0x0f, 0x7f, 0xf9, 0xeb, 0x3b, 0xda, 0xbf,
-	0xfd, 0xfa, 0x8e, 0xf6, 0xab, 0x5f, 0xdf, 0xd9, 0xf8, 0xf9, 0x7f, 0xdf, 0xd1, 0xbe, 0x4a, 0xff,
-	0x8d, 0x74, 0xe2, 0xc6, 0x51, 0x70, 0x16, 0x46, 0xc1, 0x28, 0x98, 0x26, 0xc0, 0xd4, 0xff, 0x74,
-	0xf6, 0x6a, 0xf4, 0xe9, 0xac, 0xff, 0x29, 0x2e, 0xa9, 0x5f, 0xa0, 0x7f, 0x93, 0x3e, 0xfa, 0xbf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x0f, 0xd5, 0xb3, 0x4b, 0x90, 0x2a, 0x00, 0x00,
+func (m *ShowVariables) Reset()         { *m = ShowVariables{} }
+func (m *ShowVariables) String() string { return proto.CompactTextString(m) }
+func (*ShowVariables) ProtoMessage()    {}
+func (*ShowVariables) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{63}
 }
-
-func (m *Type) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ShowVariables) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShowVariables) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShowVariables.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Type) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ShowVariables) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowVariables.Merge(m, src)
+}
+func (m *ShowVariables) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ShowVariables) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowVariables.DiscardUnknown(m)
 }
 
-func (m *Type) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Scale != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.Scale))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Size != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.Size))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.Precision != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.Precision))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Width != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.Width))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Nullable {
-		i--
-		if m.Nullable {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Id != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_ShowVariables proto.InternalMessageInfo
+
+func (m *ShowVariables) GetGlobal() bool {
+	if m != nil {
+		return m.Global
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *Const) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ShowVariables) GetWhere() []*Expr {
+	if m != nil {
+		return m.Where
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Const) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SetVariables struct {
+	Items                []*SetVariablesItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *Const) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Value != nil {
-		{
-			size := m.Value.ProtoSize()
-			i -= size
-			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	if m.Isnull {
-		i--
-		if m.Isnull {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *SetVariables) Reset()         { *m = SetVariables{} }
+func (m *SetVariables) String() string { return proto.CompactTextString(m) }
+func (*SetVariables) ProtoMessage()    {}
+func (*SetVariables) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{64}
+}
+func (m *SetVariables) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetVariables) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetVariables.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *Const_Ival) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetVariables) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetVariables.Merge(m, src)
 }
-
-func (m *Const_Ival) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintPlan(dAtA, i, uint64(m.Ival))
-	i--
-	dAtA[i] = 0x10
-	return len(dAtA) - i, nil
+func (m *SetVariables) XXX_Size() int {
+	return m.ProtoSize()
 }
-func (m *Const_Dval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetVariables) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetVariables.DiscardUnknown(m)
 }
 
-func (m *Const_Dval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= 8
-	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Dval))))
-	i--
-	dAtA[i] = 0x19
-	return len(dAtA) - i, nil
-}
-func (m *Const_Sval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_SetVariables proto.InternalMessageInfo
 
-func (m *Const_Sval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.Sval)
-	copy(dAtA[i:], m.Sval)
-	i = encodeVarintPlan(dAtA, i, uint64(len(m.Sval)))
-	i--
-	dAtA[i] = 0x22
-	return len(dAtA) - i, nil
+func (m *SetVariables) GetItems() []*SetVariablesItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
 }
-func (m *Const_Bval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type SetVariablesItem struct {
+	System               bool     `protobuf:"varint,1,opt,name=system,proto3" json:"system,omitempty"`
+	Global               bool     `protobuf:"varint,2,opt,name=global,proto3" json:"global,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Value                *Expr    `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Reserved             *Expr    `protobuf:"bytes,5,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Const_Bval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i--
-	if m.Bval {
-		dAtA[i] = 1
+func (m *SetVariablesItem) Reset()         { *m = SetVariablesItem{} }
+func (m *SetVariablesItem) String() string { return proto.CompactTextString(m) }
+func (*SetVariablesItem) ProtoMessage()    {}
+func (*SetVariablesItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{65}
+}
+func (m *SetVariablesItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetVariablesItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetVariablesItem.Marshal(b, m, deterministic)
 	} else {
-		dAtA[i] = 0
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x28
-	return len(dAtA) - i, nil
 }
-func (m *Const_Uval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetVariablesItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetVariablesItem.Merge(m, src)
 }
-
-func (m *Const_Uval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintPlan(dAtA, i, uint64(m.Uval))
-	i--
-	dAtA[i] = 0x30
-	return len(dAtA) - i, nil
+func (m *SetVariablesItem) XXX_Size() int {
+	return m.ProtoSize()
 }
-func (m *Const_Fval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SetVariablesItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetVariablesItem.DiscardUnknown(m)
 }
 
-func (m *Const_Fval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= 4
-	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Fval))))
-	i--
-	dAtA[i] = 0x3d
-	return len(dAtA) - i, nil
-}
-func (m *Const_Dateval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_SetVariablesItem proto.InternalMessageInfo
 
-func (m *Const_Dateval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintPlan(dAtA, i, uint64(m.Dateval))
-	i--
-	dAtA[i] = 0x40
-	return len(dAtA) - i, nil
+func (m *SetVariablesItem) GetSystem() bool {
+	if m != nil {
+		return m.System
+	}
+	return false
 }
-func (m *Const_Datetimeval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *SetVariablesItem) GetGlobal() bool {
+	if m != nil {
+		return m.Global
+	}
+	return false
 }
 
-func (m *Const_Datetimeval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintPlan(dAtA, i, uint64(m.Datetimeval))
-	i--
-	dAtA[i] = 0x48
-	return len(dAtA) - i, nil
+func (m *SetVariablesItem) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
-func (m *Const_Decimal64Val) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *SetVariablesItem) GetValue() *Expr {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-func (m *Const_Decimal64Val) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Decimal64Val != nil {
-		{
-			size, err := m.Decimal64Val.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPlan(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
+func (m *SetVariablesItem) GetReserved() *Expr {
+	if m != nil {
+		return m.Reserved
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *Const_Decimal128Val) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type Prepare struct {
+	Name                 string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Schemas              []*ObjectRef `protobuf:"bytes,2,rep,name=schemas,proto3" json:"schemas,omitempty"`
+	Plan                 *Plan        `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *Const_Decimal128Val) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Decimal128Val != nil {
-		{
-			size, err := m.Decimal128Val.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPlan(dAtA, i, uint64(size))
+func (m *Prepare) Reset()         { *m = Prepare{} }
+func (m *Prepare) String() string { return proto.CompactTextString(m) }
+func (*Prepare) ProtoMessage()    {}
+func (*Prepare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{66}
+}
+func (m *Prepare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Prepare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Prepare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x5a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *Const_Timestampval) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Prepare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Prepare.Merge(m, src)
 }
-
-func (m *Const_Timestampval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintPlan(dAtA, i, uint64(m.Timestampval))
-	i--
-	dAtA[i] = 0x60
-	return len(dAtA) - i, nil
+func (m *Prepare) XXX_Size() int {
+	return m.ProtoSize()
 }
-func (m *ParamRef) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Prepare) XXX_DiscardUnknown() {
+	xxx_messageInfo_Prepare.DiscardUnknown(m)
 }
 
-func (m *ParamRef) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_Prepare proto.InternalMessageInfo
 
-func (m *ParamRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Prepare) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if m.Pos != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.Pos))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *Prepare) GetSchemas() []*ObjectRef {
+	if m != nil {
+		return m.Schemas
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *VarRef) Marshal() (dAtA []byte, err error) {
-	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Prepare) GetPlan() *Plan {
+	if m != nil {
+		return m.Plan
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *VarRef) MarshalTo(dAtA []byte) (int, error) {
-	size := m.ProtoSize()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Execute struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Args                 []*Expr  `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *VarRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Global {
-		i--
-		if m.Global {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *Execute) Reset()         { *m = Execute{} }
+func (m *Execute) String() string { return proto.CompactTextString(m) }
+func (*Execute) ProtoMessage()    {}
+func (*Execute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{67}
+}
+func (m *Execute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Execute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Execute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if m.System {
-		i--
-		if m.System {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+}
+func (m *Execute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Execute.Merge(m, src)
+}
+func (m *Execute) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Execute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Execute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Execute proto.InternalMessageInfo
+
+func (m *Execute) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Execute) GetArgs() []*Expr {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+type Deallocate struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Deallocate) Reset()         { *m = Deallocate{} }
+func (m *Deallocate) String() string { return proto.CompactTextString(m) }
+func (*Deallocate) ProtoMessage()    {}
+func (*Deallocate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{68}
+}
+func (m *Deallocate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Deallocate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Deallocate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *Deallocate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Deallocate.Merge(m, src)
+}
+func (m *Deallocate) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *Deallocate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Deallocate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Deallocate proto.InternalMessageInfo
+
+func (m *Deallocate) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ColRef) Marshal() (dAtA []byte, err error) {
+func init() {
+	proto.RegisterEnum("plan.CompressType", CompressType_name, CompressType_value)
+	proto.RegisterEnum("plan.TransationCompletionType", TransationCompletionType_name, TransationCompletionType_value)
+	proto.RegisterEnum("plan.Type_TypeId", Type_TypeId_name, Type_TypeId_value)
+	proto.RegisterEnum("plan.SubqueryRef_Type", SubqueryRef_Type_name, SubqueryRef_Type_value)
+	proto.RegisterEnum("plan.Function_FuncFlag", Function_FuncFlag_name, Function_FuncFlag_value)
+	proto.RegisterEnum("plan.IndexDef_IndexType", IndexDef_IndexType_name, IndexDef_IndexType_value)
+	proto.RegisterEnum("plan.ForeignKeyDef_RefAction", ForeignKeyDef_RefAction_name, ForeignKeyDef_RefAction_value)
+	proto.RegisterEnum("plan.PartitionDef_PartitionType", PartitionDef_PartitionType_name, PartitionDef_PartitionType_value)
+	proto.RegisterEnum("plan.OrderBySpec_OrderByFlag", OrderBySpec_OrderByFlag_name, OrderBySpec_OrderByFlag_value)
+	proto.RegisterEnum("plan.FrameBound_BoundType", FrameBound_BoundType_name, FrameBound_BoundType_value)
+	proto.RegisterEnum("plan.FrameClause_FrameType", FrameClause_FrameType_name, FrameClause_FrameType_value)
+	proto.RegisterEnum("plan.Node_NodeType", Node_NodeType_name, Node_NodeType_value)
+	proto.RegisterEnum("plan.Node_JoinFlag", Node_JoinFlag_name, Node_JoinFlag_value)
+	proto.RegisterEnum("plan.Node_AggMode", Node_AggMode_name, Node_AggMode_value)
+	proto.RegisterEnum("plan.FullTextScan_SearchMode", FullTextScan_SearchMode_name, FullTextScan_SearchMode_value)
+	proto.RegisterEnum("plan.Query_StatementType", Query_StatementType_name, Query_StatementType_value)
+	proto.RegisterEnum("plan.TransationControl_TclType", TransationControl_TclType_name, TransationControl_TclType_value)
+	proto.RegisterEnum("plan.TransationBegin_TransationMode", TransationBegin_TransationMode_name, TransationBegin_TransationMode_value)
+	proto.RegisterEnum("plan.DataControl_DclType", DataControl_DclType_name, DataControl_DclType_value)
+	proto.RegisterEnum("plan.DataDefinition_DdlType", DataDefinition_DdlType_name, DataDefinition_DdlType_value)
+	proto.RegisterType((*Type)(nil), "plan.Type")
+	proto.RegisterType((*Const)(nil), "plan.Const")
+	proto.RegisterType((*ParamRef)(nil), "plan.ParamRef")
+	proto.RegisterType((*VarRef)(nil), "plan.VarRef")
+	proto.RegisterType((*ColRef)(nil), "plan.ColRef")
+	proto.RegisterType((*CorrColRef)(nil), "plan.CorrColRef")
+	proto.RegisterType((*ExprList)(nil), "plan.ExprList")
+	proto.RegisterType((*TargetType)(nil), "plan.TargetType")
+	proto.RegisterType((*SubqueryRef)(nil), "plan.SubqueryRef")
+	proto.RegisterType((*ObjectRef)(nil), "plan.ObjectRef")
+	proto.RegisterType((*Function)(nil), "plan.Function")
+	proto.RegisterType((*Expr)(nil), "plan.Expr")
+	proto.RegisterType((*DefaultExpr)(nil), "plan.DefaultExpr")
+	proto.RegisterType((*ConstantValue)(nil), "plan.ConstantValue")
+	proto.RegisterType((*Decimal64)(nil), "plan.decimal64")
+	proto.RegisterType((*Decimal128)(nil), "plan.decimal128")
+	proto.RegisterType((*ColDef)(nil), "plan.ColDef")
+	proto.RegisterType((*IndexDef)(nil), "plan.IndexDef")
+	proto.RegisterType((*PrimaryKeyDef)(nil), "plan.PrimaryKeyDef")
+	proto.RegisterType((*Property)(nil), "plan.Property")
+	proto.RegisterType((*PropertiesDef)(nil), "plan.PropertiesDef")
+	proto.RegisterType((*TableDef)(nil), "plan.TableDef")
+	proto.RegisterType((*TableDef_DefType)(nil), "plan.TableDef.DefType")
+	proto.RegisterType((*CheckDef)(nil), "plan.CheckDef")
+	proto.RegisterType((*ForeignKeyDef)(nil), "plan.ForeignKeyDef")
+	proto.RegisterType((*PartitionDef)(nil), "plan.PartitionDef")
+	proto.RegisterType((*PartitionDef_Partition)(nil), "plan.PartitionDef.Partition")
+	proto.RegisterType((*ViewDef)(nil), "plan.ViewDef")
+	proto.RegisterType((*Cost)(nil), "plan.Cost")
+	proto.RegisterType((*ColData)(nil), "plan.ColData")
+	proto.RegisterType((*RowsetData)(nil), "plan.RowsetData")
+	proto.RegisterType((*OrderBySpec)(nil), "plan.OrderBySpec")
+	proto.RegisterType((*FrameBound)(nil), "plan.FrameBound")
+	proto.RegisterType((*FrameClause)(nil), "plan.FrameClause")
+	proto.RegisterType((*WindowSpec)(nil), "plan.WindowSpec")
+	proto.RegisterType((*UpdateInfo)(nil), "plan.UpdateInfo")
+	proto.RegisterType((*AnalyzeInfo)(nil), "plan.AnalyzeInfo")
+	proto.RegisterType((*Node)(nil), "plan.Node")
+	proto.RegisterType((*FullTextScan)(nil), "plan.FullTextScan")
+	proto.RegisterType((*DeleteTableCtx)(nil), "plan.DeleteTableCtx")
+	proto.RegisterType((*Query)(nil), "plan.Query")
+	proto.RegisterType((*TransationControl)(nil), "plan.TransationControl")
+	proto.RegisterType((*TransationBegin)(nil), "plan.TransationBegin")
+	proto.RegisterType((*TransationCommit)(nil), "plan.TransationCommit")
+	proto.RegisterType((*TransationRollback)(nil), "plan.TransationRollback")
+	proto.RegisterType((*Plan)(nil), "plan.Plan")
+	proto.RegisterType((*DataControl)(nil), "plan.DataControl")
+	proto.RegisterType((*DataDefinition)(nil), "plan.DataDefinition")
+	proto.RegisterType((*CreateDatabase)(nil), "plan.CreateDatabase")
+	proto.RegisterType((*AlterDatabase)(nil), "plan.AlterDatabase")
+	proto.RegisterType((*DropDatabase)(nil), "plan.DropDatabase")
+	proto.RegisterType((*CreateTable)(nil), "plan.CreateTable")
+	proto.RegisterType((*AlterTable)(nil), "plan.AlterTable")
+	proto.RegisterType((*AlterTable_Action)(nil), "plan.AlterTable.Action")
+	proto.RegisterType((*AlterTableAddColumn)(nil), "plan.AlterTableAddColumn")
+	proto.RegisterType((*AlterTableDropColumn)(nil), "plan.AlterTableDropColumn")
+	proto.RegisterType((*AlterTableRenameColumn)(nil), "plan.AlterTableRenameColumn")
+	proto.RegisterType((*AlterTableRenameTable)(nil), "plan.AlterTableRenameTable")
+	proto.RegisterType((*AlterTableAddPartition)(nil), "plan.AlterTableAddPartition")
+	proto.RegisterType((*AlterTableDropPartition)(nil), "plan.AlterTableDropPartition")
+	proto.RegisterType((*AlterTableTruncatePartition)(nil), "plan.AlterTableTruncatePartition")
+	proto.RegisterType((*DropTable)(nil), "plan.DropTable")
+	proto.RegisterType((*CreateIndex)(nil), "plan.CreateIndex")
+	proto.RegisterType((*AlterIndex)(nil), "plan.AlterIndex")
+	proto.RegisterType((*DropIndex)(nil), "plan.DropIndex")
+	proto.RegisterType((*TruncateTable)(nil), "plan.TruncateTable")
+	proto.RegisterType((*ShowVariables)(nil), "plan.ShowVariables")
+	proto.RegisterType((*SetVariables)(nil), "plan.SetVariables")
+	proto.RegisterType((*SetVariablesItem)(nil), "plan.SetVariablesItem")
+	proto.RegisterType((*Prepare)(nil), "plan.Prepare")
+	proto.RegisterType((*Execute)(nil), "plan.Execute")
+	proto.RegisterType((*Deallocate)(nil), "plan.Deallocate")
+}
+
+func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
+
+var fileDescriptor_2d655ab2f7683c23 = []byte{
+	// 5786 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x7b, 0xcb, 0x73, 0x1b, 0x47,
+	0x7a, 0x38, 0x07, 0xcf, 0xc1, 0x07, 0x82, 0x6a, 0xb5, 0x65, 0x09, 0x7a, 0x9a, 0x1a, 0x3f, 0x56,
+	0x96, 0xd7, 0x92, 0x05, 0xd1, 0xb4, 0xec, 0xf5, 0xae, 0x3d, 0x04, 0x87, 0x24, 0x56, 0xe0, 0x80,
+	0xdb, 0x18, 0x92, 0x96, 0xb7, 0x7e, 0x85, 0x1a, 0x60, 0x06, 0xd4, 0x58, 0xe0, 0x0c, 0x7e, 0x83,
+	0x01, 0x29, 0xfa, 0xb4, 0x55, 0xa9, 0xca, 0x75, 0x73, 0x49, 0x2a, 0x55, 0xc9, 0x61, 0x2b, 0x97,
+	0x54, 0x52, 0xb9, 0x6c, 0x92, 0x43, 0x2a, 0x7f, 0xc1, 0xee, 0x2d, 0xa9, 0xd4, 0x9e, 0x72, 0xd9,
+	0x6c, 0x2e, 0xb9, 0xa7, 0x72, 0xcb, 0x21, 0xf5, 0x75, 0xf7, 0x0c, 0x1a, 0x24, 0x64, 0x3b, 0x5b,
+	0xb9, 0xa0, 0xfa, 0x7b, 0xf4, 0xd7, 0x5f, 0xbf, 0xbe, 0x57, 0x0f, 0x00, 0xc6, 0x23, 0x37, 0x7c,
+	0x30, 0x8e, 0xa3, 0x24, 0xa2, 0x05, 0x6c, 0xdf, 0x78, 0xff, 0x28, 0x48, 0x9e, 0x4f, 0xfb, 0x0f,
+	0x06, 0xd1, 0xf1, 0xc3, 0xa3, 0xe8, 0x28, 0x7a, 0xc8, 0x89, 0xfd, 0xe9, 0x90, 0x43, 0x1c, 0xe0,
+	0x2d, 0xd1, 0xc9, 0xf8, 0xb3, 0x12, 0x14, 0x9c, 0xb3, 0xb1, 0x4f, 0xef, 0x42, 0x2e, 0xf0, 0xea,
+	0xda, 0xaa, 0x76, 0x6f, 0xa5, 0x71, 0xf9, 0x01, 0x17, 0x8b, 0x78, 0xfe, 0xd3, 0xf2, 0x58, 0x2e,
+	0xf0, 0xe8, 0x0d, 0xd0, 0xc3, 0xe9, 0x68, 0xe4, 0xf6, 0x47, 0x7e, 0x3d, 0xb7, 0xaa, 0xdd, 0xd3,
+	0x59, 0x06, 0xd3, 0x2b, 0x50, 0x3c, 0x0d, 0xbc, 0xe4, 0x79, 0x3d, 0xbf, 0xaa, 0xdd, 0x2b, 0x32,
+	0x01, 0xd0, 0x5b, 0x50, 0x19, 0xc7, 0xfe, 0x20, 0x98, 0x04, 0x51, 0x58, 0x2f, 0x70, 0xca, 0x0c,
+	0x41, 0x29, 0x14, 0x26, 0xc1, 0xd7, 0x7e, 0xbd, 0xc8, 0x09, 0xbc, 0x8d, 0x72, 0x26, 0x03, 0x77,
+	0xe4, 0xd7, 0x4b, 0x42, 0x0e, 0x07, 0xe8, 0x1d, 0x00, 0x3f, 0x9c, 0x1e, 0x9f, 0xb8, 0xa3, 0xa9,
+	0x3f, 0xa9, 0x97, 0x57, 0xb5, 0x7b, 0x15, 0xa6, 0x60, 0x8c, 0xdf, 0x14, 0xa0, 0x24, 0x14, 0xa5,
+	0x65, 0xc8, 0x9b, 0xf6, 0x33, 0xb2, 0x44, 0x75, 0x28, 0x74, 0x1d, 0x93, 0x11, 0x0d, 0x5b, 0x1b,
+	0x9d, 0x4e, 0x9b, 0x00, 0x12, 0x37, 0x5a, 0x0e, 0xa9, 0x22, 0xaa, 0x65, 0x3b, 0x4f, 0xc8, 0x15,
+	0x5a, 0x81, 0x62, 0xcb, 0x76, 0x1e, 0xad, 0x93, 0xd7, 0x65, 0xf3, 0x71, 0x83, 0x5c, 0x95, 0xcd,
+	0xf5, 0x35, 0x72, 0x8d, 0x02, 0x94, 0x90, 0xa1, 0xf1, 0x84, 0xd4, 0x11, 0xbd, 0xcf, 0xfb, 0x5d,
+	0x47, 0xf4, 0xbe, 0xe8, 0x78, 0x23, 0x6d, 0x3f, 0x6e, 0x90, 0x9b, 0x69, 0x7b, 0x7d, 0x8d, 0xdc,
+	0xa2, 0x55, 0x28, 0xef, 0xcb, 0xbe, 0xb7, 0x11, 0xd8, 0x6a, 0x77, 0x4c, 0xe4, 0xba, 0x93, 0x01,
+	0xeb, 0x6b, 0xe4, 0x0d, 0x5a, 0x83, 0xca, 0xa6, 0xd5, 0x6c, 0xed, 0x9a, 0xed, 0xf5, 0x35, 0xb2,
+	0x4a, 0x57, 0x00, 0x24, 0x88, 0x1d, 0xef, 0x22, 0xaf, 0x84, 0x89, 0x81, 0xe2, 0x4d, 0xfb, 0x59,
+	0xcb, 0x76, 0xc8, 0xdb, 0x74, 0x19, 0x74, 0xd3, 0x7e, 0xc6, 0xe5, 0x90, 0x77, 0x50, 0x8a, 0x69,
+	0x3f, 0xb3, 0xf7, 0x77, 0x37, 0x2c, 0x46, 0xbe, 0x87, 0x33, 0xdc, 0xdf, 0x6f, 0x6d, 0x92, 0x7b,
+	0x5c, 0xe9, 0x8d, 0x47, 0xeb, 0x1f, 0x90, 0x77, 0x65, 0xf3, 0xc9, 0x1a, 0xb9, 0x2f, 0x9b, 0x1f,
+	0x37, 0xc8, 0x7b, 0xa2, 0xd9, 0x68, 0xac, 0x91, 0xef, 0xcb, 0xe6, 0x87, 0xeb, 0xe4, 0x7d, 0x14,
+	0xb0, 0x69, 0x3a, 0x16, 0x69, 0x60, 0xcb, 0x69, 0xed, 0x5a, 0xe4, 0x31, 0x8e, 0x88, 0x38, 0x0e,
+	0xad, 0xe1, 0x88, 0xd8, 0xea, 0x3a, 0xe6, 0xee, 0x1e, 0xf9, 0x10, 0x89, 0x2d, 0xdb, 0xb1, 0xd8,
+	0x81, 0xd9, 0x26, 0xeb, 0xa8, 0xb5, 0x69, 0x3f, 0xe3, 0x9c, 0x3f, 0x40, 0x09, 0xcd, 0x1d, 0x93,
+	0x91, 0x4f, 0x11, 0x7d, 0x60, 0x32, 0x0e, 0xfc, 0x10, 0xd1, 0x3f, 0xee, 0x76, 0x6c, 0xf2, 0x23,
+	0x3e, 0x84, 0xf5, 0x85, 0x43, 0x3e, 0xc3, 0x09, 0x6e, 0xb4, 0x6c, 0x93, 0x3d, 0x23, 0x5b, 0x38,
+	0xc0, 0x81, 0xc9, 0x24, 0xb8, 0xcd, 0xf7, 0xb1, 0xdd, 0xd9, 0x20, 0x3b, 0xd8, 0xb2, 0xec, 0xfd,
+	0x5d, 0xf2, 0x39, 0xee, 0x68, 0xd7, 0x72, 0x88, 0x89, 0x9a, 0x9b, 0x8c, 0x99, 0xcf, 0xc8, 0x97,
+	0xb8, 0x80, 0x5b, 0x6d, 0xeb, 0x8b, 0x8d, 0xfd, 0xad, 0x2d, 0x8b, 0x91, 0x9f, 0x72, 0x91, 0xcf,
+	0x1c, 0xcb, 0x7c, 0x42, 0x3c, 0x1c, 0x9f, 0xb7, 0x1f, 0xad, 0x13, 0x1f, 0xfb, 0x70, 0x80, 0x0c,
+	0xa9, 0x8e, 0x72, 0xda, 0xe4, 0x57, 0x1a, 0x05, 0x28, 0x3a, 0xfb, 0x7b, 0x6d, 0x8b, 0xfc, 0x5a,
+	0x33, 0xfe, 0x20, 0x0f, 0xc5, 0x66, 0x14, 0x4e, 0x12, 0x7a, 0x15, 0x4a, 0xc1, 0x04, 0x4f, 0x3b,
+	0xbf, 0x22, 0x3a, 0x93, 0x10, 0xbd, 0x02, 0x85, 0xe0, 0xc4, 0x1d, 0xf1, 0xfb, 0x90, 0xdf, 0x59,
+	0x62, 0x1c, 0x42, 0xac, 0x87, 0x58, 0xbc, 0x0c, 0x1a, 0x62, 0x3d, 0x89, 0x9d, 0x20, 0x16, 0x2f,
+	0x42, 0x05, 0xb1, 0x13, 0x89, 0xed, 0x23, 0x16, 0x6f, 0x81, 0x8e, 0xd8, 0xbe, 0xc4, 0x4e, 0x11,
+	0x8b, 0xd7, 0xa0, 0x80, 0xd8, 0xa9, 0xc4, 0x0e, 0x11, 0x8b, 0x37, 0x20, 0x87, 0x58, 0x84, 0xe8,
+	0x0d, 0x28, 0x7b, 0x6e, 0xe2, 0x23, 0x41, 0xc7, 0x5b, 0xb3, 0xb3, 0xc4, 0x52, 0x04, 0x35, 0xa0,
+	0x8a, 0xcd, 0x24, 0x38, 0xe6, 0xf4, 0x8a, 0x54, 0x53, 0x45, 0xd2, 0x0f, 0x61, 0xd9, 0xf3, 0x07,
+	0xc1, 0xb1, 0x3b, 0x5a, 0x5f, 0x43, 0x26, 0x58, 0xd5, 0xee, 0x55, 0x1b, 0x97, 0x84, 0x11, 0xc8,
+	0x28, 0x3b, 0x4b, 0x6c, 0x8e, 0x8d, 0x3e, 0x81, 0x9a, 0x84, 0x1f, 0x35, 0x9e, 0x60, 0xbf, 0x2a,
+	0xef, 0x47, 0xe6, 0xfa, 0x3d, 0x6a, 0x3c, 0xd9, 0x59, 0x62, 0xf3, 0x8c, 0xf4, 0x2d, 0x58, 0xc6,
+	0xb1, 0x27, 0x89, 0x7b, 0x3c, 0xc6, 0x8e, 0xcb, 0x52, 0xab, 0x39, 0xec, 0x46, 0x19, 0x8a, 0xfc,
+	0x7a, 0x1b, 0xb7, 0x40, 0xdf, 0x73, 0x63, 0xf7, 0x98, 0xf9, 0x43, 0x4a, 0x20, 0x3f, 0x8e, 0x26,
+	0x7c, 0x13, 0x8a, 0x0c, 0x9b, 0x46, 0x1b, 0x4a, 0x07, 0x6e, 0x8c, 0x34, 0x0a, 0x85, 0xd0, 0x3d,
+	0xf6, 0x39, 0xb1, 0xc2, 0x78, 0x1b, 0xf7, 0x6d, 0x72, 0x36, 0x49, 0xfc, 0x63, 0x69, 0xb1, 0x24,
+	0x84, 0xf8, 0xa3, 0x51, 0xd4, 0x97, 0x7b, 0xa4, 0x33, 0x09, 0x19, 0x36, 0x94, 0x9a, 0xd1, 0x08,
+	0xa5, 0x5d, 0x83, 0x72, 0xec, 0x8f, 0x7a, 0xb3, 0xd1, 0x4a, 0xb1, 0x3f, 0xda, 0x8b, 0x26, 0x48,
+	0x18, 0x44, 0x82, 0x90, 0x13, 0x84, 0x41, 0xc4, 0x09, 0xe9, 0xf8, 0xf9, 0xd9, 0xf8, 0x86, 0x03,
+	0xd0, 0x8c, 0xe2, 0xf8, 0xf7, 0x96, 0x79, 0x05, 0x8a, 0x9e, 0x3f, 0x9e, 0xd9, 0x55, 0x0e, 0x18,
+	0xf7, 0x41, 0xb7, 0x5e, 0x8e, 0xe3, 0x76, 0x30, 0x49, 0xe8, 0x1d, 0x28, 0x8c, 0x82, 0x49, 0x52,
+	0xd7, 0x56, 0xf3, 0xf7, 0xaa, 0x0d, 0x10, 0xab, 0x8f, 0x54, 0xc6, 0xf1, 0xc6, 0x7d, 0x00, 0xc7,
+	0x8d, 0x8f, 0xfc, 0x84, 0x9b, 0xf9, 0x5b, 0x90, 0x4f, 0xce, 0xc6, 0x7c, 0xf4, 0x8c, 0x19, 0x09,
+	0x0c, 0xd1, 0xc6, 0x7f, 0x6a, 0x50, 0xed, 0x4e, 0xfb, 0xff, 0x7f, 0xea, 0xc7, 0x67, 0xa8, 0xef,
+	0xbd, 0x19, 0xf7, 0x4a, 0xe3, 0xaa, 0xe0, 0x56, 0xe8, 0xb3, 0x9e, 0x38, 0x81, 0x30, 0xf2, 0xfc,
+	0x5e, 0xe0, 0xa5, 0x13, 0x40, 0xb0, 0xe5, 0xd1, 0x15, 0xc8, 0x45, 0x63, 0xb9, 0x24, 0xb9, 0x68,
+	0x4c, 0x57, 0xa1, 0x38, 0x78, 0x1e, 0x8c, 0xbc, 0x7a, 0x41, 0x55, 0x81, 0xeb, 0x2b, 0x08, 0xf4,
+	0x3a, 0xe8, 0x71, 0x74, 0xda, 0x53, 0x5c, 0x43, 0x39, 0x8e, 0x4e, 0xbb, 0xc1, 0xd7, 0xb8, 0x9a,
+	0xc2, 0x59, 0x01, 0x94, 0xba, 0x4d, 0xb3, 0x6d, 0x32, 0xb2, 0x84, 0x6d, 0xeb, 0x8b, 0x56, 0xd7,
+	0xe9, 0x12, 0x0d, 0x6f, 0xbe, 0xdd, 0x71, 0x7a, 0x12, 0xce, 0xd1, 0x12, 0xe4, 0x5a, 0x36, 0xc9,
+	0x23, 0x0f, 0xe2, 0x5b, 0x36, 0x29, 0xa4, 0x0e, 0xa2, 0xc8, 0x1b, 0xed, 0x36, 0x29, 0x19, 0xff,
+	0xa2, 0x41, 0xa5, 0xd3, 0xff, 0xca, 0x1f, 0x24, 0x38, 0x67, 0x3c, 0x31, 0x7e, 0x7c, 0xe2, 0xc7,
+	0x7c, 0xda, 0x79, 0x26, 0x21, 0x9c, 0x88, 0xd7, 0x17, 0xf7, 0x9c, 0xe5, 0xbc, 0x3e, 0xe7, 0x1b,
+	0x3c, 0xf7, 0x8f, 0xdd, 0x7a, 0x5e, 0xf2, 0x71, 0x08, 0x4f, 0x68, 0xd4, 0xff, 0x8a, 0x4f, 0x2f,
+	0xcf, 0xb0, 0x49, 0xdf, 0x80, 0xaa, 0x90, 0xd1, 0xe3, 0xc7, 0xa3, 0x28, 0xdc, 0x97, 0x40, 0xd9,
+	0x78, 0x48, 0xaf, 0x41, 0xd9, 0xeb, 0x0b, 0x62, 0x89, 0x13, 0x4b, 0x5e, 0x9f, 0x13, 0xb0, 0x27,
+	0x97, 0x2a, 0x88, 0xd2, 0xf1, 0x09, 0x14, 0x67, 0xb8, 0x0e, 0x7a, 0xd4, 0xff, 0x4a, 0x50, 0x75,
+	0x4e, 0x2d, 0x47, 0xfd, 0xaf, 0x90, 0x64, 0xfc, 0x9b, 0x06, 0xfa, 0xd6, 0x34, 0x1c, 0x24, 0xe8,
+	0x6a, 0xdf, 0x84, 0xc2, 0x70, 0x1a, 0x0e, 0xea, 0x9a, 0x7a, 0xb5, 0xb3, 0x39, 0x33, 0x4e, 0xc4,
+	0x93, 0xe4, 0xc6, 0x47, 0x78, 0x02, 0x2f, 0x9c, 0x24, 0xc4, 0x1b, 0x3f, 0x97, 0x12, 0xb7, 0x46,
+	0xee, 0x11, 0x9a, 0x60, 0xbb, 0x63, 0x5b, 0x64, 0x29, 0xb3, 0xfb, 0xb6, 0xd9, 0x26, 0x1a, 0xdf,
+	0x1a, 0xc7, 0xdc, 0x68, 0x5b, 0x24, 0x87, 0x94, 0x83, 0x4e, 0xdb, 0x74, 0x5a, 0x6d, 0x8b, 0x14,
+	0x04, 0x85, 0xb5, 0x9a, 0x0e, 0xd1, 0x29, 0x81, 0xe5, 0x3d, 0xd6, 0xd9, 0xdc, 0x6f, 0x5a, 0x3d,
+	0x7b, 0xbf, 0xdd, 0x26, 0x84, 0xbe, 0x06, 0x97, 0x32, 0x4c, 0x47, 0x20, 0x57, 0xb1, 0xcb, 0x81,
+	0xc9, 0x4c, 0xb6, 0x4d, 0x3e, 0x47, 0x0b, 0x6d, 0x6e, 0x6f, 0x93, 0x9f, 0xa1, 0x3f, 0xcf, 0x1f,
+	0xb6, 0x6c, 0xf2, 0xb3, 0x9c, 0xf1, 0xdb, 0x1c, 0x14, 0x50, 0xc1, 0x6f, 0x3e, 0xd6, 0xf4, 0x26,
+	0x68, 0x03, 0xbe, 0x73, 0xd5, 0x46, 0x55, 0xd0, 0xb8, 0x51, 0xdf, 0x59, 0x62, 0x1a, 0xce, 0x5a,
+	0x13, 0xe7, 0xb3, 0xda, 0x58, 0x11, 0xc4, 0xd4, 0xd8, 0x20, 0x7d, 0x4c, 0x6f, 0x81, 0x76, 0x22,
+	0x0f, 0xeb, 0xb2, 0xa0, 0x0b, 0x73, 0x83, 0xd4, 0x13, 0xba, 0x0a, 0xf9, 0x41, 0x24, 0x8c, 0x77,
+	0x46, 0x17, 0x97, 0x7d, 0x67, 0x89, 0x21, 0x09, 0xe5, 0x0f, 0xeb, 0x25, 0x55, 0x7e, 0xba, 0x2b,
+	0x28, 0x61, 0x48, 0xdf, 0x86, 0xfc, 0x64, 0xda, 0xe7, 0x7b, 0x5b, 0x6d, 0x5c, 0xbe, 0x70, 0xc7,
+	0x50, 0xcc, 0x64, 0xda, 0xa7, 0xef, 0x40, 0x61, 0x10, 0xc5, 0x71, 0x5d, 0x57, 0x8d, 0xec, 0xcc,
+	0xb4, 0xa0, 0x33, 0x40, 0x3a, 0x5d, 0x05, 0x2d, 0xa9, 0x57, 0x54, 0xa6, 0xd9, 0xed, 0xc7, 0x01,
+	0x13, 0xfa, 0x96, 0x34, 0x18, 0xa0, 0xea, 0x94, 0x9a, 0x13, 0x94, 0x83, 0xd4, 0x8d, 0x12, 0x14,
+	0xfc, 0x97, 0xe3, 0xd8, 0x38, 0x82, 0xea, 0xa6, 0x3f, 0x74, 0xa7, 0xa3, 0x84, 0x2f, 0xf4, 0x15,
+	0x28, 0xfa, 0x2f, 0x85, 0xb9, 0x41, 0xb3, 0x29, 0x00, 0xfa, 0xae, 0x34, 0xd5, 0x72, 0x91, 0x5f,
+	0x53, 0x16, 0xd9, 0x0d, 0x93, 0x03, 0x24, 0x31, 0xc1, 0x81, 0x67, 0x3d, 0x98, 0xf4, 0xb8, 0x27,
+	0xcd, 0xa7, 0x9e, 0xd4, 0x9e, 0x8e, 0x46, 0xc6, 0xdf, 0xe5, 0xa1, 0x36, 0xd7, 0x83, 0xde, 0x86,
+	0xca, 0x34, 0x7c, 0x11, 0x46, 0xa7, 0x61, 0xef, 0x44, 0xd8, 0xcb, 0x9d, 0x25, 0xa6, 0x4b, 0xd4,
+	0x01, 0xbd, 0x0e, 0xe5, 0x20, 0x4c, 0xd6, 0xd7, 0x7a, 0x27, 0x99, 0xf7, 0x2d, 0x71, 0xc4, 0x01,
+	0x6d, 0x40, 0x35, 0x73, 0x55, 0xbd, 0x93, 0x7a, 0x5e, 0x3d, 0xf5, 0xaa, 0x43, 0x83, 0x0c, 0x38,
+	0x50, 0xbc, 0xe0, 0xa3, 0xc6, 0x93, 0x5e, 0xba, 0xe5, 0x8b, 0xbc, 0x59, 0x75, 0x06, 0x1d, 0xd0,
+	0x9b, 0xa0, 0x4f, 0x53, 0x35, 0x8a, 0xd2, 0x59, 0x97, 0xa7, 0x52, 0x8f, 0xdb, 0x50, 0x19, 0x8e,
+	0x22, 0x37, 0x79, 0xdc, 0xe8, 0x9d, 0xd4, 0x4b, 0xd2, 0x69, 0xeb, 0x12, 0x35, 0x23, 0xf3, 0xce,
+	0x65, 0x19, 0x2b, 0xe8, 0x12, 0x75, 0x40, 0xaf, 0x41, 0x09, 0xdd, 0x74, 0xef, 0x24, 0x73, 0xeb,
+	0x45, 0x84, 0x0f, 0xe8, 0x1b, 0x00, 0xd8, 0x70, 0x82, 0x63, 0x24, 0xa6, 0x3e, 0xbd, 0x92, 0xe2,
+	0x0e, 0xe8, 0x5d, 0xa8, 0xa2, 0x2b, 0xed, 0xa2, 0x2b, 0xed, 0x9d, 0xd4, 0x41, 0x72, 0x40, 0x86,
+	0xe4, 0x7a, 0x4f, 0x92, 0x38, 0x08, 0x8f, 0x7a, 0x27, 0xf5, 0xaa, 0x0c, 0x48, 0xca, 0x02, 0xc3,
+	0x47, 0xee, 0x47, 0xd1, 0xa8, 0x77, 0x52, 0x5f, 0x96, 0x51, 0x49, 0x11, 0xe1, 0x83, 0x8d, 0x4b,
+	0x50, 0x1b, 0xa8, 0x7b, 0x64, 0x5c, 0x87, 0x4a, 0xb6, 0x86, 0x74, 0x19, 0x34, 0x57, 0x5a, 0x4d,
+	0xcd, 0x35, 0xee, 0x01, 0xcc, 0x16, 0x6a, 0x9e, 0x86, 0x50, 0x6a, 0x4b, 0xb5, 0xbe, 0xf1, 0xf3,
+	0x1c, 0xf7, 0xba, 0x9b, 0xaf, 0xf0, 0xe1, 0x6f, 0x41, 0xde, 0x1d, 0x1d, 0x71, 0xf6, 0x95, 0x06,
+	0x4d, 0xcf, 0xd6, 0xf1, 0x38, 0xf6, 0x27, 0x13, 0x71, 0xc9, 0xdd, 0xd1, 0x51, 0x6a, 0x02, 0xf2,
+	0x8b, 0x4d, 0xc0, 0x7b, 0x50, 0xf6, 0xc4, 0x31, 0xae, 0x17, 0xd4, 0x9b, 0xa6, 0x9c, 0x6d, 0x96,
+	0x72, 0xd0, 0x3a, 0x94, 0xc7, 0x71, 0x70, 0xec, 0xc6, 0x67, 0x22, 0x2a, 0x63, 0x29, 0x88, 0xc7,
+	0x7f, 0xfc, 0x22, 0xf0, 0x5e, 0xa6, 0xe9, 0x09, 0x07, 0x90, 0x7f, 0x10, 0x1d, 0x1f, 0xfb, 0x61,
+	0x22, 0x4d, 0x74, 0x0a, 0xd2, 0x9b, 0x50, 0x71, 0xa7, 0x49, 0xd4, 0x0b, 0xc2, 0x81, 0xb8, 0xba,
+	0x3a, 0xd3, 0x11, 0xd1, 0x0a, 0x07, 0x31, 0x1a, 0xef, 0x30, 0x4a, 0xc4, 0x5d, 0xa8, 0x88, 0x71,
+	0xc2, 0x28, 0xe1, 0x97, 0xe1, 0x97, 0x1a, 0xe8, 0xad, 0xd0, 0xf3, 0x5f, 0xe2, 0x9a, 0xdc, 0x57,
+	0xbd, 0x70, 0x5d, 0xe8, 0x9d, 0x12, 0x45, 0x63, 0x36, 0xcf, 0x74, 0xfd, 0x72, 0xca, 0xfa, 0xdd,
+	0x84, 0x0a, 0x06, 0x17, 0xd8, 0x9e, 0xd4, 0xf3, 0xab, 0xf9, 0x7b, 0x15, 0xa6, 0x0f, 0xa2, 0x11,
+	0x7a, 0x89, 0x89, 0xb1, 0x03, 0x95, 0x4c, 0x04, 0x46, 0xc7, 0x2d, 0xfb, 0xc0, 0x6c, 0xb5, 0x37,
+	0xc9, 0x12, 0x02, 0x5f, 0x76, 0x6c, 0x6b, 0xd7, 0xdc, 0x23, 0x1a, 0xcf, 0x9c, 0xba, 0x2d, 0x92,
+	0xe3, 0xf9, 0x8d, 0xdd, 0xfa, 0xc9, 0xbe, 0x45, 0xf2, 0x68, 0xdf, 0xb7, 0xf6, 0xdb, 0x6d, 0x1e,
+	0xb9, 0x17, 0x8c, 0xb7, 0xa1, 0xb6, 0x27, 0x96, 0xe9, 0xa9, 0x7f, 0x86, 0x7a, 0x5f, 0x81, 0xa2,
+	0x18, 0x53, 0xe3, 0x63, 0x0a, 0xc0, 0x68, 0x80, 0xbe, 0x17, 0x47, 0x63, 0x3f, 0x4e, 0xce, 0xd0,
+	0x57, 0xbe, 0xf0, 0xcf, 0xe4, 0x66, 0x63, 0x13, 0xfb, 0xcc, 0x2c, 0x49, 0x45, 0x1a, 0x0d, 0xe3,
+	0x33, 0xa8, 0xc9, 0x3e, 0x81, 0x3f, 0x41, 0xd1, 0x0f, 0x00, 0xc6, 0x19, 0x42, 0x86, 0x3e, 0xa9,
+	0xf5, 0x96, 0xc2, 0x99, 0xc2, 0x61, 0xfc, 0x65, 0x1e, 0x74, 0x07, 0x13, 0xd5, 0x57, 0x9d, 0xb1,
+	0x55, 0x34, 0xaf, 0xa3, 0xd4, 0xf7, 0xcd, 0x0c, 0xf9, 0x26, 0x7a, 0x47, 0xa4, 0xd0, 0xfb, 0x50,
+	0xf0, 0xfc, 0xa1, 0x58, 0xc0, 0x6a, 0x1a, 0x0c, 0xa5, 0x32, 0xf1, 0x1c, 0xf1, 0x4d, 0xe0, 0x3c,
+	0xf4, 0x2e, 0x14, 0x4e, 0x02, 0xff, 0x54, 0x1e, 0xb5, 0x9a, 0x74, 0x1b, 0x81, 0x7f, 0xca, 0xc5,
+	0x21, 0xe9, 0xc6, 0x1f, 0xe7, 0xa0, 0x2c, 0x3b, 0xd1, 0xb7, 0x21, 0x37, 0x7e, 0x51, 0xd7, 0x54,
+	0xdb, 0x39, 0xb7, 0x92, 0x3b, 0x4b, 0x2c, 0x37, 0x7e, 0x41, 0x0d, 0xc8, 0xe3, 0xd1, 0xcb, 0xa9,
+	0x76, 0x3b, 0x3d, 0x07, 0xe8, 0x26, 0xf0, 0x28, 0x7e, 0x38, 0xb7, 0x30, 0xf9, 0x79, 0x91, 0xca,
+	0x0a, 0xa2, 0x35, 0x98, 0x31, 0xd2, 0x77, 0x30, 0x2a, 0xf3, 0x07, 0x2f, 0xea, 0x05, 0x55, 0x78,
+	0x13, 0x51, 0x82, 0x59, 0x90, 0x51, 0xd3, 0xe1, 0x8b, 0x7a, 0x51, 0x15, 0xbb, 0x15, 0xc5, 0x7e,
+	0x70, 0x14, 0xce, 0x34, 0x1d, 0xbe, 0xa0, 0x0d, 0xa8, 0x8c, 0xdd, 0x38, 0x09, 0xd0, 0xcb, 0x49,
+	0xdf, 0x47, 0x33, 0xdf, 0x2a, 0xd0, 0x82, 0x79, 0xc6, 0xb6, 0x51, 0x84, 0xbc, 0xe7, 0x0f, 0xf1,
+	0x78, 0xa4, 0xc3, 0x2e, 0xdc, 0x28, 0x2a, 0xfc, 0x52, 0x7a, 0xc0, 0xb1, 0x6d, 0xfc, 0x55, 0x0e,
+	0x6a, 0x73, 0x6a, 0xbc, 0xaa, 0x67, 0xb6, 0xc5, 0x15, 0xb9, 0xa9, 0x77, 0x61, 0x79, 0xec, 0xc6,
+	0x7e, 0x98, 0xf4, 0x12, 0x5e, 0xd6, 0x10, 0x71, 0x6a, 0x55, 0xe0, 0xf8, 0xe6, 0x62, 0x0c, 0x26,
+	0x59, 0x78, 0xef, 0x02, 0xef, 0x0d, 0x02, 0xd5, 0x44, 0x19, 0x9f, 0x40, 0x25, 0x0a, 0x7b, 0x9e,
+	0x3f, 0xf2, 0x13, 0x11, 0xdc, 0xad, 0x34, 0x6e, 0x2f, 0x58, 0x9a, 0x07, 0xcc, 0x1f, 0x9a, 0xdc,
+	0xef, 0x33, 0x1d, 0xa7, 0x8f, 0xec, 0xb2, 0xef, 0x74, 0x8c, 0xa6, 0xbb, 0x5e, 0xfa, 0x8e, 0x7d,
+	0xf7, 0x39, 0xbb, 0xb1, 0x06, 0x95, 0x0c, 0x8d, 0x57, 0x91, 0x59, 0x32, 0xbc, 0xe2, 0x57, 0xb7,
+	0x69, 0x76, 0x9b, 0xe6, 0xa6, 0x45, 0x34, 0x24, 0x75, 0x2d, 0x47, 0x84, 0x54, 0x39, 0xe3, 0xcf,
+	0x73, 0xb0, 0xac, 0x6e, 0x02, 0x5d, 0x83, 0x42, 0x72, 0x36, 0xf6, 0xa5, 0x79, 0x59, 0xbd, 0xb8,
+	0x4d, 0x33, 0x40, 0x9c, 0x70, 0xe4, 0xc6, 0xc5, 0xe4, 0x01, 0xa5, 0xdc, 0x06, 0x6c, 0x67, 0x0b,
+	0x9c, 0x57, 0x16, 0xf8, 0x53, 0x80, 0x6c, 0x8b, 0xc5, 0xe2, 0x55, 0x1b, 0xb7, 0xbe, 0x69, 0x0c,
+	0xa6, 0xf0, 0xdf, 0xf8, 0x08, 0x2a, 0x19, 0xe1, 0x55, 0xe9, 0x9d, 0x2c, 0x0a, 0x89, 0x5d, 0x95,
+	0x90, 0xf1, 0x11, 0xd4, 0xe6, 0xb4, 0xc6, 0x54, 0x9f, 0x99, 0xf6, 0xb6, 0x25, 0x0a, 0x43, 0xed,
+	0x56, 0xd7, 0x11, 0x85, 0xa1, 0x1d, 0xb3, 0xbb, 0x43, 0x72, 0x68, 0xde, 0x9e, 0x5a, 0xcf, 0x48,
+	0xde, 0xb8, 0x0d, 0x65, 0x79, 0x4f, 0x71, 0x3c, 0x7e, 0x89, 0xe5, 0x78, 0xd8, 0x36, 0x62, 0x28,
+	0x34, 0xa3, 0x49, 0xc2, 0xa7, 0xea, 0xc6, 0xa2, 0x5e, 0xa6, 0x31, 0xde, 0x46, 0x2f, 0x10, 0x47,
+	0xa7, 0x3c, 0x6d, 0xc9, 0x71, 0x74, 0x0a, 0xa2, 0x99, 0x0b, 0x3d, 0x11, 0x86, 0x68, 0x0c, 0x9b,
+	0xbc, 0xcc, 0x95, 0xb8, 0xb1, 0x70, 0x46, 0x1a, 0x13, 0x00, 0x62, 0x93, 0x28, 0x91, 0xb5, 0x00,
+	0x8d, 0x09, 0x00, 0x7d, 0x41, 0x19, 0x2d, 0x91, 0x9b, 0xb8, 0x68, 0xca, 0x31, 0x37, 0x1a, 0x44,
+	0xd3, 0x30, 0x91, 0x29, 0x24, 0x26, 0x4b, 0x4d, 0x84, 0xe9, 0x6d, 0x00, 0xf4, 0x25, 0x92, 0x2a,
+	0xd2, 0xb0, 0x0a, 0x62, 0x04, 0x19, 0xcd, 0xf1, 0x74, 0x24, 0xf7, 0x47, 0x67, 0x02, 0x40, 0xdd,
+	0x82, 0xc7, 0x0d, 0xbe, 0x33, 0x45, 0x86, 0x4d, 0x8e, 0x59, 0x5f, 0xab, 0x17, 0x57, 0xf3, 0x98,
+	0xc0, 0x04, 0xeb, 0x6b, 0x88, 0x19, 0x3e, 0x6e, 0xd4, 0x4b, 0xab, 0xf9, 0x7b, 0x39, 0x86, 0x4d,
+	0x8e, 0x59, 0x5f, 0xab, 0x97, 0x57, 0xf3, 0x38, 0xa3, 0xa1, 0xf0, 0xfd, 0x93, 0xba, 0xce, 0x37,
+	0x41, 0x9b, 0x18, 0x87, 0x00, 0x2c, 0x3a, 0x9d, 0xf8, 0x09, 0xd7, 0xfa, 0x9d, 0x2c, 0x55, 0xd2,
+	0x54, 0xf3, 0x92, 0x1a, 0xcf, 0x2c, 0x75, 0xba, 0x3b, 0x67, 0x84, 0x6b, 0x33, 0x23, 0xec, 0x26,
+	0xae, 0x38, 0x4f, 0xc6, 0xbf, 0x6a, 0x50, 0xed, 0xc4, 0x9e, 0x1f, 0x6f, 0x9c, 0x75, 0xc7, 0x3e,
+	0xcf, 0x59, 0xb8, 0x39, 0xd0, 0x2e, 0x64, 0x93, 0x1c, 0x8f, 0x15, 0xc8, 0x41, 0x34, 0x1a, 0xb9,
+	0xdc, 0x12, 0x89, 0xc3, 0x3a, 0x43, 0xd0, 0x47, 0x50, 0x18, 0x8e, 0xdc, 0xa3, 0x7a, 0x5e, 0xbd,
+	0x79, 0x8a, 0xf8, 0xb4, 0x8d, 0x19, 0x0f, 0xe3, 0xac, 0xc6, 0x4f, 0xa1, 0xaa, 0x20, 0x79, 0x12,
+	0xd9, 0x6d, 0x8a, 0x53, 0xb5, 0x69, 0x75, 0x9b, 0x44, 0xa3, 0x97, 0xa0, 0x8a, 0x77, 0xad, 0xdb,
+	0xdb, 0x6a, 0xb1, 0xae, 0x43, 0x72, 0x3c, 0x2b, 0xe5, 0x88, 0xb6, 0xd9, 0x75, 0x48, 0x41, 0x71,
+	0xa1, 0xfa, 0x5c, 0xf2, 0x44, 0x8c, 0xbf, 0xd7, 0x00, 0xb6, 0x62, 0xf7, 0xd8, 0xdf, 0x88, 0xa6,
+	0xa1, 0x47, 0x1f, 0xcc, 0x5d, 0xcd, 0x1b, 0xd2, 0x30, 0x64, 0xf4, 0x07, 0xfc, 0x57, 0xb9, 0x94,
+	0xb7, 0x30, 0x60, 0xee, 0x23, 0xd2, 0xf7, 0x64, 0xbd, 0x63, 0x86, 0xc0, 0x00, 0x29, 0xad, 0x49,
+	0xcd, 0xaf, 0x14, 0xa2, 0x8d, 0x4f, 0xa0, 0x92, 0x89, 0xc3, 0xc2, 0xdb, 0x1e, 0xb3, 0x9a, 0xd6,
+	0x66, 0xcb, 0xde, 0x26, 0x4b, 0x38, 0xa3, 0xe6, 0x3e, 0x63, 0x96, 0xed, 0xf4, 0x58, 0xe7, 0x90,
+	0x68, 0x48, 0xdf, 0xea, 0xb4, 0xdb, 0x9d, 0x43, 0xa4, 0xe7, 0x8c, 0xbf, 0xd1, 0xa0, 0xca, 0xd5,
+	0x6a, 0x8e, 0xdc, 0xe9, 0xc4, 0xa7, 0x0f, 0xe7, 0xf4, 0xbe, 0xa9, 0xe8, 0x2d, 0x18, 0x44, 0x5b,
+	0x51, 0xfc, 0x9d, 0xf4, 0x3a, 0xe4, 0xd4, 0xa0, 0x7b, 0x36, 0xd3, 0xf4, 0x82, 0x18, 0x90, 0xf7,
+	0x43, 0xaf, 0x9e, 0x7f, 0x05, 0x17, 0x12, 0x8d, 0x55, 0xa8, 0x64, 0xe2, 0x71, 0x57, 0x58, 0xe7,
+	0xb0, 0x4b, 0x96, 0x66, 0x06, 0x40, 0x33, 0xfe, 0x41, 0x03, 0x38, 0x0c, 0x42, 0x2f, 0x3a, 0xe5,
+	0x47, 0xe8, 0x7d, 0xee, 0x03, 0x84, 0xad, 0xe8, 0xf5, 0xcf, 0x16, 0x14, 0x52, 0xaa, 0x33, 0x2f,
+	0x75, 0x46, 0xbf, 0x0f, 0x7a, 0x84, 0x07, 0x00, 0x59, 0xc5, 0x41, 0xbd, 0x7c, 0xe1, 0xdc, 0xb0,
+	0x72, 0x24, 0x00, 0x34, 0x14, 0x23, 0xdf, 0xf5, 0x64, 0xf9, 0x86, 0xb7, 0xf1, 0xf2, 0xe0, 0xa1,
+	0x13, 0xf5, 0x70, 0x6c, 0xd2, 0xef, 0x41, 0x71, 0x18, 0xa7, 0xb5, 0x81, 0x4c, 0xa0, 0xb2, 0x62,
+	0x4c, 0xd0, 0x8d, 0xff, 0xd0, 0x00, 0x84, 0xf9, 0x6f, 0x85, 0xc3, 0x08, 0x93, 0xa9, 0x71, 0x1c,
+	0xf4, 0x66, 0x31, 0x54, 0x69, 0x1c, 0x07, 0x4f, 0xfd, 0x33, 0x7a, 0x07, 0xaa, 0x92, 0xd0, 0x4b,
+	0x43, 0x06, 0x5e, 0x7a, 0x47, 0x62, 0xcb, 0x7b, 0x89, 0xa1, 0xe7, 0xf3, 0xc0, 0xf3, 0x79, 0x4f,
+	0xe1, 0xf3, 0xca, 0x08, 0x63, 0xd7, 0xbb, 0xb0, 0x2c, 0xfc, 0x51, 0xcf, 0x4d, 0x92, 0x38, 0x75,
+	0x78, 0x55, 0x81, 0x33, 0x11, 0x85, 0x2e, 0x31, 0x4a, 0x9e, 0xfb, 0xb1, 0xe4, 0x28, 0x72, 0x0e,
+	0xe0, 0xa8, 0x8c, 0x01, 0x49, 0x3d, 0xbe, 0x0a, 0x13, 0x6e, 0x38, 0x2a, 0x0c, 0x10, 0xc5, 0x17,
+	0x89, 0xfb, 0xdd, 0xd8, 0x77, 0x3d, 0x74, 0xa9, 0xd3, 0xe3, 0x50, 0x94, 0xf4, 0x75, 0x56, 0x45,
+	0x5c, 0x53, 0xa0, 0xb0, 0x2a, 0x53, 0x35, 0x43, 0x77, 0x74, 0xf6, 0xb5, 0x98, 0xeb, 0x6d, 0x80,
+	0x20, 0x1c, 0x4f, 0x93, 0x1e, 0x5a, 0x55, 0x99, 0x49, 0x54, 0x38, 0x06, 0x2d, 0x0d, 0xd7, 0x69,
+	0x9a, 0x64, 0x74, 0x91, 0x5b, 0x80, 0x40, 0x71, 0x86, 0xac, 0x3f, 0xb7, 0xd0, 0x79, 0xa5, 0x3f,
+	0x96, 0x96, 0x94, 0xfe, 0x9c, 0x5e, 0x50, 0xfb, 0x73, 0x86, 0x37, 0xa1, 0x86, 0xe9, 0x53, 0x0f,
+	0xf3, 0x9f, 0xe9, 0xb1, 0xef, 0xf1, 0xbd, 0xca, 0x8b, 0x9a, 0x65, 0x53, 0xe2, 0x50, 0xca, 0xb1,
+	0x7f, 0x1c, 0xc5, 0x67, 0x42, 0x4a, 0x49, 0x48, 0x11, 0x28, 0x5e, 0xc1, 0xfa, 0xd3, 0x1a, 0x14,
+	0xec, 0xc8, 0xf3, 0xe9, 0x07, 0x50, 0xe1, 0x05, 0x33, 0xe5, 0xa2, 0xc8, 0x80, 0x0a, 0xc9, 0xfc,
+	0x87, 0x5f, 0x10, 0x3d, 0x94, 0xad, 0x57, 0x97, 0xd8, 0xee, 0xa0, 0xd9, 0x9c, 0x24, 0xf3, 0x37,
+	0x1b, 0xdd, 0x14, 0xe3, 0x78, 0x7e, 0xc0, 0xe3, 0x08, 0x6b, 0x3d, 0x3d, 0x9e, 0xf8, 0x17, 0x16,
+	0x1c, 0x70, 0x41, 0xe7, 0x05, 0xc5, 0x1b, 0xa0, 0xf3, 0x42, 0x5c, 0xec, 0x87, 0x7c, 0x6b, 0x8b,
+	0x2c, 0x83, 0x51, 0xeb, 0xaf, 0xa2, 0x20, 0x14, 0x5a, 0x97, 0x2e, 0x68, 0xfd, 0xe3, 0x28, 0x08,
+	0xb9, 0xad, 0xd4, 0x91, 0x8b, 0x6b, 0xfd, 0x26, 0x94, 0xa3, 0x50, 0x8c, 0x5b, 0xbe, 0x30, 0x6e,
+	0x29, 0x0a, 0xf9, 0x90, 0xef, 0x41, 0x75, 0x18, 0x8c, 0x12, 0x3f, 0x16, 0x8c, 0xfa, 0x05, 0x46,
+	0x10, 0x64, 0xce, 0xfc, 0x36, 0xe8, 0x47, 0x71, 0x34, 0x1d, 0xe3, 0x05, 0xac, 0x5c, 0xe0, 0x2c,
+	0x73, 0xda, 0xc6, 0x19, 0xce, 0x9a, 0x37, 0x31, 0xc5, 0x9d, 0xf8, 0x58, 0xee, 0xb8, 0x30, 0xeb,
+	0x94, 0xde, 0xf5, 0xb9, 0x54, 0xf7, 0xe8, 0x48, 0x8c, 0x5f, 0xbd, 0x28, 0xd5, 0x3d, 0x3a, 0xe2,
+	0x83, 0xab, 0xb7, 0x7f, 0xf9, 0x5b, 0x6f, 0xff, 0x23, 0x90, 0xf7, 0xa6, 0x17, 0x84, 0xc3, 0xa8,
+	0x5e, 0x53, 0xed, 0xd6, 0xec, 0x1a, 0x33, 0x98, 0x66, 0x6d, 0xfa, 0x1e, 0xe8, 0xa7, 0x41, 0xd8,
+	0x9b, 0x8c, 0xfd, 0x41, 0x7d, 0x45, 0xe5, 0x9f, 0x59, 0x2c, 0x56, 0x3e, 0x0d, 0x42, 0x6c, 0x60,
+	0x31, 0x75, 0x14, 0x1c, 0x07, 0x49, 0xfd, 0xd2, 0xc5, 0x62, 0x2a, 0x27, 0x50, 0x03, 0x4a, 0xd1,
+	0x70, 0x88, 0xf3, 0x27, 0x17, 0x58, 0x24, 0x85, 0xbe, 0x07, 0x15, 0x1e, 0xfd, 0xf6, 0x3c, 0x7f,
+	0x58, 0xbf, 0xbc, 0xd0, 0x43, 0xeb, 0x89, 0x6c, 0xd1, 0x7b, 0x80, 0x15, 0xc6, 0x5e, 0xec, 0x0f,
+	0xeb, 0x74, 0x71, 0x31, 0xb1, 0x14, 0xf5, 0xbf, 0xc2, 0x42, 0xea, 0x23, 0xa8, 0xc6, 0x3c, 0x06,
+	0xe8, 0x79, 0x6e, 0xe2, 0xd6, 0x5f, 0x53, 0x27, 0x33, 0x0b, 0x0e, 0x18, 0xc4, 0x59, 0x1b, 0xef,
+	0x98, 0xff, 0x32, 0x89, 0xdd, 0x5e, 0x34, 0x16, 0x01, 0xe3, 0x15, 0x6e, 0x9b, 0x96, 0x39, 0xb2,
+	0x23, 0x70, 0xf4, 0x47, 0x70, 0x49, 0x04, 0xdb, 0x5c, 0xbb, 0x49, 0x33, 0x79, 0x59, 0x7f, 0x9d,
+	0xef, 0xc4, 0x95, 0x34, 0xa5, 0xcf, 0x88, 0xcd, 0xe4, 0x25, 0x3b, 0xcf, 0x8c, 0xb6, 0xa7, 0x1f,
+	0x84, 0x1e, 0x9e, 0x8b, 0xc4, 0x3d, 0x9a, 0xd4, 0xaf, 0xf2, 0x33, 0x5e, 0x95, 0x38, 0xc7, 0x3d,
+	0x9a, 0xd0, 0x35, 0x58, 0x76, 0x85, 0xe9, 0x11, 0x1b, 0x77, 0x4d, 0x35, 0xcb, 0x8a, 0x51, 0x62,
+	0x55, 0x77, 0x06, 0xe0, 0x2b, 0xa5, 0x12, 0xeb, 0xd6, 0xb3, 0x44, 0x41, 0x62, 0xe8, 0x43, 0xa8,
+	0x0c, 0x31, 0x3e, 0x4b, 0xfc, 0x97, 0x49, 0xfd, 0xfa, 0x6a, 0x7e, 0x96, 0x15, 0x6d, 0x4d, 0x47,
+	0x23, 0xc7, 0x7f, 0x99, 0x74, 0x07, 0x6e, 0xc8, 0xf4, 0xa1, 0x84, 0x8c, 0xdf, 0xe4, 0x41, 0x4f,
+	0x0d, 0x01, 0x7f, 0x4c, 0xb4, 0x9f, 0xda, 0x9d, 0x43, 0x9b, 0x2c, 0x61, 0x48, 0x71, 0x60, 0xb6,
+	0xf7, 0xad, 0x5e, 0xb7, 0x69, 0xda, 0xa2, 0xf0, 0xcd, 0x8b, 0xae, 0x02, 0xce, 0xd1, 0xcb, 0x50,
+	0xdb, 0xda, 0xb7, 0x9b, 0x4e, 0xab, 0x63, 0x0b, 0x54, 0x1e, 0x51, 0xd6, 0x17, 0x22, 0xd2, 0x10,
+	0xa8, 0x02, 0xa2, 0x76, 0x4d, 0xc7, 0x62, 0xad, 0x14, 0x55, 0xc4, 0x51, 0xf6, 0x58, 0xe7, 0xc7,
+	0x56, 0xd3, 0x21, 0x40, 0x5f, 0x87, 0xcb, 0x59, 0x97, 0x54, 0x1c, 0xa9, 0x62, 0xcc, 0x92, 0x76,
+	0x23, 0x57, 0x50, 0x08, 0xb3, 0x9a, 0xfb, 0xac, 0xdb, 0x3a, 0xb0, 0x7a, 0x4d, 0xc7, 0x22, 0xaf,
+	0xf3, 0xa7, 0xd7, 0x96, 0xfd, 0x94, 0x5c, 0xc5, 0x40, 0x01, 0x5b, 0x42, 0xfa, 0x35, 0x1e, 0x2d,
+	0x6d, 0x6f, 0x93, 0x3b, 0xfc, 0x21, 0xb1, 0xd5, 0x75, 0x5a, 0x76, 0xd3, 0x21, 0x6f, 0x60, 0x40,
+	0xb4, 0xd5, 0x6a, 0x3b, 0x16, 0x23, 0xab, 0xfc, 0x4d, 0xb0, 0xd3, 0xb2, 0xc9, 0x5d, 0xc4, 0x76,
+	0xcd, 0x5d, 0x7c, 0x89, 0x33, 0xb8, 0xc4, 0x0e, 0x73, 0xc8, 0x9b, 0xfc, 0x85, 0xd2, 0x46, 0x3d,
+	0xde, 0x42, 0xe1, 0xbc, 0xd9, 0xc3, 0x32, 0xfe, 0xdb, 0x4a, 0x58, 0xf5, 0x0e, 0xb6, 0x0f, 0x5b,
+	0xf6, 0x66, 0xe7, 0x90, 0x7c, 0x0f, 0xd9, 0x36, 0x58, 0xc7, 0xdc, 0x6c, 0x62, 0xf4, 0xc5, 0x9f,
+	0x43, 0xbb, 0x7b, 0xed, 0x96, 0x43, 0xde, 0x45, 0xae, 0x6d, 0xd3, 0xd9, 0xb1, 0x18, 0xb9, 0x8f,
+	0x6d, 0xb3, 0xdb, 0xb5, 0x98, 0x43, 0x1a, 0xe2, 0xc9, 0x97, 0xb7, 0x1f, 0x73, 0xa9, 0x7b, 0xfc,
+	0x21, 0x74, 0x0d, 0xdb, 0x9b, 0x56, 0xdb, 0x72, 0x2c, 0xf2, 0x21, 0x4a, 0xe5, 0x81, 0x5b, 0x17,
+	0x97, 0x6a, 0x1d, 0x57, 0x21, 0x03, 0xb9, 0x3e, 0x1f, 0xe1, 0x40, 0xbb, 0x2d, 0x7b, 0xbf, 0x4b,
+	0x9e, 0x20, 0x33, 0x6f, 0x72, 0xca, 0xc7, 0xc6, 0x57, 0xa0, 0xa7, 0x96, 0x52, 0xbc, 0x34, 0xdb,
+	0x16, 0x93, 0x89, 0x89, 0xb5, 0x85, 0x89, 0x09, 0x06, 0x2b, 0xad, 0xed, 0x1d, 0x0c, 0x1e, 0x2b,
+	0x50, 0xec, 0xec, 0xe3, 0xd2, 0xe4, 0xf9, 0x22, 0x58, 0xbb, 0x2d, 0x52, 0xc0, 0x96, 0x69, 0x3b,
+	0x2d, 0x52, 0xe4, 0x8b, 0xd4, 0xb2, 0xb7, 0xdb, 0x16, 0x29, 0x21, 0x76, 0xd7, 0x64, 0x4f, 0x49,
+	0x19, 0x3b, 0x99, 0x7b, 0x7b, 0xed, 0x67, 0x44, 0x37, 0xee, 0x41, 0xd9, 0x3c, 0x3a, 0xda, 0x45,
+	0x97, 0xa3, 0x43, 0x01, 0xcb, 0x35, 0xe2, 0xcd, 0x64, 0xa3, 0xe3, 0x38, 0x9d, 0x5d, 0x51, 0xd9,
+	0x71, 0x3a, 0x7b, 0x24, 0x67, 0xfc, 0xb3, 0x06, 0xcb, 0xea, 0x41, 0xc4, 0xd8, 0x05, 0x5d, 0x76,
+	0x9a, 0x00, 0x61, 0x7b, 0xbe, 0x96, 0x94, 0x9b, 0xaf, 0x25, 0x61, 0x86, 0xc1, 0xcb, 0xd6, 0x32,
+	0xa4, 0x10, 0x00, 0x06, 0xd9, 0xc7, 0x91, 0x27, 0x5c, 0xea, 0x2c, 0xbd, 0x55, 0x06, 0x7a, 0xd0,
+	0xf5, 0xdd, 0x78, 0xf0, 0x1c, 0x75, 0x64, 0x9c, 0x15, 0xd3, 0x3a, 0x61, 0xf0, 0x65, 0xfd, 0x4d,
+	0x42, 0xc6, 0x43, 0x80, 0x19, 0x2f, 0xbd, 0x02, 0xc4, 0x36, 0x9d, 0x7d, 0x66, 0xb6, 0x7b, 0x6d,
+	0xd3, 0xde, 0xde, 0x37, 0x79, 0x7a, 0x87, 0x2f, 0xbc, 0x9d, 0x4e, 0xdb, 0xc2, 0x7b, 0x61, 0xfc,
+	0xb5, 0x06, 0x2b, 0xf3, 0xf6, 0x00, 0x65, 0x8b, 0xd7, 0x95, 0x34, 0x64, 0x12, 0x10, 0xa6, 0x6f,
+	0x49, 0x9f, 0x4f, 0x44, 0xe6, 0x09, 0x29, 0x48, 0x0d, 0x58, 0x9e, 0x4e, 0x7c, 0x21, 0xe6, 0x69,
+	0x16, 0x30, 0xcd, 0xe1, 0xe8, 0x2a, 0x54, 0x07, 0x6e, 0xe8, 0xc4, 0xd3, 0x70, 0xe0, 0x26, 0x62,
+	0xae, 0x3a, 0x53, 0x51, 0xc8, 0xa1, 0x84, 0x37, 0x72, 0x62, 0x2a, 0xca, 0xf8, 0xa3, 0x1c, 0x14,
+	0x7f, 0xc2, 0x97, 0x6c, 0x1d, 0x2a, 0x93, 0xe4, 0x38, 0x51, 0x83, 0x83, 0xeb, 0x62, 0xdd, 0x38,
+	0xfd, 0x41, 0x37, 0x71, 0x13, 0x1f, 0x0b, 0x8c, 0x22, 0x44, 0x40, 0x5e, 0x6c, 0x89, 0xb4, 0xd2,
+	0x1f, 0x8b, 0x9d, 0x29, 0x32, 0x01, 0xa0, 0x97, 0xc0, 0x48, 0x21, 0x2d, 0x5d, 0xc1, 0xcc, 0x61,
+	0x33, 0x41, 0x40, 0x2f, 0x31, 0xc6, 0x47, 0x8f, 0xc9, 0x82, 0xd8, 0x40, 0x52, 0x30, 0x2c, 0x78,
+	0xee, 0xbb, 0x68, 0x22, 0xd3, 0x88, 0x2f, 0x83, 0x8d, 0x43, 0xa8, 0xcd, 0xa9, 0x34, 0x6f, 0xac,
+	0xf0, 0x8c, 0x5a, 0x6d, 0xbc, 0x27, 0x9a, 0x72, 0xb5, 0x72, 0xca, 0x75, 0xca, 0x2b, 0xd7, 0xac,
+	0xc0, 0x2f, 0x8e, 0xc5, 0xb6, 0x2d, 0x52, 0x34, 0xfe, 0x22, 0x07, 0x97, 0x9d, 0xd8, 0x0d, 0x27,
+	0x3c, 0x5f, 0x6b, 0x46, 0x61, 0x12, 0x47, 0x23, 0xfa, 0x09, 0xe8, 0xc9, 0x60, 0xa4, 0xae, 0xce,
+	0x1b, 0xd2, 0x5f, 0x9d, 0x67, 0x7d, 0xe0, 0x0c, 0x46, 0x7c, 0x8d, 0xca, 0x89, 0x68, 0xd0, 0xf7,
+	0xa1, 0xd8, 0xf7, 0x8f, 0x82, 0x50, 0xa6, 0x1a, 0xaf, 0x9f, 0xef, 0xb8, 0x81, 0x44, 0x5e, 0xf0,
+	0xc6, 0x06, 0xfd, 0x00, 0x4a, 0x58, 0xcb, 0x0d, 0xd2, 0xe8, 0xea, 0xea, 0xc5, 0x81, 0x90, 0x8a,
+	0x6f, 0x0f, 0x82, 0x8f, 0xae, 0xe3, 0xf3, 0xe5, 0x68, 0xd4, 0x77, 0xb3, 0x6a, 0x5a, 0xfd, 0x7c,
+	0x1f, 0x26, 0xe9, 0x58, 0xed, 0x4f, 0x79, 0x8d, 0x07, 0x50, 0x96, 0xca, 0xf2, 0xef, 0x12, 0xac,
+	0xed, 0x96, 0x5c, 0xbb, 0x66, 0x67, 0x77, 0xb7, 0xe5, 0x88, 0x42, 0x0e, 0xeb, 0xb4, 0xdb, 0x1b,
+	0x66, 0xf3, 0x29, 0xc9, 0x6d, 0xe8, 0x50, 0x72, 0x79, 0xed, 0xc7, 0xf8, 0x43, 0x0d, 0x2e, 0x9d,
+	0x9b, 0x00, 0x7d, 0x22, 0x2f, 0x9d, 0x58, 0x9e, 0xb7, 0x16, 0xce, 0x52, 0x81, 0x67, 0x77, 0xcf,
+	0xf8, 0x18, 0x56, 0xe6, 0xf1, 0xca, 0x53, 0x5f, 0x0d, 0x2a, 0xcc, 0x32, 0x37, 0x7b, 0x1d, 0xbb,
+	0xfd, 0x4c, 0x78, 0x1d, 0x0e, 0x1e, 0xb2, 0x96, 0x63, 0x91, 0x9c, 0xf1, 0x53, 0x20, 0xe7, 0x17,
+	0x86, 0x6e, 0xc3, 0xa5, 0x41, 0x74, 0x3c, 0x1e, 0xf9, 0x88, 0x53, 0xb7, 0xec, 0xce, 0x82, 0x95,
+	0x94, 0x6c, 0x7c, 0xc7, 0x56, 0x06, 0x73, 0xb0, 0xf1, 0xff, 0x80, 0x5e, 0x5c, 0xc1, 0xff, 0x3b,
+	0xf1, 0xbf, 0xd4, 0xa0, 0xb0, 0x37, 0x72, 0xf1, 0xa9, 0x54, 0x1a, 0x31, 0x4d, 0x7d, 0x30, 0xe4,
+	0xf7, 0x0e, 0x8f, 0x05, 0xa7, 0xd1, 0xf7, 0x20, 0x9f, 0x0c, 0x46, 0xf2, 0x0c, 0x5d, 0x7b, 0xc5,
+	0xe1, 0xc3, 0x9a, 0x6c, 0x32, 0x18, 0xe1, 0x2b, 0xba, 0xe7, 0xa5, 0x89, 0x77, 0x1a, 0xa4, 0xb8,
+	0x89, 0xbb, 0xe9, 0x0f, 0x83, 0x30, 0x90, 0x2f, 0x81, 0xc8, 0x82, 0x6f, 0x81, 0xde, 0x60, 0x74,
+	0xee, 0x85, 0xc2, 0x4d, 0x5c, 0x45, 0xa0, 0x37, 0x18, 0xe1, 0xdb, 0x1c, 0x92, 0x8c, 0xff, 0xce,
+	0x41, 0x55, 0x21, 0xd3, 0x35, 0xd0, 0xbd, 0xc1, 0x68, 0x81, 0xd5, 0x50, 0x98, 0x1e, 0x6c, 0xa6,
+	0x37, 0xc2, 0x13, 0x0d, 0xfa, 0x31, 0xd4, 0x30, 0x48, 0x3b, 0x71, 0xe3, 0x80, 0xc7, 0x48, 0x72,
+	0x56, 0x32, 0x34, 0xe9, 0xfa, 0xc9, 0x41, 0x4a, 0xc1, 0x4f, 0x34, 0x26, 0x0a, 0x4c, 0xdf, 0xc5,
+	0xfc, 0xd3, 0x1f, 0xbb, 0xb1, 0x2f, 0x67, 0x57, 0x4b, 0x4b, 0xcd, 0x1c, 0x89, 0x4f, 0x4a, 0x92,
+	0x8e, 0xac, 0xfe, 0x4b, 0x7f, 0x30, 0x95, 0xc6, 0x31, 0x63, 0xb5, 0x04, 0x12, 0x59, 0x25, 0x9d,
+	0x36, 0x00, 0x3c, 0xdf, 0x1d, 0x8d, 0x22, 0x6e, 0x4a, 0x8b, 0x6a, 0xdc, 0xb8, 0x99, 0xe1, 0xc5,
+	0xeb, 0x5d, 0x0a, 0x19, 0x47, 0x50, 0x96, 0x13, 0x43, 0x57, 0x8c, 0xf5, 0xce, 0x03, 0x93, 0xb5,
+	0x30, 0x24, 0x92, 0xc9, 0xff, 0x36, 0x33, 0x6d, 0x69, 0x80, 0x98, 0x75, 0xd0, 0x79, 0x8a, 0xef,
+	0xd3, 0xbc, 0x66, 0x63, 0x3f, 0x23, 0x79, 0x11, 0xf6, 0x58, 0x7b, 0x26, 0x43, 0xfb, 0x53, 0x85,
+	0xb2, 0xf5, 0x85, 0xd5, 0xdc, 0x77, 0x2c, 0x52, 0x14, 0x5f, 0x63, 0x99, 0xed, 0x76, 0xa7, 0x89,
+	0xc6, 0xa9, 0xb4, 0x51, 0xc1, 0xb7, 0x1e, 0xbe, 0x92, 0xc6, 0x3f, 0x56, 0x60, 0x65, 0x7e, 0x1f,
+	0xe9, 0x47, 0xa0, 0x7b, 0xde, 0xdc, 0x0e, 0xdc, 0x5a, 0xb4, 0xdf, 0x0f, 0x36, 0xbd, 0x74, 0x13,
+	0x44, 0x83, 0xde, 0x4d, 0x4f, 0x5d, 0xee, 0xc2, 0xa9, 0x4b, 0xcf, 0xdc, 0x67, 0x70, 0x69, 0x10,
+	0xfb, 0x98, 0x4c, 0x60, 0x3c, 0xdd, 0x77, 0x27, 0xfe, 0xfc, 0x91, 0x6a, 0x72, 0xe2, 0xa6, 0xa4,
+	0xed, 0x2c, 0xb1, 0x95, 0xc1, 0x1c, 0x86, 0x7e, 0x0a, 0x2b, 0x2e, 0x4f, 0xb2, 0xb2, 0xfe, 0x05,
+	0xb5, 0x90, 0x6f, 0x22, 0x4d, 0xe9, 0x5e, 0x73, 0x55, 0x04, 0x1e, 0x13, 0x2f, 0x8e, 0xc6, 0xb3,
+	0xce, 0x45, 0xf5, 0x98, 0x6c, 0xc6, 0xd1, 0x58, 0xe9, 0xbb, 0xec, 0x29, 0x30, 0x5d, 0x87, 0x65,
+	0xa9, 0xb9, 0xa8, 0xb2, 0x97, 0xd4, 0xf3, 0x2d, 0xd4, 0xe6, 0xee, 0x19, 0xdf, 0x56, 0x07, 0x33,
+	0x90, 0x3e, 0x86, 0xaa, 0x50, 0x58, 0x74, 0x2b, 0xab, 0x27, 0x81, 0x6b, 0x9b, 0xf6, 0x02, 0x37,
+	0x83, 0xe8, 0x07, 0x00, 0x5c, 0x4f, 0xd1, 0x47, 0x57, 0x73, 0x14, 0x54, 0x32, 0xed, 0x52, 0xf1,
+	0x52, 0x40, 0x51, 0x2f, 0xc0, 0xd7, 0x94, 0x7a, 0xe5, 0xa2, 0x7a, 0xfc, 0x99, 0x65, 0xa6, 0x1e,
+	0x07, 0x67, 0xea, 0x89, 0x6e, 0x70, 0x41, 0xbd, 0xb4, 0x17, 0xb8, 0x19, 0x94, 0xa9, 0x27, 0xfa,
+	0x54, 0xcf, 0xab, 0x97, 0x76, 0xa9, 0x78, 0x29, 0x80, 0xdb, 0x96, 0xc8, 0x20, 0x42, 0x4e, 0x6a,
+	0x59, 0xdd, 0xb6, 0x34, 0xc0, 0x48, 0x27, 0x56, 0x4b, 0x54, 0x04, 0xf6, 0x9e, 0x3c, 0x8f, 0x4e,
+	0x95, 0xeb, 0x5d, 0x53, 0x7b, 0x77, 0x9f, 0x47, 0xa7, 0xea, 0xfd, 0xae, 0x4d, 0x54, 0x84, 0xf1,
+	0xeb, 0x3c, 0x94, 0xe5, 0x59, 0xc5, 0x2f, 0x34, 0x9a, 0xcc, 0x32, 0x1d, 0xab, 0xb7, 0x69, 0x3a,
+	0xe6, 0x86, 0xd9, 0x45, 0x8f, 0x40, 0x61, 0xc5, 0xc4, 0xc8, 0x7d, 0x86, 0xd3, 0xf0, 0x02, 0x6e,
+	0xb2, 0xce, 0xde, 0x0c, 0x95, 0xc3, 0xef, 0x3d, 0x64, 0x5f, 0xf1, 0x6d, 0x48, 0x1e, 0x6b, 0x8a,
+	0xa2, 0xa3, 0x40, 0x14, 0xf8, 0x45, 0xc3, 0x5e, 0x02, 0x2e, 0x2a, 0x5d, 0x5a, 0xf6, 0xa6, 0xf5,
+	0x05, 0x29, 0xcd, 0xba, 0x08, 0x44, 0x39, 0xeb, 0x22, 0x60, 0x1d, 0x95, 0x71, 0xd8, 0xbe, 0xdd,
+	0x9c, 0x8d, 0x53, 0xa1, 0xd7, 0xe0, 0xb5, 0xee, 0x4e, 0xe7, 0xb0, 0x27, 0x64, 0x65, 0x2a, 0x01,
+	0x46, 0x8f, 0x0a, 0x41, 0xb0, 0x57, 0x51, 0x04, 0xc7, 0xa6, 0x8c, 0x5d, 0xb2, 0x8c, 0xe3, 0x72,
+	0x9c, 0x23, 0xcc, 0x49, 0x0d, 0x55, 0x13, 0x5d, 0x3b, 0xed, 0xfd, 0x5d, 0xbb, 0x4b, 0x56, 0x50,
+	0x13, 0x8e, 0x11, 0x9a, 0x5c, 0xca, 0xc4, 0xcc, 0x8c, 0x10, 0xe1, 0x76, 0x09, 0x71, 0x87, 0x26,
+	0xb3, 0x5b, 0xf6, 0x76, 0x97, 0x5c, 0xce, 0x24, 0x5b, 0x8c, 0x75, 0x58, 0x97, 0xd0, 0x0c, 0xd1,
+	0x75, 0x4c, 0x67, 0xbf, 0x4b, 0x5e, 0xcb, 0xb4, 0xdc, 0x63, 0x9d, 0xa6, 0xd5, 0xed, 0xf2, 0x87,
+	0x8b, 0x2b, 0xc8, 0x26, 0xd7, 0xe6, 0xa0, 0x65, 0x1d, 0x92, 0xd7, 0xf9, 0x27, 0xa4, 0xb8, 0x12,
+	0x1c, 0xbc, 0x8a, 0x5b, 0xa5, 0xcc, 0x8d, 0x23, 0xaf, 0x6d, 0x2c, 0xa3, 0x59, 0x4d, 0x2d, 0x90,
+	0xb1, 0x07, 0x2b, 0xf3, 0x06, 0x83, 0x1a, 0x50, 0x0b, 0x86, 0x3d, 0x7c, 0x91, 0xe6, 0x1f, 0x75,
+	0x4c, 0xe4, 0x27, 0x1e, 0xd5, 0x60, 0x68, 0x47, 0x89, 0xc5, 0x51, 0x18, 0x04, 0x66, 0xf7, 0x5f,
+	0x44, 0xc9, 0x19, 0x6c, 0xec, 0x40, 0x6d, 0xce, 0x84, 0x60, 0xae, 0x10, 0x0c, 0xe7, 0x85, 0xe9,
+	0xc1, 0xf0, 0x3b, 0x48, 0xda, 0x86, 0x65, 0xd5, 0x9e, 0xfc, 0xfe, 0x82, 0xfe, 0x56, 0x83, 0xaa,
+	0x62, 0x5f, 0xbe, 0xd3, 0x14, 0x6f, 0x41, 0x25, 0xf1, 0x8f, 0xc7, 0x51, 0xec, 0x4a, 0x6b, 0xac,
+	0xb3, 0x19, 0x62, 0x6e, 0xb4, 0xfc, 0xfc, 0x68, 0xf3, 0x75, 0x94, 0xc2, 0xb7, 0xd4, 0x51, 0xf0,
+	0xb5, 0xc8, 0x1f, 0x8f, 0xdc, 0x81, 0x9f, 0x7e, 0x63, 0x20, 0x41, 0xe3, 0x4f, 0x8a, 0x00, 0x33,
+	0xeb, 0xc6, 0x1f, 0x85, 0xb0, 0x21, 0xd3, 0x15, 0x01, 0xcc, 0x8f, 0x95, 0xfb, 0x96, 0xb1, 0xbe,
+	0x49, 0xe9, 0x47, 0x50, 0x16, 0x61, 0x64, 0x1a, 0xfb, 0x5f, 0x3b, 0x6f, 0x5f, 0x1f, 0xc8, 0x97,
+	0xc7, 0x94, 0xef, 0xc6, 0x7f, 0xe5, 0xa1, 0x24, 0x70, 0xf4, 0x13, 0x00, 0xd7, 0x4b, 0xcb, 0xb8,
+	0x32, 0x62, 0xba, 0x7e, 0x5e, 0x80, 0xe9, 0xc9, 0x14, 0x07, 0xed, 0x9a, 0x9b, 0x02, 0xf4, 0x87,
+	0x50, 0xe5, 0x96, 0x50, 0x76, 0x16, 0x93, 0xb8, 0x71, 0xbe, 0x33, 0x1e, 0x84, 0xac, 0x37, 0x78,
+	0x19, 0x44, 0x9b, 0x50, 0x8b, 0x7d, 0xcc, 0x43, 0x53, 0x01, 0xc2, 0x19, 0xde, 0x3a, 0x2f, 0x80,
+	0x71, 0xa6, 0x4c, 0xc4, 0x72, 0xac, 0xc0, 0xf4, 0x73, 0x90, 0xb0, 0xb4, 0xac, 0x62, 0xd7, 0x6e,
+	0x2e, 0x96, 0x91, 0xf9, 0xa8, 0x78, 0x06, 0xa2, 0x1a, 0xb8, 0x02, 0xb3, 0xe7, 0xee, 0xe2, 0x62,
+	0x35, 0x4c, 0xcf, 0xcb, 0x5e, 0x24, 0x51, 0x0d, 0x57, 0x81, 0xe9, 0x16, 0xac, 0xf0, 0xa5, 0x38,
+	0xff, 0x68, 0x7e, 0x7b, 0xd1, 0x6a, 0xa8, 0x62, 0x6a, 0x9e, 0x8a, 0xa0, 0x0c, 0x68, 0xe6, 0x2a,
+	0x66, 0xb2, 0x84, 0xdf, 0xbc, 0x7b, 0x5e, 0x56, 0xea, 0x38, 0x54, 0x79, 0x97, 0x93, 0xf3, 0x48,
+	0x25, 0xcf, 0xf8, 0x01, 0xbc, 0xb6, 0x60, 0x53, 0xe9, 0x5b, 0x98, 0x22, 0x29, 0xfb, 0x3f, 0xff,
+	0xf1, 0x84, 0xa4, 0x19, 0xf7, 0xe1, 0xca, 0xa2, 0x4d, 0x5d, 0xf4, 0xaa, 0x6b, 0xd8, 0x70, 0x75,
+	0xf1, 0xfe, 0xf1, 0xef, 0x1d, 0x47, 0x5e, 0x4f, 0xe9, 0x51, 0x8e, 0x46, 0x5e, 0xfa, 0x29, 0x64,
+	0xe8, 0x9f, 0xf6, 0x94, 0xaf, 0x5f, 0xca, 0xa1, 0x7f, 0x8a, 0x24, 0xa3, 0x05, 0xaf, 0x2f, 0xdc,
+	0xcb, 0xb9, 0x8b, 0xa1, 0x9d, 0xbb, 0x18, 0xd9, 0xbd, 0xcb, 0x29, 0xf7, 0xce, 0x38, 0x80, 0xab,
+	0x8b, 0xf7, 0xf4, 0xdc, 0x4b, 0xb7, 0xf6, 0xbf, 0x7b, 0xe9, 0x36, 0x1e, 0xc2, 0xb5, 0x57, 0xec,
+	0xf2, 0x2b, 0x3e, 0xa3, 0x79, 0x0c, 0x37, 0xbf, 0x61, 0x2b, 0x5f, 0xd1, 0xe9, 0x4b, 0xa8, 0x64,
+	0x31, 0xd0, 0xef, 0x6d, 0x55, 0x67, 0x2b, 0x93, 0x57, 0x57, 0x66, 0x3b, 0x35, 0xb5, 0x22, 0x6a,
+	0xf9, 0x2e, 0xa6, 0xf6, 0x0a, 0x14, 0x45, 0x18, 0x24, 0x97, 0x98, 0x03, 0x86, 0x21, 0xcd, 0x9f,
+	0x90, 0x93, 0xf1, 0x68, 0x2a, 0xcf, 0x8f, 0xc4, 0x44, 0x04, 0xcb, 0x37, 0x4e, 0x64, 0xf1, 0x18,
+	0x6f, 0x43, 0x6d, 0x2e, 0x6e, 0x5a, 0x6c, 0x65, 0x8d, 0x16, 0xd4, 0xe6, 0x02, 0x24, 0xe5, 0xb3,
+	0x71, 0x4d, 0xfd, 0x6c, 0x1c, 0x4b, 0x2c, 0xa7, 0xcf, 0xfd, 0xd8, 0x5f, 0xf0, 0xed, 0xac, 0x20,
+	0x18, 0x9f, 0xc2, 0xb2, 0x9a, 0x4a, 0xd1, 0xef, 0x43, 0x31, 0x48, 0xfc, 0xe3, 0xf4, 0xa4, 0x5c,
+	0xbd, 0x98, 0x6d, 0xb5, 0x12, 0xff, 0x98, 0x09, 0x26, 0xe3, 0x17, 0x1a, 0x90, 0xf3, 0x34, 0xe5,
+	0xdb, 0x76, 0xed, 0x15, 0xdf, 0xb6, 0xe7, 0xe6, 0x94, 0x5c, 0xf0, 0x7d, 0x3a, 0x2a, 0x2e, 0xbe,
+	0xb7, 0x5a, 0xf0, 0x39, 0x36, 0x27, 0xd0, 0x77, 0x40, 0x8f, 0x7d, 0xfe, 0xb1, 0xb2, 0x57, 0x2f,
+	0x5e, 0x60, 0xca, 0x68, 0xc6, 0x73, 0x28, 0xcb, 0xb4, 0x6f, 0xe1, 0x97, 0x1a, 0xef, 0x42, 0x59,
+	0xbc, 0xf2, 0xa7, 0xcf, 0xfb, 0x17, 0xde, 0x0d, 0x52, 0x3a, 0xbe, 0x67, 0x21, 0x69, 0xfe, 0x3d,
+	0x0b, 0x73, 0x73, 0xc6, 0xf1, 0xc6, 0x0f, 0xa1, 0x2c, 0xb3, 0xc6, 0x85, 0x23, 0x7d, 0xdb, 0x67,
+	0xcc, 0xab, 0x00, 0xb3, 0x34, 0x72, 0x91, 0x84, 0xfb, 0x77, 0x61, 0x59, 0xfd, 0xbe, 0x90, 0x17,
+	0x40, 0xa2, 0xd0, 0x27, 0x4b, 0x58, 0x2c, 0x6d, 0x7f, 0xbd, 0x46, 0xb4, 0xfb, 0x9f, 0x43, 0xfd,
+	0x55, 0xa5, 0x05, 0xcc, 0x36, 0x9b, 0x3b, 0x26, 0x2f, 0xdf, 0x2c, 0x83, 0x6e, 0x77, 0x7a, 0x02,
+	0xd2, 0x30, 0xb1, 0x64, 0x56, 0xdb, 0xe2, 0x21, 0xf1, 0xc6, 0x67, 0xbf, 0xfa, 0xdd, 0x1d, 0xed,
+	0x9f, 0x7e, 0x77, 0x47, 0xfb, 0xed, 0xef, 0xee, 0x2c, 0xfd, 0xe2, 0xdf, 0xef, 0x68, 0x5f, 0xaa,
+	0x7f, 0xdd, 0x3a, 0x76, 0x93, 0x38, 0x78, 0x19, 0xc5, 0xc1, 0x51, 0x10, 0xa6, 0x40, 0xe8, 0x3f,
+	0x1c, 0xbf, 0x38, 0x7a, 0x38, 0xee, 0x3f, 0xc4, 0x29, 0xf5, 0x4b, 0xfc, 0x1f, 0x5c, 0x8f, 0xff,
+	0x67, 0x00, 0x03, 0xdb, 0x5b, 0x29, 0x04, 0x36, 0x00, 0x00,
+}
+
+func (m *Type) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6013,12 +7099,12 @@ func (m *ColRef) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ColRef) MarshalTo(dAtA []byte) (int, error) {
+func (m *Type) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ColRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Type) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6027,27 +7113,52 @@ func (m *ColRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+	if len(m.Enumvalues) > 0 {
+		i -= len(m.Enumvalues)
+		copy(dAtA[i:], m.Enumvalues)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Enumvalues)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x3a
 	}
-	if m.ColPos != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.ColPos))
+	if m.Scale != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Scale))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Size != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Size))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Precision != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Precision))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Width != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Width))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nullable {
+		i--
+		if m.Nullable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.RelPos != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.RelPos))
+	if m.Id != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Id))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CorrColRef) Marshal() (dAtA []byte, err error) {
+func (m *Const) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6057,12 +7168,12 @@ func (m *CorrColRef) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CorrColRef) MarshalTo(dAtA []byte) (int, error) {
+func (m *Const) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CorrColRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Const) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6071,66 +7182,188 @@ func (m *CorrColRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Depth != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.Depth))
-		i--
-		dAtA[i] = 0x18
+	if m.Value != nil {
+		{
+			size := m.Value.ProtoSize()
+			i -= size
+			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	if m.ColPos != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.ColPos))
+	if m.Isnull {
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.RelPos != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.RelPos))
+		if m.Isnull {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ExprList) Marshal() (dAtA []byte, err error) {
+func (m *Const_Ival) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Const_Ival) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintPlan(dAtA, i, uint64(m.Ival))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *Const_Dval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Const_Dval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Dval))))
+	i--
+	dAtA[i] = 0x19
+	return len(dAtA) - i, nil
+}
+func (m *Const_Sval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Const_Sval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Sval)
+	copy(dAtA[i:], m.Sval)
+	i = encodeVarintPlan(dAtA, i, uint64(len(m.Sval)))
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+func (m *Const_Bval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Const_Bval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i--
+	if m.Bval {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	return dAtA[:n], nil
+	i--
+	dAtA[i] = 0x28
+	return len(dAtA) - i, nil
+}
+func (m *Const_Uval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExprList) MarshalTo(dAtA []byte) (int, error) {
+func (m *Const_Uval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintPlan(dAtA, i, uint64(m.Uval))
+	i--
+	dAtA[i] = 0x30
+	return len(dAtA) - i, nil
+}
+func (m *Const_Fval) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExprList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Const_Fval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.Fval))))
+	i--
+	dAtA[i] = 0x3d
+	return len(dAtA) - i, nil
+}
+func (m *Const_Dateval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Const_Dateval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintPlan(dAtA, i, uint64(m.Dateval))
+	i--
+	dAtA[i] = 0x40
+	return len(dAtA) - i, nil
+}
+func (m *Const_Datetimeval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Const_Datetimeval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintPlan(dAtA, i, uint64(m.Datetimeval))
+	i--
+	dAtA[i] = 0x48
+	return len(dAtA) - i, nil
+}
+func (m *Const_Decimal64Val) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Const_Decimal64Val) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Decimal64Val != nil {
+		{
+			size, err := m.Decimal64Val.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	if len(m.List) > 0 {
-		for iNdEx := len(m.List) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.List[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPlan(dAtA, i, uint64(size))
+	return len(dAtA) - i, nil
+}
+func (m *Const_Decimal128Val) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Const_Decimal128Val) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Decimal128Val != nil {
+		{
+			size, err := m.Decimal128Val.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x5a
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Const_Timestampval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
-func (m *TargetType) Marshal() (dAtA []byte, err error) {
+func (m *Const_Timestampval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintPlan(dAtA, i, uint64(m.Timestampval))
+	i--
+	dAtA[i] = 0x60
+	return len(dAtA) - i, nil
+}
+func (m *ParamRef) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6140,12 +7373,12 @@ func (m *TargetType) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TargetType) MarshalTo(dAtA []byte) (int, error) {
+func (m *ParamRef) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TargetType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ParamRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6154,22 +7387,15 @@ func (m *TargetType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Typ != nil {
-		{
-			size, err := m.Typ.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPlan(dAtA, i, uint64(size))
-		}
+	if m.Pos != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Pos))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SubqueryRef) Marshal() (dAtA []byte, err error) {
+func (m *VarRef) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6179,12 +7405,12 @@ func (m *SubqueryRef) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SubqueryRef) MarshalTo(dAtA []byte) (int, error) {
+func (m *VarRef) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SubqueryRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VarRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6193,44 +7419,37 @@ func (m *SubqueryRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RowSize != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.RowSize))
+	if m.Global {
 		i--
-		dAtA[i] = 0x28
-	}
-	if m.Child != nil {
-		{
-			size, err := m.Child.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPlan(dAtA, i, uint64(size))
+		if m.Global {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x18
 	}
-	if len(m.Op) > 0 {
-		i -= len(m.Op)
-		copy(dAtA[i:], m.Op)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Op)))
+	if m.System {
 		i--
-		dAtA[i] = 0x1a
-	}
-	if m.NodeId != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.NodeId))
+		if m.System {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Typ != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.Typ))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ObjectRef) Marshal() (dAtA []byte, err error) {
+func (m *ColRef) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6240,12 +7459,12 @@ func (m *ObjectRef) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ObjectRef) MarshalTo(dAtA []byte) (int, error) {
+func (m *ColRef) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ObjectRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ColRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6254,10 +7473,237 @@ func (m *ObjectRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ObjName) > 0 {
-		i -= len(m.ObjName)
-		copy(dAtA[i:], m.ObjName)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.ObjName)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ColPos != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.ColPos))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RelPos != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.RelPos))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CorrColRef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CorrColRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CorrColRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Depth != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ColPos != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.ColPos))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RelPos != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.RelPos))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExprList) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExprList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExprList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.List) > 0 {
+		for iNdEx := len(m.List) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.List[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TargetType) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TargetType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TargetType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Typ != nil {
+		{
+			size, err := m.Typ.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubqueryRef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubqueryRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubqueryRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RowSize != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.RowSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Child != nil {
+		{
+			size, err := m.Child.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Op) > 0 {
+		i -= len(m.Op)
+		copy(dAtA[i:], m.Op)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Op)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NodeId != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.NodeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Typ != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Typ))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ObjectRef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ObjName) > 0 {
+		i -= len(m.ObjName)
+		copy(dAtA[i:], m.ObjName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ObjName)))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -6954,6 +8400,26 @@ func (m *ColDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.NotNull {
+		i--
+		if m.NotNull {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.AutoIncr {
+		i--
+		if m.AutoIncr {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.Comment) > 0 {
 		i -= len(m.Comment)
 		copy(dAtA[i:], m.Comment)
@@ -7205,6 +8671,18 @@ func (m *TableDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.View != nil {
+		{
+			size, err := m.View.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Defs) > 0 {
 		for iNdEx := len(m.Defs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7342,7 +8820,319 @@ func (m *TableDef_DefType_Properties) MarshalToSizedBuffer(dAtA []byte) (int, er
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Cost) Marshal() (dAtA []byte, err error) {
+func (m *TableDef_DefType_Check) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TableDef_DefType_Check) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Check != nil {
+		{
+			size, err := m.Check.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TableDef_DefType_Fk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TableDef_DefType_Fk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Fk != nil {
+		{
+			size, err := m.Fk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TableDef_DefType_Partition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TableDef_DefType_Partition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Partition != nil {
+		{
+			size, err := m.Partition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *CheckDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Expr) > 0 {
+		i -= len(m.Expr)
+		copy(dAtA[i:], m.Expr)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Expr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForeignKeyDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForeignKeyDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForeignKeyDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.OnUpdate != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.OnUpdate))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.OnDelete != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.OnDelete))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ParentCols) > 0 {
+		for iNdEx := len(m.ParentCols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ParentCols[iNdEx])
+			copy(dAtA[i:], m.ParentCols[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.ParentCols[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ParentTable) > 0 {
+		i -= len(m.ParentTable)
+		copy(dAtA[i:], m.ParentTable)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ParentTable)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Cols) > 0 {
+		for iNdEx := len(m.Cols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Cols[iNdEx])
+			copy(dAtA[i:], m.Cols[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.Cols[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Partitions) > 0 {
+		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Partitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Cols) > 0 {
+		for iNdEx := len(m.Cols) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Cols[iNdEx])
+			copy(dAtA[i:], m.Cols[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.Cols[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Func) > 0 {
+		i -= len(m.Func)
+		copy(dAtA[i:], m.Func)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Func)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionDef_Partition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionDef_Partition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionDef_Partition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ViewDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ViewDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ViewDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.View) > 0 {
+		i -= len(m.View)
+		copy(dAtA[i:], m.View)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.View)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Cost) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7434,9 +9224,9 @@ func (m *ColData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	if len(m.F64) > 0 {
 		for iNdEx := len(m.F64) - 1; iNdEx >= 0; iNdEx-- {
-			f24 := math.Float64bits(float64(m.F64[iNdEx]))
+			f28 := math.Float64bits(float64(m.F64[iNdEx]))
 			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f24))
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f28))
 		}
 		i = encodeVarintPlan(dAtA, i, uint64(len(m.F64)*8))
 		i--
@@ -7444,49 +9234,49 @@ func (m *ColData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	if len(m.F32) > 0 {
 		for iNdEx := len(m.F32) - 1; iNdEx >= 0; iNdEx-- {
-			f25 := math.Float32bits(float32(m.F32[iNdEx]))
+			f29 := math.Float32bits(float32(m.F32[iNdEx]))
 			i -= 4
-			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(f25))
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(f29))
 		}
 		i = encodeVarintPlan(dAtA, i, uint64(len(m.F32)*4))
 		i--
 		dAtA[i] = 0x32
 	}
 	if len(m.I64) > 0 {
-		dAtA27 := make([]byte, len(m.I64)*10)
-		var j26 int
+		dAtA31 := make([]byte, len(m.I64)*10)
+		var j30 int
 		for _, num1 := range m.I64 {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j26++
+				j30++
 			}
-			dAtA27[j26] = uint8(num)
-			j26++
+			dAtA31[j30] = uint8(num)
+			j30++
 		}
-		i -= j26
-		copy(dAtA[i:], dAtA27[:j26])
-		i = encodeVarintPlan(dAtA, i, uint64(j26))
+		i -= j30
+		copy(dAtA[i:], dAtA31[:j30])
+		i = encodeVarintPlan(dAtA, i, uint64(j30))
 		i--
 		dAtA[i] = 0x2a
 	}
 	if len(m.I32) > 0 {
-		dAtA29 := make([]byte, len(m.I32)*10)
-		var j28 int
+		dAtA33 := make([]byte, len(m.I32)*10)
+		var j32 int
 		for _, num1 := range m.I32 {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA29[j28] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j28++
+				j32++
 			}
-			dAtA29[j28] = uint8(num)
-			j28++
+			dAtA33[j32] = uint8(num)
+			j32++
 		}
-		i -= j28
-		copy(dAtA[i:], dAtA29[:j28])
-		i = encodeVarintPlan(dAtA, i, uint64(j28))
+		i -= j32
+		copy(dAtA[i:], dAtA33[:j32])
+		i = encodeVarintPlan(dAtA, i, uint64(j32))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -7620,7 +9410,7 @@ func (m *OrderBySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WindowSpec) Marshal() (dAtA []byte, err error) {
+func (m *FrameBound) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7630,12 +9420,12 @@ func (m *WindowSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WindowSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *FrameBound) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WindowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FrameBound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7644,14 +9434,136 @@ func (m *WindowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Lag != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.Lag))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Lead != 0 {
-		i = encodeVarintPlan(dAtA, i, uint64(m.Lead))
-		i--
+	if m.Val != nil {
+		{
+			size, err := m.Val.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Unbounded {
+		i--
+		if m.Unbounded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FrameClause) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrameClause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrameClause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.End != nil {
+		{
+			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Start != nil {
+		{
+			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WindowSpec) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WindowSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WindowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Frame != nil {
+		{
+			size, err := m.Frame.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Lag != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Lag))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Lead != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Lead))
+		i--
 		dAtA[i] = 0x18
 	}
 	if len(m.OrderBy) > 0 {
@@ -7709,6 +9621,16 @@ func (m *UpdateInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ReadColumns {
+		i--
+		if m.ReadColumns {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.AttrOrders) > 0 {
 		for iNdEx := len(m.AttrOrders) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AttrOrders[iNdEx])
@@ -7839,6 +9761,33 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.FullText) > 0 {
+		for iNdEx := len(m.FullText) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FullText[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.Partitions) > 0 {
+		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Partitions[iNdEx])
+			copy(dAtA[i:], m.Partitions[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.Partitions[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
 	if m.AnalyzeInfo != nil {
 		{
 			size, err := m.AnalyzeInfo.MarshalToSizedBuffer(dAtA[:i])
@@ -7854,21 +9803,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xba
 	}
 	if len(m.BindingTags) > 0 {
-		dAtA34 := make([]byte, len(m.BindingTags)*10)
-		var j33 int
+		dAtA42 := make([]byte, len(m.BindingTags)*10)
+		var j41 int
 		for _, num1 := range m.BindingTags {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA34[j33] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j33++
+				j41++
 			}
-			dAtA34[j33] = uint8(num)
-			j33++
+			dAtA42[j41] = uint8(num)
+			j41++
 		}
-		i -= j33
-		copy(dAtA[i:], dAtA34[:j33])
-		i = encodeVarintPlan(dAtA, i, uint64(j33))
+		i -= j41
+		copy(dAtA[i:], dAtA42[:j41])
+		i = encodeVarintPlan(dAtA, i, uint64(j41))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -8081,21 +10030,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x30
 	}
 	if len(m.Children) > 0 {
-		dAtA43 := make([]byte, len(m.Children)*10)
-		var j42 int
+		dAtA51 := make([]byte, len(m.Children)*10)
+		var j50 int
 		for _, num1 := range m.Children {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA43[j42] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA51[j50] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j42++
+				j50++
 			}
-			dAtA43[j42] = uint8(num)
-			j42++
+			dAtA51[j50] = uint8(num)
+			j50++
 		}
-		i -= j42
-		copy(dAtA[i:], dAtA43[:j42])
-		i = encodeVarintPlan(dAtA, i, uint64(j42))
+		i -= j50
+		copy(dAtA[i:], dAtA51[:j50])
+		i = encodeVarintPlan(dAtA, i, uint64(j50))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -8138,6 +10087,71 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FullTextScan) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FullTextScan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FullTextScan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Filter {
+		i--
+		if m.Filter {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Mode != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ColNames) > 0 {
+		for iNdEx := len(m.ColNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ColNames[iNdEx])
+			copy(dAtA[i:], m.ColNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.ColNames[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Attr) > 0 {
+		i -= len(m.Attr)
+		copy(dAtA[i:], m.Attr)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Attr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DeleteTableCtx) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -8162,6 +10176,16 @@ func (m *DeleteTableCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ReadColumns {
+		i--
+		if m.ReadColumns {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.CanTruncate {
 		i--
 		if m.CanTruncate {
@@ -8258,21 +10282,21 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Steps) > 0 {
-		dAtA46 := make([]byte, len(m.Steps)*10)
-		var j45 int
+		dAtA54 := make([]byte, len(m.Steps)*10)
+		var j53 int
 		for _, num1 := range m.Steps {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA54[j53] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j45++
+				j53++
 			}
-			dAtA46[j45] = uint8(num)
-			j45++
+			dAtA54[j53] = uint8(num)
+			j53++
 		}
-		i -= j45
-		copy(dAtA[i:], dAtA46[:j45])
-		i = encodeVarintPlan(dAtA, i, uint64(j45))
+		i -= j53
+		copy(dAtA[i:], dAtA54[:j53])
+		i = encodeVarintPlan(dAtA, i, uint64(j53))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -9169,6 +11193,16 @@ func (m *CreateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Replace {
+		i--
+		if m.Replace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.TableDef != nil {
 		{
 			size, err := m.TableDef.MarshalToSizedBuffer(dAtA[:i])
@@ -9235,6 +11269,27 @@ func (m *AlterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TableDef != nil {
 		{
 			size, err := m.TableDef.MarshalToSizedBuffer(dAtA[:i])
@@ -9257,7 +11312,7 @@ func (m *AlterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DropTable) Marshal() (dAtA []byte, err error) {
+func (m *AlterTable_Action) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9267,12 +11322,12 @@ func (m *DropTable) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DropTable) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTable_Action) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTable_Action) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9281,34 +11336,166 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Table) > 0 {
-		i -= len(m.Table)
-		copy(dAtA[i:], m.Table)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Database) > 0 {
-		i -= len(m.Database)
-		copy(dAtA[i:], m.Database)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.IfExists {
-		i--
-		if m.IfExists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Action != nil {
+		{
+			size := m.Action.ProtoSize()
+			i -= size
+			if _, err := m.Action.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 		}
-		i--
-		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CreateIndex) Marshal() (dAtA []byte, err error) {
+func (m *AlterTable_Action_AddColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_AddColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AddColumn != nil {
+		{
+			size, err := m.AddColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_DropColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_DropColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DropColumn != nil {
+		{
+			size, err := m.DropColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_RenameColumn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_RenameColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RenameColumn != nil {
+		{
+			size, err := m.RenameColumn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_RenameTable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_RenameTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RenameTable != nil {
+		{
+			size, err := m.RenameTable.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_AddPartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_AddPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AddPartition != nil {
+		{
+			size, err := m.AddPartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_DropPartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_DropPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DropPartition != nil {
+		{
+			size, err := m.DropPartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTable_Action_TruncatePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_TruncatePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TruncatePartition != nil {
+		{
+			size, err := m.TruncatePartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *AlterTableAddColumn) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9318,12 +11505,12 @@ func (m *CreateIndex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreateIndex) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTableAddColumn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CreateIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTableAddColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9332,27 +11519,22 @@ func (m *CreateIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.IfNotExists {
-		i--
-		if m.IfNotExists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Column != nil {
+		{
+			size, err := m.Column.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AlterIndex) Marshal() (dAtA []byte, err error) {
+func (m *AlterTableDropColumn) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9362,12 +11544,12 @@ func (m *AlterIndex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AlterIndex) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTableDropColumn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AlterIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTableDropColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9376,17 +11558,17 @@ func (m *AlterIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Index)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DropIndex) Marshal() (dAtA []byte, err error) {
+func (m *AlterTableRenameColumn) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9396,12 +11578,12 @@ func (m *DropIndex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DropIndex) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTableRenameColumn) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DropIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTableRenameColumn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9410,27 +11592,24 @@ func (m *DropIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Index)))
+	if len(m.NewName) > 0 {
+		i -= len(m.NewName)
+		copy(dAtA[i:], m.NewName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.NewName)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.IfExists {
-		i--
-		if m.IfExists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.OldName) > 0 {
+		i -= len(m.OldName)
+		copy(dAtA[i:], m.OldName)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OldName)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *TruncateTable) Marshal() (dAtA []byte, err error) {
+func (m *AlterTableRenameTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9440,12 +11619,12 @@ func (m *TruncateTable) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TruncateTable) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTableRenameTable) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TruncateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTableRenameTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9459,12 +11638,19 @@ func (m *TruncateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.Table)
 		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ShowVariables) Marshal() (dAtA []byte, err error) {
+func (m *AlterTableAddPartition) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9474,12 +11660,12 @@ func (m *ShowVariables) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ShowVariables) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTableAddPartition) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ShowVariables) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTableAddPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9488,10 +11674,10 @@ func (m *ShowVariables) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Where) > 0 {
-		for iNdEx := len(m.Where) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Partitions) > 0 {
+		for iNdEx := len(m.Partitions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Where[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Partitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -9499,23 +11685,13 @@ func (m *ShowVariables) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintPlan(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Global {
-		i--
-		if m.Global {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SetVariables) Marshal() (dAtA []byte, err error) {
+func (m *AlterTableDropPartition) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9525,12 +11701,12 @@ func (m *SetVariables) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetVariables) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTableDropPartition) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetVariables) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTableDropPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9539,16 +11715,11 @@ func (m *SetVariables) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPlan(dAtA, i, uint64(size))
-			}
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.Names[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -9556,7 +11727,7 @@ func (m *SetVariables) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SetVariablesItem) Marshal() (dAtA []byte, err error) {
+func (m *AlterTableTruncatePartition) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9566,12 +11737,12 @@ func (m *SetVariablesItem) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SetVariablesItem) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterTableTruncatePartition) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetVariablesItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterTableTruncatePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9580,50 +11751,103 @@ func (m *SetVariablesItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Reserved != nil {
-		{
-			size, err := m.Reserved.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPlan(dAtA, i, uint64(size))
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPlan(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+
+func (m *DropTable) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+	return dAtA[:n], nil
+}
+
+func (m *DropTable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Global {
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
 		i--
-		if m.Global {
+		dAtA[i] = 0x12
+	}
+	if m.IfExists {
+		i--
+		if m.IfExists {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x8
 	}
-	if m.System {
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateIndex) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Index)))
 		i--
-		if m.System {
+		dAtA[i] = 0x12
+	}
+	if m.IfNotExists {
+		i--
+		if m.IfNotExists {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -9634,7 +11858,7 @@ func (m *SetVariablesItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Prepare) Marshal() (dAtA []byte, err error) {
+func (m *AlterIndex) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9644,12 +11868,12 @@ func (m *Prepare) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Prepare) MarshalTo(dAtA []byte) (int, error) {
+func (m *AlterIndex) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AlterIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9658,43 +11882,95 @@ func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Plan != nil {
-		{
-			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPlan(dAtA, i, uint64(size))
-		}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Index)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
-	if len(m.Schemas) > 0 {
-		for iNdEx := len(m.Schemas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Schemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPlan(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *DropIndex) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DropIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DropIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IfExists {
+		i--
+		if m.IfExists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+	return len(dAtA) - i, nil
+}
+
+func (m *TruncateTable) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TruncateTable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TruncateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Execute) Marshal() (dAtA []byte, err error) {
+func (m *ShowVariables) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9704,12 +11980,12 @@ func (m *Execute) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Execute) MarshalTo(dAtA []byte) (int, error) {
+func (m *ShowVariables) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Execute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ShowVariables) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9718,10 +11994,10 @@ func (m *Execute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Where) > 0 {
+		for iNdEx := len(m.Where) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Where[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -9732,17 +12008,20 @@ func (m *Execute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+	if m.Global {
 		i--
-		dAtA[i] = 0xa
+		if m.Global {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Deallocate) Marshal() (dAtA []byte, err error) {
+func (m *SetVariables) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9752,12 +12031,12 @@ func (m *Deallocate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Deallocate) MarshalTo(dAtA []byte) (int, error) {
+func (m *SetVariables) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Deallocate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SetVariables) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9766,32 +12045,259 @@ func (m *Deallocate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPlan(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPlan(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SetVariablesItem) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Type) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+
+func (m *SetVariablesItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetVariablesItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Reserved != nil {
+		{
+			size, err := m.Reserved.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Global {
+		i--
+		if m.Global {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.System {
+		i--
+		if m.System {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Prepare) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Prepare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Plan != nil {
+		{
+			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Schemas) > 0 {
+		for iNdEx := len(m.Schemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Execute) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Execute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Execute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Deallocate) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deallocate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deallocate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPlan(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPlan(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Type) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	if m.Id != 0 {
 		n += 1 + sovPlan(uint64(m.Id))
@@ -9811,6 +12317,10 @@ func (m *Type) ProtoSize() (n int) {
 	if m.Scale != 0 {
 		n += 1 + sovPlan(uint64(m.Scale))
 	}
+	l = len(m.Enumvalues)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10489,6 +12999,12 @@ func (m *ColDef) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	if m.AutoIncr {
+		n += 2
+	}
+	if m.NotNull {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10598,6 +13114,10 @@ func (m *TableDef) ProtoSize() (n int) {
 			n += 1 + l + sovPlan(uint64(l))
 		}
 	}
+	if m.View != nil {
+		l = m.View.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10655,33 +13175,196 @@ func (m *TableDef_DefType_Properties) ProtoSize() (n int) {
 	}
 	return n
 }
-func (m *Cost) ProtoSize() (n int) {
+func (m *TableDef_DefType_Check) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Card != 0 {
-		n += 9
-	}
-	if m.Rowsize != 0 {
-		n += 9
-	}
-	if m.Ndv != 0 {
-		n += 9
-	}
-	if m.Start != 0 {
-		n += 9
-	}
-	if m.Total != 0 {
-		n += 9
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Check != nil {
+		l = m.Check.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
 	}
 	return n
 }
-
+func (m *TableDef_DefType_Fk) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fk != nil {
+		l = m.Fk.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *TableDef_DefType_Partition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Partition != nil {
+		l = m.Partition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *CheckDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Expr)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForeignKeyDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.Cols) > 0 {
+		for _, s := range m.Cols {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	l = len(m.ParentTable)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.ParentCols) > 0 {
+		for _, s := range m.ParentCols {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.OnDelete != 0 {
+		n += 1 + sovPlan(uint64(m.OnDelete))
+	}
+	if m.OnUpdate != 0 {
+		n += 1 + sovPlan(uint64(m.OnUpdate))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartitionDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPlan(uint64(m.Type))
+	}
+	l = len(m.Func)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.Cols) > 0 {
+		for _, s := range m.Cols {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PartitionDef_Partition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ViewDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.View)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Cost) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Card != 0 {
+		n += 9
+	}
+	if m.Rowsize != 0 {
+		n += 9
+	}
+	if m.Ndv != 0 {
+		n += 9
+	}
+	if m.Start != 0 {
+		n += 9
+	}
+	if m.Total != 0 {
+		n += 9
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ColData) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -10774,6 +13457,51 @@ func (m *OrderBySpec) ProtoSize() (n int) {
 	return n
 }
 
+func (m *FrameBound) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPlan(uint64(m.Type))
+	}
+	if m.Unbounded {
+		n += 2
+	}
+	if m.Val != nil {
+		l = m.Val.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FrameClause) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovPlan(uint64(m.Type))
+	}
+	if m.Start != nil {
+		l = m.Start.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.End != nil {
+		l = m.End.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *WindowSpec) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -10798,6 +13526,10 @@ func (m *WindowSpec) ProtoSize() (n int) {
 	if m.Lag != 0 {
 		n += 1 + sovPlan(uint64(m.Lag))
 	}
+	if m.Frame != nil {
+		l = m.Frame.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10839,6 +13571,9 @@ func (m *UpdateInfo) ProtoSize() (n int) {
 			n += 1 + l + sovPlan(uint64(l))
 		}
 	}
+	if m.ReadColumns {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10992,6 +13727,50 @@ func (m *Node) ProtoSize() (n int) {
 		l = m.AnalyzeInfo.ProtoSize()
 		n += 2 + l + sovPlan(uint64(l))
 	}
+	if len(m.Partitions) > 0 {
+		for _, s := range m.Partitions {
+			l = len(s)
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.FullText) > 0 {
+		for _, e := range m.FullText {
+			l = e.ProtoSize()
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FullTextScan) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Attr)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.ColNames) > 0 {
+		for _, s := range m.ColNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovPlan(uint64(m.Mode))
+	}
+	if m.Filter {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11019,6 +13798,9 @@ func (m *DeleteTableCtx) ProtoSize() (n int) {
 	if m.CanTruncate {
 		n += 2
 	}
+	if m.ReadColumns {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11524,6 +14306,9 @@ func (m *CreateTable) ProtoSize() (n int) {
 		l = m.TableDef.ProtoSize()
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	if m.Replace {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11544,28 +14329,15 @@ func (m *AlterTable) ProtoSize() (n int) {
 		l = m.TableDef.ProtoSize()
 		n += 1 + l + sovPlan(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *DropTable) ProtoSize() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IfExists {
-		n += 2
-	}
 	l = len(m.Database)
 	if l > 0 {
 		n += 1 + l + sovPlan(uint64(l))
 	}
-	l = len(m.Table)
-	if l > 0 {
-		n += 1 + l + sovPlan(uint64(l))
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11573,18 +14345,14 @@ func (m *DropTable) ProtoSize() (n int) {
 	return n
 }
 
-func (m *CreateIndex) ProtoSize() (n int) {
+func (m *AlterTable_Action) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.IfNotExists {
-		n += 2
-	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovPlan(uint64(l))
+	if m.Action != nil {
+		n += m.Action.ProtoSize()
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -11592,7 +14360,259 @@ func (m *CreateIndex) ProtoSize() (n int) {
 	return n
 }
 
-func (m *AlterIndex) ProtoSize() (n int) {
+func (m *AlterTable_Action_AddColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddColumn != nil {
+		l = m.AddColumn.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_DropColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DropColumn != nil {
+		l = m.DropColumn.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_RenameColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RenameColumn != nil {
+		l = m.RenameColumn.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_RenameTable) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RenameTable != nil {
+		l = m.RenameTable.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_AddPartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddPartition != nil {
+		l = m.AddPartition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_DropPartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DropPartition != nil {
+		l = m.DropPartition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTable_Action_TruncatePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TruncatePartition != nil {
+		l = m.TruncatePartition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *AlterTableAddColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Column != nil {
+		l = m.Column.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableDropColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableRenameColumn) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.NewName)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableRenameTable) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableAddPartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableDropPartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterTableTruncatePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DropTable) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IfExists {
+		n += 2
+	}
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateIndex) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IfNotExists {
+		n += 2
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterIndex) ProtoSize() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11926,6 +14946,38 @@ func (m *Type) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enumvalues", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enumvalues = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -14637,11 +17689,51 @@ func (m *ColDef) Unmarshal(dAtA []byte) error {
 			}
 			m.Comment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoIncr", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoIncr = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotNull", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NotNull = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPlan
@@ -15205,6 +18297,42 @@ func (m *TableDef) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.View == nil {
+				m.View = &ViewDef{}
+			}
+			if err := m.View.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -15361,112 +18489,111 @@ func (m *TableDef_DefType) Unmarshal(dAtA []byte) error {
 			}
 			m.Def = &TableDef_DefType_Properties{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Check", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Cost) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &CheckDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Cost: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cost: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Card", wireType)
+			m.Def = &TableDef_DefType_Check{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fk", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Card = float64(math.Float64frombits(v))
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rowsize", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Rowsize = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ndv", wireType)
+			v := &ForeignKeyDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			m.Def = &TableDef_DefType_Fk{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Ndv = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Start = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Total = float64(math.Float64frombits(v))
+			v := &PartitionDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Def = &TableDef_DefType_Partition{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -15489,7 +18616,7 @@ func (m *Cost) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ColData) Unmarshal(dAtA []byte) error {
+func (m *CheckDef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15512,17 +18639,17 @@ func (m *ColData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ColData: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckDef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ColData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckDef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RowCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.RowCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -15532,179 +18659,2493 @@ func (m *ColData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RowCount |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NullCount", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
 			}
-			m.NullCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NullCount |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType == 0 {
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Nulls = append(m.Nulls, bool(v != 0))
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForeignKeyDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForeignKeyDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForeignKeyDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				elementCount = packedLen
-				if elementCount != 0 && len(m.Nulls) == 0 {
-					m.Nulls = make([]bool, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPlan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Nulls = append(m.Nulls, bool(v != 0))
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nulls", wireType)
 			}
-		case 4:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				m.I32 = append(m.I32, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cols = append(m.Cols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentTable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentTable = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentCols = append(m.ParentCols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnDelete", wireType)
+			}
+			m.OnDelete = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OnDelete |= ForeignKeyDef_RefAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnUpdate", wireType)
+			}
+			m.OnUpdate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OnUpdate |= ForeignKeyDef_RefAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PartitionDef_PartitionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Func", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Func = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cols = append(m.Cols, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partitions = append(m.Partitions, &PartitionDef_Partition{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionDef_Partition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Partition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Partition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ViewDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ViewDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ViewDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.View = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Cost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Cost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Cost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Card", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Card = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rowsize", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Rowsize = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ndv", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Ndv = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Start = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Total = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ColData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ColData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ColData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowCount", wireType)
+			}
+			m.RowCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NullCount", wireType)
+			}
+			m.NullCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NullCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Nulls = append(m.Nulls, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.Nulls) == 0 {
+					m.Nulls = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nulls = append(m.Nulls, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nulls", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.I32 = append(m.I32, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
 				elementCount = count
 				if elementCount != 0 && len(m.I32) == 0 {
 					m.I32 = make([]int32, 0, elementCount)
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPlan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.I32 = append(m.I32, v)
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.I32 = append(m.I32, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field I32", wireType)
+			}
+		case 5:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.I64 = append(m.I64, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.I64) == 0 {
+					m.I64 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.I64 = append(m.I64, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field I64", wireType)
+			}
+		case 6:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				v2 := float32(math.Float32frombits(v))
+				m.F32 = append(m.F32, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.F32) == 0 {
+					m.F32 = make([]float32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					v2 := float32(math.Float32frombits(v))
+					m.F32 = append(m.F32, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field F32", wireType)
+			}
+		case 7:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.F64 = append(m.F64, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.F64) == 0 {
+					m.F64 = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.F64 = append(m.F64, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field F64", wireType)
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = append(m.S, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RowsetData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RowsetData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RowsetData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schema == nil {
+				m.Schema = &TableDef{}
+			}
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cols = append(m.Cols, &ColData{})
+			if err := m.Cols[len(m.Cols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBySpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBySpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBySpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expr == nil {
+				m.Expr = &Expr{}
+			}
+			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flag", wireType)
+			}
+			m.Flag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Flag |= OrderBySpec_OrderByFlag(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrameBound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrameBound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrameBound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FrameBound_BoundType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbounded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unbounded = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Val", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Val == nil {
+				m.Val = &Expr{}
+			}
+			if err := m.Val.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrameClause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrameClause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrameClause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FrameClause_FrameType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Start == nil {
+				m.Start = &FrameBound{}
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.End == nil {
+				m.End = &FrameBound{}
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WindowSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WindowSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WindowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartitionBy = append(m.PartitionBy, &Expr{})
+			if err := m.PartitionBy[len(m.PartitionBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = append(m.OrderBy, &OrderBySpec{})
+			if err := m.OrderBy[len(m.OrderBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lead", wireType)
+			}
+			m.Lead = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lead |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
+			}
+			m.Lag = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lag |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frame", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Frame == nil {
+				m.Frame = &FrameClause{}
+			}
+			if err := m.Frame.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriKeyIdx", wireType)
+			}
+			m.PriKeyIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriKeyIdx |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HideKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HideKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAttrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateAttrs = append(m.UpdateAttrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherAttrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherAttrs = append(m.OtherAttrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttrOrders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttrOrders = append(m.AttrOrders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadColumns", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReadColumns = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalyzeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalyzeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputRows", wireType)
+			}
+			m.InputRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InputRows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputRows", wireType)
+			}
+			m.OutputRows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputRows |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputSize", wireType)
+			}
+			m.InputSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InputSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputSize", wireType)
+			}
+			m.OutputSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeConsumed", wireType)
+			}
+			m.TimeConsumed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeConsumed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemorySize", wireType)
+			}
+			m.MemorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemorySize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Node) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Node: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Node: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeType", wireType)
+			}
+			m.NodeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeType |= Node_NodeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			m.NodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field I32", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cost == nil {
+				m.Cost = &Cost{}
+			}
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectList = append(m.ProjectList, &Expr{})
+			if err := m.ProjectList[len(m.ProjectList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		case 5:
 			if wireType == 0 {
-				var v int64
+				var v int32
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowPlan
@@ -15714,12 +21155,12 @@ func (m *ColData) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int64(b&0x7F) << shift
+					v |= int32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				m.I64 = append(m.I64, v)
+				m.Children = append(m.Children, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -15754,11 +21195,11 @@ func (m *ColData) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.I64) == 0 {
-					m.I64 = make([]int64, 0, elementCount)
+				if elementCount != 0 && len(m.Children) == 0 {
+					m.Children = make([]int32, 0, elementCount)
 				}
 				for iNdEx < postIndex {
-					var v int64
+					var v int32
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowPlan
@@ -15768,129 +21209,352 @@ func (m *ColData) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						v |= int64(b&0x7F) << shift
+						v |= int32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					m.I64 = append(m.I64, v)
+					m.Children = append(m.Children, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field I64", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinType", wireType)
+			}
+			m.JoinType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JoinType |= Node_JoinFlag(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnList = append(m.OnList, &Expr{})
+			if err := m.OnList[len(m.OnList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilterList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilterList = append(m.FilterList, &Expr{})
+			if err := m.FilterList[len(m.FilterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupBy = append(m.GroupBy, &Expr{})
+			if err := m.GroupBy[len(m.GroupBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupingSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupingSet = append(m.GroupingSet, &Expr{})
+			if err := m.GroupingSet[len(m.GroupingSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 6:
-			if wireType == 5 {
-				var v uint32
-				if (iNdEx + 4) > l {
-					return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-				iNdEx += 4
-				v2 := float32(math.Float32frombits(v))
-				m.F32 = append(m.F32, v2)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggList = append(m.AggList, &Expr{})
+			if err := m.AggList[len(m.AggList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				elementCount = packedLen / 4
-				if elementCount != 0 && len(m.F32) == 0 {
-					m.F32 = make([]float32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					if (iNdEx + 4) > l {
-						return io.ErrUnexpectedEOF
-					}
-					v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-					iNdEx += 4
-					v2 := float32(math.Float32frombits(v))
-					m.F32 = append(m.F32, v2)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field F32", wireType)
 			}
-		case 7:
-			if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
-					return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBy = append(m.OrderBy, &OrderBySpec{})
+			if err := m.OrderBy[len(m.OrderBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				v2 := float64(math.Float64frombits(v))
-				m.F64 = append(m.F64, v2)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateInfo == nil {
+				m.UpdateInfo = &UpdateInfo{}
+			}
+			if err := m.UpdateInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				elementCount = packedLen / 8
-				if elementCount != 0 && len(m.F64) == 0 {
-					m.F64 = make([]float64, 0, elementCount)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					v2 := float64(math.Float64frombits(v))
-					m.F64 = append(m.F64, v2)
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WinSpec == nil {
+				m.WinSpec = &WindowSpec{}
+			}
+			if err := m.WinSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field F64", wireType)
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limit == nil {
+				m.Limit = &Expr{}
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -15900,78 +21564,31 @@ func (m *ColData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.S = append(m.S, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RowsetData) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Offset == nil {
+				m.Offset = &Expr{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Offset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RowsetData: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RowsetData: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15998,16 +21615,16 @@ func (m *RowsetData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Schema == nil {
-				m.Schema = &TableDef{}
+			if m.TableDef == nil {
+				m.TableDef = &TableDef{}
 			}
-			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cols", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16034,65 +21651,16 @@ func (m *RowsetData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cols = append(m.Cols, &ColData{})
-			if err := m.Cols[len(m.Cols)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.ObjRef == nil {
+				m.ObjRef = &ObjectRef{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ObjRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OrderBySpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OrderBySpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrderBySpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RowsetData", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16119,16 +21687,16 @@ func (m *OrderBySpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expr == nil {
-				m.Expr = &Expr{}
+			if m.RowsetData == nil {
+				m.RowsetData = &RowsetData{}
 			}
-			if err := m.Expr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RowsetData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16156,13 +21724,123 @@ func (m *OrderBySpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Collation = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Flag", wireType)
+			m.ExtraOptions = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteTablesCtx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeleteTablesCtx = append(m.DeleteTablesCtx, &DeleteTableCtx{})
+			if err := m.DeleteTablesCtx[len(m.DeleteTablesCtx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BindingTags = append(m.BindingTags, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPlan
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPlan
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPlan
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BindingTags) == 0 {
+					m.BindingTags = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPlan
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BindingTags = append(m.BindingTags, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindingTags", wireType)
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalyzeInfo", wireType)
 			}
-			m.Flag = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16172,67 +21850,33 @@ func (m *OrderBySpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Flag |= OrderBySpec_OrderByFlag(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WindowSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.AnalyzeInfo == nil {
+				m.AnalyzeInfo = &AnalyzeInfo{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.AnalyzeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WindowSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WindowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16242,29 +21886,27 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PartitionBy = append(m.PartitionBy, &Expr{})
-			if err := m.PartitionBy[len(m.PartitionBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Partitions = append(m.Partitions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FullText", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16291,49 +21933,11 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderBy = append(m.OrderBy, &OrderBySpec{})
-			if err := m.OrderBy[len(m.OrderBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FullText = append(m.FullText, &FullTextScan{})
+			if err := m.FullText[len(m.FullText)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lead", wireType)
-			}
-			m.Lead = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Lead |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
-			}
-			m.Lag = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Lag |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -16356,7 +21960,7 @@ func (m *WindowSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateInfo) Unmarshal(dAtA []byte) error {
+func (m *FullTextScan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16379,15 +21983,15 @@ func (m *UpdateInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: FullTextScan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FullTextScan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16415,30 +22019,11 @@ func (m *UpdateInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriKey = string(dAtA[iNdEx:postIndex])
+			m.Attr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriKeyIdx", wireType)
-			}
-			m.PriKeyIdx = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PriKeyIdx |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HideKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ColNames", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16466,11 +22051,11 @@ func (m *UpdateInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HideKey = string(dAtA[iNdEx:postIndex])
+			m.ColNames = append(m.ColNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAttrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16498,13 +22083,13 @@ func (m *UpdateInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateAttrs = append(m.UpdateAttrs, string(dAtA[iNdEx:postIndex]))
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OtherAttrs", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			var stringLen uint64
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16514,29 +22099,16 @@ func (m *UpdateInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Mode |= FullTextScan_SearchMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OtherAttrs = append(m.OtherAttrs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttrOrders", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16546,24 +22118,12 @@ func (m *UpdateInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AttrOrders = append(m.AttrOrders, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Filter = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -16586,7 +22146,7 @@ func (m *UpdateInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
+func (m *DeleteTableCtx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16609,17 +22169,17 @@ func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalyzeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteTableCtx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalyzeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteTableCtx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputRows", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
 			}
-			m.InputRows = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16629,16 +22189,29 @@ func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InputRows |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DbName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputRows", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TblName", wireType)
 			}
-			m.OutputRows = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16648,16 +22221,29 @@ func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OutputRows |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputSize", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TblName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDeleteKey", wireType)
 			}
-			m.InputSize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16667,35 +22253,29 @@ func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InputSize |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputSize", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
 			}
-			m.OutputSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OutputSize |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UseDeleteKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeConsumed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanTruncate", wireType)
 			}
-			m.TimeConsumed = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16705,16 +22285,17 @@ func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeConsumed |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			m.CanTruncate = bool(v != 0)
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemorySize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadColumns", wireType)
 			}
-			m.MemorySize = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16724,11 +22305,12 @@ func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MemorySize |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.ReadColumns = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -16751,7 +22333,7 @@ func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Node) Unmarshal(dAtA []byte) error {
+func (m *Query) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16774,17 +22356,17 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Node: wiretype end group for non-group")
+			return fmt.Errorf("proto: Query: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Node: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StmtType", wireType)
 			}
-			m.NodeType = 0
+			m.StmtType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -16794,101 +22376,12 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NodeType |= Node_NodeType(b&0x7F) << shift
+				m.StmtType |= Query_StatementType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
-			}
-			m.NodeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NodeId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Cost == nil {
-				m.Cost = &Cost{}
-			}
-			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectList", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProjectList = append(m.ProjectList, &Expr{})
-			if err := m.ProjectList[len(m.ProjectList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
 			if wireType == 0 {
 				var v int32
 				for shift := uint(0); ; shift += 7 {
@@ -16905,7 +22398,7 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.Children = append(m.Children, v)
+				m.Steps = append(m.Steps, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -16940,8 +22433,8 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.Children) == 0 {
-					m.Children = make([]int32, 0, elementCount)
+				if elementCount != 0 && len(m.Steps) == 0 {
+					m.Steps = make([]int32, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v int32
@@ -16951,109 +22444,22 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 						}
 						if iNdEx >= l {
 							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Children = append(m.Children, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinType", wireType)
-			}
-			m.JoinType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JoinType |= Node_JoinFlag(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnList", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OnList = append(m.OnList, &Expr{})
-			if err := m.OnList[len(m.OnList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FilterList", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FilterList = append(m.FilterList, &Expr{})
-			if err := m.FilterList[len(m.FilterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Steps = append(m.Steps, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17080,14 +22486,14 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupBy = append(m.GroupBy, &Expr{})
-			if err := m.GroupBy[len(m.GroupBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Nodes = append(m.Nodes, &Node{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupingSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17114,16 +22520,16 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupingSet = append(m.GroupingSet, &Expr{})
-			if err := m.GroupingSet[len(m.GroupingSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Params = append(m.Params, &Expr{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headings", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -17133,31 +22539,80 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggList = append(m.AggList, &Expr{})
-			if err := m.AggList[len(m.AggList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headings = append(m.Headings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
 			}
-			var msglen int
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransationControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransationControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransationControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TclType", wireType)
+			}
+			m.TclType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -17167,29 +22622,14 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TclType |= TransationControl_TclType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OrderBy = append(m.OrderBy, &OrderBySpec{})
-			if err := m.OrderBy[len(m.OrderBy)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17216,16 +22656,15 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UpdateInfo == nil {
-				m.UpdateInfo = &UpdateInfo{}
-			}
-			if err := m.UpdateInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &TransationBegin{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Action = &TransationControl_Begin{v}
 			iNdEx = postIndex
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WinSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17252,16 +22691,15 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WinSpec == nil {
-				m.WinSpec = &WindowSpec{}
-			}
-			if err := m.WinSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &TransationCommit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Action = &TransationControl_Commit{v}
 			iNdEx = postIndex
-		case 15:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17288,18 +22726,68 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Limit == nil {
-				m.Limit = &Expr{}
-			}
-			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &TransationRollback{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Action = &TransationControl_Rollback{v}
 			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransationBegin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransationBegin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransationBegin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -17309,33 +22797,67 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Mode |= TransationBegin_TransationMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPlan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Offset == nil {
-				m.Offset = &Expr{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransationCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
 			}
-			if err := m.Offset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransationCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransationCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionType", wireType)
 			}
-			var msglen int
+			m.CompletionType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -17345,33 +22867,67 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CompletionType |= TransationCompletionType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPlan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TableDef == nil {
-				m.TableDef = &TableDef{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransationRollback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
 			}
-			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjRef", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransationRollback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransationRollback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionType", wireType)
+			}
+			m.CompletionType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -17381,31 +22937,65 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CompletionType |= TransationCompletionType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPlan
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ObjRef == nil {
-				m.ObjRef = &ObjectRef{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Plan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
 			}
-			if err := m.ObjRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 19:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Plan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Plan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RowsetData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17432,18 +23022,17 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RowsetData == nil {
-				m.RowsetData = &RowsetData{}
-			}
-			if err := m.RowsetData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &Query{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Plan = &Plan_Query{v}
 			iNdEx = postIndex
-		case 20:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tcl", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -17453,27 +23042,30 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtraOptions = string(dAtA[iNdEx:postIndex])
+			v := &TransationControl{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Plan = &Plan_Tcl{v}
 			iNdEx = postIndex
-		case 21:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteTablesCtx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ddl", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17500,90 +23092,15 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeleteTablesCtx = append(m.DeleteTablesCtx, &DeleteTableCtx{})
-			if err := m.DeleteTablesCtx[len(m.DeleteTablesCtx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &DataDefinition{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 22:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.BindingTags = append(m.BindingTags, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.BindingTags) == 0 {
-					m.BindingTags = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPlan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BindingTags = append(m.BindingTags, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BindingTags", wireType)
-			}
-		case 23:
+			m.Plan = &Plan_Ddl{v}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalyzeInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dcl", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17610,12 +23127,11 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AnalyzeInfo == nil {
-				m.AnalyzeInfo = &AnalyzeInfo{}
-			}
-			if err := m.AnalyzeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &DataControl{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Plan = &Plan_Dcl{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17639,7 +23155,7 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteTableCtx) Unmarshal(dAtA []byte) error {
+func (m *DataControl) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17662,17 +23178,36 @@ func (m *DeleteTableCtx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteTableCtx: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataControl: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteTableCtx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataControl: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DclType", wireType)
+			}
+			m.DclType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DclType |= DataControl_DclType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DbName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetVariables", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -17682,29 +23217,32 @@ func (m *DeleteTableCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DbName = string(dAtA[iNdEx:postIndex])
+			v := &SetVariables{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Control = &DataControl_SetVariables{v}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TblName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prepare", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -17714,29 +23252,32 @@ func (m *DeleteTableCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TblName = string(dAtA[iNdEx:postIndex])
+			v := &Prepare{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Control = &DataControl_Prepare{v}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseDeleteKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Execute", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -17746,29 +23287,32 @@ func (m *DeleteTableCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UseDeleteKey = string(dAtA[iNdEx:postIndex])
+			v := &Execute{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Control = &DataControl_Execute{v}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanTruncate", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deallocate", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -17778,12 +23322,27 @@ func (m *DeleteTableCtx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CanTruncate = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Deallocate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Control = &DataControl_Deallocate{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -17806,7 +23365,7 @@ func (m *DeleteTableCtx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Query) Unmarshal(dAtA []byte) error {
+func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17829,17 +23388,17 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Query: wiretype end group for non-group")
+			return fmt.Errorf("proto: DataDefinition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Query: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DataDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StmtType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DdlType", wireType)
 			}
-			m.StmtType = 0
+			m.DdlType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -17849,90 +23408,85 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StmtType |= Query_StatementType(b&0x7F) << shift
+				m.DdlType |= DataDefinition_DdlType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				m.Steps = append(m.Steps, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPlan
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPlan
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPlan
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Query == nil {
+				m.Query = &Query{}
+			}
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateDatabase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Steps) == 0 {
-					m.Steps = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPlan
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Steps = append(m.Steps, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CreateDatabase{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Definition = &DataDefinition_CreateDatabase{v}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterDatabase", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17959,14 +23513,15 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &Node{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &AlterDatabase{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Definition = &DataDefinition_AlterDatabase{v}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropDatabase", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17993,16 +23548,17 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Params = append(m.Params, &Expr{})
-			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &DropDatabase{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Definition = &DataDefinition_DropDatabase{v}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTable", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18012,80 +23568,67 @@ func (m *Query) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headings = append(m.Headings, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
+			v := &CreateTable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
+			m.Definition = &DataDefinition_CreateTable{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterTable", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TransationControl) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &AlterTable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransationControl: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransationControl: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TclType", wireType)
+			m.Definition = &DataDefinition_AlterTable{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropTable", wireType)
 			}
-			m.TclType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18095,14 +23638,30 @@ func (m *TransationControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TclType |= TransationControl_TclType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DropTable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Definition = &DataDefinition_DropTable{v}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18129,15 +23688,15 @@ func (m *TransationControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &TransationBegin{}
+			v := &CreateIndex{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Action = &TransationControl_Begin{v}
+			m.Definition = &DataDefinition_CreateIndex{v}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18164,15 +23723,15 @@ func (m *TransationControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &TransationCommit{}
+			v := &AlterIndex{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Action = &TransationControl_Commit{v}
+			m.Definition = &DataDefinition_AlterIndex{v}
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18199,68 +23758,52 @@ func (m *TransationControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &TransationRollback{}
+			v := &DropIndex{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Action = &TransationControl_Rollback{v}
+			m.Definition = &DataDefinition_DropIndex{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncateTable", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TransationBegin) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &TruncateTable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TransationBegin: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransationBegin: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			m.Definition = &DataDefinition_TruncateTable{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShowVariables", wireType)
 			}
-			m.Mode = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18270,11 +23813,27 @@ func (m *TransationBegin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Mode |= TransationBegin_TransationMode(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ShowVariables{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Definition = &DataDefinition_ShowVariables{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -18297,7 +23856,7 @@ func (m *TransationBegin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransationCommit) Unmarshal(dAtA []byte) error {
+func (m *CreateDatabase) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18320,17 +23879,17 @@ func (m *TransationCommit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransationCommit: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateDatabase: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransationCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateDatabase: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IfNotExists", wireType)
 			}
-			m.CompletionType = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18340,11 +23899,44 @@ func (m *TransationCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompletionType |= TransationCompletionType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IfNotExists = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -18367,7 +23959,7 @@ func (m *TransationCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransationRollback) Unmarshal(dAtA []byte) error {
+func (m *AlterDatabase) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18390,17 +23982,17 @@ func (m *TransationRollback) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransationRollback: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterDatabase: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransationRollback: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterDatabase: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
 			}
-			m.CompletionType = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18410,11 +24002,44 @@ func (m *TransationRollback) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompletionType |= TransationCompletionType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IfExists = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -18437,7 +24062,7 @@ func (m *TransationRollback) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Plan) Unmarshal(dAtA []byte) error {
+func (m *DropDatabase) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18460,17 +24085,17 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Plan: wiretype end group for non-group")
+			return fmt.Errorf("proto: DropDatabase: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Plan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DropDatabase: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18480,102 +24105,17 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &Query{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Plan = &Plan_Query{v}
-			iNdEx = postIndex
+			m.IfExists = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tcl", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &TransationControl{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Plan = &Plan_Tcl{v}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ddl", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &DataDefinition{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Plan = &Plan_Ddl{v}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dcl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18585,26 +24125,23 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DataControl{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Plan = &Plan_Dcl{v}
+			m.Database = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18628,7 +24165,7 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataControl) Unmarshal(dAtA []byte) error {
+func (m *CreateTable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18651,17 +24188,17 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataControl: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateTable: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataControl: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateTable: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DclType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IfNotExists", wireType)
 			}
-			m.DclType = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18671,51 +24208,37 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DclType |= DataControl_DclType(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IfNotExists = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetVariables", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Temporary", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &SetVariables{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Control = &DataControl_SetVariables{v}
-			iNdEx = postIndex
+			m.Temporary = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prepare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18725,30 +24248,27 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Prepare{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Control = &DataControl_Prepare{v}
+			m.Database = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Execute", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18775,17 +24295,18 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Execute{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TableDef == nil {
+				m.TableDef = &TableDef{}
+			}
+			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Control = &DataControl_Execute{v}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deallocate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replace", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18795,27 +24316,12 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &Deallocate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Control = &DataControl_Deallocate{v}
-			iNdEx = postIndex
+			m.Replace = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -18838,7 +24344,7 @@ func (m *DataControl) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataDefinition) Unmarshal(dAtA []byte) error {
+func (m *AlterTable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18861,17 +24367,17 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataDefinition: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTable: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataDefinition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTable: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DdlType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
-			m.DdlType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18881,14 +24387,27 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DdlType |= DataDefinition_DdlType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18915,18 +24434,18 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Query == nil {
-				m.Query = &Query{}
+			if m.TableDef == nil {
+				m.TableDef = &TableDef{}
 			}
-			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateDatabase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -18936,30 +24455,27 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CreateDatabase{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Definition = &DataDefinition_CreateDatabase{v}
+			m.Database = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlterDatabase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18986,15 +24502,65 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AlterDatabase{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Actions = append(m.Actions, &AlterTable_Action{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_AlterDatabase{v}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTable_Action) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Action: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Action: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropDatabase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddColumn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19021,15 +24587,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DropDatabase{}
+			v := &AlterTableAddColumn{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_DropDatabase{v}
+			m.Action = &AlterTable_Action_AddColumn{v}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropColumn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19056,15 +24622,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CreateTable{}
+			v := &AlterTableDropColumn{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_CreateTable{v}
+			m.Action = &AlterTable_Action_DropColumn{v}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlterTable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameColumn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19091,15 +24657,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AlterTable{}
+			v := &AlterTableRenameColumn{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_AlterTable{v}
+			m.Action = &AlterTable_Action_RenameColumn{v}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropTable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameTable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19126,15 +24692,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DropTable{}
+			v := &AlterTableRenameTable{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_DropTable{v}
+			m.Action = &AlterTable_Action_RenameTable{v}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddPartition", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19161,15 +24727,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CreateIndex{}
+			v := &AlterTableAddPartition{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_CreateIndex{v}
+			m.Action = &AlterTable_Action_AddPartition{v}
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlterIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DropPartition", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19196,15 +24762,15 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &AlterIndex{}
+			v := &AlterTableDropPartition{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_AlterIndex{v}
+			m.Action = &AlterTable_Action_DropPartition{v}
 			iNdEx = postIndex
-		case 11:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncatePartition", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19231,50 +24797,66 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &DropIndex{}
+			v := &AlterTableTruncatePartition{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_DropIndex{v}
+			m.Action = &AlterTable_Action_TruncatePartition{v}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TruncateTable", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableAddColumn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &TruncateTable{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Definition = &DataDefinition_TruncateTable{v}
-			iNdEx = postIndex
-		case 13:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableAddColumn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableAddColumn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShowVariables", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19301,11 +24883,12 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ShowVariables{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Column == nil {
+				m.Column = &ColDef{}
+			}
+			if err := m.Column.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Definition = &DataDefinition_ShowVariables{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19329,7 +24912,7 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateDatabase) Unmarshal(dAtA []byte) error {
+func (m *AlterTableDropColumn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19352,35 +24935,15 @@ func (m *CreateDatabase) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateDatabase: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableDropColumn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateDatabase: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableDropColumn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IfNotExists", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IfNotExists = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19408,7 +24971,7 @@ func (m *CreateDatabase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Database = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19432,7 +24995,7 @@ func (m *CreateDatabase) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterDatabase) Unmarshal(dAtA []byte) error {
+func (m *AlterTableRenameColumn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19455,17 +25018,17 @@ func (m *AlterDatabase) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterDatabase: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableRenameColumn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterDatabase: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableRenameColumn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -19475,15 +25038,27 @@ func (m *AlterDatabase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IfExists = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19511,7 +25086,7 @@ func (m *AlterDatabase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Database = string(dAtA[iNdEx:postIndex])
+			m.NewName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19535,7 +25110,7 @@ func (m *AlterDatabase) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DropDatabase) Unmarshal(dAtA []byte) error {
+func (m *AlterTableRenameTable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19558,17 +25133,17 @@ func (m *DropDatabase) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DropDatabase: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableRenameTable: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DropDatabase: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableRenameTable: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -19578,15 +25153,27 @@ func (m *DropDatabase) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IfExists = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19614,7 +25201,7 @@ func (m *DropDatabase) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Database = string(dAtA[iNdEx:postIndex])
+			m.Table = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19638,7 +25225,7 @@ func (m *DropDatabase) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateTable) Unmarshal(dAtA []byte) error {
+func (m *AlterTableAddPartition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19661,87 +25248,15 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateTable: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableAddPartition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateTable: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableAddPartition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IfNotExists", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IfNotExists = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Temporary", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Temporary = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Database = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19768,10 +25283,8 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TableDef == nil {
-				m.TableDef = &TableDef{}
-			}
-			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Partitions = append(m.Partitions, &PartitionDef_Partition{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19797,7 +25310,7 @@ func (m *CreateTable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterTable) Unmarshal(dAtA []byte) error {
+func (m *AlterTableDropPartition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19820,15 +25333,15 @@ func (m *AlterTable) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterTable: wiretype end group for non-group")
+			return fmt.Errorf("proto: AlterTableDropPartition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterTable: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AlterTableDropPartition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19856,13 +25369,64 @@ func (m *AlterTable) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Table = string(dAtA[iNdEx:postIndex])
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterTableTruncatePartition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTableTruncatePartition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTableTruncatePartition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -19872,27 +25436,23 @@ func (m *AlterTable) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TableDef == nil {
-				m.TableDef = &TableDef{}
-			}
-			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
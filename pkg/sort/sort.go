@@ -75,7 +75,7 @@ func Sort(desc bool, os []int64, vec *vector.Vector) {
 		} else {
 			genericSort(col, os, genericGreater[uint8])
 		}
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		col := vector.GenericVectorValues[uint16](vec)
 		if !desc {
 			genericSort(col, os, genericLess[uint16])
@@ -89,7 +89,7 @@ func Sort(desc bool, os []int64, vec *vector.Vector) {
 		} else {
 			genericSort(col, os, genericGreater[uint32])
 		}
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		col := vector.GenericVectorValues[uint64](vec)
 		if !desc {
 			genericSort(col, os, genericLess[uint64])
@@ -145,7 +145,15 @@ func Sort(desc bool, os []int64, vec *vector.Vector) {
 		} else {
 			genericSort(col, os, decimal128Greater)
 		}
-	case types.T_char, types.T_varchar:
+	case types.T_uuid:
+		col := vector.GenericVectorValues[types.Uuid](vec)
+		if !desc {
+			genericSort(col, os, uuidLess)
+		} else {
+			genericSort(col, os, uuidGreater)
+		}
+	case types.T_char, types.T_varchar, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		col := vec.Col.(*types.Bytes)
 		if !desc {
 			genericSort([]types.String{col}, os, stringLess[types.String])
@@ -187,6 +195,14 @@ func decimal128Greater(data []types.Decimal128, i, j int64) bool {
 	return data[i].Compare(data[j]) > 0
 }
 
+func uuidLess(data []types.Uuid, i, j int64) bool {
+	return data[i].Compare(data[j]) < 0
+}
+
+func uuidGreater(data []types.Uuid, i, j int64) bool {
+	return data[i].Compare(data[j]) > 0
+}
+
 func genericLess[T types.Generic](data []T, i, j int64) bool {
 	return data[i] < data[j]
 }
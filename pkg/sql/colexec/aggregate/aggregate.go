@@ -22,6 +22,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/ring/bitand"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/bitor"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/bitxor"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/groupconcat"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/percentile"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/stddevpop"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/variance"
 
@@ -76,13 +78,20 @@ func ReturnType(op int, typ types.T) types.T {
 		return types.T_uint64
 	case BitOr:
 		return types.T_uint64
-	case StdDevPop:
+	case StdDevPop, VarSamp, StdDevSamp:
+		return types.T_float64
+	case GroupConcat:
+		return types.T_varchar
+	case PercentileCont:
 		return types.T_float64
+	case PercentileDisc:
+		return typ
 	}
 	return 0
 }
 
-func New(op int, dist bool, typ types.Type) (ring.Ring, error) {
+// New makes the ring of an aggregation, config is the Config of the Aggregate.
+func New(op int, dist bool, typ types.Type, config any) (ring.Ring, error) {
 	switch op {
 	case Sum:
 		return NewSum(typ)
@@ -116,6 +125,25 @@ func New(op int, dist bool, typ types.Type) (ring.Ring, error) {
 		return stddevpop.NewStdDevPopRingWithTypeCheck(typ)
 	case AnyValue:
 		return anyvalue.NewAnyValueRingWithTypeCheck(typ)
+	case VarSamp:
+		return variance.NewVarSampRingWithTypeCheck(typ)
+	case StdDevSamp:
+		return stddevpop.NewStdDevSampRingWithTypeCheck(typ)
+	case GroupConcat:
+		cfg, ok := config.(*GroupConcatConfig)
+		if !ok {
+			return nil, fmt.Errorf("group_concat needs its separator")
+		}
+		return groupconcat.NewGroupConcat(typ, dist, cfg.Separator, cfg.Descs)
+	case PercentileCont, PercentileDisc:
+		fraction, ok := config.(float64)
+		if !ok {
+			return nil, fmt.Errorf("%s needs its fraction", Names[op])
+		}
+		if op == PercentileCont {
+			return percentile.NewPercentileCont(typ, fraction)
+		}
+		return percentile.NewPercentileDisc(typ, fraction)
 	}
 	return nil, nil
 }
@@ -184,7 +212,8 @@ func NewMax(typ types.Type) (ring.Ring, error) {
 		return max.NewFloat32(typ), nil
 	case types.T_float64:
 		return max.NewFloat64(typ), nil
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		return max.NewStr(typ), nil
 	case types.T_date:
 		return max.NewDate(typ), nil
@@ -224,7 +253,8 @@ func NewMin(typ types.Type) (ring.Ring, error) {
 		return min.NewFloat32(typ), nil
 	case types.T_float64:
 		return min.NewFloat64(typ), nil
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		return min.NewStr(typ), nil
 	case types.T_date:
 		return min.NewDate(typ), nil
@@ -30,6 +30,11 @@ const (
 	BitOr
 	StdDevPop
 	AnyValue
+	VarSamp
+	StdDevSamp
+	GroupConcat
+	PercentileCont
+	PercentileDisc
 )
 
 var Names = [...]string{
@@ -46,10 +51,25 @@ var Names = [...]string{
 	BitOr:               "bit_or",
 	StdDevPop:           "stddev_pop",
 	AnyValue:            "any",
+	VarSamp:             "var_samp",
+	StdDevSamp:          "stddev_samp",
+	GroupConcat:         "group_concat",
+	PercentileCont:      "percentile_cont",
+	PercentileDisc:      "percentile_disc",
 }
 
 type Aggregate struct {
 	Op   int
 	Dist bool
 	E    *plan.Expr
+	// Config is the constant arguments of the aggregation if any, the
+	// GroupConcatConfig of GroupConcat or the float64 fraction of the percentiles
+	Config any
+}
+
+// GroupConcatConfig is the SEPARATOR of GROUP_CONCAT and the directions of its
+// ORDER BY keys, its input is the tuple of the string and the keys if any.
+type GroupConcatConfig struct {
+	Separator string
+	Descs     []bool
 }
@@ -39,7 +39,11 @@ func Call(_ int, proc *process.Process, arg interface{}) (bool, error) {
 	defer bat.Clean(proc.Mp)
 
 	for i := range p.DeleteCtxs {
-		err := p.DeleteCtxs[i].TableSource.Delete(p.Ts, bat.GetVector(int32(i)), p.DeleteCtxs[i].UseDeleteKey, proc.Snapshot)
+		err := p.DeleteCtxs[i].ForeignKeys.OnDelete(p.Ts, p.DeleteCtxs[i].TableName, p.DeleteCtxs[i].UseDeleteKey, bat.GetVector(int32(i)))
+		if err != nil {
+			return false, err
+		}
+		err = p.DeleteCtxs[i].TableSource.Delete(p.Ts, bat.GetVector(int32(i)), p.DeleteCtxs[i].UseDeleteKey, proc.Snapshot)
 		if err != nil {
 			return false, err
 		}
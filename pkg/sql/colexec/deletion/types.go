@@ -15,6 +15,7 @@
 package deletion
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/foreignkey"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
@@ -26,6 +27,10 @@ type Argument struct {
 
 type DeleteCtx struct {
 	TableSource  engine.Relation
+	TableName    string
 	UseDeleteKey string
 	CanTruncate  bool
+	// ForeignKeys applies the ON DELETE actions of the foreign keys referring
+	// to the deleted rows, nil if foreign_key_checks is off
+	ForeignKeys *foreignkey.Checker
 }
@@ -0,0 +1,206 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fixpoint
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	if ap.Distinct {
+		buf.WriteString(fmt.Sprintf("fixpoint(union, %d)", ap.MaxDepth))
+	} else {
+		buf.WriteString(fmt.Sprintf("fixpoint(union all, %d)", ap.MaxDepth))
+	}
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	if ap.Distinct {
+		ap.ctr.mp = hashmap.NewStrMap(true)
+	}
+	return nil
+}
+
+func Call(idx int, proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(ap, proc, anal); err != nil {
+				ctr.state = End
+				return true, err
+			}
+			ctr.state = Eval
+		case Eval:
+			if ctr.bat == nil {
+				ctr.state = End
+				continue
+			}
+			// the rows of the last iteration are returned after
+			// the rows of the next iteration are produced
+			bat := ctr.bat
+			ctr.bat = nil
+			if err := ctr.iterate(ap, bat, proc); err != nil {
+				bat.Clean(proc.Mp)
+				ctr.state = End
+				return true, err
+			}
+			anal.Output(bat)
+			proc.SetInputBatch(bat)
+			return false, nil
+		default:
+			if ctr.bat != nil {
+				ctr.bat.Clean(proc.Mp)
+				ctr.bat = nil
+			}
+			proc.SetInputBatch(nil)
+			return true, nil
+		}
+	}
+}
+
+// build receives the rows of the non-recursive part.
+func (ctr *container) build(ap *Argument, proc *process.Process, anal process.Analyze) error {
+	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
+		bat := <-proc.Reg.MergeReceivers[i].Ch
+		if bat == nil {
+			continue
+		}
+		i--
+		if len(bat.Zs) == 0 {
+			continue
+		}
+		anal.Input(bat)
+		err := ctr.append(ap, bat, proc)
+		bat.Clean(proc.Mp)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// iterate runs the recursive part over the rows of the last iteration.
+func (ctr *container) iterate(ap *Argument, bat *batch.Batch, proc *process.Process) error {
+	input, err := dupBatch(bat, proc)
+	if err != nil {
+		return err
+	}
+	// the input is cleaned by the operators consuming it, but the batch which is
+	// filtered out entirely is dropped without being cleaned, so it is released here.
+	defer input.Clean(proc.Mp)
+	ctr.depth++
+	if err := ap.Iterate(input, proc, func(rbat *batch.Batch) error {
+		return ctr.append(ap, rbat, proc)
+	}); err != nil {
+		return err
+	}
+	if ctr.bat != nil && ctr.depth > ap.MaxDepth {
+		return errors.New(errno.ProgramLimitExceeded, fmt.Sprintf("Recursive query aborted after %d iterations. Try increasing @@cte_max_recursion_depth to a larger value.", ctr.depth))
+	}
+	return nil
+}
+
+// append copies the rows of the batch to the rows of the current iteration,
+// the rows which have been produced are discarded for UNION.
+func (ctr *container) append(ap *Argument, bat *batch.Batch, proc *process.Process) error {
+	var err error
+
+	if len(bat.Zs) == 0 {
+		return nil
+	}
+	for _, vec := range bat.Vecs {
+		if vec.ConstExpand(proc.Mp) == nil {
+			return errors.New("", "out of memory")
+		}
+	}
+	if ap.Distinct {
+		sels := ctr.distinct(bat)
+		if len(sels) == 0 {
+			return nil
+		}
+		if len(sels) < len(bat.Zs) {
+			bat.Shrink(sels)
+		}
+		for i := range bat.Zs {
+			bat.Zs[i] = 1
+		}
+	}
+	if ctr.bat == nil {
+		ctr.bat = batch.NewWithSize(len(bat.Vecs))
+		for i, vec := range bat.Vecs {
+			ctr.bat.Vecs[i] = vector.New(vec.Typ)
+		}
+	}
+	if ctr.bat, err = ctr.bat.Append(proc.Mp, bat); err != nil {
+		return err
+	}
+	return nil
+}
+
+// distinct inserts the rows into the hash map, and returns the new rows.
+func (ctr *container) distinct(bat *batch.Batch) []int64 {
+	var sels []int64
+
+	count := len(bat.Zs)
+	scales := make([]int32, len(bat.Vecs))
+	itr := ctr.mp.NewIterator()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		rows := ctr.mp.GroupCount()
+		vals, _ := itr.Insert(i, n, bat.Vecs, scales)
+		for k, v := range vals {
+			if v > rows {
+				rows++
+				ctr.mp.AddGroup()
+				sels = append(sels, int64(i+k))
+			}
+		}
+	}
+	return sels
+}
+
+func dupBatch(bat *batch.Batch, proc *process.Process) (*batch.Batch, error) {
+	rbat := batch.NewWithSize(len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		v, err := vector.Dup(vec, proc.Mp)
+		if err != nil {
+			rbat.Clean(proc.Mp)
+			return nil, err
+		}
+		rbat.Vecs[i] = v
+	}
+	rbat.Zs = make([]int64, len(bat.Zs))
+	copy(rbat.Zs, bat.Zs)
+	return rbat, nil
+}
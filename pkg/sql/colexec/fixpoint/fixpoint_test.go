@@ -0,0 +1,169 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fixpoint
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Rows  = 10 // default rows
+	Bound = 12 // the recursive part produces n + 1 while n < Bound
+)
+
+// add unit tests for cases
+type fixpointTestCase struct {
+	arg    *Argument
+	rows   int // expected number of rows
+	fail   bool
+	types  []types.Type
+	proc   *process.Process
+	cancel context.CancelFunc
+}
+
+var (
+	tcs []fixpointTestCase
+)
+
+func init() {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	tcs = []fixpointTestCase{
+		// 0 ... 12
+		newTestCase(mheap.New(gm), true, 1000, 13, false),
+		// each of the 20 rows v of the anchor produces the rows v + 1 ... 12
+		newTestCase(mheap.New(gm), false, 1000, 170, false),
+		newTestCase(mheap.New(gm), false, 5, 0, true),
+		newTestCase(mheap.New(gm), true, 12, 13, false),
+		newTestCase(mheap.New(gm), true, 2, 0, true),
+	}
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for _, tc := range tcs {
+		String(tc.arg, buf)
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	for _, tc := range tcs {
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+	}
+}
+
+func TestFixpoint(t *testing.T) {
+	for _, tc := range tcs {
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- &batch.Batch{}
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[1].Ch <- nil
+		rows := 0
+		for {
+			ok, err := Call(0, tc.proc, tc.arg)
+			if tc.proc.Reg.InputBatch != nil {
+				rows += len(tc.proc.Reg.InputBatch.Zs)
+				tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
+			}
+			if err != nil {
+				require.True(t, tc.fail)
+				break
+			}
+			if ok {
+				require.False(t, tc.fail)
+				require.Equal(t, tc.rows, rows)
+				break
+			}
+		}
+		Call(0, tc.proc, tc.arg)
+		for i := 0; i < len(tc.proc.Reg.MergeReceivers); i++ { // simulating the end of a pipeline
+			for len(tc.proc.Reg.MergeReceivers[i].Ch) > 0 {
+				bat := <-tc.proc.Reg.MergeReceivers[i].Ch
+				if bat != nil {
+					bat.Clean(tc.proc.Mp)
+				}
+			}
+		}
+		require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+	}
+}
+
+func newTestCase(m *mheap.Mheap, distinct bool, maxDepth int64, rows int, fail bool) fixpointTestCase {
+	proc := process.New(m)
+	proc.Reg.MergeReceivers = make([]*process.WaitRegister, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	proc.Reg.MergeReceivers[0] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 3),
+	}
+	proc.Reg.MergeReceivers[1] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 3),
+	}
+	return fixpointTestCase{
+		proc: proc,
+		rows: rows,
+		fail: fail,
+		types: []types.Type{
+			{Oid: types.T_int64},
+		},
+		arg: &Argument{
+			Distinct: distinct,
+			MaxDepth: maxDepth,
+			Iterate:  iterate,
+		},
+		cancel: cancel,
+	}
+}
+
+// iterate produces n + 1 for each row n of the last iteration while n < Bound.
+func iterate(bat *batch.Batch, proc *process.Process, fill func(*batch.Batch) error) error {
+	var vs []int64
+
+	for _, v := range vector.MustTCols[int64](bat.Vecs[0]) {
+		if v < Bound {
+			vs = append(vs, v+1)
+		}
+	}
+	if len(vs) == 0 {
+		return nil
+	}
+	rbat := batch.NewWithSize(1)
+	rbat.Vecs[0] = testutil.NewInt64Vector(len(vs), types.Type{Oid: types.T_int64}, proc.Mp, false, vs)
+	rbat.InitZsOne(len(vs))
+	defer rbat.Clean(proc.Mp)
+	return fill(rbat)
+}
+
+// create a new block based on the type information
+func newBatch(t *testing.T, ts []types.Type, proc *process.Process, rows int64) *batch.Batch {
+	return testutil.NewBatch(ts, false, int(rows), proc.Mp)
+}
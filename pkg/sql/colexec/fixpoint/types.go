@@ -0,0 +1,52 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fixpoint
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	Build = iota
+	Eval
+	End
+)
+
+type container struct {
+	state int
+	// depth is the number of the iterations which have been run
+	depth int64
+	// bat is the rows produced by the last iteration
+	bat *batch.Batch
+	// mp records all the rows which have been produced for UNION
+	mp *hashmap.StrHashMap
+}
+
+// Argument computes the fixpoint of a recursive CTE, the rows of the
+// non-recursive part are received from the merge receivers, and then
+// the recursive part is run over the rows of the last iteration until
+// no new rows are produced.
+type Argument struct {
+	// Distinct is true for UNION, the duplicate rows are discarded
+	Distinct bool
+	// MaxDepth is the max number of the iterations
+	MaxDepth int64
+	// Iterate runs the recursive part over the rows of the last iteration,
+	// and calls fill for each batch produced.
+	Iterate func(bat *batch.Batch, proc *process.Process, fill func(*batch.Batch) error) error
+	ctr     *container
+}
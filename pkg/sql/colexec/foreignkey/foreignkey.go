@@ -0,0 +1,667 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package foreignkey
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// New returns a checker of the tables of the database in the transaction of
+// the snapshot
+func New(db engine.Database, dbName string, snapshot engine.Snapshot) *Checker {
+	return &Checker{
+		db:       db,
+		dbName:   dbName,
+		snapshot: snapshot,
+		tables:   make(map[string]*table),
+		removed:  make(map[string]map[string]bool),
+	}
+}
+
+// CheckInsert returns an error if a row of the batch refers to a key missing
+// in the parent table. A foreign key with a null column refers to nothing.
+func (c *Checker) CheckInsert(tblName string, bat *batch.Batch) error {
+	if c == nil || len(bat.Vecs) == 0 {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tbl, err := c.getTable(tblName)
+	if err != nil {
+		return err
+	}
+	if len(tbl.fks) == 0 {
+		return nil
+	}
+	rows := make([]*row, vector.Length(bat.Vecs[0]))
+	for i := range rows {
+		rows[i] = &row{values: make([]interface{}, len(tbl.attrs))}
+	}
+	for j, attr := range bat.Attrs {
+		idx, ok := tbl.attrIdx[attr]
+		if !ok {
+			continue
+		}
+		for i, r := range rows {
+			r.values[idx] = vector.GetValue(bat.Vecs[j], i)
+		}
+	}
+	return c.checkParents(tbl, rows, nil)
+}
+
+// OnDelete applies the ON DELETE actions of the foreign keys referring to the
+// rows of the table about to be deleted. The rows are those whose column
+// keyName takes a value of keys, or all rows if keys is nil.
+func (c *Checker) OnDelete(ts uint64, tblName string, keyName string, keys *vector.Vector) error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tbl, err := c.getTable(tblName)
+	if err != nil {
+		return err
+	}
+	children, err := c.getChildren(tblName)
+	if err != nil || len(children) == 0 {
+		return err
+	}
+	olds, err := c.loadRows(tbl, keyName, keys)
+	if err != nil {
+		return err
+	}
+	return c.onDelete(ts, tbl, olds, 0)
+}
+
+// OnUpdate checks the foreign keys of the rows of the table about to be
+// updated and applies the ON UPDATE actions of the foreign keys referring to
+// them. The i-th row of the batch holds the new values of the row whose
+// column keyName takes the i-th value of keys.
+func (c *Checker) OnUpdate(ts uint64, tblName string, keyName string, keys *vector.Vector, bat *batch.Batch) error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	tbl, err := c.getTable(tblName)
+	if err != nil {
+		return err
+	}
+	children, err := c.getChildren(tblName)
+	if err != nil {
+		return err
+	}
+	if len(children) == 0 && len(tbl.fks) == 0 {
+		return nil
+	}
+	stored, err := c.loadRows(tbl, keyName, keys)
+	if err != nil {
+		return err
+	}
+	byKey := make(map[string]*row, len(stored))
+	keyIdx, ok := tbl.attrIdx[keyName]
+	for _, r := range stored {
+		k := r.hiddenKey
+		if ok {
+			k = r.values[keyIdx]
+		}
+		byKey[encodeValue(k)] = r
+	}
+	var olds, news []*row
+	for i := 0; i < vector.Length(keys); i++ {
+		old, ok := byKey[encodeValue(vector.GetValue(keys, i))]
+		if !ok {
+			continue
+		}
+		r := &row{values: append([]interface{}{}, old.values...)}
+		for j, attr := range bat.Attrs {
+			if idx, ok := tbl.attrIdx[attr]; ok {
+				r.values[idx] = vector.GetValue(bat.Vecs[j], i)
+			}
+		}
+		olds = append(olds, old)
+		news = append(news, r)
+	}
+	if err := c.checkParents(tbl, news, olds); err != nil {
+		return err
+	}
+	return c.onUpdate(ts, tbl, olds, news, 0)
+}
+
+// CheckDrop returns an error if a table other than itself refers to the table
+func (c *Checker) CheckDrop(tblName string) error {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	children, err := c.getChildren(tblName)
+	if err != nil {
+		return err
+	}
+	for _, child := range children {
+		if child.child.name != tblName {
+			return moerr.NewForeignKeyDropParent(tblName, child.fk.Name, child.child.name)
+		}
+	}
+	return nil
+}
+
+// checkParents returns an error if a row refers to a key missing in the parent
+// table. With the old values of the rows, only the keys changed are checked.
+// The keys found are checked again when the transaction commits.
+func (c *Checker) checkParents(tbl *table, rows, olds []*row) error {
+	for _, fk := range tbl.fks {
+		cols := tbl.colIndexes(fk.Cols)
+		missing := make(map[string][]interface{})
+		for i, r := range rows {
+			k, ok := encodeKey(r.values, cols)
+			if !ok {
+				continue
+			}
+			if olds != nil {
+				if old, ok := encodeKey(olds[i].values, cols); ok && old == k {
+					continue
+				}
+			}
+			missing[k] = keyValues(r.values, cols)
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		parent, err := c.getTable(fk.ParentTable)
+		if err != nil {
+			return moerr.NewNoReferencedRow(c.describe(tbl.name, fk))
+		}
+		parentCols := parent.colIndexes(fk.ParentCols)
+		// a row can refer to a row of the same batch
+		if parent == tbl {
+			for _, r := range rows {
+				if k, ok := encodeKey(r.values, parentCols); ok {
+					delete(missing, k)
+				}
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		keys, err := parent.keyBatch(fk.ParentCols, missing)
+		if err != nil {
+			return err
+		}
+		if err := c.lookup(parent, keys, func(r *row) {
+			if k, ok := encodeKey(r.values, parentCols); ok {
+				delete(missing, k)
+			}
+		}); err != nil {
+			return err
+		}
+		keyErr := moerr.NewNoReferencedRow(c.describe(tbl.name, fk))
+		if len(missing) > 0 {
+			return keyErr
+		}
+		if rel, ok := parent.rel.(engine.ForeignKeyRelation); ok {
+			if err := rel.RequireKeys(keys, keyErr, c.snapshot); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// onDelete applies the ON DELETE actions of the foreign keys referring to the
+// deleted rows
+func (c *Checker) onDelete(ts uint64, tbl *table, olds []*row, depth int) error {
+	c.markRemoved(tbl, olds)
+	children, err := c.getChildren(tbl.name)
+	if err != nil {
+		return err
+	}
+	for _, ck := range children {
+		refs, _, err := c.findReferences(ck, tbl, olds, nil)
+		if err != nil {
+			return err
+		}
+		if len(refs) == 0 {
+			continue
+		}
+		if ck.fk.OnDelete == engine.Restrict {
+			return moerr.NewRowIsReferenced(c.describe(ck.child.name, ck.fk))
+		}
+		if depth+1 > MaxDepth {
+			return moerr.NewForeignKeyDepthExceeded(MaxDepth)
+		}
+		if ck.fk.OnDelete == engine.Cascade {
+			if err := c.onDelete(ts, ck.child, refs, depth+1); err != nil {
+				return err
+			}
+			if err := c.deleteRows(ts, ck.child, refs); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := c.setReferences(ts, ck, refs, nil, depth); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// onUpdate applies the ON UPDATE actions of the foreign keys referring to the
+// keys changed by the update
+func (c *Checker) onUpdate(ts uint64, tbl *table, olds, news []*row, depth int) error {
+	children, err := c.getChildren(tbl.name)
+	if err != nil {
+		return err
+	}
+	for _, ck := range children {
+		refs, newKeys, err := c.findReferences(ck, tbl, olds, news)
+		if err != nil {
+			return err
+		}
+		if len(refs) == 0 {
+			continue
+		}
+		if ck.fk.OnUpdate == engine.Restrict {
+			return moerr.NewRowIsReferenced(c.describe(ck.child.name, ck.fk))
+		}
+		if depth+1 > MaxDepth {
+			return moerr.NewForeignKeyDepthExceeded(MaxDepth)
+		}
+		if ck.fk.OnUpdate == engine.SetNull {
+			newKeys = nil
+		}
+		if err := c.setReferences(ts, ck, refs, newKeys, depth); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setReferences rewrites the child rows with the columns of the foreign key
+// set to the new keys, or to nulls if newKeys is nil
+func (c *Checker) setReferences(ts uint64, ck *childKey, refs []*row, newKeys [][]interface{}, depth int) error {
+	cols := ck.child.colIndexes(ck.fk.Cols)
+	news := make([]*row, len(refs))
+	for i, ref := range refs {
+		news[i] = &row{values: append([]interface{}{}, ref.values...)}
+		for j, col := range cols {
+			if newKeys == nil {
+				news[i].values[col] = nil
+			} else {
+				news[i].values[col] = newKeys[i][j]
+			}
+		}
+	}
+	if err := c.onUpdate(ts, ck.child, refs, news, depth+1); err != nil {
+		return err
+	}
+	if err := c.deleteRows(ts, ck.child, refs); err != nil {
+		return err
+	}
+	return c.writeRows(ts, ck.child, news)
+}
+
+// findReferences returns the child rows referring to the keys of the old rows
+// of the parent table which are deleted, or changed if the new rows are given.
+// The new keys are returned for every child row in the update. The child rows
+// referring to the old keys are checked again when the transaction commits.
+func (c *Checker) findReferences(ck *childKey, parent *table, olds, news []*row) ([]*row, [][]interface{}, error) {
+	parentCols := parent.colIndexes(ck.fk.ParentCols)
+	oldKeys := make(map[string][]interface{})
+	keys := make(map[string][]interface{})
+	for i, old := range olds {
+		k, ok := encodeKey(old.values, parentCols)
+		if !ok {
+			continue
+		}
+		if news == nil {
+			oldKeys[k], keys[k] = keyValues(old.values, parentCols), nil
+			continue
+		}
+		if n, ok := encodeKey(news[i].values, parentCols); ok && n == k {
+			continue
+		}
+		oldKeys[k], keys[k] = keyValues(old.values, parentCols), keyValues(news[i].values, parentCols)
+	}
+	if len(keys) == 0 {
+		return nil, nil, nil
+	}
+	bat, err := ck.child.keyBatch(ck.fk.Cols, oldKeys)
+	if err != nil {
+		return nil, nil, err
+	}
+	cols := ck.child.colIndexes(ck.fk.Cols)
+	var refs []*row
+	var newKeys [][]interface{}
+	if err := c.lookup(ck.child, bat, func(r *row) {
+		k, ok := encodeKey(r.values, cols)
+		if !ok {
+			return
+		}
+		if newKey, ok := keys[k]; ok {
+			refs = append(refs, r)
+			newKeys = append(newKeys, newKey)
+		}
+	}); err != nil {
+		return nil, nil, err
+	}
+	rel, ok := ck.child.rel.(engine.ForeignKeyRelation)
+	parentRel, parentOk := parent.rel.(engine.ForeignKeyRelation)
+	if ok && parentOk {
+		keyErr := moerr.NewRowIsReferenced(c.describe(ck.child.name, ck.fk))
+		if err := rel.ForbidKeys(bat, parentRel, ck.fk.ParentCols, keyErr, c.snapshot); err != nil {
+			return nil, nil, err
+		}
+	}
+	return refs, newKeys, nil
+}
+
+// loadRows returns the rows whose column keyName takes a value of keys, or all
+// rows if keys is nil
+func (c *Checker) loadRows(tbl *table, keyName string, keys *vector.Vector) ([]*row, error) {
+	var rows []*row
+	if keys == nil {
+		if err := c.checkHiddenKey(tbl); err != nil {
+			return nil, err
+		}
+		err := c.read(tbl, tbl.rel.NewReader(1, nil, nil, c.snapshot), func(r *row) {
+			rows = append(rows, r)
+		})
+		return rows, err
+	}
+	bat := batch.New(true, []string{keyName})
+	bat.Vecs[0] = keys
+	err := c.lookup(tbl, bat, func(r *row) {
+		rows = append(rows, r)
+	})
+	return rows, err
+}
+
+// lookup reads the stored rows of the table whose columns named by the
+// attributes of the batch take the values of a row of the batch, except
+// those being deleted. The whole table is read if the engine can't look up
+// the keys.
+func (c *Checker) lookup(tbl *table, keys *batch.Batch, fn func(*row)) error {
+	if err := c.checkHiddenKey(tbl); err != nil {
+		return err
+	}
+	// the hidden key is the column -1
+	cols := make([]int, len(keys.Attrs))
+	for i, attr := range keys.Attrs {
+		if idx, ok := tbl.attrIdx[attr]; ok {
+			cols[i] = idx
+		} else {
+			cols[i] = -1
+		}
+	}
+	set := make(map[string]bool)
+	values := make([]interface{}, len(keys.Vecs))
+	for i := 0; i < vector.Length(keys.Vecs[0]); i++ {
+		for j, vec := range keys.Vecs {
+			values[j] = vector.GetValue(vec, i)
+		}
+		if k, ok := encodeValues(values); ok {
+			set[k] = true
+		}
+	}
+	var readers []engine.Reader
+	if rel, ok := tbl.rel.(engine.KeyRelation); ok {
+		var err error
+		if readers, err = rel.NewKeyReader(1, keys, c.snapshot); err != nil {
+			return err
+		}
+	} else {
+		readers = tbl.rel.NewReader(1, nil, nil, c.snapshot)
+	}
+	return c.read(tbl, readers, func(r *row) {
+		for j, col := range cols {
+			if col < 0 {
+				values[j] = r.hiddenKey
+			} else {
+				values[j] = r.values[col]
+			}
+		}
+		if k, ok := encodeValues(values); ok && set[k] {
+			fn(r)
+		}
+	})
+}
+
+func (c *Checker) checkHiddenKey(tbl *table) error {
+	if tbl.hiddenKey == nil {
+		return errors.New(errno.FeatureNotSupported, fmt.Sprintf("foreign keys of table '%s' are not supported by the engine", tbl.name))
+	}
+	return nil
+}
+
+// read reads the rows of the table by the readers except those being deleted
+func (c *Checker) read(tbl *table, readers []engine.Reader, fn func(*row)) error {
+	attrs := append(append([]string{}, tbl.attrs...), tbl.hiddenKey.Name)
+	refCnts := make([]uint64, len(attrs))
+	for i := range refCnts {
+		refCnts[i] = 1
+	}
+	removed := c.removed[tbl.name]
+	for _, reader := range readers {
+		for {
+			bat, err := reader.Read(refCnts, attrs)
+			if err != nil {
+				return err
+			}
+			if bat == nil {
+				break
+			}
+			n := vector.Length(bat.Vecs[0])
+			for i := 0; i < n; i++ {
+				r := &row{
+					values:    make([]interface{}, len(tbl.attrs)),
+					hiddenKey: vector.GetValue(bat.Vecs[len(tbl.attrs)], i),
+				}
+				if removed[encodeValue(r.hiddenKey)] {
+					continue
+				}
+				for j := range tbl.attrs {
+					r.values[j] = vector.GetValue(bat.Vecs[j], i)
+				}
+				fn(r)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Checker) markRemoved(tbl *table, rows []*row) {
+	removed, ok := c.removed[tbl.name]
+	if !ok {
+		removed = make(map[string]bool, len(rows))
+		c.removed[tbl.name] = removed
+	}
+	for _, r := range rows {
+		removed[encodeValue(r.hiddenKey)] = true
+	}
+}
+
+func (c *Checker) deleteRows(ts uint64, tbl *table, rows []*row) error {
+	c.markRemoved(tbl, rows)
+	vec := vector.New(tbl.hiddenKey.Type)
+	keys := make([]interface{}, len(rows))
+	for i, r := range rows {
+		keys[i] = r.hiddenKey
+	}
+	if err := vector.AppendValues(vec, keys); err != nil {
+		return err
+	}
+	return tbl.rel.Delete(ts, vec, tbl.hiddenKey.Name, c.snapshot)
+}
+
+func (c *Checker) writeRows(ts uint64, tbl *table, rows []*row) error {
+	bat := batch.New(true, tbl.attrs)
+	for i := range bat.Vecs {
+		bat.Vecs[i] = vector.New(tbl.types[i])
+		values := make([]interface{}, len(rows))
+		for j, r := range rows {
+			values[j] = r.values[i]
+		}
+		if err := vector.AppendValues(bat.Vecs[i], values); err != nil {
+			return err
+		}
+	}
+	return tbl.rel.Write(ts, bat, c.snapshot)
+}
+
+func (c *Checker) getTable(name string) (*table, error) {
+	if tbl, ok := c.tables[name]; ok {
+		return tbl, nil
+	}
+	rel, err := c.db.Relation(name, c.snapshot)
+	if err != nil {
+		return nil, err
+	}
+	tbl := &table{
+		name:      name,
+		rel:       rel,
+		attrIdx:   make(map[string]int),
+		hiddenKey: rel.GetHideKey(c.snapshot),
+	}
+	for _, def := range rel.TableDefs(c.snapshot) {
+		switch d := def.(type) {
+		case *engine.AttributeDef:
+			tbl.attrIdx[d.Attr.Name] = len(tbl.attrs)
+			tbl.attrs = append(tbl.attrs, d.Attr.Name)
+			tbl.types = append(tbl.types, d.Attr.Type)
+		case *engine.ForeignKeyDef:
+			tbl.fks = append(tbl.fks, d)
+		}
+	}
+	c.tables[name] = tbl
+	return tbl, nil
+}
+
+// getChildren returns the foreign keys referring to the table
+func (c *Checker) getChildren(name string) ([]*childKey, error) {
+	if c.children == nil {
+		c.children = make(map[string][]*childKey)
+		for _, relName := range c.db.Relations(c.snapshot) {
+			tbl, err := c.getTable(relName)
+			if err != nil {
+				return nil, err
+			}
+			for _, fk := range tbl.fks {
+				c.children[fk.ParentTable] = append(c.children[fk.ParentTable], &childKey{child: tbl, fk: fk})
+			}
+		}
+	}
+	return c.children[name], nil
+}
+
+// describe returns the foreign key as MySQL prints it in the errors
+func (c *Checker) describe(child string, fk *engine.ForeignKeyDef) string {
+	return fmt.Sprintf("`%s`.`%s`, CONSTRAINT `%s` FOREIGN KEY (%s) REFERENCES `%s` (%s)",
+		c.dbName, child, fk.Name, quoteNames(fk.Cols), fk.ParentTable, quoteNames(fk.ParentCols))
+}
+
+func quoteNames(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = "`" + name + "`"
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// keyBatch returns the batch of the keys of the columns of the table, the
+// column not in the attributes is the hidden key
+func (tbl *table) keyBatch(names []string, keys map[string][]interface{}) (*batch.Batch, error) {
+	rows := make([][]interface{}, 0, len(keys))
+	for _, key := range keys {
+		rows = append(rows, key)
+	}
+	bat := batch.New(true, names)
+	for i, name := range names {
+		typ := tbl.hiddenKey.Type
+		if idx, ok := tbl.attrIdx[name]; ok {
+			typ = tbl.types[idx]
+		}
+		bat.Vecs[i] = vector.New(typ)
+		values := make([]interface{}, len(rows))
+		for j, key := range rows {
+			values[j] = key[i]
+		}
+		if err := vector.AppendValues(bat.Vecs[i], values); err != nil {
+			return nil, err
+		}
+	}
+	return bat, nil
+}
+
+func (tbl *table) colIndexes(names []string) []int {
+	cols := make([]int, len(names))
+	for i, name := range names {
+		cols[i] = tbl.attrIdx[name]
+	}
+	return cols
+}
+
+// encodeKey returns the string of the values of the columns, false if any of
+// them is null
+func encodeKey(values []interface{}, cols []int) (string, bool) {
+	var b strings.Builder
+	for _, col := range cols {
+		if values[col] == nil {
+			return "", false
+		}
+		b.WriteString(encodeValue(values[col]))
+	}
+	return b.String(), true
+}
+
+// encodeValues returns the string of the values, false if any of them is null
+func encodeValues(values []interface{}) (string, bool) {
+	var b strings.Builder
+	for _, v := range values {
+		if v == nil {
+			return "", false
+		}
+		b.WriteString(encodeValue(v))
+	}
+	return b.String(), true
+}
+
+func keyValues(values []interface{}, cols []int) []interface{} {
+	key := make([]interface{}, len(cols))
+	for i, col := range cols {
+		key[i] = values[col]
+	}
+	return key
+}
+
+func encodeValue(v interface{}) string {
+	var s string
+	if bs, ok := v.([]byte); ok {
+		s = string(bs)
+	} else {
+		s = fmt.Sprint(v)
+	}
+	return strconv.Itoa(len(s)) + ":" + s
+}
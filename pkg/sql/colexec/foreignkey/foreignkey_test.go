@@ -0,0 +1,253 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package foreignkey
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+var int64Type = types.Type{Oid: types.T_int64, Size: 8}
+
+// newDatabase creates the tables in a new database of a new tae, every table
+// has an int64 primary key id and the int64 columns of the foreign keys
+func newDatabase(t *testing.T, tables map[string][]*engine.ForeignKeyDef) (engine.Database, moengine.Txn) {
+	eng, txn := newEngine(t, tables)
+	dbase, err := eng.Database("db", txn.GetCtx())
+	require.NoError(t, err)
+	return dbase, txn
+}
+
+// newEngine returns the engine and the txn creating the database
+func newEngine(t *testing.T, tables map[string][]*engine.ForeignKeyDef) (moengine.TxnEngine, moengine.Txn) {
+	tae, err := db.Open(testutils.InitTestEnv("foreignkey", t), nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { tae.Close() })
+	eng := moengine.NewEngine(tae)
+	txn, err := eng.StartTxn(nil)
+	require.NoError(t, err)
+	require.NoError(t, eng.Create(0, "db", 0, txn.GetCtx()))
+	dbase, err := eng.Database("db", txn.GetCtx())
+	require.NoError(t, err)
+	for name, fks := range tables {
+		defs := []engine.TableDef{
+			&engine.AttributeDef{Attr: engine.Attribute{Name: "id", Type: int64Type, Primary: true}},
+			&engine.PrimaryIndexDef{Names: []string{"id"}},
+		}
+		for _, fk := range fks {
+			for _, col := range fk.Cols {
+				defs = append(defs, &engine.AttributeDef{Attr: engine.Attribute{Name: col, Type: int64Type}})
+			}
+			defs = append(defs, fk)
+		}
+		require.NoError(t, dbase.Create(0, name, defs, txn.GetCtx()))
+	}
+	return eng, txn
+}
+
+func makeBatch(t *testing.T, attrs []string, rows ...[]interface{}) *batch.Batch {
+	bat := batch.New(true, attrs)
+	for i := range bat.Vecs {
+		bat.Vecs[i] = vector.New(int64Type)
+		values := make([]interface{}, len(rows))
+		for j, row := range rows {
+			values[j] = row[i]
+		}
+		require.NoError(t, vector.AppendValues(bat.Vecs[i], values))
+	}
+	return bat
+}
+
+func write(t *testing.T, dbase engine.Database, txn moengine.Txn, name string, attrs []string, rows ...[]interface{}) {
+	rel, err := dbase.Relation(name, txn.GetCtx())
+	require.NoError(t, err)
+	require.NoError(t, rel.Write(0, makeBatch(t, attrs, rows...), txn.GetCtx()))
+}
+
+// readRows returns the rows of the table sorted by id
+func readRows(t *testing.T, dbase engine.Database, txn moengine.Txn, name string) [][]interface{} {
+	c := New(dbase, "db", txn.GetCtx())
+	tbl, err := c.getTable(name)
+	require.NoError(t, err)
+	var rows [][]interface{}
+	stored, err := c.loadRows(tbl, "", nil)
+	require.NoError(t, err)
+	for _, r := range stored {
+		rows = append(rows, r.values)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i][0].(int64) < rows[j][0].(int64)
+	})
+	return rows
+}
+
+func keys(t *testing.T, values ...int64) *vector.Vector {
+	vec := vector.New(int64Type)
+	require.NoError(t, vector.Append(vec, values))
+	return vec
+}
+
+func TestCheckInsert(t *testing.T) {
+	dbase, txn := newDatabase(t, map[string][]*engine.ForeignKeyDef{
+		"p": nil,
+		"c": {{Name: "fk", Cols: []string{"pid"}, ParentTable: "p", ParentCols: []string{"id"}}},
+	})
+	write(t, dbase, txn, "p", []string{"id"}, []interface{}{int64(1)}, []interface{}{int64(2)})
+
+	c := New(dbase, "db", txn.GetCtx())
+	require.NoError(t, c.CheckInsert("c", makeBatch(t, []string{"id", "pid"},
+		[]interface{}{int64(1), int64(1)}, []interface{}{int64(2), nil})))
+	err := c.CheckInsert("c", makeBatch(t, []string{"id", "pid"}, []interface{}{int64(3), int64(3)}))
+	require.True(t, moerr.IsMoErrCode(err, moerr.NO_REFERENCED_ROW), "unexpected error: %v", err)
+	require.Equal(t, "Cannot add or update a child row: a foreign key constraint fails (`db`.`c`, CONSTRAINT `fk` FOREIGN KEY (`pid`) REFERENCES `p` (`id`))", err.Error())
+
+	// the rows of the parent table are checked too
+	require.NoError(t, c.CheckInsert("p", makeBatch(t, []string{"id"}, []interface{}{int64(3)})))
+
+	// a nil checker checks nothing
+	var nc *Checker
+	require.NoError(t, nc.CheckInsert("c", makeBatch(t, []string{"id", "pid"}, []interface{}{int64(3), int64(3)})))
+}
+
+func TestOnDelete(t *testing.T) {
+	dbase, txn := newDatabase(t, map[string][]*engine.ForeignKeyDef{
+		"p":  nil,
+		"c1": {{Name: "fk1", Cols: []string{"pid"}, ParentTable: "p", ParentCols: []string{"id"}, OnDelete: engine.Cascade}},
+		"c2": {{Name: "fk2", Cols: []string{"cid"}, ParentTable: "c1", ParentCols: []string{"id"}, OnDelete: engine.SetNull}},
+		"c3": {{Name: "fk3", Cols: []string{"pid"}, ParentTable: "p", ParentCols: []string{"id"}}},
+	})
+	write(t, dbase, txn, "p", []string{"id"}, []interface{}{int64(1)}, []interface{}{int64(2)}, []interface{}{int64(3)})
+	write(t, dbase, txn, "c1", []string{"id", "pid"}, []interface{}{int64(10), int64(1)}, []interface{}{int64(20), int64(2)})
+	write(t, dbase, txn, "c2", []string{"id", "cid"}, []interface{}{int64(100), int64(10)}, []interface{}{int64(200), int64(20)})
+	write(t, dbase, txn, "c3", []string{"id", "pid"}, []interface{}{int64(1000), int64(3)})
+
+	// p.1 is deleted with c1.10, and c2.100 refers to nothing then
+	c := New(dbase, "db", txn.GetCtx())
+	require.NoError(t, c.OnDelete(0, "p", "id", keys(t, 1)))
+	require.Equal(t, [][]interface{}{{int64(20), int64(2)}}, readRows(t, dbase, txn, "c1"))
+	require.Equal(t, [][]interface{}{{int64(100), nil}, {int64(200), int64(20)}}, readRows(t, dbase, txn, "c2"))
+
+	// p.3 is referred by c3.1000
+	c = New(dbase, "db", txn.GetCtx())
+	err := c.OnDelete(0, "p", "id", nil)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ROW_IS_REFERENCED), "unexpected error: %v", err)
+
+	c = New(dbase, "db", txn.GetCtx())
+	err = c.CheckDrop("p")
+	require.True(t, moerr.IsMoErrCode(err, moerr.FK_DROP_PARENT), "unexpected error: %v", err)
+	require.NoError(t, c.CheckDrop("c3"))
+}
+
+func TestOnUpdate(t *testing.T) {
+	dbase, txn := newDatabase(t, map[string][]*engine.ForeignKeyDef{
+		"p": nil,
+		"c": {{Name: "fk", Cols: []string{"pid"}, ParentTable: "p", ParentCols: []string{"id"}, OnUpdate: engine.Cascade}},
+		"s": {{Name: "fk_s", Cols: []string{"pid"}, ParentTable: "p", ParentCols: []string{"id"}, OnUpdate: engine.SetNull}},
+	})
+	write(t, dbase, txn, "p", []string{"id"}, []interface{}{int64(1)}, []interface{}{int64(2)})
+	write(t, dbase, txn, "c", []string{"id", "pid"}, []interface{}{int64(10), int64(1)}, []interface{}{int64(20), int64(2)})
+	write(t, dbase, txn, "s", []string{"id", "pid"}, []interface{}{int64(100), int64(1)})
+
+	c := New(dbase, "db", txn.GetCtx())
+	require.NoError(t, c.OnUpdate(0, "p", "id", keys(t, 1), makeBatch(t, []string{"id"}, []interface{}{int64(5)})))
+	require.Equal(t, [][]interface{}{{int64(10), int64(5)}, {int64(20), int64(2)}}, readRows(t, dbase, txn, "c"))
+	require.Equal(t, [][]interface{}{{int64(100), nil}}, readRows(t, dbase, txn, "s"))
+
+	// the child rows must refer to a key of the parent table
+	c = New(dbase, "db", txn.GetCtx())
+	err := c.OnUpdate(0, "c", "id", keys(t, 20), makeBatch(t, []string{"pid"}, []interface{}{int64(7)}))
+	require.True(t, moerr.IsMoErrCode(err, moerr.NO_REFERENCED_ROW), "unexpected error: %v", err)
+	require.NoError(t, c.OnUpdate(0, "c", "id", keys(t, 20), makeBatch(t, []string{"pid"}, []interface{}{int64(2)})))
+}
+
+func TestMaxDepth(t *testing.T) {
+	tables := map[string][]*engine.ForeignKeyDef{"t": {
+		{Name: "fk", Cols: []string{"pid"}, ParentTable: "t", ParentCols: []string{"id"}, OnDelete: engine.Cascade},
+	}}
+	dbase, txn := newDatabase(t, tables)
+	write(t, dbase, txn, "t", []string{"id", "pid"}, []interface{}{int64(0), nil})
+	for i := int64(1); i <= MaxDepth+1; i++ {
+		write(t, dbase, txn, "t", []string{"id", "pid"}, []interface{}{i, i - 1})
+	}
+
+	c := New(dbase, "db", txn.GetCtx())
+	err := c.OnDelete(0, "t", "id", keys(t, 0))
+	require.True(t, moerr.IsMoErrCode(err, moerr.FK_DEPTH_EXCEEDED), "unexpected error: %v", err)
+
+	c = New(dbase, "db", txn.GetCtx())
+	require.NoError(t, c.OnDelete(0, "t", "id", keys(t, 1)))
+	require.Equal(t, [][]interface{}{{int64(0), nil}, {int64(1), int64(0)}}, readRows(t, dbase, txn, "t"))
+}
+
+func TestCommitCheck(t *testing.T) {
+	eng, txn := newEngine(t, map[string][]*engine.ForeignKeyDef{
+		"p": nil,
+		"c": {{Name: "fk", Cols: []string{"pid"}, ParentTable: "p", ParentCols: []string{"id"}}},
+	})
+	dbase, err := eng.Database("db", txn.GetCtx())
+	require.NoError(t, err)
+	write(t, dbase, txn, "p", []string{"id"}, []interface{}{int64(1)}, []interface{}{int64(2)})
+	require.NoError(t, txn.Commit())
+
+	begin := func() (engine.Database, moengine.Txn) {
+		txn, err := eng.StartTxn(nil)
+		require.NoError(t, err)
+		dbase, err := eng.Database("db", txn.GetCtx())
+		require.NoError(t, err)
+		return dbase, txn
+	}
+	insertChild := func(id, pid int64) moengine.Txn {
+		dbase, txn := begin()
+		bat := makeBatch(t, []string{"id", "pid"}, []interface{}{id, pid})
+		require.NoError(t, New(dbase, "db", txn.GetCtx()).CheckInsert("c", bat))
+		write(t, dbase, txn, "c", []string{"id", "pid"}, []interface{}{id, pid})
+		return txn
+	}
+	deleteParent := func(id int64) moengine.Txn {
+		dbase, txn := begin()
+		require.NoError(t, New(dbase, "db", txn.GetCtx()).OnDelete(0, "p", "id", keys(t, id)))
+		rel, err := dbase.Relation("p", txn.GetCtx())
+		require.NoError(t, err)
+		require.NoError(t, rel.Delete(0, keys(t, id), "id", txn.GetCtx()))
+		return txn
+	}
+
+	// the parent row is deleted before the child row referring to it commits
+	txn1, txn2 := insertChild(10, 1), deleteParent(1)
+	require.NoError(t, txn2.Commit())
+	err = txn1.Commit()
+	require.True(t, moerr.IsMoErrCode(err, moerr.NO_REFERENCED_ROW), "unexpected error: %v", err)
+
+	// the child row referring to the parent row commits before its deletion
+	txn1, txn2 = insertChild(20, 2), deleteParent(2)
+	require.NoError(t, txn1.Commit())
+	err = txn2.Commit()
+	require.True(t, moerr.IsMoErrCode(err, moerr.ROW_IS_REFERENCED), "unexpected error: %v", err)
+
+	dbase, txn = begin()
+	require.Equal(t, [][]interface{}{{int64(2)}}, readRows(t, dbase, txn, "p"))
+	require.Equal(t, [][]interface{}{{int64(20), int64(2)}}, readRows(t, dbase, txn, "c"))
+	require.NoError(t, txn.Commit())
+}
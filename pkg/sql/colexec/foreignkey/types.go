@@ -0,0 +1,68 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package foreignkey
+
+import (
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// MaxDepth is the max depth of the cascading actions, as InnoDB
+const MaxDepth = 15
+
+// Checker enforces the foreign keys between the tables of a database in a
+// transaction. A nil checker checks nothing, it is used when the session
+// turns foreign_key_checks off.
+type Checker struct {
+	mu       sync.Mutex
+	db       engine.Database
+	dbName   string
+	snapshot engine.Snapshot
+	// tables caches the tables read, by name
+	tables map[string]*table
+	// children are the foreign keys referring to every table, nil until
+	// the definitions of all the tables are read
+	children map[string][]*childKey
+	// removed are the hidden keys of the rows being deleted for every table,
+	// the scans skip them
+	removed map[string]map[string]bool
+}
+
+type table struct {
+	name string
+	rel  engine.Relation
+	// attrs are the visible columns of the table
+	attrs     []string
+	types     []types.Type
+	attrIdx   map[string]int
+	hiddenKey *engine.Attribute
+	fks       []*engine.ForeignKeyDef
+}
+
+// childKey is a foreign key of the child table
+type childKey struct {
+	child *table
+	fk    *engine.ForeignKeyDef
+}
+
+// row is a row of a table, the values are in the order of the columns and
+// nil for nulls
+type row struct {
+	values []interface{}
+	// hiddenKey is nil for the rows not stored yet
+	hiddenKey interface{}
+}
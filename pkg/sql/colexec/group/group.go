@@ -25,9 +25,12 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/ring"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregate"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/add"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -47,7 +50,11 @@ func String(arg interface{}, buf *bytes.Buffer) {
 		}
 		buf.WriteString(fmt.Sprintf("%v(%v)", aggregate.Names[agg.Op], agg.E))
 	}
-	buf.WriteString("])")
+	buf.WriteString("]")
+	if len(ap.GroupingSets) > 0 {
+		buf.WriteString(fmt.Sprintf(", grouping sets %v", ap.GroupingSets))
+	}
+	buf.WriteString(")")
 }
 
 func Prepare(_ *process.Process, arg interface{}) error {
@@ -127,7 +134,7 @@ func (ctr *Container) process(ap *Argument, proc *process.Process) (bool, error)
 		ctr.bat.Zs = []int64{0}
 		ctr.bat.Rs = make([]ring.Ring, len(ap.Aggs))
 		for i, agg := range ap.Aggs {
-			if ctr.bat.Rs[i], err = aggregate.New(agg.Op, agg.Dist, ctr.aggVecs[i].vec.Typ); err != nil {
+			if ctr.bat.Rs[i], err = aggregate.New(agg.Op, agg.Dist, ctr.aggVecs[i].vec.Typ, agg.Config); err != nil {
 				ctr.bat = nil
 				return false, err
 			}
@@ -150,26 +157,13 @@ func (ctr *Container) process(ap *Argument, proc *process.Process) (bool, error)
 }
 
 func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (bool, error) {
-	var err error
-
 	bat := proc.Reg.InputBatch
 	if bat == nil {
+		if ctr.parts != nil {
+			return ctr.processPartitions(ap, proc)
+		}
 		if ctr.bat != nil {
-			switch ctr.typ {
-			case H8:
-				ctr.bat.Ht = ctr.intHashMap
-			case H24:
-				ctr.bat.Ht = ctr.strHashMap
-			case H32:
-				ctr.bat.Ht = ctr.strHashMap
-			case H40:
-				ctr.bat.Ht = ctr.strHashMap
-			default:
-				ctr.bat.Ht = ctr.strHashMap
-			}
-			ctr.bat.ExpandNulls()
-			proc.Reg.InputBatch = ctr.bat
-			ctr.bat = nil
+			ctr.output(proc)
 			return true, nil
 		}
 		proc.Reg.InputBatch = nil
@@ -180,6 +174,133 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 	}
 	defer bat.Clean(proc.Mp)
 	proc.Reg.InputBatch = &batch.Batch{}
+	if ctr.parts != nil {
+		if err := ctr.spill(bat, ap, proc); err != nil {
+			ctr.parts.Free(proc)
+			ctr.parts = nil
+			return false, err
+		}
+		return false, nil
+	}
+	if err := ctr.aggregate(bat, ap, proc); err != nil {
+		return false, err
+	}
+	if size := ctr.size(); spill.Need(proc, int64(size)) {
+		// the groups aggregated so far are returned at once to release the memory,
+		// they will be merged with the groups of the partitions by the merge-group.
+		ctr.parts = spill.NewPartitions(proc, spill.PartitionNumber)
+		ctr.output(proc)
+	}
+	return false, nil
+}
+
+// output returns the groups aggregated as the result of the operator.
+func (ctr *Container) output(proc *process.Process) {
+	switch ctr.typ {
+	case H8:
+		ctr.bat.Ht = ctr.intHashMap
+	case H24:
+		ctr.bat.Ht = ctr.strHashMap
+	case H32:
+		ctr.bat.Ht = ctr.strHashMap
+	case H40:
+		ctr.bat.Ht = ctr.strHashMap
+	default:
+		ctr.bat.Ht = ctr.strHashMap
+	}
+	ctr.bat.ExpandNulls()
+	proc.Reg.InputBatch = ctr.bat
+	ctr.bat = nil
+}
+
+// size returns the memory size of the groups aggregated.
+func (ctr *Container) size() int {
+	if ctr.bat == nil {
+		return 0
+	}
+	size := ctr.bat.Size()
+	for _, r := range ctr.bat.Rs {
+		size += r.Size()
+	}
+	return size
+}
+
+// spill writes the rows of the batch into the partitions by the group keys.
+func (ctr *Container) spill(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	if len(ctr.groupVecs) == 0 {
+		ctr.groupVecs = make([]evalVector, len(ap.Exprs))
+	}
+	keys := make([]*vector.Vector, len(ap.Exprs))
+	for i, expr := range ap.Exprs {
+		vec, err := colexec.EvalExpr(bat, proc, expr)
+		if err != nil {
+			for j := 0; j < i; j++ {
+				if ctr.groupVecs[j].needFree {
+					vector.Clean(ctr.groupVecs[j].vec, proc.Mp)
+				}
+			}
+			return err
+		}
+		keys[i] = vec
+		ctr.groupVecs[i].vec = vec
+		ctr.groupVecs[i].needFree = true
+		for j := range bat.Vecs {
+			if bat.Vecs[j] == vec {
+				ctr.groupVecs[i].needFree = false
+				break
+			}
+		}
+	}
+	defer func() {
+		for i := range ctr.groupVecs {
+			if ctr.groupVecs[i].needFree {
+				vector.Clean(ctr.groupVecs[i].vec, proc.Mp)
+			}
+		}
+	}()
+	return ctr.parts.Write(proc, bat, keys)
+}
+
+// processPartitions aggregates the spilled partitions one by one, the groups of
+// each partition are returned as soon as the partition is aggregated.
+func (ctr *Container) processPartitions(ap *Argument, proc *process.Process) (bool, error) {
+	if ctr.part == 0 {
+		if err := ctr.parts.Flush(proc); err != nil {
+			ctr.parts.Free(proc)
+			ctr.parts = nil
+			return false, err
+		}
+	}
+	for ctr.part < ctr.parts.Len() {
+		r := ctr.parts.Run(ctr.part)
+		ctr.part++
+		for i := 0; i < r.Count(); i++ {
+			bat, err := r.Read(proc, i)
+			if err == nil {
+				err = ctr.aggregate(bat, ap, proc)
+				bat.Clean(proc.Mp)
+			}
+			if err != nil {
+				ctr.parts.Free(proc)
+				ctr.parts = nil
+				return false, err
+			}
+		}
+		if ctr.bat != nil {
+			ctr.output(proc)
+			return false, nil
+		}
+	}
+	err := ctr.parts.Free(proc)
+	ctr.parts = nil
+	proc.Reg.InputBatch = nil
+	return true, err
+}
+
+// aggregate adds the rows of the batch into the groups.
+func (ctr *Container) aggregate(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	var err error
+
 	if len(ctr.aggVecs) == 0 {
 		ctr.aggVecs = make([]evalVector, len(ap.Aggs))
 	}
@@ -191,7 +312,7 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 					vector.Clean(ctr.aggVecs[j].vec, proc.Mp)
 				}
 			}
-			return false, err
+			return err
 		}
 		ctr.aggVecs[i].vec = vec
 		ctr.aggVecs[i].needFree = true
@@ -220,7 +341,7 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 					vector.Clean(ctr.groupVecs[j].vec, proc.Mp)
 				}
 			}
-			return false, err
+			return err
 		}
 		ctr.groupVecs[i].vec = vec
 		ctr.groupVecs[i].needFree = true
@@ -240,6 +361,7 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 	}()
 	if ctr.bat == nil {
 		size := 0
+		ctr.rows = 0
 		ctr.bat = batch.NewWithSize(len(ap.Exprs))
 		for i := range ctr.groupVecs {
 			vec := ctr.groupVecs[i].vec
@@ -247,15 +369,16 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 			switch vec.Typ.Oid {
 			case types.T_int8, types.T_uint8, types.T_bool:
 				size += 1 + 1
-			case types.T_int16, types.T_uint16:
+			case types.T_int16, types.T_uint16, types.T_enum:
 				size += 2 + 1
 			case types.T_int32, types.T_uint32, types.T_float32, types.T_date:
 				size += 4 + 1
-			case types.T_int64, types.T_uint64, types.T_float64, types.T_datetime, types.T_decimal64:
+			case types.T_int64, types.T_uint64, types.T_set, types.T_bit, types.T_float64, types.T_datetime, types.T_decimal64:
 				size += 8 + 1
-			case types.T_decimal128:
+			case types.T_decimal128, types.T_uuid:
 				size += 16 + 1
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_text,
+				types.T_binary, types.T_varbinary, types.T_blob:
 				if width := vec.Typ.Width; width > 0 {
 					size += int(width) + 1
 				} else {
@@ -265,9 +388,9 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 		}
 		ctr.bat.Rs = make([]ring.Ring, len(ap.Aggs))
 		for i, agg := range ap.Aggs {
-			if ctr.bat.Rs[i], err = aggregate.New(agg.Op, agg.Dist, ctr.aggVecs[i].vec.Typ); err != nil {
+			if ctr.bat.Rs[i], err = aggregate.New(agg.Op, agg.Dist, ctr.aggVecs[i].vec.Typ, agg.Config); err != nil {
 				ctr.bat = nil
-				return false, err
+				return err
 			}
 		}
 		ctr.keyOffs = make([]uint32, UnitLimit)
@@ -306,24 +429,76 @@ func (ctr *Container) processWithGroup(ap *Argument, proc *process.Process) (boo
 			ctr.strHashMap.Init()
 		}
 	}
+	if len(ap.GroupingSets) > 0 {
+		err = ctr.processGroupingSets(bat, ap, proc)
+	} else {
+		err = ctr.processGroups(bat, ap, proc)
+	}
+	if err != nil {
+		ctr.bat.Clean(proc.Mp)
+		ctr.bat = nil
+		return err
+	}
+	return nil
+}
+
+func (ctr *Container) processGroups(bat *batch.Batch, ap *Argument, proc *process.Process) error {
 	switch ctr.typ {
 	case H8:
-		err = ctr.processH8(bat, ap, proc)
+		return ctr.processH8(bat, ap, proc)
 	case H24:
-		err = ctr.processH24(bat, ap, proc)
+		return ctr.processH24(bat, ap, proc)
 	case H32:
-		err = ctr.processH32(bat, ap, proc)
+		return ctr.processH32(bat, ap, proc)
 	case H40:
-		err = ctr.processH40(bat, ap, proc)
+		return ctr.processH40(bat, ap, proc)
 	default:
-		err = ctr.processHStr(bat, ap, proc)
+		return ctr.processHStr(bat, ap, proc)
 	}
-	if err != nil {
-		ctr.bat.Clean(proc.Mp)
-		ctr.bat = nil
-		return false, err
+}
+
+// processGroupingSets groups the rows of the batch once for each grouping set,
+// with the keys rolled up by the set replaced by nulls and the first key
+// replaced by the index of the set, so all the sets share one input.
+func (ctr *Container) processGroupingSets(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	n := len(bat.Zs)
+	groupVecs := ctr.groupVecs
+	defer func() { ctr.groupVecs = groupVecs }()
+	ctr.groupVecs = make([]evalVector, len(groupVecs))
+	for i, set := range ap.GroupingSets {
+		copy(ctr.groupVecs, groupVecs)
+		for _, pos := range set {
+			ctr.groupVecs[pos].vec = rollup(groupVecs[pos].vec, n)
+		}
+		data, err := mheap.Alloc(proc.Mp, int64(n)*8)
+		if err != nil {
+			return err
+		}
+		vs := encoding.DecodeInt64Slice(data)[:n]
+		for j := range vs {
+			vs[j] = int64(i)
+		}
+		vec := vector.NewWithData(groupVecs[0].vec.Typ, data, vs, new(nulls.Nulls))
+		ctr.groupVecs[0].vec = vec
+		err = ctr.processGroups(bat, ap, proc)
+		vector.Clean(vec, proc.Mp)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rollup returns a vector of the values of vec which are all null.
+func rollup(vec *vector.Vector, n int) *vector.Vector {
+	nsp := nulls.NewWithSize(n)
+	nsp.Np.AddRange(0, uint64(n))
+	return &vector.Vector{
+		Typ:  vec.Typ,
+		Col:  vec.Col,
+		Data: vec.Data,
+		Nsp:  nsp,
 	}
-	return false, err
 }
 
 func (ctr *Container) processH0(bat *batch.Batch, ap *Argument, proc *process.Process) error {
@@ -19,7 +19,10 @@ import (
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregate"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
@@ -130,6 +133,85 @@ func TestGroup(t *testing.T) {
 	}
 }
 
+func TestGroupSpill(t *testing.T) {
+	fs, err := fileservice.NewMemoryFS()
+	require.NoError(t, err)
+	for _, tc := range tcs {
+		if len(tc.arg.Exprs) == 0 {
+			continue
+		}
+		tc.proc.FileService = fs
+		tc.proc.Lim.Size = 1 // the groups are spilled after the first batch
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+		cnt := int64(0)
+		for i := 0; i < 4; i++ {
+			if i < 3 {
+				tc.proc.Reg.InputBatch = newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+			} else {
+				tc.proc.Reg.InputBatch = nil
+			}
+			for {
+				ok, err := Call(0, tc.proc, tc.arg)
+				require.NoError(t, err)
+				if bat := tc.proc.Reg.InputBatch; bat != nil {
+					for _, z := range bat.Zs {
+						cnt += z
+					}
+					bat.Clean(tc.proc.Mp)
+				}
+				if ok || i < 3 {
+					break
+				}
+				tc.proc.Reg.InputBatch = nil
+			}
+		}
+		require.Equal(t, int64(3*Rows), cnt)
+		require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+		tc.proc.FileService = nil
+	}
+}
+
+func TestGroupingSets(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	ts := []types.Type{{Oid: types.T_int8}, {Oid: types.T_int16}}
+	index := &plan.Expr{
+		Typ: &plan.Type{Id: plan.Type_INT64, Size: 8},
+		Expr: &plan.Expr_C{
+			C: &plan.Const{Value: &plan.Const_Ival{Ival: 0}},
+		},
+	}
+	tc := newTestCase(mheap.New(gm), []bool{false, false}, ts,
+		[]*plan.Expr{index, newExpression(0), newExpression(1)}, []aggregate.Aggregate{{Op: 0, E: newExpression(0)}})
+	// ROLLUP(a, b)
+	tc.arg.GroupingSets = [][]int32{{}, {2}, {1, 2}}
+	err := Prepare(tc.proc, tc.arg)
+	require.NoError(t, err)
+	tc.proc.Reg.InputBatch = newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+	_, err = Call(0, tc.proc, tc.arg)
+	require.NoError(t, err)
+	tc.proc.Reg.InputBatch = nil
+	_, err = Call(0, tc.proc, tc.arg)
+	require.NoError(t, err)
+	bat := tc.proc.Reg.InputBatch
+	cnt := int64(0)
+	totals := 0
+	for i, z := range bat.Zs {
+		cnt += z
+		if vector.GetColumn[int64](bat.Vecs[0])[i] == 2 {
+			totals++
+			require.True(t, nulls.Contains(bat.Vecs[1].Nsp, uint64(i)))
+			require.True(t, nulls.Contains(bat.Vecs[2].Nsp, uint64(i)))
+			require.Equal(t, int64(Rows), z)
+		}
+	}
+	require.Equal(t, int64(3*Rows), cnt)
+	require.Equal(t, 1, totals)
+	bat.Clean(tc.proc.Mp)
+	require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+}
+
 func BenchmarkGroup(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -21,6 +21,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregate"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 )
 
 const (
@@ -76,11 +77,19 @@ type Container struct {
 		keys [][]byte
 	}
 	bat *batch.Batch
+
+	// parts are the partitions of the input spilled to the file service under
+	// memory pressure, they are aggregated one by one after all the input is received.
+	parts *spill.Partitions
+	part  int // index of the next partition to be aggregated
 }
 
 type Argument struct {
 	ctr   *Container
 	Exprs []*plan.Expr // group Expressions
-	Types []types.Type
-	Aggs  []aggregate.Aggregate // aggregations
+	// GroupingSets are the positions of Exprs rolled up to null by each
+	// grouping set, Exprs[0] is then replaced by the index of the set
+	GroupingSets [][]int32
+	Types        []types.Type
+	Aggs         []aggregate.Aggregate // aggregations
 }
@@ -18,11 +18,15 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/incrservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/foreignkey"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -33,6 +37,17 @@ type Argument struct {
 	TargetTable   engine.Relation
 	TargetColDefs []*plan.ColDef
 	Affected      uint64
+	// DbName and TableName name the keys of the auto increment columns
+	DbName    string
+	TableName string
+	// LastInsertID is the first auto increment value allocated
+	LastInsertID uint64
+	// Checks are the names of the CHECK constraints, the results of the
+	// constraints follow the columns of the table in the input batch
+	Checks []string
+	// ForeignKeys checks the foreign keys of the rows, nil if
+	// foreign_key_checks is off
+	ForeignKeys *foreignkey.Checker
 }
 
 func String(_ interface{}, buf *bytes.Buffer) {
@@ -55,15 +70,21 @@ func Call(_ int, proc *process.Process, arg interface{}) (bool, error) {
 	defer bat.Clean(proc.Mp)
 	{
 		// do null value check
-		for i := range bat.Vecs {
-			if n.TargetColDefs[i].Primary {
+		for i, def := range n.TargetColDefs {
+			if (def.Primary || def.NotNull) && !def.AutoIncr {
 				if nulls.Any(bat.Vecs[i].Nsp) {
-					return false, errors.New(errno.IntegrityConstraintViolation,
-						fmt.Sprintf("Column '%s' cannot be null", n.TargetColDefs[i].GetName()))
+					return false, moerr.NewBadNullValue(def.GetName())
 				}
 			}
 		}
 	}
+	if err := checkConstraints(n, bat); err != nil {
+		return false, err
+	}
+	for _, vec := range bat.Vecs[len(n.TargetColDefs):] {
+		vector.Clean(vec, proc.Mp)
+	}
+	bat.Vecs = bat.Vecs[:len(n.TargetColDefs)]
 	{
 		bat.Ro = false
 		bat.Attrs = make([]string, len(bat.Vecs))
@@ -75,7 +96,8 @@ func Call(_ int, proc *process.Process, arg interface{}) (bool, error) {
 					bat.Vecs[i].Typ.Oid = types.T(n.TargetColDefs[i].Typ.GetId())
 				}
 				switch bat.Vecs[i].Typ.Oid {
-				case types.T_char, types.T_varchar:
+				case types.T_char, types.T_varchar, types.T_text,
+					types.T_binary, types.T_varbinary, types.T_blob:
 					bat.Vecs[i].Col = &types.Bytes{
 						Data:    nil,
 						Offsets: make([]uint32, len(bat.Zs)),
@@ -89,7 +111,52 @@ func Call(_ int, proc *process.Process, arg interface{}) (bool, error) {
 			bat.Vecs[i] = bat.Vecs[i].ConstExpand(proc.Mp)
 		}
 	}
+	if err := fillAutoIncrement(n, bat, proc); err != nil {
+		return false, err
+	}
+	if err := n.ForeignKeys.CheckInsert(n.TableName, bat); err != nil {
+		return false, err
+	}
 	err := n.TargetTable.Write(n.Ts, bat, proc.Snapshot)
 	n.Affected += uint64(len(bat.Zs))
 	return false, err
 }
+
+// checkConstraints verifies the results of the CHECK constraints. A row
+// violates a constraint only if the result is false, null is accepted.
+func checkConstraints(n *Argument, bat *batch.Batch) error {
+	for i, name := range n.Checks {
+		vec := bat.Vecs[len(n.TargetColDefs)+i]
+		if vec.IsScalarNull() {
+			continue
+		}
+		for j, ok := range vector.MustTCols[bool](vec) {
+			if !ok && !nulls.Contains(vec.Nsp, uint64(j)) {
+				return moerr.NewCheckViolation(name)
+			}
+		}
+	}
+	return nil
+}
+
+// fillAutoIncrement allocates the values of the auto increment columns
+// for the rows inserting nulls or zeros into them
+func fillAutoIncrement(n *Argument, bat *batch.Batch, proc *process.Process) error {
+	for i, def := range n.TargetColDefs {
+		if !def.AutoIncr {
+			continue
+		}
+		if proc.IncrService == nil {
+			return errors.New(errno.FeatureNotSupported, fmt.Sprintf("auto increment column '%s' is not supported", def.GetName()))
+		}
+		key := incrservice.Key(n.DbName, n.TableName, def.GetName())
+		id, err := proc.IncrService.Fill(key, bat.Vecs[i])
+		if err != nil {
+			return err
+		}
+		if n.LastInsertID == 0 {
+			n.LastInsertID = id
+		}
+	}
+	return nil
+}
@@ -19,9 +19,11 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/incrservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -107,3 +109,92 @@ func TestInsertOperator(t *testing.T) {
 	_, err2 := Call(0, proc, &argument2)
 	require.Errorf(t, err2, "should return error when insert null into primary key column")
 }
+
+func TestInsertAutoIncrement(t *testing.T) {
+	proc := testutil.NewProc()
+	proc.IncrService = incrservice.New(incrservice.NewMemoryStore(), 0)
+	argument := Argument{
+		TargetTable: &mockRelation{},
+		TargetColDefs: []*plan.ColDef{
+			{Name: "id", Primary: true, AutoIncr: true, Typ: i64typ},
+			{Name: "varchar_column", Typ: varchartyp},
+		},
+		DbName:    "db",
+		TableName: "t",
+	}
+	proc.Reg.InputBatch = &batch.Batch{
+		Vecs: []*vector.Vector{
+			testutil.MakeInt64Vector([]int64{0, 5, 0}, []uint64{0}),
+			testutil.MakeVarcharVector([]string{"a", "b", "c"}, nil),
+		},
+		Zs: []int64{1, 1, 1},
+	}
+	_, err := Call(0, proc, &argument)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), argument.LastInsertID)
+	result := argument.TargetTable.(*mockRelation).result
+	require.Equal(t, []int64{1, 5, 6}, vector.MustTCols[int64](result.Vecs[0]))
+
+	proc.Reg.InputBatch = &batch.Batch{
+		Vecs: []*vector.Vector{
+			testutil.MakeScalarNull(2),
+			testutil.MakeVarcharVector([]string{"d", "e"}, nil),
+		},
+		Zs: []int64{1, 1},
+	}
+	_, err = Call(0, proc, &argument)
+	require.NoError(t, err)
+	result = argument.TargetTable.(*mockRelation).result
+	require.Equal(t, []int64{7, 8}, vector.MustTCols[int64](result.Vecs[0]))
+}
+
+func TestInsertConstraints(t *testing.T) {
+	proc := testutil.NewProc()
+	argument := Argument{
+		TargetTable: &mockRelation{},
+		TargetColDefs: []*plan.ColDef{
+			{Name: "a", NotNull: true, Typ: i64typ},
+			{Name: "b", Typ: i64typ},
+		},
+		Checks: []string{"c1", "c2"},
+	}
+
+	proc.Reg.InputBatch = &batch.Batch{
+		Vecs: []*vector.Vector{
+			testutil.MakeInt64Vector([]int64{1, 2}, nil),
+			testutil.MakeInt64Vector([]int64{0, 0}, []uint64{1}),
+			testutil.MakeBoolVector([]bool{true, true}),
+			testutil.MakeScalarNull(2),
+		},
+		Zs: []int64{1, 1},
+	}
+	_, err := Call(0, proc, &argument)
+	require.NoError(t, err)
+	result := argument.TargetTable.(*mockRelation).result
+	require.Equal(t, []string{"a", "b"}, result.Attrs)
+	require.Equal(t, 2, len(result.Vecs))
+
+	proc.Reg.InputBatch = &batch.Batch{
+		Vecs: []*vector.Vector{
+			testutil.MakeInt64Vector([]int64{1, 0}, []uint64{1}),
+			testutil.MakeInt64Vector([]int64{0, 0}, nil),
+			testutil.MakeBoolVector([]bool{true, true}),
+			testutil.MakeScalarBool(true, 2),
+		},
+		Zs: []int64{1, 1},
+	}
+	_, err = Call(0, proc, &argument)
+	require.Equal(t, moerr.NewBadNullValue("a"), err)
+
+	proc.Reg.InputBatch = &batch.Batch{
+		Vecs: []*vector.Vector{
+			testutil.MakeInt64Vector([]int64{1, 2}, nil),
+			testutil.MakeInt64Vector([]int64{0, 0}, nil),
+			testutil.MakeBoolVector([]bool{true, true}),
+			testutil.MakeBoolVector([]bool{true, false}),
+		},
+		Zs: []int64{1, 1},
+	}
+	_, err = Call(0, proc, &argument)
+	require.Equal(t, moerr.NewCheckViolation("c2"), err)
+}
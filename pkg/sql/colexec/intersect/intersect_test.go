@@ -0,0 +1,126 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intersect
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// add unit tests for cases
+type intersectTestCase struct {
+	arg    *Argument
+	rows   int64 // expected number of rows
+	proc   *process.Process
+	cancel context.CancelFunc
+}
+
+var (
+	tcs []intersectTestCase
+)
+
+func init() {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	tcs = []intersectTestCase{
+		// 1, 2, NULL
+		newTestCase(mheap.New(gm), false, 3),
+		// 1, 2, 2, NULL
+		newTestCase(mheap.New(gm), true, 4),
+	}
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for _, tc := range tcs {
+		String(tc.arg, buf)
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	for _, tc := range tcs {
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+	}
+}
+
+func TestIntersect(t *testing.T) {
+	for _, tc := range tcs {
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+		// the left relation is 1, 1, 2, 2, 3, NULL, NULL
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(tc.proc, []int64{1, 1, 2}, nil)
+		tc.proc.Reg.MergeReceivers[0].Ch <- &batch.Batch{}
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(tc.proc, []int64{2, 3, 0, 0}, []uint64{2, 3})
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		// the right relation is 1, 2, 2, 4, NULL
+		tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(tc.proc, []int64{1, 2, 2, 4, 0}, []uint64{4})
+		tc.proc.Reg.MergeReceivers[1].Ch <- nil
+		rows := int64(0)
+		for {
+			ok, err := Call(0, tc.proc, tc.arg)
+			require.NoError(t, err)
+			if ok {
+				break
+			}
+			for _, z := range tc.proc.Reg.InputBatch.Zs {
+				rows += z
+			}
+			tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
+		}
+		require.Equal(t, tc.rows, rows)
+		require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+	}
+}
+
+func newTestCase(m *mheap.Mheap, all bool, rows int64) intersectTestCase {
+	proc := process.New(m)
+	proc.Reg.MergeReceivers = make([]*process.WaitRegister, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	proc.Reg.MergeReceivers[0] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 4),
+	}
+	proc.Reg.MergeReceivers[1] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 2),
+	}
+	return intersectTestCase{
+		proc:   proc,
+		rows:   rows,
+		arg:    &Argument{All: all},
+		cancel: cancel,
+	}
+}
+
+// create a new block of an int64 column, the rows in nsp are NULLs
+func newBatch(proc *process.Process, vs []int64, nsp []uint64) *batch.Batch {
+	bat := batch.NewWithSize(1)
+	bat.Vecs[0] = testutil.NewInt64Vector(len(vs), types.Type{Oid: types.T_int64}, proc.Mp, false, vs)
+	nulls.Add(bat.Vecs[0].Nsp, nsp...)
+	bat.InitZsOne(len(vs))
+	return bat
+}
@@ -24,6 +24,8 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/joincondition"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -91,16 +93,33 @@ func Call(_ int, proc *process.Process, arg interface{}) (bool, error) {
 		case Build:
 			if err := ctr.build(ap, proc); err != nil {
 				ctr.state = End
+				ctr.freePartitions(proc)
 				return true, err
 			}
 			ctr.state = Probe
 		case Probe:
-			bat := <-proc.Reg.MergeReceivers[0].Ch
+			bat, err := ctr.receive(proc, 0)
+			if err != nil {
+				ctr.state = End
+				if ctr.bat != nil {
+					ctr.bat.Clean(proc.Mp)
+				}
+				ctr.freePartitions(proc)
+				return true, err
+			}
 			if bat == nil {
 				ctr.state = End
 				if ctr.bat != nil {
 					ctr.bat.Clean(proc.Mp)
 				}
+				if ctr.parts[0] != nil && ctr.part+1 < ctr.parts[0].Len() {
+					ctr.nextPartition()
+					ctr.state = Build
+					continue
+				}
+				if err := ctr.freePartitions(proc); err != nil {
+					return true, err
+				}
 				continue
 			}
 			if len(bat.Zs) == 0 {
@@ -112,6 +131,8 @@ func Call(_ int, proc *process.Process, arg interface{}) (bool, error) {
 			}
 			if err := ctr.probe(bat, ap, proc); err != nil {
 				ctr.state = End
+				ctr.bat.Clean(proc.Mp)
+				ctr.freePartitions(proc)
 				proc.Reg.InputBatch = nil
 				return true, err
 			}
@@ -131,10 +152,14 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 		return nil
 	}
 	if ctr.flg {
-		var err error
-
 		for {
-			bat := <-proc.Reg.MergeReceivers[1].Ch
+			bat, err := ctr.receive(proc, 1)
+			if err != nil {
+				if ctr.bat != nil {
+					ctr.bat.Clean(proc.Mp)
+				}
+				return err
+			}
 			if bat == nil {
 				break
 			}
@@ -153,6 +178,13 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 				return err
 			}
 			bat.Clean(proc.Mp)
+			if ctr.parts[0] == nil && spill.Need(proc, int64(ctr.bat.Size())) {
+				if err := ctr.spill(ap, proc); err != nil {
+					return err
+				}
+				// starts over with the first partition
+				return ctr.build(ap, proc)
+			}
 		}
 		if ctr.bat == nil || len(ctr.bat.Zs) == 0 {
 			return nil
@@ -190,51 +222,7 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 				n = UnitLimit
 			}
 			copy(ctr.zValues[:n], OneInt64s[:n])
-			for j, cond := range ap.Conditions[1] {
-				vec := ctr.vecs[j].vec
-				switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-				case 1:
-					fillGroupStr[uint8](ctr, vec, n, 1, i)
-				case 2:
-					fillGroupStr[uint16](ctr, vec, n, 2, i)
-				case 4:
-					fillGroupStr[uint32](ctr, vec, n, 4, i)
-				case 8:
-					fillGroupStr[uint64](ctr, vec, n, 8, i)
-				case -8:
-					if cond.Scale > 0 {
-						fillGroupStrWithDecimal64(ctr, vec, n, i, cond.Scale)
-					} else {
-						fillGroupStr[uint64](ctr, vec, n, 8, i)
-					}
-				case -16:
-					if cond.Scale > 0 {
-						fillGroupStrWithDecimal128(ctr, vec, n, i, cond.Scale)
-					} else {
-						fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
-					}
-				default:
-					vs := vec.Col.(*types.Bytes)
-					if !nulls.Any(vec.Nsp) {
-						for k := 0; k < n; k++ {
-							ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-						}
-					} else {
-						for k := 0; k < n; k++ {
-							if vec.Nsp.Np.Contains(uint64(i + k)) {
-								ctr.zValues[k] = 0
-							} else {
-								ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-							}
-						}
-					}
-				}
-			}
-			for k := 0; k < n; k++ {
-				if l := len(ctr.keys[k]); l < 16 {
-					ctr.keys[k] = append(ctr.keys[k], hashtable.StrKeyPadding[l:]...)
-				}
-			}
+			ctr.fillKeys(ap.Conditions[1], n, i)
 			ctr.strHashMap.InsertStringBatchWithRing(ctr.zValues, ctr.strHashStates, ctr.keys[:n], ctr.values)
 			for k, v := range ctr.values[:n] {
 				if ctr.zValues[k] == 0 {
@@ -292,51 +280,7 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 				n = UnitLimit
 			}
 			copy(ctr.zValues[:n], OneInt64s[:n])
-			for j, cond := range ap.Conditions[1] {
-				vec := ctr.vecs[j].vec
-				switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-				case 1:
-					fillGroupStr[uint8](ctr, vec, n, 1, i)
-				case 2:
-					fillGroupStr[uint16](ctr, vec, n, 2, i)
-				case 4:
-					fillGroupStr[uint32](ctr, vec, n, 4, i)
-				case 8:
-					fillGroupStr[uint64](ctr, vec, n, 8, i)
-				case -8:
-					if cond.Scale > 0 {
-						fillGroupStrWithDecimal64(ctr, vec, n, i, cond.Scale)
-					} else {
-						fillGroupStr[uint64](ctr, vec, n, 8, i)
-					}
-				case -16:
-					if cond.Scale > 0 {
-						fillGroupStrWithDecimal128(ctr, vec, n, i, cond.Scale)
-					} else {
-						fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
-					}
-				default:
-					vs := vec.Col.(*types.Bytes)
-					if !nulls.Any(vec.Nsp) {
-						for k := 0; k < n; k++ {
-							ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-						}
-					} else {
-						for k := 0; k < n; k++ {
-							if vec.Nsp.Np.Contains(uint64(i + k)) {
-								ctr.zValues[k] = 0
-							} else {
-								ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-							}
-						}
-					}
-				}
-			}
-			for k := 0; k < n; k++ {
-				if l := len(ctr.keys[k]); l < 16 {
-					ctr.keys[k] = append(ctr.keys[k], hashtable.StrKeyPadding[l:]...)
-				}
-			}
+			ctr.fillKeys(ap.Conditions[1], n, i)
 			ctr.strHashMap.InsertStringBatchWithRing(ctr.zValues, ctr.strHashStates, ctr.keys[:n], ctr.values)
 			cnt := 0
 			copy(ctr.inserted[:n], ctr.zInserted[:n])
@@ -424,51 +368,7 @@ func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Proces
 			n = UnitLimit
 		}
 		copy(ctr.zValues[:n], OneInt64s[:n])
-		for j, cond := range ap.Conditions[0] {
-			vec := ctr.vecs[j].vec
-			switch typLen := vec.Typ.Oid.FixedLength(); typLen {
-			case 1:
-				fillGroupStr[uint8](ctr, vec, n, 1, i)
-			case 2:
-				fillGroupStr[uint16](ctr, vec, n, 2, i)
-			case 4:
-				fillGroupStr[uint32](ctr, vec, n, 4, i)
-			case 8:
-				fillGroupStr[uint64](ctr, vec, n, 8, i)
-			case -8:
-				if cond.Scale > 0 {
-					fillGroupStrWithDecimal64(ctr, vec, n, i, cond.Scale)
-				} else {
-					fillGroupStr[uint64](ctr, vec, n, 8, i)
-				}
-			case -16:
-				if cond.Scale > 0 {
-					fillGroupStrWithDecimal128(ctr, vec, n, i, cond.Scale)
-				} else {
-					fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
-				}
-			default:
-				vs := vec.Col.(*types.Bytes)
-				if !nulls.Any(vec.Nsp) {
-					for k := 0; k < n; k++ {
-						ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-					}
-				} else {
-					for k := 0; k < n; k++ {
-						if vec.Nsp.Np.Contains(uint64(i + k)) {
-							ctr.zValues[k] = 0
-						} else {
-							ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
-						}
-					}
-				}
-			}
-		}
-		for k := 0; k < n; k++ {
-			if l := len(ctr.keys[k]); l < 16 {
-				ctr.keys[k] = append(ctr.keys[k], hashtable.StrKeyPadding[l:]...)
-			}
-		}
+		ctr.fillKeys(ap.Conditions[0], n, i)
 		ctr.strHashMap.FindStringBatch(ctr.strHashStates, ctr.keys[:n], ctr.values)
 		for k := 0; k < n; k++ {
 			ctr.keys[k] = ctr.keys[k][:0]
@@ -522,6 +422,182 @@ func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Proces
 	return nil
 }
 
+// receive returns the next batch of the idx-th input, the batches are read from
+// the partition being joined if the inputs have been spilled.
+func (ctr *Container) receive(proc *process.Process, idx int) (*batch.Batch, error) {
+	if ctr.parts[idx] == nil {
+		return <-proc.Reg.MergeReceivers[idx].Ch, nil
+	}
+	r := ctr.parts[idx].Run(ctr.part)
+	if ctr.reads[idx] == r.Count() {
+		return nil, nil
+	}
+	ctr.reads[idx]++
+	return r.Read(proc, ctr.reads[idx]-1)
+}
+
+// spill is the partitioning phase of the grace hash join, the rows of both inputs
+// are distributed into the partitions by the join keys, so that the rows which can
+// be joined are always in the same partition. Only the join with additional columns
+// of the right input is spilled, because the others keep only the counts of rows.
+func (ctr *Container) spill(ap *Argument, proc *process.Process) error {
+	for i := range ctr.parts {
+		ctr.parts[i] = spill.NewPartitions(proc, spill.PartitionNumber)
+	}
+	bat := ctr.bat
+	ctr.bat = nil
+	err := ctr.partition(bat, ap.Conditions[1], ctr.parts[1], proc)
+	bat.Clean(proc.Mp)
+	if err != nil {
+		return err
+	}
+	for _, idx := range []int{1, 0} {
+		for {
+			bat := <-proc.Reg.MergeReceivers[idx].Ch
+			if bat == nil {
+				break
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			err := ctr.partition(bat, ap.Conditions[idx], ctr.parts[idx], proc)
+			bat.Clean(proc.Mp)
+			if err != nil {
+				return err
+			}
+		}
+		if err := ctr.parts[idx].Flush(proc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// partition writes the rows of the batch into the partitions by the join keys,
+// the rows whose key contains NULL are dropped because they can never be joined.
+func (ctr *Container) partition(bat *batch.Batch, conds []joincondition.Condition, p *spill.Partitions, proc *process.Process) error {
+	for i, cond := range conds {
+		vec, err := colexec.EvalExpr(bat, proc, cond.Expr)
+		if err != nil || vec.ConstExpand(proc.Mp) == nil {
+			for j := 0; j < i; j++ {
+				if ctr.vecs[j].needFree {
+					vector.Clean(ctr.vecs[j].vec, proc.Mp)
+				}
+			}
+			return err
+		}
+		ctr.vecs[i].vec = vec
+		ctr.vecs[i].needFree = true
+		for j := range bat.Vecs {
+			if bat.Vecs[j] == vec {
+				ctr.vecs[i].needFree = false
+				break
+			}
+		}
+	}
+	defer func() {
+		for i := range ctr.vecs {
+			if ctr.vecs[i].needFree {
+				vector.Clean(ctr.vecs[i].vec, proc.Mp)
+			}
+		}
+	}()
+	sels := make([][]int64, p.Len())
+	count := len(bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		copy(ctr.zValues[:n], OneInt64s[:n])
+		ctr.fillKeys(conds, n, i)
+		for k := 0; k < n; k++ {
+			if ctr.zValues[k] != 0 {
+				idx := p.Index(ctr.keys[k])
+				sels[idx] = append(sels[idx], int64(i+k))
+			}
+			ctr.keys[k] = ctr.keys[k][:0]
+		}
+	}
+	return p.WriteSels(proc, bat, sels)
+}
+
+// nextPartition resets the hash table to join the next partition.
+func (ctr *Container) nextPartition() {
+	ctr.part++
+	ctr.reads = [2]int{}
+	ctr.bat = nil
+	ctr.rows = 0
+	ctr.sels = nil
+	ctr.strHashMap = &hashtable.StringHashMap{}
+	ctr.strHashMap.Init()
+}
+
+func (ctr *Container) freePartitions(proc *process.Process) error {
+	var err error
+
+	for i, p := range ctr.parts {
+		if p == nil {
+			continue
+		}
+		if e := p.Free(proc); e != nil && err == nil {
+			err = e
+		}
+		ctr.parts[i] = nil
+	}
+	return err
+}
+
+// fillKeys encodes the join keys of the rows [start, start+n) into ctr.keys,
+// ctr.zValues[k] is set to 0 if the key of the row contains NULL.
+func (ctr *Container) fillKeys(conds []joincondition.Condition, n int, start int) {
+	for j, cond := range conds {
+		vec := ctr.vecs[j].vec
+		switch typLen := vec.Typ.Oid.FixedLength(); typLen {
+		case 1:
+			fillGroupStr[uint8](ctr, vec, n, 1, start)
+		case 2:
+			fillGroupStr[uint16](ctr, vec, n, 2, start)
+		case 4:
+			fillGroupStr[uint32](ctr, vec, n, 4, start)
+		case 8:
+			fillGroupStr[uint64](ctr, vec, n, 8, start)
+		case -8:
+			if cond.Scale > 0 {
+				fillGroupStrWithDecimal64(ctr, vec, n, start, cond.Scale)
+			} else {
+				fillGroupStr[uint64](ctr, vec, n, 8, start)
+			}
+		case -16:
+			if cond.Scale > 0 {
+				fillGroupStrWithDecimal128(ctr, vec, n, start, cond.Scale)
+			} else {
+				fillGroupStr[types.Decimal128](ctr, vec, n, 16, start)
+			}
+		default:
+			vs := vec.Col.(*types.Bytes)
+			if !nulls.Any(vec.Nsp) {
+				for k := 0; k < n; k++ {
+					ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(start+k))...)
+				}
+			} else {
+				for k := 0; k < n; k++ {
+					if vec.Nsp.Np.Contains(uint64(start + k)) {
+						ctr.zValues[k] = 0
+					} else {
+						ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(start+k))...)
+					}
+				}
+			}
+		}
+	}
+	for k := 0; k < n; k++ {
+		if l := len(ctr.keys[k]); l < 16 {
+			ctr.keys[k] = append(ctr.keys[k], hashtable.StrKeyPadding[l:]...)
+		}
+	}
+}
+
 func fillGroupStr[T any](ctr *Container, vec *vector.Vector, n int, sz int, start int) {
 	vs := vector.GetFixedVectorValues[T](vec, int(sz))
 	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*sz)[:len(vs)*sz]
@@ -21,6 +21,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/joincondition"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
@@ -245,6 +246,42 @@ func TestJoin(t *testing.T) {
 	}
 }
 
+func TestJoinSpill(t *testing.T) {
+	fs, err := fileservice.NewMemoryFS()
+	require.NoError(t, err)
+	for _, tc := range tcs {
+		var cnts [2]int
+
+		for i := range cnts {
+			if i == 1 {
+				tc.proc.FileService = fs
+				tc.proc.Lim.Size = 1 // the right input is spilled at once
+			}
+			err := Prepare(tc.proc, tc.arg)
+			require.NoError(t, err)
+			tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+			tc.proc.Reg.MergeReceivers[0].Ch <- &batch.Batch{}
+			tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+			tc.proc.Reg.MergeReceivers[0].Ch <- nil
+			tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+			tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, Rows)
+			tc.proc.Reg.MergeReceivers[1].Ch <- nil
+			for {
+				ok, err := Call(0, tc.proc, tc.arg)
+				require.NoError(t, err)
+				if ok {
+					break
+				}
+				cnts[i] += len(tc.proc.Reg.InputBatch.Zs)
+				tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
+			}
+			require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+		}
+		require.Equal(t, cnts[0], cnts[1])
+		tc.proc.FileService = nil
+	}
+}
+
 func BenchmarkJoin(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
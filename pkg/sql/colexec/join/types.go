@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/joincondition"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 )
 
 const (
@@ -62,6 +63,12 @@ type Container struct {
 
 	decimal64Slice  []types.Decimal64
 	decimal128Slice []types.Decimal128
+
+	// parts are the partitions of the left and the right input spilled to the
+	// file service under memory pressure, they are joined partition by partition.
+	parts [2]*spill.Partitions
+	part  int    // index of the partition being joined
+	reads [2]int // number of batches read from the partitions being joined
 }
 
 type ResultPos struct {
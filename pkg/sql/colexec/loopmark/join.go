@@ -0,0 +1,211 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loopmark
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(_ interface{}, buf *bytes.Buffer) {
+	buf.WriteString(" mark join ")
+}
+
+func Prepare(proc *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	return nil
+}
+
+func Call(_ int, proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(ap, proc); err != nil {
+				ctr.state = End
+				return true, err
+			}
+			ctr.state = Probe
+		case Probe:
+			bat := <-proc.Reg.MergeReceivers[0].Ch
+			if bat == nil {
+				ctr.state = End
+				if ctr.bat != nil {
+					ctr.bat.Clean(proc.Mp)
+				}
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			if err := ctr.probe(bat, ap, proc); err != nil {
+				ctr.state = End
+				if ctr.bat != nil {
+					ctr.bat.Clean(proc.Mp)
+				}
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			return false, nil
+		default:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+func (ctr *Container) build(ap *Argument, proc *process.Process) error {
+	var err error
+
+	for {
+		bat := <-proc.Reg.MergeReceivers[1].Ch
+		if bat == nil {
+			break
+		}
+		if len(bat.Zs) == 0 {
+			continue
+		}
+		if ctr.bat == nil {
+			ctr.bat = batch.NewWithSize(len(bat.Vecs))
+			for i, vec := range bat.Vecs {
+				ctr.bat.Vecs[i] = vector.New(vec.Typ)
+			}
+		}
+		if ctr.bat, err = ctr.bat.Append(proc.Mp, bat); err != nil {
+			bat.Clean(proc.Mp)
+			ctr.bat.Clean(proc.Mp)
+			return err
+		}
+		bat.Clean(proc.Mp)
+	}
+	return nil
+}
+
+func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	defer bat.Clean(proc.Mp)
+	rbat := batch.NewWithSize(len(ap.Result))
+	for i, rp := range ap.Result {
+		if rp.Rel == 0 {
+			rbat.Vecs[i] = vector.New(bat.Vecs[rp.Pos].Typ)
+		} else {
+			rbat.Vecs[i] = vector.New(types.Type{Oid: types.T_bool, Size: 1})
+		}
+	}
+	count := len(bat.Zs)
+	for i := 0; i < count; i++ {
+		mark, isNull, err := ctr.mark(bat, i, ap, proc)
+		if err != nil {
+			rbat.Clean(proc.Mp)
+			return err
+		}
+		for k, rp := range ap.Result {
+			switch {
+			case rp.Rel == 0:
+				err = vector.UnionOne(rbat.Vecs[k], bat.Vecs[rp.Pos], int64(i), proc.Mp)
+			case isNull:
+				err = vector.UnionNull(rbat.Vecs[k], nil, proc.Mp)
+			default:
+				err = rbat.Vecs[k].Append(mark, proc.Mp)
+			}
+			if err != nil {
+				rbat.Clean(proc.Mp)
+				return err
+			}
+		}
+		rbat.Zs = append(rbat.Zs, bat.Zs[i])
+	}
+	rbat.ExpandNulls()
+	proc.Reg.InputBatch = rbat
+	return nil
+}
+
+// mark returns the mark of the i-th row of the left relation
+func (ctr *Container) mark(bat *batch.Batch, i int, ap *Argument, proc *process.Process) (bool, bool, error) {
+	if ctr.bat == nil {
+		return false, false, nil
+	}
+	conds, err := evalBools(bat, ctr.bat, i, proc, ap.Cond)
+	if err != nil {
+		return false, false, err
+	}
+	defer cleanBools(conds, ctr.bat, proc)
+	marks, err := evalBools(bat, ctr.bat, i, proc, ap.Mark)
+	if err != nil {
+		return false, false, err
+	}
+	defer cleanBools(marks, ctr.bat, proc)
+	hasNull := false
+	for j := range ctr.bat.Zs {
+		if ok, isNull := conds.get(j); !ok || isNull {
+			continue
+		}
+		ok, isNull := marks.get(j)
+		if isNull {
+			hasNull = true
+		} else if ok {
+			return true, false, nil
+		}
+	}
+	return false, hasNull, nil
+}
+
+// bools is the value of a condition for each row of the right relation, a
+// nil vector is always true
+type bools struct {
+	vec *vector.Vector
+	bs  []bool
+}
+
+func evalBools(bat, rbat *batch.Batch, i int, proc *process.Process, expr *plan.Expr) (bools, error) {
+	if expr == nil {
+		return bools{}, nil
+	}
+	vec, err := colexec.JoinFilterEvalExpr(bat, rbat, i, proc, expr)
+	if err != nil {
+		return bools{}, err
+	}
+	return bools{vec: vec, bs: vec.Col.([]bool)}, nil
+}
+
+func (b bools) get(j int) (bool, bool) {
+	if b.vec == nil {
+		return true, false
+	}
+	if len(b.bs) == 1 {
+		j = 0
+	}
+	return b.bs[j], nulls.Contains(b.vec.Nsp, uint64(j))
+}
+
+func cleanBools(b bools, rbat *batch.Batch, proc *process.Process) {
+	if b.vec == nil {
+		return
+	}
+	for _, vec := range rbat.Vecs {
+		if vec == b.vec {
+			return
+		}
+	}
+	vector.Clean(b.vec, proc.Mp)
+}
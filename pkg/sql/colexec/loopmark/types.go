@@ -0,0 +1,53 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loopmark
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+const (
+	Build = iota
+	Probe
+	End
+)
+
+const (
+	UnitLimit = 256
+)
+
+type Container struct {
+	state int
+	bat   *batch.Batch
+}
+
+// ResultPos is a column of the result, Rel -1 is the mark column
+type ResultPos struct {
+	Rel int32
+	Pos int32
+}
+
+// Argument is a nested loop mark join. Each row of the left relation is
+// returned with a mark, the value of a subquery predicate such as EXISTS or
+// IN. The mark is true if Mark is true for one of the right rows satisfying
+// Cond, null if Mark is null for one of them and false otherwise. A nil Cond
+// or Mark is always true.
+type Argument struct {
+	ctr    *Container
+	Cond   *plan.Expr
+	Mark   *plan.Expr
+	Result []ResultPos
+}
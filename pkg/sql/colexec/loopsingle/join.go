@@ -0,0 +1,212 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loopsingle
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(_ interface{}, buf *bytes.Buffer) {
+	buf.WriteString(" single join ")
+}
+
+func Prepare(proc *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	return nil
+}
+
+func Call(_ int, proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(ap, proc); err != nil {
+				ctr.state = End
+				return true, err
+			}
+			ctr.state = Probe
+		case Probe:
+			bat := <-proc.Reg.MergeReceivers[0].Ch
+			if bat == nil {
+				ctr.state = End
+				if ctr.bat != nil {
+					ctr.bat.Clean(proc.Mp)
+				}
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			if ctr.bat == nil {
+				if err := ctr.emptyProbe(bat, ap, proc); err != nil {
+					ctr.state = End
+					proc.Reg.InputBatch = nil
+					return true, err
+				}
+
+			} else {
+				if err := ctr.probe(bat, ap, proc); err != nil {
+					ctr.state = End
+					ctr.bat.Clean(proc.Mp)
+					proc.Reg.InputBatch = nil
+					return true, err
+				}
+			}
+			return false, nil
+		default:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+func (ctr *Container) build(ap *Argument, proc *process.Process) error {
+	var err error
+
+	for {
+		bat := <-proc.Reg.MergeReceivers[1].Ch
+		if bat == nil {
+			break
+		}
+		if len(bat.Zs) == 0 {
+			continue
+		}
+		if ctr.bat == nil {
+			ctr.bat = batch.NewWithSize(len(bat.Vecs))
+			for i, vec := range bat.Vecs {
+				ctr.bat.Vecs[i] = vector.New(vec.Typ)
+			}
+		}
+		if ctr.bat, err = ctr.bat.Append(proc.Mp, bat); err != nil {
+			bat.Clean(proc.Mp)
+			ctr.bat.Clean(proc.Mp)
+			return err
+		}
+		bat.Clean(proc.Mp)
+	}
+	return nil
+}
+
+func (ctr *Container) emptyProbe(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	defer bat.Clean(proc.Mp)
+	rbat := batch.NewWithSize(len(ap.Result))
+	for i, rp := range ap.Result {
+		if rp.Rel == 0 {
+			rbat.Vecs[i] = vector.New(bat.Vecs[rp.Pos].Typ)
+		} else {
+			rbat.Vecs[i] = vector.New(types.Type{Oid: types.T_int8, Size: 1})
+		}
+	}
+	count := len(bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		for k := 0; k < n; k++ {
+			for j, rp := range ap.Result {
+				if rp.Rel == 0 {
+					if err := vector.UnionOne(rbat.Vecs[j], bat.Vecs[rp.Pos], int64(i+k), proc.Mp); err != nil {
+						rbat.Clean(proc.Mp)
+						return err
+					}
+				} else {
+					if err := vector.UnionNull(rbat.Vecs[j], nil, proc.Mp); err != nil {
+						rbat.Clean(proc.Mp)
+						return err
+					}
+				}
+			}
+			rbat.Zs = append(rbat.Zs, bat.Zs[i+k])
+		}
+	}
+	rbat.ExpandNulls()
+	proc.Reg.InputBatch = rbat
+	return nil
+}
+
+func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	defer bat.Clean(proc.Mp)
+	rbat := batch.NewWithSize(len(ap.Result))
+	for i, rp := range ap.Result {
+		if rp.Rel == 0 {
+			rbat.Vecs[i] = vector.New(bat.Vecs[rp.Pos].Typ)
+		} else {
+			rbat.Vecs[i] = vector.New(ctr.bat.Vecs[rp.Pos].Typ)
+		}
+	}
+	count := len(bat.Zs)
+	for i := 0; i < count; i++ {
+		vec, err := colexec.JoinFilterEvalExpr(bat, ctr.bat, i, proc, ap.Cond)
+		if err != nil {
+			rbat.Clean(proc.Mp)
+			return err
+		}
+		sel, rows := int64(-1), int64(0)
+		bs := vec.Col.([]bool)
+		if len(bs) == 1 {
+			if bs[0] && !nulls.Contains(vec.Nsp, 0) {
+				sel = 0
+				for _, z := range ctr.bat.Zs {
+					rows += z
+				}
+			}
+		} else {
+			for j, b := range bs {
+				if b && !nulls.Contains(vec.Nsp, uint64(j)) {
+					sel = int64(j)
+					rows += ctr.bat.Zs[j]
+				}
+			}
+		}
+		vector.Clean(vec, proc.Mp)
+		if rows > 1 {
+			rbat.Clean(proc.Mp)
+			return moerr.NewSubqueryNoOneRow()
+		}
+		for k, rp := range ap.Result {
+			if rp.Rel == 0 {
+				if err := vector.UnionOne(rbat.Vecs[k], bat.Vecs[rp.Pos], int64(i), proc.Mp); err != nil {
+					rbat.Clean(proc.Mp)
+					return err
+				}
+			} else if sel < 0 {
+				if err := vector.UnionNull(rbat.Vecs[k], ctr.bat.Vecs[rp.Pos], proc.Mp); err != nil {
+					rbat.Clean(proc.Mp)
+					return err
+				}
+			} else {
+				if err := vector.UnionOne(rbat.Vecs[k], ctr.bat.Vecs[rp.Pos], sel, proc.Mp); err != nil {
+					rbat.Clean(proc.Mp)
+					return err
+				}
+			}
+		}
+		rbat.Zs = append(rbat.Zs, bat.Zs[i])
+	}
+	rbat.ExpandNulls()
+	proc.Reg.InputBatch = rbat
+	return nil
+}
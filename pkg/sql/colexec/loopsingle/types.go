@@ -0,0 +1,48 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loopsingle
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+const (
+	Build = iota
+	Probe
+	End
+)
+
+const (
+	UnitLimit = 256
+)
+
+type Container struct {
+	state int
+	bat   *batch.Batch
+}
+
+type ResultPos struct {
+	Rel int32
+	Pos int32
+}
+
+// Argument is a nested loop single join, a left join checking that each row
+// of the left relation matches at most one row of the right relation
+type Argument struct {
+	ctr    *Container
+	Cond   *plan.Expr
+	Result []ResultPos
+}
@@ -0,0 +1,498 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mark
+
+import (
+	"bytes"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	OneInt64s = make([]int64, UnitLimit)
+	for i := range OneInt64s {
+		OneInt64s[i] = 1
+	}
+}
+
+func String(_ interface{}, buf *bytes.Buffer) {
+	buf.WriteString(" mark join ")
+}
+
+func Prepare(proc *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	ap.ctr.keys = make([][]byte, UnitLimit)
+	ap.ctr.values = make([]uint64, UnitLimit)
+	ap.ctr.zValues = make([]int64, UnitLimit)
+	ap.ctr.strHashStates = make([][3]uint64, UnitLimit)
+	ap.ctr.strHashMap = &hashtable.StringHashMap{}
+	ap.ctr.strHashMap.Init()
+	ap.ctr.vecs = make([]evalVector, len(ap.Conditions[0]))
+	for i, cond := range ap.Conditions[0] { // aligning the precision of decimal
+		switch types.T(cond.Expr.Typ.Id) {
+		case types.T_decimal64:
+			typ := ap.Conditions[1][i].Expr.Typ
+			if typ.Scale > cond.Expr.Typ.Scale {
+				cond.Scale = typ.Scale - cond.Expr.Typ.Scale
+			} else if typ.Scale < cond.Expr.Typ.Scale {
+				ap.Conditions[1][i].Scale = cond.Expr.Typ.Scale - typ.Scale
+			}
+		case types.T_decimal128:
+			typ := ap.Conditions[1][i].Expr.Typ
+			if typ.Scale > cond.Expr.Typ.Scale {
+				cond.Scale = typ.Scale - cond.Expr.Typ.Scale
+			} else if typ.Scale < cond.Expr.Typ.Scale {
+				ap.Conditions[1][i].Scale = cond.Expr.Typ.Scale - typ.Scale
+			}
+		}
+	}
+	ap.ctr.decimal64Slice = make([]types.Decimal64, UnitLimit)
+	ap.ctr.decimal128Slice = make([]types.Decimal128, UnitLimit)
+	return nil
+}
+
+func Call(_ int, proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(ap, proc); err != nil {
+				ctr.state = End
+				return true, err
+			}
+			ctr.state = Probe
+		case Probe:
+			bat := <-proc.Reg.MergeReceivers[0].Ch
+			if bat == nil {
+				ctr.state = End
+				ctr.clean(proc)
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			if err := ctr.probe(bat, ap, proc); err != nil {
+				ctr.state = End
+				ctr.clean(proc)
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			return false, nil
+		default:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+func (ctr *Container) build(ap *Argument, proc *process.Process) error {
+	var err error
+
+	for {
+		bat := <-proc.Reg.MergeReceivers[1].Ch
+		if bat == nil {
+			break
+		}
+		if len(bat.Zs) == 0 {
+			continue
+		}
+		if ctr.bat == nil {
+			ctr.bat = batch.NewWithSize(len(bat.Vecs))
+			for i, vec := range bat.Vecs {
+				ctr.bat.Vecs[i] = vector.New(vec.Typ)
+			}
+		}
+		if ctr.bat, err = ctr.bat.Append(proc.Mp, bat); err != nil {
+			bat.Clean(proc.Mp)
+			ctr.bat.Clean(proc.Mp)
+			return err
+		}
+		bat.Clean(proc.Mp)
+	}
+	if ctr.bat == nil || len(ctr.bat.Zs) == 0 {
+		return nil
+	}
+	for i, cond := range ap.Conditions[1] {
+		vec, err := colexec.EvalExpr(ctr.bat, proc, cond.Expr)
+		if err != nil || vec.ConstExpand(proc.Mp) == nil {
+			for j := 0; j < i; j++ {
+				if ctr.vecs[j].needFree {
+					vector.Clean(ctr.vecs[j].vec, proc.Mp)
+				}
+			}
+			return err
+		}
+		ctr.vecs[i].vec = vec
+		ctr.vecs[i].needFree = true
+		for j := range ctr.bat.Vecs {
+			if ctr.bat.Vecs[j] == vec {
+				ctr.vecs[i].needFree = false
+				break
+			}
+		}
+	}
+	defer func() {
+		for i := range ctr.vecs {
+			if ctr.vecs[i].needFree {
+				vector.Clean(ctr.vecs[i].vec, proc.Mp)
+			}
+		}
+	}()
+	count := len(ctr.bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		copy(ctr.zValues[:n], OneInt64s[:n])
+		for j, cond := range ap.Conditions[1] {
+			vec := ctr.vecs[j].vec
+			switch typLen := vec.Typ.Oid.FixedLength(); typLen {
+			case 1:
+				fillGroupStr[uint8](ctr, vec, n, 1, i)
+			case 2:
+				fillGroupStr[uint16](ctr, vec, n, 2, i)
+			case 4:
+				fillGroupStr[uint32](ctr, vec, n, 4, i)
+			case 8:
+				fillGroupStr[uint64](ctr, vec, n, 8, i)
+			case -8:
+				if cond.Scale > 0 {
+					fillGroupStrWithDecimal64(ctr, vec, n, i, cond.Scale)
+				} else {
+					fillGroupStr[uint64](ctr, vec, n, 8, i)
+				}
+			case -16:
+				if cond.Scale > 0 {
+					fillGroupStrWithDecimal128(ctr, vec, n, i, cond.Scale)
+				} else {
+					fillGroupStr[types.Decimal128](ctr, vec, n, 16, i)
+				}
+			default:
+				vs := vec.Col.(*types.Bytes)
+				if !nulls.Any(vec.Nsp) {
+					for k := 0; k < n; k++ {
+						ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
+					}
+				} else {
+					for k := 0; k < n; k++ {
+						if vec.Nsp.Np.Contains(uint64(i + k)) {
+							ctr.zValues[k] = 0
+						} else {
+							ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(i+k))...)
+						}
+					}
+				}
+			}
+		}
+		for k := 0; k < n; k++ {
+			if l := len(ctr.keys[k]); l < 16 {
+				ctr.keys[k] = append(ctr.keys[k], hashtable.StrKeyPadding[l:]...)
+			}
+		}
+		ctr.strHashMap.InsertStringBatchWithRing(ctr.zValues, ctr.strHashStates, ctr.keys[:n], ctr.values)
+		for k, v := range ctr.values[:n] {
+			if ctr.zValues[k] == 0 {
+				continue
+			}
+			if v > ctr.rows {
+				ctr.rows++
+				ctr.sels = append(ctr.sels, make([]int64, 0, 1))
+			}
+			ai := int64(v) - 1
+			ctr.sels[ai] = append(ctr.sels[ai], int64(i+k))
+		}
+		for k := 0; k < n; k++ {
+			ctr.keys[k] = ctr.keys[k][:0]
+		}
+	}
+	return nil
+}
+
+func (ctr *Container) probe(bat *batch.Batch, ap *Argument, proc *process.Process) error {
+	defer bat.Clean(proc.Mp)
+	rbat := batch.NewWithSize(len(ap.Result))
+	for i, rp := range ap.Result {
+		if rp.Rel == 0 {
+			rbat.Vecs[i] = vector.New(bat.Vecs[rp.Pos].Typ)
+		} else {
+			rbat.Vecs[i] = vector.New(types.Type{Oid: types.T_bool, Size: 1})
+		}
+	}
+	if ctr.bat != nil {
+		for i, cond := range ap.Conditions[0] {
+			vec, err := colexec.EvalExpr(bat, proc, cond.Expr)
+			if err != nil || vec.ConstExpand(proc.Mp) == nil {
+				for j := 0; j < i; j++ {
+					if ctr.vecs[j].needFree {
+						vector.Clean(ctr.vecs[j].vec, proc.Mp)
+					}
+				}
+				rbat.Clean(proc.Mp)
+				return err
+			}
+			ctr.vecs[i].vec = vec
+			ctr.vecs[i].needFree = true
+			for j := range bat.Vecs {
+				if bat.Vecs[j] == vec {
+					ctr.vecs[i].needFree = false
+					break
+				}
+			}
+		}
+		defer func() {
+			for i := range ctr.vecs {
+				if ctr.vecs[i].needFree {
+					vector.Clean(ctr.vecs[i].vec, proc.Mp)
+				}
+			}
+		}()
+	}
+	count := len(bat.Zs)
+	for i := 0; i < count; i += UnitLimit {
+		n := count - i
+		if n > UnitLimit {
+			n = UnitLimit
+		}
+		if ctr.bat != nil {
+			ctr.findKeys(ap, n, i)
+		}
+		for k := 0; k < n; k++ {
+			var mark, isNull bool
+			if ctr.bat != nil && ctr.zValues[k] != 0 && ctr.values[k] != 0 {
+				var err error
+				if mark, isNull, err = ctr.mark(bat, i+k, ctr.values[k]-1, ap, proc); err != nil {
+					rbat.Clean(proc.Mp)
+					return err
+				}
+			}
+			for j, rp := range ap.Result {
+				var err error
+				switch {
+				case rp.Rel == 0:
+					err = vector.UnionOne(rbat.Vecs[j], bat.Vecs[rp.Pos], int64(i+k), proc.Mp)
+				case isNull:
+					err = vector.UnionNull(rbat.Vecs[j], nil, proc.Mp)
+				default:
+					err = rbat.Vecs[j].Append(mark, proc.Mp)
+				}
+				if err != nil {
+					rbat.Clean(proc.Mp)
+					return err
+				}
+			}
+			rbat.Zs = append(rbat.Zs, bat.Zs[i+k])
+		}
+	}
+	rbat.ExpandNulls()
+	proc.Reg.InputBatch = rbat
+	return nil
+}
+
+// findKeys looks up the keys of the rows [start, start+n) of the left
+// relation, a null key is never found
+func (ctr *Container) findKeys(ap *Argument, n int, start int) {
+	copy(ctr.zValues[:n], OneInt64s[:n])
+	for j, cond := range ap.Conditions[0] {
+		vec := ctr.vecs[j].vec
+		switch typLen := vec.Typ.Oid.FixedLength(); typLen {
+		case 1:
+			fillGroupStr[uint8](ctr, vec, n, 1, start)
+		case 2:
+			fillGroupStr[uint16](ctr, vec, n, 2, start)
+		case 4:
+			fillGroupStr[uint32](ctr, vec, n, 4, start)
+		case 8:
+			fillGroupStr[uint64](ctr, vec, n, 8, start)
+		case -8:
+			if cond.Scale > 0 {
+				fillGroupStrWithDecimal64(ctr, vec, n, start, cond.Scale)
+			} else {
+				fillGroupStr[uint64](ctr, vec, n, 8, start)
+			}
+		case -16:
+			if cond.Scale > 0 {
+				fillGroupStrWithDecimal128(ctr, vec, n, start, cond.Scale)
+			} else {
+				fillGroupStr[types.Decimal128](ctr, vec, n, 16, start)
+			}
+		default:
+			vs := vec.Col.(*types.Bytes)
+			if !nulls.Any(vec.Nsp) {
+				for k := 0; k < n; k++ {
+					ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(start+k))...)
+				}
+			} else {
+				for k := 0; k < n; k++ {
+					if vec.Nsp.Np.Contains(uint64(start + k)) {
+						ctr.zValues[k] = 0
+					} else {
+						ctr.keys[k] = append(ctr.keys[k], vs.Get(int64(start+k))...)
+					}
+				}
+			}
+		}
+	}
+	for k := 0; k < n; k++ {
+		if l := len(ctr.keys[k]); l < 16 {
+			ctr.keys[k] = append(ctr.keys[k], hashtable.StrKeyPadding[l:]...)
+		}
+	}
+	ctr.strHashMap.FindStringBatch(ctr.strHashStates, ctr.keys[:n], ctr.values)
+	for k := 0; k < n; k++ {
+		ctr.keys[k] = ctr.keys[k][:0]
+	}
+}
+
+// mark returns the mark of the i-th row of the left relation, whose key is
+// the g-th key of the right relation
+func (ctr *Container) mark(bat *batch.Batch, i int, g uint64, ap *Argument, proc *process.Process) (bool, bool, error) {
+	if ap.Mark == nil {
+		return true, false, nil
+	}
+	gbat, err := ctr.group(g, proc)
+	if err != nil {
+		return false, false, err
+	}
+	vec, err := colexec.JoinFilterEvalExpr(bat, gbat, i, proc, ap.Mark)
+	if err != nil {
+		return false, false, err
+	}
+	defer cleanVector(vec, gbat, proc)
+	bs := vec.Col.([]bool)
+	hasNull := false
+	for j := range gbat.Zs {
+		if len(bs) == 1 {
+			j = 0
+		}
+		if nulls.Contains(vec.Nsp, uint64(j)) {
+			hasNull = true
+		} else if bs[j] {
+			return true, false, nil
+		}
+	}
+	return false, hasNull, nil
+}
+
+// group returns the right rows of the g-th key
+func (ctr *Container) group(g uint64, proc *process.Process) (*batch.Batch, error) {
+	if ctr.groups == nil {
+		ctr.groups = make([]*batch.Batch, len(ctr.sels))
+	}
+	if ctr.groups[g] != nil {
+		return ctr.groups[g], nil
+	}
+	sels := ctr.sels[g]
+	gbat := batch.NewWithSize(len(ctr.bat.Vecs))
+	for j, vec := range ctr.bat.Vecs {
+		gbat.Vecs[j] = vector.New(vec.Typ)
+		if err := vector.Union(gbat.Vecs[j], vec, sels, proc.Mp); err != nil {
+			gbat.Clean(proc.Mp)
+			return nil, err
+		}
+	}
+	gbat.Zs = make([]int64, len(sels))
+	for j, sel := range sels {
+		gbat.Zs[j] = ctr.bat.Zs[sel]
+	}
+	ctr.groups[g] = gbat
+	return gbat, nil
+}
+
+func (ctr *Container) clean(proc *process.Process) {
+	if ctr.bat != nil {
+		ctr.bat.Clean(proc.Mp)
+	}
+	for _, gbat := range ctr.groups {
+		if gbat != nil {
+			gbat.Clean(proc.Mp)
+		}
+	}
+}
+
+func cleanVector(vec *vector.Vector, gbat *batch.Batch, proc *process.Process) {
+	for _, v := range gbat.Vecs {
+		if v == vec {
+			return
+		}
+	}
+	vector.Clean(vec, proc.Mp)
+}
+
+func fillGroupStr[T any](ctr *Container, vec *vector.Vector, n int, sz int, start int) {
+	vs := vector.GetFixedVectorValues[T](vec, int(sz))
+	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*sz)[:len(vs)*sz]
+	if !nulls.Any(vec.Nsp) {
+		for i := 0; i < n; i++ {
+			ctr.keys[i] = append(ctr.keys[i], data[(i+start)*sz:(i+start+1)*sz]...)
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			if vec.Nsp.Np.Contains(uint64(i + start)) {
+				ctr.zValues[i] = 0
+			} else {
+				ctr.keys[i] = append(ctr.keys[i], data[(i+start)*sz:(i+start+1)*sz]...)
+			}
+		}
+	}
+}
+
+func fillGroupStrWithDecimal64(ctr *Container, vec *vector.Vector, n int, start int, scale int32) {
+	src := vector.GetFixedVectorValues[types.Decimal64](vec, 8)
+	vs := types.AlignDecimal64UsingScaleDiffBatch(src[start:start+n], ctr.decimal64Slice[:n], scale)
+	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*8)[:len(vs)*8]
+	if !nulls.Any(vec.Nsp) {
+		for i := 0; i < n; i++ {
+			ctr.keys[i] = append(ctr.keys[i], data[(i)*8:(i+1)*8]...)
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			if vec.Nsp.Np.Contains(uint64(i + start)) {
+				ctr.zValues[i] = 0
+			} else {
+				ctr.keys[i] = append(ctr.keys[i], data[(i)*8:(i+1)*8]...)
+			}
+		}
+	}
+}
+
+func fillGroupStrWithDecimal128(ctr *Container, vec *vector.Vector, n int, start int, scale int32) {
+	src := vector.GetFixedVectorValues[types.Decimal128](vec, 16)
+	vs := ctr.decimal128Slice[:n]
+	types.AlignDecimal128UsingScaleDiffBatch(src[start:start+n], vs, scale)
+	data := unsafe.Slice((*byte)(unsafe.Pointer(&vs[0])), cap(vs)*16)[:len(vs)*16]
+	if !nulls.Any(vec.Nsp) {
+		for i := 0; i < n; i++ {
+			ctr.keys[i] = append(ctr.keys[i], data[(i)*16:(i+1)*16]...)
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			if vec.Nsp.Np.Contains(uint64(i + start)) {
+				ctr.zValues[i] = 0
+			} else {
+				ctr.keys[i] = append(ctr.keys[i], data[(i)*16:(i+1)*16]...)
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mark
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/joincondition"
+)
+
+const (
+	Build = iota
+	Probe
+	End
+)
+
+const (
+	UnitLimit = 256
+)
+
+var OneInt64s []int64
+
+type evalVector struct {
+	needFree bool
+	vec      *vector.Vector
+}
+
+type Container struct {
+	state         int
+	rows          uint64
+	keys          [][]byte
+	values        []uint64
+	zValues       []int64
+	strHashStates [][3]uint64
+	strHashMap    *hashtable.StringHashMap
+
+	sels   [][]int64      // rows of the right relation of each key
+	groups []*batch.Batch // right rows of each key, copied on the first probe of the key
+
+	bat *batch.Batch
+
+	vecs []evalVector
+
+	decimal64Slice  []types.Decimal64
+	decimal128Slice []types.Decimal128
+}
+
+// ResultPos is a column of the result, Rel -1 is the mark column
+type ResultPos struct {
+	Rel int32
+	Pos int32
+}
+
+// Argument is a hash mark join. Each row of the left relation is returned
+// with a mark, the value of a subquery predicate such as EXISTS or IN. Only
+// the right rows whose Conditions are equal to the left row are considered,
+// the mark is true if Mark is true for one of them, null if Mark is null for
+// one of them and false otherwise. A nil Mark is always true.
+type Argument struct {
+	ctr        *Container
+	Result     []ResultPos
+	Conditions [][]joincondition.Condition
+	Mark       *plan.Expr
+}
@@ -82,8 +82,22 @@ func (ctr *Container) build(proc *process.Process) error {
 			if len(bat.Zs) == 0 {
 				continue
 			}
-			ctr.bat = bat
-			return nil
+			if ctr.bat == nil {
+				ctr.bat = bat
+				continue
+			}
+			// the group returns more than one batch if it spilled under memory pressure
+			if ctr.intHashMap == nil {
+				first := ctr.bat
+				ctr.bat = nil
+				if err := ctr.process(first, proc); err != nil {
+					bat.Clean(proc.Mp)
+					return err
+				}
+			}
+			if err := ctr.process(bat, proc); err != nil {
+				return err
+			}
 		}
 	}
 	for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
@@ -98,6 +112,7 @@ func (ctr *Container) build(proc *process.Process) error {
 		if err := ctr.process(bat, proc); err != nil {
 			return err
 		}
+		i-- // reads the receiver until the end
 	}
 	return nil
 }
@@ -111,15 +126,16 @@ func (ctr *Container) process(bat *batch.Batch, proc *process.Process) error {
 			switch vec.Typ.Oid {
 			case types.T_int8, types.T_uint8, types.T_bool:
 				size += 1 + 1
-			case types.T_int16, types.T_uint16:
+			case types.T_int16, types.T_uint16, types.T_enum:
 				size += 2 + 1
 			case types.T_int32, types.T_uint32, types.T_float32, types.T_date:
 				size += 4 + 1
-			case types.T_int64, types.T_uint64, types.T_float64, types.T_datetime, types.T_decimal64:
+			case types.T_int64, types.T_uint64, types.T_set, types.T_bit, types.T_float64, types.T_datetime, types.T_decimal64:
 				size += 8 + 1
-			case types.T_decimal128:
+			case types.T_decimal128, types.T_uuid:
 				size += 16 + 1
-			case types.T_char, types.T_varchar:
+			case types.T_char, types.T_varchar, types.T_text,
+				types.T_binary, types.T_varbinary, types.T_blob:
 				if width := vec.Typ.Width; width > 0 {
 					size += int(width) + 1
 				} else {
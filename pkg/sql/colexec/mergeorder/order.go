@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -56,18 +57,49 @@ func Call(_ int, proc *process.Process, arg interface{}) (bool, error) {
 			}
 			ctr.state = Eval
 		case Eval:
-			if ctr.bat != nil {
-				for i := ctr.n; i < len(ctr.bat.Vecs); i++ {
-					vector.Clean(ctr.bat.Vecs[i], proc.Mp)
+			if len(ctr.runs) > 0 {
+				if err := ctr.mergeRuns(proc); err != nil {
+					ctr.state = End
+					return true, err
 				}
-				ctr.bat.Vecs = ctr.bat.Vecs[:ctr.n]
-				ctr.bat.ExpandNulls()
+				ctr.state = Output
+				continue
+			}
+			if ctr.bat == nil {
+				ctr.state = End
+				continue
 			}
+			for i := ctr.n; i < len(ctr.bat.Vecs); i++ {
+				vector.Clean(ctr.bat.Vecs[i], proc.Mp)
+			}
+			ctr.bat.Vecs = ctr.bat.Vecs[:ctr.n]
+			ctr.bat.ExpandNulls()
+			// the end is returned by the next call as for the runs, so the
+			// next operator knows that no rows follow the batch
 			proc.Reg.InputBatch = ctr.bat
 			ctr.bat = nil
 			ctr.state = End
-			return true, nil
+			return false, nil
+		case Output:
+			if ctr.idx == ctr.runs[0].Count() {
+				ctr.state = End
+				continue
+			}
+			bat, err := ctr.runs[0].Read(proc, ctr.idx)
+			if err != nil {
+				ctr.state = End
+				return true, err
+			}
+			ctr.idx++
+			for i := ctr.n; i < len(bat.Vecs); i++ {
+				vector.Clean(bat.Vecs[i], proc.Mp)
+			}
+			bat.Vecs = bat.Vecs[:ctr.n]
+			bat.ExpandNulls()
+			proc.Reg.InputBatch = bat
+			return false, nil
 		default:
+			ctr.freeRuns()
 			proc.Reg.InputBatch = nil
 			return true, nil
 		}
@@ -134,6 +166,11 @@ func (ctr *Container) build(ap *Argument, proc *process.Process) error {
 				}
 				bat.Clean(proc.Mp)
 			}
+			if spill.Need(proc, int64(ctr.bat.Size())) {
+				if err := ctr.spill(proc); err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
@@ -213,6 +250,165 @@ func (ctr *Container) processBatch(bat2 *batch.Batch, proc *process.Process) err
 	return nil
 }
 
+// spill writes the rows merged as a sorted run to the file service.
+func (ctr *Container) spill(proc *process.Process) error {
+	r := spill.NewRun(proc)
+	ctr.runs = append(ctr.runs, r)
+	err := r.WriteBatch(proc, ctr.bat)
+	ctr.bat.Clean(proc.Mp)
+	ctr.bat = nil
+	return err
+}
+
+// mergeRuns merges all the sorted runs into one run two by two.
+func (ctr *Container) mergeRuns(proc *process.Process) error {
+	if ctr.bat != nil {
+		if err := ctr.spill(proc); err != nil {
+			return err
+		}
+	}
+	for len(ctr.runs) > 1 {
+		r, err := ctr.mergeTwoRuns(ctr.runs[0], ctr.runs[1], proc)
+		if err != nil {
+			return err
+		}
+		if err := ctr.runs[0].Free(); err != nil {
+			r.Free()
+			return err
+		}
+		if err := ctr.runs[1].Free(); err != nil {
+			r.Free()
+			return err
+		}
+		ctr.runs = append(ctr.runs[2:], r)
+	}
+	return nil
+}
+
+// runReader reads the batches of a run one by one.
+type runReader struct {
+	r   *spill.Run
+	idx int          // index of the next batch of the run
+	i   int64        // index of the current row of bat
+	bat *batch.Batch // current batch, nil if all the batches have been read
+}
+
+func (rd *runReader) next(proc *process.Process) error {
+	if rd.bat != nil {
+		rd.bat.Clean(proc.Mp)
+		rd.bat = nil
+	}
+	rd.i = 0
+	if rd.idx == rd.r.Count() {
+		return nil
+	}
+	bat, err := rd.r.Read(proc, rd.idx)
+	if err != nil {
+		return err
+	}
+	rd.idx++
+	rd.bat = bat
+	return nil
+}
+
+// mergeTwoRuns merges two sorted runs into a new sorted run, only one batch
+// of each run is kept in memory.
+func (ctr *Container) mergeTwoRuns(r1, r2 *spill.Run, proc *process.Process) (*spill.Run, error) {
+	var rbat *batch.Batch
+
+	out := spill.NewRun(proc)
+	rds := []*runReader{{r: r1}, {r: r2}}
+	defer func() {
+		for _, rd := range rds {
+			if rd.bat != nil {
+				rd.bat.Clean(proc.Mp)
+			}
+		}
+		if rbat != nil {
+			rbat.Clean(proc.Mp)
+		}
+	}()
+	for k, rd := range rds {
+		if err := rd.next(proc); err != nil {
+			out.Free()
+			return nil, err
+		}
+		if rd.bat != nil {
+			for i, cmp := range ctr.cmps {
+				cmp.Set(k, rd.bat.GetVector(int32(i)))
+			}
+		}
+	}
+	for rds[0].bat != nil || rds[1].bat != nil {
+		if rbat == nil {
+			bat := rds[0].bat
+			if bat == nil {
+				bat = rds[1].bat
+			}
+			rbat = batch.NewWithSize(len(bat.Vecs))
+			for i, vec := range bat.Vecs {
+				rbat.Vecs[i] = vector.New(vec.Typ)
+			}
+		}
+		k := 0
+		switch {
+		case rds[0].bat == nil:
+			k = 1
+		case rds[1].bat != nil:
+			for _, pos := range ctr.poses {
+				if r := ctr.cmps[pos].Compare(0, 1, rds[0].i, rds[1].i); r != 0 {
+					if r > 0 {
+						k = 1
+					}
+					break
+				}
+			}
+		}
+		rd := rds[k]
+		for i := range rbat.Vecs {
+			if err := vector.UnionOne(rbat.Vecs[i], rd.bat.Vecs[i], rd.i, proc.Mp); err != nil {
+				out.Free()
+				return nil, err
+			}
+		}
+		rbat.Zs = append(rbat.Zs, rd.bat.Zs[rd.i])
+		if rd.i++; rd.i == int64(len(rd.bat.Zs)) {
+			if err := rd.next(proc); err != nil {
+				out.Free()
+				return nil, err
+			}
+			if rd.bat != nil {
+				for i, cmp := range ctr.cmps {
+					cmp.Set(k, rd.bat.GetVector(int32(i)))
+				}
+			}
+		}
+		if len(rbat.Zs) >= spill.UnitRows {
+			err := out.Write(proc, rbat)
+			rbat.Clean(proc.Mp)
+			rbat = nil
+			if err != nil {
+				out.Free()
+				return nil, err
+			}
+		}
+	}
+	if rbat != nil {
+		if err := out.Write(proc, rbat); err != nil {
+			out.Free()
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func (ctr *Container) freeRuns() {
+	for _, r := range ctr.runs {
+		r.Free()
+	}
+	ctr.runs = nil
+}
+
 func makeFlagsOne(n int) []uint8 {
 	t := make([]uint8, n)
 	for i := range t {
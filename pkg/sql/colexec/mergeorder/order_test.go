@@ -21,8 +21,11 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
@@ -86,10 +89,11 @@ func TestOrder(t *testing.T) {
 		tc.proc.Reg.MergeReceivers[1].Ch <- &batch.Batch{}
 		tc.proc.Reg.MergeReceivers[1].Ch <- nil
 		for {
-			if ok, err := Call(0, tc.proc, tc.arg); ok || err != nil {
-				if tc.proc.Reg.InputBatch != nil {
-					tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
-				}
+			ok, err := Call(0, tc.proc, tc.arg)
+			if tc.proc.Reg.InputBatch != nil {
+				tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
+			}
+			if ok || err != nil {
 				break
 			}
 		}
@@ -105,6 +109,50 @@ func TestOrder(t *testing.T) {
 	}
 }
 
+func TestOrderSpill(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	tc := newTestCase(mheap.New(gm), []bool{false}, []types.Type{{Oid: types.T_int64}}, []order.Field{{E: newExpression(0), Type: 0}})
+	fs, err := fileservice.NewMemoryFS()
+	require.NoError(t, err)
+	tc.proc.FileService = fs
+	tc.proc.Lim.Size = 1 // every merged batch is spilled
+	err = Prepare(tc.proc, tc.arg)
+	require.NoError(t, err)
+	n := 0
+	for i := 0; i < len(tc.proc.Reg.MergeReceivers); i++ {
+		for j := 0; j < 2; j++ {
+			vs := make([]int64, 3*spill.UnitRows)
+			for k := range vs {
+				vs[k] = int64(k*4 + j*2 + i)
+			}
+			bat := batch.NewWithSize(1)
+			bat.Vecs[0] = testutil.NewInt64Vector(len(vs), types.Type{Oid: types.T_int64}, tc.proc.Mp, false, vs)
+			bat.InitZsOne(len(vs))
+			tc.proc.Reg.MergeReceivers[i].Ch <- bat
+			n += len(vs)
+		}
+		tc.proc.Reg.MergeReceivers[i].Ch <- nil
+	}
+	var rs []int64
+	for {
+		ok, err := Call(0, tc.proc, tc.arg)
+		require.NoError(t, err)
+		if bat := tc.proc.Reg.InputBatch; bat != nil {
+			rs = append(rs, vector.MustTCols[int64](bat.Vecs[0])...)
+			bat.Clean(tc.proc.Mp)
+		}
+		if ok {
+			break
+		}
+	}
+	require.Equal(t, n, len(rs))
+	for i := 1; i < len(rs); i++ {
+		require.LessOrEqual(t, rs[i-1], rs[i])
+	}
+	require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+}
+
 func BenchmarkOrder(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
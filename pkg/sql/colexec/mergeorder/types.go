@@ -18,11 +18,13 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
 )
 
 const (
 	Build = iota
 	Eval
+	Output
 	End
 )
 
@@ -33,6 +35,11 @@ type Container struct {
 	cmps  []compare.Compare // compare structures used to do sort work for attrs
 
 	bat *batch.Batch // bat store the result of merge-order
+
+	// runs are the sorted runs spilled to the file service under memory pressure,
+	// they are merged into one run which is returned batch by batch.
+	runs []*spill.Run
+	idx  int // index of the next batch of the run to be returned
 }
 
 type Argument struct {
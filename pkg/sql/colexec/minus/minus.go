@@ -0,0 +1,183 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package minus
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	if ap.All {
+		buf.WriteString("minus all")
+	} else {
+		buf.WriteString("minus")
+	}
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	ap.ctr.mp = hashmap.NewStrMap(true)
+	return nil
+}
+
+func Call(idx int, proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(proc, anal); err != nil {
+				ctr.state = End
+				return true, err
+			}
+			ctr.rows = ctr.mp.GroupCount()
+			ctr.state = Probe
+		case Probe:
+			bat := <-proc.Reg.MergeReceivers[0].Ch
+			if bat == nil {
+				ctr.state = End
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			anal.Input(bat)
+			if err := ctr.probe(ap, bat, proc); err != nil {
+				bat.Clean(proc.Mp)
+				ctr.state = End
+				return true, err
+			}
+			if len(bat.Zs) == 0 {
+				bat.Clean(proc.Mp)
+				continue
+			}
+			anal.Output(bat)
+			proc.SetInputBatch(bat)
+			return false, nil
+		default:
+			proc.SetInputBatch(nil)
+			return true, nil
+		}
+	}
+}
+
+// build inserts the rows of the right relation into the hash table.
+func (ctr *container) build(proc *process.Process, anal process.Analyze) error {
+	for {
+		bat := <-proc.Reg.MergeReceivers[1].Ch
+		if bat == nil {
+			return nil
+		}
+		if len(bat.Zs) == 0 {
+			continue
+		}
+		anal.Input(bat)
+		err := ctr.insert(bat, proc)
+		bat.Clean(proc.Mp)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (ctr *container) insert(bat *batch.Batch, proc *process.Process) error {
+	for _, vec := range bat.Vecs {
+		if vec.ConstExpand(proc.Mp) == nil {
+			return errors.New("", "out of memory")
+		}
+	}
+	count := len(bat.Zs)
+	scales := make([]int32, len(bat.Vecs))
+	itr := ctr.mp.NewIterator()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		rows := ctr.mp.GroupCount()
+		vals, _ := itr.Insert(i, n, bat.Vecs, scales)
+		for k, v := range vals {
+			if v > rows {
+				rows++
+				ctr.mp.AddGroup()
+				ctr.cnts = append(ctr.cnts, 0)
+			}
+			ctr.cnts[v-1] += bat.Zs[i+k]
+		}
+	}
+	return nil
+}
+
+// probe removes the rows of the left relation which are subtracted by the
+// rows of the right relation, and the duplicate rows for EXCEPT.
+func (ctr *container) probe(ap *Argument, bat *batch.Batch, proc *process.Process) error {
+	for _, vec := range bat.Vecs {
+		if vec.ConstExpand(proc.Mp) == nil {
+			return errors.New("", "out of memory")
+		}
+	}
+	sels := proc.GetSels()
+	count := len(bat.Zs)
+	scales := make([]int32, len(bat.Vecs))
+	itr := ctr.mp.NewIterator()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		rows := ctr.mp.GroupCount()
+		vals, _ := itr.Insert(i, n, bat.Vecs, scales)
+		for k, v := range vals {
+			switch {
+			case v <= ctr.rows: // the row appears in the right relation
+				if !ap.All {
+					continue
+				}
+				if bat.Zs[i+k] <= ctr.cnts[v-1] {
+					ctr.cnts[v-1] -= bat.Zs[i+k]
+					continue
+				}
+				bat.Zs[i+k] -= ctr.cnts[v-1]
+				ctr.cnts[v-1] = 0
+			case v > rows: // the row is produced for the first time
+				rows++
+				ctr.mp.AddGroup()
+				if !ap.All {
+					bat.Zs[i+k] = 1
+				}
+			default:
+				if !ap.All {
+					continue
+				}
+			}
+			sels = append(sels, int64(i+k))
+		}
+	}
+	if len(sels) < count {
+		bat.Shrink(sels)
+	}
+	proc.PutSels(sels)
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package minus
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+)
+
+const (
+	Build = iota
+	Probe
+	End
+)
+
+type container struct {
+	state int
+	// mp records the distinct rows of the right relation, and then the
+	// distinct rows of the left relation which have been produced
+	mp *hashmap.StrHashMap
+	// rows is the number of the distinct rows of the right relation
+	rows uint64
+	// cnts is the number of the rows of each group of the right relation
+	// which have not been subtracted yet
+	cnts []int64
+}
+
+// Argument computes EXCEPT [ALL], the rows of the right relation are
+// received from the second merge receiver and built into a hash table,
+// and then the rows of the left relation from the first merge receiver
+// are probed. NULLs are regarded as equal.
+type Argument struct {
+	// All is true for EXCEPT ALL, a row appears max(m - n, 0) times in
+	// the result if it appears m times in the left relation and n times
+	// in the right relation.
+	All bool
+	ctr *container
+}
@@ -0,0 +1,181 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"hash/maphash"
+	"reflect"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// NewPartitions creates n empty partitions whose rows are written to the file service of the process.
+func NewPartitions(proc *process.Process, n int) *Partitions {
+	p := &Partitions{
+		runs: make([]*Run, n),
+		bats: make([]*batch.Batch, n),
+		sels: make([][]int64, n),
+	}
+	for i := range p.runs {
+		p.runs[i] = NewRun(proc)
+	}
+	return p
+}
+
+// Len returns the number of partitions.
+func (p *Partitions) Len() int {
+	return len(p.runs)
+}
+
+// Run returns the run of the i-th partition, the partitions must be flushed first.
+func (p *Partitions) Run(i int) *Run {
+	return p.runs[i]
+}
+
+// Write distributes the rows of the batch into the partitions by the hash values
+// of the keys, the keys are the vectors evaluated from the batch.
+func (p *Partitions) Write(proc *process.Process, bat *batch.Batch, keys []*vector.Vector) error {
+	for i := range p.sels {
+		p.sels[i] = p.sels[i][:0]
+	}
+	bat.ExpandNulls()
+	datas := make([][]byte, len(keys))
+	for i, vec := range keys {
+		vec.TryExpandNulls(len(bat.Zs))
+		datas[i] = fixedData(vec)
+	}
+	for i := range bat.Zs {
+		idx := p.hash(keys, datas, i) % uint64(len(p.runs))
+		p.sels[idx] = append(p.sels[idx], int64(i))
+	}
+	return p.WriteSels(proc, bat, p.sels)
+}
+
+// Index returns the partition of an encoded key, it is used by the operators
+// which have their own encoding of the keys.
+func (p *Partitions) Index(key []byte) int {
+	return int(maphash.Bytes(seed, key) % uint64(len(p.runs)))
+}
+
+// WriteSels writes the rows sels[i] of the batch into the i-th partition.
+func (p *Partitions) WriteSels(proc *process.Process, bat *batch.Batch, sels [][]int64) error {
+	bat.ExpandNulls()
+	for i := range sels {
+		if len(sels[i]) == 0 {
+			continue
+		}
+		rbat, err := copyBatch(bat, sels[i], proc)
+		if err != nil {
+			return err
+		}
+		if p.bats[i] == nil {
+			p.bats[i] = rbat
+		} else {
+			p.bats[i], err = p.bats[i].Append(proc.Mp, rbat)
+			rbat.Clean(proc.Mp)
+			if err != nil {
+				return err
+			}
+		}
+		if len(p.bats[i].Zs) >= UnitRows {
+			if err := p.flush(proc, i); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Flush writes the rows buffered to the runs.
+func (p *Partitions) Flush(proc *process.Process) error {
+	for i := range p.bats {
+		if err := p.flush(proc, i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Free releases the rows buffered and deletes the files of all the partitions.
+func (p *Partitions) Free(proc *process.Process) error {
+	var err error
+
+	for i := range p.runs {
+		if p.bats[i] != nil {
+			p.bats[i].Clean(proc.Mp)
+			p.bats[i] = nil
+		}
+		if e := p.runs[i].Free(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+func (p *Partitions) flush(proc *process.Process, i int) error {
+	if p.bats[i] == nil {
+		return nil
+	}
+	err := p.runs[i].WriteBatch(proc, p.bats[i])
+	p.bats[i].Clean(proc.Mp)
+	p.bats[i] = nil
+	return err
+}
+
+// hash returns the hash value of the keys of the i-th row, the NULLs are
+// hashed as a flag so that the equal keys are always in the same partition.
+func (p *Partitions) hash(keys []*vector.Vector, datas [][]byte, i int) uint64 {
+	p.key = p.key[:0]
+	for j, vec := range keys {
+		if vec.IsScalarNull() || nulls.Contains(vec.Nsp, uint64(i)) {
+			p.key = append(p.key, 1)
+			continue
+		}
+		p.key = append(p.key, 0)
+		row := i
+		if vec.IsConst {
+			row = 0
+		}
+		if datas[j] == nil {
+			p.key = append(p.key, vec.Col.(*types.Bytes).Get(int64(row))...)
+			continue
+		}
+		size := vec.Typ.TypeSize()
+		p.key = append(p.key, datas[j][row*size:(row+1)*size]...)
+	}
+	return maphash.Bytes(seed, p.key)
+}
+
+// fixedData returns the content of a vector of fixed length type, and nil for the others.
+func fixedData(vec *vector.Vector) []byte {
+	switch vec.Typ.Oid {
+	case types.T_char, types.T_varchar, types.T_json, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
+		return nil
+	}
+	if vec.IsScalarNull() {
+		return nil
+	}
+	if vec.IsConst {
+		addr := reflect.ValueOf(vec.Col).Index(0).Addr().UnsafePointer()
+		return unsafe.Slice((*byte)(addr), vec.Typ.TypeSize())
+	}
+	return vec.Data
+}
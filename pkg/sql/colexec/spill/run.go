@@ -0,0 +1,246 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Need returns true if the memory held by an operator should be spilled to the
+// file service, that is the memory exceeds the memory threshold of the operator or
+// the memory of the query is nearly exhausted.
+func Need(proc *process.Process, size int64) bool {
+	if proc.FileService == nil {
+		return false
+	}
+	if proc.OperatorOutofMemory(size) {
+		return true
+	}
+	gm := proc.Mp.Gm
+	return gm.Size() > gm.Limit/4*3
+}
+
+// NewRun creates an empty run whose batches are written to the file service of the process.
+func NewRun(proc *process.Process) *Run {
+	return &Run{
+		fs:  proc.FileService,
+		dir: path.Join(Dir, uuid.NewString()),
+	}
+}
+
+// Count returns the number of batches of the run.
+func (r *Run) Count() int {
+	return r.cnt
+}
+
+// Rows returns the number of rows of the run.
+func (r *Run) Rows() int64 {
+	return r.rows
+}
+
+// Write appends the batch to the run, the constant vectors are expanded.
+func (r *Run) Write(proc *process.Process, bat *batch.Batch) error {
+	if len(bat.Zs) == 0 {
+		return nil
+	}
+	data, err := encodeBatch(bat, proc)
+	if err != nil {
+		return err
+	}
+	if err := r.fs.Write(context.TODO(), fileservice.IOVector{
+		FilePath: r.filePath(r.cnt),
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Size:   len(data),
+				Data:   data,
+			},
+		},
+	}); err != nil {
+		return err
+	}
+	r.cnt++
+	r.rows += int64(len(bat.Zs))
+	return nil
+}
+
+// WriteBatch appends the rows of the batch to the run, the batch is split
+// into the batches of UnitRows rows.
+func (r *Run) WriteBatch(proc *process.Process, bat *batch.Batch) error {
+	n := len(bat.Zs)
+	if n <= UnitRows {
+		return r.Write(proc, bat)
+	}
+	for i := 0; i < n; i += UnitRows {
+		cnt := n - i
+		if cnt > UnitRows {
+			cnt = UnitRows
+		}
+		sels := make([]int64, cnt)
+		for j := range sels {
+			sels[j] = int64(i + j)
+		}
+		rbat, err := copyBatch(bat, sels, proc)
+		if err != nil {
+			return err
+		}
+		err = r.Write(proc, rbat)
+		rbat.Clean(proc.Mp)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Read returns the i-th batch of the run, the memory of the batch is allocated from the process.
+func (r *Run) Read(proc *process.Process, i int) (*batch.Batch, error) {
+	vec := &fileservice.IOVector{
+		FilePath: r.filePath(i),
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Size:   -1,
+			},
+		},
+	}
+	if err := r.fs.Read(context.TODO(), vec); err != nil {
+		return nil, err
+	}
+	return decodeBatch(vec.Entries[0].Data, proc)
+}
+
+// Free deletes all the files of the run.
+func (r *Run) Free() error {
+	var err error
+
+	for i := 0; i < r.cnt; i++ {
+		if e := r.fs.Delete(context.TODO(), r.filePath(i)); e != nil && err == nil {
+			err = e
+		}
+	}
+	r.cnt = 0
+	r.rows = 0
+	return err
+}
+
+func (r *Run) filePath(i int) string {
+	return path.Join(r.dir, fmt.Sprintf("%d", i))
+}
+
+// encodeBatch encodes the batch as: the number of rows, Zs, the number of vectors,
+// and then the flag, the size and the content of each vector.
+func encodeBatch(bat *batch.Batch, proc *process.Process) ([]byte, error) {
+	var data []byte
+
+	data = append(data, encoding.EncodeUint32(uint32(len(bat.Zs)))...)
+	data = append(data, encoding.EncodeInt64Slice(bat.Zs)...)
+	data = append(data, encoding.EncodeUint32(uint32(len(bat.Vecs)))...)
+	for _, vec := range bat.Vecs {
+		if vec.IsScalarNull() {
+			data = append(data, flagScalarNull)
+			data = append(data, encoding.EncodeType(vec.Typ)...)
+			continue
+		}
+		if vec.ConstExpand(proc.Mp) == nil {
+			return nil, fmt.Errorf("out of memory")
+		}
+		buf, err := vec.Show()
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, flagVector)
+		data = append(data, encoding.EncodeUint32(uint32(len(buf)))...)
+		data = append(data, buf...)
+	}
+	return data, nil
+}
+
+func decodeBatch(data []byte, proc *process.Process) (*batch.Batch, error) {
+	rows := int(encoding.DecodeUint32(data))
+	data = data[4:]
+	zs := make([]int64, rows)
+	copy(zs, encoding.DecodeInt64Slice(data[:rows*8]))
+	data = data[rows*8:]
+	bat := batch.NewWithSize(int(encoding.DecodeUint32(data)))
+	data = data[4:]
+	for i := range bat.Vecs {
+		flag := data[0]
+		data = data[1:]
+		if flag == flagScalarNull {
+			bat.Vecs[i] = vector.NewConstNull(encoding.DecodeType(data[:encoding.TypeSize]), rows)
+			data = data[encoding.TypeSize:]
+			continue
+		}
+		size := encoding.DecodeUint32(data)
+		data = data[4:]
+		vec := vector.New(encoding.DecodeType(data[:encoding.TypeSize]))
+		if err := vec.Read(data[:size]); err != nil {
+			bat.Clean(proc.Mp)
+			return nil, err
+		}
+		data = data[size:]
+		v, err := vector.Dup(vec, proc.Mp)
+		if err != nil {
+			bat.Clean(proc.Mp)
+			return nil, err
+		}
+		bat.Vecs[i] = v
+	}
+	bat.Zs = zs
+	return bat, nil
+}
+
+// copyBatch returns a new batch made of the rows of the batch in sels, the scalar
+// null vectors are expanded except the ones of the NULL literals.
+func copyBatch(bat *batch.Batch, sels []int64, proc *process.Process) (*batch.Batch, error) {
+	rbat := batch.NewWithSize(len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		if vec.IsScalarNull() && vec.Typ.Oid == types.T_any {
+			rbat.Vecs[i] = vector.NewConstNull(vec.Typ, len(sels))
+			continue
+		}
+		rbat.Vecs[i] = vector.New(vec.Typ)
+		if vec.IsScalarNull() {
+			for range sels {
+				if err := vector.UnionNull(rbat.Vecs[i], nil, proc.Mp); err != nil {
+					rbat.Clean(proc.Mp)
+					return nil, err
+				}
+			}
+			continue
+		}
+		if err := vector.Union(rbat.Vecs[i], vec, sels, proc.Mp); err != nil {
+			rbat.Clean(proc.Mp)
+			return nil, err
+		}
+	}
+	rbat.Zs = make([]int64, len(sels))
+	for i, sel := range sels {
+		rbat.Zs[i] = bat.Zs[sel]
+	}
+	rbat.ExpandNulls()
+	return rbat, nil
+}
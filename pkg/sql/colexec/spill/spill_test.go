@@ -0,0 +1,138 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Rows = 20000 // default rows
+)
+
+func TestNeed(t *testing.T) {
+	proc := newProcess(t)
+	require.False(t, Need(proc, 10))
+	require.True(t, Need(proc, proc.Lim.Size+1))
+	proc.FileService = nil
+	require.False(t, Need(proc, proc.Lim.Size+1))
+}
+
+func TestRun(t *testing.T) {
+	proc := newProcess(t)
+	bat := newBatch(proc, Rows)
+	r := NewRun(proc)
+	require.NoError(t, r.WriteBatch(proc, bat))
+	require.Equal(t, (Rows+UnitRows-1)/UnitRows, r.Count())
+	require.Equal(t, int64(Rows), r.Rows())
+	row := 0
+	for i := 0; i < r.Count(); i++ {
+		rbat, err := r.Read(proc, i)
+		require.NoError(t, err)
+		for j := range rbat.Zs {
+			require.Equal(t, bat.Zs[row], rbat.Zs[j])
+			for k := 0; k < 2; k++ {
+				require.Equal(t, nulls.Contains(bat.Vecs[k].Nsp, uint64(row)), nulls.Contains(rbat.Vecs[k].Nsp, uint64(j)), "%d %d %d", k, row, j)
+			}
+			require.True(t, nulls.Contains(rbat.Vecs[2].Nsp, uint64(j)))
+			require.Equal(t, vector.MustTCols[int64](bat.Vecs[0])[row], vector.MustTCols[int64](rbat.Vecs[0])[j])
+			require.Equal(t, vector.GetStrColumn(bat.Vecs[1]).Get(int64(row)), vector.GetStrColumn(rbat.Vecs[1]).Get(int64(j)))
+			row++
+		}
+		rbat.Clean(proc.Mp)
+	}
+	require.Equal(t, Rows, row)
+	bat.Clean(proc.Mp)
+	require.NoError(t, r.Free())
+	require.Equal(t, 0, r.Count())
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
+func TestPartitions(t *testing.T) {
+	proc := newProcess(t)
+	bat := newBatch(proc, Rows)
+	p := NewPartitions(proc, PartitionNumber)
+	require.NoError(t, p.Write(proc, bat, bat.Vecs[:1]))
+	require.NoError(t, p.Write(proc, bat, bat.Vecs[:1]))
+	require.NoError(t, p.Flush(proc))
+	rows := 0
+	mp := make(map[int64]int)
+	for i := 0; i < p.Len(); i++ {
+		for j := 0; j < p.Run(i).Count(); j++ {
+			rbat, err := p.Run(i).Read(proc, j)
+			require.NoError(t, err)
+			vs := vector.MustTCols[int64](rbat.Vecs[0])
+			for k := range rbat.Zs {
+				key := vs[k]
+				if nulls.Contains(rbat.Vecs[0].Nsp, uint64(k)) {
+					key = -1
+				}
+				// the rows with the same key are in the same partition
+				if idx, ok := mp[key]; ok {
+					require.Equal(t, i, idx)
+				}
+				mp[key] = i
+				rows++
+			}
+			rbat.Clean(proc.Mp)
+		}
+	}
+	require.Equal(t, 2*Rows, rows)
+	bat.Clean(proc.Mp)
+	require.NoError(t, p.Free(proc))
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
+func newProcess(t *testing.T) *process.Process {
+	fs, err := fileservice.NewMemoryFS()
+	require.NoError(t, err)
+	proc := testutil.NewProcess()
+	proc.FileService = fs
+	return proc
+}
+
+// create a new block of an int64 column, a varchar column and a null column,
+// the values are repeated every 100 rows and every 7th row is NULL.
+func newBatch(proc *process.Process, rows int) *batch.Batch {
+	vs := make([]int64, rows)
+	ss := make([]string, rows)
+	for i := range vs {
+		vs[i] = int64(i % 100)
+		ss[i] = string(rune('a' + i%26))
+	}
+	bat := batch.NewWithSize(3)
+	bat.Vecs[0] = testutil.NewInt64Vector(rows, types.Type{Oid: types.T_int64}, proc.Mp, false, vs)
+	bat.Vecs[1] = testutil.NewStringVector(rows, types.Type{Oid: types.T_varchar, Width: 10}, proc.Mp, false, ss)
+	bat.Vecs[2] = vector.NewConstNull(types.Type{Oid: types.T_int64}, rows)
+	for i := 0; i < rows; i += 7 {
+		nulls.Add(bat.Vecs[0].Nsp, uint64(i))
+		nulls.Add(bat.Vecs[1].Nsp, uint64(i))
+	}
+	bat.Zs = make([]int64, rows)
+	for i := range bat.Zs {
+		bat.Zs[i] = int64(i%3 + 1)
+	}
+	return bat
+}
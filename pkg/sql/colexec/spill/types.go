@@ -0,0 +1,61 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"hash/maphash"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+)
+
+const (
+	// UnitRows is the max number of rows of a batch written to a run
+	UnitRows = 8192
+	// PartitionNumber is the number of partitions of the grace hash algorithms
+	PartitionNumber = 16
+	// Dir is the directory of the runs in the file service, each run is
+	// a subdirectory named by an uuid
+	Dir = "spill"
+)
+
+const (
+	flagVector = iota
+	flagScalarNull
+)
+
+// Run is a sequence of batches which are spilled to the file service,
+// each batch is written as a temporary file under the directory of the run.
+type Run struct {
+	fs   fileservice.FileService
+	dir  string
+	cnt  int
+	rows int64
+}
+
+// seed is shared by all the partitions, so the equal keys of different inputs
+// are distributed into the partitions with the same index.
+var seed = maphash.MakeSeed()
+
+// Partitions distributes the rows into a group of runs according to the
+// hash values of the keys, so the rows with the same keys are always in
+// the same partition.
+type Partitions struct {
+	runs []*Run
+	// bats buffers the rows of each partition until UnitRows rows are collected
+	bats []*batch.Batch
+	sels [][]int64
+	key  []byte
+}
@@ -0,0 +1,30 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package union
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+)
+
+type container struct {
+	// mp records all the rows which have been produced
+	mp *hashmap.StrHashMap
+}
+
+// Argument removes the duplicate rows of UNION, the rows which have
+// been produced are discarded and NULLs are regarded as equal.
+type Argument struct {
+	ctr *container
+}
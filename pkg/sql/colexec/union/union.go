@@ -0,0 +1,97 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package union
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(_ interface{}, buf *bytes.Buffer) {
+	buf.WriteString("union distinct")
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	ap.ctr.mp = hashmap.NewStrMap(true)
+	return nil
+}
+
+func Call(idx int, proc *process.Process, arg interface{}) (bool, error) {
+	bat := proc.InputBatch()
+	if bat == nil {
+		return true, nil
+	}
+	if bat.Length() == 0 {
+		return false, nil
+	}
+	ap := arg.(*Argument)
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+	anal.Input(bat)
+	for _, vec := range bat.Vecs {
+		if vec.ConstExpand(proc.Mp) == nil {
+			bat.Clean(proc.Mp)
+			return false, errors.New("", "out of memory")
+		}
+	}
+	sels := ap.ctr.distinct(bat, proc)
+	if len(sels) == 0 {
+		proc.PutSels(sels)
+		bat.Clean(proc.Mp)
+		proc.SetInputBatch(&batch.Batch{})
+		return false, nil
+	}
+	if len(sels) < len(bat.Zs) {
+		bat.Shrink(sels)
+	}
+	proc.PutSels(sels)
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	anal.Output(bat)
+	proc.SetInputBatch(bat)
+	return false, nil
+}
+
+// distinct inserts the rows into the hash map, and returns the new rows.
+func (ctr *container) distinct(bat *batch.Batch, proc *process.Process) []int64 {
+	sels := proc.GetSels()
+	count := len(bat.Zs)
+	scales := make([]int32, len(bat.Vecs))
+	itr := ctr.mp.NewIterator()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		rows := ctr.mp.GroupCount()
+		vals, _ := itr.Insert(i, n, bat.Vecs, scales)
+		for k, v := range vals {
+			if v > rows {
+				rows++
+				ctr.mp.AddGroup()
+				sels = append(sels, int64(i+k))
+			}
+		}
+	}
+	return sels
+}
@@ -0,0 +1,111 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package union
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// add unit tests for cases
+type unionTestCase struct {
+	arg  *Argument
+	rows int // expected number of rows
+	proc *process.Process
+}
+
+var (
+	tcs []unionTestCase
+)
+
+func init() {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	tcs = []unionTestCase{
+		// 1, 2, 3, NULL
+		newTestCase(mheap.New(gm), 4),
+	}
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for _, tc := range tcs {
+		String(tc.arg, buf)
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	for _, tc := range tcs {
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+	}
+}
+
+func TestUnion(t *testing.T) {
+	for _, tc := range tcs {
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+		rows := 0
+		for _, bat := range []*batch.Batch{
+			newBatch(tc.proc, []int64{1, 1, 2, 0}, []uint64{3}),
+			{},
+			newBatch(tc.proc, []int64{2, 3, 0, 0}, []uint64{2, 3}),
+			newBatch(tc.proc, []int64{1, 3}, nil),
+		} {
+			tc.proc.Reg.InputBatch = bat
+			ok, err := Call(0, tc.proc, tc.arg)
+			require.NoError(t, err)
+			require.False(t, ok)
+			for _, z := range tc.proc.Reg.InputBatch.Zs {
+				require.Equal(t, int64(1), z)
+			}
+			rows += len(tc.proc.Reg.InputBatch.Zs)
+			tc.proc.Reg.InputBatch.Clean(tc.proc.Mp)
+		}
+		tc.proc.Reg.InputBatch = nil
+		ok, err := Call(0, tc.proc, tc.arg)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, tc.rows, rows)
+		require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+	}
+}
+
+func newTestCase(m *mheap.Mheap, rows int) unionTestCase {
+	return unionTestCase{
+		proc: process.New(m),
+		rows: rows,
+		arg:  new(Argument),
+	}
+}
+
+// create a new block of an int64 column, the rows in nsp are NULLs
+func newBatch(proc *process.Process, vs []int64, nsp []uint64) *batch.Batch {
+	bat := batch.NewWithSize(1)
+	bat.Vecs[0] = testutil.NewInt64Vector(len(vs), types.Type{Oid: types.T_int64}, proc.Mp, false, vs)
+	nulls.Add(bat.Vecs[0].Nsp, nsp...)
+	bat.InitZsOne(len(vs))
+	return bat
+}
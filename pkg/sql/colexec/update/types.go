@@ -15,8 +15,10 @@
 package update
 
 import (
-	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/foreignkey"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
 
 type Argument struct {
@@ -31,4 +33,13 @@ type Argument struct {
 	UpdateAttrs []string
 	OtherAttrs  []string
 	AttrOrders  []string
+	// NotNull[i] is set if UpdateAttrs[i] can't be set to null
+	NotNull []bool
+	// Checks are the names of the CHECK constraints, the results of the
+	// constraints are the last vectors of the input batch
+	Checks []string
+	// TableName and ForeignKeys apply the foreign keys to the updated rows,
+	// ForeignKeys is nil if foreign_key_checks is off
+	TableName   string
+	ForeignKeys *foreignkey.Checker
 }
@@ -17,7 +17,9 @@ package update
 import (
 	"bytes"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -38,6 +40,14 @@ func Call(_ int, proc *process.Process, arg interface{}) (bool, error) {
 	}
 	defer bat.Clean(proc.Mp)
 	affectedRows := uint64(batch.Length(bat))
+	if err := checkConstraints(p, bat); err != nil {
+		return false, err
+	}
+	n := len(bat.Vecs) - len(p.Checks)
+	for _, vec := range bat.Vecs[n:] {
+		vector.Clean(vec, proc.Mp)
+	}
+	bat.Vecs = bat.Vecs[:n]
 	// Fill vector for constant value
 	for i := range bat.Vecs {
 		if i == 0 {
@@ -45,6 +55,9 @@ func Call(_ int, proc *process.Process, arg interface{}) (bool, error) {
 		}
 		bat.Vecs[i] = bat.Vecs[i].ConstExpand(proc.Mp)
 	}
+	if err := applyForeignKeys(p, bat); err != nil {
+		return false, err
+	}
 
 	if p.PriKeyIdx != -1 {
 		// Delete old data because update primary key
@@ -82,3 +95,42 @@ func Call(_ int, proc *process.Process, arg interface{}) (bool, error) {
 
 	return false, nil
 }
+
+// checkConstraints verifies that the updated columns which can't be null get
+// no null, and the rows don't violate the CHECK constraints. A row violates a
+// constraint only if the result is false, null is accepted.
+func checkConstraints(p *Argument, bat *batch.Batch) error {
+	for i, notNull := range p.NotNull {
+		if notNull && nulls.Any(bat.Vecs[i+1].Nsp) {
+			return moerr.NewBadNullValue(p.UpdateAttrs[i])
+		}
+	}
+	for i, name := range p.Checks {
+		vec := bat.Vecs[len(bat.Vecs)-len(p.Checks)+i]
+		if vec.IsScalarNull() {
+			continue
+		}
+		for j, ok := range vector.MustTCols[bool](vec) {
+			if !ok && !nulls.Contains(vec.Nsp, uint64(j)) {
+				return moerr.NewCheckViolation(name)
+			}
+		}
+	}
+	return nil
+}
+
+// applyForeignKeys checks the foreign keys of the updated rows and applies the
+// ON UPDATE actions of the foreign keys referring to them. The first vector of
+// the batch holds the keys of the rows, the new values follow it.
+func applyForeignKeys(p *Argument, bat *batch.Batch) error {
+	if p.ForeignKeys == nil {
+		return nil
+	}
+	keyName := p.HideKey
+	if p.PriKeyIdx != -1 {
+		keyName = p.PriKey
+	}
+	news := batch.New(true, p.UpdateAttrs)
+	copy(news.Vecs, bat.Vecs[1:1+len(p.UpdateAttrs)])
+	return p.ForeignKeys.OnUpdate(p.Ts, p.TableName, keyName, bat.Vecs[0], news)
+}
@@ -0,0 +1,147 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregate"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+)
+
+// Kind of a window function
+const (
+	RowNumber = iota
+	Rank
+	DenseRank
+	Lag
+	Lead
+	FirstValue
+	LastValue
+	Aggregate
+)
+
+var kindNames = [...]string{
+	RowNumber:  "row_number",
+	Rank:       "rank",
+	DenseRank:  "dense_rank",
+	Lag:        "lag",
+	Lead:       "lead",
+	FirstValue: "first_value",
+	LastValue:  "last_value",
+}
+
+// FrameType is the unit of a frame
+type FrameType int8
+
+const (
+	Rows FrameType = iota
+	Range
+)
+
+// BoundType is the direction of a frame bound
+type BoundType int8
+
+const (
+	Preceding BoundType = iota
+	CurrentRow
+	Following
+)
+
+// Bound is the start or end of a frame, Offset is the number of rows for
+// ROWS frames and the distance of the order value for RANGE frames.
+type Bound struct {
+	Type      BoundType
+	Unbounded bool
+	Offset    int64
+}
+
+type Frame struct {
+	Type  FrameType
+	Start Bound
+	End   Bound
+}
+
+// Function is a window function,
+// Op is the aggregate operator if Kind is Aggregate,
+// Offset is the N of LAG(expr, N, default) and LEAD(expr, N, default).
+type Function struct {
+	Kind   int
+	Op     int
+	Offset int64
+	Es     []*plan.Expr
+}
+
+type evalVector struct {
+	needFree bool
+	vec      *vector.Vector
+}
+
+type Container struct {
+	vecs []evalVector
+	// bat is the rows of the last partition of the previous batches
+	bat *batch.Batch
+}
+
+type Argument struct {
+	PartitionBy []*plan.Expr
+	OrderBy     []order.Field
+	Frame       Frame
+	Funcs       []Function
+	ctr         *Container
+}
+
+func (f Function) String() string {
+	var name string
+	if f.Kind == Aggregate {
+		name = aggregate.Names[f.Op]
+	} else {
+		name = kindNames[f.Kind]
+	}
+	s := name + "("
+	for i, e := range f.Es {
+		if i > 0 {
+			s += ", "
+		}
+		s += fmt.Sprintf("%v", e)
+	}
+	return s + ")"
+}
+
+func (b Bound) String() string {
+	switch {
+	case b.Type == CurrentRow:
+		return "CURRENT ROW"
+	case b.Unbounded && b.Type == Preceding:
+		return "UNBOUNDED PRECEDING"
+	case b.Unbounded:
+		return "UNBOUNDED FOLLOWING"
+	case b.Type == Preceding:
+		return fmt.Sprintf("%v PRECEDING", b.Offset)
+	default:
+		return fmt.Sprintf("%v FOLLOWING", b.Offset)
+	}
+}
+
+func (f Frame) String() string {
+	s := "ROWS"
+	if f.Type == Range {
+		s = "RANGE"
+	}
+	return s + " BETWEEN " + f.Start.String() + " AND " + f.End.String()
+}
@@ -0,0 +1,599 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/partition"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregate"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	buf.WriteString("ω([")
+	for i, f := range ap.Funcs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(f.String())
+	}
+	buf.WriteString("], [")
+	for i, e := range ap.PartitionBy {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(fmt.Sprintf("%v", e))
+	}
+	buf.WriteString("], [")
+	for i, f := range ap.OrderBy {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(f.String())
+	}
+	buf.WriteString("], " + ap.Frame.String() + ")")
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	ap := arg.(*Argument)
+	ap.ctr = new(Container)
+	return nil
+}
+
+// Call computes the window functions over the batches which have been sorted
+// by the partition keys and the order keys, the results are appended to the
+// batches. The sorted rows may come in many batches if they are spilled, so the
+// rows of the last partition of a batch are kept until the next batch or the
+// end of the input.
+func Call(_ int, proc *process.Process, arg interface{}) (bool, error) {
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	bat := proc.Reg.InputBatch
+	if bat == nil {
+		if ctr.bat == nil {
+			return true, nil
+		}
+		bat, ctr.bat = ctr.bat, nil
+		if err := ctr.process(ap, bat, proc); err != nil {
+			bat.Clean(proc.Mp)
+			return true, err
+		}
+		proc.Reg.InputBatch = bat
+		return true, nil
+	}
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	if err := expandRows(bat, proc); err != nil {
+		bat.Clean(proc.Mp)
+		return false, err
+	}
+	if ctr.bat != nil {
+		err := appendRows(ctr.bat, bat, 0, proc)
+		bat.Clean(proc.Mp)
+		if err != nil {
+			ctr.bat.Clean(proc.Mp)
+			ctr.bat = nil
+			return false, err
+		}
+		bat, ctr.bat = ctr.bat, nil
+	}
+	bat, err := ctr.keepLastPartition(ap, bat, proc)
+	if err != nil {
+		return false, err
+	}
+	if bat == nil {
+		proc.Reg.InputBatch = &batch.Batch{}
+		return false, nil
+	}
+	if err := ctr.process(ap, bat, proc); err != nil {
+		bat.Clean(proc.Mp)
+		return false, err
+	}
+	proc.Reg.InputBatch = bat
+	return false, nil
+}
+
+// keepLastPartition moves the rows of the last partition of the batch to
+// ctr.bat, because the partition may go on in the next batch. It returns the
+// batch of the other rows, which is nil if all the rows are kept.
+func (ctr *Container) keepLastPartition(ap *Argument, bat *batch.Batch, proc *process.Process) (*batch.Batch, error) {
+	defer ctr.cleanEvalVectors(proc.Mp)
+	n := len(bat.Zs)
+	ps := []int64{0}
+	if len(ap.PartitionBy) > 0 {
+		sels := make([]int64, n)
+		for i := range sels {
+			sels[i] = int64(i)
+		}
+		diffs := make([]bool, n)
+		for _, e := range ap.PartitionBy {
+			vec, err := ctr.eval(bat, proc, e)
+			if err != nil {
+				bat.Clean(proc.Mp)
+				return nil, err
+			}
+			ps = partition.Partition(sels, diffs, make([]int64, 0, 16), vec)
+		}
+	}
+	start := ps[len(ps)-1]
+	if start == 0 {
+		ctr.bat = bat
+		return nil, nil
+	}
+	rbat := batch.NewWithSize(len(bat.Vecs))
+	rbat.Attrs = bat.Attrs
+	for i, vec := range bat.Vecs {
+		if vec.IsScalar() {
+			// the scalar is shared by the rows, only its memory isn't
+			v := *vec
+			v.Data = nil
+			rbat.Vecs[i] = &v
+			vector.SetScalarLength(rbat.Vecs[i], 0)
+		} else {
+			rbat.Vecs[i] = vector.New(vec.Typ)
+		}
+	}
+	if err := appendRows(rbat, bat, start, proc); err != nil {
+		rbat.Clean(proc.Mp)
+		bat.Clean(proc.Mp)
+		return nil, err
+	}
+	batch.SetLength(bat, int(start))
+	ctr.bat = rbat
+	return bat, nil
+}
+
+// appendRows appends the rows of bat from start to the end to rbat.
+func appendRows(rbat, bat *batch.Batch, start int64, proc *process.Process) error {
+	n := len(bat.Zs) - int(start)
+	flags := make([]uint8, n)
+	for i := range flags {
+		flags[i] = 1
+	}
+	for i, vec := range rbat.Vecs {
+		if vec.IsScalar() {
+			vector.SetScalarLength(vec, len(rbat.Zs)+n)
+			continue
+		}
+		if err := vector.UnionBatch(vec, bat.Vecs[i], start, n, flags, proc.Mp); err != nil {
+			return err
+		}
+	}
+	rbat.Zs = append(rbat.Zs, bat.Zs[start:]...)
+	return nil
+}
+
+func (ctr *Container) process(ap *Argument, bat *batch.Batch, proc *process.Process) error {
+	defer ctr.cleanEvalVectors(proc.Mp)
+	if err := expandRows(bat, proc); err != nil {
+		return err
+	}
+	n := len(bat.Zs)
+	sels := make([]int64, n)
+	for i := range sels {
+		sels[i] = int64(i)
+	}
+	diffs := make([]bool, n)
+	ps := []int64{0}
+	for _, e := range ap.PartitionBy {
+		vec, err := ctr.eval(bat, proc, e)
+		if err != nil {
+			return err
+		}
+		ps = partition.Partition(sels, diffs, make([]int64, 0, 16), vec)
+	}
+	peers := ps
+	ovecs := make([]*vector.Vector, len(ap.OrderBy))
+	for i, f := range ap.OrderBy {
+		vec, err := ctr.eval(bat, proc, f.E)
+		if err != nil {
+			return err
+		}
+		ovecs[i] = vec
+		peers = partition.Partition(sels, diffs, make([]int64, 0, 16), vec)
+	}
+
+	// [partStart[i], partEnd[i]) is the partition of the i-th row,
+	// and [peerStart[i], peerEnd[i]) are the peers of the i-th row.
+	partStart, partEnd := spans(ps, n)
+	peerStart, peerEnd := spans(peers, n)
+	frameStart, frameEnd, err := ap.Frame.bounds(ap.OrderBy, ovecs, partStart, partEnd, peerStart, peerEnd)
+	if err != nil {
+		return err
+	}
+
+	vecs := make([]*vector.Vector, len(ap.Funcs))
+	for i, f := range ap.Funcs {
+		var vec *vector.Vector
+		var err error
+
+		switch f.Kind {
+		case RowNumber, Rank, DenseRank:
+			vec, err = evalRank(f.Kind, partStart, peerStart, proc)
+		case Lag, Lead:
+			vec, err = ctr.evalOffset(f, bat, partStart, partEnd, proc)
+		case FirstValue, LastValue:
+			vec, err = ctr.evalValue(f, bat, frameStart, frameEnd, proc)
+		default:
+			vec, err = ctr.evalAggregate(ap.Frame, f, bat, partStart, frameStart, frameEnd, proc)
+		}
+		if err != nil {
+			for j := 0; j < i; j++ {
+				vector.Clean(vecs[j], proc.Mp)
+			}
+			return err
+		}
+		vecs[i] = vec
+	}
+	bat.Vecs = append(bat.Vecs, vecs...)
+	return nil
+}
+
+// eval evaluates an expression over the batch, the result will be freed after
+// the batch is processed if it isn't a vector of the batch.
+func (ctr *Container) eval(bat *batch.Batch, proc *process.Process, e *plan.Expr) (*vector.Vector, error) {
+	vec, err := colexec.EvalExpr(bat, proc, e)
+	if err != nil {
+		return nil, err
+	}
+	needFree := true
+	for i := range bat.Vecs {
+		if bat.Vecs[i] == vec {
+			needFree = false
+			break
+		}
+	}
+	if needFree {
+		ctr.vecs = append(ctr.vecs, evalVector{needFree: true, vec: vec})
+		if vec.ConstExpand(proc.Mp) == nil {
+			return nil, fmt.Errorf("out of memory")
+		}
+	}
+	return vec, nil
+}
+
+func (ctr *Container) cleanEvalVectors(m *mheap.Mheap) {
+	for i := range ctr.vecs {
+		if ctr.vecs[i].needFree {
+			vector.Clean(ctr.vecs[i].vec, m)
+		}
+	}
+	ctr.vecs = ctr.vecs[:0]
+}
+
+// expandRows makes each row of the batch appear once, because the
+// window functions are computed row by row.
+func expandRows(bat *batch.Batch, proc *process.Process) error {
+	var sels []int64
+
+	for i, z := range bat.Zs {
+		if z != 1 && sels == nil {
+			sels = make([]int64, 0, len(bat.Zs))
+			for j := 0; j < i; j++ {
+				sels = append(sels, int64(j))
+			}
+		}
+		if sels != nil {
+			for k := int64(0); k < z; k++ {
+				sels = append(sels, int64(i))
+			}
+		}
+	}
+	if sels == nil {
+		return nil
+	}
+	mp := make(map[*vector.Vector]*vector.Vector)
+	for i, vec := range bat.Vecs {
+		if nv, ok := mp[vec]; ok {
+			bat.Vecs[i] = nv
+			continue
+		}
+		nv := vector.New(vec.Typ)
+		if err := vector.Union(nv, vec, sels, proc.Mp); err != nil {
+			vector.Clean(nv, proc.Mp)
+			return err
+		}
+		mp[vec] = nv
+		vector.Clean(vec, proc.Mp)
+		bat.Vecs[i] = nv
+	}
+	bat.Zs = make([]int64, len(sels))
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	return nil
+}
+
+// spans converts the start positions of the groups to the group bounds of each row.
+func spans(starts []int64, n int) ([]int64, []int64) {
+	ss := make([]int64, n)
+	es := make([]int64, n)
+	for i, start := range starts {
+		end := int64(n)
+		if i+1 < len(starts) {
+			end = starts[i+1]
+		}
+		for j := start; j < end; j++ {
+			ss[j], es[j] = start, end
+		}
+	}
+	return ss, es
+}
+
+// bounds computes the frame [start[i], end[i]) of each row.
+func (f Frame) bounds(fs []order.Field, ovecs []*vector.Vector, partStart, partEnd, peerStart, peerEnd []int64) ([]int64, []int64, error) {
+	var keys []float64
+	var ovec *vector.Vector
+
+	if f.Type == Range && (!f.Start.Unbounded && f.Start.Type != CurrentRow ||
+		!f.End.Unbounded && f.End.Type != CurrentRow) {
+		if len(ovecs) != 1 {
+			return nil, nil, fmt.Errorf("RANGE with offset PRECEDING/FOLLOWING requires exactly one ORDER BY column")
+		}
+		ovec = ovecs[0]
+		ks, err := orderKeys(ovec, fs[0].Type == order.Descending)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = ks
+	}
+	n := len(partStart)
+	start := make([]int64, n)
+	end := make([]int64, n)
+	for i := 0; i < n; i++ {
+		ps, pe := partStart[i], partEnd[i]
+		if f.Type == Rows {
+			start[i] = rowsBound(f.Start, int64(i), ps, pe, false)
+			end[i] = rowsBound(f.End, int64(i), ps, pe, true)
+		} else {
+			isNull := ovec != nil && nulls.Contains(ovec.Nsp, uint64(i))
+			start[i] = rangeBound(f.Start, int64(i), ps, pe, peerStart[i], peerEnd[i], keys, isNull, false)
+			end[i] = rangeBound(f.End, int64(i), ps, pe, peerStart[i], peerEnd[i], keys, isNull, true)
+		}
+		if end[i] < start[i] {
+			end[i] = start[i]
+		}
+	}
+	return start, end, nil
+}
+
+func rowsBound(b Bound, i, ps, pe int64, isEnd bool) int64 {
+	var pos int64
+
+	switch {
+	case b.Unbounded && b.Type == Preceding:
+		return ps
+	case b.Unbounded:
+		return pe
+	case b.Type == CurrentRow:
+		pos = i
+	case b.Type == Preceding:
+		pos = i - b.Offset
+	default:
+		pos = i + b.Offset
+	}
+	if isEnd {
+		pos++
+	}
+	if pos < ps {
+		return ps
+	}
+	if pos > pe {
+		return pe
+	}
+	return pos
+}
+
+// rangeBound searches the bound in the sorted keys of the partition,
+// the keys are negated for the descending order so that they are always ascending.
+func rangeBound(b Bound, i, ps, pe, peerStart, peerEnd int64, keys []float64, isNull bool, isEnd bool) int64 {
+	switch {
+	case b.Unbounded && b.Type == Preceding:
+		return ps
+	case b.Unbounded:
+		return pe
+	case b.Type == CurrentRow || isNull:
+		if isEnd {
+			return peerEnd
+		}
+		return peerStart
+	}
+	target := keys[i] - float64(b.Offset)
+	if b.Type == Following {
+		target = keys[i] + float64(b.Offset)
+	}
+	k := sort.Search(int(pe-ps), func(j int) bool {
+		if isEnd {
+			return keys[ps+int64(j)] > target
+		}
+		return keys[ps+int64(j)] >= target
+	})
+	return ps + int64(k)
+}
+
+func orderKeys(vec *vector.Vector, desc bool) ([]float64, error) {
+	n := vector.Length(vec)
+	keys := make([]float64, n)
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		fillKeys(keys, vec.Col.([]int8))
+	case types.T_int16:
+		fillKeys(keys, vec.Col.([]int16))
+	case types.T_int32:
+		fillKeys(keys, vec.Col.([]int32))
+	case types.T_int64:
+		fillKeys(keys, vec.Col.([]int64))
+	case types.T_uint8:
+		fillKeys(keys, vec.Col.([]uint8))
+	case types.T_uint16:
+		fillKeys(keys, vec.Col.([]uint16))
+	case types.T_uint32:
+		fillKeys(keys, vec.Col.([]uint32))
+	case types.T_uint64:
+		fillKeys(keys, vec.Col.([]uint64))
+	case types.T_float32:
+		fillKeys(keys, vec.Col.([]float32))
+	case types.T_float64:
+		fillKeys(keys, vec.Col.([]float64))
+	default:
+		return nil, fmt.Errorf("RANGE with offset PRECEDING/FOLLOWING requires a numeric ORDER BY column, but got '%v'", vec.Typ)
+	}
+	if desc {
+		for i := range keys {
+			keys[i] = -keys[i]
+		}
+	}
+	return keys, nil
+}
+
+func fillKeys[T types.Ints | types.UInts | types.Floats](keys []float64, vs []T) {
+	for i := range keys {
+		keys[i] = float64(vs[i])
+	}
+}
+
+func evalRank(kind int, partStart, peerStart []int64, proc *process.Process) (*vector.Vector, error) {
+	n := len(partStart)
+	data, err := mheap.Alloc(proc.Mp, int64(n)*8)
+	if err != nil {
+		return nil, err
+	}
+	vs := encoding.DecodeInt64Slice(data)[:n]
+	var dense int64
+	for i := 0; i < n; i++ {
+		switch kind {
+		case RowNumber:
+			vs[i] = int64(i) - partStart[i] + 1
+		case Rank:
+			vs[i] = peerStart[i] - partStart[i] + 1
+		default:
+			if int64(i) == partStart[i] {
+				dense = 0
+			}
+			if int64(i) == peerStart[i] {
+				dense++
+			}
+			vs[i] = dense
+		}
+	}
+	return vector.NewWithData(types.Type{Oid: types.T_int64, Size: 8}, data, vs, new(nulls.Nulls)), nil
+}
+
+// evalOffset computes LAG(expr, N, default) and LEAD(expr, N, default).
+func (ctr *Container) evalOffset(f Function, bat *batch.Batch, partStart, partEnd []int64, proc *process.Process) (*vector.Vector, error) {
+	var dvec *vector.Vector
+
+	src, err := ctr.eval(bat, proc, f.Es[0])
+	if err != nil {
+		return nil, err
+	}
+	if len(f.Es) > 2 {
+		if dvec, err = ctr.eval(bat, proc, f.Es[2]); err != nil {
+			return nil, err
+		}
+	}
+	vec := vector.New(src.Typ)
+	for i := range partStart {
+		j := int64(i) - f.Offset
+		if f.Kind == Lead {
+			j = int64(i) + f.Offset
+		}
+		switch {
+		case j >= partStart[i] && j < partEnd[i]:
+			err = vector.UnionOne(vec, src, j, proc.Mp)
+		case dvec != nil && !nulls.Contains(dvec.Nsp, uint64(i)):
+			err = vector.UnionOne(vec, dvec, int64(i), proc.Mp)
+		default:
+			err = vector.UnionNull(vec, src, proc.Mp)
+		}
+		if err != nil {
+			vector.Clean(vec, proc.Mp)
+			return nil, err
+		}
+	}
+	return vec, nil
+}
+
+// evalValue computes FIRST_VALUE(expr) and LAST_VALUE(expr) of the frames.
+func (ctr *Container) evalValue(f Function, bat *batch.Batch, frameStart, frameEnd []int64, proc *process.Process) (*vector.Vector, error) {
+	src, err := ctr.eval(bat, proc, f.Es[0])
+	if err != nil {
+		return nil, err
+	}
+	vec := vector.New(src.Typ)
+	for i := range frameStart {
+		switch {
+		case frameStart[i] == frameEnd[i]:
+			err = vector.UnionNull(vec, src, proc.Mp)
+		case f.Kind == FirstValue:
+			err = vector.UnionOne(vec, src, frameStart[i], proc.Mp)
+		default:
+			err = vector.UnionOne(vec, src, frameEnd[i]-1, proc.Mp)
+		}
+		if err != nil {
+			vector.Clean(vec, proc.Mp)
+			return nil, err
+		}
+	}
+	return vec, nil
+}
+
+// evalAggregate computes an aggregate function over the frames, each row has
+// its own group in the ring. If the frames start at the beginning of the partitions,
+// the group of a row is the group of the previous row plus the new rows of its frame.
+func (ctr *Container) evalAggregate(frame Frame, f Function, bat *batch.Batch, partStart, frameStart, frameEnd []int64, proc *process.Process) (*vector.Vector, error) {
+	src, err := ctr.eval(bat, proc, f.Es[0])
+	if err != nil {
+		return nil, err
+	}
+	r, err := aggregate.New(f.Op, false, src.Typ, nil)
+	if err != nil {
+		return nil, err
+	}
+	n := len(frameStart)
+	if err := r.Grows(n, proc.Mp); err != nil {
+		r.Free(proc.Mp)
+		return nil, err
+	}
+	cumulative := frame.Start.Unbounded && frame.Start.Type == Preceding
+	zs := make([]int64, n)
+	for i := 0; i < n; i++ {
+		start := frameStart[i]
+		if cumulative && int64(i) > partStart[i] {
+			r.Add(r, int64(i), int64(i-1))
+			zs[i] = zs[i-1]
+			start = frameEnd[i-1]
+		}
+		for j := start; j < frameEnd[i]; j++ {
+			r.Fill(int64(i), j, 1, src)
+			zs[i]++
+		}
+	}
+	return r.Eval(zs), nil
+}
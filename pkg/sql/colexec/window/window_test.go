@@ -0,0 +1,293 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/aggregate"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeorder"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/spill"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// add unit tests for cases
+type windowTestCase struct {
+	arg  *Argument
+	proc *process.Process
+	// expected results of the window functions
+	expects [][]float64
+	nsps    [][]uint64
+}
+
+var (
+	tcs []windowTestCase
+
+	// the batch is sorted by (a, b), a is the partition key and b is the order key
+	as = []int64{1, 1, 1, 1, 2, 2, 2}
+	bs = []float64{1, 2, 2, 5, 1, 3, 4}
+)
+
+var (
+	rowsFrame = Frame{
+		Type:  Rows,
+		Start: Bound{Type: Preceding, Offset: 1},
+		End:   Bound{Type: Following, Offset: 1},
+	}
+	cumulativeFrame = Frame{
+		Type:  Range,
+		Start: Bound{Type: Preceding, Unbounded: true},
+		End:   Bound{Type: CurrentRow},
+	}
+	rangeFrame = Frame{
+		Type:  Range,
+		Start: Bound{Type: Preceding, Offset: 1},
+		End:   Bound{Type: CurrentRow},
+	}
+)
+
+func init() {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	tcs = []windowTestCase{
+		newTestCase(mheap.New(gm), cumulativeFrame, []Function{
+			{Kind: RowNumber},
+			{Kind: Rank},
+			{Kind: DenseRank},
+		}, [][]float64{
+			{1, 2, 3, 4, 1, 2, 3},
+			{1, 2, 2, 4, 1, 2, 3},
+			{1, 2, 2, 3, 1, 2, 3},
+		}, [][]uint64{nil, nil, nil}),
+		newTestCase(mheap.New(gm), cumulativeFrame, []Function{
+			{Kind: Lag, Offset: 1, Es: []*plan.Expr{newExpression(1)}},
+			{Kind: Lead, Offset: 2, Es: []*plan.Expr{newExpression(1), nil, newExpression(1)}},
+		}, [][]float64{
+			{0, 1, 2, 2, 0, 1, 3},
+			{2, 5, 2, 5, 4, 3, 4},
+		}, [][]uint64{{0, 4}, nil}),
+		newTestCase(mheap.New(gm), rowsFrame, []Function{
+			{Kind: Aggregate, Op: aggregate.Sum, Es: []*plan.Expr{newExpression(1)}},
+			{Kind: FirstValue, Es: []*plan.Expr{newExpression(1)}},
+			{Kind: LastValue, Es: []*plan.Expr{newExpression(1)}},
+		}, [][]float64{
+			{3, 5, 9, 7, 4, 8, 7},
+			{1, 1, 2, 2, 1, 1, 3},
+			{2, 2, 5, 5, 3, 4, 4},
+		}, [][]uint64{nil, nil, nil}),
+		newTestCase(mheap.New(gm), cumulativeFrame, []Function{
+			{Kind: Aggregate, Op: aggregate.Sum, Es: []*plan.Expr{newExpression(1)}},
+			{Kind: Aggregate, Op: aggregate.Max, Es: []*plan.Expr{newExpression(1)}},
+		}, [][]float64{
+			{1, 5, 5, 10, 1, 4, 8},
+			{1, 2, 2, 5, 1, 3, 4},
+		}, [][]uint64{nil, nil}),
+		newTestCase(mheap.New(gm), rangeFrame, []Function{
+			{Kind: Aggregate, Op: aggregate.Sum, Es: []*plan.Expr{newExpression(1)}},
+		}, [][]float64{
+			{1, 5, 5, 5, 1, 3, 7},
+		}, [][]uint64{nil}),
+	}
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for _, tc := range tcs {
+		String(tc.arg, buf)
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	for _, tc := range tcs {
+		err := Prepare(tc.proc, tc.arg)
+		require.NoError(t, err)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	// the rows come in one batch, or in many batches like the spilled rows,
+	// which may cut the partitions
+	for _, ends := range [][]int{{7}, {2, 5, 7}, {1, 2, 3, 4, 5, 6, 7}} {
+		for _, tc := range tcs {
+			err := Prepare(tc.proc, tc.arg)
+			require.NoError(t, err)
+			rows, start := 0, 0
+			nullCnts := make([]int, len(tc.expects))
+			for _, end := range append(ends, -1) {
+				tc.proc.Reg.InputBatch = nil
+				if end >= 0 {
+					tc.proc.Reg.InputBatch = newBatch(tc.proc, start, end)
+					start = end
+				}
+				ok, err := Call(0, tc.proc, tc.arg)
+				require.NoError(t, err)
+				require.Equal(t, end < 0, ok)
+				bat := tc.proc.Reg.InputBatch
+				if bat == nil || len(bat.Zs) == 0 {
+					continue
+				}
+				require.Equal(t, 2+len(tc.expects), len(bat.Vecs))
+				for i, expect := range tc.expects {
+					vec := bat.Vecs[2+i]
+					for j := range bat.Zs {
+						if nulls.Contains(vec.Nsp, uint64(j)) {
+							require.Contains(t, tc.nsps[i], uint64(rows+j))
+							nullCnts[i]++
+							continue
+						}
+						require.Equal(t, expect[rows+j], toFloat64(vec.Col, j), "function %d, row %d, ends %v", i, rows+j, ends)
+					}
+				}
+				rows += len(bat.Zs)
+				bat.Clean(tc.proc.Mp)
+			}
+			require.Equal(t, len(as), rows)
+			for i, nsp := range tc.nsps {
+				require.Equal(t, len(nsp), nullCnts[i])
+			}
+			require.Equal(t, int64(0), mheap.Size(tc.proc.Mp))
+		}
+	}
+}
+
+// TestWindowSpill computes the window functions over the rows spilled by the
+// merge order, whose batches of spill.UnitRows rows cut the partitions.
+func TestWindowSpill(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	proc := process.New(mheap.New(gm))
+	fs, err := fileservice.NewMemoryFS()
+	require.NoError(t, err)
+	proc.FileService = fs
+	proc.Lim.Size = 1 // every merged batch is spilled
+	proc.Reg.MergeReceivers = []*process.WaitRegister{{
+		Ctx: context.Background(),
+		Ch:  make(chan *batch.Batch, 3),
+	}}
+	const partRows = 3000
+	n := 3 * spill.UnitRows
+	for _, start := range []int{0, n / 2} {
+		as, bs := make([]int64, n/2), make([]float64, n/2)
+		for i := range as {
+			as[i] = int64((start + i) / partRows)
+			bs[i] = float64(start + i)
+		}
+		proc.Reg.MergeReceivers[0].Ch <- makeBatch(proc, as, bs)
+	}
+	proc.Reg.MergeReceivers[0].Ch <- nil
+
+	orderArg := &mergeorder.Argument{Fs: []order.Field{{E: newExpression(0)}, {E: newExpression(1)}}}
+	require.NoError(t, mergeorder.Prepare(proc, orderArg))
+	arg := &Argument{
+		PartitionBy: []*plan.Expr{newExpression(0)},
+		OrderBy:     []order.Field{{E: newExpression(1)}},
+		Frame:       cumulativeFrame,
+		Funcs:       []Function{{Kind: RowNumber}},
+	}
+	require.NoError(t, Prepare(proc, arg))
+	rows := 0
+	for {
+		end, err := mergeorder.Call(0, proc, orderArg)
+		require.NoError(t, err)
+		_, err = Call(1, proc, arg)
+		require.NoError(t, err)
+		if bat := proc.Reg.InputBatch; bat != nil && len(bat.Zs) > 0 {
+			for _, v := range vector.MustTCols[int64](bat.Vecs[2]) {
+				require.Equal(t, int64(rows%partRows+1), v, "row %d", rows)
+				rows++
+			}
+			bat.Clean(proc.Mp)
+		}
+		if end {
+			break
+		}
+	}
+	require.Equal(t, n, rows)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
+func newTestCase(m *mheap.Mheap, frame Frame, funcs []Function, expects [][]float64, nsps [][]uint64) windowTestCase {
+	return windowTestCase{
+		proc: process.New(m),
+		arg: &Argument{
+			PartitionBy: []*plan.Expr{newExpression(0)},
+			OrderBy:     []order.Field{{E: newExpression(1)}},
+			Frame:       frame,
+			Funcs:       funcs,
+		},
+		expects: expects,
+		nsps:    nsps,
+	}
+}
+
+func newExpression(pos int32) *plan.Expr {
+	return &plan.Expr{
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				ColPos: pos,
+			},
+		},
+	}
+}
+
+// newBatch returns the batch of the rows [start, end)
+func newBatch(proc *process.Process, start, end int) *batch.Batch {
+	return makeBatch(proc, as[start:end], bs[start:end])
+}
+
+func makeBatch(proc *process.Process, as []int64, bs []float64) *batch.Batch {
+	flags := make([]uint8, len(as))
+	for i := range flags {
+		flags[i] = 1
+	}
+	bat := batch.NewWithSize(2)
+	for i, vec := range []*vector.Vector{
+		testutil.MakeInt64Vector(as, nil),
+		testutil.MakeFloat64Vector(bs, nil),
+	} {
+		bat.Vecs[i] = vector.New(vec.Typ)
+		if err := vector.UnionBatch(bat.Vecs[i], vec, 0, len(flags), flags, proc.Mp); err != nil {
+			panic(err)
+		}
+	}
+	bat.Zs = make([]int64, len(as))
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+	return bat
+}
+
+func toFloat64(col interface{}, i int) float64 {
+	switch vs := col.(type) {
+	case []int64:
+		return float64(vs[i])
+	case []float64:
+		return vs[i]
+	}
+	return 0
+}
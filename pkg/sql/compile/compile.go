@@ -25,9 +25,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/insert"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/merge"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
@@ -61,6 +63,13 @@ func (c *Compile) Compile(pn *plan.Plan, u interface{}, fill func(interface{}, *
 	}()
 	c.u = u
 	c.fill = fill
+	// the privileges are checked here only, since the plan may be
+	// built long before, e.g. by PREPARE
+	if checker, ok := u.(plan2.PrivilegeChecker); ok {
+		if err = checker.CheckPrivileges(plan2.GetRequiredPrivileges(pn)); err != nil {
+			return err
+		}
+	}
 	// build scope for a single sql
 	s, err := c.compileScope(pn)
 	if err != nil {
@@ -78,6 +87,10 @@ func (c *Compile) GetAffectedRows() uint64 {
 	return c.affectRows
 }
 
+func (c *Compile) GetLastInsertID() uint64 {
+	return c.lastInsertID
+}
+
 // Run is an important function of the compute-layer, it executes a single sql according to its scope
 func (c *Compile) Run(ts uint64) (err error) {
 	if c.scope == nil {
@@ -101,6 +114,8 @@ func (c *Compile) Run(ts uint64) (err error) {
 		return c.scope.CreateTable(ts, c.proc.Snapshot, c.e, c.db)
 	case DropTable:
 		return c.scope.DropTable(ts, c.proc.Snapshot, c.e)
+	case AlterTable:
+		return c.scope.AlterTable(ts, c.proc.Snapshot, c.e)
 	case CreateIndex:
 		return c.scope.CreateIndex(ts, c.proc.Snapshot, c.e)
 	case DropIndex:
@@ -118,6 +133,7 @@ func (c *Compile) Run(ts uint64) (err error) {
 			return err
 		}
 		c.setAffectedRows(affectedRows)
+		c.lastInsertID = c.scope.Instructions[len(c.scope.Instructions)-1].Arg.(*insert.Argument).LastInsertID
 		return nil
 	case Update:
 		affectedRows, err := c.scope.Update(ts, c.proc.Snapshot, c.e)
@@ -146,15 +162,23 @@ func (c *Compile) compileScope(pn *plan.Plan) (*Scope, error) {
 				Magic: DropDatabase,
 				Plan:  pn,
 			}, nil
-		case plan.DataDefinition_CREATE_TABLE:
+		case plan.DataDefinition_CREATE_TABLE, plan.DataDefinition_CREATE_VIEW:
 			return &Scope{
 				Magic: CreateTable,
 				Plan:  pn,
+				Proc:  c.proc,
 			}, nil
-		case plan.DataDefinition_DROP_TABLE:
+		case plan.DataDefinition_DROP_TABLE, plan.DataDefinition_DROP_VIEW:
 			return &Scope{
 				Magic: DropTable,
 				Plan:  pn,
+				Proc:  c.proc,
+			}, nil
+		case plan.DataDefinition_ALTER_TABLE:
+			return &Scope{
+				Magic: AlterTable,
+				Plan:  pn,
+				Proc:  c.proc,
 			}, nil
 		case plan.DataDefinition_CREATE_INDEX:
 			return &Scope{
@@ -169,7 +193,8 @@ func (c *Compile) compileScope(pn *plan.Plan) (*Scope, error) {
 		case plan.DataDefinition_SHOW_DATABASES,
 			plan.DataDefinition_SHOW_TABLES,
 			plan.DataDefinition_SHOW_COLUMNS,
-			plan.DataDefinition_SHOW_CREATETABLE:
+			plan.DataDefinition_SHOW_CREATETABLE,
+			plan.DataDefinition_SHOW_CREATEVIEW:
 			return c.compileQuery(pn.GetDdl().GetQuery())
 			// 1、not supported: show arnings/errors/status/processlist
 			// 2、show variables will not return query
@@ -219,7 +244,7 @@ func (c *Compile) compileQuery(qry *plan.Query) (*Scope, error) {
 	})
 	switch qry.StmtType {
 	case plan.Query_DELETE:
-		scp, err := constructDeletion(qry.Nodes[qry.Steps[0]], c.e, c.proc.Snapshot)
+		scp, err := constructDeletion(qry.Nodes[qry.Steps[0]], c.e, c.proc)
 		if err != nil {
 			return nil, err
 		}
@@ -228,7 +253,7 @@ func (c *Compile) compileQuery(qry *plan.Query) (*Scope, error) {
 			Arg: scp,
 		})
 	case plan.Query_INSERT:
-		arg, err := constructInsert(qry.Nodes[qry.Steps[0]], c.e, c.proc.Snapshot)
+		arg, err := constructInsert(qry.Nodes[qry.Steps[0]], c.e, c.proc)
 		if err != nil {
 			return nil, err
 		}
@@ -237,7 +262,7 @@ func (c *Compile) compileQuery(qry *plan.Query) (*Scope, error) {
 			Arg: arg,
 		})
 	case plan.Query_UPDATE:
-		scp, err := constructUpdate(qry.Nodes[qry.Steps[0]], c.e, c.proc.Snapshot)
+		scp, err := constructUpdate(qry.Nodes[qry.Steps[0]], c.e, c.proc)
 		if err != nil {
 			return nil, err
 		}
@@ -289,30 +314,46 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 		if err != nil {
 			return nil, err
 		}
-		rel, err := db.Relation(n.TableDef.Name, snap)
-		if err != nil {
-			return nil, err
+		attrs := make([]string, len(n.TableDef.Cols))
+		for i, col := range n.TableDef.Cols {
+			attrs[i] = col.Name
 		}
-		src := &Source{
-			RelationName: n.TableDef.Name,
-			SchemaName:   n.ObjRef.SchemaName,
-			Attributes:   make([]string, len(n.TableDef.Cols)),
+		fullText := compileFullTextSearches(n, attrs)
+		// a partitioned table is read from the relations of the partitions
+		// left by the pruning. The full-text searches read all of them, the
+		// relevance depends on the words of the whole table
+		names := []string{n.TableDef.Name}
+		if len(n.Partitions) > 0 && len(fullText) == 0 {
+			names = make([]string, len(n.Partitions))
+			for i, part := range n.Partitions {
+				names[i] = engine.PartitionTableName(n.TableDef.Name, part)
+			}
 		}
-		for i, col := range n.TableDef.Cols {
-			src.Attributes[i] = col.Name
-		}
-		nodes := rel.Nodes(snap)
-		if len(nodes) == 0 {
-			nodes = make([]engine.Node, 1)
-		}
-		ss := make([]*Scope, len(nodes))
-		for i := range nodes {
-			ss[i] = &Scope{
-				DataSource: src,
-				Magic:      Remote,
-				NodeInfo:   nodes[i],
+		var ss []*Scope
+		for _, name := range names {
+			rel, err := db.Relation(name, snap)
+			if err != nil {
+				return nil, err
+			}
+			src := &Source{
+				RelationName: name,
+				SchemaName:   n.ObjRef.SchemaName,
+				Attributes:   attrs,
+				FullText:     fullText,
+			}
+			nodes := rel.Nodes(snap)
+			if len(nodes) == 0 {
+				nodes = make([]engine.Node, 1)
+			}
+			for i := range nodes {
+				s := &Scope{
+					DataSource: src,
+					Magic:      Remote,
+					NodeInfo:   nodes[i],
+				}
+				s.Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, 0)
+				ss = append(ss, s)
 			}
-			ss[i].Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, 0)
 		}
 		return c.compileSort(n, c.compileProjection(n, c.compileRestrict(n, ss))), nil
 	case plan.Node_FILTER:
@@ -350,6 +391,63 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 			return c.compileSort(n, c.compileJoin(n, children, ss, joinTyp)), nil
 		}
 		return c.compileSort(n, c.compileJoin(n, ss, children, joinTyp)), nil
+	case plan.Node_WINDOW:
+		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
+		if err != nil {
+			return nil, err
+		}
+		ss = c.compileWindow(n, ss)
+		rewriteExprListForWindowNode(n.FilterList, int32(len(ns[n.Children[0]].ProjectList)))
+		rewriteExprListForWindowNode(n.ProjectList, int32(len(ns[n.Children[0]].ProjectList)))
+		return c.compileProjection(n, c.compileRestrict(n, ss)), nil
+	case plan.Node_RECURSIVE_CTE:
+		ss, err := c.compileRecursiveCTE(n, ns)
+		if err != nil {
+			return nil, err
+		}
+		return c.compileProjection(n, c.compileRestrict(n, ss)), nil
+	case plan.Node_SINK_SCAN:
+		if c.sinkBat == nil {
+			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("query '%s' not support now", n))
+		}
+		bat := c.sinkBat
+		if c.sinkRead {
+			var err error
+			if bat, err = copySinkBatch(c.sinkBat, c.proc); err != nil {
+				return nil, err
+			}
+			c.sinkCopies = append(c.sinkCopies, bat)
+		}
+		c.sinkRead = true
+		ds := &Scope{Magic: Normal}
+		ds.Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, 0)
+		ds.DataSource = &Source{Bat: bat}
+		return c.compileProjection(n, c.compileRestrict(n, []*Scope{ds})), nil
+	case plan.Node_UNION, plan.Node_UNION_ALL:
+		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
+		if err != nil {
+			return nil, err
+		}
+		children, err := c.compilePlanScope(ns[n.Children[1]], ns)
+		if err != nil {
+			return nil, err
+		}
+		ss = append(ss, children...)
+		if n.NodeType == plan.Node_UNION {
+			ss = c.compileUnion(n, ss)
+		}
+		return c.compileProjection(n, c.compileRestrict(n, ss)), nil
+	case plan.Node_INTERSECT, plan.Node_INTERSECT_ALL, plan.Node_MINUS, plan.Node_MINUS_ALL:
+		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
+		if err != nil {
+			return nil, err
+		}
+		children, err := c.compilePlanScope(ns[n.Children[1]], ns)
+		if err != nil {
+			return nil, err
+		}
+		ss = c.compileMinusAndIntersect(n, ss, children)
+		return c.compileProjection(n, c.compileRestrict(n, ss)), nil
 	case plan.Node_SORT:
 		ss, err := c.compilePlanScope(ns[n.Children[0]], ns)
 		if err != nil {
@@ -383,6 +481,27 @@ func (c *Compile) compilePlanScope(n *plan.Node, ns []*plan.Node) ([]*Scope, err
 	}
 }
 
+// compileFullTextSearches returns the full-text searches of a scan whose
+// relevance columns are read
+func compileFullTextSearches(n *plan.Node, attrs []string) []engine.FullTextSearch {
+	var searches []engine.FullTextSearch
+	for _, ft := range n.FullText {
+		for _, attr := range attrs {
+			if attr == ft.Attr {
+				searches = append(searches, engine.FullTextSearch{
+					Attr:    ft.Attr,
+					Cols:    ft.ColNames,
+					Query:   ft.Query,
+					Boolean: ft.Mode == plan.FullTextScan_BOOLEAN,
+					Filter:  ft.Filter,
+				})
+				break
+			}
+		}
+	}
+	return searches
+}
+
 func (c *Compile) compileRestrict(n *plan.Node, ss []*Scope) []*Scope {
 	if len(n.FilterList) == 0 {
 		return ss
@@ -517,6 +636,34 @@ func (c *Compile) compileJoin(n *plan.Node, ss []*Scope, children []*Scope, join
 				})
 			}
 		}
+	case plan.Node_SINGLE:
+		for i := range rs {
+			if isEq {
+				rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
+					Op:  vm.Single,
+					Arg: constructSingle(n, c.proc),
+				})
+			} else {
+				rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
+					Op:  vm.LoopSingle,
+					Arg: constructLoopSingle(n, c.proc),
+				})
+			}
+		}
+	case plan.Node_MARK:
+		for i := range rs {
+			if isEq && len(n.OnList) > 0 {
+				rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
+					Op:  vm.Mark,
+					Arg: constructMark(n, c.proc),
+				})
+			} else {
+				rs[i].Instructions = append(rs[i].Instructions, vm.Instruction{
+					Op:  vm.LoopMark,
+					Arg: constructLoopMark(n, c.proc),
+				})
+			}
+		}
 	default:
 		panic(errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("join typ '%v' not support now", n.JoinType)))
 	}
@@ -654,6 +801,253 @@ func (c *Compile) compileLimit(n *plan.Node, ss []*Scope) []*Scope {
 	return []*Scope{rs}
 }
 
+// compileWindow sorts the rows by the partition keys and the order keys,
+// and computes the window functions over all the sorted rows in a merge scope.
+func (c *Compile) compileWindow(n *plan.Node, ss []*Scope) []*Scope {
+	if len(n.WinSpec.PartitionBy)+len(n.WinSpec.OrderBy) > 0 {
+		for i := range ss {
+			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+				Op:  vm.Order,
+				Arg: constructWindowOrder(n),
+			})
+		}
+	}
+	rs := &Scope{
+		PreScopes: ss,
+		Magic:     Merge,
+	}
+	rs.Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, len(ss))
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Op:  vm.MergeOrder,
+		Arg: constructWindowMergeOrder(n),
+	})
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Op:  vm.Window,
+		Arg: constructWindow(n, c.proc),
+	})
+
+	for i := range ss {
+		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+			Op: vm.Connector,
+			Arg: &connector.Argument{
+				Mmu: rs.Proc.Mp.Gm,
+				Reg: rs.Proc.Reg.MergeReceivers[i],
+			},
+		})
+	}
+	return []*Scope{rs}
+}
+
+// compileUnion removes the duplicate rows of each scope, and then removes
+// the duplicate rows of all the scopes in a merge scope.
+func (c *Compile) compileUnion(n *plan.Node, ss []*Scope) []*Scope {
+	for i := range ss {
+		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+			Op:  vm.Union,
+			Arg: constructUnion(n),
+		})
+	}
+	rs := &Scope{
+		PreScopes: ss,
+		Magic:     Merge,
+	}
+	rs.Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, len(ss))
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Op:  vm.Merge,
+		Arg: &merge.Argument{},
+	})
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Op:  vm.Union,
+		Arg: constructUnion(n),
+	})
+
+	for i := range ss {
+		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+			Op: vm.Connector,
+			Arg: &connector.Argument{
+				Mmu: rs.Proc.Mp.Gm,
+				Reg: rs.Proc.Reg.MergeReceivers[i],
+			},
+		})
+	}
+	return []*Scope{rs}
+}
+
+// compileMinusAndIntersect merges the scopes of the left relation and the scopes
+// of the right relation, and then computes INTERSECT or EXCEPT in a merge scope
+// whose first receiver is the left relation and second receiver is the right one.
+// The duplicate rows are removed by each scope first for the distinct variants.
+func (c *Compile) compileMinusAndIntersect(n *plan.Node, ss []*Scope, children []*Scope) []*Scope {
+	if n.NodeType == plan.Node_INTERSECT || n.NodeType == plan.Node_MINUS {
+		for _, s := range [][]*Scope{ss, children} {
+			for i := range s {
+				s[i].Instructions = append(s[i].Instructions, vm.Instruction{
+					Op:  vm.Union,
+					Arg: constructUnion(n),
+				})
+			}
+		}
+	}
+	rs := &Scope{
+		PreScopes: []*Scope{c.newMergeScope(ss), c.newMergeScope(children)},
+		Magic:     Merge,
+	}
+	rs.Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, 2)
+	switch n.NodeType {
+	case plan.Node_INTERSECT, plan.Node_INTERSECT_ALL:
+		rs.Instructions = append(rs.Instructions, vm.Instruction{
+			Op:  vm.Intersect,
+			Arg: constructIntersect(n),
+		})
+	default:
+		rs.Instructions = append(rs.Instructions, vm.Instruction{
+			Op:  vm.Minus,
+			Arg: constructMinus(n),
+		})
+	}
+
+	for i := range rs.PreScopes {
+		rs.PreScopes[i].Instructions = append(rs.PreScopes[i].Instructions, vm.Instruction{
+			Op: vm.Connector,
+			Arg: &connector.Argument{
+				Mmu: rs.Proc.Mp.Gm,
+				Reg: rs.Proc.Reg.MergeReceivers[i],
+			},
+		})
+	}
+	return []*Scope{rs}
+}
+
+// newMergeScope returns a scope which merges the rows of all the scopes.
+func (c *Compile) newMergeScope(ss []*Scope) *Scope {
+	if len(ss) == 1 {
+		return ss[0]
+	}
+	rs := &Scope{
+		PreScopes: ss,
+		Magic:     Merge,
+	}
+	rs.Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, len(ss))
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Op:  vm.Merge,
+		Arg: &merge.Argument{},
+	})
+
+	for i := range ss {
+		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+			Op: vm.Connector,
+			Arg: &connector.Argument{
+				Mmu: rs.Proc.Mp.Gm,
+				Reg: rs.Proc.Reg.MergeReceivers[i],
+			},
+		})
+	}
+	return rs
+}
+
+// compileRecursiveCTE runs the anchor of the recursive cte once, and then runs the
+// recursive part over the rows produced by the last iteration until no new rows are produced.
+func (c *Compile) compileRecursiveCTE(n *plan.Node, ns []*plan.Node) ([]*Scope, error) {
+	union := ns[n.Children[0]]
+	ss, err := c.compilePlanScope(ns[union.Children[0]], ns)
+	if err != nil {
+		return nil, err
+	}
+	rs := &Scope{
+		PreScopes: ss,
+		Magic:     Merge,
+	}
+	rs.Proc = process.NewFromProc(mheap.New(c.proc.Mp.Gm), c.proc, len(ss))
+	rs.Instructions = append(rs.Instructions, vm.Instruction{
+		Op:  vm.Fixpoint,
+		Arg: constructFixpoint(n, union, c.proc, c.iterateRecursiveCTE(union.Children[1], ns)),
+	})
+
+	for i := range ss {
+		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+			Op: vm.Connector,
+			Arg: &connector.Argument{
+				Mmu: rs.Proc.Mp.Gm,
+				Reg: rs.Proc.Reg.MergeReceivers[i],
+			},
+		})
+	}
+	return []*Scope{rs}, nil
+}
+
+// iterateRecursiveCTE returns a function that compiles and runs the recursive part of
+// the recursive cte over the rows of the last iteration. The nodes are copied for each
+// iteration because compiling rewrites the expressions of the nodes.
+func (c *Compile) iterateRecursiveCTE(id int32, ns []*plan.Node) func(*batch.Batch, *process.Process, func(*batch.Batch) error) error {
+	return func(bat *batch.Batch, proc *process.Process, fill func(*batch.Batch) error) error {
+		nodes := make([]*plan.Node, len(ns))
+		for i := range ns {
+			nodes[i] = plan2.DeepCopyNode(ns[i])
+		}
+		ic := &Compile{
+			e:       c.e,
+			db:      c.db,
+			uid:     c.uid,
+			sql:     c.sql,
+			proc:    proc,
+			sinkBat: bat,
+		}
+		// the copies which are filtered out entirely are not cleaned by the operators
+		defer func() {
+			for _, bat := range ic.sinkCopies {
+				bat.Clean(proc.Mp)
+			}
+		}()
+		ss, err := ic.compilePlanScope(nodes[id], nodes)
+		if err != nil {
+			return err
+		}
+		rs := &Scope{
+			PreScopes: ss,
+			Magic:     Merge,
+		}
+		rs.Proc = process.NewFromProc(mheap.New(proc.Mp.Gm), proc, len(ss))
+		rs.Instructions = append(rs.Instructions, vm.Instruction{
+			Op:  vm.Merge,
+			Arg: &merge.Argument{},
+		})
+		rs.Instructions = append(rs.Instructions, vm.Instruction{
+			Op: vm.Output,
+			Arg: &output.Argument{
+				Func: func(_ interface{}, b *batch.Batch) error {
+					return fill(b)
+				},
+			},
+		})
+
+		for i := range ss {
+			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
+				Op: vm.Connector,
+				Arg: &connector.Argument{
+					Mmu: rs.Proc.Mp.Gm,
+					Reg: rs.Proc.Reg.MergeReceivers[i],
+				},
+			})
+		}
+		return rs.MergeRun(c.e)
+	}
+}
+
+func copySinkBatch(bat *batch.Batch, proc *process.Process) (*batch.Batch, error) {
+	rbat := batch.NewWithSize(len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		v, err := vector.Dup(vec, proc.Mp)
+		if err != nil {
+			rbat.Clean(proc.Mp)
+			return nil, err
+		}
+		rbat.Vecs[i] = v
+	}
+	rbat.Zs = make([]int64, len(bat.Zs))
+	copy(rbat.Zs, bat.Zs)
+	return rbat, nil
+}
+
 func (c *Compile) compileGroup(n *plan.Node, ss []*Scope, ns []*plan.Node) []*Scope {
 	for i := range ss {
 		ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
@@ -689,6 +1083,29 @@ func rewriteExprListForAggNode(es []*plan.Expr, groupSize int32) {
 	}
 }
 
+func rewriteExprListForWindowNode(es []*plan.Expr, childSize int32) {
+	for i := range es {
+		rewriteExprForWindowNode(es[i], childSize)
+	}
+}
+
+// rewriteExprForWindowNode rewrites the references of the window functions,
+// whose results are appended after the columns of the child.
+func rewriteExprForWindowNode(expr *plan.Expr, childSize int32) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		if e.Col.RelPos == -1 {
+			e.Col.ColPos += childSize
+		}
+	case *plan.Expr_F:
+		for i := range e.F.Args {
+			rewriteExprForWindowNode(e.F.Args[i], childSize)
+		}
+	default:
+		return
+	}
+}
+
 func rewriteExprForAggNode(expr *plan.Expr, groupSize int32) {
 	switch e := expr.Expr.(type) {
 	case *plan.Expr_Col:
@@ -714,6 +1131,10 @@ func joinType(n *plan.Node, ns []*plan.Node) (bool, plan.Node_JoinFlag) {
 		return false, plan.Node_SEMI
 	case plan.Node_ANTI:
 		return false, plan.Node_ANTI
+	case plan.Node_SINGLE:
+		return false, plan.Node_SINGLE
+	case plan.Node_MARK:
+		return false, plan.Node_MARK
 	case plan.Node_RIGHT:
 		return true, plan.Node_LEFT
 	default:
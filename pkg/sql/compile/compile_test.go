@@ -15,9 +15,12 @@
 package compile
 
 import (
+	"sort"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/incrservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
@@ -54,6 +57,30 @@ func init() {
 		newTestCase("select * from R right join S on R.uid = S.uid", new(testing.T)),
 		newTestCase("select * from R join S on R.uid > S.uid", new(testing.T)),
 		newTestCase("insert into R select * from R", new(testing.T)),
+		newTestCase("select uid, row_number() over (partition by uid order by price desc), rank() over (order by uid) from R", new(testing.T)),
+		newTestCase("select uid, lag(price) over (order by price), sum(price) over (order by price rows between 1 preceding and 1 following) from R", new(testing.T)),
+		newTestCase("select uid, count(*) over (), avg(price) over (order by price range between 2 preceding and current row) from R", new(testing.T)),
+		newTestCase("with recursive c(n) as (select 1 union all select n + 1 from c where n < 5) select * from c", new(testing.T)),
+		newTestCase("with recursive c(n) as (select uid from R union select n + 1 from c where n < 10) select n from c", new(testing.T)),
+		newTestCase("with recursive c as (select uid from R union all select 2) select * from c", new(testing.T)),
+		newTestCase("select uid from R union select uid from S", new(testing.T)),
+		newTestCase("select cast(1.5 as decimal(10, 2)) union all select cast(1.2345 as decimal(10, 4)) union select cast(2 as decimal(20, 1))", new(testing.T)),
+		newTestCase("select uid from R union all select uid from S order by uid limit 5", new(testing.T)),
+		newTestCase("select uid from R intersect select uid from S", new(testing.T)),
+		newTestCase("select uid from R except all select uid from S", new(testing.T)),
+		newTestCase("select 1 union all select null except select 1", new(testing.T)),
+		newTestCase("select uid, count(*), grouping(uid) from R group by uid with rollup", new(testing.T)),
+		newTestCase("select uid, orderid, sum(price) from R group by cube(uid, orderid) having grouping(orderid) = 1", new(testing.T)),
+		newTestCase("select uid, orderid, count(*) from R group by grouping sets((uid, orderid), uid, ())", new(testing.T)),
+		newTestCase("select uid, group_concat(distinct orderid order by price desc separator ';'), median(price), percentile_disc(price, 0.9) from R group by uid", new(testing.T)),
+		newTestCase("select count(distinct uid, orderid), var_samp(price), stddev_samp(price), group_concat(uid, '-', orderid) from R", new(testing.T)),
+		newTestCase("select group_concat(null), group_concat(uid, null order by null) from R", new(testing.T)),
+		newTestCase("select uid, regexp_replace(orderid, '1', 'x'), regexp_instr(orderid, '1') from R where uid regexp '^[12]$' and orderid not rlike '^1'", new(testing.T)),
+		newTestCase("select upper(orderid), replace(orderid, '1', 'x'), locate('1', orderid), left(orderid, 2), trim(leading '0' from orderid), repeat(orderid, 2), hex(uid), char(uid + 64) from R where lower(orderid) <> 'x'", new(testing.T)),
+		newTestCase("select uid, (select max(price) from S where S.uid = R.uid) from R", new(testing.T)),
+		newTestCase("select uid, exists (select 1 from S where S.uid = R.uid) from R", new(testing.T)),
+		newTestCase("select uid from R where uid > 1 or uid in (select uid from S)", new(testing.T)),
+		newTestCase("select uid from R where price > (select max(price) from S where S.uid < R.uid)", new(testing.T)),
 	}
 }
 
@@ -95,3 +122,137 @@ func newTestCase(sql string, t *testing.T) compileTestCase {
 		},
 	}
 }
+
+func TestView(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	run := func(sql string) int {
+		stmts, err := mysql.Parse(sql)
+		require.NoError(t, err)
+		pn, err := plan2.BuildPlan(e.(*memEngine.MemEngine), stmts[0])
+		require.NoError(t, err)
+		rows := 0
+		c := New("test", sql, "", e, testutil.NewProcess())
+		err = c.Compile(pn, nil, func(_ interface{}, bat *batch.Batch) error {
+			if bat != nil {
+				rows += bat.Length()
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.NoError(t, c.Run(0))
+		return rows
+	}
+	n := run("select uid from R where uid > 1")
+	require.NotZero(t, n)
+	run("create view v (id) as select uid from R where uid > 1")
+	require.Equal(t, n, run("select id from v"))
+	require.NotZero(t, run("select a.id from v a join R on a.id = R.uid"))
+	run("create or replace view v as select uid, orderid from R")
+	require.Equal(t, run("select uid from R"), run("select uid from v"))
+	run("create view w as select uid from v where uid > 1")
+	require.Equal(t, n, run("select * from w"))
+}
+
+func TestRecursiveCTE(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	run := func(sql string) []int64 {
+		stmts, err := mysql.Parse(sql)
+		require.NoError(t, err)
+		pn, err := plan2.BuildPlan(e.(*memEngine.MemEngine), stmts[0])
+		require.NoError(t, err)
+		var rows []int64
+		c := New("test", sql, "", e, testutil.NewProcess())
+		err = c.Compile(pn, nil, func(_ interface{}, bat *batch.Batch) error {
+			if bat != nil {
+				rows = append(rows, vector.MustTCols[int64](bat.Vecs[0])...)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.NoError(t, c.Run(0))
+		sort.Slice(rows, func(i, j int) bool { return rows[i] < rows[j] })
+		return rows
+	}
+	// the recursive query blocks read the rows of the last iteration each
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 11, 12},
+		run("with recursive c(n) as (select 1 union all select n + 1 from c where n < 5 union all select n + 10 from c where n < 3) select * from c"))
+	require.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7},
+		run("with recursive c(n) as (select 1 union all select 2 union all select n + 2 from c where n < 6) select * from c"))
+}
+
+func TestScalarSubquery(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	run := func(sql string) (int, error) {
+		stmts, err := mysql.Parse(sql)
+		require.NoError(t, err)
+		pn, err := plan2.BuildPlan(e.(*memEngine.MemEngine), stmts[0])
+		require.NoError(t, err)
+		rows := 0
+		c := New("test", sql, "", e, testutil.NewProcess())
+		err = c.Compile(pn, nil, func(_ interface{}, bat *batch.Batch) error {
+			if bat != nil {
+				rows += bat.Length()
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		return rows, c.Run(0)
+	}
+	n, err := run("select uid from R")
+	require.NoError(t, err)
+	for _, sql := range []string{
+		"select uid, (select max(price) from S where S.uid = R.uid) from R",
+		"select uid, (select count(*) from S where S.uid = R.uid) from R",
+		"select uid, exists (select 1 from S where S.uid = R.uid) from R",
+		"select uid, uid not in (select uid from S) from R",
+	} {
+		rows, err := run(sql)
+		require.NoError(t, err)
+		require.Equal(t, n, rows)
+	}
+	// S holds more than one row of some uids
+	_, err = run("select uid, (select price from S where S.uid = R.uid) from R")
+	require.Error(t, err)
+}
+
+func TestAutoIncrement(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	proc := testutil.NewProcess()
+	store := incrservice.NewMemoryStore()
+	proc.IncrService = incrservice.New(store, 0)
+	run := func(sql string) (*Compile, []int64) {
+		stmts, err := mysql.Parse(sql)
+		require.NoError(t, err)
+		pn, err := plan2.BuildPlan(e.(*memEngine.MemEngine), stmts[0])
+		require.NoError(t, err)
+		var ids []int64
+		c := New("test", sql, "", e, proc)
+		err = c.Compile(pn, nil, func(_ interface{}, bat *batch.Batch) error {
+			if bat != nil {
+				ids = append(ids, vector.MustTCols[int64](bat.Vecs[0])...)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.NoError(t, c.Run(0))
+		return c, ids
+	}
+	run("create table t_auto (id bigint auto_increment, uid int) auto_increment = 10")
+	c, _ := run("insert into t_auto (uid) select uid from R where uid < 2")
+	require.NotZero(t, c.GetAffectedRows())
+	require.Equal(t, uint64(10), c.GetLastInsertID())
+	_, ids := run("select id from t_auto")
+	require.Len(t, ids, int(c.GetAffectedRows()))
+	for i, id := range ids {
+		require.Equal(t, int64(10+i), id)
+	}
+	// the offset of the column is removed with the table
+	key := incrservice.Key("test", "t_auto", "id")
+	offset, err := store.Allocate(key, 0, 0)
+	require.NoError(t, err)
+	require.NotZero(t, offset)
+	run("drop table t_auto")
+	offset, err = store.Allocate(key, 0, 0)
+	require.NoError(t, err)
+	require.Zero(t, offset)
+}
@@ -21,10 +21,13 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopcomplement"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopjoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopleft"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopmark"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopsemi"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopsingle"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/update"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/deletion"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/foreignkey"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/insert"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 
@@ -37,15 +40,19 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/complement"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/dispatch"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/fixpoint"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/group"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/intersect"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/join"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/left"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/limit"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mark"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergegroup"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergelimit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeoffset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeorder"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergetop"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/minus"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/offset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
@@ -53,7 +60,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/semi"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/single"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/union"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/vm"
@@ -99,6 +109,17 @@ func dupInstruction(in vm.Instruction) vm.Instruction {
 			Result:     arg.Result,
 			Conditions: copyCondition(arg.Conditions),
 		}
+	case *single.Argument:
+		rin.Arg = &single.Argument{
+			Result:     arg.Result,
+			Conditions: copyCondition(arg.Conditions),
+		}
+	case *mark.Argument:
+		rin.Arg = &mark.Argument{
+			Result:     arg.Result,
+			Conditions: copyCondition(arg.Conditions),
+			Mark:       arg.Mark,
+		}
 	case *product.Argument:
 		rin.Arg = &product.Argument{
 			Result: arg.Result,
@@ -150,6 +171,40 @@ func dupInstruction(in vm.Instruction) vm.Instruction {
 			Cond:   arg.Cond,
 			Result: arg.Result,
 		}
+	case *loopsingle.Argument:
+		rin.Arg = &loopsingle.Argument{
+			Cond:   arg.Cond,
+			Result: arg.Result,
+		}
+	case *loopmark.Argument:
+		rin.Arg = &loopmark.Argument{
+			Cond:   arg.Cond,
+			Mark:   arg.Mark,
+			Result: arg.Result,
+		}
+	case *window.Argument:
+		rin.Arg = &window.Argument{
+			PartitionBy: arg.PartitionBy,
+			OrderBy:     arg.OrderBy,
+			Frame:       arg.Frame,
+			Funcs:       arg.Funcs,
+		}
+	case *fixpoint.Argument:
+		rin.Arg = &fixpoint.Argument{
+			Distinct: arg.Distinct,
+			MaxDepth: arg.MaxDepth,
+			Iterate:  arg.Iterate,
+		}
+	case *union.Argument:
+		rin.Arg = &union.Argument{}
+	case *intersect.Argument:
+		rin.Arg = &intersect.Argument{
+			All: arg.All,
+		}
+	case *minus.Argument:
+		rin.Arg = &minus.Argument{
+			All: arg.All,
+		}
 	case *dispatch.Argument:
 	case *connector.Argument:
 	default:
@@ -164,22 +219,24 @@ func constructRestrict(n *plan.Node) *restrict.Argument {
 	}
 }
 
-func constructDeletion(n *plan.Node, eg engine.Engine, snapshot engine.Snapshot) (*deletion.Argument, error) {
+func constructDeletion(n *plan.Node, eg engine.Engine, proc *process.Process) (*deletion.Argument, error) {
 	count := len(n.DeleteTablesCtx)
 	ds := make([]*deletion.DeleteCtx, count)
 	for i := 0; i < count; i++ {
 
-		dbSource, err := eg.Database(n.DeleteTablesCtx[i].DbName, snapshot)
+		dbSource, err := eg.Database(n.DeleteTablesCtx[i].DbName, proc.Snapshot)
 		if err != nil {
 			return nil, err
 		}
-		relation, err := dbSource.Relation(n.DeleteTablesCtx[i].TblName, snapshot)
+		relation, err := dbSource.Relation(n.DeleteTablesCtx[i].TblName, proc.Snapshot)
 		if err != nil {
 			return nil, err
 		}
 
 		ds[i] = &deletion.DeleteCtx{
 			TableSource:  relation,
+			TableName:    n.DeleteTablesCtx[i].TblName,
+			ForeignKeys:  newForeignKeyChecker(dbSource, n.DeleteTablesCtx[i].DbName, proc),
 			UseDeleteKey: n.DeleteTablesCtx[i].UseDeleteKey,
 			CanTruncate:  n.DeleteTablesCtx[i].CanTruncate,
 		}
@@ -190,30 +247,42 @@ func constructDeletion(n *plan.Node, eg engine.Engine, snapshot engine.Snapshot)
 	}, nil
 }
 
-func constructInsert(n *plan.Node, eg engine.Engine, snapshot engine.Snapshot) (*insert.Argument, error) {
-	db, err := eg.Database(n.ObjRef.SchemaName, snapshot)
+func constructInsert(n *plan.Node, eg engine.Engine, proc *process.Process) (*insert.Argument, error) {
+	db, err := eg.Database(n.ObjRef.SchemaName, proc.Snapshot)
 	if err != nil {
 		return nil, err
 	}
-	relation, err := db.Relation(n.TableDef.Name, snapshot)
+	relation, err := db.Relation(n.TableDef.Name, proc.Snapshot)
 	if err != nil {
 		return nil, err
 	}
 	return &insert.Argument{
 		TargetTable:   relation,
 		TargetColDefs: n.TableDef.Cols,
+		DbName:        n.ObjRef.SchemaName,
+		TableName:     n.TableDef.Name,
+		Checks:        checkNames(n.TableDef),
+		ForeignKeys:   newForeignKeyChecker(db, n.ObjRef.SchemaName, proc),
 	}, nil
 }
 
-func constructUpdate(n *plan.Node, eg engine.Engine, snapshot engine.Snapshot) (*update.Argument, error) {
-	dbSource, err := eg.Database(n.ObjRef.SchemaName, snapshot)
+func constructUpdate(n *plan.Node, eg engine.Engine, proc *process.Process) (*update.Argument, error) {
+	dbSource, err := eg.Database(n.ObjRef.SchemaName, proc.Snapshot)
 	if err != nil {
 		return nil, err
 	}
-	relation, err := dbSource.Relation(n.TableDef.Name, snapshot)
+	relation, err := dbSource.Relation(n.TableDef.Name, proc.Snapshot)
 	if err != nil {
 		return nil, err
 	}
+	notNull := make([]bool, len(n.UpdateInfo.UpdateAttrs))
+	for i, attr := range n.UpdateInfo.UpdateAttrs {
+		for _, col := range n.TableDef.Cols {
+			if col.Name == attr {
+				notNull[i] = col.Primary || col.NotNull
+			}
+		}
+	}
 	return &update.Argument{
 		TableSource: relation,
 		PriKey:      n.UpdateInfo.PriKey,
@@ -222,9 +291,34 @@ func constructUpdate(n *plan.Node, eg engine.Engine, snapshot engine.Snapshot) (
 		UpdateAttrs: n.UpdateInfo.UpdateAttrs,
 		OtherAttrs:  n.UpdateInfo.OtherAttrs,
 		AttrOrders:  n.UpdateInfo.AttrOrders,
+		NotNull:     notNull,
+		Checks:      checkNames(n.TableDef),
+		TableName:   n.TableDef.Name,
+		ForeignKeys: newForeignKeyChecker(dbSource, n.ObjRef.SchemaName, proc),
 	}, nil
 }
 
+// newForeignKeyChecker returns the checker of the foreign keys of the
+// database, or nil if the session turns foreign_key_checks off
+func newForeignKeyChecker(db engine.Database, dbName string, proc *process.Process) *foreignkey.Checker {
+	if proc.SessionInfo.NoForeignKeyChecks {
+		return nil
+	}
+	return foreignkey.New(db, dbName, proc.Snapshot)
+}
+
+// checkNames returns the names of the CHECK constraints of the table, the
+// results of the constraints are projected in the same order
+func checkNames(tableDef *plan.TableDef) []string {
+	var checks []string
+	for _, def := range tableDef.Defs {
+		if check := def.GetCheck(); check != nil {
+			checks = append(checks, check.Name)
+		}
+	}
+	return checks
+}
+
 func constructProjection(n *plan.Node) *projection.Argument {
 	return &projection.Argument{
 		Es: n.ProjectList,
@@ -313,6 +407,47 @@ func constructLeft(n *plan.Node, proc *process.Process) *left.Argument {
 	}
 }
 
+func constructSingle(n *plan.Node, proc *process.Process) *single.Argument {
+	result := make([]single.ResultPos, len(n.ProjectList))
+	for i, expr := range n.ProjectList {
+		result[i].Rel, result[i].Pos = constructJoinResult(expr)
+	}
+	conds := make([][]joincondition.Condition, 2)
+	{
+		conds[0] = make([]joincondition.Condition, len(n.OnList))
+		conds[1] = make([]joincondition.Condition, len(n.OnList))
+	}
+	for i, expr := range n.OnList {
+		conds[0][i].Expr, conds[1][i].Expr = constructJoinCondition(expr)
+	}
+	return &single.Argument{
+		Result:     result,
+		Conditions: conds,
+	}
+}
+
+// constructMark builds the hash mark join of a subquery predicate whose
+// OnList is equi-conditions
+func constructMark(n *plan.Node, proc *process.Process) *mark.Argument {
+	result := make([]mark.ResultPos, len(n.ProjectList))
+	for i, expr := range n.ProjectList {
+		result[i].Rel, result[i].Pos = constructJoinResult(expr)
+	}
+	conds := make([][]joincondition.Condition, 2)
+	{
+		conds[0] = make([]joincondition.Condition, len(n.OnList))
+		conds[1] = make([]joincondition.Condition, len(n.OnList))
+	}
+	for i, expr := range n.OnList {
+		conds[0][i].Expr, conds[1][i].Expr = constructJoinCondition(expr)
+	}
+	return &mark.Argument{
+		Result:     result,
+		Conditions: conds,
+		Mark:       colexec.RewriteFilterExprList(n.FilterList),
+	}
+}
+
 func constructProduct(n *plan.Node, proc *process.Process) *product.Argument {
 	result := make([]product.ResultPos, len(n.ProjectList))
 	for i, expr := range n.ProjectList {
@@ -380,6 +515,23 @@ func constructLimit(n *plan.Node, proc *process.Process) *limit.Argument {
 	}
 }
 
+// constructAggregateConfig returns the config of the aggregate from its constant args.
+func constructAggregateConfig(op int, args []*plan.Expr) any {
+	switch op {
+	case aggregate.GroupConcat:
+		cfg := &aggregate.GroupConcatConfig{
+			Separator: args[1].Expr.(*plan.Expr_C).C.Value.(*plan.Const_Sval).Sval,
+		}
+		for _, arg := range args[2:] {
+			cfg.Descs = append(cfg.Descs, arg.Expr.(*plan.Expr_C).C.Value.(*plan.Const_Ival).Ival != 0)
+		}
+		return cfg
+	case aggregate.PercentileCont, aggregate.PercentileDisc:
+		return args[1].Expr.(*plan.Expr_C).C.Value.(*plan.Const_Dval).Dval
+	}
+	return nil
+}
+
 func constructGroup(n, cn *plan.Node) *group.Argument {
 	aggs := make([]aggregate.Aggregate, len(n.AggList))
 	for i, expr := range n.AggList {
@@ -391,9 +543,10 @@ func constructGroup(n, cn *plan.Node) *group.Argument {
 				panic(err)
 			}
 			aggs[i] = aggregate.Aggregate{
-				E:    f.F.Args[0],
-				Dist: distinct,
-				Op:   fun.AggregateInfo,
+				E:      f.F.Args[0],
+				Dist:   distinct,
+				Op:     fun.AggregateInfo,
+				Config: constructAggregateConfig(fun.AggregateInfo, f.F.Args),
 			}
 		}
 	}
@@ -405,13 +558,128 @@ func constructGroup(n, cn *plan.Node) *group.Argument {
 		typs[i].Scale = e.Typ.Scale
 		typs[i].Precision = e.Typ.Precision
 	}
+	var groupingSets [][]int32
+	if len(n.GroupingSet) > 0 {
+		groupingSets = make([][]int32, len(n.GroupingSet))
+		for i, set := range n.GroupingSet {
+			keys := set.Expr.(*plan.Expr_List).List.List
+			groupingSets[i] = make([]int32, len(keys))
+			for j, key := range keys {
+				groupingSets[i][j] = int32(key.Expr.(*plan.Expr_C).C.Value.(*plan.Const_Ival).Ival)
+			}
+		}
+	}
 	return &group.Argument{
-		Aggs:  aggs,
-		Types: typs,
-		Exprs: n.GroupBy,
+		Aggs:         aggs,
+		Types:        typs,
+		Exprs:        n.GroupBy,
+		GroupingSets: groupingSets,
+	}
+}
+
+func constructWindowOrder(n *plan.Node) *order.Argument {
+	return &order.Argument{
+		Fs: constructWindowSortFields(n),
 	}
 }
 
+func constructWindowMergeOrder(n *plan.Node) *mergeorder.Argument {
+	return &mergeorder.Argument{
+		Fs: constructWindowSortFields(n),
+	}
+}
+
+// constructWindowSortFields returns the partition keys and the order keys of the window,
+// the rows are sorted by them before the window functions are computed.
+func constructWindowSortFields(n *plan.Node) []order.Field {
+	fs := make([]order.Field, 0, len(n.WinSpec.PartitionBy)+len(n.WinSpec.OrderBy))
+	for _, e := range n.WinSpec.PartitionBy {
+		fs = append(fs, order.Field{E: e})
+	}
+	for _, e := range n.WinSpec.OrderBy {
+		f := order.Field{E: e.Expr}
+		if e.Flag == plan.OrderBySpec_DESC {
+			f.Type = order.Descending
+		}
+		fs = append(fs, f)
+	}
+	return fs
+}
+
+func constructWindow(n *plan.Node, proc *process.Process) *window.Argument {
+	funcs := make([]window.Function, len(n.AggList))
+	for i, expr := range n.AggList {
+		f := expr.Expr.(*plan.Expr_F)
+		funcs[i].Es = f.F.Args
+		fid, _ := function.DecodeOverloadID(f.F.Func.GetObj())
+		switch fid {
+		case function.ROW_NUMBER:
+			funcs[i].Kind = window.RowNumber
+		case function.RANK:
+			funcs[i].Kind = window.Rank
+		case function.DENSE_RANK:
+			funcs[i].Kind = window.DenseRank
+		case function.FIRST_VALUE:
+			funcs[i].Kind = window.FirstValue
+		case function.LAST_VALUE:
+			funcs[i].Kind = window.LastValue
+		case function.LAG, function.LEAD:
+			funcs[i].Kind = window.Lag
+			if fid == function.LEAD {
+				funcs[i].Kind = window.Lead
+			}
+			funcs[i].Offset = 1
+			if len(f.F.Args) > 1 {
+				funcs[i].Offset = constructWindowOffset(f.F.Args[1], proc)
+			}
+		default:
+			fun, err := function.GetFunctionByID(f.F.Func.GetObj())
+			if err != nil {
+				panic(err)
+			}
+			funcs[i].Kind = window.Aggregate
+			funcs[i].Op = fun.AggregateInfo
+		}
+	}
+	fs := make([]order.Field, len(n.WinSpec.OrderBy))
+	for i, e := range n.WinSpec.OrderBy {
+		fs[i].E = e.Expr
+		if e.Flag == plan.OrderBySpec_DESC {
+			fs[i].Type = order.Descending
+		}
+	}
+	frame := n.WinSpec.Frame
+	return &window.Argument{
+		PartitionBy: n.WinSpec.PartitionBy,
+		OrderBy:     fs,
+		Frame: window.Frame{
+			Type:  window.FrameType(frame.Type),
+			Start: constructWindowBound(frame.Start, proc),
+			End:   constructWindowBound(frame.End, proc),
+		},
+		Funcs: funcs,
+	}
+}
+
+func constructWindowBound(b *plan.FrameBound, proc *process.Process) window.Bound {
+	bound := window.Bound{
+		Type:      window.BoundType(b.Type),
+		Unbounded: b.Unbounded,
+	}
+	if b.Val != nil {
+		bound.Offset = constructWindowOffset(b.Val, proc)
+	}
+	return bound
+}
+
+func constructWindowOffset(e *plan.Expr, proc *process.Process) int64 {
+	vec, err := colexec.EvalExpr(constBat, proc, e)
+	if err != nil {
+		panic(err)
+	}
+	return vec.Col.([]int64)[0]
+}
+
 func constructMergeGroup(_ *plan.Node, needEval bool) *mergegroup.Argument {
 	return &mergegroup.Argument{
 		NeedEval: needEval,
@@ -456,6 +724,37 @@ func constructMergeLimit(n *plan.Node, proc *process.Process) *mergelimit.Argume
 	}
 }
 
+// constructFixpoint returns the argument of the recursive cte, the limit of
+// the node is the max number of iterations.
+func constructFixpoint(n *plan.Node, union *plan.Node, proc *process.Process,
+	iterate func(*batch.Batch, *process.Process, func(*batch.Batch) error) error) *fixpoint.Argument {
+	vec, err := colexec.EvalExpr(constBat, proc, n.Limit)
+	if err != nil {
+		panic(err)
+	}
+	return &fixpoint.Argument{
+		Distinct: union.NodeType == plan.Node_UNION,
+		MaxDepth: vec.Col.([]int64)[0],
+		Iterate:  iterate,
+	}
+}
+
+func constructUnion(_ *plan.Node) *union.Argument {
+	return &union.Argument{}
+}
+
+func constructIntersect(n *plan.Node) *intersect.Argument {
+	return &intersect.Argument{
+		All: n.NodeType == plan.Node_INTERSECT_ALL,
+	}
+}
+
+func constructMinus(n *plan.Node) *minus.Argument {
+	return &minus.Argument{
+		All: n.NodeType == plan.Node_MINUS_ALL,
+	}
+}
+
 func constructMergeOrder(n *plan.Node, proc *process.Process) *mergeorder.Argument {
 	fs := make([]order.Field, len(n.OrderBy))
 	for i, e := range n.OrderBy {
@@ -506,6 +805,32 @@ func constructLoopLeft(n *plan.Node, proc *process.Process) *loopleft.Argument {
 	}
 }
 
+func constructLoopSingle(n *plan.Node, proc *process.Process) *loopsingle.Argument {
+	result := make([]loopsingle.ResultPos, len(n.ProjectList))
+	for i, expr := range n.ProjectList {
+		result[i].Rel, result[i].Pos = constructJoinResult(expr)
+	}
+	return &loopsingle.Argument{
+		Result: result,
+		Cond:   colexec.RewriteFilterExprList(n.OnList),
+	}
+}
+
+// constructLoopMark builds the mark join of a subquery predicate, the
+// OnList of the node correlates the rows and its FilterList is the
+// comparison of a quantified subquery
+func constructLoopMark(n *plan.Node, proc *process.Process) *loopmark.Argument {
+	result := make([]loopmark.ResultPos, len(n.ProjectList))
+	for i, expr := range n.ProjectList {
+		result[i].Rel, result[i].Pos = constructJoinResult(expr)
+	}
+	return &loopmark.Argument{
+		Result: result,
+		Cond:   colexec.RewriteFilterExprList(n.OnList),
+		Mark:   colexec.RewriteFilterExprList(n.FilterList),
+	}
+}
+
 func constructLoopComplement(n *plan.Node, proc *process.Process) *loopcomplement.Argument {
 	result := make([]int32, len(n.ProjectList))
 	for i, expr := range n.ProjectList {
@@ -18,8 +18,10 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"strconv"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/deletion"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/foreignkey"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/insert"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/update"
 
@@ -28,6 +30,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/incrservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/dispatch"
@@ -43,6 +46,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
@@ -72,7 +76,7 @@ func (s *Scope) DropDatabase(ts uint64, snapshot engine.Snapshot, engine engine.
 	return engine.Delete(ts, dbName, snapshot)
 }
 
-func (s *Scope) CreateTable(ts uint64, snapshot engine.Snapshot, engine engine.Engine, dbName string) error {
+func (s *Scope) CreateTable(ts uint64, snapshot engine.Snapshot, e engine.Engine, dbName string) error {
 	qry := s.Plan.GetDdl().GetCreateTable()
 	// convert the plan's cols to the execution's cols
 	planCols := qry.GetTableDef().GetCols()
@@ -81,23 +85,78 @@ func (s *Scope) CreateTable(ts uint64, snapshot engine.Snapshot, engine engine.E
 	// convert the plan's defs to the execution's defs
 	planDefs := qry.GetTableDef().GetDefs()
 	exeDefs := planDefsToExeDefs(planDefs)
+	if view := qry.GetTableDef().GetView(); view != nil {
+		exeDefs = append(exeDefs, &engine.ViewDef{View: view.GetView()})
+	}
 
 	if qry.GetDatabase() != "" {
 		dbName = qry.GetDatabase()
 	}
-	dbSource, err := engine.Database(dbName, snapshot)
+	dbSource, err := e.Database(dbName, snapshot)
 	if err != nil {
 		return err
 	}
 	tblName := qry.GetTableDef().GetName()
 	if relation, err := dbSource.Relation(tblName, snapshot); err == nil {
 		relation.Close(snapshot)
+		if qry.GetReplace() {
+			if err := dbSource.Delete(ts, tblName, snapshot); err != nil {
+				return err
+			}
+			return dbSource.Create(ts, tblName, append(exeCols, exeDefs...), snapshot)
+		}
 		if qry.GetIfNotExists() {
 			return nil
 		}
 		return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("table '%s' already exists", tblName))
 	}
-	return dbSource.Create(ts, tblName, append(exeCols, exeDefs...), snapshot)
+	if err := dbSource.Create(ts, tblName, append(exeCols, exeDefs...), snapshot); err != nil {
+		return err
+	}
+	return s.resetAutoIncrement(dbName, qry.GetTableDef())
+}
+
+// resetAutoIncrement starts the auto increment column of a new table from
+// the auto_increment option of the table, or 1 if there is none
+func (s *Scope) resetAutoIncrement(dbName string, tableDef *plan.TableDef) error {
+	offset := uint64(0)
+	for _, def := range tableDef.GetDefs() {
+		if properties := def.GetProperties(); properties != nil {
+			for _, p := range properties.GetProperties() {
+				if p.GetKey() != plan2.AutoIncrementProperty {
+					continue
+				}
+				v, err := strconv.ParseUint(p.GetValue(), 10, 64)
+				if err != nil {
+					return err
+				}
+				if v > 0 {
+					offset = v - 1
+				}
+			}
+		}
+	}
+	for _, col := range tableDef.GetCols() {
+		if !col.GetAutoIncr() {
+			continue
+		}
+		if s.Proc.IncrService == nil {
+			return errors.New(errno.FeatureNotSupported, fmt.Sprintf("auto increment column '%s' is not supported", col.GetName()))
+		}
+		return s.Proc.IncrService.Reset(incrservice.Key(dbName, tableDef.GetName(), col.GetName()), offset)
+	}
+	return nil
+}
+
+// autoIncrementColumn returns the name of the auto increment column of the
+// relation, "" if there is none
+func autoIncrementColumn(relation engine.Relation, snapshot engine.Snapshot) string {
+	for _, def := range relation.TableDefs(snapshot) {
+		if attr, ok := def.(*engine.AttributeDef); ok && attr.Attr.AutoIncrement {
+			return attr.Attr.Name
+		}
+	}
+	return ""
 }
 
 func (s *Scope) DropTable(ts uint64, snapshot engine.Snapshot, engine engine.Engine) error {
@@ -112,15 +171,28 @@ func (s *Scope) DropTable(ts uint64, snapshot engine.Snapshot, engine engine.Eng
 		return err
 	}
 	tblName := qry.GetTable()
+	var autoCol string
 	if relation, err := dbSource.Relation(tblName, snapshot); err != nil {
 		if qry.GetIfExists() {
 			return nil
 		}
 		return err
 	} else {
+		autoCol = autoIncrementColumn(relation, snapshot)
 		relation.Close(snapshot)
 	}
-	return dbSource.Delete(ts, tblName, snapshot)
+	if !s.Proc.SessionInfo.NoForeignKeyChecks {
+		if err := foreignkey.New(dbSource, dbName, snapshot).CheckDrop(tblName); err != nil {
+			return err
+		}
+	}
+	if err := dbSource.Delete(ts, tblName, snapshot); err != nil {
+		return err
+	}
+	if autoCol != "" && s.Proc.IncrService != nil {
+		return s.Proc.IncrService.Delete(incrservice.Key(dbName, tblName, autoCol))
+	}
+	return nil
 }
 
 func (s *Scope) CreateIndex(ts uint64, snapshot engine.Snapshot, engine engine.Engine) error {
@@ -136,8 +208,11 @@ func (s *Scope) Delete(ts uint64, snapshot engine.Snapshot, engine engine.Engine
 	arg := s.Instructions[len(s.Instructions)-1].Arg.(*deletion.Argument)
 	arg.Ts = ts
 
-	if arg.DeleteCtxs[0].CanTruncate {
-		return arg.DeleteCtxs[0].TableSource.Truncate(snapshot)
+	if ctx := arg.DeleteCtxs[0]; ctx.CanTruncate {
+		if err := ctx.ForeignKeys.OnDelete(ts, ctx.TableName, ctx.UseDeleteKey, nil); err != nil {
+			return 0, err
+		}
+		return ctx.TableSource.Truncate(snapshot)
 	}
 
 	defer func() {
@@ -184,6 +259,7 @@ func planDefsToExeDefs(planDefs []*plan.TableDef_DefType) []engine.TableDef {
 			}
 		case *plan.TableDef_DefType_Idx:
 			exeDefs[i] = &engine.IndexTableDef{
+				Typ:      engine.IndexT(defVal.Idx.GetTyp()),
 				ColNames: defVal.Idx.GetColNames(),
 				Name:     defVal.Idx.GetName(),
 			}
@@ -198,11 +274,152 @@ func planDefsToExeDefs(planDefs []*plan.TableDef_DefType) []engine.TableDef {
 			exeDefs[i] = &engine.PropertiesDef{
 				Properties: properties,
 			}
+		case *plan.TableDef_DefType_Check:
+			exeDefs[i] = &engine.CheckDef{
+				Name: defVal.Check.GetName(),
+				Expr: defVal.Check.GetExpr(),
+			}
+		case *plan.TableDef_DefType_Fk:
+			exeDefs[i] = &engine.ForeignKeyDef{
+				Name:        defVal.Fk.GetName(),
+				Cols:        defVal.Fk.GetCols(),
+				ParentTable: defVal.Fk.GetParentTable(),
+				ParentCols:  defVal.Fk.GetParentCols(),
+				OnDelete:    engine.ForeignKeyAction(defVal.Fk.GetOnDelete()),
+				OnUpdate:    engine.ForeignKeyAction(defVal.Fk.GetOnUpdate()),
+			}
+		case *plan.TableDef_DefType_Partition:
+			exeDefs[i] = plan2.MakeEnginePartitionDef(defVal.Partition)
 		}
 	}
 	return exeDefs
 }
 
+func (s *Scope) AlterTable(ts uint64, snapshot engine.Snapshot, e engine.Engine) error {
+	qry := s.Plan.GetDdl().GetAlterTable()
+
+	dbSource, err := e.Database(qry.GetDatabase(), snapshot)
+	if err != nil {
+		return err
+	}
+	relation, err := dbSource.Relation(qry.GetTable(), snapshot)
+	if err != nil {
+		return err
+	}
+	defer relation.Close(snapshot)
+	// the key of the auto increment column follows the renames
+	tblName, autoCol := qry.GetTable(), autoIncrementColumn(relation, snapshot)
+	for _, action := range qry.GetActions() {
+		switch act := action.GetAction().(type) {
+		case *plan.AlterTable_Action_AddColumn:
+			def := planColsToExeCols([]*plan.ColDef{act.AddColumn.GetColumn()})[0]
+			err = relation.AddTableDef(ts, def, snapshot)
+		case *plan.AlterTable_Action_DropColumn:
+			err = relation.DelTableDef(ts, &engine.AttributeDef{
+				Attr: engine.Attribute{Name: act.DropColumn.GetName()},
+			}, snapshot)
+			if err == nil && act.DropColumn.GetName() == autoCol {
+				err = s.deleteAutoIncrement(qry.GetDatabase(), tblName, autoCol)
+				autoCol = ""
+			}
+		case *plan.AlterTable_Action_RenameColumn:
+			err = relation.AddTableDef(ts, &engine.RenameColumnDef{
+				OldName: act.RenameColumn.GetOldName(),
+				NewName: act.RenameColumn.GetNewName(),
+			}, snapshot)
+			if err == nil && act.RenameColumn.GetOldName() == autoCol {
+				err = s.renameAutoIncrement(qry.GetDatabase(), tblName, autoCol, tblName, act.RenameColumn.GetNewName())
+				autoCol = act.RenameColumn.GetNewName()
+			}
+		case *plan.AlterTable_Action_RenameTable:
+			newName := act.RenameTable.GetTable()
+			if rel, err := dbSource.Relation(newName, snapshot); err == nil {
+				rel.Close(snapshot)
+				return errors.New(errno.DuplicateTable, fmt.Sprintf("table '%s' already exists", newName))
+			}
+			err = relation.AddTableDef(ts, &engine.RenameTableDef{Name: newName}, snapshot)
+			if err == nil && autoCol != "" {
+				err = s.renameAutoIncrement(qry.GetDatabase(), tblName, autoCol, newName, autoCol)
+			}
+			tblName = newName
+		case *plan.AlterTable_Action_AddPartition:
+			var def *engine.PartitionDef
+			if def, err = partitionDef(relation, snapshot); err != nil {
+				return err
+			}
+			for _, part := range act.AddPartition.GetPartitions() {
+				def.Partitions = append(def.Partitions, engine.Partition{
+					Name:   part.GetName(),
+					Values: part.GetValues(),
+				})
+			}
+			err = relation.AddTableDef(ts, def, snapshot)
+		case *plan.AlterTable_Action_DropPartition:
+			var def *engine.PartitionDef
+			if def, err = partitionDef(relation, snapshot); err != nil {
+				return err
+			}
+			dropped := make(map[string]bool)
+			for _, name := range act.DropPartition.GetNames() {
+				dropped[name] = true
+			}
+			parts := def.Partitions[:0]
+			for _, part := range def.Partitions {
+				if !dropped[part.Name] {
+					parts = append(parts, part)
+				}
+			}
+			def.Partitions = parts
+			err = relation.AddTableDef(ts, def, snapshot)
+		case *plan.AlterTable_Action_TruncatePartition:
+			for _, name := range act.TruncatePartition.GetNames() {
+				var rel engine.Relation
+				if rel, err = dbSource.Relation(engine.PartitionTableName(tblName, name), snapshot); err != nil {
+					break
+				}
+				_, err = rel.Truncate(snapshot)
+				rel.Close(snapshot)
+				if err != nil {
+					break
+				}
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// partitionDef returns a copy of the partition definition of the relation
+func partitionDef(relation engine.Relation, snapshot engine.Snapshot) (*engine.PartitionDef, error) {
+	for _, def := range relation.TableDefs(snapshot) {
+		if part, ok := def.(*engine.PartitionDef); ok {
+			return &engine.PartitionDef{
+				Typ:        part.Typ,
+				Func:       part.Func,
+				Cols:       part.Cols,
+				Partitions: append([]engine.Partition{}, part.Partitions...),
+			}, nil
+		}
+	}
+	return nil, errors.New(errno.WrongObjectType, "Partition management on a not partitioned table is not possible")
+}
+
+func (s *Scope) deleteAutoIncrement(dbName, tblName, colName string) error {
+	if s.Proc.IncrService == nil {
+		return nil
+	}
+	return s.Proc.IncrService.Delete(incrservice.Key(dbName, tblName, colName))
+}
+
+func (s *Scope) renameAutoIncrement(dbName, oldTbl, oldCol, newTbl, newCol string) error {
+	if s.Proc.IncrService == nil {
+		return nil
+	}
+	return s.Proc.IncrService.Rename(incrservice.Key(dbName, oldTbl, oldCol), incrservice.Key(dbName, newTbl, newCol))
+}
+
 func planColsToExeCols(planCols []*plan.ColDef) []engine.TableDef {
 	exeCols := make([]engine.TableDef, len(planCols))
 	for i, col := range planCols {
@@ -230,8 +447,11 @@ func planColsToExeCols(planCols []*plan.ColDef) []engine.TableDef {
 					Value:  planValToExeVal(col.GetDefault().GetValue(), colTyp.GetId()),
 					IsNull: col.GetDefault().GetIsNull(),
 				},
-				Primary: col.GetPrimary(),
-				Comment: col.GetComment(),
+				Primary:       col.GetPrimary(),
+				Comment:       col.GetComment(),
+				AutoIncrement: col.GetAutoIncr(),
+				NotNull:       col.GetNotNull(),
+				EnumValues:    colTyp.GetEnumvalues(),
 			},
 		}
 	}
@@ -448,7 +668,17 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 		if err != nil {
 			return err
 		}
-		rds = rel.NewReader(mcpu, nil, s.NodeInfo.Data, snap)
+		if len(s.DataSource.FullText) == 0 {
+			rds = rel.NewReader(mcpu, nil, s.NodeInfo.Data, snap)
+		} else {
+			ftRel, ok := rel.(engine.FullTextRelation)
+			if !ok {
+				return errors.New(errno.FeatureNotSupported, fmt.Sprintf("table '%s' does not support full-text search", s.DataSource.RelationName))
+			}
+			if rds, err = ftRel.NewFullTextReader(mcpu, s.DataSource.FullText, snap); err != nil {
+				return err
+			}
+		}
 	}
 	ss := make([]*Scope, mcpu)
 	for i := 0; i < mcpu; i++ {
@@ -467,6 +697,7 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 		ss[i].Proc.UnixTime = s.Proc.UnixTime
 		ss[i].Proc.Snapshot = s.Proc.Snapshot
 		ss[i].Proc.SessionInfo = s.Proc.SessionInfo
+		ss[i].Proc.FileService = s.Proc.FileService
 	}
 	{
 		var flg bool
@@ -546,9 +777,10 @@ func (s *Scope) ParallelRun(e engine.Engine) error {
 					ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 						Op: vm.Group,
 						Arg: &group.Argument{
-							Aggs:  arg.Aggs,
-							Exprs: arg.Exprs,
-							Types: arg.Types,
+							Aggs:         arg.Aggs,
+							Exprs:        arg.Exprs,
+							Types:        arg.Types,
+							GroupingSets: arg.GroupingSets,
 						},
 					})
 				}
@@ -38,6 +38,7 @@ const (
 	Deletion
 	Insert
 	Update
+	AlterTable
 )
 
 // Address is the ip:port of local node
@@ -48,8 +49,11 @@ type Source struct {
 	SchemaName   string
 	RelationName string
 	Attributes   []string
-	R            engine.Reader
-	Bat          *batch.Batch
+	// FullText are the full-text searches of the scan whose relevance
+	// columns are in the attributes
+	FullText []engine.FullTextSearch
+	R        engine.Reader
+	Bat      *batch.Batch
 }
 
 // Col is the information of attribute
@@ -94,6 +98,8 @@ type Compile struct {
 	fill func(interface{}, *batch.Batch) error
 	//affectRows stores the number of rows affected while insert / update / delete
 	affectRows uint64
+	//lastInsertID stores the first auto increment value allocated by insert
+	lastInsertID uint64
 	// db current database name.
 	db string
 	// uid the user who initiated the sql.
@@ -104,4 +110,11 @@ type Compile struct {
 	e engine.Engine
 	// proc stores the execution context.
 	proc *process.Process
+	// sinkBat stores the rows of the last iteration of the recursive cte,
+	// it is the data source of the sink scan.
+	sinkBat *batch.Batch
+	// sinkRead is set once a sink scan reads sinkBat, the other sink scans
+	// read the copies of it in sinkCopies, as the rows read are consumed.
+	sinkRead   bool
+	sinkCopies []*batch.Batch
 }
@@ -95,6 +95,8 @@ func init() {
 		"sysdate":                  SYSDATE,
 		"create":                   CREATE,
 		"cross":                    CROSS,
+		"cube":                     CUBE,
+		"current":                  CURRENT,
 		"current_date":             CURRENT_DATE,
 		"current_time":             CURRENT_TIME,
 		"current_timestamp":        CURRENT_TIMESTAMP,
@@ -155,6 +157,7 @@ func init() {
 		"for":                      FOR,
 		"force":                    FORCE,
 		"foreign":                  FOREIGN,
+		"following":                FOLLOWING,
 		"format":                   FORMAT,
 		"from":                     FROM,
 		"full":                     FULL,
@@ -170,6 +173,7 @@ func init() {
 		"global":                   GLOBAL,
 		"grant":                    GRANT,
 		"group":                    GROUP,
+		"grouping":                 GROUPING,
 		"group_concat":             GROUP_CONCAT,
 		"having":                   HAVING,
 		"hash":                     HASH,
@@ -193,6 +197,7 @@ func init() {
 		"int4":                     INT4,
 		"int8":                     INT8,
 		"integer":                  INTEGER,
+		"intersect":                INTERSECT,
 		"interval":                 INTERVAL,
 		"into":                     INTO,
 		"invisible":                INVISIBLE,
@@ -208,6 +213,7 @@ func init() {
 		"key_block_size":           KEY_BLOCK_SIZE,
 		"kill":                     UNUSED,
 		"language":                 LANGUAGE,
+		"lateral":                  LATERAL,
 		"leading":                  LEADING,
 		"leave":                    UNUSED,
 		"left":                     LEFT,
@@ -276,6 +282,7 @@ func init() {
 		"out":                      UNUSED,
 		"outer":                    OUTER,
 		"outfile":                  OUTFILE,
+		"over":                     OVER,
 		"header":                   HEADER,
 		"max_file_size":            MAX_FILE_SIZE,
 		"force_quote":              FORCE_QUOTE,
@@ -287,6 +294,7 @@ func init() {
 		"pack_keys":                PACK_KEYS,
 		"point":                    POINT,
 		"polygon":                  POLYGON,
+		"preceding":                PRECEDING,
 		"precision":                UNUSED,
 		"primary":                  PRIMARY,
 		"processlist":              PROCESSLIST,
@@ -303,6 +311,7 @@ func init() {
 		"redundant":                REDUNDANT,
 		"read_write":               UNUSED,
 		"real":                     REAL,
+		"recursive":                RECURSIVE,
 		"references":               REFERENCES,
 		"regexp":                   REGEXP,
 		"release":                  RELEASE,
@@ -323,11 +332,13 @@ func init() {
 		"right":                    RIGHT,
 		"rlike":                    REGEXP,
 		"rollback":                 ROLLBACK,
+		"rollup":                   ROLLUP,
 		"role":                     ROLE,
 		"routine":                  ROUTINE,
 		"row":                      ROW,
 		"row_format":               ROW_FORMAT,
 		"row_count":                ROW_COUNT,
+		"rows":                     ROWS,
 		"rtree":                    RTREE,
 		"schema":                   SCHEMA,
 		"schemas":                  SCHEMAS,
@@ -338,6 +349,7 @@ func init() {
 		"serializable":             SERIALIZABLE,
 		"session":                  SESSION,
 		"set":                      SET,
+		"sets":                     SETS,
 		"share":                    SHARE,
 		"show":                     SHOW,
 		"shutdown":                 SHUTDOWN,
@@ -390,6 +402,7 @@ func init() {
 		"true":                     TRUE,
 		"truncate":                 TRUNCATE,
 		"uncommitted":              UNCOMMITTED,
+		"unbounded":                UNBOUNDED,
 		"undo":                     UNUSED,
 		"unknown":                  UNKNOWN,
 		"union":                    UNION,
@@ -404,6 +417,7 @@ func init() {
 		"utc_date":                 UTC_DATE,
 		"utc_time":                 UTC_TIME,
 		"utc_timestamp":            UTC_TIMESTAMP,
+		"uuid":                     UUID,
 		"values":                   VALUES,
 		"variables":                VARIABLES,
 		"varbinary":                VARBINARY,
@@ -44,8 +44,9 @@ func ParseOne(sql string) (tree.Statement, error) {
 }
 
 type Lexer struct {
-	scanner *Scanner
-	stmts   []tree.Statement
+	scanner    *Scanner
+	stmts      []tree.Statement
+	paramIndex int
 }
 
 func NewLexer(dialectType dialect.DialectType, sql string) *Lexer {
@@ -84,6 +85,14 @@ func (l *Lexer) AppendStmt(stmt tree.Statement) {
 	l.stmts = append(l.stmts, stmt)
 }
 
+// GetParamIndex numbers the parameter markers in the order they appear.
+// The marker is reduced before the next token is shifted, so the order
+// of the reductions is the order of the markers in the sql.
+func (l *Lexer) GetParamIndex() int {
+	l.paramIndex++
+	return l.paramIndex
+}
+
 func (l *Lexer) toInt(lval *yySymType, str string) int {
 	ival, err := strconv.ParseUint(str, 10, 64)
 	if err != nil {
@@ -23,445 +23,460 @@ import (
 const LEX_ERROR = 57346
 const EMPTY = 57347
 const UNION = 57348
-const SELECT = 57349
-const STREAM = 57350
-const INSERT = 57351
-const UPDATE = 57352
-const DELETE = 57353
-const FROM = 57354
-const WHERE = 57355
-const GROUP = 57356
-const HAVING = 57357
-const ORDER = 57358
-const BY = 57359
-const LIMIT = 57360
-const OFFSET = 57361
-const FOR = 57362
-const LOWER_THAN_SET = 57363
-const SET = 57364
-const ALL = 57365
-const DISTINCT = 57366
-const DISTINCTROW = 57367
-const AS = 57368
-const EXISTS = 57369
-const ASC = 57370
-const DESC = 57371
-const INTO = 57372
-const DUPLICATE = 57373
-const DEFAULT = 57374
-const LOCK = 57375
-const KEYS = 57376
-const VALUES = 57377
-const NEXT = 57378
-const VALUE = 57379
-const SHARE = 57380
-const MODE = 57381
-const SQL_NO_CACHE = 57382
-const SQL_CACHE = 57383
-const JOIN = 57384
-const STRAIGHT_JOIN = 57385
-const LEFT = 57386
-const RIGHT = 57387
-const INNER = 57388
-const OUTER = 57389
-const CROSS = 57390
-const NATURAL = 57391
-const USE = 57392
-const FORCE = 57393
-const ON = 57394
-const USING = 57395
-const SUBQUERY_AS_EXPR = 57396
-const LOWER_THAN_STRING = 57397
-const ID = 57398
-const AT_ID = 57399
-const AT_AT_ID = 57400
-const STRING = 57401
-const VALUE_ARG = 57402
-const LIST_ARG = 57403
-const COMMENT = 57404
-const COMMENT_KEYWORD = 57405
-const INTEGRAL = 57406
-const HEX = 57407
-const BIT_LITERAL = 57408
-const FLOAT = 57409
-const HEXNUM = 57410
-const NULL = 57411
-const TRUE = 57412
-const FALSE = 57413
-const LOWER_THAN_CHARSET = 57414
-const CHARSET = 57415
-const UNIQUE = 57416
-const KEY = 57417
-const OR = 57418
-const PIPE_CONCAT = 57419
-const XOR = 57420
-const AND = 57421
-const NOT = 57422
-const BETWEEN = 57423
-const CASE = 57424
-const WHEN = 57425
-const THEN = 57426
-const ELSE = 57427
-const END = 57428
-const LE = 57429
-const GE = 57430
-const NE = 57431
-const NULL_SAFE_EQUAL = 57432
-const IS = 57433
-const LIKE = 57434
-const REGEXP = 57435
-const IN = 57436
-const ASSIGNMENT = 57437
-const SHIFT_LEFT = 57438
-const SHIFT_RIGHT = 57439
-const DIV = 57440
-const MOD = 57441
-const UNARY = 57442
-const COLLATE = 57443
-const BINARY = 57444
-const UNDERSCORE_BINARY = 57445
-const INTERVAL = 57446
-const BEGIN = 57447
-const START = 57448
-const TRANSACTION = 57449
-const COMMIT = 57450
-const ROLLBACK = 57451
-const WORK = 57452
-const CONSISTENT = 57453
-const SNAPSHOT = 57454
-const CHAIN = 57455
-const NO = 57456
-const RELEASE = 57457
-const PRIORITY = 57458
-const QUICK = 57459
-const BIT = 57460
-const TINYINT = 57461
-const SMALLINT = 57462
-const MEDIUMINT = 57463
-const INT = 57464
-const INTEGER = 57465
-const BIGINT = 57466
-const INTNUM = 57467
-const REAL = 57468
-const DOUBLE = 57469
-const FLOAT_TYPE = 57470
-const DECIMAL = 57471
-const NUMERIC = 57472
-const DECIMAL_VALUE = 57473
-const TIME = 57474
-const TIMESTAMP = 57475
-const DATETIME = 57476
-const YEAR = 57477
-const CHAR = 57478
-const VARCHAR = 57479
-const BOOL = 57480
-const CHARACTER = 57481
-const VARBINARY = 57482
-const NCHAR = 57483
-const TEXT = 57484
-const TINYTEXT = 57485
-const MEDIUMTEXT = 57486
-const LONGTEXT = 57487
-const BLOB = 57488
-const TINYBLOB = 57489
-const MEDIUMBLOB = 57490
-const LONGBLOB = 57491
-const JSON = 57492
-const ENUM = 57493
-const GEOMETRY = 57494
-const POINT = 57495
-const LINESTRING = 57496
-const POLYGON = 57497
-const GEOMETRYCOLLECTION = 57498
-const MULTIPOINT = 57499
-const MULTILINESTRING = 57500
-const MULTIPOLYGON = 57501
-const INT1 = 57502
-const INT2 = 57503
-const INT3 = 57504
-const INT4 = 57505
-const INT8 = 57506
-const SQL_SMALL_RESULT = 57507
-const SQL_BIG_RESULT = 57508
-const SQL_BUFFER_RESULT = 57509
-const LOW_PRIORITY = 57510
-const HIGH_PRIORITY = 57511
-const DELAYED = 57512
-const CREATE = 57513
-const ALTER = 57514
-const DROP = 57515
-const RENAME = 57516
-const ANALYZE = 57517
-const ADD = 57518
-const SCHEMA = 57519
-const TABLE = 57520
-const INDEX = 57521
-const VIEW = 57522
-const TO = 57523
-const IGNORE = 57524
-const IF = 57525
-const PRIMARY = 57526
-const COLUMN = 57527
-const CONSTRAINT = 57528
-const SPATIAL = 57529
-const FULLTEXT = 57530
-const FOREIGN = 57531
-const KEY_BLOCK_SIZE = 57532
-const SHOW = 57533
-const DESCRIBE = 57534
-const EXPLAIN = 57535
-const DATE = 57536
-const ESCAPE = 57537
-const REPAIR = 57538
-const OPTIMIZE = 57539
-const TRUNCATE = 57540
-const MAXVALUE = 57541
-const PARTITION = 57542
-const REORGANIZE = 57543
-const LESS = 57544
-const THAN = 57545
-const PROCEDURE = 57546
-const TRIGGER = 57547
-const STATUS = 57548
-const VARIABLES = 57549
-const ROLE = 57550
-const PROXY = 57551
-const AVG_ROW_LENGTH = 57552
-const STORAGE = 57553
-const DISK = 57554
-const MEMORY = 57555
-const CHECKSUM = 57556
-const COMPRESSION = 57557
-const DATA = 57558
-const DIRECTORY = 57559
-const DELAY_KEY_WRITE = 57560
-const ENCRYPTION = 57561
-const ENGINE = 57562
-const MAX_ROWS = 57563
-const MIN_ROWS = 57564
-const PACK_KEYS = 57565
-const ROW_FORMAT = 57566
-const STATS_AUTO_RECALC = 57567
-const STATS_PERSISTENT = 57568
-const STATS_SAMPLE_PAGES = 57569
-const DYNAMIC = 57570
-const COMPRESSED = 57571
-const REDUNDANT = 57572
-const COMPACT = 57573
-const FIXED = 57574
-const COLUMN_FORMAT = 57575
-const AUTO_RANDOM = 57576
-const RESTRICT = 57577
-const CASCADE = 57578
-const ACTION = 57579
-const PARTIAL = 57580
-const SIMPLE = 57581
-const CHECK = 57582
-const ENFORCED = 57583
-const RANGE = 57584
-const LIST = 57585
-const ALGORITHM = 57586
-const LINEAR = 57587
-const PARTITIONS = 57588
-const SUBPARTITION = 57589
-const SUBPARTITIONS = 57590
-const TYPE = 57591
-const ANY = 57592
-const SOME = 57593
-const PREPARE = 57594
-const DEALLOCATE = 57595
-const PROPERTIES = 57596
-const PARSER = 57597
-const VISIBLE = 57598
-const INVISIBLE = 57599
-const BTREE = 57600
-const HASH = 57601
-const RTREE = 57602
-const BSI = 57603
-const ZONEMAP = 57604
-const LEADING = 57605
-const BOTH = 57606
-const TRAILING = 57607
-const UNKNOWN = 57608
-const EXPIRE = 57609
-const ACCOUNT = 57610
-const UNLOCK = 57611
-const DAY = 57612
-const NEVER = 57613
-const SECOND = 57614
-const ASCII = 57615
-const COALESCE = 57616
-const COLLATION = 57617
-const HOUR = 57618
-const MICROSECOND = 57619
-const MINUTE = 57620
-const MONTH = 57621
-const QUARTER = 57622
-const REPEAT = 57623
-const REVERSE = 57624
-const ROW_COUNT = 57625
-const WEEK = 57626
-const REVOKE = 57627
-const FUNCTION = 57628
-const PRIVILEGES = 57629
-const TABLESPACE = 57630
-const EXECUTE = 57631
-const SUPER = 57632
-const GRANT = 57633
-const OPTION = 57634
-const REFERENCES = 57635
-const REPLICATION = 57636
-const SLAVE = 57637
-const CLIENT = 57638
-const USAGE = 57639
-const RELOAD = 57640
-const FILE = 57641
-const TEMPORARY = 57642
-const ROUTINE = 57643
-const EVENT = 57644
-const SHUTDOWN = 57645
-const NULLX = 57646
-const AUTO_INCREMENT = 57647
-const APPROXNUM = 57648
-const SIGNED = 57649
-const UNSIGNED = 57650
-const ZEROFILL = 57651
-const USER = 57652
-const IDENTIFIED = 57653
-const CIPHER = 57654
-const ISSUER = 57655
-const X509 = 57656
-const SUBJECT = 57657
-const SAN = 57658
-const REQUIRE = 57659
-const SSL = 57660
-const NONE = 57661
-const PASSWORD = 57662
-const MAX_QUERIES_PER_HOUR = 57663
-const MAX_UPDATES_PER_HOUR = 57664
-const MAX_CONNECTIONS_PER_HOUR = 57665
-const MAX_USER_CONNECTIONS = 57666
-const FORMAT = 57667
-const VERBOSE = 57668
-const CONNECTION = 57669
-const LOAD = 57670
-const INFILE = 57671
-const TERMINATED = 57672
-const OPTIONALLY = 57673
-const ENCLOSED = 57674
-const ESCAPED = 57675
-const STARTING = 57676
-const LINES = 57677
-const DATABASES = 57678
-const TABLES = 57679
-const EXTENDED = 57680
-const FULL = 57681
-const PROCESSLIST = 57682
-const FIELDS = 57683
-const COLUMNS = 57684
-const OPEN = 57685
-const ERRORS = 57686
-const WARNINGS = 57687
-const INDEXES = 57688
-const SCHEMAS = 57689
-const NAMES = 57690
-const GLOBAL = 57691
-const SESSION = 57692
-const ISOLATION = 57693
-const LEVEL = 57694
-const READ = 57695
-const WRITE = 57696
-const ONLY = 57697
-const REPEATABLE = 57698
-const COMMITTED = 57699
-const UNCOMMITTED = 57700
-const SERIALIZABLE = 57701
-const LOCAL = 57702
-const EXCEPT = 57703
-const CURRENT_TIMESTAMP = 57704
-const DATABASE = 57705
-const CURRENT_TIME = 57706
-const LOCALTIME = 57707
-const LOCALTIMESTAMP = 57708
-const UTC_DATE = 57709
-const UTC_TIME = 57710
-const UTC_TIMESTAMP = 57711
-const REPLACE = 57712
-const CONVERT = 57713
-const SEPARATOR = 57714
-const CURRENT_DATE = 57715
-const CURRENT_USER = 57716
-const CURRENT_ROLE = 57717
-const SECOND_MICROSECOND = 57718
-const MINUTE_MICROSECOND = 57719
-const MINUTE_SECOND = 57720
-const HOUR_MICROSECOND = 57721
-const HOUR_SECOND = 57722
-const HOUR_MINUTE = 57723
-const DAY_MICROSECOND = 57724
-const DAY_SECOND = 57725
-const DAY_MINUTE = 57726
-const DAY_HOUR = 57727
-const YEAR_MONTH = 57728
-const SQL_TSI_HOUR = 57729
-const SQL_TSI_DAY = 57730
-const SQL_TSI_WEEK = 57731
-const SQL_TSI_MONTH = 57732
-const SQL_TSI_QUARTER = 57733
-const SQL_TSI_YEAR = 57734
-const SQL_TSI_SECOND = 57735
-const SQL_TSI_MINUTE = 57736
-const RECURSIVE = 57737
-const CONFIG = 57738
-const MATCH = 57739
-const AGAINST = 57740
-const BOOLEAN = 57741
-const LANGUAGE = 57742
-const WITH = 57743
-const QUERY = 57744
-const EXPANSION = 57745
-const ADDDATE = 57746
-const BIT_AND = 57747
-const BIT_OR = 57748
-const BIT_XOR = 57749
-const CAST = 57750
-const COUNT = 57751
-const APPROX_COUNT_DISTINCT = 57752
-const APPROX_PERCENTILE = 57753
-const CURDATE = 57754
-const CURTIME = 57755
-const DATE_ADD = 57756
-const DATE_SUB = 57757
-const EXTRACT = 57758
-const GROUP_CONCAT = 57759
-const MAX = 57760
-const MID = 57761
-const MIN = 57762
-const NOW = 57763
-const POSITION = 57764
-const SESSION_USER = 57765
-const STD = 57766
-const STDDEV = 57767
-const STDDEV_POP = 57768
-const STDDEV_SAMP = 57769
-const SUBDATE = 57770
-const SUBSTR = 57771
-const SUBSTRING = 57772
-const SUM = 57773
-const SYSDATE = 57774
-const SYSTEM_USER = 57775
-const TRANSLATE = 57776
-const TRIM = 57777
-const VARIANCE = 57778
-const VAR_POP = 57779
-const VAR_SAMP = 57780
-const AVG = 57781
-const ROW = 57782
-const OUTFILE = 57783
-const HEADER = 57784
-const MAX_FILE_SIZE = 57785
-const FORCE_QUOTE = 57786
-const UNUSED = 57787
+const EXCEPT = 57349
+const INTERSECT = 57350
+const SELECT = 57351
+const STREAM = 57352
+const INSERT = 57353
+const UPDATE = 57354
+const DELETE = 57355
+const FROM = 57356
+const WHERE = 57357
+const GROUP = 57358
+const HAVING = 57359
+const ORDER = 57360
+const BY = 57361
+const LIMIT = 57362
+const OFFSET = 57363
+const FOR = 57364
+const LOWER_THAN_SET = 57365
+const SET = 57366
+const ALL = 57367
+const DISTINCT = 57368
+const DISTINCTROW = 57369
+const AS = 57370
+const EXISTS = 57371
+const ASC = 57372
+const DESC = 57373
+const INTO = 57374
+const DUPLICATE = 57375
+const DEFAULT = 57376
+const LOCK = 57377
+const KEYS = 57378
+const VALUES = 57379
+const NEXT = 57380
+const VALUE = 57381
+const SHARE = 57382
+const MODE = 57383
+const SQL_NO_CACHE = 57384
+const SQL_CACHE = 57385
+const JOIN = 57386
+const STRAIGHT_JOIN = 57387
+const LEFT = 57388
+const RIGHT = 57389
+const INNER = 57390
+const OUTER = 57391
+const CROSS = 57392
+const NATURAL = 57393
+const USE = 57394
+const FORCE = 57395
+const ON = 57396
+const USING = 57397
+const SUBQUERY_AS_EXPR = 57398
+const LOWER_THAN_STRING = 57399
+const ID = 57400
+const AT_ID = 57401
+const AT_AT_ID = 57402
+const STRING = 57403
+const VALUE_ARG = 57404
+const LIST_ARG = 57405
+const COMMENT = 57406
+const COMMENT_KEYWORD = 57407
+const INTEGRAL = 57408
+const HEX = 57409
+const BIT_LITERAL = 57410
+const FLOAT = 57411
+const HEXNUM = 57412
+const NULL = 57413
+const TRUE = 57414
+const FALSE = 57415
+const JSON_EXTRACT_OP = 57416
+const JSON_UNQUOTE_EXTRACT_OP = 57417
+const LOWER_THAN_CHARSET = 57418
+const CHARSET = 57419
+const UNIQUE = 57420
+const KEY = 57421
+const OR = 57422
+const PIPE_CONCAT = 57423
+const XOR = 57424
+const AND = 57425
+const NOT = 57426
+const BETWEEN = 57427
+const CASE = 57428
+const WHEN = 57429
+const THEN = 57430
+const ELSE = 57431
+const END = 57432
+const LE = 57433
+const GE = 57434
+const NE = 57435
+const NULL_SAFE_EQUAL = 57436
+const IS = 57437
+const LIKE = 57438
+const REGEXP = 57439
+const IN = 57440
+const ASSIGNMENT = 57441
+const SHIFT_LEFT = 57442
+const SHIFT_RIGHT = 57443
+const DIV = 57444
+const MOD = 57445
+const UNARY = 57446
+const COLLATE = 57447
+const BINARY = 57448
+const UNDERSCORE_BINARY = 57449
+const INTERVAL = 57450
+const BEGIN = 57451
+const START = 57452
+const TRANSACTION = 57453
+const COMMIT = 57454
+const ROLLBACK = 57455
+const WORK = 57456
+const CONSISTENT = 57457
+const SNAPSHOT = 57458
+const CHAIN = 57459
+const NO = 57460
+const RELEASE = 57461
+const PRIORITY = 57462
+const QUICK = 57463
+const BIT = 57464
+const TINYINT = 57465
+const SMALLINT = 57466
+const MEDIUMINT = 57467
+const INT = 57468
+const INTEGER = 57469
+const BIGINT = 57470
+const INTNUM = 57471
+const REAL = 57472
+const DOUBLE = 57473
+const FLOAT_TYPE = 57474
+const DECIMAL = 57475
+const NUMERIC = 57476
+const DECIMAL_VALUE = 57477
+const TIME = 57478
+const TIMESTAMP = 57479
+const DATETIME = 57480
+const YEAR = 57481
+const CHAR = 57482
+const VARCHAR = 57483
+const BOOL = 57484
+const CHARACTER = 57485
+const VARBINARY = 57486
+const NCHAR = 57487
+const TEXT = 57488
+const TINYTEXT = 57489
+const MEDIUMTEXT = 57490
+const LONGTEXT = 57491
+const BLOB = 57492
+const TINYBLOB = 57493
+const MEDIUMBLOB = 57494
+const LONGBLOB = 57495
+const JSON = 57496
+const ENUM = 57497
+const UUID = 57498
+const GEOMETRY = 57499
+const POINT = 57500
+const LINESTRING = 57501
+const POLYGON = 57502
+const GEOMETRYCOLLECTION = 57503
+const MULTIPOINT = 57504
+const MULTILINESTRING = 57505
+const MULTIPOLYGON = 57506
+const INT1 = 57507
+const INT2 = 57508
+const INT3 = 57509
+const INT4 = 57510
+const INT8 = 57511
+const SQL_SMALL_RESULT = 57512
+const SQL_BIG_RESULT = 57513
+const SQL_BUFFER_RESULT = 57514
+const LOW_PRIORITY = 57515
+const HIGH_PRIORITY = 57516
+const DELAYED = 57517
+const CREATE = 57518
+const ALTER = 57519
+const DROP = 57520
+const RENAME = 57521
+const ANALYZE = 57522
+const ADD = 57523
+const SCHEMA = 57524
+const TABLE = 57525
+const INDEX = 57526
+const VIEW = 57527
+const TO = 57528
+const IGNORE = 57529
+const IF = 57530
+const PRIMARY = 57531
+const COLUMN = 57532
+const CONSTRAINT = 57533
+const SPATIAL = 57534
+const FULLTEXT = 57535
+const FOREIGN = 57536
+const KEY_BLOCK_SIZE = 57537
+const SHOW = 57538
+const DESCRIBE = 57539
+const EXPLAIN = 57540
+const DATE = 57541
+const ESCAPE = 57542
+const REPAIR = 57543
+const OPTIMIZE = 57544
+const TRUNCATE = 57545
+const MAXVALUE = 57546
+const PARTITION = 57547
+const REORGANIZE = 57548
+const LESS = 57549
+const THAN = 57550
+const PROCEDURE = 57551
+const TRIGGER = 57552
+const STATUS = 57553
+const VARIABLES = 57554
+const ROLE = 57555
+const PROXY = 57556
+const AVG_ROW_LENGTH = 57557
+const STORAGE = 57558
+const DISK = 57559
+const MEMORY = 57560
+const CHECKSUM = 57561
+const COMPRESSION = 57562
+const DATA = 57563
+const DIRECTORY = 57564
+const DELAY_KEY_WRITE = 57565
+const ENCRYPTION = 57566
+const ENGINE = 57567
+const MAX_ROWS = 57568
+const MIN_ROWS = 57569
+const PACK_KEYS = 57570
+const ROW_FORMAT = 57571
+const STATS_AUTO_RECALC = 57572
+const STATS_PERSISTENT = 57573
+const STATS_SAMPLE_PAGES = 57574
+const DYNAMIC = 57575
+const COMPRESSED = 57576
+const REDUNDANT = 57577
+const COMPACT = 57578
+const FIXED = 57579
+const COLUMN_FORMAT = 57580
+const AUTO_RANDOM = 57581
+const RESTRICT = 57582
+const CASCADE = 57583
+const ACTION = 57584
+const PARTIAL = 57585
+const SIMPLE = 57586
+const CHECK = 57587
+const ENFORCED = 57588
+const RANGE = 57589
+const LIST = 57590
+const ALGORITHM = 57591
+const LINEAR = 57592
+const PARTITIONS = 57593
+const SUBPARTITION = 57594
+const SUBPARTITIONS = 57595
+const TYPE = 57596
+const ANY = 57597
+const SOME = 57598
+const PREPARE = 57599
+const DEALLOCATE = 57600
+const PROPERTIES = 57601
+const PARSER = 57602
+const VISIBLE = 57603
+const INVISIBLE = 57604
+const BTREE = 57605
+const HASH = 57606
+const RTREE = 57607
+const BSI = 57608
+const ZONEMAP = 57609
+const LEADING = 57610
+const BOTH = 57611
+const TRAILING = 57612
+const UNKNOWN = 57613
+const EXPIRE = 57614
+const ACCOUNT = 57615
+const UNLOCK = 57616
+const DAY = 57617
+const NEVER = 57618
+const SECOND = 57619
+const ASCII = 57620
+const COALESCE = 57621
+const COLLATION = 57622
+const HOUR = 57623
+const MICROSECOND = 57624
+const MINUTE = 57625
+const MONTH = 57626
+const QUARTER = 57627
+const REPEAT = 57628
+const REVERSE = 57629
+const ROW_COUNT = 57630
+const WEEK = 57631
+const REVOKE = 57632
+const FUNCTION = 57633
+const PRIVILEGES = 57634
+const TABLESPACE = 57635
+const EXECUTE = 57636
+const SUPER = 57637
+const GRANT = 57638
+const OPTION = 57639
+const REFERENCES = 57640
+const REPLICATION = 57641
+const SLAVE = 57642
+const CLIENT = 57643
+const USAGE = 57644
+const RELOAD = 57645
+const FILE = 57646
+const TEMPORARY = 57647
+const ROUTINE = 57648
+const EVENT = 57649
+const SHUTDOWN = 57650
+const NULLX = 57651
+const AUTO_INCREMENT = 57652
+const APPROXNUM = 57653
+const SIGNED = 57654
+const UNSIGNED = 57655
+const ZEROFILL = 57656
+const USER = 57657
+const IDENTIFIED = 57658
+const CIPHER = 57659
+const ISSUER = 57660
+const X509 = 57661
+const SUBJECT = 57662
+const SAN = 57663
+const REQUIRE = 57664
+const SSL = 57665
+const NONE = 57666
+const PASSWORD = 57667
+const MAX_QUERIES_PER_HOUR = 57668
+const MAX_UPDATES_PER_HOUR = 57669
+const MAX_CONNECTIONS_PER_HOUR = 57670
+const MAX_USER_CONNECTIONS = 57671
+const FORMAT = 57672
+const VERBOSE = 57673
+const CONNECTION = 57674
+const LOAD = 57675
+const INFILE = 57676
+const TERMINATED = 57677
+const OPTIONALLY = 57678
+const ENCLOSED = 57679
+const ESCAPED = 57680
+const STARTING = 57681
+const LINES = 57682
+const DATABASES = 57683
+const TABLES = 57684
+const EXTENDED = 57685
+const FULL = 57686
+const PROCESSLIST = 57687
+const FIELDS = 57688
+const COLUMNS = 57689
+const OPEN = 57690
+const ERRORS = 57691
+const WARNINGS = 57692
+const INDEXES = 57693
+const SCHEMAS = 57694
+const NAMES = 57695
+const GLOBAL = 57696
+const SESSION = 57697
+const ISOLATION = 57698
+const LEVEL = 57699
+const READ = 57700
+const WRITE = 57701
+const ONLY = 57702
+const REPEATABLE = 57703
+const COMMITTED = 57704
+const UNCOMMITTED = 57705
+const SERIALIZABLE = 57706
+const LOCAL = 57707
+const CURRENT_TIMESTAMP = 57708
+const DATABASE = 57709
+const CURRENT_TIME = 57710
+const LOCALTIME = 57711
+const LOCALTIMESTAMP = 57712
+const UTC_DATE = 57713
+const UTC_TIME = 57714
+const UTC_TIMESTAMP = 57715
+const REPLACE = 57716
+const CONVERT = 57717
+const SEPARATOR = 57718
+const CURRENT_DATE = 57719
+const CURRENT_USER = 57720
+const CURRENT_ROLE = 57721
+const SECOND_MICROSECOND = 57722
+const MINUTE_MICROSECOND = 57723
+const MINUTE_SECOND = 57724
+const HOUR_MICROSECOND = 57725
+const HOUR_SECOND = 57726
+const HOUR_MINUTE = 57727
+const DAY_MICROSECOND = 57728
+const DAY_SECOND = 57729
+const DAY_MINUTE = 57730
+const DAY_HOUR = 57731
+const YEAR_MONTH = 57732
+const SQL_TSI_HOUR = 57733
+const SQL_TSI_DAY = 57734
+const SQL_TSI_WEEK = 57735
+const SQL_TSI_MONTH = 57736
+const SQL_TSI_QUARTER = 57737
+const SQL_TSI_YEAR = 57738
+const SQL_TSI_SECOND = 57739
+const SQL_TSI_MINUTE = 57740
+const RECURSIVE = 57741
+const CONFIG = 57742
+const LATERAL = 57743
+const MATCH = 57744
+const AGAINST = 57745
+const BOOLEAN = 57746
+const LANGUAGE = 57747
+const WITH = 57748
+const QUERY = 57749
+const EXPANSION = 57750
+const ADDDATE = 57751
+const BIT_AND = 57752
+const BIT_OR = 57753
+const BIT_XOR = 57754
+const CAST = 57755
+const COUNT = 57756
+const APPROX_COUNT_DISTINCT = 57757
+const APPROX_PERCENTILE = 57758
+const CURDATE = 57759
+const CURTIME = 57760
+const DATE_ADD = 57761
+const DATE_SUB = 57762
+const EXTRACT = 57763
+const GROUP_CONCAT = 57764
+const MAX = 57765
+const MID = 57766
+const MIN = 57767
+const NOW = 57768
+const POSITION = 57769
+const SESSION_USER = 57770
+const STD = 57771
+const STDDEV = 57772
+const STDDEV_POP = 57773
+const STDDEV_SAMP = 57774
+const SUBDATE = 57775
+const SUBSTR = 57776
+const SUBSTRING = 57777
+const SUM = 57778
+const SYSDATE = 57779
+const SYSTEM_USER = 57780
+const TRANSLATE = 57781
+const TRIM = 57782
+const VARIANCE = 57783
+const VAR_POP = 57784
+const VAR_SAMP = 57785
+const AVG = 57786
+const OVER = 57787
+const ROWS = 57788
+const UNBOUNDED = 57789
+const PRECEDING = 57790
+const FOLLOWING = 57791
+const CURRENT = 57792
+const ROLLUP = 57793
+const CUBE = 57794
+const GROUPING = 57795
+const SETS = 57796
+const ROW = 57797
+const OUTFILE = 57798
+const HEADER = 57799
+const MAX_FILE_SIZE = 57800
+const FORCE_QUOTE = 57801
+const UNUSED = 57802
 
 var yyToknames = [...]string{
 	"$end",
@@ -470,6 +485,8 @@ var yyToknames = [...]string{
 	"LEX_ERROR",
 	"EMPTY",
 	"UNION",
+	"EXCEPT",
+	"INTERSECT",
 	"SELECT",
 	"STREAM",
 	"INSERT",
@@ -538,6 +555,8 @@ var yyToknames = [...]string{
 	"NULL",
 	"TRUE",
 	"FALSE",
+	"JSON_EXTRACT_OP",
+	"JSON_UNQUOTE_EXTRACT_OP",
 	"LOWER_THAN_CHARSET",
 	"CHARSET",
 	"UNIQUE",
@@ -632,6 +651,7 @@ var yyToknames = [...]string{
 	"LONGBLOB",
 	"JSON",
 	"ENUM",
+	"UUID",
 	"GEOMETRY",
 	"POINT",
 	"LINESTRING",
@@ -841,7 +861,6 @@ var yyToknames = [...]string{
 	"UNCOMMITTED",
 	"SERIALIZABLE",
 	"LOCAL",
-	"EXCEPT",
 	"CURRENT_TIMESTAMP",
 	"DATABASE",
 	"CURRENT_TIME",
@@ -877,6 +896,7 @@ var yyToknames = [...]string{
 	"SQL_TSI_MINUTE",
 	"RECURSIVE",
 	"CONFIG",
+	"LATERAL",
 	"MATCH",
 	"AGAINST",
 	"BOOLEAN",
@@ -920,6 +940,16 @@ var yyToknames = [...]string{
 	"VAR_POP",
 	"VAR_SAMP",
 	"AVG",
+	"OVER",
+	"ROWS",
+	"UNBOUNDED",
+	"PRECEDING",
+	"FOLLOWING",
+	"CURRENT",
+	"ROLLUP",
+	"CUBE",
+	"GROUPING",
+	"SETS",
 	"ROW",
 	"OUTFILE",
 	"HEADER",
@@ -936,2537 +966,2884 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:6750
+//line mysql_sql.y:7205
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 61,
-	18, 407,
-	-2, 388,
-	-1, 66,
-	196, 557,
-	-2, 593,
-	-1, 81,
-	223, 273,
-	224, 273,
-	-2, 294,
-	-1, 333,
-	60, 1380,
-	464, 1380,
-	-2, 97,
-	-1, 352,
-	60, 718,
-	464, 718,
-	-2, 555,
-	-1, 353,
-	60, 548,
-	464, 548,
-	-2, 556,
-	-1, 359,
-	18, 408,
-	-2, 371,
-	-1, 428,
-	91, 1260,
-	102, 1260,
-	121, 1260,
-	-2, 1083,
-	-1, 456,
-	18, 408,
-	-2, 371,
-	-1, 603,
-	55, 1409,
-	-2, 1416,
-	-1, 611,
-	55, 1410,
-	-2, 1424,
-	-1, 613,
-	55, 1406,
-	-2, 1426,
-	-1, 614,
-	55, 1407,
-	-2, 1427,
-	-1, 619,
-	55, 1408,
-	-2, 1433,
-	-1, 620,
-	55, 1411,
-	-2, 1434,
-	-1, 621,
-	55, 1412,
-	-2, 1435,
-	-1, 622,
-	55, 847,
-	-2, 1436,
-	-1, 623,
-	55, 848,
-	-2, 1437,
+	-1, 62,
+	20, 437,
+	-2, 416,
+	-1, 67,
+	201, 606,
+	-2, 642,
+	-1, 83,
+	228, 296,
+	229, 296,
+	-2, 317,
+	-1, 341,
+	62, 1470,
+	479, 1470,
+	-2, 102,
+	-1, 360,
+	62, 771,
+	479, 771,
+	-2, 604,
+	-1, 361,
+	62, 597,
+	479, 597,
+	-2, 605,
+	-1, 367,
+	20, 438,
+	-2, 399,
+	-1, 440,
+	95, 1345,
+	106, 1345,
+	125, 1345,
+	-2, 1161,
+	-1, 469,
+	20, 438,
+	-2, 399,
 	-1, 624,
-	55, 849,
-	-2, 1438,
-	-1, 626,
-	55, 1413,
-	-2, 1440,
-	-1, 627,
-	55, 866,
-	-2, 1441,
-	-1, 628,
-	55, 865,
-	-2, 1442,
-	-1, 631,
-	55, 1414,
-	-2, 1445,
+	57, 1500,
+	-2, 1506,
 	-1, 632,
-	55, 1415,
-	-2, 1446,
-	-1, 638,
-	55, 928,
-	-2, 1260,
-	-1, 639,
-	55, 937,
-	-2, 1286,
+	57, 1501,
+	-2, 1514,
+	-1, 634,
+	57, 1497,
+	-2, 1516,
+	-1, 635,
+	57, 1498,
+	-2, 1517,
 	-1, 640,
-	55, 941,
-	-2, 1325,
-	-1, 641,
-	55, 952,
-	-2, 1385,
+	57, 1499,
+	-2, 1523,
 	-1, 642,
-	55, 954,
-	-2, 1395,
+	57, 1502,
+	-2, 1525,
 	-1, 643,
-	55, 942,
-	-2, 1400,
+	57, 920,
+	-2, 1526,
 	-1, 644,
-	55, 950,
-	-2, 1404,
+	57, 921,
+	-2, 1527,
 	-1, 645,
-	55, 931,
-	-2, 1405,
-	-1, 795,
-	1, 583,
-	57, 583,
-	463, 583,
-	-2, 590,
-	-1, 932,
-	18, 407,
-	-2, 776,
-	-1, 978,
-	121, 1093,
-	-2, 1091,
-	-1, 980,
-	121, 497,
-	-2, 1088,
-	-1, 981,
-	121, 498,
-	-2, 1089,
-	-1, 1169,
-	1, 584,
-	57, 584,
-	463, 584,
-	-2, 590,
-	-1, 1256,
-	55, 995,
-	-2, 1402,
-	-1, 1257,
-	55, 996,
-	-2, 1403,
-	-1, 1418,
-	53, 328,
-	56, 328,
-	-2, 682,
-	-1, 1733,
-	76, 590,
-	117, 590,
-	153, 590,
-	156, 590,
-	-2, 630,
-	-1, 1735,
-	257, 743,
-	-2, 724,
-	-1, 1759,
-	53, 328,
-	56, 328,
+	57, 922,
+	-2, 1528,
+	-1, 647,
+	57, 1503,
+	-2, 1530,
+	-1, 648,
+	57, 940,
+	-2, 1531,
+	-1, 649,
+	57, 939,
+	-2, 1532,
+	-1, 652,
+	57, 1504,
+	-2, 1535,
+	-1, 653,
+	57, 1505,
+	-2, 1536,
+	-1, 659,
+	57, 1004,
+	-2, 1345,
+	-1, 660,
+	57, 1013,
+	-2, 1370,
+	-1, 661,
+	57, 1017,
+	-2, 1409,
+	-1, 662,
+	57, 1028,
+	-2, 1475,
+	-1, 663,
+	57, 1029,
+	-2, 1476,
+	-1, 664,
+	57, 1031,
+	-2, 1486,
+	-1, 665,
+	57, 1018,
+	-2, 1491,
+	-1, 666,
+	57, 1026,
+	-2, 1495,
+	-1, 667,
+	57, 1007,
+	-2, 1496,
+	-1, 822,
+	1, 632,
+	59, 632,
+	478, 632,
+	-2, 639,
+	-1, 972,
+	20, 437,
+	-2, 829,
+	-1, 1025,
+	125, 1171,
+	-2, 1169,
+	-1, 1027,
+	125, 545,
+	-2, 1166,
+	-1, 1028,
+	125, 546,
+	-2, 1167,
+	-1, 1224,
+	1, 633,
+	59, 633,
+	478, 633,
+	-2, 639,
+	-1, 1323,
+	57, 1072,
+	-2, 1493,
+	-1, 1324,
+	57, 1073,
+	-2, 1494,
+	-1, 1495,
+	55, 354,
+	58, 354,
+	-2, 735,
+	-1, 1699,
+	262, 796,
+	-2, 777,
+	-1, 1846,
+	80, 639,
+	121, 639,
+	157, 639,
+	160, 639,
 	-2, 683,
-	-1, 1843,
-	76, 590,
-	117, 590,
-	153, 590,
-	156, 590,
-	-2, 631,
-	-1, 1871,
-	257, 743,
-	-2, 725,
-	-1, 2255,
-	56, 605,
-	57, 605,
-	-2, 590,
-	-1, 2259,
-	56, 605,
-	57, 605,
-	-2, 590,
-	-1, 2271,
-	56, 609,
-	57, 609,
-	-2, 590,
-	-1, 2274,
-	56, 610,
-	57, 610,
-	-2, 590,
+	-1, 1872,
+	55, 354,
+	58, 354,
+	-2, 736,
+	-1, 1881,
+	262, 796,
+	-2, 778,
+	-1, 1996,
+	80, 639,
+	121, 639,
+	157, 639,
+	160, 639,
+	-2, 684,
+	-1, 2040,
+	58, 654,
+	59, 654,
+	-2, 639,
+	-1, 2144,
+	58, 654,
+	59, 654,
+	-2, 639,
+	-1, 2321,
+	58, 658,
+	59, 658,
+	-2, 639,
+	-1, 2377,
+	58, 659,
+	59, 659,
+	-2, 639,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 23312
+const yyLast = 26755
 
 var yyAct = [...]int{
-	782, 1259, 2266, 2261, 2259, 2232, 2258, 648, 2206, 1901,
-	771, 2097, 666, 2177, 2221, 1216, 1883, 2158, 2071, 2159,
-	1839, 2074, 2052, 537, 1727, 1156, 1899, 98, 863, 574,
-	2007, 582, 312, 318, 2059, 318, 1260, 1900, 1212, 829,
-	360, 1914, 316, 22, 101, 1891, 1753, 1788, 799, 359,
-	322, 646, 1558, 1872, 801, 1582, 1454, 1890, 354, 354,
-	426, 516, 1554, 1421, 1542, 525, 97, 1799, 849, 383,
-	1803, 1791, 602, 1433, 1781, 1599, 823, 1570, 1211, 1559,
-	960, 1629, 1402, 1619, 1162, 1563, 1496, 1739, 1175, 427,
-	1637, 1608, 647, 451, 766, 975, 978, 98, 969, 961,
-	1343, 1459, 657, 1329, 970, 1247, 826, 842, 1432, 1396,
-	1198, 1174, 3, 60, 824, 808, 1170, 1258, 1847, 784,
-	527, 767, 1273, 649, 595, 305, 315, 15, 434, 29,
-	429, 1261, 313, 6, 846, 809, 453, 22, 466, 431,
-	314, 5, 385, 324, 810, 865, 1138, 769, 308, 1214,
-	868, 899, 418, 566, 1238, 382, 550, 758, 326, 325,
-	29, 504, 94, 1925, 1145, 483, 12, 7, 4, 1835,
-	1726, 779, 963, 1543, 594, 317, 583, 93, 2124, 1141,
-	1378, 92, 1397, 93, 89, 26, 83, 67, 552, 2114,
-	514, 1385, 544, 93, 93, 26, 83, 67, 678, 61,
-	836, 380, 356, 419, 432, 329, 329, 450, 548, 93,
-	320, 26, 83, 67, 303, 503, 1388, 438, 437, 404,
-	372, 15, 1521, 29, 93, 90, 812, 6, 304, 774,
-	61, 90, 831, 832, 498, 5, 553, 494, 747, 727,
-	536, 90, 90, 535, 538, 539, 2181, 436, 2005, 2146,
-	93, 2144, 724, 538, 539, 2162, 2163, 90, 2008, 2009,
-	2010, 2011, 1546, 2085, 390, 1547, 2088, 1548, 1928, 1728,
-	778, 1370, 726, 1571, 1572, 1573, 1574, 460, 1600, 1603,
-	1143, 469, 843, 1405, 1403, 405, 1404, 1406, 489, 433,
-	1911, 440, 485, 61, 1141, 1787, 1786, 1832, 90, 496,
-	497, 1405, 1403, 1400, 1404, 1406, 459, 1399, 1398, 1888,
-	495, 1723, 759, 484, 1991, 458, 490, 1770, 1766, 2123,
-	318, 2172, 98, 1769, 319, 1250, 1251, 1252, 2148, 1980,
-	1602, 1464, 1251, 1252, 2251, 2267, 1248, 374, 761, 2060,
-	2061, 2062, 2064, 2063, 455, 457, 2186, 371, 370, 435,
-	2143, 2121, 2099, 431, 456, 2193, 2161, 2073, 1974, 1943,
-	361, 476, 2095, 2096, 66, 2099, 91, 2242, 366, 1942,
-	358, 401, 1408, 1409, 1410, 1411, 2105, 545, 562, 1386,
-	2150, 2151, 383, 492, 81, 2126, 2127, 2268, 396, 534,
-	533, 2262, 2233, 493, 1931, 452, 487, 1497, 517, 549,
-	2083, 1382, 439, 551, 480, 1225, 1149, 515, 488, 491,
-	1567, 1767, 786, 396, 519, 354, 1724, 321, 486, 1452,
-	760, 427, 427, 427, 522, 432, 354, 354, 518, 1414,
-	520, 471, 470, 1221, 1575, 469, 1783, 1782, 29, 29,
-	556, 318, 598, 598, 834, 377, 378, 379, 410, 835,
-	430, 1220, 1965, 833, 1969, 729, 579, 2246, 462, 463,
-	369, 1223, 1222, 554, 555, 398, 407, 408, 397, 1592,
-	365, 2210, 1762, 745, 1588, 1549, 1461, 1422, 597, 597,
-	354, 354, 459, 354, 1376, 1375, 1369, 730, 1364, 1190,
-	398, 772, 1154, 397, 725, 474, 1135, 412, 411, 880,
-	733, 354, 354, 581, 2037, 472, 529, 454, 61, 61,
-	433, 857, 917, 541, 542, 1537, 754, 354, 1535, 354,
-	528, 795, 530, 373, 383, 395, 2149, 800, 1568, 464,
-	2125, 98, 2072, 399, 2228, 791, 1249, 506, 2219, 567,
-	2224, 1543, 1463, 508, 781, 817, 500, 785, 1415, 354,
-	568, 98, 538, 539, 538, 539, 1583, 844, 561, 2109,
-	509, 565, 329, 354, 427, 1536, 354, 815, 1144, 482,
-	805, 1765, 1164, 576, 576, 1606, 787, 572, 573, 1768,
-	1366, 1379, 858, 524, 804, 471, 470, 1227, 68, 354,
-	354, 862, 98, 29, 68, 776, 813, 814, 877, 756,
-	753, 461, 29, 850, 68, 68, 750, 818, 866, 850,
-	850, 788, 584, 736, 749, 1697, 593, 722, 731, 303,
-	68, 803, 531, 777, 569, 570, 571, 1140, 329, 585,
-	773, 864, 564, 304, 732, 68, 881, 867, 762, 770,
-	752, 751, 748, 819, 588, 589, 590, 591, 592, 2225,
-	61, 1970, 1971, 934, 806, 807, 540, 775, 1344, 543,
-	1502, 68, 790, 61, 430, 1967, 329, 409, 780, 1966,
-	740, 741, 61, 811, 1344, 1564, 1567, 1139, 796, 797,
-	1976, 1405, 1403, 860, 1404, 1406, 1394, 845, 933, 396,
-	392, 789, 394, 404, 873, 375, 941, 391, 389, 388,
-	400, 393, 1975, 402, 403, 822, 840, 855, 856, 1743,
-	329, 532, 1263, 1262, 943, 1738, 841, 2038, 2040, 2041,
-	2042, 2039, 2241, 852, 853, 854, 1960, 967, 967, 972,
-	1937, 876, 873, 861, 2257, 2238, 2203, 329, 920, 921,
-	922, 923, 924, 917, 859, 935, 936, 937, 938, 980,
-	384, 874, 875, 876, 873, 413, 744, 1840, 800, 2182,
-	1699, 939, 2240, 2187, 743, 432, 398, 2048, 2133, 397,
-	2222, 2223, 2046, 1825, 443, 448, 449, 1336, 981, 406,
-	1271, 2081, 907, 957, 2080, 2054, 2044, 98, 98, 1702,
-	1272, 1334, 1335, 1333, 1568, 2034, 2032, 1176, 1268, 1561,
-	2031, 2171, 2047, 1562, 1565, 2030, 1137, 2045, 2027, 2021,
-	1824, 2018, 312, 2017, 1676, 1673, 1674, 1675, 2154, 1192,
-	1707, 2043, 1706, 1705, 1703, 866, 1159, 1161, 1926, 1919,
-	2033, 966, 874, 875, 876, 873, 951, 354, 1918, 1180,
-	432, 918, 919, 920, 921, 922, 923, 924, 917, 1917,
-	932, 1484, 1913, 1912, 867, 1566, 1905, 1749, 354, 1748,
-	1181, 1182, 1183, 1747, 1746, 1533, 29, 734, 2053, 2103,
-	598, 2102, 98, 959, 1217, 2079, 2035, 1134, 2028, 1243,
-	2024, 1245, 1704, 979, 2023, 2022, 1133, 1962, 1927, 850,
-	850, 850, 973, 1915, 974, 1455, 1483, 1838, 1836, 1269,
-	1270, 792, 793, 794, 1193, 1756, 597, 1580, 1579, 1578,
-	1239, 1240, 1241, 1242, 1171, 1577, 693, 692, 874, 875,
-	876, 873, 1148, 1235, 1151, 433, 1186, 1150, 1188, 1184,
-	953, 914, 1266, 445, 446, 447, 61, 1187, 913, 735,
-	2271, 957, 2249, 1511, 1218, 1308, 1467, 1510, 1237, 1467,
-	2276, 811, 2130, 323, 1353, 1185, 1253, 1157, 1158, 2129,
-	1189, 2270, 2269, 2110, 1652, 1147, 2252, 1317, 1318, 1319,
-	1320, 1321, 1322, 1323, 1324, 1325, 1326, 1327, 1328, 1345,
-	1224, 1348, 1338, 1339, 329, 1998, 2227, 1228, 1229, 1230,
-	1505, 2248, 2247, 1504, 1236, 1708, 1709, 1147, 2236, 2272,
-	2239, 874, 875, 876, 873, 1232, 1994, 1355, 874, 875,
-	876, 873, 355, 1993, 1358, 1826, 874, 875, 876, 873,
-	1147, 2235, 1337, 2209, 2208, 1264, 1265, 1823, 1267, 1219,
-	1331, 1987, 2169, 1815, 1303, 1304, 1305, 1306, 1307, 1987,
-	2164, 1313, 1314, 1315, 1316, 2155, 916, 915, 925, 926,
-	918, 919, 920, 921, 922, 923, 924, 917, 1234, 2152,
-	1640, 1780, 1347, 1349, 1350, 1733, 1346, 874, 875, 876,
-	873, 1716, 1354, 1618, 1356, 2141, 2140, 1659, 1663, 1665,
-	1667, 1669, 1670, 1672, 1593, 1676, 1673, 1674, 1675, 1514,
-	1357, 1654, 1655, 1656, 1657, 1638, 1639, 1660, 1512, 1641,
-	802, 1642, 1643, 1644, 1645, 1646, 1647, 1648, 1649, 1650,
-	1651, 1658, 2216, 1987, 2119, 1153, 2077, 1471, 1509, 1662,
-	1664, 1666, 1668, 1671, 916, 915, 925, 926, 918, 919,
-	920, 921, 922, 923, 924, 917, 1371, 1508, 874, 875,
-	876, 873, 354, 1208, 363, 354, 1587, 2218, 459, 1420,
-	354, 1506, 1152, 1653, 362, 1391, 1476, 1381, 916, 915,
-	925, 926, 918, 919, 920, 921, 922, 923, 924, 917,
-	874, 875, 876, 873, 1418, 874, 875, 876, 873, 2003,
-	1424, 884, 885, 886, 887, 888, 889, 890, 882, 1389,
-	1390, 1429, 785, 1987, 2118, 587, 459, 98, 98, 98,
-	98, 874, 875, 876, 873, 1434, 1985, 1473, 459, 98,
-	1449, 1987, 2117, 1416, 1987, 2116, 802, 1434, 1920, 1466,
-	22, 2108, 2107, 1811, 1451, 1393, 354, 1431, 874, 875,
-	876, 873, 1413, 1352, 98, 98, 2002, 2001, 1875, 1425,
-	874, 875, 876, 873, 1383, 874, 875, 876, 873, 2000,
-	1999, 1351, 1450, 1996, 1997, 1996, 1995, 1810, 1217, 1605,
-	1372, 1179, 1992, 1377, 1987, 1986, 1630, 1631, 586, 1472,
-	2212, 1809, 1878, 1457, 1458, 1392, 1467, 1691, 1873, 874,
-	875, 876, 873, 1886, 1887, 1417, 1412, 499, 1171, 1874,
-	1423, 478, 1380, 874, 875, 876, 873, 1467, 1468, 1467,
-	1677, 1469, 1470, 1430, 15, 1428, 29, 1426, 1359, 1427,
-	6, 1435, 1436, 1437, 1438, 1448, 1447, 1446, 5, 1734,
-	1453, 1467, 1475, 1879, 925, 926, 918, 919, 920, 921,
-	922, 923, 924, 917, 1491, 1456, 2194, 1714, 1467, 1474,
-	1478, 1479, 1480, 1481, 1482, 477, 1486, 2191, 1696, 478,
-	1487, 1488, 1489, 1490, 1462, 1179, 1373, 1465, 1661, 874,
-	875, 876, 873, 967, 1207, 1525, 967, 1690, 1141, 1528,
-	874, 875, 876, 873, 1717, 354, 61, 1689, 1499, 354,
-	354, 1503, 1208, 354, 1419, 1531, 480, 1494, 1495, 874,
-	875, 876, 873, 1688, 1515, 1365, 459, 850, 1208, 874,
-	875, 876, 873, 850, 1522, 1557, 1686, 1885, 98, 1560,
-	1368, 1367, 1362, 1361, 1532, 874, 875, 876, 873, 871,
-	98, 1176, 1341, 1591, 1179, 1178, 1687, 1493, 1420, 1520,
-	1683, 1507, 1147, 1146, 1881, 1527, 1331, 1492, 738, 737,
-	1234, 1155, 479, 1501, 1524, 1136, 432, 1581, 874, 875,
-	876, 873, 874, 875, 876, 873, 1880, 1882, 523, 1517,
-	1516, 563, 1622, 869, 1526, 1529, 1530, 1523, 1682, 1534,
-	1584, 1585, 2189, 874, 875, 876, 873, 1541, 2132, 2069,
-	2057, 1594, 1595, 2055, 1576, 93, 480, 1604, 83, 67,
-	874, 875, 876, 873, 93, 1681, 1589, 2050, 874, 875,
-	876, 873, 1616, 2012, 1624, 1790, 354, 1983, 1982, 1590,
-	1586, 1981, 354, 1978, 1678, 1617, 1888, 874, 875, 876,
-	873, 1684, 1685, 1680, 1973, 1958, 1538, 1540, 1876, 1613,
-	1979, 932, 1679, 90, 526, 1710, 1800, 1792, 1804, 1698,
-	354, 1712, 90, 1807, 1711, 874, 875, 876, 873, 1596,
-	1622, 1627, 98, 1797, 874, 875, 876, 873, 1796, 1776,
-	61, 1737, 1751, 1744, 1332, 1713, 90, 1395, 1360, 1226,
-	1194, 1695, 1177, 874, 875, 876, 873, 958, 956, 955,
-	1692, 954, 952, 354, 1167, 354, 1626, 900, 98, 1759,
-	1694, 1732, 475, 1731, 1625, 1701, 949, 948, 946, 945,
-	1715, 944, 1718, 2199, 942, 1340, 459, 912, 874, 875,
-	876, 873, 1752, 911, 910, 1434, 874, 875, 876, 873,
-	909, 908, 906, 1779, 905, 1741, 1722, 874, 875, 876,
-	873, 1217, 904, 903, 902, 901, 898, 897, 1736, 1735,
-	896, 895, 2197, 1774, 1760, 1772, 1775, 1745, 1740, 1777,
-	1740, 1763, 1742, 576, 431, 1761, 894, 1778, 1750, 576,
-	893, 892, 891, 757, 1758, 1757, 728, 481, 2160, 1693,
-	1609, 1610, 1612, 1407, 1764, 1233, 501, 1443, 1441, 1773,
-	1615, 1614, 1444, 1442, 1440, 1439, 2256, 1719, 1794, 1795,
-	916, 915, 925, 926, 918, 919, 920, 921, 922, 923,
-	924, 917, 1798, 1363, 1172, 1802, 1784, 1445, 1544, 1204,
-	1205, 1793, 1200, 1203, 1204, 1205, 1201, 505, 1202, 1206,
-	1551, 354, 354, 1812, 1801, 98, 1157, 1158, 48, 28,
-	576, 27, 1754, 1165, 821, 459, 1814, 1844, 1929, 1892,
-	1894, 1550, 1892, 1892, 1557, 1805, 1210, 1808, 1720, 61,
-	1816, 798, 459, 1818, 850, 1820, 2174, 1721, 300, 301,
-	547, 302, 1263, 1262, 1833, 546, 1813, 511, 512, 1195,
-	1132, 507, 2213, 2137, 1819, 1817, 2135, 800, 2090, 2089,
-	2087, 1898, 2015, 1821, 1822, 1893, 1828, 1831, 1889, 1200,
-	1203, 1204, 1205, 1201, 2013, 1202, 1206, 1869, 1841, 1895,
-	1896, 1837, 1771, 1730, 1908, 1897, 1729, 1621, 363, 510,
-	362, 1620, 1903, 1904, 1460, 2200, 802, 1477, 362, 2201,
-	2200, 2201, 1374, 1761, 473, 1909, 1209, 386, 1907, 34,
-	1, 521, 376, 1309, 513, 742, 442, 468, 739, 1916,
-	467, 465, 1342, 1274, 679, 962, 968, 1933, 2051, 2173,
-	2205, 2131, 2176, 755, 665, 2082, 1545, 1923, 2004, 2084,
-	2006, 1387, 1921, 1922, 1384, 502, 1518, 1519, 1829, 1830,
-	691, 682, 947, 684, 723, 444, 681, 1906, 1601, 364,
-	354, 441, 387, 1910, 1725, 1785, 1806, 1789, 2265, 98,
-	2255, 2231, 2211, 2098, 2250, 2142, 2192, 2185, 1894, 2094,
-	1930, 1934, 1935, 327, 1938, 1939, 1940, 1941, 1936, 837,
-	1944, 1945, 1946, 1947, 1948, 1949, 1950, 1951, 1952, 1953,
-	1954, 1955, 1956, 1957, 1889, 1959, 1963, 1961, 1977, 557,
-	416, 2070, 1569, 1401, 1163, 1142, 2016, 768, 928, 1989,
-	931, 328, 2122, 1984, 1990, 2056, 367, 1166, 368, 1988,
-	1169, 1168, 1254, 431, 929, 930, 927, 2049, 916, 915,
-	925, 926, 918, 919, 920, 921, 922, 923, 924, 917,
-	883, 1330, 2014, 950, 940, 600, 1500, 656, 650, 459,
-	1598, 1597, 459, 459, 459, 2029, 1884, 816, 459, 33,
-	32, 1217, 2019, 2020, 31, 872, 976, 680, 2025, 2026,
-	100, 1191, 977, 2091, 1924, 2058, 2178, 664, 2066, 2067,
-	2068, 663, 662, 2092, 2076, 2065, 661, 1199, 1197, 1196,
-	354, 354, 2078, 828, 827, 2075, 870, 1754, 2157, 2156,
-	2112, 2113, 1834, 1972, 2093, 2036, 1968, 2086, 915, 925,
-	926, 918, 919, 920, 921, 922, 923, 924, 917, 2100,
-	2101, 98, 1964, 2104, 1843, 1842, 1870, 1871, 459, 1877,
-	1636, 1632, 1634, 1635, 1633, 1700, 1628, 1555, 1556, 1553,
-	459, 1552, 1611, 1607, 964, 971, 783, 95, 825, 2106,
-	11, 10, 746, 9, 2115, 14, 21, 20, 19, 56,
-	864, 55, 2111, 54, 53, 18, 2120, 8, 52, 51,
-	2214, 50, 17, 2128, 16, 46, 44, 43, 2136, 2134,
-	2138, 2139, 42, 41, 40, 39, 38, 45, 37, 2145,
-	2147, 36, 35, 65, 64, 63, 62, 23, 61, 24,
-	25, 2153, 74, 2180, 73, 70, 72, 71, 2165, 2166,
-	2167, 2168, 2184, 69, 30, 2179, 916, 915, 925, 926,
-	918, 919, 920, 921, 922, 923, 924, 917, 2183, 13,
-	2, 0, 0, 0, 0, 0, 0, 576, 576, 0,
-	0, 0, 0, 0, 2195, 0, 0, 2198, 2196, 2170,
-	0, 0, 2207, 0, 0, 0, 2202, 0, 2204, 0,
-	459, 0, 459, 0, 0, 0, 2188, 0, 2190, 772,
-	2215, 772, 2217, 0, 0, 0, 0, 0, 0, 0,
-	2180, 2230, 0, 0, 0, 0, 2226, 0, 0, 459,
-	0, 0, 2179, 2229, 0, 2234, 0, 0, 772, 2237,
-	0, 0, 0, 0, 0, 2207, 2243, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 2220, 2253, 0, 0,
-	0, 0, 0, 0, 2254, 0, 0, 0, 0, 0,
-	0, 0, 0, 2264, 0, 2245, 2263, 0, 0, 0,
-	0, 0, 0, 2273, 2275, 0, 2274, 0, 2264, 1090,
-	1077, 0, 1039, 1092, 1013, 1028, 1100, 1029, 1030, 1064,
-	992, 1048, 225, 1026, 0, 1080, 984, 1016, 1017, 986,
-	1023, 987, 1014, 1041, 169, 1012, 1051, 195, 1098, 0,
-	0, 254, 209, 0, 0, 1044, 1082, 1046, 1069, 1038,
-	1065, 1000, 1058, 1093, 1027, 1062, 1094, 0, 0, 0,
-	0, 0, 792, 793, 794, 0, 0, 0, 0, 151,
-	0, 0, 0, 0, 0, 1061, 1087, 1025, 0, 154,
-	1091, 1045, 1063, 0, 0, 985, 1059, 0, 990, 993,
-	1099, 1085, 1020, 1021, 0, 0, 0, 0, 0, 0,
-	0, 1042, 1047, 1066, 1035, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1018, 0, 1055, 0, 0, 0,
-	995, 991, 0, 1040, 0, 143, 259, 273, 152, 250,
-	286, 157, 257, 148, 224, 246, 0, 1131, 145, 271,
-	256, 206, 189, 190, 144, 0, 241, 167, 181, 164,
-	222, 0, 1089, 298, 163, 289, 994, 281, 147, 1126,
-	280, 221, 268, 272, 207, 201, 146, 270, 205, 200,
-	193, 171, 185, 233, 199, 234, 186, 211, 210, 212,
-	1110, 1111, 1112, 1113, 1114, 1122, 1123, 0, 1127, 1128,
-	1129, 999, 0, 1019, 1067, 0, 983, 1075, 1083, 1037,
-	283, 1086, 1034, 1033, 1117, 0, 1116, 258, 1118, 1119,
-	194, 1081, 1015, 1024, 299, 1022, 244, 227, 1088, 1054,
-	1130, 242, 197, 269, 235, 274, 260, 282, 238, 236,
-	139, 261, 166, 208, 149, 150, 162, 168, 170, 172,
-	173, 217, 218, 230, 249, 262, 263, 264, 165, 158,
-	243, 159, 183, 160, 140, 251, 161, 141, 231, 267,
-	1115, 179, 239, 204, 142, 203, 232, 266, 265, 290,
-	296, 297, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1124, 0, 1125, 295, 176, 982, 278,
-	0, 223, 1078, 988, 998, 996, 1031, 1056, 1057, 219,
-	294, 1071, 1074, 1072, 1101, 247, 0, 0, 0, 0,
-	0, 188, 229, 0, 248, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 989, 0, 255, 276, 288,
-	279, 1032, 1006, 1043, 287, 1009, 1007, 1070, 1008, 1060,
-	1103, 213, 214, 215, 216, 180, 0, 156, 1052, 1036,
-	1104, 1105, 1106, 1107, 1108, 1109, 1011, 1084, 175, 182,
-	0, 184, 155, 228, 178, 285, 191, 1076, 220, 187,
-	252, 192, 198, 240, 284, 226, 245, 153, 275, 253,
-	202, 177, 1005, 1010, 1004, 1049, 1050, 1095, 1096, 1097,
-	1068, 997, 1079, 1001, 1003, 1002, 0, 0, 0, 0,
-	0, 0, 0, 1498, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1073, 0, 1053, 138, 0,
-	196, 1102, 237, 174, 916, 915, 925, 926, 918, 919,
-	920, 921, 922, 923, 924, 917, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 687, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 225, 0,
-	1120, 1121, 291, 292, 293, 277, 658, 0, 0, 0,
-	169, 0, 0, 195, 0, 0, 0, 254, 209, 0,
-	1827, 0, 0, 701, 707, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 651, 0, 0, 0, 601, 693,
-	692, 667, 676, 0, 0, 151, 668, 0, 675, 669,
-	673, 672, 670, 671, 0, 638, 0, 0, 0, 0,
-	0, 0, 599, 655, 0, 659, 916, 915, 925, 926,
-	918, 919, 920, 921, 922, 923, 924, 917, 0, 0,
-	0, 0, 0, 0, 0, 0, 652, 653, 0, 0,
-	0, 0, 688, 0, 654, 0, 0, 690, 0, 677,
-	0, 143, 259, 273, 152, 250, 286, 157, 257, 148,
-	224, 246, 1513, 0, 145, 271, 256, 206, 189, 190,
-	144, 0, 241, 167, 181, 164, 222, 674, 686, 644,
-	163, 642, 685, 281, 147, 0, 280, 221, 268, 272,
-	207, 201, 146, 270, 205, 200, 193, 171, 185, 233,
-	199, 234, 186, 211, 210, 212, 0, 0, 0, 916,
-	915, 925, 926, 918, 919, 920, 921, 922, 923, 924,
-	917, 0, 0, 683, 0, 0, 283, 0, 0, 700,
-	0, 0, 0, 258, 0, 0, 194, 0, 0, 0,
-	645, 0, 244, 227, 710, 0, 0, 242, 197, 269,
-	235, 274, 260, 282, 238, 236, 139, 261, 166, 208,
-	149, 150, 162, 168, 170, 172, 173, 217, 218, 230,
-	249, 262, 263, 264, 165, 158, 243, 159, 183, 160,
-	140, 251, 161, 141, 231, 267, 0, 179, 239, 204,
-	142, 203, 232, 266, 265, 290, 296, 297, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1311,
-	1310, 1312, 295, 176, 0, 278, 698, 223, 709, 694,
-	695, 696, 699, 702, 703, 640, 643, 704, 706, 708,
-	711, 247, 0, 0, 0, 0, 0, 188, 229, 0,
-	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 255, 276, 288, 641, 0, 0, 0,
-	287, 0, 0, 0, 0, 0, 689, 213, 214, 215,
-	216, 639, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 175, 182, 0, 184, 155, 228,
-	178, 285, 191, 0, 220, 187, 252, 192, 198, 240,
-	284, 226, 245, 153, 275, 253, 202, 177, 717, 697,
-	716, 718, 719, 715, 720, 721, 705, 660, 0, 713,
-	712, 714, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 138, 0, 196, 0, 237, 174,
-	603, 604, 605, 606, 607, 608, 609, 610, 611, 612,
-	613, 614, 615, 616, 617, 117, 618, 619, 620, 621,
-	622, 623, 624, 625, 626, 627, 628, 629, 630, 631,
-	632, 633, 634, 635, 636, 637, 0, 0, 291, 292,
-	293, 277, 93, 0, 687, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 225, 0, 0, 0, 0, 0,
-	0, 0, 658, 0, 0, 0, 169, 0, 0, 195,
-	0, 0, 0, 254, 209, 0, 0, 0, 0, 701,
-	707, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	651, 0, 0, 0, 601, 693, 692, 667, 676, 0,
-	0, 151, 668, 0, 675, 669, 673, 672, 670, 671,
-	0, 638, 0, 0, 0, 0, 0, 0, 599, 655,
-	0, 659, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 652, 653, 0, 0, 0, 0, 688, 0,
-	654, 0, 0, 690, 0, 677, 0, 143, 259, 273,
-	152, 250, 286, 157, 257, 148, 224, 246, 0, 0,
-	145, 271, 256, 206, 189, 190, 144, 0, 241, 167,
-	181, 164, 222, 674, 686, 644, 163, 642, 685, 281,
-	147, 0, 280, 221, 268, 272, 207, 201, 146, 270,
-	205, 200, 193, 171, 185, 233, 199, 234, 186, 211,
-	210, 212, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 683,
-	0, 0, 283, 0, 0, 700, 0, 0, 0, 258,
-	0, 0, 194, 0, 0, 0, 645, 0, 244, 227,
-	710, 0, 0, 242, 197, 269, 235, 274, 260, 282,
-	238, 236, 139, 261, 166, 208, 149, 150, 162, 168,
-	170, 172, 173, 217, 218, 230, 249, 262, 263, 264,
-	165, 158, 243, 159, 183, 160, 140, 251, 161, 141,
-	231, 267, 0, 179, 239, 204, 142, 203, 232, 266,
-	265, 290, 296, 297, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 295, 176,
-	0, 278, 698, 223, 709, 694, 695, 696, 699, 702,
-	703, 640, 643, 704, 706, 708, 711, 247, 0, 0,
-	0, 0, 0, 188, 229, 0, 248, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
-	276, 288, 641, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 689, 213, 214, 215, 216, 639, 0, 156,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	175, 182, 0, 184, 155, 228, 178, 285, 191, 0,
-	220, 187, 252, 192, 198, 240, 284, 226, 245, 153,
-	275, 253, 202, 177, 717, 697, 716, 718, 719, 715,
-	720, 721, 705, 660, 0, 713, 712, 714, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	138, 0, 196, 68, 237, 174, 603, 604, 605, 606,
-	607, 608, 609, 610, 611, 612, 613, 614, 615, 616,
-	617, 117, 618, 619, 620, 621, 622, 623, 624, 625,
-	626, 627, 628, 629, 630, 631, 632, 633, 634, 635,
-	636, 637, 687, 0, 291, 292, 293, 277, 0, 0,
-	0, 0, 225, 0, 0, 0, 0, 0, 0, 0,
-	658, 0, 0, 0, 169, 851, 0, 195, 0, 0,
-	0, 254, 209, 0, 0, 0, 0, 701, 707, 0,
-	0, 0, 0, 0, 0, 847, 0, 0, 651, 0,
-	0, 0, 601, 693, 692, 667, 676, 0, 0, 151,
-	668, 0, 675, 669, 673, 672, 670, 671, 0, 638,
-	0, 0, 0, 0, 0, 0, 599, 655, 0, 659,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	652, 653, 0, 0, 0, 0, 688, 0, 654, 0,
-	0, 848, 0, 677, 0, 143, 259, 273, 152, 250,
-	286, 157, 257, 148, 224, 246, 0, 0, 145, 271,
-	256, 206, 189, 190, 144, 0, 241, 167, 181, 164,
-	222, 674, 686, 644, 163, 642, 685, 281, 147, 0,
-	280, 221, 268, 272, 207, 201, 146, 270, 205, 200,
-	193, 171, 185, 233, 199, 234, 186, 211, 210, 212,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 683, 0, 0,
-	283, 0, 0, 700, 0, 0, 0, 258, 0, 0,
-	194, 0, 0, 0, 645, 0, 244, 227, 710, 0,
-	0, 242, 197, 269, 235, 274, 260, 282, 238, 236,
-	139, 261, 166, 208, 149, 150, 162, 168, 170, 172,
-	173, 217, 218, 230, 249, 262, 263, 264, 165, 158,
-	243, 159, 183, 160, 140, 251, 161, 141, 231, 267,
-	0, 179, 239, 204, 142, 203, 232, 266, 265, 290,
-	296, 297, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 295, 176, 0, 278,
-	698, 223, 709, 694, 695, 696, 699, 702, 703, 640,
-	643, 704, 706, 708, 711, 247, 0, 0, 0, 0,
-	0, 188, 229, 0, 248, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 255, 276, 288,
-	641, 0, 0, 0, 287, 0, 0, 0, 0, 0,
-	689, 213, 214, 215, 216, 639, 0, 156, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 175, 182,
-	0, 184, 155, 228, 178, 285, 191, 0, 220, 187,
-	252, 192, 198, 240, 284, 226, 245, 153, 275, 253,
-	202, 177, 717, 697, 716, 718, 719, 715, 720, 721,
-	705, 660, 0, 713, 712, 714, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 138, 0,
-	196, 0, 237, 174, 603, 604, 605, 606, 607, 608,
-	609, 610, 611, 612, 613, 614, 615, 616, 617, 117,
-	618, 619, 620, 621, 622, 623, 624, 625, 626, 627,
-	628, 629, 630, 631, 632, 633, 634, 635, 636, 637,
-	687, 0, 291, 292, 293, 277, 0, 0, 0, 0,
-	225, 0, 0, 0, 0, 0, 0, 0, 658, 0,
-	0, 0, 169, 2244, 0, 195, 0, 0, 0, 254,
-	209, 0, 0, 0, 0, 701, 707, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 651, 0, 0, 0,
-	601, 693, 692, 667, 676, 0, 0, 151, 668, 0,
-	675, 669, 673, 672, 670, 671, 0, 638, 0, 0,
-	0, 0, 0, 0, 599, 655, 0, 659, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 652, 653,
-	0, 0, 0, 0, 688, 0, 654, 0, 0, 690,
-	0, 677, 0, 143, 259, 273, 152, 250, 286, 157,
-	257, 148, 224, 246, 0, 0, 145, 271, 256, 206,
-	189, 190, 144, 0, 241, 167, 181, 164, 222, 674,
-	686, 644, 163, 642, 685, 281, 147, 0, 280, 221,
-	268, 272, 207, 201, 146, 270, 205, 200, 193, 171,
-	185, 233, 199, 234, 186, 211, 210, 212, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 683, 0, 0, 283, 0,
-	0, 700, 0, 0, 0, 258, 0, 0, 194, 0,
-	0, 0, 645, 0, 244, 227, 710, 0, 0, 242,
-	197, 269, 235, 274, 260, 282, 238, 236, 139, 261,
-	166, 208, 149, 150, 162, 168, 170, 172, 173, 217,
-	218, 230, 249, 262, 263, 264, 165, 158, 243, 159,
-	183, 160, 140, 251, 161, 141, 231, 267, 0, 179,
-	239, 204, 142, 203, 232, 266, 265, 290, 296, 297,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 295, 176, 0, 278, 698, 223,
-	709, 694, 695, 696, 699, 702, 703, 640, 643, 704,
-	706, 708, 711, 247, 0, 0, 0, 0, 0, 188,
-	229, 0, 248, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 255, 276, 288, 641, 0,
-	0, 0, 287, 0, 0, 0, 0, 0, 689, 213,
-	214, 215, 216, 639, 0, 156, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 175, 182, 0, 184,
-	155, 228, 178, 285, 191, 0, 220, 187, 252, 192,
-	198, 240, 284, 226, 245, 153, 275, 253, 202, 177,
-	717, 697, 716, 718, 719, 715, 720, 721, 705, 660,
-	0, 713, 712, 714, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 138, 0, 196, 0,
-	237, 174, 603, 604, 605, 606, 607, 608, 609, 610,
-	611, 612, 613, 614, 615, 616, 617, 117, 618, 619,
-	620, 621, 622, 623, 624, 625, 626, 627, 628, 629,
-	630, 631, 632, 633, 634, 635, 636, 637, 687, 0,
-	291, 292, 293, 277, 0, 0, 0, 0, 225, 0,
-	0, 0, 0, 0, 0, 0, 658, 0, 0, 0,
-	169, 851, 0, 195, 0, 0, 0, 254, 209, 0,
-	0, 0, 0, 701, 707, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 651, 0, 0, 0, 601, 693,
-	692, 667, 676, 0, 0, 151, 668, 0, 675, 669,
-	673, 672, 670, 671, 0, 638, 0, 0, 0, 0,
-	0, 0, 599, 655, 0, 659, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 652, 653, 0, 0,
-	0, 0, 688, 0, 654, 0, 0, 690, 0, 677,
-	0, 143, 259, 273, 152, 250, 286, 157, 257, 148,
-	224, 246, 0, 0, 145, 271, 256, 206, 189, 190,
-	144, 0, 241, 167, 181, 164, 222, 674, 686, 644,
-	163, 642, 685, 281, 147, 0, 280, 221, 268, 272,
-	207, 201, 146, 270, 205, 200, 193, 171, 185, 233,
-	199, 234, 186, 211, 210, 212, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 683, 0, 0, 283, 0, 0, 700,
-	0, 0, 0, 258, 0, 0, 194, 0, 0, 0,
-	645, 0, 244, 227, 710, 0, 0, 242, 197, 269,
-	235, 274, 260, 282, 238, 236, 139, 261, 166, 208,
-	149, 150, 162, 168, 170, 172, 173, 217, 218, 230,
-	249, 262, 263, 264, 165, 158, 243, 159, 183, 160,
-	140, 251, 161, 141, 231, 267, 0, 179, 239, 204,
-	142, 203, 232, 266, 265, 290, 296, 297, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 295, 176, 0, 278, 698, 223, 709, 694,
-	695, 696, 699, 702, 703, 640, 643, 704, 706, 708,
-	711, 247, 0, 0, 0, 0, 0, 188, 229, 0,
-	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 255, 276, 288, 641, 0, 0, 0,
-	287, 0, 0, 0, 0, 0, 689, 213, 214, 215,
-	216, 639, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 175, 182, 0, 184, 155, 228,
-	178, 285, 191, 0, 220, 187, 252, 192, 198, 240,
-	284, 226, 245, 153, 275, 253, 202, 177, 717, 697,
-	716, 718, 719, 715, 720, 721, 705, 660, 0, 713,
-	712, 714, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 138, 0, 196, 0, 237, 174,
-	603, 604, 605, 606, 607, 608, 609, 610, 611, 612,
-	613, 614, 615, 616, 617, 117, 618, 619, 620, 621,
-	622, 623, 624, 625, 626, 627, 628, 629, 630, 631,
-	632, 633, 634, 635, 636, 637, 0, 0, 291, 292,
-	293, 277, 687, 0, 0, 1485, 0, 0, 0, 0,
-	0, 0, 225, 0, 0, 0, 0, 0, 0, 0,
-	658, 0, 0, 0, 169, 0, 0, 195, 0, 0,
-	0, 254, 209, 0, 0, 0, 0, 701, 707, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 651, 0,
-	0, 0, 601, 693, 692, 667, 676, 0, 0, 151,
-	668, 0, 675, 669, 673, 672, 670, 671, 0, 638,
-	0, 0, 0, 0, 0, 0, 599, 655, 0, 659,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	652, 653, 0, 0, 0, 0, 688, 0, 654, 0,
-	0, 690, 0, 677, 0, 143, 259, 273, 152, 250,
-	286, 157, 257, 148, 224, 246, 0, 0, 145, 271,
-	256, 206, 189, 190, 144, 0, 241, 167, 181, 164,
-	222, 674, 686, 644, 163, 642, 685, 281, 147, 0,
-	280, 221, 268, 272, 207, 201, 146, 270, 205, 200,
-	193, 171, 185, 233, 199, 234, 186, 211, 210, 212,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 683, 0, 0,
-	283, 0, 0, 700, 0, 0, 0, 258, 0, 0,
-	194, 0, 0, 0, 645, 0, 244, 227, 710, 0,
-	0, 242, 197, 269, 235, 274, 260, 282, 238, 236,
-	139, 261, 166, 208, 149, 150, 162, 168, 170, 172,
-	173, 217, 218, 230, 249, 262, 263, 264, 165, 158,
-	243, 159, 183, 160, 140, 251, 161, 141, 231, 267,
-	0, 179, 239, 204, 142, 203, 232, 266, 265, 290,
-	296, 297, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 295, 176, 0, 278,
-	698, 223, 709, 694, 695, 696, 699, 702, 703, 640,
-	643, 704, 706, 708, 711, 247, 0, 0, 0, 0,
-	0, 188, 229, 0, 248, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 255, 276, 288,
-	641, 0, 0, 0, 287, 0, 0, 0, 0, 0,
-	689, 213, 214, 215, 216, 639, 0, 156, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 175, 182,
-	0, 184, 155, 228, 178, 285, 191, 0, 220, 187,
-	252, 192, 198, 240, 284, 226, 245, 153, 275, 253,
-	202, 177, 717, 697, 716, 718, 719, 715, 720, 721,
-	705, 660, 0, 713, 712, 714, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 138, 0,
-	196, 0, 237, 174, 603, 604, 605, 606, 607, 608,
-	609, 610, 611, 612, 613, 614, 615, 616, 617, 117,
-	618, 619, 620, 621, 622, 623, 624, 625, 626, 627,
+	808, 670, 2425, 797, 1696, 668, 690, 2293, 2417, 2146,
+	1327, 1893, 1283, 2394, 2270, 1992, 2329, 2328, 2243, 2144,
+	1326, 2254, 2246, 2228, 1681, 1840, 672, 100, 555, 2085,
+	2035, 1210, 320, 326, 2033, 326, 61, 899, 594, 2183,
+	2143, 2034, 2231, 602, 1942, 702, 62, 826, 1279, 2024,
+	438, 330, 2065, 1544, 368, 1866, 324, 22, 865, 362,
+	362, 1656, 1882, 1697, 1498, 103, 1903, 534, 543, 1653,
+	394, 2023, 885, 1641, 1914, 312, 1521, 62, 623, 1749,
+	1906, 1918, 858, 1522, 1669, 99, 1278, 1479, 1657, 1661,
+	1217, 439, 1007, 1770, 1851, 1705, 1587, 1758, 1948, 100,
+	669, 1699, 1250, 1230, 792, 464, 1016, 1022, 1025, 1008,
+	1017, 1598, 1549, 1413, 545, 1314, 679, 1397, 878, 443,
+	1275, 861, 1018, 1654, 1473, 1229, 1264, 849, 3, 444,
+	323, 15, 1225, 835, 396, 321, 6, 859, 445, 810,
+	322, 5, 62, 1325, 793, 671, 441, 1340, 882, 332,
+	863, 616, 837, 22, 2000, 902, 1192, 466, 313, 1328,
+	836, 530, 316, 479, 905, 430, 936, 1305, 517, 1281,
+	843, 446, 29, 393, 570, 374, 784, 333, 334, 1199,
+	96, 12, 367, 496, 2095, 1988, 1839, 7, 4, 805,
+	2176, 1010, 995, 603, 2165, 2177, 2178, 2174, 2175, 1974,
+	586, 985, 984, 29, 568, 691, 700, 95, 2456, 364,
+	692, 2342, 699, 693, 697, 696, 694, 695, 94, 2443,
+	615, 2276, 828, 325, 795, 463, 2314, 15, 2077, 1195,
+	2086, 91, 6, 572, 95, 1620, 2423, 5, 1642, 1454,
+	1474, 691, 700, 2340, 2274, 1807, 692, 431, 699, 693,
+	697, 696, 694, 695, 2262, 92, 531, 532, 328, 1954,
+	533, 311, 1461, 516, 867, 868, 1464, 2171, 29, 954,
+	953, 963, 964, 956, 957, 958, 959, 960, 961, 962,
+	955, 573, 92, 337, 337, 95, 95, 95, 415, 26,
+	85, 68, 95, 698, 26, 85, 68, 391, 839, 401,
+	753, 556, 557, 95, 800, 26, 85, 68, 511, 2359,
+	2332, 2333, 2398, 750, 472, 2181, 450, 449, 451, 562,
+	554, 563, 2357, 553, 556, 557, 507, 1645, 326, 698,
+	100, 2281, 2284, 752, 92, 92, 2184, 2185, 2186, 2187,
+	92, 1646, 773, 1647, 471, 2098, 448, 1841, 804, 1446,
+	473, 92, 1750, 1753, 482, 879, 444, 1670, 1671, 1672,
+	1673, 1197, 2062, 62, 62, 445, 468, 470, 380, 416,
+	2313, 1482, 1480, 1477, 1481, 1483, 498, 1476, 1475, 1482,
+	1480, 1898, 1481, 1483, 1195, 1985, 1902, 1901, 489, 508,
+	453, 509, 510, 394, 1836, 497, 2163, 1931, 1973, 785,
+	1485, 1486, 1487, 1488, 1752, 2375, 1317, 1318, 1319, 2131,
+	327, 2331, 2463, 2403, 2255, 522, 369, 100, 2356, 1315,
+	2291, 2292, 482, 2295, 2361, 787, 1930, 362, 2295, 2410,
+	2311, 1927, 469, 439, 439, 439, 2316, 2317, 362, 362,
+	2232, 2233, 2234, 2236, 2235, 536, 2057, 538, 447, 1462,
+	2442, 2113, 2112, 366, 326, 619, 619, 2363, 2364, 502,
+	2301, 582, 552, 551, 2256, 618, 618, 505, 755, 2245,
+	1698, 67, 532, 93, 1674, 567, 2148, 599, 2101, 1588,
+	475, 476, 506, 1554, 1318, 1319, 771, 503, 605, 29,
+	29, 83, 312, 362, 362, 472, 362, 465, 535, 1251,
+	452, 417, 1600, 564, 484, 483, 1254, 786, 442, 1251,
+	62, 547, 756, 2420, 362, 362, 1928, 2048, 559, 560,
+	569, 751, 2279, 62, 1252, 798, 1251, 2322, 571, 537,
+	1746, 807, 62, 1458, 811, 362, 1292, 362, 493, 822,
+	1203, 1249, 394, 780, 2052, 827, 2213, 1472, 812, 100,
+	818, 487, 1258, 851, 853, 1837, 850, 329, 548, 388,
+	389, 390, 519, 844, 844, 367, 540, 500, 1542, 362,
+	1288, 100, 484, 483, 576, 383, 581, 375, 852, 501,
+	504, 2315, 870, 362, 439, 2172, 362, 871, 521, 499,
+	842, 1950, 1949, 2076, 1642, 2147, 1198, 886, 1287, 813,
+	495, 869, 894, 886, 886, 2087, 2080, 832, 2088, 362,
+	362, 898, 100, 100, 2275, 556, 557, 779, 802, 914,
+	1316, 69, 776, 2362, 2421, 420, 903, 775, 556, 557,
+	419, 918, 846, 880, 782, 893, 592, 593, 757, 815,
+	762, 2087, 477, 1455, 2088, 2244, 2460, 830, 69, 29,
+	901, 337, 608, 609, 610, 611, 612, 613, 29, 803,
+	1665, 604, 596, 596, 758, 1219, 833, 834, 778, 788,
+	900, 900, 796, 854, 777, 774, 748, 614, 311, 904,
+	589, 590, 591, 513, 973, 1929, 817, 1926, 806, 801,
+	542, 2323, 981, 385, 974, 1509, 853, 1553, 1508, 69,
+	69, 69, 558, 382, 381, 561, 69, 766, 767, 814,
+	444, 2429, 986, 896, 823, 824, 838, 69, 337, 972,
+	799, 1875, 1482, 1480, 377, 1481, 1483, 1257, 442, 1687,
+	881, 1255, 876, 2050, 845, 1648, 422, 2049, 857, 1662,
+	1665, 891, 892, 1551, 2053, 2054, 2418, 2419, 1499, 877,
+	1290, 1289, 1452, 1014, 1014, 1019, 574, 575, 1451, 897,
+	1445, 337, 2214, 2216, 2217, 2218, 2215, 1440, 1245, 1208,
+	831, 895, 1027, 1189, 407, 975, 976, 977, 978, 1666,
+	917, 759, 840, 841, 827, 424, 423, 601, 485, 444,
+	888, 889, 890, 467, 955, 770, 1636, 979, 445, 1330,
+	1329, 1194, 1810, 769, 1691, 1634, 1404, 337, 587, 62,
+	546, 407, 585, 100, 100, 2107, 380, 1002, 945, 588,
+	1402, 1403, 1401, 549, 1231, 1028, 376, 1745, 1742, 1743,
+	1744, 1191, 2445, 1815, 337, 1814, 1813, 1811, 2438, 320,
+	1235, 1682, 2305, 1756, 456, 461, 462, 1247, 1635, 903,
+	1442, 409, 1491, 1193, 408, 1294, 474, 1804, 347, 1666,
+	346, 350, 342, 1013, 1659, 1470, 1414, 816, 1660, 1663,
+	2277, 1213, 1215, 362, 338, 994, 2059, 1414, 384, 1593,
+	911, 912, 913, 910, 357, 584, 910, 1335, 409, 1806,
+	2058, 408, 913, 910, 362, 1855, 1812, 1850, 1615, 886,
+	886, 886, 904, 1285, 970, 971, 421, 619, 2043, 100,
+	2466, 2441, 1286, 1004, 550, 2454, 1310, 618, 1312, 2413,
+	1664, 1306, 1307, 1308, 1309, 1020, 1187, 1021, 1188, 1026,
+	2404, 1284, 1561, 386, 1338, 29, 958, 959, 960, 961,
+	962, 955, 2346, 1333, 1339, 1259, 1993, 2266, 1373, 2265,
+	1239, 2440, 2208, 2207, 1253, 1336, 1337, 1376, 1202, 1385,
+	1386, 1387, 1388, 1389, 1390, 1391, 1392, 1393, 1394, 1395,
+	1396, 1241, 1492, 1243, 1406, 1407, 1216, 1226, 2206, 1211,
+	1212, 412, 1575, 472, 1977, 1002, 1415, 911, 912, 913,
+	910, 1304, 1240, 2224, 1416, 1244, 425, 1242, 1421, 1320,
+	1793, 472, 2203, 838, 2197, 1429, 458, 459, 460, 1816,
+	1817, 2194, 2193, 798, 2222, 1277, 1426, 1427, 2321, 418,
+	2149, 1976, 1291, 2096, 2071, 407, 1885, 1574, 2223, 2220,
+	2210, 1430, 911, 912, 913, 910, 2070, 1295, 1296, 1297,
+	2069, 2068, 340, 339, 343, 911, 912, 913, 910, 2221,
+	345, 911, 912, 913, 910, 1236, 1237, 1238, 1303, 1207,
+	1888, 2399, 349, 2064, 2219, 2209, 1883, 2063, 1405, 911,
+	912, 913, 910, 1896, 1897, 1862, 789, 1861, 1860, 1884,
+	1859, 1632, 1399, 760, 2374, 2367, 1597, 2229, 2299, 1596,
+	1331, 1332, 367, 1334, 2298, 2416, 1206, 337, 2264, 1370,
+	1371, 1372, 409, 1374, 1375, 408, 2211, 1381, 1382, 1383,
+	1384, 2204, 2200, 1889, 911, 912, 913, 910, 1299, 2199,
+	2198, 911, 912, 913, 910, 2097, 1800, 2455, 1302, 2082,
+	1545, 1420, 1422, 1423, 1419, 2066, 1603, 2045, 406, 819,
+	820, 821, 1428, 1991, 1989, 1431, 410, 954, 953, 963,
+	964, 956, 957, 958, 959, 960, 961, 962, 955, 1869,
+	344, 348, 790, 1432, 352, 791, 1679, 2325, 354, 355,
+	356, 1678, 1879, 358, 359, 954, 953, 963, 964, 956,
+	957, 958, 959, 960, 961, 962, 955, 2249, 1677, 1676,
+	1447, 911, 912, 913, 910, 1409, 362, 2179, 1895, 362,
+	1658, 1408, 472, 1205, 362, 911, 912, 913, 910, 1467,
+	1204, 911, 912, 913, 910, 719, 718, 1465, 1466, 997,
+	811, 911, 912, 913, 910, 1891, 952, 1433, 951, 1495,
+	761, 2336, 1457, 2335, 2136, 1501, 963, 964, 956, 957,
+	958, 959, 960, 961, 962, 955, 1506, 1890, 1892, 1201,
+	2464, 472, 2273, 1019, 472, 1019, 472, 100, 911, 912,
+	913, 910, 472, 100, 100, 100, 100, 2258, 1608, 2158,
+	2083, 1557, 1607, 2154, 472, 100, 1538, 1490, 62, 1878,
+	2461, 1512, 1469, 2153, 1514, 2081, 1517, 1493, 1978, 22,
+	1201, 2451, 1523, 362, 911, 912, 913, 910, 1201, 2450,
+	1970, 100, 100, 403, 1523, 405, 415, 1898, 1459, 1962,
+	402, 400, 399, 411, 404, 1518, 413, 414, 1947, 1886,
+	956, 957, 958, 959, 960, 961, 962, 955, 1846, 1540,
+	911, 912, 913, 910, 2428, 2427, 1448, 1557, 2415, 1558,
+	1453, 1829, 1559, 1560, 829, 1957, 1284, 1557, 2380, 1468,
+	1536, 2139, 2372, 1822, 1494, 1562, 1503, 1489, 1504, 1547,
+	1548, 1500, 395, 15, 1301, 2365, 2354, 2353, 6, 911,
+	912, 913, 910, 5, 1511, 1513, 1507, 1515, 1505, 1226,
+	2338, 2337, 1569, 1570, 1519, 1572, 1573, 1274, 1577, 1819,
+	1956, 1568, 1578, 1579, 1580, 1581, 1537, 1543, 1539, 1535,
+	1524, 1525, 1526, 1527, 29, 1769, 367, 1692, 1955, 1585,
+	1586, 2139, 2334, 1546, 911, 912, 913, 910, 371, 373,
+	372, 1582, 1590, 1456, 331, 1594, 1686, 2320, 2319, 1497,
+	370, 1552, 911, 912, 913, 910, 1612, 444, 1555, 1014,
+	1611, 1624, 1014, 1613, 1609, 1627, 972, 886, 2139, 2309,
+	1952, 362, 1605, 886, 1826, 362, 362, 1604, 1630, 362,
+	2139, 2308, 1502, 921, 922, 923, 924, 925, 926, 927,
+	919, 607, 472, 100, 911, 912, 913, 910, 911, 912,
+	913, 910, 1602, 62, 363, 100, 2139, 2307, 2139, 2306,
+	2304, 2303, 1557, 2268, 1621, 1566, 1803, 100, 1231, 1563,
+	1690, 829, 1512, 1584, 1557, 2267, 1234, 2164, 1556, 1619,
+	1797, 1631, 2162, 2161, 1541, 1626, 1683, 1684, 1399, 1583,
+	911, 912, 913, 910, 2160, 2159, 1592, 2156, 2157, 1520,
+	1601, 1667, 1623, 1796, 911, 912, 913, 910, 2156, 2155,
+	1768, 2139, 2138, 1680, 1755, 1616, 1614, 1795, 1625, 1622,
+	1878, 1877, 1773, 1628, 1629, 1633, 1496, 911, 912, 913,
+	910, 1557, 1798, 1640, 1794, 1425, 1675, 1424, 1775, 1790,
+	606, 911, 912, 913, 910, 370, 1557, 1783, 1784, 1688,
+	1557, 1606, 1788, 1201, 1595, 2444, 1791, 1792, 911, 912,
+	913, 910, 1755, 911, 912, 913, 910, 1557, 362, 1685,
+	1497, 1689, 1557, 1565, 1805, 1557, 1564, 1767, 1435, 362,
+	1234, 1449, 1253, 1847, 1823, 1557, 1825, 1789, 1444, 1443,
+	1695, 1438, 1437, 1785, 1763, 953, 963, 964, 956, 957,
+	958, 959, 960, 961, 962, 955, 1818, 1234, 1233, 362,
+	1787, 911, 912, 913, 910, 1786, 1195, 1824, 1830, 1773,
+	1778, 100, 1201, 1200, 1820, 764, 763, 1273, 512, 1849,
+	1444, 1274, 491, 1780, 911, 912, 913, 910, 1802, 911,
+	912, 913, 910, 1777, 911, 912, 913, 910, 1776, 1637,
+	1639, 1799, 362, 493, 362, 1441, 908, 100, 1872, 1801,
+	1410, 1274, 1809, 492, 1411, 62, 1301, 911, 912, 913,
+	910, 1248, 911, 912, 913, 910, 1844, 490, 1827, 1845,
+	1209, 491, 1190, 1831, 911, 912, 913, 910, 95, 541,
+	1865, 85, 68, 95, 583, 2437, 1693, 1694, 2431, 1853,
+	906, 1835, 2411, 2408, 2406, 2345, 1857, 493, 2257, 2241,
+	2226, 2188, 2169, 2152, 1754, 1848, 2150, 472, 1905, 2134,
+	1852, 1284, 1852, 1858, 2133, 1874, 472, 1854, 2132, 1766,
+	2129, 1899, 1863, 2128, 2079, 2078, 92, 1935, 2056, 1871,
+	1936, 92, 1870, 1938, 544, 1909, 1910, 1924, 1915, 1907,
+	1961, 1937, 1940, 1943, 1919, 1922, 1523, 1912, 1908, 1911,
+	1913, 1864, 1261, 1917, 1856, 1400, 92, 1510, 1471, 1436,
+	1916, 1418, 1417, 1293, 1958, 866, 1260, 1232, 1003, 1933,
+	1001, 1000, 1266, 1269, 1270, 1271, 1267, 1960, 1268, 1272,
+	999, 998, 596, 996, 995, 1920, 937, 1923, 1925, 472,
+	992, 991, 989, 596, 362, 362, 988, 1939, 100, 1934,
+	987, 886, 1975, 1266, 1269, 1270, 1271, 1267, 472, 1268,
+	1272, 2025, 2027, 983, 2025, 2025, 1997, 982, 950, 1523,
+	949, 948, 947, 1832, 946, 472, 944, 2031, 943, 942,
+	941, 940, 939, 938, 935, 934, 933, 1963, 1512, 1959,
+	1965, 1951, 1967, 932, 931, 930, 929, 928, 783, 1966,
+	362, 2044, 1964, 754, 494, 2130, 1986, 1759, 1760, 100,
+	1222, 2026, 488, 1981, 2385, 1980, 596, 2383, 1867, 1984,
+	2330, 1873, 1762, 1484, 1994, 1968, 1969, 2022, 1876, 1300,
+	1006, 514, 1532, 2030, 1530, 2028, 2029, 1533, 1534, 1531,
+	1270, 1271, 1765, 1764, 1529, 1528, 2389, 1874, 827, 2341,
+	2041, 1439, 2039, 1899, 2042, 2046, 1227, 1434, 1211, 1212,
+	49, 1643, 518, 28, 27, 1833, 1650, 2060, 1220, 856,
+	2099, 2032, 1649, 1276, 1834, 2067, 825, 2084, 1330, 1329,
+	528, 529, 526, 527, 524, 525, 2391, 2074, 566, 565,
+	308, 520, 1186, 309, 310, 2075, 2073, 2432, 2091, 371,
+	373, 372, 2350, 2348, 2286, 2285, 2283, 2191, 2189, 2090,
+	1990, 370, 829, 1932, 1843, 1842, 2103, 1821, 1772, 523,
+	370, 1771, 1550, 2387, 2386, 2093, 1567, 1450, 486, 2386,
+	2387, 1828, 872, 2104, 2105, 397, 2108, 2109, 2110, 2111,
+	34, 2027, 2114, 2115, 2116, 2117, 2118, 2119, 2120, 2121,
+	2122, 2123, 2124, 2125, 2126, 2127, 966, 2141, 969, 1,
+	539, 2106, 387, 1377, 768, 455, 481, 765, 1982, 1983,
+	480, 478, 967, 968, 965, 1412, 954, 953, 963, 964,
+	956, 957, 958, 959, 960, 961, 962, 955, 62, 1341,
+	2135, 1256, 703, 1009, 1015, 2227, 2390, 2424, 1943, 2137,
+	2344, 2393, 2142, 2037, 2038, 781, 689, 2278, 1644, 2167,
+	2168, 2180, 2140, 2280, 2182, 1463, 2092, 1460, 515, 1617,
+	1618, 717, 2192, 2090, 1867, 706, 2173, 990, 708, 749,
+	457, 705, 2166, 2072, 1751, 454, 398, 2225, 2061, 1838,
+	472, 2195, 2196, 472, 472, 472, 1900, 2201, 2202, 1921,
+	472, 1904, 2253, 2040, 2430, 62, 2294, 2462, 2355, 2409,
+	2402, 2290, 2100, 472, 2252, 335, 2190, 873, 2435, 577,
+	428, 2259, 2242, 1005, 2230, 2205, 1668, 2238, 2239, 2240,
+	1478, 1218, 2248, 1196, 2237, 794, 336, 2312, 2151, 378,
+	2271, 2247, 1284, 1221, 379, 2251, 2250, 2263, 1224, 2288,
+	1223, 1321, 920, 1398, 993, 2433, 362, 362, 980, 621,
+	1591, 678, 1748, 1747, 1894, 2289, 954, 953, 963, 964,
+	956, 957, 958, 959, 960, 961, 962, 955, 33, 32,
+	31, 909, 2282, 1023, 704, 102, 1246, 100, 1024, 2287,
+	2094, 2395, 2089, 1972, 2296, 2297, 1971, 1599, 688, 687,
+	686, 685, 472, 954, 953, 963, 964, 956, 957, 958,
+	959, 960, 961, 962, 955, 684, 1265, 1263, 1262, 862,
+	2269, 1941, 907, 2327, 2302, 2326, 2170, 2260, 2261, 1987,
+	2055, 2212, 2051, 2047, 2300, 1996, 2310, 1995, 2324, 1880,
+	1881, 2318, 1887, 973, 1704, 900, 1700, 1702, 1703, 1701,
+	1808, 1779, 848, 974, 847, 1655, 1652, 1651, 1761, 2349,
+	1757, 2351, 2352, 1011, 2090, 1953, 2347, 2343, 809, 444,
+	97, 860, 11, 10, 772, 9, 14, 21, 972, 1979,
+	2358, 2360, 20, 19, 57, 56, 55, 54, 2366, 2368,
+	2369, 2370, 2371, 18, 8, 53, 52, 51, 2373, 17,
+	16, 2377, 2376, 2378, 2381, 2382, 2397, 2384, 47, 2271,
+	46, 44, 2396, 2388, 43, 2401, 42, 41, 40, 39,
+	38, 45, 37, 2405, 36, 2407, 2400, 954, 953, 963,
+	964, 956, 957, 958, 959, 960, 961, 962, 955, 35,
+	66, 65, 64, 63, 2412, 23, 24, 2252, 2414, 25,
+	76, 75, 71, 2426, 74, 73, 2422, 72, 70, 30,
+	13, 2, 0, 0, 0, 0, 0, 472, 0, 472,
+	2434, 0, 2436, 0, 0, 0, 0, 2439, 0, 0,
+	596, 596, 0, 0, 0, 0, 0, 0, 0, 2397,
+	2447, 0, 0, 0, 0, 2396, 2448, 798, 472, 798,
+	2449, 2452, 2446, 0, 0, 0, 2426, 2457, 0, 0,
+	0, 0, 0, 0, 0, 0, 2459, 0, 0, 0,
+	0, 2465, 0, 0, 1142, 1071, 1090, 1129, 798, 1089,
+	1144, 1061, 1077, 1152, 1078, 1080, 1116, 1039, 1100, 226,
+	1075, 0, 1132, 1031, 1064, 1065, 1033, 1072, 1034, 1062,
+	1092, 171, 1060, 1103, 196, 1150, 0, 0, 261, 210,
+	0, 0, 1095, 1134, 1098, 1121, 1088, 1117, 1047, 1110,
+	1145, 1076, 1114, 1146, 0, 0, 0, 0, 0, 819,
+	820, 821, 0, 0, 0, 0, 153, 0, 0, 0,
+	0, 0, 1113, 1139, 1074, 0, 0, 0, 156, 1143,
+	1096, 1115, 0, 0, 1032, 1111, 0, 1037, 1040, 1151,
+	1137, 1068, 1069, 0, 0, 0, 0, 0, 0, 0,
+	1093, 1099, 1118, 1085, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1066, 0, 1107, 0, 0, 0, 1042,
+	1038, 0, 1091, 0, 145, 266, 280, 154, 255, 294,
+	159, 264, 150, 225, 251, 0, 1183, 147, 278, 263,
+	207, 190, 191, 146, 0, 246, 169, 182, 166, 223,
+	0, 1141, 306, 165, 297, 1041, 289, 149, 1178, 288,
+	222, 275, 279, 208, 202, 148, 277, 206, 201, 194,
+	173, 287, 186, 234, 200, 235, 187, 212, 211, 213,
+	1162, 1163, 1164, 1165, 1166, 1174, 1175, 0, 1179, 1180,
+	1181, 1046, 0, 1067, 1119, 0, 1030, 1127, 1135, 1087,
+	291, 1138, 1084, 1083, 1169, 0, 1168, 265, 1170, 1171,
+	195, 1133, 1063, 1073, 307, 1070, 249, 228, 1140, 1106,
+	1182, 247, 198, 276, 236, 281, 267, 290, 239, 237,
+	141, 268, 168, 209, 151, 152, 164, 170, 172, 174,
+	175, 218, 219, 231, 254, 269, 270, 271, 167, 160,
+	248, 161, 184, 162, 142, 257, 163, 143, 232, 274,
+	1167, 180, 240, 205, 144, 204, 233, 273, 272, 298,
+	304, 305, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1176, 0, 1177, 303, 178, 1029, 285,
+	0, 224, 1130, 1035, 1045, 1043, 1081, 1108, 1109, 220,
+	302, 1123, 1126, 1124, 1153, 252, 0, 0, 0, 0,
+	0, 189, 230, 0, 253, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1036, 0, 262, 283, 296,
+	286, 1082, 1054, 1094, 295, 1057, 1055, 1122, 1056, 1112,
+	1155, 214, 215, 216, 217, 181, 1610, 158, 1104, 1086,
+	1156, 1157, 1158, 1159, 1160, 1161, 1059, 1136, 177, 183,
+	0, 185, 157, 229, 179, 293, 192, 1128, 221, 188,
+	258, 193, 199, 245, 292, 227, 250, 155, 282, 259,
+	203, 1053, 1058, 1052, 1101, 1102, 1147, 1148, 1149, 1120,
+	1044, 1131, 1049, 1051, 1050, 954, 953, 963, 964, 956,
+	957, 958, 959, 960, 961, 962, 955, 1589, 0, 0,
+	0, 0, 0, 0, 1125, 0, 1097, 1105, 140, 0,
+	197, 1154, 238, 176, 0, 0, 0, 0, 954, 953,
+	963, 964, 956, 957, 958, 959, 960, 961, 962, 955,
+	1571, 0, 954, 953, 963, 964, 956, 957, 958, 959,
+	960, 961, 962, 955, 0, 0, 0, 0, 0, 0,
+	1184, 1185, 242, 243, 244, 241, 256, 1048, 1079, 260,
+	1172, 1173, 299, 300, 301, 284, 95, 0, 712, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 226, 0,
+	0, 0, 0, 0, 0, 0, 680, 0, 0, 0,
+	171, 0, 0, 196, 714, 0, 0, 261, 210, 0,
+	0, 0, 0, 727, 733, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 673, 0, 2339, 0, 622, 719,
+	718, 691, 700, 0, 0, 153, 692, 0, 699, 693,
+	697, 696, 694, 695, 0, 0, 0, 659, 0, 0,
+	0, 0, 0, 0, 620, 677, 0, 681, 954, 953,
+	963, 964, 956, 957, 958, 959, 960, 961, 962, 955,
+	0, 0, 0, 0, 0, 0, 0, 0, 674, 675,
+	0, 0, 0, 0, 713, 0, 676, 0, 0, 716,
+	0, 701, 0, 145, 266, 280, 154, 255, 294, 159,
+	264, 150, 225, 251, 0, 0, 147, 278, 263, 207,
+	190, 191, 146, 0, 246, 169, 182, 166, 223, 698,
+	711, 666, 165, 664, 710, 289, 149, 0, 288, 222,
+	275, 279, 208, 202, 148, 277, 206, 201, 194, 173,
+	663, 186, 234, 200, 235, 187, 212, 211, 213, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 707, 0, 0, 291,
+	0, 0, 726, 0, 0, 0, 265, 0, 0, 195,
+	0, 0, 0, 667, 0, 249, 228, 736, 0, 0,
+	247, 198, 276, 236, 281, 267, 290, 239, 237, 141,
+	268, 168, 209, 151, 152, 164, 170, 172, 174, 175,
+	218, 219, 231, 254, 269, 270, 271, 167, 160, 248,
+	161, 184, 162, 142, 257, 163, 143, 232, 274, 0,
+	180, 240, 205, 144, 204, 233, 273, 272, 298, 304,
+	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 303, 178, 0, 285, 724,
+	224, 735, 720, 721, 722, 725, 728, 729, 661, 665,
+	730, 732, 734, 737, 252, 0, 0, 0, 0, 0,
+	189, 230, 0, 253, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 283, 296, 662,
+	0, 0, 0, 295, 0, 0, 0, 0, 0, 715,
+	214, 215, 216, 217, 660, 0, 158, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 177, 183, 0,
+	185, 157, 229, 179, 293, 192, 0, 221, 188, 258,
+	193, 199, 245, 292, 227, 250, 155, 282, 259, 203,
+	743, 723, 742, 744, 745, 741, 746, 747, 731, 683,
+	0, 739, 738, 740, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 682, 140, 0, 197,
+	69, 238, 176, 624, 625, 626, 627, 628, 629, 630,
+	631, 632, 633, 634, 635, 636, 637, 638, 119, 639,
+	640, 641, 642, 643, 644, 645, 646, 647, 648, 649,
+	650, 651, 652, 653, 654, 655, 656, 657, 658, 712,
+	0, 242, 243, 244, 241, 256, 0, 709, 260, 226,
+	0, 299, 300, 301, 284, 0, 0, 680, 0, 0,
+	0, 171, 0, 0, 196, 714, 0, 0, 261, 210,
+	0, 0, 0, 0, 727, 733, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 673, 0, 0, 0, 622,
+	719, 718, 691, 700, 0, 0, 153, 692, 0, 699,
+	693, 697, 696, 694, 695, 0, 0, 0, 659, 0,
+	0, 0, 0, 0, 0, 620, 677, 0, 681, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 674,
+	675, 0, 0, 0, 0, 713, 0, 676, 0, 0,
+	716, 0, 701, 0, 145, 266, 280, 154, 255, 294,
+	159, 264, 150, 225, 251, 0, 0, 147, 278, 263,
+	207, 190, 191, 146, 0, 246, 169, 182, 166, 223,
+	698, 711, 666, 165, 664, 710, 289, 149, 0, 288,
+	222, 275, 279, 208, 202, 148, 277, 206, 201, 194,
+	173, 663, 186, 234, 200, 235, 187, 212, 211, 213,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 707, 0, 0,
+	291, 0, 0, 726, 0, 0, 0, 265, 0, 0,
+	195, 0, 0, 0, 667, 0, 249, 228, 736, 0,
+	0, 247, 198, 276, 236, 281, 267, 290, 239, 237,
+	141, 268, 168, 209, 151, 152, 164, 170, 172, 174,
+	175, 218, 219, 231, 254, 269, 270, 271, 167, 160,
+	248, 161, 184, 162, 142, 257, 163, 143, 232, 274,
+	0, 180, 240, 205, 144, 204, 233, 273, 272, 298,
+	304, 305, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1379, 1378, 1380, 303, 178, 0, 285,
+	724, 224, 735, 720, 721, 722, 725, 728, 729, 661,
+	665, 730, 732, 734, 737, 252, 0, 0, 0, 0,
+	0, 189, 230, 0, 253, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 283, 296,
+	662, 0, 0, 0, 295, 0, 0, 0, 0, 0,
+	715, 214, 215, 216, 217, 660, 0, 158, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 183,
+	0, 185, 157, 229, 179, 293, 192, 0, 221, 188,
+	258, 193, 199, 245, 292, 227, 250, 155, 282, 259,
+	203, 743, 723, 742, 744, 745, 741, 746, 747, 731,
+	683, 0, 739, 738, 740, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 682, 140, 0,
+	197, 0, 238, 176, 624, 625, 626, 627, 628, 629,
+	630, 631, 632, 633, 634, 635, 636, 637, 638, 119,
+	639, 640, 641, 642, 643, 644, 645, 646, 647, 648,
+	649, 650, 651, 652, 653, 654, 655, 656, 657, 658,
+	0, 0, 242, 243, 244, 241, 256, 0, 709, 260,
+	0, 0, 299, 300, 301, 284, 95, 0, 712, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 226, 0,
+	0, 0, 0, 0, 0, 0, 680, 0, 0, 0,
+	171, 0, 0, 196, 714, 0, 0, 261, 210, 0,
+	0, 0, 0, 727, 733, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 673, 0, 0, 0, 622, 719,
+	718, 691, 700, 0, 0, 153, 692, 0, 699, 693,
+	697, 696, 694, 695, 0, 0, 0, 659, 0, 0,
+	0, 0, 0, 0, 620, 677, 0, 681, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 674, 675,
+	0, 0, 0, 0, 713, 0, 676, 0, 0, 716,
+	0, 701, 0, 145, 266, 280, 154, 255, 294, 159,
+	264, 150, 225, 251, 0, 0, 147, 278, 263, 207,
+	190, 191, 146, 0, 246, 169, 182, 166, 223, 698,
+	711, 666, 165, 664, 710, 289, 149, 0, 288, 222,
+	275, 279, 208, 202, 148, 277, 206, 201, 194, 173,
+	663, 186, 234, 200, 235, 187, 212, 211, 213, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 707, 0, 0, 291,
+	0, 0, 726, 0, 0, 0, 265, 0, 0, 195,
+	0, 0, 0, 667, 0, 249, 228, 736, 0, 0,
+	247, 198, 276, 236, 281, 267, 290, 239, 237, 141,
+	268, 168, 209, 151, 152, 164, 170, 172, 174, 175,
+	218, 219, 231, 254, 269, 270, 271, 167, 160, 248,
+	161, 184, 162, 142, 257, 163, 143, 232, 274, 0,
+	180, 240, 205, 144, 204, 233, 273, 272, 298, 304,
+	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 303, 178, 0, 285, 724,
+	224, 735, 720, 721, 722, 725, 728, 729, 661, 665,
+	730, 732, 734, 737, 252, 0, 0, 0, 0, 0,
+	189, 230, 0, 253, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 283, 296, 662,
+	0, 0, 0, 295, 0, 0, 0, 0, 0, 715,
+	214, 215, 216, 217, 660, 0, 158, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 177, 183, 0,
+	185, 157, 229, 179, 293, 192, 0, 221, 188, 258,
+	193, 199, 245, 292, 227, 250, 155, 282, 259, 203,
+	743, 723, 742, 744, 745, 741, 746, 747, 731, 683,
+	0, 739, 738, 740, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 682, 140, 0, 197,
+	69, 238, 176, 624, 625, 626, 627, 628, 629, 630,
+	631, 632, 633, 634, 635, 636, 637, 638, 119, 639,
+	640, 641, 642, 643, 644, 645, 646, 647, 648, 649,
+	650, 651, 652, 653, 654, 655, 656, 657, 658, 712,
+	0, 242, 243, 244, 241, 256, 0, 709, 260, 226,
+	0, 299, 300, 301, 284, 0, 0, 680, 0, 0,
+	0, 171, 887, 0, 196, 714, 0, 0, 261, 210,
+	0, 0, 0, 0, 727, 733, 0, 0, 0, 0,
+	0, 0, 883, 0, 0, 673, 0, 0, 0, 622,
+	719, 718, 691, 700, 0, 0, 153, 692, 0, 699,
+	693, 697, 696, 694, 695, 0, 0, 0, 659, 0,
+	0, 0, 0, 0, 0, 620, 677, 0, 681, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 674,
+	675, 0, 0, 0, 0, 713, 0, 676, 0, 0,
+	884, 0, 701, 0, 145, 266, 280, 154, 255, 294,
+	159, 264, 150, 225, 251, 0, 0, 147, 278, 263,
+	207, 190, 191, 146, 0, 246, 169, 182, 166, 223,
+	698, 711, 666, 165, 664, 710, 289, 149, 0, 288,
+	222, 275, 279, 208, 202, 148, 277, 206, 201, 194,
+	173, 663, 186, 234, 200, 235, 187, 212, 211, 213,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 707, 0, 0,
+	291, 0, 0, 726, 0, 0, 0, 265, 0, 0,
+	195, 0, 0, 0, 667, 0, 249, 228, 736, 0,
+	0, 247, 198, 276, 236, 281, 267, 290, 239, 237,
+	141, 268, 168, 209, 151, 152, 164, 170, 172, 174,
+	175, 218, 219, 231, 254, 269, 270, 271, 167, 160,
+	248, 161, 184, 162, 142, 257, 163, 143, 232, 274,
+	0, 180, 240, 205, 144, 204, 233, 273, 272, 298,
+	304, 305, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 303, 178, 0, 285,
+	724, 224, 735, 720, 721, 722, 725, 728, 729, 661,
+	665, 730, 732, 734, 737, 252, 0, 0, 0, 0,
+	0, 189, 230, 0, 253, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 283, 296,
+	662, 0, 0, 0, 295, 0, 0, 0, 0, 0,
+	715, 214, 215, 216, 217, 660, 0, 158, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 183,
+	0, 185, 157, 229, 179, 293, 192, 0, 221, 188,
+	258, 193, 199, 245, 292, 227, 250, 155, 282, 259,
+	203, 743, 723, 742, 744, 745, 741, 746, 747, 731,
+	683, 0, 739, 738, 740, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 682, 140, 0,
+	197, 0, 238, 176, 624, 625, 626, 627, 628, 629,
+	630, 631, 632, 633, 634, 635, 636, 637, 638, 119,
+	639, 640, 641, 642, 643, 644, 645, 646, 647, 648,
+	649, 650, 651, 652, 653, 654, 655, 656, 657, 658,
+	712, 0, 242, 243, 244, 241, 256, 0, 709, 260,
+	226, 0, 299, 300, 301, 284, 0, 0, 680, 0,
+	0, 0, 171, 2458, 0, 196, 714, 0, 0, 261,
+	210, 0, 0, 0, 0, 727, 733, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 673, 0, 0, 0,
+	622, 719, 718, 691, 700, 0, 0, 153, 692, 0,
+	699, 693, 697, 696, 694, 695, 0, 0, 0, 659,
+	0, 0, 0, 0, 0, 0, 620, 677, 0, 681,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	674, 675, 0, 0, 0, 0, 713, 0, 676, 0,
+	0, 716, 0, 701, 0, 145, 266, 280, 154, 255,
+	294, 159, 264, 150, 225, 251, 0, 0, 147, 278,
+	263, 207, 190, 191, 146, 0, 246, 169, 182, 166,
+	223, 698, 711, 666, 165, 664, 710, 289, 149, 0,
+	288, 222, 275, 279, 208, 202, 148, 277, 206, 201,
+	194, 173, 663, 186, 234, 200, 235, 187, 212, 211,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 707, 0,
+	0, 291, 0, 0, 726, 0, 0, 0, 265, 0,
+	0, 195, 0, 0, 0, 667, 0, 249, 228, 736,
+	0, 0, 247, 198, 276, 236, 281, 267, 290, 239,
+	237, 141, 268, 168, 209, 151, 152, 164, 170, 172,
+	174, 175, 218, 219, 231, 254, 269, 270, 271, 167,
+	160, 248, 161, 184, 162, 142, 257, 163, 143, 232,
+	274, 0, 180, 240, 205, 144, 204, 233, 273, 272,
+	298, 304, 305, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 303, 178, 0,
+	285, 724, 224, 735, 720, 721, 722, 725, 728, 729,
+	661, 665, 730, 732, 734, 737, 252, 0, 0, 0,
+	0, 0, 189, 230, 0, 253, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 283,
+	296, 662, 0, 0, 0, 295, 0, 0, 0, 0,
+	0, 715, 214, 215, 216, 217, 660, 0, 158, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
+	183, 0, 185, 157, 229, 179, 293, 192, 0, 221,
+	188, 258, 193, 199, 245, 292, 227, 250, 155, 282,
+	259, 203, 743, 723, 742, 744, 745, 741, 746, 747,
+	731, 683, 0, 739, 738, 740, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 682, 140,
+	0, 197, 0, 238, 176, 624, 625, 626, 627, 628,
+	629, 630, 631, 632, 633, 634, 635, 636, 637, 638,
+	119, 639, 640, 641, 642, 643, 644, 645, 646, 647,
+	648, 649, 650, 651, 652, 653, 654, 655, 656, 657,
+	658, 712, 0, 242, 243, 244, 241, 256, 0, 709,
+	260, 226, 0, 299, 300, 301, 284, 0, 0, 680,
+	0, 0, 0, 171, 0, 0, 196, 714, 0, 0,
+	261, 210, 0, 0, 0, 0, 727, 733, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 673, 0, 0,
+	0, 622, 719, 718, 691, 700, 0, 0, 153, 692,
+	0, 699, 693, 697, 696, 694, 695, 0, 0, 0,
+	659, 0, 0, 0, 0, 0, 0, 620, 677, 0,
+	681, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 674, 675, 0, 0, 0, 0, 713, 0, 676,
+	0, 0, 716, 0, 701, 0, 145, 266, 280, 154,
+	255, 294, 159, 264, 150, 225, 251, 0, 0, 147,
+	278, 263, 207, 190, 191, 146, 0, 246, 169, 182,
+	166, 223, 698, 711, 666, 165, 664, 710, 289, 149,
+	0, 288, 222, 275, 279, 208, 202, 148, 277, 206,
+	201, 194, 173, 663, 186, 234, 200, 235, 187, 212,
+	211, 213, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 707,
+	0, 0, 291, 0, 0, 726, 0, 0, 0, 265,
+	0, 0, 195, 0, 0, 0, 667, 0, 249, 228,
+	736, 2379, 0, 247, 198, 276, 236, 281, 267, 290,
+	239, 237, 141, 268, 168, 209, 151, 152, 164, 170,
+	172, 174, 175, 218, 219, 231, 254, 269, 270, 271,
+	167, 160, 248, 161, 184, 162, 142, 257, 163, 143,
+	232, 274, 0, 180, 240, 205, 144, 204, 233, 273,
+	272, 298, 304, 305, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 303, 178,
+	0, 285, 724, 224, 735, 720, 721, 722, 725, 728,
+	729, 661, 665, 730, 732, 734, 737, 252, 0, 0,
+	0, 0, 0, 189, 230, 0, 253, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 262,
+	283, 296, 662, 0, 0, 0, 295, 0, 0, 0,
+	0, 0, 715, 214, 215, 216, 217, 660, 0, 158,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	177, 183, 0, 185, 157, 229, 179, 293, 192, 0,
+	221, 188, 258, 193, 199, 245, 292, 227, 250, 155,
+	282, 259, 203, 743, 723, 742, 744, 745, 741, 746,
+	747, 731, 683, 0, 739, 738, 740, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 682,
+	140, 0, 197, 0, 238, 176, 624, 625, 626, 627,
 	628, 629, 630, 631, 632, 633, 634, 635, 636, 637,
-	687, 0, 291, 292, 293, 277, 0, 0, 0, 0,
-	225, 0, 0, 0, 0, 0, 0, 0, 658, 0,
-	0, 0, 169, 0, 0, 195, 0, 0, 0, 254,
-	209, 0, 0, 0, 0, 701, 707, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 651, 0, 0, 0,
-	601, 693, 692, 667, 676, 0, 0, 151, 668, 0,
-	675, 669, 673, 672, 670, 671, 0, 638, 0, 0,
-	0, 0, 0, 0, 599, 655, 0, 659, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 652, 653,
-	596, 0, 0, 0, 688, 0, 654, 0, 0, 690,
-	0, 677, 0, 143, 259, 273, 152, 250, 286, 157,
-	257, 148, 224, 246, 0, 0, 145, 271, 256, 206,
-	189, 190, 144, 0, 241, 167, 181, 164, 222, 674,
-	686, 644, 163, 642, 685, 281, 147, 0, 280, 221,
-	268, 272, 207, 201, 146, 270, 205, 200, 193, 171,
-	185, 233, 199, 234, 186, 211, 210, 212, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 683, 0, 0, 283, 0,
-	0, 700, 0, 0, 0, 258, 0, 0, 194, 0,
-	0, 0, 645, 0, 244, 227, 710, 0, 0, 242,
-	197, 269, 235, 274, 260, 282, 238, 236, 139, 261,
-	166, 208, 149, 150, 162, 168, 170, 172, 173, 217,
-	218, 230, 249, 262, 263, 264, 165, 158, 243, 159,
-	183, 160, 140, 251, 161, 141, 231, 267, 0, 179,
-	239, 204, 142, 203, 232, 266, 265, 290, 296, 297,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 295, 176, 0, 278, 698, 223,
-	709, 694, 695, 696, 699, 702, 703, 640, 643, 704,
-	706, 708, 711, 247, 0, 0, 0, 0, 0, 188,
-	229, 0, 248, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 255, 276, 288, 641, 0,
-	0, 0, 287, 0, 0, 0, 0, 0, 689, 213,
-	214, 215, 216, 639, 0, 156, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 175, 182, 0, 184,
-	155, 228, 178, 285, 191, 0, 220, 187, 252, 192,
-	198, 240, 284, 226, 245, 153, 275, 253, 202, 177,
-	717, 697, 716, 718, 719, 715, 720, 721, 705, 660,
-	0, 713, 712, 714, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 138, 0, 196, 0,
-	237, 174, 603, 604, 605, 606, 607, 608, 609, 610,
-	611, 612, 613, 614, 615, 616, 617, 117, 618, 619,
-	620, 621, 622, 623, 624, 625, 626, 627, 628, 629,
-	630, 631, 632, 633, 634, 635, 636, 637, 687, 0,
-	291, 292, 293, 277, 0, 0, 0, 0, 225, 0,
-	0, 0, 0, 0, 0, 0, 658, 0, 0, 0,
-	169, 0, 0, 195, 0, 0, 0, 254, 209, 0,
-	0, 0, 0, 701, 707, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 651, 0, 0, 0, 601, 693,
-	692, 667, 676, 0, 0, 151, 668, 0, 675, 669,
-	673, 672, 670, 671, 0, 638, 0, 0, 0, 0,
-	0, 0, 599, 655, 0, 659, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 652, 653, 0, 0,
-	0, 0, 688, 0, 654, 0, 0, 690, 0, 677,
-	0, 143, 259, 273, 152, 250, 286, 157, 257, 148,
-	224, 246, 0, 0, 145, 271, 256, 206, 189, 190,
-	144, 0, 241, 167, 181, 164, 222, 674, 686, 644,
-	163, 642, 685, 281, 147, 0, 280, 221, 268, 272,
-	207, 201, 146, 270, 205, 200, 193, 171, 185, 233,
-	199, 234, 186, 211, 210, 212, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 683, 0, 0, 283, 0, 0, 700,
-	0, 0, 0, 258, 0, 0, 194, 0, 0, 0,
-	645, 0, 244, 227, 710, 0, 0, 242, 197, 269,
-	235, 274, 260, 282, 238, 236, 139, 261, 166, 208,
-	149, 150, 162, 168, 170, 172, 173, 217, 218, 230,
-	249, 262, 263, 264, 165, 158, 243, 159, 183, 160,
-	140, 251, 161, 141, 231, 267, 0, 179, 239, 204,
-	142, 203, 232, 266, 265, 290, 296, 297, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 295, 176, 0, 278, 698, 223, 709, 694,
-	695, 696, 699, 702, 703, 640, 643, 704, 706, 708,
-	711, 247, 0, 0, 0, 0, 0, 188, 229, 0,
-	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 255, 276, 288, 641, 0, 0, 0,
-	287, 0, 0, 0, 0, 0, 689, 213, 214, 215,
-	216, 639, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 175, 182, 0, 184, 155, 228,
-	178, 285, 191, 0, 220, 187, 252, 192, 198, 240,
-	284, 226, 245, 153, 275, 253, 202, 177, 717, 697,
-	716, 718, 719, 715, 720, 721, 705, 660, 0, 713,
-	712, 714, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 138, 0, 196, 0, 237, 174,
-	603, 604, 605, 606, 607, 608, 609, 610, 611, 612,
-	613, 614, 615, 616, 617, 117, 618, 619, 620, 621,
-	622, 623, 624, 625, 626, 627, 628, 629, 630, 631,
-	632, 633, 634, 635, 636, 637, 687, 0, 291, 292,
-	293, 277, 0, 0, 0, 0, 225, 0, 0, 0,
-	1255, 0, 0, 0, 658, 0, 0, 0, 169, 0,
-	0, 195, 0, 0, 0, 254, 209, 0, 0, 0,
-	0, 701, 707, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 651, 0, 0, 0, 601, 693, 692, 667,
-	676, 0, 0, 151, 668, 0, 675, 669, 673, 672,
-	670, 671, 0, 638, 0, 0, 0, 0, 0, 0,
-	0, 655, 0, 659, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 652, 653, 0, 0, 0, 0,
-	688, 0, 654, 0, 0, 690, 0, 677, 0, 143,
-	259, 273, 152, 250, 286, 157, 257, 148, 224, 246,
-	0, 0, 145, 271, 256, 206, 189, 190, 144, 0,
-	241, 167, 181, 164, 222, 674, 686, 644, 163, 642,
-	685, 281, 147, 0, 280, 221, 268, 272, 207, 201,
-	146, 270, 205, 200, 193, 171, 185, 233, 199, 234,
-	186, 211, 210, 212, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 683, 0, 0, 283, 0, 0, 700, 0, 0,
-	0, 258, 0, 0, 194, 0, 0, 0, 645, 0,
-	244, 227, 710, 0, 0, 242, 197, 269, 235, 274,
-	260, 282, 238, 236, 139, 261, 166, 208, 149, 150,
-	162, 168, 170, 172, 173, 217, 218, 230, 249, 262,
-	263, 264, 165, 158, 243, 159, 183, 160, 140, 251,
-	161, 141, 231, 267, 0, 179, 239, 204, 142, 203,
-	232, 266, 265, 290, 1256, 1257, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	295, 176, 0, 278, 698, 223, 709, 694, 695, 696,
-	699, 702, 703, 640, 643, 704, 706, 708, 711, 247,
-	0, 0, 0, 0, 0, 188, 229, 0, 248, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 255, 276, 288, 641, 0, 0, 0, 287, 0,
-	0, 0, 0, 0, 689, 213, 214, 215, 216, 639,
-	0, 156, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 175, 182, 0, 184, 155, 228, 178, 285,
-	191, 0, 220, 187, 252, 192, 198, 240, 284, 226,
-	245, 153, 275, 253, 202, 177, 717, 697, 716, 718,
-	719, 715, 720, 721, 705, 660, 0, 713, 712, 714,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 138, 0, 196, 0, 237, 174, 603, 604,
-	605, 606, 607, 608, 609, 610, 611, 612, 613, 614,
-	615, 616, 617, 117, 618, 619, 620, 621, 622, 623,
-	624, 625, 626, 627, 628, 629, 630, 631, 632, 633,
-	634, 635, 636, 637, 687, 0, 291, 292, 293, 277,
-	0, 0, 0, 0, 225, 0, 0, 0, 0, 0,
-	0, 0, 658, 0, 0, 0, 169, 0, 0, 195,
-	0, 0, 0, 254, 209, 0, 0, 0, 0, 701,
-	707, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	651, 0, 0, 0, 601, 693, 692, 667, 676, 0,
-	0, 151, 668, 0, 675, 669, 673, 672, 670, 671,
-	0, 638, 0, 0, 0, 0, 0, 0, 0, 655,
-	0, 659, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 652, 653, 0, 0, 0, 0, 688, 0,
-	654, 0, 0, 690, 0, 677, 0, 143, 259, 273,
-	152, 250, 286, 157, 257, 148, 224, 246, 0, 0,
-	145, 271, 256, 206, 189, 190, 144, 0, 241, 167,
-	181, 164, 222, 674, 686, 644, 163, 642, 685, 281,
-	147, 0, 280, 221, 268, 272, 207, 201, 146, 270,
-	205, 200, 193, 171, 185, 233, 199, 234, 186, 211,
-	210, 212, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 683,
-	0, 0, 283, 0, 0, 700, 0, 0, 0, 258,
-	0, 0, 194, 0, 0, 0, 645, 0, 244, 227,
-	710, 0, 0, 242, 197, 269, 235, 274, 260, 282,
-	238, 236, 139, 261, 166, 208, 149, 150, 162, 168,
-	170, 172, 173, 217, 218, 230, 249, 262, 263, 264,
-	165, 158, 243, 159, 183, 160, 140, 251, 161, 141,
-	231, 267, 0, 179, 239, 204, 142, 203, 232, 266,
-	265, 290, 296, 297, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 295, 176,
-	0, 278, 698, 223, 709, 694, 695, 696, 699, 702,
-	703, 640, 643, 704, 706, 708, 711, 247, 0, 0,
-	0, 0, 0, 188, 229, 0, 248, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
-	276, 288, 641, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 689, 213, 214, 215, 216, 639, 0, 156,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	175, 182, 0, 184, 155, 228, 178, 285, 191, 0,
-	220, 187, 252, 192, 198, 240, 284, 226, 245, 153,
-	275, 253, 202, 177, 717, 697, 716, 718, 719, 715,
-	720, 721, 705, 660, 0, 713, 712, 714, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	138, 0, 196, 0, 237, 174, 603, 604, 605, 606,
-	607, 608, 609, 610, 611, 612, 613, 614, 615, 616,
-	617, 117, 618, 619, 620, 621, 622, 623, 624, 625,
+	638, 119, 639, 640, 641, 642, 643, 644, 645, 646,
+	647, 648, 649, 650, 651, 652, 653, 654, 655, 656,
+	657, 658, 712, 0, 242, 243, 244, 241, 256, 0,
+	709, 260, 226, 0, 299, 300, 301, 284, 0, 0,
+	680, 0, 0, 0, 171, 0, 0, 196, 714, 0,
+	0, 261, 210, 0, 0, 0, 0, 727, 733, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 673, 0,
+	0, 0, 622, 719, 718, 691, 700, 0, 0, 153,
+	692, 0, 699, 693, 697, 696, 694, 695, 0, 0,
+	0, 659, 0, 0, 0, 0, 0, 0, 620, 677,
+	0, 681, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 674, 675, 0, 0, 0, 0, 713, 0,
+	676, 0, 0, 716, 0, 701, 0, 145, 266, 280,
+	154, 255, 294, 159, 264, 150, 225, 251, 0, 0,
+	147, 278, 263, 207, 190, 191, 146, 0, 246, 169,
+	182, 166, 223, 698, 711, 666, 165, 664, 710, 289,
+	149, 0, 288, 222, 275, 279, 208, 202, 148, 277,
+	206, 201, 194, 173, 663, 186, 234, 200, 235, 187,
+	212, 211, 213, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	707, 0, 0, 291, 0, 0, 726, 0, 0, 0,
+	265, 0, 0, 195, 0, 0, 0, 667, 0, 249,
+	228, 736, 0, 0, 247, 198, 276, 236, 281, 267,
+	290, 239, 237, 141, 268, 168, 209, 151, 152, 164,
+	170, 172, 174, 175, 218, 219, 231, 254, 269, 270,
+	271, 167, 160, 248, 161, 184, 162, 142, 257, 163,
+	143, 232, 274, 0, 180, 240, 205, 144, 204, 233,
+	273, 272, 298, 304, 305, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 303,
+	178, 0, 285, 724, 224, 735, 720, 721, 722, 725,
+	728, 729, 661, 665, 730, 732, 734, 737, 252, 0,
+	0, 0, 0, 0, 189, 230, 0, 253, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 283, 296, 662, 0, 0, 0, 295, 0, 0,
+	0, 0, 0, 715, 214, 215, 216, 217, 660, 0,
+	158, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 177, 183, 0, 185, 157, 229, 179, 293, 192,
+	0, 221, 188, 258, 193, 199, 245, 292, 227, 250,
+	155, 282, 259, 203, 743, 723, 742, 744, 745, 741,
+	746, 747, 731, 683, 0, 739, 738, 740, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	682, 140, 0, 197, 0, 238, 176, 624, 625, 626,
+	627, 628, 629, 630, 631, 632, 633, 634, 635, 636,
+	637, 638, 119, 639, 640, 641, 642, 643, 644, 645,
+	646, 647, 648, 649, 650, 651, 652, 653, 654, 655,
+	656, 657, 658, 712, 0, 242, 243, 244, 241, 1944,
+	1945, 1946, 260, 226, 0, 299, 300, 301, 284, 0,
+	0, 680, 0, 0, 0, 171, 887, 0, 196, 714,
+	0, 0, 261, 210, 0, 0, 0, 0, 727, 733,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 673,
+	0, 0, 0, 622, 719, 718, 691, 700, 0, 0,
+	153, 692, 0, 699, 693, 697, 696, 694, 695, 0,
+	0, 0, 659, 0, 0, 0, 0, 0, 0, 620,
+	677, 0, 681, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 674, 675, 0, 0, 0, 0, 713,
+	0, 676, 0, 0, 716, 0, 701, 0, 145, 266,
+	280, 154, 255, 294, 159, 264, 150, 225, 251, 0,
+	0, 147, 278, 263, 207, 190, 191, 146, 0, 246,
+	169, 182, 166, 223, 698, 711, 666, 165, 664, 710,
+	289, 149, 0, 288, 222, 275, 279, 208, 202, 148,
+	277, 206, 201, 194, 173, 663, 186, 234, 200, 235,
+	187, 212, 211, 213, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 707, 0, 0, 291, 0, 0, 726, 0, 0,
+	0, 265, 0, 0, 195, 0, 0, 0, 667, 0,
+	249, 228, 736, 0, 0, 247, 198, 276, 236, 281,
+	267, 290, 239, 237, 141, 268, 168, 209, 151, 152,
+	164, 170, 172, 174, 175, 218, 219, 231, 254, 269,
+	270, 271, 167, 160, 248, 161, 184, 162, 142, 257,
+	163, 143, 232, 274, 0, 180, 240, 205, 144, 204,
+	233, 273, 272, 298, 304, 305, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	303, 178, 0, 285, 724, 224, 735, 720, 721, 722,
+	725, 728, 729, 661, 665, 730, 732, 734, 737, 252,
+	0, 0, 0, 0, 0, 189, 230, 0, 253, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 262, 283, 296, 662, 0, 0, 0, 295, 0,
+	0, 0, 0, 0, 715, 214, 215, 216, 217, 660,
+	0, 158, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 183, 0, 185, 157, 229, 179, 293,
+	192, 0, 221, 188, 258, 193, 199, 245, 292, 227,
+	250, 155, 282, 259, 203, 743, 723, 742, 744, 745,
+	741, 746, 747, 731, 683, 0, 739, 738, 740, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 682, 140, 0, 197, 0, 238, 176, 624, 625,
 	626, 627, 628, 629, 630, 631, 632, 633, 634, 635,
-	636, 637, 687, 0, 291, 292, 293, 277, 0, 0,
-	0, 0, 225, 0, 0, 0, 0, 0, 0, 0,
-	658, 0, 0, 0, 169, 0, 0, 195, 0, 0,
-	0, 254, 209, 0, 0, 0, 0, 701, 707, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 601, 693, 692, 667, 676, 0, 0, 151,
-	668, 0, 675, 669, 673, 672, 670, 671, 0, 638,
-	0, 0, 0, 0, 0, 0, 599, 655, 0, 659,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	652, 653, 0, 0, 0, 0, 688, 0, 654, 0,
-	0, 690, 0, 677, 0, 143, 259, 273, 152, 250,
-	286, 157, 257, 148, 224, 246, 0, 0, 145, 271,
-	256, 206, 189, 190, 144, 0, 241, 167, 181, 164,
-	222, 674, 686, 644, 163, 642, 685, 281, 147, 0,
-	280, 221, 268, 272, 207, 201, 146, 270, 205, 200,
-	193, 171, 185, 233, 199, 234, 186, 211, 210, 212,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 683, 0, 0,
-	283, 0, 0, 700, 0, 0, 0, 258, 0, 0,
-	194, 0, 0, 0, 645, 0, 244, 227, 710, 0,
-	0, 242, 197, 269, 235, 274, 260, 282, 238, 236,
-	139, 261, 166, 208, 149, 150, 162, 168, 170, 172,
-	173, 217, 218, 230, 249, 262, 263, 264, 165, 158,
-	243, 159, 183, 160, 140, 251, 161, 141, 231, 267,
-	0, 179, 239, 204, 142, 203, 232, 266, 265, 290,
-	296, 297, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 295, 176, 0, 278,
-	698, 223, 709, 694, 695, 696, 699, 702, 703, 640,
-	643, 704, 706, 708, 711, 247, 0, 0, 0, 0,
-	0, 188, 229, 0, 248, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 255, 276, 288,
-	641, 0, 0, 0, 287, 0, 0, 0, 0, 0,
-	689, 213, 214, 215, 216, 639, 0, 156, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 175, 182,
-	0, 184, 155, 228, 178, 285, 191, 0, 220, 187,
-	252, 192, 198, 240, 284, 226, 245, 153, 275, 253,
-	202, 177, 717, 697, 716, 718, 719, 715, 720, 721,
-	705, 660, 0, 713, 712, 714, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 138, 0,
-	196, 0, 237, 174, 603, 604, 605, 606, 607, 608,
-	609, 610, 611, 612, 613, 614, 615, 616, 617, 117,
-	618, 619, 620, 621, 622, 623, 624, 625, 626, 627,
+	636, 637, 638, 119, 639, 640, 641, 642, 643, 644,
+	645, 646, 647, 648, 649, 650, 651, 652, 653, 654,
+	655, 656, 657, 658, 0, 0, 242, 243, 244, 241,
+	256, 712, 709, 260, 1576, 0, 299, 300, 301, 284,
+	0, 226, 0, 0, 0, 0, 0, 0, 0, 680,
+	0, 0, 0, 171, 0, 0, 196, 714, 0, 0,
+	261, 210, 0, 0, 0, 0, 727, 733, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 673, 0, 0,
+	0, 622, 719, 718, 691, 700, 0, 0, 153, 692,
+	0, 699, 693, 697, 696, 694, 695, 0, 0, 0,
+	659, 0, 0, 0, 0, 0, 0, 620, 677, 0,
+	681, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 674, 675, 0, 0, 0, 0, 713, 0, 676,
+	0, 0, 716, 0, 701, 0, 145, 266, 280, 154,
+	255, 294, 159, 264, 150, 225, 251, 0, 0, 147,
+	278, 263, 207, 190, 191, 146, 0, 246, 169, 182,
+	166, 223, 698, 711, 666, 165, 664, 710, 289, 149,
+	0, 288, 222, 275, 279, 208, 202, 148, 277, 206,
+	201, 194, 173, 663, 186, 234, 200, 235, 187, 212,
+	211, 213, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 707,
+	0, 0, 291, 0, 0, 726, 0, 0, 0, 265,
+	0, 0, 195, 0, 0, 0, 667, 0, 249, 228,
+	736, 0, 0, 247, 198, 276, 236, 281, 267, 290,
+	239, 237, 141, 268, 168, 209, 151, 152, 164, 170,
+	172, 174, 175, 218, 219, 231, 254, 269, 270, 271,
+	167, 160, 248, 161, 184, 162, 142, 257, 163, 143,
+	232, 274, 0, 180, 240, 205, 144, 204, 233, 273,
+	272, 298, 304, 305, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 303, 178,
+	0, 285, 724, 224, 735, 720, 721, 722, 725, 728,
+	729, 661, 665, 730, 732, 734, 737, 252, 0, 0,
+	0, 0, 0, 189, 230, 0, 253, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 262,
+	283, 296, 662, 0, 0, 0, 295, 0, 0, 0,
+	0, 0, 715, 214, 215, 216, 217, 660, 0, 158,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	177, 183, 0, 185, 157, 229, 179, 293, 192, 0,
+	221, 188, 258, 193, 199, 245, 292, 227, 250, 155,
+	282, 259, 203, 743, 723, 742, 744, 745, 741, 746,
+	747, 731, 683, 0, 739, 738, 740, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 682,
+	140, 0, 197, 0, 238, 176, 624, 625, 626, 627,
 	628, 629, 630, 631, 632, 633, 634, 635, 636, 637,
-	0, 0, 291, 292, 293, 277, 339, 0, 338, 342,
-	334, 0, 0, 0, 0, 0, 0, 0, 225, 0,
-	0, 0, 330, 0, 0, 0, 0, 0, 0, 0,
-	169, 0, 349, 195, 0, 0, 0, 254, 209, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 352, 0,
-	0, 353, 0, 0, 0, 151, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 154, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 339, 0, 338,
-	342, 334, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 330, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 349, 0, 0, 0, 0, 0, 0,
-	0, 143, 259, 273, 152, 250, 286, 157, 257, 148,
-	224, 246, 0, 0, 145, 271, 256, 206, 189, 190,
-	144, 0, 241, 167, 181, 164, 222, 0, 0, 298,
-	163, 289, 0, 281, 147, 0, 280, 221, 268, 272,
-	207, 201, 146, 270, 205, 200, 193, 171, 185, 233,
-	199, 234, 186, 211, 210, 212, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 332, 331, 335,
-	0, 0, 0, 0, 0, 337, 283, 0, 0, 0,
-	0, 0, 0, 258, 0, 0, 194, 341, 0, 0,
-	299, 0, 244, 227, 0, 0, 0, 242, 197, 269,
-	235, 333, 260, 282, 238, 357, 139, 261, 166, 208,
-	149, 150, 162, 168, 170, 172, 173, 217, 218, 230,
-	249, 262, 263, 264, 165, 158, 243, 159, 183, 160,
-	140, 251, 161, 141, 231, 267, 0, 179, 239, 204,
-	142, 203, 232, 266, 265, 290, 296, 297, 332, 331,
-	335, 0, 0, 0, 0, 0, 337, 0, 0, 0,
-	0, 0, 295, 176, 0, 278, 0, 223, 341, 0,
-	0, 0, 0, 0, 0, 219, 294, 0, 0, 0,
-	0, 247, 763, 0, 0, 336, 340, 343, 229, 344,
-	345, 0, 0, 346, 347, 348, 0, 0, 350, 351,
-	0, 0, 0, 255, 276, 288, 279, 0, 0, 0,
-	287, 0, 0, 0, 0, 0, 0, 213, 214, 215,
-	216, 180, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 175, 182, 0, 184, 155, 228,
-	178, 285, 191, 0, 220, 187, 252, 192, 198, 240,
-	284, 226, 245, 153, 275, 253, 202, 177, 0, 0,
-	0, 0, 0, 0, 0, 0, 336, 340, 764, 0,
-	344, 765, 0, 0, 346, 347, 348, 0, 0, 350,
-	351, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 138, 0, 196, 0, 237, 174,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
-	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
-	132, 133, 134, 135, 136, 137, 0, 0, 291, 292,
-	293, 277, 339, 0, 338, 342, 334, 0, 0, 0,
-	0, 0, 0, 0, 225, 0, 0, 0, 330, 0,
-	0, 0, 0, 0, 0, 0, 169, 0, 349, 195,
-	0, 0, 0, 254, 209, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 352, 0, 0, 353, 0, 0,
-	0, 151, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 154, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 143, 259, 273,
-	152, 250, 286, 157, 257, 148, 224, 246, 0, 0,
-	145, 271, 256, 206, 189, 190, 144, 0, 241, 167,
-	181, 164, 222, 0, 0, 298, 163, 289, 0, 281,
-	147, 0, 280, 221, 268, 272, 207, 201, 146, 270,
-	205, 200, 193, 171, 185, 233, 199, 234, 186, 211,
-	210, 212, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 332, 331, 335, 0, 0, 0, 0,
-	0, 337, 283, 0, 0, 0, 0, 0, 0, 258,
-	0, 0, 194, 341, 0, 0, 299, 0, 244, 227,
-	0, 0, 0, 242, 197, 269, 235, 333, 260, 282,
-	238, 236, 139, 261, 166, 208, 149, 150, 162, 168,
-	170, 172, 173, 217, 218, 230, 249, 262, 263, 264,
-	165, 158, 243, 159, 183, 160, 140, 251, 161, 141,
-	231, 267, 0, 179, 239, 204, 142, 203, 232, 266,
-	265, 290, 296, 297, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 295, 176,
-	0, 278, 0, 223, 0, 0, 0, 0, 0, 0,
-	0, 219, 294, 0, 0, 0, 0, 247, 0, 0,
-	0, 336, 340, 343, 229, 344, 345, 0, 0, 346,
-	347, 348, 0, 0, 350, 351, 0, 0, 0, 255,
-	276, 288, 279, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 0, 213, 214, 215, 216, 180, 0, 156,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	175, 182, 0, 184, 155, 228, 178, 285, 191, 0,
-	220, 187, 252, 192, 198, 240, 284, 226, 245, 153,
-	275, 253, 202, 177, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	138, 0, 196, 0, 237, 174, 102, 103, 104, 105,
+	638, 119, 639, 640, 641, 642, 643, 644, 645, 646,
+	647, 648, 649, 650, 651, 652, 653, 654, 655, 656,
+	657, 658, 712, 0, 242, 243, 244, 241, 256, 0,
+	709, 260, 226, 0, 299, 300, 301, 284, 0, 0,
+	680, 0, 0, 0, 171, 0, 0, 196, 714, 0,
+	0, 261, 210, 0, 0, 0, 0, 727, 733, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 673, 0,
+	0, 0, 622, 719, 718, 691, 700, 0, 0, 153,
+	692, 0, 699, 693, 697, 696, 694, 695, 0, 0,
+	0, 659, 0, 0, 0, 0, 0, 0, 620, 677,
+	0, 681, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 674, 675, 617, 0, 0, 0, 713, 0,
+	676, 0, 0, 716, 0, 701, 0, 145, 266, 280,
+	154, 255, 294, 159, 264, 150, 225, 251, 0, 0,
+	147, 278, 263, 207, 190, 191, 146, 0, 246, 169,
+	182, 166, 223, 698, 711, 666, 165, 664, 710, 289,
+	149, 0, 288, 222, 275, 279, 208, 202, 148, 277,
+	206, 201, 194, 173, 663, 186, 234, 200, 235, 187,
+	212, 211, 213, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	707, 0, 0, 291, 0, 0, 726, 0, 0, 0,
+	265, 0, 0, 195, 0, 0, 0, 667, 0, 249,
+	228, 736, 0, 0, 247, 198, 276, 236, 281, 267,
+	290, 239, 237, 141, 268, 168, 209, 151, 152, 164,
+	170, 172, 174, 175, 218, 219, 231, 254, 269, 270,
+	271, 167, 160, 248, 161, 184, 162, 142, 257, 163,
+	143, 232, 274, 0, 180, 240, 205, 144, 204, 233,
+	273, 272, 298, 304, 305, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 303,
+	178, 0, 285, 724, 224, 735, 720, 721, 722, 725,
+	728, 729, 661, 665, 730, 732, 734, 737, 252, 0,
+	0, 0, 0, 0, 189, 230, 0, 253, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 283, 296, 662, 0, 0, 0, 295, 0, 0,
+	0, 0, 0, 715, 214, 215, 216, 217, 660, 0,
+	158, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 177, 183, 0, 185, 157, 229, 179, 293, 192,
+	0, 221, 188, 258, 193, 199, 245, 292, 227, 250,
+	155, 282, 259, 203, 743, 723, 742, 744, 745, 741,
+	746, 747, 731, 683, 0, 739, 738, 740, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	682, 140, 0, 197, 0, 238, 176, 624, 625, 626,
+	627, 628, 629, 630, 631, 632, 633, 634, 635, 636,
+	637, 638, 119, 639, 640, 641, 642, 643, 644, 645,
+	646, 647, 648, 649, 650, 651, 652, 653, 654, 655,
+	656, 657, 658, 712, 0, 242, 243, 244, 241, 256,
+	0, 709, 260, 226, 0, 299, 300, 301, 284, 0,
+	0, 680, 0, 0, 0, 171, 0, 0, 196, 714,
+	0, 0, 261, 210, 0, 0, 0, 0, 727, 733,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2272,
+	0, 0, 0, 622, 719, 718, 691, 700, 0, 0,
+	153, 692, 0, 699, 693, 697, 696, 694, 695, 0,
+	0, 0, 659, 0, 0, 0, 0, 0, 0, 620,
+	677, 0, 681, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 674, 675, 0, 0, 0, 0, 713,
+	0, 676, 0, 0, 716, 0, 701, 0, 145, 266,
+	280, 154, 255, 294, 159, 264, 150, 225, 251, 0,
+	0, 147, 278, 263, 207, 190, 191, 146, 0, 246,
+	169, 182, 166, 223, 698, 711, 666, 165, 664, 710,
+	289, 149, 0, 288, 222, 275, 279, 208, 202, 148,
+	277, 206, 201, 194, 173, 663, 186, 234, 200, 235,
+	187, 212, 211, 213, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 707, 0, 0, 291, 0, 0, 726, 0, 0,
+	0, 265, 0, 0, 195, 0, 0, 0, 667, 0,
+	249, 228, 736, 0, 0, 247, 198, 276, 236, 281,
+	267, 290, 239, 237, 141, 268, 168, 209, 151, 152,
+	164, 170, 172, 174, 175, 218, 219, 231, 254, 269,
+	270, 271, 167, 160, 248, 161, 184, 162, 142, 257,
+	163, 143, 232, 274, 0, 180, 240, 205, 144, 204,
+	233, 273, 272, 298, 304, 305, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	303, 178, 0, 285, 724, 224, 735, 720, 721, 722,
+	725, 728, 729, 661, 665, 730, 732, 734, 737, 252,
+	0, 0, 0, 0, 0, 189, 230, 0, 253, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 262, 283, 296, 662, 0, 0, 0, 295, 0,
+	0, 0, 0, 0, 715, 214, 215, 216, 217, 660,
+	0, 158, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 183, 0, 185, 157, 229, 179, 293,
+	192, 0, 221, 188, 258, 193, 199, 245, 292, 227,
+	250, 155, 282, 259, 203, 743, 723, 742, 744, 745,
+	741, 746, 747, 731, 683, 0, 739, 738, 740, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 682, 140, 0, 197, 0, 238, 176, 624, 625,
+	626, 627, 628, 629, 630, 631, 632, 633, 634, 635,
+	636, 637, 638, 119, 639, 640, 641, 642, 643, 644,
+	645, 646, 647, 648, 649, 650, 651, 652, 653, 654,
+	655, 656, 657, 658, 712, 0, 242, 243, 244, 241,
+	256, 0, 709, 260, 226, 0, 299, 300, 301, 284,
+	0, 0, 680, 0, 0, 0, 171, 0, 0, 196,
+	714, 0, 0, 261, 210, 0, 0, 0, 0, 727,
+	733, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	673, 0, 0, 0, 622, 719, 718, 691, 700, 0,
+	0, 153, 692, 0, 699, 693, 697, 696, 694, 695,
+	0, 0, 0, 659, 0, 0, 0, 0, 0, 0,
+	620, 677, 0, 681, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 674, 675, 0, 0, 0, 0,
+	713, 0, 676, 0, 0, 716, 0, 701, 0, 145,
+	266, 280, 154, 255, 294, 159, 264, 150, 225, 251,
+	0, 0, 147, 278, 263, 207, 190, 191, 146, 0,
+	246, 169, 182, 166, 223, 698, 711, 666, 165, 664,
+	710, 289, 149, 0, 288, 222, 275, 279, 208, 202,
+	148, 277, 206, 201, 194, 173, 663, 186, 234, 200,
+	235, 187, 212, 211, 213, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 707, 0, 0, 291, 0, 0, 726, 0,
+	0, 0, 265, 0, 0, 195, 0, 0, 0, 667,
+	0, 249, 228, 736, 0, 0, 247, 198, 276, 236,
+	281, 267, 290, 239, 237, 141, 268, 168, 209, 151,
+	152, 164, 170, 172, 174, 175, 218, 219, 231, 254,
+	269, 270, 271, 167, 160, 248, 161, 184, 162, 142,
+	257, 163, 143, 232, 274, 0, 180, 240, 205, 144,
+	204, 233, 273, 272, 298, 304, 305, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 303, 178, 0, 285, 724, 224, 735, 720, 721,
+	722, 725, 728, 729, 661, 665, 730, 732, 734, 737,
+	252, 0, 0, 0, 0, 0, 189, 230, 0, 253,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 283, 296, 662, 0, 0, 0, 295,
+	0, 0, 0, 0, 0, 715, 214, 215, 216, 217,
+	660, 0, 158, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 177, 183, 0, 185, 157, 229, 179,
+	293, 192, 0, 221, 188, 258, 193, 199, 245, 292,
+	227, 250, 155, 282, 259, 203, 743, 723, 742, 744,
+	745, 741, 746, 747, 731, 683, 0, 739, 738, 740,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 682, 140, 0, 197, 0, 238, 176, 624,
+	625, 626, 627, 628, 629, 630, 631, 632, 633, 634,
+	635, 636, 637, 638, 119, 639, 640, 641, 642, 643,
+	644, 645, 646, 647, 648, 649, 650, 651, 652, 653,
+	654, 655, 656, 657, 658, 0, 0, 242, 243, 244,
+	241, 256, 712, 709, 260, 0, 0, 299, 300, 301,
+	284, 0, 226, 0, 0, 0, 1322, 0, 0, 0,
+	680, 0, 0, 0, 171, 0, 0, 196, 714, 0,
+	0, 261, 210, 0, 0, 0, 0, 727, 733, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 673, 0,
+	0, 0, 622, 719, 718, 691, 700, 0, 0, 153,
+	692, 0, 699, 693, 697, 696, 694, 695, 0, 0,
+	0, 659, 0, 0, 0, 0, 0, 0, 0, 677,
+	0, 681, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 674, 675, 0, 0, 0, 0, 713, 0,
+	676, 0, 0, 716, 0, 701, 0, 145, 266, 280,
+	154, 255, 294, 159, 264, 150, 225, 251, 0, 0,
+	147, 278, 263, 207, 190, 191, 146, 0, 246, 169,
+	182, 166, 223, 698, 711, 666, 165, 664, 710, 289,
+	149, 0, 288, 222, 275, 279, 208, 202, 148, 277,
+	206, 201, 194, 173, 663, 186, 234, 200, 235, 187,
+	212, 211, 213, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	707, 0, 0, 291, 0, 0, 726, 0, 0, 0,
+	265, 0, 0, 195, 0, 0, 0, 667, 0, 249,
+	228, 736, 0, 0, 247, 198, 276, 236, 281, 267,
+	290, 239, 237, 141, 268, 168, 209, 151, 152, 164,
+	170, 172, 174, 175, 218, 219, 231, 254, 269, 270,
+	271, 167, 160, 248, 161, 184, 162, 142, 257, 163,
+	143, 232, 274, 0, 180, 240, 205, 144, 204, 233,
+	273, 272, 298, 1323, 1324, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 303,
+	178, 0, 285, 724, 224, 735, 720, 721, 722, 725,
+	728, 729, 661, 665, 730, 732, 734, 737, 252, 0,
+	0, 0, 0, 0, 189, 230, 0, 253, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 283, 296, 662, 0, 0, 0, 295, 0, 0,
+	0, 0, 0, 715, 214, 215, 216, 217, 660, 0,
+	158, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 177, 183, 0, 185, 157, 229, 179, 293, 192,
+	0, 221, 188, 258, 193, 199, 245, 292, 227, 250,
+	155, 282, 259, 203, 743, 723, 742, 744, 745, 741,
+	746, 747, 731, 683, 0, 739, 738, 740, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	682, 140, 0, 197, 0, 238, 176, 624, 625, 626,
+	627, 628, 629, 630, 631, 632, 633, 634, 635, 636,
+	637, 638, 119, 639, 640, 641, 642, 643, 644, 645,
+	646, 647, 648, 649, 650, 651, 652, 653, 654, 655,
+	656, 657, 658, 712, 0, 242, 243, 244, 241, 256,
+	0, 709, 260, 226, 0, 299, 300, 301, 284, 0,
+	0, 680, 0, 0, 0, 171, 0, 0, 196, 714,
+	0, 0, 261, 210, 0, 0, 0, 0, 727, 733,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 673,
+	0, 0, 0, 622, 719, 718, 691, 700, 0, 0,
+	153, 692, 0, 699, 693, 697, 696, 694, 695, 0,
+	0, 0, 659, 0, 0, 0, 0, 0, 0, 0,
+	677, 0, 681, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 674, 675, 0, 0, 0, 0, 713,
+	0, 676, 0, 0, 716, 0, 701, 0, 145, 266,
+	280, 154, 255, 294, 159, 264, 150, 225, 251, 0,
+	0, 147, 278, 263, 207, 190, 191, 146, 0, 246,
+	169, 182, 166, 223, 698, 711, 666, 165, 664, 710,
+	289, 149, 0, 288, 222, 275, 279, 208, 202, 148,
+	277, 206, 201, 194, 173, 663, 186, 234, 200, 235,
+	187, 212, 211, 213, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 707, 0, 0, 291, 0, 0, 726, 0, 0,
+	0, 265, 0, 0, 195, 0, 0, 0, 667, 0,
+	249, 228, 736, 0, 0, 247, 198, 276, 236, 281,
+	267, 290, 239, 237, 141, 268, 168, 209, 151, 152,
+	164, 170, 172, 174, 175, 218, 219, 231, 254, 269,
+	270, 271, 167, 160, 248, 161, 184, 162, 142, 257,
+	163, 143, 232, 274, 0, 180, 240, 205, 144, 204,
+	233, 273, 272, 298, 304, 305, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	303, 178, 0, 285, 724, 224, 735, 720, 721, 722,
+	725, 728, 729, 661, 665, 730, 732, 734, 737, 252,
+	0, 0, 0, 0, 0, 189, 230, 0, 253, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 262, 283, 296, 662, 0, 0, 0, 295, 0,
+	0, 0, 0, 0, 715, 214, 215, 216, 217, 660,
+	0, 158, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 183, 0, 185, 157, 229, 179, 293,
+	192, 0, 221, 188, 258, 193, 199, 245, 292, 227,
+	250, 155, 282, 259, 203, 743, 723, 742, 744, 745,
+	741, 746, 747, 731, 683, 0, 739, 738, 740, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 682, 140, 0, 197, 0, 238, 176, 624, 625,
+	626, 627, 628, 629, 630, 631, 632, 633, 634, 635,
+	636, 637, 638, 119, 639, 640, 641, 642, 643, 644,
+	645, 646, 647, 648, 649, 650, 651, 652, 653, 654,
+	655, 656, 657, 658, 712, 0, 242, 243, 244, 241,
+	256, 0, 709, 260, 226, 0, 299, 300, 301, 284,
+	0, 0, 680, 0, 0, 0, 171, 0, 0, 196,
+	714, 0, 0, 261, 210, 0, 0, 0, 0, 727,
+	733, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 622, 719, 718, 691, 700, 0,
+	0, 153, 692, 0, 699, 693, 697, 696, 694, 695,
+	0, 0, 0, 659, 0, 0, 0, 0, 0, 0,
+	620, 677, 0, 681, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 674, 675, 0, 0, 0, 0,
+	713, 0, 676, 0, 0, 716, 0, 701, 0, 145,
+	266, 280, 154, 255, 294, 159, 264, 150, 225, 251,
+	0, 0, 147, 278, 263, 207, 190, 191, 146, 0,
+	246, 169, 182, 166, 223, 698, 711, 666, 165, 664,
+	710, 289, 149, 0, 288, 222, 275, 279, 208, 202,
+	148, 277, 206, 201, 194, 173, 663, 186, 234, 200,
+	235, 187, 212, 211, 213, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 707, 0, 0, 291, 0, 0, 726, 0,
+	0, 0, 265, 0, 0, 195, 0, 0, 0, 667,
+	0, 249, 228, 736, 0, 0, 247, 198, 276, 236,
+	281, 267, 290, 239, 237, 141, 268, 168, 209, 151,
+	152, 164, 170, 172, 174, 175, 218, 219, 231, 254,
+	269, 270, 271, 167, 160, 248, 161, 184, 162, 142,
+	257, 163, 143, 232, 274, 0, 180, 240, 205, 144,
+	204, 233, 273, 272, 298, 304, 305, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 303, 178, 0, 285, 724, 224, 735, 720, 721,
+	722, 725, 728, 729, 661, 665, 730, 732, 734, 737,
+	252, 0, 0, 0, 0, 0, 189, 230, 0, 253,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 283, 296, 662, 0, 0, 0, 295,
+	0, 0, 0, 0, 0, 715, 214, 215, 216, 217,
+	660, 0, 158, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 177, 183, 0, 185, 157, 229, 179,
+	293, 192, 0, 221, 188, 258, 193, 199, 245, 292,
+	227, 250, 155, 282, 259, 203, 743, 723, 742, 744,
+	745, 741, 746, 747, 731, 683, 0, 739, 738, 740,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 682, 140, 0, 197, 0, 238, 176, 624,
+	625, 626, 627, 628, 629, 630, 631, 632, 633, 634,
+	635, 636, 637, 638, 119, 639, 640, 641, 642, 643,
+	644, 645, 646, 647, 648, 649, 650, 651, 652, 653,
+	654, 655, 656, 657, 658, 0, 0, 242, 243, 244,
+	241, 256, 0, 709, 260, 0, 0, 299, 300, 301,
+	284, 347, 0, 346, 350, 342, 0, 0, 0, 0,
+	0, 0, 0, 226, 0, 0, 0, 338, 0, 0,
+	0, 0, 0, 0, 0, 171, 0, 357, 196, 0,
+	0, 0, 261, 210, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 360, 0, 0, 361, 0, 0, 0,
+	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 156, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 145, 266,
+	280, 154, 255, 294, 159, 264, 150, 225, 251, 0,
+	0, 147, 278, 263, 207, 190, 191, 146, 0, 246,
+	169, 182, 166, 223, 0, 0, 306, 165, 297, 0,
+	289, 149, 0, 288, 222, 275, 279, 208, 202, 148,
+	277, 206, 201, 194, 173, 287, 186, 234, 200, 235,
+	187, 212, 211, 213, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 340, 339, 343, 0, 0,
+	0, 0, 0, 345, 291, 0, 0, 0, 0, 0,
+	0, 265, 0, 0, 195, 349, 0, 0, 307, 0,
+	249, 228, 0, 0, 0, 247, 198, 276, 236, 341,
+	267, 290, 239, 365, 141, 268, 168, 209, 151, 152,
+	164, 170, 172, 174, 175, 218, 219, 231, 254, 269,
+	270, 271, 167, 160, 248, 161, 184, 162, 142, 257,
+	163, 143, 232, 274, 0, 180, 240, 205, 144, 204,
+	233, 273, 272, 298, 304, 305, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	303, 178, 0, 285, 0, 224, 0, 0, 0, 0,
+	0, 0, 0, 220, 302, 0, 0, 0, 0, 252,
+	0, 0, 0, 344, 348, 351, 230, 352, 353, 0,
+	0, 354, 355, 356, 0, 0, 358, 359, 0, 0,
+	0, 262, 283, 296, 286, 0, 0, 0, 295, 0,
+	0, 0, 0, 0, 0, 214, 215, 216, 217, 181,
+	0, 158, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 177, 183, 0, 185, 157, 229, 179, 293,
+	192, 0, 221, 188, 258, 193, 199, 245, 292, 227,
+	250, 155, 282, 259, 203, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 140, 0, 197, 0, 238, 176, 104, 105,
 	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
 	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
 	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
-	136, 137, 0, 0, 291, 292, 293, 277, 93, 0,
-	26, 83, 67, 0, 0, 0, 0, 0, 0, 0,
-	225, 306, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 169, 0, 0, 195, 0, 0, 0, 254,
-	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 311, 0, 0, 0,
-	99, 0, 0, 0, 0, 0, 0, 151, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 154, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 143, 259, 273, 152, 250, 286, 157,
-	257, 148, 224, 246, 0, 0, 145, 271, 256, 206,
-	189, 190, 144, 0, 241, 167, 181, 164, 222, 0,
-	0, 298, 163, 289, 0, 281, 147, 0, 280, 221,
-	268, 272, 207, 201, 146, 270, 205, 200, 193, 171,
-	185, 233, 199, 234, 186, 211, 210, 212, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 310, 0, 0, 0, 0, 283, 0,
-	0, 0, 0, 0, 0, 258, 0, 0, 194, 0,
-	0, 0, 299, 0, 244, 227, 0, 0, 0, 242,
-	197, 269, 235, 274, 260, 282, 238, 236, 139, 261,
-	166, 208, 149, 150, 162, 168, 170, 172, 173, 217,
-	218, 230, 249, 262, 263, 264, 165, 158, 243, 159,
-	183, 160, 140, 251, 161, 141, 231, 267, 0, 179,
-	239, 204, 142, 203, 232, 266, 265, 290, 296, 297,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 295, 176, 0, 278, 0, 223,
-	0, 0, 0, 0, 0, 0, 0, 219, 294, 0,
-	0, 0, 0, 247, 0, 0, 0, 0, 0, 188,
-	229, 0, 248, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 255, 276, 288, 279, 0,
-	0, 0, 287, 0, 0, 0, 0, 0, 0, 213,
-	214, 215, 216, 307, 309, 156, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 175, 182, 0, 184,
-	155, 228, 178, 285, 191, 0, 220, 187, 252, 192,
-	198, 240, 284, 226, 245, 153, 275, 253, 202, 177,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 138, 0, 196, 68,
-	237, 174, 102, 103, 104, 105, 106, 107, 108, 109,
-	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
-	130, 131, 132, 133, 134, 135, 136, 137, 225, 0,
-	291, 292, 293, 277, 0, 0, 0, 0, 0, 0,
-	169, 0, 0, 195, 0, 0, 0, 254, 209, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 99, 0,
-	0, 0, 0, 0, 0, 151, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 154, 1564, 1567, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 143, 259, 273, 152, 250, 286, 157, 257, 148,
-	224, 246, 0, 0, 145, 271, 256, 206, 189, 190,
-	144, 0, 241, 167, 181, 164, 222, 0, 0, 298,
-	163, 289, 0, 281, 147, 0, 280, 221, 268, 272,
-	207, 201, 146, 270, 205, 200, 193, 171, 185, 233,
-	199, 234, 186, 211, 210, 212, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1568, 283, 0, 0, 0,
-	1561, 0, 1560, 258, 1562, 1565, 194, 0, 0, 0,
-	299, 0, 244, 227, 0, 0, 0, 242, 197, 269,
-	235, 274, 260, 282, 238, 236, 139, 261, 166, 208,
-	149, 150, 162, 168, 170, 172, 173, 217, 218, 230,
-	249, 262, 263, 264, 165, 158, 243, 159, 183, 160,
-	140, 251, 161, 141, 231, 267, 1566, 179, 239, 204,
-	142, 203, 232, 266, 265, 290, 296, 297, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 295, 176, 0, 278, 0, 223, 0, 0,
-	0, 0, 0, 0, 0, 219, 294, 0, 0, 0,
-	0, 247, 0, 0, 0, 0, 0, 188, 229, 0,
-	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 255, 276, 288, 279, 0, 0, 0,
-	287, 0, 0, 0, 0, 0, 0, 213, 214, 215,
-	216, 180, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 175, 182, 0, 184, 155, 228,
-	178, 285, 191, 0, 220, 187, 252, 192, 198, 240,
-	284, 226, 245, 153, 275, 253, 202, 177, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 138, 0, 196, 0, 237, 174,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
-	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
-	132, 133, 134, 135, 136, 137, 225, 0, 291, 292,
-	293, 277, 0, 878, 0, 0, 0, 0, 169, 0,
-	0, 195, 0, 0, 0, 254, 209, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 99, 0, 0, 879,
-	0, 0, 0, 151, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 154, 0, 0, 874, 875, 876, 873,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
-	259, 273, 152, 250, 286, 157, 257, 148, 224, 246,
-	0, 0, 145, 271, 256, 206, 189, 190, 144, 0,
-	241, 167, 181, 164, 222, 0, 0, 298, 163, 289,
-	0, 281, 147, 0, 280, 221, 268, 272, 207, 201,
-	146, 270, 205, 200, 193, 171, 185, 233, 199, 234,
-	186, 211, 210, 212, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 283, 0, 0, 0, 0, 0,
-	0, 258, 0, 0, 194, 0, 0, 0, 299, 0,
-	244, 227, 0, 0, 0, 242, 197, 269, 235, 274,
-	260, 282, 238, 236, 139, 261, 166, 208, 149, 150,
-	162, 168, 170, 172, 173, 217, 218, 230, 249, 262,
-	263, 264, 165, 158, 243, 159, 183, 160, 140, 251,
-	161, 141, 231, 267, 0, 179, 239, 204, 142, 203,
-	232, 266, 265, 290, 296, 297, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	295, 176, 0, 278, 0, 223, 0, 0, 0, 0,
-	0, 0, 0, 219, 294, 0, 0, 0, 0, 247,
-	0, 0, 0, 0, 0, 188, 229, 0, 248, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 255, 276, 288, 279, 0, 0, 0, 287, 0,
-	0, 0, 0, 0, 0, 213, 214, 215, 216, 180,
+	136, 137, 138, 139, 0, 0, 242, 243, 244, 241,
+	256, 0, 0, 260, 0, 0, 299, 300, 301, 284,
+	347, 0, 346, 350, 342, 0, 0, 0, 0, 0,
+	0, 0, 226, 0, 0, 0, 338, 0, 0, 0,
+	0, 0, 0, 0, 171, 0, 357, 196, 0, 0,
+	0, 261, 210, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 360, 0, 0, 361, 0, 0, 0, 153,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 156, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 175, 182, 0, 184, 155, 228, 178, 285,
-	191, 0, 220, 187, 252, 192, 198, 240, 284, 226,
-	245, 153, 275, 253, 202, 177, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 138, 0, 196, 0, 237, 174, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
-	124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
-	134, 135, 136, 137, 225, 0, 291, 292, 293, 277,
-	0, 0, 0, 0, 0, 0, 169, 415, 0, 195,
-	0, 0, 0, 254, 209, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 99, 423, 424, 0, 0, 0,
-	0, 151, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 428, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 143, 259, 273,
-	152, 250, 286, 157, 257, 148, 224, 246, 0, 0,
-	145, 271, 256, 206, 189, 190, 144, 0, 241, 167,
-	181, 164, 222, 0, 0, 298, 163, 289, 398, 281,
-	147, 397, 280, 221, 268, 272, 207, 201, 146, 270,
-	205, 200, 193, 171, 185, 233, 199, 234, 186, 211,
-	210, 212, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 283, 0, 0, 0, 0, 0, 0, 258,
-	0, 0, 194, 0, 0, 0, 299, 0, 244, 227,
-	0, 0, 0, 242, 197, 269, 235, 274, 260, 282,
-	414, 236, 139, 261, 166, 208, 149, 150, 162, 168,
-	170, 172, 173, 217, 218, 230, 249, 262, 263, 264,
-	165, 158, 243, 159, 183, 160, 140, 251, 161, 141,
-	231, 267, 0, 179, 239, 204, 142, 203, 232, 266,
-	265, 290, 296, 297, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 295, 176,
-	0, 278, 0, 223, 0, 0, 0, 0, 0, 0,
-	0, 219, 294, 0, 0, 0, 0, 247, 0, 0,
-	0, 0, 0, 188, 229, 0, 248, 0, 874, 875,
-	876, 873, 0, 0, 0, 0, 0, 0, 0, 255,
-	276, 288, 279, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 417, 213, 214, 215, 216, 180, 0, 156,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	175, 182, 0, 184, 155, 228, 178, 285, 191, 0,
-	425, 420, 421, 192, 198, 240, 284, 226, 245, 153,
-	275, 253, 422, 177, 0, 0, 0, 0, 0, 0,
-	0, 1294, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	138, 0, 196, 0, 237, 174, 102, 103, 104, 105,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
-	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
-	136, 137, 93, 0, 291, 292, 293, 277, 0, 0,
-	0, 0, 0, 0, 225, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 169, 0, 0, 195,
-	0, 0, 0, 254, 209, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	90, 0, 965, 0, 99, 0, 0, 0, 0, 0,
-	0, 151, 0, 0, 0, 0, 1290, 0, 1287, 0,
-	0, 154, 1289, 1286, 1288, 1292, 1293, 0, 0, 0,
-	1291, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 143, 259, 273,
-	152, 250, 286, 157, 257, 148, 224, 246, 0, 0,
-	145, 271, 256, 206, 189, 190, 144, 0, 241, 167,
-	181, 164, 222, 0, 0, 298, 163, 289, 0, 281,
-	147, 0, 280, 221, 268, 272, 207, 201, 146, 270,
-	205, 200, 193, 171, 185, 233, 199, 234, 186, 211,
-	210, 212, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282,
-	1283, 1284, 1285, 1297, 1298, 1299, 1300, 1301, 1302, 1295,
-	1296, 0, 283, 0, 0, 0, 0, 0, 0, 258,
-	0, 0, 194, 0, 0, 0, 299, 0, 244, 227,
-	0, 0, 0, 242, 197, 269, 235, 274, 260, 282,
-	238, 236, 139, 261, 166, 208, 149, 150, 162, 168,
-	170, 172, 173, 217, 218, 230, 249, 262, 263, 264,
-	165, 158, 243, 159, 183, 160, 140, 251, 161, 141,
-	231, 267, 0, 179, 239, 204, 142, 203, 232, 266,
-	265, 290, 296, 297, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 295, 176,
-	0, 278, 0, 223, 0, 0, 0, 0, 0, 0,
-	0, 219, 294, 0, 0, 0, 0, 247, 0, 0,
-	0, 0, 0, 188, 229, 0, 248, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
-	276, 288, 279, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 0, 213, 214, 215, 216, 180, 0, 156,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	175, 182, 0, 184, 155, 228, 178, 285, 191, 0,
-	220, 187, 252, 192, 198, 240, 284, 226, 245, 153,
-	275, 253, 202, 177, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	138, 0, 196, 68, 237, 174, 102, 103, 104, 105,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
-	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
-	136, 137, 225, 0, 291, 292, 293, 277, 0, 0,
-	0, 0, 0, 0, 169, 0, 0, 195, 0, 0,
-	0, 254, 209, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 99, 423, 424, 0, 0, 0, 0, 151,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 428,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 143, 259, 273, 152, 250,
-	286, 157, 257, 148, 224, 246, 0, 0, 145, 271,
-	256, 206, 189, 190, 144, 0, 241, 167, 181, 164,
-	222, 0, 0, 298, 163, 289, 398, 281, 147, 397,
-	280, 221, 268, 272, 207, 201, 146, 270, 205, 200,
-	193, 171, 185, 233, 199, 234, 186, 211, 210, 212,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	283, 0, 0, 0, 0, 0, 0, 258, 0, 0,
-	194, 0, 0, 0, 299, 0, 244, 227, 0, 0,
-	0, 242, 197, 269, 235, 274, 260, 282, 238, 236,
-	139, 261, 166, 208, 149, 150, 162, 168, 170, 172,
-	173, 217, 218, 230, 249, 262, 263, 264, 165, 158,
-	243, 159, 183, 160, 140, 251, 161, 141, 231, 267,
-	0, 179, 239, 204, 142, 203, 232, 266, 265, 290,
-	296, 297, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 295, 176, 0, 278,
-	0, 223, 0, 0, 0, 0, 0, 0, 0, 219,
-	294, 0, 0, 0, 0, 247, 0, 0, 0, 0,
-	0, 188, 229, 0, 248, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 255, 276, 288,
-	279, 0, 0, 0, 287, 0, 0, 0, 0, 0,
-	0, 213, 214, 215, 216, 180, 0, 156, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 175, 182,
-	0, 184, 155, 228, 178, 285, 191, 0, 425, 420,
-	421, 192, 198, 240, 284, 226, 245, 153, 275, 253,
-	422, 177, 0, 1294, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 138, 0,
-	196, 0, 237, 174, 102, 103, 104, 105, 106, 107,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 145, 266, 280,
+	154, 255, 294, 159, 264, 150, 225, 251, 0, 0,
+	147, 278, 263, 207, 190, 191, 146, 0, 246, 169,
+	182, 166, 223, 0, 0, 306, 165, 297, 0, 289,
+	149, 0, 288, 222, 275, 279, 208, 202, 148, 277,
+	206, 201, 194, 173, 287, 186, 234, 200, 235, 187,
+	212, 211, 213, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 340, 339, 343, 0, 0, 0,
+	0, 0, 345, 291, 0, 0, 0, 0, 0, 0,
+	265, 0, 0, 195, 349, 0, 0, 307, 0, 249,
+	228, 0, 0, 0, 247, 198, 276, 236, 341, 267,
+	290, 239, 237, 141, 268, 168, 209, 151, 152, 164,
+	170, 172, 174, 175, 218, 219, 231, 254, 269, 270,
+	271, 167, 160, 248, 161, 184, 162, 142, 257, 163,
+	143, 232, 274, 0, 180, 240, 205, 144, 204, 233,
+	273, 272, 298, 304, 305, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 303,
+	178, 0, 285, 0, 224, 0, 0, 0, 0, 0,
+	0, 0, 220, 302, 0, 0, 0, 0, 252, 0,
+	0, 0, 344, 348, 351, 230, 352, 353, 0, 0,
+	354, 355, 356, 0, 0, 358, 359, 0, 0, 0,
+	262, 283, 296, 286, 0, 0, 0, 295, 0, 0,
+	0, 0, 0, 0, 214, 215, 216, 217, 181, 0,
+	158, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 177, 183, 0, 185, 157, 229, 179, 293, 192,
+	0, 221, 188, 258, 193, 199, 245, 292, 227, 250,
+	155, 282, 259, 203, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 140, 0, 197, 0, 238, 176, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	137, 138, 139, 0, 0, 242, 243, 244, 241, 256,
+	0, 0, 260, 0, 0, 299, 300, 301, 284, 95,
+	0, 26, 85, 68, 0, 0, 0, 0, 0, 0,
+	0, 226, 314, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 171, 0, 0, 196, 0, 0, 0,
+	261, 210, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 319, 0, 0,
+	0, 101, 0, 0, 0, 0, 0, 0, 153, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 145, 266, 280, 154,
+	255, 294, 159, 264, 150, 225, 251, 0, 0, 147,
+	278, 263, 207, 190, 191, 146, 0, 246, 169, 182,
+	166, 223, 0, 0, 306, 165, 297, 0, 289, 149,
+	0, 288, 222, 275, 279, 208, 202, 148, 277, 206,
+	201, 194, 173, 287, 186, 234, 200, 235, 187, 212,
+	211, 213, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 318, 0, 0,
+	0, 0, 291, 0, 0, 0, 0, 0, 0, 265,
+	0, 0, 195, 0, 0, 0, 307, 0, 249, 228,
+	0, 0, 0, 247, 198, 276, 236, 281, 267, 290,
+	239, 237, 141, 268, 168, 209, 151, 152, 164, 170,
+	172, 174, 175, 218, 219, 231, 254, 269, 270, 271,
+	167, 160, 248, 161, 184, 162, 142, 257, 163, 143,
+	232, 274, 0, 180, 240, 205, 144, 204, 233, 273,
+	272, 298, 304, 305, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 303, 178,
+	0, 285, 0, 224, 0, 0, 0, 0, 0, 0,
+	0, 220, 302, 0, 0, 0, 0, 252, 0, 0,
+	0, 0, 0, 189, 230, 0, 253, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 262,
+	283, 296, 286, 0, 0, 0, 295, 0, 0, 0,
+	0, 0, 0, 214, 215, 216, 217, 315, 317, 158,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	177, 183, 0, 185, 157, 229, 179, 293, 192, 0,
+	221, 188, 258, 193, 199, 245, 292, 227, 250, 155,
+	282, 259, 203, 0, 0, 0, 0, 0, 0, 1361,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	140, 0, 197, 69, 238, 176, 104, 105, 106, 107,
 	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
 	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
 	128, 129, 130, 131, 132, 133, 134, 135, 136, 137,
-	0, 0, 291, 292, 293, 277, 225, 0, 0, 0,
-	558, 0, 0, 0, 0, 0, 0, 0, 169, 559,
-	0, 195, 0, 0, 0, 254, 209, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 352, 0, 0, 353,
-	0, 0, 0, 151, 0, 0, 0, 0, 1290, 0,
-	1287, 0, 0, 154, 1289, 1286, 1288, 1292, 1293, 0,
-	0, 0, 1291, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
-	259, 273, 152, 250, 286, 157, 257, 148, 224, 246,
-	0, 0, 145, 271, 256, 206, 189, 190, 144, 0,
-	241, 167, 181, 164, 222, 0, 0, 298, 163, 289,
-	0, 281, 147, 0, 280, 221, 268, 272, 207, 201,
-	146, 270, 205, 200, 193, 171, 185, 233, 199, 234,
-	186, 211, 210, 212, 1275, 1276, 1277, 1278, 1279, 1280,
-	1281, 1282, 1283, 1284, 1285, 1297, 1298, 1299, 1300, 1301,
-	1302, 1295, 1296, 0, 283, 0, 0, 0, 0, 0,
-	0, 258, 0, 0, 194, 0, 0, 0, 299, 0,
-	244, 227, 0, 0, 0, 242, 197, 269, 235, 274,
-	260, 282, 238, 236, 139, 261, 166, 208, 149, 150,
-	162, 168, 170, 172, 173, 217, 218, 230, 249, 262,
-	263, 264, 165, 158, 243, 159, 183, 160, 140, 251,
-	161, 141, 231, 267, 0, 179, 239, 204, 142, 203,
-	232, 266, 265, 290, 296, 297, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	295, 176, 0, 278, 0, 223, 0, 0, 0, 0,
-	0, 0, 0, 219, 294, 0, 0, 0, 0, 247,
-	0, 0, 0, 0, 0, 188, 229, 0, 248, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 255, 276, 288, 279, 0, 0, 0, 287, 0,
-	0, 0, 0, 560, 0, 213, 214, 215, 216, 180,
-	0, 156, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 175, 182, 0, 184, 155, 228, 178, 285,
-	191, 0, 220, 187, 252, 192, 198, 240, 284, 226,
-	245, 153, 275, 253, 202, 177, 0, 0, 0, 0,
+	138, 139, 0, 0, 242, 243, 244, 241, 256, 226,
+	0, 260, 0, 0, 299, 300, 301, 284, 0, 0,
+	0, 171, 0, 0, 196, 0, 0, 0, 261, 210,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 101,
+	0, 0, 0, 0, 0, 0, 153, 0, 0, 0,
+	0, 0, 0, 0, 0, 1357, 0, 1354, 156, 1662,
+	1665, 1356, 1353, 1355, 1359, 1360, 0, 0, 0, 1358,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 145, 266, 280, 154, 255, 294,
+	159, 264, 150, 225, 251, 0, 0, 147, 278, 263,
+	207, 190, 191, 146, 0, 246, 169, 182, 166, 223,
+	0, 0, 306, 165, 297, 0, 289, 149, 0, 288,
+	222, 275, 279, 208, 202, 148, 277, 206, 201, 194,
+	173, 287, 186, 234, 200, 235, 187, 212, 211, 213,
+	1342, 1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350, 1351,
+	1352, 1364, 1365, 1366, 1367, 1368, 1369, 1362, 1363, 1666,
+	291, 0, 0, 0, 1659, 0, 1658, 265, 1660, 1663,
+	195, 0, 0, 0, 307, 0, 249, 228, 0, 0,
+	0, 247, 198, 276, 236, 281, 267, 290, 239, 237,
+	141, 268, 168, 209, 151, 152, 164, 170, 172, 174,
+	175, 218, 219, 231, 254, 269, 270, 271, 167, 160,
+	248, 161, 184, 162, 142, 257, 163, 143, 232, 274,
+	1664, 180, 240, 205, 144, 204, 233, 273, 272, 298,
+	304, 305, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 303, 178, 0, 285,
+	0, 224, 0, 0, 0, 0, 0, 0, 0, 220,
+	302, 0, 0, 0, 0, 252, 0, 0, 0, 0,
+	0, 189, 230, 0, 253, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 262, 283, 296,
+	286, 0, 0, 0, 295, 0, 0, 0, 0, 0,
+	0, 214, 215, 216, 217, 181, 0, 158, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 177, 183,
+	0, 185, 157, 229, 179, 293, 192, 0, 221, 188,
+	258, 193, 199, 245, 292, 227, 250, 155, 282, 259,
+	203, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 140, 0,
+	197, 0, 238, 176, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
+	130, 131, 132, 133, 134, 135, 136, 137, 138, 139,
+	0, 0, 242, 243, 244, 241, 256, 0, 0, 260,
+	226, 0, 299, 300, 301, 284, 0, 915, 0, 0,
+	0, 0, 171, 0, 0, 196, 0, 0, 0, 261,
+	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	101, 0, 0, 916, 0, 0, 0, 153, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 156,
+	0, 0, 911, 912, 913, 910, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 145, 266, 280, 154, 255,
+	294, 159, 264, 150, 225, 251, 0, 0, 147, 278,
+	263, 207, 190, 191, 146, 0, 246, 169, 182, 166,
+	223, 0, 0, 306, 165, 297, 0, 289, 149, 0,
+	288, 222, 275, 279, 208, 202, 148, 277, 206, 201,
+	194, 173, 287, 186, 234, 200, 235, 187, 212, 211,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 291, 0, 0, 0, 0, 0, 0, 265, 0,
+	0, 195, 0, 0, 0, 307, 0, 249, 228, 0,
+	0, 0, 247, 198, 276, 236, 281, 267, 290, 239,
+	237, 141, 268, 168, 209, 151, 152, 164, 170, 172,
+	174, 175, 218, 219, 231, 254, 269, 270, 271, 167,
+	160, 248, 161, 184, 162, 142, 257, 163, 143, 232,
+	274, 0, 180, 240, 205, 144, 204, 233, 273, 272,
+	298, 304, 305, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 303, 178, 0,
+	285, 0, 224, 0, 0, 0, 0, 0, 0, 0,
+	220, 302, 0, 0, 0, 0, 252, 0, 0, 0,
+	0, 0, 189, 230, 0, 253, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 283,
+	296, 286, 0, 0, 0, 295, 0, 0, 0, 0,
+	0, 0, 214, 215, 216, 217, 181, 0, 158, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
+	183, 0, 185, 157, 229, 179, 293, 192, 0, 221,
+	188, 258, 193, 199, 245, 292, 227, 250, 155, 282,
+	259, 203, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 140,
+	0, 197, 0, 238, 176, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	139, 0, 0, 242, 243, 244, 241, 256, 226, 0,
+	260, 0, 0, 299, 300, 301, 284, 0, 0, 0,
+	171, 427, 0, 196, 0, 0, 0, 261, 210, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 101, 435,
+	436, 0, 0, 0, 0, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 440, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 145, 266, 280, 154, 255, 294, 159,
+	264, 150, 225, 251, 0, 0, 147, 278, 263, 207,
+	190, 191, 146, 0, 246, 169, 182, 166, 223, 0,
+	0, 306, 165, 297, 409, 289, 149, 408, 288, 222,
+	275, 279, 208, 202, 148, 277, 206, 201, 194, 173,
+	287, 186, 234, 200, 235, 187, 212, 211, 213, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	0, 0, 0, 0, 0, 0, 265, 0, 0, 195,
+	0, 0, 0, 307, 0, 249, 228, 0, 0, 0,
+	247, 198, 276, 236, 281, 267, 290, 426, 237, 141,
+	268, 168, 209, 151, 152, 164, 170, 172, 174, 175,
+	218, 219, 231, 254, 269, 270, 271, 167, 160, 248,
+	161, 184, 162, 142, 257, 163, 143, 232, 274, 0,
+	180, 240, 205, 144, 204, 233, 273, 272, 298, 304,
+	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 303, 178, 0, 285, 0,
+	224, 0, 0, 0, 0, 0, 0, 0, 220, 302,
+	0, 0, 0, 0, 252, 0, 0, 0, 0, 0,
+	189, 230, 0, 253, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 283, 296, 286,
+	0, 0, 0, 295, 0, 0, 0, 0, 0, 429,
+	214, 215, 216, 217, 181, 0, 158, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 177, 183, 0,
+	185, 157, 229, 179, 293, 192, 0, 437, 432, 433,
+	193, 199, 245, 292, 227, 250, 155, 282, 259, 434,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 140, 0, 197,
+	0, 238, 176, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 134, 135, 136, 137, 138, 139, 0,
+	0, 242, 243, 244, 241, 256, 95, 0, 260, 0,
+	0, 299, 300, 301, 284, 0, 0, 0, 226, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	171, 0, 0, 196, 0, 0, 0, 261, 210, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 92, 0, 1012, 0, 101, 0,
+	0, 0, 0, 0, 0, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 145, 266, 280, 154, 255, 294, 159,
+	264, 150, 225, 251, 0, 0, 147, 278, 263, 207,
+	190, 191, 146, 0, 246, 169, 182, 166, 223, 0,
+	0, 306, 165, 297, 0, 289, 149, 0, 288, 222,
+	275, 279, 208, 202, 148, 277, 206, 201, 194, 173,
+	287, 186, 234, 200, 235, 187, 212, 211, 213, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	0, 0, 0, 0, 0, 0, 265, 0, 0, 195,
+	0, 0, 0, 307, 0, 249, 228, 0, 0, 0,
+	247, 198, 276, 236, 281, 267, 290, 239, 237, 141,
+	268, 168, 209, 151, 152, 164, 170, 172, 174, 175,
+	218, 219, 231, 254, 269, 270, 271, 167, 160, 248,
+	161, 184, 162, 142, 257, 163, 143, 232, 274, 0,
+	180, 240, 205, 144, 204, 233, 273, 272, 298, 304,
+	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 303, 178, 0, 285, 0,
+	224, 0, 0, 0, 0, 0, 0, 0, 220, 302,
+	0, 0, 0, 0, 252, 0, 0, 0, 0, 0,
+	189, 230, 0, 253, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 283, 296, 286,
+	0, 0, 0, 295, 0, 0, 0, 0, 0, 0,
+	214, 215, 216, 217, 181, 0, 158, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 177, 183, 0,
+	185, 157, 229, 179, 293, 192, 0, 221, 188, 258,
+	193, 199, 245, 292, 227, 250, 155, 282, 259, 203,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 140, 0, 197,
+	69, 238, 176, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 134, 135, 136, 137, 138, 139, 0,
+	0, 242, 243, 244, 241, 256, 226, 0, 260, 0,
+	0, 299, 300, 301, 284, 0, 0, 0, 171, 0,
+	0, 196, 0, 0, 0, 261, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 101, 435, 436, 0,
+	0, 0, 0, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 440, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 138, 0, 196, 0, 237, 174, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
-	124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
-	134, 135, 136, 137, 0, 0, 291, 292, 293, 277,
-	225, 0, 0, 0, 839, 0, 0, 0, 0, 0,
-	0, 0, 169, 0, 0, 195, 0, 0, 0, 254,
-	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	352, 0, 0, 353, 0, 0, 0, 151, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 154, 0, 0,
+	0, 145, 266, 280, 154, 255, 294, 159, 264, 150,
+	225, 251, 0, 0, 147, 278, 263, 207, 190, 191,
+	146, 0, 246, 169, 182, 166, 223, 0, 0, 306,
+	165, 297, 409, 289, 149, 408, 288, 222, 275, 279,
+	208, 202, 148, 277, 206, 201, 194, 173, 287, 186,
+	234, 200, 235, 187, 212, 211, 213, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 0,
+	0, 0, 0, 0, 265, 0, 0, 195, 0, 0,
+	0, 307, 0, 249, 228, 0, 0, 0, 247, 198,
+	276, 236, 281, 267, 290, 239, 237, 141, 268, 168,
+	209, 151, 152, 164, 170, 172, 174, 175, 218, 219,
+	231, 254, 269, 270, 271, 167, 160, 248, 161, 184,
+	162, 142, 257, 163, 143, 232, 274, 0, 180, 240,
+	205, 144, 204, 233, 273, 272, 298, 304, 305, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 303, 178, 0, 285, 0, 224, 0,
+	0, 0, 0, 0, 0, 0, 220, 302, 0, 0,
+	0, 0, 252, 0, 0, 0, 0, 0, 189, 230,
+	0, 253, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 283, 296, 286, 0, 0,
+	0, 295, 0, 0, 0, 0, 0, 0, 214, 215,
+	216, 217, 181, 0, 158, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 177, 183, 0, 185, 157,
+	229, 179, 293, 192, 0, 437, 432, 433, 193, 199,
+	245, 292, 227, 250, 155, 282, 259, 434, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 143, 259, 273, 152, 250, 286, 157,
-	257, 148, 224, 246, 0, 0, 145, 271, 256, 206,
-	189, 190, 144, 0, 241, 167, 181, 164, 222, 0,
-	0, 298, 163, 289, 0, 281, 147, 0, 280, 221,
-	268, 272, 207, 201, 146, 270, 205, 200, 193, 171,
-	185, 233, 199, 234, 186, 211, 210, 212, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 283, 0,
-	0, 0, 0, 0, 0, 258, 0, 0, 194, 0,
-	0, 0, 299, 0, 244, 227, 0, 0, 0, 242,
-	197, 269, 235, 274, 260, 282, 238, 236, 139, 261,
-	166, 208, 149, 150, 162, 168, 170, 172, 173, 217,
-	218, 230, 249, 262, 263, 264, 165, 158, 243, 159,
-	183, 160, 140, 251, 161, 141, 231, 267, 0, 179,
-	239, 204, 142, 203, 232, 266, 265, 290, 296, 297,
+	0, 0, 0, 0, 0, 140, 0, 197, 0, 238,
+	176, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 134, 135, 136, 137, 138, 139, 0, 0, 242,
+	243, 244, 241, 256, 226, 0, 260, 0, 578, 299,
+	300, 301, 284, 0, 0, 0, 171, 579, 0, 196,
+	0, 0, 0, 261, 210, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 295, 176, 0, 278, 0, 223,
-	0, 0, 0, 0, 0, 0, 0, 219, 294, 0,
-	0, 0, 0, 247, 0, 0, 0, 0, 0, 188,
-	229, 0, 248, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 255, 276, 288, 279, 0,
-	0, 0, 287, 0, 0, 0, 0, 838, 0, 213,
-	214, 215, 216, 180, 0, 156, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 175, 182, 0, 184,
-	155, 228, 178, 285, 191, 0, 220, 187, 252, 192,
-	198, 240, 284, 226, 245, 153, 275, 253, 202, 177,
+	0, 0, 0, 0, 360, 0, 0, 361, 0, 0,
+	0, 153, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 138, 0, 196, 0,
-	237, 174, 102, 103, 104, 105, 106, 107, 108, 109,
-	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
-	130, 131, 132, 133, 134, 135, 136, 137, 225, 0,
-	291, 292, 293, 277, 0, 0, 0, 0, 0, 0,
-	169, 580, 0, 195, 0, 0, 0, 254, 209, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 99, 0,
-	0, 578, 0, 0, 0, 151, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 154, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 577, 0, 0,
-	0, 143, 259, 273, 152, 250, 286, 157, 257, 148,
-	224, 246, 0, 0, 145, 271, 256, 206, 189, 190,
-	144, 0, 241, 167, 181, 164, 222, 0, 0, 298,
-	163, 289, 0, 281, 147, 0, 280, 221, 268, 272,
-	207, 201, 146, 270, 205, 200, 193, 171, 185, 233,
-	199, 234, 186, 211, 210, 212, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 283, 0, 0, 0,
-	0, 0, 0, 258, 0, 0, 194, 0, 0, 0,
-	299, 0, 244, 227, 0, 0, 0, 242, 197, 269,
-	235, 274, 260, 282, 238, 236, 139, 261, 166, 208,
-	149, 150, 162, 168, 170, 172, 173, 217, 218, 230,
-	249, 262, 263, 264, 165, 158, 243, 159, 183, 160,
-	140, 251, 161, 141, 231, 267, 0, 179, 239, 204,
-	142, 203, 232, 266, 265, 290, 296, 297, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 295, 176, 0, 278, 0, 223, 0, 0,
-	0, 0, 0, 0, 0, 219, 294, 0, 0, 0,
-	0, 247, 0, 0, 0, 0, 0, 188, 229, 0,
-	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 255, 276, 288, 279, 0, 0, 0,
-	287, 0, 0, 0, 0, 0, 0, 213, 214, 215,
-	216, 180, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 175, 182, 0, 184, 155, 228,
-	178, 285, 191, 0, 220, 187, 252, 192, 198, 240,
-	284, 226, 245, 153, 275, 253, 202, 177, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 138, 0, 196, 0, 237, 174,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
-	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
-	132, 133, 134, 135, 136, 137, 225, 0, 291, 292,
-	293, 277, 0, 0, 0, 0, 0, 0, 169, 575,
-	0, 195, 0, 0, 0, 254, 209, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 99, 0, 0, 578,
-	0, 0, 0, 151, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 154, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 577, 0, 0, 0, 143,
-	259, 273, 152, 250, 286, 157, 257, 148, 224, 246,
-	0, 0, 145, 271, 256, 206, 189, 190, 144, 0,
-	241, 167, 181, 164, 222, 0, 0, 298, 163, 289,
-	0, 281, 147, 0, 280, 221, 268, 272, 207, 201,
-	146, 270, 205, 200, 193, 171, 185, 233, 199, 234,
-	186, 211, 210, 212, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 283, 0, 0, 0, 0, 0,
-	0, 258, 0, 0, 194, 0, 0, 0, 299, 0,
-	244, 227, 0, 0, 0, 242, 197, 269, 235, 274,
-	260, 282, 238, 236, 139, 261, 166, 208, 149, 150,
-	162, 168, 170, 172, 173, 217, 218, 230, 249, 262,
-	263, 264, 165, 158, 243, 159, 183, 160, 140, 251,
-	161, 141, 231, 267, 0, 179, 239, 204, 142, 203,
-	232, 266, 265, 290, 296, 297, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	295, 176, 0, 278, 0, 223, 0, 0, 0, 0,
-	0, 0, 0, 219, 294, 0, 0, 0, 0, 247,
-	0, 0, 0, 0, 0, 188, 229, 0, 248, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 255, 276, 288, 279, 0, 0, 0, 287, 0,
-	0, 0, 0, 0, 0, 213, 214, 215, 216, 180,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 145,
+	266, 280, 154, 255, 294, 159, 264, 150, 225, 251,
+	0, 0, 147, 278, 263, 207, 190, 191, 146, 0,
+	246, 169, 182, 166, 223, 0, 0, 306, 165, 297,
+	0, 289, 149, 0, 288, 222, 275, 279, 208, 202,
+	148, 277, 206, 201, 194, 173, 287, 186, 234, 200,
+	235, 187, 212, 211, 213, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 0, 0, 0, 0,
+	0, 0, 265, 0, 0, 195, 0, 0, 0, 307,
+	0, 249, 228, 0, 0, 0, 247, 198, 276, 236,
+	281, 267, 290, 239, 237, 141, 268, 168, 209, 151,
+	152, 164, 170, 172, 174, 175, 218, 219, 231, 254,
+	269, 270, 271, 167, 160, 248, 161, 184, 162, 142,
+	257, 163, 143, 232, 274, 0, 180, 240, 205, 144,
+	204, 233, 273, 272, 298, 304, 305, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 303, 178, 0, 285, 0, 224, 0, 0, 0,
+	0, 0, 0, 0, 220, 302, 0, 0, 0, 0,
+	252, 0, 0, 0, 0, 0, 189, 230, 0, 253,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 283, 296, 286, 0, 0, 0, 295,
+	0, 0, 0, 0, 580, 0, 214, 215, 216, 217,
+	181, 0, 158, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 177, 183, 0, 185, 157, 229, 179,
+	293, 192, 0, 221, 188, 258, 193, 199, 245, 292,
+	227, 250, 155, 282, 259, 203, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 140, 0, 197, 0, 238, 176, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 137, 138, 139, 0, 0, 242, 243, 244,
+	241, 256, 226, 0, 260, 0, 875, 299, 300, 301,
+	284, 0, 0, 0, 171, 0, 0, 196, 0, 0,
+	0, 261, 210, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 360, 0, 0, 361, 0, 0, 0, 153,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 156, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 175, 182, 0, 184, 155, 228, 178, 285,
-	191, 0, 220, 187, 252, 192, 198, 240, 284, 226,
-	245, 153, 275, 253, 202, 177, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 138, 0, 196, 0, 237, 174, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
-	124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
-	134, 135, 136, 137, 225, 0, 291, 292, 293, 277,
-	0, 0, 0, 0, 0, 0, 169, 0, 0, 195,
-	0, 0, 0, 254, 209, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 145, 266, 280,
+	154, 255, 294, 159, 264, 150, 225, 251, 0, 0,
+	147, 278, 263, 207, 190, 191, 146, 0, 246, 169,
+	182, 166, 223, 0, 0, 306, 165, 297, 0, 289,
+	149, 0, 288, 222, 275, 279, 208, 202, 148, 277,
+	206, 201, 194, 173, 287, 186, 234, 200, 235, 187,
+	212, 211, 213, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 2175, 0, 99, 693, 0, 0, 0, 0,
-	0, 151, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 154, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 291, 0, 0, 0, 0, 0, 0,
+	265, 0, 0, 195, 0, 0, 0, 307, 0, 249,
+	228, 0, 0, 0, 247, 198, 276, 236, 281, 267,
+	290, 239, 237, 141, 268, 168, 209, 151, 152, 164,
+	170, 172, 174, 175, 218, 219, 231, 254, 269, 270,
+	271, 167, 160, 248, 161, 184, 162, 142, 257, 163,
+	143, 232, 274, 0, 180, 240, 205, 144, 204, 233,
+	273, 272, 298, 304, 305, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 303,
+	178, 0, 285, 0, 224, 0, 0, 0, 0, 0,
+	0, 0, 220, 302, 0, 0, 0, 0, 252, 0,
+	0, 0, 0, 0, 189, 230, 0, 253, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 283, 296, 286, 0, 0, 0, 295, 0, 0,
+	0, 0, 874, 0, 214, 215, 216, 217, 181, 0,
+	158, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 177, 183, 0, 185, 157, 229, 179, 293, 192,
+	0, 221, 188, 258, 193, 199, 245, 292, 227, 250,
+	155, 282, 259, 203, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 143, 259, 273,
-	152, 250, 286, 157, 257, 148, 224, 246, 0, 0,
-	145, 271, 256, 206, 189, 190, 144, 0, 241, 167,
-	181, 164, 222, 0, 0, 298, 163, 289, 0, 281,
-	147, 0, 280, 221, 268, 272, 207, 201, 146, 270,
-	205, 200, 193, 171, 185, 233, 199, 234, 186, 211,
-	210, 212, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 283, 0, 0, 0, 0, 0, 0, 258,
-	0, 0, 194, 0, 0, 0, 299, 0, 244, 227,
-	0, 0, 0, 242, 197, 269, 235, 274, 260, 282,
-	238, 236, 139, 261, 166, 208, 149, 150, 162, 168,
-	170, 172, 173, 217, 218, 230, 249, 262, 263, 264,
-	165, 158, 243, 159, 183, 160, 140, 251, 161, 141,
-	231, 267, 0, 179, 239, 204, 142, 203, 232, 266,
-	265, 290, 296, 297, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 295, 176,
-	0, 278, 0, 223, 0, 0, 0, 0, 0, 0,
-	0, 219, 294, 0, 0, 0, 0, 247, 0, 0,
-	0, 0, 0, 188, 229, 0, 248, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
-	276, 288, 279, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 0, 213, 214, 215, 216, 180, 0, 156,
+	0, 140, 0, 197, 0, 238, 176, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	137, 138, 139, 0, 0, 242, 243, 244, 241, 256,
+	226, 0, 260, 0, 0, 299, 300, 301, 284, 0,
+	0, 0, 171, 600, 0, 196, 0, 0, 0, 261,
+	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	101, 0, 0, 598, 0, 0, 0, 153, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 156,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 597, 0, 0, 0, 145, 266, 280, 154, 255,
+	294, 159, 264, 150, 225, 251, 0, 0, 147, 278,
+	263, 207, 190, 191, 146, 0, 246, 169, 182, 166,
+	223, 0, 0, 306, 165, 297, 0, 289, 149, 0,
+	288, 222, 275, 279, 208, 202, 148, 277, 206, 201,
+	194, 173, 287, 186, 234, 200, 235, 187, 212, 211,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 291, 0, 0, 0, 0, 0, 0, 265, 0,
+	0, 195, 0, 0, 0, 307, 0, 249, 228, 0,
+	0, 0, 247, 198, 276, 236, 281, 267, 290, 239,
+	237, 141, 268, 168, 209, 151, 152, 164, 170, 172,
+	174, 175, 218, 219, 231, 254, 269, 270, 271, 167,
+	160, 248, 161, 184, 162, 142, 257, 163, 143, 232,
+	274, 0, 180, 240, 205, 144, 204, 233, 273, 272,
+	298, 304, 305, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 303, 178, 0,
+	285, 0, 224, 0, 0, 0, 0, 0, 0, 0,
+	220, 302, 0, 0, 0, 0, 252, 0, 0, 0,
+	0, 0, 189, 230, 0, 253, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 283,
+	296, 286, 0, 0, 0, 295, 0, 0, 0, 0,
+	0, 0, 214, 215, 216, 217, 181, 0, 158, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
+	183, 0, 185, 157, 229, 179, 293, 192, 0, 221,
+	188, 258, 193, 199, 245, 292, 227, 250, 155, 282,
+	259, 203, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 140,
+	0, 197, 0, 238, 176, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	139, 0, 0, 242, 243, 244, 241, 256, 226, 0,
+	260, 0, 0, 299, 300, 301, 284, 0, 0, 0,
+	171, 595, 0, 196, 0, 0, 0, 261, 210, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 101, 0,
+	0, 598, 0, 0, 0, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 597,
+	0, 0, 0, 145, 266, 280, 154, 255, 294, 159,
+	264, 150, 225, 251, 0, 0, 147, 278, 263, 207,
+	190, 191, 146, 0, 246, 169, 182, 166, 223, 0,
+	0, 306, 165, 297, 0, 289, 149, 0, 288, 222,
+	275, 279, 208, 202, 148, 277, 206, 201, 194, 173,
+	287, 186, 234, 200, 235, 187, 212, 211, 213, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	0, 0, 0, 0, 0, 0, 265, 0, 0, 195,
+	0, 0, 0, 307, 0, 249, 228, 0, 0, 0,
+	247, 198, 276, 236, 281, 267, 290, 239, 237, 141,
+	268, 168, 209, 151, 152, 164, 170, 172, 174, 175,
+	218, 219, 231, 254, 269, 270, 271, 167, 160, 248,
+	161, 184, 162, 142, 257, 163, 143, 232, 274, 0,
+	180, 240, 205, 144, 204, 233, 273, 272, 298, 304,
+	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 303, 178, 0, 285, 0,
+	224, 0, 0, 0, 0, 0, 0, 0, 220, 302,
+	0, 0, 0, 0, 252, 0, 0, 0, 0, 0,
+	189, 230, 0, 253, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 283, 296, 286,
+	0, 0, 0, 295, 0, 0, 0, 0, 0, 0,
+	214, 215, 216, 217, 181, 0, 158, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 177, 183, 0,
+	185, 157, 229, 179, 293, 192, 0, 221, 188, 258,
+	193, 199, 245, 292, 227, 250, 155, 282, 259, 203,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 140, 0, 197,
+	0, 238, 176, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 134, 135, 136, 137, 138, 139, 0,
+	0, 242, 243, 244, 241, 256, 226, 0, 260, 0,
+	0, 299, 300, 301, 284, 0, 0, 0, 171, 0,
+	0, 196, 0, 0, 0, 261, 210, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	175, 182, 0, 184, 155, 228, 178, 285, 191, 0,
-	220, 187, 252, 192, 198, 240, 284, 226, 245, 153,
-	275, 253, 202, 177, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2392, 0, 101, 719, 0, 0,
+	0, 0, 0, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 156, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	138, 0, 196, 0, 237, 174, 102, 103, 104, 105,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
-	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
-	136, 137, 225, 0, 291, 292, 293, 277, 0, 0,
-	0, 0, 0, 0, 169, 0, 0, 195, 0, 0,
-	0, 254, 209, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 99, 0, 0, 578, 0, 0, 0, 151,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 154,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 577, 0, 0, 0, 143, 259, 273, 152, 250,
-	286, 157, 257, 148, 224, 246, 0, 0, 145, 271,
-	256, 206, 189, 190, 144, 0, 241, 167, 181, 164,
-	222, 0, 0, 298, 163, 289, 0, 281, 147, 0,
-	280, 221, 268, 272, 207, 201, 146, 270, 205, 200,
-	193, 171, 185, 233, 199, 234, 186, 211, 210, 212,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	283, 0, 0, 0, 0, 0, 0, 258, 0, 0,
-	194, 0, 0, 0, 299, 0, 244, 227, 0, 0,
-	0, 242, 197, 269, 235, 274, 260, 282, 238, 236,
-	139, 261, 166, 208, 149, 150, 162, 168, 170, 172,
-	173, 217, 218, 230, 249, 262, 263, 264, 165, 158,
-	243, 159, 183, 160, 140, 251, 161, 141, 231, 267,
-	0, 179, 239, 204, 142, 203, 232, 266, 265, 290,
-	296, 297, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 295, 176, 0, 278,
-	0, 223, 0, 0, 0, 0, 0, 0, 0, 219,
-	294, 0, 0, 0, 0, 247, 0, 0, 0, 0,
-	0, 188, 229, 0, 248, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 255, 276, 288,
-	279, 0, 0, 0, 287, 0, 0, 0, 0, 0,
-	0, 213, 214, 215, 216, 180, 0, 156, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 175, 182,
-	0, 184, 155, 228, 178, 285, 191, 0, 220, 187,
-	252, 192, 198, 240, 284, 226, 245, 153, 275, 253,
-	202, 177, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 138, 0,
-	196, 0, 237, 174, 102, 103, 104, 105, 106, 107,
-	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
-	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
-	128, 129, 130, 131, 132, 133, 134, 135, 136, 137,
-	225, 0, 291, 292, 293, 277, 0, 0, 0, 0,
-	0, 0, 169, 0, 0, 195, 0, 0, 0, 254,
-	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	99, 0, 0, 578, 0, 0, 0, 151, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 154, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1755,
-	0, 0, 0, 143, 259, 273, 152, 250, 286, 157,
-	257, 148, 224, 246, 0, 0, 145, 271, 256, 206,
-	189, 190, 144, 0, 241, 167, 181, 164, 222, 0,
-	0, 298, 163, 289, 0, 281, 147, 0, 280, 221,
-	268, 272, 207, 201, 146, 270, 205, 200, 193, 171,
-	185, 233, 199, 234, 186, 211, 210, 212, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 283, 0,
-	0, 0, 0, 0, 0, 258, 0, 0, 194, 0,
-	0, 0, 299, 0, 244, 227, 0, 0, 0, 242,
-	197, 269, 235, 274, 260, 282, 238, 236, 139, 261,
-	166, 208, 149, 150, 162, 168, 170, 172, 173, 217,
-	218, 230, 249, 262, 263, 264, 165, 158, 243, 159,
-	183, 160, 140, 251, 161, 141, 231, 267, 0, 179,
-	239, 204, 142, 203, 232, 266, 265, 290, 296, 297,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 295, 176, 0, 278, 0, 223,
-	0, 0, 0, 0, 0, 0, 0, 219, 294, 0,
-	0, 0, 0, 247, 0, 0, 0, 0, 0, 188,
-	229, 0, 248, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 255, 276, 288, 279, 0,
-	0, 0, 287, 0, 0, 0, 0, 0, 0, 213,
-	214, 215, 216, 180, 0, 156, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 175, 182, 0, 184,
-	155, 228, 178, 285, 191, 0, 220, 187, 252, 192,
-	198, 240, 284, 226, 245, 153, 275, 253, 202, 177,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 138, 0, 196, 0,
-	237, 174, 102, 103, 104, 105, 106, 107, 108, 109,
-	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
-	130, 131, 132, 133, 134, 135, 136, 137, 225, 0,
-	291, 292, 293, 277, 0, 0, 0, 0, 0, 0,
-	169, 0, 0, 195, 0, 0, 0, 254, 209, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 99, 0,
-	0, 578, 0, 0, 0, 151, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 154, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 143, 259, 273, 152, 250, 286, 157, 257, 148,
-	224, 246, 0, 0, 145, 271, 256, 206, 189, 190,
-	144, 0, 241, 167, 181, 164, 222, 0, 0, 298,
-	163, 289, 0, 281, 147, 0, 280, 221, 268, 272,
-	207, 201, 146, 270, 205, 200, 193, 171, 185, 233,
-	199, 234, 186, 211, 210, 212, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 283, 0, 0, 0,
-	0, 0, 0, 258, 0, 0, 194, 0, 0, 0,
-	299, 0, 244, 227, 0, 0, 0, 242, 197, 269,
-	235, 274, 260, 282, 238, 236, 139, 261, 166, 208,
-	149, 150, 162, 168, 170, 172, 173, 217, 218, 230,
-	249, 262, 263, 264, 165, 158, 243, 159, 183, 160,
-	140, 251, 161, 141, 231, 267, 0, 179, 239, 204,
-	142, 203, 232, 266, 265, 290, 296, 297, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 295, 176, 0, 278, 0, 223, 0, 0,
-	0, 0, 0, 0, 0, 219, 294, 0, 0, 0,
-	0, 247, 0, 0, 0, 0, 0, 188, 229, 0,
-	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 255, 276, 288, 279, 0, 0, 0,
-	287, 0, 0, 0, 0, 0, 1539, 213, 214, 215,
-	216, 180, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 175, 182, 0, 184, 155, 228,
-	178, 285, 191, 0, 220, 187, 252, 192, 198, 240,
-	284, 226, 245, 153, 275, 253, 202, 177, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 138, 0, 196, 0, 237, 174,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
-	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
-	132, 133, 134, 135, 136, 137, 225, 0, 291, 292,
-	293, 277, 0, 0, 0, 0, 0, 0, 169, 1231,
-	0, 195, 0, 0, 0, 254, 209, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 99, 0, 0, 578,
-	0, 0, 0, 151, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 154, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
-	259, 273, 152, 250, 286, 157, 257, 148, 224, 246,
-	0, 0, 145, 271, 256, 206, 189, 190, 144, 0,
-	241, 167, 181, 164, 222, 0, 0, 298, 163, 289,
-	0, 281, 147, 0, 280, 221, 268, 272, 207, 201,
-	146, 270, 205, 200, 193, 171, 185, 233, 199, 234,
-	186, 211, 210, 212, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 283, 0, 0, 0, 0, 0,
-	0, 258, 0, 0, 194, 0, 0, 0, 299, 0,
-	244, 227, 0, 0, 0, 242, 197, 269, 235, 274,
-	260, 282, 238, 236, 139, 261, 166, 208, 149, 150,
-	162, 168, 170, 172, 173, 217, 218, 230, 249, 262,
-	263, 264, 165, 158, 243, 159, 183, 160, 140, 251,
-	161, 141, 231, 267, 0, 179, 239, 204, 142, 203,
-	232, 266, 265, 290, 296, 297, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	295, 176, 0, 278, 0, 223, 0, 0, 0, 0,
-	0, 0, 0, 219, 294, 0, 0, 0, 0, 247,
-	0, 0, 0, 0, 0, 188, 229, 0, 248, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 255, 276, 288, 279, 0, 0, 0, 287, 0,
-	0, 0, 0, 0, 0, 213, 214, 215, 216, 180,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 145, 266, 280, 154, 255, 294, 159, 264, 150,
+	225, 251, 0, 0, 147, 278, 263, 207, 190, 191,
+	146, 0, 246, 169, 182, 166, 223, 0, 0, 306,
+	165, 297, 0, 289, 149, 0, 288, 222, 275, 279,
+	208, 202, 148, 277, 206, 201, 194, 173, 287, 186,
+	234, 200, 235, 187, 212, 211, 213, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 0,
+	0, 0, 0, 0, 265, 0, 0, 195, 0, 0,
+	0, 307, 0, 249, 228, 0, 0, 0, 247, 198,
+	276, 236, 281, 267, 290, 239, 237, 141, 268, 168,
+	209, 151, 152, 164, 170, 172, 174, 175, 218, 219,
+	231, 254, 269, 270, 271, 167, 160, 248, 161, 184,
+	162, 142, 257, 163, 143, 232, 274, 0, 180, 240,
+	205, 144, 204, 233, 273, 272, 298, 304, 305, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 303, 178, 0, 285, 0, 224, 0,
+	0, 0, 0, 0, 0, 0, 220, 302, 0, 0,
+	0, 0, 252, 0, 0, 0, 0, 0, 189, 230,
+	0, 253, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 283, 296, 286, 0, 0,
+	0, 295, 0, 0, 0, 0, 0, 0, 214, 215,
+	216, 217, 181, 0, 158, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 177, 183, 0, 185, 157,
+	229, 179, 293, 192, 0, 221, 188, 258, 193, 199,
+	245, 292, 227, 250, 155, 282, 259, 203, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 140, 0, 197, 0, 238,
+	176, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 134, 135, 136, 137, 138, 139, 0, 0, 242,
+	243, 244, 241, 256, 226, 0, 260, 0, 0, 299,
+	300, 301, 284, 0, 0, 0, 171, 0, 0, 196,
+	0, 0, 0, 261, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 101, 0, 0, 598, 0, 0,
+	0, 153, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 597, 0, 0, 0, 145,
+	266, 280, 154, 255, 294, 159, 264, 150, 225, 251,
+	0, 0, 147, 278, 263, 207, 190, 191, 146, 0,
+	246, 169, 182, 166, 223, 0, 0, 306, 165, 297,
+	0, 289, 149, 0, 288, 222, 275, 279, 208, 202,
+	148, 277, 206, 201, 194, 173, 287, 186, 234, 200,
+	235, 187, 212, 211, 213, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 0, 0, 0, 0,
+	0, 0, 265, 0, 0, 195, 0, 0, 0, 307,
+	0, 249, 228, 0, 0, 0, 247, 198, 276, 236,
+	281, 267, 290, 239, 237, 141, 268, 168, 209, 151,
+	152, 164, 170, 172, 174, 175, 218, 219, 231, 254,
+	269, 270, 271, 167, 160, 248, 161, 184, 162, 142,
+	257, 163, 143, 232, 274, 0, 180, 240, 205, 144,
+	204, 233, 273, 272, 298, 304, 305, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 303, 178, 0, 285, 0, 224, 0, 0, 0,
+	0, 0, 0, 0, 220, 302, 0, 0, 0, 0,
+	252, 0, 0, 0, 0, 0, 189, 230, 0, 253,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 283, 296, 286, 0, 0, 0, 295,
+	0, 0, 0, 0, 0, 0, 214, 215, 216, 217,
+	181, 0, 158, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 177, 183, 0, 185, 157, 229, 179,
+	293, 192, 0, 221, 188, 258, 193, 199, 245, 292,
+	227, 250, 155, 282, 259, 203, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 140, 0, 197, 0, 238, 176, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 137, 138, 139, 0, 0, 242, 243, 244,
+	241, 256, 226, 0, 260, 0, 0, 299, 300, 301,
+	284, 0, 0, 0, 171, 0, 0, 196, 0, 0,
+	0, 261, 210, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 101, 0, 0, 598, 0, 0, 0, 153,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 156, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 175, 182, 0, 184, 155, 228, 178, 285,
-	191, 0, 220, 187, 252, 192, 198, 240, 284, 226,
-	245, 153, 275, 253, 202, 177, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 138, 0, 196, 0, 237, 174, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
-	124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
-	134, 135, 136, 137, 225, 0, 291, 292, 293, 277,
-	0, 0, 0, 0, 0, 0, 169, 0, 0, 195,
-	0, 0, 0, 254, 209, 0, 0, 0, 0, 0,
+	0, 0, 0, 1868, 0, 0, 0, 145, 266, 280,
+	154, 255, 294, 159, 264, 150, 225, 251, 0, 0,
+	147, 278, 263, 207, 190, 191, 146, 0, 246, 169,
+	182, 166, 223, 0, 0, 306, 165, 297, 0, 289,
+	149, 0, 288, 222, 275, 279, 208, 202, 148, 277,
+	206, 201, 194, 173, 287, 186, 234, 200, 235, 187,
+	212, 211, 213, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 291, 0, 0, 0, 0, 0, 0,
+	265, 0, 0, 195, 0, 0, 0, 307, 0, 249,
+	228, 0, 0, 0, 247, 198, 276, 236, 281, 267,
+	290, 239, 237, 141, 268, 168, 209, 151, 152, 164,
+	170, 172, 174, 175, 218, 219, 231, 254, 269, 270,
+	271, 167, 160, 248, 161, 184, 162, 142, 257, 163,
+	143, 232, 274, 0, 180, 240, 205, 144, 204, 233,
+	273, 272, 298, 304, 305, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 303,
+	178, 0, 285, 0, 224, 0, 0, 0, 0, 0,
+	0, 0, 220, 302, 0, 0, 0, 0, 252, 0,
+	0, 0, 0, 0, 189, 230, 0, 253, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 283, 296, 286, 0, 0, 0, 295, 0, 0,
+	0, 0, 0, 0, 214, 215, 216, 217, 181, 0,
+	158, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 177, 183, 0, 185, 157, 229, 179, 293, 192,
+	0, 221, 188, 258, 193, 199, 245, 292, 227, 250,
+	155, 282, 259, 203, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 99, 693, 0, 0, 0, 0,
-	0, 151, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 154, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 140, 0, 197, 0, 238, 176, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	137, 138, 139, 0, 0, 242, 243, 244, 241, 256,
+	226, 0, 260, 0, 0, 299, 300, 301, 284, 0,
+	0, 0, 171, 0, 0, 196, 0, 0, 0, 261,
+	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 866, 0, 0, 0,
+	101, 0, 0, 0, 0, 0, 0, 153, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 156,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 145, 266, 280, 154, 255,
+	294, 159, 264, 150, 225, 251, 0, 0, 147, 278,
+	263, 207, 190, 191, 146, 0, 246, 169, 182, 166,
+	223, 0, 0, 306, 165, 297, 0, 289, 149, 0,
+	288, 222, 275, 279, 208, 202, 148, 277, 206, 201,
+	194, 173, 287, 186, 234, 200, 235, 187, 212, 211,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 291, 0, 0, 0, 0, 0, 0, 265, 0,
+	0, 195, 0, 0, 0, 307, 0, 249, 228, 0,
+	0, 0, 247, 198, 276, 236, 281, 267, 290, 239,
+	237, 141, 268, 168, 209, 151, 152, 164, 170, 172,
+	174, 175, 218, 219, 231, 254, 269, 270, 271, 167,
+	160, 248, 161, 184, 162, 142, 257, 163, 143, 232,
+	274, 0, 180, 240, 205, 144, 204, 233, 273, 272,
+	298, 304, 305, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 303, 178, 0,
+	285, 0, 224, 0, 0, 0, 0, 0, 0, 0,
+	220, 302, 0, 0, 0, 0, 252, 0, 0, 0,
+	0, 0, 189, 230, 0, 253, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 283,
+	296, 286, 0, 0, 0, 295, 0, 0, 0, 0,
+	0, 0, 214, 215, 216, 217, 181, 0, 158, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
+	183, 0, 185, 157, 229, 179, 293, 192, 0, 221,
+	188, 258, 193, 199, 245, 292, 227, 250, 155, 282,
+	259, 203, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 864, 0, 140,
+	0, 197, 0, 238, 176, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	139, 0, 0, 242, 243, 244, 241, 256, 226, 0,
+	260, 0, 0, 299, 300, 301, 284, 0, 0, 0,
+	171, 0, 0, 196, 0, 0, 0, 261, 210, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 101, 0,
+	0, 598, 0, 0, 0, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 145, 266, 280, 154, 255, 294, 159,
+	264, 150, 225, 251, 0, 0, 147, 278, 263, 207,
+	190, 191, 146, 0, 246, 169, 182, 166, 223, 0,
+	0, 306, 165, 297, 0, 289, 149, 0, 288, 222,
+	275, 279, 208, 202, 148, 277, 206, 201, 194, 173,
+	287, 186, 234, 200, 235, 187, 212, 211, 213, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	0, 0, 0, 0, 0, 0, 265, 0, 0, 195,
+	0, 0, 0, 307, 0, 249, 228, 0, 0, 0,
+	247, 198, 276, 236, 281, 267, 290, 239, 237, 141,
+	268, 168, 209, 151, 152, 164, 170, 172, 174, 175,
+	218, 219, 231, 254, 269, 270, 271, 167, 160, 248,
+	161, 184, 162, 142, 257, 163, 143, 232, 274, 0,
+	180, 240, 205, 144, 204, 233, 273, 272, 298, 304,
+	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 303, 178, 0, 285, 0,
+	224, 0, 0, 0, 0, 0, 0, 0, 220, 302,
+	0, 0, 0, 0, 252, 0, 0, 0, 0, 0,
+	189, 230, 0, 253, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 283, 296, 286,
+	0, 0, 0, 295, 0, 0, 0, 0, 0, 1638,
+	214, 215, 216, 217, 181, 0, 158, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 177, 183, 0,
+	185, 157, 229, 179, 293, 192, 0, 221, 188, 258,
+	193, 199, 245, 292, 227, 250, 155, 282, 259, 203,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 140, 0, 197,
+	0, 238, 176, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 134, 135, 136, 137, 138, 139, 0,
+	0, 242, 243, 244, 241, 256, 226, 0, 260, 0,
+	0, 299, 300, 301, 284, 0, 0, 0, 171, 1298,
+	0, 196, 0, 0, 0, 261, 210, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 143, 259, 273,
-	152, 250, 286, 157, 257, 148, 224, 246, 0, 0,
-	145, 271, 256, 206, 189, 190, 144, 0, 241, 167,
-	181, 164, 222, 0, 0, 298, 163, 289, 0, 281,
-	147, 0, 280, 221, 268, 272, 207, 201, 146, 270,
-	205, 200, 193, 171, 185, 233, 199, 234, 186, 211,
-	210, 212, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 101, 0, 0, 598,
+	0, 0, 0, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 156, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 283, 0, 0, 0, 0, 0, 0, 258,
-	0, 0, 194, 0, 0, 0, 299, 0, 244, 227,
-	0, 0, 0, 242, 197, 269, 235, 274, 260, 282,
-	238, 236, 139, 261, 166, 208, 149, 150, 162, 168,
-	170, 172, 173, 217, 218, 230, 249, 262, 263, 264,
-	165, 158, 243, 159, 183, 160, 140, 251, 161, 141,
-	231, 267, 0, 179, 239, 204, 142, 203, 232, 266,
-	265, 290, 296, 297, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 295, 176,
-	0, 278, 0, 223, 0, 0, 0, 0, 0, 0,
-	0, 219, 294, 0, 0, 0, 0, 247, 0, 0,
-	0, 0, 0, 188, 229, 0, 248, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
-	276, 288, 279, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 0, 213, 214, 215, 216, 180, 0, 156,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	175, 182, 0, 184, 155, 228, 178, 285, 191, 0,
-	220, 187, 252, 192, 198, 240, 284, 226, 245, 153,
-	275, 253, 202, 177, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 145, 266, 280, 154, 255, 294, 159, 264, 150,
+	225, 251, 0, 0, 147, 278, 263, 207, 190, 191,
+	146, 0, 246, 169, 182, 166, 223, 0, 0, 306,
+	165, 297, 0, 289, 149, 0, 288, 222, 275, 279,
+	208, 202, 148, 277, 206, 201, 194, 173, 287, 186,
+	234, 200, 235, 187, 212, 211, 213, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	138, 0, 196, 0, 237, 174, 102, 103, 104, 105,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
-	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
-	136, 137, 225, 0, 291, 292, 293, 277, 0, 0,
-	0, 0, 0, 0, 169, 0, 0, 195, 0, 0,
-	0, 254, 209, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1902, 0,
-	0, 0, 99, 0, 0, 0, 0, 0, 0, 151,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 154,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 143, 259, 273, 152, 250,
-	286, 157, 257, 148, 224, 246, 0, 0, 145, 271,
-	256, 206, 189, 190, 144, 0, 241, 167, 181, 164,
-	222, 0, 0, 298, 163, 289, 0, 281, 147, 0,
-	280, 221, 268, 272, 207, 201, 146, 270, 205, 200,
-	193, 171, 185, 233, 199, 234, 186, 211, 210, 212,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	283, 0, 0, 0, 0, 0, 0, 258, 0, 0,
-	194, 0, 0, 0, 299, 0, 244, 227, 0, 0,
-	0, 242, 197, 269, 235, 274, 260, 282, 238, 236,
-	139, 261, 166, 208, 149, 150, 162, 168, 170, 172,
-	173, 217, 218, 230, 249, 262, 263, 264, 165, 158,
-	243, 159, 183, 160, 140, 251, 161, 141, 231, 267,
-	0, 179, 239, 204, 142, 203, 232, 266, 265, 290,
-	296, 297, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 295, 176, 0, 278,
-	0, 223, 0, 0, 0, 0, 0, 0, 0, 219,
-	294, 0, 0, 0, 0, 247, 0, 0, 0, 0,
-	0, 188, 229, 0, 248, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 255, 276, 288,
-	279, 0, 0, 0, 287, 0, 0, 0, 0, 0,
-	0, 213, 214, 215, 216, 180, 0, 156, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 175, 182,
-	0, 184, 155, 228, 178, 285, 191, 0, 220, 187,
-	252, 192, 198, 240, 284, 226, 245, 153, 275, 253,
-	202, 177, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 138, 0,
-	196, 0, 237, 174, 102, 103, 104, 105, 106, 107,
-	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
-	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
-	128, 129, 130, 131, 132, 133, 134, 135, 136, 137,
-	225, 0, 291, 292, 293, 277, 0, 0, 0, 0,
-	0, 0, 169, 0, 0, 195, 0, 0, 0, 254,
-	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 0,
+	0, 0, 0, 0, 265, 0, 0, 195, 0, 0,
+	0, 307, 0, 249, 228, 0, 0, 0, 247, 198,
+	276, 236, 281, 267, 290, 239, 237, 141, 268, 168,
+	209, 151, 152, 164, 170, 172, 174, 175, 218, 219,
+	231, 254, 269, 270, 271, 167, 160, 248, 161, 184,
+	162, 142, 257, 163, 143, 232, 274, 0, 180, 240,
+	205, 144, 204, 233, 273, 272, 298, 304, 305, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	99, 0, 0, 578, 0, 0, 0, 151, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 154, 0, 0,
+	0, 0, 0, 303, 178, 0, 285, 0, 224, 0,
+	0, 0, 0, 0, 0, 0, 220, 302, 0, 0,
+	0, 0, 252, 0, 0, 0, 0, 0, 189, 230,
+	0, 253, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 283, 296, 286, 0, 0,
+	0, 295, 0, 0, 0, 0, 0, 0, 214, 215,
+	216, 217, 181, 0, 158, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 177, 183, 0, 185, 157,
+	229, 179, 293, 192, 0, 221, 188, 258, 193, 199,
+	245, 292, 227, 250, 155, 282, 259, 203, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 140, 0, 197, 0, 238,
+	176, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 134, 135, 136, 137, 138, 139, 0, 0, 242,
+	243, 244, 241, 256, 226, 0, 260, 0, 0, 299,
+	300, 301, 284, 0, 0, 0, 171, 0, 0, 196,
+	0, 0, 0, 261, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2453, 0, 101, 0, 0, 0, 0, 0,
+	0, 153, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 143, 259, 273, 152, 250, 286, 157,
-	257, 148, 224, 246, 0, 0, 145, 271, 256, 206,
-	189, 190, 144, 0, 241, 167, 181, 164, 222, 0,
-	0, 298, 163, 289, 0, 281, 147, 0, 280, 221,
-	268, 272, 207, 201, 146, 270, 205, 200, 193, 171,
-	185, 233, 199, 234, 186, 211, 210, 212, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 283, 0,
-	0, 0, 0, 0, 0, 258, 0, 0, 194, 0,
-	0, 0, 299, 0, 244, 227, 0, 0, 0, 242,
-	197, 269, 235, 274, 260, 282, 238, 236, 139, 261,
-	166, 208, 149, 150, 162, 168, 170, 172, 173, 217,
-	218, 230, 249, 262, 263, 264, 165, 158, 243, 159,
-	183, 160, 140, 251, 161, 141, 231, 267, 0, 179,
-	239, 204, 142, 203, 232, 266, 265, 290, 296, 297,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 295, 176, 0, 278, 0, 223,
-	0, 0, 0, 0, 0, 0, 0, 219, 294, 0,
-	0, 0, 0, 247, 0, 0, 0, 0, 0, 188,
-	229, 0, 248, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 255, 276, 288, 279, 0,
-	0, 0, 287, 0, 0, 0, 0, 0, 0, 213,
-	214, 215, 216, 180, 0, 156, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 175, 182, 0, 184,
-	155, 228, 178, 285, 191, 0, 220, 187, 252, 192,
-	198, 240, 284, 226, 245, 153, 275, 253, 202, 177,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 145,
+	266, 280, 154, 255, 294, 159, 264, 150, 225, 251,
+	0, 0, 147, 278, 263, 207, 190, 191, 146, 0,
+	246, 169, 182, 166, 223, 0, 0, 306, 165, 297,
+	0, 289, 149, 0, 288, 222, 275, 279, 208, 202,
+	148, 277, 206, 201, 194, 173, 287, 186, 234, 200,
+	235, 187, 212, 211, 213, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 0, 0, 0, 0,
+	0, 0, 265, 0, 0, 195, 0, 0, 0, 307,
+	0, 249, 228, 0, 0, 0, 247, 198, 276, 236,
+	281, 267, 290, 239, 237, 141, 268, 168, 209, 151,
+	152, 164, 170, 172, 174, 175, 218, 219, 231, 254,
+	269, 270, 271, 167, 160, 248, 161, 184, 162, 142,
+	257, 163, 143, 232, 274, 0, 180, 240, 205, 144,
+	204, 233, 273, 272, 298, 304, 305, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 303, 178, 0, 285, 0, 224, 0, 0, 0,
+	0, 0, 0, 0, 220, 302, 0, 0, 0, 0,
+	252, 0, 0, 0, 0, 0, 189, 230, 0, 253,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 138, 0, 196, 0,
-	237, 174, 102, 103, 104, 105, 106, 107, 108, 109,
-	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
-	130, 131, 132, 133, 134, 135, 136, 137, 225, 0,
-	291, 292, 293, 277, 0, 0, 0, 0, 0, 0,
-	169, 0, 0, 195, 0, 0, 0, 254, 209, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 99, 0,
-	0, 0, 0, 0, 0, 151, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 154, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1592, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 143, 259, 273, 152, 250, 286, 157, 257, 148,
-	224, 246, 0, 0, 145, 271, 256, 206, 189, 190,
-	144, 0, 241, 167, 181, 164, 222, 0, 0, 298,
-	163, 289, 0, 281, 147, 0, 280, 221, 268, 272,
-	207, 201, 146, 270, 205, 200, 193, 171, 185, 233,
-	199, 234, 186, 211, 210, 212, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 283, 0, 0, 0,
-	0, 0, 0, 258, 0, 0, 194, 0, 0, 0,
-	299, 0, 244, 227, 0, 0, 0, 242, 197, 269,
-	235, 274, 260, 282, 238, 236, 139, 261, 166, 208,
-	149, 150, 162, 168, 170, 172, 173, 217, 218, 230,
-	249, 262, 263, 264, 165, 158, 243, 159, 183, 160,
-	140, 251, 161, 141, 231, 267, 0, 179, 239, 204,
-	142, 203, 232, 266, 265, 290, 296, 297, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 295, 176, 0, 278, 0, 223, 0, 0,
-	0, 0, 0, 0, 0, 219, 294, 0, 0, 0,
-	0, 247, 0, 0, 0, 0, 0, 188, 229, 0,
-	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 255, 276, 288, 279, 0, 0, 0,
-	287, 0, 0, 0, 0, 0, 0, 213, 214, 215,
-	216, 180, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 175, 182, 0, 184, 155, 228,
-	178, 285, 191, 0, 220, 187, 252, 192, 198, 240,
-	284, 226, 245, 153, 275, 253, 202, 177, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 138, 0, 196, 0, 237, 174,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
-	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
-	132, 133, 134, 135, 136, 137, 225, 0, 291, 292,
-	293, 277, 0, 0, 0, 0, 0, 0, 169, 0,
-	0, 195, 0, 0, 0, 254, 209, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 830, 0, 0, 0, 99, 0, 0, 0,
-	0, 0, 0, 151, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 154, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
-	259, 273, 152, 250, 286, 157, 257, 148, 224, 246,
-	0, 0, 145, 271, 256, 206, 189, 190, 144, 0,
-	241, 167, 181, 164, 222, 0, 0, 298, 163, 289,
-	0, 281, 147, 0, 280, 221, 268, 272, 207, 201,
-	146, 270, 205, 200, 193, 171, 185, 233, 199, 234,
-	186, 211, 210, 212, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 283, 0, 0, 0, 0, 0,
-	0, 258, 0, 0, 194, 0, 0, 0, 299, 0,
-	244, 227, 0, 0, 0, 242, 197, 269, 235, 274,
-	260, 282, 238, 236, 139, 261, 166, 208, 149, 150,
-	162, 168, 170, 172, 173, 217, 218, 230, 249, 262,
-	263, 264, 165, 158, 243, 159, 183, 160, 140, 251,
-	161, 141, 231, 267, 0, 179, 239, 204, 142, 203,
-	232, 266, 265, 290, 296, 297, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	295, 176, 0, 278, 0, 223, 0, 0, 0, 0,
-	0, 0, 0, 219, 294, 0, 0, 0, 0, 247,
-	0, 0, 0, 0, 0, 188, 229, 0, 248, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 255, 276, 288, 279, 0, 0, 0, 287, 0,
-	0, 0, 0, 0, 0, 213, 214, 215, 216, 180,
-	0, 156, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 175, 182, 0, 184, 155, 228, 178, 285,
-	191, 0, 220, 187, 252, 192, 198, 240, 284, 226,
-	245, 153, 275, 253, 202, 177, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 138, 0, 196, 0, 237, 174, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
-	124, 125, 126, 127, 128, 129, 130, 131, 132, 133,
-	134, 135, 136, 137, 225, 0, 291, 292, 293, 277,
-	0, 0, 0, 0, 0, 0, 169, 0, 0, 195,
-	0, 0, 0, 254, 209, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 99, 0, 0, 0, 0, 0,
-	0, 151, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 154, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1623, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 143, 259, 273,
-	152, 250, 286, 157, 257, 148, 224, 246, 0, 0,
-	145, 271, 256, 206, 189, 190, 144, 0, 241, 167,
-	181, 164, 222, 0, 0, 298, 163, 289, 0, 281,
-	147, 0, 280, 221, 268, 272, 207, 201, 146, 270,
-	205, 200, 193, 171, 185, 233, 199, 234, 186, 211,
-	210, 212, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 283, 0, 0, 0, 0, 0, 0, 258,
-	0, 0, 194, 0, 0, 0, 299, 0, 244, 227,
-	0, 0, 0, 242, 197, 269, 235, 274, 260, 282,
-	238, 236, 139, 261, 166, 208, 149, 150, 162, 168,
-	170, 172, 173, 217, 218, 230, 249, 262, 263, 264,
-	165, 158, 243, 159, 183, 160, 140, 251, 161, 141,
-	231, 267, 0, 179, 239, 204, 142, 203, 232, 266,
-	265, 290, 296, 297, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 295, 176,
-	0, 278, 0, 223, 0, 0, 0, 0, 0, 0,
-	0, 219, 294, 0, 0, 0, 0, 247, 0, 0,
-	0, 0, 0, 188, 229, 0, 248, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 255,
-	276, 288, 279, 0, 0, 0, 287, 0, 0, 0,
-	0, 0, 0, 213, 214, 215, 216, 180, 0, 156,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	175, 182, 0, 184, 155, 228, 178, 285, 191, 0,
-	220, 187, 252, 192, 198, 240, 284, 226, 245, 153,
-	275, 253, 202, 177, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	138, 0, 196, 0, 237, 174, 102, 103, 104, 105,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 123, 124, 125,
-	126, 127, 128, 129, 130, 131, 132, 133, 134, 135,
-	136, 137, 225, 0, 291, 292, 293, 277, 0, 0,
-	0, 0, 0, 0, 169, 0, 0, 195, 0, 0,
-	0, 254, 209, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 99, 0, 0, 0, 0, 0, 0, 151,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 154,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1246, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 143, 259, 273, 152, 250,
-	286, 157, 257, 148, 224, 246, 0, 0, 145, 271,
-	256, 206, 189, 190, 144, 0, 241, 167, 181, 164,
-	222, 0, 0, 298, 163, 289, 0, 281, 147, 0,
-	280, 221, 268, 272, 207, 201, 146, 270, 205, 200,
-	193, 171, 185, 233, 199, 234, 186, 211, 210, 212,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	283, 0, 0, 0, 0, 0, 0, 258, 0, 0,
-	194, 0, 0, 0, 299, 0, 244, 227, 0, 0,
-	0, 242, 197, 269, 235, 274, 260, 282, 238, 236,
-	139, 261, 166, 208, 149, 150, 162, 168, 170, 172,
-	173, 217, 218, 230, 249, 262, 263, 264, 165, 158,
-	243, 159, 183, 160, 140, 251, 161, 141, 231, 267,
-	0, 179, 239, 204, 142, 203, 232, 266, 265, 290,
-	296, 297, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 295, 176, 0, 278,
-	0, 223, 0, 0, 0, 0, 0, 0, 0, 219,
-	294, 0, 0, 0, 0, 247, 0, 0, 0, 0,
-	0, 188, 229, 0, 248, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 255, 276, 288,
-	279, 0, 0, 0, 287, 0, 0, 0, 0, 0,
-	0, 213, 214, 215, 216, 180, 0, 156, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 175, 182,
-	0, 184, 155, 228, 178, 285, 191, 0, 220, 187,
-	252, 192, 198, 240, 284, 226, 245, 153, 275, 253,
-	202, 177, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 138, 0,
-	196, 0, 237, 174, 102, 103, 104, 105, 106, 107,
-	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
-	118, 119, 120, 121, 122, 123, 124, 125, 126, 127,
-	128, 129, 130, 131, 132, 133, 134, 135, 136, 137,
-	225, 0, 291, 292, 293, 277, 0, 0, 0, 0,
-	0, 0, 169, 0, 0, 195, 0, 0, 0, 254,
-	209, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 283, 296, 286, 0, 0, 0, 295,
+	0, 0, 0, 0, 0, 0, 214, 215, 216, 217,
+	181, 0, 158, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 177, 183, 0, 185, 157, 229, 179,
+	293, 192, 0, 221, 188, 258, 193, 199, 245, 292,
+	227, 250, 155, 282, 259, 203, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	99, 0, 0, 1244, 0, 0, 0, 151, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 154, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 140, 0, 197, 0, 238, 176, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 137, 138, 139, 0, 0, 242, 243, 244,
+	241, 256, 226, 0, 260, 0, 0, 299, 300, 301,
+	284, 0, 0, 0, 171, 0, 0, 196, 0, 0,
+	0, 261, 210, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 101, 719, 0, 0, 0, 0, 0, 153,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 143, 259, 273, 152, 250, 286, 157,
-	257, 148, 224, 246, 0, 0, 145, 271, 256, 206,
-	189, 190, 144, 0, 241, 167, 181, 164, 222, 0,
-	0, 298, 163, 289, 0, 281, 147, 0, 280, 221,
-	268, 272, 207, 201, 146, 270, 205, 200, 193, 171,
-	185, 233, 199, 234, 186, 211, 210, 212, 0, 0,
+	0, 156, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 283, 0,
-	0, 0, 0, 0, 0, 258, 0, 0, 194, 0,
-	0, 0, 299, 0, 244, 227, 0, 0, 0, 242,
-	197, 269, 235, 274, 260, 282, 238, 236, 139, 261,
-	166, 208, 149, 150, 162, 168, 170, 172, 173, 217,
-	218, 230, 249, 262, 263, 264, 165, 158, 243, 159,
-	183, 160, 140, 251, 161, 141, 231, 267, 0, 179,
-	239, 204, 142, 203, 232, 266, 265, 290, 296, 297,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 295, 176, 0, 278, 0, 223,
-	0, 0, 0, 0, 0, 0, 0, 219, 294, 0,
-	0, 0, 0, 247, 0, 0, 0, 0, 0, 188,
-	229, 0, 248, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 255, 276, 288, 279, 0,
-	0, 0, 287, 0, 0, 0, 0, 0, 0, 213,
-	214, 215, 216, 180, 0, 156, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 175, 182, 0, 184,
-	155, 228, 178, 285, 191, 0, 220, 187, 252, 192,
-	198, 240, 284, 226, 245, 153, 275, 253, 202, 177,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 145, 266, 280,
+	154, 255, 294, 159, 264, 150, 225, 251, 0, 0,
+	147, 278, 263, 207, 190, 191, 146, 0, 246, 169,
+	182, 166, 223, 0, 0, 306, 165, 297, 0, 289,
+	149, 0, 288, 222, 275, 279, 208, 202, 148, 277,
+	206, 201, 194, 173, 287, 186, 234, 200, 235, 187,
+	212, 211, 213, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 291, 0, 0, 0, 0, 0, 0,
+	265, 0, 0, 195, 0, 0, 0, 307, 0, 249,
+	228, 0, 0, 0, 247, 198, 276, 236, 281, 267,
+	290, 239, 237, 141, 268, 168, 209, 151, 152, 164,
+	170, 172, 174, 175, 218, 219, 231, 254, 269, 270,
+	271, 167, 160, 248, 161, 184, 162, 142, 257, 163,
+	143, 232, 274, 0, 180, 240, 205, 144, 204, 233,
+	273, 272, 298, 304, 305, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 303,
+	178, 0, 285, 0, 224, 0, 0, 0, 0, 0,
+	0, 0, 220, 302, 0, 0, 0, 0, 252, 0,
+	0, 0, 0, 0, 189, 230, 0, 253, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 138, 0, 196, 0,
-	237, 174, 102, 103, 104, 105, 106, 107, 108, 109,
-	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 121, 122, 123, 124, 125, 126, 127, 128, 129,
-	130, 131, 132, 133, 134, 135, 136, 137, 225, 0,
-	291, 292, 293, 277, 0, 0, 0, 0, 0, 0,
-	169, 0, 0, 195, 0, 0, 0, 254, 209, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 352, 0,
-	0, 353, 0, 0, 0, 151, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 154, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 143, 259, 273, 152, 250, 286, 157, 257, 148,
-	224, 246, 0, 0, 145, 271, 256, 206, 189, 190,
-	144, 0, 241, 167, 181, 164, 222, 0, 0, 298,
-	163, 289, 0, 281, 147, 0, 280, 221, 268, 272,
-	207, 201, 146, 270, 205, 200, 193, 171, 185, 233,
-	199, 234, 186, 211, 210, 212, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 283, 0, 0, 0,
-	0, 0, 0, 258, 0, 0, 194, 0, 0, 0,
-	299, 0, 244, 227, 0, 0, 0, 242, 197, 269,
-	235, 274, 260, 282, 238, 236, 139, 261, 166, 208,
-	149, 150, 162, 168, 170, 172, 173, 217, 218, 230,
-	249, 262, 263, 264, 165, 158, 243, 159, 183, 160,
-	140, 251, 161, 141, 231, 267, 0, 179, 239, 204,
-	142, 203, 232, 266, 265, 290, 296, 297, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 295, 176, 0, 278, 0, 223, 0, 0,
-	0, 0, 0, 0, 0, 219, 294, 0, 0, 0,
-	0, 247, 0, 0, 0, 0, 0, 188, 229, 0,
-	248, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 255, 276, 288, 279, 0, 0, 0,
-	287, 0, 0, 0, 0, 0, 0, 213, 214, 215,
-	216, 180, 0, 156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 175, 182, 0, 184, 155, 228,
-	178, 285, 191, 0, 220, 187, 252, 192, 198, 240,
-	284, 226, 245, 153, 275, 253, 202, 177, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 138, 0, 196, 0, 237, 174,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
-	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
-	132, 133, 134, 135, 136, 137, 1173, 0, 291, 292,
-	293, 277, 0, 225, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 169, 0, 0, 195, 0,
-	0, 0, 254, 209, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 99, 0, 0, 0, 0, 0, 0,
-	151, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	154, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 143, 259, 273, 152,
-	250, 286, 157, 257, 148, 224, 246, 0, 0, 145,
-	271, 256, 206, 189, 190, 144, 0, 241, 167, 181,
-	164, 222, 0, 0, 298, 163, 289, 0, 281, 147,
-	0, 280, 221, 268, 272, 207, 201, 146, 270, 205,
-	200, 193, 171, 185, 233, 199, 234, 186, 211, 210,
-	212, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 283, 0, 0, 0, 0, 0, 0, 258, 0,
-	0, 194, 0, 0, 0, 299, 0, 244, 227, 0,
-	0, 0, 242, 197, 269, 235, 274, 260, 282, 238,
-	236, 139, 261, 166, 208, 149, 150, 162, 168, 170,
-	172, 173, 217, 218, 230, 249, 262, 263, 264, 165,
-	158, 243, 159, 183, 160, 140, 251, 161, 141, 231,
-	267, 0, 179, 239, 204, 142, 203, 232, 266, 265,
-	290, 296, 297, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 295, 176, 0,
-	278, 0, 223, 0, 0, 0, 0, 0, 0, 0,
-	219, 294, 0, 0, 0, 0, 247, 0, 0, 0,
-	0, 0, 188, 229, 0, 248, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 255, 276,
-	288, 279, 0, 0, 0, 287, 0, 0, 0, 0,
-	0, 0, 213, 214, 215, 216, 180, 0, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
-	182, 0, 184, 155, 228, 178, 285, 191, 0, 220,
-	187, 252, 192, 198, 240, 284, 226, 245, 153, 275,
-	253, 202, 177, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 138,
-	0, 196, 0, 237, 174, 102, 103, 104, 105, 106,
+	262, 283, 296, 286, 0, 0, 0, 295, 0, 0,
+	0, 0, 0, 0, 214, 215, 216, 217, 181, 0,
+	158, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 177, 183, 0, 185, 157, 229, 179, 293, 192,
+	0, 221, 188, 258, 193, 199, 245, 292, 227, 250,
+	155, 282, 259, 203, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 140, 0, 197, 0, 238, 176, 104, 105, 106,
 	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
 	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
 	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
-	137, 225, 0, 291, 292, 293, 277, 0, 0, 0,
-	0, 0, 0, 169, 0, 0, 195, 0, 0, 0,
-	254, 209, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 99, 0, 0, 0, 0, 0, 0, 151, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 154, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 259, 273, 152, 250, 286,
-	157, 257, 148, 224, 246, 0, 0, 145, 271, 256,
-	206, 189, 190, 144, 0, 241, 167, 181, 164, 222,
-	0, 0, 298, 163, 289, 0, 281, 147, 0, 280,
-	221, 268, 272, 207, 201, 146, 270, 205, 200, 193,
-	171, 185, 233, 199, 234, 186, 211, 210, 212, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 283,
-	0, 0, 1160, 0, 0, 0, 258, 0, 0, 194,
-	0, 0, 0, 299, 0, 244, 227, 0, 0, 0,
-	242, 197, 269, 235, 274, 260, 282, 238, 236, 139,
-	261, 166, 208, 149, 150, 162, 168, 170, 172, 173,
-	217, 218, 230, 249, 262, 263, 264, 165, 158, 243,
-	159, 183, 160, 140, 251, 161, 141, 231, 267, 0,
-	179, 239, 204, 142, 203, 232, 266, 265, 290, 296,
-	297, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 295, 176, 0, 278, 0,
-	223, 0, 0, 0, 0, 0, 0, 0, 219, 294,
-	0, 0, 0, 0, 247, 0, 0, 0, 0, 0,
-	188, 229, 0, 248, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 255, 276, 288, 279,
-	0, 0, 0, 287, 0, 0, 0, 0, 0, 0,
-	213, 214, 215, 216, 180, 0, 156, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 175, 182, 0,
-	184, 155, 228, 178, 285, 191, 0, 220, 187, 252,
-	192, 198, 240, 284, 226, 245, 153, 275, 253, 202,
-	177, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 138, 0, 196,
-	0, 237, 174, 102, 103, 104, 105, 106, 107, 108,
+	137, 138, 139, 0, 0, 242, 243, 244, 241, 256,
+	226, 0, 260, 0, 0, 299, 300, 301, 284, 0,
+	0, 0, 171, 0, 0, 196, 0, 0, 0, 261,
+	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2036, 0, 0, 0,
+	101, 0, 0, 0, 0, 0, 0, 153, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 156,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 145, 266, 280, 154, 255,
+	294, 159, 264, 150, 225, 251, 0, 0, 147, 278,
+	263, 207, 190, 191, 146, 0, 246, 169, 182, 166,
+	223, 0, 0, 306, 165, 297, 0, 289, 149, 0,
+	288, 222, 275, 279, 208, 202, 148, 277, 206, 201,
+	194, 173, 287, 186, 234, 200, 235, 187, 212, 211,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 291, 0, 0, 0, 0, 0, 0, 265, 0,
+	0, 195, 0, 0, 0, 307, 0, 249, 228, 0,
+	0, 0, 247, 198, 276, 236, 281, 267, 290, 239,
+	237, 141, 268, 168, 209, 151, 152, 164, 170, 172,
+	174, 175, 218, 219, 231, 254, 269, 270, 271, 167,
+	160, 248, 161, 184, 162, 142, 257, 163, 143, 232,
+	274, 0, 180, 240, 205, 144, 204, 233, 273, 272,
+	298, 304, 305, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 303, 178, 0,
+	285, 0, 224, 0, 0, 0, 0, 0, 0, 0,
+	220, 302, 0, 0, 0, 0, 252, 0, 0, 0,
+	0, 0, 189, 230, 0, 253, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 283,
+	296, 286, 0, 0, 0, 295, 0, 0, 0, 0,
+	0, 0, 214, 215, 216, 217, 181, 0, 158, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
+	183, 0, 185, 157, 229, 179, 293, 192, 0, 221,
+	188, 258, 193, 199, 245, 292, 227, 250, 155, 282,
+	259, 203, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 140,
+	0, 197, 0, 238, 176, 104, 105, 106, 107, 108,
 	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
 	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
-	129, 130, 131, 132, 133, 134, 135, 136, 137, 225,
-	0, 291, 292, 293, 277, 0, 0, 0, 0, 0,
-	0, 169, 0, 0, 195, 0, 0, 0, 254, 209,
+	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	139, 0, 0, 242, 243, 244, 241, 256, 226, 0,
+	260, 0, 0, 299, 300, 301, 284, 0, 0, 0,
+	171, 0, 0, 196, 0, 0, 0, 261, 210, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 101, 0,
+	0, 598, 0, 0, 0, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 145, 266, 280, 154, 255, 294, 159,
+	264, 150, 225, 251, 0, 0, 147, 278, 263, 207,
+	190, 191, 146, 0, 246, 169, 182, 166, 223, 0,
+	0, 306, 165, 297, 0, 289, 149, 0, 288, 222,
+	275, 279, 208, 202, 148, 277, 206, 201, 194, 173,
+	287, 186, 234, 200, 235, 187, 212, 211, 213, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	0, 0, 0, 0, 0, 0, 265, 0, 0, 195,
+	0, 0, 0, 307, 0, 249, 228, 0, 0, 0,
+	247, 198, 276, 236, 281, 267, 290, 239, 237, 141,
+	268, 168, 209, 151, 152, 164, 170, 172, 174, 175,
+	218, 219, 231, 254, 269, 270, 271, 167, 160, 248,
+	161, 184, 162, 142, 257, 163, 143, 232, 274, 0,
+	180, 240, 205, 144, 204, 233, 273, 272, 298, 304,
+	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 303, 178, 0, 285, 0,
+	224, 0, 0, 0, 0, 0, 0, 0, 220, 302,
+	0, 0, 0, 0, 252, 0, 0, 0, 0, 0,
+	189, 230, 0, 253, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 283, 296, 286,
+	0, 0, 0, 295, 0, 0, 0, 0, 0, 0,
+	214, 215, 216, 217, 181, 0, 158, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 177, 183, 0,
+	185, 157, 229, 179, 293, 192, 0, 221, 188, 258,
+	193, 199, 245, 292, 227, 250, 155, 282, 259, 203,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 140, 0, 197,
+	0, 238, 176, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 134, 135, 136, 137, 138, 139, 0,
+	0, 242, 243, 244, 241, 256, 226, 0, 260, 0,
+	0, 299, 300, 301, 284, 0, 0, 0, 171, 0,
+	0, 196, 0, 0, 0, 261, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 101, 0, 0, 0,
+	0, 0, 0, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 156, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1691, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 145, 266, 280, 154, 255, 294, 159, 264, 150,
+	225, 251, 0, 0, 147, 278, 263, 207, 190, 191,
+	146, 0, 246, 169, 182, 166, 223, 0, 0, 306,
+	165, 297, 0, 289, 149, 0, 288, 222, 275, 279,
+	208, 202, 148, 277, 206, 201, 194, 173, 287, 186,
+	234, 200, 235, 187, 212, 211, 213, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 0,
+	0, 0, 0, 0, 265, 0, 0, 195, 0, 0,
+	0, 307, 0, 249, 228, 0, 0, 0, 247, 198,
+	276, 236, 281, 267, 290, 239, 237, 141, 268, 168,
+	209, 151, 152, 164, 170, 172, 174, 175, 218, 219,
+	231, 254, 269, 270, 271, 167, 160, 248, 161, 184,
+	162, 142, 257, 163, 143, 232, 274, 0, 180, 240,
+	205, 144, 204, 233, 273, 272, 298, 304, 305, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 303, 178, 0, 285, 0, 224, 0,
+	0, 0, 0, 0, 0, 0, 220, 302, 0, 0,
+	0, 0, 252, 0, 0, 0, 0, 0, 189, 230,
+	0, 253, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 283, 296, 286, 0, 0,
+	0, 295, 0, 0, 0, 0, 0, 0, 214, 215,
+	216, 217, 181, 0, 158, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 177, 183, 0, 185, 157,
+	229, 179, 293, 192, 0, 221, 188, 258, 193, 199,
+	245, 292, 227, 250, 155, 282, 259, 203, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 140, 0, 197, 0, 238,
+	176, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 134, 135, 136, 137, 138, 139, 0, 0, 242,
+	243, 244, 241, 256, 226, 0, 260, 0, 0, 299,
+	300, 301, 284, 0, 0, 0, 171, 0, 0, 196,
+	0, 0, 0, 261, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
+	0, 153, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1774, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 145,
+	266, 280, 154, 255, 294, 159, 264, 150, 225, 251,
+	0, 0, 147, 278, 263, 207, 190, 191, 146, 0,
+	246, 169, 182, 166, 223, 0, 0, 306, 165, 297,
+	0, 289, 149, 0, 288, 222, 275, 279, 208, 202,
+	148, 277, 206, 201, 194, 173, 287, 186, 234, 200,
+	235, 187, 212, 211, 213, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 0, 0, 0, 0,
+	0, 0, 265, 0, 0, 195, 0, 0, 0, 307,
+	0, 249, 228, 0, 0, 0, 247, 198, 276, 236,
+	281, 267, 290, 239, 237, 141, 268, 168, 209, 151,
+	152, 164, 170, 172, 174, 175, 218, 219, 231, 254,
+	269, 270, 271, 167, 160, 248, 161, 184, 162, 142,
+	257, 163, 143, 232, 274, 0, 180, 240, 205, 144,
+	204, 233, 273, 272, 298, 304, 305, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 303, 178, 0, 285, 0, 224, 0, 0, 0,
+	0, 0, 0, 0, 220, 302, 0, 0, 0, 0,
+	252, 0, 0, 0, 0, 0, 189, 230, 0, 253,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 262, 283, 296, 286, 0, 0, 0, 295,
+	0, 0, 0, 0, 0, 0, 214, 215, 216, 217,
+	181, 0, 158, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 177, 183, 0, 185, 157, 229, 179,
+	293, 192, 0, 221, 188, 258, 193, 199, 245, 292,
+	227, 250, 155, 282, 259, 203, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 140, 0, 197, 0, 238, 176, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 137, 138, 139, 0, 0, 242, 243, 244,
+	241, 256, 226, 0, 260, 0, 1516, 299, 300, 301,
+	284, 0, 0, 0, 171, 0, 0, 196, 0, 0,
+	0, 261, 210, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 99,
-	0, 0, 578, 0, 0, 0, 151, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 154, 0, 0, 0,
+	0, 0, 101, 0, 0, 0, 0, 0, 0, 153,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 156, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 143, 259, 273, 152, 250, 286, 157, 257,
-	148, 224, 246, 0, 0, 145, 271, 256, 206, 189,
-	190, 144, 0, 241, 167, 181, 164, 222, 0, 0,
-	298, 163, 289, 0, 281, 147, 0, 280, 221, 268,
-	272, 207, 201, 146, 270, 205, 200, 193, 171, 185,
-	233, 199, 234, 186, 211, 210, 212, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 145, 266, 280,
+	154, 255, 294, 159, 264, 150, 225, 251, 0, 0,
+	147, 278, 263, 207, 190, 191, 146, 0, 246, 169,
+	182, 166, 223, 0, 0, 306, 165, 297, 0, 289,
+	149, 0, 288, 222, 275, 279, 208, 202, 148, 277,
+	206, 201, 194, 173, 287, 186, 234, 200, 235, 187,
+	212, 211, 213, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 283, 0, 0,
-	0, 0, 0, 0, 258, 0, 0, 194, 0, 0,
-	0, 299, 0, 244, 227, 0, 0, 0, 242, 197,
-	269, 235, 274, 260, 282, 238, 236, 139, 261, 166,
-	208, 149, 150, 162, 168, 170, 172, 173, 217, 218,
-	230, 249, 262, 263, 264, 165, 158, 243, 159, 183,
-	160, 140, 251, 161, 141, 231, 267, 0, 179, 239,
-	204, 142, 203, 232, 266, 265, 290, 296, 297, 0,
+	0, 0, 0, 291, 0, 0, 0, 0, 0, 0,
+	265, 0, 0, 195, 0, 0, 0, 307, 0, 249,
+	228, 0, 0, 0, 247, 198, 276, 236, 281, 267,
+	290, 239, 237, 141, 268, 168, 209, 151, 152, 164,
+	170, 172, 174, 175, 218, 219, 231, 254, 269, 270,
+	271, 167, 160, 248, 161, 184, 162, 142, 257, 163,
+	143, 232, 274, 0, 180, 240, 205, 144, 204, 233,
+	273, 272, 298, 304, 305, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 303,
+	178, 0, 285, 0, 224, 0, 0, 0, 0, 0,
+	0, 0, 220, 302, 0, 0, 0, 0, 252, 0,
+	0, 0, 0, 0, 189, 230, 0, 253, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 295, 176, 0, 278, 0, 223, 0,
-	0, 0, 0, 0, 0, 0, 219, 294, 0, 0,
-	0, 0, 247, 0, 0, 0, 0, 0, 188, 229,
-	0, 248, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 255, 276, 288, 820, 0, 0,
-	0, 287, 0, 0, 0, 0, 0, 0, 213, 214,
-	215, 216, 180, 0, 156, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 175, 182, 0, 184, 155,
-	228, 178, 285, 191, 0, 220, 187, 252, 192, 198,
-	240, 284, 226, 245, 153, 275, 253, 202, 177, 0,
+	262, 283, 296, 286, 0, 0, 0, 295, 0, 0,
+	0, 0, 0, 0, 214, 215, 216, 217, 181, 0,
+	158, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 177, 183, 0, 185, 157, 229, 179, 293, 192,
+	0, 221, 188, 258, 193, 199, 245, 292, 227, 250,
+	155, 282, 259, 203, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 138, 0, 196, 0, 237,
-	174, 102, 103, 104, 105, 106, 107, 108, 109, 110,
+	0, 140, 0, 197, 0, 238, 176, 104, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	137, 138, 139, 0, 0, 242, 243, 244, 241, 256,
+	226, 0, 260, 0, 0, 299, 300, 301, 284, 0,
+	0, 0, 171, 0, 0, 196, 0, 0, 0, 261,
+	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	101, 0, 0, 0, 0, 0, 0, 153, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 156,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1313, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 145, 266, 280, 154, 255,
+	294, 159, 264, 150, 225, 251, 0, 0, 147, 278,
+	263, 207, 190, 191, 146, 0, 246, 169, 182, 166,
+	223, 0, 0, 306, 165, 297, 0, 289, 149, 0,
+	288, 222, 275, 279, 208, 202, 148, 277, 206, 201,
+	194, 173, 287, 186, 234, 200, 235, 187, 212, 211,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 291, 0, 0, 0, 0, 0, 0, 265, 0,
+	0, 195, 0, 0, 0, 307, 0, 249, 228, 0,
+	0, 0, 247, 198, 276, 236, 281, 267, 290, 239,
+	237, 141, 268, 168, 209, 151, 152, 164, 170, 172,
+	174, 175, 218, 219, 231, 254, 269, 270, 271, 167,
+	160, 248, 161, 184, 162, 142, 257, 163, 143, 232,
+	274, 0, 180, 240, 205, 144, 204, 233, 273, 272,
+	298, 304, 305, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 303, 178, 0,
+	285, 0, 224, 0, 0, 0, 0, 0, 0, 0,
+	220, 302, 0, 0, 0, 0, 252, 0, 0, 0,
+	0, 0, 189, 230, 0, 253, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 283,
+	296, 286, 0, 0, 0, 295, 0, 0, 0, 0,
+	0, 0, 214, 215, 216, 217, 181, 0, 158, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
+	183, 0, 185, 157, 229, 179, 293, 192, 0, 221,
+	188, 258, 193, 199, 245, 292, 227, 250, 155, 282,
+	259, 203, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 140,
+	0, 197, 0, 238, 176, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	139, 0, 0, 242, 243, 244, 241, 256, 226, 0,
+	260, 0, 0, 299, 300, 301, 284, 0, 0, 0,
+	171, 0, 0, 196, 0, 0, 0, 261, 210, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 101, 0,
+	0, 1311, 0, 0, 0, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 145, 266, 280, 154, 255, 294, 159,
+	264, 150, 225, 251, 0, 0, 147, 278, 263, 207,
+	190, 191, 146, 0, 246, 169, 182, 166, 223, 0,
+	0, 306, 165, 297, 0, 289, 149, 0, 288, 222,
+	275, 279, 208, 202, 148, 277, 206, 201, 194, 173,
+	287, 186, 234, 200, 235, 187, 212, 211, 213, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	0, 0, 0, 0, 0, 0, 265, 0, 0, 195,
+	0, 0, 0, 307, 0, 249, 228, 0, 0, 0,
+	247, 198, 276, 236, 281, 267, 290, 239, 237, 141,
+	268, 168, 209, 151, 152, 164, 170, 172, 174, 175,
+	218, 219, 231, 254, 269, 270, 271, 167, 160, 248,
+	161, 184, 162, 142, 257, 163, 143, 232, 274, 0,
+	180, 240, 205, 144, 204, 233, 273, 272, 298, 304,
+	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 303, 178, 0, 285, 0,
+	224, 0, 0, 0, 0, 0, 0, 0, 220, 302,
+	0, 0, 0, 0, 252, 0, 0, 0, 0, 0,
+	189, 230, 0, 253, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 283, 296, 286,
+	0, 0, 0, 295, 0, 0, 0, 0, 0, 0,
+	214, 215, 216, 217, 181, 0, 158, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 177, 183, 0,
+	185, 157, 229, 179, 293, 192, 0, 221, 188, 258,
+	193, 199, 245, 292, 227, 250, 155, 282, 259, 203,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 140, 0, 197,
+	0, 238, 176, 104, 105, 106, 107, 108, 109, 110,
 	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
 	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
-	131, 132, 133, 134, 135, 136, 137, 225, 0, 291,
-	292, 293, 277, 0, 0, 0, 0, 0, 0, 169,
-	0, 0, 195, 0, 0, 0, 254, 209, 0, 0,
+	131, 132, 133, 134, 135, 136, 137, 138, 139, 0,
+	0, 242, 243, 244, 241, 256, 226, 0, 260, 0,
+	0, 299, 300, 301, 284, 0, 0, 0, 171, 0,
+	0, 196, 0, 0, 0, 261, 210, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 99, 0, 0,
-	0, 0, 0, 0, 151, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 154, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 360, 0, 0, 361,
+	0, 0, 0, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 156, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	143, 259, 273, 152, 250, 286, 157, 257, 148, 224,
-	246, 0, 0, 145, 271, 256, 206, 189, 190, 144,
-	0, 241, 167, 181, 164, 222, 0, 0, 298, 163,
-	289, 0, 281, 147, 0, 280, 221, 268, 272, 207,
-	201, 146, 270, 205, 200, 193, 171, 185, 233, 199,
-	234, 186, 211, 210, 212, 0, 0, 0, 0, 0,
+	0, 145, 266, 280, 154, 255, 294, 159, 264, 150,
+	225, 251, 0, 0, 147, 278, 263, 207, 190, 191,
+	146, 0, 246, 169, 182, 166, 223, 0, 0, 306,
+	165, 297, 0, 289, 149, 0, 288, 222, 275, 279,
+	208, 202, 148, 277, 206, 201, 194, 173, 287, 186,
+	234, 200, 235, 187, 212, 211, 213, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 283, 0, 0, 0, 0,
-	0, 0, 258, 0, 0, 194, 0, 0, 0, 299,
-	0, 244, 227, 0, 0, 0, 242, 197, 269, 235,
-	274, 260, 282, 238, 236, 139, 261, 166, 208, 149,
-	150, 162, 168, 170, 172, 173, 217, 218, 230, 249,
-	262, 263, 264, 165, 158, 243, 159, 183, 160, 140,
-	251, 161, 141, 231, 267, 0, 179, 239, 204, 142,
-	203, 232, 266, 265, 290, 296, 297, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 0,
+	0, 0, 0, 0, 265, 0, 0, 195, 0, 0,
+	0, 307, 0, 249, 228, 0, 0, 0, 247, 198,
+	276, 236, 281, 267, 290, 239, 237, 141, 268, 168,
+	209, 151, 152, 164, 170, 172, 174, 175, 218, 219,
+	231, 254, 269, 270, 271, 167, 160, 248, 161, 184,
+	162, 142, 257, 163, 143, 232, 274, 0, 180, 240,
+	205, 144, 204, 233, 273, 272, 298, 304, 305, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 295, 176, 0, 278, 0, 223, 0, 0, 0,
-	0, 0, 0, 0, 219, 294, 0, 0, 0, 0,
-	247, 0, 0, 0, 0, 0, 188, 229, 0, 248,
+	0, 0, 0, 303, 178, 0, 285, 0, 224, 0,
+	0, 0, 0, 0, 0, 0, 220, 302, 0, 0,
+	0, 0, 252, 0, 0, 0, 0, 0, 189, 230,
+	0, 253, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 283, 296, 286, 0, 0,
+	0, 295, 0, 0, 0, 0, 0, 0, 214, 215,
+	216, 217, 181, 0, 158, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 177, 183, 0, 185, 157,
+	229, 179, 293, 192, 0, 221, 188, 258, 193, 199,
+	245, 292, 227, 250, 155, 282, 259, 203, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 255, 276, 288, 279, 0, 0, 0, 287,
-	0, 0, 0, 0, 0, 0, 213, 214, 215, 216,
-	180, 0, 156, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 175, 182, 0, 184, 155, 228, 178,
-	285, 191, 0, 220, 187, 252, 192, 198, 240, 284,
-	226, 245, 153, 275, 253, 202, 177, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	381, 0, 0, 138, 0, 196, 0, 237, 174, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	0, 0, 0, 0, 0, 140, 0, 197, 0, 238,
+	176, 104, 105, 106, 107, 108, 109, 110, 111, 112,
 	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
 	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
-	133, 134, 135, 136, 137, 225, 0, 291, 292, 293,
-	277, 0, 0, 0, 0, 0, 96, 169, 0, 0,
-	195, 0, 0, 0, 254, 209, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 99, 0, 0, 0, 0,
-	0, 0, 151, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 154, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 143, 259,
-	273, 152, 250, 286, 157, 257, 148, 224, 246, 0,
-	0, 145, 271, 256, 206, 189, 190, 144, 0, 241,
-	167, 181, 164, 222, 0, 0, 298, 163, 289, 0,
-	281, 147, 0, 280, 221, 268, 272, 207, 201, 146,
-	270, 205, 200, 193, 171, 185, 233, 199, 234, 186,
-	211, 210, 212, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 283, 0, 0, 0, 0, 0, 0,
-	258, 0, 0, 194, 0, 0, 0, 299, 0, 244,
-	227, 0, 0, 0, 242, 197, 269, 235, 274, 260,
-	282, 238, 236, 139, 261, 166, 208, 149, 150, 162,
-	168, 170, 172, 173, 217, 218, 230, 249, 262, 263,
-	264, 165, 158, 243, 159, 183, 160, 140, 251, 161,
-	141, 231, 267, 0, 179, 239, 204, 142, 203, 232,
-	266, 265, 290, 296, 297, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 295,
-	176, 0, 278, 0, 223, 0, 0, 0, 0, 0,
-	0, 0, 219, 294, 0, 0, 0, 0, 247, 0,
-	0, 0, 0, 0, 188, 229, 0, 248, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	255, 276, 288, 279, 0, 0, 0, 287, 0, 0,
-	0, 0, 0, 0, 213, 214, 215, 216, 180, 0,
-	156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 175, 182, 0, 184, 155, 228, 178, 285, 191,
-	0, 220, 187, 252, 192, 198, 240, 284, 226, 245,
-	153, 275, 253, 202, 177, 0, 0, 0, 0, 0,
+	133, 134, 135, 136, 137, 138, 139, 1228, 0, 242,
+	243, 244, 241, 256, 226, 0, 260, 0, 0, 299,
+	300, 301, 284, 0, 0, 0, 171, 0, 0, 196,
+	0, 0, 0, 261, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
+	0, 153, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 145,
+	266, 280, 154, 255, 294, 159, 264, 150, 225, 251,
+	0, 0, 147, 278, 263, 207, 190, 191, 146, 0,
+	246, 169, 182, 166, 223, 0, 0, 306, 165, 297,
+	0, 289, 149, 0, 288, 222, 275, 279, 208, 202,
+	148, 277, 206, 201, 194, 173, 287, 186, 234, 200,
+	235, 187, 212, 211, 213, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 0, 0, 0, 0,
+	0, 0, 265, 0, 0, 195, 0, 0, 0, 307,
+	0, 249, 228, 0, 0, 0, 247, 198, 276, 236,
+	281, 267, 290, 239, 237, 141, 268, 168, 209, 151,
+	152, 164, 170, 172, 174, 175, 218, 219, 231, 254,
+	269, 270, 271, 167, 160, 248, 161, 184, 162, 142,
+	257, 163, 143, 232, 274, 0, 180, 240, 205, 144,
+	204, 233, 273, 272, 298, 304, 305, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 303, 178, 0, 285, 0, 224, 0, 0, 0,
+	0, 0, 0, 0, 220, 302, 0, 0, 0, 0,
+	252, 0, 0, 0, 0, 0, 189, 230, 0, 253,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 138, 0, 196, 0, 237, 174, 102, 103, 104,
+	0, 0, 262, 283, 296, 286, 0, 0, 0, 295,
+	0, 0, 0, 0, 0, 0, 214, 215, 216, 217,
+	181, 0, 158, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 177, 183, 0, 185, 157, 229, 179,
+	293, 192, 0, 221, 188, 258, 193, 199, 245, 292,
+	227, 250, 155, 282, 259, 203, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 140, 0, 197, 0, 238, 176, 104,
 	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
 	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
 	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
-	135, 136, 137, 225, 0, 291, 292, 293, 277, 0,
-	0, 0, 0, 0, 0, 169, 0, 0, 195, 0,
-	0, 0, 254, 209, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 99, 0, 0, 0, 0, 0, 0,
-	151, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	154, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 143, 259, 273, 152,
-	250, 286, 157, 257, 148, 224, 246, 0, 0, 145,
-	271, 256, 206, 189, 190, 144, 0, 241, 167, 181,
-	164, 222, 0, 0, 298, 163, 289, 0, 281, 147,
-	0, 280, 221, 268, 272, 207, 201, 146, 270, 205,
-	200, 193, 171, 185, 233, 199, 234, 186, 211, 210,
-	212, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 283, 0, 0, 0, 0, 0, 0, 258, 0,
-	0, 194, 0, 0, 0, 299, 0, 244, 227, 0,
-	0, 0, 242, 197, 269, 235, 274, 260, 282, 238,
-	236, 139, 261, 166, 208, 149, 150, 162, 168, 170,
-	172, 173, 217, 218, 230, 249, 262, 263, 264, 165,
-	158, 243, 159, 183, 160, 140, 251, 161, 141, 231,
-	267, 0, 179, 239, 204, 142, 203, 232, 266, 265,
-	290, 296, 297, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 295, 176, 0,
-	278, 0, 223, 0, 0, 0, 0, 0, 0, 0,
-	219, 294, 0, 0, 0, 0, 247, 0, 0, 0,
-	0, 0, 188, 229, 0, 248, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 255, 276,
-	288, 279, 0, 0, 0, 287, 0, 0, 0, 0,
-	0, 0, 213, 214, 215, 216, 180, 0, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
-	182, 0, 184, 155, 228, 178, 285, 191, 0, 220,
-	187, 252, 192, 198, 240, 284, 226, 245, 153, 275,
-	253, 202, 177, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 138,
-	0, 196, 0, 237, 174, 102, 103, 104, 105, 106,
+	135, 136, 137, 138, 139, 0, 0, 242, 243, 244,
+	241, 256, 226, 0, 260, 0, 0, 299, 300, 301,
+	284, 0, 0, 0, 171, 0, 0, 196, 0, 0,
+	0, 261, 210, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 101, 0, 0, 0, 0, 0, 0, 153,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 156, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 145, 266, 280,
+	154, 255, 294, 159, 264, 150, 225, 251, 0, 0,
+	147, 278, 263, 207, 190, 191, 146, 0, 246, 169,
+	182, 166, 223, 0, 0, 306, 165, 297, 0, 289,
+	149, 0, 288, 222, 275, 279, 208, 202, 148, 277,
+	206, 201, 194, 173, 287, 186, 234, 200, 235, 187,
+	212, 211, 213, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 291, 0, 0, 1214, 0, 0, 0,
+	265, 0, 0, 195, 0, 0, 0, 307, 0, 249,
+	228, 0, 0, 0, 247, 198, 276, 236, 281, 267,
+	290, 239, 237, 141, 268, 168, 209, 151, 152, 164,
+	170, 172, 174, 175, 218, 219, 231, 254, 269, 270,
+	271, 167, 160, 248, 161, 184, 162, 142, 257, 163,
+	143, 232, 274, 0, 180, 240, 205, 144, 204, 233,
+	273, 272, 298, 304, 305, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 303,
+	178, 0, 285, 0, 224, 0, 0, 0, 0, 0,
+	0, 0, 220, 302, 0, 0, 0, 0, 252, 0,
+	0, 0, 0, 0, 189, 230, 0, 253, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	262, 283, 296, 286, 0, 0, 0, 295, 0, 0,
+	0, 0, 0, 0, 214, 215, 216, 217, 181, 0,
+	158, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 177, 183, 0, 185, 157, 229, 179, 293, 192,
+	0, 221, 188, 258, 193, 199, 245, 292, 227, 250,
+	155, 282, 259, 203, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 140, 0, 197, 0, 238, 176, 104, 105, 106,
 	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
 	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
 	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
-	137, 225, 0, 291, 292, 293, 277, 0, 1213, 0,
-	0, 0, 0, 169, 0, 0, 195, 0, 0, 0,
-	254, 209, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 792, 793, 794, 1215, 0, 0, 0, 151, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 154, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 143, 259, 273, 152, 250, 286,
-	157, 257, 148, 224, 246, 0, 0, 145, 271, 256,
-	206, 189, 190, 144, 0, 241, 167, 181, 164, 222,
-	0, 0, 298, 163, 289, 0, 281, 147, 0, 280,
-	221, 268, 272, 207, 201, 146, 270, 205, 200, 193,
-	171, 185, 233, 199, 234, 186, 211, 210, 212, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 283,
-	0, 0, 0, 0, 0, 0, 258, 0, 0, 194,
-	0, 0, 0, 299, 0, 244, 227, 0, 0, 0,
-	242, 197, 269, 235, 274, 260, 282, 238, 236, 139,
-	261, 166, 208, 149, 150, 162, 168, 170, 172, 173,
-	217, 218, 230, 249, 262, 263, 264, 165, 158, 243,
-	159, 183, 160, 140, 251, 161, 141, 231, 267, 0,
-	179, 239, 204, 142, 203, 232, 266, 265, 290, 296,
-	297, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 295, 176, 0, 278, 0,
-	223, 0, 0, 0, 0, 0, 0, 0, 219, 294,
-	0, 0, 0, 0, 247, 0, 0, 0, 0, 0,
-	188, 229, 0, 248, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 255, 276, 288, 279,
-	0, 0, 0, 287, 0, 0, 0, 0, 0, 0,
-	213, 214, 215, 216, 180, 0, 156, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 175, 182, 0,
-	184, 155, 228, 178, 285, 191, 0, 220, 187, 252,
-	192, 198, 240, 284, 226, 245, 153, 275, 253, 202,
-	177, 0, 0, 225, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 169, 0, 0, 195, 0,
-	0, 0, 254, 209, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 138, 0, 196,
-	0, 237, 174, 792, 793, 794, 1215, 0, 0, 0,
-	151, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	154, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 291, 292, 293, 277, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 143, 259, 273, 152,
-	250, 286, 157, 257, 148, 224, 246, 0, 0, 145,
-	271, 256, 206, 189, 190, 144, 0, 241, 167, 181,
-	164, 222, 0, 0, 298, 163, 289, 0, 281, 147,
-	0, 280, 221, 268, 272, 207, 201, 146, 270, 205,
-	200, 193, 171, 185, 233, 199, 234, 186, 211, 210,
-	212, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 283, 0, 0, 0, 0, 0, 0, 258, 0,
-	0, 194, 0, 0, 0, 299, 0, 244, 227, 0,
-	0, 0, 242, 197, 269, 235, 274, 260, 282, 238,
-	236, 139, 261, 166, 208, 149, 150, 162, 168, 170,
-	172, 173, 217, 218, 230, 249, 262, 263, 264, 165,
-	158, 243, 159, 183, 160, 140, 251, 161, 141, 231,
-	267, 0, 179, 239, 204, 142, 203, 232, 266, 265,
-	290, 296, 297, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 295, 176, 0,
-	278, 0, 223, 0, 0, 0, 0, 0, 0, 0,
-	219, 294, 0, 0, 0, 0, 247, 0, 0, 0,
-	0, 0, 188, 229, 0, 248, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 255, 276,
-	288, 279, 0, 0, 0, 287, 0, 0, 0, 0,
-	0, 0, 213, 214, 215, 216, 180, 0, 156, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 175,
-	182, 0, 184, 155, 228, 178, 285, 191, 0, 220,
-	187, 252, 192, 198, 240, 284, 226, 245, 153, 275,
-	253, 202, 177, 0, 0, 225, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 169, 0, 0,
-	195, 0, 0, 0, 254, 209, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 138,
-	0, 196, 0, 237, 174, 792, 793, 794, 0, 0,
-	0, 0, 151, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 154, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 291, 292, 293, 277, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 143, 259,
-	273, 152, 250, 286, 157, 257, 148, 224, 246, 0,
-	0, 145, 271, 256, 206, 189, 190, 144, 0, 241,
-	167, 181, 164, 222, 0, 0, 298, 163, 289, 0,
-	281, 147, 0, 280, 221, 268, 272, 207, 201, 146,
-	270, 205, 200, 193, 171, 185, 233, 199, 234, 186,
-	211, 210, 212, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 283, 0, 0, 0, 0, 0, 0,
-	258, 0, 0, 194, 0, 0, 0, 299, 0, 244,
-	227, 0, 0, 0, 242, 197, 269, 235, 274, 260,
-	282, 238, 236, 139, 261, 166, 208, 149, 150, 162,
-	168, 170, 172, 173, 217, 218, 230, 249, 262, 263,
-	264, 165, 158, 243, 159, 183, 160, 140, 251, 161,
-	141, 231, 267, 0, 179, 239, 204, 142, 203, 232,
-	266, 265, 290, 296, 297, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 295,
-	176, 0, 278, 1867, 223, 0, 0, 0, 0, 0,
-	0, 0, 219, 294, 0, 0, 0, 0, 247, 0,
-	0, 0, 0, 0, 188, 229, 1652, 248, 0, 1172,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	255, 276, 288, 279, 0, 0, 0, 287, 0, 0,
-	0, 0, 2260, 0, 213, 214, 215, 216, 180, 0,
-	156, 0, 0, 1849, 0, 0, 0, 0, 0, 0,
-	0, 175, 182, 0, 184, 155, 228, 178, 285, 191,
-	0, 220, 187, 252, 192, 198, 240, 284, 226, 245,
-	153, 275, 253, 202, 177, 0, 0, 0, 0, 0,
-	0, 0, 0, 93, 0, 26, 83, 67, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 88, 0,
-	0, 0, 1640, 0, 0, 77, 0, 0, 0, 0,
-	0, 138, 0, 196, 0, 237, 174, 0, 0, 1659,
-	1663, 1665, 1667, 1669, 1670, 1672, 49, 1676, 1673, 1674,
-	1675, 90, 0, 1654, 1655, 1656, 1657, 1638, 1639, 1660,
-	0, 1641, 0, 1642, 1643, 1644, 1645, 1646, 1647, 1648,
-	1649, 1650, 1651, 1658, 0, 291, 292, 293, 277, 0,
-	0, 1662, 1664, 1666, 1668, 1671, 0, 0, 0, 0,
-	0, 0, 0, 1853, 0, 0, 0, 0, 0, 0,
-	1867, 0, 0, 0, 1857, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1653, 0, 0, 84, 85,
-	0, 86, 87, 1867, 1846, 0, 1172, 0, 1848, 1850,
-	1852, 0, 1854, 1855, 1856, 1858, 1859, 1860, 1862, 1863,
-	1864, 1865, 0, 0, 0, 0, 0, 0, 0, 1172,
-	1932, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1849, 0, 0, 0, 0, 0, 0, 0, 1868, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1849, 66, 82, 91, 0, 47, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1866, 0, 81, 76, 75, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1845,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1861, 0, 0, 0, 0, 0,
-	0, 1851, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 78, 79, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1853, 0, 0, 0, 0, 0, 0, 0, 57, 0,
-	0, 1857, 80, 0, 58, 0, 0, 0, 0, 0,
-	0, 0, 0, 1853, 0, 0, 0, 0, 0, 0,
-	1661, 1846, 0, 0, 1857, 1848, 1850, 1852, 0, 1854,
-	1855, 1856, 1858, 1859, 1860, 1862, 1863, 1864, 1865, 0,
-	0, 59, 0, 0, 1846, 0, 0, 0, 1848, 1850,
-	1852, 0, 1854, 1855, 1856, 1858, 1859, 1860, 1862, 1863,
-	1864, 1865, 0, 0, 0, 1868, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1868, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1866,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 68, 0, 1845, 0, 0, 0,
-	0, 0, 1866, 0, 0, 0, 0, 0, 0, 0,
-	0, 1861, 0, 0, 0, 0, 0, 0, 1851, 1845,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1861, 0, 0, 0, 0, 0,
-	0, 1851,
+	137, 138, 139, 0, 0, 242, 243, 244, 241, 256,
+	226, 0, 260, 0, 0, 299, 300, 301, 284, 0,
+	0, 0, 171, 0, 0, 196, 0, 0, 0, 261,
+	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	101, 0, 0, 598, 0, 0, 0, 153, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 156,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 145, 266, 280, 154, 255,
+	294, 159, 264, 150, 225, 251, 0, 0, 147, 278,
+	263, 207, 190, 191, 146, 0, 246, 169, 182, 166,
+	223, 0, 0, 306, 165, 297, 0, 289, 149, 0,
+	288, 222, 275, 279, 208, 202, 148, 277, 206, 201,
+	194, 173, 287, 186, 234, 200, 235, 187, 212, 211,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 291, 0, 0, 0, 0, 0, 0, 265, 0,
+	0, 195, 0, 0, 0, 307, 0, 249, 228, 0,
+	0, 0, 247, 198, 276, 236, 281, 267, 290, 239,
+	237, 141, 268, 168, 209, 151, 152, 164, 170, 172,
+	174, 175, 218, 219, 231, 254, 269, 270, 271, 167,
+	160, 248, 161, 184, 162, 142, 257, 163, 143, 232,
+	274, 0, 180, 240, 205, 144, 204, 233, 273, 272,
+	298, 304, 305, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 303, 178, 0,
+	285, 0, 224, 0, 0, 0, 0, 0, 0, 0,
+	220, 302, 0, 0, 0, 0, 252, 0, 0, 0,
+	0, 0, 189, 230, 0, 253, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 283,
+	296, 855, 0, 0, 0, 295, 0, 0, 0, 0,
+	0, 0, 214, 215, 216, 217, 181, 0, 158, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
+	183, 0, 185, 157, 229, 179, 293, 192, 0, 221,
+	188, 258, 193, 199, 245, 292, 227, 250, 155, 282,
+	259, 203, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 140,
+	0, 197, 0, 238, 176, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
+	139, 0, 0, 242, 243, 244, 241, 256, 226, 0,
+	260, 0, 0, 299, 300, 301, 284, 0, 0, 0,
+	171, 0, 0, 196, 0, 0, 0, 261, 210, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 101, 0,
+	0, 0, 0, 0, 0, 153, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 156, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 145, 266, 280, 154, 255, 294, 159,
+	264, 150, 225, 251, 0, 0, 147, 278, 263, 207,
+	190, 191, 146, 0, 246, 169, 182, 166, 223, 0,
+	0, 306, 165, 297, 0, 289, 149, 0, 288, 222,
+	275, 279, 208, 202, 148, 277, 206, 201, 194, 173,
+	287, 186, 234, 200, 235, 187, 212, 211, 213, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 291,
+	0, 0, 0, 0, 0, 0, 265, 0, 0, 195,
+	0, 0, 0, 307, 0, 249, 228, 0, 0, 0,
+	247, 198, 276, 236, 281, 267, 290, 239, 237, 141,
+	268, 168, 209, 151, 152, 164, 170, 172, 174, 175,
+	218, 219, 231, 254, 269, 270, 271, 167, 160, 248,
+	161, 184, 162, 142, 257, 163, 143, 232, 274, 0,
+	180, 240, 205, 144, 204, 233, 273, 272, 298, 304,
+	305, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 303, 178, 0, 285, 0,
+	224, 0, 0, 0, 0, 0, 0, 0, 220, 302,
+	0, 0, 0, 0, 252, 0, 0, 0, 0, 0,
+	189, 230, 0, 253, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 262, 283, 296, 286,
+	0, 0, 0, 295, 0, 0, 0, 0, 0, 0,
+	214, 215, 216, 217, 181, 0, 158, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 177, 183, 0,
+	185, 157, 229, 179, 293, 192, 0, 221, 188, 258,
+	193, 199, 245, 292, 227, 250, 155, 282, 259, 203,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 392, 0, 0, 0, 140, 0, 197,
+	0, 238, 176, 104, 105, 106, 107, 108, 109, 110,
+	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
+	121, 122, 123, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 134, 135, 136, 137, 138, 139, 0,
+	0, 242, 243, 244, 241, 256, 226, 0, 260, 0,
+	0, 299, 300, 301, 284, 0, 0, 98, 171, 0,
+	0, 196, 0, 0, 0, 261, 210, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 101, 0, 0, 0,
+	0, 0, 0, 153, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 156, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 145, 266, 280, 154, 255, 294, 159, 264, 150,
+	225, 251, 0, 0, 147, 278, 263, 207, 190, 191,
+	146, 0, 246, 169, 182, 166, 223, 0, 0, 306,
+	165, 297, 0, 289, 149, 0, 288, 222, 275, 279,
+	208, 202, 148, 277, 206, 201, 194, 173, 287, 186,
+	234, 200, 235, 187, 212, 211, 213, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 291, 0, 0,
+	0, 0, 0, 0, 265, 0, 0, 195, 0, 0,
+	0, 307, 0, 249, 228, 0, 0, 0, 247, 198,
+	276, 236, 281, 267, 290, 239, 237, 141, 268, 168,
+	209, 151, 152, 164, 170, 172, 174, 175, 218, 219,
+	231, 254, 269, 270, 271, 167, 160, 248, 161, 184,
+	162, 142, 257, 163, 143, 232, 274, 0, 180, 240,
+	205, 144, 204, 233, 273, 272, 298, 304, 305, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 303, 178, 0, 285, 0, 224, 0,
+	0, 0, 0, 0, 0, 0, 220, 302, 0, 0,
+	0, 0, 252, 0, 0, 0, 0, 0, 189, 230,
+	0, 253, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 262, 283, 296, 286, 0, 0,
+	0, 295, 0, 0, 0, 0, 0, 0, 214, 215,
+	216, 217, 181, 0, 158, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 177, 183, 0, 185, 157,
+	229, 179, 293, 192, 0, 221, 188, 258, 193, 199,
+	245, 292, 227, 250, 155, 282, 259, 203, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 140, 0, 197, 0, 238,
+	176, 104, 105, 106, 107, 108, 109, 110, 111, 112,
+	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
+	123, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 134, 135, 136, 137, 138, 139, 0, 0, 242,
+	243, 244, 241, 256, 226, 0, 260, 0, 0, 299,
+	300, 301, 284, 0, 0, 0, 171, 0, 0, 196,
+	0, 0, 0, 261, 210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
+	0, 153, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 156, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 145,
+	266, 280, 154, 255, 294, 159, 264, 150, 225, 251,
+	0, 0, 147, 278, 263, 207, 190, 191, 146, 0,
+	246, 169, 182, 166, 223, 0, 0, 306, 165, 297,
+	0, 289, 149, 0, 288, 222, 275, 279, 208, 202,
+	148, 277, 206, 201, 194, 173, 287, 186, 234, 200,
+	235, 187, 212, 211, 213, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 291, 0, 0, 0, 0,
+	0, 0, 265, 0, 0, 195, 0, 0, 0, 307,
+	0, 249, 228, 0, 0, 0, 247, 198, 276, 236,
+	281, 267, 290, 239, 237, 141, 268, 168, 209, 151,
+	152, 164, 170, 172, 174, 175, 218, 219, 231, 254,
+	269, 270, 271, 167, 160, 248, 161, 184, 162, 142,
+	257, 163, 143, 232, 274, 0, 180, 240, 205, 144,
+	204, 233, 273, 272, 298, 304, 305, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 303, 178, 0, 285, 0, 224, 0, 0, 0,
+	0, 0, 0, 0, 220, 302, 0, 0, 0, 0,
+	252, 0, 0, 0, 0, 0, 189, 230, 0, 253,
+	0, 0, 911, 912, 913, 910, 0, 0, 0, 0,
+	0, 0, 262, 283, 296, 286, 0, 0, 0, 295,
+	0, 0, 0, 0, 0, 0, 214, 215, 216, 217,
+	181, 0, 158, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 177, 183, 0, 185, 157, 229, 179,
+	293, 192, 0, 221, 188, 258, 193, 199, 245, 292,
+	227, 250, 155, 282, 259, 203, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1361, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 140, 0, 197, 0, 238, 176, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	125, 126, 127, 128, 129, 130, 131, 132, 133, 134,
+	135, 136, 137, 138, 139, 0, 0, 242, 243, 244,
+	241, 256, 0, 0, 260, 226, 0, 299, 300, 301,
+	284, 0, 1280, 0, 0, 0, 0, 171, 0, 0,
+	196, 0, 0, 0, 261, 210, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 819, 820, 821, 1282, 0,
+	0, 0, 153, 0, 0, 0, 0, 0, 0, 0,
+	0, 1357, 0, 1354, 156, 0, 0, 1356, 1353, 1355,
+	1359, 1360, 0, 0, 0, 1358, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	145, 266, 280, 154, 255, 294, 159, 264, 150, 225,
+	251, 0, 0, 147, 278, 263, 207, 190, 191, 146,
+	0, 246, 169, 182, 166, 223, 0, 0, 306, 165,
+	297, 0, 289, 149, 0, 288, 222, 275, 279, 208,
+	202, 148, 277, 206, 201, 194, 173, 287, 186, 234,
+	200, 235, 187, 212, 211, 213, 1342, 1343, 1344, 1345,
+	1346, 1347, 1348, 1349, 1350, 1351, 1352, 1364, 1365, 1366,
+	1367, 1368, 1369, 1362, 1363, 0, 291, 0, 0, 0,
+	0, 0, 0, 265, 0, 0, 195, 0, 0, 0,
+	307, 0, 249, 228, 0, 0, 0, 247, 198, 276,
+	236, 281, 267, 290, 239, 237, 141, 268, 168, 209,
+	151, 152, 164, 170, 172, 174, 175, 218, 219, 231,
+	254, 269, 270, 271, 167, 160, 248, 161, 184, 162,
+	142, 257, 163, 143, 232, 274, 0, 180, 240, 205,
+	144, 204, 233, 273, 272, 298, 304, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 303, 178, 0, 285, 0, 224, 0, 0,
+	0, 0, 0, 0, 0, 220, 302, 0, 0, 0,
+	0, 252, 0, 0, 0, 0, 0, 189, 230, 0,
+	253, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 262, 283, 296, 286, 0, 0, 0,
+	295, 0, 0, 0, 0, 0, 0, 214, 215, 216,
+	217, 181, 0, 158, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 177, 183, 0, 185, 157, 229,
+	179, 293, 192, 0, 221, 188, 258, 193, 199, 245,
+	292, 227, 250, 155, 282, 259, 203, 0, 0, 0,
+	226, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 0, 0, 196, 0, 0, 0, 261,
+	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 140, 0, 197, 0, 238, 176,
+	819, 820, 821, 1282, 0, 0, 0, 153, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 156,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 242, 243,
+	244, 241, 256, 0, 0, 260, 0, 0, 299, 300,
+	301, 284, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 145, 266, 280, 154, 255,
+	294, 159, 264, 150, 225, 251, 0, 0, 147, 278,
+	263, 207, 190, 191, 146, 0, 246, 169, 182, 166,
+	223, 0, 0, 306, 165, 297, 0, 289, 149, 0,
+	288, 222, 275, 279, 208, 202, 148, 277, 206, 201,
+	194, 173, 287, 186, 234, 200, 235, 187, 212, 211,
+	213, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 291, 0, 0, 0, 0, 0, 0, 265, 0,
+	0, 195, 0, 0, 0, 307, 0, 249, 228, 0,
+	0, 0, 247, 198, 276, 236, 281, 267, 290, 239,
+	237, 141, 268, 168, 209, 151, 152, 164, 170, 172,
+	174, 175, 218, 219, 231, 254, 269, 270, 271, 167,
+	160, 248, 161, 184, 162, 142, 257, 163, 143, 232,
+	274, 0, 180, 240, 205, 144, 204, 233, 273, 272,
+	298, 304, 305, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 303, 178, 0,
+	285, 0, 224, 0, 0, 0, 0, 0, 0, 0,
+	220, 302, 0, 0, 0, 0, 252, 0, 0, 0,
+	0, 0, 189, 230, 0, 253, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 262, 283,
+	296, 286, 0, 0, 0, 295, 0, 0, 0, 0,
+	0, 0, 214, 215, 216, 217, 181, 0, 158, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 177,
+	183, 0, 185, 157, 229, 179, 293, 192, 0, 221,
+	188, 258, 193, 199, 245, 292, 227, 250, 155, 282,
+	259, 203, 0, 0, 0, 226, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 171, 0, 0,
+	196, 0, 0, 0, 261, 210, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 140,
+	0, 197, 0, 238, 176, 819, 820, 821, 0, 0,
+	0, 0, 153, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 156, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 242, 243, 244, 241, 256, 0, 0,
+	260, 0, 0, 299, 300, 301, 284, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	145, 266, 280, 154, 255, 294, 159, 264, 150, 225,
+	251, 0, 0, 147, 278, 263, 207, 190, 191, 146,
+	0, 246, 169, 182, 166, 223, 0, 0, 306, 165,
+	297, 0, 289, 149, 0, 288, 222, 275, 279, 208,
+	202, 148, 277, 206, 201, 194, 173, 287, 186, 234,
+	200, 235, 187, 212, 211, 213, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 291, 0, 0, 0,
+	0, 0, 0, 265, 0, 0, 195, 0, 0, 0,
+	307, 0, 249, 228, 0, 0, 0, 247, 198, 276,
+	236, 281, 267, 290, 239, 237, 141, 268, 168, 209,
+	151, 152, 164, 170, 172, 174, 175, 218, 219, 231,
+	254, 269, 270, 271, 167, 160, 248, 161, 184, 162,
+	142, 257, 163, 143, 232, 274, 0, 180, 240, 205,
+	144, 204, 233, 273, 272, 298, 304, 305, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 303, 178, 0, 285, 0, 224, 0, 0,
+	0, 0, 0, 0, 0, 220, 302, 0, 0, 0,
+	0, 252, 0, 0, 0, 0, 0, 189, 230, 0,
+	253, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 262, 283, 296, 286, 0, 0, 0,
+	295, 0, 0, 0, 0, 0, 0, 214, 215, 216,
+	217, 181, 0, 158, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 177, 183, 0, 185, 157, 229,
+	179, 293, 192, 0, 221, 188, 258, 193, 199, 245,
+	292, 227, 250, 155, 282, 259, 203, 1721, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 140, 0, 197, 0, 238, 176,
+	0, 0, 0, 0, 95, 2020, 26, 85, 68, 0,
+	0, 1721, 0, 0, 0, 0, 0, 0, 0, 90,
+	0, 0, 0, 0, 0, 0, 79, 0, 0, 0,
+	0, 0, 0, 1227, 0, 0, 0, 0, 242, 243,
+	244, 241, 256, 0, 0, 260, 0, 50, 299, 300,
+	301, 284, 92, 0, 0, 1708, 2145, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2002, 0, 0,
+	0, 0, 1728, 1732, 1734, 1736, 1738, 1739, 1741, 0,
+	1745, 1742, 1743, 1744, 0, 0, 1723, 1724, 1725, 1726,
+	1706, 1707, 1729, 0, 1709, 0, 1710, 1711, 1712, 1713,
+	1714, 1715, 1716, 1717, 1718, 1720, 1719, 1727, 0, 1708,
+	0, 0, 0, 0, 0, 1731, 1733, 1735, 1737, 1740,
+	0, 86, 87, 0, 88, 89, 1728, 1732, 1734, 1736,
+	1738, 1739, 1741, 0, 1745, 1742, 1743, 1744, 0, 0,
+	1723, 1724, 1725, 1726, 1706, 1707, 1729, 0, 1709, 1722,
+	1710, 1711, 1712, 1713, 1714, 1715, 1716, 1717, 1718, 1720,
+	1719, 1727, 0, 2020, 0, 0, 0, 0, 0, 1731,
+	1733, 1735, 1737, 1740, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 67, 84,
+	93, 1227, 48, 0, 0, 0, 0, 0, 0, 0,
+	2006, 0, 0, 1722, 0, 0, 0, 0, 83, 78,
+	77, 2010, 0, 0, 0, 2102, 0, 0, 0, 0,
+	0, 2020, 0, 0, 0, 2002, 0, 0, 0, 0,
+	0, 1999, 0, 0, 0, 2001, 2003, 2005, 0, 2007,
+	2008, 2009, 2011, 2012, 2013, 2015, 2016, 2017, 2018, 1227,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1781, 1782, 0, 0, 0, 0, 0, 80,
+	81, 0, 0, 0, 0, 2021, 0, 0, 0, 0,
+	0, 0, 0, 2002, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 58, 0, 0, 0, 82, 0, 59, 2019,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1998, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2014, 0, 0, 0, 60, 0, 0, 2004, 0,
+	0, 0, 0, 0, 1730, 0, 0, 0, 2006, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2010,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1999,
+	0, 0, 0, 2001, 2003, 2005, 0, 2007, 2008, 2009,
+	2011, 2012, 2013, 2015, 2016, 2017, 2018, 0, 1730, 0,
+	0, 0, 0, 0, 0, 0, 2006, 0, 69, 0,
+	0, 0, 0, 0, 0, 0, 0, 2010, 0, 0,
+	0, 0, 0, 2021, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1999, 0, 0,
+	0, 2001, 2003, 2005, 0, 2007, 2008, 2009, 2011, 2012,
+	2013, 2015, 2016, 2017, 2018, 0, 0, 2019, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1998, 0, 0, 0, 0, 0,
+	0, 2021, 0, 0, 0, 0, 0, 0, 0, 2014,
+	0, 0, 0, 0, 0, 0, 2004, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2019, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1998, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2014, 0, 0,
+	0, 0, 0, 0, 2004,
 }
 
 var yyPact = [...]int{
-	22846, -1000, -301, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	26185, -1000, -298, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 24135, -1000, -1000, 1709,
+	-1000, 10680, 24583, 136, 24583, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 357, -1000,
+	24583, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 10211, 9742,
+	215, -1000, 1983, -1000, -1000, -1000, -1000, 494, 369, 23687,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 945, 169, 369, 499, 497, 650, 650,
+	12027, 1983, 277, 116, -1000, 819, 26185, 275, 24583, -1000,
+	668, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 20866, -1000, -1000, 1478,
-	-1000, 8571, 21304, 55, 21304, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 222, -1000, 21304,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 8115, 7659, 137,
-	-1000, 1802, -1000, -1000, -1000, -1000, 143, 260, 20428, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 337, -42, 260, 339, 343, 366, 366, 9885, 1802,
-	170, 22, -1000, 751, 22846, 178, 21304, -1000, 386, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3485,76 +3862,79 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1983, 1983, 24583, 1, 761, -1000, 283, 294, 225,
+	663, -1000, -1000, -1000, -1000, 2004, -1000, 24583, 1847, 24583,
+	-1000, 1653, 1679, -1000, -1000, 1837, -1000, 121, 86, 53,
+	257, -1000, -1000, 237, -1000, -1000, -1000, -1000, -1000, 124,
+	-1000, 75, -1000, 74, -1000, -1000, -1000, -51, -1000, -1000,
+	-1000, -1000, -1000, 1604, 480, 1867, -119, 1920, 1961, 1714,
+	1990, 1949, 1947, 1945, 56, -131, 293, 293, 328, 293,
+	-1000, -1000, -1000, -1000, -1000, -1000, 428, -1000, -1000, -1000,
+	-1000, 1661, 24583, -1000, 1717, 707, 707, 809, 234, -1000,
+	-1000, -39, -73, 707, 707, -73, 119, -1000, 1955, 1954,
+	-1000, -1000, -1000, -1000, -1000, -1000, 24583, 315, 324, -1000,
+	-142, -1000, 622, -1000, 438, -1000, 13383, 231, 1666, 790,
+	-1000, 713, 24583, 24583, 24583, 713, 713, 14727, 14279, 662,
+	-1000, 1961, 1714, -1000, 1511, 1412, 1714, 315, 315, 315,
+	315, 315, 315, 24583, 7011, 7011, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 276, 1836, -1000, 24583, 1961, 1920,
+	1961, -1000, 656, 1014, 1166, -1000, -1000, 283, 1597, -1000,
+	632, -1000, -1000, -1000, -1000, 24583, 278, -1000, 1153, 1831,
+	199, 849, 19207, 21895, 24583, 19207, -1000, -1000, -1000, -1000,
+	-1000, -55, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 199, 19207, 19207, -3, -1000, -1000, -284, 1920,
+	7913, -1000, -1000, 7913, -1000, -1000, -1000, -1000, -1000, -1000,
+	346, 293, -1000, 46, 19207, 780, 21895, 1078, 24583, 324,
+	-1000, 24583, 1661, 1938, 24583, 1987, 8822, 1987, 24583, -1000,
+	-1000, 707, 707, -1000, 809, 809, -1000, -1000, -61, 1987,
+	1987, -62, 24583, 24583, 293, -1000, -1000, 358, 23239, 1930,
+	16519, -1000, -112, 469, 447, 453, -1000, -1000, 2015, -1000,
+	-1000, 1625, 13831, 12935, 291, 19207, 4298, -1000, -1000, 713,
+	713, 713, 4298, 4298, 514, -1000, -1000, -1000, -1000, -1000,
+	-1000, 24583, 1920, -1000, -1000, -1000, -1000, -1000, 19207, 21895,
+	24583, 24583, 24583, 25764, -1000, 1672, -1000, -1000, 11579, 655,
+	7913, 1368, 1830, -1000, -1000, 1829, 1828, 1827, 1826, 1819,
+	1818, 1817, -1000, 1769, -1000, -1000, 1816, 1815, 1814, 1813,
+	-1000, 1812, -1000, -1000, -1000, -1000, 1811, -1000, -1000, -1000,
+	1809, 1769, -1000, -1000, 1807, 1805, 1804, 1803, 1801, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1164, 1162, 1959, -1000,
+	827, -1000, -1000, 3847, 8822, 8822, 8822, 8822, -1000, -1000,
+	1739, 7913, 1800, 1796, -260, -1000, -1000, -261, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 9273, -1000, 1783, 1779, 1775, 1774, 1773, 1769, 1767,
+	1766, 1155, 1764, 1763, 1754, 1753, 8822, 1751, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1802, 1802, 21304, -67, 510, -1000, 202,
-	186, 243, 384, -1000, -1000, -1000, -1000, 1812, -1000, 21304,
-	1539, 21304, -1000, 1293, 1430, -1000, -1000, 1612, -1000, 105,
-	9, -26, 91, -1000, -1000, 158, -1000, -1000, -1000, -1000,
-	-1000, 40, -1000, 1, -1000, -13, -1000, -1000, -1000, -120,
-	-1000, -1000, -1000, -1000, -1000, 1235, 348, 1624, -162, 1687,
-	1753, 1487, 1792, 1744, -5, 198, 198, 218, 198, -1000,
-	-1000, -1000, -1000, -1000, -1000, 290, -1000, -1000, -1000, -1000,
-	1402, 21304, -1000, 1479, 421, 421, 610, 166, -1000, -1000,
-	-114, -137, 421, 421, -137, -3, -1000, 1743, 1738, -1000,
-	-1000, -1000, -1000, -1000, -1000, 199, 204, -1000, -182, -1000,
-	333, -1000, 308, -1000, 11217, 153, 1405, 541, -1000, 448,
-	21304, 21304, 21304, 448, 448, 12537, 12099, 382, -1000, 1753,
-	1487, -1000, 1211, 1138, 1487, 199, 199, 199, 199, 199,
-	21304, 5411, 5411, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 217, 1611, -1000, 21304, 1753, 1687, 1753, -1000, 379,
-	800, 877, -1000, -1000, 202, 1382, -1000, 597, -1000, -1000,
-	-1000, -1000, 21304, 176, -1000, 856, 1608, 117, 7740, 16041,
-	18669, 21304, 16041, -1000, -1000, -1000, -1000, -1000, -125, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 117,
-	16041, 16041, -76, -1000, -1000, -287, 1687, 5859, -1000, -1000,
-	5859, -1000, -1000, 215, 198, -1000, 16041, 608, 18669, 842,
-	21304, 204, -1000, 21304, 1402, 1725, 21304, 1803, 6755, 1803,
-	21304, -1000, -1000, 421, 421, -1000, 610, 610, -1000, -1000,
-	-128, 1803, 1803, -105, 21304, 198, -1000, -1000, 19990, 1707,
-	16917, -1000, -139, 325, 313, 319, -1000, -1000, -178, -1000,
-	-1000, 1330, 11661, 10773, 220, 16041, 3613, -1000, -1000, 448,
-	448, 448, 3613, 3613, 394, -1000, -1000, -1000, -1000, -1000,
-	-1000, 21304, 1687, -1000, -1000, -1000, -1000, -1000, 16041, 18669,
-	21304, 21304, 22466, -1000, 1407, -1000, -1000, 9447, 378, 5859,
-	1090, 1607, -1000, -1000, 1606, 1605, 1601, 1586, 1585, 1582,
-	1581, -1000, 1532, -1000, -1000, 1580, 1579, 1578, 1577, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1569, -1000, -1000, -1000, 1567,
-	1532, -1000, -1000, 1566, 1565, 1559, 1558, 1552, -1000, -1000,
-	-1000, -1000, -1000, -1000, 876, 869, 1855, -1000, -1000, -1000,
-	-1000, 3165, 6755, 6755, 6755, 6755, -1000, -1000, 1511, 5859,
-	1549, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 7203, -1000, 1546,
-	1544, 1543, 1542, 1541, 1532, 1527, 868, 1526, 1524, 1523,
-	6755, 1522, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1672, 1866,
+	-281, -1000, 12487, 24583, 24583, -1000, 1920, -1000, 1920, 2458,
+	-1000, 1960, -1000, 283, 157, -1000, -1000, -1000, -1000, -1000,
+	-1000, 648, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1654, -1000, 24583, 740, -1000, -1000, -1000, -1000, -1000,
+	75, 74, 1588, -1000, 28, 117, -1000, 1594, -1000, -1000,
+	-1000, 740, 1588, 337, 1146, 1139, -1000, 1038, 644, 1652,
+	-1000, 949, 22791, 24583, -159, 326, 1929, 1625, 1845, -1000,
+	-1000, -1000, 1912, 22343, -1000, 1750, 1579, -1000, -1000, 7913,
+	-1000, -1000, 1987, 1987, 1987, 707, 25764, 809, 24583, 809,
+	-1000, -1000, 809, -1000, 643, -1000, 24583, 1643, -1000, -1000,
+	319, 302, 284, 524, 326, 1749, -1000, 1768, 1633, -1000,
+	-1000, -1000, -1000, 1935, 1748, 25034, 277, -1000, -1000, 465,
+	434, 615, 21895, 333, -1000, -1000, 1625, -1000, -1000, -1000,
+	1746, 760, -1000, -1000, 8822, -1000, 1247, -1000, 4298, 4298,
+	4298, -1000, -1000, 17415, -1000, -1000, 1588, 1625, 1865, 1638,
+	-1000, 1638, -1000, -1000, -1000, 1987, 7011, -1000, 16519, -1000,
+	7913, 7913, 7913, 7913, -1000, 21447, -1000, 20999, -1000, 332,
+	8371, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 7913, 1943,
+	1943, 1943, 7913, 774, 7913, 7913, -1000, 875, 10913, 1943,
+	1943, 1943, 8822, 1943, 1943, -1000, 3378, 1943, 1943, 1943,
+	1943, -1000, -1000, 8822, 8822, 8822, 8822, 8822, 8822, 8822,
+	8822, 8822, 8822, 8822, 8822, 1738, 717, 8822, 8822, 8822,
+	1137, 1131, 1412, 1631, 1636, -1000, -1000, -1000, -1000, -1000,
+	775, 1247, 24583, 7913, 1745, 1744, 24819, 7913, 7913, 7913,
+	-1000, 1508, 1506, -1000, -1000, 7913, 7913, -1000, 7913, 8822,
+	24583, 7913, -1000, 1943, 1987, -1000, 1914, 1550, -1000, 1742,
+	-1000, 1563, 1904, -1000, 642, 1627, -1000, 755, 1560, -1000,
+	-1000, -1000, -1000, 635, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1407, -1000, -285, -1000, 10335, 21304, 21304, -1000,
-	1687, -1000, 1687, 2273, -1000, 1750, -1000, 202, 89, -1000,
-	-1000, -1000, -1000, -1000, -1000, 375, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1389, -1000, 21304, 568, -1000,
-	-1000, -1000, -1000, -1000, 1, -13, 1312, -1000, -48, 104,
-	-1000, 1376, -1000, -1000, -1000, 568, 1312, 208, 865, 862,
-	-1000, 1096, 371, 1385, -1000, 929, 19552, 21304, 238, 1706,
-	1330, 1531, -1000, -1000, -1000, 1672, 19114, -1000, 1517, 1368,
-	-1000, -1000, 5859, -1000, -1000, 1803, 1803, 1803, 421, 22466,
-	610, 21304, 610, -1000, -1000, 610, -1000, 368, 21304, 238,
-	1515, -1000, 1747, 1342, -1000, -1000, -1000, -1000, 1720, 21742,
-	170, -1000, -1000, 322, 301, 330, 18669, 207, -1000, -1000,
-	1330, -1000, -1000, -1000, 1514, 496, -1000, -1000, 6755, -1000,
-	922, -1000, 3613, 3613, 3613, -1000, -1000, 14727, -1000, -1000,
-	1312, 1330, 1623, 1384, -1000, -1000, -1000, -1000, 1803, 5411,
-	-1000, 16917, -1000, 5859, 5859, 5859, 5859, -1000, 18231, -1000,
-	17793, -1000, 253, 6307, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 5859, 1739, 1739, 1739, 5859, 689, 5859, 5859, -1000,
-	723, 11001, 1739, 1739, 1739, 1739, 1739, -1000, 2709, 1739,
-	1739, 1739, 1739, -1000, -1000, 6755, 6755, 6755, 6755, 6755,
-	6755, 6755, 6755, 6755, 6755, 6755, 6755, 1509, 692, 6755,
-	6755, 6755, 1138, 1548, 1366, -1000, -1000, -1000, -1000, -1000,
-	587, 922, 5859, 10119, 5859, 5859, 5859, -1000, 1194, 1176,
-	-1000, -1000, 5859, -1000, 5859, 6755, 5859, -1000, 1739, 1803,
-	1252, -1000, 1513, -1000, 1356, 1668, -1000, 367, 1339, -1000,
-	489, 1354, -1000, -1000, -1000, -1000, 365, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3569,164 +3949,179 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 0, -1000, -1000, 24583,
+	1153, 1552, 2003, 633, 627, 19207, -1000, 220, 19207, -1000,
+	-1000, 24583, 330, 19207, 58, -97, 7913, 7913, 24583, 7913,
+	-1000, -1000, -1000, 1717, 778, 1741, 345, -183, -1000, 37,
+	-1000, 1859, 120, -1000, 1912, -1000, 731, -1000, 24583, 1542,
+	-1000, 623, 26185, -1000, 24583, 1247, -1000, -1000, -1000, 1987,
+	-1000, 707, -1000, 707, 809, 24583, -1000, -1000, 500, 1740,
+	24583, -1000, 24583, 24583, 20551, 24583, 24583, -1000, -1000, -183,
+	1470, 24583, 16519, 16519, 16519, 16519, -1000, 1891, 1890, -1000,
+	1880, 1878, 1884, 24583, 16519, 24583, -1000, 1935, -1000, -1000,
+	25399, -1000, -1000, -1000, -1000, 1455, 1983, -1000, -1000, -1000,
+	432, 1625, 19207, 1066, 291, -1000, -1000, -1000, -1000, -1000,
+	24583, 24583, 1996, -1000, 1603, 1799, -1000, 807, 807, 800,
+	-1000, -1000, 618, -1000, -1000, 409, -1000, -1000, -1000, -1000,
+	-1000, 1739, -1000, -1000, -1000, 1449, 1539, 1247, 7913, -1000,
+	-1000, 7913, 7913, 904, 7913, 1440, 1547, 1544, -1000, 1436,
+	2002, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -73, -1000, -1000, 21304, 856, 1299, 1810, 364,
-	363, 16041, -1000, 163, 16041, -1000, -1000, 21304, 203, 16041,
-	-8, -142, 5859, 5859, 21304, 5859, -1000, -1000, -1000, 1479,
-	603, 1512, -236, -1000, -28, -1000, 1621, 97, -1000, 1672,
-	-1000, 312, -1000, 21304, 1372, -1000, 356, 22846, -1000, 21304,
-	922, -1000, -1000, -1000, 1803, -1000, 421, -1000, 421, 610,
-	21304, -1000, -1000, -236, 1170, 21304, 16917, 16917, 16917, 16917,
-	-1000, 1643, 1642, -1000, 1636, 1635, 1665, 21304, 16917, 21304,
-	-1000, -1000, -1000, 22104, -1000, -1000, -1000, -1000, 1167, 1802,
-	-1000, -1000, -1000, 287, 1330, 16041, 833, 220, -1000, -1000,
-	-1000, -1000, -1000, 21304, 21304, 1800, -1000, 1326, 1670, -1000,
-	650, 650, 612, -1000, -1000, 355, -1000, -1000, 259, -1000,
-	-1000, -1000, -1000, -1000, 1511, -1000, -1000, -1000, 1162, 1241,
-	922, 5859, -1000, -1000, 5859, 5859, 1091, 5859, 1150, 1282,
-	1265, -1000, 1099, 1805, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	7913, 7913, 7913, 2795, 7913, 7913, 968, 6560, -1000, -1000,
+	-1000, 7913, 7913, 7913, 7913, 1127, 1517, -1000, 823, 823,
+	676, 676, 676, 676, 676, 1209, 1209, -1000, -1000, -1000,
+	3847, 1738, 8822, 8822, 8822, 262, 2911, 2781, -1000, -1000,
+	-1000, 7913, 786, -1000, 7913, 1525, 1031, 280, 280, -1000,
+	1423, 1122, 1398, 1393, -1000, -1000, 1522, 1213, 1385, 2748,
+	1381, 1377, 7913, 1996, 816, -281, 6102, 198, 24583, -281,
+	24583, 24583, 6102, -1000, 24583, 2458, 1012, -1000, -1000, -1000,
+	19207, 692, 735, -1000, 16967, 19207, -1000, -1000, 19207, 171,
+	1919, -1000, -1000, -30, -11, 1247, 1247, 610, -1000, 1934,
+	1927, 11128, 24583, -1000, 14, -1000, -1000, -1000, 388, -1000,
+	1125, 1124, 1107, 1102, 24583, -1000, -1000, -1000, -1000, -1000,
+	746, 746, 746, 275, 1371, 604, 16519, 24583, -1000, 19655,
+	1348, -1000, -1000, 1987, 1987, 707, -1000, -1000, 292, 292,
+	248, -1000, 26177, 1602, -1000, 1602, -1000, 327, -1000, 62,
+	20, 1486, -1000, 748, 1843, 1858, 1843, -1000, -1000, -1000,
+	-1000, 1889, -1000, 1888, -1000, -1000, 1486, -1000, 1717, 24583,
+	-1000, -1000, -1000, 1588, 1346, -1000, -1000, -1000, -1000, 1994,
+	1989, 20103, -1000, -1000, -1000, -1000, -1000, 7913, 1619, 1614,
+	1591, 26123, 1518, -1000, -1000, -1000, -1000, 7913, 1557, 1586,
+	1581, 7913, 1558, 1510, -1000, 7913, 7913, 986, 1505, 1488,
+	1474, 1451, 1503, -1000, 262, 2911, 1040, -1000, 8822, 8822,
+	1437, 763, -1000, 7913, 797, -175, 680, 15623, 1330, 1992,
+	1988, 1294, -1000, 7913, -1000, -1000, -1000, -1000, 15623, -1000,
+	8822, -1000, -1000, 1395, 1994, 2009, -1000, 1282, 1590, -1000,
+	-281, -1000, -1000, 1550, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1588, -1000, -1000, -1000, -1000, 19207, 1936,
+	326, -1000, 81, 355, -288, -5, 1986, 1985, 24583, 277,
+	24583, 1269, 1555, -1000, -1000, -1000, 658, -1000, 24583, 815,
+	578, 293, 578, 813, 1737, -1000, -1000, 1717, 14, -1000,
+	1011, 1009, 1008, 1006, 45, -1000, -1000, -1000, -1000, -1000,
+	1734, 15623, -1000, 16071, 1095, 25034, 16519, 19655, 1329, -1000,
+	596, -1000, -1000, -1000, -1000, 1987, 1492, -1000, 1111, 992,
+	57, -1000, -1000, -1000, 1691, -1000, 1722, 1722, 1691, 1691,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 5859, 5859, 5859, 5859, 5859, 839, 4963,
-	-1000, -1000, -1000, 5859, 5859, 5859, 5859, 1219, 1934, -1000,
-	629, 629, 398, 398, 398, 398, 398, 734, 734, -1000,
-	-1000, -1000, 3165, 1509, 6755, 6755, 6755, 185, 1021, 2591,
-	-1000, 5859, 571, -1000, 5859, 937, -1000, 1094, 1419, 1080,
-	1061, -1000, -1000, 890, 1041, 2786, 1032, 5859, 1800, -285,
-	4509, 187, 21304, -285, 21304, 21304, 4509, -1000, 21304, 2273,
-	798, -1000, -1000, -1000, 16041, 409, 456, -1000, 14289, 16041,
-	-1000, -1000, 16041, 123, 1678, -1000, -1000, -90, -82, 922,
-	922, 354, -1000, 1715, 1693, 9009, -1000, -65, -1000, -1000,
-	-1000, 352, -1000, 853, 847, 846, 845, 21304, -1000, -1000,
-	-1000, -1000, -1000, 465, 465, 465, 178, 1093, 353, 16917,
-	21304, -1000, 16479, 1027, -1000, -1000, 1803, 1803, 421, -1000,
-	-7, -49, 1203, -1000, 484, 1618, 1620, 1618, -1000, -1000,
-	-1000, -1000, 1639, -1000, 1638, -1000, -1000, 1203, -1000, 1479,
-	-1000, -1000, -1000, 1312, 1016, -1000, -1000, -1000, -1000, 1796,
-	1790, 17355, -1000, -1000, -1000, -1000, -1000, 5859, 1537, 1529,
-	1494, 942, 1243, -1000, -1000, -1000, -1000, 5859, 1475, 1466,
-	1438, 1411, 1373, -1000, 5859, 5859, 1394, 1369, 1336, 1320,
-	1310, 1220, -1000, 185, 1021, 1587, -1000, 6755, 6755, 1291,
-	525, -1000, 5859, 672, 671, 13413, -1000, 5859, -1000, -1000,
-	-1000, 13413, -1000, 6755, -1000, 1280, 1796, -1000, 1014, 1318,
-	-1000, -285, -1000, -1000, 1252, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1312, -1000, -1000, -1000, -1000, 16041,
-	1731, 238, -1000, 3, 221, -289, -78, 1789, 1786, 21304,
-	170, 21304, 1008, 1263, -1000, -1000, -1000, 22754, 598, -1000,
-	21304, 637, 332, 198, 332, 631, 1508, -1000, -1000, -65,
-	-1000, 797, 796, 792, 790, -46, -1000, -1000, -1000, -1000,
-	-1000, 1507, 13413, -1000, 13851, 843, 21742, 16917, 16479, 1087,
-	-1000, 351, -1000, -1000, -1000, -1000, 1803, -1000, -7, -1000,
-	285, 291, 33, 1785, 1794, 21304, 5859, -1000, -1000, 5859,
-	1504, -1000, 5859, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	5859, 5859, -1000, -1000, 922, -1000, -1000, -1000, 1004, -1000,
-	297, 297, -29, -1000, -1000, -1000, 1450, -1000, 1482, 1482,
-	1450, 1450, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1503, 1498, -1000, 1450, 1481, 1481, 1450, -1000, -1000,
+	1732, 1730, -1000, 1691, 1721, 1721, 1691, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1483, 1483, 1488, 1483, -1000, 1214, -1000,
-	-1000, -1000, -1000, -1000, 1200, 1166, 5859, -1000, -1000, -1000,
-	-1000, -1000, -1000, 6755, -1000, -1000, -1000, -1000, 922, 5859,
-	976, -1000, 1450, 1482, -1000, 1450, 1481, 1450, 297, 297,
-	970, 753, 958, 2693, -1000, -1000, -1000, 4509, 1252, -1000,
-	16041, 16041, -245, -12, 21304, -291, 836, -1000, 1784, 835,
-	695, -1000, 1479, 22967, 9009, 1206, -1000, -1000, 21304, 21304,
-	-1000, 21304, 21304, 198, 5859, -1000, -1000, -1000, -1000, -1000,
-	-1000, 15603, -1000, -1000, -1000, -1000, -1000, 1803, 1087, 351,
-	-1000, -1000, 360, -1000, -1000, -1000, -1000, 789, -1000, -1000,
-	-1000, 833, 1753, -1000, 922, 922, 21304, 922, 922, 1241,
-	-1000, -1000, -1000, -1000, -1000, -36, -1000, -1000, -1000, -1000,
-	786, -1000, 785, -1000, -1000, -1000, 831, 831, -1000, -1000,
-	782, -1000, -1000, -1000, 771, -1000, -1000, 762, -1000, -1000,
-	-1000, -1000, 1161, -1000, 922, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 5859, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -105, -298, 761, -1000, 826, -81, -1000,
-	-1000, 1712, 177, 22944, -1000, 465, 465, 613, 465, 465,
-	465, 465, 135, 125, 465, 465, 465, 465, 465, 465,
-	465, 465, 465, 465, 465, 465, 465, 465, 1470, -1000,
-	-1000, 1206, -1000, -1000, 654, 6755, -1000, -1000, 825, 13851,
-	420, 422, 1469, -1000, 101, 624, 602, -1000, 21304, -1000,
-	1458, 1477, 63, 1456, -1000, 1453, 1452, 21304, 1149, 1208,
-	-1000, 1450, 5859, 1794, -1000, 27, -1000, -1000, -1000, 1205,
-	-1000, -1000, 956, 949, 1199, -1000, 1197, 928, 1193, 1180,
-	-1000, 1122, -104, -89, -1000, 1448, -1000, -1000, 1777, 170,
-	-1000, 1765, 22967, -1000, 746, 744, 465, 465, 742, 823,
-	822, 818, 465, 465, 741, 816, 22104, 738, 733, 729,
-	763, 814, 472, 754, 740, 735, 21304, 1442, 806, -1000,
-	-1000, 1021, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 718, 1428, -1000, -1000, 1425, 15603, 64,
-	64, 15603, 15603, 15603, 1424, 274, -1000, 15603, 1698, 1059,
-	1753, -1000, -1000, -1000, -1000, -1000, 813, -1000, -1000, -1000,
-	717, -1000, 714, -1000, 201, -88, -89, -1000, 1763, -83,
-	1762, 1761, 21304, 695, -1000, 103, -1000, -1000, -1000, 13413,
-	13413, -1000, -1000, -1000, -1000, 809, 807, -1000, -1000, -1000,
+	-1000, -1000, 1727, 1727, 1728, 1727, 24583, -1000, 62, -1000,
+	396, 392, 108, 1984, 1992, 24583, 7913, -1000, -1000, 7913,
+	1724, -1000, 7913, -1000, -1000, -1000, -1000, -1000, 1717, -1000,
+	-1000, 7913, 5651, -1000, -1000, 1247, -1000, -1000, -1000, 1259,
+	-1000, 448, 448, -1000, 1391, -134, -1000, -1000, 1349, -1000,
+	-1000, 1331, 1286, 7913, -1000, -1000, -1000, -1000, -1000, -1000,
+	8822, -1000, -1000, -1000, -1000, 1247, 7913, 1723, 1250, -1000,
+	1691, 1722, -1000, 1691, 1721, 1691, 448, 448, 1241, -1000,
+	-65, 7913, -1000, 962, 1229, 2260, -1000, -1000, 24583, -1000,
+	6102, 1550, -1000, 19207, 19207, -185, 71, 24583, -290, 1080,
+	-1000, 1981, 1079, 882, -1000, 1717, 26405, 11128, -1000, -1000,
+	24583, 24583, -1000, 24583, 24583, 293, 7913, 1933, -1000, -1000,
+	-1000, -1000, -1000, -1000, 18759, -1000, -1000, -1000, -1000, -1000,
+	1987, 1329, 596, -1000, -1000, 691, -1000, -1000, 248, 1903,
+	-1000, 992, -1000, -1000, 834, 8822, -1000, -1000, 1073, 16071,
+	483, 510, 1711, -1000, 184, 808, 794, -1000, 24583, -1000,
+	31, -1000, -1000, -1000, -1000, 998, -1000, 994, -1000, -1000,
+	-1000, 1071, 1071, -1000, -1000, 972, -1000, -1000, -1000, 971,
+	-1000, -1000, 967, -1000, -1000, -1000, -1000, -1000, 955, -1000,
+	-1000, -1000, 1066, 1961, -1000, 1247, 1247, 24583, 1247, -1000,
+	1247, 170, -1000, 1247, 1708, 1707, 135, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1226, 1065, -1000, -1000, -1000, 1211, -1000,
+	1247, 8822, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 141, -1000, -1000, 1539, -1000, 7913, -1000, -1000,
+	1534, -1000, -1000, -1000, -1000, -1000, -62, -292, 954, -1000,
+	1061, -9, -1000, -1000, 1932, 256, 26347, -1000, 746, 746,
+	694, 746, 746, 746, 746, 213, 212, 746, 746, 746,
+	746, 746, 746, 746, 746, 746, 746, 746, 746, 746,
+	746, 1706, -1000, 1703, 1840, 138, 1701, -1000, 1697, 1692,
+	24583, 1175, 277, 1483, -1000, 1691, 7913, 1992, -1000, -1000,
+	26189, 371, -1000, -1000, 2911, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 951, 1689, -1000, -1000,
+	1686, -1000, -1000, 1224, 1214, 1480, -1000, 1469, 1210, 1466,
+	1454, 104, -1000, -1000, -1000, 1448, -274, 5651, 7913, 7913,
+	1685, -1000, -1000, -1000, 162, -1000, 177, -268, -282, -270,
+	-1000, 1138, -42, -16, -1000, 1684, -1000, -1000, 1979, 277,
+	-1000, 1978, 26405, -1000, 943, 942, 746, 746, 935, 1056,
+	1055, 1048, 746, 746, 933, 1047, 25399, 909, 884, 883,
+	996, 1042, 512, 995, 980, 959, 24583, 1683, 1023, 18759,
+	160, 160, 18759, 18759, 18759, 1682, 382, -1000, -1000, 18759,
+	1918, 1128, 1961, -1000, 26189, 145, -1000, 239, 1681, 1208,
+	7913, -165, 18759, -1000, -1000, -1000, 1034, -1000, -1000, -1000,
+	880, -1000, 878, -1000, -1000, -1000, -1000, 1446, 1434, 7462,
+	1193, 193, -203, 784, -1000, -1000, -1000, -1000, -1000, -1000,
+	318, -25, -16, -1000, 1977, -22, 1976, 1975, 24583, 882,
+	-1000, 156, -1000, -1000, -1000, 15623, 15623, -1000, -1000, -1000,
+	-1000, 1030, 1024, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 148,
-	21304, 1165, -1000, 468, 906, 5859, -225, 15603, 1158, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1155, 1137, 1057, 15603,
-	-1000, -1000, -1000, 93, 112, -1000, -1000, 1698, -1000, -1000,
-	902, 895, 1423, 701, -78, 1759, -1000, 695, 1756, 695,
-	695, 1019, -1000, -1000, 87, 196, 194, -1000, 250, -1000,
-	-1000, -1000, -1000, -1000, -1000, 151, 1002, -1000, 806, 756,
-	-1000, 988, 1616, -1000, 0, 983, -1000, -1000, -1000, -1000,
-	975, -1000, -1000, 465, 739, 49, -1000, -1000, -1000, -1000,
-	-1000, 1734, 12975, -106, -1000, 697, -1000, 695, -1000, -1000,
-	-1000, 21304, 82, 696, 6755, 1417, 6755, 1292, 94, 1281,
-	-1000, -1000, -1000, -1000, -1000, 274, -1000, -1000, 1590, 1551,
-	1809, -1000, -1000, -1000, -1000, 112, 112, 112, 112, -1,
-	669, -1000, 842, -1000, 21304, -1000, 967, -1000, -1000, -1000,
-	350, -1000, -1000, -1000, -1000, 1215, 1755, -1000, 2043, 21304,
-	1055, 21304, 1092, 447, 6755, -1000, -1000, 1811, -1000, 1804,
-	518, 518, -1000, -1000, -1000, 930, -1000, 443, -1000, 15165,
-	21304, -1000, 175, 90, -1000, 964, -1000, 941, 21304, 668,
-	943, -1000, -1000, -1000, 690, 113, -1000, 21304, 4061, -1000,
-	336, 935, -1000, 883, 69, -1000, -1000, 909, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 922, 21304, -1000, 175, 1651,
-	-1000, 667, -1000, -1000, -1000, 22747, 172, -1000, -1000, 22747,
-	71, -1000, 167, -1000, -1000, 905, -1000, 881, 944, -1000,
-	71, 22967, 5859, -1000, 22967, 893, -1000,
+	-1000, -1000, -1000, -1000, -1000, 227, 24583, 1432, -1000, 747,
+	1430, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1428, 1402,
+	1390, 18759, -1000, -1000, -1000, 167, 158, -1000, -1000, 1918,
+	-1000, -1000, -1000, 1369, -1000, 957, 470, 7913, -1000, 1108,
+	1856, -1000, 50, 1353, -1000, 1174, 1172, -1000, -1000, 1322,
+	-1000, 1247, 2927, -1000, -179, 1898, -214, 177, 1678, 873,
+	-5, 1974, -1000, 882, 1973, 882, 882, 1308, -1000, -1000,
+	150, 265, 252, -1000, 342, -1000, -1000, -1000, -1000, -1000,
+	-1000, 223, 1306, -1000, 1023, 1021, -1000, -1000, -1000, -1000,
+	1293, -1000, -1000, 746, 1020, 128, -1000, -1000, -1000, -1000,
+	145, 26405, 5200, -1000, 1289, 382, -1000, -1000, 1853, 1850,
+	2001, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 7462, -1000,
+	1895, -1000, -1000, -1000, 1952, 15175, -45, -1000, 997, -1000,
+	882, -1000, -1000, -1000, 24583, 144, 861, 8822, 1677, 8822,
+	1676, 163, 1675, -1000, -1000, -1000, -1000, -1000, 158, 158,
+	158, 158, 66, 850, -1000, 1078, -1000, 26405, 1279, 1036,
+	-1000, -1000, -1000, 2008, -1000, 2006, 489, 489, -1000, -187,
+	-1000, 24583, -1000, 1276, -1000, -1000, -1000, 586, -1000, -1000,
+	-1000, -1000, 1671, 1968, -1000, 2136, 24583, 2099, 24583, 1668,
+	743, 8822, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	877, 191, -1000, -205, 1527, -1000, 737, -1000, 18311, 24583,
+	-1000, 248, 161, -1000, 1240, -1000, 1232, 17863, 846, 1068,
+	-1000, -1000, -1000, -217, 24583, 4749, -1000, 521, 1221, 142,
+	-1000, -1000, 1191, -1000, -1000, -1000, -1000, -1000, -1000, 1247,
+	24583, -1000, -1000, 841, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 112, 2160, 168, 140, 132, 167, 2159, 1731, 1729,
-	2144, 2143, 2137, 2136, 2135, 2134, 2132, 2130, 2129, 2127,
-	2126, 2125, 2124, 2123, 166, 2122, 2121, 2118, 2117, 2116,
-	2115, 2114, 2113, 2112, 2107, 2106, 2105, 126, 2104, 1728,
-	2102, 2101, 2099, 2098, 2097, 148, 2095, 2094, 2093, 2091,
-	2089, 2088, 2087, 2086, 2085, 2083, 2082, 2081, 2080, 161,
-	42, 113, 184, 198, 181, 174, 124, 76, 111, 154,
-	114, 2078, 2077, 106, 25, 119, 2076, 49, 40, 31,
-	176, 104, 48, 65, 93, 2075, 2074, 91, 2073, 2072,
-	2071, 2069, 62, 2068, 79, 39, 88, 28, 2067, 2066,
-	2065, 81, 2064, 2063, 2062, 2061, 90, 2060, 74, 53,
-	2059, 2057, 2056, 2055, 2054, 29, 2053, 46, 2052, 2036,
-	2035, 2033, 2032, 2031, 2030, 14, 17, 19, 2029, 2028,
-	16, 3, 2026, 150, 54, 83, 101, 2024, 360, 2023,
-	2019, 2018, 110, 2017, 131, 2016, 2012, 2011, 2007, 9,
-	2006, 41, 2004, 2003, 2002, 60, 2001, 2000, 1997, 96,
-	44, 55, 95, 1996, 1995, 78, 149, 15, 147, 0,
-	145, 38, 1994, 1990, 1989, 175, 125, 144, 1987, 120,
-	264, 115, 56, 1986, 52, 75, 1981, 1980, 1978, 105,
-	72, 51, 1977, 92, 1976, 36, 86, 1975, 103, 1974,
-	117, 1, 99, 1973, 151, 1971, 1970, 1952, 116, 1951,
-	1950, 61, 118, 1948, 1947, 1946, 26, 1945, 37, 21,
-	1942, 143, 158, 1941, 1937, 1935, 121, 94, 84, 1934,
-	1933, 82, 1932, 109, 77, 73, 108, 667, 107, 64,
-	18, 1931, 152, 1930, 203, 153, 134, 1929, 1909, 159,
-	953, 157, 1903, 146, 10, 1900, 1899, 11, 1897, 23,
-	1896, 1895, 1894, 1893, 5, 1892, 1891, 1890, 4, 2,
-	1888, 6, 102, 47, 71, 1887, 67, 70, 1886, 1885,
-	1884, 1883, 1882, 208, 1881, 1879, 1878, 1877, 1876, 1875,
-	1874, 80, 1873, 1872, 1871, 1870, 68, 1867, 1866, 1865,
-	1864, 1863, 30, 1861, 1860, 20, 1859, 24, 1858, 1856,
-	1855, 12, 123, 1854, 1853, 13, 1852, 1851, 7, 8,
-	1850, 1849, 57, 45, 34, 87, 85, 1848, 22, 1846,
-	98, 1845, 1844, 122, 1843, 100, 1842, 128, 155, 201,
-	1841, 138, 1840, 1838, 1837, 1836, 1835, 1834, 1833, 695,
-	1832, 1831, 156, 63, 1830, 1829, 1827, 135, 1826,
-}
-
-//line mysql_sql.y:6750
+	0, 128, 2401, 188, 140, 135, 187, 2400, 1954, 1953,
+	2399, 2398, 2397, 2395, 2394, 2392, 2391, 2390, 2389, 2386,
+	2385, 2383, 2382, 2381, 2380, 181, 2379, 2364, 2362, 2361,
+	2360, 2359, 2358, 2357, 2356, 2354, 2351, 2350, 2348, 130,
+	2340, 1950, 2339, 2337, 2336, 2335, 2334, 162, 2333, 2327,
+	2326, 2325, 2324, 2323, 2322, 2317, 2316, 2315, 2314, 2313,
+	2312, 168, 56, 36, 231, 45, 218, 220, 151, 82,
+	125, 167, 137, 2311, 2310, 121, 31, 139, 2308, 119,
+	54, 2305, 43, 193, 110, 47, 68, 105, 122, 2303,
+	97, 2300, 2298, 2297, 2296, 69, 2295, 88, 58, 103,
+	37, 123, 2294, 127, 2292, 2291, 2290, 101, 2289, 2288,
+	2287, 2286, 95, 2284, 98, 62, 2282, 2280, 2279, 2277,
+	2275, 38, 2274, 55, 2273, 2272, 2271, 2270, 2269, 2268,
+	2267, 2266, 8, 17, 16, 2265, 2263, 11, 9, 2262,
+	164, 222, 93, 112, 2261, 44, 14, 2260, 2259, 416,
+	150, 2258, 2257, 126, 2256, 159, 2255, 2241, 2240, 2239,
+	2238, 111, 2237, 2236, 2233, 29, 2232, 30, 2231, 52,
+	2230, 2229, 2228, 50, 2226, 2225, 2224, 108, 65, 24,
+	107, 2223, 2221, 86, 169, 12, 224, 0, 155, 48,
+	2220, 2219, 2218, 223, 158, 152, 170, 114, 299, 133,
+	53, 2204, 61, 79, 2203, 2202, 26, 115, 78, 5,
+	2201, 100, 2200, 10, 96, 2199, 117, 2198, 143, 20,
+	109, 2194, 166, 2193, 2192, 2191, 132, 2190, 2188, 67,
+	154, 2184, 2183, 2179, 34, 2178, 41, 22, 2177, 149,
+	178, 2176, 2175, 2173, 144, 104, 90, 2171, 2170, 87,
+	2166, 124, 84, 83, 76, 2163, 906, 118, 73, 18,
+	2162, 165, 2160, 247, 200, 148, 2159, 2157, 177, 1424,
+	176, 2155, 156, 3, 2152, 2151, 7, 2150, 28, 2149,
+	2148, 2147, 2146, 63, 2144, 4, 2143, 19, 21, 2142,
+	40, 116, 66, 80, 2141, 74, 81, 2139, 2136, 2129,
+	2128, 2126, 204, 2125, 175, 2124, 2123, 2121, 2120, 2119,
+	92, 2118, 2117, 2115, 2111, 72, 2110, 2109, 2108, 2107,
+	2106, 39, 2105, 2104, 15, 2103, 25, 2101, 2098, 2097,
+	6, 145, 2096, 2095, 13, 2091, 2090, 1, 2, 2087,
+	2086, 71, 49, 42, 94, 89, 2085, 23, 2084, 106,
+	2083, 2082, 102, 2081, 147, 2079, 113, 2065, 171, 173,
+	297, 2061, 163, 2060, 2057, 2056, 2055, 2054, 161, 2053,
+	933, 2052, 2050, 174, 64, 2049, 2030, 2025, 160, 120,
+}
+
+//line mysql_sql.y:7205
 type yySymType struct {
 	union interface{}
 	id    int
@@ -3740,6 +4135,16 @@ func (st *yySymType) aliasedTableExprUnion() *tree.AliasedTableExpr {
 	return v
 }
 
+func (st *yySymType) alterTableOptionUnion() tree.AlterTableOption {
+	v, _ := st.union.(tree.AlterTableOption)
+	return v
+}
+
+func (st *yySymType) alterTableOptionsUnion() tree.AlterTableOptions {
+	v, _ := st.union.(tree.AlterTableOptions)
+	return v
+}
+
 func (st *yySymType) assignmentUnion() *tree.Assignment {
 	v, _ := st.union.(*tree.Assignment)
 	return v
@@ -3875,6 +4280,21 @@ func (st *yySymType) fieldsListUnion() []*tree.Fields {
 	return v
 }
 
+func (st *yySymType) frameBoundUnion() *tree.FrameBound {
+	v, _ := st.union.(*tree.FrameBound)
+	return v
+}
+
+func (st *yySymType) frameClauseUnion() *tree.FrameClause {
+	v, _ := st.union.(*tree.FrameClause)
+	return v
+}
+
+func (st *yySymType) frameTypeUnion() tree.FrameType {
+	v, _ := st.union.(tree.FrameType)
+	return v
+}
+
 func (st *yySymType) fromUnion() *tree.From {
 	v, _ := st.union.(*tree.From)
 	return v
@@ -3885,6 +4305,11 @@ func (st *yySymType) fullOptUnion() bool {
 	return v
 }
 
+func (st *yySymType) fullTextSearchTypeUnion() tree.FullTextSearchType {
+	v, _ := st.union.(tree.FullTextSearchType)
+	return v
+}
+
 func (st *yySymType) funcExprUnion() *tree.FuncExpr {
 	v, _ := st.union.(*tree.FuncExpr)
 	return v
@@ -4120,6 +4545,11 @@ func (st *yySymType) selectStatementUnion() tree.SelectStatement {
 	return v
 }
 
+func (st *yySymType) separatorUnion() *string {
+	v, _ := st.union.(*string)
+	return v
+}
+
 func (st *yySymType) setDefaultRoleUnion() *tree.SetDefaultRole {
 	v, _ := st.union.(*tree.SetDefaultRole)
 	return v
@@ -4315,6 +4745,11 @@ func (st *yySymType) whereUnion() *tree.Where {
 	return v
 }
 
+func (st *yySymType) windowSpecUnion() *tree.WindowSpec {
+	v, _ := st.union.(*tree.WindowSpec)
+	return v
+}
+
 func (st *yySymType) withClauseUnion() *tree.With {
 	v, _ := st.union.(*tree.With)
 	return v
@@ -4326,152 +4761,161 @@ func (st *yySymType) zeroFillOptUnion() bool {
 }
 
 var yyR1 = [...]int{
-	0, 354, 2, 2, 1, 1, 1, 1, 1, 1,
+	0, 375, 2, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 53, 321, 321, 320, 320, 319,
-	319, 318, 318, 318, 317, 317, 317, 316, 316, 315,
-	315, 314, 314, 312, 312, 313, 311, 310, 310, 308,
-	308, 306, 306, 307, 307, 301, 301, 304, 304, 302,
-	302, 302, 302, 305, 300, 300, 300, 299, 299, 52,
-	52, 52, 239, 239, 51, 51, 253, 253, 253, 253,
-	253, 251, 251, 251, 251, 250, 250, 249, 249, 254,
-	254, 252, 252, 252, 252, 252, 252, 252, 252, 252,
-	252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
-	252, 252, 252, 252, 252, 252, 252, 252, 252, 252,
-	252, 252, 252, 252, 46, 46, 46, 46, 49, 50,
-	247, 247, 247, 247, 247, 248, 248, 248, 47, 48,
-	48, 238, 238, 243, 243, 242, 242, 242, 242, 242,
-	242, 242, 242, 242, 242, 242, 242, 246, 246, 246,
-	245, 245, 244, 244, 40, 40, 40, 43, 42, 237,
-	237, 237, 237, 237, 237, 237, 237, 41, 41, 41,
-	41, 41, 41, 38, 38, 37, 37, 39, 39, 236,
-	236, 235, 56, 56, 56, 56, 56, 56, 56, 55,
-	55, 58, 58, 57, 45, 45, 45, 45, 44, 44,
-	44, 44, 44, 44, 44, 44, 44, 344, 344, 344,
-	346, 346, 173, 174, 355, 172, 172, 172, 340, 340,
-	341, 342, 343, 343, 343, 54, 7, 36, 36, 283,
-	283, 186, 186, 187, 187, 185, 185, 185, 185, 185,
-	185, 286, 287, 182, 24, 24, 24, 24, 24, 24,
-	24, 24, 24, 24, 24, 28, 28, 35, 356, 356,
-	356, 33, 34, 282, 282, 282, 32, 31, 30, 29,
-	29, 27, 27, 26, 26, 179, 179, 181, 181, 177,
-	357, 357, 259, 259, 180, 180, 25, 25, 178, 178,
-	156, 176, 176, 176, 6, 10, 10, 10, 10, 10,
-	10, 16, 15, 13, 12, 11, 14, 5, 5, 5,
-	5, 8, 8, 9, 68, 68, 96, 96, 353, 353,
-	349, 349, 350, 350, 350, 351, 351, 352, 352, 4,
-	290, 290, 290, 290, 290, 290, 329, 329, 329, 330,
-	86, 86, 81, 81, 291, 291, 202, 331, 331, 298,
-	298, 297, 297, 296, 296, 84, 84, 85, 85, 72,
-	72, 59, 59, 303, 303, 303, 303, 309, 309, 280,
-	280, 122, 122, 152, 152, 153, 153, 60, 60, 61,
-	61, 61, 61, 61, 61, 337, 337, 339, 339, 338,
-	83, 83, 79, 79, 80, 80, 80, 78, 78, 77,
-	76, 76, 75, 74, 74, 74, 63, 63, 62, 62,
-	62, 62, 62, 138, 138, 138, 64, 64, 345, 345,
-	345, 284, 284, 284, 289, 289, 135, 135, 136, 136,
-	134, 134, 65, 65, 66, 66, 66, 66, 133, 133,
-	132, 67, 67, 73, 69, 69, 71, 71, 71, 71,
-	143, 143, 142, 142, 142, 142, 89, 89, 141, 140,
-	140, 140, 88, 88, 87, 87, 82, 82, 70, 70,
-	139, 358, 358, 137, 165, 165, 165, 171, 171, 164,
-	164, 164, 164, 164, 175, 170, 170, 166, 166, 167,
-	167, 167, 3, 3, 3, 19, 19, 19, 19, 23,
-	23, 347, 347, 17, 233, 233, 232, 232, 234, 234,
-	234, 234, 228, 228, 229, 229, 229, 229, 230, 230,
-	230, 231, 231, 231, 231, 227, 227, 226, 224, 224,
-	224, 225, 225, 225, 225, 225, 225, 168, 168, 18,
-	221, 221, 222, 222, 222, 223, 223, 215, 215, 215,
-	215, 22, 219, 219, 220, 220, 220, 220, 220, 216,
-	216, 218, 218, 214, 214, 214, 214, 214, 21, 213,
-	213, 211, 211, 209, 209, 210, 210, 208, 208, 208,
-	212, 212, 20, 285, 285, 255, 255, 258, 258, 265,
-	265, 266, 266, 264, 264, 271, 271, 270, 270, 269,
-	269, 268, 268, 267, 267, 262, 262, 261, 261, 256,
-	256, 256, 256, 256, 257, 257, 260, 260, 263, 263,
-	113, 113, 114, 114, 114, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 131, 131, 131, 131, 131, 131,
-	131, 131, 131, 131, 327, 327, 328, 116, 116, 116,
-	120, 120, 120, 120, 120, 120, 115, 115, 117, 117,
-	97, 97, 95, 95, 90, 90, 91, 91, 92, 92,
-	93, 93, 94, 94, 94, 94, 94, 94, 241, 241,
-	325, 325, 326, 326, 322, 322, 322, 324, 324, 324,
-	324, 324, 323, 323, 98, 150, 150, 150, 169, 169,
-	169, 149, 149, 149, 112, 112, 111, 111, 109, 109,
-	109, 109, 109, 109, 109, 109, 109, 109, 109, 109,
-	109, 240, 240, 183, 183, 184, 184, 130, 128, 128,
-	129, 129, 129, 129, 126, 127, 125, 125, 125, 125,
-	125, 124, 124, 123, 123, 123, 217, 217, 121, 121,
-	119, 119, 119, 118, 118, 118, 272, 191, 191, 191,
-	191, 191, 191, 191, 191, 191, 191, 191, 191, 191,
-	193, 193, 193, 193, 193, 193, 193, 193, 193, 193,
-	193, 193, 193, 193, 193, 193, 193, 193, 193, 193,
-	194, 194, 199, 199, 336, 336, 335, 99, 99, 99,
-	100, 100, 100, 100, 100, 100, 100, 100, 100, 108,
-	108, 108, 148, 148, 148, 148, 148, 148, 148, 148,
-	148, 148, 148, 148, 148, 148, 148, 295, 295, 295,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 348, 348, 348, 332, 332, 333, 333, 333,
-	333, 333, 333, 333, 333, 333, 333, 333, 333, 334,
-	334, 334, 334, 334, 334, 334, 334, 334, 334, 334,
-	334, 334, 334, 334, 334, 334, 147, 147, 146, 146,
-	146, 146, 146, 146, 146, 146, 146, 146, 146, 146,
-	146, 203, 203, 204, 204, 292, 292, 292, 292, 292,
-	292, 293, 293, 294, 294, 294, 294, 288, 288, 288,
-	288, 288, 288, 288, 288, 288, 288, 288, 288, 288,
-	288, 288, 288, 288, 288, 288, 288, 288, 288, 288,
-	288, 288, 288, 288, 288, 192, 144, 144, 144, 205,
-	200, 200, 201, 201, 195, 195, 195, 195, 195, 195,
-	195, 195, 195, 195, 197, 197, 197, 197, 197, 189,
-	189, 190, 190, 190, 190, 190, 190, 190, 190, 190,
-	196, 196, 198, 198, 207, 207, 207, 206, 206, 206,
-	206, 206, 206, 206, 110, 110, 110, 110, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 101, 101,
-	101, 101, 105, 105, 107, 107, 107, 107, 107, 107,
-	107, 107, 107, 107, 107, 107, 107, 107, 106, 106,
-	106, 106, 104, 104, 104, 104, 104, 102, 102, 102,
-	102, 102, 102, 102, 102, 102, 102, 102, 102, 102,
-	102, 102, 103, 151, 151, 273, 273, 276, 276, 274,
-	274, 275, 277, 277, 277, 278, 278, 278, 279, 279,
-	279, 281, 281, 155, 155, 155, 161, 161, 154, 154,
-	162, 162, 163, 163, 159, 159, 159, 159, 159, 159,
-	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
-	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
-	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
-	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
-	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
-	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
-	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
-	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
-	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
-	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
-	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
-	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	1, 1, 1, 1, 55, 340, 340, 339, 339, 338,
+	338, 337, 337, 337, 336, 336, 336, 335, 335, 334,
+	334, 333, 333, 331, 331, 332, 330, 329, 329, 327,
+	327, 325, 325, 326, 326, 320, 320, 323, 323, 321,
+	321, 321, 321, 324, 319, 319, 319, 318, 318, 54,
+	54, 54, 258, 258, 53, 53, 272, 272, 272, 272,
+	272, 270, 270, 270, 270, 269, 269, 268, 268, 131,
+	131, 131, 131, 131, 273, 273, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 271,
+	271, 271, 271, 271, 271, 271, 271, 271, 271, 48,
+	48, 48, 48, 51, 52, 266, 266, 266, 266, 266,
+	267, 267, 267, 49, 50, 50, 257, 257, 262, 262,
+	261, 261, 261, 261, 261, 261, 261, 261, 261, 261,
+	261, 261, 265, 265, 265, 264, 264, 263, 263, 42,
+	42, 42, 45, 44, 256, 256, 256, 256, 256, 256,
+	256, 256, 43, 43, 43, 43, 43, 43, 40, 40,
+	39, 39, 41, 41, 254, 254, 253, 58, 58, 58,
+	58, 58, 58, 58, 57, 57, 60, 60, 59, 47,
+	47, 47, 47, 46, 46, 46, 46, 46, 46, 46,
+	46, 46, 365, 365, 365, 367, 367, 191, 192, 376,
+	190, 190, 190, 361, 361, 362, 363, 364, 364, 364,
+	56, 7, 7, 38, 38, 104, 104, 104, 104, 102,
+	102, 103, 103, 103, 103, 352, 352, 353, 353, 353,
+	37, 37, 302, 302, 204, 204, 205, 205, 203, 203,
+	203, 203, 203, 203, 305, 306, 200, 25, 25, 25,
+	25, 25, 25, 25, 25, 25, 25, 25, 29, 29,
+	36, 377, 377, 377, 34, 35, 301, 301, 301, 33,
+	32, 31, 30, 30, 28, 28, 27, 27, 197, 197,
+	199, 199, 195, 378, 378, 278, 278, 198, 198, 26,
+	26, 26, 196, 196, 174, 194, 194, 194, 6, 10,
+	10, 10, 10, 10, 10, 10, 17, 16, 14, 12,
+	13, 11, 15, 5, 5, 5, 5, 8, 8, 9,
+	70, 70, 99, 99, 374, 374, 370, 370, 371, 371,
+	371, 372, 372, 373, 373, 4, 255, 255, 309, 309,
+	309, 309, 309, 309, 348, 348, 348, 349, 89, 89,
+	84, 84, 310, 310, 220, 350, 350, 317, 317, 316,
+	316, 315, 315, 87, 87, 88, 88, 74, 74, 61,
+	61, 322, 322, 322, 322, 328, 328, 299, 299, 128,
+	128, 170, 170, 171, 171, 62, 62, 63, 63, 63,
+	63, 63, 63, 358, 358, 360, 360, 359, 86, 86,
+	82, 82, 83, 83, 83, 81, 81, 80, 80, 79,
+	78, 78, 77, 76, 76, 76, 65, 65, 64, 64,
+	64, 64, 64, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 66, 66, 366, 366, 366, 303, 303, 303,
+	308, 308, 142, 142, 143, 143, 143, 144, 144, 145,
+	145, 145, 145, 147, 147, 146, 146, 141, 141, 67,
+	67, 68, 68, 68, 68, 140, 140, 139, 69, 69,
+	75, 71, 71, 73, 73, 73, 73, 154, 154, 153,
+	153, 153, 153, 92, 92, 152, 151, 151, 151, 91,
+	91, 90, 90, 85, 85, 72, 72, 72, 150, 379,
+	379, 148, 183, 183, 183, 189, 189, 182, 182, 182,
+	182, 182, 193, 188, 188, 184, 184, 185, 185, 185,
+	3, 3, 3, 20, 20, 20, 20, 24, 24, 24,
+	368, 368, 18, 251, 251, 250, 250, 252, 252, 252,
+	252, 246, 246, 247, 247, 247, 247, 248, 248, 248,
+	249, 249, 249, 249, 245, 245, 244, 242, 242, 242,
+	243, 243, 243, 243, 243, 243, 186, 186, 19, 239,
+	239, 240, 240, 240, 241, 241, 233, 233, 233, 233,
+	23, 237, 237, 238, 238, 238, 238, 238, 234, 234,
+	236, 236, 232, 232, 232, 232, 232, 22, 231, 231,
+	229, 229, 227, 227, 228, 228, 226, 226, 226, 230,
+	230, 21, 304, 304, 274, 274, 277, 277, 284, 284,
+	285, 285, 283, 283, 290, 290, 289, 289, 288, 288,
+	287, 287, 286, 286, 286, 286, 286, 281, 281, 280,
+	280, 275, 275, 275, 275, 275, 276, 276, 276, 279,
+	279, 282, 282, 119, 119, 120, 120, 120, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 346, 346, 347,
+	122, 122, 122, 126, 126, 126, 126, 126, 126, 121,
+	121, 123, 123, 100, 100, 98, 98, 93, 93, 94,
+	94, 95, 95, 96, 96, 97, 97, 97, 97, 97,
+	97, 260, 260, 344, 344, 345, 345, 341, 341, 341,
+	343, 343, 343, 343, 343, 342, 342, 101, 168, 168,
+	168, 187, 187, 187, 167, 167, 167, 118, 118, 117,
+	117, 115, 115, 115, 115, 115, 115, 115, 115, 115,
+	115, 115, 115, 115, 259, 259, 201, 201, 202, 202,
+	137, 135, 135, 136, 136, 136, 136, 133, 134, 132,
+	132, 132, 132, 132, 130, 130, 129, 129, 129, 235,
+	235, 127, 127, 125, 125, 125, 124, 124, 124, 291,
+	209, 209, 209, 209, 209, 209, 209, 209, 209, 209,
+	209, 209, 209, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
+	211, 211, 211, 211, 211, 211, 211, 212, 212, 217,
+	217, 357, 357, 356, 105, 105, 105, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 114, 114, 114, 159,
 	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
-	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
-	159, 159, 159, 159, 160, 160, 160, 160, 160, 160,
-	160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
-	160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
-	160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
-	160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
-	160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
-	160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
-	160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
-	160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
-	160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
-	160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
-	160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
-	160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
-	160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
-	160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
-	160, 160, 160, 160, 160, 160, 160, 160, 160, 160,
-	160, 160, 160, 160, 160, 160, 158, 158, 158, 158,
-	158, 158, 158, 158, 158, 158, 157, 157, 157, 157,
-	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
-	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
-	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
-	157, 157,
+	159, 159, 159, 159, 160, 160, 161, 162, 162, 163,
+	163, 163, 164, 164, 165, 165, 165, 165, 165, 166,
+	314, 314, 314, 156, 156, 156, 156, 156, 156, 156,
+	156, 156, 156, 156, 156, 156, 369, 369, 369, 351,
+	351, 354, 354, 354, 354, 354, 354, 354, 354, 354,
+	354, 354, 354, 355, 355, 355, 355, 355, 355, 355,
+	355, 355, 355, 355, 355, 355, 355, 355, 355, 355,
+	158, 158, 157, 157, 157, 157, 157, 157, 157, 157,
+	157, 157, 157, 157, 157, 157, 157, 221, 221, 222,
+	222, 311, 311, 311, 311, 311, 311, 312, 312, 313,
+	313, 313, 313, 307, 307, 307, 307, 307, 307, 307,
+	307, 307, 307, 307, 307, 307, 307, 307, 307, 307,
+	307, 307, 307, 307, 307, 307, 307, 307, 307, 307,
+	307, 307, 210, 155, 155, 155, 223, 218, 218, 219,
+	219, 213, 213, 213, 213, 213, 213, 213, 213, 213,
+	213, 215, 215, 215, 215, 215, 207, 207, 208, 208,
+	208, 208, 208, 208, 208, 208, 208, 214, 214, 216,
+	216, 225, 225, 225, 224, 224, 224, 224, 224, 224,
+	224, 116, 116, 116, 116, 206, 206, 206, 206, 206,
+	206, 206, 206, 206, 206, 107, 107, 107, 107, 111,
+	111, 113, 113, 113, 113, 113, 113, 113, 113, 113,
+	113, 113, 113, 113, 113, 112, 112, 112, 112, 110,
+	110, 110, 110, 110, 108, 108, 108, 108, 108, 108,
+	108, 108, 108, 108, 108, 108, 108, 108, 108, 108,
+	109, 169, 169, 292, 292, 295, 295, 293, 293, 294,
+	296, 296, 296, 297, 297, 297, 298, 298, 298, 300,
+	300, 173, 173, 173, 179, 179, 172, 172, 180, 180,
+	181, 181, 177, 177, 177, 177, 177, 177, 177, 177,
+	177, 177, 177, 177, 177, 177, 177, 177, 177, 177,
+	177, 177, 177, 177, 177, 177, 177, 177, 177, 177,
+	177, 177, 177, 177, 177, 177, 177, 177, 177, 177,
+	177, 177, 177, 177, 177, 177, 177, 177, 177, 177,
+	177, 177, 177, 177, 177, 177, 177, 177, 177, 177,
+	177, 177, 177, 177, 177, 177, 177, 177, 177, 177,
+	177, 177, 177, 177, 177, 177, 177, 177, 177, 177,
+	177, 177, 177, 177, 177, 177, 177, 177, 177, 177,
+	177, 177, 177, 177, 177, 177, 177, 177, 177, 177,
+	177, 177, 177, 177, 177, 177, 177, 177, 177, 177,
+	177, 177, 177, 177, 177, 177, 177, 177, 177, 177,
+	177, 177, 177, 177, 177, 177, 177, 177, 177, 177,
+	177, 177, 177, 177, 177, 177, 177, 177, 177, 177,
+	177, 177, 177, 177, 177, 177, 177, 177, 177, 177,
+	177, 177, 177, 177, 177, 177, 177, 177, 177, 178,
+	178, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 178, 178, 178, 178, 178, 178, 178,
+	178, 178, 178, 178, 178, 178, 178, 176, 176, 176,
+	176, 176, 176, 176, 176, 176, 175, 175, 175, 175,
+	175, 175, 175, 175, 175, 175, 175, 175, 175, 175,
+	175, 175, 175, 175, 175, 175, 175, 175, 175, 175,
+	175, 175, 175, 175, 175, 175, 175, 175, 175, 175,
+	175, 175,
 }
 
 var yyR2 = [...]int{
@@ -4483,107 +4927,116 @@ var yyR2 = [...]int{
 	3, 0, 3, 0, 3, 0, 2, 1, 2, 3,
 	4, 3, 3, 1, 0, 1, 1, 0, 1, 9,
 	4, 7, 0, 3, 7, 4, 1, 3, 3, 3,
-	1, 0, 1, 1, 1, 1, 3, 1, 4, 1,
-	3, 1, 2, 1, 1, 2, 2, 1, 1, 1,
-	1, 1, 1, 1, 1, 2, 1, 2, 1, 2,
-	2, 1, 1, 1, 3, 2, 2, 2, 2, 2,
-	2, 2, 1, 1, 1, 1, 1, 1, 3, 6,
-	3, 1, 1, 1, 1, 1, 1, 1, 2, 4,
-	6, 1, 4, 1, 3, 3, 4, 4, 4, 3,
-	3, 2, 4, 4, 2, 2, 2, 1, 1, 1,
-	1, 1, 1, 3, 1, 1, 1, 2, 2, 0,
-	4, 2, 4, 1, 5, 3, 2, 1, 2, 2,
-	4, 4, 5, 2, 1, 1, 2, 9, 7, 1,
-	3, 3, 1, 1, 1, 1, 1, 1, 1, 4,
-	4, 2, 4, 3, 1, 1, 1, 1, 2, 3,
-	4, 7, 2, 3, 3, 4, 5, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 3,
-	2, 1, 1, 1, 1, 6, 1, 7, 9, 0,
-	2, 0, 1, 1, 2, 2, 2, 1, 4, 2,
-	2, 3, 0, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 4, 4, 5, 1, 1,
-	1, 5, 5, 0, 1, 1, 2, 2, 3, 6,
-	7, 4, 4, 7, 8, 0, 2, 0, 2, 2,
-	1, 1, 1, 1, 0, 1, 4, 5, 1, 3,
-	1, 1, 3, 5, 1, 1, 1, 1, 1, 1,
-	1, 4, 4, 6, 4, 4, 3, 1, 1, 2,
-	2, 11, 8, 9, 1, 3, 2, 4, 0, 2,
-	0, 1, 1, 1, 1, 0, 1, 0, 1, 4,
-	2, 1, 5, 4, 4, 2, 0, 1, 3, 3,
-	1, 3, 1, 3, 1, 3, 4, 0, 1, 0,
-	1, 1, 3, 1, 1, 0, 4, 1, 3, 2,
-	1, 0, 8, 0, 4, 7, 4, 0, 2, 0,
-	2, 0, 2, 0, 4, 1, 3, 1, 1, 4,
-	3, 4, 5, 4, 5, 2, 3, 1, 3, 6,
-	0, 3, 0, 1, 2, 4, 4, 0, 1, 3,
+	1, 0, 1, 1, 1, 1, 3, 1, 4, 0,
+	4, 7, 3, 3, 1, 3, 1, 2, 1, 1,
+	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 1, 2, 1, 2, 2, 1, 1, 1, 3,
+	2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
+	1, 1, 1, 3, 6, 3, 1, 1, 1, 1,
+	1, 1, 1, 2, 4, 6, 1, 4, 1, 3,
+	3, 4, 4, 4, 3, 3, 2, 4, 4, 2,
+	2, 2, 1, 1, 1, 1, 1, 1, 3, 1,
+	1, 1, 2, 2, 0, 4, 2, 4, 1, 5,
+	3, 2, 1, 2, 2, 4, 4, 5, 2, 1,
+	1, 2, 9, 7, 1, 3, 3, 1, 1, 1,
+	1, 1, 1, 1, 4, 4, 2, 4, 3, 1,
+	1, 1, 1, 2, 3, 4, 7, 2, 3, 3,
+	4, 5, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 3, 2, 1, 1, 1, 1,
+	6, 1, 1, 4, 4, 5, 3, 3, 3, 1,
+	3, 3, 3, 5, 3, 0, 1, 0, 1, 1,
+	7, 9, 0, 2, 0, 1, 1, 2, 2, 2,
+	1, 4, 2, 2, 3, 0, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 4, 4,
+	5, 1, 1, 1, 5, 5, 0, 1, 1, 2,
+	2, 3, 6, 7, 4, 4, 7, 8, 0, 2,
+	0, 2, 2, 1, 1, 1, 1, 0, 1, 4,
+	4, 5, 1, 3, 1, 1, 3, 5, 1, 1,
+	1, 1, 1, 1, 1, 1, 4, 4, 6, 4,
+	4, 4, 3, 1, 1, 2, 2, 11, 8, 9,
+	1, 3, 2, 4, 0, 2, 0, 1, 1, 1,
+	1, 0, 1, 0, 1, 5, 0, 5, 2, 1,
+	5, 4, 4, 2, 0, 1, 3, 3, 1, 3,
+	1, 3, 1, 3, 4, 0, 1, 0, 1, 1,
+	3, 1, 1, 0, 4, 1, 3, 2, 1, 0,
+	8, 0, 4, 7, 4, 0, 2, 0, 2, 0,
+	2, 0, 4, 1, 3, 1, 1, 4, 3, 4,
+	5, 4, 5, 2, 3, 1, 3, 6, 0, 3,
+	0, 1, 2, 4, 4, 0, 2, 0, 1, 3,
 	1, 3, 2, 0, 1, 1, 3, 3, 1, 3,
-	3, 3, 3, 1, 2, 2, 7, 7, 1, 1,
-	1, 0, 1, 1, 1, 1, 0, 2, 0, 3,
-	0, 2, 1, 3, 1, 2, 3, 5, 0, 1,
-	2, 1, 3, 1, 1, 1, 4, 4, 4, 3,
-	2, 2, 2, 3, 2, 3, 0, 2, 1, 1,
-	2, 2, 0, 1, 2, 4, 1, 3, 1, 4,
-	3, 0, 1, 2, 0, 1, 2, 1, 1, 0,
-	1, 2, 1, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 8,
-	11, 0, 1, 6, 0, 2, 1, 2, 2, 2,
-	2, 2, 0, 1, 2, 2, 2, 2, 1, 3,
-	2, 2, 2, 2, 2, 1, 3, 2, 1, 3,
-	2, 0, 3, 3, 5, 5, 4, 1, 1, 4,
-	1, 3, 1, 3, 2, 1, 1, 0, 1, 1,
-	1, 11, 0, 2, 3, 2, 3, 1, 1, 1,
-	3, 3, 4, 0, 2, 2, 2, 2, 5, 1,
-	1, 0, 3, 0, 1, 1, 2, 4, 4, 4,
-	0, 1, 10, 0, 1, 0, 6, 0, 4, 0,
-	3, 1, 3, 4, 5, 0, 3, 1, 3, 2,
-	3, 1, 2, 0, 6, 0, 2, 0, 2, 4,
-	5, 4, 5, 1, 6, 5, 0, 3, 0, 1,
-	0, 1, 1, 3, 2, 3, 3, 4, 4, 3,
-	3, 3, 3, 4, 4, 3, 3, 3, 3, 3,
+	3, 3, 3, 1, 2, 2, 1, 2, 2, 1,
+	2, 2, 7, 7, 1, 1, 1, 0, 1, 1,
+	1, 1, 0, 2, 0, 3, 5, 1, 3, 1,
+	4, 4, 5, 1, 3, 1, 2, 0, 2, 1,
+	3, 1, 2, 3, 5, 0, 1, 2, 1, 3,
+	1, 1, 1, 4, 4, 4, 3, 2, 2, 2,
+	3, 2, 3, 0, 2, 1, 1, 2, 2, 0,
+	1, 2, 4, 1, 3, 1, 4, 5, 3, 0,
+	1, 2, 0, 1, 2, 1, 1, 0, 1, 2,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 8, 11, 10,
+	0, 1, 6, 0, 2, 1, 2, 2, 2, 2,
+	2, 0, 1, 2, 2, 2, 2, 1, 3, 2,
+	2, 2, 2, 2, 1, 3, 2, 1, 3, 2,
+	0, 3, 3, 5, 5, 4, 1, 1, 4, 1,
+	3, 1, 3, 2, 1, 1, 0, 1, 1, 1,
+	11, 0, 2, 3, 2, 3, 1, 1, 1, 3,
+	3, 4, 0, 2, 2, 2, 2, 5, 1, 1,
+	0, 3, 0, 1, 1, 2, 4, 4, 4, 0,
+	1, 10, 0, 1, 0, 6, 0, 4, 0, 3,
+	1, 3, 4, 5, 0, 3, 1, 3, 2, 3,
+	1, 2, 0, 6, 4, 6, 5, 0, 2, 0,
+	2, 4, 5, 4, 5, 1, 6, 5, 5, 0,
+	3, 0, 1, 0, 1, 1, 3, 2, 3, 3,
+	4, 4, 3, 3, 3, 3, 4, 4, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 4, 5, 4, 1, 3, 3,
+	0, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 3, 1, 3, 0, 1, 1,
+	3, 1, 1, 2, 1, 7, 7, 7, 7, 8,
+	5, 0, 1, 0, 1, 1, 1, 1, 3, 3,
+	1, 1, 1, 1, 1, 0, 1, 3, 1, 3,
+	5, 1, 1, 1, 1, 3, 5, 0, 1, 1,
+	2, 1, 2, 2, 1, 1, 2, 2, 2, 2,
+	2, 1, 5, 6, 1, 2, 0, 1, 1, 2,
+	5, 0, 1, 1, 1, 2, 2, 3, 3, 1,
+	1, 2, 2, 2, 0, 1, 2, 2, 2, 0,
+	3, 0, 3, 1, 1, 1, 1, 1, 1, 1,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 4, 5, 4, 1, 3, 3, 0, 2, 2,
+	3, 3, 1, 1, 3, 3, 1, 1, 3, 5,
+	2, 2, 2, 2, 1, 1, 2, 5, 9, 6,
+	6, 6, 1, 1, 1, 1, 1, 0, 2, 0,
+	1, 1, 2, 4, 1, 2, 2, 1, 2, 2,
+	1, 2, 2, 2, 2, 2, 0, 1, 1, 5,
+	4, 4, 5, 5, 5, 5, 4, 5, 5, 5,
+	5, 5, 5, 5, 5, 5, 3, 0, 3, 0,
+	2, 5, 1, 1, 2, 2, 2, 2, 2, 1,
+	1, 1, 1, 4, 4, 6, 8, 6, 4, 5,
+	7, 4, 6, 6, 7, 6, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 1, 3, 0, 1, 1, 3, 1, 1,
-	2, 1, 7, 7, 7, 7, 8, 5, 0, 1,
-	0, 1, 1, 1, 1, 3, 3, 1, 1, 1,
-	1, 1, 0, 1, 3, 1, 3, 5, 1, 1,
-	1, 1, 3, 5, 0, 1, 1, 2, 1, 2,
-	2, 1, 1, 2, 2, 2, 2, 2, 1, 5,
-	6, 1, 2, 0, 1, 1, 2, 5, 0, 1,
-	1, 1, 2, 2, 3, 3, 1, 1, 2, 2,
-	2, 0, 1, 2, 2, 2, 0, 3, 0, 3,
-	1, 1, 1, 1, 1, 1, 1, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 1,
-	1, 1, 1, 3, 5, 2, 2, 2, 2, 1,
-	1, 2, 5, 6, 6, 6, 1, 1, 1, 1,
-	0, 2, 0, 1, 1, 2, 4, 1, 2, 2,
-	1, 2, 2, 1, 2, 2, 2, 2, 2, 0,
-	1, 1, 5, 4, 4, 5, 5, 5, 5, 4,
-	5, 5, 5, 5, 5, 5, 5, 1, 1, 1,
-	4, 4, 6, 8, 6, 4, 5, 5, 4, 6,
-	6, 7, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 2, 4, 2, 3, 2, 4, 4, 6, 2,
+	2, 4, 6, 4, 4, 2, 2, 0, 1, 2,
+	3, 1, 1, 1, 1, 1, 1, 0, 2, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 2, 2, 4, 2,
-	3, 2, 4, 6, 2, 2, 4, 6, 4, 2,
-	2, 0, 1, 2, 3, 1, 1, 1, 1, 1,
-	1, 0, 2, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 3, 0, 1, 1, 3, 0, 1, 1,
+	3, 3, 3, 3, 3, 2, 3, 4, 3, 4,
+	1, 3, 4, 3, 4, 1, 1, 1, 3, 4,
+	4, 5, 3, 4, 5, 6, 1, 0, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 1, 1, 1, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 2, 2, 2, 1,
+	2, 2, 2, 2, 2, 2, 2, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 4, 4,
+	1, 1, 3, 0, 1, 0, 3, 0, 3, 3,
+	0, 3, 5, 0, 3, 5, 0, 1, 1, 0,
+	1, 1, 2, 2, 0, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 3, 0, 1, 1, 3,
-	0, 1, 1, 3, 3, 3, 3, 3, 2, 3,
-	4, 3, 4, 1, 3, 4, 3, 4, 1, 1,
-	1, 3, 4, 4, 5, 3, 4, 5, 6, 1,
-	0, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 3, 1,
-	1, 1, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
-	2, 2, 1, 2, 2, 2, 2, 2, 2, 2,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	4, 4, 1, 1, 3, 0, 1, 0, 3, 0,
-	3, 3, 0, 3, 5, 0, 3, 5, 0, 1,
-	1, 0, 1, 1, 2, 2, 0, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -4624,481 +5077,519 @@ var yyR2 = [...]int{
 }
 
 var yyChk = [...]int{
-	-1000, -354, -2, -1, -3, -4, -5, -6, -44, -55,
-	-57, -58, -24, -7, -54, -37, -38, -40, -46, -51,
-	-52, -53, -60, -19, -18, -17, 9, -8, -9, -337,
-	-10, -172, -173, -174, -355, -25, -26, -27, -29, -30,
-	-31, -32, -33, -34, -35, -28, -36, 192, -39, 50,
-	-41, -42, -43, -47, -48, -49, -50, 302, 308, 345,
-	-61, -63, -20, -21, -22, -23, 188, 11, 418, -11,
-	-14, -12, -13, -15, -16, 210, 209, 29, 269, 270,
-	306, 208, 189, 10, 122, 123, 125, 126, 22, -62,
-	55, 190, -64, 7, 463, -72, 30, -95, -169, 59,
-	-157, -160, 421, 422, 423, 424, 425, 426, 427, 428,
-	429, 430, 431, 432, 433, 434, 435, 436, 437, 438,
-	439, 440, 441, 442, 443, 444, 445, 446, 447, 448,
-	449, 450, 451, 452, 453, 454, 455, 456, 415, 227,
-	251, 254, 261, 122, 141, 135, 163, 155, 130, 231,
-	232, 66, 125, 374, 76, 359, 344, 128, 246, 248,
-	250, 253, 233, 151, 146, 245, 229, 144, 234, 31,
-	235, 168, 236, 237, 420, 355, 284, 378, 361, 258,
-	342, 145, 356, 249, 358, 169, 173, 366, 308, 139,
-	140, 363, 368, 167, 207, 34, 417, 219, 369, 171,
-	166, 162, 377, 262, 260, 165, 138, 161, 230, 39,
-	175, 174, 176, 338, 339, 340, 341, 238, 239, 296,
-	365, 158, 147, 288, 131, 19, 372, 214, 360, 309,
-	240, 255, 263, 170, 172, 221, 226, 419, 225, 259,
-	370, 143, 218, 247, 213, 373, 132, 302, 311, 241,
-	126, 252, 367, 376, 38, 324, 137, 129, 204, 123,
-	223, 228, 242, 243, 244, 265, 264, 256, 159, 220,
-	164, 136, 160, 124, 222, 375, 325, 462, 286, 327,
-	157, 154, 224, 197, 371, 362, 127, 331, 326, 152,
-	266, 459, 460, 461, 297, 283, 267, 268, 150, 211,
-	-39, -9, -8, -62, -63, -176, 20, 342, -45, 343,
-	192, 55, -169, -5, -4, -37, -60, -175, -169, 269,
-	-175, 195, -169, -250, -221, -249, -222, -252, -223, -168,
-	23, 189, 188, 222, 11, 190, 306, 196, 9, 7,
-	307, 208, 10, 308, 310, 311, 314, 315, 316, 33,
-	319, 320, 59, 62, -169, -250, -221, 226, 233, -77,
-	-78, -138, 16, 6, -285, 327, 225, -215, -213, 317,
-	205, 204, 77, 380, 194, -349, -350, 185, 186, 187,
-	-339, 412, -338, -169, 413, -155, -356, -282, 362, 361,
-	-180, 360, 353, 364, 355, 188, 76, 156, 153, 196,
-	363, 34, 366, 367, 356, 327, -349, 127, 124, -237,
-	82, 132, 131, -237, 225, 32, -243, 337, -242, -244,
-	366, 367, 377, 60, 61, 365, -155, -169, 76, -78,
-	-138, -77, -61, -63, -337, 327, 225, 196, 195, 380,
-	269, -284, -345, 23, -289, 182, 183, 184, 24, 25,
-	-1, -84, 217, -95, 121, -78, -77, -78, -149, -169,
-	344, 91, -45, -45, 343, -340, -341, -342, -344, 192,
-	343, 342, 121, 12, -175, 53, -95, 52, 56, 12,
-	56, 55, 464, 60, 304, 318, 327, 305, 317, 197,
-	225, 318, 225, 353, 197, 309, 312, 313, 354, 52,
-	198, 52, -299, 377, -59, 30, -80, 18, -64, -63,
-	17, 23, 24, -347, 195, 412, -211, 200, -211, 196,
-	-211, -351, 134, 56, -339, -83, 55, -179, 99, -179,
-	-357, 12, 101, 224, 223, 357, 354, -259, 358, 359,
-	-180, -179, -179, -180, 195, 380, 22, 22, -283, 200,
-	-352, 199, 370, 418, 130, 131, 132, -247, 23, 32,
-	336, -221, 225, 56, 91, 20, -245, 91, 102, -244,
-	-244, -244, -245, -245, -115, 32, -168, 118, 62, -115,
-	32, 121, -79, -80, -64, -63, 57, 57, -283, -283,
-	-283, -283, -283, -175, -65, -66, 109, -195, -169, 83,
-	-197, 59, -190, 421, 422, 423, 424, 425, 426, 427,
-	428, 429, 430, 431, 432, 433, 434, 435, 437, 438,
-	439, 440, 441, 442, 443, 444, 445, 446, 447, 448,
-	449, 450, 451, 452, 453, 454, 455, 456, 76, 342,
-	296, 327, 152, 297, 150, 211, -191, -193, -318, -312,
-	-188, 55, 107, 108, 115, 84, -192, -272, 27, 86,
-	388, -145, -146, -147, -148, -313, -311, 62, 67, 70,
-	73, 74, 72, 71, 148, 69, 63, 120, -63, -332,
-	-158, -288, -294, 194, -292, 153, 149, 9, 113, 337,
-	118, -295, 61, 60, 290, 291, 292, 380, 287, 293,
-	200, 44, 294, 295, 298, 387, 299, 45, 300, 289,
-	215, 301, 391, 390, 392, 384, 381, 379, 382, 383,
-	385, 386, -65, -290, 35, -60, 55, 22, 55, -169,
-	-79, -59, -80, 121, 67, 62, -45, 57, 56, -343,
-	73, 74, -346, 167, 159, -169, -56, 62, -3, -4,
-	-5, -6, -24, -37, -60, -314, -312, 55, -251, 195,
-	303, 221, -249, 222, 308, 311, -227, -226, -224, -168,
-	-222, -254, -149, -168, 354, -251, -227, -226, 346, 458,
-	-59, -195, -169, -76, -75, -195, 197, -211, -227, 83,
-	-221, -167, 59, 60, 61, -169, -352, -338, 26, -82,
-	-169, -134, 13, -193, -134, -95, -179, -179, -181, -357,
-	-177, -357, 354, -134, -134, -259, -178, -169, -211, -227,
-	327, 27, -69, -67, -70, -71, -73, -137, -139, -95,
-	55, 371, 372, 128, 131, 130, 378, -248, 336, 23,
-	-221, -242, -238, 62, 337, -226, -246, 52, 118, -296,
-	-195, 32, -245, -245, -245, -246, -246, 117, -169, -59,
-	-227, -221, -169, -97, -95, -170, -167, -160, -133, 56,
-	-132, 12, -164, 82, 79, 80, 81, -169, 26, 62,
-	121, -195, 98, -206, 91, 92, 93, 94, 95, 96,
-	97, 55, 55, 55, 55, 55, 55, 55, 55, -204,
-	55, 55, 55, 55, 55, 55, 55, -204, 55, 55,
-	55, 55, 55, 62, 62, 104, 103, 114, 107, 108,
-	109, 110, 111, 112, 113, 105, 106, 101, 83, 99,
-	100, 85, -63, -195, -201, -193, -193, -193, -193, -272,
-	-199, -195, 55, -195, 55, 55, 55, -293, 55, 55,
-	-203, -204, 55, 62, 55, 55, 55, -193, 55, -133,
-	-291, -202, -331, 457, -86, 57, -81, -169, -329, -330,
-	-81, -85, -169, -59, -59, -162, -163, -154, -159, -166,
-	-167, -160, 285, 193, 23, 82, 26, 28, 290, 322,
-	85, 118, 17, 86, 153, 117, 292, 388, 291, 188,
-	48, 390, 392, 391, 381, 379, 329, 333, 335, 332,
-	380, 353, 32, 11, 29, 209, 24, 25, 111, 190,
-	89, 90, 212, 27, 210, 74, 20, 51, 12, 14,
-	15, 293, 328, 200, 199, 101, 346, 196, 46, 9,
-	120, 30, 98, 330, 42, 78, 44, 99, 18, 382,
-	383, 33, 345, 414, 216, 113, 294, 295, 49, 83,
-	336, 72, 52, 79, 16, 47, 100, 191, 387, 45,
-	334, 298, 300, 412, 299, 194, 364, 7, 289, 389,
-	22, 208, 43, 195, 354, 88, 198, 73, 215, 149,
-	6, 77, 10, 50, 53, 384, 385, 386, 35, 87,
-	13, 301, 418, 337, 347, 348, 349, 350, 351, 352,
-	177, 178, 179, 180, 181, 257, 203, 201, 205, 206,
-	457, 458, 182, 183, 280, 282, 156, 185, 186, 187,
-	217, 134, 20, -45, -341, 121, 56, -82, -253, 109,
-	59, 56, -225, 328, 464, 60, 57, 56, -253, 198,
-	62, 62, 56, 19, 121, 56, -74, 28, 29, -95,
-	200, -95, -228, -229, 334, 27, -214, 53, -209, -210,
-	-208, -212, 32, 12, -68, -96, -169, 55, 57, 56,
-	-195, -134, -134, -134, -179, -170, -181, -176, -181, -177,
-	121, -156, -169, -228, 55, 22, -140, -141, -142, -143,
-	42, 46, 48, 43, 44, 45, 49, 22, 56, -358,
-	26, -165, -171, 26, -166, 62, -167, -160, -61, -63,
-	129, 132, 132, 131, -221, 198, 55, 91, -246, -246,
-	-246, 32, -168, 52, 56, -134, -66, -67, -69, -195,
-	-195, -195, -195, -169, 62, -169, 109, -189, 83, 283,
-	72, 73, 74, -190, -207, 23, 267, 268, -200, -201,
-	-195, -144, 24, 23, -144, -144, -195, -144, 109, -201,
-	-201, 57, 67, -333, -334, 393, 394, 395, 396, 397,
-	398, 399, 400, 401, 402, 403, 294, 289, 295, 293,
-	287, 301, 296, 297, 152, 410, 411, 404, 405, 406,
-	407, 408, 409, -144, -144, -144, -144, -144, -195, -348,
-	281, 280, 282, -144, -144, -144, -144, -191, -191, -191,
-	-191, -191, -191, -191, -191, -191, -191, -191, -191, -198,
-	-205, -272, 55, 101, 99, 100, 85, -193, -191, -191,
-	57, 56, -336, -335, 87, -195, -333, -200, -195, -200,
-	-200, 57, 57, -201, -200, -191, -200, -144, -134, 56,
-	55, 57, 56, 35, 121, 56, 91, 57, 56, 121,
-	344, -169, -312, 57, 12, 121, 121, -226, 17, 418,
-	-168, -149, 198, -227, -300, 199, 387, -303, 358, -195,
-	-195, -169, -75, -83, 83, 55, -233, 418, 336, 335,
-	331, -230, -231, 330, 332, 329, 333, 52, 275, 276,
-	277, 278, -208, -155, 117, 236, -95, -68, -169, 12,
-	56, -353, 121, -1, -169, -134, -179, -179, -181, -169,
-	-233, 57, -236, -235, -149, -70, -70, -70, -70, 42,
-	42, 42, 47, 42, 47, 42, -142, -236, -73, -169,
-	-171, 57, 132, -227, -182, 62, -238, -95, -95, -136,
-	14, 121, -189, 283, 72, -272, 57, 56, -195, -195,
-	-195, 26, -201, 57, 57, 57, 57, 12, -195, -195,
-	-195, -195, -195, 57, 12, 12, -195, -195, -195, -195,
-	-195, -201, -198, -193, -191, -191, -196, 212, 82, -195,
-	-194, -335, 89, -195, 56, 53, 57, 12, 57, 57,
-	57, 53, 57, 56, 57, -195, -136, -202, -298, -297,
-	-296, 35, -60, -81, -291, -169, -330, -296, -169, -162,
-	-159, -167, -160, 67, -227, 109, 109, 59, -168, 337,
-	-168, -227, -239, 418, 30, -309, 352, 347, 349, 121,
-	26, 27, -90, -91, -92, -98, -93, -149, -184, -94,
-	203, 201, 205, -326, 77, 206, 257, 78, 196, -232,
-	-234, 338, 339, 340, 341, 82, -231, 62, 62, 62,
-	62, -95, -161, 91, -161, -161, -84, 53, 121, -67,
-	-96, -169, 109, 57, -134, -134, -179, -186, -187, -185,
-	285, -286, 337, 328, -134, 56, 91, -88, -87, 52,
-	53, -89, 52, -87, 42, 42, -134, -83, 57, -135,
-	15, 17, -169, 109, -195, 57, 57, 57, -99, -101,
-	324, 325, -105, -102, -104, -103, -107, -106, 153, 154,
-	118, 157, 159, 160, 161, 162, 163, 164, 165, 166,
-	167, 168, 22, 211, 149, 150, 151, 152, 169, 135,
-	155, 416, 177, 136, 178, 137, 179, 138, 180, 139,
-	140, 181, 141, 144, 145, 146, 143, 57, -195, 57,
-	57, 57, 57, 57, -195, -195, 12, 57, 57, 57,
-	57, 57, -196, 82, -193, -190, 57, 90, -195, 88,
-	-100, -106, 118, 153, 211, 152, 151, 149, 324, 325,
-	-115, -195, -115, -191, 57, -135, 57, 56, -291, -168,
-	17, 26, -228, 308, 195, -280, 459, -307, 347, 17,
-	17, -60, -95, 57, 56, -101, -94, -169, 78, -325,
-	-326, -211, -325, 78, 55, -234, 67, 67, 67, 67,
-	-231, 55, -115, -117, -168, 118, 62, -165, -67, -169,
-	-134, -353, 121, -134, -185, 286, 33, 120, 288, 32,
-	284, 17, -78, -235, -195, -195, 55, -195, -195, -201,
-	57, -108, 140, 139, -108, -279, 325, 324, -273, -275,
-	55, -274, 55, -274, -273, -273, 55, 55, -273, -276,
-	55, -276, -273, -277, 55, -277, -278, 55, -277, 57,
-	57, 57, -195, -190, -195, 57, -273, -274, -273, -276,
-	-273, -108, -108, 57, 57, 20, 57, 57, -296, -168,
-	-168, -239, 309, -95, -122, 460, 62, 17, 62, -305,
-	62, -83, -113, -114, -131, 322, 227, -212, 231, 66,
-	232, 344, 233, 196, 235, 236, 237, 207, 238, 239,
-	240, 337, 241, 242, 243, 244, 305, 6, 271, -92,
-	-112, -111, -109, 72, 83, 32, 322, -110, 66, 117,
-	250, 228, 251, -130, -183, 201, 77, 78, 310, -184,
-	-322, -323, -169, -323, -169, -322, -322, -211, -195, -216,
-	-218, -149, 55, -134, -134, 67, -287, -182, -79, -82,
-	-281, 326, 67, 67, -151, 62, -151, 67, 67, 67,
-	57, -195, -301, -259, -152, 461, 67, 62, 349, 26,
-	-255, 217, 56, -131, -161, -161, -155, 117, -161, -161,
-	-161, -161, 234, 234, -161, -161, -161, -161, -161, -161,
-	-161, -161, -161, -161, -161, -161, -161, -161, 55, -109,
-	72, -191, 62, -117, -118, 32, 249, 245, -119, 32,
-	229, 230, -121, 55, 257, 78, 78, -95, 55, 53,
-	266, 55, 55, 55, -323, 57, 57, 56, -273, -195,
-	-78, 287, 57, 57, 57, 57, 56, 57, 57, 57,
-	56, 57, 56, 57, -308, 352, -304, -302, 347, 348,
-	349, 350, 55, 17, -60, 17, -131, 67, 67, -161,
-	-161, 67, 62, 62, 62, -161, -161, 67, 62, -171,
-	67, 67, 67, 67, 32, 62, -120, 32, 245, 249,
-	246, 247, 248, 67, 32, 67, 32, 67, 32, -169,
-	55, -327, -328, 62, 67, 55, -217, 55, -216, -324,
-	275, 276, 277, 279, 278, -324, -216, -216, -216, 55,
-	-241, -240, 258, 83, -219, -218, -74, 57, -79, 62,
-	67, 67, -310, 199, -306, 351, -302, 17, 349, 17,
-	17, -153, -169, -305, -256, 259, 260, -257, -263, 262,
-	-115, -115, 62, 62, -116, 228, -97, 57, 56, 91,
-	57, -195, -124, -123, 414, -216, 57, 57, 57, 57,
-	-216, 258, -220, 207, 66, 418, 273, 274, -74, 57,
-	57, -317, 55, 67, -307, 17, -305, 17, -305, -305,
-	57, 56, -261, 263, 55, -259, 55, -259, 78, 276,
-	229, 230, 57, -328, 62, 57, -128, -129, -126, -127,
-	52, 356, 255, 256, 57, -219, -219, -219, -219, 57,
-	-161, 62, 272, -321, 22, 57, -316, -315, -150, -311,
-	-169, 352, 62, -305, -169, -258, 264, 67, -191, 55,
-	-191, 55, -260, 261, 55, -240, -127, 52, -126, 52,
-	11, 10, -130, 67, -167, -320, -319, -318, 57, 56,
-	121, -265, 55, 17, 57, -254, 57, -254, 55, 91,
-	-191, -125, 252, 253, 22, 131, -125, 56, 91, -315,
-	-169, -266, -264, 217, -257, 57, 57, -254, 67, 57,
-	72, 32, 254, -319, 32, -195, 121, 57, 56, 59,
-	-262, 265, 57, -169, -264, -267, 35, 67, -271, -268,
-	55, -131, 219, -271, -131, -270, -269, 264, 220, 57,
-	56, 59, 55, -269, -268, -201, 57,
+	-1000, -375, -2, -1, -3, -4, -5, -6, -46, -57,
+	-59, -60, -25, -7, -56, -39, -40, -42, -48, -53,
+	-54, -55, -62, -20, -19, -18, 11, -8, -9, -358,
+	-10, -190, -191, -192, -376, -26, -27, -28, -30, -31,
+	-32, -33, -34, -35, -36, -29, -37, -38, 197, -41,
+	52, -43, -44, -45, -49, -50, -51, -52, 307, 313,
+	350, -63, -65, -21, -22, -23, -24, 193, 13, 423,
+	-11, -15, -12, -13, -14, -16, -17, 215, 214, 31,
+	274, 275, 311, 213, 194, 12, 126, 127, 129, 130,
+	24, -64, 57, 195, -66, 9, 478, -74, 32, -98,
+	-187, 61, -175, -178, 426, 427, 428, 429, 430, 431,
+	432, 433, 434, 435, 436, 437, 438, 439, 440, 441,
+	442, 443, 444, 445, 446, 447, 448, 449, 450, 451,
+	452, 453, 454, 455, 456, 457, 458, 459, 460, 461,
+	420, 232, 256, 259, 266, 126, 145, 139, 167, 159,
+	134, 236, 237, 68, 129, 379, 80, 364, 349, 132,
+	251, 253, 255, 258, 238, 155, 150, 250, 234, 148,
+	239, 33, 240, 172, 241, 242, 425, 360, 289, 366,
+	263, 347, 149, 361, 254, 363, 174, 178, 371, 313,
+	143, 144, 368, 373, 171, 212, 36, 422, 224, 374,
+	176, 170, 166, 382, 267, 265, 169, 142, 165, 235,
+	41, 180, 179, 181, 343, 344, 345, 346, 243, 244,
+	301, 370, 162, 151, 293, 135, 21, 377, 219, 365,
+	314, 245, 260, 268, 175, 177, 226, 231, 424, 230,
+	264, 467, 464, 465, 466, 375, 147, 223, 252, 218,
+	378, 136, 307, 316, 246, 130, 468, 257, 372, 381,
+	471, 40, 329, 141, 133, 209, 127, 228, 233, 247,
+	248, 249, 270, 269, 261, 163, 225, 168, 140, 164,
+	128, 227, 380, 330, 477, 291, 332, 173, 161, 158,
+	229, 202, 376, 367, 131, 336, 331, 156, 271, 474,
+	475, 476, 302, 288, 272, 273, 154, 216, -41, -9,
+	-8, -64, -65, -194, 22, 347, -47, 348, 197, 57,
+	-187, -5, -4, -39, -62, -193, -187, 274, -193, 200,
+	-187, -269, -239, -268, -240, -271, -241, -186, 25, 194,
+	193, 227, 13, 195, 311, 201, 11, 9, 312, 213,
+	12, 313, 315, 316, 319, 320, 321, 35, 324, 325,
+	61, 64, -187, -269, -239, 231, 238, -79, -80, -149,
+	18, 6, 8, 7, -304, 83, 332, 230, -233, -231,
+	322, 210, 209, 81, 384, 199, -370, -371, 190, 191,
+	192, -360, 416, -359, -187, 417, -173, -377, -301, 367,
+	366, -198, 365, 358, 369, 360, 193, 80, 160, 157,
+	201, 368, 36, 371, 372, 361, 200, 332, -370, 131,
+	128, -256, 86, 136, 135, -256, 230, 34, -262, 342,
+	-261, -263, 371, 372, 382, 62, 63, 370, -173, -187,
+	80, -80, -149, -79, -63, -65, -358, 332, 230, 201,
+	200, 202, 384, 274, -303, -366, 25, -308, 187, 188,
+	189, 26, 27, -1, -87, 222, -98, 125, -80, -79,
+	-80, -167, -187, 349, 95, -47, -47, 348, -361, -362,
+	-363, -365, 197, 348, 347, 125, 14, -193, 55, -98,
+	54, 58, 14, 58, 57, 479, 62, 309, 323, 332,
+	310, 322, 202, 230, 323, 230, 358, 202, 314, 317,
+	318, 359, 54, 203, 54, -318, 382, -61, 32, -83,
+	20, -66, -65, 19, 25, 26, 25, 26, 25, 26,
+	-368, 200, 416, 391, -229, 205, -229, 201, -229, -372,
+	138, 58, -360, -86, 57, -197, 103, -197, -378, 14,
+	105, 229, 228, 362, 359, -278, 363, 364, -198, -197,
+	-197, -198, 200, 202, 384, 24, 24, -98, -302, 205,
+	-373, 204, 375, 423, 134, 135, 136, -266, 25, 34,
+	341, -239, 230, 58, 95, 22, -264, 95, 106, -263,
+	-263, -263, -264, -264, -121, 34, -186, 122, 64, -121,
+	34, 125, -82, -83, -66, -65, 59, 59, -302, -302,
+	-302, -302, -302, -302, -193, -67, -68, 113, -213, -187,
+	87, -215, 61, -208, 426, 427, 428, 429, 430, 431,
+	432, 433, 434, 435, 436, 437, 438, 439, 440, 442,
+	443, 444, 445, 446, 447, 448, 449, 450, 451, 452,
+	453, 454, 455, 456, 457, 458, 459, 460, 461, 80,
+	347, 301, 332, 173, 156, 302, 154, 216, -209, -211,
+	-337, -331, -206, 57, 111, 112, 119, 88, -210, -291,
+	29, 90, 419, 392, -156, -157, -158, -159, -160, -332,
+	-330, 64, 69, 72, 75, 76, 74, 73, 152, 71,
+	65, 124, -65, -351, -176, -307, -313, 199, -311, 470,
+	157, 153, 11, 117, 37, 342, 122, -314, 63, 62,
+	295, 296, 297, 384, 292, 298, 205, 46, 299, 300,
+	303, 391, 304, 47, 305, 294, 220, 306, 395, 394,
+	396, 388, 385, 383, 386, 387, 389, 390, -67, -309,
+	37, -62, 57, 24, 57, -187, -82, -61, -83, 125,
+	69, 64, -47, 59, 58, -364, 75, 76, -367, 171,
+	163, -187, -58, 64, -3, -4, -5, -6, -25, -39,
+	-62, -333, -331, 57, -270, 200, 308, 226, -268, 227,
+	313, 316, -245, -244, -242, -186, -240, -273, -167, -186,
+	359, -270, -245, -244, 351, 473, -61, -213, -187, -78,
+	-77, -213, 202, -229, -304, -245, 87, -239, -185, 61,
+	62, 63, -187, -373, -359, 28, -85, -187, -141, 15,
+	-211, -141, -98, -197, -197, -199, -378, -195, -378, 359,
+	-141, -141, -278, -196, -187, -196, -229, -102, -104, -103,
+	198, 195, 220, 196, -245, 332, 29, -71, -69, -72,
+	-73, -75, -148, -150, 418, -98, 57, 376, 377, 132,
+	135, 134, 7, -267, 341, 25, -239, -261, -257, 64,
+	342, -244, -265, 54, 122, -315, -213, 34, -264, -264,
+	-264, -265, -265, 121, -187, -61, -245, -239, -187, -100,
+	-98, -100, -188, -185, -178, -140, 58, -139, 14, -182,
+	86, 83, 84, 85, -187, 28, 64, 125, -213, 102,
+	-224, 95, 96, 97, 98, 99, 100, 101, 57, 57,
+	57, 57, 57, 57, 57, 57, -222, 57, 57, 57,
+	57, 57, 57, 57, 57, -222, 57, 57, 57, 57,
+	57, 64, 64, 108, 107, 118, 111, 112, 113, 114,
+	115, 116, 117, 109, 110, 105, 87, 103, 104, 89,
+	77, 78, -65, -213, -219, -211, -211, -211, -211, -291,
+	-217, -213, 57, 57, 462, 462, -213, 57, 57, 57,
+	-312, 57, 57, -221, -222, 57, 57, 64, 57, 57,
+	57, 57, -211, 57, -140, -255, 54, -310, -220, -350,
+	472, -89, 59, -84, -187, -348, -349, -84, -88, -187,
+	-61, -61, -180, -181, -172, -177, -184, -185, -178, 290,
+	198, 25, 86, 28, 30, 295, 327, 89, 122, 19,
+	90, 157, 121, 297, 392, 296, 193, 50, 469, 394,
+	396, 395, 385, 383, 334, 338, 340, 337, 384, 358,
+	34, 13, 31, 214, 26, 27, 115, 195, 93, 94,
+	217, 7, 29, 215, 76, 22, 53, 14, 16, 470,
+	17, 298, 333, 205, 204, 105, 351, 201, 48, 11,
+	8, 124, 32, 102, 335, 44, 82, 418, 46, 103,
+	20, 386, 387, 35, 350, 419, 221, 117, 299, 300,
+	51, 87, 341, 74, 54, 83, 18, 49, 104, 196,
+	391, 47, 339, 303, 305, 416, 304, 199, 369, 9,
+	294, 393, 24, 213, 45, 200, 359, 92, 203, 75,
+	220, 153, 6, 81, 12, 52, 55, 388, 389, 390,
+	37, 91, 15, 306, 423, 342, 352, 353, 354, 355,
+	356, 357, 182, 183, 184, 185, 186, 262, 208, 206,
+	210, 211, 472, 473, 187, 188, 285, 287, 160, 190,
+	191, 192, 222, 138, 462, 463, 22, -47, -362, 125,
+	58, -85, -272, 113, 61, 58, -243, 333, 479, 62,
+	59, 58, -272, 203, 64, 64, 58, 21, 125, 58,
+	-76, 30, 31, -98, 205, -98, -368, -246, -247, 339,
+	29, -232, 55, -227, -228, -226, -230, 34, 14, -70,
+	-99, -187, 57, 59, 58, -213, -141, -141, -141, -197,
+	-188, -199, -194, -199, -195, 125, -174, -187, 58, 222,
+	-352, 207, 222, -352, 222, 207, -353, 203, 28, -246,
+	57, 24, -151, -152, -153, -154, 44, 48, 50, 45,
+	46, 47, 51, 24, 58, -379, 28, -150, -183, -189,
+	28, -184, 64, -185, -178, -63, -65, 133, 136, 136,
+	135, -239, 203, 57, 95, -265, -265, -265, 34, -186,
+	54, 58, -141, -68, -69, -71, -213, -213, -213, -213,
+	-187, 64, -187, 113, -207, 87, 288, 74, 75, 76,
+	-208, -225, 25, 272, 273, -218, -219, -213, -155, 26,
+	25, -155, -155, -213, -155, 113, -219, -219, 59, 69,
+	-354, -355, 397, 398, 399, 400, 401, 402, 403, 404,
+	405, 406, 407, 299, 294, 300, 298, 292, 306, 301,
+	302, 156, 414, 415, 408, 409, 410, 411, 412, 413,
+	-155, -155, -155, -209, -155, -155, -213, -369, 286, 285,
+	287, -155, -155, -155, -155, -209, -209, -209, -209, -209,
+	-209, -209, -209, -209, -209, -209, -209, -216, -223, -291,
+	57, 105, 103, 104, 89, -211, -209, -209, 64, 64,
+	59, 58, -357, -356, 91, -273, -213, 57, 57, -354,
+	-218, -213, -218, -218, 59, 59, -219, -219, -218, -209,
+	-167, -218, -155, -141, 33, 58, 57, 59, 58, 37,
+	125, 58, 95, 59, 58, 125, 349, -187, -331, 59,
+	14, 125, 125, -244, 19, 423, -186, -167, 203, -245,
+	-319, 204, 391, -322, 363, -213, -213, -187, -77, -86,
+	87, 57, 202, -251, 423, 341, 340, 336, -248, -249,
+	335, 337, 334, 338, 54, 280, 281, 282, 283, -226,
+	-173, 121, 241, -98, -70, -187, 14, 58, -374, 125,
+	-1, -187, -141, -197, -197, -199, -187, -103, 198, 195,
+	57, -101, -167, -88, -167, -88, 25, -167, -98, -251,
+	59, -254, -253, -167, -72, -72, -72, -72, 44, 44,
+	44, 49, 44, 49, 44, -153, -254, -75, -187, -379,
+	-189, 59, 136, -245, -200, 64, -257, -98, -98, -143,
+	16, 125, -207, 288, 74, -291, 59, 58, -213, -213,
+	-213, 28, -219, 59, 59, 59, 59, 14, -219, -213,
+	-213, 105, -213, -213, 59, 14, 14, -213, -213, -213,
+	-213, -213, -219, -216, -211, -209, -209, -214, 217, 86,
+	-213, -212, -356, 93, -213, 59, 58, 55, -161, -162,
+	222, -161, 59, 14, 59, 59, 59, 59, 55, 59,
+	58, 59, 59, -213, -143, 82, -220, -317, -316, -315,
+	37, -62, -84, -310, -187, -349, -315, -187, -180, -177,
+	-185, -178, 69, -245, 113, 113, 61, -186, 342, -186,
+	-245, -258, 423, 32, -328, 357, 352, 354, 125, 28,
+	29, -93, -94, -95, -101, -96, -202, -97, 208, 206,
+	210, -345, 81, 211, 262, 82, 201, -98, -250, -252,
+	343, 344, 345, 346, 86, -249, 64, 64, 64, 64,
+	-98, -179, 95, -179, -179, -87, 55, 125, -69, -99,
+	-187, 113, 59, -141, -141, -197, -285, -283, 222, -107,
+	-111, -108, -110, -109, -113, -112, 157, 158, 122, 161,
+	163, 164, 165, 166, 167, 168, 169, 170, 171, 173,
+	172, 24, 216, 153, 154, 155, 156, 174, 139, 159,
+	421, 182, 140, 183, 141, 184, 142, 185, 143, 144,
+	186, 145, 148, 149, 150, 147, 203, -204, -205, -203,
+	290, -305, 342, 333, -141, 58, 95, -91, -90, 54,
+	55, -92, 54, -90, 44, 44, -141, -86, -187, 59,
+	-142, 17, 19, -187, 113, -213, 59, 59, 59, -105,
+	-107, 329, 330, 59, -213, -80, 59, 59, -213, 59,
+	59, -213, -213, 14, 59, 59, 59, 59, 59, -214,
+	86, -211, -208, 59, 94, -213, 92, 420, -106, -112,
+	122, 157, 216, 156, 155, 153, 329, 330, -121, 59,
+	-80, 19, 59, -213, -121, -209, 59, -142, 12, 59,
+	58, -310, -186, 19, 28, -246, 313, 200, -299, 474,
+	-326, 352, 19, 19, -62, -98, 59, 58, -97, -187,
+	82, -344, -345, -229, -344, 82, 57, -86, -252, 69,
+	69, 69, 69, -249, 57, -121, -123, -186, 122, 64,
+	-183, -69, -187, -141, -374, 125, -141, 59, 58, 61,
+	-118, -117, -115, 74, 87, 34, 327, -116, 68, 121,
+	255, 233, 256, -137, -201, 206, 81, 82, 315, -202,
+	-298, 330, 329, -292, -294, 57, -293, 57, -293, -292,
+	-292, 57, 57, -292, -295, 57, -295, -292, -296, 57,
+	-296, -297, 57, -296, -167, -203, 291, 35, 124, 293,
+	34, 289, 19, -80, -253, -213, -213, 57, -213, -86,
+	-213, -144, -145, -213, 468, 469, 470, 59, -114, 144,
+	143, -114, 59, -81, 393, 59, 59, 59, -213, -208,
+	-213, 57, 59, -292, -293, -292, -295, -292, -114, -114,
+	59, -163, -164, 463, 264, -219, 59, 22, 59, 59,
+	-254, -315, -186, -186, -258, 314, -98, -128, 475, 64,
+	19, 64, -324, 64, -86, -119, -120, -138, 327, 232,
+	-230, 236, 68, 237, 349, 238, 201, 240, 241, 242,
+	212, 243, 244, 245, 342, 246, 247, 248, 249, 310,
+	6, 276, -95, -341, -342, -187, -342, -187, -341, -341,
+	-229, -213, 28, -234, -236, -167, 57, -141, -141, -283,
+	-286, 37, -115, 74, -209, 64, -123, -124, 34, 254,
+	250, -125, 34, 234, 235, -127, 57, 262, 82, 82,
+	-98, -300, 331, 69, 69, -169, 64, -169, 69, 69,
+	69, 69, -306, -200, -82, -85, 423, 58, 57, 57,
+	471, 59, 64, 59, -209, -165, 89, 464, 467, -166,
+	-206, -213, -320, -278, -170, 476, 69, 64, 354, 28,
+	-274, 222, 58, -138, -179, -179, -173, 121, -179, -179,
+	-179, -179, 239, 239, -179, -179, -179, -179, -179, -179,
+	-179, -179, -179, -179, -179, -179, -179, -179, 57, 57,
+	55, 271, 57, 57, 57, -342, 59, -62, 59, 58,
+	-292, -213, -80, -290, -287, 57, -138, 224, 105, 69,
+	57, -235, 57, 59, 59, 59, 58, 59, 59, 59,
+	58, 59, 58, 292, 59, 468, -145, -219, -219, 57,
+	-131, 105, 423, -165, 465, 466, 472, 465, 466, 59,
+	-327, 357, -323, -321, 352, 353, 354, 355, 57, 19,
+	-62, 19, -138, 69, 69, -179, -179, 69, 64, 64,
+	64, -179, -179, 69, 64, -189, 69, 69, 69, 69,
+	34, 64, -126, 34, 250, 254, 251, 252, 253, 69,
+	34, 69, 34, 69, 34, -187, 57, -346, -347, 64,
+	-234, -343, 280, 281, 282, 284, 283, -343, -234, -234,
+	-234, 57, -260, -259, 263, 87, -237, -236, -76, 59,
+	-82, -290, -138, -289, -288, 269, 225, 57, 59, -213,
+	-130, -129, 419, -234, 64, 69, 69, 59, 59, -147,
+	-146, -213, 57, 59, 51, 421, 424, 86, -329, 204,
+	-325, 356, -321, 19, 354, 19, 19, -171, -187, -324,
+	-275, 264, 265, -276, -282, 267, -121, -121, 64, 64,
+	-122, 233, -100, 59, 58, 95, 59, 59, 59, 59,
+	-234, 263, -238, 212, 68, 423, 278, 279, -76, 59,
+	58, 61, 57, 221, -219, 59, -135, -136, -133, -134,
+	54, 361, 260, 261, 59, 59, 59, 59, 58, 59,
+	422, 41, 425, -165, -336, 57, 69, -326, 19, -324,
+	19, -324, -324, 59, 58, -280, 268, 57, -278, 57,
+	-278, 82, 281, 234, 235, 59, -347, 64, -237, -237,
+	-237, -237, 59, -179, 64, 277, -288, -287, -219, 221,
+	59, -259, -134, 54, -133, 54, 13, 12, -146, 41,
+	-340, 24, 59, -335, -334, -168, -330, -187, 357, 64,
+	-324, -187, -277, 269, 69, -209, 57, -209, 57, -279,
+	266, 57, -137, 69, -185, 59, 59, -132, 257, 258,
+	24, 135, -132, 423, -339, -338, -337, 59, 58, 125,
+	-284, 57, 19, 59, -273, 59, -273, 57, 95, -209,
+	74, 34, 259, 424, 58, 95, -334, -187, -285, -276,
+	59, 59, -273, 59, 69, 59, 425, -338, 34, -213,
+	125, 59, -281, 270, 59, -187, 69,
 }
 
 var yyDef = [...]int{
 	23, -2, 1, 2, 4, 5, 6, 7, 8, 9,
 	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-	20, 21, 22, 502, 503, 504, 0, 317, 318, 0,
-	304, 0, 0, 0, 0, 254, 255, 256, 257, 258,
-	259, 260, 261, 262, 263, 264, 236, 0, 185, 184,
-	164, 165, 166, 124, 125, 126, 127, 0, 0, 0,
-	387, -2, 505, 506, 507, 508, -2, 330, 0, 305,
-	306, 307, 308, 309, 310, 225, 226, 227, 222, 223,
-	224, -2, 0, 330, 177, 0, 169, 169, 0, 407,
-	0, 0, 418, 431, 23, 365, 0, 370, 682, 718,
-	719, 720, 1416, 1417, 1418, 1419, 1420, 1421, 1422, 1423,
-	1424, 1425, 1426, 1427, 1428, 1429, 1430, 1431, 1432, 1433,
-	1434, 1435, 1436, 1437, 1438, 1439, 1440, 1441, 1442, 1443,
-	1444, 1445, 1446, 1447, 1448, 1449, 1450, 1451, 1244, 1245,
-	1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255,
-	1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265,
-	1266, 1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275,
-	1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285,
-	1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295,
-	1296, 1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305,
-	1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315,
-	1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325,
-	1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334, 1335,
-	1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344, 1345,
-	1346, 1347, 1348, 1349, 1350, 1351, 1352, 1353, 1354, 1355,
-	1356, 1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365,
-	1366, 1367, 1368, 1369, 1370, 1371, 1372, 1373, 1374, 1375,
-	1376, 1377, 1378, 1379, 1380, 1381, 1382, 1383, 1384, 1385,
-	1386, 1387, 1388, 1389, 1390, 1391, 1392, 1393, 1394, 1395,
-	1396, 1397, 1398, 1399, 1400, 1401, 1402, 1403, 1404, 1405,
-	186, 319, 320, 407, 407, 208, 0, 1286, 212, 0,
-	0, 0, 301, 204, 205, 206, 207, 0, 494, 0,
-	201, 0, 183, 0, 0, 85, 550, 87, 552, 0,
-	91, 93, 94, -2, 98, 99, 100, 101, 102, 103,
-	104, 0, 106, 1294, 108, 1354, 111, 112, 113, 0,
-	122, 123, -2, -2, 547, 0, 0, 1342, 67, -2,
-	0, 0, 0, 423, 511, 581, 581, 0, 581, 594,
-	558, 559, 560, 579, 580, 335, 331, 332, 333, 334,
-	395, 0, 397, 400, 285, 285, 0, 0, 276, 277,
-	0, 294, 285, 285, 294, 0, 1083, 0, 0, 268,
-	269, 270, 274, 275, 295, 239, 337, 178, 179, 168,
-	0, 173, 0, 167, 1344, 0, 138, 0, 143, 0,
-	1293, 1358, 1308, 0, 0, 1326, 0, 162, -2, 402,
-	0, 408, 0, 407, 0, 239, 239, 239, 239, 239,
-	0, 0, 0, 432, 433, 428, 429, 430, 434, 435,
-	3, 0, 0, 369, 0, 402, -2, 0, 209, 721,
-	0, 0, 213, 214, 0, 0, 228, 0, 231, 217,
-	218, 219, 0, 0, 203, 0, 0, 81, 0, 0,
-	0, 0, 0, 554, 92, 121, 95, 96, 0, 116,
-	118, 120, 119, 105, 117, 107, 109, 110, 115, 81,
-	0, 0, 0, 68, 390, 0, 371, 0, 420, 422,
-	0, 424, 425, 0, 581, 512, 0, 0, 0, 0,
-	0, 337, 336, 0, 396, 0, 0, 440, 0, 440,
-	0, 290, 291, 285, 285, 278, 287, 0, 292, 293,
-	0, 440, 440, 0, 0, 581, 1084, 1085, 0, 0,
-	0, 338, 0, 0, 171, 0, 176, 128, 133, 131,
-	132, 134, 0, 0, 0, 0, 0, 160, 161, 0,
-	0, 0, 0, 0, 151, 154, 676, 677, 548, 155,
-	156, 0, 371, 403, 419, 421, 416, 417, 0, 0,
-	0, 0, 0, 316, 448, 442, 444, 489, 31, 0,
-	973, 718, 978, -2, 1417, 1418, 1419, 1420, 1421, 1422,
-	1423, -2, 1425, -2, -2, 1428, 1429, 1430, 1432, -2,
-	-2, -2, -2, -2, -2, 1439, -2, -2, -2, 1443,
-	1444, -2, -2, 1447, 1448, 1449, 1450, 1451, -2, -2,
-	-2, -2, -2, -2, -2, -2, 989, 789, 790, 791,
-	792, 0, 0, 0, 0, 0, 799, 800, 0, 812,
-	0, 806, 807, 808, 809, 43, 44, 1008, 1009, 1010,
-	1011, 1012, 1013, 1014, 1015, 1016, 1017, 936, 776, 0,
-	0, 0, 921, 0, 911, 0, 949, 0, 0, 0,
-	0, 0, 45, 46, 927, 929, 930, 932, 933, 934,
-	935, 938, 939, 940, 943, 944, 945, 946, 947, 948,
-	951, 953, 923, 924, 925, 926, 915, 916, 917, 918,
-	919, 920, 448, 339, 357, 341, 0, 346, 0, 683,
-	371, 393, 371, 0, 210, 0, 215, 0, 0, 230,
-	232, 233, 234, 220, 221, 302, 199, 200, 192, 193,
-	194, 195, 196, 197, 198, 202, 41, 0, 0, 82,
-	83, 84, 86, 97, 0, 0, 75, 535, 541, 538,
-	551, 0, 89, 553, 114, 0, 70, 0, 0, 0,
-	391, 404, 31, 409, 410, 413, 0, 0, 522, 0,
-	549, 573, 499, 500, 501, -2, 0, 398, 0, 0,
-	476, 265, 0, 286, 266, 440, 440, 440, 285, 0,
-	287, 0, 287, 281, 282, 0, 296, 298, 0, 522,
-	1385, 240, 453, 0, 454, 455, 451, 478, 481, 484,
-	0, 180, 181, 0, 0, 175, 0, 0, 135, 136,
-	137, 144, 139, 141, 0, 0, 145, 157, 158, 159,
-	363, 364, 0, 0, 0, 149, 150, 0, 163, 389,
-	311, 312, 0, 314, 680, 315, 495, 496, 440, 0,
-	449, 0, 445, 0, 0, 0, 0, 490, 0, 492,
-	0, 968, 0, 0, 997, 998, 999, 1000, 1001, 1002,
-	1003, 960, 956, 956, 956, 0, 956, 0, 0, 896,
-	0, 0, 956, 956, 956, 956, 956, 897, 0, 956,
-	956, 956, 956, 910, 904, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, -2, 962, 0, 795, 796, 797, 798, 801,
-	0, 813, 0, 0, 960, 960, 960, 899, 0, 0,
-	901, 912, 0, 905, 960, 0, 960, 909, 956, 440,
-	340, 354, 0, 358, 0, 0, 350, 352, 345, 347,
-	0, 0, 367, 392, 394, 722, 0, 1090, -2, 1092,
-	-2, -2, 1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101,
-	1102, 1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111,
-	1112, 1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121,
-	1122, 1123, 1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131,
-	1132, 1133, 1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141,
-	1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151,
-	1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161,
-	1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171,
-	1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181,
-	1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191,
-	1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201,
-	1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211,
-	1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221,
-	1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231,
-	1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241,
-	1242, 1243, 0, 216, 229, 0, 0, 0, 0, 76,
-	80, 0, 537, 0, 0, 540, 88, 0, 0, 0,
-	64, 373, 0, 0, 0, 0, 412, 414, 415, 400,
-	0, 0, 514, 523, 0, 582, 0, 0, 578, -2,
-	585, 0, 591, 0, 0, 324, 328, 23, 401, 0,
-	441, 267, 271, 272, 440, 288, 285, 289, 285, 287,
-	0, 297, 300, 514, 0, 0, 0, 0, 0, 0,
-	469, 0, 0, 468, 0, 0, 0, 0, 0, 0,
-	482, 483, 485, 0, 487, 488, 497, 498, 0, 407,
-	182, 170, 172, 0, 130, 0, 0, 0, 146, 147,
-	148, 152, 153, 0, 0, 438, 443, 450, 453, 964,
-	965, 966, 967, 491, 493, 32, 446, 969, 0, 971,
-	974, 979, 980, 976, 0, 994, -2, -2, 0, 961,
-	962, 0, 957, 958, 0, 0, 0, 0, 0, 0,
-	0, 913, 0, 0, 867, 868, 869, 870, 871, 872,
-	873, 874, 875, 876, 877, 878, 879, 880, 881, 882,
-	883, 884, 885, 886, 887, 888, 889, 890, 891, 892,
-	893, 894, 895, 0, 0, 0, 0, 0, 0, 0,
-	862, 863, 864, 0, 0, 0, 0, 777, 778, 779,
-	780, 781, 782, 783, 784, 785, 786, 787, 788, 981,
-	992, 993, 0, 0, 0, 0, 0, 990, 985, 0,
-	793, 0, 810, 814, 0, 0, 955, 0, 962, 0,
-	0, 922, 900, 0, 0, 0, 0, 0, 438, 357,
-	359, 0, 0, 357, 0, 0, 0, 366, 0, 0,
-	0, 303, 42, 235, 0, 0, 0, 536, 0, 0,
-	539, 90, 0, 72, 0, 65, 66, 377, 0, 405,
-	406, 32, 411, 0, 0, 684, 513, 0, 524, 525,
-	526, 527, 528, 0, 0, 0, 0, 0, 574, 575,
-	576, 577, 586, 1086, 1086, 1086, 365, 0, -2, 0,
-	0, 326, 0, 0, 477, 279, 440, 440, 285, 299,
-	241, 0, 440, 189, 0, 472, 466, 0, 459, 470,
-	471, 462, 0, 464, 0, 460, 461, 440, 452, 400,
-	486, 480, 174, 129, 0, 253, 140, 313, 681, 436,
-	0, 0, 970, 972, 975, 977, 850, 0, 0, 0,
-	0, 0, 0, 839, 833, 834, 914, 0, 0, 0,
-	0, 0, 0, 858, 0, 0, 0, 0, 0, 0,
-	0, 0, 982, 990, 986, 0, 983, 0, 0, 963,
-	0, 815, 0, 0, 0, 0, 851, 0, 855, 898,
-	902, 0, 906, 0, 908, 0, 436, 355, 0, 360,
-	361, 357, 344, 351, 343, 353, 348, 349, 368, 723,
-	1091, 1088, 1089, 211, 74, 77, 78, 79, 542, 0,
-	543, 522, 71, 0, 0, 379, 53, 0, 0, 0,
-	0, 0, 0, 685, 686, 688, 689, 0, 0, 691,
-	745, 0, 700, 581, 700, 0, 0, 702, 703, 515,
-	516, 0, 0, 0, 0, 0, 530, 531, 532, 533,
-	534, 0, 0, 1087, 0, 0, 484, 0, 0, 440,
-	325, 328, 329, 399, 283, 280, 440, 237, 242, 243,
-	0, 247, 0, 0, 407, 0, 0, 456, 473, 0,
-	0, 457, 0, 458, 463, 465, 188, 479, 142, 426,
-	0, 0, 33, 447, 963, 835, 836, 837, 0, 817,
-	829, 829, 1078, 1019, 1020, 1021, 1065, 1023, 1069, 1069,
-	1065, 1065, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 1058,
-	1059, 0, 0, 1042, 1065, 1067, 1067, 1065, 1062, 1024,
-	1025, 1026, 1027, 1028, 1029, 1030, 1031, 1032, 1033, 1034,
-	1035, 1036, 1037, 1072, 1072, 1075, 1072, 838, 0, 857,
-	840, 841, 844, 842, 0, 0, 0, 856, 845, 846,
-	832, 959, 984, 0, 991, 987, 794, 802, 811, 0,
-	0, 820, 1065, 1069, 823, 1065, 1067, 1065, 829, 829,
-	0, 0, 0, 0, 843, 427, 356, 0, 342, 546,
-	0, 0, 72, 0, 0, 381, 0, 378, 0, 0,
-	0, 509, 400, -2, 0, -2, 690, 746, 712, 712,
-	701, 712, 712, 581, 0, 517, 518, 519, 520, 521,
-	529, 0, 587, 588, 678, 679, 589, 440, 440, -2,
-	322, 327, 0, 284, 244, 245, 246, 0, 249, 250,
-	252, 0, 402, 190, 191, 474, 0, 467, 437, 439,
-	803, 818, 830, 831, 819, 1081, 1079, 1080, 1022, 1066,
-	0, 1047, 0, 1048, 1049, 1050, 0, 0, 1043, 1044,
-	0, 1045, 1046, 1038, 0, 1039, 1040, 0, 1041, 854,
-	859, 860, 0, 988, 816, 804, 821, 822, 824, 825,
-	826, 827, 828, 805, 852, 0, 903, 907, 362, 544,
-	545, 69, 73, 55, 383, 0, 380, 0, 374, 376,
-	63, 0, 595, -2, 632, 1086, 1086, 0, 1086, 1086,
-	1086, 1086, 0, 0, 1086, 1086, 1086, 1086, 1086, 1086,
-	1086, 1086, 1086, 1086, 1086, 1086, 1086, 1086, 0, 687,
-	714, -2, 726, 728, 0, 0, 731, 732, 0, 0,
-	0, 0, 768, 738, 0, 0, 1006, 1007, 0, 744,
-	0, 704, 713, 0, 713, 0, 0, 712, 0, 0,
-	569, 1065, 0, 407, 323, 0, 251, 238, 187, 0,
-	1018, 1082, 0, 0, 0, 1063, 0, 0, 0, 0,
-	861, 0, 49, 0, 372, 0, 382, 54, 0, 0,
-	592, 0, 590, 634, 0, 0, 1086, 1086, 0, 0,
-	0, 0, 1086, 1086, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 727,
-	729, 730, 733, 734, 735, 773, 774, 775, 736, 770,
-	771, 772, 737, 0, 0, 1004, 1005, 766, 0, 0,
-	0, 0, 0, 0, 0, 698, 562, 0, 413, 0,
-	402, 248, 475, 1071, 1070, 1060, 0, 1061, 1068, 1073,
-	0, 1076, 0, 853, 47, 51, 56, 57, 0, 0,
-	0, 0, 0, 0, 510, 628, 633, 635, 636, 0,
-	0, 639, 640, 641, 642, 0, 0, 645, 646, 647,
-	648, 649, 650, 651, 652, 653, 654, 670, 671, 672,
-	673, 674, 675, 655, 656, 657, 658, 659, 660, 667,
-	0, 0, 664, 0, 0, 0, 761, 0, 0, 705,
-	707, 708, 709, 710, 711, 706, 0, 0, 0, 0,
-	697, 699, 741, 0, 561, 570, 571, 413, 321, 1064,
-	0, 0, 34, 0, 53, 0, 58, 0, 0, 0,
-	0, 0, 385, 375, 617, 0, 0, 623, 0, 629,
-	637, 638, 643, 644, 661, 0, 0, 663, 0, 0,
-	769, 0, 748, 762, 0, 0, 562, 562, 562, 562,
-	0, 742, 563, 1086, 0, 0, 567, 568, 572, 1074,
-	1077, 25, 0, 0, 50, 0, 59, 0, 61, 62,
-	384, 0, 597, 0, 0, 0, 0, 0, 626, 0,
-	668, 669, 662, 665, 666, 739, 747, 749, 750, 751,
-	0, 763, 764, 765, 767, 692, 693, 694, 695, 0,
-	0, 565, 0, 24, 0, 35, 0, 37, 39, 40,
-	715, 48, 52, 60, 386, 599, 0, 618, 0, 0,
-	0, 0, 0, 0, 0, 740, 752, 0, 753, 0,
-	0, 0, 696, 564, 566, 26, 27, 0, 36, 0,
-	0, 596, 0, 628, 619, 0, 621, 0, 0, 0,
-	0, 754, 756, 757, 0, 0, 755, 0, 0, 38,
-	716, 0, 601, 0, 615, 620, 622, 0, 627, 625,
-	758, 760, 759, 28, 29, 30, 0, 600, 0, 613,
-	598, 0, 624, 717, 602, -2, 0, 616, 603, -2,
-	0, 611, 0, 604, 612, 0, 607, 0, 0, 606,
-	0, -2, 0, 608, -2, 0, 614,
+	20, 21, 22, 550, 551, 552, 0, 343, 344, 0,
+	328, 0, 0, 0, 0, 277, 278, 279, 280, 281,
+	282, 283, 284, 285, 286, 287, 241, 242, 0, 190,
+	189, 169, 170, 171, 129, 130, 131, 132, 0, 0,
+	0, 415, -2, 553, 554, 555, 556, -2, 356, 0,
+	329, 330, 331, 332, 333, 334, 335, 230, 231, 232,
+	227, 228, 229, -2, 0, 356, 182, 0, 174, 174,
+	0, 437, 0, 0, 448, 467, 23, 393, 0, 398,
+	735, 771, 772, 773, 1506, 1507, 1508, 1509, 1510, 1511,
+	1512, 1513, 1514, 1515, 1516, 1517, 1518, 1519, 1520, 1521,
+	1522, 1523, 1524, 1525, 1526, 1527, 1528, 1529, 1530, 1531,
+	1532, 1533, 1534, 1535, 1536, 1537, 1538, 1539, 1540, 1541,
+	1329, 1330, 1331, 1332, 1333, 1334, 1335, 1336, 1337, 1338,
+	1339, 1340, 1341, 1342, 1343, 1344, 1345, 1346, 1347, 1348,
+	1349, 1350, 1351, 1352, 1353, 1354, 1355, 1356, 1357, 1358,
+	1359, 1360, 1361, 1362, 1363, 1364, 1365, 1366, 1367, 1368,
+	1369, 1370, 1371, 1372, 1373, 1374, 1375, 1376, 1377, 1378,
+	1379, 1380, 1381, 1382, 1383, 1384, 1385, 1386, 1387, 1388,
+	1389, 1390, 1391, 1392, 1393, 1394, 1395, 1396, 1397, 1398,
+	1399, 1400, 1401, 1402, 1403, 1404, 1405, 1406, 1407, 1408,
+	1409, 1410, 1411, 1412, 1413, 1414, 1415, 1416, 1417, 1418,
+	1419, 1420, 1421, 1422, 1423, 1424, 1425, 1426, 1427, 1428,
+	1429, 1430, 1431, 1432, 1433, 1434, 1435, 1436, 1437, 1438,
+	1439, 1440, 1441, 1442, 1443, 1444, 1445, 1446, 1447, 1448,
+	1449, 1450, 1451, 1452, 1453, 1454, 1455, 1456, 1457, 1458,
+	1459, 1460, 1461, 1462, 1463, 1464, 1465, 1466, 1467, 1468,
+	1469, 1470, 1471, 1472, 1473, 1474, 1475, 1476, 1477, 1478,
+	1479, 1480, 1481, 1482, 1483, 1484, 1485, 1486, 1487, 1488,
+	1489, 1490, 1491, 1492, 1493, 1494, 1495, 1496, 191, 345,
+	346, 437, 437, 213, 0, 1370, 217, 0, 0, 0,
+	325, 209, 210, 211, 212, 0, 542, 0, 206, 0,
+	188, 0, 0, 85, 599, 87, 601, 0, 96, 98,
+	99, -2, 103, 104, 105, 106, 107, 108, 109, 0,
+	111, 1378, 113, 1442, 116, 117, 118, 0, 127, 128,
+	-2, -2, 596, 0, 0, 1426, 67, -2, 0, 0,
+	0, 453, 456, 459, 560, 0, 630, 630, 0, 630,
+	643, 607, 608, 609, 628, 629, 361, 357, 358, 359,
+	360, 423, 0, 425, 428, 308, 308, 0, 0, 299,
+	300, 0, 317, 308, 308, 317, 0, 1161, 0, 0,
+	291, 292, 293, 297, 298, 318, 0, 262, 363, 183,
+	184, 173, 0, 178, 0, 172, 1428, 0, 143, 0,
+	148, 0, 1377, 1447, 1392, 0, 0, 1410, 0, 167,
+	-2, 430, 0, 438, 0, 437, 0, 262, 262, 262,
+	262, 262, 262, 0, 0, 0, 468, 469, 464, 465,
+	466, 470, 471, 3, 0, 0, 397, 0, 430, -2,
+	0, 214, 774, 0, 0, 218, 219, 0, 0, 233,
+	0, 236, 222, 223, 224, 0, 0, 208, 0, 0,
+	81, 0, 0, 0, 0, 0, 603, 97, 126, 100,
+	101, 0, 121, 123, 125, 124, 110, 122, 112, 114,
+	115, 120, 81, 0, 0, 0, 68, 418, 0, 399,
+	0, 450, 452, 0, 454, 455, 457, 458, 460, 461,
+	0, 630, 561, 642, 0, 0, 0, 0, 0, 363,
+	362, 0, 424, 0, 0, 487, 0, 487, 0, 313,
+	314, 308, 308, 301, 310, 0, 315, 316, 0, 487,
+	487, 0, 0, 0, 630, 1162, 1163, 0, 0, 0,
+	0, 364, 0, 0, 176, 0, 181, 133, 138, 136,
+	137, 139, 0, 0, 0, 0, 0, 165, 166, 0,
+	0, 0, 0, 0, 156, 159, 729, 730, 597, 160,
+	161, 0, 399, 431, 449, 451, 446, 447, 0, 0,
+	0, 0, 0, 0, 342, 495, 489, 491, 537, 31,
+	0, 1050, 771, 1055, -2, 1507, 1508, 1509, 1510, 1511,
+	1512, 1513, -2, 1515, -2, -2, 1518, 1519, 1520, 1522,
+	-2, 1524, -2, -2, -2, -2, 1529, -2, -2, -2,
+	1533, 1534, -2, -2, 1537, 1538, 1539, 1540, 1541, -2,
+	-2, -2, -2, -2, -2, -2, -2, -2, 1066, 842,
+	843, 846, 847, 0, 0, 0, 0, 0, 854, 855,
+	0, 869, 0, 0, 862, 863, 864, 865, 866, 43,
+	44, 1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092, 1093,
+	1094, 1012, 829, 0, 0, 0, 997, 0, 987, 0,
+	0, 1025, 0, 0, 0, 0, 0, 0, 45, 46,
+	1003, 1005, 1006, 1008, 1009, 1010, 1011, 1014, 1015, 1016,
+	1019, 1020, 1021, 1022, 1023, 1024, 1027, 1030, 999, 1000,
+	1001, 1002, 991, 992, 993, 994, 995, 996, 495, 366,
+	385, 369, 0, 374, 0, 736, 399, 421, 399, 0,
+	215, 0, 220, 0, 0, 235, 237, 238, 239, 225,
+	226, 326, 204, 205, 197, 198, 199, 200, 201, 202,
+	203, 207, 41, 0, 0, 82, 83, 84, 86, 102,
+	0, 0, 75, 584, 590, 587, 600, 0, 94, 602,
+	119, 0, 70, 0, 0, 0, 419, 432, 31, 439,
+	440, 443, 0, 0, 560, 571, 0, 598, 622, 547,
+	548, 549, -2, 0, 426, 0, 0, 523, 288, 0,
+	309, 289, 487, 487, 487, 308, 0, 310, 0, 310,
+	304, 305, 0, 319, 322, 320, 0, 243, 244, 249,
+	255, 255, 0, 257, 571, 1475, 263, 500, 0, 501,
+	502, 498, 525, 529, 0, 532, 0, 185, 186, 0,
+	0, 180, 0, 0, 140, 141, 142, 149, 144, 146,
+	0, 0, 150, 162, 163, 164, 391, 392, 0, 0,
+	0, 154, 155, 0, 168, 417, 336, 337, 0, 339,
+	733, 340, 341, 543, 544, 487, 0, 496, 0, 492,
+	0, 0, 0, 0, 538, 0, 540, 0, 1045, 0,
+	0, 1074, 1075, 1076, 1077, 1078, 1079, 1080, 1037, 1033,
+	1033, 1033, 0, 1033, 0, 0, 970, 0, 0, 1033,
+	1033, 1033, 0, 1033, 1033, 971, 0, 1033, 1033, 1033,
+	1033, 986, 979, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, -2, 1039, 0, 850, 851, 852, 853, 856,
+	0, 870, 0, 0, 0, 0, 0, 1037, 1037, 1037,
+	973, 0, 0, 975, 988, 0, 0, 980, 1037, 0,
+	0, 1037, 985, 1033, 487, 365, 0, 368, 382, 0,
+	386, 0, 0, 378, 380, 373, 375, 0, 0, 395,
+	420, 422, 775, 0, 1168, -2, 1170, -2, -2, 1172,
+	1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182,
+	1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192,
+	1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202,
+	1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212,
+	1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222,
+	1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232,
+	1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242,
+	1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252,
+	1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262,
+	1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272,
+	1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282,
+	1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292,
+	1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302,
+	1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312,
+	1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322,
+	1323, 1324, 1325, 1326, 1327, 1328, 0, 221, 234, 0,
+	0, 0, 0, 76, 80, 0, 586, 0, 0, 589,
+	88, 0, 0, 0, 64, 401, 0, 0, 0, 0,
+	442, 444, 445, 428, 0, 0, 0, 563, 572, 0,
+	631, 0, 0, 627, -2, 634, 0, 640, 0, 0,
+	350, 354, 23, 429, 0, 488, 290, 294, 295, 487,
+	311, 308, 312, 308, 310, 0, 321, 324, 0, 0,
+	0, 256, 0, 0, 0, 0, 0, 258, 259, 563,
+	0, 0, 0, 0, 0, 0, 516, 0, 0, 515,
+	0, 0, 0, 0, 0, 0, 530, 529, 531, 533,
+	0, 535, 536, 545, 546, 0, 437, 187, 175, 177,
+	0, 135, 0, 0, 0, 151, 152, 153, 157, 158,
+	0, 0, 474, 490, 497, 500, 1041, 1042, 1043, 1044,
+	539, 541, 32, 493, 1046, 0, 1048, 1051, 1056, 1057,
+	1053, 0, 1071, -2, -2, 0, 1038, 1039, 0, 1034,
+	1035, 0, 0, 0, 0, 0, 0, 0, 989, 0,
+	0, 941, 942, 943, 944, 945, 946, 947, 948, 949,
+	950, 951, 952, 953, 954, 955, 956, 957, 958, 959,
+	960, 961, 962, 963, 964, 965, 966, 967, 968, 969,
+	0, 0, 0, 0, 0, 0, 0, 0, 936, 937,
+	938, 0, 0, 0, 0, 830, 831, 832, 833, 834,
+	835, 836, 837, 838, 839, 840, 841, 1058, 1069, 1070,
+	0, 0, 0, 0, 0, 1067, 1062, 0, 844, 845,
+	848, 0, 867, 871, 0, 0, 0, 907, 907, 1032,
+	0, 1039, 0, 0, 998, 974, 0, 0, 0, 0,
+	0, 0, 0, 474, 0, 385, 387, 0, 0, 385,
+	0, 0, 0, 394, 0, 0, 0, 327, 42, 240,
+	0, 0, 0, 585, 0, 0, 588, 95, 0, 72,
+	0, 65, 66, 405, 0, 433, 434, 32, 441, 0,
+	0, 737, 0, 562, 0, 573, 574, 575, 576, 577,
+	0, 0, 0, 0, 0, 623, 624, 625, 626, 635,
+	1164, 1164, 1164, 393, 0, -2, 0, 0, 352, 0,
+	0, 524, 302, 487, 487, 308, 323, 250, 255, 255,
+	0, 251, 0, 246, 252, 247, 248, 0, 254, 264,
+	0, 487, 194, 0, 519, 513, 0, 506, 517, 518,
+	509, 0, 511, 0, 507, 508, 487, 499, 428, 0,
+	534, 528, 179, 134, 0, 276, 145, 338, 734, 472,
+	0, 0, 1047, 1049, 1052, 1054, 923, 0, 0, 0,
+	0, 0, 0, 896, 890, 891, 990, 0, 437, 0,
+	0, 0, 0, 0, 931, 0, 0, 0, 0, 0,
+	0, 0, 0, 1059, 1067, 1063, 0, 1060, 0, 0,
+	1040, 0, 872, 0, 0, 0, 0, 0, 0, 437,
+	0, 0, 924, 0, 928, 972, 976, 977, 0, 981,
+	0, 983, 984, 0, 472, 0, 383, 0, 388, 389,
+	385, 372, 379, 371, 381, 376, 377, 396, 776, 1169,
+	1166, 1167, 216, 74, 77, 78, 79, 591, 0, 592,
+	571, 71, 0, 0, 407, 53, 0, 0, 0, 0,
+	0, 0, 738, 739, 741, 742, 0, 744, 798, 0,
+	753, 630, 753, 0, 0, 755, 756, 428, 564, 565,
+	0, 0, 0, 0, 0, 579, 580, 581, 582, 583,
+	0, 0, 1165, 0, 0, 532, 0, 0, 487, 351,
+	354, 355, 427, 306, 303, 487, 0, 650, 0, -2,
+	1156, 1096, 1097, 1098, 1143, 1100, 1147, 1147, 1143, 1143,
+	1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137,
+	0, 0, 1119, 1143, 1145, 1145, 1143, 1140, 1101, 1102,
+	1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112,
+	1113, 1114, 1150, 1150, 1153, 1150, 0, 260, 265, 266,
+	0, 270, 0, 0, 437, 0, 0, 503, 520, 0,
+	0, 504, 0, 505, 510, 512, 193, 526, 428, 147,
+	462, 0, 0, 33, 494, 1040, 892, 893, 894, 0,
+	874, 886, 886, 895, 0, 435, 897, 898, 0, 901,
+	899, 0, 0, 0, 929, 902, 903, 889, 1036, 1061,
+	0, 1068, 1064, 849, 857, 868, 0, 0, 0, 877,
+	1143, 1147, 880, 1143, 1145, 1143, 886, 886, 0, 904,
+	909, 0, 905, 0, 0, 0, 900, 463, 0, 384,
+	0, 370, 595, 0, 0, 72, 0, 0, 409, 0,
+	406, 0, 0, 0, 557, 428, -2, 0, 743, 799,
+	765, 765, 754, 765, 765, 630, 0, 0, 566, 567,
+	568, 569, 570, 578, 0, 636, 637, 731, 732, 638,
+	487, 487, -2, 348, 353, 0, 307, 245, 0, 662,
+	767, -2, 779, 781, 0, 0, 784, 785, 0, 0,
+	0, 0, 821, 791, 0, 0, 1083, 1084, 0, 797,
+	1159, 1157, 1158, 1099, 1144, 0, 1124, 0, 1125, 1126,
+	1127, 0, 0, 1120, 1121, 0, 1122, 1123, 1115, 0,
+	1116, 1117, 0, 1118, 253, 267, 268, 269, 0, 272,
+	273, 275, 0, 430, 195, 196, 521, 0, 514, 527,
+	473, 475, 477, 479, 1445, 0, 0, 859, 875, 887,
+	888, 876, 927, 0, 0, 935, 932, 933, 0, 1065,
+	873, 0, 860, 878, 879, 881, 882, 883, 884, 885,
+	861, 906, 0, 912, 913, 908, 925, 0, 978, 982,
+	367, 390, 593, 594, 69, 73, 55, 411, 0, 408,
+	0, 402, 404, 63, 0, 644, -2, 685, 1164, 1164,
+	0, 1164, 1164, 1164, 1164, 0, 0, 1164, 1164, 1164,
+	1164, 1164, 1164, 1164, 1164, 1164, 1164, 1164, 1164, 1164,
+	1164, 0, 740, 0, 757, 766, 0, 766, 0, 0,
+	765, 0, 0, 0, 618, 1143, 0, 437, 349, 651,
+	-2, 0, 780, 782, 783, 786, 787, 788, 826, 827,
+	828, 789, 823, 824, 825, 790, 0, 0, 1081, 1082,
+	819, 1095, 1160, 0, 0, 0, 1141, 0, 0, 0,
+	0, 0, 274, 261, 192, 0, 0, 0, 0, 0,
+	0, 930, 436, 934, 89, 910, 0, 0, 0, 0,
+	919, 0, 49, 0, 400, 0, 410, 54, 0, 0,
+	641, 0, 639, 687, 0, 0, 1164, 1164, 0, 0,
+	0, 0, 1164, 1164, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 751, 559, 611, 0,
+	443, 0, 430, 652, -2, 0, 660, 0, 0, 0,
+	0, 814, 0, 1149, 1148, 1138, 0, 1139, 1146, 1151,
+	0, 1154, 0, 271, 522, 476, 478, 0, 0, 0,
+	0, 0, 0, 0, 914, 915, 916, 917, 918, 926,
+	47, 51, 56, 57, 0, 0, 0, 0, 0, 0,
+	558, 681, 686, 688, 689, 0, 0, 692, 693, 694,
+	695, 0, 0, 698, 699, 700, 701, 702, 703, 704,
+	705, 706, 707, 723, 724, 725, 726, 727, 728, 708,
+	709, 710, 711, 712, 713, 720, 0, 0, 717, 0,
+	0, 758, 760, 761, 762, 763, 764, 759, 0, 0,
+	0, 0, 750, 752, 794, 0, 610, 619, 620, 443,
+	347, 653, 661, 0, 656, 0, 0, 0, 822, 0,
+	801, 815, 0, 0, 1142, 0, 0, 480, 481, 0,
+	483, 485, 0, 858, 0, 0, 0, 0, 34, 0,
+	53, 0, 58, 0, 0, 0, 0, 0, 413, 403,
+	669, 0, 0, 675, 0, 682, 690, 691, 696, 697,
+	714, 0, 0, 716, 0, 0, 611, 611, 611, 611,
+	0, 795, 612, 1164, 0, 0, 616, 617, 621, 655,
+	0, -2, 0, 664, 0, 792, 800, 802, 803, 804,
+	0, 816, 817, 818, 820, 1152, 1155, 482, 0, 486,
+	0, 92, 93, 911, 25, 0, 0, 50, 0, 59,
+	0, 61, 62, 412, 0, 646, 0, 0, 0, 0,
+	0, 679, 0, 721, 722, 715, 718, 719, 745, 746,
+	747, 748, 0, 0, 614, 0, 657, -2, 0, 0,
+	666, 793, 805, 0, 806, 0, 0, 0, 484, 90,
+	24, 0, 35, 0, 37, 39, 40, 768, 48, 52,
+	60, 414, 648, 0, 670, 0, 0, 0, 0, 0,
+	0, 0, 749, 613, 615, 663, 665, 807, 809, 810,
+	0, 0, 808, 0, 26, 27, 0, 36, 0, 0,
+	645, 0, 681, 671, 0, 673, 0, 0, 0, 0,
+	811, 813, 812, 0, 0, 0, 38, 769, 0, 667,
+	672, 674, 0, 677, 680, 678, 91, 28, 29, 30,
+	0, 649, 647, 0, 676, 770, 668,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 84, 3, 3, 3, 112, 104, 3,
-	55, 57, 109, 107, 56, 108, 121, 110, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 463,
-	92, 91, 93, 3, 464, 3, 3, 3, 3, 3,
+	3, 3, 3, 88, 3, 3, 3, 116, 108, 3,
+	57, 59, 113, 111, 58, 112, 125, 114, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 478,
+	96, 95, 97, 3, 479, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 114, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 118, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 103, 3, 115,
+	3, 3, 3, 3, 107, 3, 119,
 }
 
 var yyTok2 = [...]int{
@@ -5107,12 +5598,12 @@ var yyTok2 = [...]int{
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 54, 58, 59, 60, 61, 62, 63, 64,
+	52, 53, 54, 55, 56, 60, 61, 62, 63, 64,
 	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
-	75, 76, 77, 78, 79, 80, 81, 82, 83, 85,
-	86, 87, 88, 89, 90, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 105, 106, 111, 113, 116, 117,
-	118, 119, 120, 122, 123, 124, 125, 126, 127, 128,
+	75, 76, 77, 78, 79, 80, 81, 82, 83, 84,
+	85, 86, 87, 89, 90, 91, 92, 93, 94, 98,
+	99, 100, 101, 102, 103, 104, 105, 106, 109, 110,
+	115, 117, 120, 121, 122, 123, 124, 126, 127, 128,
 	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
@@ -5168,7 +5659,10 @@ var yyTok3 = [...]int{
 	57770, 445, 57771, 446, 57772, 447, 57773, 448, 57774, 449,
 	57775, 450, 57776, 451, 57777, 452, 57778, 453, 57779, 454,
 	57780, 455, 57781, 456, 57782, 457, 57783, 458, 57784, 459,
-	57785, 460, 57786, 461, 57787, 462, 0,
+	57785, 460, 57786, 461, 57787, 462, 57788, 463, 57789, 464,
+	57790, 465, 57791, 466, 57792, 467, 57793, 468, 57794, 469,
+	57795, 470, 57796, 471, 57797, 472, 57798, 473, 57799, 474,
+	57800, 475, 57801, 476, 57802, 477, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -5518,7 +6012,7 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:514
+//line mysql_sql.y:549
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -5526,7 +6020,7 @@ yydefault:
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:520
+//line mysql_sql.y:555
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
@@ -5535,7 +6029,7 @@ yydefault:
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:546
+//line mysql_sql.y:581
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
@@ -5543,7 +6037,7 @@ yydefault:
 	case 23:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:550
+//line mysql_sql.y:585
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
@@ -5551,7 +6045,7 @@ yydefault:
 	case 24:
 		yyDollar = yyS[yypt-14 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:556
+//line mysql_sql.y:591
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
@@ -5569,7 +6063,7 @@ yydefault:
 	case 25:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:571
+//line mysql_sql.y:606
 		{
 			yyLOCAL = nil
 		}
@@ -5577,7 +6071,7 @@ yydefault:
 	case 26:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:575
+//line mysql_sql.y:610
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
@@ -5585,7 +6079,7 @@ yydefault:
 	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:581
+//line mysql_sql.y:616
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
@@ -5593,7 +6087,7 @@ yydefault:
 	case 28:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:585
+//line mysql_sql.y:620
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
@@ -5601,7 +6095,7 @@ yydefault:
 	case 29:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:591
+//line mysql_sql.y:626
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -5612,7 +6106,7 @@ yydefault:
 	case 30:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:598
+//line mysql_sql.y:633
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -5623,7 +6117,7 @@ yydefault:
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:607
+//line mysql_sql.y:642
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
@@ -5631,7 +6125,7 @@ yydefault:
 	case 32:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:611
+//line mysql_sql.y:646
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
@@ -5639,7 +6133,7 @@ yydefault:
 	case 33:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:615
+//line mysql_sql.y:650
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
@@ -5647,7 +6141,7 @@ yydefault:
 	case 34:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:620
+//line mysql_sql.y:655
 		{
 			yyLOCAL = nil
 		}
@@ -5655,7 +6149,7 @@ yydefault:
 	case 35:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:624
+//line mysql_sql.y:659
 		{
 			yyLOCAL = nil
 		}
@@ -5663,7 +6157,7 @@ yydefault:
 	case 36:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:628
+//line mysql_sql.y:663
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
@@ -5671,7 +6165,7 @@ yydefault:
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:634
+//line mysql_sql.y:669
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -5684,7 +6178,7 @@ yydefault:
 	case 38:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:643
+//line mysql_sql.y:678
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -5697,7 +6191,7 @@ yydefault:
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:654
+//line mysql_sql.y:689
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
@@ -5705,7 +6199,7 @@ yydefault:
 	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:658
+//line mysql_sql.y:693
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
@@ -5713,7 +6207,7 @@ yydefault:
 	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:664
+//line mysql_sql.y:699
 		{
 			yyLOCAL = []*tree.VarExpr{yyDollar[1].varExprUnion()}
 		}
@@ -5721,7 +6215,7 @@ yydefault:
 	case 42:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:668
+//line mysql_sql.y:703
 		{
 			yyLOCAL = append(yyDollar[1].varExprsUnion(), yyDollar[3].varExprUnion())
 		}
@@ -5729,7 +6223,7 @@ yydefault:
 	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:674
+//line mysql_sql.y:709
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
@@ -5737,7 +6231,7 @@ yydefault:
 	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:678
+//line mysql_sql.y:713
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
@@ -5745,7 +6239,7 @@ yydefault:
 	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:684
+//line mysql_sql.y:719
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -5771,7 +6265,7 @@ yydefault:
 	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:708
+//line mysql_sql.y:743
 		{
 			//        vs := strings.Split($1, ".")
 			//        var r string
@@ -5793,7 +6287,7 @@ yydefault:
 	case 47:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:727
+//line mysql_sql.y:762
 		{
 			yyLOCAL = 0
 		}
@@ -5801,7 +6295,7 @@ yydefault:
 	case 48:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:731
+//line mysql_sql.y:766
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
@@ -5809,7 +6303,7 @@ yydefault:
 	case 49:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:736
+//line mysql_sql.y:771
 		{
 			yyLOCAL = nil
 		}
@@ -5817,7 +6311,7 @@ yydefault:
 	case 50:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:740
+//line mysql_sql.y:775
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -5827,32 +6321,32 @@ yydefault:
 		yyVAL.union = yyLOCAL
 	case 51:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:748
+//line mysql_sql.y:783
 		{
 			yyVAL.str = ""
 		}
 	case 52:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:752
+//line mysql_sql.y:787
 		{
 			yyVAL.str = yyDollar[3].str
 		}
 	case 53:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:757
+//line mysql_sql.y:792
 		{
 			yyVAL.str = "\n"
 		}
 	case 54:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:761
+//line mysql_sql.y:796
 		{
 			yyVAL.str = yyDollar[3].str
 		}
 	case 55:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:766
+//line mysql_sql.y:801
 		{
 			yyLOCAL = nil
 		}
@@ -5860,7 +6354,7 @@ yydefault:
 	case 56:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:770
+//line mysql_sql.y:805
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -5886,7 +6380,7 @@ yydefault:
 	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:794
+//line mysql_sql.y:829
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
@@ -5894,7 +6388,7 @@ yydefault:
 	case 58:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:798
+//line mysql_sql.y:833
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
@@ -5902,7 +6396,7 @@ yydefault:
 	case 59:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:804
+//line mysql_sql.y:839
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
@@ -5912,7 +6406,7 @@ yydefault:
 	case 60:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:810
+//line mysql_sql.y:845
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -5934,7 +6428,7 @@ yydefault:
 	case 61:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:828
+//line mysql_sql.y:863
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5955,7 +6449,7 @@ yydefault:
 	case 62:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:845
+//line mysql_sql.y:880
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5976,7 +6470,7 @@ yydefault:
 	case 64:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:868
+//line mysql_sql.y:903
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
@@ -5984,7 +6478,7 @@ yydefault:
 	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:872
+//line mysql_sql.y:907
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
@@ -5992,7 +6486,7 @@ yydefault:
 	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:876
+//line mysql_sql.y:911
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
@@ -6000,7 +6494,7 @@ yydefault:
 	case 67:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:881
+//line mysql_sql.y:916
 		{
 			yyLOCAL = false
 		}
@@ -6008,7 +6502,7 @@ yydefault:
 	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:885
+//line mysql_sql.y:920
 		{
 			yyLOCAL = true
 		}
@@ -6016,7 +6510,7 @@ yydefault:
 	case 69:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:891
+//line mysql_sql.y:926
 		{
 			yyLOCAL = &tree.Grant{
 				Privileges:  yyDollar[2].privilegesUnion(),
@@ -6030,7 +6524,7 @@ yydefault:
 	case 70:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:901
+//line mysql_sql.y:936
 		{
 			yyLOCAL = &tree.Grant{
 				IsGrantRole:      true,
@@ -6042,7 +6536,7 @@ yydefault:
 	case 71:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:909
+//line mysql_sql.y:944
 		{
 			yyLOCAL = &tree.Grant{
 				IsProxy:     true,
@@ -6055,7 +6549,7 @@ yydefault:
 	case 72:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:919
+//line mysql_sql.y:954
 		{
 			yyLOCAL = false
 		}
@@ -6063,7 +6557,7 @@ yydefault:
 	case 73:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:923
+//line mysql_sql.y:958
 		{
 			yyLOCAL = true
 		}
@@ -6071,7 +6565,7 @@ yydefault:
 	case 74:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:933
+//line mysql_sql.y:968
 		{
 			yyLOCAL = &tree.Revoke{
 				Privileges: yyDollar[2].privilegesUnion(),
@@ -6085,7 +6579,7 @@ yydefault:
 	case 75:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:943
+//line mysql_sql.y:978
 		{
 			yyLOCAL = &tree.Revoke{
 				IsRevokeRole:      true,
@@ -6097,7 +6591,7 @@ yydefault:
 	case 76:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:953
+//line mysql_sql.y:988
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
@@ -6107,7 +6601,7 @@ yydefault:
 	case 77:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:959
+//line mysql_sql.y:994
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_GLOBAL,
@@ -6117,7 +6611,7 @@ yydefault:
 	case 78:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:965
+//line mysql_sql.y:1000
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
@@ -6128,7 +6622,7 @@ yydefault:
 	case 79:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:972
+//line mysql_sql.y:1007
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -6140,7 +6634,7 @@ yydefault:
 	case 80:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:980
+//line mysql_sql.y:1015
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -6151,7 +6645,7 @@ yydefault:
 	case 81:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:988
+//line mysql_sql.y:1023
 		{
 			yyLOCAL = tree.OBJECT_TYPE_NONE
 		}
@@ -6159,7 +6653,7 @@ yydefault:
 	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:992
+//line mysql_sql.y:1027
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
@@ -6167,7 +6661,7 @@ yydefault:
 	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:996
+//line mysql_sql.y:1031
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
@@ -6175,7 +6669,7 @@ yydefault:
 	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1000
+//line mysql_sql.y:1035
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
@@ -6183,7 +6677,7 @@ yydefault:
 	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1006
+//line mysql_sql.y:1041
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
@@ -6191,7 +6685,7 @@ yydefault:
 	case 86:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1010
+//line mysql_sql.y:1045
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
@@ -6199,7 +6693,7 @@ yydefault:
 	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1016
+//line mysql_sql.y:1051
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -6210,7 +6704,7 @@ yydefault:
 	case 88:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1023
+//line mysql_sql.y:1058
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -6219,417 +6713,457 @@ yydefault:
 		}
 		yyVAL.union = yyLOCAL
 	case 89:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1032
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:1066
 		{
-			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
+			yyLOCAL = tree.FULLTEXT_NATURAL_LANGUAGE
 		}
 		yyVAL.union = yyLOCAL
 	case 90:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:1070
+		{
+			yyLOCAL = tree.FULLTEXT_NATURAL_LANGUAGE
+		}
+		yyVAL.union = yyLOCAL
+	case 91:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:1074
+		{
+			yyLOCAL = tree.FULLTEXT_NATURAL_LANGUAGE_WITH_QUERY_EXPANSION
+		}
+		yyVAL.union = yyLOCAL
+	case 92:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:1078
+		{
+			yyLOCAL = tree.FULLTEXT_BOOLEAN
+		}
+		yyVAL.union = yyLOCAL
+	case 93:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:1082
+		{
+			yyLOCAL = tree.FULLTEXT_QUERY_EXPANSION
+		}
+		yyVAL.union = yyLOCAL
+	case 94:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.UnresolvedName
+//line mysql_sql.y:1088
+		{
+			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1036
+//line mysql_sql.y:1092
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1042
+//line mysql_sql.y:1098
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 97:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1046
+//line mysql_sql.y:1102
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 98:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1050
+//line mysql_sql.y:1106
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1054
+//line mysql_sql.y:1110
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 100:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1058
+//line mysql_sql.y:1114
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 101:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1062
+//line mysql_sql.y:1118
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1066
+//line mysql_sql.y:1122
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1070
+//line mysql_sql.y:1126
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1074
+//line mysql_sql.y:1130
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1078
+//line mysql_sql.y:1134
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1082
+//line mysql_sql.y:1138
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1086
+//line mysql_sql.y:1142
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1090
+//line mysql_sql.y:1146
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1094
+//line mysql_sql.y:1150
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 110:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1098
+//line mysql_sql.y:1154
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1102
+//line mysql_sql.y:1158
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 112:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1106
+//line mysql_sql.y:1162
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1110
+//line mysql_sql.y:1166
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1114
+//line mysql_sql.y:1170
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1118
+//line mysql_sql.y:1174
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1122
+//line mysql_sql.y:1178
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 117:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1126
+//line mysql_sql.y:1182
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1130
+//line mysql_sql.y:1186
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 119:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1134
+//line mysql_sql.y:1190
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1138
+//line mysql_sql.y:1194
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1142
+//line mysql_sql.y:1198
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 117:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1146
+//line mysql_sql.y:1202
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 118:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1150
+//line mysql_sql.y:1206
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 119:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1154
+//line mysql_sql.y:1210
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 120:
+	case 125:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1158
+//line mysql_sql.y:1214
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 121:
+	case 126:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1162
+//line mysql_sql.y:1218
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 122:
+	case 127:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1166
+//line mysql_sql.y:1222
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1170
+//line mysql_sql.y:1226
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
 		yyVAL.union = yyLOCAL
-	case 128:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1182
+//line mysql_sql.y:1238
 		{
 			yyLOCAL = yyDollar[3].setRoleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 134:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1188
+//line mysql_sql.y:1244
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
 			yyLOCAL = dr
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 135:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1196
+//line mysql_sql.y:1252
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL_EXCEPT, Roles: yyDollar[3].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1200
+//line mysql_sql.y:1256
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_DEFAULT, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1204
+//line mysql_sql.y:1260
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1208
+//line mysql_sql.y:1264
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1212
+//line mysql_sql.y:1268
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 135:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1218
+//line mysql_sql.y:1274
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 136:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1222
+//line mysql_sql.y:1278
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1226
+//line mysql_sql.y:1282
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 138:
+	case 143:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1232
+//line mysql_sql.y:1288
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 144:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1238
+//line mysql_sql.y:1294
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 145:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1242
+//line mysql_sql.y:1298
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 147:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1249
+//line mysql_sql.y:1305
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 143:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1255
+//line mysql_sql.y:1311
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 149:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1259
+//line mysql_sql.y:1315
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1265
+//line mysql_sql.y:1321
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6638,10 +7172,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 151:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1273
+//line mysql_sql.y:1329
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6651,10 +7185,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 152:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1282
+//line mysql_sql.y:1338
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6663,10 +7197,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 153:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1290
+//line mysql_sql.y:1346
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -6675,10 +7209,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1298
+//line mysql_sql.y:1354
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -6702,10 +7236,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1321
+//line mysql_sql.y:1377
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -6729,10 +7263,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 151:
+	case 156:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1344
+//line mysql_sql.y:1400
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6740,10 +7274,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 152:
+	case 157:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1351
+//line mysql_sql.y:1407
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6751,10 +7285,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 153:
+	case 158:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1358
+//line mysql_sql.y:1414
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     yyDollar[1].str,
@@ -6763,10 +7297,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 159:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1366
+//line mysql_sql.y:1422
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6774,10 +7308,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1373
+//line mysql_sql.y:1429
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6785,10 +7319,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1380
+//line mysql_sql.y:1436
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -6796,207 +7330,207 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 157:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1389
+//line mysql_sql.y:1445
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 158:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1393
+//line mysql_sql.y:1449
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 159:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1397
+//line mysql_sql.y:1453
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 160:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1403
+//line mysql_sql.y:1459
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 161:
+	case 166:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1407
+//line mysql_sql.y:1463
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 163:
+	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1414
+//line mysql_sql.y:1470
 		{
 			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
 		}
-	case 167:
+	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1425
+//line mysql_sql.y:1481
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 168:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1431
+//line mysql_sql.y:1487
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 169:
+	case 174:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1436
+//line mysql_sql.y:1492
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 170:
+	case 175:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1440
+//line mysql_sql.y:1496
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1444
+//line mysql_sql.y:1500
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 177:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1448
+//line mysql_sql.y:1504
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1452
+//line mysql_sql.y:1508
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 174:
+	case 179:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1456
+//line mysql_sql.y:1512
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 175:
+	case 180:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1460
+//line mysql_sql.y:1516
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1464
+//line mysql_sql.y:1520
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1470
+//line mysql_sql.y:1526
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1474
+//line mysql_sql.y:1530
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1478
+//line mysql_sql.y:1534
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 185:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1482
+//line mysql_sql.y:1538
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 181:
+	case 186:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1487
+//line mysql_sql.y:1543
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 187:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1492
+//line mysql_sql.y:1548
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 183:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1498
+//line mysql_sql.y:1554
 		{
 			yyLOCAL = &tree.Use{Name: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 184:
+	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1502
+//line mysql_sql.y:1558
 		{
 			yyLOCAL = &tree.Use{}
 		}
 		yyVAL.union = yyLOCAL
-	case 186:
+	case 191:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1509
+//line mysql_sql.y:1565
 		{
 			yyDollar[2].statementUnion().(*tree.Update).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 187:
+	case 192:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1516
+//line mysql_sql.y:1572
 		{
 			// Single-table syntax
 			yyLOCAL = &tree.Update{
@@ -7008,10 +7542,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 188:
+	case 193:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1527
+//line mysql_sql.y:1583
 		{
 			// Multiple-table syntax
 			yyLOCAL = &tree.Update{
@@ -7021,132 +7555,132 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 189:
+	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1538
+//line mysql_sql.y:1594
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 190:
+	case 195:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1542
+//line mysql_sql.y:1598
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 196:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1548
+//line mysql_sql.y:1604
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 198:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1560
+//line mysql_sql.y:1616
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 199:
+	case 204:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1566
+//line mysql_sql.y:1622
 		{
 			yyLOCAL = tree.NewPrepareStmt(tree.Identifier(yyDollar[2].str), yyDollar[4].statementUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 200:
+	case 205:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1570
+//line mysql_sql.y:1626
 		{
 			yyLOCAL = tree.NewPrepareString(tree.Identifier(yyDollar[2].str), yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 201:
+	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1576
+//line mysql_sql.y:1632
 		{
 			yyLOCAL = tree.NewExecute(tree.Identifier(yyDollar[2].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 202:
+	case 207:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1580
+//line mysql_sql.y:1636
 		{
 			yyLOCAL = tree.NewExecuteWithVariables(tree.Identifier(yyDollar[2].str), yyDollar[4].varExprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 203:
+	case 208:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1586
+//line mysql_sql.y:1642
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), false)
 		}
 		yyVAL.union = yyLOCAL
-	case 207:
+	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1595
+//line mysql_sql.y:1651
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 208:
+	case 213:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1601
+//line mysql_sql.y:1657
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 209:
+	case 214:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1606
+//line mysql_sql.y:1662
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
+	case 215:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1611
+//line mysql_sql.y:1667
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 211:
+	case 216:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1615
+//line mysql_sql.y:1671
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 212:
+	case 217:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1619
+//line mysql_sql.y:1675
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "text")
 		}
 		yyVAL.union = yyLOCAL
-	case 213:
+	case 218:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1623
+//line mysql_sql.y:1679
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("verbose", "NULL")
@@ -7155,10 +7689,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 219:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1631
+//line mysql_sql.y:1687
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("analyze", "NULL")
@@ -7167,10 +7701,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 220:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1639
+//line mysql_sql.y:1695
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[4].statementUnion(), "text")
 			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
@@ -7181,76 +7715,202 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 221:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1649
+//line mysql_sql.y:1705
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[5].statementUnion(), "text")
 			explainStmt.Options = yyDollar[3].epxlainOptionsUnion()
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 228:
+	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1681
+//line mysql_sql.y:1737
 		{
 			yyLOCAL = tree.MakeOptions(yyDollar[1].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 229:
+	case 234:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1685
+//line mysql_sql.y:1741
 		{
 			yyLOCAL = append(yyDollar[1].epxlainOptionsUnion(), yyDollar[3].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 230:
+	case 235:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.OptionElem
-//line mysql_sql.y:1691
+//line mysql_sql.y:1747
 		{
 			yyLOCAL = tree.MakeOptionElem(yyDollar[1].str, yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 231:
+	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1697
+//line mysql_sql.y:1753
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 232:
+	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1702
+//line mysql_sql.y:1758
 		{
 			yyVAL.str = "true"
 		}
-	case 233:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1703
+//line mysql_sql.y:1759
 		{
 			yyVAL.str = "false"
 		}
-	case 234:
+	case 239:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1704
+//line mysql_sql.y:1760
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 235:
+	case 240:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1709
+//line mysql_sql.y:1765
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 237:
+	case 243:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1775
+		{
+			yyLOCAL = tree.NewAlterTable(yyDollar[3].tableNameUnion(), yyDollar[4].alterTableOptionsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 244:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1779
+		{
+			yyLOCAL = tree.NewAlterTable(yyDollar[3].tableNameUnion(), tree.AlterTableOptions{yyDollar[4].alterTableOptionUnion()})
+		}
+		yyVAL.union = yyLOCAL
+	case 245:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1785
+		{
+			yyLOCAL = tree.NewAlterOptionAddPartition(yyDollar[4].partitionsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 246:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1789
+		{
+			yyLOCAL = tree.NewAlterOptionDropPartition(yyDollar[3].identifierListUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 247:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1793
+		{
+			yyLOCAL = tree.NewAlterOptionTruncatePartition(yyDollar[3].identifierListUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 248:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1797
+		{
+			yyLOCAL = tree.NewAlterOptionTruncatePartition(nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 249:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.AlterTableOptions
+//line mysql_sql.y:1803
+		{
+			yyLOCAL = tree.AlterTableOptions{yyDollar[1].alterTableOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 250:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOptions
+//line mysql_sql.y:1807
+		{
+			yyLOCAL = append(yyDollar[1].alterTableOptionsUnion(), yyDollar[3].alterTableOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 251:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1813
+		{
+			yyLOCAL = tree.NewAlterOptionAddColumn(yyDollar[3].columnTableDefUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 252:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1817
+		{
+			yyLOCAL = tree.NewAlterOptionDropColumn(yyDollar[3].unresolvedNameUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 253:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1821
+		{
+			yyLOCAL = tree.NewAlterOptionRenameColumn(yyDollar[3].unresolvedNameUnion(), yyDollar[5].unresolvedNameUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 254:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1825
+		{
+			yyLOCAL = tree.NewAlterOptionRenameTable(yyDollar[3].tableNameUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 255:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:1830
+		{
+			yyVAL.str = ""
+		}
+	case 256:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:1834
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 257:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:1839
+		{
+			yyVAL.str = ""
+		}
+	case 258:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:1843
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 259:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:1847
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 260:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1719
+//line mysql_sql.y:1853
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:   yyDollar[3].boolValUnion(),
@@ -7262,10 +7922,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 238:
+	case 261:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1730
+//line mysql_sql.y:1864
 		{
 			auth := &tree.User{
 				AuthString: yyDollar[9].str,
@@ -7278,134 +7938,134 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 239:
+	case 262:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1743
+//line mysql_sql.y:1877
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 240:
+	case 263:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1747
+//line mysql_sql.y:1881
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 241:
+	case 264:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1752
+//line mysql_sql.y:1886
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 242:
+	case 265:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1756
+//line mysql_sql.y:1890
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 243:
+	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1762
+//line mysql_sql.y:1896
 		{
 			yyLOCAL = []tree.UserMiscOption{yyDollar[1].userMiscOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 267:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1766
+//line mysql_sql.y:1900
 		{
 			yyLOCAL = append(yyDollar[1].userMiscOptionsUnion(), yyDollar[2].userMiscOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 268:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1772
+//line mysql_sql.y:1906
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 246:
+	case 269:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1776
+//line mysql_sql.y:1910
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 247:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1780
+//line mysql_sql.y:1914
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 248:
+	case 271:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1784
+//line mysql_sql.y:1918
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 249:
+	case 272:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1788
+//line mysql_sql.y:1922
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 250:
+	case 273:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1792
+//line mysql_sql.y:1926
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 251:
+	case 274:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1798
+//line mysql_sql.y:1932
 		{
 			yyVAL.item = nil
 		}
-	case 252:
+	case 275:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1803
+//line mysql_sql.y:1937
 		{
 			yyVAL.item = nil
 		}
-	case 265:
+	case 288:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1825
+//line mysql_sql.y:1959
 		{
 			yyLOCAL = &tree.ShowTarget{Target: yyDollar[2].str, Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 266:
+	case 289:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1829
+//line mysql_sql.y:1963
 		{
 			yyLOCAL = &tree.ShowTarget{Target: "charset", Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 290:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1835
+//line mysql_sql.y:1969
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[4].tableNameUnion(),
@@ -7413,10 +8073,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 294:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1849
+//line mysql_sql.y:1983
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -7425,10 +8085,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 295:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1859
+//line mysql_sql.y:1993
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -7437,58 +8097,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 273:
+	case 296:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1868
+//line mysql_sql.y:2002
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 274:
+	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1872
+//line mysql_sql.y:2006
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 275:
+	case 298:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1876
+//line mysql_sql.y:2010
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 276:
+	case 299:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1882
+//line mysql_sql.y:2016
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 277:
+	case 300:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1888
+//line mysql_sql.y:2022
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 278:
+	case 301:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1894
+//line mysql_sql.y:2028
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 279:
+	case 302:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1900
+//line mysql_sql.y:2034
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -7499,10 +8159,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 303:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1910
+//line mysql_sql.y:2044
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -7513,26 +8173,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 304:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1922
+//line mysql_sql.y:2056
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 305:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1926
+//line mysql_sql.y:2060
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 306:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1932
+//line mysql_sql.y:2066
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -7545,10 +8205,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 307:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1944
+//line mysql_sql.y:2078
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -7561,118 +8221,126 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 308:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1957
+//line mysql_sql.y:2091
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 309:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1961
+//line mysql_sql.y:2095
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 310:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1966
+//line mysql_sql.y:2100
 		{
 			yyVAL.str = ""
 		}
-	case 288:
+	case 311:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:1970
+//line mysql_sql.y:2104
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 289:
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1976
+//line mysql_sql.y:2110
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 317:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1989
+//line mysql_sql.y:2123
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1993
+//line mysql_sql.y:2127
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 296:
+	case 319:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1999
+//line mysql_sql.y:2133
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 320:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2137
+		{
+			yyLOCAL = &tree.ShowCreateView{Name: yyDollar[4].unresolvedObjectNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 321:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2003
+//line mysql_sql.y:2141
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 322:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2009
+//line mysql_sql.y:2147
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 299:
+	case 323:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2013
+//line mysql_sql.y:2151
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 325:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2022
+//line mysql_sql.y:2160
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 326:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2026
+//line mysql_sql.y:2164
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 327:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:2030
+//line mysql_sql.y:2168
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].str, yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 336:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2047
+//line mysql_sql.y:2186
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -7680,10 +8348,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 337:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2056
+//line mysql_sql.y:2195
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -7691,10 +8359,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 338:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2065
+//line mysql_sql.y:2204
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].str),
@@ -7703,52 +8371,60 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 339:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2075
+//line mysql_sql.y:2214
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 340:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2220
+		{
+			yyLOCAL = &tree.DropView{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 341:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2081
+//line mysql_sql.y:2226
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].str), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 342:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2087
+//line mysql_sql.y:2232
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), true)
 		}
 		yyVAL.union = yyLOCAL
-	case 319:
+	case 345:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2095
+//line mysql_sql.y:2240
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 346:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2100
+//line mysql_sql.y:2245
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 347:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2107
+//line mysql_sql.y:2252
 		{
 			// Single-Table Syntax
 			t := &tree.AliasedTableExpr{
@@ -7765,10 +8441,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 348:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2123
+//line mysql_sql.y:2268
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -7778,10 +8454,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 349:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2136
+//line mysql_sql.y:2281
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -7791,80 +8467,97 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 324:
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2147
+//line mysql_sql.y:2292
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 351:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2151
+//line mysql_sql.y:2296
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 352:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:2157
+//line mysql_sql.y:2302
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 353:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:2162
+//line mysql_sql.y:2307
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 354:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2169
+//line mysql_sql.y:2314
 		{
 		}
-	case 329:
+	case 355:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2171
+//line mysql_sql.y:2316
 		{
 		}
-	case 330:
+	case 356:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2174
+//line mysql_sql.y:2319
 		{
 		}
-	case 335:
+	case 361:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2183
+//line mysql_sql.y:2328
 		{
 		}
-	case 337:
+	case 363:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2187
+//line mysql_sql.y:2332
 		{
 		}
-	case 339:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 365:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2192
+//line mysql_sql.y:2337
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
 			ins.PartitionNames = yyDollar[3].identifierListUnion()
+			ins.OnDuplicateUpdate = yyDollar[5].updateExprsUnion()
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 366:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.UpdateExprs
+//line mysql_sql.y:2346
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 367:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.UpdateExprs
+//line mysql_sql.y:2350
+		{
+			yyLOCAL = yyDollar[5].updateExprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 368:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2201
+//line mysql_sql.y:2356
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -7872,20 +8565,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 369:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2208
+//line mysql_sql.y:2363
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: yyDollar[1].selectUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 370:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2214
+//line mysql_sql.y:2369
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -7894,10 +8587,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 371:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2222
+//line mysql_sql.y:2377
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -7905,10 +8598,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 372:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2229
+//line mysql_sql.y:2384
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -7916,10 +8609,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2236
+//line mysql_sql.y:2391
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -7938,34 +8631,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 374:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2255
+//line mysql_sql.y:2410
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 375:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2259
+//line mysql_sql.y:2414
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 376:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2263
+//line mysql_sql.y:2418
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 377:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:2269
+//line mysql_sql.y:2424
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -7973,155 +8666,155 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 378:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2278
+//line mysql_sql.y:2433
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 379:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2282
+//line mysql_sql.y:2437
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 380:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2288
+//line mysql_sql.y:2443
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 353:
+	case 381:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2292
+//line mysql_sql.y:2447
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 354:
+	case 382:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2298
+//line mysql_sql.y:2453
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 383:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2302
+//line mysql_sql.y:2457
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 384:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2308
+//line mysql_sql.y:2463
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 385:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2313
+//line mysql_sql.y:2468
 		{
 		}
-	case 359:
+	case 387:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2317
+//line mysql_sql.y:2472
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 389:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2324
+//line mysql_sql.y:2479
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 390:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2328
+//line mysql_sql.y:2483
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 392:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2335
+//line mysql_sql.y:2490
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 393:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2340
+//line mysql_sql.y:2495
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 394:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2344
+//line mysql_sql.y:2499
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 395:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2350
+//line mysql_sql.y:2505
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 396:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2354
+//line mysql_sql.y:2509
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 397:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2360
+//line mysql_sql.y:2515
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2364
+//line mysql_sql.y:2519
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 399:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2369
+//line mysql_sql.y:2524
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 400:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2373
+//line mysql_sql.y:2528
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
@@ -8134,10 +8827,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 401:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2386
+//line mysql_sql.y:2541
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -8145,10 +8838,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 402:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2393
+//line mysql_sql.y:2548
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -8156,10 +8849,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 403:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2400
+//line mysql_sql.y:2555
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -8178,10 +8871,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 376:
+	case 404:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2418
+//line mysql_sql.y:2573
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -8200,38 +8893,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 377:
+	case 405:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2437
+//line mysql_sql.y:2592
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 406:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2443
+//line mysql_sql.y:2598
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 407:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2450
+//line mysql_sql.y:2605
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 408:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2454
+//line mysql_sql.y:2609
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -8244,115 +8937,115 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 409:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2467
+//line mysql_sql.y:2622
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 410:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2471
+//line mysql_sql.y:2626
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 411:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2476
+//line mysql_sql.y:2631
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 412:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2480
+//line mysql_sql.y:2635
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 413:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2487
+//line mysql_sql.y:2642
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 414:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2492
+//line mysql_sql.y:2647
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 416:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2499
+//line mysql_sql.y:2654
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 417:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2505
+//line mysql_sql.y:2660
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2509
+//line mysql_sql.y:2664
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 419:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2513
+//line mysql_sql.y:2668
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 420:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2517
+//line mysql_sql.y:2672
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 421:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2521
+//line mysql_sql.y:2676
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Ep: yyDollar[4].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 422:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2525
+//line mysql_sql.y:2680
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 395:
+	case 423:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2531
+//line mysql_sql.y:2686
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: false,
@@ -8360,10 +9053,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 396:
+	case 424:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2538
+//line mysql_sql.y:2693
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: true,
@@ -8371,26 +9064,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 425:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2547
+//line mysql_sql.y:2702
 		{
 			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 426:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2551
+//line mysql_sql.y:2706
 		{
 			yyLOCAL = append(yyDollar[1].cteListUnion(), yyDollar[3].cteUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 427:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.CTE
-//line mysql_sql.y:2557
+//line mysql_sql.y:2712
 		{
 			yyLOCAL = &tree.CTE{
 				Name: &tree.AliasClause{Alias: tree.Identifier(yyDollar[1].str), Cols: yyDollar[2].identifierListUnion()},
@@ -8398,218 +9091,211 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 428:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2565
+//line mysql_sql.y:2720
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 429:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2569
+//line mysql_sql.y:2724
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 402:
+	case 430:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2574
+//line mysql_sql.y:2729
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 403:
+	case 431:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2578
+//line mysql_sql.y:2733
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 404:
+	case 432:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2584
+//line mysql_sql.y:2739
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 405:
+	case 433:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2588
+//line mysql_sql.y:2743
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 434:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2592
+//line mysql_sql.y:2747
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 407:
+	case 435:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *string
+//line mysql_sql.y:2752
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 436:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *string
+//line mysql_sql.y:2756
+		{
+			s := yyDollar[2].str
+			yyLOCAL = &s
+		}
+		yyVAL.union = yyLOCAL
+	case 437:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2597
+//line mysql_sql.y:2762
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 438:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2601
+//line mysql_sql.y:2766
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 439:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2607
+//line mysql_sql.y:2772
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 410:
+	case 440:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2613
+//line mysql_sql.y:2778
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 411:
+	case 441:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2617
+//line mysql_sql.y:2782
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 412:
+	case 442:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:2623
+//line mysql_sql.y:2788
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 413:
+	case 443:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2628
+//line mysql_sql.y:2793
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 444:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2632
+//line mysql_sql.y:2797
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 445:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2636
+//line mysql_sql.y:2801
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 446:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2643
+//line mysql_sql.y:2808
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 447:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2647
+//line mysql_sql.y:2812
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 448:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2653
+//line mysql_sql.y:2818
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 449:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2657
+//line mysql_sql.y:2822
 		{
-			yyLOCAL = &tree.UnionClause{
-				Type:     yyDollar[2].unionTypeRecordUnion().Type,
-				Left:     yyDollar[1].selectStatementUnion(),
-				Right:    yyDollar[3].selectStatementUnion(),
-				All:      yyDollar[2].unionTypeRecordUnion().All,
-				Distinct: yyDollar[2].unionTypeRecordUnion().Distinct,
-			}
+			yyLOCAL = tree.NewUnionClauseWithRecord(yyDollar[1].selectStatementUnion(), yyDollar[2].unionTypeRecordUnion(), yyDollar[3].selectStatementUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 450:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2667
+//line mysql_sql.y:2826
 		{
-			yyLOCAL = &tree.UnionClause{
-				Type:     yyDollar[2].unionTypeRecordUnion().Type,
-				Left:     yyDollar[1].selectStatementUnion(),
-				Right:    yyDollar[3].selectStatementUnion(),
-				All:      yyDollar[2].unionTypeRecordUnion().All,
-				Distinct: yyDollar[2].unionTypeRecordUnion().Distinct,
-			}
+			yyLOCAL = tree.NewUnionClauseWithRecord(yyDollar[1].selectStatementUnion(), yyDollar[2].unionTypeRecordUnion(), yyDollar[3].selectStatementUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 451:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2677
+//line mysql_sql.y:2830
 		{
-			yyLOCAL = &tree.UnionClause{
-				Type:     yyDollar[2].unionTypeRecordUnion().Type,
-				Left:     yyDollar[1].selectStatementUnion(),
-				Right:    yyDollar[3].selectStatementUnion(),
-				All:      yyDollar[2].unionTypeRecordUnion().All,
-				Distinct: yyDollar[2].unionTypeRecordUnion().Distinct,
-			}
+			yyLOCAL = tree.NewUnionClauseWithRecord(yyDollar[1].selectStatementUnion(), yyDollar[2].unionTypeRecordUnion(), yyDollar[3].selectStatementUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 452:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2687
+//line mysql_sql.y:2834
 		{
-			yyLOCAL = &tree.UnionClause{
-				Type:     yyDollar[2].unionTypeRecordUnion().Type,
-				Left:     yyDollar[1].selectStatementUnion(),
-				Right:    yyDollar[3].selectStatementUnion(),
-				All:      yyDollar[2].unionTypeRecordUnion().All,
-				Distinct: yyDollar[2].unionTypeRecordUnion().Distinct,
-			}
+			yyLOCAL = tree.NewUnionClauseWithRecord(yyDollar[1].selectStatementUnion(), yyDollar[2].unionTypeRecordUnion(), yyDollar[3].selectStatementUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 453:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2699
+//line mysql_sql.y:2840
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -8618,10 +9304,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 424:
+	case 454:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2707
+//line mysql_sql.y:2848
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -8630,10 +9316,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 455:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2715
+//line mysql_sql.y:2856
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -8642,10 +9328,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 426:
+	case 456:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2864
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      false,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 457:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2872
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      true,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 458:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2880
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.INTERSECT,
+				All:      false,
+				Distinct: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 459:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2888
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      false,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 460:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2896
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      true,
+				Distinct: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 461:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line mysql_sql.y:2904
+		{
+			yyLOCAL = &tree.UnionTypeRecord{
+				Type:     tree.EXCEPT,
+				All:      false,
+				Distinct: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 462:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2725
+//line mysql_sql.y:2914
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: yyDollar[2].boolValUnion(),
@@ -8657,10 +9415,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 427:
+	case 463:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2736
+//line mysql_sql.y:2925
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: false,
@@ -8673,130 +9431,210 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 467:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2754
+//line mysql_sql.y:2943
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2758
+//line mysql_sql.y:2947
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 469:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2762
+//line mysql_sql.y:2951
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 472:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2771
+//line mysql_sql.y:2960
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 437:
+	case 473:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2775
+//line mysql_sql.y:2964
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 438:
+	case 474:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2780
+//line mysql_sql.y:2969
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 475:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2784
+//line mysql_sql.y:2973
 		{
-			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
+			yyLOCAL = yyDollar[3].groupByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 476:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.GroupBy
+//line mysql_sql.y:2977
+		{
+			exprs := make(tree.Exprs, len(yyDollar[3].groupByUnion()))
+			for i, e := range yyDollar[3].groupByUnion() {
+				if _, ok := e.(*tree.GroupingSetsExpr); ok {
+					yylex.Error("WITH ROLLUP cannot be used with ROLLUP, CUBE or GROUPING SETS")
+					return 1
+				}
+				exprs[i] = e
+			}
+			yyLOCAL = tree.GroupBy{tree.NewGroupingSetsExpr(tree.GROUPING_ROLLUP, exprs)}
+		}
+		yyVAL.union = yyLOCAL
+	case 477:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.GroupBy
+//line mysql_sql.y:2991
+		{
+			yyLOCAL = tree.GroupBy{yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 478:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.GroupBy
+//line mysql_sql.y:2995
+		{
+			yyLOCAL = append(yyDollar[1].groupByUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 480:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:3002
+		{
+			yyLOCAL = tree.NewGroupingSetsExpr(tree.GROUPING_ROLLUP, yyDollar[3].exprsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 481:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:3006
+		{
+			yyLOCAL = tree.NewGroupingSetsExpr(tree.GROUPING_CUBE, yyDollar[3].exprsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 482:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:3010
+		{
+			yyLOCAL = tree.NewGroupingSetsExpr(tree.GROUPING_SETS, yyDollar[4].exprsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 483:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:3016
+		{
+			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 484:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:3020
+		{
+			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 486:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:3027
+		{
+			yyLOCAL = tree.NewTuple(nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 487:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2789
+//line mysql_sql.y:3032
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 488:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2793
+//line mysql_sql.y:3036
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 489:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2799
+//line mysql_sql.y:3042
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 490:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2803
+//line mysql_sql.y:3046
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 491:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2809
+//line mysql_sql.y:3052
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 492:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2813
+//line mysql_sql.y:3056
 		{
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: tree.UnrestrictedIdentifier(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 493:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2817
+//line mysql_sql.y:3060
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 494:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2821
+//line mysql_sql.y:3064
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].str, yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 495:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2826
+//line mysql_sql.y:3069
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier(""), prefix)
@@ -8805,44 +9643,44 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 496:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2834
+//line mysql_sql.y:3077
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 450:
+	case 497:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2840
+//line mysql_sql.y:3083
 		{
 			yyLOCAL = &tree.From{
 				Tables: yyDollar[2].tableExprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 498:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2848
+//line mysql_sql.y:3091
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 499:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2852
+//line mysql_sql.y:3095
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 503:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2865
+//line mysql_sql.y:3108
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -8852,10 +9690,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 504:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2874
+//line mysql_sql.y:3117
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -8865,10 +9703,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 505:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2884
+//line mysql_sql.y:3127
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -8878,10 +9716,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 506:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2893
+//line mysql_sql.y:3136
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -8890,15 +9728,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 507:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2903
+//line mysql_sql.y:3146
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 461:
+	case 508:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2907
+//line mysql_sql.y:3150
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -8906,130 +9744,130 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 462:
+	case 509:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2917
+//line mysql_sql.y:3160
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 463:
+	case 510:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2921
+//line mysql_sql.y:3164
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 464:
+	case 511:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2925
+//line mysql_sql.y:3168
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 465:
+	case 512:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2929
+//line mysql_sql.y:3172
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 466:
+	case 513:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2935
+//line mysql_sql.y:3178
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 514:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2939
+//line mysql_sql.y:3182
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 515:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2945
+//line mysql_sql.y:3188
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 469:
+	case 516:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2951
+//line mysql_sql.y:3194
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 470:
+	case 517:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2955
+//line mysql_sql.y:3198
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 471:
+	case 518:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2959
+//line mysql_sql.y:3202
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 472:
+	case 519:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2965
+//line mysql_sql.y:3208
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 520:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2969
+//line mysql_sql.y:3212
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 521:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2975
+//line mysql_sql.y:3218
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 522:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2979
+//line mysql_sql.y:3222
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2985
+//line mysql_sql.y:3228
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 524:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2989
+//line mysql_sql.y:3232
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2995
+//line mysql_sql.y:3238
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 526:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2999
+//line mysql_sql.y:3242
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -9040,28 +9878,43 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 480:
+	case 527:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line mysql_sql.y:3252
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[2].parenTableExprUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[4].str),
+					Cols:  yyDollar[5].identifierListUnion(),
+				},
+				Lateral: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 528:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:3012
+//line mysql_sql.y:3266
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 481:
+	case 529:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3017
+//line mysql_sql.y:3271
 		{
 		}
-	case 482:
+	case 530:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3018
+//line mysql_sql.y:3272
 		{
 		}
-	case 483:
+	case 531:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:3022
+//line mysql_sql.y:3276
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
@@ -9071,58 +9924,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 532:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3034
+//line mysql_sql.y:3288
 		{
 			yyVAL.str = ""
 		}
-	case 485:
+	case 533:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3038
+//line mysql_sql.y:3292
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 486:
+	case 534:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3042
+//line mysql_sql.y:3296
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 489:
+	case 537:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3051
+//line mysql_sql.y:3305
 		{
 			yyVAL.str = ""
 		}
-	case 490:
+	case 538:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3055
+//line mysql_sql.y:3309
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 491:
+	case 539:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3059
+//line mysql_sql.y:3313
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 492:
+	case 540:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3063
+//line mysql_sql.y:3317
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 493:
+	case 541:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3067
+//line mysql_sql.y:3321
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 509:
+	case 557:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3100
+//line mysql_sql.y:3354
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[5].tableNameUnion(),
@@ -9133,10 +9986,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 558:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3110
+//line mysql_sql.y:3364
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[8].tableNameUnion(),
@@ -9147,15 +10000,29 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 559:
+		yyDollar = yyS[yypt-10 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:3375
+		{
+			yyLOCAL = &tree.CreateView{
+				Name:      yyDollar[7].tableNameUnion(),
+				ColNames:  yyDollar[8].identifierListUnion(),
+				AsSource:  yyDollar[10].selectUnion(),
+				Temporary: yyDollar[4].boolValUnion(),
+				Replace:   true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 560:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3121
+//line mysql_sql.y:3386
 		{
 		}
-	case 513:
+	case 562:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3126
+//line mysql_sql.y:3391
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -9165,189 +10032,189 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 563:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:3136
+//line mysql_sql.y:3401
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 564:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:3140
+//line mysql_sql.y:3405
 		{
 			yyLOCAL = yyDollar[2].resourceOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 565:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:3146
+//line mysql_sql.y:3411
 		{
 			yyLOCAL = []tree.ResourceOption{yyDollar[1].resourceOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 566:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:3150
+//line mysql_sql.y:3415
 		{
 			yyLOCAL = append(yyDollar[1].resourceOptionsUnion(), yyDollar[2].resourceOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 567:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:3156
+//line mysql_sql.y:3421
 		{
 			yyLOCAL = &tree.ResourceOptionMaxQueriesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:3160
+//line mysql_sql.y:3425
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUpdatesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 569:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:3164
+//line mysql_sql.y:3429
 		{
 			yyLOCAL = &tree.ResourceOptionMaxConnectionPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 570:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:3168
+//line mysql_sql.y:3433
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUserConnections{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 571:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:3174
+//line mysql_sql.y:3439
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 573:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:3181
+//line mysql_sql.y:3446
 		{
 			t := &tree.TlsOptionNone{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 574:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:3186
+//line mysql_sql.y:3451
 		{
 			t := &tree.TlsOptionSSL{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 575:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:3191
+//line mysql_sql.y:3456
 		{
 			t := &tree.TlsOptionX509{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 576:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:3196
+//line mysql_sql.y:3461
 		{
 			yyLOCAL = yyDollar[2].tlsOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:3202
+//line mysql_sql.y:3467
 		{
 			yyLOCAL = []tree.TlsOption{yyDollar[1].tlsOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 578:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:3206
+//line mysql_sql.y:3471
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[3].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 579:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:3210
+//line mysql_sql.y:3475
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[2].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 580:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:3216
+//line mysql_sql.y:3481
 		{
 			yyLOCAL = &tree.TlsOptionIssuer{Issuer: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 581:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:3220
+//line mysql_sql.y:3485
 		{
 			yyLOCAL = &tree.TlsOptionSubject{Subject: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 582:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:3224
+//line mysql_sql.y:3489
 		{
 			yyLOCAL = &tree.TlsOptionCipher{Cipher: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 583:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:3228
+//line mysql_sql.y:3493
 		{
 			yyLOCAL = &tree.TlsOptionSan{San: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 584:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3234
+//line mysql_sql.y:3499
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 585:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3238
+//line mysql_sql.y:3503
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 586:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:3244
+//line mysql_sql.y:3509
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -9359,42 +10226,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3257
+//line mysql_sql.y:3522
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 588:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3261
+//line mysql_sql.y:3526
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 589:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:3265
+//line mysql_sql.y:3530
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 590:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3270
+//line mysql_sql.y:3535
 		{
 			yyLOCAL = &tree.AuthRecord{}
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 591:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3274
+//line mysql_sql.y:3539
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthString: yyDollar[3].str,
@@ -9402,20 +10269,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 592:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3281
+//line mysql_sql.y:3546
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 593:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3287
+//line mysql_sql.y:3552
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -9424,10 +10291,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 594:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3295
+//line mysql_sql.y:3560
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -9435,20 +10302,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 595:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3302
+//line mysql_sql.y:3567
 		{
 			yyLOCAL = &tree.AuthRecord{
 				HashString: yyDollar[4].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 598:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3314
+//line mysql_sql.y:3579
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -9456,82 +10323,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 599:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3323
+//line mysql_sql.y:3588
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 600:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3327
+//line mysql_sql.y:3592
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 601:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3333
+//line mysql_sql.y:3598
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 602:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3337
+//line mysql_sql.y:3602
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 603:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3341
+//line mysql_sql.y:3606
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 606:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3350
+//line mysql_sql.y:3615
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 607:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3354
+//line mysql_sql.y:3619
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 608:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3358
+//line mysql_sql.y:3623
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 609:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3362
+//line mysql_sql.y:3627
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 610:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3368
+//line mysql_sql.y:3633
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -9550,18 +10417,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 611:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3387
+//line mysql_sql.y:3652
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 612:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3391
+//line mysql_sql.y:3656
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -9582,123 +10449,123 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3413
+//line mysql_sql.y:3678
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 614:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3417
+//line mysql_sql.y:3682
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 615:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3421
+//line mysql_sql.y:3686
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 616:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3425
+//line mysql_sql.y:3690
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 617:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3429
+//line mysql_sql.y:3694
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 618:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3435
+//line mysql_sql.y:3700
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 619:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3439
+//line mysql_sql.y:3704
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 620:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3445
+//line mysql_sql.y:3710
 		{
 			// Order is parsed but just ignored as MySQL did.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 621:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3450
+//line mysql_sql.y:3715
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 622:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3455
+//line mysql_sql.y:3720
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 623:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3459
+//line mysql_sql.y:3724
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 624:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3463
+//line mysql_sql.y:3728
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 625:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3467
+//line mysql_sql.y:3732
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 626:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3471
+//line mysql_sql.y:3736
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 627:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3477
+//line mysql_sql.y:3742
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:   yyDollar[3].ifNotExistsUnion(),
@@ -9707,98 +10574,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 630:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3491
+//line mysql_sql.y:3756
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 631:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3495
+//line mysql_sql.y:3760
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 632:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3500
+//line mysql_sql.y:3765
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 633:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3504
+//line mysql_sql.y:3769
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3510
+//line mysql_sql.y:3775
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 635:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3514
+//line mysql_sql.y:3779
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 636:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3520
+//line mysql_sql.y:3785
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 637:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3524
+//line mysql_sql.y:3789
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 638:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3528
+//line mysql_sql.y:3793
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 639:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3533
+//line mysql_sql.y:3798
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 640:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3537
+//line mysql_sql.y:3802
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 641:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3543
+//line mysql_sql.y:3808
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -9810,34 +10677,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 642:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3555
+//line mysql_sql.y:3820
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3559
+//line mysql_sql.y:3824
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 644:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3564
+//line mysql_sql.y:3829
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 645:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3568
+//line mysql_sql.y:3833
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -9847,18 +10714,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 646:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3578
+//line mysql_sql.y:3843
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 647:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3582
+//line mysql_sql.y:3847
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -9867,42 +10734,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 648:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3591
+//line mysql_sql.y:3856
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 649:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3595
+//line mysql_sql.y:3860
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3601
+//line mysql_sql.y:3866
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 651:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3605
+//line mysql_sql.y:3870
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 652:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3611
+//line mysql_sql.y:3876
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -9912,10 +10779,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 653:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3620
+//line mysql_sql.y:3885
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -9925,42 +10792,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 654:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3630
+//line mysql_sql.y:3895
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 655:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3634
+//line mysql_sql.y:3899
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 656:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3640
+//line mysql_sql.y:3905
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 657:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3644
+//line mysql_sql.y:3909
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 658:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3650
+//line mysql_sql.y:3915
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -9968,10 +10835,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 659:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3657
+//line mysql_sql.y:3922
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -9979,50 +10846,78 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 611:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3666
+//line mysql_sql.y:3931
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 661:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3670
+//line mysql_sql.y:3935
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 613:
+	case 662:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3675
+//line mysql_sql.y:3940
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 663:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3679
+//line mysql_sql.y:3944
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 664:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Values
+//line mysql_sql.y:3948
+		{
+			yyLOCAL = &tree.ValuesLessThan{ValueList: tree.Exprs{tree.NewMaxValue()}}
+		}
+		yyVAL.union = yyLOCAL
+	case 665:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Values
+//line mysql_sql.y:3952
+		{
+			yyLOCAL = &tree.ValuesLessThan{ValueList: tree.Exprs{tree.NewMaxValue()}}
+		}
+		yyVAL.union = yyLOCAL
+	case 666:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Values
+//line mysql_sql.y:3956
+		{
+			vals := make([]tree.Exprs, len(yyDollar[4].exprsUnion()))
+			for i, e := range yyDollar[4].exprsUnion() {
+				vals[i] = tree.Exprs{e}
+			}
+			yyLOCAL = &tree.ValuesIn{ValueList: vals}
+		}
+		yyVAL.union = yyLOCAL
+	case 667:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3684
+//line mysql_sql.y:3965
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 668:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3688
+//line mysql_sql.y:3969
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -10032,18 +10927,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 669:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3698
+//line mysql_sql.y:3979
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 670:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3702
+//line mysql_sql.y:3983
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -10053,10 +10948,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 671:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3713
+//line mysql_sql.y:3994
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -10065,10 +10960,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 672:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3721
+//line mysql_sql.y:4002
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -10077,10 +10972,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 673:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3729
+//line mysql_sql.y:4010
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -10089,10 +10984,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 674:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3737
+//line mysql_sql.y:4018
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -10101,10 +10996,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 676:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3748
+//line mysql_sql.y:4029
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -10115,10 +11010,23 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 625:
+	case 677:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.PartitionBy
+//line mysql_sql.y:4039
+		{
+			yyLOCAL = &tree.PartitionBy{
+				PType: &tree.KeyType{
+					Linear:    yyDollar[1].boolValUnion(),
+					Algorithm: yyDollar[3].int64ValUnion(),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 678:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3758
+//line mysql_sql.y:4048
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -10128,500 +11036,504 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 679:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3768
+//line mysql_sql.y:4058
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 680:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3772
+//line mysql_sql.y:4062
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 681:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3777
+//line mysql_sql.y:4067
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3781
+//line mysql_sql.y:4071
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 683:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3786
+//line mysql_sql.y:4076
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 684:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3790
+//line mysql_sql.y:4080
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3796
+//line mysql_sql.y:4086
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 686:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3800
+//line mysql_sql.y:4090
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 687:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3804
+//line mysql_sql.y:4094
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 688:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3810
+//line mysql_sql.y:4100
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 689:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3814
+//line mysql_sql.y:4104
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 690:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3818
+//line mysql_sql.y:4108
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 691:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3822
+//line mysql_sql.y:4112
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 692:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3826
+//line mysql_sql.y:4116
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 693:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3830
+//line mysql_sql.y:4120
 		{
 			yyLOCAL = tree.NewTableOptionComment(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 694:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3834
+//line mysql_sql.y:4124
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 642:
+	case 695:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3838
+//line mysql_sql.y:4128
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 643:
+	case 696:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3842
+//line mysql_sql.y:4132
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 644:
+	case 697:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3846
+//line mysql_sql.y:4136
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 645:
+	case 698:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3850
+//line mysql_sql.y:4140
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 699:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3854
+//line mysql_sql.y:4144
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 700:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3858
+//line mysql_sql.y:4148
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 648:
+	case 701:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3862
+//line mysql_sql.y:4152
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 649:
+	case 702:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3866
+//line mysql_sql.y:4156
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 703:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3870
+//line mysql_sql.y:4160
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 651:
+	case 704:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3874
+//line mysql_sql.y:4164
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 705:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3878
+//line mysql_sql.y:4168
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 706:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3882
+//line mysql_sql.y:4172
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 707:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3886
+//line mysql_sql.y:4176
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 708:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3890
+//line mysql_sql.y:4180
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 709:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3894
+//line mysql_sql.y:4184
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 710:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3898
+//line mysql_sql.y:4188
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 711:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3902
+//line mysql_sql.y:4192
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 712:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3906
+//line mysql_sql.y:4196
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 713:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3910
+//line mysql_sql.y:4200
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 714:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3914
+//line mysql_sql.y:4204
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].str, yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 662:
+	case 715:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3918
+//line mysql_sql.y:4208
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 716:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3922
+//line mysql_sql.y:4212
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 717:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3929
+//line mysql_sql.y:4219
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 665:
+	case 718:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3933
+//line mysql_sql.y:4223
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 719:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:3939
+//line mysql_sql.y:4229
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 720:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3944
+//line mysql_sql.y:4234
 		{
 			yyVAL.str = ""
 		}
-	case 668:
+	case 721:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3948
+//line mysql_sql.y:4238
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 669:
+	case 722:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3952
+//line mysql_sql.y:4242
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 670:
+	case 723:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3958
+//line mysql_sql.y:4248
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 724:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3962
+//line mysql_sql.y:4252
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 725:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3966
+//line mysql_sql.y:4256
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 726:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3970
+//line mysql_sql.y:4260
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 727:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3974
+//line mysql_sql.y:4264
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 728:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3978
+//line mysql_sql.y:4268
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 733:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3992
+//line mysql_sql.y:4282
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 734:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3996
+//line mysql_sql.y:4286
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 735:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:4005
+//line mysql_sql.y:4295
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 736:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:4010
+//line mysql_sql.y:4300
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 737:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:4016
+//line mysql_sql.y:4306
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 739:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:4023
+//line mysql_sql.y:4313
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 740:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:4027
+//line mysql_sql.y:4317
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 741:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4033
+//line mysql_sql.y:4323
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 742:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4037
+//line mysql_sql.y:4327
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 743:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4043
+//line mysql_sql.y:4333
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
 				case *tree.PrimaryKeyIndex:
 					v.Name = yyDollar[1].str
+				case *tree.CheckIndex:
+					v.Name = yyDollar[1].str
+				case *tree.ForeignKey:
+					v.Name = yyDollar[1].str
 				}
 			}
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 744:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4053
+//line mysql_sql.y:4347
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 745:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4059
+//line mysql_sql.y:4353
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -10631,10 +11543,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 746:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4068
+//line mysql_sql.y:4362
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -10644,10 +11556,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 747:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4077
+//line mysql_sql.y:4371
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -10671,10 +11583,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 748:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4100
+//line mysql_sql.y:4394
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -10684,10 +11596,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 749:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4109
+//line mysql_sql.y:4403
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -10698,10 +11610,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 750:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:4119
+//line mysql_sql.y:4413
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -10709,298 +11621,298 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 751:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4127
+//line mysql_sql.y:4421
 		{
-			yyLOCAL = false
+			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 753:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4133
+//line mysql_sql.y:4427
 		{
 			yyVAL.str = ""
 		}
-	case 701:
+	case 754:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4137
+//line mysql_sql.y:4431
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 704:
+	case 757:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4147
+//line mysql_sql.y:4441
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 758:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4153
+//line mysql_sql.y:4447
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 759:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4159
+//line mysql_sql.y:4453
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 765:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4173
+//line mysql_sql.y:4467
 		{
 			yyVAL.str = ""
 		}
-	case 714:
+	case 767:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:4180
+//line mysql_sql.y:4474
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 768:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:4186
+//line mysql_sql.y:4480
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 769:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:4190
+//line mysql_sql.y:4484
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 770:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:4194
+//line mysql_sql.y:4488
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 774:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:4205
+//line mysql_sql.y:4499
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 775:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:4209
+//line mysql_sql.y:4503
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 776:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:4213
+//line mysql_sql.y:4507
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 777:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4218
+//line mysql_sql.y:4512
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 778:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4222
+//line mysql_sql.y:4516
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 779:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4228
+//line mysql_sql.y:4522
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 780:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:4232
+//line mysql_sql.y:4526
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 781:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4238
+//line mysql_sql.y:4532
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 782:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4242
+//line mysql_sql.y:4536
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 783:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4246
+//line mysql_sql.y:4540
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 784:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4250
+//line mysql_sql.y:4544
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 785:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4254
+//line mysql_sql.y:4548
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 786:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4258
+//line mysql_sql.y:4552
 		{
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char))
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 787:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4262
+//line mysql_sql.y:4556
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 788:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4266
+//line mysql_sql.y:4560
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 789:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4270
+//line mysql_sql.y:4564
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 790:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4274
+//line mysql_sql.y:4568
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 791:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4278
+//line mysql_sql.y:4572
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 792:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4282
+//line mysql_sql.y:4576
 		{
-			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
+			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), true, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 793:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4286
+//line mysql_sql.y:4580
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 794:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4296
+//line mysql_sql.y:4590
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 795:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4300
+//line mysql_sql.y:4594
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 796:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4305
+//line mysql_sql.y:4599
 		{
 			yyVAL.str = ""
 		}
-	case 744:
+	case 797:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4309
+//line mysql_sql.y:4603
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 745:
+	case 798:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4315
+//line mysql_sql.y:4609
 		{
 			yyVAL.str = ""
 		}
-	case 746:
+	case 799:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4319
+//line mysql_sql.y:4613
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 747:
+	case 800:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:4325
+//line mysql_sql.y:4619
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -11011,10 +11923,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 801:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4336
+//line mysql_sql.y:4630
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -11022,10 +11934,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 803:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4346
+//line mysql_sql.y:4640
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -11033,10 +11945,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 804:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4353
+//line mysql_sql.y:4647
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -11044,10 +11956,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
+	case 805:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4360
+//line mysql_sql.y:4654
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -11055,10 +11967,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
+	case 806:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4367
+//line mysql_sql.y:4661
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -11066,339 +11978,370 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 807:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4376
+//line mysql_sql.y:4670
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 808:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4382
+//line mysql_sql.y:4676
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 809:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4388
+//line mysql_sql.y:4682
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 810:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4392
+//line mysql_sql.y:4686
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 811:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4396
+//line mysql_sql.y:4690
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 812:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4400
+//line mysql_sql.y:4694
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 813:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4404
+//line mysql_sql.y:4698
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 814:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4409
+//line mysql_sql.y:4703
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 816:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4416
+//line mysql_sql.y:4710
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 764:
+	case 817:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4420
+//line mysql_sql.y:4714
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 818:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4424
+//line mysql_sql.y:4718
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 819:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4429
+//line mysql_sql.y:4723
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 820:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4433
+//line mysql_sql.y:4727
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 821:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4438
+//line mysql_sql.y:4732
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 822:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4442
+//line mysql_sql.y:4736
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 829:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:4458
+//line mysql_sql.y:4752
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 830:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4464
+//line mysql_sql.y:4758
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 831:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4468
+//line mysql_sql.y:4762
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 779:
+	case 832:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4472
+//line mysql_sql.y:4766
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 780:
+	case 833:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4476
+//line mysql_sql.y:4770
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 781:
+	case 834:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4480
+//line mysql_sql.y:4774
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 835:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4484
+//line mysql_sql.y:4778
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 836:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4488
+//line mysql_sql.y:4782
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 837:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4492
+//line mysql_sql.y:4786
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 838:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4496
+//line mysql_sql.y:4790
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 839:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4500
+//line mysql_sql.y:4794
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 840:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4504
+//line mysql_sql.y:4798
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 841:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4508
+//line mysql_sql.y:4802
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 842:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4512
+//line mysql_sql.y:4806
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 843:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4518
+//line mysql_sql.y:4812
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 844:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4816
+		{
+			name := tree.SetUnresolvedName("json_extract")
+			path := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), path},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 845:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4825
+		{
+			name := tree.SetUnresolvedName("json_extract")
+			path := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
+			extract := &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), path},
+			}
+			name = tree.SetUnresolvedName("json_unquote")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{extract},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 846:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4522
+//line mysql_sql.y:4839
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 847:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4526
+//line mysql_sql.y:4843
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 848:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4530
+//line mysql_sql.y:4847
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 849:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4534
+//line mysql_sql.y:4851
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 850:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4538
+//line mysql_sql.y:4855
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 796:
+	case 851:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4542
+//line mysql_sql.y:4859
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 797:
+	case 852:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4546
+//line mysql_sql.y:4863
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 798:
+	case 853:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4550
+//line mysql_sql.y:4867
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 854:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4554
+//line mysql_sql.y:4871
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 800:
+	case 855:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4558
+//line mysql_sql.y:4875
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 801:
+	case 856:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4562
+//line mysql_sql.y:4879
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 802:
+	case 857:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4567
+//line mysql_sql.y:4884
 		{
 			yyLOCAL = &tree.CaseExpr{
 				Expr:  yyDollar[2].exprUnion(),
@@ -11407,26 +12350,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 858:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4892
+		{
+			yyLOCAL = tree.NewFullTextMatchExpr(yyDollar[3].unresolveNamesUnion(), yyDollar[7].exprUnion(), yyDollar[8].fullTextSearchTypeUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 859:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4575
+//line mysql_sql.y:4896
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 860:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4579
+//line mysql_sql.y:4900
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 861:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4583
+//line mysql_sql.y:4904
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
@@ -11436,90 +12387,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 862:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4592
+//line mysql_sql.y:4913
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 863:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4596
+//line mysql_sql.y:4917
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
+	case 864:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4600
+//line mysql_sql.y:4921
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 809:
+	case 865:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4604
+//line mysql_sql.y:4925
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 810:
+	case 866:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4929
+		{
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 867:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4609
+//line mysql_sql.y:4934
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 811:
+	case 868:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4613
+//line mysql_sql.y:4938
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 812:
+	case 869:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4618
+//line mysql_sql.y:4943
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 813:
+	case 870:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4622
+//line mysql_sql.y:4947
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 814:
+	case 871:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:4628
+//line mysql_sql.y:4953
 		{
 			yyLOCAL = []*tree.When{yyDollar[1].whenClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 872:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:4632
+//line mysql_sql.y:4957
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseListUnion(), yyDollar[2].whenClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 873:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.When
-//line mysql_sql.y:4638
+//line mysql_sql.y:4963
 		{
 			yyLOCAL = &tree.When{
 				Cond: yyDollar[2].exprUnion(),
@@ -11527,10 +12486,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 875:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4648
+//line mysql_sql.y:4973
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -11548,10 +12507,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 876:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4665
+//line mysql_sql.y:4990
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11566,10 +12525,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 878:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4682
+//line mysql_sql.y:5007
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11583,10 +12542,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 879:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4695
+//line mysql_sql.y:5020
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11600,10 +12559,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 880:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4708
+//line mysql_sql.y:5033
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11616,10 +12575,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 881:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4720
+//line mysql_sql.y:5045
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11634,10 +12593,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 882:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4734
+//line mysql_sql.y:5059
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11653,10 +12612,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 883:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4749
+//line mysql_sql.y:5074
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11672,10 +12631,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 884:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4764
+//line mysql_sql.y:5089
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -11693,10 +12652,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 885:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4781
+//line mysql_sql.y:5106
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11711,15 +12670,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 886:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4796
+//line mysql_sql.y:5121
 		{
 		}
-	case 832:
+	case 889:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4802
+//line mysql_sql.y:5127
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11729,10 +12688,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 890:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4811
+//line mysql_sql.y:5136
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11741,10 +12700,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 891:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4819
+//line mysql_sql.y:5144
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11753,10 +12712,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 892:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4827
+//line mysql_sql.y:5152
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11766,10 +12725,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 893:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4836
+//line mysql_sql.y:5161
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11779,10 +12738,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 894:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4845
+//line mysql_sql.y:5170
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11792,10 +12751,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 895:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4854
+//line mysql_sql.y:5179
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11805,10 +12764,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 896:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4863
+//line mysql_sql.y:5188
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumValWithType(constant.MakeString("*"), "*", false, tree.P_char)
@@ -11818,10 +12777,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 897:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4872
+//line mysql_sql.y:5197
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11831,10 +12790,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 898:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4881
+//line mysql_sql.y:5206
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11844,10 +12803,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 899:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4890
+//line mysql_sql.y:5215
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11857,10 +12816,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 900:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4899
+//line mysql_sql.y:5224
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11870,10 +12829,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 901:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4908
+//line mysql_sql.y:5233
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11883,36 +12842,166 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 902:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4917
+//line mysql_sql.y:5242
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 903:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5251
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 904:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5262
+		{
+			yyDollar[1].funcExprUnion().WindowSpec = yyDollar[4].windowSpecUnion()
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 905:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5267
+		{
+			yyDollar[1].funcExprUnion().WindowSpec = yyDollar[4].windowSpecUnion()
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 906:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.WindowSpec
+//line mysql_sql.y:5274
+		{
+			yyLOCAL = tree.NewWindowSpec(yyDollar[1].exprsUnion(), yyDollar[2].orderByUnion(), yyDollar[3].frameClauseUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 907:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:5279
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 908:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:5283
+		{
+			yyLOCAL = yyDollar[3].exprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 909:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:5288
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 910:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:5292
+		{
+			yyLOCAL = tree.NewFrameClause(yyDollar[1].frameTypeUnion(), yyDollar[2].frameBoundUnion(), nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 911:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:5296
+		{
+			yyLOCAL = tree.NewFrameClause(yyDollar[1].frameTypeUnion(), yyDollar[3].frameBoundUnion(), yyDollar[5].frameBoundUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 912:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FrameType
+//line mysql_sql.y:5302
+		{
+			yyLOCAL = tree.FRAME_ROWS
+		}
+		yyVAL.union = yyLOCAL
+	case 913:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FrameType
+//line mysql_sql.y:5306
+		{
+			yyLOCAL = tree.FRAME_RANGE
+		}
+		yyVAL.union = yyLOCAL
+	case 914:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:5312
+		{
+			yyLOCAL = tree.NewFrameBound(tree.PRECEDING, true, nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 915:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:5316
+		{
+			yyLOCAL = tree.NewFrameBound(tree.FOLLOWING, true, nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 916:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:5320
+		{
+			yyLOCAL = tree.NewFrameBound(tree.CURRENT_ROW, false, nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 917:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:5324
+		{
+			yyLOCAL = tree.NewFrameBound(tree.PRECEDING, false, yyDollar[1].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 918:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:5328
 		{
-			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
-			yyLOCAL = &tree.FuncExpr{
-				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
-			}
+			yyLOCAL = tree.NewFrameBound(tree.FOLLOWING, false, yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 846:
-		yyDollar = yyS[yypt-5 : yypt+1]
-		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4926
+	case 919:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5334
 		{
-			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
-			yyLOCAL = &tree.FuncExpr{
-				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
-			}
+			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 923:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4942
+//line mysql_sql.y:5345
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11921,10 +13010,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 924:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4950
+//line mysql_sql.y:5353
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11933,10 +13022,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 925:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4958
+//line mysql_sql.y:5361
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11945,10 +13034,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 926:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4966
+//line mysql_sql.y:5369
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11957,10 +13046,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 927:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4974
+//line mysql_sql.y:5377
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			timeUinit := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
@@ -11970,10 +13059,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 928:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4983
+//line mysql_sql.y:5386
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11982,10 +13071,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 929:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4991
+//line mysql_sql.y:5394
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -11995,23 +13084,25 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
-		yyDollar = yyS[yypt-5 : yypt+1]
+	case 930:
+		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5000
+//line mysql_sql.y:5403
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
-				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[4].exprUnion()},
-				Type:  yyDollar[3].funcTypeUnion(),
+				Func:      tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:     yyDollar[4].exprsUnion(),
+				Type:      yyDollar[3].funcTypeUnion(),
+				OrderBy:   yyDollar[5].orderByUnion(),
+				Separator: yyDollar[6].separatorUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 931:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5009
+//line mysql_sql.y:5414
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12020,22 +13111,23 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 859:
+	case 932:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5017
+//line mysql_sql.y:5422
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			arg1 := tree.NewNumValWithType(constant.MakeString("both"), "both", false, tree.P_char)
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
-				Exprs: tree.Exprs{yyDollar[3].exprUnion()},
+				Exprs: tree.Exprs{arg1, yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 933:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5025
+//line mysql_sql.y:5431
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg1 := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
@@ -12045,10 +13137,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 934:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5034
+//line mysql_sql.y:5440
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg1 := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
@@ -12058,16 +13150,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 867:
+	case 935:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5449
+		{
+			name := tree.SetUnresolvedName("locate")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 941:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5054
+//line mysql_sql.y:5468
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 896:
+	case 970:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5090
+//line mysql_sql.y:5504
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -12080,10 +13184,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 971:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5102
+//line mysql_sql.y:5516
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -12096,10 +13200,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 972:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5116
+//line mysql_sql.y:5530
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12108,10 +13212,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 973:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5124
+//line mysql_sql.y:5538
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12119,10 +13223,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 974:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5131
+//line mysql_sql.y:5545
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -12130,10 +13234,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 975:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5138
+//line mysql_sql.y:5552
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -12146,10 +13250,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 976:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5150
+//line mysql_sql.y:5564
+		{
+			name := tree.SetUnresolvedName("grouping")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[3].exprsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 977:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5572
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -12158,10 +13274,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 978:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5158
+//line mysql_sql.y:5580
 		{
 			cn := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
 			es := yyDollar[3].exprsUnion()
@@ -12173,10 +13289,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 979:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5169
+//line mysql_sql.y:5591
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("date")
@@ -12186,10 +13302,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 980:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5178
+//line mysql_sql.y:5600
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("time")
@@ -12199,10 +13315,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 981:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5187
+//line mysql_sql.y:5609
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -12211,10 +13327,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 982:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5195
+//line mysql_sql.y:5617
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -12225,10 +13341,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 983:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5627
+		{
+			name := tree.SetUnresolvedName("values")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].unresolvedNameUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 984:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5205
+//line mysql_sql.y:5635
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -12237,10 +13365,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 985:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5213
+//line mysql_sql.y:5643
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -12249,10 +13377,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 986:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:5221
+//line mysql_sql.y:5651
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("timestamp")
@@ -12262,34 +13390,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 987:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5231
+//line mysql_sql.y:5661
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 988:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5235
+//line mysql_sql.y:5665
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 989:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5241
+//line mysql_sql.y:5671
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 990:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5245
+//line mysql_sql.y:5675
 		{
 			ival, errStr := util.GetInt64(yyDollar[2].item)
 			if errStr != "" {
@@ -12300,20 +13428,20 @@ yydefault:
 			yyLOCAL = tree.NewNumValWithType(constant.MakeInt64(ival), str, false, tree.P_int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 997:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5264
+//line mysql_sql.y:5694
 		{
 		}
-	case 922:
+	case 998:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:5266
+//line mysql_sql.y:5696
 		{
 		}
-	case 955:
+	case 1032:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5306
+//line mysql_sql.y:5737
 		{
 			name := tree.SetUnresolvedName("interval")
 			arg2 := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false, tree.P_char)
@@ -12323,90 +13451,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 1033:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:5316
+//line mysql_sql.y:5747
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 1034:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:5320
+//line mysql_sql.y:5751
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 1035:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:5324
+//line mysql_sql.y:5755
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 1036:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:5330
+//line mysql_sql.y:5761
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 1037:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5335
+//line mysql_sql.y:5766
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 1038:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5339
+//line mysql_sql.y:5770
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 1039:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5345
+//line mysql_sql.y:5776
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 1040:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:5349
+//line mysql_sql.y:5780
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 1041:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5356
+//line mysql_sql.y:5787
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 965:
+	case 1042:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5360
+//line mysql_sql.y:5791
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 1043:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5364
+//line mysql_sql.y:5795
 		{
 			name := tree.SetUnresolvedName(strings.ToLower("concat"))
 			yyLOCAL = &tree.FuncExpr{
@@ -12415,332 +13543,332 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 1044:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5372
+//line mysql_sql.y:5803
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 1045:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5376
+//line mysql_sql.y:5807
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 1046:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5380
+//line mysql_sql.y:5811
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.EQUAL, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 1047:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5384
+//line mysql_sql.y:5815
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_EQUAL, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 1048:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5388
+//line mysql_sql.y:5819
 		{
 			arg := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), "", false, tree.P_char)
 			yyLOCAL = tree.NewComparisonExpr(tree.EQUAL, yyDollar[1].exprUnion(), arg)
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1049:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5393
+//line mysql_sql.y:5824
 		{
 			arg := tree.NewNumValWithType(constant.MakeString(yyDollar[3].str), "", false, tree.P_char)
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_EQUAL, yyDollar[1].exprUnion(), arg)
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 1050:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5398
+//line mysql_sql.y:5829
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 974:
+	case 1051:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5404
+//line mysql_sql.y:5835
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 1052:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5408
+//line mysql_sql.y:5839
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 1053:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5412
+//line mysql_sql.y:5843
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 1054:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5416
+//line mysql_sql.y:5847
 		{
 			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 1056:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5423
+//line mysql_sql.y:5854
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(true), "", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 980:
+	case 1057:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5427
+//line mysql_sql.y:5858
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(false), "", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 1058:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5433
+//line mysql_sql.y:5864
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 1059:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5437
+//line mysql_sql.y:5868
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 1060:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5441
+//line mysql_sql.y:5872
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1061:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5445
+//line mysql_sql.y:5876
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1062:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5449
+//line mysql_sql.y:5880
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1063:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5453
+//line mysql_sql.y:5884
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1064:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5457
+//line mysql_sql.y:5888
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1065:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5461
+//line mysql_sql.y:5892
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 990:
+	case 1067:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5467
+//line mysql_sql.y:5898
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 991:
+	case 1068:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5471
+//line mysql_sql.y:5902
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 992:
+	case 1069:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5477
+//line mysql_sql.y:5908
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 993:
+	case 1070:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5481
+//line mysql_sql.y:5912
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 1071:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5488
+//line mysql_sql.y:5919
 		{
 			yyLOCAL = tree.ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 995:
+	case 1072:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5492
+//line mysql_sql.y:5923
 		{
 			yyLOCAL = tree.ANY
 		}
 		yyVAL.union = yyLOCAL
-	case 996:
+	case 1073:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5496
+//line mysql_sql.y:5927
 		{
 			yyLOCAL = tree.SOME
 		}
 		yyVAL.union = yyLOCAL
-	case 997:
+	case 1074:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5502
+//line mysql_sql.y:5933
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 998:
+	case 1075:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5506
+//line mysql_sql.y:5937
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 999:
+	case 1076:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5510
+//line mysql_sql.y:5941
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 1000:
+	case 1077:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5514
+//line mysql_sql.y:5945
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1001:
+	case 1078:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5518
+//line mysql_sql.y:5949
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1002:
+	case 1079:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5522
+//line mysql_sql.y:5953
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1003:
+	case 1080:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5526
+//line mysql_sql.y:5957
 		{
 			yyLOCAL = tree.NULL_SAFE_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1004:
+	case 1081:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5532
+//line mysql_sql.y:5963
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1005:
+	case 1082:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5536
+//line mysql_sql.y:5967
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1006:
+	case 1083:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5540
+//line mysql_sql.y:5971
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 1007:
+	case 1084:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5544
+//line mysql_sql.y:5975
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1008:
+	case 1085:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5550
+//line mysql_sql.y:5981
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 1009:
+	case 1086:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5554
+//line mysql_sql.y:5985
 		{
 			str := fmt.Sprintf("%v", yyDollar[1].item)
 			switch v := yyDollar[1].item.(type) {
@@ -12754,43 +13882,43 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1010:
+	case 1087:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5567
+//line mysql_sql.y:5998
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithType(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, tree.P_float64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1011:
+	case 1088:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5572
+//line mysql_sql.y:6003
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(true), "true", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1012:
+	case 1089:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5576
+//line mysql_sql.y:6007
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(false), "false", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1013:
+	case 1090:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5580
+//line mysql_sql.y:6011
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeUnknown(), "null", false, tree.P_null)
 		}
 		yyVAL.union = yyLOCAL
-	case 1014:
+	case 1091:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5584
+//line mysql_sql.y:6015
 		{
 			switch v := yyDollar[1].item.(type) {
 			case uint64:
@@ -12805,18 +13933,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1015:
+	case 1092:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5598
+//line mysql_sql.y:6029
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_decimal)
 		}
 		yyVAL.union = yyLOCAL
-	case 1016:
+	case 1093:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5602
+//line mysql_sql.y:6033
 		{
 			switch v := yyDollar[1].item.(type) {
 			case uint64:
@@ -12831,45 +13959,45 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1017:
+	case 1094:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5616
+//line mysql_sql.y:6047
 		{
-			yyLOCAL = tree.NewParamExpr(yyp)
+			yyLOCAL = tree.NewParamExpr(yylex.(*Lexer).GetParamIndex())
 		}
 		yyVAL.union = yyLOCAL
-	case 1018:
+	case 1095:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5622
+//line mysql_sql.y:6053
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1022:
+	case 1099:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5633
+//line mysql_sql.y:6064
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1023:
+	case 1100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5638
+//line mysql_sql.y:6069
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1024:
+	case 1101:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5644
+//line mysql_sql.y:6075
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12882,10 +14010,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1025:
+	case 1102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5656
+//line mysql_sql.y:6087
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12898,10 +14026,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1026:
+	case 1103:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5668
+//line mysql_sql.y:6099
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12914,10 +14042,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1027:
+	case 1104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5680
+//line mysql_sql.y:6111
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12931,10 +14059,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1028:
+	case 1105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5693
+//line mysql_sql.y:6124
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12948,10 +14076,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1029:
+	case 1106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5706
+//line mysql_sql.y:6137
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12965,10 +14093,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1030:
+	case 1107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5719
+//line mysql_sql.y:6150
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12982,10 +14110,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1031:
+	case 1108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5732
+//line mysql_sql.y:6163
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12999,10 +14127,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1032:
+	case 1109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5745
+//line mysql_sql.y:6176
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13016,10 +14144,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1033:
+	case 1110:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5758
+//line mysql_sql.y:6189
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13033,10 +14161,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1034:
+	case 1111:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5771
+//line mysql_sql.y:6202
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13050,10 +14178,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1035:
+	case 1112:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5784
+//line mysql_sql.y:6215
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13067,10 +14195,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1036:
+	case 1113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5797
+//line mysql_sql.y:6228
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13084,10 +14212,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1037:
+	case 1114:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5810
+//line mysql_sql.y:6241
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13101,10 +14229,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1038:
+	case 1115:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5825
+//line mysql_sql.y:6256
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -13128,10 +14256,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1039:
+	case 1116:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5848
+//line mysql_sql.y:6279
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -13168,10 +14296,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1040:
+	case 1117:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5885
+//line mysql_sql.y:6316
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -13208,10 +14336,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1041:
+	case 1118:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5933
+//line mysql_sql.y:6364
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13227,10 +14355,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1042:
+	case 1119:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5950
+//line mysql_sql.y:6381
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13243,10 +14371,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1043:
+	case 1120:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5962
+//line mysql_sql.y:6393
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13262,10 +14390,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1044:
+	case 1121:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5977
+//line mysql_sql.y:6408
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -13286,10 +14414,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1045:
+	case 1122:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5997
+//line mysql_sql.y:6428
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -13310,10 +14438,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1046:
+	case 1123:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6017
+//line mysql_sql.y:6448
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13328,10 +14456,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1047:
+	case 1124:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6033
+//line mysql_sql.y:6464
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13345,10 +14473,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1048:
+	case 1125:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6046
+//line mysql_sql.y:6477
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13362,10 +14490,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1049:
+	case 1126:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6059
+//line mysql_sql.y:6490
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13379,10 +14507,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1050:
+	case 1127:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6072
+//line mysql_sql.y:6503
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13396,10 +14524,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1051:
+	case 1128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6085
+//line mysql_sql.y:6516
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13412,10 +14540,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1052:
+	case 1129:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6097
+//line mysql_sql.y:6528
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13428,10 +14556,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1053:
+	case 1130:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6109
+//line mysql_sql.y:6540
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13444,10 +14572,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1054:
+	case 1131:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6121
+//line mysql_sql.y:6552
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13460,10 +14588,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1055:
+	case 1132:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6133
+//line mysql_sql.y:6564
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13476,10 +14604,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1056:
+	case 1133:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6145
+//line mysql_sql.y:6576
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13492,10 +14620,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1057:
+	case 1134:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6157
+//line mysql_sql.y:6588
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13508,10 +14636,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1058:
+	case 1135:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6169
+//line mysql_sql.y:6600
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13524,10 +14652,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1059:
+	case 1136:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6181
+//line mysql_sql.y:6612
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13540,10 +14668,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1060:
+	case 1137:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:6624
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.UuidFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					Oid:          uint32(defines.MYSQL_TYPE_UUID),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1138:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6193
+//line mysql_sql.y:6636
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13557,10 +14701,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1061:
+	case 1139:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6206
+//line mysql_sql.y:6649
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13574,10 +14718,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1062:
+	case 1140:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6221
+//line mysql_sql.y:6664
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -13590,75 +14734,75 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1063:
+	case 1141:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6244
+//line mysql_sql.y:6687
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1064:
+	case 1142:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6249
+//line mysql_sql.y:6692
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1065:
+	case 1143:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6255
+//line mysql_sql.y:6698
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1067:
+	case 1145:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6262
+//line mysql_sql.y:6705
 		{
 			yyLOCAL = 6
 		}
 		yyVAL.union = yyLOCAL
-	case 1068:
+	case 1146:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6266
+//line mysql_sql.y:6709
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1069:
+	case 1147:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6271
+//line mysql_sql.y:6714
 		{
 			yyLOCAL = int32(-1)
 		}
 		yyVAL.union = yyLOCAL
-	case 1070:
+	case 1148:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6275
+//line mysql_sql.y:6718
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1071:
+	case 1149:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:6281
+//line mysql_sql.y:6724
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1072:
+	case 1150:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6287
+//line mysql_sql.y:6730
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -13666,10 +14810,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1073:
+	case 1151:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6294
+//line mysql_sql.y:6737
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -13677,10 +14821,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1074:
+	case 1152:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6301
+//line mysql_sql.y:6744
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -13688,10 +14832,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1075:
+	case 1153:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6310
+//line mysql_sql.y:6753
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 10, // this is the default precision for decimal
@@ -13699,10 +14843,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1076:
+	case 1154:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6317
+//line mysql_sql.y:6760
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -13710,10 +14854,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1077:
+	case 1155:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:6324
+//line mysql_sql.y:6767
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -13721,52 +14865,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1078:
+	case 1156:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6333
+//line mysql_sql.y:6776
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1079:
+	case 1157:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6337
+//line mysql_sql.y:6780
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1080:
+	case 1158:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6341
+//line mysql_sql.y:6784
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1081:
+	case 1159:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6347
+//line mysql_sql.y:6790
 		{
 		}
-	case 1082:
+	case 1160:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6349
+//line mysql_sql.y:6792
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1086:
+	case 1164:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:6359
+//line mysql_sql.y:6802
 		{
 			yyVAL.str = ""
 		}
-	case 1087:
+	case 1165:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:6363
+//line mysql_sql.y:6806
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
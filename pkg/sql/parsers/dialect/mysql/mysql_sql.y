@@ -47,6 +47,7 @@ import (
     tableExpr tree.TableExpr
     rowFormatType tree.RowFormatType
     matchType tree.MatchType
+    fullTextSearchType tree.FullTextSearchType
     attributeReference *tree.AttributeReference
 
     from *tree.From
@@ -163,11 +164,22 @@ import (
     withClause *tree.With
     cte *tree.CTE
     cteList []*tree.CTE
+
+    alterTableOptions tree.AlterTableOptions
+    alterTableOption tree.AlterTableOption
+
+    windowSpec *tree.WindowSpec
+    frameClause *tree.FrameClause
+    frameBound *tree.FrameBound
+    frameType tree.FrameType
+
+    separator *string
 }
 
 %token LEX_ERROR
 %nonassoc EMPTY
-%left <str> UNION
+%left <str> UNION EXCEPT
+%left <str> INTERSECT
 %token <str> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
 %nonassoc LOWER_THAN_SET
 %nonassoc <str> SET
@@ -183,6 +195,7 @@ import (
 %nonassoc <str> ID AT_ID AT_AT_ID STRING VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD
 %token <item> INTEGRAL HEX BIT_LITERAL FLOAT HEXNUM
 %token <str> NULL TRUE FALSE
+%token <str> JSON_EXTRACT_OP JSON_UNQUOTE_EXTRACT_OP
 %nonassoc LOWER_THAN_CHARSET
 %nonassoc <str> CHARSET
 %right <str> UNIQUE KEY
@@ -214,7 +227,7 @@ import (
 %token <str> TIME TIMESTAMP DATETIME YEAR
 %token <str> CHAR VARCHAR BOOL CHARACTER VARBINARY NCHAR
 %token <str> TEXT TINYTEXT MEDIUMTEXT LONGTEXT
-%token <str> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM
+%token <str> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM UUID
 %token <str> GEOMETRY POINT LINESTRING POLYGON GEOMETRYCOLLECTION MULTIPOINT MULTILINESTRING MULTIPOLYGON
 %token <str> INT1 INT2 INT3 INT4 INT8
 
@@ -272,7 +285,7 @@ import (
 
 // SET tokens
 %token <str> NAMES GLOBAL SESSION ISOLATION LEVEL READ WRITE ONLY REPEATABLE COMMITTED UNCOMMITTED SERIALIZABLE
-%token <str> LOCAL EXCEPT
+%token <str> LOCAL
 
 // Functions
 %token <str> CURRENT_TIMESTAMP DATABASE
@@ -291,6 +304,9 @@ import (
 // With
 %token <str> RECURSIVE CONFIG
 
+// Lateral
+%token <str> LATERAL
+
 // Match
 %token <str> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION
 
@@ -301,6 +317,12 @@ import (
 %token <str> STDDEV_POP STDDEV_SAMP SUBDATE SUBSTR SUBSTRING SUM SYSDATE
 %token <str> SYSTEM_USER TRANSLATE TRIM VARIANCE VAR_POP VAR_SAMP AVG
 
+// Window function
+%token <str> OVER ROWS UNBOUNDED PRECEDING FOLLOWING CURRENT
+
+// Grouping sets
+%token <str> ROLLUP CUBE GROUPING SETS
+
 // Insert
 %token <str> ROW OUTFILE HEADER MAX_FILE_SIZE FORCE_QUOTE
 
@@ -310,14 +332,14 @@ import (
 %type <statements> stmt_list
 %type <statement> create_stmt insert_stmt delete_stmt drop_stmt alter_stmt
 %type <statement> delete_without_using_stmt delete_with_using_stmt
-%type <statement> drop_ddl_stmt drop_database_stmt drop_table_stmt drop_index_stmt drop_prepare_stmt
+%type <statement> drop_ddl_stmt drop_database_stmt drop_table_stmt drop_view_stmt drop_index_stmt drop_prepare_stmt
 %type <statement> drop_role_stmt drop_user_stmt
 %type <statement> create_user_stmt create_role_stmt
 %type <statement> create_ddl_stmt create_table_stmt create_database_stmt create_index_stmt create_view_stmt
 %type <statement> show_stmt show_create_stmt show_columns_stmt show_databases_stmt show_target_filter_stmt
 %type <statement> show_tables_stmt show_process_stmt show_errors_stmt show_warnings_stmt
 %type <statement> show_variables_stmt show_status_stmt show_index_stmt
-%type <statement> alter_user_stmt update_stmt use_stmt update_no_with_stmt
+%type <statement> alter_user_stmt alter_table_stmt update_stmt use_stmt update_no_with_stmt
 %type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
 %type <statement> explain_stmt explainable_stmt
 %type <statement> set_stmt set_variable_stmt set_password_stmt set_role_stmt set_default_role_stmt
@@ -336,6 +358,7 @@ import (
 %type <direction> asc_desc_opt
 %type <order> order
 %type <orderBy> order_list order_by_clause order_by_opt
+%type <separator> separator_opt
 %type <limit> limit_opt limit_clause
 %type <str> insert_column
 %type <identifierList> column_list column_list_opt partition_clause_opt partition_id_list insert_column_list
@@ -346,6 +369,8 @@ import (
 %type <tableName> table_name table_name_opt_wild
 %type <tableNames> table_name_list
 %type <columnTableDef> column_def
+%type <alterTableOptions> alter_table_option_list
+%type <alterTableOption> alter_table_option alter_partition_option
 %type <columnType> mo_cast_type mysql_cast_type
 %type <columnType> column_type char_type spatial_type time_type numeric_type decimal_type int_type
 %type <str> integer_opt
@@ -356,6 +381,7 @@ import (
 %type <rowFormatType> row_format_options
 %type <int64Val> field_length_opt max_file_size_opt
 %type <matchType> match match_opt
+%type <fullTextSearchType> fulltext_search_opt
 %type <referenceOptionType> ref_opt on_delete on_update
 %type <referenceOnRecord> on_delete_update_opt on_delete_update
 %type <attributeReference> references_def
@@ -363,7 +389,9 @@ import (
 %type <tableOption> table_option
 %type <from> from_clause from_opt
 %type <where> where_expression_opt having_opt
-%type <groupBy> group_by_opt
+%type <groupBy> group_by_opt group_by_list
+%type <expr> group_by_item grouping_set
+%type <exprs> grouping_set_list
 %type <aliasedTableExpr> aliased_table_name
 %type <unionTypeRecord> union_op
 %type <parenTableExpr> derived_table
@@ -373,6 +401,13 @@ import (
 %type <funcExpr> function_call_keyword
 %type <funcExpr> function_call_nonkeyword
 %type <funcExpr> function_call_aggregate
+%type <funcExpr> function_call_window
+%type <windowSpec> window_spec
+%type <exprs> window_partition_by_opt
+%type <frameClause> frame_clause_opt
+%type <frameType> frame_units
+%type <frameBound> frame_bound
+%type <expr> frame_offset
 
 %type <unresolvedName> column_name column_name_unresolved
 %type <strs> enum_values force_quote_opt force_quote_list
@@ -422,7 +457,7 @@ import (
 %type <resourceOptions> conn_option_list conn_options
 %type <resourceOption> conn_option
 %type <updateExpr> update_expression
-%type <updateExprs> update_list
+%type <updateExprs> update_list on_duplicate_key_update_opt
 %type <completionType> completion_type
 %type <str> password_opt
 %type <boolVal> grant_option_opt enforce enforce_opt
@@ -487,7 +522,7 @@ import (
 %type <property> property_elem
 %type <assignments> set_value_list
 %type <assignment> set_value
-%type <str> row_opt substr_option
+%type <str> row_opt substr_option column_keyword_opt to_or_as_opt
 %type <str> time_unit time_stamp_unit
 %type <whenClause> when_clause
 %type <whenClauseList> when_clause_list
@@ -1027,6 +1062,27 @@ priv_elem:
         }
     }
 
+fulltext_search_opt:
+    {
+        $$ = tree.FULLTEXT_NATURAL_LANGUAGE
+    }
+|   IN NATURAL LANGUAGE MODE
+    {
+        $$ = tree.FULLTEXT_NATURAL_LANGUAGE
+    }
+|   IN NATURAL LANGUAGE MODE WITH QUERY EXPANSION
+    {
+        $$ = tree.FULLTEXT_NATURAL_LANGUAGE_WITH_QUERY_EXPANSION
+    }
+|   IN BOOLEAN MODE
+    {
+        $$ = tree.FULLTEXT_BOOLEAN
+    }
+|   WITH QUERY EXPANSION
+    {
+        $$ = tree.FULLTEXT_QUERY_EXPANSION
+    }
+
 column_name_list:
     column_name
     {
@@ -1712,7 +1768,85 @@ analyze_stmt:
 
 alter_stmt:
     alter_user_stmt
-// |    alter_ddl_stmt
+|   alter_table_stmt
+
+alter_table_stmt:
+    ALTER TABLE table_name alter_table_option_list
+    {
+        $$ = tree.NewAlterTable($3, $4)
+    }
+|   ALTER TABLE table_name alter_partition_option
+    {
+        $$ = tree.NewAlterTable($3, tree.AlterTableOptions{$4})
+    }
+
+alter_partition_option:
+    ADD PARTITION '(' partition_list ')'
+    {
+        $$ = tree.NewAlterOptionAddPartition($4)
+    }
+|   DROP PARTITION partition_id_list
+    {
+        $$ = tree.NewAlterOptionDropPartition($3)
+    }
+|   TRUNCATE PARTITION partition_id_list
+    {
+        $$ = tree.NewAlterOptionTruncatePartition($3)
+    }
+|   TRUNCATE PARTITION ALL
+    {
+        $$ = tree.NewAlterOptionTruncatePartition(nil)
+    }
+
+alter_table_option_list:
+    alter_table_option
+    {
+        $$ = tree.AlterTableOptions{$1}
+    }
+|   alter_table_option_list ',' alter_table_option
+    {
+        $$ = append($1, $3)
+    }
+
+alter_table_option:
+    ADD column_keyword_opt column_def
+    {
+        $$ = tree.NewAlterOptionAddColumn($3)
+    }
+|   DROP column_keyword_opt column_name
+    {
+        $$ = tree.NewAlterOptionDropColumn($3)
+    }
+|   RENAME COLUMN column_name TO column_name
+    {
+        $$ = tree.NewAlterOptionRenameColumn($3, $5)
+    }
+|   RENAME to_or_as_opt table_name
+    {
+        $$ = tree.NewAlterOptionRenameTable($3)
+    }
+
+column_keyword_opt:
+    {
+        $$ = ""
+    }
+|   COLUMN
+    {
+        $$ = $1
+    }
+
+to_or_as_opt:
+    {
+        $$ = ""
+    }
+|   TO
+    {
+        $$ = $1
+    }
+|   AS
+    {
+        $$ = $1
+    }
 
 alter_user_stmt:
     ALTER USER exists_opt user_spec_list require_clause_opt conn_options pwd_or_lck_opt
@@ -1999,6 +2133,10 @@ show_create_stmt:
     {
         $$ = &tree.ShowCreateTable{Name: $4}
     }
+|   SHOW CREATE VIEW table_name_unresolved
+    {
+        $$ = &tree.ShowCreateView{Name: $4}
+    }
 |   SHOW CREATE DATABASE not_exists_opt db_name
     {
         $$ = &tree.ShowCreateDatabase{IfNotExists: $4, Name: $5}
@@ -2038,6 +2176,7 @@ drop_ddl_stmt:
     drop_database_stmt
 |   drop_prepare_stmt
 |   drop_table_stmt
+|   drop_view_stmt
 |   drop_index_stmt
 |   drop_role_stmt
 |   drop_user_stmt
@@ -2076,6 +2215,12 @@ drop_table_stmt:
         $$ = &tree.DropTable{IfExists: $3, Names: $4}
     }
 
+drop_view_stmt:
+    DROP VIEW exists_opt table_name_list
+    {
+        $$ = &tree.DropView{IfExists: $3, Names: $4}
+    }
+
 drop_database_stmt:
     DROP DATABASE exists_opt database_id
     {
@@ -2188,14 +2333,24 @@ ignore_opt:
 |	IGNORE
 
 insert_stmt:
-    INSERT into_table_name partition_clause_opt insert_data
+    INSERT into_table_name partition_clause_opt insert_data on_duplicate_key_update_opt
     {
         ins := $4
         ins.Table = $2
         ins.PartitionNames = $3
+        ins.OnDuplicateUpdate = $5
         $$ = ins
     }
 
+on_duplicate_key_update_opt:
+    {
+        $$ = nil
+    }
+|   ON DUPLICATE KEY UPDATE update_list
+    {
+        $$ = $5
+    }
+
 insert_data:
     VALUES values_list
     {
@@ -2593,6 +2748,16 @@ limit_clause:
         $$ = &tree.Limit{Offset: $4, Count: $2}
     }
 
+separator_opt:
+    {
+        $$ = nil
+    }
+|   SEPARATOR STRING
+    {
+        s := $2
+        $$ = &s
+    }
+
 order_by_opt:
     {
         $$ = nil
@@ -2655,43 +2820,19 @@ simple_select:
     }
 |   simple_select union_op simple_select_clause
     {
-        $$ = &tree.UnionClause{
-            Type: $2.Type,
-            Left: $1,
-            Right: $3,
-            All: $2.All,
-            Distinct: $2.Distinct,
-        }
+        $$ = tree.NewUnionClauseWithRecord($1, $2, $3)
     }
 |   select_with_parens union_op simple_select_clause
     {
-        $$ = &tree.UnionClause{
-            Type: $2.Type,
-            Left: $1,
-            Right: $3,
-            All: $2.All,
-            Distinct: $2.Distinct,
-        }
+        $$ = tree.NewUnionClauseWithRecord($1, $2, $3)
     }
 |   simple_select union_op select_with_parens
     {
-        $$ = &tree.UnionClause{
-            Type: $2.Type,
-            Left: $1,
-            Right: $3,
-            All: $2.All,
-            Distinct: $2.Distinct,
-        }
+        $$ = tree.NewUnionClauseWithRecord($1, $2, $3)
     }
 |   select_with_parens union_op select_with_parens
     {
-        $$ = &tree.UnionClause{
-            Type: $2.Type,
-            Left: $1,
-            Right: $3,
-            All: $2.All,
-            Distinct: $2.Distinct,
-        }
+        $$ = tree.NewUnionClauseWithRecord($1, $2, $3)
     }
 
 union_op:
@@ -2719,6 +2860,54 @@ union_op:
             Distinct: true,
         }
     }
+|   INTERSECT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.INTERSECT,
+            All: false,
+            Distinct: false,
+        }
+    }
+|   INTERSECT ALL
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.INTERSECT,
+            All: true,
+            Distinct: false,
+        }
+    }
+|   INTERSECT DISTINCT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.INTERSECT,
+            All: false,
+            Distinct: true,
+        }
+    }
+|   EXCEPT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.EXCEPT,
+            All: false,
+            Distinct: false,
+        }
+    }
+|   EXCEPT ALL
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.EXCEPT,
+            All: true,
+            Distinct: false,
+        }
+    }
+|   EXCEPT DISTINCT
+    {
+        $$ = &tree.UnionTypeRecord{
+            Type: tree.EXCEPT,
+            All: false,
+            Distinct: true,
+        }
+    }
 
 simple_select_clause:
     SELECT distinct_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
@@ -2780,9 +2969,63 @@ group_by_opt:
     {
         $$ = nil
     }
-|   GROUP BY expression_list
+|   GROUP BY group_by_list
+    {
+        $$ = $3
+    }
+|   GROUP BY group_by_list WITH ROLLUP
+    {
+        exprs := make(tree.Exprs, len($3))
+        for i, e := range $3 {
+            if _, ok := e.(*tree.GroupingSetsExpr); ok {
+                yylex.Error("WITH ROLLUP cannot be used with ROLLUP, CUBE or GROUPING SETS")
+                return 1
+            }
+            exprs[i] = e
+        }
+        $$ = tree.GroupBy{tree.NewGroupingSetsExpr(tree.GROUPING_ROLLUP, exprs)}
+    }
+
+group_by_list:
+    group_by_item
+    {
+        $$ = tree.GroupBy{$1}
+    }
+|   group_by_list ',' group_by_item
+    {
+        $$ = append($1, $3)
+    }
+
+group_by_item:
+    expression
+|   ROLLUP '(' expression_list ')'
+    {
+        $$ = tree.NewGroupingSetsExpr(tree.GROUPING_ROLLUP, $3)
+    }
+|   CUBE '(' expression_list ')'
+    {
+        $$ = tree.NewGroupingSetsExpr(tree.GROUPING_CUBE, $3)
+    }
+|   GROUPING SETS '(' grouping_set_list ')'
+    {
+        $$ = tree.NewGroupingSetsExpr(tree.GROUPING_SETS, $4)
+    }
+
+grouping_set_list:
+    grouping_set
+    {
+        $$ = tree.Exprs{$1}
+    }
+|   grouping_set_list ',' grouping_set
+    {
+        $$ = append($1, $3)
+    }
+
+grouping_set:
+    expression
+|   '(' ')'
     {
-        $$ = tree.GroupBy($3)
+        $$ = tree.NewTuple(nil)
     }
 
 where_expression_opt:
@@ -3005,6 +3248,17 @@ table_factor:
             },
         }
     }
+|   LATERAL derived_table as_opt ident column_list_opt
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $2,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($4),
+                Cols: $5,
+            },
+            Lateral: true,
+        }
+    }
 // |   '(' table_references ')'
 
 derived_table:
@@ -3117,6 +3371,17 @@ create_view_stmt:
        }
     }
 
+|	CREATE OR REPLACE temporary_opt view_recursive_opt VIEW table_name column_list_opt AS select_stmt
+	{
+		$$ = &tree.CreateView{
+			Name: $7,
+			ColNames: $8,
+			AsSource: $10,
+			Temporary: $4,
+			Replace: true,
+		}
+	}
+
 view_recursive_opt:
 	{}
 |	RECURSIVE
@@ -3679,6 +3944,22 @@ values_opt:
     {
         $$ = &tree.ValuesLessThan{ValueList: $5}
     }
+|   VALUES LESS THAN MAXVALUE
+    {
+        $$ = &tree.ValuesLessThan{ValueList: tree.Exprs{tree.NewMaxValue()}}
+    }
+|   VALUES LESS THAN '(' MAXVALUE ')'
+    {
+        $$ = &tree.ValuesLessThan{ValueList: tree.Exprs{tree.NewMaxValue()}}
+    }
+|   VALUES IN '(' expression_list ')'
+    {
+        vals := make([]tree.Exprs, len($4))
+        for i, e := range $4 {
+            vals[i] = tree.Exprs{e}
+        }
+        $$ = &tree.ValuesIn{ValueList: vals}
+    }
 
 sub_partition_num_opt:
     {
@@ -3754,6 +4035,15 @@ sub_partition_method:
             },
         }
     }
+|   linear_opt KEY algorithm_opt '(' ')'
+    {
+        $$ = &tree.PartitionBy{
+            PType: &tree.KeyType{
+                Linear: $1,
+                Algorithm: $3,
+            },
+        }
+    }
 |   linear_opt HASH '(' bit_expr ')'
     {
         $$ = &tree.PartitionBy{
@@ -4045,6 +4335,10 @@ constaint_def:
 			switch v := $2.(type) {
             case *tree.PrimaryKeyIndex:
             	v.Name = $1
+            case *tree.CheckIndex:
+            	v.Name = $1
+            case *tree.ForeignKey:
+            	v.Name = $1
             }
 		}
 		$$ = $2
@@ -4125,7 +4419,7 @@ constraint_elem:
 
 enforce_opt:
 	{
-		$$ = false
+		$$ = true
 	}
 |	enforce
 
@@ -4280,7 +4574,7 @@ column_attribute_elem:
     }
 |   constraint_keyword_opt CHECK '(' expression ')'
     {
-        $$ = tree.NewAttributeCheck($4, false, $1)
+        $$ = tree.NewAttributeCheck($4, true, $1)
     }
 |   constraint_keyword_opt CHECK '(' expression ')' enforce
     {
@@ -4518,6 +4812,29 @@ simple_expr:
     {
         $$ = $1
     }
+|   normal_ident JSON_EXTRACT_OP STRING
+    {
+        name := tree.SetUnresolvedName("json_extract")
+        path := tree.NewNumValWithType(constant.MakeString($3), $3, false, tree.P_char)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, path},
+        }
+    }
+|   normal_ident JSON_UNQUOTE_EXTRACT_OP STRING
+    {
+        name := tree.SetUnresolvedName("json_extract")
+        path := tree.NewNumValWithType(constant.MakeString($3), $3, false, tree.P_char)
+        extract := &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, path},
+        }
+        name = tree.SetUnresolvedName("json_unquote")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{extract},
+        }
+    }
 |   variable
     {
         $$ = $1
@@ -4571,6 +4888,10 @@ simple_expr:
 			Else: $4,
 		}
 	}
+|   MATCH '(' column_name_list ')' AGAINST '(' bit_expr fulltext_search_opt ')'
+    {
+        $$ = tree.NewFullTextMatchExpr($3, $7, $8)
+    }
 |   CAST '(' expression AS mo_cast_type ')'
     {
         $$ = tree.NewCastExpr($3, $5)
@@ -4604,6 +4925,10 @@ simple_expr:
     {
         $$ = $1
     }
+|   function_call_window
+    {
+        $$ = $1
+    }
 
 else_opt:
 	{
@@ -4932,6 +5257,84 @@ function_call_aggregate:
         }
     }
 
+function_call_window:
+    function_call_generic OVER '(' window_spec ')'
+    {
+        $1.WindowSpec = $4
+        $$ = $1
+    }
+|   function_call_aggregate OVER '(' window_spec ')'
+    {
+        $1.WindowSpec = $4
+        $$ = $1
+    }
+
+window_spec:
+    window_partition_by_opt order_by_opt frame_clause_opt
+    {
+        $$ = tree.NewWindowSpec($1, $2, $3)
+    }
+
+window_partition_by_opt:
+    {
+        $$ = nil
+    }
+|   PARTITION BY expression_list
+    {
+        $$ = $3
+    }
+
+frame_clause_opt:
+    {
+        $$ = nil
+    }
+|   frame_units frame_bound
+    {
+        $$ = tree.NewFrameClause($1, $2, nil)
+    }
+|   frame_units BETWEEN frame_bound AND frame_bound
+    {
+        $$ = tree.NewFrameClause($1, $3, $5)
+    }
+
+frame_units:
+    ROWS
+    {
+        $$ = tree.FRAME_ROWS
+    }
+|   RANGE
+    {
+        $$ = tree.FRAME_RANGE
+    }
+
+frame_bound:
+    UNBOUNDED PRECEDING
+    {
+        $$ = tree.NewFrameBound(tree.PRECEDING, true, nil)
+    }
+|   UNBOUNDED FOLLOWING
+    {
+        $$ = tree.NewFrameBound(tree.FOLLOWING, true, nil)
+    }
+|   CURRENT ROW
+    {
+        $$ = tree.NewFrameBound(tree.CURRENT_ROW, false, nil)
+    }
+|   frame_offset PRECEDING
+    {
+        $$ = tree.NewFrameBound(tree.PRECEDING, false, $1)
+    }
+|   frame_offset FOLLOWING
+    {
+        $$ = tree.NewFrameBound(tree.FOLLOWING, false, $1)
+    }
+
+frame_offset:
+    literal
+    {
+        $$ = $1
+    }
+
 std_dev_pop:
     STD
 |   STDDEV
@@ -4996,13 +5399,15 @@ function_call_generic:
             Type: $3,
         }
 	}
-|	GROUP_CONCAT '(' func_type_opt expression ')'
+|	GROUP_CONCAT '(' func_type_opt expression_list order_by_opt separator_opt ')'
 	{
 		name := tree.SetUnresolvedName(strings.ToLower($1))
         $$ = &tree.FuncExpr{
             Func: tree.FuncName2ResolvableFunctionReference(name),
-            Exprs: tree.Exprs{$4},
+            Exprs: $4,
             Type: $3,
+            OrderBy: $5,
+            Separator: $6,
         }
 	}
 |	TRIM '(' expression ')'
@@ -5016,9 +5421,10 @@ function_call_generic:
 |	TRIM '(' expression FROM expression ')'
 	{
 		name := tree.SetUnresolvedName(strings.ToLower($1))
+		arg1 := tree.NewNumValWithType(constant.MakeString("both"), "both", false, tree.P_char)
         $$ = &tree.FuncExpr{
              Func: tree.FuncName2ResolvableFunctionReference(name),
-             Exprs: tree.Exprs{$3},
+             Exprs: tree.Exprs{arg1, $3, $5},
         }
 	}
 |	TRIM '(' trim_direction FROM expression ')'
@@ -5039,6 +5445,14 @@ function_call_generic:
              Exprs: tree.Exprs{arg1, $4, $6},
         }
 	}
+|	POSITION '(' bit_expr IN expression ')'
+	{
+		name := tree.SetUnresolvedName("locate")
+        $$ = &tree.FuncExpr{
+             Func: tree.FuncName2ResolvableFunctionReference(name),
+             Exprs: tree.Exprs{$3, $5},
+        }
+	}
 
 trim_direction:
 	BOTH
@@ -5146,6 +5560,14 @@ function_call_keyword:
             Exprs: es,
         }
     }
+|   GROUPING '(' expression_list ')'
+    {
+        name := tree.SetUnresolvedName("grouping")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $3,
+        }
+    }
 |   CHAR '(' expression_list ')'
     {
         name := tree.SetUnresolvedName("char")
@@ -5201,6 +5623,14 @@ function_call_keyword:
             Exprs: es,
         }
     }
+|   VALUES '(' column_name ')'
+    {
+        name := tree.SetUnresolvedName("values")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3},
+        }
+    }
 |   PASSWORD '(' expression_list_opt ')'
     {
         name := tree.SetUnresolvedName("password")
@@ -5298,6 +5728,7 @@ name_confict:
 |   TIMESTAMP
 |   TRUNCATE
 |   USER
+|   UUID
 |   WEEK
 |   YEAR
 
@@ -5614,7 +6045,7 @@ literal:
 	}
 |   VALUE_ARG
     {
-        $$ = tree.NewParamExpr(yyp)
+        $$ = tree.NewParamExpr(yylex.(*Lexer).GetParamIndex())
     }
 
 column_type:
@@ -6189,6 +6620,18 @@ char_type:
             },
         }
     }
+|   UUID
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+		        Family: tree.UuidFamily,
+                FamilyString: $1,
+		        Locale: &locale,
+		        Oid:uint32(defines.MYSQL_TYPE_UUID),
+            },
+        }
+    }
 |   ENUM '(' enum_values ')'
     {
         locale := ""
@@ -6396,6 +6839,7 @@ reserved_keyword:
 |   COALESCE
 |   CREATE
 |   CROSS
+|   CUBE
 |   CURRENT_DATE
 |   CURRENT_ROLE
 |   CURRENT_USER
@@ -6418,6 +6862,7 @@ reserved_keyword:
 |   ELSE
 |   END
 |   ESCAPE
+|   EXCEPT
 |   EXISTS
 |   EXPLAIN
 |   FALSE
@@ -6425,6 +6870,7 @@ reserved_keyword:
 |   FORCE
 |   FROM
 |   GROUP
+|   GROUPING
 |   HAVING
 |   HOUR
 |   IDENTIFIED
@@ -6435,12 +6881,14 @@ reserved_keyword:
 |   INDEX
 |   INNER
 |   INSERT
+|   INTERSECT
 |   INTERVAL
 |   INTO
 |   IS
 |   ISSUER
 |   JOIN
 |   KEY
+|   LATERAL
 |   LEFT
 |   LIKE
 |   LIMIT
@@ -6527,6 +6975,8 @@ reserved_keyword:
 |	DELAYED
 |   PARTITION
 |	QUICK
+|   OVER
+|   ROWS
 
 non_reserved_keyword:
     AGAINST
@@ -6568,7 +7018,6 @@ non_reserved_keyword:
 |   EXPANSION
 |   EXTENDED
 |   EXPIRE
-|   EXCEPT
 |   ERRORS
 |   ENFORCED
 |   FORMAT
@@ -6631,6 +7080,10 @@ non_reserved_keyword:
 |   QUERY
 |   ROLE
 |   RANGE
+|   CURRENT
+|   UNBOUNDED
+|   PRECEDING
+|   FOLLOWING
 |   READ
 |   REAL
 |   REORGANIZE
@@ -6642,9 +7095,11 @@ non_reserved_keyword:
 |   REPLICATION
 |   ROW_FORMAT
 |   ROLLBACK
+|   ROLLUP
 |   RESTRICT
 |   SESSION
 |   SERIALIZABLE
+|   SETS
 |   SHARE
 |   SIGNED
 |   SMALLINT
@@ -6671,6 +7126,7 @@ non_reserved_keyword:
 |   UNUSED
 |   UNLOCK
 |   USER
+|   UUID
 |   VARBINARY
 |   VARCHAR
 |   VARIABLES
@@ -6698,7 +7154,6 @@ func_not_keyword:
 |   NOW
 |	ADDDATE
 |   CURDATE
-|   POSITION
 |   SESSION_USER
 |   SUBDATE
 |   SYSTEM_USER
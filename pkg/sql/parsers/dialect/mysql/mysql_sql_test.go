@@ -72,6 +72,27 @@ var (
 	}, {
 		input:  "with t11 as (select * from t1) update t11 join t2 on t11.a = t2.b set t11.b = 1 where t2.a > 1",
 		output: "with t11 as (select * from t1) update t11 inner join t2 on t11.a = t2.b set t11.b = 1 where t2.a > 1",
+	}, {
+		input:  "create table t (a json)",
+		output: "create table t (a json)",
+	}, {
+		input:  "select a->'$.b', t.a->>'$[0]' from t where json_contains(a, '1', '$.c')",
+		output: "select json_extract(a, $.b), json_unquote(json_extract(t.a, $[0])) from t where json_contains(a, 1, $.c)",
+	}, {
+		input:  "select json_object('a', 1, 'b', json_array(1, 2)), cast(a as json) from t",
+		output: "select json_object(a, 1, b, json_array(1, 2)), cast(a as json) from t",
+	}, {
+		input:  "create table t (id uuid primary key, uuid int)",
+		output: "create table t (id uuid primary key, uuid int)",
+	}, {
+		input:  "select uuid(), uuid_to_bin(uuid(), 1), bin_to_uuid(a), cast(b as uuid) from t",
+		output: "select uuid(), uuid_to_bin(uuid(), 1), bin_to_uuid(a), cast(b as uuid) from t",
+	}, {
+		input:  "select a, match(b, c) against ('+mysql -oracle' IN BOOLEAN MODE) as score from t where match (b) against ('database')",
+		output: "select a, match(b, c) against (+mysql -oracle in boolean mode) as score from t where match(b) against (database in natural language mode)",
+	}, {
+		input:  "select * from t where match(t.b) against (concat('my', 'sql') in natural language mode with query expansion) > 0.5",
+		output: "select * from t where match(t.b) against (concat(my, sql) in natural language mode with query expansion) > 0.5",
 	}, {
 		input:  "UPDATE items,(SELECT id FROM items WHERE id IN (SELECT id FROM items WHERE retail / wholesale >= 1.3 AND quantity < 100)) AS discounted SET items.retail = items.retail * 0.9 WHERE items.id = discounted.id",
 		output: "update items, (select id from items where id in (select id from items where retail / wholesale >= 1.3 and quantity < 100)) as discounted set items.retail = items.retail * 0.9 where items.id = discounted.id",
@@ -269,8 +290,25 @@ var (
 	}, {
 		input:  "SELECT GROUP_CONCAT(DISTINCT 2) from t1",
 		output: "select group_concat(distinct 2) from t1",
+	}, {
+		input:  "select group_concat(a, b order by c desc, a separator ';') from t1 group by d",
+		output: "select group_concat(a, b order by c desc, a separator ';') from t1 group by d",
+	}, {
+		input:  "select group_concat(distinct a separator '') from t1",
+		output: "select group_concat(distinct a separator '') from t1",
+	}, {
+		input: "select median(a), percentile_cont(a, 0.9), percentile_disc(b, 0.1) from t1",
 	}, {
 		input: "select variance(2) from t1",
+	}, {
+		input:  "select a, b, sum(c) from t1 group by a, b with rollup",
+		output: "select a, b, sum(c) from t1 group by rollup(a, b)",
+	}, {
+		input: "select a, b, sum(c), grouping(a, b) from t1 group by rollup(a, b)",
+	}, {
+		input: "select a, b, sum(c) from t1 group by a, cube(b, c) having grouping(b) = 0",
+	}, {
+		input: "select a, b, sum(c) from t1 group by grouping sets((a, b), a, ())",
 	}, {
 		input:  "select SQL_BIG_RESULT bit_and(col), bit_or(col) from t1 group by col;",
 		output: "select sql_big_result bit_and(col), bit_or(col) from t1 group by col",
@@ -282,6 +320,21 @@ var (
 		input: "select any_value(name), avg(value1), std(value1), variance(value1) from t1, t2 where t1.id = t2.id group by t1.id",
 	}, {
 		input: "select id, avg(value1), std(value1), variance(value1) from t1 group by id",
+	}, {
+		input:  "select a, row_number() over (partition by b order by c desc) from t1",
+		output: "select a, row_number() over (partition by b order by c desc) from t1",
+	}, {
+		input:  "select rank() over (order by a), dense_rank() over (order by a), lag(a, 1, 0) over (order by a) from t1",
+		output: "select rank() over (order by a), dense_rank() over (order by a), lag(a, 1, 0) over (order by a) from t1",
+	}, {
+		input:  "select sum(a) over (partition by b order by c rows between 1 preceding and current row) from t1",
+		output: "select sum(a) over (partition by b order by c rows between 1 preceding and current row) from t1",
+	}, {
+		input:  "select count(*) over (), first_value(a) over (order by c range unbounded preceding) from t1",
+		output: "select count(*) over (), first_value(a) over (order by c range unbounded preceding) from t1",
+	}, {
+		input:  "select last_value(a) over (order by c range between 2 preceding and unbounded following) from t1",
+		output: "select last_value(a) over (order by c range between 2 preceding and unbounded following) from t1",
 	}, {
 		input: "select i, count(*), std(s1 / s2) from bug22555 group by i order by i",
 	}, {
@@ -340,6 +393,15 @@ var (
 		output: "create view v as select qty, price, qty * price as value from t",
 	}, {
 		input: "create view v_today (today) as select current_day from t",
+	}, {
+		input:  "CREATE OR REPLACE VIEW v (a, b) AS SELECT a, b FROM db.t",
+		output: "create or replace view v (a, b) as select a, b from db.t",
+	}, {
+		input: "drop view if exists v1, db.v2",
+	}, {
+		input: "drop view v",
+	}, {
+		input: "show create view db.v",
 	}, {
 		input: "explain (analyze true,verbose false) select * from emp",
 	}, {
@@ -350,11 +412,19 @@ var (
 		input: "with tw as (select * from t2), tf as (select * from t3) select * from tw where a > 1",
 	}, {
 		input: "with tw as (select * from t2) select * from tw where a > 1",
+	}, {
+		input: "with recursive c(n) as (select 1 union all select n + 1 from c where n < 5) select * from c",
 	}, {
 		input:  "create table t (a double(13))  // comment",
 		output: "create table t (a double(13))",
 	}, {
 		input: "select a as promo_revenue from (select * from r) as c_orders(c_custkey, c_count)",
+	}, {
+		input:  "select * from nation n, lateral (select r_name from region where r_regionkey = n.n_regionkey) x",
+		output: "select * from nation as n, lateral (select r_name from region where r_regionkey = n.n_regionkey) as x",
+	}, {
+		input:  "select * from nation n left join lateral (select r_name from region where r_regionkey = n.n_regionkey) as x on true",
+		output: "select * from nation as n left join lateral (select r_name from region where r_regionkey = n.n_regionkey) as x on true",
 	}, {
 		input:  "select extract(year from l_shipdate) as l_year from t",
 		output: "select extract(year, l_shipdate) as l_year from t",
@@ -417,6 +487,12 @@ var (
 	}, {
 		input:  "INSERT INTO t1 SET f1 = -1;",
 		output: "insert into t1 (f1) values (-1)",
+	}, {
+		input:  "insert into t1 values (1, 2) on duplicate key update b = b + 1, c = values(b)",
+		output: "insert into t1 values (1, 2) on duplicate key update b = b + 1, c = values(b)",
+	}, {
+		input:  "INSERT INTO t1 SET a = 1 ON DUPLICATE KEY UPDATE a = 2",
+		output: "insert into t1 (a) values (1) on duplicate key update a = 2",
 	}, {
 		input:  "insert into t1 values (18446744073709551615), (0xFFFFFFFFFFFFFFFE), (18446744073709551613), (18446744073709551612)",
 		output: "insert into t1 values (18446744073709551615), (0xfffffffffffffffe), (18446744073709551613), (18446744073709551612)",
@@ -431,8 +507,17 @@ var (
 		output: "load data infile /root/lineorder_flat_10.tbl into table lineorder_flat fields terminated by \t optionally enclosed by \u0000 lines",
 	}, {
 		input: "create table t (a int, b char, check (1 + 1) enforced)",
+	}, {
+		input:  "create table t (a int, b char, constraint c1 check (a > 0), check (b <> 'x') not enforced)",
+		output: "create table t (a int, b char, constraint c1 check (a > 0) enforced, check (b != x) not enforced)",
+	}, {
+		input:  "create table t (a int check (a > 0))",
+		output: "create table t (a int constraint check (a > 0) enforced)",
 	}, {
 		input: "create table t (a int, b char, foreign key sdf (a, b) references b(a asc, b desc))",
+	}, {
+		input:  "create table t (a int, constraint fk1 foreign key (a) references p(id) on delete cascade on update set null)",
+		output: "create table t (a int, foreign key fk1 (a) references p(id) on delete cascade on update set null)",
 	}, {
 		input: "create table t (a int, b char, unique key idx (a, b))",
 	}, {
@@ -601,6 +686,18 @@ var (
 	}, {
 		input:  "select * from t where a like 'a%'",
 		output: "select * from t where a like a%",
+	}, {
+		input:  "select * from t where a regexp '^a' and b not rlike 'b$'",
+		output: "select * from t where a regexp ^a and b not regexp b$",
+	}, {
+		input:  "select regexp_like(a, 'x', 'i'), regexp_instr(a, 'x', 1, 2, 1), regexp_substr(a, 'x'), regexp_replace(a, 'x', 'y', 1, 0, 'c') from t",
+		output: "select regexp_like(a, x, i), regexp_instr(a, x, 1, 2, 1), regexp_substr(a, x), regexp_replace(a, x, y, 1, 0, c) from t",
+	}, {
+		input:  "select trim(a), trim('x' from a), trim(leading from a), trim(trailing 'x' from a) from t",
+		output: "select trim(a), trim(both, x, a), trim(leading, a), trim(trailing, x, a) from t",
+	}, {
+		input:  "select position('b' in a), locate('b', a, 2), instr(a, 'b'), char(77, 121 using utf8mb4) from t",
+		output: "select locate(b, a), locate(b, a, 2), instr(a, b), char(77, 121, utf8mb4) from t",
 	}, {
 		input: "select sysdate(), curtime(22) from t",
 	}, {
@@ -650,12 +747,20 @@ var (
 		input: "create table a (a int) partition by linear key algorithm = 31 (a, b, db.t.c)",
 	}, {
 		input: "create table a (a int) partition by linear key (a, b, db.t.c)",
+	}, {
+		input: "create table a (a int primary key) partition by key () partitions 4",
 	}, {
 		input: "create table a (a int) partition by list columns (a, b, db.t.c)",
 	}, {
 		input: "create table a (a int) partition by list columns (a, b, db.t.c)",
 	}, {
 		input: "create table a (a int) partition by range columns (a, b, db.t.c)",
+	}, {
+		input:  "create table a (d date) partition by range (year(d)) (partition p0 values less than (2020), partition p1 values less than maxvalue)",
+		output: "create table a (d date) partition by range(year(d)) (partition p0 values less than (2020), partition p1 values less than (maxvalue))",
+	}, {
+		input:  "create table a (a int) partition by list (a) (partition p0 values in (1, 3), partition p1 values in (2, 4))",
+		output: "create table a (a int) partition by list(a) (partition p0 values in (1, 3), partition p1 values in (2, 4))",
 	}, {
 		input: "create table a (a int) partition by range(1 + 21)",
 	}, {
@@ -780,6 +885,27 @@ var (
 	}, {
 		input:  "alter user if exists user() identified by 'test'",
 		output: "alter user if exists user() identified by test",
+	}, {
+		input: "alter table t1 add column c int",
+	}, {
+		input:  "alter table db.t1 add c varchar(10) not null default 'x', drop column d",
+		output: "alter table db.t1 add column c varchar(10) not null default x, drop column d",
+	}, {
+		input:  "alter table t1 drop c, rename column a to b",
+		output: "alter table t1 drop column c, rename column a to b",
+	}, {
+		input:  "alter table t1 rename as t2",
+		output: "alter table t1 rename to t2",
+	}, {
+		input: "alter table t1 rename to db.t2",
+	}, {
+		input: "alter table t1 add partition (partition p3 values less than (2023), partition p4 values less than (maxvalue))",
+	}, {
+		input: "alter table t1 drop partition p0, p1",
+	}, {
+		input: "alter table t1 truncate partition p2",
+	}, {
+		input: "alter table t1 truncate partition all",
 	}, {
 		input: "show index from t where true",
 	}, {
@@ -950,6 +1076,18 @@ var (
 		input: "select * from t union all select c from t1",
 	}, {
 		input: "select * from t union distinct select c from t1",
+	}, {
+		input: "select * from t intersect select c from t1",
+	}, {
+		input: "select * from t intersect all select c from t1",
+	}, {
+		input: "select * from t except select c from t1",
+	}, {
+		input: "select * from t except all select c from t1",
+	}, {
+		input: "select a from t union select b from t1 intersect select c from t2 except select d from t3",
+	}, {
+		input: "(select a from t union select b from t1) intersect select c from t2 order by a limit 1",
 	}, {
 		input: "select * from (select a from t) as t1",
 	}, {
@@ -1034,6 +1172,26 @@ func TestValid(t *testing.T) {
 	}
 }
 
+func TestSetOperationPrecedence(t *testing.T) {
+	ast, err := ParseOne("select a from t union select b from t1 intersect select c from t2 intersect select d from t3 except select e from t4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// (t union ((t1 intersect t2) intersect t3)) except t4
+	except := ast.(*tree.Select).Select.(*tree.UnionClause)
+	union, ok := except.Left.(*tree.UnionClause)
+	if except.Type != tree.EXCEPT || !ok || union.Type != tree.UNION {
+		t.Fatalf("unexpected set operations: %s", tree.String(ast, dialect.MYSQL))
+	}
+	intersect, ok := union.Right.(*tree.UnionClause)
+	if !ok || intersect.Type != tree.INTERSECT {
+		t.Fatalf("unexpected set operations: %s", tree.String(ast, dialect.MYSQL))
+	}
+	if out := tree.String(intersect.Left, dialect.MYSQL); out != "select b from t1 intersect select c from t2" {
+		t.Errorf("unexpected left operand of intersect: %s", out)
+	}
+}
+
 var (
 	multiSQL = []struct {
 		input  string
@@ -82,3 +82,171 @@ func NewAlterUser(ife bool, iuf bool, uf *User, u []*User, r []*Role, t []TlsOpt
 		MiscOpts:   m,
 	}
 }
+
+// ALTER TABLE statement
+type AlterTable struct {
+	statementImpl
+	Table   *TableName
+	Options AlterTableOptions
+}
+
+func (node *AlterTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter table ")
+	node.Table.Format(ctx)
+	prefix := " "
+	for _, opt := range node.Options {
+		ctx.WriteString(prefix)
+		opt.Format(ctx)
+		prefix = ", "
+	}
+}
+
+func NewAlterTable(t *TableName, o AlterTableOptions) *AlterTable {
+	return &AlterTable{
+		Table:   t,
+		Options: o,
+	}
+}
+
+type AlterTableOptions []AlterTableOption
+
+type AlterTableOption interface {
+	NodeFormatter
+}
+
+type alterTableOptionImpl struct {
+	AlterTableOption
+}
+
+// ADD [COLUMN] col_def
+type AlterOptionAddColumn struct {
+	alterTableOptionImpl
+	Column *ColumnTableDef
+}
+
+func (node *AlterOptionAddColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("add column ")
+	node.Column.Format(ctx)
+}
+
+func NewAlterOptionAddColumn(c *ColumnTableDef) *AlterOptionAddColumn {
+	return &AlterOptionAddColumn{
+		Column: c,
+	}
+}
+
+// DROP [COLUMN] col_name
+type AlterOptionDropColumn struct {
+	alterTableOptionImpl
+	Name *UnresolvedName
+}
+
+func (node *AlterOptionDropColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop column ")
+	node.Name.Format(ctx)
+}
+
+func NewAlterOptionDropColumn(n *UnresolvedName) *AlterOptionDropColumn {
+	return &AlterOptionDropColumn{
+		Name: n,
+	}
+}
+
+// RENAME COLUMN old_col_name TO new_col_name
+type AlterOptionRenameColumn struct {
+	alterTableOptionImpl
+	OldName *UnresolvedName
+	NewName *UnresolvedName
+}
+
+func (node *AlterOptionRenameColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("rename column ")
+	node.OldName.Format(ctx)
+	ctx.WriteString(" to ")
+	node.NewName.Format(ctx)
+}
+
+func NewAlterOptionRenameColumn(o, n *UnresolvedName) *AlterOptionRenameColumn {
+	return &AlterOptionRenameColumn{
+		OldName: o,
+		NewName: n,
+	}
+}
+
+// RENAME [TO | AS] new_tbl_name
+type AlterOptionRenameTable struct {
+	alterTableOptionImpl
+	Name *TableName
+}
+
+func (node *AlterOptionRenameTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("rename to ")
+	node.Name.Format(ctx)
+}
+
+func NewAlterOptionRenameTable(n *TableName) *AlterOptionRenameTable {
+	return &AlterOptionRenameTable{
+		Name: n,
+	}
+}
+
+// ADD PARTITION (partition_definition [, partition_definition] ...)
+type AlterOptionAddPartition struct {
+	alterTableOptionImpl
+	Partitions []*Partition
+}
+
+func (node *AlterOptionAddPartition) Format(ctx *FmtCtx) {
+	ctx.WriteString("add partition (")
+	for i, p := range node.Partitions {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		p.Format(ctx)
+	}
+	ctx.WriteByte(')')
+}
+
+func NewAlterOptionAddPartition(p []*Partition) *AlterOptionAddPartition {
+	return &AlterOptionAddPartition{
+		Partitions: p,
+	}
+}
+
+// DROP PARTITION partition_names
+type AlterOptionDropPartition struct {
+	alterTableOptionImpl
+	Names IdentifierList
+}
+
+func (node *AlterOptionDropPartition) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop partition ")
+	node.Names.Format(ctx)
+}
+
+func NewAlterOptionDropPartition(n IdentifierList) *AlterOptionDropPartition {
+	return &AlterOptionDropPartition{
+		Names: n,
+	}
+}
+
+// TRUNCATE PARTITION {partition_names | ALL}, Names is empty for ALL
+type AlterOptionTruncatePartition struct {
+	alterTableOptionImpl
+	Names IdentifierList
+}
+
+func (node *AlterOptionTruncatePartition) Format(ctx *FmtCtx) {
+	ctx.WriteString("truncate partition ")
+	if len(node.Names) == 0 {
+		ctx.WriteString("all")
+		return
+	}
+	node.Names.Format(ctx)
+}
+
+func NewAlterOptionTruncatePartition(n IdentifierList) *AlterOptionTruncatePartition {
+	return &AlterOptionTruncatePartition{
+		Names: n,
+	}
+}
@@ -57,6 +57,12 @@ type NumVal struct {
 }
 
 func (n *NumVal) Format(ctx *FmtCtx) {
+	if ctx.QuoteString && n.ValType == P_char {
+		ctx.WriteByte('\'')
+		ctx.WriteString(strings.ReplaceAll(FormatString(n.origString), "'", "\\'"))
+		ctx.WriteByte('\'')
+		return
+	}
 	if n.origString != "" {
 		ctx.WriteString(FormatString(n.origString))
 		return
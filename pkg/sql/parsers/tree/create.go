@@ -904,16 +904,24 @@ func NewFullTextIndex(k []*KeyPart, n string, e bool, io *IndexOption) *FullText
 
 type CheckIndex struct {
 	tableDefImpl
+	Name     string
 	Expr     Expr
 	Enforced bool
 }
 
 func (node *CheckIndex) Format(ctx *FmtCtx) {
+	if node.Name != "" {
+		ctx.WriteString("constraint ")
+		ctx.WriteString(node.Name)
+		ctx.WriteByte(' ')
+	}
 	ctx.WriteString("check (")
 	node.Expr.Format(ctx)
 	ctx.WriteByte(')')
 	if node.Enforced {
 		ctx.WriteString(" enforced")
+	} else {
+		ctx.WriteString(" not enforced")
 	}
 }
 
@@ -1473,15 +1481,14 @@ func (node *KeyType) Format(ctx *FmtCtx) {
 		ctx.WriteString(" algorithm = ")
 		ctx.WriteString(strconv.FormatInt(node.Algorithm, 10))
 	}
-	if node.ColumnList != nil {
-		prefix := " ("
-		for _, c := range node.ColumnList {
-			ctx.WriteString(prefix)
-			c.Format(ctx)
-			prefix = ", "
+	ctx.WriteString(" (")
+	for i, c := range node.ColumnList {
+		if i > 0 {
+			ctx.WriteString(", ")
 		}
-		ctx.WriteByte(')')
+		c.Format(ctx)
 	}
+	ctx.WriteByte(')')
 }
 
 func NewKeyType(l bool, c []*UnresolvedName) *KeyType {
@@ -1613,6 +1620,23 @@ type ValuesIn struct {
 	ValueList []Exprs
 }
 
+func (node *ValuesIn) Format(ctx *FmtCtx) {
+	ctx.WriteString("values in (")
+	for i, vals := range node.ValueList {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		if len(vals) == 1 {
+			vals[0].Format(ctx)
+			continue
+		}
+		ctx.WriteByte('(')
+		vals.Format(ctx)
+		ctx.WriteByte(')')
+	}
+	ctx.WriteByte(')')
+}
+
 func NewValuesIn(vl []Exprs) *ValuesIn {
 	return &ValuesIn{
 		ValueList: vl,
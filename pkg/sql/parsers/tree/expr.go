@@ -16,6 +16,7 @@ package tree
 
 import (
 	"fmt"
+	"strings"
 )
 
 //AST for the expression
@@ -227,9 +228,9 @@ func (op ComparisonOp) ToString() string {
 	case NOT_LIKE:
 		return "not like"
 	case REG_MATCH:
-		return "reg_match"
+		return "regexp"
 	case NOT_REG_MATCH:
-		return "not reg_match"
+		return "not regexp"
 	case IS_DISTINCT_FROM:
 		return "is distinct from"
 	case IS_NOT_DISTINCT_FROM:
@@ -545,6 +546,12 @@ type FuncExpr struct {
 
 	//aggregations which specify an order.
 	OrderBy OrderBy
+
+	//the separator of GROUP_CONCAT, nil for the default one.
+	Separator *string
+
+	//the window of the window function call
+	WindowSpec *WindowSpec
 }
 
 func (node *FuncExpr) Format(ctx *FmtCtx) {
@@ -556,7 +563,134 @@ func (node *FuncExpr) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 	}
 	node.Exprs.Format(ctx)
+	if len(node.OrderBy) > 0 {
+		ctx.WriteByte(' ')
+		node.OrderBy.Format(ctx)
+	}
+	if node.Separator != nil {
+		ctx.WriteString(" separator '")
+		ctx.WriteString(strings.ReplaceAll(FormatString(*node.Separator), "'", "\\'"))
+		ctx.WriteByte('\'')
+	}
 	ctx.WriteByte(')')
+
+	if node.WindowSpec != nil {
+		ctx.WriteString(" over (")
+		node.WindowSpec.Format(ctx)
+		ctx.WriteByte(')')
+	}
+}
+
+//the window specification of the OVER clause
+type WindowSpec struct {
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	Frame       *FrameClause
+}
+
+func (node *WindowSpec) Format(ctx *FmtCtx) {
+	prefix := ""
+	if len(node.PartitionBy) > 0 {
+		ctx.WriteString("partition by ")
+		node.PartitionBy.Format(ctx)
+		prefix = " "
+	}
+	if len(node.OrderBy) > 0 {
+		ctx.WriteString(prefix)
+		node.OrderBy.Format(ctx)
+		prefix = " "
+	}
+	if node.Frame != nil {
+		ctx.WriteString(prefix)
+		node.Frame.Format(ctx)
+	}
+}
+
+func NewWindowSpec(partitionBy Exprs, orderBy OrderBy, frame *FrameClause) *WindowSpec {
+	return &WindowSpec{
+		PartitionBy: partitionBy,
+		OrderBy:     orderBy,
+		Frame:       frame,
+	}
+}
+
+type FrameType int
+
+const (
+	FRAME_ROWS FrameType = iota
+	FRAME_RANGE
+)
+
+//the frame of the window
+type FrameClause struct {
+	Type  FrameType
+	Start *FrameBound
+	//the end of the frame is nil when the frame is not specified by BETWEEN
+	End *FrameBound
+}
+
+func (node *FrameClause) Format(ctx *FmtCtx) {
+	if node.Type == FRAME_RANGE {
+		ctx.WriteString("range ")
+	} else {
+		ctx.WriteString("rows ")
+	}
+	if node.End == nil {
+		node.Start.Format(ctx)
+		return
+	}
+	ctx.WriteString("between ")
+	node.Start.Format(ctx)
+	ctx.WriteString(" and ")
+	node.End.Format(ctx)
+}
+
+func NewFrameClause(typ FrameType, start, end *FrameBound) *FrameClause {
+	return &FrameClause{
+		Type:  typ,
+		Start: start,
+		End:   end,
+	}
+}
+
+type BoundType int
+
+const (
+	PRECEDING BoundType = iota
+	CURRENT_ROW
+	FOLLOWING
+)
+
+//the start or the end of the frame
+type FrameBound struct {
+	Type      BoundType
+	Unbounded bool
+	Expr      Expr
+}
+
+func (node *FrameBound) Format(ctx *FmtCtx) {
+	if node.Type == CURRENT_ROW {
+		ctx.WriteString("current row")
+		return
+	}
+	if node.Unbounded {
+		ctx.WriteString("unbounded")
+	} else {
+		node.Expr.Format(ctx)
+	}
+	if node.Type == PRECEDING {
+		ctx.WriteString(" preceding")
+	} else {
+		ctx.WriteString(" following")
+	}
+}
+
+func NewFrameBound(typ BoundType, unbounded bool, e Expr) *FrameBound {
+	return &FrameBound{
+		Type:      typ,
+		Unbounded: unbounded,
+		Expr:      e,
+	}
 }
 
 func NewFuncExpr(ft FuncType, name *UnresolvedName, e Exprs, order OrderBy) *FuncExpr {
@@ -805,6 +939,10 @@ type MaxValue struct {
 	exprImpl
 }
 
+func (node *MaxValue) Format(ctx *FmtCtx) {
+	ctx.WriteString("maxvalue")
+}
+
 func NewMaxValue() *MaxValue {
 	return &MaxValue{}
 }
@@ -865,3 +1003,56 @@ func NewParamExpr(offset int) *ParamExpr {
 		Offset: offset,
 	}
 }
+
+type FullTextSearchType int
+
+const (
+	FULLTEXT_NATURAL_LANGUAGE FullTextSearchType = iota
+	FULLTEXT_NATURAL_LANGUAGE_WITH_QUERY_EXPANSION
+	FULLTEXT_BOOLEAN
+	FULLTEXT_QUERY_EXPANSION
+)
+
+func (t FullTextSearchType) ToString() string {
+	switch t {
+	case FULLTEXT_NATURAL_LANGUAGE_WITH_QUERY_EXPANSION:
+		return "in natural language mode with query expansion"
+	case FULLTEXT_BOOLEAN:
+		return "in boolean mode"
+	case FULLTEXT_QUERY_EXPANSION:
+		return "with query expansion"
+	default:
+		return "in natural language mode"
+	}
+}
+
+// match(a, b) against ('text' in boolean mode)
+type FullTextMatchExpr struct {
+	exprImpl
+	Cols  []*UnresolvedName
+	Query Expr
+	Mode  FullTextSearchType
+}
+
+func (node *FullTextMatchExpr) Format(ctx *FmtCtx) {
+	ctx.WriteString("match(")
+	for i, col := range node.Cols {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		col.Format(ctx)
+	}
+	ctx.WriteString(") against (")
+	node.Query.Format(ctx)
+	ctx.WriteByte(' ')
+	ctx.WriteString(node.Mode.ToString())
+	ctx.WriteByte(')')
+}
+
+func NewFullTextMatchExpr(cols []*UnresolvedName, query Expr, mode FullTextSearchType) *FullTextMatchExpr {
+	return &FullTextMatchExpr{
+		Cols:  cols,
+		Query: query,
+		Mode:  mode,
+	}
+}
@@ -24,6 +24,9 @@ import (
 type FmtCtx struct {
 	*strings.Builder
 	dialectType dialect.DialectType
+	// QuoteString is set if string literals should be quoted, so that
+	// the formatted text can be parsed again
+	QuoteString bool
 }
 
 func NewFmtCtx(dialectType dialect.DialectType) *FmtCtx {
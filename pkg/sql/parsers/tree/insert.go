@@ -14,13 +14,15 @@
 
 package tree
 
-//the INSERT statement.
+// the INSERT statement.
 type Insert struct {
 	statementImpl
 	Table          TableExpr
 	PartitionNames IdentifierList
 	Columns        IdentifierList
 	Rows           *Select
+	// OnDuplicateUpdate is the assignments of ON DUPLICATE KEY UPDATE
+	OnDuplicateUpdate UpdateExprs
 }
 
 func (node *Insert) Format(ctx *FmtCtx) {
@@ -42,6 +44,10 @@ func (node *Insert) Format(ctx *FmtCtx) {
 		ctx.WriteByte(' ')
 		node.Rows.Format(ctx)
 	}
+	if len(node.OnDuplicateUpdate) > 0 {
+		ctx.WriteString(" on duplicate key update ")
+		node.OnDuplicateUpdate.Format(ctx)
+	}
 }
 
 func NewInsert(t TableExpr, c IdentifierList, r *Select, p IdentifierList) *Insert {
@@ -272,6 +272,57 @@ func (node *GroupBy) Format(ctx *FmtCtx) {
 	}
 }
 
+// GroupingSetsType is the kind of a grouping sets item of a GROUP BY clause.
+type GroupingSetsType int
+
+const (
+	GROUPING_SETS GroupingSetsType = iota
+	GROUPING_ROLLUP
+	GROUPING_CUBE
+)
+
+func (t GroupingSetsType) ToString() string {
+	switch t {
+	case GROUPING_ROLLUP:
+		return "rollup"
+	case GROUPING_CUBE:
+		return "cube"
+	default:
+		return "grouping sets"
+	}
+}
+
+// ROLLUP(a, b), CUBE(a, b) or GROUPING SETS((a, b), a, ()) in a GROUP BY clause,
+// each of Exprs is a grouping set of GROUPING SETS, () is an empty Tuple.
+type GroupingSetsExpr struct {
+	exprImpl
+	Type  GroupingSetsType
+	Exprs Exprs
+}
+
+func (node *GroupingSetsExpr) Format(ctx *FmtCtx) {
+	ctx.WriteString(node.Type.ToString())
+	ctx.WriteByte('(')
+	for i, e := range node.Exprs {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		if tuple, ok := e.(*Tuple); ok && len(tuple.Exprs) == 0 {
+			ctx.WriteString("()")
+		} else {
+			e.Format(ctx)
+		}
+	}
+	ctx.WriteByte(')')
+}
+
+func NewGroupingSetsExpr(typ GroupingSetsType, exprs Exprs) *GroupingSetsExpr {
+	return &GroupingSetsExpr{
+		Type:  typ,
+		Exprs: exprs,
+	}
+}
+
 const (
 	JOIN_TYPE_FULL          = "FULL"
 	JOIN_TYPE_LEFT          = "LEFT"
@@ -419,9 +470,15 @@ type AliasedTableExpr struct {
 	TableExpr
 	Expr TableExpr
 	As   AliasClause
+	// Lateral is set for a derived table which can refer to the tables
+	// before it in the FROM clause
+	Lateral bool
 }
 
 func (node *AliasedTableExpr) Format(ctx *FmtCtx) {
+	if node.Lateral {
+		ctx.WriteString("lateral ")
+	}
 	node.Expr.Format(ctx)
 	if node.As.Alias != "" {
 		ctx.WriteString(" as ")
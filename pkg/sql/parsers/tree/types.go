@@ -37,6 +37,7 @@ const (
 	JsonFamily
 	EnumFamily
 	SetFamily
+	UuidFamily
 
 	//test
 	BlobFamily
@@ -348,6 +349,12 @@ var (
 		Oid:    uint32(defines.MYSQL_TYPE_SET),
 	}}
 
+	TYPE_UUID = &T{InternalType: InternalType{
+		Family: UuidFamily,
+		Locale: &emptyLocale,
+		Oid:    uint32(defines.MYSQL_TYPE_UUID),
+	}}
+
 	TYPE_TINY_BLOB = &T{InternalType: InternalType{
 		Family: BlobFamily,
 		Locale: &emptyLocale,
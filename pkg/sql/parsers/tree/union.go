@@ -68,6 +68,25 @@ func (i UnionType) String() string {
 	return unionTypeName[i]
 }
 
+// NewUnionClauseWithRecord returns the set operation of the left and the right.
+// INTERSECT binds tighter than UNION and EXCEPT, so it takes the rightmost operand
+// of the UNION or EXCEPT on its left as its left operand.
+func NewUnionClauseWithRecord(left SelectStatement, r *UnionTypeRecord, right SelectStatement) *UnionClause {
+	if r.Type == INTERSECT {
+		if clause, ok := left.(*UnionClause); ok && clause.Type != INTERSECT {
+			clause.Right = NewUnionClauseWithRecord(clause.Right, r, right)
+			return clause
+		}
+	}
+	return &UnionClause{
+		Type:     r.Type,
+		Left:     left,
+		Right:    right,
+		All:      r.All,
+		Distinct: r.Distinct,
+	}
+}
+
 //func NewUnionClause(t UnionType,l,r *Select,a bool)*UnionClause{
 func NewUnionClause(t UnionType, l, r SelectStatement, a bool) *UnionClause {
 	return &UnionClause{
@@ -21,11 +21,16 @@ type CreateView struct {
 	AsSource    *Select
 	IfNotExists bool
 	Temporary   bool
+	Replace     bool
 }
 
 func (node *CreateView) Format(ctx *FmtCtx) {
 	ctx.WriteString("create ")
 
+	if node.Replace {
+		ctx.WriteString("or replace ")
+	}
+
 	if node.Temporary {
 		ctx.WriteString("temporary ")
 	}
@@ -44,3 +49,25 @@ func (node *CreateView) Format(ctx *FmtCtx) {
 	ctx.WriteString(" as ")
 	node.AsSource.Format(ctx)
 }
+
+type DropView struct {
+	statementImpl
+	IfExists bool
+	Names    TableNames
+}
+
+func (node *DropView) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop view")
+	if node.IfExists {
+		ctx.WriteString(" if exists")
+	}
+	ctx.WriteByte(' ')
+	node.Names.Format(ctx)
+}
+
+func NewDropView(i bool, n TableNames) *DropView {
+	return &DropView{
+		IfExists: i,
+		Names:    n,
+	}
+}
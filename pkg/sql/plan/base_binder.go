@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -89,6 +90,10 @@ func (b *baseBinder) baseBindExpr(astExpr tree.Expr, depth int32, isRoot bool) (
 		if err != nil {
 			return
 		}
+		if typ.Id == plan.Type_BINARY && exprImpl.Type.(*tree.T).InternalType.DisplayWith == 0 {
+			// cast(x as binary) keeps the length of x, as MySQL does
+			typ.Id = plan.Type_VARBINARY
+		}
 		expr, err = appendCastBeforeExpr(expr, typ)
 
 	case *tree.IsNullExpr:
@@ -121,6 +126,9 @@ func (b *baseBinder) baseBindExpr(astExpr tree.Expr, depth int32, isRoot bool) (
 	case *tree.CaseExpr:
 		expr, err = b.bindCaseExpr(exprImpl, depth, isRoot)
 
+	case *tree.FullTextMatchExpr:
+		expr, err = b.bindFullTextMatch(exprImpl, depth, isRoot)
+
 	case *tree.IntervalExpr:
 		err = errors.New("", fmt.Sprintf("expr interval'%v' is not supported now", exprImpl))
 
@@ -128,11 +136,6 @@ func (b *baseBinder) baseBindExpr(astExpr tree.Expr, depth int32, isRoot bool) (
 		expr, err = b.bindFuncExprImplByAstExpr("xor", []tree.Expr{exprImpl.Left, exprImpl.Right}, depth)
 
 	case *tree.Subquery:
-		if !isRoot && exprImpl.Exists {
-			// TODO: implement MARK join to better support non-scalar subqueries
-			return nil, errors.New("", "EXISTS subquery as non-root expression will be supported in future version")
-		}
-
 		expr, err = b.impl.BindSubquery(exprImpl, isRoot)
 
 	case *tree.DefaultVal:
@@ -348,6 +351,116 @@ func (b *baseBinder) bindCaseExpr(astExpr *tree.CaseExpr, depth int32, isRoot bo
 	return b.bindFuncExprImplByAstExpr("case", args, depth)
 }
 
+// bindFullTextMatch adds a full-text search to the scan of the searched
+// table. The relevance of the rows is read by the scan as a virtual float64
+// column, the expression is bound as a reference to that column
+func (b *baseBinder) bindFullTextMatch(astExpr *tree.FullTextMatchExpr, depth int32, isRoot bool) (*Expr, error) {
+	var mode plan.FullTextScan_SearchMode
+	switch astExpr.Mode {
+	case tree.FULLTEXT_NATURAL_LANGUAGE:
+		mode = plan.FullTextScan_NATURAL_LANGUAGE
+	case tree.FULLTEXT_BOOLEAN:
+		mode = plan.FullTextScan_BOOLEAN
+	default:
+		return nil, errors.New(errno.FeatureNotSupported, "full-text search with query expansion is not supported now")
+	}
+
+	queryExpr, err := b.impl.BindExpr(astExpr.Query, depth, false)
+	if err != nil {
+		return nil, err
+	}
+	c, ok := queryExpr.Expr.(*plan.Expr_C)
+	if !ok {
+		return nil, errors.New(errno.DatatypeMismatch, "Incorrect arguments to AGAINST")
+	}
+	sval, ok := c.C.Value.(*plan.Const_Sval)
+	if !ok {
+		return nil, errors.New(errno.DatatypeMismatch, "Incorrect arguments to AGAINST")
+	}
+
+	// all the searched columns must be of the same table scan
+	var binding *Binding
+	colPos := make([]int32, len(astExpr.Cols))
+	for i, col := range astExpr.Cols {
+		name := tree.String(col, dialect.MYSQL)
+		colBinding, ok := b.ctx.bindingByTable[col.Parts[1]]
+		if !ok {
+			return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Column '%s' does not exist", name))
+		}
+		if binding != nil && binding != colBinding {
+			return nil, errors.New(errno.InvalidColumnReference, "Incorrect arguments to MATCH")
+		}
+		binding = colBinding
+		colPos[i] = binding.FindColumn(col.Parts[0])
+		if colPos[i] == AmbiguousName {
+			return nil, errors.New(errno.AmbiguousColumn, fmt.Sprintf("Column reference '%s' is ambiguous", name))
+		}
+		if colPos[i] == NotFound {
+			return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Column '%s' does not exist", name))
+		}
+	}
+	node := b.builder.qry.Nodes[binding.nodeId]
+	if node.NodeType != plan.Node_TABLE_SCAN {
+		return nil, errors.New(errno.FeatureNotSupported, "Can't find FULLTEXT index matching the column list")
+	}
+	names := make(map[string]bool, len(colPos))
+	for _, pos := range colPos {
+		names[node.TableDef.Cols[pos].Name] = true
+	}
+	var colNames []string
+	for _, def := range node.TableDef.Defs {
+		if idx, ok := def.Def.(*plan.TableDef_DefType_Idx); ok && idx.Idx.Typ == plan.IndexDef_FULLTEXT && len(idx.Idx.ColNames) == len(names) {
+			matched := true
+			for _, name := range idx.Idx.ColNames {
+				matched = matched && names[name]
+			}
+			if matched {
+				colNames = idx.Idx.ColNames
+				break
+			}
+		}
+	}
+	if colNames == nil {
+		return nil, errors.New(errno.FeatureNotSupported, "Can't find FULLTEXT index matching the column list")
+	}
+
+	var attr string
+	for _, ft := range node.FullText {
+		if ft.Query == sval.Sval && ft.Mode == mode && strings.Join(ft.ColNames, ",") == strings.Join(colNames, ",") {
+			attr = ft.Attr
+			break
+		}
+	}
+	if attr == "" {
+		attr = fmt.Sprintf("__mo_fulltext_%d", len(node.FullText))
+		typ := makePlan2Type(&types.Type{Oid: types.T_float64, Size: 8})
+
+		// the table def may be shared with other scans of the table
+		cols := make([]*ColDef, len(node.TableDef.Cols), len(node.TableDef.Cols)+1)
+		copy(cols, node.TableDef.Cols)
+		node.TableDef = &plan.TableDef{
+			Name: node.TableDef.Name,
+			Cols: append(cols, &ColDef{Name: attr, Typ: typ}),
+			Defs: node.TableDef.Defs,
+			View: node.TableDef.View,
+		}
+		node.FullText = append(node.FullText, &plan.FullTextScan{
+			Attr:     attr,
+			ColNames: colNames,
+			Query:    sval.Sval,
+			Mode:     mode,
+		})
+
+		b.builder.nameByColRef[[2]int32{binding.tag, int32(len(binding.cols))}] = binding.table + "." + attr
+		binding.colIdByName[attr] = int32(len(binding.cols))
+		binding.cols = append(binding.cols, attr)
+		binding.types = append(binding.types, typ)
+		binding.refCnts = append(binding.refCnts, 0)
+	}
+
+	return b.impl.BindColRef(tree.SetUnresolvedName(binding.table, attr), depth, isRoot)
+}
+
 func (b *baseBinder) bindRangeCond(astExpr *tree.RangeCond, depth int32, isRoot bool) (*Expr, error) {
 	if astExpr.Not {
 		// rewrite 'col not between 1, 20' to 'col < 1 or col > 20'
@@ -423,6 +536,13 @@ func (b *baseBinder) bindComparisonExpr(astExpr *tree.ComparisonExpr, depth int3
 		newExpr := tree.NewComparisonExpr(tree.LIKE, astExpr.Left, astExpr.Right)
 		return b.bindFuncExprImplByAstExpr("not", []tree.Expr{newExpr}, depth)
 
+	case tree.REG_MATCH:
+		return b.bindFuncExprImplByAstExpr("regexp_like", []tree.Expr{astExpr.Left, astExpr.Right}, depth)
+
+	case tree.NOT_REG_MATCH:
+		newExpr := tree.NewComparisonExpr(tree.REG_MATCH, astExpr.Left, astExpr.Right)
+		return b.bindFuncExprImplByAstExpr("not", []tree.Expr{newExpr}, depth)
+
 	case tree.IN:
 		switch list := astExpr.Right.(type) {
 		case *tree.Tuple:
@@ -449,11 +569,6 @@ func (b *baseBinder) bindComparisonExpr(astExpr *tree.ComparisonExpr, depth int3
 			}
 
 			if subquery, ok := rightArg.Expr.(*plan.Expr_Sub); ok {
-				if !isRoot {
-					// TODO: implement MARK join to better support non-scalar subqueries
-					return nil, errors.New("", "IN subquery as non-root expression will be supported in future version")
-				}
-
 				if list, ok := leftArg.Expr.(*plan.Expr_List); ok {
 					if len(list.List.List) != int(subquery.Sub.RowSize) {
 						return nil, errors.New("", fmt.Sprintf("subquery should return %d columns", len(list.List.List)))
@@ -466,6 +581,11 @@ func (b *baseBinder) bindComparisonExpr(astExpr *tree.ComparisonExpr, depth int3
 
 				subquery.Sub.Typ = plan.SubqueryRef_IN
 				subquery.Sub.Child = leftArg
+				rightArg.Typ = &plan.Type{
+					Id:       plan.Type_BOOL,
+					Nullable: true,
+					Size:     1,
+				}
 				return rightArg, nil
 			} else {
 				return bindFuncExprImplByPlanExpr("in", []*plan.Expr{leftArg, rightArg})
@@ -498,11 +618,6 @@ func (b *baseBinder) bindComparisonExpr(astExpr *tree.ComparisonExpr, depth int3
 			}
 
 			if subquery, ok := rightArg.Expr.(*plan.Expr_Sub); ok {
-				if !isRoot {
-					// TODO: implement MARK join to better support non-scalar subqueries
-					return nil, errors.New("", "IN subquery as non-root expression will be supported in future version")
-				}
-
 				if list, ok := leftArg.Expr.(*plan.Expr_List); ok {
 					if len(list.List.List) != int(subquery.Sub.RowSize) {
 						return nil, errors.New("", fmt.Sprintf("subquery should return %d columns", len(list.List.List)))
@@ -515,6 +630,11 @@ func (b *baseBinder) bindComparisonExpr(astExpr *tree.ComparisonExpr, depth int3
 
 				subquery.Sub.Typ = plan.SubqueryRef_NOT_IN
 				subquery.Sub.Child = leftArg
+				rightArg.Typ = &plan.Type{
+					Id:       plan.Type_BOOL,
+					Nullable: true,
+					Size:     1,
+				}
 				return rightArg, nil
 			} else {
 				expr, err := bindFuncExprImplByPlanExpr("in", []*plan.Expr{leftArg, rightArg})
@@ -542,11 +662,6 @@ func (b *baseBinder) bindComparisonExpr(astExpr *tree.ComparisonExpr, depth int3
 		}
 
 		if subquery, ok := expr.Expr.(*plan.Expr_Sub); ok {
-			if !isRoot {
-				// TODO: implement MARK join to better support non-scalar subqueries
-				return nil, errors.New("", fmt.Sprintf("%q subquery as non-root expression will be supported in future version", strings.ToUpper(astExpr.SubOp.ToString())))
-			}
-
 			if list, ok := child.Expr.(*plan.Expr_List); ok {
 				if len(list.List.List) != int(subquery.Sub.RowSize) {
 					return nil, errors.New("", fmt.Sprintf("subquery should return %d columns", len(list.List.List)))
@@ -559,6 +674,11 @@ func (b *baseBinder) bindComparisonExpr(astExpr *tree.ComparisonExpr, depth int3
 
 			subquery.Sub.Op = op
 			subquery.Sub.Child = child
+			expr.Typ = &plan.Type{
+				Id:       plan.Type_BOOL,
+				Nullable: true,
+				Size:     1,
+			}
 
 			switch astExpr.SubOp {
 			case tree.ANY, tree.SOME:
@@ -583,7 +703,13 @@ func (b *baseBinder) bindFuncExpr(astExpr *tree.FuncExpr, depth int32, isRoot bo
 	}
 	funcName := funcRef.Parts[0]
 
-	if function.GetFunctionIsAggregateByName(funcName) {
+	if funcName == "grouping" {
+		return b.bindGrouping(astExpr)
+	}
+
+	if astExpr.WindowSpec != nil {
+		return b.impl.BindWinFunc(funcName, astExpr, depth, isRoot)
+	} else if function.GetFunctionIsAggregateByName(funcName) {
 		return b.impl.BindAggFunc(funcName, astExpr, depth, isRoot)
 	} else if function.GetFunctionIsWinfunByName(funcName) {
 		return b.impl.BindWinFunc(funcName, astExpr, depth, isRoot)
@@ -592,6 +718,70 @@ func (b *baseBinder) bindFuncExpr(astExpr *tree.FuncExpr, depth int32, isRoot bo
 	return b.bindFuncExprImplByAstExpr(funcName, astExpr.Exprs, depth)
 }
 
+// bindGrouping binds GROUPING(expr, ...), whose bits tell which of the
+// expressions are rolled up by the grouping set of the row, the first being
+// the most significant, as a CASE on the index of the grouping set.
+func (b *baseBinder) bindGrouping(astExpr *tree.FuncExpr) (*plan.Expr, error) {
+	if len(b.ctx.groupingSets) == 0 {
+		return nil, errors.New("", "GROUPING function is only allowed with ROLLUP, CUBE or GROUPING SETS in the GROUP BY clause")
+	}
+	if len(astExpr.Exprs) == 0 || len(astExpr.Exprs) > 63 {
+		return nil, errors.New("", "GROUPING function needs 1 to 63 arguments")
+	}
+
+	keys := make([]int32, len(astExpr.Exprs))
+	for i, arg := range astExpr.Exprs {
+		astStr := tree.String(arg, dialect.MYSQL)
+		colPos, ok := b.ctx.groupByAst[astStr]
+		if !ok {
+			return nil, errors.New("", fmt.Sprintf("argument %q of GROUPING function must be an expression of the GROUP BY clause", astStr))
+		}
+		keys[i] = colPos
+	}
+
+	var args []*plan.Expr
+	for i, set := range b.ctx.groupingSets {
+		var bits int64
+		for j, key := range keys {
+			for _, pos := range set {
+				if pos == key {
+					bits |= 1 << (len(keys) - 1 - j)
+					break
+				}
+			}
+		}
+
+		if i == len(b.ctx.groupingSets)-1 {
+			args = append(args, makePlan2Int64ConstExprWithType(bits))
+			break
+		}
+
+		cond, err := bindFuncExprImplByPlanExpr("=", []*plan.Expr{
+			{
+				Typ: b.ctx.groups[0].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: b.ctx.groupTag,
+						ColPos: 0,
+					},
+				},
+			},
+			makePlan2Int64ConstExprWithType(int64(i)),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		args = append(args, cond, makePlan2Int64ConstExprWithType(bits))
+	}
+
+	if len(args) == 1 {
+		return args[0], nil
+	}
+
+	return bindFuncExprImplByPlanExpr("case", args)
+}
+
 func (b *baseBinder) bindFuncExprImplByAstExpr(name string, astArgs []tree.Expr, depth int32) (*plan.Expr, error) {
 	// rewrite some ast Exprs before binding
 	switch name {
@@ -640,6 +830,29 @@ func (b *baseBinder) bindFuncExprImplByAstExpr(name string, astArgs []tree.Expr,
 				astArgs[0] = newCountCol
 			}
 		}
+	case "median", "percentile_cont", "percentile_disc":
+		// the fraction must be a constant, which is bound as a float64,
+		// and median(expr) is percentile_cont(expr, 0.5)
+		fraction := 0.5
+		if name == "median" {
+			if len(astArgs) != 1 {
+				return nil, errors.New("", "median function need one arg")
+			}
+			name = "percentile_cont"
+		} else {
+			if len(astArgs) != 2 {
+				return nil, errors.New("", fmt.Sprintf("%s function need two args", name))
+			}
+			var ok bool
+			if fraction, ok = getPercentileFraction(astArgs[1]); !ok {
+				return nil, errors.New("", fmt.Sprintf("the fraction of %s must be a constant between 0 and 1", name))
+			}
+		}
+		arg, err := b.impl.BindExpr(astArgs[0], depth, false)
+		if err != nil {
+			return nil, err
+		}
+		return bindFuncExprImplByPlanExpr(name, []*Expr{arg, makePlan2Float64ConstExprWithType(fraction)})
 	}
 	// bind ast function's args
 	args := make([]*Expr, len(astArgs))
@@ -654,6 +867,84 @@ func (b *baseBinder) bindFuncExprImplByAstExpr(name string, astArgs []tree.Expr,
 	return bindFuncExprImplByPlanExpr(name, args)
 }
 
+// bindGroupConcat binds GROUP_CONCAT(exprs ORDER BY keys SEPARATOR sep) to
+// group_concat(value, sep, directions of the keys...), the value is the
+// concatenation of the exprs, packed with the keys by serial_full if there are any.
+func (b *baseBinder) bindGroupConcat(astExpr *tree.FuncExpr, depth int32) (*plan.Expr, error) {
+	var err error
+
+	args := make([]*Expr, len(astExpr.Exprs))
+	for idx, arg := range astExpr.Exprs {
+		if args[idx], err = b.impl.BindExpr(arg, depth, false); err != nil {
+			return nil, err
+		}
+	}
+	value := args[0]
+	if len(args) > 1 {
+		if value, err = bindFuncExprImplByPlanExpr("concat", args); err != nil {
+			return nil, err
+		}
+	} else {
+		switch value.Typ.Id {
+		case plan.Type_CHAR, plan.Type_VARCHAR, plan.Type_TEXT:
+		case plan.Type_ANY:
+			// a NULL can't be cast, take it as a null string
+			value = &Expr{
+				Expr: value.Expr,
+				Typ:  &plan.Type{Id: plan.Type_VARCHAR, Nullable: true, Size: 4},
+			}
+		default:
+			if value, err = appendCastBeforeExpr(value, &plan.Type{Id: plan.Type_VARCHAR, Size: 4}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	separator := ","
+	if astExpr.Separator != nil {
+		separator = *astExpr.Separator
+	}
+	groupConcatArgs := []*Expr{value, makePlan2StringConstExprWithType(separator)}
+	if len(astExpr.OrderBy) > 0 {
+		tuple := []*Expr{value}
+		for _, order := range astExpr.OrderBy {
+			key, err := b.impl.BindExpr(order.Expr, depth, false)
+			if err != nil {
+				return nil, err
+			}
+			tuple = append(tuple, key)
+			desc := int64(0)
+			if order.Direction == tree.Descending {
+				desc = 1
+			}
+			groupConcatArgs = append(groupConcatArgs, makePlan2Int64ConstExprWithType(desc))
+		}
+		if groupConcatArgs[0], err = bindFuncExprImplByPlanExpr("serial_full", tuple); err != nil {
+			return nil, err
+		}
+	}
+	return bindFuncExprImplByPlanExpr("group_concat", groupConcatArgs)
+}
+
+// getPercentileFraction returns the fraction of a percentile, which must be a
+// numeric constant between 0 and 1.
+func getPercentileFraction(astExpr tree.Expr) (float64, bool) {
+	numVal, ok := astExpr.(*tree.NumVal)
+	if !ok {
+		return 0, false
+	}
+	switch numVal.ValType {
+	case tree.P_int64, tree.P_uint64, tree.P_float64, tree.P_decimal:
+	default:
+		return 0, false
+	}
+	fraction, _ := constant.Float64Val(constant.ToFloat(numVal.Value))
+	if fraction < 0 || fraction > 1 {
+		return 0, false
+	}
+	return fraction, true
+}
+
 func bindFuncExprImplByPlanExpr(name string, args []*Expr) (*plan.Expr, error) {
 	var err error
 
@@ -722,6 +1013,13 @@ func bindFuncExprImplByPlanExpr(name string, args []*Expr) (*plan.Expr, error) {
 		} else {
 			name = "date_sub"
 		}
+	case "uuid":
+		// uuid() has no args, add a constant arg to let the function know
+		// the row count of the batch, uuid(0)
+		if len(args) != 0 {
+			return nil, errors.New("", "uuid function need no args")
+		}
+		args = append(args, makePlan2Int64ConstExprWithType(0))
 	case "+":
 		// rewrite "date '2001' + interval '1 day'" to date_add(date '2001', 1, day(unit))
 		if len(args) != 2 {
@@ -774,18 +1072,16 @@ func bindFuncExprImplByPlanExpr(name string, args []*Expr) (*plan.Expr, error) {
 				return nil, err
 			}
 		}
-	case "variance":
-		if args[0].Typ.Id == plan.Type_DECIMAL128 || args[0].Typ.Id == plan.Type_DECIMAL64 {
-			args[0], err = appendCastBeforeExpr(args[0], &plan.Type{
-				Id:       plan.Type_FLOAT64,
-				Nullable: false,
-			})
+	case "count":
+		// count(distinct a, b) counts the distinct tuples of the args
+		if len(args) > 1 {
+			arg, err := bindFuncExprImplByPlanExpr("serial", args)
 			if err != nil {
 				return nil, err
 			}
-
+			args = []*Expr{arg}
 		}
-	case "stddev_pop":
+	case "variance", "var_pop", "var_samp", "std", "stddev", "stddev_pop", "stddev_samp", "percentile_cont":
 		if args[0].Typ.Id == plan.Type_DECIMAL128 || args[0].Typ.Id == plan.Type_DECIMAL64 {
 			args[0], err = appendCastBeforeExpr(args[0], &plan.Type{
 				Id:       plan.Type_FLOAT64,
@@ -797,6 +1093,24 @@ func bindFuncExprImplByPlanExpr(name string, args []*Expr) (*plan.Expr, error) {
 		}
 	}
 
+	// ENUM and SET work as the names of their members and BIT as an unsigned
+	// integer, except in the functions converting them
+	switch name {
+	case "cast_value_to_enum", "cast_value_to_set", "cast_enum_to_value":
+	default:
+		for idx, expr := range args {
+			switch expr.Typ.Id {
+			case plan.Type_ENUM, plan.Type_SET:
+				args[idx], err = appendCastBeforeExpr(expr, &plan.Type{Id: plan.Type_VARCHAR, Size: 4})
+			case plan.Type_BIT:
+				args[idx], err = appendCastBeforeExpr(expr, &plan.Type{Id: plan.Type_UINT64, Size: 8})
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	// get args(exprs) & types
 	argsLength := len(args)
 	argsType := make([]types.Type, argsLength)
@@ -1000,6 +1314,12 @@ func appendCastBeforeExpr(expr *Expr, toType *Type) (*Expr, error) {
 	if expr.Typ.Id == plan.Type_ANY {
 		return expr, nil
 	}
+	if isEnumType(expr.Typ) || isEnumType(toType) {
+		if isSameColumnType(expr.Typ, toType) {
+			return expr, nil
+		}
+		return makeEnumCastExpr(expr, toType)
+	}
 	argsType := []types.Type{
 		makeTypeByPlan2Expr(expr),
 		makeTypeByPlan2Type(toType),
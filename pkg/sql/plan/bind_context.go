@@ -27,12 +27,18 @@ func NewBindContext(builder *QueryBuilder, parent *BindContext) *BindContext {
 		groupByAst:     make(map[string]int32),
 		aggregateByAst: make(map[string]int32),
 		projectByExpr:  make(map[string]int32),
+		windowByAst:    make(map[string]*plan.Expr),
+		windowBySpec:   make(map[string]*WindowRef),
 		aliasMap:       make(map[string]int32),
 		bindingByTag:   make(map[int32]*Binding),
 		bindingByTable: make(map[string]*Binding),
 		bindingByCol:   make(map[string]*Binding),
 		parent:         parent,
 	}
+	if parent != nil {
+		bc.defaultDatabase = parent.defaultDatabase
+		bc.views = parent.views
+	}
 
 	return bc
 }
@@ -65,6 +71,27 @@ func (bc *BindContext) findCTE(name string) *CTERef {
 	return nil
 }
 
+// findRecursiveRef finds the recursive CTE referenced in its own recursive query block.
+func (bc *BindContext) findRecursiveRef(name string) (*RecursiveRef, error) {
+	var inner *RecursiveRef
+	for ; bc != nil; bc = bc.parent {
+		if bc.recursiveRef == nil {
+			continue
+		}
+		if bc.recursiveRef.name != name {
+			if inner == nil {
+				inner = bc.recursiveRef
+			}
+			continue
+		}
+		if inner != nil {
+			return nil, errors.New("", fmt.Sprintf("reference to recursive CTE %q in the recursive query block of %q will be supported in future version.", name, inner.name))
+		}
+		return bc.recursiveRef, nil
+	}
+	return nil, nil
+}
+
 func (bc *BindContext) mergeContexts(left, right *BindContext) error {
 	left.parent = bc
 	right.parent = bc
@@ -283,6 +310,22 @@ func (bc *BindContext) qualifyColumnNames(astExpr tree.Expr, selectList tree.Sel
 			}
 		}
 
+		if exprImpl.WindowSpec != nil {
+			for i := range exprImpl.WindowSpec.PartitionBy {
+				exprImpl.WindowSpec.PartitionBy[i], err = bc.qualifyColumnNames(exprImpl.WindowSpec.PartitionBy[i], selectList, expandAlias)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			for _, order := range exprImpl.WindowSpec.OrderBy {
+				order.Expr, err = bc.qualifyColumnNames(order.Expr, selectList, expandAlias)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
 	case *tree.RangeCond:
 		exprImpl.Left, err = bc.qualifyColumnNames(exprImpl.Left, selectList, expandAlias)
 		if err != nil {
@@ -296,6 +339,18 @@ func (bc *BindContext) qualifyColumnNames(astExpr tree.Expr, selectList tree.Sel
 
 		exprImpl.To, err = bc.qualifyColumnNames(exprImpl.To, selectList, expandAlias)
 
+	case *tree.FullTextMatchExpr:
+		for i, col := range exprImpl.Cols {
+			var colExpr tree.Expr
+			colExpr, err = bc.qualifyColumnNames(col, selectList, false)
+			if err != nil {
+				return nil, err
+			}
+			exprImpl.Cols[i] = colExpr.(*tree.UnresolvedName)
+		}
+
+		exprImpl.Query, err = bc.qualifyColumnNames(exprImpl.Query, selectList, expandAlias)
+
 	case *tree.UnresolvedName:
 		if !exprImpl.Star && exprImpl.NumParts == 1 {
 			col := exprImpl.Parts[0]
@@ -69,6 +69,12 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement) (*Plan, error) {
 		return buildCreateTable(stmt, ctx)
 	case *tree.DropTable:
 		return buildDropTable(stmt, ctx)
+	case *tree.CreateView:
+		return buildCreateView(stmt, ctx)
+	case *tree.DropView:
+		return buildDropView(stmt, ctx)
+	case *tree.AlterTable:
+		return buildAlterTable(stmt, ctx)
 	case *tree.CreateIndex:
 		return buildCreateIndex(stmt, ctx)
 	case *tree.DropIndex:
@@ -77,6 +83,8 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement) (*Plan, error) {
 		return buildShowCreateDatabase(stmt, ctx)
 	case *tree.ShowCreateTable:
 		return buildShowCreateTable(stmt, ctx)
+	case *tree.ShowCreateView:
+		return buildShowCreateView(stmt, ctx)
 	case *tree.ShowDatabases:
 		return buildShowDatabases(stmt, ctx)
 	case *tree.ShowTables:
@@ -0,0 +1,421 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// buildCheckDefs validates the enforced CHECK constraints of a new table and
+// appends them to its definition. The constraints without a name are named
+// <table>_chk_<n>, as MySQL does. NOT ENFORCED constraints have no effect and
+// are not kept.
+func buildCheckDefs(checks []*tree.CheckIndex, ctx CompilerContext, tableDef *TableDef) error {
+	names := make(map[string]bool, len(checks))
+	for _, check := range checks {
+		if check.Name == "" {
+			continue
+		}
+		if names[check.Name] {
+			return errors.New(errno.DuplicateObject, fmt.Sprintf("Duplicate check constraint name '%s'.", check.Name))
+		}
+		names[check.Name] = true
+	}
+	n := 0
+	for _, check := range checks {
+		name := check.Name
+		for name == "" || (check.Name == "" && names[name]) {
+			n++
+			name = fmt.Sprintf("%s_chk_%d", tableDef.Name, n)
+		}
+		if !check.Enforced {
+			continue
+		}
+		expr, err := bindCheckExpr(ctx, tableDef, name, check.Expr)
+		if err != nil {
+			return err
+		}
+		for _, col := range getCheckColumns(expr, tableDef) {
+			if col.AutoIncr {
+				return errors.New(errno.InvalidTableDefinition, fmt.Sprintf("Check constraint '%s' cannot refer to an auto-increment column.", name))
+			}
+		}
+		tableDef.Defs = append(tableDef.Defs, &plan.TableDef_DefType{
+			Def: &plan.TableDef_DefType_Check{
+				Check: &plan.CheckDef{
+					Name: name,
+					Expr: formatCheckExpr(check.Expr),
+				},
+			},
+		})
+	}
+	return nil
+}
+
+// formatCheckExpr returns the text of a check expression which is kept in the
+// table definition
+func formatCheckExpr(expr tree.Expr) string {
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL)
+	fmtCtx.QuoteString = true
+	expr.Format(fmtCtx)
+	return fmtCtx.String()
+}
+
+// parseCheckExpr parses the text of a check expression kept in the table
+// definition
+func parseCheckExpr(text string) (tree.Expr, error) {
+	stmt, err := mysql.ParseOne("select " + text)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*tree.Select)
+	if !ok {
+		return nil, errors.New(errno.SyntaxError, fmt.Sprintf("invalid check expression '%s'", text))
+	}
+	clause, ok := sel.Select.(*tree.SelectClause)
+	if !ok || len(clause.Exprs) != 1 {
+		return nil, errors.New(errno.SyntaxError, fmt.Sprintf("invalid check expression '%s'", text))
+	}
+	return clause.Exprs[0].Expr, nil
+}
+
+// bindCheckExpr binds a check expression to the columns of the table, the
+// column references of the result are the positions of tableDef.Cols
+func bindCheckExpr(ctx CompilerContext, tableDef *TableDef, name string, astExpr tree.Expr) (*Expr, error) {
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	bindCtx := NewBindContext(builder, nil)
+	cols := make([]string, len(tableDef.Cols))
+	types := make([]*plan.Type, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		cols[i] = col.Name
+		types[i] = col.Typ
+	}
+	binding := NewBinding(0, 0, tableDef.Name, cols, types)
+	bindCtx.bindings = append(bindCtx.bindings, binding)
+	bindCtx.bindingByTag[binding.tag] = binding
+	bindCtx.bindingByTable[binding.table] = binding
+	for _, col := range cols {
+		bindCtx.bindingByCol[col] = binding
+	}
+
+	expr, err := NewTableBinder(builder, bindCtx).BindExpr(astExpr, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	if expr.Typ.Id != plan.Type_BOOL {
+		if expr, err = makePlan2CastExpr(expr, &plan.Type{Id: plan.Type_BOOL, Size: 1}); err != nil {
+			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("An expression of non-boolean type specified to a check constraint '%s'.", name))
+		}
+	}
+	return expr, nil
+}
+
+// getCheckColumns returns the columns referred by a bound check expression
+func getCheckColumns(expr *Expr, tableDef *TableDef) []*ColDef {
+	var cols []*ColDef
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		cols = append(cols, tableDef.Cols[e.Col.ColPos])
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			cols = append(cols, getCheckColumns(arg, tableDef)...)
+		}
+	case *plan.Expr_List:
+		for _, arg := range e.List.List {
+			cols = append(cols, getCheckColumns(arg, tableDef)...)
+		}
+	}
+	return cols
+}
+
+// replaceCheckColumns replaces the column references of a bound check
+// expression with the expressions of the columns
+func replaceCheckColumns(expr *Expr, colExprs []*Expr) *Expr {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		return DeepCopyExpr(colExprs[e.Col.ColPos])
+	case *plan.Expr_F:
+		for i, arg := range e.F.Args {
+			e.F.Args[i] = replaceCheckColumns(arg, colExprs)
+		}
+	case *plan.Expr_List:
+		for i, arg := range e.List.List {
+			e.List.List[i] = replaceCheckColumns(arg, colExprs)
+		}
+	}
+	return expr
+}
+
+// getCheckDefs returns the CHECK constraints of the table
+func getCheckDefs(tableDef *TableDef) []*plan.CheckDef {
+	var checks []*plan.CheckDef
+	for _, def := range tableDef.Defs {
+		if check, ok := def.Def.(*plan.TableDef_DefType_Check); ok {
+			checks = append(checks, check.Check)
+		}
+	}
+	return checks
+}
+
+// BuildCheckExprs binds the CHECK constraints of the table, in the order of
+// the table definition. The column references of the expressions are the
+// positions of tableDef.Cols.
+func BuildCheckExprs(ctx CompilerContext, tableDef *TableDef) ([]*Expr, error) {
+	checks := getCheckDefs(tableDef)
+	exprs := make([]*Expr, len(checks))
+	for i, check := range checks {
+		astExpr, err := parseCheckExpr(check.Expr)
+		if err != nil {
+			return nil, err
+		}
+		if exprs[i], err = bindCheckExpr(ctx, tableDef, check.Name, astExpr); err != nil {
+			return nil, err
+		}
+	}
+	return exprs, nil
+}
+
+// buildForeignKeyDefs validates the foreign keys of a new table and appends
+// them to its definition. The parent table must be in the database of the
+// table and the parent columns must be its primary key or a unique key. The
+// foreign keys without a name are named <table>_ibfk_<n>, as MySQL does.
+func buildForeignKeyDefs(fks []*tree.ForeignKey, ctx CompilerContext, dbName string, tableDef *TableDef) error {
+	names := make(map[string]bool, len(fks))
+	for _, fk := range fks {
+		if fk.Name == "" {
+			continue
+		}
+		if names[fk.Name] {
+			return errors.New(errno.DuplicateObject, fmt.Sprintf("Duplicate foreign key constraint name '%s'", fk.Name))
+		}
+		names[fk.Name] = true
+	}
+	n := 0
+	for _, fk := range fks {
+		name := fk.Name
+		for name == "" || (fk.Name == "" && names[name]) {
+			n++
+			name = fmt.Sprintf("%s_ibfk_%d", tableDef.Name, n)
+		}
+		def, err := buildForeignKeyDef(fk, name, ctx, dbName, tableDef)
+		if err != nil {
+			return err
+		}
+		tableDef.Defs = append(tableDef.Defs, &plan.TableDef_DefType{
+			Def: &plan.TableDef_DefType_Fk{
+				Fk: def,
+			},
+		})
+	}
+	return nil
+}
+
+func buildForeignKeyDef(fk *tree.ForeignKey, name string, ctx CompilerContext, dbName string, tableDef *TableDef) (*plan.ForeignKeyDef, error) {
+	refer := fk.Refer
+	parentDb := string(refer.TableName.SchemaName)
+	if parentDb == "" {
+		parentDb = dbName
+	}
+	if parentDb != dbName {
+		return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport foreign key '%s' referring to a table of another database", name))
+	}
+	parentName := string(refer.TableName.ObjectName)
+
+	// a table can refer to itself
+	parentDef, parentKeys := tableDef, getTableKeys(tableDef)
+	if parentName != tableDef.Name {
+		_, parentDef = ctx.Resolve(parentDb, parentName)
+		if parentDef == nil || parentDef.View != nil {
+			return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("Failed to open the referenced table '%s'", parentName))
+		}
+		if getPartitionDef(parentDef) != nil {
+			return nil, errors.New(errno.FeatureNotSupported, "Foreign keys are not yet supported in conjunction with partitioning")
+		}
+		parentKeys = getTableKeys(parentDef)
+		if pks := ctx.GetPrimaryKeyDef(parentDb, parentName); len(pks) > 0 {
+			pk := make([]string, len(pks))
+			for i, col := range pks {
+				pk[i] = col.Name
+			}
+			parentKeys = append(parentKeys, pk)
+		}
+	}
+
+	def := &plan.ForeignKeyDef{
+		Name:        name,
+		ParentTable: parentName,
+	}
+	for _, key := range fk.KeyParts {
+		def.Cols = append(def.Cols, key.ColName.Parts[0])
+	}
+	for _, key := range refer.KeyParts {
+		def.ParentCols = append(def.ParentCols, key.ColName.Parts[0])
+	}
+	if len(def.Cols) != len(def.ParentCols) {
+		return nil, errors.New(errno.InvalidForeignKey, fmt.Sprintf("Incorrect foreign key definition for '%s': Key reference and table reference don't match", name))
+	}
+	if !isTableKey(parentKeys, def.ParentCols) {
+		return nil, errors.New(errno.InvalidForeignKey, fmt.Sprintf("Failed to add the foreign key constraint. Missing index for constraint '%s' in the referenced table '%s'", name, parentName))
+	}
+
+	var err error
+	if def.OnDelete, err = getReferenceAction(refer.OnDelete); err != nil {
+		return nil, err
+	}
+	if def.OnUpdate, err = getReferenceAction(refer.OnUpdate); err != nil {
+		return nil, err
+	}
+	setNull := def.OnDelete == plan.ForeignKeyDef_SET_NULL || def.OnUpdate == plan.ForeignKeyDef_SET_NULL
+	for i, colName := range def.Cols {
+		col := findColDef(tableDef, colName)
+		if col == nil {
+			return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Key column '%s' doesn't exist in table", colName))
+		}
+		parentCol := findColDef(parentDef, def.ParentCols[i])
+		if parentCol == nil {
+			return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Key column '%s' doesn't exist in referenced table", def.ParentCols[i]))
+		}
+		if !isReferableType(col.Typ, parentCol.Typ) {
+			return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("Referencing column '%s' and referenced column '%s' in foreign key constraint '%s' are incompatible.", colName, parentCol.Name, name))
+		}
+		if setNull && (col.NotNull || col.Primary || isPrimaryKeyColumn(tableDef, colName)) {
+			return nil, errors.New(errno.InvalidForeignKey, fmt.Sprintf("Column '%s' cannot be NOT NULL: needed in a foreign key constraint '%s' SET NULL", colName, name))
+		}
+	}
+	return def, nil
+}
+
+func getReferenceAction(opt tree.ReferenceOptionType) (plan.ForeignKeyDef_RefAction, error) {
+	switch opt {
+	case tree.REFERENCE_OPTION_CASCADE:
+		return plan.ForeignKeyDef_CASCADE, nil
+	case tree.REFERENCE_OPTION_SET_NULL:
+		return plan.ForeignKeyDef_SET_NULL, nil
+	case tree.REFERENCE_OPTION_SET_DEFAULT:
+		return 0, errors.New(errno.SQLStatementNotYetComplete, "unsupport foreign key action 'set default'")
+	}
+	// NO ACTION is checked immediately as RESTRICT
+	return plan.ForeignKeyDef_RESTRICT, nil
+}
+
+// getTableKeys returns the columns of the primary key and the unique keys
+// kept in the table definition
+func getTableKeys(tableDef *TableDef) [][]string {
+	var keys [][]string
+	for _, def := range tableDef.Defs {
+		switch d := def.Def.(type) {
+		case *plan.TableDef_DefType_Pk:
+			keys = append(keys, d.Pk.Names)
+		case *plan.TableDef_DefType_Idx:
+			if d.Idx.Typ == plan.IndexDef_UNIQUE {
+				keys = append(keys, d.Idx.ColNames)
+			}
+		}
+	}
+	return keys
+}
+
+func isPrimaryKeyColumn(tableDef *TableDef, name string) bool {
+	for _, def := range tableDef.Defs {
+		if pk, ok := def.Def.(*plan.TableDef_DefType_Pk); ok {
+			for _, colName := range pk.Pk.Names {
+				if colName == name {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// isTableKey returns true if the columns are one of the keys, in any order
+func isTableKey(keys [][]string, cols []string) bool {
+	for _, key := range keys {
+		if len(key) != len(cols) {
+			continue
+		}
+		match := true
+		for _, name := range key {
+			found := false
+			for _, col := range cols {
+				if col == name {
+					found = true
+					break
+				}
+			}
+			if !found {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+func findColDef(tableDef *TableDef, name string) *ColDef {
+	for _, col := range tableDef.Cols {
+		if col.Name == name {
+			return col
+		}
+	}
+	return nil
+}
+
+// isReferableType returns true if the values of the types compare equal as
+// they are, the strings of char and varchar columns refer to each other and
+// so do binary and varbinary
+func isReferableType(typ, parentTyp *plan.Type) bool {
+	isString := func(id plan.Type_TypeId) bool {
+		return id == plan.Type_CHAR || id == plan.Type_VARCHAR
+	}
+	isBinary := func(id plan.Type_TypeId) bool {
+		return id == plan.Type_BINARY || id == plan.Type_VARBINARY
+	}
+	if isString(typ.Id) && isString(parentTyp.Id) {
+		return true
+	}
+	if isBinary(typ.Id) && isBinary(parentTyp.Id) {
+		return true
+	}
+	if typ.Id != parentTyp.Id {
+		return false
+	}
+	switch typ.Id {
+	case plan.Type_DECIMAL64, plan.Type_DECIMAL128:
+		return typ.Scale == parentTyp.Scale
+	}
+	return true
+}
+
+// getForeignKeyDefs returns the foreign keys of the table
+func getForeignKeyDefs(tableDef *TableDef) []*plan.ForeignKeyDef {
+	var fks []*plan.ForeignKeyDef
+	for _, def := range tableDef.Defs {
+		if fk, ok := def.Def.(*plan.TableDef_DefType_Fk); ok {
+			fks = append(fks, fk.Fk)
+		}
+	}
+	return fks
+}
@@ -131,6 +131,19 @@ func buildPrepare(stmt tree.Prepare, ctx CompilerContext) (*Plan, error) {
 	}, nil
 }
 
+// GetPrepareParamCount returns the number of the parameters of the prepared statement
+func GetPrepareParamCount(prepare *plan.Prepare) (int, error) {
+	query := prepare.GetPlan().GetQuery()
+	if query == nil {
+		return 0, nil
+	}
+	getParamRule := NewGetParamRule()
+	if err := NewVisitQuery(query, []VisitRule{getParamRule}).Visit(); err != nil {
+		return 0, err
+	}
+	return len(getParamRule.params), nil
+}
+
 func buildExecute(stmt *tree.Execute, ctx CompilerContext) (*Plan, error) {
 	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
 	binder := NewWhereBinder(builder, &BindContext{})
@@ -16,7 +16,9 @@ package plan
 
 import (
 	"fmt"
+	"strconv"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
@@ -41,7 +43,11 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 	}
 
 	// set tableDef
-	err := buildTableDefs(stmt.Defs, ctx, createTable.TableDef)
+	dbName := createTable.Database
+	if dbName == "" {
+		dbName = ctx.DefaultDatabase()
+	}
+	err := buildTableDefs(stmt.Defs, ctx, dbName, createTable.TableDef)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +90,20 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 					},
 				},
 			})
+		case *tree.TableOptionAutoIncrement:
+			properties := []*plan.Property{
+				{
+					Key:   AutoIncrementProperty,
+					Value: strconv.FormatUint(opt.Value, 10),
+				},
+			}
+			createTable.TableDef.Defs = append(createTable.TableDef.Defs, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: properties,
+					},
+				},
+			})
 		// these table options is not support in plan
 		// case *tree.TableOptionEngine, *tree.TableOptionSecondaryEngine, *tree.TableOptionCharset,
 		// 	*tree.TableOptionCollate, *tree.TableOptionAutoIncrement, *tree.TableOptionComment,
@@ -100,9 +120,11 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 		}
 	}
 
-	// set partition(unsupport now)
+	// set partition
 	if stmt.PartitionOption != nil {
-		return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("partition unsupport now; statement: '%v'", tree.String(stmt, dialect.MYSQL)))
+		if err := buildPartitionDef(stmt.PartitionOption, createTable.TableDef); err != nil {
+			return nil, err
+		}
 	}
 
 	return &Plan{
@@ -117,8 +139,12 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 	}, nil
 }
 
-func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef) error {
+func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, dbName string, tableDef *TableDef) error {
 	var primaryKeys []string
+	var keyIndexes []*plan.IndexDef
+	var checks []*tree.CheckIndex
+	var foreignKeys []*tree.ForeignKey
+	var hasAutoIncr bool
 	for _, item := range defs {
 		switch def := item.(type) {
 		case *tree.ColumnTableDef:
@@ -133,11 +159,47 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 
 			var pks []string
 			var comment string
+			var autoIncr, notNull bool
 			for _, attr := range def.Attributes {
 				if _, ok := attr.(*tree.AttributePrimaryKey); ok {
 					pks = append(pks, def.Name.Parts[0])
 				}
 
+				switch a := attr.(type) {
+				case *tree.AttributeUniqueKey, *tree.AttributeUnique:
+					keyIndexes = append(keyIndexes, &plan.IndexDef{
+						Typ:      plan.IndexDef_UNIQUE,
+						ColNames: []string{def.Name.Parts[0]},
+					})
+				case *tree.AttributeNull:
+					notNull = !a.Is
+				case *tree.AttributeCheckConstraint:
+					checks = append(checks, &tree.CheckIndex{
+						Name:     a.Name,
+						Expr:     a.Expr,
+						Enforced: a.Enforced,
+					})
+				case *tree.AttributeReference:
+					foreignKeys = append(foreignKeys, &tree.ForeignKey{
+						KeyParts: []*tree.KeyPart{{ColName: def.Name}},
+						Refer:    a,
+					})
+				}
+
+				if _, ok := attr.(*tree.AttributeAutoIncrement); ok {
+					switch colType.Id {
+					case plan.Type_INT8, plan.Type_INT16, plan.Type_INT32, plan.Type_INT64,
+						plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64:
+					default:
+						return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Incorrect column specifier for column '%s'", def.Name.Parts[0]))
+					}
+					if hasAutoIncr {
+						return errors.New(errno.InvalidTableDefinition, "Incorrect table definition; there can be only one auto column")
+					}
+					hasAutoIncr = true
+					autoIncr = true
+				}
+
 				if attrComment, ok := attr.(*tree.AttributeComment); ok {
 					comment = attrComment.CMT.String()
 					if getNumOfCharacters(comment) > maxLengthOfColumnComment {
@@ -146,6 +208,9 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 					}
 				}
 			}
+			if autoIncr && defultValue.Exist && !defultValue.IsNull {
+				return errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Invalid default value for '%s'", def.Name.Parts[0]))
+			}
 			if len(pks) > 0 {
 				if len(primaryKeys) > 0 {
 					return errors.New(errno.SyntaxErrororAccessRuleViolation, "Multiple primary key defined")
@@ -153,11 +218,13 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 				primaryKeys = pks
 			}
 			col := &ColDef{
-				Name:    def.Name.Parts[0],
-				Alg:     plan.CompressType_Lz4,
-				Typ:     colType,
-				Default: defultValue,
-				Comment: comment,
+				Name:     def.Name.Parts[0],
+				Alg:      plan.CompressType_Lz4,
+				Typ:      colType,
+				Default:  defultValue,
+				Comment:  comment,
+				AutoIncr: autoIncr,
+				NotNull:  notNull,
 			}
 			tableDef.Cols = append(tableDef.Cols, col)
 		case *tree.PrimaryKeyIndex:
@@ -207,15 +274,51 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 					Idx: idxDef,
 				},
 			})
-		case *tree.UniqueIndex, *tree.CheckIndex, *tree.ForeignKey, *tree.FullTextIndex:
-			// unsupport in plan. will support in next version.
-			return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport table def: '%v'", def))
+		case *tree.UniqueIndex:
+			idxDef := &plan.IndexDef{
+				Typ:      plan.IndexDef_UNIQUE,
+				Name:     def.Name,
+				ColNames: make([]string, len(def.KeyParts)),
+			}
+			nameMap := map[string]bool{}
+			for i, key := range def.KeyParts {
+				name := key.ColName.Parts[0] // name of unique key column
+				if _, ok := nameMap[name]; ok {
+					return errors.New(errno.InvalidTableDefinition, fmt.Sprintf("Duplicate column name '%s'", name))
+				}
+				idxDef.ColNames[i] = name
+				nameMap[name] = true
+			}
+			keyIndexes = append(keyIndexes, idxDef)
+		case *tree.CheckIndex:
+			checks = append(checks, def)
+		case *tree.ForeignKey:
+			foreignKeys = append(foreignKeys, def)
+		case *tree.FullTextIndex:
+			idxDef := &plan.IndexDef{
+				Typ:      plan.IndexDef_FULLTEXT,
+				Name:     def.Name,
+				ColNames: make([]string, len(def.KeyParts)),
+			}
+			nameMap := map[string]bool{}
+			for i, key := range def.KeyParts {
+				name := key.ColName.Parts[0] // name of full-text key column
+				if _, ok := nameMap[name]; ok {
+					return errors.New(errno.InvalidTableDefinition, fmt.Sprintf("Duplicate column name '%s'", name))
+				}
+				idxDef.ColNames[i] = name
+				nameMap[name] = true
+			}
+			keyIndexes = append(keyIndexes, idxDef)
 		default:
 			return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport table def: '%v'", def))
 		}
 	}
 
 	if len(primaryKeys) > 0 {
+		if err := checkKeyColumns(primaryKeys, tableDef); err != nil {
+			return err
+		}
 		tableDef.Defs = append(tableDef.Defs, &plan.TableDef_DefType{
 			Def: &plan.TableDef_DefType_Pk{
 				Pk: &plan.PrimaryKeyDef{
@@ -225,9 +328,207 @@ func buildTableDefs(defs tree.TableDefs, ctx CompilerContext, tableDef *TableDef
 		})
 	}
 
+	if err := buildKeyIndexes(keyIndexes, tableDef); err != nil {
+		return err
+	}
+	if err := buildCheckDefs(checks, ctx, tableDef); err != nil {
+		return err
+	}
+	return buildForeignKeyDefs(foreignKeys, ctx, dbName, tableDef)
+}
+
+// buildKeyIndexes checks the columns of the unique and full-text keys and
+// names the keys without a name after their first column, as MySQL does
+func buildKeyIndexes(keyIndexes []*plan.IndexDef, tableDef *TableDef) error {
+	cols := make(map[string]bool, len(tableDef.Cols))
+	for _, col := range tableDef.Cols {
+		cols[col.Name] = true
+	}
+	names := make(map[string]bool, len(keyIndexes))
+	for _, idxDef := range keyIndexes {
+		for _, name := range idxDef.ColNames {
+			if !cols[name] {
+				return errors.New(errno.UndefinedColumn, fmt.Sprintf("Key column '%s' doesn't exist in table", name))
+			}
+		}
+		if idxDef.Typ == plan.IndexDef_FULLTEXT {
+			if err := checkFullTextColumns(idxDef.ColNames, tableDef); err != nil {
+				return err
+			}
+		} else if err := checkKeyColumns(idxDef.ColNames, tableDef); err != nil {
+			return err
+		}
+		if idxDef.Name == "" {
+			continue
+		}
+		if names[idxDef.Name] {
+			return errors.New(errno.DuplicateObject, fmt.Sprintf("Duplicate key name '%s'", idxDef.Name))
+		}
+		names[idxDef.Name] = true
+	}
+	for _, idxDef := range keyIndexes {
+		if idxDef.Name == "" {
+			name := idxDef.ColNames[0]
+			for i := 2; names[name]; i++ {
+				name = fmt.Sprintf("%s_%d", idxDef.ColNames[0], i)
+			}
+			idxDef.Name = name
+			names[name] = true
+		}
+		tableDef.Defs = append(tableDef.Defs, &plan.TableDef_DefType{
+			Def: &plan.TableDef_DefType_Idx{
+				Idx: idxDef,
+			},
+		})
+	}
+	return nil
+}
+
+// checkKeyColumns rejects the large object and json columns in a key, a key
+// on TEXT or BLOB needs a prefix length which is not supported
+func checkKeyColumns(names []string, tableDef *TableDef) error {
+	for _, name := range names {
+		col := findColDef(tableDef, name)
+		if col != nil && types.T(col.Typ.Id).IsLob() {
+			return errors.New(errno.InvalidTableDefinition, fmt.Sprintf("BLOB/TEXT column '%s' used in key specification without a key length", name))
+		}
+		if col != nil && col.Typ.Id == plan.Type_JSON {
+			return errors.New(errno.InvalidTableDefinition, fmt.Sprintf("JSON column '%s' cannot be used in key specification", name))
+		}
+	}
 	return nil
 }
 
+// checkFullTextColumns rejects the columns of a full-text key which are not
+// char, varchar or text columns
+func checkFullTextColumns(names []string, tableDef *TableDef) error {
+	for _, name := range names {
+		col := findColDef(tableDef, name)
+		switch col.Typ.Id {
+		case plan.Type_CHAR, plan.Type_VARCHAR, plan.Type_TEXT:
+		default:
+			return errors.New(errno.InvalidTableDefinition, fmt.Sprintf("Column '%s' cannot be part of FULLTEXT index", name))
+		}
+	}
+	return nil
+}
+
+// isKeyColumn returns true if the column is a part of a key of the type
+func isKeyColumn(tableDef *TableDef, typ plan.IndexDef_IndexType, name string) bool {
+	for _, def := range tableDef.Defs {
+		idx, ok := def.Def.(*plan.TableDef_DefType_Idx)
+		if !ok || idx.Idx.Typ != typ {
+			continue
+		}
+		for _, colName := range idx.Idx.ColNames {
+			if colName == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func buildCreateView(stmt *tree.CreateView, ctx CompilerContext) (*Plan, error) {
+	if stmt.Temporary {
+		return nil, errors.New(errno.SQLStatementNotYetComplete, "temporary view unsupport now")
+	}
+	createTable := &plan.CreateTable{
+		IfNotExists: stmt.IfNotExists,
+		Replace:     stmt.Replace,
+		Database:    string(stmt.Name.SchemaName),
+		TableDef: &TableDef{
+			Name: string(stmt.Name.ObjectName),
+		},
+	}
+	if createTable.Database == "" {
+		createTable.Database = ctx.DefaultDatabase()
+	}
+
+	if _, tableDef := ctx.Resolve(createTable.Database, createTable.TableDef.Name); tableDef != nil && tableDef.View == nil && stmt.Replace {
+		return nil, errors.New(errno.WrongObjectType, fmt.Sprintf("'%s.%s' is not VIEW", createTable.Database, createTable.TableDef.Name))
+	}
+
+	// build the query to check it and to get the columns of the view
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx)
+	bindCtx := NewBindContext(builder, nil)
+	bindCtx.defaultDatabase = createTable.Database
+	rootId, err := builder.buildSelect(stmt.AsSource, bindCtx, true)
+	if err != nil {
+		return nil, err
+	}
+	builder.qry.Steps = append(builder.qry.Steps, rootId)
+	query, err := builder.createQuery()
+	if err != nil {
+		return nil, err
+	}
+	cols := GetResultColumnsFromPlan(&Plan{Plan: &plan.Plan_Query{Query: query}})
+	if len(stmt.ColNames) > 0 {
+		if len(stmt.ColNames) != len(cols) {
+			return nil, errors.New(errno.InvalidColumnReference, "View's SELECT and view's field list have different column counts")
+		}
+		for i, name := range stmt.ColNames {
+			cols[i].Name = string(name)
+		}
+	}
+	names := make(map[string]bool, len(cols))
+	for _, col := range cols {
+		if names[col.Name] {
+			return nil, errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", col.Name))
+		}
+		names[col.Name] = true
+	}
+	createTable.TableDef.Cols = cols
+
+	// the definition keeps what the view is, not how it was created
+	view := *stmt
+	view.IfNotExists = false
+	view.Replace = false
+	createTable.TableDef.View = &ViewDef{
+		View: tree.String(&view, dialect.MYSQL),
+	}
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_CREATE_VIEW,
+				Definition: &plan.DataDefinition_CreateTable{
+					CreateTable: createTable,
+				},
+			},
+		},
+	}, nil
+}
+
+func buildDropView(stmt *tree.DropView, ctx CompilerContext) (*Plan, error) {
+	dropTable := &plan.DropTable{
+		IfExists: stmt.IfExists,
+	}
+	if len(stmt.Names) != 1 {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, "support drop one view now")
+	}
+	dropTable.Database = string(stmt.Names[0].SchemaName)
+	if dropTable.Database == "" {
+		dropTable.Database = ctx.DefaultDatabase()
+	}
+	dropTable.Table = string(stmt.Names[0].ObjectName)
+
+	if _, tableDef := ctx.Resolve(dropTable.Database, dropTable.Table); tableDef != nil && tableDef.View == nil {
+		return nil, errors.New(errno.WrongObjectType, fmt.Sprintf("'%s.%s' is not VIEW", dropTable.Database, dropTable.Table))
+	}
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_DROP_VIEW,
+				Definition: &plan.DataDefinition_DropTable{
+					DropTable: dropTable,
+				},
+			},
+		},
+	}, nil
+}
+
 func buildDropTable(stmt *tree.DropTable, ctx CompilerContext) (*Plan, error) {
 	dropTable := &plan.DropTable{
 		IfExists: stmt.IfExists,
@@ -241,6 +542,10 @@ func buildDropTable(stmt *tree.DropTable, ctx CompilerContext) (*Plan, error) {
 	}
 	dropTable.Table = string(stmt.Names[0].ObjectName)
 
+	if _, tableDef := ctx.Resolve(dropTable.Database, dropTable.Table); tableDef != nil && tableDef.View != nil {
+		return nil, errors.New(errno.WrongObjectType, fmt.Sprintf("'%s.%s' is a view, use DROP VIEW", dropTable.Database, dropTable.Table))
+	}
+
 	return &Plan{
 		Plan: &plan.Plan_Ddl{
 			Ddl: &plan.DataDefinition{
@@ -253,6 +558,212 @@ func buildDropTable(stmt *tree.DropTable, ctx CompilerContext) (*Plan, error) {
 	}, nil
 }
 
+func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error) {
+	alterTable := &plan.AlterTable{
+		Database: string(stmt.Table.SchemaName),
+		Table:    string(stmt.Table.ObjectName),
+	}
+	if alterTable.Database == "" {
+		alterTable.Database = ctx.DefaultDatabase()
+	}
+
+	_, tableDef := ctx.Resolve(alterTable.Database, alterTable.Table)
+	if tableDef == nil {
+		return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("table '%v' doesn't exist", alterTable.Table))
+	}
+	if tableDef.View != nil {
+		return nil, errors.New(errno.WrongObjectType, fmt.Sprintf("'%s.%s' is not BASE TABLE", alterTable.Database, alterTable.Table))
+	}
+	alterTable.TableDef = tableDef
+
+	// apply actions to a copy of the column list so that every action
+	// is checked against the result of the previous ones
+	cols := make(map[string]*ColDef, len(tableDef.Cols))
+	for _, col := range tableDef.Cols {
+		cols[col.Name] = col
+	}
+	// the columns used by the check constraints can't be dropped or renamed
+	checkByCol := make(map[string]string)
+	checkExprs, err := BuildCheckExprs(ctx, tableDef)
+	if err != nil {
+		return nil, err
+	}
+	for i, check := range getCheckDefs(tableDef) {
+		for _, col := range getCheckColumns(checkExprs[i], tableDef) {
+			checkByCol[col.Name] = check.Name
+		}
+	}
+	// so are the columns of the foreign keys
+	fkByCol := make(map[string]string)
+	for _, fk := range getForeignKeyDefs(tableDef) {
+		for _, col := range fk.Cols {
+			fkByCol[col] = fk.Name
+		}
+	}
+	// and so are the partition columns
+	partDef := getPartitionDef(tableDef)
+	partByCol := make(map[string]bool)
+	if partDef != nil {
+		for _, col := range partDef.Cols {
+			partByCol[col] = true
+		}
+	}
+	for _, option := range stmt.Options {
+		var action *plan.AlterTable_Action
+		switch opt := option.(type) {
+		case *tree.AlterOptionAddColumn:
+			def := &TableDef{}
+			if err := buildTableDefs(tree.TableDefs{opt.Column}, ctx, alterTable.Database, def); err != nil {
+				return nil, err
+			}
+			for _, d := range def.Defs {
+				switch d.Def.(type) {
+				case *plan.TableDef_DefType_Idx:
+					return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport to add unique key column '%s'", def.Cols[0].Name))
+				case *plan.TableDef_DefType_Check:
+					return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport to add check constraint on column '%s'", def.Cols[0].Name))
+				case *plan.TableDef_DefType_Fk:
+					return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport to add foreign key on column '%s'", def.Cols[0].Name))
+				}
+				return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport to add primary key column '%s'", def.Cols[0].Name))
+			}
+			col := def.Cols[0]
+			if col.AutoIncr {
+				return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport to add auto_increment column '%s'", col.Name))
+			}
+			// the rows in the table would get null for the new column
+			if col.NotNull && !col.Default.Exist {
+				return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport to add not null column '%s' without default value", col.Name))
+			}
+			if _, ok := cols[col.Name]; ok {
+				return nil, errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", col.Name))
+			}
+			cols[col.Name] = col
+			action = &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_AddColumn{
+					AddColumn: &plan.AlterTableAddColumn{
+						Column: col,
+					},
+				},
+			}
+		case *tree.AlterOptionDropColumn:
+			name := opt.Name.Parts[0]
+			col, ok := cols[name]
+			if !ok {
+				return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Can't DROP '%s'; check that column/key exists", name))
+			}
+			if col.Primary {
+				return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport to drop primary key column '%s'", name))
+			}
+			if isKeyColumn(tableDef, plan.IndexDef_UNIQUE, name) {
+				return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport to drop unique key column '%s'", name))
+			}
+			if isKeyColumn(tableDef, plan.IndexDef_FULLTEXT, name) {
+				return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport to drop full-text key column '%s'", name))
+			}
+			if check, ok := checkByCol[col.Name]; ok {
+				return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("Check constraint '%s' uses column '%s', hence column cannot be dropped or renamed.", check, name))
+			}
+			if fk, ok := fkByCol[col.Name]; ok {
+				return nil, errors.New(errno.InvalidForeignKey, fmt.Sprintf("Cannot drop column '%s': needed in a foreign key constraint '%s'", name, fk))
+			}
+			if partByCol[col.Name] {
+				return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("Cannot drop column '%s': used in the partitioning function", name))
+			}
+			if len(cols) == 1 {
+				return nil, errors.New(errno.InvalidTableDefinition, "You can't delete all columns with ALTER TABLE; use DROP TABLE instead")
+			}
+			delete(cols, name)
+			action = &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_DropColumn{
+					DropColumn: &plan.AlterTableDropColumn{
+						Name: name,
+					},
+				},
+			}
+		case *tree.AlterOptionRenameColumn:
+			oldName, newName := opt.OldName.Parts[0], opt.NewName.Parts[0]
+			col, ok := cols[oldName]
+			if !ok {
+				return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in '%s'", oldName, alterTable.Table))
+			}
+			if _, ok := cols[newName]; ok {
+				return nil, errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", newName))
+			}
+			if check, ok := checkByCol[col.Name]; ok {
+				return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("Check constraint '%s' uses column '%s', hence column cannot be dropped or renamed.", check, oldName))
+			}
+			if fk, ok := fkByCol[col.Name]; ok {
+				return nil, errors.New(errno.InvalidForeignKey, fmt.Sprintf("Cannot rename column '%s': needed in a foreign key constraint '%s'", oldName, fk))
+			}
+			if partByCol[col.Name] {
+				return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("Cannot rename column '%s': used in the partitioning function", oldName))
+			}
+			delete(cols, oldName)
+			cols[newName] = col
+			action = &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_RenameColumn{
+					RenameColumn: &plan.AlterTableRenameColumn{
+						OldName: oldName,
+						NewName: newName,
+					},
+				},
+			}
+		case *tree.AlterOptionRenameTable:
+			database := string(opt.Name.SchemaName)
+			if database == "" {
+				database = alterTable.Database
+			}
+			if database != alterTable.Database {
+				return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport to rename table '%s' to another database", alterTable.Table))
+			}
+			action = &plan.AlterTable_Action{
+				Action: &plan.AlterTable_Action_RenameTable{
+					RenameTable: &plan.AlterTableRenameTable{
+						Database: database,
+						Table:    string(opt.Name.ObjectName),
+					},
+				},
+			}
+		case *tree.AlterOptionAddPartition:
+			if partDef == nil {
+				return nil, errTableNotPartitioned
+			}
+			if action, partDef, err = buildAddPartition(opt, partDef, tableDef); err != nil {
+				return nil, err
+			}
+		case *tree.AlterOptionDropPartition:
+			if partDef == nil {
+				return nil, errTableNotPartitioned
+			}
+			if action, partDef, err = buildDropPartition(opt, partDef); err != nil {
+				return nil, err
+			}
+		case *tree.AlterOptionTruncatePartition:
+			if partDef == nil {
+				return nil, errTableNotPartitioned
+			}
+			if action, err = buildTruncatePartition(opt, partDef, alterTable.Table); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unexpected options; statement: '%v'", tree.String(stmt, dialect.MYSQL)))
+		}
+		alterTable.Actions = append(alterTable.Actions, action)
+	}
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_ALTER_TABLE,
+				Definition: &plan.DataDefinition_AlterTable{
+					AlterTable: alterTable,
+				},
+			},
+		},
+	}, nil
+}
+
 func buildCreateDatabase(stmt *tree.CreateDatabase, ctx CompilerContext) (*Plan, error) {
 	createDB := &plan.CreateDatabase{
 		IfNotExists: stmt.IfNotExists,
@@ -45,6 +45,9 @@ func buildDeleteSingleTable(stmt *tree.Delete, ctx CompilerContext) (*Plan, erro
 	if tableDef == nil {
 		return nil, errors.New(errno.FeatureNotSupported, "cannot find delete table")
 	}
+	if tableDef.View != nil {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("view '%s' is not updatable", tableDef.Name))
+	}
 
 	// optimize to truncate,
 	if stmt.Where == nil && stmt.Limit == nil {
@@ -77,11 +80,16 @@ func buildDeleteSingleTable(stmt *tree.Delete, ctx CompilerContext) (*Plan, erro
 	qry := usePlan.Plan.(*plan.Plan_Query).Query
 
 	// build delete node
+	tblName := string(tbl.ObjectName)
+	if alsTbl, ok := stmt.Tables[0].(*tree.AliasedTableExpr); ok && alsTbl.As.Alias != "" {
+		tblName = string(alsTbl.As.Alias)
+	}
 	d := &plan.DeleteTableCtx{
 		DbName:       objRef.SchemaName,
 		TblName:      tableDef.Name,
 		UseDeleteKey: useKey.Name,
 		CanTruncate:  false,
+		ReadColumns:  stmt.Where != nil && readsColumns(tblName, tableDef, stmt.Where.Expr),
 	}
 	node := &Node{
 		NodeType:        plan.Node_DELETE,
@@ -136,6 +144,9 @@ func buildDeleteMultipleTable(stmt *tree.Delete, ctx CompilerContext) (*Plan, er
 		if tblDefs[i] == nil {
 			return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("cannot find delete table: %s.%s", dbName, string(t.ObjectName)))
 		}
+		if tblDefs[i].View != nil {
+			return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("view '%s' is not updatable", tblDefs[i].Name))
+		}
 	}
 
 	// find out use keys to delete
@@ -167,6 +178,10 @@ func buildDeleteMultipleTable(stmt *tree.Delete, ctx CompilerContext) (*Plan, er
 	usePlan.Plan.(*plan.Plan_Query).Query.StmtType = plan.Query_DELETE
 	qry := usePlan.Plan.(*plan.Plan_Query).Query
 
+	readExprs := getJoinConds(stmt.TableRefs)
+	if stmt.Where != nil {
+		readExprs = append(readExprs, stmt.Where.Expr)
+	}
 	ds := make([]*plan.DeleteTableCtx, tableCount)
 	for i := 0; i < tableCount; i++ {
 		ds[i] = &plan.DeleteTableCtx{
@@ -174,6 +189,7 @@ func buildDeleteMultipleTable(stmt *tree.Delete, ctx CompilerContext) (*Plan, er
 			TblName:      tblDefs[i].Name,
 			UseDeleteKey: useKeys[i].Name,
 			CanTruncate:  false,
+			ReadColumns:  readsColumns(string(tbs[i].ObjectName), tblDefs[i], readExprs...),
 		}
 	}
 	node := &Node{
@@ -41,6 +41,9 @@ func buildInsertValues(_ *tree.Insert, _ CompilerContext) (p *Plan, err error) {
 }
 
 func buildInsertSelect(stmt *tree.Insert, ctx CompilerContext) (p *Plan, err error) {
+	if len(stmt.OnDuplicateUpdate) > 0 {
+		return nil, errors.New(errno.FeatureNotSupported, "insert ... select with on duplicate key update is not supported now")
+	}
 	pn, err := runBuildSelectByBinder(plan.Query_SELECT, ctx, stmt.Rows)
 	if err != nil {
 		return nil, err
@@ -76,6 +79,15 @@ func buildInsertSelect(stmt *tree.Insert, ctx CompilerContext) (p *Plan, err err
 			return nil, err
 		}
 	}
+
+	// the results of the check constraints follow the columns
+	checks, err := BuildCheckExprs(ctx, tableDef)
+	if err != nil {
+		return nil, err
+	}
+	for _, check := range checks {
+		exprs = append(exprs, replaceCheckColumns(check, exprs[:len(tableDef.Cols)]))
+	}
 	qry := pn.Plan.(*plan.Plan_Query).Query
 	n := &Node{
 		ObjRef:      objRef,
@@ -133,6 +145,10 @@ func getInsertExprs(stmt *tree.Insert, cols []*ColDef, tableDef *TableDef) ([]*E
 					},
 				}
 			} else {
+				col := tableDef.Cols[i]
+				if col.NotNull && !col.AutoIncr && !col.Default.GetExist() {
+					return nil, errors.New(errno.IntegrityConstraintViolation, fmt.Sprintf("Field '%s' doesn't have a default value", col.Name))
+				}
 				// get default value, and init constant expr.
 				exprs[i], err = generateDefaultExpr(tableDef.Cols[i].Default, tableDef.Cols[i].Typ)
 				if err != nil {
@@ -208,6 +224,9 @@ func getInsertTable(stmt tree.TableExpr, ctx CompilerContext) (*ObjectRef, *Tabl
 		if tableDef == nil {
 			return nil, nil, errors.New(errno.InvalidSchemaName, fmt.Sprintf("table '%v' is not exist", tblName))
 		}
+		if tableDef.View != nil {
+			return nil, nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("view '%v' is not insertable", tblName))
+		}
 		return objRef, tableDef, nil
 	case *tree.ParenTableExpr:
 		return getInsertTable(tbl.Expr, ctx)
@@ -0,0 +1,651 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+var errTableNotPartitioned = errors.New(errno.WrongObjectType, "Partition management on a not partitioned table is not possible")
+
+// buildPartitionDef checks the partition option of a new table and appends
+// the partition definition to the table definition
+func buildPartitionDef(opt *tree.PartitionOption, tableDef *TableDef) error {
+	if opt.SubPartBy != nil {
+		return errors.New(errno.FeatureNotSupported, "subpartitions are not supported")
+	}
+	def := &plan.PartitionDef{}
+	var err error
+	var columns bool
+	switch typ := opt.PartBy.PType.(type) {
+	case *tree.RangeType:
+		def.Type = plan.PartitionDef_RANGE
+		columns = typ.ColumnList != nil
+		err = buildPartitionExpr(def, typ.Expr, typ.ColumnList, tableDef)
+	case *tree.ListType:
+		def.Type = plan.PartitionDef_LIST
+		columns = typ.ColumnList != nil
+		err = buildPartitionExpr(def, typ.Expr, typ.ColumnList, tableDef)
+	case *tree.HashType:
+		if typ.Linear {
+			return errors.New(errno.FeatureNotSupported, "LINEAR HASH partitions are not supported")
+		}
+		def.Type = plan.PartitionDef_HASH
+		err = buildPartitionExpr(def, typ.Expr, nil, tableDef)
+	case *tree.KeyType:
+		if typ.Linear {
+			return errors.New(errno.FeatureNotSupported, "LINEAR KEY partitions are not supported")
+		}
+		def.Type = plan.PartitionDef_KEY
+		err = buildPartitionKey(def, typ.ColumnList, tableDef)
+	default:
+		return errors.New(errno.FeatureNotSupported, fmt.Sprintf("partition type '%s' is not supported", tree.String(opt.PartBy.PType, dialect.MYSQL)))
+	}
+	if err != nil {
+		return err
+	}
+
+	// the values of RANGE and LIST partitions are integers unless the
+	// partitions are defined by COLUMNS
+	if def.Type != plan.PartitionDef_KEY && !columns {
+		oid, err := engine.PartitionValueType(def.Func, makeTypeByPlan2Type(findColDef(tableDef, def.Cols[0]).Typ))
+		if err != nil {
+			return err
+		}
+		if oid != types.T_int64 {
+			return errors.New(errno.InvalidTableDefinition, fmt.Sprintf("Field '%s' is of a not allowed type for this type of partitioning", def.Cols[0]))
+		}
+	}
+
+	switch def.Type {
+	case plan.PartitionDef_RANGE, plan.PartitionDef_LIST:
+		if len(opt.Partitions) == 0 {
+			return errors.New(errno.InvalidTableDefinition, fmt.Sprintf("For %s partitions each partition must be defined", def.Type))
+		}
+		if opt.PartBy.Num != 0 && opt.PartBy.Num != uint64(len(opt.Partitions)) {
+			return errors.New(errno.InvalidTableDefinition, "Wrong number of partitions defined, mismatch with previous setting")
+		}
+		if def.Partitions, err = buildPartitions(def, opt.Partitions, tableDef); err != nil {
+			return err
+		}
+	default:
+		num := opt.PartBy.Num
+		if len(opt.Partitions) > 0 {
+			if num != 0 && num != uint64(len(opt.Partitions)) {
+				return errors.New(errno.InvalidTableDefinition, "Wrong number of partitions defined, mismatch with previous setting")
+			}
+			if def.Partitions, err = buildPartitions(def, opt.Partitions, tableDef); err != nil {
+				return err
+			}
+			break
+		}
+		if num == 0 {
+			num = 1
+		}
+		for i := uint64(0); i < num; i++ {
+			def.Partitions = append(def.Partitions, &plan.PartitionDef_Partition{
+				Name: fmt.Sprintf("p%d", i),
+			})
+		}
+	}
+	if err := checkPartitionDef(def, tableDef); err != nil {
+		return err
+	}
+
+	// every row of a key value must go to the same partition
+	for _, d := range tableDef.Defs {
+		switch d := d.Def.(type) {
+		case *plan.TableDef_DefType_Pk:
+			if !containsAll(d.Pk.Names, def.Cols) {
+				return errors.New(errno.InvalidTableDefinition, "A PRIMARY KEY must include all columns in the table's partitioning function")
+			}
+		case *plan.TableDef_DefType_Idx:
+			if d.Idx.Typ == plan.IndexDef_UNIQUE && !containsAll(d.Idx.ColNames, def.Cols) {
+				return errors.New(errno.InvalidTableDefinition, "A UNIQUE INDEX must include all columns in the table's partitioning function")
+			}
+		}
+	}
+	if len(getForeignKeyDefs(tableDef)) > 0 {
+		return errors.New(errno.FeatureNotSupported, "Foreign keys are not yet supported in conjunction with partitioning")
+	}
+
+	tableDef.Defs = append(tableDef.Defs, &plan.TableDef_DefType{
+		Def: &plan.TableDef_DefType_Partition{
+			Partition: def,
+		},
+	})
+	return nil
+}
+
+// buildPartitionExpr resolves the partitioning expression of RANGE, LIST and
+// HASH partitions, which is a column or a supported function of a column
+func buildPartitionExpr(def *plan.PartitionDef, expr tree.Expr, cols []*tree.UnresolvedName, tableDef *TableDef) error {
+	var name string
+	if cols != nil {
+		if len(cols) != 1 {
+			return errors.New(errno.FeatureNotSupported, "partitioning by multiple COLUMNS is not supported")
+		}
+		name = cols[0].Parts[0]
+	} else {
+		for {
+			paren, ok := expr.(*tree.ParenExpr)
+			if !ok {
+				break
+			}
+			expr = paren.Expr
+		}
+		switch e := expr.(type) {
+		case *tree.UnresolvedName:
+			name = e.Parts[0]
+		case *tree.FuncExpr:
+			def.Func = getPartitionFuncName(e)
+			if def.Func == "" || len(e.Exprs) != 1 {
+				return errors.New(errno.FeatureNotSupported, fmt.Sprintf("partitioning function '%s' is not supported", tree.String(e, dialect.MYSQL)))
+			}
+			col, ok := e.Exprs[0].(*tree.UnresolvedName)
+			if !ok {
+				return errors.New(errno.FeatureNotSupported, fmt.Sprintf("partitioning function '%s' is not supported", tree.String(e, dialect.MYSQL)))
+			}
+			name = col.Parts[0]
+		default:
+			return errors.New(errno.FeatureNotSupported, fmt.Sprintf("partitioning expression '%s' is not supported", tree.String(expr, dialect.MYSQL)))
+		}
+	}
+	if findColDef(tableDef, name) == nil {
+		return errors.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in 'partition function'", name))
+	}
+	def.Cols = []string{name}
+	return nil
+}
+
+// buildPartitionKey resolves the columns of KEY partitions, which are the
+// columns of the primary key if none is given
+func buildPartitionKey(def *plan.PartitionDef, cols []*tree.UnresolvedName, tableDef *TableDef) error {
+	if len(cols) == 0 {
+		for _, d := range tableDef.Defs {
+			if pk, ok := d.Def.(*plan.TableDef_DefType_Pk); ok {
+				def.Cols = pk.Pk.Names
+			}
+		}
+		if len(def.Cols) == 0 {
+			return errors.New(errno.InvalidTableDefinition, "Field in list of fields for partition function not found in table")
+		}
+		return nil
+	}
+	for _, col := range cols {
+		name := col.Parts[0]
+		if findColDef(tableDef, name) == nil {
+			return errors.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s' in 'partition function'", name))
+		}
+		for _, c := range def.Cols {
+			if c == name {
+				return errors.New(errno.InvalidTableDefinition, fmt.Sprintf("Duplicate partition field name '%s'", name))
+			}
+		}
+		def.Cols = append(def.Cols, name)
+	}
+	return nil
+}
+
+func getPartitionFuncName(e *tree.FuncExpr) string {
+	funcRef, ok := e.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok {
+		return ""
+	}
+	switch name := strings.ToLower(funcRef.Parts[0]); name {
+	case engine.PartitionFuncYear, engine.PartitionFuncMonth, engine.PartitionFuncToDays, engine.PartitionFuncToSeconds:
+		return name
+	}
+	return ""
+}
+
+// buildPartitions builds the partitions listed in the definition of def
+func buildPartitions(def *plan.PartitionDef, parts []*tree.Partition, tableDef *TableDef) ([]*plan.PartitionDef_Partition, error) {
+	result := make([]*plan.PartitionDef_Partition, len(parts))
+	for i, part := range parts {
+		if len(part.Subs) > 0 {
+			return nil, errors.New(errno.FeatureNotSupported, "subpartitions are not supported")
+		}
+		result[i] = &plan.PartitionDef_Partition{
+			Name: string(part.Name),
+		}
+		switch def.Type {
+		case plan.PartitionDef_RANGE:
+			values, ok := part.Values.(*tree.ValuesLessThan)
+			if !ok {
+				return nil, errors.New(errno.InvalidTableDefinition, "RANGE PARTITIONING requires definition of VALUES LESS THAN for each partition")
+			}
+			if len(values.ValueList) != 1 {
+				return nil, errors.New(errno.InvalidTableDefinition, "Inconsistency in usage of column lists for partitioning")
+			}
+			// the partition of MAXVALUE has no upper bound
+			if _, ok := values.ValueList[0].(*tree.MaxValue); ok {
+				continue
+			}
+			value, err := buildPartitionValue(def, values.ValueList[0], tableDef)
+			if err != nil {
+				return nil, err
+			}
+			result[i].Values = []string{value}
+		case plan.PartitionDef_LIST:
+			values, ok := part.Values.(*tree.ValuesIn)
+			if !ok {
+				return nil, errors.New(errno.InvalidTableDefinition, "LIST PARTITIONING requires definition of VALUES IN for each partition")
+			}
+			for _, exprs := range values.ValueList {
+				if len(exprs) != 1 {
+					return nil, errors.New(errno.InvalidTableDefinition, "Inconsistency in usage of column lists for partitioning")
+				}
+				value, err := buildPartitionValue(def, exprs[0], tableDef)
+				if err != nil {
+					return nil, err
+				}
+				result[i].Values = append(result[i].Values, value)
+			}
+		default:
+			if part.Values != nil {
+				return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("VALUES are not allowed in the partitions of %s partitioning", def.Type))
+			}
+		}
+	}
+	return result, nil
+}
+
+// buildPartitionValue folds a value of the partition definition into its
+// canonical text, a value of a partitioning function is either an integer
+// or the function applied to a constant
+func buildPartitionValue(def *plan.PartitionDef, expr tree.Expr, tableDef *TableDef) (string, error) {
+	col := findColDef(tableDef, def.Cols[0])
+	if e, ok := expr.(*tree.FuncExpr); ok && def.Func != "" {
+		fn := getPartitionFuncName(e)
+		if fn == "" || len(e.Exprs) != 1 {
+			return "", errors.New(errno.FeatureNotSupported, fmt.Sprintf("partition value '%s' is not supported", tree.String(e, dialect.MYSQL)))
+		}
+		v, err := buildConstant(col.Typ, e.Exprs[0])
+		if err != nil {
+			return "", err
+		}
+		result, ok := engine.ApplyPartitionFunc(fn, v)
+		if !ok {
+			return "", errors.New(errno.InvalidTableDefinition, fmt.Sprintf("invalid partition value '%s'", tree.String(e, dialect.MYSQL)))
+		}
+		return engine.FormatPartitionValue(result), nil
+	}
+
+	typ := col.Typ
+	oid, err := engine.PartitionValueType(def.Func, makeTypeByPlan2Type(col.Typ))
+	if err != nil {
+		return "", err
+	}
+	if oid == types.T_int64 {
+		typ = &plan.Type{Id: plan.Type_INT64}
+	}
+	v, err := buildConstant(typ, expr)
+	if err != nil {
+		return "", err
+	}
+	if v == nil {
+		return "", errors.New(errno.InvalidTableDefinition, "Not allowed to use NULL value in partition definitions")
+	}
+	return engine.FormatPartitionValue(v), nil
+}
+
+// checkPartitionDef checks the names and the values of the partitions
+func checkPartitionDef(def *plan.PartitionDef, tableDef *TableDef) error {
+	for i, part := range def.Partitions {
+		for _, prev := range def.Partitions[:i] {
+			if strings.EqualFold(prev.Name, part.Name) {
+				return errors.New(errno.DuplicateObject, fmt.Sprintf("Duplicate partition name %s", part.Name))
+			}
+		}
+	}
+	typs := make([]types.Type, len(def.Cols))
+	for i, name := range def.Cols {
+		typs[i] = makeTypeByPlan2Type(findColDef(tableDef, name).Typ)
+	}
+	_, err := engine.NewPartitioner(MakeEnginePartitionDef(def), typs)
+	return err
+}
+
+func containsAll(names, cols []string) bool {
+	for _, col := range cols {
+		found := false
+		for _, name := range names {
+			if name == col {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func getPartitionDef(tableDef *TableDef) *plan.PartitionDef {
+	for _, def := range tableDef.Defs {
+		if part, ok := def.Def.(*plan.TableDef_DefType_Partition); ok {
+			return part.Partition
+		}
+	}
+	return nil
+}
+
+// findPartition returns the position of the partition name, or -1
+func findPartition(def *plan.PartitionDef, name string) int {
+	for i, part := range def.Partitions {
+		if strings.EqualFold(part.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func MakeEnginePartitionDef(def *plan.PartitionDef) *engine.PartitionDef {
+	ret := &engine.PartitionDef{
+		Typ:        engine.PartitionType(def.Type),
+		Func:       def.Func,
+		Cols:       def.Cols,
+		Partitions: make([]engine.Partition, len(def.Partitions)),
+	}
+	for i, part := range def.Partitions {
+		ret.Partitions[i] = engine.Partition{
+			Name:   part.Name,
+			Values: part.Values,
+		}
+	}
+	return ret
+}
+
+func MakePlan2PartitionDef(def *engine.PartitionDef) *plan.PartitionDef {
+	ret := &plan.PartitionDef{
+		Type:       plan.PartitionDef_PartitionType(def.Typ),
+		Func:       def.Func,
+		Cols:       def.Cols,
+		Partitions: make([]*plan.PartitionDef_Partition, len(def.Partitions)),
+	}
+	for i, part := range def.Partitions {
+		ret.Partitions[i] = &plan.PartitionDef_Partition{
+			Name:   part.Name,
+			Values: part.Values,
+		}
+	}
+	return ret
+}
+
+// buildAddPartition checks the partitions added to def and returns the
+// action with the resulting partition definition
+func buildAddPartition(opt *tree.AlterOptionAddPartition, def *plan.PartitionDef, tableDef *TableDef) (*plan.AlterTable_Action, *plan.PartitionDef, error) {
+	if def.Type != plan.PartitionDef_RANGE && def.Type != plan.PartitionDef_LIST {
+		return nil, nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("unsupport to add partitions to %s partitioning", def.Type))
+	}
+	parts, err := buildPartitions(def, opt.Partitions, tableDef)
+	if err != nil {
+		return nil, nil, err
+	}
+	newDef := &plan.PartitionDef{
+		Type:       def.Type,
+		Func:       def.Func,
+		Cols:       def.Cols,
+		Partitions: append(append([]*plan.PartitionDef_Partition{}, def.Partitions...), parts...),
+	}
+	if err := checkPartitionDef(newDef, tableDef); err != nil {
+		return nil, nil, err
+	}
+	return &plan.AlterTable_Action{
+		Action: &plan.AlterTable_Action_AddPartition{
+			AddPartition: &plan.AlterTableAddPartition{
+				Partitions: parts,
+			},
+		},
+	}, newDef, nil
+}
+
+// buildDropPartition checks the partitions dropped from def and returns the
+// action with the resulting partition definition
+func buildDropPartition(opt *tree.AlterOptionDropPartition, def *plan.PartitionDef) (*plan.AlterTable_Action, *plan.PartitionDef, error) {
+	if def.Type != plan.PartitionDef_RANGE && def.Type != plan.PartitionDef_LIST {
+		return nil, nil, errors.New(errno.FeatureNotSupported, "DROP PARTITION can only be used on RANGE/LIST partitions")
+	}
+	dropped := make([]bool, len(def.Partitions))
+	drop := &plan.AlterTableDropPartition{}
+	for _, name := range opt.Names {
+		i := findPartition(def, string(name))
+		if i < 0 || dropped[i] {
+			return nil, nil, errors.New(errno.UndefinedObject, "Error in list of partitions to DROP")
+		}
+		dropped[i] = true
+		drop.Names = append(drop.Names, def.Partitions[i].Name)
+	}
+	newDef := &plan.PartitionDef{
+		Type: def.Type,
+		Func: def.Func,
+		Cols: def.Cols,
+	}
+	for i, part := range def.Partitions {
+		if !dropped[i] {
+			newDef.Partitions = append(newDef.Partitions, part)
+		}
+	}
+	if len(newDef.Partitions) == 0 {
+		return nil, nil, errors.New(errno.InvalidTableDefinition, "Cannot remove all partitions, use DROP TABLE instead")
+	}
+	return &plan.AlterTable_Action{
+		Action: &plan.AlterTable_Action_DropPartition{
+			DropPartition: drop,
+		},
+	}, newDef, nil
+}
+
+// buildTruncatePartition checks the partitions to truncate, all of them if
+// none is named
+func buildTruncatePartition(opt *tree.AlterOptionTruncatePartition, def *plan.PartitionDef, table string) (*plan.AlterTable_Action, error) {
+	truncate := &plan.AlterTableTruncatePartition{}
+	if len(opt.Names) == 0 {
+		for _, part := range def.Partitions {
+			truncate.Names = append(truncate.Names, part.Name)
+		}
+	}
+	for _, name := range opt.Names {
+		i := findPartition(def, string(name))
+		if i < 0 {
+			return nil, errors.New(errno.UndefinedObject, fmt.Sprintf("Unknown partition '%s' in table '%s'", name, table))
+		}
+		truncate.Names = append(truncate.Names, def.Partitions[i].Name)
+	}
+	return &plan.AlterTable_Action{
+		Action: &plan.AlterTable_Action_TruncatePartition{
+			TruncatePartition: truncate,
+		},
+	}, nil
+}
+
+// prunePartitions sets the partitions scanned by the TABLE_SCAN nodes of
+// partitioned tables, skipping the partitions that can't hold any row
+// passing the filters pushed down to the scan
+func (builder *QueryBuilder) prunePartitions(nodeID int32) {
+	node := builder.qry.Nodes[nodeID]
+	for _, childID := range node.Children {
+		builder.prunePartitions(childID)
+	}
+	if node.NodeType != plan.Node_TABLE_SCAN || node.TableDef == nil {
+		return
+	}
+	def := getPartitionDef(node.TableDef)
+	if def == nil {
+		return
+	}
+
+	selected := make([]bool, len(def.Partitions))
+	for i := range selected {
+		selected[i] = true
+	}
+	typs := make([]types.Type, len(def.Cols))
+	for i, name := range def.Cols {
+		if col := findColDef(node.TableDef, name); col != nil {
+			typs[i] = makeTypeByPlan2Type(col.Typ)
+		}
+	}
+	if p, err := engine.NewPartitioner(MakeEnginePartitionDef(def), typs); err == nil {
+		pruner := &partitionPruner{
+			p:        p,
+			tag:      node.BindingTags[0],
+			col:      def.Cols[0],
+			tableDef: node.TableDef,
+		}
+		for _, filter := range node.FilterList {
+			if parts := pruner.prune(filter); parts != nil {
+				for i := range selected {
+					selected[i] = selected[i] && parts[i]
+				}
+			}
+		}
+	}
+
+	node.Partitions = nil
+	for i, part := range def.Partitions {
+		if selected[i] {
+			node.Partitions = append(node.Partitions, part.Name)
+		}
+	}
+	// the scan reads a partition even if the filters reject every row
+	if len(node.Partitions) == 0 {
+		node.Partitions = append(node.Partitions, def.Partitions[0].Name)
+	}
+}
+
+type partitionPruner struct {
+	p        *engine.Partitioner
+	tag      int32
+	col      string
+	tableDef *TableDef
+}
+
+// prune returns the partitions which may hold the rows passing the filter,
+// or nil if it can't tell
+func (pp *partitionPruner) prune(expr *Expr) []bool {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok || len(f.F.Args) != 2 {
+		return nil
+	}
+	left, right := f.F.Args[0], f.F.Args[1]
+	switch op := f.F.Func.ObjName; op {
+	case "and":
+		l, r := pp.prune(left), pp.prune(right)
+		if l == nil {
+			return r
+		}
+		if r == nil {
+			return l
+		}
+		for i := range l {
+			l[i] = l[i] && r[i]
+		}
+		return l
+	case "or":
+		l, r := pp.prune(left), pp.prune(right)
+		if l == nil || r == nil {
+			return nil
+		}
+		for i := range l {
+			l[i] = l[i] || r[i]
+		}
+		return l
+	case "=", "<", "<=", ">", ">=":
+		if !pp.isPartitionCol(left) {
+			// turn "value op col" into "col op value"
+			left, right = right, left
+			switch op {
+			case "<":
+				op = ">"
+			case "<=":
+				op = ">="
+			case ">":
+				op = "<"
+			case ">=":
+				op = "<="
+			}
+		}
+		if !pp.isPartitionCol(left) || !isPartitionConstant(right) {
+			return nil
+		}
+		bat := batch.NewWithSize(0)
+		bat.Zs = []int64{1}
+		vec, err := colexec.EvalExpr(bat, nil, right)
+		if err != nil {
+			return nil
+		}
+		return pp.p.Prune(op, vector.GetValue(vec, 0))
+	}
+	return nil
+}
+
+// isPartitionCol returns true if expr is the partition column, or a cast
+// of it keeping the order of the values
+func (pp *partitionPruner) isPartitionCol(expr *Expr) bool {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		return e.Col.RelPos == pp.tag && int(e.Col.ColPos) < len(pp.tableDef.Cols) &&
+			pp.tableDef.Cols[e.Col.ColPos].Name == pp.col
+	case *plan.Expr_F:
+		if e.F.Func.ObjName != "cast" || len(e.F.Args) == 0 || !pp.isPartitionCol(e.F.Args[0]) {
+			return false
+		}
+		from, to := e.F.Args[0].Typ.Id, expr.Typ.Id
+		return (isIntegerTypeId(from) && isIntegerTypeId(to)) || (from == plan.Type_DATE && to == plan.Type_DATETIME)
+	}
+	return false
+}
+
+func isIntegerTypeId(id plan.Type_TypeId) bool {
+	switch id {
+	case plan.Type_INT8, plan.Type_INT16, plan.Type_INT32, plan.Type_INT64,
+		plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64:
+		return true
+	}
+	return false
+}
+
+// isPartitionConstant returns true if expr is a constant or casts of it
+func isPartitionConstant(expr *Expr) bool {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_C, *plan.Expr_T:
+		return true
+	case *plan.Expr_F:
+		if e.F.Func.ObjName != "cast" {
+			return false
+		}
+		for _, arg := range e.F.Args {
+			if !isPartitionConstant(arg) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -61,6 +61,28 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 	return returnByRewriteSQL(ctx, sql, plan.DataDefinition_SHOW_CREATETABLE)
 }
 
+func buildShowCreateView(stmt *tree.ShowCreateView, ctx CompilerContext) (*Plan, error) {
+	sql := "SELECT relname `View`, rel_createsql `Create View`, 'utf8mb4' `character_set_client`, 'utf8mb4_general_ci' `collation_connection` " +
+		"FROM %s.mo_tables WHERE reldatabase = '%s' AND relname = '%s'"
+	tblName := stmt.Name.Parts[0]
+	dbName := ctx.DefaultDatabase()
+	if stmt.Name.NumParts == 2 {
+		dbName = stmt.Name.Parts[1]
+	}
+
+	_, tableDef := ctx.Resolve(dbName, tblName)
+	if tableDef == nil {
+		return nil, errors.New(errno.UndefinedTable, fmt.Sprintf("view '%v' doesn't exist", tblName))
+	}
+	if tableDef.View == nil {
+		return nil, errors.New(errno.WrongObjectType, fmt.Sprintf("'%s.%s' is not VIEW", dbName, tblName))
+	}
+
+	sql = fmt.Sprintf(sql, MO_CATALOG_DB_NAME, dbName, tblName)
+
+	return returnByRewriteSQL(ctx, sql, plan.DataDefinition_SHOW_CREATEVIEW)
+}
+
 func buildShowDatabases(stmt *tree.ShowDatabases, ctx CompilerContext) (*Plan, error) {
 	if stmt.Like != nil && stmt.Where != nil {
 		return nil, errors.New(errno.SyntaxError, "like clause and where clause cannot exist at the same time")
@@ -18,11 +18,13 @@ import (
 	"bytes"
 	"encoding/json"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/rule"
 )
 
 //only use in developing
@@ -397,6 +399,8 @@ func TestSingleTableSQLBuilder(t *testing.T) {
 		"SELECT N_NAME, count(distinct N_REGIONKEY) FROM NATION group by N_NAME", //test distinct agg function
 		"SELECT N_NAME, MAX(N_REGIONKEY) FROM NATION GROUP BY N_NAME HAVING MAX(N_REGIONKEY) > 10", //test agg
 		"SELECT DISTINCT N_NAME FROM NATION", //test distinct
+		"SELECT N_NAME FROM NATION WHERE N_NAME REGEXP '^A' OR N_COMMENT NOT RLIKE 'x$'",
+		"SELECT regexp_like(N_NAME, 'a', 'i'), regexp_instr(N_NAME, 'A', N_NATIONKEY + 1), regexp_substr(N_NAME, '[A-Z]+', 1, 2), regexp_replace(N_NAME, 'A(.)', '$1', 1, 0, 'c') FROM NATION",
 		"select sum(n_nationkey) as s from nation order by s",
 		"select date_add(date '2001-01-01', interval 1 day) as a",
 		"select date_sub(date '2001-01-01', interval '1' day) as a",
@@ -404,6 +408,23 @@ func TestSingleTableSQLBuilder(t *testing.T) {
 		"select n_name, count(*) from nation group by n_name order by 2 asc",
 		"select count(distinct 12)",
 		"select nullif(n_name, n_comment), ifnull(n_comment, n_name) from nation",
+		"select a, length(b), max(c), min(d), count(e) from t_lob where b like 'a%' and d = 'x' and e <> c group by a, b order by b",
+		"select concat(b, e), ltrim(b), b || c from t_lob where a in (select n_nationkey from nation where n_name = b) and d is null",
+		"select cast(n_name as binary(4)), cast(n_name as binary), cast(n_nationkey as char) from nation",
+		"select j->'$.a', j->>'$.b[0]', json_extract(j, '$.a', '$.c'), json_keys(j), json_length(j, '$.b') from t_json where json_contains(j, '1', '$.a')",
+		"select json_object('a', a, 'b', j, 'c', null), json_array(a, 1.5, n_name, null), json_unquote(n_comment) from t_json, nation where cast(j as varchar) = n_name",
+		"select cast(n_comment as json), json_extract(n_comment, '$[0]'), json_unquote(cast(n_name as json)) from nation",
+		"select a, e, s, b from t_enum where e = 'small' and find_in_set('red', s) > 0 order by e",
+		"select e, count(*), max(b) from t_enum group by e having e <> 'large'",
+		"select distinct s from t_enum union select n_name from nation",
+		"select cast(e as char), cast(b as varchar), b + 1, b * 3 from t_enum where b > 2",
+		"select id, uuid(), uuid_to_bin(id), uuid_to_bin(id, 1), bin_to_uuid(b, 1), cast(id as varchar) from t_uuid where id = '6ccd780c-baba-1026-9564-5b8c656024db'",
+		"select id, count(*) from t_uuid where id > bin_to_uuid(b) group by id order by id",
+		"select uuid_to_bin(n_comment), bin_to_uuid(uuid_to_bin(uuid())) from nation",
+		"select id, match(title) against('database') as score from articles where match(title) against('database') order by score desc",
+		"select id, match(title, body) against('+mysql -yoursql' in boolean mode) from articles where match(body, title) against('+mysql -yoursql' in boolean mode) > 0.5",
+		"select a.id, match(a.title) against('tutorial' in natural language mode) from articles a join articles b on a.id = b.id where match(b.title, b.body) against('security')",
+		"select count(*) from articles where match(title) against('mysql') and id > 10",
 
 		"select 18446744073709551500",
 		"select 0xffffffffffffffff",
@@ -460,6 +481,15 @@ func TestSingleTableSQLBuilder(t *testing.T) {
 
 		"SELECT DISTINCT N_NAME FROM NATION GROUP BY N_REGIONKEY", //test distinct with group by
 		"SELECT DISTINCT N_NAME FROM NATION ORDER BY N_REGIONKEY", //test distinct with order by
+		"SELECT regexp_like(N_NAME) FROM NATION",                  //wrong arguments
+		"SELECT regexp_replace(N_NAME, 'a') FROM NATION",          //wrong arguments
+		"select id from articles where match(body) against('mysql')",
+		"select id from articles where match(id) against('mysql')",
+		"select id from articles where match(title) against('mysql' with query expansion)",
+		"select id from articles where match(title) against(body)",
+		"select id from articles where match(title) against(1)",
+		"select a.id from articles a, articles b where match(a.title, b.body) against('mysql')",
+		"select id from (select * from articles) t where match(title) against('mysql')",
 		//"select 18446744073709551500",                             //over int64
 		//"select 0xffffffffffffffff",                               //over int64
 
@@ -525,6 +555,62 @@ func TestDerivedTableSqlBuilder(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestLateralSqlBuilder(t *testing.T) {
+	mock := NewMockOptimizer()
+	// should pass
+	sqls := []string{
+		"select * from nation n, lateral (select r_name from region where r_regionkey = n.n_regionkey) x",
+		"select * from nation n join lateral (select r_name from region where r_regionkey = n.n_regionkey) x on x.r_name > n.n_name",
+		"select * from nation n left join lateral (select r_name from region where r_regionkey = n.n_regionkey) x on true",
+		"select * from nation n, lateral (select max(r_name) m from region where r_regionkey < n.n_regionkey) x",
+		"select * from nation n, lateral (select r_regionkey, count(*) c from region where r_regionkey = n.n_regionkey group by r_regionkey) x",
+		"select * from nation n, region r, lateral (select c_name from customer where c_nationkey = n.n_nationkey and c_custkey > r.r_regionkey) x",
+		"select * from nation n, lateral (select r_name from region) x",
+		"select * from nation where exists (select * from region r, lateral (select c_name from customer where c_custkey = r.r_regionkey and c_nationkey = n_nationkey) x)",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// the correlated predicates become the ON condition of the join
+	expected := map[string]plan.Node_JoinFlag{
+		"select * from nation n, lateral (select r_name from region where r_regionkey = n.n_regionkey) x":                                          plan.Node_INNER,
+		"select * from nation n left join lateral (select r_name from region where r_regionkey = n.n_regionkey) x on true":                         plan.Node_LEFT,
+		"select * from nation n, lateral (select max(r_name) m from region where r_regionkey = n.n_regionkey) x":                                   plan.Node_LEFT,
+		"select * from nation n, lateral (select r_regionkey, max(r_name) m from region where r_regionkey = n.n_regionkey group by r_regionkey) x": plan.Node_INNER,
+	}
+	for sql, joinType := range expected {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		qry := logicPlan.GetQuery()
+		root := qry.Steps[len(qry.Steps)-1]
+		if hasCorrColInTree(qry.Nodes, root) {
+			t.Fatalf("correlated column left in the plan, sql=%v", sql)
+		}
+		var join *plan.Node
+		for _, node := range qry.Nodes {
+			if node.NodeType == plan.Node_JOIN {
+				join = node
+			}
+		}
+		if join == nil || join.JoinType != joinType || len(join.OnList) == 0 {
+			t.Fatalf("expect a %s join on the correlated predicates, sql=%v", joinType.String(), sql)
+		}
+	}
+
+	// should error
+	sqls = []string{
+		"select * from nation n, (select r_name from region where r_regionkey = n.n_regionkey) x",                                         // not lateral
+		"select * from lateral (select r_name from region where r_regionkey = n.n_regionkey) x, nation n",                                 // table after it
+		"select * from nation n, lateral (select n.n_name, r_name from region where r_regionkey = n.n_regionkey) x",                       // correlated projection
+		"select * from nation n, lateral (select count(*) c from region where r_regionkey = n.n_regionkey) x",                             // count without group by
+		"select * from nation n, lateral (select max(r_name) m from region where r_regionkey = n.n_regionkey having max(r_name) > 'A') x", // having without group by
+		"select * from nation n right join lateral (select r_name from region where r_regionkey = n.n_regionkey) x on true",               // right join
+		"select * from nation n, lateral (select r_name from region where r_regionkey = n.n_regionkey limit 1) x",                         // limit
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
 //test CTE plan building
 func TestCTESqlBuilder(t *testing.T) {
 	mock := NewMockOptimizer()
@@ -555,6 +641,172 @@ func TestCTESqlBuilder(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestViewSqlBuilder(t *testing.T) {
+	mock := NewMockOptimizer()
+
+	// should pass
+	sqls := []string{
+		"select * from v_nation",
+		"select k, n_name from tpch.v_nation where k > 1",
+		"select a.k, r_name from v_nation a join region on a.k = r_regionkey",
+		"with v_nation as (select 1 as x) select x from v_nation",
+		"select count(*) from v_nation group by n_name",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"select n_nationkey from v_nation", //column is renamed by the view
+		"select * from v_loop",             //view references itself
+		"insert into v_nation values (1, 'a')",
+		"update v_nation set n_name = 'a'",
+		"delete from v_nation where k = 1",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestRecursiveCTESqlBuilder(t *testing.T) {
+	mock := NewMockOptimizer()
+
+	// should pass
+	sqls := []string{
+		"WITH RECURSIVE qn(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM qn WHERE n < 10) SELECT * FROM qn",
+		"WITH RECURSIVE qn(n) AS (SELECT 1 UNION SELECT n + 1 FROM qn WHERE n < 10) SELECT SUM(n) FROM qn WHERE n > 2",
+		"WITH RECURSIVE qn AS (SELECT N_NATIONKEY, N_REGIONKEY FROM NATION WHERE N_NATIONKEY = 0 UNION ALL SELECT NATION.N_NATIONKEY, NATION.N_REGIONKEY FROM NATION JOIN qn ON NATION.N_REGIONKEY = qn.N_NATIONKEY) SELECT * FROM qn",
+		"WITH RECURSIVE qn(a, b) AS (SELECT 1, N_NAME FROM NATION UNION ALL SELECT a + 1, b FROM qn WHERE a < 3) SELECT qn.b, COUNT(*) FROM qn JOIN REGION ON qn.a = R_REGIONKEY GROUP BY qn.b",
+		"WITH RECURSIVE qn(n) AS (SELECT 1 UNION ALL SELECT n + 1.5 FROM qn WHERE n < 10) SELECT * FROM qn", // cast to the type of the non-recursive part
+		"WITH RECURSIVE qn AS (SELECT * FROM NATION) SELECT * FROM qn",                                      // not recursive
+		"WITH RECURSIVE c AS (SELECT 1 UNION ALL SELECT 2) SELECT * FROM c",                                 // union not referencing the CTE
+		"WITH RECURSIVE c(n) AS (SELECT N_NATIONKEY FROM NATION UNION SELECT 2 ORDER BY 1 LIMIT 3) SELECT n FROM c",
+		// more than one non-recursive or recursive query blocks
+		"WITH RECURSIVE qn(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM qn WHERE n < 5 UNION ALL SELECT n + 10 FROM qn WHERE n < 3) SELECT * FROM qn",
+		"WITH RECURSIVE qn(n) AS (SELECT 1 UNION ALL SELECT 2 UNION SELECT n + 2 FROM qn WHERE n < 10) SELECT * FROM qn",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"WITH RECURSIVE qn(n) AS (SELECT 1 UNION ALL SELECT n + 1, n FROM qn) SELECT * FROM qn",                 // different number of columns
+		"WITH RECURSIVE qn(n) AS (SELECT 1 UNION ALL SELECT SUM(n) FROM qn) SELECT * FROM qn",                   // aggregation in recursive block
+		"WITH RECURSIVE qn(n) AS (SELECT 1 UNION ALL SELECT a.n FROM qn a, qn b) SELECT * FROM qn",              // referenced twice
+		"WITH RECURSIVE qn(n) AS (SELECT n FROM qn UNION ALL SELECT 1) SELECT * FROM qn",                        // referenced in non-recursive block
+		"WITH RECURSIVE qn(n, m) AS (SELECT 1 UNION ALL SELECT n + 1 FROM qn) SELECT * FROM qn",                 // too many column names
+		"WITH RECURSIVE qn(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM qn ORDER BY 1 LIMIT 2) SELECT * FROM qn", // order by and limit
+		// a non-recursive query block after a recursive one
+		"WITH RECURSIVE qn(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM qn WHERE n < 5 UNION ALL SELECT 3) SELECT * FROM qn",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	// a union not referencing the CTE is a plain set operation
+	logicPlan, err := runOneStmt(mock, t, "WITH RECURSIVE c AS (SELECT 1 UNION ALL SELECT 2) SELECT * FROM c")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	qry := logicPlan.GetQuery()
+	unions := 0
+	var visit func(nodeID int32)
+	visit = func(nodeID int32) {
+		node := qry.Nodes[nodeID]
+		if node.NodeType == plan.Node_RECURSIVE_CTE {
+			t.Fatalf("the CTE should not be recursive")
+		}
+		if node.NodeType == plan.Node_UNION_ALL {
+			unions++
+		}
+		for _, childID := range node.Children {
+			visit(childID)
+		}
+	}
+	visit(qry.Steps[0])
+	if unions != 1 {
+		t.Fatalf("the plan should have 1 UNION ALL but has %d", unions)
+	}
+}
+
+func TestUnionSqlBuilder(t *testing.T) {
+	mock := NewMockOptimizer()
+
+	// should pass
+	sqls := []string{
+		"SELECT N_NAME FROM NATION UNION SELECT R_NAME FROM REGION",
+		"SELECT N_NAME FROM NATION UNION ALL SELECT R_NAME FROM REGION",
+		"SELECT N_NATIONKEY, N_NAME FROM NATION INTERSECT SELECT R_REGIONKEY, R_NAME FROM REGION",
+		"SELECT N_NATIONKEY FROM NATION INTERSECT ALL SELECT R_REGIONKEY FROM REGION",
+		"SELECT N_REGIONKEY FROM NATION EXCEPT SELECT R_REGIONKEY FROM REGION WHERE R_REGIONKEY > 2",
+		"SELECT N_REGIONKEY FROM NATION EXCEPT ALL SELECT R_REGIONKEY FROM REGION",
+		"SELECT N_NATIONKEY FROM NATION UNION SELECT R_REGIONKEY FROM REGION INTERSECT SELECT 1 EXCEPT SELECT 2",
+		"SELECT N_NATIONKEY AS a FROM NATION UNION SELECT R_REGIONKEY FROM REGION ORDER BY a DESC LIMIT 3",
+		"(SELECT N_NATIONKEY FROM NATION ORDER BY N_NATIONKEY LIMIT 2) UNION ALL (SELECT R_REGIONKEY FROM REGION LIMIT 1) ORDER BY 1",
+		"SELECT N_NATIONKEY FROM NATION UNION SELECT 1.5",                // cast to the same type
+		"SELECT N_NATIONKEY FROM NATION UNION SELECT N_NAME FROM NATION", // cast to string
+		"SELECT N_NATIONKEY, NULL FROM NATION UNION SELECT NULL, N_NAME FROM NATION",
+		"SELECT COUNT(*) FROM (SELECT N_NAME FROM NATION UNION SELECT R_NAME FROM REGION) AS t",
+		"SELECT N_NAME FROM NATION WHERE N_REGIONKEY IN (SELECT R_REGIONKEY FROM REGION UNION SELECT 1)",
+		"WITH qn AS (SELECT N_NATIONKEY FROM NATION UNION SELECT R_REGIONKEY FROM REGION) SELECT * FROM qn",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"SELECT N_NATIONKEY FROM NATION UNION SELECT R_REGIONKEY, R_NAME FROM REGION",         // different number of columns
+		"SELECT N_NATIONKEY FROM NATION UNION SELECT R_REGIONKEY FROM REGION ORDER BY N_NAME", // column not in the result
+	}
+	runTestShouldError(mock, t, sqls)
+
+	// decimals keep the larger scale and the more integer digits
+	cases := []struct {
+		sql          string
+		id           plan.Type_TypeId
+		width, scale int32
+	}{
+		{"SELECT CAST(1 AS DECIMAL(10, 2)) UNION SELECT CAST(1 AS DECIMAL(10, 4))", plan.Type_DECIMAL64, 12, 4},
+		{"SELECT CAST(1 AS DECIMAL(6, 4)) UNION ALL SELECT CAST(1 AS DECIMAL(12, 1))", plan.Type_DECIMAL64, 15, 4},
+		{"SELECT CAST(1 AS DECIMAL(18, 2)) EXCEPT SELECT CAST(1 AS DECIMAL(18, 10))", plan.Type_DECIMAL128, 26, 10},
+	}
+	for _, c := range cases {
+		logicPlan, err := runOneStmt(mock, t, c.sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, c.sql)
+		}
+		qry := logicPlan.GetQuery()
+		typ := qry.Nodes[qry.Steps[0]].ProjectList[0].Typ
+		if typ.Id != c.id || typ.Width != c.width || typ.Scale != c.scale {
+			t.Fatalf("sql=%v, type should be %v(%d, %d) but now is %v(%d, %d)", c.sql, c.id, c.width, c.scale, typ.Id, typ.Width, typ.Scale)
+		}
+	}
+}
+
+func TestWindowSqlBuilder(t *testing.T) {
+	mock := NewMockOptimizer()
+
+	// should pass
+	sqls := []string{
+		"SELECT N_NAME, ROW_NUMBER() OVER (PARTITION BY N_REGIONKEY ORDER BY N_NATIONKEY) FROM NATION",
+		"SELECT N_NAME, RANK() OVER (ORDER BY N_REGIONKEY), DENSE_RANK() OVER (ORDER BY N_REGIONKEY) FROM NATION",
+		"SELECT N_NAME, LAG(N_NAME) OVER (ORDER BY N_NATIONKEY), LEAD(N_NAME, 2, 'none') OVER (ORDER BY N_NATIONKEY) FROM NATION",
+		"SELECT N_NAME, FIRST_VALUE(N_NAME) OVER (PARTITION BY N_REGIONKEY ORDER BY N_NATIONKEY), LAST_VALUE(N_NAME) OVER (PARTITION BY N_REGIONKEY ORDER BY N_NATIONKEY ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING) FROM NATION",
+		"SELECT N_NATIONKEY, SUM(N_NATIONKEY) OVER (ORDER BY N_NATIONKEY ROWS BETWEEN 1 PRECEDING AND 1 FOLLOWING) FROM NATION",
+		"SELECT N_NATIONKEY, AVG(N_NATIONKEY) OVER (ORDER BY N_NATIONKEY RANGE BETWEEN 2 PRECEDING AND CURRENT ROW) FROM NATION",
+		"SELECT N_NATIONKEY, COUNT(*) OVER () FROM NATION WHERE N_REGIONKEY > 1",
+		"SELECT N_REGIONKEY, SUM(N_NATIONKEY), RANK() OVER (ORDER BY SUM(N_NATIONKEY) DESC) FROM NATION GROUP BY N_REGIONKEY",
+		"SELECT N_NAME, ROW_NUMBER() OVER (ORDER BY N_NATIONKEY) AS RN FROM NATION ORDER BY RN DESC LIMIT 3",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"SELECT ROW_NUMBER() FROM NATION",                                                     // window function without OVER
+		"SELECT ROW_NUMBER(N_NAME) OVER () FROM NATION",                                       // wrong arguments
+		"SELECT ABS(N_NATIONKEY) OVER () FROM NATION",                                         // not a window function
+		"SELECT N_NAME FROM NATION WHERE ROW_NUMBER() OVER () > 1",                            // window function in where
+		"SELECT SUM(ROW_NUMBER() OVER ()) OVER () FROM NATION",                                // nested window function
+		"SELECT SUM(N_NATIONKEY) OVER (ORDER BY N_NAME RANGE 1 PRECEDING) FROM NATION",        // range offset on a string
+		"SELECT SUM(N_NATIONKEY) OVER (ROWS BETWEEN CURRENT ROW AND 1 PRECEDING) FROM NATION", // wrong frame
+		"SELECT LAG(N_NAME, -1) OVER (ORDER BY N_NATIONKEY) FROM NATION",                      // negative offset
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
 func TestInsert(t *testing.T) {
 	mock := NewMockOptimizer()
 	// should pass
@@ -562,6 +814,8 @@ func TestInsert(t *testing.T) {
 		//"INSERT NATION VALUES (1, 'NAME1',21, 'COMMENT1'), (2, 'NAME2', 22, 'COMMENT2')",
 		//"INSERT NATION (N_NATIONKEY, N_REGIONKEY, N_NAME) VALUES (1, 21, 'NAME1'), (2, 22, 'NAME2')",
 		"INSERT INTO NATION SELECT * FROM NATION2",
+		"INSERT INTO t_chk SELECT n_nationkey, n_regionkey, n_name FROM NATION",
+		"INSERT INTO t_chk (a, c) SELECT n_nationkey, n_name FROM NATION",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -588,6 +842,8 @@ func TestUpdate(t *testing.T) {
 		//"UPDATE NATION SET N_NAME ='U1', N_REGIONKEY=2",
 		//"UPDATE NATION SET N_NAME ='U1', N_REGIONKEY=2 WHERE N_NATIONKEY > 10 LIMIT 20",
 		//"UPDATE NATION SET N_NAME ='U1', N_REGIONKEY=N_REGIONKEY+2 WHERE N_NATIONKEY > 10 LIMIT 20",
+		"UPDATE t_chk SET a = a + 1 WHERE c = 'y'",
+		"UPDATE t_chk SET c = 'z'",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -644,6 +900,16 @@ func TestSubQuery(t *testing.T) {
 			where
 				l_partkey = p_partkey
 		);`, //tpch q17
+		"SELECT * FROM NATION where N_REGIONKEY > (select R_REGIONKEY from REGION where R_REGIONKEY = N_REGIONKEY)",                                                  // scalar without aggregation
+		"SELECT N_NAME, (select count(*) from REGION where R_REGIONKEY = N_REGIONKEY) FROM NATION",                                                                   // count in select list
+		"SELECT N_NAME, exists (select 1 from REGION where R_REGIONKEY = N_REGIONKEY) FROM NATION",                                                                   // exists in select list
+		"SELECT N_NAME, N_REGIONKEY in (select R_REGIONKEY from REGION) FROM NATION",                                                                                 // in in select list
+		"SELECT * FROM NATION where N_NATIONKEY > 10 or exists (select 1 from REGION where R_REGIONKEY = N_REGIONKEY)",                                               // exists not in root
+		"SELECT * FROM NATION where N_NATIONKEY > 10 or N_REGIONKEY not in (select R_REGIONKEY from REGION where R_NAME > 'A')",                                      // not in not in root
+		"SELECT * FROM NATION where N_REGIONKEY > all (select R_REGIONKEY from REGION where R_NAME = N_NAME) or N_NATIONKEY = 1",                                     // all not in root
+		"SELECT * FROM NATION where exists (select 1 from REGION where exists (select 1 from CUSTOMER where C_NATIONKEY = N_NATIONKEY and C_CUSTKEY = R_REGIONKEY))", // correlated two levels up
+		"SELECT * FROM NATION where exists (select * from (select R_NAME from REGION where R_REGIONKEY = N_REGIONKEY) a where a.R_NAME > 'A')",                       // correlated derived table
+		"SELECT * FROM NATION where exists (select 1 from REGION where R_REGIONKEY = N_REGIONKEY limit 1)",                                                           // limit of exists
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -651,6 +917,57 @@ func TestSubQuery(t *testing.T) {
 	sqls = []string{
 		"SELECT * FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION222)",                                 // table not exist
 		"SELECT * FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION where R_REGIONKEY < N_REGIONKEY222)", // column not exist
+		"SELECT * FROM NATION where N_REGIONKEY > (select R_REGIONKEY from REGION where R_REGIONKEY < N_REGIONKEY limit 1)", // correlated limit
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestGroupingSets(t *testing.T) {
+	mock := NewMockOptimizer()
+	// should pass
+	sqls := []string{
+		"SELECT N_REGIONKEY, N_NAME, count(*) FROM NATION group by N_REGIONKEY, N_NAME with rollup",
+		"SELECT N_REGIONKEY, N_NAME, count(*), grouping(N_REGIONKEY, N_NAME) FROM NATION group by rollup(N_REGIONKEY, N_NAME)",
+		"SELECT N_REGIONKEY, N_NAME, count(*) FROM NATION group by cube(N_REGIONKEY, N_NAME) having grouping(N_NAME) = 0 and N_REGIONKEY > 1",
+		"SELECT N_REGIONKEY, N_NAME, count(*) FROM NATION group by grouping sets((N_REGIONKEY, N_NAME), (N_REGIONKEY), ())",
+		"SELECT N_REGIONKEY, N_NAME, count(*) FROM NATION group by N_REGIONKEY, rollup(N_NAME, N_COMMENT) order by grouping(N_NAME)",
+		"SELECT R_NAME FROM REGION where exists (select count(*) from NATION where N_REGIONKEY = R_REGIONKEY group by rollup(N_NAME) having count(*) > 1)",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"SELECT N_REGIONKEY, grouping(N_REGIONKEY) FROM NATION group by N_REGIONKEY",                   // no grouping sets
+		"SELECT N_REGIONKEY, grouping(N_NAME) FROM NATION group by rollup(N_REGIONKEY)",                // not a group key
+		"SELECT N_REGIONKEY FROM NATION where grouping(N_REGIONKEY) = 0 group by rollup(N_REGIONKEY)",  // in WHERE
+		"SELECT N_REGIONKEY, count(*) FROM NATION group by rollup(N_REGIONKEY), grouping(N_REGIONKEY)", // in GROUP BY
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestAggregates(t *testing.T) {
+	mock := NewMockOptimizer()
+	// should pass
+	sqls := []string{
+		"SELECT N_REGIONKEY, group_concat(N_NAME) FROM NATION group by N_REGIONKEY",
+		"SELECT N_REGIONKEY, group_concat(distinct N_NAME, '-', N_NATIONKEY order by N_NATIONKEY desc, N_NAME separator ';') FROM NATION group by N_REGIONKEY",
+		"SELECT group_concat(N_NATIONKEY separator '') FROM NATION having group_concat(N_NATIONKEY separator '') is not null",
+		"SELECT group_concat(null), group_concat(N_NAME, null order by null) FROM NATION",
+		"SELECT var_samp(N_NATIONKEY), stddev_samp(N_NATIONKEY), var_pop(N_NATIONKEY), std(N_NATIONKEY) FROM NATION",
+		"SELECT L_SHIPDATE, median(L_EXTENDEDPRICE), percentile_cont(L_QUANTITY, 0.9), percentile_disc(L_SHIPDATE, 1) FROM LINEITEM group by L_SHIPDATE",
+		"SELECT count(distinct N_REGIONKEY, N_NAME), count(distinct N_REGIONKEY) FROM NATION",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"SELECT count(N_REGIONKEY, N_NAME) FROM NATION",                         // several args without distinct
+		"SELECT percentile_cont(N_NATIONKEY, 1.5) FROM NATION",                  // fraction out of range
+		"SELECT percentile_cont(N_NATIONKEY, N_REGIONKEY) FROM NATION",          // fraction not a constant
+		"SELECT percentile_disc(N_NAME, 0.5) FROM NATION",                       // not a number
+		"SELECT median(N_NATIONKEY, 1) FROM NATION",                             // wrong arguments
+		"SELECT group_concat(N_NAME) OVER (ORDER BY N_NATIONKEY) FROM NATION",   // window function
+		"SELECT group_concat(N_NAME order by group_concat(N_NAME)) FROM NATION", // nested aggregate
 	}
 	runTestShouldError(mock, t, sqls)
 }
@@ -692,9 +1009,133 @@ func TestDdl(t *testing.T) {
 		"drop table tpch.nation",
 		"drop table if exists tpch.tbl_not_exist",
 		"drop table if exists db_not_exist.tbl",
+		"alter table nation add column n_extra int default 1",
+		"alter table tpch.nation drop column n_comment, rename column n_name to n_title",
+		"alter table nation add n_extra varchar(20), drop n_extra",
+		"alter table nation rename to nation2",
+		"create view v1 as select n_name from nation",
+		"create view if not exists v1 (a, b) as select n_nationkey, n_name from nation",
+		"create or replace view v_nation as select * from region",
+		"drop view v_nation",
+		"drop view if exists tpch.v1",
+		"create table t_auto (a bigint unsigned auto_increment primary key, b int) auto_increment = 100",
+		"create table t_auto (a int, b tinyint auto_increment)",
+		"create table t_uk (a int primary key, b int unique key, c int, d varchar(20), unique key uk_cd (c, d))",
+		"create table t_uk (a int unique, b int, unique key (a, b))",
+		"create table t_chk (a int not null, b int, constraint c1 check (a > b), check (b <> 0) not enforced, c varchar(10) check (c <> 'x'))",
+		"alter table t_chk add column d int not null default 0",
+		"create table t_fk (a int, b int references nation(n_nationkey) on delete cascade, constraint fk1 foreign key (a) references nation(n_nationkey) on update set null)",
+		"create table t_fk (id int primary key, pid int, foreign key (pid) references t_fk(id) on delete set null on update cascade)",
+		"create table t_fk (a char(10), b varchar(20), unique key (a), foreign key (b) references t_fk(a) on delete no action)",
+		"alter table t_fk add column c int",
+		"create table t_part (a int, d date) partition by range (year(d)) (partition p0 values less than (2020), partition p1 values less than maxvalue)",
+		"create table t_part (a int, d datetime) partition by range (to_days(d)) (partition p0 values less than (to_days('2020-01-01')), partition p1 values less than (to_days('2021-01-01')))",
+		"create table t_part (a int, d date) partition by range columns (d) (partition p0 values less than ('2020-01-01'), partition p1 values less than (maxvalue))",
+		"create table t_part (a int primary key, b int) partition by list (a) (partition p0 values in (1, 3), partition p1 values in (2, 4))",
+		"create table t_part (a int, b int) partition by hash (a) partitions 4",
+		"create table t_part (a int primary key, b int) partition by key () partitions 2",
+		"alter table t_part add partition (partition p3 values less than (2023))",
+		"alter table t_part drop partition p0, p1",
+		"alter table t_part truncate partition p0",
+		"alter table t_part truncate partition all",
+		"alter table t_part rename column a to b",
+		"create table t_lob (a int, b text, c tinytext, d mediumblob, e longblob, f binary(4), g varbinary(10), h blob default null)",
+		"create table t_lob (a binary(4) primary key, b varbinary(8) unique key, c binary)",
+		"insert into t_lob select n_nationkey, n_name, n_comment, n_name, n_comment from nation",
+		"create table t_json (a int, j json default null)",
+		"insert into t_json select n_nationkey, n_comment from nation",
+		"create table t_enum (a int, e enum('x', 'y ') default 'Y', s set('a', 'b') default 'b,a', b bit(4) default b'101', c bit)",
+		"insert into t_enum select a, e, s, b from t_enum",
+		"insert into t_enum select n_nationkey, 'small', 'red,blue', n_regionkey from nation",
+		"create table t_uuid (id uuid primary key, b varbinary(16))",
+		"insert into t_uuid select uuid(), uuid_to_bin(uuid()) from nation",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
+	// should error
+	sqls = []string{
+		"alter table tbl_not_exist add column a int",
+		"alter table nation add column n_name int",
+		"alter table nation add column a int primary key",
+		"alter table nation drop column col_not_exist",
+		"alter table nation rename column n_name to n_comment",
+		"alter table nation drop column n_comment, drop column n_comment",
+		"alter table nation rename to db_name.nation2",
+		"create view v1 (a) as select n_nationkey, n_name from nation",
+		"create view v1 as select n_name, n_name from nation",
+		"create view v1 as select * from tbl_not_exist",
+		"create or replace view nation as select 1",
+		"drop view nation",
+		"drop table v_nation",
+		"alter table v_nation add column a int",
+		"create table t_auto (a varchar(20) auto_increment)",
+		"create table t_auto (a int auto_increment, b int auto_increment)",
+		"create table t_auto (a int auto_increment default 1)",
+		"alter table nation add column a int auto_increment",
+		"create table t_uk (a int, b int, unique key uk (a), unique key uk (b))",
+		"create table t_uk (a int, unique key (b))",
+		"create table t_uk (a int, unique key (a, a))",
+		"alter table nation add column a int unique",
+		"insert into nation select * from nation on duplicate key update n_name = 'a'",
+		"create table t_chk (a int, constraint c1 check (a > 0), constraint c1 check (a < 10))",
+		"create table t_chk (a int, check (b > 0))",
+		"create table t_chk (a int auto_increment, check (a > 0))",
+		"create table t_chk (a int, check (a > (select 1)))",
+		"create table t_chk (a int, check (sum(a) > 0))",
+		"alter table nation add column a int not null",
+		"alter table nation add column a int check (a > 0)",
+		"alter table t_chk drop column b",
+		"alter table t_chk rename column c to d",
+		"create table t_fk (a int, foreign key (a) references tbl_not_exist(a))",
+		"create table t_fk (a int, foreign key (a) references v_nation(k))",
+		"create table t_fk (a int, foreign key (a) references nation(n_regionkey))",
+		"create table t_fk (a int, foreign key (a) references nation(n_nationkey, n_name))",
+		"create table t_fk (a bigint, foreign key (a) references nation(n_nationkey))",
+		"create table t_fk (a int, foreign key (b) references nation(n_nationkey))",
+		"create table t_fk (a int not null, foreign key (a) references nation(n_nationkey) on delete set null)",
+		"create table t_fk (a int, foreign key (a) references nation(n_nationkey) on update set default)",
+		"create table t_fk (a int, b int, constraint fk foreign key (a) references nation(n_nationkey), constraint fk foreign key (b) references nation(n_nationkey))",
+		"create table t_fk (a int, foreign key (a) references tpch2.nation(n_nationkey))",
+		"alter table nation add column a int references region(r_regionkey)",
+		"alter table t_fk drop column b",
+		"alter table t_fk rename column b to c",
+		"create table t_part (a int, d date) partition by range (d) (partition p0 values less than ('2020-01-01'))",
+		"create table t_part (a int, d date) partition by range (year(d)) (partition p0 values less than (2021), partition p1 values less than (2020))",
+		"create table t_part (a int, d date) partition by range (year(d)) (partition p0 values less than maxvalue, partition p1 values less than (2020))",
+		"create table t_part (a int, d date) partition by range (year(d)) (partition p0 values less than (2020), partition p0 values less than (2021))",
+		"create table t_part (a int, d date) partition by range (dayofweek(d)) (partition p0 values less than (3))",
+		"create table t_part (a int, d date) partition by range (year(d))",
+		"create table t_part (a int, b int) partition by list (a) (partition p0 values in (1, 2), partition p1 values in (2))",
+		"create table t_part (a int primary key, b int) partition by hash (b) partitions 2",
+		"create table t_part (a int, b int) partition by key () partitions 2",
+		"create table t_lob (a text default 'x')",
+		"create table t_lob (a blob primary key)",
+		"create table t_lob (a int, b mediumtext, unique key (b))",
+		"create table t_lob (a int, b int references t_lob(c))",
+		"create table t_json (a json default '{}')",
+		"create table t_json (a json primary key)",
+		"select json_object('a') from t_json",
+		"select json_extract(j) from t_json",
+		"create table t_enum (a enum('x', 'X'))",
+		"create table t_enum (a set('x,y'))",
+		"create table t_enum (a enum('x') default 'z')",
+		"create table t_enum (a set('x', 'y') default 'x,z')",
+		"create table t_enum (a bit(65))",
+		"create table t_enum (a bit(2) default 4)",
+		"select uuid(1) from t_uuid",
+		"create table t_part (a int, b int) partition by linear hash (a) partitions 2",
+		"create table t_part (a int, b int, foreign key (a) references nation(n_nationkey)) partition by hash (a)",
+		"create table t_fk (a int, foreign key (a) references t_part(a))",
+		"alter table t_part add partition (partition p3 values less than (2021))",
+		"alter table t_part add partition (partition p1 values less than (2030))",
+		"alter table t_part drop partition p0, p1, p2",
+		"alter table t_part drop partition p5",
+		"alter table t_part truncate partition p5",
+		"alter table t_part drop column d",
+		"alter table nation drop partition p0",
+	}
+	runTestShouldError(mock, t, sqls)
+
 	// should error
 	//sqls = []string{
 	//	"create database tpch",  //we mock database tpch。 so tpch is exist
@@ -711,6 +1152,119 @@ func TestDdl(t *testing.T) {
 	//runTestShouldError(mock, t, sqls)
 }
 
+func TestStringFunctions(t *testing.T) {
+	mock := NewMockOptimizer()
+	// should pass
+	sqls := []string{
+		"SELECT upper(N_NAME), ucase(N_NAME), lower(N_COMMENT), lcase(N_COMMENT), replace(N_NAME, 'A', N_COMMENT) FROM NATION",
+		"SELECT instr(N_NAME, 'A'), locate('A', N_NAME), locate('A', N_NAME, N_NATIONKEY), position('A' in N_NAME) FROM NATION",
+		"SELECT left(N_NAME, 3), right(N_NAME, N_REGIONKEY), repeat(N_NAME, 2), ascii(N_NAME) FROM NATION",
+		"SELECT trim(N_NAME), trim(both from N_NAME), trim('x' from N_NAME), trim(trailing N_COMMENT from N_NAME) FROM NATION",
+		"SELECT char(N_NATIONKEY, 65, null), char(N_NATIONKEY using utf8mb4), hex(N_NAME), hex(N_NATIONKEY), unhex(hex(N_NAME)), from_base64(to_base64(N_COMMENT)) FROM NATION",
+		"SELECT N_NAME FROM NATION WHERE upper(N_NAME) = 'CHINA' AND left(N_COMMENT, 1) <> 'x'",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	// should error
+	sqls = []string{
+		"SELECT upper(N_NAME, N_NAME) FROM NATION",
+		"SELECT locate(N_NAME) FROM NATION",
+		"SELECT left(N_NAME) FROM NATION",
+		"SELECT repeat(N_NAME, 1, 2) FROM NATION",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	// the functions of constants are folded
+	folded := map[string]interface{}{
+		"select upper('straße')":                               "STRAßE",
+		"select concat(lcase('AB'), replace('aXa', 'X', '-'))": "aba-a",
+		"select instr('数据库', '库')":                             int64(3),
+		"select position('b' in 'abc')":                        int64(2),
+		"select trim(leading 'x' from 'xxaxx')":                "axx",
+		"select left(right('abcdef', 4), 2)":                   "cd",
+		"select repeat('ab', 2)":                               "abab",
+		"select ascii('a')":                                    int64(97),
+		"select hex(255)":                                      "FF",
+		"select to_base64('abc')":                              "YWJj",
+	}
+	fold := rule.NewConstantFlod()
+	for sql, want := range folded {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		qry := logicPlan.GetQuery()
+		node := qry.Nodes[qry.Steps[0]]
+		fold.Apply(node, qry)
+		c, ok := node.ProjectList[0].Expr.(*plan.Expr_C)
+		if !ok {
+			t.Fatalf("sql=%v, %v should be folded", sql, node.ProjectList[0])
+		}
+		var value interface{} = c.C.GetSval()
+		if _, ok := want.(int64); ok {
+			value = c.C.GetIval()
+		}
+		if value != want {
+			t.Fatalf("sql=%v, result should be %v but now is %v", sql, want, value)
+		}
+	}
+}
+
+func TestPartitionPruning(t *testing.T) {
+	mock := NewMockOptimizer()
+	cases := map[string][]string{
+		"select * from t_part where d >= '2021-03-01'":                   {"p2"},
+		"select * from t_part where d < '2020-06-01'":                    {"p0", "p1"},
+		"select * from t_part where d = '2019-05-05' or d = '2021-05-05'": {"p0", "p2"},
+		"select * from t_part where d > '2020-01-01' and a > 1":          {"p1", "p2"},
+		"select * from t_part where a > 1":                               {"p0", "p1", "p2"},
+		"select * from t_part where d = '2030-01-01'":                    {"p0"},
+	}
+	for sql, parts := range cases {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType == plan.Node_TABLE_SCAN && !reflect.DeepEqual(node.Partitions, parts) {
+				t.Fatalf("sql=%v, partitions should be %v but now is %v", sql, parts, node.Partitions)
+			}
+		}
+	}
+}
+
+func TestFullTextSearch(t *testing.T) {
+	mock := NewMockOptimizer()
+	sql := "select id, match(title) against('mysql') from articles where match(title) against('mysql') and match(title, body) against('+tuning' in boolean mode) > 1"
+	logicPlan, err := runOneStmt(mock, t, sql)
+	if err != nil {
+		t.Fatalf("%+v, sql=%v", err, sql)
+	}
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType != plan.Node_TABLE_SCAN {
+			continue
+		}
+		if len(node.FullText) != 2 {
+			t.Fatalf("scan should have 2 full-text searches but has %d", len(node.FullText))
+		}
+		if !node.FullText[0].Filter || node.FullText[1].Filter {
+			t.Fatalf("only the first search should filter the blocks")
+		}
+		if !reflect.DeepEqual(node.FullText[1].ColNames, []string{"title", "body"}) || node.FullText[1].Mode != plan.FullTextScan_BOOLEAN {
+			t.Fatalf("wrong second search %v", node.FullText[1])
+		}
+		for _, ft := range node.FullText {
+			found := false
+			for _, col := range node.TableDef.Cols {
+				found = found || col.Name == ft.Attr
+			}
+			if !found {
+				t.Fatalf("relevance column %s should be read by the scan", ft.Attr)
+			}
+		}
+	}
+}
+
 func TestShow(t *testing.T) {
 	mock := NewMockOptimizer()
 	// should pass
@@ -719,6 +1273,8 @@ func TestShow(t *testing.T) {
 		//"show create database tpch",
 		"show create table nation",
 		"show create table tpch.nation",
+		"show create view v_nation",
+		"show create view tpch.v_nation",
 		"show databases",
 		"show databases like '%d'",
 		"show databases where `Database` = '11'",
@@ -737,6 +1293,8 @@ func TestShow(t *testing.T) {
 	sqls = []string{
 		"show create database db_not_exist",                    //db no exist
 		"show create table tpch.nation22",                      //table not exist
+		"show create view v_not_exist",                         //view not exist
+		"show create view nation",                              //not a view
 		"show databases where d ='a'",                          //Column not exist,  show databases only have one column named 'Database'
 		"show databases where `Databaseddddd` = '11'",          //column not exist
 		"show tables from tpch22222",                           //database not exist
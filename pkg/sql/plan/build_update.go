@@ -15,6 +15,8 @@
 package plan
 
 import (
+	"fmt"
+
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
@@ -47,6 +49,9 @@ func buildUpdate(stmt *tree.Update, ctx CompilerContext) (*Plan, error) {
 	if tableDef == nil {
 		return nil, errors.New(errno.FeatureNotSupported, "cannot find update table")
 	}
+	if tableDef.View != nil {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("view '%s' is not updatable", tableDef.Name))
+	}
 
 	// Function of getting def of col from col name
 	getColTyp := func(name string) *plan.Type {
@@ -101,7 +106,9 @@ func buildUpdate(stmt *tree.Update, ctx CompilerContext) (*Plan, error) {
 	}
 
 	// Build projection for select
+	projByCol := make(map[string]int, len(tableDef.Cols))
 	for _, expr := range stmt.Exprs {
+		projByCol[expr.Names[0].Parts[0]] = len(useProjectExprs)
 		useProjectExprs = append(useProjectExprs, tree.SelectExpr{Expr: expr.Expr})
 	}
 
@@ -123,6 +130,24 @@ func buildUpdate(stmt *tree.Update, ctx CompilerContext) (*Plan, error) {
 			if !find {
 				otherAttrs = append(otherAttrs, col.Name)
 				e, _ := tree.NewUnresolvedName(col.Name)
+				projByCol[col.Name] = len(useProjectExprs)
+				useProjectExprs = append(useProjectExprs, tree.SelectExpr{Expr: e})
+			}
+		}
+	}
+
+	// project the other columns used by the check constraints, they are
+	// replaced by the results of the constraints after the select is built
+	checks, err := BuildCheckExprs(ctx, tableDef)
+	if err != nil {
+		return nil, err
+	}
+	projLen := len(useProjectExprs)
+	for _, check := range checks {
+		for _, col := range getCheckColumns(check, tableDef) {
+			if _, ok := projByCol[col.Name]; !ok {
+				e, _ := tree.NewUnresolvedName(col.Name)
+				projByCol[col.Name] = len(useProjectExprs)
 				useProjectExprs = append(useProjectExprs, tree.SelectExpr{Expr: e})
 			}
 		}
@@ -160,6 +185,33 @@ func buildUpdate(stmt *tree.Update, ctx CompilerContext) (*Plan, error) {
 		idx++
 	}
 
+	// the results of the check constraints follow the columns to write
+	if len(checks) > 0 {
+		colExprs := make([]*Expr, len(tableDef.Cols))
+		for i, col := range tableDef.Cols {
+			if pos, ok := projByCol[col.Name]; ok {
+				colExprs[i] = lastNode.ProjectList[pos]
+			}
+		}
+		lastNode.ProjectList = lastNode.ProjectList[:projLen]
+		for _, check := range checks {
+			lastNode.ProjectList = append(lastNode.ProjectList, replaceCheckColumns(check, colExprs))
+		}
+	}
+
+	// the table needs SELECT too if its columns are read
+	readExprs := make([]tree.Expr, 0, len(stmt.Exprs)+1)
+	for _, expr := range stmt.Exprs {
+		readExprs = append(readExprs, expr.Expr)
+	}
+	if stmt.Where != nil {
+		readExprs = append(readExprs, stmt.Where.Expr)
+	}
+	tblName := string(tbl.ObjectName)
+	if alsTbl.As.Alias != "" {
+		tblName = string(alsTbl.As.Alias)
+	}
+
 	// Build update node
 	node := &Node{
 		NodeType: plan.Node_UPDATE,
@@ -174,6 +226,7 @@ func buildUpdate(stmt *tree.Update, ctx CompilerContext) (*Plan, error) {
 			UpdateAttrs: updateAttrs,
 			OtherAttrs:  otherAttrs,
 			AttrOrders:  attrOrders,
+			ReadColumns: readsColumns(tblName, tableDef, readExprs...),
 		},
 	}
 	qry.Nodes = append(qry.Nodes, node)
@@ -186,6 +239,9 @@ func isSameColumnType(t1 *Type, t2 *Type) bool {
 	if t1.Id != t2.Id {
 		return false
 	}
+	if t1.Enumvalues != t2.Enumvalues {
+		return false
+	}
 	if t1.Width == t2.Width && t1.Precision == t2.Precision && t1.Size == t2.Size && t1.Scale == t2.Scale {
 		return true
 	}
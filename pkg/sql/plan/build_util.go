@@ -82,10 +82,60 @@ func getTypeFromAst(typ tree.ResolvableTypeReference) (*plan.Type, error) {
 				// create table t1(a char) -> DisplayWith = -1；but get width=1 in MySQL and PgSQL
 				width = 1
 			}
-			if n.InternalType.FamilyString == "char" { // type char
+			switch n.InternalType.FamilyString {
+			case "char":
 				return &plan.Type{Id: plan.Type_CHAR, Size: 24, Width: width}, nil
+			case "binary":
+				if width == 0 {
+					width = 1
+				}
+				return &plan.Type{Id: plan.Type_BINARY, Size: 24, Width: width}, nil
+			case "varbinary":
+				return &plan.Type{Id: plan.Type_VARBINARY, Size: 24, Width: width}, nil
 			}
 			return &plan.Type{Id: plan.Type_VARCHAR, Size: 24, Width: width}, nil
+		case defines.MYSQL_TYPE_TINY_BLOB, defines.MYSQL_TYPE_BLOB, defines.MYSQL_TYPE_MEDIUM_BLOB, defines.MYSQL_TYPE_LONG_BLOB:
+			// the width is the max length in bytes of the type
+			var width int32
+			switch uint8(n.InternalType.Oid) {
+			case defines.MYSQL_TYPE_TINY_BLOB:
+				width = math.MaxUint8
+			case defines.MYSQL_TYPE_BLOB:
+				width = math.MaxUint16
+			case defines.MYSQL_TYPE_MEDIUM_BLOB:
+				width = 1<<24 - 1
+			default:
+				width = math.MaxInt32
+			}
+			if strings.HasSuffix(n.InternalType.FamilyString, "text") {
+				return &plan.Type{Id: plan.Type_TEXT, Size: 24, Width: width}, nil
+			}
+			return &plan.Type{Id: plan.Type_BLOB, Size: 24, Width: width}, nil
+		case defines.MYSQL_TYPE_JSON:
+			return &plan.Type{Id: plan.Type_JSON, Size: 24}, nil
+		case defines.MYSQL_TYPE_UUID:
+			return &plan.Type{Id: plan.Type_UUID, Size: 16}, nil
+		case defines.MYSQL_TYPE_BIT:
+			// the width is the number of bits, BIT is BIT(1)
+			width := n.InternalType.DisplayWith
+			if width <= 0 {
+				width = 1
+			}
+			if width > types.MaxBitWidth {
+				return nil, errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("Display width out of range for BIT(%d), max is %d", width, types.MaxBitWidth))
+			}
+			return &plan.Type{Id: plan.Type_BIT, Size: 8, Width: width}, nil
+		case defines.MYSQL_TYPE_ENUM, defines.MYSQL_TYPE_SET:
+			typ := &plan.Type{Id: plan.Type_ENUM, Size: 2}
+			if uint8(n.InternalType.Oid) == defines.MYSQL_TYPE_SET {
+				typ = &plan.Type{Id: plan.Type_SET, Size: 8}
+			}
+			values, err := types.JoinEnumMembers(types.T(typ.Id), n.InternalType.EnumValues)
+			if err != nil {
+				return nil, err
+			}
+			typ.Enumvalues = values
+			return typ, nil
 		case defines.MYSQL_TYPE_DATE:
 			return &plan.Type{Id: plan.Type_DATE, Size: 4}, nil
 		case defines.MYSQL_TYPE_DATETIME:
@@ -127,6 +177,9 @@ func getDefaultExprFromColumn(column *tree.ColumnTableDef, typ *plan.Type) (*pla
 	for _, attr := range column.Attributes {
 		if d, ok := attr.(*tree.AttributeDefault); ok {
 			defaultExpr := d.Expr
+			if (types.T(typ.Id).IsLob() || typ.Id == plan.Type_JSON) && !isNullExpr(defaultExpr) {
+				return nil, errors.New(errno.InvalidColumnDefinition, fmt.Sprintf("BLOB, TEXT, GEOMETRY or JSON column '%s' can't have a default value", column.Name.Parts[0]))
+			}
 			// check allowNull
 			if isNullExpr(defaultExpr) {
 				if !allowNull {
@@ -267,6 +320,10 @@ func rangeCheck(value interface{}, typ *plan.Type, columnName string, rowNumber
 			}
 		case plan.Type_UINT64:
 			return v, nil
+		case plan.Type_BIT:
+			if types.CheckBit(v, typ.Width) == nil {
+				return v, nil
+			}
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 		}
@@ -290,13 +347,15 @@ func rangeCheck(value interface{}, typ *plan.Type, columnName string, rowNumber
 		return nil, errors.New(errno.DataException, fmt.Sprintf(errString, columnName, rowNumber))
 	case string:
 		switch typ.GetId() {
-		case plan.Type_CHAR, plan.Type_VARCHAR: // string family should compare the length but not value
+		case plan.Type_CHAR, plan.Type_VARCHAR, plan.Type_BINARY, plan.Type_VARBINARY: // string family should compare the length but not value
 			if len(v) > math.MaxUint16 {
 				return nil, errors.New(errno.DataException, "length out of uint16 is unexpected for char / varchar value")
 			}
 			if len(v) <= int(typ.Width) {
 				return v, nil
 			}
+		case plan.Type_ENUM, plan.Type_SET: // the members are checked while building the constant
+			return v, nil
 		default:
 			return nil, errors.New(errno.DatatypeMismatch, "unexpected type and value")
 		}
@@ -550,6 +609,18 @@ func buildConstantValue(typ *plan.Type, num *tree.NumVal) (interface{}, error) {
 			return float64(v), nil
 		case plan.Type_TIMESTAMP:
 			return types.ParseTimestamp(str, typ.Precision)
+		case plan.Type_BIT:
+			v, _ := constant.Uint64Val(val)
+			if num.Negative() && v != 0 {
+				return nil, errConstantOutRange
+			}
+			return v, nil
+		case plan.Type_ENUM, plan.Type_SET:
+			v, ok := constant.Uint64Val(val)
+			if !ok || num.Negative() {
+				return nil, errConstantOutRange
+			}
+			return buildEnumConstant(typ, "", v, true)
 		}
 	case constant.Float:
 		switch typ.GetId() {
@@ -647,6 +718,10 @@ func buildConstantValue(typ *plan.Type, num *tree.NumVal) (interface{}, error) {
 			return types.ParseStringToDecimal64(str, typ.Width, typ.Scale)
 		case plan.Type_DECIMAL128:
 			return types.ParseStringToDecimal128(str, typ.Width, typ.Scale)
+		case plan.Type_BIT:
+			return types.ParseBitBytes([]byte(constant.StringVal(val)), typ.Width)
+		case plan.Type_ENUM, plan.Type_SET:
+			return buildEnumConstant(typ, constant.StringVal(val), 0, false)
 		}
 		if !num.Negative() {
 			switch typ.GetId() {
@@ -664,6 +739,36 @@ func buildConstantValue(typ *plan.Type, num *tree.NumVal) (interface{}, error) {
 	return nil, errors.New(errno.IndeterminateDatatype, fmt.Sprintf("unsupport value: %v", val))
 }
 
+// buildEnumConstant checks a member of ENUM or SET by name or by number, and
+// returns the members as they are defined.
+func buildEnumConstant(typ *plan.Type, s string, n uint64, isNumber bool) (string, error) {
+	members := types.SplitEnumMembers(typ.Enumvalues)
+	if typ.Id == plan.Type_ENUM {
+		var v uint16
+		var err error
+		if isNumber {
+			v, err = types.ParseEnumIndex(members, n)
+		} else {
+			v, err = types.ParseEnum(members, s)
+		}
+		if err != nil {
+			return "", err
+		}
+		return types.EnumString(members, v), nil
+	}
+	var v uint64
+	var err error
+	if isNumber {
+		v, err = types.ParseSetIndex(members, n)
+	} else {
+		v, err = types.ParseSet(members, s)
+	}
+	if err != nil {
+		return "", err
+	}
+	return types.SetString(members, v), nil
+}
+
 func getFunctionObjRef(funcID int64, name string) *ObjectRef {
 	return &ObjectRef{
 		Obj:     funcID,
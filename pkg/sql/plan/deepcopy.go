@@ -34,10 +34,24 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 		AggList:         make([]*plan.Expr, len(node.AggList)),
 		OrderBy:         make([]*plan.OrderBySpec, len(node.OrderBy)),
 		DeleteTablesCtx: make([]*plan.DeleteTableCtx, len(node.DeleteTablesCtx)),
+		Partitions:      make([]string, len(node.Partitions)),
+		FullText:        make([]*plan.FullTextScan, len(node.FullText)),
 	}
 
 	copy(newNode.Children, node.Children)
 	copy(newNode.BindingTags, node.BindingTags)
+	copy(newNode.Partitions, node.Partitions)
+
+	for idx, ft := range node.FullText {
+		newNode.FullText[idx] = &plan.FullTextScan{
+			Attr:     ft.Attr,
+			ColNames: make([]string, len(ft.ColNames)),
+			Query:    ft.Query,
+			Mode:     ft.Mode,
+			Filter:   ft.Filter,
+		}
+		copy(newNode.FullText[idx].ColNames, ft.ColNames)
+	}
 
 	for idx, expr := range node.ProjectList {
 		newNode.ProjectList[idx] = DeepCopyExpr(expr)
@@ -134,6 +148,13 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 				Flag:      orderBy.Flag,
 			}
 		}
+		if node.WinSpec.Frame != nil {
+			newNode.WinSpec.Frame = &plan.FrameClause{
+				Type:  node.WinSpec.Frame.Type,
+				Start: deepCopyFrameBound(node.WinSpec.Frame.Start),
+				End:   deepCopyFrameBound(node.WinSpec.Frame.End),
+			}
+		}
 	}
 
 	if node.TableDef != nil {
@@ -225,3 +246,14 @@ func DeepCopyQuery(qry *plan.Query) *plan.Query {
 	}
 	return newQry
 }
+
+func deepCopyFrameBound(bound *plan.FrameBound) *plan.FrameBound {
+	if bound == nil {
+		return nil
+	}
+	return &plan.FrameBound{
+		Type:      bound.Type,
+		Unbounded: bound.Unbounded,
+		Val:       DeepCopyExpr(bound.Val),
+	}
+}
@@ -429,6 +429,10 @@ func TestNestedQueryPrune(t *testing.T) {
 					tableName: "region",
 					colNames:  []string{"r_regionkey"},
 				},
+				{
+					tableName: "nation",
+					colNames:  []string{"n_regionkey"},
+				},
 			},
 		},
 		{
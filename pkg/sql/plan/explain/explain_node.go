@@ -16,6 +16,7 @@ package explain
 
 import (
 	"strconv"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -101,6 +102,14 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(options *ExplainOptions) (string
 		pname = "Union"
 	case plan.Node_UNION_ALL:
 		pname = "Union All"
+	case plan.Node_INTERSECT:
+		pname = "Intersect"
+	case plan.Node_INTERSECT_ALL:
+		pname = "Intersect All"
+	case plan.Node_MINUS:
+		pname = "Minus"
+	case plan.Node_MINUS_ALL:
+		pname = "Minus All"
 	case plan.Node_UNIQUE:
 		pname = "Unique"
 	case plan.Node_WINDOW:
@@ -137,6 +146,8 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(options *ExplainOptions) (string
 			fallthrough
 		case plan.Node_MATERIAL_SCAN:
 			fallthrough
+		case plan.Node_SINK_SCAN:
+			fallthrough
 		case plan.Node_INSERT:
 			fallthrough
 		case plan.Node_UPDATE:
@@ -158,8 +169,6 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(options *ExplainOptions) (string
 			fallthrough
 		case plan.Node_SINK:
 			fallthrough
-		case plan.Node_SINK_SCAN:
-			fallthrough
 		case plan.Node_AGG:
 			fallthrough
 		case plan.Node_DISTINCT:
@@ -176,6 +185,14 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(options *ExplainOptions) (string
 			fallthrough
 		case plan.Node_UNION_ALL:
 			fallthrough
+		case plan.Node_INTERSECT:
+			fallthrough
+		case plan.Node_INTERSECT_ALL:
+			fallthrough
+		case plan.Node_MINUS:
+			fallthrough
+		case plan.Node_MINUS_ALL:
+			fallthrough
 		case plan.Node_UNIQUE:
 			fallthrough
 		case plan.Node_WINDOW:
@@ -253,6 +270,15 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(options *ExplainOptions) ([]string,
 		lines = append(lines, groupByInfo)
 	}
 
+	// Get Grouping sets info
+	if len(ndesc.Node.GroupingSet) > 0 {
+		groupingSetsInfo, err := ndesc.GetGroupingSetsInfo(options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, groupingSetsInfo)
+	}
+
 	// Get Aggregate function info
 	if ndesc.Node.AggList != nil {
 		aggListInfo, err := ndesc.GetAggregationInfo(options)
@@ -262,6 +288,18 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(options *ExplainOptions) ([]string,
 		lines = append(lines, aggListInfo)
 	}
 
+	// Get Window spec info
+	if ndesc.Node.WinSpec != nil {
+		winSpecDescImpl := &WinSpecDescribeImpl{
+			WinSpec: ndesc.Node.WinSpec,
+		}
+		winSpecInfo, err := winSpecDescImpl.GetDescription(options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, "Window Spec:"+winSpecInfo)
+	}
+
 	// Get Filter list info
 	if ndesc.Node.FilterList != nil && len(ndesc.Node.FilterList) != 0 {
 		filterInfo, err := ndesc.GetFilterConditionInfo(options)
@@ -271,6 +309,11 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(options *ExplainOptions) ([]string,
 		lines = append(lines, filterInfo)
 	}
 
+	// Get partitions info
+	if len(ndesc.Node.Partitions) != 0 {
+		lines = append(lines, "Partitions: "+strings.Join(ndesc.Node.Partitions, ", "))
+	}
+
 	// Get Limit And Offset info
 	if ndesc.Node.Limit != nil {
 		var temp string
@@ -375,6 +418,45 @@ func (ndesc *NodeDescribeImpl) GetGroupByInfo(options *ExplainOptions) (string,
 	return result, nil
 }
 
+// GetGroupingSetsInfo describes each grouping set by the group keys it keeps.
+func (ndesc *NodeDescribeImpl) GetGroupingSetsInfo(options *ExplainOptions) (string, error) {
+	result := "Grouping Sets: "
+	if options.Format == EXPLAIN_FORMAT_TEXT {
+		groupBy := ndesc.Node.GetGroupBy()
+		for i, set := range ndesc.Node.GetGroupingSet() {
+			if i > 0 {
+				result += ", "
+			}
+			rolledUp := make(map[int64]bool)
+			for _, key := range set.GetList().GetList() {
+				rolledUp[key.GetC().GetIval()] = true
+			}
+			result += "("
+			first := true
+			for pos := 1; pos < len(groupBy); pos++ {
+				if rolledUp[int64(pos)] {
+					continue
+				}
+				if !first {
+					result += ", "
+				}
+				first = false
+				descV, err := describeExpr(groupBy[pos], options)
+				if err != nil {
+					return result, err
+				}
+				result += descV
+			}
+			result += ")"
+		}
+	} else if options.Format == EXPLAIN_FORMAT_JSON {
+		return result, errors.New(errno.FeatureNotSupported, "unimplement explain format json")
+	} else if options.Format == EXPLAIN_FORMAT_DOT {
+		return result, errors.New(errno.FeatureNotSupported, "unimplement explain format dot")
+	}
+	return result, nil
+}
+
 func (ndesc *NodeDescribeImpl) GetAggregationInfo(options *ExplainOptions) (string, error) {
 	result := "Aggregate Functions: "
 	if options.Format == EXPLAIN_FORMAT_TEXT {
@@ -514,8 +596,55 @@ type WinSpecDescribeImpl struct {
 }
 
 func (w *WinSpecDescribeImpl) GetDescription(options *ExplainOptions) (string, error) {
-	// TODO implement me
-	panic("implement me")
+	result := ""
+	if len(w.WinSpec.PartitionBy) > 0 {
+		exprs := NewExprListDescribeImpl(w.WinSpec.PartitionBy)
+		describe, err := exprs.GetDescription(options)
+		if err != nil {
+			return result, err
+		}
+		result += " Partition By:" + describe
+	}
+	if len(w.WinSpec.OrderBy) > 0 {
+		result += " Order By:"
+		for i, orderBy := range w.WinSpec.OrderBy {
+			if i > 0 {
+				result += ","
+			}
+			describe, err := NewOrderByDescribeImpl(orderBy).GetDescription(options)
+			if err != nil {
+				return result, err
+			}
+			result += describe
+		}
+	}
+	if frame := w.WinSpec.Frame; frame != nil {
+		result += " Frame: " + frame.Type.String() + " BETWEEN "
+		start, err := describeFrameBound(frame.Start, options)
+		if err != nil {
+			return result, err
+		}
+		end, err := describeFrameBound(frame.End, options)
+		if err != nil {
+			return result, err
+		}
+		result += start + " AND " + end
+	}
+	return result, nil
+}
+
+func describeFrameBound(bound *plan.FrameBound, options *ExplainOptions) (string, error) {
+	if bound.Type == plan.FrameBound_CURRENT_ROW {
+		return "CURRENT ROW", nil
+	}
+	if bound.Unbounded {
+		return "UNBOUNDED " + bound.Type.String(), nil
+	}
+	describe, err := describeExpr(bound.Val, options)
+	if err != nil {
+		return "", err
+	}
+	return describe + " " + bound.Type.String(), nil
 }
 
 type RowsetDataDescribeImpl struct {
@@ -187,6 +187,24 @@ func TestDerivedTableQuery(t *testing.T) {
 	runTestShouldPass(mockOptimizer, t, sqls)
 }
 
+func TestRecursiveCTEQuery(t *testing.T) {
+	sqls := []string{
+		"explain with recursive qn(n) as (select 1 union all select n + 1 from qn where n < 10) select * from qn",
+		"explain verbose with recursive qn as (select N_NATIONKEY, N_REGIONKEY from NATION where N_NATIONKEY = 0 union select NATION.N_NATIONKEY, NATION.N_REGIONKEY from NATION join qn on NATION.N_REGIONKEY = qn.N_NATIONKEY) select N_NATIONKEY from qn where N_REGIONKEY > 1",
+	}
+	mockOptimizer := plan.NewMockOptimizer()
+	runTestShouldPass(mockOptimizer, t, sqls)
+}
+
+func TestSetOperationQuery(t *testing.T) {
+	sqls := []string{
+		"explain select N_NAME from NATION union select R_NAME from REGION",
+		"explain verbose select N_REGIONKEY from NATION intersect all select R_REGIONKEY from REGION except select 1 order by 1 limit 2",
+	}
+	mockOptimizer := plan.NewMockOptimizer()
+	runTestShouldPass(mockOptimizer, t, sqls)
+}
+
 // Collection query
 func TestCollectionQuery(t *testing.T) {
 
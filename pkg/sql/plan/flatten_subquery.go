@@ -21,33 +21,39 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 )
 
-func (builder *QueryBuilder) flattenSubqueries(nodeID int32, expr *plan.Expr, ctx *BindContext) (int32, *plan.Expr, error) {
+// flattenSubqueries turns the subqueries of expr into joins with nodeID. A
+// root expression is a conjunct of WHERE or HAVING, its EXISTS, IN and
+// quantified subqueries become semi or anti joins and nil is returned for
+// it. The other ones become MARK joins and are replaced by their marks.
+func (builder *QueryBuilder) flattenSubqueries(nodeID int32, expr *plan.Expr, ctx *BindContext, isRoot bool) (int32, *plan.Expr, error) {
 	var err error
 
 	switch exprImpl := expr.Expr.(type) {
 	case *plan.Expr_F:
 		for i, arg := range exprImpl.F.Args {
-			nodeID, exprImpl.F.Args[i], err = builder.flattenSubqueries(nodeID, arg, ctx)
+			nodeID, exprImpl.F.Args[i], err = builder.flattenSubqueries(nodeID, arg, ctx, false)
 			if err != nil {
 				return 0, nil, err
 			}
 		}
 
 	case *plan.Expr_Sub:
-		nodeID, expr, err = builder.flattenSubquery(nodeID, exprImpl.Sub, ctx)
+		nodeID, expr, err = builder.flattenSubquery(nodeID, exprImpl.Sub, ctx, isRoot)
 	}
 
 	return nodeID, expr, err
 }
 
-func (builder *QueryBuilder) flattenSubquery(nodeID int32, subquery *plan.SubqueryRef, ctx *BindContext) (int32, *plan.Expr, error) {
-	// TODO: use SINGLE JOIN for scalar subquery and MARK JOIN for quantified subquery
-
+func (builder *QueryBuilder) flattenSubquery(nodeID int32, subquery *plan.SubqueryRef, ctx *BindContext, isRoot bool) (int32, *plan.Expr, error) {
 	subID := subquery.NodeId
 	subCtx := builder.ctxByNode[subID]
 
-	if subquery.Typ == plan.SubqueryRef_SCALAR && !subCtx.hasSingleRow {
-		return 0, nil, errors.New("", "runtime check of scalar subquery will be supported in future version")
+	if subCtx.innerSemiJoin && (subquery.Typ == plan.SubqueryRef_SCALAR || len(subCtx.aggregates) > 0 || len(subCtx.windows) > 0) {
+		return 0, nil, errors.New("", fmt.Sprintf("%s subquery containing subqueries correlated with an outer query will be supported in future version", subquery.Typ.String()))
+	}
+
+	if subquery.Typ == plan.SubqueryRef_EXISTS || subquery.Typ == plan.SubqueryRef_NOT_EXISTS {
+		builder.removeLimitOfExists(subID)
 	}
 
 	subID, preds, err := builder.pullupCorrelatedPredicates(subID, subCtx)
@@ -57,8 +63,20 @@ func (builder *QueryBuilder) flattenSubquery(nodeID int32, subquery *plan.Subque
 
 	filterPreds, joinPreds := decreaseDepthAndDispatch(preds)
 
-	if len(filterPreds) > 0 && subquery.Typ >= plan.SubqueryRef_SCALAR {
-		return 0, nil, errors.New("", fmt.Sprintf("correlated columns in %s subquery deeper than 1 level will be supported in future version", subquery.Typ.String()))
+	if subquery.Typ == plan.SubqueryRef_SCALAR {
+		if len(filterPreds) > 0 {
+			return 0, nil, errors.New("", fmt.Sprintf("correlated columns in %s subquery deeper than 1 level will be supported in future version", subquery.Typ.String()))
+		}
+
+		return builder.flattenScalarSubquery(nodeID, subID, joinPreds, ctx, subCtx)
+	}
+
+	if !isRoot {
+		if len(filterPreds) > 0 {
+			return 0, nil, errors.New("", fmt.Sprintf("correlated columns in %s subquery as non-root expression deeper than 1 level will be supported in future version", subquery.Typ.String()))
+		}
+
+		return builder.flattenMarkSubquery(nodeID, subID, subquery, joinPreds, ctx, subCtx)
 	}
 
 	alwaysTrue := &plan.Expr{
@@ -77,52 +95,16 @@ func (builder *QueryBuilder) flattenSubquery(nodeID int32, subquery *plan.Subque
 		},
 	}
 
-	switch subquery.Typ {
-	case plan.SubqueryRef_SCALAR:
-		// Uncorrelated subquery
-		if len(joinPreds) == 0 {
-			joinPreds = append(joinPreds, alwaysTrue)
-		}
-
-		nodeID = builder.appendNode(&plan.Node{
-			NodeType: plan.Node_JOIN,
-			Children: []int32{nodeID, subID},
-			JoinType: plan.Node_LEFT,
-			OnList:   joinPreds,
-		}, ctx)
-
-		if len(filterPreds) > 0 {
-			nodeID = builder.appendNode(&plan.Node{
-				NodeType:   plan.Node_FILTER,
-				Children:   []int32{nodeID},
-				FilterList: filterPreds,
-			}, ctx)
-		}
-
-		return nodeID, &plan.Expr{
-			Typ: subCtx.results[0].Typ,
-			Expr: &plan.Expr_Col{
-				Col: &plan.ColRef{
-					RelPos: subCtx.rootTag(),
-					ColPos: 0,
-				},
-			},
-		}, nil
+	var joinType plan.Node_JoinFlag
 
+	switch subquery.Typ {
 	case plan.SubqueryRef_EXISTS:
 		// Uncorrelated subquery
 		if len(joinPreds) == 0 {
 			joinPreds = append(joinPreds, alwaysTrue)
 		}
 
-		nodeID = builder.appendNode(&plan.Node{
-			NodeType: plan.Node_JOIN,
-			Children: []int32{nodeID, subID},
-			JoinType: plan.Node_SEMI,
-			OnList:   joinPreds,
-		}, ctx)
-
-		return nodeID, nil, nil
+		joinType = plan.Node_SEMI
 
 	case plan.SubqueryRef_NOT_EXISTS:
 		// Uncorrelated subquery
@@ -130,14 +112,7 @@ func (builder *QueryBuilder) flattenSubquery(nodeID int32, subquery *plan.Subque
 			joinPreds = append(joinPreds, alwaysTrue)
 		}
 
-		nodeID = builder.appendNode(&plan.Node{
-			NodeType: plan.Node_JOIN,
-			Children: []int32{nodeID, subID},
-			JoinType: plan.Node_ANTI,
-			OnList:   joinPreds,
-		}, ctx)
-
-		return nodeID, nil, nil
+		joinType = plan.Node_ANTI
 
 	case plan.SubqueryRef_IN:
 		expr, err := builder.generateComparison("=", subquery.Child, subCtx)
@@ -146,15 +121,7 @@ func (builder *QueryBuilder) flattenSubquery(nodeID int32, subquery *plan.Subque
 		}
 
 		joinPreds = append(joinPreds, expr)
-
-		nodeID = builder.appendNode(&plan.Node{
-			NodeType: plan.Node_JOIN,
-			Children: []int32{nodeID, subID},
-			JoinType: plan.Node_SEMI,
-			OnList:   joinPreds,
-		}, ctx)
-
-		return nodeID, nil, nil
+		joinType = plan.Node_SEMI
 
 	case plan.SubqueryRef_NOT_IN:
 		expr, err := builder.generateComparison("=", subquery.Child, subCtx)
@@ -163,15 +130,7 @@ func (builder *QueryBuilder) flattenSubquery(nodeID int32, subquery *plan.Subque
 		}
 
 		joinPreds = append(joinPreds, expr)
-
-		nodeID = builder.appendNode(&plan.Node{
-			NodeType: plan.Node_JOIN,
-			Children: []int32{nodeID, subID},
-			JoinType: plan.Node_ANTI,
-			OnList:   joinPreds,
-		}, ctx)
-
-		return nodeID, nil, nil
+		joinType = plan.Node_ANTI
 
 	case plan.SubqueryRef_ANY:
 		expr, err := builder.generateComparison(subquery.Op, subquery.Child, subCtx)
@@ -180,15 +139,7 @@ func (builder *QueryBuilder) flattenSubquery(nodeID int32, subquery *plan.Subque
 		}
 
 		joinPreds = append(joinPreds, expr)
-
-		nodeID = builder.appendNode(&plan.Node{
-			NodeType: plan.Node_JOIN,
-			Children: []int32{nodeID, subID},
-			JoinType: plan.Node_SEMI,
-			OnList:   joinPreds,
-		}, ctx)
-
-		return nodeID, nil, nil
+		joinType = plan.Node_SEMI
 
 	case plan.SubqueryRef_ALL:
 		expr, err := builder.generateComparison(subquery.Op, subquery.Child, subCtx)
@@ -202,19 +153,408 @@ func (builder *QueryBuilder) flattenSubquery(nodeID int32, subquery *plan.Subque
 		}
 
 		joinPreds = append(joinPreds, expr)
+		joinType = plan.Node_ANTI
+
+	default:
+		return 0, nil, errors.New("", fmt.Sprintf("%s subquery not supported", subquery.Typ.String()))
+	}
+
+	if len(filterPreds) > 0 {
+		// The predicates correlated with an outer query are pulled up again
+		// with the query of ctx. They may refer to the columns of the
+		// subquery, so it is joined by an INNER join. The duplicated rows
+		// don't change the result of the semi join of that query.
+		if joinType != plan.Node_SEMI {
+			return 0, nil, errors.New("", fmt.Sprintf("correlated columns in %s subquery deeper than 1 level will be supported in future version", subquery.Typ.String()))
+		}
+
+		ctx.innerSemiJoin = true
 
 		nodeID = builder.appendNode(&plan.Node{
 			NodeType: plan.Node_JOIN,
 			Children: []int32{nodeID, subID},
-			JoinType: plan.Node_ANTI,
+			JoinType: plan.Node_INNER,
 			OnList:   joinPreds,
 		}, ctx)
 
+		nodeID = builder.appendNode(&plan.Node{
+			NodeType:   plan.Node_FILTER,
+			Children:   []int32{nodeID},
+			FilterList: filterPreds,
+		}, ctx)
+
 		return nodeID, nil, nil
+	}
+
+	nodeID = builder.appendNode(&plan.Node{
+		NodeType: plan.Node_JOIN,
+		Children: []int32{nodeID, subID},
+		JoinType: joinType,
+		OnList:   joinPreds,
+	}, ctx)
+
+	return nodeID, nil, nil
+}
+
+// flattenLateral decorrelates the LATERAL derived table subID joined to the
+// tables before it by a joinType join. Its predicates correlated with these
+// tables are pulled up and returned as the ON condition of the join, the
+// ones correlated with an outer query as filters above the join, which are
+// pulled up again with the query of the join.
+func (builder *QueryBuilder) flattenLateral(subID int32, joinType plan.Node_JoinFlag) (int32, plan.Node_JoinFlag, []*plan.Expr, []*plan.Expr, error) {
+	subCtx := builder.ctxByNode[subID]
+
+	if subCtx.innerSemiJoin {
+		return 0, 0, nil, nil, errors.New("", "LATERAL derived table containing subqueries correlated with the tables before it will be supported in future version")
+	}
+
+	// an aggregation without GROUP BY returns a row even for no input rows,
+	// but not the groups of the correlated columns pulled up through it
+	aggregated := len(subCtx.groups) == 0 && len(subCtx.aggregates) > 0
+	hasHaving := aggregated && hasFilterAboveAgg(builder.qry.Nodes, subID)
+
+	subID, preds, err := builder.pullupCorrelatedPredicates(subID, subCtx)
+	if err != nil {
+		return 0, 0, nil, nil, err
+	}
+
+	if hasCorrColInTree(builder.qry.Nodes, subID) {
+		return 0, 0, nil, nil, errors.New("", "correlated columns outside the WHERE and ON conditions of a LATERAL derived table will be supported in future version")
+	}
+
+	filterPreds, joinPreds := decreaseDepthAndDispatch(preds)
+	if len(preds) == 0 {
+		return subID, joinType, nil, nil, nil
+	}
+
+	if joinType != plan.Node_INNER && joinType != plan.Node_LEFT {
+		return 0, 0, nil, nil, errors.New("", fmt.Sprintf("correlated LATERAL derived table in a %s JOIN is not supported", joinType.String()))
+	}
+
+	if aggregated && len(joinPreds) > 0 {
+		if hasHaving {
+			return 0, 0, nil, nil, errors.New("", "correlated LATERAL derived table aggregated with HAVING but without GROUP BY will be supported in future version")
+		}
+
+		for _, project := range subCtx.projects {
+			if containsCount(project, subCtx) {
+				return 0, 0, nil, nil, errors.New("", "COUNT in a correlated LATERAL derived table aggregated without GROUP BY will be supported in future version")
+			}
+		}
+
+		// the other aggregate functions are null over no rows, as the
+		// columns of the LEFT join without a matching group
+		joinType = plan.Node_LEFT
+	}
+
+	if len(filterPreds) > 0 && joinType != plan.Node_INNER {
+		return 0, 0, nil, nil, errors.New("", fmt.Sprintf("correlated columns of an outer query in the LATERAL derived table of a %s JOIN will be supported in future version", joinType.String()))
+	}
+
+	return subID, joinType, joinPreds, filterPreds, nil
+}
+
+// hasFilterAboveAgg reports whether there is a FILTER, i.e. a HAVING, between
+// nodeID and the aggregation below it
+func hasFilterAboveAgg(nodes []*plan.Node, nodeID int32) bool {
+	for {
+		node := nodes[nodeID]
+		switch {
+		case node.NodeType == plan.Node_FILTER:
+			return true
+		case node.NodeType == plan.Node_AGG, len(node.Children) == 0:
+			return false
+		}
+		nodeID = node.Children[0]
+	}
+}
+
+// hasCorrColInTree reports whether any expression of the nodes below nodeID
+// still refers to a correlated column
+func hasCorrColInTree(nodes []*plan.Node, nodeID int32) bool {
+	node := nodes[nodeID]
+
+	var exprs []*plan.Expr
+	exprs = append(exprs, node.ProjectList...)
+	exprs = append(exprs, node.FilterList...)
+	exprs = append(exprs, node.OnList...)
+	exprs = append(exprs, node.AggList...)
+	exprs = append(exprs, node.GroupBy...)
+	for _, orderBy := range node.OrderBy {
+		exprs = append(exprs, orderBy.Expr)
+	}
+
+	for _, expr := range exprs {
+		if hasCorrCol(expr) {
+			return true
+		}
+	}
+
+	for _, childID := range node.Children {
+		if hasCorrColInTree(nodes, childID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// flattenScalarSubquery joins a scalar subquery by a LEFT join if it returns
+// at most one row for each row of nodeID, or by a SINGLE join checking it at
+// runtime otherwise
+func (builder *QueryBuilder) flattenScalarSubquery(nodeID, subID int32, joinPreds []*plan.Expr, ctx, subCtx *BindContext) (int32, *plan.Expr, error) {
+	joinType := plan.Node_SINGLE
+	if subCtx.hasSingleRow {
+		joinType = plan.Node_LEFT
+	}
+
+	expr := &plan.Expr{
+		Typ: subCtx.results[0].Typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: subCtx.rootTag(),
+				ColPos: 0,
+			},
+		},
+	}
+
+	// Uncorrelated subquery
+	if len(joinPreds) == 0 {
+		joinPreds = append(joinPreds, &plan.Expr{
+			Expr: &plan.Expr_C{
+				C: &plan.Const{
+					Value: &plan.Const_Bval{
+						Bval: true,
+					},
+				},
+			},
+			Typ: &plan.Type{
+				Id:   plan.Type_BOOL,
+				Size: 1,
+			},
+		})
+	} else if root := builder.qry.Nodes[subID]; root.NodeType == plan.Node_PROJECT && root.BindingTags[0] == subCtx.projectTag && subCtx.hasSingleRow {
+		// COUNT over no rows is 0, not the null of the LEFT join without
+		// a matching group
+		var err error
+		expr, err = builder.pullupCountOfScalar(root, subCtx)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	nodeID = builder.appendNode(&plan.Node{
+		NodeType: plan.Node_JOIN,
+		Children: []int32{nodeID, subID},
+		JoinType: joinType,
+		OnList:   joinPreds,
+	}, ctx)
+
+	return nodeID, expr, nil
+}
+
+// pullupCountOfScalar returns the result of a correlated scalar subquery
+// aggregated without GROUP BY, computed above its join. The columns it uses
+// are added to the root PROJECT of the subquery and the COUNTs are replaced
+// by 0 when they are null.
+func (builder *QueryBuilder) pullupCountOfScalar(root *plan.Node, subCtx *BindContext) (*plan.Expr, error) {
+	projTag := subCtx.projectTag
+	result := root.ProjectList[0]
+	if !containsCount(result, subCtx) {
+		return &plan.Expr{
+			Typ: result.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: projTag,
+					ColPos: 0,
+				},
+			},
+		}, nil
+	}
+
+	return builder.pullupThroughRoot(root, projTag, DeepCopyExpr(result), subCtx)
+}
+
+func (builder *QueryBuilder) pullupThroughRoot(root *plan.Node, projTag int32, expr *plan.Expr, subCtx *BindContext) (*plan.Expr, error) {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_F:
+		for i, arg := range exprImpl.F.Args {
+			newArg, err := builder.pullupThroughRoot(root, projTag, arg, subCtx)
+			if err != nil {
+				return nil, err
+			}
+			exprImpl.F.Args[i] = newArg
+		}
+
+	case *plan.Expr_Col:
+		colPos := int32(len(root.ProjectList))
+		root.ProjectList = append(root.ProjectList, expr)
+
+		newExpr := &plan.Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: projTag,
+					ColPos: colPos,
+				},
+			},
+		}
+
+		if exprImpl.Col.RelPos == subCtx.aggregateTag && isCount(subCtx.aggregates[exprImpl.Col.ColPos]) {
+			isNull, err := bindFuncExprImplByPlanExpr("isnull", []*plan.Expr{DeepCopyExpr(newExpr)})
+			if err != nil {
+				return nil, err
+			}
+
+			return bindFuncExprImplByPlanExpr("case", []*plan.Expr{isNull, {
+				Typ: &plan.Type{
+					Id:   plan.Type_INT64,
+					Size: 8,
+				},
+				Expr: &plan.Expr_C{
+					C: &plan.Const{
+						Value: &plan.Const_Ival{
+							Ival: 0,
+						},
+					},
+				},
+			}, newExpr})
+		}
+
+		return newExpr, nil
+	}
+
+	return expr, nil
+}
+
+func isCount(expr *plan.Expr) bool {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	return ok && (f.F.Func.ObjName == "count" || f.F.Func.ObjName == "starcount")
+}
+
+func containsCount(expr *plan.Expr, subCtx *BindContext) bool {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			if containsCount(arg, subCtx) {
+				return true
+			}
+		}
+
+	case *plan.Expr_Col:
+		return exprImpl.Col.RelPos == subCtx.aggregateTag && isCount(subCtx.aggregates[exprImpl.Col.ColPos])
+	}
+
+	return false
+}
+
+// flattenMarkSubquery joins a subquery predicate which is not a root
+// expression by a MARK join. Its mark is true, false or null as the
+// predicate, the NOT and ALL forms are the negation of their positive form.
+func (builder *QueryBuilder) flattenMarkSubquery(nodeID, subID int32, subquery *plan.SubqueryRef, joinPreds []*plan.Expr, ctx, subCtx *BindContext) (int32, *plan.Expr, error) {
+	var markList []*plan.Expr
+	var negative bool
+	var err error
+
+	switch subquery.Typ {
+	case plan.SubqueryRef_EXISTS:
+
+	case plan.SubqueryRef_NOT_EXISTS:
+		negative = true
+
+	case plan.SubqueryRef_IN, plan.SubqueryRef_NOT_IN:
+		expr, err := builder.generateComparison("=", subquery.Child, subCtx)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		markList = append(markList, expr)
+		negative = subquery.Typ == plan.SubqueryRef_NOT_IN
+
+	case plan.SubqueryRef_ANY:
+		expr, err := builder.generateComparison(subquery.Op, subquery.Child, subCtx)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		markList = append(markList, expr)
+
+	case plan.SubqueryRef_ALL:
+		expr, err := builder.generateComparison(subquery.Op, subquery.Child, subCtx)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		expr, err = bindFuncExprImplByPlanExpr("not", []*plan.Expr{expr})
+		if err != nil {
+			return 0, nil, err
+		}
+
+		markList = append(markList, expr)
+		negative = true
 
 	default:
 		return 0, nil, errors.New("", fmt.Sprintf("%s subquery not supported", subquery.Typ.String()))
 	}
+
+	markTag := builder.genNewTag()
+
+	nodeID = builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_JOIN,
+		Children:    []int32{nodeID, subID},
+		JoinType:    plan.Node_MARK,
+		OnList:      joinPreds,
+		FilterList:  markList,
+		BindingTags: []int32{markTag},
+	}, ctx)
+
+	expr := &plan.Expr{
+		Typ: &plan.Type{
+			Id:       plan.Type_BOOL,
+			Nullable: true,
+			Size:     1,
+		},
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: markTag,
+				ColPos: 0,
+			},
+		},
+	}
+
+	if negative {
+		expr, err = bindFuncExprImplByPlanExpr("not", []*plan.Expr{expr})
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	return nodeID, expr, nil
+}
+
+// removeLimitOfExists removes the LIMIT of an EXISTS subquery, which
+// doesn't change its result but would stop its correlated predicates
+func (builder *QueryBuilder) removeLimitOfExists(nodeID int32) {
+	for {
+		node := builder.qry.Nodes[nodeID]
+		if node.Limit != nil {
+			if c, ok := node.Limit.Expr.(*plan.Expr_C); ok && node.Offset == nil {
+				if ival, ok := c.C.Value.(*plan.Const_Ival); ok && ival.Ival > 0 {
+					node.Limit = nil
+				}
+			}
+
+			return
+		}
+
+		switch node.NodeType {
+		case plan.Node_PROJECT, plan.Node_SORT, plan.Node_DISTINCT:
+			nodeID = node.Children[0]
+
+		default:
+			return
+		}
+	}
 }
 
 func (builder *QueryBuilder) generateComparison(op string, child *plan.Expr, ctx *BindContext) (*plan.Expr, error) {
@@ -406,11 +746,28 @@ func (builder *QueryBuilder) pullupCorrelatedPredicates(nodeID int32, ctx *BindC
 			return 0, nil, err
 		}
 
+		// the columns of the right side of these joins are not visible above
+		// them, or are null extended
+		if len(subPreds) > 0 && node.NodeType == plan.Node_JOIN && node.JoinType != plan.Node_INNER && (i > 0 || node.JoinType == plan.Node_OUTER || node.JoinType == plan.Node_RIGHT) {
+			return 0, nil, errors.New("", fmt.Sprintf("correlated columns in the %s JOIN of a subquery will be supported in future version", node.JoinType.String()))
+		}
+
 		preds = append(preds, subPreds...)
 	}
 
+	if len(preds) > 0 && (node.Limit != nil || node.Offset != nil) {
+		return 0, nil, errors.New("", "correlated subquery with LIMIT will be supported in future version")
+	}
+
 	switch node.NodeType {
 	case plan.Node_AGG:
+		if len(node.AggList) > 0 {
+			preds, err = builder.pullupDomainThroughAgg(node, preds, ctx)
+			if err != nil {
+				return 0, nil, err
+			}
+		}
+
 		groupTag := node.BindingTags[0]
 		for _, pred := range preds {
 			builder.pullupThroughAgg(ctx, node, groupTag, pred)
@@ -437,11 +794,245 @@ func (builder *QueryBuilder) pullupCorrelatedPredicates(nodeID int32, ctx *BindC
 		} else {
 			node.FilterList = newFilterList
 		}
+
+	case plan.Node_JOIN:
+		var newOnList []*plan.Expr
+		for _, cond := range node.OnList {
+			if !hasCorrCol(cond) {
+				newOnList = append(newOnList, cond)
+			} else if node.JoinType == plan.Node_INNER {
+				preds = append(preds, cond)
+			} else {
+				return 0, nil, errors.New("", fmt.Sprintf("correlated columns in the ON condition of a %s JOIN will be supported in future version", node.JoinType.String()))
+			}
+		}
+
+		node.OnList = newOnList
+
+	case plan.Node_WINDOW, plan.Node_RECURSIVE_CTE, plan.Node_UNION, plan.Node_UNION_ALL, plan.Node_INTERSECT, plan.Node_INTERSECT_ALL, plan.Node_MINUS, plan.Node_MINUS_ALL:
+		if len(preds) > 0 {
+			return 0, nil, errors.New("", fmt.Sprintf("correlated columns below the %s of a subquery will be supported in future version", node.NodeType.String()))
+		}
 	}
 
 	return nodeID, preds, err
 }
 
+// isEquiCorrPred reports whether pred is an equality between an expression
+// of the correlated columns and an expression of the columns of the
+// subquery, which can be pulled up through an aggregation as a group key
+func isEquiCorrPred(pred *plan.Expr) bool {
+	f, ok := pred.Expr.(*plan.Expr_F)
+	if !ok || f.F.Func.ObjName != "=" || len(f.F.Args) != 2 {
+		return false
+	}
+
+	left, right := f.F.Args[0], f.F.Args[1]
+	if hasCorrCol(left) {
+		left, right = right, left
+	}
+
+	return !hasCorrCol(left) && hasCorrCol(right) && !hasColRef(right)
+}
+
+// pullupDomainThroughAgg rewrites the non-equal correlated predicates below
+// an aggregation into a join with the distinct values of the correlated
+// columns, the domain, which is then grouped together with the subquery.
+// The domain is read again from the tables of the outer query, so it may
+// hold more values than the outer rows, which are dropped by the equal
+// predicates returned in place of the rewritten ones.
+func (builder *QueryBuilder) pullupDomainThroughAgg(node *plan.Node, preds []*plan.Expr, ctx *BindContext) ([]*plan.Expr, error) {
+	var newPreds, domainPreds []*plan.Expr
+	for _, pred := range preds {
+		if isEquiCorrPred(pred) {
+			newPreds = append(newPreds, pred)
+		} else {
+			domainPreds = append(domainPreds, pred)
+		}
+	}
+
+	if len(domainPreds) == 0 {
+		return preds, nil
+	}
+
+	var corrCols []*plan.Expr
+	corrPos := make(map[[2]int32]int32)
+	for _, pred := range domainPreds {
+		if !collectCorrCols(pred, corrPos, &corrCols) {
+			return nil, errors.New("", "correlated columns of outer queries more than one level up in the non-equal predicates of an aggregated subquery will be supported in future version")
+		}
+	}
+
+	domainID := int32(-1)
+	scanTags := make(map[int32]int32)
+	for _, corrCol := range corrCols {
+		corr := corrCol.Expr.(*plan.Expr_Corr).Corr
+		if _, ok := scanTags[corr.RelPos]; ok {
+			continue
+		}
+
+		scanNode := builder.findTableScan(corr.RelPos)
+		if scanNode == nil {
+			return nil, errors.New("", "correlated columns of derived tables in the non-equal predicates of an aggregated subquery will be supported in future version")
+		}
+
+		scanTag := builder.genNewTag()
+		scanTags[corr.RelPos] = scanTag
+		for i := range scanNode.TableDef.Cols {
+			builder.nameByColRef[[2]int32{scanTag, int32(i)}] = builder.nameByColRef[[2]int32{corr.RelPos, int32(i)}]
+		}
+
+		scanID := builder.appendNode(&plan.Node{
+			NodeType:    plan.Node_TABLE_SCAN,
+			Cost:        builder.compCtx.Cost(scanNode.ObjRef, nil),
+			ObjRef:      scanNode.ObjRef,
+			TableDef:    scanNode.TableDef,
+			BindingTags: []int32{scanTag},
+		}, ctx)
+
+		if domainID == -1 {
+			domainID = scanID
+		} else {
+			domainID = builder.appendNode(&plan.Node{
+				NodeType: plan.Node_JOIN,
+				Children: []int32{domainID, scanID},
+				JoinType: plan.Node_INNER,
+			}, ctx)
+		}
+	}
+
+	domainTag := builder.genNewTag()
+	groupBy := make([]*plan.Expr, len(corrCols))
+	for i, corrCol := range corrCols {
+		corr := corrCol.Expr.(*plan.Expr_Corr).Corr
+		groupBy[i] = &plan.Expr{
+			Typ: corrCol.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: scanTags[corr.RelPos],
+					ColPos: corr.ColPos,
+				},
+			},
+		}
+		builder.nameByColRef[[2]int32{domainTag, int32(i)}] = builder.nameByColRef[[2]int32{scanTags[corr.RelPos], corr.ColPos}]
+	}
+
+	domainID = builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_AGG,
+		Children:    []int32{domainID},
+		GroupBy:     groupBy,
+		BindingTags: []int32{domainTag, builder.genNewTag()},
+	}, ctx)
+
+	onList := make([]*plan.Expr, len(domainPreds))
+	for i, pred := range domainPreds {
+		onList[i] = replaceCorrCols(pred, corrPos, domainTag)
+	}
+
+	node.Children[0] = builder.appendNode(&plan.Node{
+		NodeType: plan.Node_JOIN,
+		Children: []int32{node.Children[0], domainID},
+		JoinType: plan.Node_INNER,
+		OnList:   onList,
+	}, ctx)
+
+	for i, corrCol := range corrCols {
+		pred, err := bindFuncExprImplByPlanExpr("=", []*plan.Expr{
+			DeepCopyExpr(corrCol),
+			{
+				Typ: corrCol.Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: domainTag,
+						ColPos: int32(i),
+					},
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		newPreds = append(newPreds, pred)
+	}
+
+	return newPreds, nil
+}
+
+func (builder *QueryBuilder) findTableScan(tag int32) *plan.Node {
+	for _, node := range builder.qry.Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN && node.TableDef != nil && len(node.BindingTags) > 0 && node.BindingTags[0] == tag {
+			return node
+		}
+	}
+
+	return nil
+}
+
+// collectCorrCols gathers the distinct correlated columns of expr, and
+// reports false if any of them belongs to an outer query more than one level up
+func collectCorrCols(expr *plan.Expr, corrPos map[[2]int32]int32, corrCols *[]*plan.Expr) bool {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Corr:
+		if exprImpl.Corr.Depth > 1 {
+			return false
+		}
+
+		mapID := [2]int32{exprImpl.Corr.RelPos, exprImpl.Corr.ColPos}
+		if _, ok := corrPos[mapID]; !ok {
+			corrPos[mapID] = int32(len(*corrCols))
+			*corrCols = append(*corrCols, expr)
+		}
+
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			if !collectCorrCols(arg, corrPos, corrCols) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func replaceCorrCols(expr *plan.Expr, corrPos map[[2]int32]int32, tag int32) *plan.Expr {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Corr:
+		return &plan.Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: tag,
+					ColPos: corrPos[[2]int32{exprImpl.Corr.RelPos, exprImpl.Corr.ColPos}],
+				},
+			},
+		}
+
+	case *plan.Expr_F:
+		for i, arg := range exprImpl.F.Args {
+			exprImpl.F.Args[i] = replaceCorrCols(arg, corrPos, tag)
+		}
+	}
+
+	return expr
+}
+
+func hasColRef(expr *plan.Expr) bool {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		return true
+
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			if hasColRef(arg) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (builder *QueryBuilder) pullupThroughAgg(ctx *BindContext, node *plan.Node, tag int32, expr *plan.Expr) *plan.Expr {
 	if !hasCorrCol(expr) {
 		switch expr.Expr.(type) {
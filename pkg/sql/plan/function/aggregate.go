@@ -15,6 +15,7 @@
 package function
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/container/ring/percentile"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/stddevpop"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/variance"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -715,6 +716,233 @@ var aggregates = map[int]Functions{
 			},
 		},
 	},
+	VAR_SAMPLE: {
+		Id: VAR_SAMPLE,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, _ []types.T) {
+			if len(inputs) == 1 {
+				if inputs[0] == types.T_any {
+					return 0, nil
+				}
+				_, err := variance.NewVarSampRingWithTypeCheck(types.Type{Oid: inputs[0]})
+				if err == nil {
+					return 0, nil
+				}
+			}
+			return wrongFunctionParameters, nil
+		},
+		Overloads: []Function{
+			{
+				Index:         0,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				ReturnTyp:     types.T_float64,
+				AggregateInfo: aggregate.VarSamp,
+			},
+		},
+	},
+	STDDEV_SAMPLE: {
+		Id: STDDEV_SAMPLE,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, _ []types.T) {
+			if len(inputs) == 1 {
+				if inputs[0] == types.T_any {
+					return 0, nil
+				}
+				_, err := stddevpop.NewStdDevSampRingWithTypeCheck(types.Type{Oid: inputs[0]})
+				if err == nil {
+					return 0, nil
+				}
+			}
+			return wrongFunctionParameters, nil
+		},
+		Overloads: []Function{
+			{
+				Index:         0,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				ReturnTyp:     types.T_float64,
+				AggregateInfo: aggregate.StdDevSamp,
+			},
+		},
+	},
+	// GROUP_CONCAT(value, separator, directions of the ORDER BY keys...), the
+	// value is the serial_full tuple of the string and its keys if there are any.
+	GROUP_CONCAT: {
+		Id: GROUP_CONCAT,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, _ []types.T) {
+			if len(inputs) < 2 {
+				return wrongFunctionParameters, nil
+			}
+			switch inputs[0] {
+			case types.T_char, types.T_varchar, types.T_text:
+			default:
+				return wrongFunctionParameters, nil
+			}
+			if inputs[1] != types.T_varchar && inputs[1] != types.T_char {
+				return wrongFunctionParameters, nil
+			}
+			for _, t := range inputs[2:] {
+				if t != types.T_int64 {
+					return wrongFunctionParameters, nil
+				}
+			}
+			return 0, nil
+		},
+		Overloads: []Function{
+			{
+				Index:         0,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				ReturnTyp:     types.T_varchar,
+				AggregateInfo: aggregate.GroupConcat,
+			},
+		},
+	},
+	PERCENTILE_CONT: {
+		Id: PERCENTILE_CONT,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, _ []types.T) {
+			if len(inputs) == 2 && inputs[1] == types.T_float64 {
+				if inputs[0] == types.T_any {
+					return 0, nil
+				}
+				_, err := percentile.NewPercentileCont(types.Type{Oid: inputs[0]}, 0)
+				if err == nil {
+					return 0, nil
+				}
+			}
+			return wrongFunctionParameters, nil
+		},
+		Overloads: []Function{
+			{
+				Index:         0,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				ReturnTyp:     types.T_float64,
+				AggregateInfo: aggregate.PercentileCont,
+			},
+		},
+	},
+	PERCENTILE_DISC: {
+		Id: PERCENTILE_DISC,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, _ []types.T) {
+			if len(inputs) == 2 && inputs[1] == types.T_float64 {
+				if inputs[0] == types.T_any {
+					return 0, nil
+				}
+				for i, o := range overloads {
+					if o.Args[0] == inputs[0] {
+						return int32(i), nil
+					}
+				}
+			}
+			return wrongFunctionParameters, nil
+		},
+		Overloads: []Function{
+			{
+				Index:         0,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_uint8, types.T_float64},
+				ReturnTyp:     types.T_uint8,
+				AggregateInfo: aggregate.PercentileDisc,
+			},
+			{
+				Index:         1,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_uint16, types.T_float64},
+				ReturnTyp:     types.T_uint16,
+				AggregateInfo: aggregate.PercentileDisc,
+			},
+			{
+				Index:         2,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_uint32, types.T_float64},
+				ReturnTyp:     types.T_uint32,
+				AggregateInfo: aggregate.PercentileDisc,
+			},
+			{
+				Index:         3,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_uint64, types.T_float64},
+				ReturnTyp:     types.T_uint64,
+				AggregateInfo: aggregate.PercentileDisc,
+			},
+			{
+				Index:         4,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_int8, types.T_float64},
+				ReturnTyp:     types.T_int8,
+				AggregateInfo: aggregate.PercentileDisc,
+			},
+			{
+				Index:         5,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_int16, types.T_float64},
+				ReturnTyp:     types.T_int16,
+				AggregateInfo: aggregate.PercentileDisc,
+			},
+			{
+				Index:         6,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_int32, types.T_float64},
+				ReturnTyp:     types.T_int32,
+				AggregateInfo: aggregate.PercentileDisc,
+			},
+			{
+				Index:         7,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_int64, types.T_float64},
+				ReturnTyp:     types.T_int64,
+				AggregateInfo: aggregate.PercentileDisc,
+			},
+			{
+				Index:         8,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_float32, types.T_float64},
+				ReturnTyp:     types.T_float32,
+				AggregateInfo: aggregate.PercentileDisc,
+			},
+			{
+				Index:         9,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_float64, types.T_float64},
+				ReturnTyp:     types.T_float64,
+				AggregateInfo: aggregate.PercentileDisc,
+			},
+			{
+				Index:         10,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_date, types.T_float64},
+				ReturnTyp:     types.T_date,
+				AggregateInfo: aggregate.PercentileDisc,
+			},
+			{
+				Index:         11,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_datetime, types.T_float64},
+				ReturnTyp:     types.T_datetime,
+				AggregateInfo: aggregate.PercentileDisc,
+			},
+			{
+				Index:         12,
+				Flag:          plan.Function_AGG,
+				Layout:        STANDARD_FUNCTION,
+				Args:          []types.T{types.T_timestamp, types.T_float64},
+				ReturnTyp:     types.T_timestamp,
+				AggregateInfo: aggregate.PercentileDisc,
+			},
+		},
+	},
 	APPROX_COUNT_DISTINCT: {
 		Id: APPROX_COUNT_DISTINCT,
 		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
@@ -76,6 +76,7 @@ func concatWithSomeCols(vectors []*vector.Vector, proc *process.Process) (*vecto
 		for j := range vectors {
 			if nulls.Contains(vectors[j].Nsp, uint64(i)) {
 				nulls.Add(nsp, uint64(i))
+				val.Data = val.Data[:offset]
 				rowLen = 0
 				break
 			}
@@ -0,0 +1,106 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// The ENUM and SET functions are added by the planner, their first argument is
+// the members of the column, see types.JoinEnumMembers.
+
+// CastValueToEnum converts a member name or an ordinal to the ordinal of ENUM
+func CastValueToEnum(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	members := enumMembers(vectors[0])
+	return fixedResult(vectors, types.T_enum.ToType(), proc, func(row int) (uint16, bool, error) {
+		s, n, isNumber, ok, err := enumValue(vectors[1], row)
+		if err != nil || !ok {
+			return 0, false, err
+		}
+		if isNumber {
+			v, err := types.ParseEnumIndex(members, n)
+			return v, true, err
+		}
+		v, err := types.ParseEnum(members, s)
+		return v, true, err
+	})
+}
+
+// CastValueToSet converts a list of member names or a bitmap to the bitmap of SET
+func CastValueToSet(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	members := enumMembers(vectors[0])
+	return fixedResult(vectors, types.T_set.ToType(), proc, func(row int) (uint64, bool, error) {
+		s, n, isNumber, ok, err := enumValue(vectors[1], row)
+		if err != nil || !ok {
+			return 0, false, err
+		}
+		if isNumber {
+			v, err := types.ParseSetIndex(members, n)
+			return v, true, err
+		}
+		v, err := types.ParseSet(members, s)
+		return v, true, err
+	})
+}
+
+// CastEnumToValue returns the member names of ENUM or SET
+func CastEnumToValue(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	members := enumMembers(vectors[0])
+	vec := vectors[1]
+	return bytesResult(vectors, types.T_varchar.ToType(), proc, func(row int) ([]byte, error) {
+		if vec.IsScalarNull() || nulls.Contains(vec.Nsp, uint64(row)) {
+			return nil, nil
+		}
+		if vec.IsScalar() {
+			row = 0
+		}
+		if vec.Typ.Oid == types.T_enum {
+			return []byte(types.EnumString(members, vector.MustTCols[uint16](vec)[row])), nil
+		}
+		return []byte(types.SetString(members, vector.MustTCols[uint64](vec)[row])), nil
+	})
+}
+
+func enumMembers(vec *vector.Vector) []string {
+	return types.SplitEnumMembers(string(vector.MustBytesCols(vec).Get(0)))
+}
+
+// enumValue returns the string or the number of the row, false means NULL
+func enumValue(vec *vector.Vector, row int) (string, uint64, bool, bool, error) {
+	if vec.IsScalarNull() || nulls.Contains(vec.Nsp, uint64(row)) {
+		return "", 0, false, false, nil
+	}
+	if vec.IsScalar() {
+		row = 0
+	}
+	switch vec.Typ.Oid {
+	case types.T_int64:
+		n := vector.MustTCols[int64](vec)[row]
+		if n < 0 {
+			return "", 0, false, false, errors.New(errno.DataException, fmt.Sprintf("Data truncated for value %d", n))
+		}
+		return "", uint64(n), true, true, nil
+	case types.T_uint64:
+		return "", vector.MustTCols[uint64](vec)[row], true, true, nil
+	}
+	return string(vector.MustBytesCols(vec).Get(int64(row))), 0, false, true, nil
+}
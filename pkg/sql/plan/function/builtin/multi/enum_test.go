@@ -0,0 +1,82 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCastValueToEnum(t *testing.T) {
+	proc := testutil.NewProc()
+	members := testutil.MakeScalarVarchar("small,medium,large", 3)
+
+	res, err := CastValueToEnum([]*vector.Vector{members, testutil.MakeVarcharVector([]string{"Large", "", "small"}, []uint64{1})}, proc)
+	require.NoError(t, err)
+	require.Equal(t, types.T_enum, res.Typ.Oid)
+	require.Equal(t, []uint16{3, 0, 1}, res.Col)
+	require.True(t, nulls.Contains(res.Nsp, 1))
+
+	res, err = CastValueToEnum([]*vector.Vector{members, testutil.MakeScalarInt64(2, 3)}, proc)
+	require.NoError(t, err)
+	require.True(t, res.IsScalar())
+	require.Equal(t, []uint16{2}, res.Col)
+
+	_, err = CastValueToEnum([]*vector.Vector{members, testutil.MakeScalarVarchar("huge", 3)}, proc)
+	require.Error(t, err)
+	_, err = CastValueToEnum([]*vector.Vector{members, testutil.MakeScalarInt64(-1, 3)}, proc)
+	require.Error(t, err)
+}
+
+func TestCastValueToSet(t *testing.T) {
+	proc := testutil.NewProc()
+	members := testutil.MakeScalarVarchar("a,b,c", 2)
+
+	res, err := CastValueToSet([]*vector.Vector{members, testutil.MakeVarcharVector([]string{"c,a", ""}, nil)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5, 0}, res.Col)
+
+	res, err = CastValueToSet([]*vector.Vector{members, testutil.MakeUint64Vector([]uint64{7, 2}, nil)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{7, 2}, res.Col)
+
+	_, err = CastValueToSet([]*vector.Vector{members, testutil.MakeScalarUint64(8, 2)}, proc)
+	require.Error(t, err)
+}
+
+func TestCastEnumToValue(t *testing.T) {
+	proc := testutil.NewProc()
+	enums := testutil.MakeUint16Vector([]uint16{2, 0, 1}, []uint64{1})
+	enums.Typ = types.T_enum.ToType()
+	res, err := CastEnumToValue([]*vector.Vector{testutil.MakeScalarVarchar("x,y", 3), enums}, proc)
+	require.NoError(t, err)
+	values := vector.MustBytesCols(res)
+	require.Equal(t, "y", string(values.Get(0)))
+	require.True(t, nulls.Contains(res.Nsp, 1))
+	require.Equal(t, "x", string(values.Get(2)))
+
+	sets := testutil.MakeUint64Vector([]uint64{3, 0}, nil)
+	sets.Typ = types.T_set.ToType()
+	res, err = CastEnumToValue([]*vector.Vector{testutil.MakeScalarVarchar("x,y", 2), sets}, proc)
+	require.NoError(t, err)
+	values = vector.MustBytesCols(res)
+	require.Equal(t, "x,y", string(values.Get(0)))
+	require.Equal(t, "", string(values.Get(1)))
+}
@@ -0,0 +1,384 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// JsonArray returns a json array of its arguments, NULL becomes the json null
+func JsonArray(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return jsonBytesResult(vectors, proc, func(row int) ([]byte, error) {
+		elems := make([]interface{}, len(vectors))
+		for i, vec := range vectors {
+			v, err := jsonValue(vec, row)
+			if err != nil {
+				return nil, err
+			}
+			elems[i] = v
+		}
+		bj, err := types.CreateByteJson(elems)
+		if err != nil {
+			return nil, err
+		}
+		return bj.Encode(), nil
+	})
+}
+
+// JsonObject returns a json object of its key and value pairs, a later key
+// overwrites an earlier one with the same name
+func JsonObject(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return jsonBytesResult(vectors, proc, func(row int) ([]byte, error) {
+		m := make(map[string]interface{}, len(vectors)/2)
+		for i := 0; i < len(vectors); i += 2 {
+			k, err := jsonValue(vectors[i], row)
+			if err != nil {
+				return nil, err
+			}
+			if k == nil {
+				return nil, errors.New(errno.DataException, "JSON documents may not contain NULL member names")
+			}
+			key, err := types.CreateByteJson(k)
+			if err != nil {
+				return nil, err
+			}
+			if m[key.Unquote()], err = jsonValue(vectors[i+1], row); err != nil {
+				return nil, err
+			}
+		}
+		bj, err := types.CreateByteJson(m)
+		if err != nil {
+			return nil, err
+		}
+		return bj.Encode(), nil
+	})
+}
+
+// JsonExtract returns the values of the json document matched by the paths,
+// or NULL if nothing matches
+func JsonExtract(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return jsonBytesResult(vectors, proc, func(row int) ([]byte, error) {
+		doc, ok := jsonDoc(vectors[0], row)
+		if !ok {
+			return nil, nil
+		}
+		paths := make([]*types.JsonPath, len(vectors)-1)
+		for i := range paths {
+			p, ok, err := jsonPath(vectors[i+1], row)
+			if err != nil || !ok {
+				return nil, err
+			}
+			paths[i] = p
+		}
+		res, ok := doc.Extract(paths)
+		if !ok {
+			return nil, nil
+		}
+		return res.Encode(), nil
+	})
+}
+
+// JsonKeys returns the keys of the json object at the optional path as a json
+// array, or NULL if the value is not an object
+func JsonKeys(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return jsonBytesResult(vectors, proc, func(row int) ([]byte, error) {
+		doc, ok, err := jsonDocAtPath(vectors, row)
+		if err != nil || !ok || doc.Type != types.TpCodeObject {
+			return nil, err
+		}
+		return doc.Keys().Encode(), nil
+	})
+}
+
+// JsonUnquote returns the content of a json string, a varchar argument
+// which is not a quoted json string is returned as it is
+func JsonUnquote(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	vec := vectors[0]
+	return bytesResult(vectors, types.T_varchar.ToType(), proc, func(row int) ([]byte, error) {
+		if vec.IsScalarNull() || nulls.Contains(vec.Nsp, uint64(row)) {
+			return nil, nil
+		}
+		if vec.IsScalar() {
+			row = 0
+		}
+		v := vector.MustBytesCols(vec).Get(int64(row))
+		if vec.Typ.Oid == types.T_json {
+			return []byte(types.DecodeByteJson(v).Unquote()), nil
+		}
+		if len(v) < 2 || v[0] != '"' || v[len(v)-1] != '"' {
+			return v, nil
+		}
+		bj, err := types.ParseByteJson(v)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(bj.Unquote()), nil
+	})
+}
+
+// JsonLength returns the length of the json document at the optional path,
+// or NULL if the path matches nothing
+func JsonLength(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return jsonInt64Result(vectors, proc, func(row int) (int64, bool, error) {
+		doc, ok, err := jsonDocAtPath(vectors, row)
+		if err != nil || !ok {
+			return 0, false, err
+		}
+		return int64(doc.Len()), true, nil
+	})
+}
+
+// JsonContains returns 1 if the candidate is contained in the target document
+// at the optional path, or 0 if not
+func JsonContains(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return jsonInt64Result(vectors, proc, func(row int) (int64, bool, error) {
+		target, ok := jsonDoc(vectors[0], row)
+		if !ok {
+			return 0, false, nil
+		}
+		candidate, ok := jsonDoc(vectors[1], row)
+		if !ok {
+			return 0, false, nil
+		}
+		if len(vectors) > 2 {
+			p, ok, err := jsonPath(vectors[2], row)
+			if err != nil || !ok {
+				return 0, false, err
+			}
+			matches := target.Query(p)
+			if len(matches) == 0 {
+				return 0, false, nil
+			}
+			target = matches[0]
+		}
+		if target.Contains(candidate) {
+			return 1, true, nil
+		}
+		return 0, true, nil
+	})
+}
+
+// jsonDoc returns the json document of the row, false means NULL
+func jsonDoc(vec *vector.Vector, row int) (types.ByteJson, bool) {
+	if vec.IsScalarNull() || nulls.Contains(vec.Nsp, uint64(row)) {
+		return types.ByteJson{}, false
+	}
+	if vec.IsScalar() {
+		row = 0
+	}
+	return types.DecodeByteJson(vector.MustBytesCols(vec).Get(int64(row))), true
+}
+
+// jsonPath parses the path of the row, false means NULL
+func jsonPath(vec *vector.Vector, row int) (*types.JsonPath, bool, error) {
+	if vec.IsScalarNull() || nulls.Contains(vec.Nsp, uint64(row)) {
+		return nil, false, nil
+	}
+	if vec.IsScalar() {
+		row = 0
+	}
+	p, err := types.ParseJsonPath(string(vector.MustBytesCols(vec).Get(int64(row))))
+	if err != nil {
+		return nil, false, err
+	}
+	return p, true, nil
+}
+
+// jsonDocAtPath returns the value at the path of the second argument in the
+// document of the first one, or the document itself if there is no path.
+// It fails on a path with wildcards which may match several values.
+func jsonDocAtPath(vectors []*vector.Vector, row int) (types.ByteJson, bool, error) {
+	doc, ok := jsonDoc(vectors[0], row)
+	if !ok || len(vectors) == 1 {
+		return doc, ok, nil
+	}
+	p, ok, err := jsonPath(vectors[1], row)
+	if err != nil || !ok {
+		return doc, false, err
+	}
+	if p.HasWildcard() {
+		return doc, false, errors.New(errno.DataException, "In this situation, path expressions may not contain the * and ** tokens")
+	}
+	matches := doc.Query(p)
+	if len(matches) == 0 {
+		return doc, false, nil
+	}
+	return matches[0], true, nil
+}
+
+// jsonValue returns the value of the row as a value of a json document
+func jsonValue(vec *vector.Vector, row int) (interface{}, error) {
+	if vec.IsScalarNull() || nulls.Contains(vec.Nsp, uint64(row)) {
+		return nil, nil
+	}
+	if vec.IsScalar() {
+		row = 0
+	}
+	switch vec.Typ.Oid {
+	case types.T_bool:
+		return vector.MustTCols[bool](vec)[row], nil
+	case types.T_int8:
+		return int64(vector.MustTCols[int8](vec)[row]), nil
+	case types.T_int16:
+		return int64(vector.MustTCols[int16](vec)[row]), nil
+	case types.T_int32:
+		return int64(vector.MustTCols[int32](vec)[row]), nil
+	case types.T_int64:
+		return vector.MustTCols[int64](vec)[row], nil
+	case types.T_uint8:
+		return uint64(vector.MustTCols[uint8](vec)[row]), nil
+	case types.T_uint16:
+		return uint64(vector.MustTCols[uint16](vec)[row]), nil
+	case types.T_uint32:
+		return uint64(vector.MustTCols[uint32](vec)[row]), nil
+	case types.T_uint64:
+		return vector.MustTCols[uint64](vec)[row], nil
+	case types.T_float32:
+		return float64(vector.MustTCols[float32](vec)[row]), nil
+	case types.T_float64:
+		return vector.MustTCols[float64](vec)[row], nil
+	case types.T_decimal64:
+		return json.Number(vector.MustTCols[types.Decimal64](vec)[row].ToStringWithScale(vec.Typ.Scale)), nil
+	case types.T_decimal128:
+		return json.Number(vector.MustTCols[types.Decimal128](vec)[row].ToStringWithScale(vec.Typ.Scale)), nil
+	case types.T_date:
+		return vector.MustTCols[types.Date](vec)[row].String(), nil
+	case types.T_datetime:
+		return vector.MustTCols[types.Datetime](vec)[row].String2(vec.Typ.Precision), nil
+	case types.T_timestamp:
+		return vector.MustTCols[types.Timestamp](vec)[row].String2(vec.Typ.Precision), nil
+	case types.T_json:
+		return types.DecodeByteJson(vector.MustBytesCols(vec).Get(int64(row))), nil
+	}
+	if vec.Typ.IsString() {
+		return string(vector.MustBytesCols(vec).Get(int64(row))), nil
+	}
+	return nil, errors.New(errno.DatatypeMismatch, fmt.Sprintf("type %s can not be a value of json", vec.Typ))
+}
+
+// rowCount returns the number of result rows and whether all the arguments are scalars
+func rowCount(vectors []*vector.Vector) (int, bool) {
+	for _, vec := range vectors {
+		if !vec.IsScalar() {
+			return vector.Length(vec), false
+		}
+	}
+	return 1, true
+}
+
+// jsonBytesResult builds the json result vector from the value of each row,
+// a nil value is NULL
+func jsonBytesResult(vectors []*vector.Vector, proc *process.Process, fn func(row int) ([]byte, error)) (*vector.Vector, error) {
+	return bytesResult(vectors, types.T_json.ToType(), proc, fn)
+}
+
+func bytesResult(vectors []*vector.Vector, resultType types.Type, proc *process.Process, fn func(row int) ([]byte, error)) (*vector.Vector, error) {
+	count, scalar := rowCount(vectors)
+	resultValues := &types.Bytes{
+		Offsets: make([]uint32, count),
+		Lengths: make([]uint32, count),
+	}
+	resultNsp := new(nulls.Nulls)
+	for i := 0; i < count; i++ {
+		v, err := fn(i)
+		if err != nil {
+			return nil, err
+		}
+		resultValues.Offsets[i] = uint32(len(resultValues.Data))
+		if v == nil {
+			nulls.Add(resultNsp, uint64(i))
+			continue
+		}
+		resultValues.Data = append(resultValues.Data, v...)
+		resultValues.Lengths[i] = uint32(len(v))
+	}
+	return bytesVector(resultValues, resultNsp, resultType, scalar, proc)
+}
+
+// bytesVector moves the values and nulls of the result rows into a new vector
+func bytesVector(resultValues *types.Bytes, resultNsp *nulls.Nulls, resultType types.Type, scalar bool, proc *process.Process) (*vector.Vector, error) {
+	if scalar {
+		if nulls.Contains(resultNsp, 0) {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		resultVector := proc.AllocScalarVector(resultType)
+		vector.SetCol(resultVector, resultValues)
+		return resultVector, nil
+	}
+	resultVector, err := proc.AllocVector(resultType, int64(len(resultValues.Data)))
+	if err != nil {
+		return nil, err
+	}
+	copy(resultVector.Data, resultValues.Data)
+	resultValues.Data = resultVector.Data[:len(resultValues.Data)]
+	nulls.Set(resultVector.Nsp, resultNsp)
+	vector.SetCol(resultVector, resultValues)
+	return resultVector, nil
+}
+
+// jsonInt64Result builds the int64 result vector from the value of each row,
+// false means NULL
+func jsonInt64Result(vectors []*vector.Vector, proc *process.Process, fn func(row int) (int64, bool, error)) (*vector.Vector, error) {
+	return fixedResult(vectors, types.T_int64.ToType(), proc, fn)
+}
+
+func fixedResult[T any](vectors []*vector.Vector, resultType types.Type, proc *process.Process, fn func(row int) (T, bool, error)) (*vector.Vector, error) {
+	count, scalar := rowCount(vectors)
+	resultValues := make([]T, count)
+	resultNsp := new(nulls.Nulls)
+	for i := 0; i < count; i++ {
+		v, ok, err := fn(i)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			nulls.Add(resultNsp, uint64(i))
+			continue
+		}
+		resultValues[i] = v
+	}
+	return fixedVector(resultValues, resultNsp, resultType, scalar, proc)
+}
+
+// fixedVector moves the values and nulls of the result rows into a new vector
+func fixedVector[T any](resultValues []T, resultNsp *nulls.Nulls, resultType types.Type, scalar bool, proc *process.Process) (*vector.Vector, error) {
+	count := len(resultValues)
+	if scalar {
+		if nulls.Contains(resultNsp, 0) {
+			return proc.AllocScalarNullVector(resultType), nil
+		}
+		resultVector := proc.AllocScalarVector(resultType)
+		vector.SetCol(resultVector, resultValues)
+		return resultVector, nil
+	}
+	resultVector, err := proc.AllocVector(resultType, int64(count*int(resultType.Size)))
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeFixedSlice[T](resultVector.Data, int(resultType.Size))[:count]
+	copy(rs, resultValues)
+	nulls.Set(resultVector.Nsp, resultNsp)
+	vector.SetCol(resultVector, rs)
+	return resultVector, nil
+}
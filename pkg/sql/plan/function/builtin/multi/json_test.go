@@ -0,0 +1,146 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func makeJsonVector(t *testing.T, texts []string, nsp []uint64) *vector.Vector {
+	values := make([]string, len(texts))
+	for i, s := range texts {
+		if s == "" {
+			continue
+		}
+		bj, err := types.ParseByteJson([]byte(s))
+		require.NoError(t, err)
+		values[i] = string(bj.Encode())
+	}
+	vec := testutil.MakeVarcharVector(values, nsp)
+	vec.Typ = types.T_json.ToType()
+	return vec
+}
+
+// jsonTexts returns the text of the json results, NULL is returned as an empty string
+func jsonTexts(vec *vector.Vector) []string {
+	values := vector.MustBytesCols(vec)
+	texts := make([]string, len(values.Offsets))
+	for i := range texts {
+		if !nulls.Contains(vec.Nsp, uint64(i)) {
+			texts[i] = types.DecodeByteJson(values.Get(int64(i))).String()
+		}
+	}
+	return texts
+}
+
+func TestJsonExtract(t *testing.T) {
+	proc := testutil.NewProc()
+	doc := makeJsonVector(t, []string{`{"a": [1, 2], "b": "x"}`, `{"a": 3}`, ``, `[1]`}, []uint64{2})
+
+	res, err := JsonExtract([]*vector.Vector{doc, testutil.MakeScalarVarchar("$.a", 4)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{`[1, 2]`, `3`, ``, ``}, jsonTexts(res))
+	require.True(t, nulls.Contains(res.Nsp, 3))
+
+	res, err = JsonExtract([]*vector.Vector{doc, testutil.MakeVarcharVector([]string{"$.a[*]", "$.*", "$", "$[0]"}, nil), testutil.MakeScalarVarchar("$.b", 4)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{`[1, 2, "x"]`, `[3]`, ``, `[1]`}, jsonTexts(res))
+
+	_, err = JsonExtract([]*vector.Vector{doc, testutil.MakeScalarVarchar("a", 4)}, proc)
+	require.Error(t, err)
+}
+
+func TestJsonObjectAndArray(t *testing.T) {
+	proc := testutil.NewProc()
+	doc := makeJsonVector(t, []string{`[1]`, `{"x": null}`}, nil)
+	ints := testutil.MakeInt64Vector([]int64{1, 2}, []uint64{1})
+
+	res, err := JsonObject([]*vector.Vector{testutil.MakeScalarVarchar("b", 2), ints, testutil.MakeScalarInt64(7, 2), doc}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{`{"7": [1], "b": 1}`, `{"7": {"x": null}, "b": null}`}, jsonTexts(res))
+
+	res, err = JsonArray([]*vector.Vector{ints, testutil.MakeScalarVarchar("s", 2), doc, testutil.MakeScalarNull(2)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{`[1, "s", [1], null]`, `[null, "s", {"x": null}, null]`}, jsonTexts(res))
+
+	res, err = JsonArray([]*vector.Vector{testutil.MakeScalarFloat64(1.5, 1)}, proc)
+	require.NoError(t, err)
+	require.True(t, res.IsScalar())
+	require.Equal(t, []string{`[1.5]`}, jsonTexts(res))
+
+	_, err = JsonObject([]*vector.Vector{testutil.MakeScalarNull(1), testutil.MakeScalarInt64(1, 1)}, proc)
+	require.Error(t, err)
+}
+
+func TestJsonKeysLengthContains(t *testing.T) {
+	proc := testutil.NewProc()
+	doc := makeJsonVector(t, []string{`{"a": [1, 2], "bb": {"c": 1}}`, `[1, [2, 3]]`, `"x"`}, nil)
+
+	res, err := JsonKeys([]*vector.Vector{doc}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{`["a", "bb"]`, ``, ``}, jsonTexts(res))
+
+	res, err = JsonKeys([]*vector.Vector{doc, testutil.MakeScalarVarchar("$.bb", 3)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{`["c"]`, ``, ``}, jsonTexts(res))
+
+	res, err = JsonLength([]*vector.Vector{doc}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 2, 1}, res.Col)
+
+	res, err = JsonLength([]*vector.Vector{doc, testutil.MakeScalarVarchar("$[1]", 3)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{0, 2, 0}, res.Col)
+	require.True(t, nulls.Contains(res.Nsp, 0))
+	require.True(t, nulls.Contains(res.Nsp, 2))
+
+	_, err = JsonLength([]*vector.Vector{doc, testutil.MakeScalarVarchar("$[*]", 3)}, proc)
+	require.Error(t, err)
+
+	candidate := makeJsonVector(t, []string{`[2]`, `3`, `"x"`}, nil)
+	res, err = JsonContains([]*vector.Vector{doc, candidate}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{0, 1, 1}, res.Col)
+
+	res, err = JsonContains([]*vector.Vector{doc, candidate, testutil.MakeScalarVarchar("$.a", 3)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 0, 0}, res.Col)
+	require.True(t, nulls.Contains(res.Nsp, 1))
+}
+
+func TestJsonUnquote(t *testing.T) {
+	proc := testutil.NewProc()
+	doc := makeJsonVector(t, []string{`"a\tb"`, `[1, "x"]`, ``}, []uint64{2})
+	res, err := JsonUnquote([]*vector.Vector{doc}, proc)
+	require.NoError(t, err)
+	require.Equal(t, types.T_varchar, res.Typ.Oid)
+	values := vector.MustBytesCols(res)
+	require.Equal(t, "a\tb", string(values.Get(0)))
+	require.Equal(t, `[1, "x"]`, string(values.Get(1)))
+	require.True(t, nulls.Contains(res.Nsp, 2))
+
+	res, err = JsonUnquote([]*vector.Vector{testutil.MakeVarcharVector([]string{`"Ab"`, `abc`, `"`}, nil)}, proc)
+	require.NoError(t, err)
+	values = vector.MustBytesCols(res)
+	require.Equal(t, "Ab", string(values.Get(0)))
+	require.Equal(t, "abc", string(values.Get(1)))
+	require.Equal(t, `"`, string(values.Get(2)))
+}
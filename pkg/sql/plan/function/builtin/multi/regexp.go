@@ -0,0 +1,408 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// The regular expression functions take the optional match type of MySQL,
+// whose flags are c for case sensitive matching, i for case insensitive
+// matching, m for the multiple line mode, n to let . match the line
+// terminators and u for the unix line endings, which are the only ones here.
+// The matching is case sensitive by default as the strings are compared by
+// their bytes. The positions and the occurrences count from 1, and the
+// positions are in characters.
+
+// RegexpLike returns whether the string matches the pattern, which is also
+// the REGEXP and RLIKE operators.
+// regexp_like(expr, pattern[, match_type])
+func RegexpLike(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	patterns, err := newRegexpPatterns(vectors[1], regexpArg(vectors, 2))
+	if err != nil {
+		return nil, err
+	}
+	return fixedResult(vectors, types.T_bool.ToType(), proc, func(row int) (bool, bool, error) {
+		s, ok := regexpString(vectors[0], row)
+		if !ok {
+			return false, false, nil
+		}
+		re, ok, err := patterns.get(row)
+		if err != nil || !ok {
+			return false, false, err
+		}
+		return re.Match(s), true, nil
+	})
+}
+
+// RegexpInstr returns the position of the occurrence of the pattern in the
+// string, or the position after it if the return option is 1, 0 if there is
+// no such occurrence.
+// regexp_instr(expr, pattern[, pos[, occurrence[, return_option[, match_type]]]])
+func RegexpInstr(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	patterns, err := newRegexpPatterns(vectors[1], regexpArg(vectors, 5))
+	if err != nil {
+		return nil, err
+	}
+	return fixedResult(vectors, types.T_int64.ToType(), proc, func(row int) (int64, bool, error) {
+		s, ok := regexpString(vectors[0], row)
+		if !ok {
+			return 0, false, nil
+		}
+		pos, occurrence, returnOption := int64(1), int64(1), int64(0)
+		if !regexpInt(regexpArg(vectors, 2), row, &pos) ||
+			!regexpInt(regexpArg(vectors, 3), row, &occurrence) ||
+			!regexpInt(regexpArg(vectors, 4), row, &returnOption) {
+			return 0, false, nil
+		}
+		if returnOption != 0 && returnOption != 1 {
+			return 0, false, moerr.NewError(moerr.INVALID_ARGUMENT, "Incorrect arguments to regexp_instr: return_option must be 1 or 0.")
+		}
+		re, ok, err := patterns.get(row)
+		if err != nil || !ok {
+			return 0, false, err
+		}
+		start, err := regexpStart(s, pos)
+		if err != nil {
+			return 0, false, err
+		}
+		loc := regexpFind(re, s[start:], occurrence)
+		if loc == nil {
+			return 0, true, nil
+		}
+		return int64(utf8.RuneCount(s[:start+loc[returnOption]])) + 1, true, nil
+	})
+}
+
+// RegexpSubstr returns the occurrence of the pattern in the string, NULL if
+// there is no such occurrence.
+// regexp_substr(expr, pattern[, pos[, occurrence[, match_type]]])
+func RegexpSubstr(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	patterns, err := newRegexpPatterns(vectors[1], regexpArg(vectors, 4))
+	if err != nil {
+		return nil, err
+	}
+	return bytesResult(vectors, types.T_varchar.ToType(), proc, func(row int) ([]byte, error) {
+		s, ok := regexpString(vectors[0], row)
+		if !ok {
+			return nil, nil
+		}
+		pos, occurrence := int64(1), int64(1)
+		if !regexpInt(regexpArg(vectors, 2), row, &pos) || !regexpInt(regexpArg(vectors, 3), row, &occurrence) {
+			return nil, nil
+		}
+		re, ok, err := patterns.get(row)
+		if err != nil || !ok {
+			return nil, err
+		}
+		start, err := regexpStart(s, pos)
+		if err != nil {
+			return nil, err
+		}
+		loc := regexpFind(re, s[start:], occurrence)
+		if loc == nil {
+			return nil, nil
+		}
+		return append([]byte{}, s[start+loc[0]:start+loc[1]]...), nil
+	})
+}
+
+// RegexpReplace replaces the occurrence of the pattern in the string, or all
+// of them if the occurrence is 0, by the replacement, where $n is the text of
+// the nth group and \ escapes the next character.
+// regexp_replace(expr, pattern, replacement[, pos[, occurrence[, match_type]]])
+func RegexpReplace(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	patterns, err := newRegexpPatterns(vectors[1], regexpArg(vectors, 5))
+	if err != nil {
+		return nil, err
+	}
+	return bytesResult(vectors, types.T_varchar.ToType(), proc, func(row int) ([]byte, error) {
+		s, ok := regexpString(vectors[0], row)
+		if !ok {
+			return nil, nil
+		}
+		replacement, ok := regexpString(vectors[2], row)
+		if !ok {
+			return nil, nil
+		}
+		pos, occurrence := int64(1), int64(0)
+		if !regexpInt(regexpArg(vectors, 3), row, &pos) || !regexpInt(regexpArg(vectors, 4), row, &occurrence) {
+			return nil, nil
+		}
+		re, ok, err := patterns.get(row)
+		if err != nil || !ok {
+			return nil, err
+		}
+		start, err := regexpStart(s, pos)
+		if err != nil {
+			return nil, err
+		}
+		template := regexpTemplate(replacement)
+		src := s[start:]
+		result := append([]byte{}, s[:start]...)
+		if occurrence <= 0 {
+			return append(result, re.ReplaceAll(src, template)...), nil
+		}
+		matches := re.FindAllSubmatchIndex(src, int(occurrence))
+		if int64(len(matches)) < occurrence {
+			return append(result, src...), nil
+		}
+		match := matches[occurrence-1]
+		result = append(result, src[:match[0]]...)
+		result = re.Expand(result, template, src, match)
+		return append(result, src[match[1]:]...), nil
+	})
+}
+
+// regexpPatterns compiles the pattern of each row with its match type, the
+// pattern given by constant arguments is compiled only once, through the
+// cache shared by all the calls, when the patterns are made.
+type regexpPatterns struct {
+	pattern, matchType *vector.Vector
+	constant           bool
+
+	// the last compiled expression, which is often the one of the next row
+	expr string
+	re   *regexp.Regexp
+}
+
+func newRegexpPatterns(pattern, matchType *vector.Vector) (*regexpPatterns, error) {
+	p := &regexpPatterns{
+		pattern:   pattern,
+		matchType: matchType,
+		constant:  pattern.IsScalar() && (matchType == nil || matchType.IsScalar()),
+	}
+	if p.constant {
+		if _, _, err := p.compile(0); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// get returns the compiled pattern of the row, false means NULL
+func (p *regexpPatterns) get(row int) (*regexp.Regexp, bool, error) {
+	if p.constant {
+		return p.re, p.re != nil, nil
+	}
+	return p.compile(row)
+}
+
+func (p *regexpPatterns) compile(row int) (*regexp.Regexp, bool, error) {
+	pattern, ok := regexpString(p.pattern, row)
+	if !ok {
+		return nil, false, nil
+	}
+	var matchType []byte
+	if p.matchType != nil {
+		if matchType, ok = regexpString(p.matchType, row); !ok {
+			return nil, false, nil
+		}
+	}
+	expr, err := regexpExpr(pattern, matchType)
+	if err != nil {
+		return nil, false, err
+	}
+	if p.re != nil && p.expr == expr {
+		return p.re, true, nil
+	}
+	var re *regexp.Regexp
+	if p.constant {
+		re, err = compileCachedRegexp(expr)
+	} else {
+		re, err = compileRegexp(expr)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	p.expr, p.re = expr, re
+	return re, true, nil
+}
+
+// regexpCacheSize is the number of the compiled patterns kept by the cache,
+// which is emptied once it is full.
+const regexpCacheSize = 1024
+
+var regexpCache = struct {
+	sync.Mutex
+	res map[string]*regexp.Regexp
+}{
+	res: make(map[string]*regexp.Regexp),
+}
+
+func compileCachedRegexp(expr string) (*regexp.Regexp, error) {
+	regexpCache.Lock()
+	re, ok := regexpCache.res[expr]
+	regexpCache.Unlock()
+	if ok {
+		return re, nil
+	}
+	re, err := compileRegexp(expr)
+	if err != nil {
+		return nil, err
+	}
+	regexpCache.Lock()
+	if len(regexpCache.res) >= regexpCacheSize {
+		regexpCache.res = make(map[string]*regexp.Regexp)
+	}
+	regexpCache.res[expr] = re
+	regexpCache.Unlock()
+	return re, nil
+}
+
+func compileRegexp(expr string) (*regexp.Regexp, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, moerr.NewError(moerr.INVALID_ARGUMENT, fmt.Sprintf("invalid regular expression: %v", err))
+	}
+	return re, nil
+}
+
+// regexpExpr returns the expression of the pattern with the flags of the match type
+func regexpExpr(pattern, matchType []byte) (string, error) {
+	caseInsensitive, multiLine, dotAll := false, false, false
+	for _, c := range matchType {
+		switch c {
+		case 'c':
+			caseInsensitive = false
+		case 'i':
+			caseInsensitive = true
+		case 'm':
+			multiLine = true
+		case 'n':
+			dotAll = true
+		case 'u':
+		default:
+			return "", moerr.NewError(moerr.INVALID_ARGUMENT, "Incorrect arguments to regexp: invalid match_type")
+		}
+	}
+	flags := ""
+	if caseInsensitive {
+		flags += "i"
+	}
+	if multiLine {
+		flags += "m"
+	}
+	if dotAll {
+		flags += "s"
+	}
+	if flags == "" {
+		return string(pattern), nil
+	}
+	return "(?" + flags + ")" + string(pattern), nil
+}
+
+// regexpTemplate converts the replacement of MySQL to the template of Expand,
+// $n is the nth group and \ escapes the next character.
+func regexpTemplate(replacement []byte) []byte {
+	template := make([]byte, 0, len(replacement))
+	for i := 0; i < len(replacement); i++ {
+		switch c := replacement[i]; {
+		case c == '\\' && i+1 < len(replacement):
+			i++
+			if replacement[i] == '$' {
+				template = append(template, '$', '$')
+			} else {
+				template = append(template, replacement[i])
+			}
+		case c == '$':
+			j := i + 1
+			for j < len(replacement) && replacement[j] >= '0' && replacement[j] <= '9' {
+				j++
+			}
+			if j == i+1 {
+				template = append(template, '$', '$')
+				continue
+			}
+			template = append(template, "${"...)
+			template = append(template, replacement[i+1:j]...)
+			template = append(template, '}')
+			i = j - 1
+		default:
+			template = append(template, c)
+		}
+	}
+	return template
+}
+
+// regexpStart returns the offset of the character at the position, which is
+// at most the one after the last character.
+func regexpStart(s []byte, pos int64) (int, error) {
+	if pos < 1 || pos > int64(utf8.RuneCount(s))+1 {
+		return 0, moerr.NewError(moerr.INVALID_ARGUMENT, "Index out of bounds in regular expression search.")
+	}
+	start := 0
+	for ; pos > 1; pos-- {
+		_, size := utf8.DecodeRune(s[start:])
+		start += size
+	}
+	return start, nil
+}
+
+// regexpFind returns the location of the occurrence of the pattern, nil if
+// there is no such occurrence.
+func regexpFind(re *regexp.Regexp, s []byte, occurrence int64) []int {
+	if occurrence < 1 {
+		occurrence = 1
+	}
+	locs := re.FindAllIndex(s, int(occurrence))
+	if int64(len(locs)) < occurrence {
+		return nil
+	}
+	return locs[occurrence-1]
+}
+
+// regexpArg returns the optional argument, nil if it is not given
+func regexpArg(vectors []*vector.Vector, i int) *vector.Vector {
+	if i < len(vectors) {
+		return vectors[i]
+	}
+	return nil
+}
+
+// regexpString returns the string of the row, false means NULL
+func regexpString(vec *vector.Vector, row int) ([]byte, bool) {
+	if vec.IsScalarNull() || nulls.Contains(vec.Nsp, uint64(row)) {
+		return nil, false
+	}
+	if vec.IsScalar() {
+		row = 0
+	}
+	return vector.MustBytesCols(vec).Get(int64(row)), true
+}
+
+// regexpInt sets v to the integer of the row if the argument is given, false means NULL
+func regexpInt(vec *vector.Vector, row int, v *int64) bool {
+	if vec == nil {
+		return true
+	}
+	if vec.IsScalarNull() || nulls.Contains(vec.Nsp, uint64(row)) {
+		return false
+	}
+	if vec.IsScalar() {
+		row = 0
+	}
+	*v = vector.MustTCols[int64](vec)[row]
+	return true
+}
@@ -0,0 +1,132 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegexpLike(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeVarcharVector([]string{"Error: disk", "warn", "ERROR: net", ""}, []uint64{3})
+
+	res, err := RegexpLike([]*vector.Vector{strs, testutil.MakeScalarVarchar("^error", 4)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []bool{false, false, false, false}, res.Col)
+	require.True(t, nulls.Contains(res.Nsp, 3))
+
+	res, err = RegexpLike([]*vector.Vector{strs, testutil.MakeScalarVarchar("^error", 4), testutil.MakeScalarVarchar("ci", 4)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, false, true, false}, res.Col)
+
+	// the patterns of the rows
+	patterns := testutil.MakeVarcharVector([]string{"disk$", "^w", "net", "x"}, nil)
+	res, err = RegexpLike([]*vector.Vector{strs, patterns}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []bool{true, true, true, false}, res.Col)
+
+	_, err = RegexpLike([]*vector.Vector{strs, testutil.MakeScalarVarchar("(", 4)}, proc)
+	require.Error(t, err)
+	_, err = RegexpLike([]*vector.Vector{strs, testutil.MakeScalarVarchar("a", 4), testutil.MakeScalarVarchar("x", 4)}, proc)
+	require.Error(t, err)
+
+	res, err = RegexpLike([]*vector.Vector{strs, testutil.MakeScalarNull(4)}, proc)
+	require.NoError(t, err)
+	require.True(t, nulls.Contains(res.Nsp, 0))
+}
+
+func TestRegexpPatterns(t *testing.T) {
+	// the constant pattern is compiled when the patterns are made
+	p, err := newRegexpPatterns(testutil.MakeScalarVarchar("^a", 4), testutil.MakeScalarVarchar("i", 4))
+	require.NoError(t, err)
+	re, ok, err := p.get(0)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, re.MatchString("Ab"))
+	re2, _, _ := p.get(3)
+	require.Same(t, re, re2)
+
+	p, err = newRegexpPatterns(testutil.MakeScalarNull(4), nil)
+	require.NoError(t, err)
+	_, ok, err = p.get(0)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestRegexpInstr(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeVarcharVector([]string{"dog cat dog", "猫 dog", "cat"}, nil)
+	pattern := testutil.MakeScalarVarchar("dog", 3)
+
+	res, err := RegexpInstr([]*vector.Vector{strs, pattern}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 3, 0}, res.Col)
+
+	res, err = RegexpInstr([]*vector.Vector{strs, pattern, testutil.MakeScalarInt64(2, 3)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{9, 3, 0}, res.Col)
+
+	res, err = RegexpInstr([]*vector.Vector{strs, pattern, testutil.MakeScalarInt64(1, 3), testutil.MakeScalarInt64(1, 3), testutil.MakeScalarInt64(1, 3)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 6, 0}, res.Col)
+
+	_, err = RegexpInstr([]*vector.Vector{strs, pattern, testutil.MakeScalarInt64(1, 3), testutil.MakeScalarInt64(1, 3), testutil.MakeScalarInt64(2, 3)}, proc)
+	require.Error(t, err)
+	_, err = RegexpInstr([]*vector.Vector{strs, pattern, testutil.MakeScalarInt64(5, 3)}, proc)
+	require.Error(t, err)
+}
+
+func TestRegexpSubstr(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeVarcharVector([]string{"code=404 code=500", "no code"}, nil)
+
+	res, err := RegexpSubstr([]*vector.Vector{strs, testutil.MakeScalarVarchar("[0-9]+", 2), testutil.MakeScalarInt64(1, 2), testutil.MakeScalarInt64(2, 2)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, "500", string(vector.MustBytesCols(res).Get(0)))
+	require.True(t, nulls.Contains(res.Nsp, 1))
+
+	res, err = RegexpSubstr([]*vector.Vector{testutil.MakeScalarVarchar("abc", 1), testutil.MakeScalarVarchar("x*", 1)}, proc)
+	require.NoError(t, err)
+	require.False(t, res.IsScalarNull())
+	require.Equal(t, "", string(vector.MustBytesCols(res).Get(0)))
+}
+
+func TestRegexpReplace(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeVarcharVector([]string{"a1b22c333", "xyz"}, nil)
+	pattern := testutil.MakeScalarVarchar("([0-9])+", 2)
+
+	cases := []struct {
+		args     []*vector.Vector
+		expected []string
+	}{
+		{[]*vector.Vector{testutil.MakeScalarVarchar("#", 2)}, []string{"a#b#c#", "xyz"}},
+		{[]*vector.Vector{testutil.MakeScalarVarchar("<$1>", 2), testutil.MakeScalarInt64(3, 2)}, []string{"a1b<2>c<3>", "xyz"}},
+		{[]*vector.Vector{testutil.MakeScalarVarchar(`\$1$`, 2), testutil.MakeScalarInt64(1, 2), testutil.MakeScalarInt64(2, 2)}, []string{"a1b$1$c333", "xyz"}},
+	}
+	for _, c := range cases {
+		res, err := RegexpReplace(append([]*vector.Vector{strs, pattern}, c.args...), proc)
+		require.NoError(t, err)
+		values := vector.MustBytesCols(res)
+		for i, expected := range c.expected {
+			require.Equal(t, expected, string(values.Get(int64(i))))
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Serial packs its arguments into one value by the comparable encoding, so
+// that the tuples are compared and hashed as strings, e.g. by COUNT(DISTINCT a, b).
+// The result is NULL if any of the arguments is NULL.
+func Serial(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return serial(vectors, false, proc)
+}
+
+// SerialFull is Serial keeping the NULL arguments in the tuple.
+func SerialFull(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return serial(vectors, true, proc)
+}
+
+func serial(vectors []*vector.Vector, full bool, proc *process.Process) (*vector.Vector, error) {
+	return bytesResult(vectors, types.T_varchar.ToType(), proc, func(row int) ([]byte, error) {
+		tuple := make([]byte, 0, 16)
+		for _, vec := range vectors {
+			i := row
+			if vec.IsScalar() {
+				i = 0
+			}
+			if vec.IsScalarNull() || nulls.Contains(vec.Nsp, uint64(i)) {
+				if !full {
+					return nil, nil
+				}
+				tuple = encoding.EncodeComparableNull(tuple)
+				continue
+			}
+			var err error
+			if tuple, err = appendComparable(tuple, vec, i); err != nil {
+				return nil, err
+			}
+		}
+		return tuple, nil
+	})
+}
+
+func appendComparable(tuple []byte, vec *vector.Vector, i int) ([]byte, error) {
+	switch vec.Typ.Oid {
+	case types.T_bool:
+		if vector.MustTCols[bool](vec)[i] {
+			return encoding.EncodeComparableUint64(tuple, 1), nil
+		}
+		return encoding.EncodeComparableUint64(tuple, 0), nil
+	case types.T_int8:
+		return encoding.EncodeComparableInt64(tuple, int64(vector.MustTCols[int8](vec)[i])), nil
+	case types.T_int16:
+		return encoding.EncodeComparableInt64(tuple, int64(vector.MustTCols[int16](vec)[i])), nil
+	case types.T_int32:
+		return encoding.EncodeComparableInt64(tuple, int64(vector.MustTCols[int32](vec)[i])), nil
+	case types.T_int64:
+		return encoding.EncodeComparableInt64(tuple, vector.MustTCols[int64](vec)[i]), nil
+	case types.T_uint8:
+		return encoding.EncodeComparableUint64(tuple, uint64(vector.MustTCols[uint8](vec)[i])), nil
+	case types.T_uint16:
+		return encoding.EncodeComparableUint64(tuple, uint64(vector.MustTCols[uint16](vec)[i])), nil
+	case types.T_uint32:
+		return encoding.EncodeComparableUint64(tuple, uint64(vector.MustTCols[uint32](vec)[i])), nil
+	case types.T_uint64:
+		return encoding.EncodeComparableUint64(tuple, vector.MustTCols[uint64](vec)[i]), nil
+	case types.T_float32:
+		return encoding.EncodeComparableFloat64(tuple, float64(vector.MustTCols[float32](vec)[i])), nil
+	case types.T_float64:
+		return encoding.EncodeComparableFloat64(tuple, vector.MustTCols[float64](vec)[i]), nil
+	case types.T_date:
+		return encoding.EncodeComparableInt64(tuple, int64(vector.MustTCols[types.Date](vec)[i])), nil
+	case types.T_datetime:
+		return encoding.EncodeComparableInt64(tuple, int64(vector.MustTCols[types.Datetime](vec)[i])), nil
+	case types.T_timestamp:
+		return encoding.EncodeComparableInt64(tuple, int64(vector.MustTCols[types.Timestamp](vec)[i])), nil
+	case types.T_decimal64:
+		return encoding.EncodeComparableDecimal(tuple, vector.MustTCols[types.Decimal64](vec)[i].ToStringWithScale(vec.Typ.Scale)), nil
+	case types.T_decimal128:
+		return encoding.EncodeComparableDecimal(tuple, vector.MustTCols[types.Decimal128](vec)[i].ToStringWithScale(vec.Typ.Scale)), nil
+	case types.T_uuid:
+		return encoding.EncodeComparableFixed16(tuple, vector.MustTCols[types.Uuid](vec)[i]), nil
+	case types.T_char, types.T_varchar, types.T_text, types.T_json,
+		types.T_binary, types.T_varbinary, types.T_blob:
+		return encoding.EncodeComparableBytes(tuple, vector.MustBytesCols(vec).Get(int64(i))), nil
+	}
+	return nil, moerr.NewError(moerr.INVALID_ARGUMENT, fmt.Sprintf("serial function does not support type '%v'", vec.Typ))
+}
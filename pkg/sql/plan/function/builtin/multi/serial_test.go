@@ -0,0 +1,57 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSerial(t *testing.T) {
+	proc := testutil.NewProc()
+	ints := testutil.MakeInt64Vector([]int64{-1, 2, -1, 0}, []uint64{3})
+	strs := testutil.MakeVarcharVector([]string{"b", "a", "b", "a"}, nil)
+
+	res, err := Serial([]*vector.Vector{ints, strs}, proc)
+	require.NoError(t, err)
+	values := vector.MustBytesCols(res)
+	require.Equal(t, values.Get(0), values.Get(2))
+	require.Equal(t, -1, bytes.Compare(values.Get(0), values.Get(1)))
+	require.True(t, nulls.Contains(res.Nsp, 3))
+
+	value, rest, err := encoding.SplitComparable(values.Get(1))
+	require.NoError(t, err)
+	require.Equal(t, encoding.EncodeComparableInt64(nil, 2), value)
+	s, err := encoding.DecodeComparableBytes(rest)
+	require.NoError(t, err)
+	require.Equal(t, "a", string(s))
+
+	res, err = SerialFull([]*vector.Vector{ints, testutil.MakeScalarVarchar("x", 4)}, proc)
+	require.NoError(t, err)
+	require.False(t, nulls.Any(res.Nsp))
+	value, _, err = encoding.SplitComparable(vector.MustBytesCols(res).Get(3))
+	require.NoError(t, err)
+	require.Equal(t, encoding.EncodeComparableNull(nil), value)
+
+	res, err = Serial([]*vector.Vector{testutil.MakeScalarInt64(1, 1), testutil.MakeScalarNull(1)}, proc)
+	require.NoError(t, err)
+	require.True(t, res.IsScalarNull())
+}
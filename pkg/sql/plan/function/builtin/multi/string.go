@@ -0,0 +1,284 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/ascii"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/base64"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/char"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/hex"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/instr"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/left"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/lower"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/repeat"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/replace"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/right"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/trim"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/upper"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// The string functions are NULL if any of their arguments is NULL, except
+// CHAR which skips the NULL codes. The lengths and the positions count the
+// characters of utf-8, and the strings are compared by their bytes.
+
+// Upper returns the string in upper case, which is also UCASE.
+// upper(str)
+func Upper(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return stringResult(vectors, types.T_varchar.ToType(), proc, func(rs *types.Bytes, _ *nulls.Nulls) {
+		upper.Upper(vector.MustBytesCols(vectors[0]), rs)
+	})
+}
+
+// Lower returns the string in lower case, which is also LCASE.
+// lower(str)
+func Lower(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return stringResult(vectors, types.T_varchar.ToType(), proc, func(rs *types.Bytes, _ *nulls.Nulls) {
+		lower.Lower(vector.MustBytesCols(vectors[0]), rs)
+	})
+}
+
+// Replace returns the string with all the occurrences of from_str replaced by to_str.
+// replace(str, from_str, to_str)
+func Replace(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return stringResult(vectors, types.T_varchar.ToType(), proc, func(rs *types.Bytes, _ *nulls.Nulls) {
+		replace.Replace(vector.MustBytesCols(vectors[0]), vector.MustBytesCols(vectors[1]), vector.MustBytesCols(vectors[2]), rs)
+	})
+}
+
+// Instr returns the position of the first occurrence of the substring, 0 if there is none.
+// instr(str, substr)
+func Instr(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return stringInt64Result(vectors, proc, func(rs []int64) {
+		instr.Instr(vector.MustBytesCols(vectors[0]), vector.MustBytesCols(vectors[1]), rs)
+	})
+}
+
+// Locate returns the position of the first occurrence of the substring from
+// the position pos, 0 if there is none, which is also POSITION(substr IN str).
+// locate(substr, str[, pos])
+func Locate(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return stringInt64Result(vectors, proc, func(rs []int64) {
+		var ps []int64
+		if len(vectors) > 2 {
+			ps = vector.MustTCols[int64](vectors[2])
+		}
+		instr.Locate(vector.MustBytesCols(vectors[0]), vector.MustBytesCols(vectors[1]), ps, rs)
+	})
+}
+
+// Left returns the leftmost len characters of the string.
+// left(str, len)
+func Left(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return stringResult(vectors, types.T_varchar.ToType(), proc, func(rs *types.Bytes, _ *nulls.Nulls) {
+		left.Left(vector.MustBytesCols(vectors[0]), vector.MustTCols[int64](vectors[1]), rs)
+	})
+}
+
+// Right returns the rightmost len characters of the string.
+// right(str, len)
+func Right(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return stringResult(vectors, types.T_varchar.ToType(), proc, func(rs *types.Bytes, _ *nulls.Nulls) {
+		right.Right(vector.MustBytesCols(vectors[0]), vector.MustTCols[int64](vectors[1]), rs)
+	})
+}
+
+// Trim removes the repeated remstr, or the spaces, at the start and the end
+// of the string. The parser passes the direction as the first argument of
+// TRIM([{BOTH | LEADING | TRAILING} [remstr] FROM] str).
+// trim(str), trim(direction, str), trim(direction, remstr, str)
+func Trim(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	leading, trailing := true, true
+	rems := &types.Bytes{Data: []byte{' '}, Offsets: []uint32{0}, Lengths: []uint32{1}}
+	if len(vectors) > 1 {
+		if !vectors[0].IsScalar() {
+			return nil, moerr.NewError(moerr.INVALID_ARGUMENT, "the direction of trim must be a constant")
+		}
+		if !vectors[0].IsScalarNull() {
+			switch direction := vector.MustBytesCols(vectors[0]).Get(0); strings.ToLower(string(direction)) {
+			case "both":
+			case "leading":
+				trailing = false
+			case "trailing":
+				leading = false
+			default:
+				return nil, moerr.NewError(moerr.INVALID_ARGUMENT, "invalid direction of trim: "+string(direction))
+			}
+		}
+	}
+	if len(vectors) > 2 {
+		rems = vector.MustBytesCols(vectors[1])
+	}
+	return stringResult(vectors, types.T_varchar.ToType(), proc, func(rs *types.Bytes, _ *nulls.Nulls) {
+		trim.Trim(vector.MustBytesCols(vectors[len(vectors)-1]), rems, leading, trailing, rs)
+	})
+}
+
+// Repeat returns the string repeated count times, NULL if the result is longer than 16MB.
+// repeat(str, count)
+func Repeat(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return stringResult(vectors, types.T_varchar.ToType(), proc, func(rs *types.Bytes, nsp *nulls.Nulls) {
+		repeat.Repeat(vector.MustBytesCols(vectors[0]), vector.MustTCols[int64](vectors[1]), rs, nsp)
+	})
+}
+
+// Ascii returns the value of the first byte of the string, 0 for the empty string.
+// ascii(str)
+func Ascii(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return stringInt64Result(vectors, proc, func(rs []int64) {
+		ascii.Ascii(vector.MustBytesCols(vectors[0]), rs)
+	})
+}
+
+// Char returns the binary string of the bytes of the codes.
+// char(N, ...)
+func Char(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return charResult(vectors, types.T_varbinary.ToType(), proc)
+}
+
+// CharUsing returns the string of the bytes of the codes in the charset,
+// which is NULL if they are not valid utf-8 as it is the only charset here.
+// char(N, ... USING charset_name)
+func CharUsing(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return charResult(vectors[:len(vectors)-1], types.T_varchar.ToType(), proc)
+}
+
+func charResult(vectors []*vector.Vector, resultType types.Type, proc *process.Process) (*vector.Vector, error) {
+	count, scalar := rowCount(vectors)
+	codes := make([][]int64, 0, len(vectors))
+	nsps := make([]*nulls.Nulls, 0, len(vectors))
+	for _, vec := range vectors {
+		if !vec.IsScalarNull() {
+			codes = append(codes, vector.MustTCols[int64](vec))
+			nsps = append(nsps, vec.Nsp)
+		}
+	}
+	rs := char.Char(codes, nsps, newStringValues(count))
+	resultNsp := new(nulls.Nulls)
+	if resultType.Oid == types.T_varchar {
+		for i := 0; i < count; i++ {
+			if !utf8.Valid(rs.Get(int64(i))) {
+				nulls.Add(resultNsp, uint64(i))
+			}
+		}
+	}
+	return bytesVector(rs, resultNsp, resultType, scalar, proc)
+}
+
+// Hex returns the hexadecimal digits of the bytes of the string.
+// hex(str)
+func Hex(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return stringResult(vectors, types.T_varchar.ToType(), proc, func(rs *types.Bytes, _ *nulls.Nulls) {
+		hex.HexString(vector.MustBytesCols(vectors[0]), rs)
+	})
+}
+
+// HexInt64 returns the hexadecimal digits of the number, the negative numbers
+// are taken as unsigned 64 bits integers.
+// hex(N)
+func HexInt64(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return stringResult(vectors, types.T_varchar.ToType(), proc, func(rs *types.Bytes, _ *nulls.Nulls) {
+		hex.HexInt64(vector.MustTCols[int64](vectors[0]), rs)
+	})
+}
+
+// HexUint64 returns the hexadecimal digits of the number.
+// hex(N)
+func HexUint64(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return stringResult(vectors, types.T_varchar.ToType(), proc, func(rs *types.Bytes, _ *nulls.Nulls) {
+		hex.HexUint64(vector.MustTCols[uint64](vectors[0]), rs)
+	})
+}
+
+// Unhex returns the binary string of the hexadecimal digits, NULL if there
+// is any other character.
+// unhex(str)
+func Unhex(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return stringResult(vectors, types.T_varbinary.ToType(), proc, func(rs *types.Bytes, nsp *nulls.Nulls) {
+		hex.Unhex(vector.MustBytesCols(vectors[0]), rs, nsp)
+	})
+}
+
+// ToBase64 returns the base64 encoding of the string.
+// to_base64(str)
+func ToBase64(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return stringResult(vectors, types.T_varchar.ToType(), proc, func(rs *types.Bytes, _ *nulls.Nulls) {
+		base64.Encode(vector.MustBytesCols(vectors[0]), rs)
+	})
+}
+
+// FromBase64 returns the binary string of the base64 encoding, NULL if it is
+// not valid base64.
+// from_base64(str)
+func FromBase64(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return stringResult(vectors, types.T_varbinary.ToType(), proc, func(rs *types.Bytes, nsp *nulls.Nulls) {
+		base64.Decode(vector.MustBytesCols(vectors[0]), rs, nsp)
+	})
+}
+
+// stringNulls returns the NULL rows of the arguments, false means one of them
+// is a scalar null and so is the result
+func stringNulls(vectors []*vector.Vector) (*nulls.Nulls, bool) {
+	nsp := new(nulls.Nulls)
+	for _, vec := range vectors {
+		if vec.IsScalarNull() {
+			return nil, false
+		}
+		if !vec.IsScalar() {
+			nulls.Set(nsp, vec.Nsp)
+		}
+	}
+	return nsp, true
+}
+
+func newStringValues(count int) *types.Bytes {
+	return &types.Bytes{
+		Offsets: make([]uint32, count),
+		Lengths: make([]uint32, count),
+	}
+}
+
+// stringResult builds the result vector of a kernel writing the strings of all
+// the rows, which may add more NULL rows
+func stringResult(vectors []*vector.Vector, resultType types.Type, proc *process.Process, kernel func(rs *types.Bytes, nsp *nulls.Nulls)) (*vector.Vector, error) {
+	resultNsp, ok := stringNulls(vectors)
+	if !ok {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	count, scalar := rowCount(vectors)
+	resultValues := newStringValues(count)
+	kernel(resultValues, resultNsp)
+	return bytesVector(resultValues, resultNsp, resultType, scalar, proc)
+}
+
+// stringInt64Result builds the result vector of a kernel writing the integers of all the rows
+func stringInt64Result(vectors []*vector.Vector, proc *process.Process, kernel func(rs []int64)) (*vector.Vector, error) {
+	resultType := types.T_int64.ToType()
+	resultNsp, ok := stringNulls(vectors)
+	if !ok {
+		return proc.AllocScalarNullVector(resultType), nil
+	}
+	count, scalar := rowCount(vectors)
+	resultValues := make([]int64, count)
+	kernel(resultValues)
+	return fixedVector(resultValues, resultNsp, resultType, scalar, proc)
+}
@@ -0,0 +1,182 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func stringValues(vec *vector.Vector) []string {
+	values := vector.MustBytesCols(vec)
+	strs := make([]string, len(values.Offsets))
+	for i := range strs {
+		strs[i] = string(values.Get(int64(i)))
+	}
+	return strs
+}
+
+func TestUpperLowerReplace(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeVarcharVector([]string{"MatrixOne", "数据库 Db", ""}, []uint64{2})
+
+	res, err := Upper([]*vector.Vector{strs}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"MATRIXONE", "数据库 DB", ""}, stringValues(res))
+	require.True(t, nulls.Contains(res.Nsp, 2))
+
+	res, err = Lower([]*vector.Vector{strs}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"matrixone", "数据库 db", ""}, stringValues(res))
+
+	res, err = Replace([]*vector.Vector{strs, testutil.MakeScalarVarchar("数据", 3), testutil.MakeScalarVarchar("x", 3)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"MatrixOne", "x库 Db", ""}, stringValues(res))
+	require.True(t, nulls.Contains(res.Nsp, 2))
+
+	res, err = Replace([]*vector.Vector{strs, testutil.MakeScalarNull(3), testutil.MakeScalarVarchar("x", 3)}, proc)
+	require.NoError(t, err)
+	require.True(t, res.IsScalarNull())
+
+	// all the arguments are constants
+	res, err = Upper([]*vector.Vector{testutil.MakeScalarVarchar("straße", 1)}, proc)
+	require.NoError(t, err)
+	require.True(t, res.IsScalar())
+	require.Equal(t, []string{"STRAßE"}, stringValues(res))
+}
+
+func TestInstrLocate(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeVarcharVector([]string{"foobarbar", "数据库", "x"}, []uint64{2})
+
+	res, err := Instr([]*vector.Vector{strs, testutil.MakeScalarVarchar("库", 3)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{0, 3, 0}, res.Col)
+	require.True(t, nulls.Contains(res.Nsp, 2))
+
+	res, err = Locate([]*vector.Vector{testutil.MakeScalarVarchar("bar", 3), strs, testutil.MakeScalarInt64(5, 3)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{7, 0, 0}, res.Col)
+
+	res, err = Locate([]*vector.Vector{testutil.MakeScalarVarchar("b", 1), testutil.MakeScalarVarchar("abc", 1)}, proc)
+	require.NoError(t, err)
+	require.True(t, res.IsScalar())
+	require.Equal(t, []int64{2}, res.Col)
+}
+
+func TestLeftRightRepeat(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeVarcharVector([]string{"foobar", "数据库", "ab"}, nil)
+	lengths := testutil.MakeInt64Vector([]int64{2, 2, 0}, []uint64{0})
+
+	res, err := Left([]*vector.Vector{strs, lengths}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"数据", ""}, stringValues(res)[1:])
+	require.True(t, nulls.Contains(res.Nsp, 0))
+
+	res, err = Right([]*vector.Vector{strs, testutil.MakeScalarInt64(2, 3)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"ar", "据库", "ab"}, stringValues(res))
+
+	res, err = Repeat([]*vector.Vector{strs, testutil.MakeScalarInt64(2, 3)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"foobarfoobar", "数据库数据库", "abab"}, stringValues(res))
+
+	res, err = Repeat([]*vector.Vector{testutil.MakeScalarVarchar("ab", 1), testutil.MakeScalarInt64(MaxSpacePerRowOfLpad, 1)}, proc)
+	require.NoError(t, err)
+	require.True(t, res.IsScalarNull())
+}
+
+func TestTrim(t *testing.T) {
+	proc := testutil.NewProc()
+	strs := testutil.MakeVarcharVector([]string{"  bar ", "xxbarxx"}, nil)
+
+	res, err := Trim([]*vector.Vector{strs}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"bar", "xxbarxx"}, stringValues(res))
+
+	res, err = Trim([]*vector.Vector{testutil.MakeScalarVarchar("LEADING", 2), strs}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"bar ", "xxbarxx"}, stringValues(res))
+
+	res, err = Trim([]*vector.Vector{testutil.MakeScalarVarchar("trailing", 2), testutil.MakeScalarVarchar("x", 2), strs}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"  bar ", "xxbar"}, stringValues(res))
+
+	res, err = Trim([]*vector.Vector{testutil.MakeScalarVarchar("both", 2), testutil.MakeScalarVarchar("x", 2), strs}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"  bar ", "bar"}, stringValues(res))
+
+	_, err = Trim([]*vector.Vector{testutil.MakeScalarVarchar("middle", 2), strs}, proc)
+	require.Error(t, err)
+	_, err = Trim([]*vector.Vector{strs, strs}, proc)
+	require.Error(t, err)
+}
+
+func TestAsciiChar(t *testing.T) {
+	proc := testutil.NewProc()
+
+	res, err := Ascii([]*vector.Vector{testutil.MakeVarcharVector([]string{"2", "dx", ""}, nil)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []int64{50, 100, 0}, res.Col)
+
+	codes := testutil.MakeInt64Vector([]int64{77, 0, 0xE6}, []uint64{1})
+	res, err = Char([]*vector.Vector{codes, testutil.MakeScalarNull(3), testutil.MakeScalarInt64(121, 3)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, types.T_varbinary, res.Typ.Oid)
+	require.Equal(t, []string{"My", "y", "\xe6y"}, stringValues(res))
+	require.False(t, nulls.Any(res.Nsp))
+
+	res, err = CharUsing([]*vector.Vector{codes, testutil.MakeScalarInt64(121, 3), testutil.MakeScalarVarchar("utf8mb4", 3)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, types.T_varchar, res.Typ.Oid)
+	require.True(t, nulls.Contains(res.Nsp, 2))
+	require.False(t, nulls.Contains(res.Nsp, 0))
+}
+
+func TestHexBase64(t *testing.T) {
+	proc := testutil.NewProc()
+
+	res, err := Hex([]*vector.Vector{testutil.MakeVarcharVector([]string{"abc", "数"}, nil)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"616263", "E695B0"}, stringValues(res))
+
+	res, err = HexInt64([]*vector.Vector{testutil.MakeInt64Vector([]int64{255, -1}, nil)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"FF", "FFFFFFFFFFFFFFFF"}, stringValues(res))
+
+	res, err = HexUint64([]*vector.Vector{testutil.MakeUint64Vector([]uint64{10}, nil)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"A"}, stringValues(res))
+
+	res, err = Unhex([]*vector.Vector{testutil.MakeVarcharVector([]string{"616263", "xy"}, nil)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, "abc", stringValues(res)[0])
+	require.True(t, nulls.Contains(res.Nsp, 1))
+
+	res, err = ToBase64([]*vector.Vector{testutil.MakeScalarVarchar("abc", 1)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, []string{"YWJj"}, stringValues(res))
+
+	res, err = FromBase64([]*vector.Vector{testutil.MakeVarcharVector([]string{"YWJj", "!"}, nil)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, "abc", stringValues(res)[0])
+	require.True(t, nulls.Contains(res.Nsp, 1))
+}
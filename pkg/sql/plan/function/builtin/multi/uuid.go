@@ -0,0 +1,106 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// Uuid returns a new random uuid for each row, its only argument is the
+// constant added by the binder whose length is the row count of the batch.
+func Uuid(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultType := types.T_uuid.ToType()
+	count := vector.Length(vectors[0])
+	resultVector, err := proc.AllocVector(resultType, int64(count*types.UuidLen))
+	if err != nil {
+		return nil, err
+	}
+	rs := encoding.DecodeUuidSlice(resultVector.Data)[:count]
+	for i := range rs {
+		if rs[i], err = types.NewUuid(); err != nil {
+			return nil, err
+		}
+	}
+	vector.SetCol(resultVector, rs)
+	return resultVector, nil
+}
+
+// UuidToBin returns the 16 bytes form of a uuid, the time-low and time-high
+// parts are swapped if the second argument is true
+func UuidToBin(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return bytesResult(vectors, types.T_varbinary.ToType(), proc, func(row int) ([]byte, error) {
+		swap, ok := uuidSwapFlag(vectors, row)
+		if !ok {
+			return nil, nil
+		}
+		vec := vectors[0]
+		if vec.IsScalarNull() || nulls.Contains(vec.Nsp, uint64(row)) {
+			return nil, nil
+		}
+		if vec.IsScalar() {
+			row = 0
+		}
+		if vec.Typ.Oid == types.T_uuid {
+			return vector.MustTCols[types.Uuid](vec)[row].Bytes(swap), nil
+		}
+		u, err := types.ParseUuid(string(vector.MustBytesCols(vec).Get(int64(row))))
+		if err != nil {
+			return nil, err
+		}
+		return u.Bytes(swap), nil
+	})
+}
+
+// BinToUuid returns the text form of a uuid in its 16 bytes form, the
+// time-low and time-high parts are swapped back if the second argument is true
+func BinToUuid(vectors []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return bytesResult(vectors, types.T_varchar.ToType(), proc, func(row int) ([]byte, error) {
+		swap, ok := uuidSwapFlag(vectors, row)
+		if !ok {
+			return nil, nil
+		}
+		vec := vectors[0]
+		if vec.IsScalarNull() || nulls.Contains(vec.Nsp, uint64(row)) {
+			return nil, nil
+		}
+		if vec.IsScalar() {
+			row = 0
+		}
+		u, err := types.UuidFromBytes(vector.MustBytesCols(vec).Get(int64(row)), swap)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(u.String()), nil
+	})
+}
+
+// uuidSwapFlag returns the optional swap flag of the row, false means NULL
+func uuidSwapFlag(vectors []*vector.Vector, row int) (bool, bool) {
+	if len(vectors) < 2 {
+		return false, true
+	}
+	vec := vectors[1]
+	if vec.IsScalarNull() || nulls.Contains(vec.Nsp, uint64(row)) {
+		return false, false
+	}
+	if vec.IsScalar() {
+		row = 0
+	}
+	return vector.MustTCols[int64](vec)[row] != 0, true
+}
@@ -0,0 +1,71 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUuid(t *testing.T) {
+	proc := testutil.NewProc()
+	res, err := Uuid([]*vector.Vector{testutil.MakeScalarInt64(0, 3)}, proc)
+	require.NoError(t, err)
+	require.False(t, res.IsScalar())
+	require.Equal(t, types.T_uuid, res.Typ.Oid)
+	ids := vector.MustTCols[types.Uuid](res)
+	require.Equal(t, 3, len(ids))
+	require.NotEqual(t, ids[0], ids[1])
+	require.NotEqual(t, ids[1], ids[2])
+}
+
+func TestUuidToBin(t *testing.T) {
+	proc := testutil.NewProc()
+	text := "6ccd780c-baba-1026-9564-5b8c656024db"
+	res, err := UuidToBin([]*vector.Vector{testutil.MakeVarcharVector([]string{text, ""}, []uint64{1})}, proc)
+	require.NoError(t, err)
+	values := vector.MustBytesCols(res)
+	require.Equal(t, "\x6c\xcd\x78\x0c\xba\xba\x10\x26\x95\x64\x5b\x8c\x65\x60\x24\xdb", string(values.Get(0)))
+	require.True(t, nulls.Contains(res.Nsp, 1))
+
+	res, err = UuidToBin([]*vector.Vector{testutil.MakeScalarVarchar(text, 1), testutil.MakeScalarInt64(1, 1)}, proc)
+	require.NoError(t, err)
+	require.True(t, res.IsScalar())
+	require.Equal(t, "\x10\x26\xba\xba\x6c\xcd\x78\x0c\x95\x64\x5b\x8c\x65\x60\x24\xdb", string(vector.MustBytesCols(res).Get(0)))
+
+	_, err = UuidToBin([]*vector.Vector{testutil.MakeScalarVarchar("6ccd780c", 1)}, proc)
+	require.Error(t, err)
+}
+
+func TestBinToUuid(t *testing.T) {
+	proc := testutil.NewProc()
+	bins := testutil.MakeVarcharVector([]string{"\x10\x26\xba\xba\x6c\xcd\x78\x0c\x95\x64\x5b\x8c\x65\x60\x24\xdb"}, nil)
+	bins.Typ = types.T_varbinary.ToType()
+	res, err := BinToUuid([]*vector.Vector{bins, testutil.MakeScalarInt64(1, 1)}, proc)
+	require.NoError(t, err)
+	require.Equal(t, "6ccd780c-baba-1026-9564-5b8c656024db", string(vector.MustBytesCols(res).Get(0)))
+
+	res, err = BinToUuid([]*vector.Vector{bins}, proc)
+	require.NoError(t, err)
+	require.Equal(t, "1026baba-6ccd-780c-9564-5b8c656024db", string(vector.MustBytesCols(res).Get(0)))
+
+	_, err = BinToUuid([]*vector.Vector{testutil.MakeScalarVarchar("short", 1)}, proc)
+	require.Error(t, err)
+}
@@ -244,7 +244,7 @@ func LastInsertID(vectors []*vector.Vector, proc *process.Process) (*vector.Vect
 		},
 		func(proc *process.Process, params ...interface{}) (interface{}, error) {
 			result := params[0].([]uint64)
-			result[0] = 0
+			result[0] = proc.SessionInfo.LastInsertID
 			return result, nil
 		},
 	)
@@ -275,7 +275,7 @@ func RowCount(vectors []*vector.Vector, proc *process.Process) (*vector.Vector,
 		},
 		func(proc *process.Process, params ...interface{}) (interface{}, error) {
 			result := params[0].([]uint64)
-			result[0] = 0
+			result[0] = proc.SessionInfo.LastInsertID
 			return result, nil
 		},
 	)
@@ -1760,4 +1760,634 @@ var builtins = map[int]Functions{
 			},
 		},
 	},
+	// json functions
+	JSON_ARRAY: {
+		Id:          JSON_ARRAY,
+		TypeCheckFn: variadicTypeCheck(0, -1),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_any},
+				ReturnTyp: types.T_json,
+				Fn:        multi.JsonArray,
+			},
+		},
+	},
+	JSON_CONTAINS: {
+		Id:          JSON_CONTAINS,
+		TypeCheckFn: variadicTypeCheck(2, 3),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_json, types.T_json, types.T_varchar},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.JsonContains,
+			},
+		},
+	},
+	JSON_EXTRACT: {
+		Id:          JSON_EXTRACT,
+		TypeCheckFn: variadicTypeCheck(2, -1),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_json, types.T_varchar},
+				ReturnTyp: types.T_json,
+				Fn:        multi.JsonExtract,
+			},
+		},
+	},
+	JSON_KEYS: {
+		Id:          JSON_KEYS,
+		TypeCheckFn: variadicTypeCheck(1, 2),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_json, types.T_varchar},
+				ReturnTyp: types.T_json,
+				Fn:        multi.JsonKeys,
+			},
+		},
+	},
+	JSON_LENGTH: {
+		Id:          JSON_LENGTH,
+		TypeCheckFn: variadicTypeCheck(1, 2),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_json, types.T_varchar},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.JsonLength,
+			},
+		},
+	},
+	JSON_OBJECT: {
+		Id: JSON_OBJECT,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			// the arguments are pairs of key and value
+			if len(inputs)%2 != 0 {
+				return wrongFunctionParameters, nil
+			}
+			return variadicTypeCheck(0, -1)(overloads, inputs)
+		},
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_any},
+				ReturnTyp: types.T_json,
+				Fn:        multi.JsonObject,
+			},
+		},
+	},
+	JSON_UNQUOTE: {
+		Id: JSON_UNQUOTE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_json},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.JsonUnquote,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.JsonUnquote,
+			},
+		},
+	},
+	UUID: {
+		Id: UUID,
+		Overloads: []Function{
+			{
+				// the argument is a constant added by the binder, it only
+				// carries the row count of the batch
+				Index:     0,
+				Volatile:  true,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_int64},
+				ReturnTyp: types.T_uuid,
+				Fn:        multi.Uuid,
+			},
+		},
+	},
+	UUID_TO_BIN: {
+		Id: UUID_TO_BIN,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varbinary,
+				Fn:        multi.UuidToBin,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_varbinary,
+				Fn:        multi.UuidToBin,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_uuid},
+				ReturnTyp: types.T_varbinary,
+				Fn:        multi.UuidToBin,
+			},
+			{
+				Index:     3,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_uuid, types.T_int64},
+				ReturnTyp: types.T_varbinary,
+				Fn:        multi.UuidToBin,
+			},
+		},
+	},
+	BIN_TO_UUID: {
+		Id: BIN_TO_UUID,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varbinary},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.BinToUuid,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varbinary, types.T_int64},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.BinToUuid,
+			},
+		},
+	},
+	CAST_VALUE_TO_ENUM: {
+		Id: CAST_VALUE_TO_ENUM,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_enum,
+				Fn:        multi.CastValueToEnum,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_char},
+				ReturnTyp: types.T_enum,
+				Fn:        multi.CastValueToEnum,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_enum,
+				Fn:        multi.CastValueToEnum,
+			},
+			{
+				Index:     3,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_uint64},
+				ReturnTyp: types.T_enum,
+				Fn:        multi.CastValueToEnum,
+			},
+		},
+	},
+	CAST_VALUE_TO_SET: {
+		Id: CAST_VALUE_TO_SET,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_set,
+				Fn:        multi.CastValueToSet,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_char},
+				ReturnTyp: types.T_set,
+				Fn:        multi.CastValueToSet,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_set,
+				Fn:        multi.CastValueToSet,
+			},
+			{
+				Index:     3,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_uint64},
+				ReturnTyp: types.T_set,
+				Fn:        multi.CastValueToSet,
+			},
+		},
+	},
+	CAST_ENUM_TO_VALUE: {
+		Id: CAST_ENUM_TO_VALUE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_enum},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.CastEnumToValue,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_set},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.CastEnumToValue,
+			},
+		},
+	},
+	SERIAL: {
+		Id: SERIAL,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			if len(inputs) > 0 {
+				return 0, nil
+			}
+			return wrongFunctionParameters, nil
+		},
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_NONE,
+				Layout:    STANDARD_FUNCTION,
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Serial,
+			},
+		},
+	},
+	SERIAL_FULL: {
+		Id: SERIAL_FULL,
+		TypeCheckFn: func(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			if len(inputs) > 0 {
+				return 0, nil
+			}
+			return wrongFunctionParameters, nil
+		},
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_NONE,
+				Layout:    STANDARD_FUNCTION,
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.SerialFull,
+			},
+		},
+	},
+	REGEXP_LIKE: {
+		Id:          REGEXP_LIKE,
+		TypeCheckFn: variadicTypeCheck(2, 3),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_bool,
+				Fn:        multi.RegexpLike,
+			},
+		},
+	},
+	REGEXP_INSTR: {
+		Id:          REGEXP_INSTR,
+		TypeCheckFn: variadicTypeCheck(2, 6),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar, types.T_int64, types.T_int64, types.T_int64, types.T_varchar},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.RegexpInstr,
+			},
+		},
+	},
+	REGEXP_SUBSTR: {
+		Id:          REGEXP_SUBSTR,
+		TypeCheckFn: variadicTypeCheck(2, 5),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar, types.T_int64, types.T_int64, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.RegexpSubstr,
+			},
+		},
+	},
+	REGEXP_REPLACE: {
+		Id:          REGEXP_REPLACE,
+		TypeCheckFn: variadicTypeCheck(3, 6),
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar, types.T_varchar, types.T_int64, types.T_int64, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.RegexpReplace,
+			},
+		},
+	},
+	UPPER: {
+		Id: UPPER,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Upper,
+			},
+		},
+	},
+	LOWER: {
+		Id: LOWER,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Lower,
+			},
+		},
+	},
+	REPLACE: {
+		Id: REPLACE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Replace,
+			},
+		},
+	},
+	INSTR: {
+		Id: INSTR,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.Instr,
+			},
+		},
+	},
+	POSITION: {
+		Id: POSITION,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.Locate,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.Locate,
+			},
+		},
+	},
+	LEFT: {
+		Id: LEFT,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Left,
+			},
+		},
+	},
+	RIGHT: {
+		Id: RIGHT,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Right,
+			},
+		},
+	},
+	TRIM: {
+		Id: TRIM,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Trim,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Trim,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_varchar, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Trim,
+			},
+		},
+	},
+	REPEAT: {
+		Id: REPEAT,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar, types.T_int64},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Repeat,
+			},
+		},
+	},
+	ASCII: {
+		Id: ASCII,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_int64,
+				Fn:        multi.Ascii,
+			},
+		},
+	},
+	CHR: {
+		Id: CHR,
+		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+			// the parser passes the charset of CHAR(N, ... USING charset_name) as the last argument
+			n := len(inputs)
+			if n < 2 || (inputs[n-1] != types.T_char && inputs[n-1] != types.T_varchar) {
+				return variadicTypeCheck(1, -1)(overloads, inputs)
+			}
+			if overloadIndex, ts = variadicTypeCheck(1, -1)(overloads, inputs[:n-1]); overloadIndex < 0 {
+				return overloadIndex, nil
+			}
+			if ts != nil {
+				ts = append(ts, inputs[n-1])
+			}
+			return 1, ts
+		},
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_int64},
+				ReturnTyp: types.T_varbinary,
+				Fn:        multi.Char,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_int64, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.CharUsing,
+			},
+		},
+	},
+	HEX_ENCODE: {
+		Id: HEX_ENCODE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.Hex,
+			},
+			{
+				Index:     1,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_int64},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.HexInt64,
+			},
+			{
+				Index:     2,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_uint64},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.HexUint64,
+			},
+		},
+	},
+	HEX_DECODE: {
+		Id: HEX_DECODE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varbinary,
+				Fn:        multi.Unhex,
+			},
+		},
+	},
+	BASE64_ENCODE: {
+		Id: BASE64_ENCODE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        multi.ToBase64,
+			},
+		},
+	},
+	BASE64_DECODE: {
+		Id: BASE64_DECODE,
+		Overloads: []Function{
+			{
+				Index:     0,
+				Flag:      plan.Function_STRICT,
+				Layout:    STANDARD_FUNCTION,
+				Args:      []types.T{types.T_varchar},
+				ReturnTyp: types.T_varbinary,
+				Fn:        multi.FromBase64,
+			},
+		},
+	},
 }
@@ -69,8 +69,8 @@ const (
 	ATAN           // ATAN
 	ATAN2          // ATAN2
 	AVG            // AVG
-	BASE64_DECODE  // BASE64_DECODE
-	BASE64_ENCODE  // BASE64_ENCODE
+	BASE64_DECODE  // BASE64_DECODE, which is FROM_BASE64
+	BASE64_ENCODE  // BASE64_ENCODE, which is TO_BASE64
 	BIT_AND        // BIT_AND
 	BIT_LENGTH     // BIT_LENGTH
 	BIT_NOT        // BIT_NOT
@@ -82,7 +82,7 @@ const (
 	BOOLAGG_OR     // BOOLAGG_OR
 	CASE           // CASE
 	CEIL           // CEIL
-	CHR            // CHR
+	CHR            // CHR, which is CHAR
 	COALESCE       // COALESCE
 	CONCAT_WS
 	CONTAINS          // CONTAINS
@@ -111,8 +111,8 @@ const (
 	GROUPING_ID // GROUPING_ID
 	HASH        // HASH
 	HASH_AGG    // HASH_AGG
-	HEX_DECODE  // HEX_DECODE
-	HEX_ENCODE  // HEX_ENCODE
+	HEX_DECODE  // HEX_DECODE, which is UNHEX
+	HEX_ENCODE  // HEX_ENCODE, which is HEX
 	IFF         // IFF
 	IFNULL      // IFNULL
 	ILIKE       // ILIKE
@@ -145,7 +145,7 @@ const (
 	NULLIF         // NULLIF
 	PERCENT_RANK   // PERCENT_RANK
 	PI             // PI
-	POSITION       // POSITION
+	POSITION       // POSITION, which is LOCATE
 	POW            // POW
 	RADIAN         // RADIAN
 	RANDOM         // RANDOM
@@ -221,6 +221,38 @@ const (
 
 	TIMESTAMP // TIMESTAMP
 
+	//json functions
+	//Reference to : https://dev.mysql.com/doc/refman/8.0/en/json-functions.html
+	JSON_ARRAY
+	JSON_CONTAINS
+	JSON_EXTRACT
+	JSON_KEYS
+	JSON_LENGTH
+	JSON_OBJECT
+	JSON_UNQUOTE
+
+	UUID        // UUID
+	UUID_TO_BIN // UUID_TO_BIN
+	BIN_TO_UUID // BIN_TO_UUID
+
+	// conversions of ENUM and SET added by the planner
+	CAST_VALUE_TO_ENUM
+	CAST_VALUE_TO_SET
+	CAST_ENUM_TO_VALUE
+
+	GROUP_CONCAT    // GROUP_CONCAT
+	PERCENTILE_CONT // PERCENTILE_CONT, and MEDIAN as PERCENTILE_CONT(x, 0.5)
+	PERCENTILE_DISC // PERCENTILE_DISC
+
+	// packing of the tuples added by the planner
+	SERIAL
+	SERIAL_FULL
+
+	REGEXP_LIKE  // REGEXP_LIKE, and the REGEXP and RLIKE operators
+	REGEXP_INSTR // REGEXP_INSTR
+
+	INSTR // INSTR
+
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
 	FUNCTION_END_NUMBER
@@ -274,10 +306,26 @@ var functionIdRegister = map[string]int32{
 	"bit_and":               BIT_AND,
 	"bit_xor":               BIT_XOR,
 	"std":                   STDDEV_POP,
+	"stddev":                STDDEV_POP,
 	"stddev_pop":            STDDEV_POP,
+	"stddev_samp":           STDDEV_SAMPLE,
 	"variance":              VAR_POP,
+	"var_pop":               VAR_POP,
+	"var_samp":              VAR_SAMPLE,
+	"group_concat":          GROUP_CONCAT,
+	"median":                PERCENTILE_CONT,
+	"percentile_cont":       PERCENTILE_CONT,
+	"percentile_disc":       PERCENTILE_DISC,
 	"approx_count_distinct": APPROX_COUNT_DISTINCT,
 	"any_value":             ANY_VALUE,
+	// window
+	"row_number":  ROW_NUMBER,
+	"rank":        RANK,
+	"dense_rank":  DENSE_RANK,
+	"lag":         LAG,
+	"lead":        LEAD,
+	"first_value": FIRST_VALUE,
+	"last_value":  LAST_VALUE,
 	// builtin
 	// whoever edit this, please follow the lexical order, or come up with a better ordering method
 	// binary functions
@@ -357,6 +405,48 @@ var functionIdRegister = map[string]int32{
 	"row_count":      ROW_COUNT,
 	"version":        VERSION,
 	"collation":      COLLATION,
+	// json functions
+	"json_array":    JSON_ARRAY,
+	"json_contains": JSON_CONTAINS,
+	"json_extract":  JSON_EXTRACT,
+	"json_keys":     JSON_KEYS,
+	"json_length":   JSON_LENGTH,
+	"json_object":   JSON_OBJECT,
+	"json_unquote":  JSON_UNQUOTE,
+	// uuid functions
+	"uuid":        UUID,
+	"uuid_to_bin": UUID_TO_BIN,
+	"bin_to_uuid": BIN_TO_UUID,
+	// conversions of enum and set
+	"cast_value_to_enum": CAST_VALUE_TO_ENUM,
+	"cast_value_to_set":  CAST_VALUE_TO_SET,
+	"cast_enum_to_value": CAST_ENUM_TO_VALUE,
+	// packing of the tuples
+	"serial":      SERIAL,
+	"serial_full": SERIAL_FULL,
+	// regular expressions
+	"regexp_like":    REGEXP_LIKE,
+	"regexp_instr":   REGEXP_INSTR,
+	"regexp_substr":  REGEXP_SUBSTR,
+	"regexp_replace": REGEXP_REPLACE,
+	// string functions
+	"upper":       UPPER,
+	"ucase":       UPPER,
+	"lower":       LOWER,
+	"lcase":       LOWER,
+	"replace":     REPLACE,
+	"instr":       INSTR,
+	"locate":      POSITION,
+	"left":        LEFT,
+	"right":       RIGHT,
+	"trim":        TRIM,
+	"repeat":      REPEAT,
+	"ascii":       ASCII,
+	"char":        CHR,
+	"hex":         HEX_ENCODE,
+	"unhex":       HEX_DECODE,
+	"to_base64":   BASE64_ENCODE,
+	"from_base64": BASE64_DECODE,
 }
 
 func GetFunctionIsWinfunByName(name string) bool {
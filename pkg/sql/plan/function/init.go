@@ -32,6 +32,7 @@ func init() {
 	initOperators()
 	initBuiltIns()
 	initAggregateFunction()
+	initWindowFunction()
 
 	initTypeCheckRelated()
 }
@@ -416,7 +416,82 @@ func doCast(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error)
 		}
 	}
 
+	if isString(lv.Typ.Oid) && rv.Typ.Oid == types.T_json {
+		return CastStringToJson(lv, rv, proc)
+	}
+	if lv.Typ.Oid == types.T_json && isString(rv.Typ.Oid) {
+		return CastJsonToString(lv, rv, proc)
+	}
+	if lv.Typ.Oid == types.T_json && rv.Typ.Oid == types.T_json {
+		return CastSpecials3(lv, rv, proc)
+	}
+
+	if rv.Typ.Oid == types.T_bit {
+		switch lv.Typ.Oid {
+		case types.T_int8:
+			return CastIntToBit[int8](lv, rv, proc)
+		case types.T_int16:
+			return CastIntToBit[int16](lv, rv, proc)
+		case types.T_int32:
+			return CastIntToBit[int32](lv, rv, proc)
+		case types.T_int64:
+			return CastIntToBit[int64](lv, rv, proc)
+		case types.T_uint8:
+			return CastIntToBit[uint8](lv, rv, proc)
+		case types.T_uint16:
+			return CastIntToBit[uint16](lv, rv, proc)
+		case types.T_uint32:
+			return CastIntToBit[uint32](lv, rv, proc)
+		case types.T_uint64, types.T_bit:
+			return CastIntToBit[uint64](lv, rv, proc)
+		}
+		if isString(lv.Typ.Oid) {
+			return CastStringToBit(lv, rv, proc)
+		}
+	}
+	if lv.Typ.Oid == types.T_bit {
+		switch rv.Typ.Oid {
+		case types.T_int8:
+			return CastLeftToRight[uint64, int8](lv, rv, proc)
+		case types.T_int16:
+			return CastLeftToRight[uint64, int16](lv, rv, proc)
+		case types.T_int32:
+			return CastLeftToRight[uint64, int32](lv, rv, proc)
+		case types.T_int64:
+			return CastLeftToRight[uint64, int64](lv, rv, proc)
+		case types.T_uint8:
+			return CastLeftToRight[uint64, uint8](lv, rv, proc)
+		case types.T_uint16:
+			return CastLeftToRight[uint64, uint16](lv, rv, proc)
+		case types.T_uint32:
+			return CastLeftToRight[uint64, uint32](lv, rv, proc)
+		case types.T_uint64:
+			return CastSameType[uint64](lv, rv, proc)
+		}
+		if isString(rv.Typ.Oid) {
+			return CastBitToString(lv, rv, proc)
+		}
+	}
+
+	if rv.Typ.Oid == types.T_uuid {
+		if isString(lv.Typ.Oid) {
+			return CastStringToUuid(lv, rv, proc)
+		}
+		if lv.Typ.Oid == types.T_uuid {
+			lvs := vector.MustTCols[types.Uuid](lv)
+			return castToUuid(lv, rv, proc, len(lvs), func(i int) (types.Uuid, error) {
+				return lvs[i], nil
+			})
+		}
+	}
+	if lv.Typ.Oid == types.T_uuid && isString(rv.Typ.Oid) {
+		return CastUuidToString(lv, rv, proc)
+	}
+
 	if isString(lv.Typ.Oid) && isString(rv.Typ.Oid) {
+		if rv.Typ.Oid == types.T_binary && rv.Typ.Width > 0 {
+			return CastStringToBinary(lv, rv, proc)
+		}
 		return CastSpecials3(lv, rv, proc)
 	}
 
@@ -1163,6 +1238,250 @@ func CastSpecials3(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector
 	return vec, nil
 }
 
+// CastStringToBinary casts strings to binary(n), a value shorter than n bytes
+// is right-padded with 0x00 bytes as MySQL does
+func CastStringToBinary(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	width := int(rv.Typ.Width)
+	source := vector.MustBytesCols(lv)
+	size := 0
+	for _, n := range source.Lengths {
+		if int(n) < width {
+			size += width
+		} else {
+			size += int(n)
+		}
+	}
+	var vec *vector.Vector
+	if lv.IsScalar() {
+		vec = proc.AllocScalarVector(rv.Typ)
+		vec.Col = &types.Bytes{Data: make([]byte, 0, size)}
+	} else {
+		var err error
+		if vec, err = proc.AllocVector(rv.Typ, int64(size)); err != nil {
+			return nil, err
+		}
+		vec.Col.(*types.Bytes).Data = vec.Data[:0]
+		nulls.Set(vec.Nsp, lv.Nsp)
+	}
+	target := vec.Col.(*types.Bytes)
+	target.Offsets = make([]uint32, len(source.Offsets))
+	target.Lengths = make([]uint32, len(source.Lengths))
+	for i := range source.Offsets {
+		target.Offsets[i] = uint32(len(target.Data))
+		if nulls.Contains(lv.Nsp, uint64(i)) {
+			continue
+		}
+		v := source.Get(int64(i))
+		target.Data = append(target.Data, v...)
+		for j := len(v); j < width; j++ {
+			target.Data = append(target.Data, 0)
+		}
+		target.Lengths[i] = uint32(len(target.Data)) - target.Offsets[i]
+	}
+	return vec, nil
+}
+
+// CastStringToJson validates the json texts and converts them into binary json
+func CastStringToJson(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return castBytes(lv, rv, proc, func(v []byte) ([]byte, error) {
+		bj, err := types.ParseByteJson(v)
+		if err != nil {
+			return nil, err
+		}
+		return bj.Encode(), nil
+	})
+}
+
+// CastJsonToString converts binary json into json texts
+func CastJsonToString(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return castBytes(lv, rv, proc, func(v []byte) ([]byte, error) {
+		return []byte(types.DecodeByteJson(v).String()), nil
+	})
+}
+
+// CastIntToBit checks that the integers fit in the bits of the target BIT
+func CastIntToBit[T constraints.Integer](lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := vector.MustTCols[T](lv)
+	return castToBit(lv, rv, proc, len(lvs), func(i int) (uint64, error) {
+		if lvs[i] < 0 {
+			return 0, errors.New(errno.DataException, fmt.Sprintf("Out of range value %d for BIT(%d)", lvs[i], rv.Typ.Width))
+		}
+		v := uint64(lvs[i])
+		return v, types.CheckBit(v, rv.Typ.Width)
+	})
+}
+
+// CastStringToBit takes the strings as big endian binary numbers
+func CastStringToBit(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := vector.MustBytesCols(lv)
+	return castToBit(lv, rv, proc, len(lvs.Offsets), func(i int) (uint64, error) {
+		return types.ParseBitBytes(lvs.Get(int64(i)), rv.Typ.Width)
+	})
+}
+
+// castToBit builds a BIT vector whose values are fn of the not null rows of lv
+func castToBit(lv, rv *vector.Vector, proc *process.Process, n int, fn func(int) (uint64, error)) (*vector.Vector, error) {
+	var vec *vector.Vector
+	var rs []uint64
+	if lv.IsScalar() {
+		vec = proc.AllocScalarVector(rv.Typ)
+		rs = make([]uint64, 1)
+	} else {
+		var err error
+		if vec, err = proc.AllocVector(rv.Typ, int64(8*n)); err != nil {
+			return nil, err
+		}
+		rs = encoding.DecodeUint64Slice(vec.Data)[:n]
+	}
+	for i := range rs {
+		if nulls.Contains(lv.Nsp, uint64(i)) {
+			continue
+		}
+		v, err := fn(i)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = v
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastBitToString converts BIT to the big endian binary string as mysql does
+func CastBitToString(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := vector.MustTCols[uint64](lv)
+	values := &types.Bytes{
+		Offsets: make([]uint32, len(lvs)),
+		Lengths: make([]uint32, len(lvs)),
+	}
+	for i, v := range lvs {
+		values.Offsets[i] = uint32(len(values.Data))
+		if nulls.Contains(lv.Nsp, uint64(i)) {
+			continue
+		}
+		b := types.BitBytes(v, lv.Typ.Width)
+		values.Data = append(values.Data, b...)
+		values.Lengths[i] = uint32(len(b))
+	}
+	if lv.IsScalar() {
+		vec := proc.AllocScalarVector(rv.Typ)
+		vector.SetCol(vec, values)
+		return vec, nil
+	}
+	vec, err := proc.AllocVector(rv.Typ, int64(len(values.Data)))
+	if err != nil {
+		return nil, err
+	}
+	copy(vec.Data, values.Data)
+	values.Data = vec.Data[:len(values.Data)]
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, values)
+	return vec, nil
+}
+
+// CastStringToUuid parses the uuid texts, both with and without dashes
+func CastStringToUuid(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := vector.MustBytesCols(lv)
+	return castToUuid(lv, rv, proc, len(lvs.Offsets), func(i int) (types.Uuid, error) {
+		return types.ParseUuid(string(lvs.Get(int64(i))))
+	})
+}
+
+// castToUuid builds an UUID vector whose values are fn of the not null rows of lv
+func castToUuid(lv, rv *vector.Vector, proc *process.Process, n int, fn func(int) (types.Uuid, error)) (*vector.Vector, error) {
+	var vec *vector.Vector
+	var rs []types.Uuid
+	if lv.IsScalar() {
+		vec = proc.AllocScalarVector(rv.Typ)
+		rs = make([]types.Uuid, 1)
+	} else {
+		var err error
+		if vec, err = proc.AllocVector(rv.Typ, int64(types.UuidLen*n)); err != nil {
+			return nil, err
+		}
+		rs = encoding.DecodeUuidSlice(vec.Data)[:n]
+	}
+	for i := range rs {
+		if nulls.Contains(lv.Nsp, uint64(i)) {
+			continue
+		}
+		v, err := fn(i)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = v
+	}
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// CastUuidToString converts UUID to the 36 characters text form
+func CastUuidToString(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	lvs := vector.MustTCols[types.Uuid](lv)
+	values := &types.Bytes{
+		Offsets: make([]uint32, len(lvs)),
+		Lengths: make([]uint32, len(lvs)),
+	}
+	for i, v := range lvs {
+		values.Offsets[i] = uint32(len(values.Data))
+		if nulls.Contains(lv.Nsp, uint64(i)) {
+			continue
+		}
+		s := v.String()
+		values.Data = append(values.Data, s...)
+		values.Lengths[i] = uint32(len(s))
+	}
+	if lv.IsScalar() {
+		vec := proc.AllocScalarVector(rv.Typ)
+		vector.SetCol(vec, values)
+		return vec, nil
+	}
+	vec, err := proc.AllocVector(rv.Typ, int64(len(values.Data)))
+	if err != nil {
+		return nil, err
+	}
+	copy(vec.Data, values.Data)
+	values.Data = vec.Data[:len(values.Data)]
+	nulls.Set(vec.Nsp, lv.Nsp)
+	vector.SetCol(vec, values)
+	return vec, nil
+}
+
+// castBytes builds a bytes vector of rv's type whose values are fn of lv's values
+func castBytes(lv, rv *vector.Vector, proc *process.Process, fn func([]byte) ([]byte, error)) (*vector.Vector, error) {
+	source := vector.MustBytesCols(lv)
+	var vec *vector.Vector
+	if lv.IsScalar() {
+		vec = proc.AllocScalarVector(rv.Typ)
+		vec.Col = &types.Bytes{}
+	} else {
+		var err error
+		if vec, err = proc.AllocVector(rv.Typ, int64(len(source.Data))); err != nil {
+			return nil, err
+		}
+		vec.Col.(*types.Bytes).Data = vec.Data[:0]
+		nulls.Set(vec.Nsp, lv.Nsp)
+	}
+	target := vec.Col.(*types.Bytes)
+	target.Offsets = make([]uint32, len(source.Offsets))
+	target.Lengths = make([]uint32, len(source.Lengths))
+	for i := range source.Offsets {
+		target.Offsets[i] = uint32(len(target.Data))
+		if nulls.Contains(lv.Nsp, uint64(i)) {
+			continue
+		}
+		v, err := fn(source.Get(int64(i)))
+		if err != nil {
+			return nil, err
+		}
+		target.Data = append(target.Data, v...)
+		target.Lengths[i] = uint32(len(v))
+	}
+	return vec, nil
+}
+
 func CastSpecialIntToDecimal[T constraints.Integer](
 	lv, _ *vector.Vector,
 	i2d func(xs []T, rs []types.Decimal128) ([]types.Decimal128, error),
@@ -1430,10 +1749,11 @@ func CastVarcharAsTimestamp(lv, rv *vector.Vector, proc *process.Process) (*vect
 }
 
 // CastDecimal64AsDecimal128 : Cast converts decimal64 to timestamp decimal128
-func CastDecimal64AsDecimal128(lv, _ *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	lvScale := lv.Typ.Scale
-	resultScale := lvScale
-	resultTyp := types.Type{Oid: types.T_decimal128, Size: types.DECIMAL128_NBYTES, Width: types.DECIMAL128_WIDTH, Scale: resultScale}
+func CastDecimal64AsDecimal128(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultTyp := types.Type{Oid: types.T_decimal128, Size: types.DECIMAL128_NBYTES, Width: types.DECIMAL128_WIDTH, Scale: lv.Typ.Scale}
+	if rv.Typ.Width > 0 {
+		resultTyp.Width, resultTyp.Scale = rv.Typ.Width, rv.Typ.Scale
+	}
 	lvs := vector.MustTCols[types.Decimal64](lv)
 
 	if lv.IsScalar() {
@@ -1461,9 +1781,21 @@ func CastDecimal64AsDecimal128(lv, _ *vector.Vector, proc *process.Process) (*ve
 	return vec, nil
 }
 
+// decimalCastType returns the type of the result of casting a decimal to the
+// decimal of the same size, which takes the width and the scale of the target
+// if it has them, as the values keep all their digits.
+func decimalCastType(lv, rv *vector.Vector) types.Type {
+	typ := lv.Typ
+	typ.Size = int32(typ.Oid.TypeLen())
+	if rv.Typ.Width > 0 {
+		typ.Width, typ.Scale = rv.Typ.Width, rv.Typ.Scale
+	}
+	return typ
+}
+
 // CastDecimal64AsDecimal64 : Cast converts decimal64 to timestamp decimal64
-func CastDecimal64AsDecimal64(lv, _ *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	resultTyp := lv.Typ
+func CastDecimal64AsDecimal64(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultTyp := decimalCastType(lv, rv)
 	lvs := vector.MustTCols[types.Decimal64](lv)
 
 	if lv.IsScalar() {
@@ -1488,8 +1820,8 @@ func CastDecimal64AsDecimal64(lv, _ *vector.Vector, proc *process.Process) (*vec
 }
 
 // CastDecimal128AsDecimal128 : Cast converts decimal128 to timestamp decimal128
-func CastDecimal128AsDecimal128(lv, _ *vector.Vector, proc *process.Process) (*vector.Vector, error) {
-	resultTyp := lv.Typ
+func CastDecimal128AsDecimal128(lv, rv *vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	resultTyp := decimalCastType(lv, rv)
 	lvs := vector.MustTCols[types.Decimal128](lv)
 	if lv.IsScalar() {
 		vec := proc.AllocScalarVector(resultTyp)
@@ -2330,10 +2662,7 @@ func isNumeric(t types.T) bool {
 
 //  isString: return true if the types.T is string type
 func isString(t types.T) bool {
-	if t == types.T_char || t == types.T_varchar {
-		return true
-	}
-	return false
+	return t.IsString()
 }
 
 //  isDateSeries: return true if the types.T is date related type
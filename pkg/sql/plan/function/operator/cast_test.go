@@ -1543,6 +1543,83 @@ func TestCastSpecials3(t *testing.T) {
 
 }
 
+func TestCastStringToBinary(t *testing.T) {
+	// varchar -> binary(n) pads the value with 0x00
+	// varchar -> text, varbinary, blob keep the value as it is
+	makeTempVectors := func(src string, destType types.T, width int32, srcIsConst bool) []*vector.Vector {
+		vectors := make([]*vector.Vector, 2)
+		vectors[0] = makeStringVector(src, types.T_varchar, srcIsConst)
+		vectors[1] = makeTypeVector(destType)
+		vectors[1].Typ.Width = width
+		return vectors
+	}
+
+	procs := makeProcess()
+	cases := []struct {
+		name       string
+		vecs       []*vector.Vector
+		proc       *process.Process
+		wantBytes  []byte
+		wantScalar bool
+	}{
+		{
+			name:       "Test01",
+			vecs:       makeTempVectors("abc", types.T_binary, 5, true),
+			proc:       procs,
+			wantBytes:  []byte("abc\x00\x00"),
+			wantScalar: true,
+		},
+		{
+			name:       "Test02",
+			vecs:       makeTempVectors("abc", types.T_binary, 5, false),
+			proc:       procs,
+			wantBytes:  []byte("abc\x00\x00"),
+			wantScalar: false,
+		},
+		{
+			name:       "Test03",
+			vecs:       makeTempVectors("abcdef", types.T_binary, 5, false),
+			proc:       procs,
+			wantBytes:  []byte("abcdef"),
+			wantScalar: false,
+		},
+		{
+			name:       "Test04",
+			vecs:       makeTempVectors("abc", types.T_varbinary, 5, false),
+			proc:       procs,
+			wantBytes:  []byte("abc"),
+			wantScalar: false,
+		},
+		{
+			name:       "Test05",
+			vecs:       makeTempVectors("abc", types.T_text, 0, true),
+			proc:       procs,
+			wantBytes:  []byte("abc"),
+			wantScalar: true,
+		},
+		{
+			name:       "Test06",
+			vecs:       makeTempVectors("abc", types.T_blob, 0, false),
+			proc:       procs,
+			wantBytes:  []byte("abc"),
+			wantScalar: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			castRes, err := Cast(c.vecs, c.proc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			col := castRes.Col.(*types.Bytes)
+			require.Equal(t, c.wantBytes, col.Get(0))
+			require.Equal(t, c.vecs[1].Typ.Oid, castRes.Typ.Oid)
+			require.Equal(t, c.wantScalar, castRes.IsScalar())
+		})
+	}
+}
+
 func TestCastSpecial4(t *testing.T) {
 	//(int8/int16/int32/int64) to decimal128
 	// (uint8/uint16/uint32/uint64) to decimal128
@@ -4143,6 +4220,57 @@ func TestCastBoolAsString(t *testing.T) {
 
 }
 
+func TestCastBit(t *testing.T) {
+	procs := makeProcess()
+	bitType := func(width int32) *vector.Vector {
+		vec := makeTypeVector(types.T_bit)
+		vec.Typ.Width = width
+		return vec
+	}
+
+	res, err := Cast([]*vector.Vector{makeVector(int64(5), false), bitType(4)}, procs)
+	require.NoError(t, err)
+	require.Equal(t, types.T_bit, res.Typ.Oid)
+	require.Equal(t, []uint64{5}, res.Col)
+
+	_, err = Cast([]*vector.Vector{makeVector(int64(16), true), bitType(4)}, procs)
+	require.Error(t, err)
+	_, err = Cast([]*vector.Vector{makeVector(int8(-1), true), bitType(4)}, procs)
+	require.Error(t, err)
+
+	res, err = Cast([]*vector.Vector{makeStringVector("ab", types.T_varchar, true), bitType(16)}, procs)
+	require.NoError(t, err)
+	require.True(t, res.IsScalar())
+	require.Equal(t, []uint64{0x6162}, res.Col)
+
+	bits := makeVector(uint64(0x6162), false)
+	bits.Typ = types.Type{Oid: types.T_bit, Size: 8, Width: 16}
+	res, err = Cast([]*vector.Vector{bits, makeTypeVector(types.T_varchar)}, procs)
+	require.NoError(t, err)
+	require.Equal(t, "ab", string(vector.MustBytesCols(res).Get(0)))
+
+	res, err = Cast([]*vector.Vector{bits, makeTypeVector(types.T_int32)}, procs)
+	require.NoError(t, err)
+	require.Equal(t, []int32{0x6162}, res.Col)
+}
+
+func TestCastUuid(t *testing.T) {
+	procs := makeProcess()
+	text := "6ccd780c-baba-1026-9564-5b8c656024db"
+	res, err := Cast([]*vector.Vector{makeStringVector("6CCD780C-BABA-1026-9564-5B8C656024DB", types.T_varchar, false), makeTypeVector(types.T_uuid)}, procs)
+	require.NoError(t, err)
+	require.Equal(t, types.T_uuid, res.Typ.Oid)
+	ids := vector.MustTCols[types.Uuid](res)
+	require.Equal(t, text, ids[0].String())
+
+	res, err = Cast([]*vector.Vector{res, makeTypeVector(types.T_varchar)}, procs)
+	require.NoError(t, err)
+	require.Equal(t, text, string(vector.MustBytesCols(res).Get(0)))
+
+	_, err = Cast([]*vector.Vector{makeStringVector("not a uuid", types.T_varchar, true), makeTypeVector(types.T_uuid)}, procs)
+	require.Error(t, err)
+}
+
 // date to datetime and date to string
 func TestCastDateAsDatetimeAndString(t *testing.T) {
 	makeTempVectors := func(src string, srcIsConst bool, destType types.T) []*vector.Vector {
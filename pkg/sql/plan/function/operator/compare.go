@@ -133,7 +133,7 @@ func CompareOrdered[T constraints.Integer | constraints.Float, C CompOp[T]](c C,
 }
 
 type compFnType interface {
-	bool | types.Decimal64 | types.Decimal128
+	bool | types.Decimal64 | types.Decimal128 | types.Uuid
 }
 
 type compFn[T compFnType] func(v1, v2 T, s1, s2 int32) bool
@@ -226,6 +226,25 @@ func CompareDecimal128Ne(v1, v2 types.Decimal128, s1, s2 int32) bool {
 	return types.CompareDecimal128Decimal128(v1, v2, s1, s2) != 0
 }
 
+func CompareUuidEq(v1, v2 types.Uuid, _, _ int32) bool {
+	return v1 == v2
+}
+func CompareUuidLe(v1, v2 types.Uuid, _, _ int32) bool {
+	return v1.Compare(v2) <= 0
+}
+func CompareUuidLt(v1, v2 types.Uuid, _, _ int32) bool {
+	return v1.Compare(v2) < 0
+}
+func CompareUuidGe(v1, v2 types.Uuid, _, _ int32) bool {
+	return v1.Compare(v2) >= 0
+}
+func CompareUuidGt(v1, v2 types.Uuid, _, _ int32) bool {
+	return v1.Compare(v2) > 0
+}
+func CompareUuidNe(v1, v2 types.Uuid, _, _ int32) bool {
+	return v1 != v2
+}
+
 func CompareBoolEq(v1, v2 bool, s1, s2 int32) bool {
 	return v1 == v2
 }
@@ -326,6 +345,10 @@ func EqDecimal128(vs []*vector.Vector, proc *process.Process) (*vector.Vector, e
 	return CompareWithFn(vs, CompareDecimal128Eq, proc)
 }
 
+func EqUuid(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return CompareWithFn(vs, CompareUuidEq, proc)
+}
+
 func EqBool(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	return CompareWithFn(vs, CompareBoolEq, proc)
 }
@@ -347,6 +370,10 @@ func LeDecimal128(vs []*vector.Vector, proc *process.Process) (*vector.Vector, e
 	return CompareWithFn(vs, CompareDecimal128Le, proc)
 }
 
+func LeUuid(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return CompareWithFn(vs, CompareUuidLe, proc)
+}
+
 func LeBool(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	return CompareWithFn(vs, CompareBoolLe, proc)
 }
@@ -368,6 +395,10 @@ func LtDecimal128(vs []*vector.Vector, proc *process.Process) (*vector.Vector, e
 	return CompareWithFn(vs, CompareDecimal128Lt, proc)
 }
 
+func LtUuid(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return CompareWithFn(vs, CompareUuidLt, proc)
+}
+
 func LtBool(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	return CompareWithFn(vs, CompareBoolLt, proc)
 }
@@ -389,6 +420,10 @@ func GeDecimal128(vs []*vector.Vector, proc *process.Process) (*vector.Vector, e
 	return CompareWithFn(vs, CompareDecimal128Ge, proc)
 }
 
+func GeUuid(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return CompareWithFn(vs, CompareUuidGe, proc)
+}
+
 func GeBool(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	return CompareWithFn(vs, CompareBoolGe, proc)
 }
@@ -410,6 +445,10 @@ func GtDecimal128(vs []*vector.Vector, proc *process.Process) (*vector.Vector, e
 	return CompareWithFn(vs, CompareDecimal128Gt, proc)
 }
 
+func GtUuid(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return CompareWithFn(vs, CompareUuidGt, proc)
+}
+
 func GtBool(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	return CompareWithFn(vs, CompareBoolGt, proc)
 }
@@ -431,6 +470,10 @@ func NeDecimal128(vs []*vector.Vector, proc *process.Process) (*vector.Vector, e
 	return CompareWithFn(vs, CompareDecimal128Ne, proc)
 }
 
+func NeUuid(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+	return CompareWithFn(vs, CompareUuidNe, proc)
+}
+
 func NeBool(vs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
 	return CompareWithFn(vs, CompareBoolNe, proc)
 }
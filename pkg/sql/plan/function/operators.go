@@ -626,6 +626,17 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.EqGeneral[types.Timestamp],
 			},
+			{
+				Index:  18,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_uuid,
+					types.T_uuid,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.EqUuid,
+			},
 		},
 	},
 
@@ -831,6 +842,17 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.GtGeneral[types.Timestamp],
 			},
+			{
+				Index:  18,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_uuid,
+					types.T_uuid,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.GtUuid,
+			},
 		},
 	},
 
@@ -1036,6 +1058,17 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.GeGeneral[types.Timestamp],
 			},
+			{
+				Index:  18,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_uuid,
+					types.T_uuid,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.GeUuid,
+			},
 		},
 	},
 
@@ -1241,6 +1274,17 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.LtGeneral[types.Timestamp],
 			},
+			{
+				Index:  18,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_uuid,
+					types.T_uuid,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LtUuid,
+			},
 		},
 	},
 
@@ -1446,6 +1490,17 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.LeGeneral[types.Timestamp],
 			},
+			{
+				Index:  18,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_uuid,
+					types.T_uuid,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.LeUuid,
+			},
 		},
 	},
 
@@ -1651,6 +1706,17 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_bool,
 				Fn:        operator.NeGeneral[types.Timestamp],
 			},
+			{
+				Index:  18,
+				Flag:   plan.Function_STRICT,
+				Layout: COMPARISON_OPERATOR,
+				Args: []types.T{
+					types.T_uuid,
+					types.T_uuid,
+				},
+				ReturnTyp: types.T_bool,
+				Fn:        operator.NeUuid,
+			},
 		},
 	},
 
@@ -1661,10 +1727,7 @@ var operators = map[int]Functions{
 				return wrongFunctionParameters, nil
 			}
 			typ1, typ2 := inputs[0], inputs[1]
-			if typ1 != types.T_char && typ1 != types.T_varchar {
-				return wrongFunctionParameters, nil
-			}
-			if typ2 != types.T_char && typ2 != types.T_varchar {
+			if !typ1.IsString() || !typ2.IsString() {
 				return wrongFunctionParameters, nil
 			}
 			return 0, nil
@@ -2598,10 +2661,12 @@ var operators = map[int]Functions{
 	CAST: {
 		Id: CAST,
 		TypeCheckFn: func(overloads []Function, inputs []types.T) (overloadIndex int32, _ []types.T) {
-			// cast-operator should check param types strictly
+			// cast-operator should check param types strictly,
+			// the text and binary strings share the overloads of varchar
 			if len(inputs) == 2 {
+				in0, in1 := castStringType(inputs[0]), castStringType(inputs[1])
 				for i, o := range overloads {
-					if o.Args[0] == inputs[0] && o.Args[1] == inputs[1] {
+					if o.Args[0] == in0 && o.Args[1] == in1 {
 						return int32(i), nil
 					}
 				}
@@ -4425,6 +4490,254 @@ var operators = map[int]Functions{
 				ReturnTyp: types.T_decimal64,
 				Fn:        operator.Cast,
 			},
+			{
+				Index:     227,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_varchar, types.T_json},
+				ReturnTyp: types.T_json,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     228,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_char, types.T_json},
+				ReturnTyp: types.T_json,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     229,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_json, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     230,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_json, types.T_char},
+				ReturnTyp: types.T_char,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     231,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_json, types.T_json},
+				ReturnTyp: types.T_json,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     232,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_int8, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     233,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_int16, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     234,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_int32, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     235,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_int64, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     236,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uint8, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     237,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uint16, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     238,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uint32, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     239,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uint64, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     240,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_int8},
+				ReturnTyp: types.T_int8,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     241,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_int16},
+				ReturnTyp: types.T_int16,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     242,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_int32},
+				ReturnTyp: types.T_int32,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     243,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_int64},
+				ReturnTyp: types.T_int64,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     244,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_uint8},
+				ReturnTyp: types.T_uint8,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     245,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_uint16},
+				ReturnTyp: types.T_uint16,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     246,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_uint32},
+				ReturnTyp: types.T_uint32,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     247,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_uint64},
+				ReturnTyp: types.T_uint64,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     248,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     249,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_varchar, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     250,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_char, types.T_bit},
+				ReturnTyp: types.T_bit,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     251,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     252,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_bit, types.T_char},
+				ReturnTyp: types.T_char,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     253,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_varchar, types.T_uuid},
+				ReturnTyp: types.T_uuid,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     254,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_char, types.T_uuid},
+				ReturnTyp: types.T_uuid,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     255,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uuid, types.T_uuid},
+				ReturnTyp: types.T_uuid,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     256,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uuid, types.T_varchar},
+				ReturnTyp: types.T_varchar,
+				Fn:        operator.Cast,
+			},
+			{
+				Index:     257,
+				Flag:      plan.Function_STRICT,
+				Layout:    CAST_EXPRESSION,
+				Args:      []types.T{types.T_uuid, types.T_char},
+				ReturnTyp: types.T_char,
+				Fn:        operator.Cast,
+			},
 		},
 	},
 
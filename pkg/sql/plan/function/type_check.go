@@ -60,6 +60,7 @@ func initTypeCheckRelated() {
 		types.T_date, types.T_datetime, types.T_timestamp,
 		types.T_char, types.T_varchar,
 		types.T_decimal64, types.T_decimal128,
+		types.T_uuid,
 	}
 	numbers := []types.T{ // numbers without decimal
 		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
@@ -70,6 +71,8 @@ func initTypeCheckRelated() {
 	floats := []types.T{types.T_float32, types.T_float64}
 	strings := []types.T{types.T_char, types.T_varchar}
 	decimals := []types.T{types.T_decimal64, types.T_decimal128}
+	// strings without operators of their own, they work as varchar
+	varStrings := []types.T{types.T_text, types.T_binary, types.T_varbinary, types.T_blob}
 
 	// init binaryTable
 	var convertRuleForBinaryTable [][4]types.T // left-input, right-input, left-target, right-target
@@ -154,6 +157,21 @@ func initTypeCheckRelated() {
 				convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{t2, t1, t2, t2})
 			}
 		}
+		for _, t1 := range varStrings {
+			convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{ScalarNull, t1, types.T_varchar, types.T_varchar})
+			convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{t1, ScalarNull, types.T_varchar, types.T_varchar})
+			for _, t2 := range append(strings, varStrings...) {
+				convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{t1, t2, types.T_varchar, types.T_varchar})
+				convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{t2, t1, types.T_varchar, types.T_varchar})
+			}
+			for _, t2 := range all {
+				if t2 == types.T_any || t2 == types.T_char || t2 == types.T_varchar {
+					continue
+				}
+				convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{t1, t2, t2, t2})
+				convertRuleForBinaryTable = append(convertRuleForBinaryTable, [4]types.T{t2, t1, t2, t2})
+			}
+		}
 	}
 
 	binaryTable = make([][]binaryTargetTypes, maxTypes)
@@ -171,6 +189,7 @@ func initTypeCheckRelated() {
 	// init binaryTable2
 	var convertRuleForBinaryTable2 [][4]types.T
 	{
+		strings := append(strings, varStrings...)
 		for _, typ := range all {
 			convertRuleForBinaryTable2 = append(convertRuleForBinaryTable2, [4]types.T{ScalarNull, typ, types.T_float64, types.T_float64})
 			convertRuleForBinaryTable2 = append(convertRuleForBinaryTable2, [4]types.T{typ, ScalarNull, types.T_float64, types.T_float64})
@@ -332,6 +351,39 @@ func initTypeCheckRelated() {
 				castTable[t][typ] = true
 			}
 		}
+		// json converts from and to its text
+		castTable[types.T_json][types.T_json] = true
+		for _, t := range strings {
+			castTable[t][types.T_json] = true
+			castTable[types.T_json][t] = true
+		}
+		// bit converts from and to integers and its binary string
+		castTable[types.T_bit][types.T_bit] = true
+		for _, t := range numbers {
+			castTable[t][types.T_bit] = true
+			castTable[types.T_bit][t] = true
+		}
+		for _, t := range strings {
+			castTable[t][types.T_bit] = true
+			castTable[types.T_bit][t] = true
+		}
+		// uuid converts from and to its text form only
+		castTable[types.T_uuid][types.T_uuid] = true
+		for _, t := range strings {
+			castTable[t][types.T_uuid] = true
+			castTable[types.T_uuid][t] = true
+		}
+		for _, t := range varStrings {
+			for i := range castTable {
+				castTable[t][i] = castTable[types.T_varchar][i]
+				castTable[i][t] = castTable[i][types.T_varchar]
+			}
+		}
+		for _, t := range append(strings, varStrings...) {
+			for _, typ := range varStrings {
+				castTable[t][typ] = true
+			}
+		}
 	}
 
 	// init preferredTypeConvert
@@ -347,6 +399,10 @@ func initTypeCheckRelated() {
 		types.T_float32:    {types.T_float64},
 		types.T_char:       {types.T_varchar},
 		types.T_varchar:    {types.T_char},
+		types.T_text:       {types.T_varchar},
+		types.T_binary:     {types.T_varchar},
+		types.T_varbinary:  {types.T_varchar},
+		types.T_blob:       {types.T_varchar},
 		types.T_decimal64:  {types.T_decimal128, types.T_float64},
 		types.T_decimal128: {types.T_float64},
 	}
@@ -400,6 +456,51 @@ func generalBinaryOperatorTypeCheckFn(overloads []Function, inputs []types.T, co
 	return wrongFunctionParameters, targets
 }
 
+// variadicTypeCheck returns a type check function for a function with only one overload,
+// the inputs after the declared arguments are checked against the last declared argument.
+// It requires at least min and at most max inputs, a negative max means no limit.
+// An argument declared as T_any accepts any type without conversion.
+func variadicTypeCheck(min, max int) func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+	return func(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+		if len(inputs) < min || (max >= 0 && len(inputs) > max) {
+			return wrongFunctionParameters, nil
+		}
+		args := overloads[0].Args
+		ts = make([]types.T, len(inputs))
+		convert := false
+		for i, t := range inputs {
+			require := args[len(args)-1]
+			if i < len(args) {
+				require = args[i]
+			}
+			switch {
+			case require == types.T_any || t == ScalarNull:
+				ts[i] = types.T_any
+			case t == require:
+				ts[i] = t
+			case castTable[t][require]:
+				ts[i] = require
+				convert = true
+			default:
+				return wrongFunctionParameters, nil
+			}
+		}
+		if convert {
+			return 0, ts
+		}
+		return 0, nil
+	}
+}
+
+// castStringType returns the type whose cast overloads are used for typ
+func castStringType(typ types.T) types.T {
+	switch typ {
+	case types.T_text, types.T_binary, types.T_varbinary, types.T_blob:
+		return types.T_varchar
+	}
+	return typ
+}
+
 func generalBinaryParamsConvert(l, r types.T) (types.T, types.T, bool) {
 	ts := binaryTable[l][r] // targets
 	if ts.convert {
@@ -428,6 +529,14 @@ func generalTypeCheckForUnaryAggregate(overloads []Function, inputs []types.T) (
 				return int32(i), nil
 			}
 		}
+		// text and binary strings are aggregated as varchar
+		if t := castStringType(inputs[0]); t != inputs[0] {
+			for i, o := range overloads {
+				if o.Args[0] == t {
+					return int32(i), []types.T{t}
+				}
+			}
+		}
 	}
 	return wrongFunctionParameters, nil
 }
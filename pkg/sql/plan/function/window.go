@@ -0,0 +1,162 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+func initWindowFunction() {
+	var err error
+
+	for fid, fs := range windows {
+		err = appendFunction(fid, fs)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+// windowValueTypes are the types of the values which can be returned by
+// the value window functions, such as LAG and FIRST_VALUE.
+var windowValueTypes = []types.T{
+	types.T_bool,
+	types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+	types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	types.T_float32, types.T_float64,
+	types.T_decimal64, types.T_decimal128,
+	types.T_date, types.T_datetime, types.T_timestamp,
+	types.T_char, types.T_varchar,
+}
+
+// windows contains the window function indexed by function id.
+var windows = map[int]Functions{
+	ROW_NUMBER: {
+		Id:          ROW_NUMBER,
+		TypeCheckFn: rankTypeCheck,
+		Overloads:   makeRankOverloads(),
+	},
+	RANK: {
+		Id:          RANK,
+		TypeCheckFn: rankTypeCheck,
+		Overloads:   makeRankOverloads(),
+	},
+	DENSE_RANK: {
+		Id:          DENSE_RANK,
+		TypeCheckFn: rankTypeCheck,
+		Overloads:   makeRankOverloads(),
+	},
+	LAG: {
+		Id:          LAG,
+		TypeCheckFn: offsetTypeCheck,
+		Overloads:   makeValueOverloads(),
+	},
+	LEAD: {
+		Id:          LEAD,
+		TypeCheckFn: offsetTypeCheck,
+		Overloads:   makeValueOverloads(),
+	},
+	FIRST_VALUE: {
+		Id:          FIRST_VALUE,
+		TypeCheckFn: generalTypeCheckForUnaryAggregate,
+		Overloads:   makeValueOverloads(),
+	},
+	LAST_VALUE: {
+		Id:          LAST_VALUE,
+		TypeCheckFn: generalTypeCheckForUnaryAggregate,
+		Overloads:   makeValueOverloads(),
+	},
+}
+
+func makeRankOverloads() []Function {
+	return []Function{
+		{
+			Index:     0,
+			Flag:      plan.Function_WIN,
+			Layout:    STANDARD_FUNCTION,
+			Args:      []types.T{},
+			ReturnTyp: types.T_int64,
+		},
+	}
+}
+
+func makeValueOverloads() []Function {
+	fs := make([]Function, len(windowValueTypes))
+	for i, typ := range windowValueTypes {
+		fs[i] = Function{
+			Index:     int32(i),
+			Flag:      plan.Function_WIN,
+			Layout:    STANDARD_FUNCTION,
+			Args:      []types.T{typ},
+			ReturnTyp: typ,
+		}
+	}
+	return fs
+}
+
+func rankTypeCheck(_ []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+	if len(inputs) == 0 {
+		return 0, nil
+	}
+	return wrongFunctionParameters, nil
+}
+
+// offsetTypeCheck checks the arguments of LAG(expr [, N [, default]]) and LEAD(expr [, N [, default]]),
+// the N will be cast to int64 and the default will be cast to the type of expr.
+func offsetTypeCheck(overloads []Function, inputs []types.T) (overloadIndex int32, ts []types.T) {
+	if len(inputs) < 1 || len(inputs) > 3 {
+		return wrongFunctionParameters, nil
+	}
+	overloadIndex = wrongFunctionParameters
+	if inputs[0] == ScalarNull {
+		overloadIndex = 0
+	}
+	for i, o := range overloads {
+		if o.Args[0] == inputs[0] {
+			overloadIndex = int32(i)
+			break
+		}
+	}
+	if overloadIndex == wrongFunctionParameters {
+		return wrongFunctionParameters, nil
+	}
+	ts = make([]types.T, len(inputs))
+	ts[0] = inputs[0]
+	needCast := false
+	if len(inputs) > 1 {
+		switch inputs[1] {
+		case types.T_int64:
+		case types.T_int8, types.T_int16, types.T_int32, types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+			needCast = true
+		default:
+			return wrongFunctionParameters, nil
+		}
+		ts[1] = types.T_int64
+	}
+	if len(inputs) > 2 {
+		ts[2] = overloads[overloadIndex].Args[0]
+		if inputs[2] != ts[2] && inputs[2] != ScalarNull {
+			if !castTable[inputs[2]][ts[2]] {
+				return wrongFunctionParameters, nil
+			}
+			needCast = true
+		}
+	}
+	if !needCast {
+		return overloadIndex, nil
+	}
+	return overloadIndex, ts
+}
@@ -15,6 +15,8 @@
 package plan
 
 import (
+	"fmt"
+
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -49,6 +51,134 @@ func (b *GroupBinder) BindExpr(astExpr tree.Expr, depth int32, isRoot bool) (*pl
 	return expr, err
 }
 
+// bindGroupingSets binds a GROUP BY clause with ROLLUP, CUBE or GROUPING SETS,
+// the grouping sets of its items are combined by cross product.
+func (b *GroupBinder) bindGroupingSets(groupBy tree.GroupBy) error {
+	b.ctx.groups = append(b.ctx.groups, makePlan2Int64ConstExprWithType(0))
+
+	sets := [][]int32{{}}
+	for _, item := range groupBy {
+		var itemSets [][]int32
+		if groupingSets, ok := item.(*tree.GroupingSetsExpr); ok {
+			keys := make([][]int32, len(groupingSets.Exprs))
+			for i, astExpr := range groupingSets.Exprs {
+				var err error
+				keys[i], err = b.bindGroupKeys(astExpr)
+				if err != nil {
+					return err
+				}
+			}
+
+			switch groupingSets.Type {
+			case tree.GROUPING_ROLLUP:
+				for i := len(keys); i >= 0; i-- {
+					var set []int32
+					for _, key := range keys[:i] {
+						set = append(set, key...)
+					}
+					itemSets = append(itemSets, set)
+				}
+
+			case tree.GROUPING_CUBE:
+				if len(keys) > maxCubeKeys {
+					return errors.New("", fmt.Sprintf("CUBE of more than %d expressions is not supported", maxCubeKeys))
+				}
+				for mask := 0; mask < 1<<len(keys); mask++ {
+					var set []int32
+					for i, key := range keys {
+						if mask&(1<<(len(keys)-1-i)) == 0 {
+							set = append(set, key...)
+						}
+					}
+					itemSets = append(itemSets, set)
+				}
+
+			default:
+				itemSets = keys
+			}
+		} else {
+			key, err := b.bindGroupKeys(item)
+			if err != nil {
+				return err
+			}
+			itemSets = [][]int32{key}
+		}
+
+		newSets := make([][]int32, 0, len(sets)*len(itemSets))
+		for _, set := range sets {
+			for _, itemSet := range itemSets {
+				newSet := make([]int32, 0, len(set)+len(itemSet))
+				newSets = append(newSets, append(append(newSet, set...), itemSet...))
+			}
+		}
+		if len(newSets) > maxGroupingSets {
+			return errors.New("", fmt.Sprintf("more than %d grouping sets are not supported", maxGroupingSets))
+		}
+		sets = newSets
+	}
+
+	b.ctx.groupingSets = make([][]int32, len(sets))
+	for i, set := range sets {
+		grouped := make(map[int32]bool)
+		for _, pos := range set {
+			grouped[pos] = true
+		}
+		for pos := int32(1); pos < int32(len(b.ctx.groups)); pos++ {
+			if grouped[pos] {
+				continue
+			}
+			b.ctx.groupingSets[i] = append(b.ctx.groupingSets[i], pos)
+			if !b.ctx.groups[pos].Typ.Nullable {
+				b.ctx.groups[pos] = DeepCopyExpr(b.ctx.groups[pos])
+				b.ctx.groups[pos].Typ.Nullable = true
+			}
+		}
+	}
+
+	return nil
+}
+
+// bindGroupKeys binds an element of ROLLUP, CUBE or GROUPING SETS, a tuple
+// is an element of all its expressions.
+func (b *GroupBinder) bindGroupKeys(astExpr tree.Expr) ([]int32, error) {
+	var astExprs tree.Exprs
+	switch e := astExpr.(type) {
+	case *tree.Tuple:
+		astExprs = e.Exprs
+	case *tree.ParenExpr:
+		astExprs = tree.Exprs{e.Expr}
+	default:
+		astExprs = tree.Exprs{astExpr}
+	}
+
+	keys := make([]int32, len(astExprs))
+	for i, astExpr := range astExprs {
+		astExpr, err := b.ctx.qualifyColumnNames(astExpr, nil, false)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = b.BindExpr(astExpr, 0, true)
+		if err != nil {
+			return nil, err
+		}
+
+		keys[i] = b.ctx.groupByAst[tree.String(astExpr, dialect.MYSQL)]
+	}
+
+	return keys, nil
+}
+
+func hasGroupingSets(groupBy tree.GroupBy) bool {
+	for _, item := range groupBy {
+		if _, ok := item.(*tree.GroupingSetsExpr); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (b *GroupBinder) BindColRef(astExpr *tree.UnresolvedName, depth int32, isRoot bool) (*plan.Expr, error) {
 	expr, err := b.baseBindColRef(astExpr, depth, isRoot)
 	if err != nil {
@@ -94,8 +94,18 @@ func (b *HavingBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr, dept
 		return nil, errors.New(errno.GroupingError, "aggregate function calls cannot be nested")
 	}
 
+	if funcName == "count" && len(astExpr.Exprs) > 1 && astExpr.Type != tree.FUNC_TYPE_DISTINCT {
+		return nil, errors.New("", "count function of several args need distinct")
+	}
+
 	b.insideAgg = true
-	expr, err := b.bindFuncExprImplByAstExpr(funcName, astExpr.Exprs, depth)
+	var expr *plan.Expr
+	var err error
+	if funcName == "group_concat" {
+		expr, err = b.bindGroupConcat(astExpr, depth)
+	} else {
+		expr, err = b.bindFuncExprImplByAstExpr(funcName, astExpr.Exprs, depth)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -15,8 +15,11 @@
 package plan
 
 import (
+	"fmt"
+
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
@@ -201,6 +204,9 @@ func makePlan2CastExpr(expr *Expr, targetType *Type) (*Expr, error) {
 		expr.Typ = copyType(targetType)
 		return expr, nil
 	}
+	if isEnumType(expr.Typ) || isEnumType(targetType) {
+		return makeEnumCastExpr(expr, targetType)
+	}
 	id, _, _, err := function.GetFunctionByName("cast", []types.Type{t1, t2})
 	if err != nil {
 		return nil, err
@@ -219,14 +225,111 @@ func makePlan2CastExpr(expr *Expr, targetType *Type) (*Expr, error) {
 	}, nil
 }
 
+func isEnumType(t *Type) bool {
+	return t.Id == plan.Type_ENUM || t.Id == plan.Type_SET
+}
+
+// makeEnumCastExpr converts from and to ENUM and SET, whose values are only
+// meaningful with the members of their columns. They are converted to the
+// other types through the names of their members.
+func makeEnumCastExpr(expr *Expr, targetType *Type) (*Expr, error) {
+	var err error
+	if isEnumType(expr.Typ) {
+		args := []*Expr{makePlan2StringConstExprWithType(expr.Typ.Enumvalues), expr}
+		if expr, err = bindFuncExprImplByPlanExpr("cast_enum_to_value", args); err != nil {
+			return nil, err
+		}
+	}
+	if !isEnumType(targetType) {
+		if expr.Typ.Id == targetType.Id {
+			return expr, nil
+		}
+		return appendCastBeforeExpr(expr, targetType)
+	}
+	name := "cast_value_to_enum"
+	if targetType.Id == plan.Type_SET {
+		name = "cast_value_to_set"
+	}
+	args := []*Expr{makePlan2StringConstExprWithType(targetType.Enumvalues), expr}
+	if expr, err = bindFuncExprImplByPlanExpr(name, args); err != nil {
+		return nil, err
+	}
+	expr.Typ = copyType(targetType)
+	return expr, nil
+}
+
+// unionColumnType returns the type which the columns of both sides of a set operation
+// are cast to. The columns are cast to string if either of them is a string, or an
+// ENUM or SET of other members.
+func unionColumnType(t1, t2 *plan.Type) (*plan.Type, error) {
+	if isDecimalType(t1) && isDecimalType(t2) {
+		return unionDecimalType(t1, t2), nil
+	}
+	if t1.Id == t2.Id && t1.Enumvalues == t2.Enumvalues {
+		typ := copyType(t1)
+		if t2.Width > typ.Width {
+			typ.Width = t2.Width
+		}
+		return typ, nil
+	}
+	if types.T(t1.Id) == types.T_any {
+		return t2, nil
+	}
+	if types.T(t2.Id) == types.T_any {
+		return t1, nil
+	}
+
+	typ1, typ2 := makeTypeByPlan2Type(t1), makeTypeByPlan2Type(t2)
+	if typ1.IsString() || typ2.IsString() || isEnumType(t1) || isEnumType(t2) {
+		typ := makePlan2Type(&types.Type{Oid: types.T_varchar, Size: int32(types.T_varchar.TypeLen())})
+		typ.Width = t1.Width
+		if t2.Width > typ.Width {
+			typ.Width = t2.Width
+		}
+		return typ, nil
+	}
+
+	_, _, argTypes, err := function.GetFunctionByName("=", []types.Type{typ1, typ2})
+	if err != nil {
+		return nil, errors.New("", fmt.Sprintf("The types %s and %s of the used SELECT statements are not compatible", typ1, typ2))
+	}
+	return makePlan2Type(&argTypes[0]), nil
+}
+
+func isDecimalType(t *Type) bool {
+	return t.Id == plan.Type_DECIMAL64 || t.Id == plan.Type_DECIMAL128
+}
+
+// unionDecimalType returns the decimal which holds the values of both decimals,
+// with the larger number of integer digits and the larger scale.
+func unionDecimalType(t1, t2 *plan.Type) *plan.Type {
+	scale := t1.Scale
+	if t2.Scale > scale {
+		scale = t2.Scale
+	}
+	digits := t1.Width - t1.Scale
+	if d := t2.Width - t2.Scale; d > digits {
+		digits = d
+	}
+	width := digits + scale
+	if width > 38 {
+		width = 38
+	}
+	if width > 18 {
+		return &plan.Type{Id: plan.Type_DECIMAL128, Nullable: t1.Nullable || t2.Nullable, Size: 16, Width: width, Scale: scale}
+	}
+	return &plan.Type{Id: plan.Type_DECIMAL64, Nullable: t1.Nullable || t2.Nullable, Size: 8, Width: width, Scale: scale}
+}
+
 func copyType(t *Type) *Type {
 	return &Type{
-		Id:        t.Id,
-		Nullable:  t.Nullable,
-		Width:     t.Width,
-		Precision: t.Precision,
-		Size:      t.Size,
-		Scale:     t.Scale,
+		Id:         t.Id,
+		Nullable:   t.Nullable,
+		Width:      t.Width,
+		Precision:  t.Precision,
+		Size:       t.Size,
+		Scale:      t.Scale,
+		Enumvalues: t.Enumvalues,
 	}
 }
 
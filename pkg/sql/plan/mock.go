@@ -63,8 +63,15 @@ func NewEmptyCompilerContext() *MockCompilerContext {
 }
 
 type Schema struct {
-	cols []col
-	card float64
+	cols   []col
+	card   float64
+	view   string
+	checks []*plan.CheckDef
+	fks    []*plan.ForeignKeyDef
+	part   *plan.PartitionDef
+	idxes  []*plan.IndexDef
+	// enums are the members of the ENUM and SET columns by name
+	enums map[string]string
 }
 
 const SF float64 = 1
@@ -189,6 +196,105 @@ func NewMockCompilerContext() *MockCompilerContext {
 		card: SF * 6e5,
 	}
 
+	tpchSchema["v_nation"] = &Schema{
+		cols: []col{
+			{"k", plan.Type_INT32, false, 0, 0},
+			{"n_name", plan.Type_VARCHAR, false, 25, 0},
+		},
+		view: "create view v_nation (k, n_name) as select n_nationkey, n_name from nation where n_regionkey > 0",
+	}
+	tpchSchema["v_loop"] = &Schema{
+		cols: []col{
+			{"a", plan.Type_INT32, false, 0, 0},
+		},
+		view: "create view v_loop as select * from v_loop",
+	}
+	tpchSchema["t_chk"] = &Schema{
+		cols: []col{
+			{"a", plan.Type_INT32, false, 0, 0},
+			{"b", plan.Type_INT32, false, 0, 0},
+			{"c", plan.Type_VARCHAR, false, 10, 0},
+		},
+		checks: []*plan.CheckDef{
+			{Name: "c1", Expr: "a > b"},
+			{Name: "t_chk_chk_1", Expr: "c <> 'x'"},
+		},
+	}
+	tpchSchema["t_fk"] = &Schema{
+		cols: []col{
+			{"a", plan.Type_INT32, false, 0, 0},
+			{"b", plan.Type_INT32, true, 0, 0},
+		},
+		fks: []*plan.ForeignKeyDef{
+			{Name: "fk1", Cols: []string{"b"}, ParentTable: "nation", ParentCols: []string{"n_nationkey"}},
+		},
+	}
+
+	tpchSchema["t_part"] = &Schema{
+		cols: []col{
+			{"a", plan.Type_INT32, false, 0, 0},
+			{"d", plan.Type_DATE, false, 0, 0},
+		},
+		part: &plan.PartitionDef{
+			Type: plan.PartitionDef_RANGE,
+			Func: "year",
+			Cols: []string{"d"},
+			Partitions: []*plan.PartitionDef_Partition{
+				{Name: "p0", Values: []string{"2020"}},
+				{Name: "p1", Values: []string{"2021"}},
+				{Name: "p2", Values: []string{"2022"}},
+			},
+		},
+	}
+	tpchSchema["t_lob"] = &Schema{
+		cols: []col{
+			{"a", plan.Type_INT32, false, 0, 0},
+			{"b", plan.Type_TEXT, true, 65535, 0},
+			{"c", plan.Type_BLOB, true, 65535, 0},
+			{"d", plan.Type_BINARY, true, 4, 0},
+			{"e", plan.Type_VARBINARY, true, 10, 0},
+		},
+	}
+
+	tpchSchema["t_json"] = &Schema{
+		cols: []col{
+			{"a", plan.Type_INT32, false, 0, 0},
+			{"j", plan.Type_JSON, true, 0, 0},
+		},
+	}
+
+	tpchSchema["t_enum"] = &Schema{
+		cols: []col{
+			{"a", plan.Type_INT32, false, 0, 0},
+			{"e", plan.Type_ENUM, true, 0, 0},
+			{"s", plan.Type_SET, true, 0, 0},
+			{"b", plan.Type_BIT, true, 8, 0},
+		},
+		enums: map[string]string{
+			"e": "small,medium,large",
+			"s": "red,green,blue",
+		},
+	}
+
+	tpchSchema["t_uuid"] = &Schema{
+		cols: []col{
+			{"id", plan.Type_UUID, false, 16, 0},
+			{"b", plan.Type_VARBINARY, true, 16, 0},
+		},
+	}
+
+	tpchSchema["articles"] = &Schema{
+		cols: []col{
+			{"id", plan.Type_INT32, false, 0, 0},
+			{"title", plan.Type_VARCHAR, true, 200, 0},
+			{"body", plan.Type_TEXT, true, 0, 0},
+		},
+		idxes: []*plan.IndexDef{
+			{Typ: plan.IndexDef_FULLTEXT, Name: "title", ColNames: []string{"title"}},
+			{Typ: plan.IndexDef_FULLTEXT, Name: "title_2", ColNames: []string{"title", "body"}},
+		},
+	}
+
 	moSchema["mo_database"] = &Schema{
 		cols: []col{
 			{"datname", plan.Type_VARCHAR, false, 50, 0},
@@ -198,6 +304,8 @@ func NewMockCompilerContext() *MockCompilerContext {
 		cols: []col{
 			{"reldatabase", plan.Type_VARCHAR, false, 50, 0},
 			{"relname", plan.Type_VARCHAR, false, 50, 0},
+			{"relkind", plan.Type_VARCHAR, false, 50, 0},
+			{"rel_createsql", plan.Type_VARCHAR, false, 1024, 0},
 		},
 	}
 	moSchema["mo_columns"] = &Schema{
@@ -227,10 +335,11 @@ func NewMockCompilerContext() *MockCompilerContext {
 			for _, col := range table.cols {
 				colDefs = append(colDefs, &ColDef{
 					Typ: &plan.Type{
-						Id:        col.Id,
-						Nullable:  col.Nullable,
-						Width:     col.Width,
-						Precision: col.Precision,
+						Id:         col.Id,
+						Nullable:   col.Nullable,
+						Width:      col.Width,
+						Precision:  col.Precision,
+						Enumvalues: table.enums[col.Name],
 					},
 					Name:  col.Name,
 					Pkidx: 1,
@@ -252,6 +361,29 @@ func NewMockCompilerContext() *MockCompilerContext {
 				Name: tableName,
 				Cols: colDefs,
 			}
+			if table.view != "" {
+				tables[tableName].View = &ViewDef{View: table.view}
+			}
+			for _, check := range table.checks {
+				tables[tableName].Defs = append(tables[tableName].Defs, &plan.TableDef_DefType{
+					Def: &plan.TableDef_DefType_Check{Check: check},
+				})
+			}
+			for _, fk := range table.fks {
+				tables[tableName].Defs = append(tables[tableName].Defs, &plan.TableDef_DefType{
+					Def: &plan.TableDef_DefType_Fk{Fk: fk},
+				})
+			}
+			for _, idx := range table.idxes {
+				tables[tableName].Defs = append(tables[tableName].Defs, &plan.TableDef_DefType{
+					Def: &plan.TableDef_DefType_Idx{Idx: idx},
+				})
+			}
+			if table.part != nil {
+				tables[tableName].Defs = append(tables[tableName].Defs, &plan.TableDef_DefType{
+					Def: &plan.TableDef_DefType_Partition{Partition: table.part},
+				})
+			}
 			tableIdx++
 
 			if table.card == 0 {
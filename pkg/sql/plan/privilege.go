@@ -0,0 +1,292 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// RequiredPrivilege is a privilege needed to run a plan.
+// It is a database privilege if TableName is empty.
+// An empty DbName means the default database.
+type RequiredPrivilege struct {
+	Type      tree.PrivilegeType
+	DbName    string
+	TableName string
+}
+
+// PrivilegeChecker is implemented by the CompilerContext that knows the
+// privileges of the current user
+type PrivilegeChecker interface {
+	// CheckPrivileges returns an error if any of the privileges is not granted
+	CheckPrivileges(privs []*RequiredPrivilege) error
+}
+
+// CheckPlanPrivileges checks the privileges required by the plan if ctx is a PrivilegeChecker
+func CheckPlanPrivileges(ctx CompilerContext, p *Plan) error {
+	checker, ok := ctx.(PrivilegeChecker)
+	if !ok {
+		return nil
+	}
+	return checker.CheckPrivileges(GetRequiredPrivileges(p))
+}
+
+// GetRequiredPrivileges returns the privileges required to run the plan.
+// The tables read by a query need SELECT, except the table changed by
+// UPDATE or DELETE, which needs SELECT only if its columns are read.
+func GetRequiredPrivileges(p *Plan) []*RequiredPrivilege {
+	switch pn := p.Plan.(type) {
+	case *plan.Plan_Query:
+		return getQueryPrivileges(pn.Query)
+	case *plan.Plan_Ddl:
+		return getDdlPrivileges(pn.Ddl)
+	case *plan.Plan_Dcl:
+		if prepare := pn.Dcl.GetPrepare(); prepare != nil && prepare.Plan != nil {
+			return GetRequiredPrivileges(prepare.Plan)
+		}
+	}
+	return nil
+}
+
+func getQueryPrivileges(qry *Query) []*RequiredPrivilege {
+	var privs []*RequiredPrivilege
+	changed := make(map[[2]string]bool)
+	for _, node := range qry.Nodes {
+		switch node.NodeType {
+		case plan.Node_INSERT:
+			privs = append(privs, newObjRefPrivilege(tree.PRIVILEGE_TYPE_STATIC_INSERT, node.ObjRef))
+		case plan.Node_UPDATE:
+			privs = append(privs, newObjRefPrivilege(tree.PRIVILEGE_TYPE_STATIC_UPDATE, node.ObjRef))
+			if node.UpdateInfo.GetReadColumns() {
+				privs = append(privs, newObjRefPrivilege(tree.PRIVILEGE_TYPE_STATIC_SELECT, node.ObjRef))
+			}
+			changed[[2]string{node.ObjRef.SchemaName, node.ObjRef.ObjName}] = true
+		case plan.Node_DELETE:
+			for _, ctx := range node.DeleteTablesCtx {
+				privs = append(privs, &RequiredPrivilege{
+					Type:      tree.PRIVILEGE_TYPE_STATIC_DELETE,
+					DbName:    ctx.DbName,
+					TableName: ctx.TblName,
+				})
+				if ctx.ReadColumns {
+					privs = append(privs, &RequiredPrivilege{
+						Type:      tree.PRIVILEGE_TYPE_STATIC_SELECT,
+						DbName:    ctx.DbName,
+						TableName: ctx.TblName,
+					})
+				}
+				changed[[2]string{ctx.DbName, ctx.TblName}] = true
+			}
+		}
+	}
+	for _, node := range qry.Nodes {
+		if node.NodeType != plan.Node_TABLE_SCAN || node.ObjRef == nil {
+			continue
+		}
+		if changed[[2]string{node.ObjRef.SchemaName, node.ObjRef.ObjName}] {
+			continue
+		}
+		privs = append(privs, newObjRefPrivilege(tree.PRIVILEGE_TYPE_STATIC_SELECT, node.ObjRef))
+	}
+	return privs
+}
+
+func getDdlPrivileges(ddl *plan.DataDefinition) []*RequiredPrivilege {
+	switch df := ddl.Definition.(type) {
+	case *plan.DataDefinition_CreateDatabase:
+		return []*RequiredPrivilege{
+			{Type: tree.PRIVILEGE_TYPE_STATIC_CREATE, DbName: df.CreateDatabase.Database},
+		}
+	case *plan.DataDefinition_DropDatabase:
+		return []*RequiredPrivilege{
+			{Type: tree.PRIVILEGE_TYPE_STATIC_DROP, DbName: df.DropDatabase.Database},
+		}
+	case *plan.DataDefinition_CreateTable:
+		typ := tree.PRIVILEGE_TYPE_STATIC_CREATE
+		if df.CreateTable.TableDef.GetView() != nil {
+			typ = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
+		}
+		return []*RequiredPrivilege{
+			{Type: typ, DbName: df.CreateTable.Database, TableName: df.CreateTable.TableDef.GetName()},
+		}
+	case *plan.DataDefinition_DropTable:
+		return []*RequiredPrivilege{
+			{Type: tree.PRIVILEGE_TYPE_STATIC_DROP, DbName: df.DropTable.Database, TableName: df.DropTable.Table},
+		}
+	case *plan.DataDefinition_AlterTable:
+		alter := df.AlterTable
+		privs := []*RequiredPrivilege{
+			{Type: tree.PRIVILEGE_TYPE_STATIC_ALTER, DbName: alter.Database, TableName: alter.Table},
+		}
+		for _, action := range alter.Actions {
+			//renaming moves the rows of the table into a new one
+			if rename := action.GetRenameTable(); rename != nil {
+				privs = append(privs,
+					&RequiredPrivilege{Type: tree.PRIVILEGE_TYPE_STATIC_DROP, DbName: alter.Database, TableName: alter.Table},
+					&RequiredPrivilege{Type: tree.PRIVILEGE_TYPE_STATIC_CREATE, DbName: rename.Database, TableName: rename.Table},
+					&RequiredPrivilege{Type: tree.PRIVILEGE_TYPE_STATIC_INSERT, DbName: rename.Database, TableName: rename.Table})
+			}
+		}
+		return privs
+	}
+	//show statements read the catalog
+	if ddl.Query != nil {
+		return getQueryPrivileges(ddl.Query)
+	}
+	return nil
+}
+
+// readsColumns returns true if any of the expressions reads a column of the table
+// named name, by its alias or by its own name. A column is of the table if it is
+// qualified by the name, or if it is unqualified and the table has the column.
+// The unqualified columns in the subqueries may be of the tables of the subqueries,
+// they are taken as read in doubt.
+func readsColumns(name string, tableDef *TableDef, exprs ...tree.Expr) bool {
+	for _, expr := range exprs {
+		if readsColumnsOfExpr(name, tableDef, expr) {
+			return true
+		}
+	}
+	return false
+}
+
+func readsColumnsOfExpr(name string, tableDef *TableDef, expr tree.Expr) bool {
+	reads := func(exprs ...tree.Expr) bool {
+		return readsColumns(name, tableDef, exprs...)
+	}
+	switch e := expr.(type) {
+	case *tree.UnresolvedName:
+		if e.NumParts > 1 {
+			return e.Parts[1] == name
+		}
+		if e.Star {
+			return false
+		}
+		for _, col := range tableDef.Cols {
+			if col.Name == e.Parts[0] {
+				return true
+			}
+		}
+		return false
+	case *tree.ParenExpr:
+		return reads(e.Expr)
+	case *tree.NotExpr:
+		return reads(e.Expr)
+	case *tree.UnaryExpr:
+		return reads(e.Expr)
+	case *tree.IsNullExpr:
+		return reads(e.Expr)
+	case *tree.IsNotNullExpr:
+		return reads(e.Expr)
+	case *tree.CastExpr:
+		return reads(e.Expr)
+	case *tree.IntervalExpr:
+		return reads(e.Expr)
+	case *tree.AndExpr:
+		return reads(e.Left, e.Right)
+	case *tree.OrExpr:
+		return reads(e.Left, e.Right)
+	case *tree.XorExpr:
+		return reads(e.Left, e.Right)
+	case *tree.BinaryExpr:
+		return reads(e.Left, e.Right)
+	case *tree.ComparisonExpr:
+		return reads(e.Left, e.Right, e.Escape)
+	case *tree.RangeCond:
+		return reads(e.Left, e.From, e.To)
+	case *tree.FuncExpr:
+		return reads(e.Exprs...)
+	case *tree.Tuple:
+		return reads(e.Exprs...)
+	case *tree.ExprList:
+		return reads(e.Exprs...)
+	case *tree.CaseExpr:
+		if reads(e.Expr, e.Else) {
+			return true
+		}
+		for _, when := range e.Whens {
+			if reads(when.Cond, when.Val) {
+				return true
+			}
+		}
+		return false
+	case *tree.FullTextMatchExpr:
+		for _, col := range e.Cols {
+			if reads(col) {
+				return true
+			}
+		}
+		return reads(e.Query)
+	case *tree.Subquery:
+		return readsColumnsOfSelect(name, tableDef, e.Select)
+	}
+	return false
+}
+
+func readsColumnsOfSelect(name string, tableDef *TableDef, stmt tree.SelectStatement) bool {
+	switch s := stmt.(type) {
+	case *tree.Select:
+		return readsColumnsOfSelect(name, tableDef, s.Select)
+	case *tree.ParenSelect:
+		return readsColumnsOfSelect(name, tableDef, s.Select)
+	case *tree.UnionClause:
+		return readsColumnsOfSelect(name, tableDef, s.Left) || readsColumnsOfSelect(name, tableDef, s.Right)
+	case *tree.SelectClause:
+		var exprs []tree.Expr
+		for _, expr := range s.Exprs {
+			exprs = append(exprs, expr.Expr)
+		}
+		if s.From != nil {
+			exprs = append(exprs, getJoinConds(s.From.Tables)...)
+		}
+		if s.Where != nil {
+			exprs = append(exprs, s.Where.Expr)
+		}
+		if s.Having != nil {
+			exprs = append(exprs, s.Having.Expr)
+		}
+		exprs = append(exprs, s.GroupBy...)
+		return readsColumns(name, tableDef, exprs...)
+	}
+	return false
+}
+
+// getJoinConds returns the ON conditions of the joins in the tables
+func getJoinConds(tables tree.TableExprs) []tree.Expr {
+	var conds []tree.Expr
+	for _, table := range tables {
+		switch t := table.(type) {
+		case *tree.JoinTableExpr:
+			conds = append(conds, getJoinConds(tree.TableExprs{t.Left, t.Right})...)
+			if on, ok := t.Cond.(*tree.OnJoinCond); ok {
+				conds = append(conds, on.Expr)
+			}
+		case *tree.ParenTableExpr:
+			conds = append(conds, getJoinConds(tree.TableExprs{t.Expr})...)
+		case *tree.AliasedTableExpr:
+			conds = append(conds, getJoinConds(tree.TableExprs{t.Expr})...)
+		}
+	}
+	return conds
+}
+
+func newObjRefPrivilege(typ tree.PrivilegeType, obj *ObjectRef) *RequiredPrivilege {
+	return &RequiredPrivilege{
+		Type:      typ,
+		DbName:    obj.GetSchemaName(),
+		TableName: obj.GetObjName(),
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+var errPrivilegeDenied = errors.New("privilege denied")
+
+// privilegeMockContext grants only the privileges in the granted set
+type privilegeMockContext struct {
+	*MockCompilerContext
+	granted map[RequiredPrivilege]bool
+}
+
+func (m *privilegeMockContext) CheckPrivileges(privs []*RequiredPrivilege) error {
+	for _, priv := range privs {
+		if !m.granted[*priv] {
+			return errPrivilegeDenied
+		}
+	}
+	return nil
+}
+
+func buildPrivilegeTestPlan(t *testing.T, ctx CompilerContext, sql string) (*Plan, error) {
+	stmts, err := mysql.Parse(sql)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return BuildPlan(ctx, stmts[0])
+}
+
+func TestGetRequiredPrivileges(t *testing.T) {
+	ctx := NewMockCompilerContext()
+	type testCase struct {
+		sql   string
+		privs []RequiredPrivilege
+	}
+	cases := []testCase{
+		{
+			sql: "select n_name from nation join region on n_regionkey = r_regionkey",
+			privs: []RequiredPrivilege{
+				{Type: tree.PRIVILEGE_TYPE_STATIC_SELECT, DbName: "tpch", TableName: "nation"},
+				{Type: tree.PRIVILEGE_TYPE_STATIC_SELECT, DbName: "tpch", TableName: "region"},
+			},
+		},
+		{
+			sql: "insert into nation select * from nation2",
+			privs: []RequiredPrivilege{
+				{Type: tree.PRIVILEGE_TYPE_STATIC_INSERT, DbName: "tpch", TableName: "nation"},
+				{Type: tree.PRIVILEGE_TYPE_STATIC_SELECT, DbName: "tpch", TableName: "nation2"},
+			},
+		},
+		{
+			sql: "update nation set n_name = 'a'",
+			privs: []RequiredPrivilege{
+				{Type: tree.PRIVILEGE_TYPE_STATIC_UPDATE, DbName: "tpch", TableName: "nation"},
+			},
+		},
+		{
+			sql: "update nation set n_name = 'a' where n_nationkey > 10",
+			privs: []RequiredPrivilege{
+				{Type: tree.PRIVILEGE_TYPE_STATIC_UPDATE, DbName: "tpch", TableName: "nation"},
+				{Type: tree.PRIVILEGE_TYPE_STATIC_SELECT, DbName: "tpch", TableName: "nation"},
+			},
+		},
+		{
+			sql: "update nation n set n_name = concat(n.n_comment, 'a')",
+			privs: []RequiredPrivilege{
+				{Type: tree.PRIVILEGE_TYPE_STATIC_UPDATE, DbName: "tpch", TableName: "nation"},
+				{Type: tree.PRIVILEGE_TYPE_STATIC_SELECT, DbName: "tpch", TableName: "nation"},
+			},
+		},
+		{
+			sql: "delete from nation where n_nationkey > 10",
+			privs: []RequiredPrivilege{
+				{Type: tree.PRIVILEGE_TYPE_STATIC_DELETE, DbName: "tpch", TableName: "nation"},
+				{Type: tree.PRIVILEGE_TYPE_STATIC_SELECT, DbName: "tpch", TableName: "nation"},
+			},
+		},
+		{
+			sql: "delete from nation where 1 in (select r_regionkey from region)",
+			privs: []RequiredPrivilege{
+				{Type: tree.PRIVILEGE_TYPE_STATIC_DELETE, DbName: "tpch", TableName: "nation"},
+				{Type: tree.PRIVILEGE_TYPE_STATIC_SELECT, DbName: "tpch", TableName: "region"},
+			},
+		},
+		{
+			sql: "delete from nation where exists (select 1 from region where r_regionkey = nation.n_regionkey)",
+			privs: []RequiredPrivilege{
+				{Type: tree.PRIVILEGE_TYPE_STATIC_DELETE, DbName: "tpch", TableName: "nation"},
+				{Type: tree.PRIVILEGE_TYPE_STATIC_SELECT, DbName: "tpch", TableName: "nation"},
+				{Type: tree.PRIVILEGE_TYPE_STATIC_SELECT, DbName: "tpch", TableName: "region"},
+			},
+		},
+		{
+			sql: "delete nation, region from nation join region on n_regionkey = r_regionkey",
+			privs: []RequiredPrivilege{
+				{Type: tree.PRIVILEGE_TYPE_STATIC_DELETE, DbName: "tpch", TableName: "nation"},
+				{Type: tree.PRIVILEGE_TYPE_STATIC_SELECT, DbName: "tpch", TableName: "nation"},
+				{Type: tree.PRIVILEGE_TYPE_STATIC_DELETE, DbName: "tpch", TableName: "region"},
+				{Type: tree.PRIVILEGE_TYPE_STATIC_SELECT, DbName: "tpch", TableName: "region"},
+			},
+		},
+		{
+			sql: "create database db1",
+			privs: []RequiredPrivilege{
+				{Type: tree.PRIVILEGE_TYPE_STATIC_CREATE, DbName: "db1"},
+			},
+		},
+		{
+			sql: "drop table tpch.nation",
+			privs: []RequiredPrivilege{
+				{Type: tree.PRIVILEGE_TYPE_STATIC_DROP, DbName: "tpch", TableName: "nation"},
+			},
+		},
+	}
+	for _, c := range cases {
+		p, err := buildPrivilegeTestPlan(t, ctx, c.sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, c.sql)
+		}
+		privs := GetRequiredPrivileges(p)
+		if len(privs) != len(c.privs) {
+			t.Fatalf("sql=%v, expect %v privileges, got %v", c.sql, len(c.privs), len(privs))
+		}
+		for i, priv := range privs {
+			if *priv != c.privs[i] {
+				t.Fatalf("sql=%v, expect %+v, got %+v", c.sql, c.privs[i], *priv)
+			}
+		}
+	}
+}
+
+func TestCheckPlanPrivileges(t *testing.T) {
+	ctx := &privilegeMockContext{
+		MockCompilerContext: NewMockCompilerContext(),
+		granted: map[RequiredPrivilege]bool{
+			{Type: tree.PRIVILEGE_TYPE_STATIC_SELECT, DbName: "tpch", TableName: "nation"}: true,
+			{Type: tree.PRIVILEGE_TYPE_STATIC_CREATE, DbName: "db1"}:                       true,
+		},
+	}
+	for _, sql := range []string{
+		"select * from nation",
+		"create database db1",
+	} {
+		p, err := buildPrivilegeTestPlan(t, ctx, sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		if err = CheckPlanPrivileges(ctx, p); err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+	}
+	for _, sql := range []string{
+		"select * from nation join region on n_regionkey = r_regionkey",
+		"delete from nation",
+		"create database db2",
+		"drop database db1",
+	} {
+		p, err := buildPrivilegeTestPlan(t, ctx, sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		if err = CheckPlanPrivileges(ctx, p); err != errPrivilegeDenied {
+			t.Fatalf("sql=%v should be denied, got %v", sql, err)
+		}
+	}
+}
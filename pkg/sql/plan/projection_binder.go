@@ -15,10 +15,14 @@
 package plan
 
 import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 )
 
 func NewProjectionBinder(builder *QueryBuilder, ctx *BindContext, havingBinder *HavingBinder) *ProjectionBinder {
@@ -71,7 +75,222 @@ func (b *ProjectionBinder) BindAggFunc(funcName string, astExpr *tree.FuncExpr,
 }
 
 func (b *ProjectionBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth int32, isRoot bool) (*plan.Expr, error) {
-	return nil, errors.New("", "window functions will be supported in future version")
+	if astExpr.WindowSpec == nil {
+		return nil, errors.New(errno.WindowingError, fmt.Sprintf("window function %s requires an OVER clause", funcName))
+	}
+	if b.insideWin {
+		return nil, errors.New(errno.WindowingError, "window function calls cannot be nested")
+	}
+	if !function.GetFunctionIsWinfunByName(funcName) && !function.GetFunctionIsAggregateByName(funcName) {
+		return nil, errors.New(errno.WindowingError, fmt.Sprintf("OVER specified, but %s is not a window function nor an aggregate function", funcName))
+	}
+	if astExpr.Type == tree.FUNC_TYPE_DISTINCT {
+		return nil, errors.New(errno.WindowingError, "DISTINCT is not supported in window functions")
+	}
+	switch funcName {
+	case "group_concat", "median", "percentile_cont", "percentile_disc":
+		return nil, errors.New(errno.WindowingError, fmt.Sprintf("%s is not supported in window functions", funcName))
+	}
+
+	astStr := tree.String(astExpr, dialect.MYSQL)
+	if expr, ok := b.ctx.windowByAst[astStr]; ok {
+		return DeepCopyExpr(expr), nil
+	}
+
+	b.insideWin = true
+	expr, err := b.bindFuncExprImplByAstExpr(funcName, astExpr.Exprs, depth)
+	if err != nil {
+		return nil, err
+	}
+	if funcName == "lag" || funcName == "lead" {
+		if len(expr.GetF().Args) > 1 {
+			if offset, ok := getWindowOffset(expr.GetF().Args[1]); !ok || offset < 0 {
+				return nil, errors.New(errno.WindowingError, fmt.Sprintf("the offset of %s must be a non-negative integer", funcName))
+			}
+		}
+	}
+	spec, err := b.bindWindowSpec(astExpr.WindowSpec, depth)
+	if err != nil {
+		return nil, err
+	}
+	b.insideWin = false
+
+	specStr := spec.String()
+	w, ok := b.ctx.windowBySpec[specStr]
+	if !ok {
+		w = &WindowRef{
+			tag:  b.builder.genNewTag(),
+			spec: spec,
+		}
+		b.ctx.windowBySpec[specStr] = w
+		b.ctx.windows = append(b.ctx.windows, w)
+	}
+	colPos := int32(len(w.funcs))
+	w.funcs = append(w.funcs, expr)
+	b.builder.nameByColRef[[2]int32{w.tag, colPos}] = astStr
+
+	expr = &plan.Expr{
+		Typ: expr.Typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: w.tag,
+				ColPos: colPos,
+			},
+		},
+	}
+	b.ctx.windowByAst[astStr] = expr
+
+	return DeepCopyExpr(expr), nil
+}
+
+func (b *ProjectionBinder) bindWindowSpec(astSpec *tree.WindowSpec, depth int32) (*plan.WindowSpec, error) {
+	spec := &plan.WindowSpec{
+		PartitionBy: make([]*plan.Expr, 0, len(astSpec.PartitionBy)),
+		OrderBy:     make([]*plan.OrderBySpec, 0, len(astSpec.OrderBy)),
+	}
+
+	for _, astExpr := range astSpec.PartitionBy {
+		expr, err := b.BindExpr(astExpr, depth, false)
+		if err != nil {
+			return nil, err
+		}
+		spec.PartitionBy = append(spec.PartitionBy, expr)
+	}
+
+	for _, order := range astSpec.OrderBy {
+		expr, err := b.BindExpr(order.Expr, depth, false)
+		if err != nil {
+			return nil, err
+		}
+
+		orderBy := &plan.OrderBySpec{
+			Expr: expr,
+		}
+
+		switch order.Direction {
+		case tree.DefaultDirection:
+			orderBy.Flag = plan.OrderBySpec_INTERNAL
+		case tree.Ascending:
+			orderBy.Flag = plan.OrderBySpec_ASC
+		case tree.Descending:
+			orderBy.Flag = plan.OrderBySpec_DESC
+		}
+
+		spec.OrderBy = append(spec.OrderBy, orderBy)
+	}
+
+	frame, err := b.bindFrameClause(astSpec.Frame, spec.OrderBy, depth)
+	if err != nil {
+		return nil, err
+	}
+	spec.Frame = frame
+
+	return spec, nil
+}
+
+func (b *ProjectionBinder) bindFrameClause(astFrame *tree.FrameClause, orderBys []*plan.OrderBySpec, depth int32) (*plan.FrameClause, error) {
+	// the default frame is the rows from the start of the partition to the last peer of the current row,
+	// it is the whole partition if there is no ORDER BY
+	if astFrame == nil {
+		frame := &plan.FrameClause{
+			Type: plan.FrameClause_RANGE,
+			Start: &plan.FrameBound{
+				Type:      plan.FrameBound_PRECEDING,
+				Unbounded: true,
+			},
+			End: &plan.FrameBound{
+				Type: plan.FrameBound_CURRENT_ROW,
+			},
+		}
+		if len(orderBys) == 0 {
+			frame.End = &plan.FrameBound{
+				Type:      plan.FrameBound_FOLLOWING,
+				Unbounded: true,
+			}
+		}
+		return frame, nil
+	}
+
+	frame := &plan.FrameClause{
+		Type: plan.FrameClause_ROWS,
+	}
+	if astFrame.Type == tree.FRAME_RANGE {
+		frame.Type = plan.FrameClause_RANGE
+	}
+
+	var err error
+	if frame.Start, err = b.bindFrameBound(astFrame.Start, frame.Type, orderBys, depth); err != nil {
+		return nil, err
+	}
+	if astFrame.End == nil {
+		frame.End = &plan.FrameBound{
+			Type: plan.FrameBound_CURRENT_ROW,
+		}
+	} else if frame.End, err = b.bindFrameBound(astFrame.End, frame.Type, orderBys, depth); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case frame.Start.Type == plan.FrameBound_FOLLOWING && frame.Start.Unbounded:
+		return nil, errors.New(errno.WindowingError, "frame start cannot be UNBOUNDED FOLLOWING")
+	case frame.End.Type == plan.FrameBound_PRECEDING && frame.End.Unbounded:
+		return nil, errors.New(errno.WindowingError, "frame end cannot be UNBOUNDED PRECEDING")
+	case frame.Start.Type > frame.End.Type:
+		return nil, errors.New(errno.WindowingError, "frame starting from the following row cannot end with the current row or the preceding row")
+	}
+
+	return frame, nil
+}
+
+func (b *ProjectionBinder) bindFrameBound(astBound *tree.FrameBound, typ plan.FrameClause_FrameType, orderBys []*plan.OrderBySpec, depth int32) (*plan.FrameBound, error) {
+	bound := &plan.FrameBound{
+		Unbounded: astBound.Unbounded,
+	}
+	switch astBound.Type {
+	case tree.PRECEDING:
+		bound.Type = plan.FrameBound_PRECEDING
+	case tree.CURRENT_ROW:
+		bound.Type = plan.FrameBound_CURRENT_ROW
+	case tree.FOLLOWING:
+		bound.Type = plan.FrameBound_FOLLOWING
+	}
+	if astBound.Expr == nil {
+		return bound, nil
+	}
+
+	val, err := b.BindExpr(astBound.Expr, depth, false)
+	if err != nil {
+		return nil, err
+	}
+	if offset, ok := getWindowOffset(val); !ok || offset < 0 {
+		return nil, errors.New(errno.WindowingError, "the offset of the frame must be a non-negative integer")
+	}
+	if typ == plan.FrameClause_RANGE {
+		if len(orderBys) != 1 {
+			return nil, errors.New(errno.WindowingError, "RANGE with offset PRECEDING/FOLLOWING requires exactly one ORDER BY column")
+		}
+		switch orderBys[0].Expr.Typ.Id {
+		case plan.Type_INT8, plan.Type_INT16, plan.Type_INT32, plan.Type_INT64,
+			plan.Type_UINT8, plan.Type_UINT16, plan.Type_UINT32, plan.Type_UINT64,
+			plan.Type_FLOAT32, plan.Type_FLOAT64:
+		default:
+			return nil, errors.New(errno.WindowingError, "RANGE with offset PRECEDING/FOLLOWING requires a numeric ORDER BY column")
+		}
+	}
+	bound.Val = val
+
+	return bound, nil
+}
+
+// getWindowOffset returns the value of the integer constant used as
+// the offset of LAG/LEAD or the frame bound.
+func getWindowOffset(expr *plan.Expr) (int64, bool) {
+	if c, ok := expr.Expr.(*plan.Expr_C); ok {
+		if ival, ok := c.C.Value.(*plan.Const_Ival); ok {
+			return ival.Ival, true
+		}
+	}
+	return 0, false
 }
 
 func (b *ProjectionBinder) BindSubquery(astExpr *tree.Subquery, isRoot bool) (*plan.Expr, error) {
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
@@ -159,6 +160,10 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			increaseRefCnt(expr, colRefCnt)
 		}
 
+		for _, expr := range node.FilterList {
+			increaseRefCnt(expr, colRefCnt)
+		}
+
 		internalMap := make(map[[2]int32][2]int32)
 
 		leftID := node.Children[0]
@@ -189,6 +194,15 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			}
 		}
 
+		// the comparison of the subquery of a MARK join
+		for _, expr := range node.FilterList {
+			decreaseRefCnt(expr, colRefCnt)
+			err := builder.remapExpr(expr, internalMap)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		childProjList := builder.qry.Nodes[leftID].ProjectList
 		for i, globalRef := range leftRemapping.localToGlobal {
 			if colRefCnt[globalRef] == 0 {
@@ -370,6 +384,105 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			}
 		}
 
+	case plan.Node_WINDOW:
+		for _, expr := range node.WinSpec.PartitionBy {
+			increaseRefCnt(expr, colRefCnt)
+		}
+
+		for _, orderBy := range node.WinSpec.OrderBy {
+			increaseRefCnt(orderBy.Expr, colRefCnt)
+		}
+
+		for _, expr := range node.AggList {
+			increaseRefCnt(expr, colRefCnt)
+		}
+
+		childRemapping, err := builder.remapAllColRefs(node.Children[0], colRefCnt)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, expr := range node.WinSpec.PartitionBy {
+			decreaseRefCnt(expr, colRefCnt)
+			err := builder.remapExpr(expr, childRemapping.globalToLocal)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, orderBy := range node.WinSpec.OrderBy {
+			decreaseRefCnt(orderBy.Expr, colRefCnt)
+			err := builder.remapExpr(orderBy.Expr, childRemapping.globalToLocal)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, expr := range node.AggList {
+			decreaseRefCnt(expr, colRefCnt)
+			err := builder.remapExpr(expr, childRemapping.globalToLocal)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		childProjList := builder.qry.Nodes[node.Children[0]].ProjectList
+		for i, globalRef := range childRemapping.localToGlobal {
+			if colRefCnt[globalRef] == 0 {
+				continue
+			}
+
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: childProjList[i].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: int32(i),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
+		windowTag := node.BindingTags[0]
+		for idx, expr := range node.AggList {
+			globalRef := [2]int32{windowTag, int32(idx)}
+			if colRefCnt[globalRef] == 0 {
+				continue
+			}
+
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: expr.Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: -1,
+						ColPos: int32(idx),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
+		if len(node.ProjectList) == 0 {
+			globalRef := [2]int32{windowTag, 0}
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: node.AggList[0].Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: -1,
+						ColPos: 0,
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
 	case plan.Node_SORT:
 		for _, orderBy := range node.OrderBy {
 			increaseRefCnt(orderBy.Expr, colRefCnt)
@@ -478,7 +591,64 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, colRefCnt map[[2]int3
 			})
 		}
 
-	case plan.Node_PROJECT, plan.Node_MATERIAL:
+	case plan.Node_SINK_SCAN:
+		// the rows of the last iteration always contain all the columns
+		tag := node.BindingTags[0]
+
+		for i, col := range node.TableDef.Cols {
+			globalRef := [2]int32{tag, int32(i)}
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: col.Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: int32(i),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
+	case plan.Node_UNION, plan.Node_UNION_ALL, plan.Node_INTERSECT, plan.Node_INTERSECT_ALL, plan.Node_MINUS, plan.Node_MINUS_ALL:
+		// all the columns of the children are needed to combine the rows
+		unionTag := node.BindingTags[0]
+
+		for _, childID := range node.Children {
+			child := builder.qry.Nodes[childID]
+			childTag := child.BindingTags[0]
+			for i := range child.ProjectList {
+				colRefCnt[[2]int32{childTag, int32(i)}]++
+			}
+
+			_, err := builder.remapAllColRefs(childID, colRefCnt)
+			if err != nil {
+				return nil, err
+			}
+
+			for i := range child.ProjectList {
+				colRefCnt[[2]int32{childTag, int32(i)}]--
+			}
+		}
+
+		for i, expr := range builder.qry.Nodes[node.Children[0]].ProjectList {
+			globalRef := [2]int32{unionTag, int32(i)}
+			remapping.addColRef(globalRef)
+
+			node.ProjectList = append(node.ProjectList, &plan.Expr{
+				Typ: expr.Typ,
+				Expr: &plan.Expr_Col{
+					Col: &plan.ColRef{
+						RelPos: 0,
+						ColPos: int32(i),
+						Name:   builder.nameByColRef[globalRef],
+					},
+				},
+			})
+		}
+
+	case plan.Node_PROJECT, plan.Node_MATERIAL, plan.Node_RECURSIVE_CTE:
 		projectTag := node.BindingTags[0]
 
 		var neededProj []int32
@@ -576,6 +746,7 @@ func (builder *QueryBuilder) createQuery() (*Query, error) {
 	for i, rootId := range builder.qry.Steps {
 		rootId = builder.pushdownSemiAntiJoins(rootId)
 		rootId, _ = builder.pushdownFilters(rootId, nil)
+		builder.prunePartitions(rootId)
 		rootId = builder.determineJoinOrder(rootId)
 		builder.qry.Steps[i] = rootId
 
@@ -620,8 +791,9 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 			maskedNames = append(maskedNames, name)
 
 			ctx.cteByName[name] = &CTERef{
-				ast:        cte,
-				maskedCTEs: maskedCTEs,
+				ast:         cte,
+				maskedCTEs:  maskedCTEs,
+				isRecursive: stmt.With.IsRecursive,
 			}
 		}
 	}
@@ -634,7 +806,7 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 	case *tree.ParenSelect:
 		return builder.buildSelect(selectClause.Select, ctx, isRoot)
 	case *tree.UnionClause:
-		return 0, errors.New("", fmt.Sprintf("'%s' will be supported in future version.", selectClause.Type.String()))
+		return builder.buildUnion(stmt, selectClause, ctx, isRoot)
 	case *tree.ValuesClause:
 		return 0, errors.New("", "'SELECT FROM VALUES' will be supported in future version.")
 	default:
@@ -730,7 +902,9 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		var expr *plan.Expr
 
 		for _, cond := range whereList {
-			nodeID, expr, err = builder.flattenSubqueries(nodeID, cond, ctx)
+			builder.markFullTextFilter(cond, ctx)
+
+			nodeID, expr, err = builder.flattenSubqueries(nodeID, cond, ctx, true)
 			if err != nil {
 				return 0, err
 			}
@@ -752,7 +926,12 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 	ctx.projectTag = builder.genNewTag()
 
 	// bind GROUP BY clause
-	if clause.GroupBy != nil {
+	if clause.GroupBy != nil && hasGroupingSets(clause.GroupBy) {
+		err = NewGroupBinder(builder, ctx).bindGroupingSets(clause.GroupBy)
+		if err != nil {
+			return 0, err
+		}
+	} else if clause.GroupBy != nil {
 		groupBinder := NewGroupBinder(builder, ctx)
 		for _, group := range clause.GroupBy {
 			group, err = ctx.qualifyColumnNames(group, nil, false)
@@ -876,10 +1055,29 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 
 	// append AGG node
 	if len(ctx.groups) > 0 || len(ctx.aggregates) > 0 {
+		groupingSets := make([]*plan.Expr, len(ctx.groupingSets))
+		for i, set := range ctx.groupingSets {
+			keys := make([]*plan.Expr, len(set))
+			for j, pos := range set {
+				keys[j] = makePlan2Int64ConstExprWithType(int64(pos))
+			}
+			groupingSets[i] = &plan.Expr{
+				Typ: &plan.Type{
+					Id: plan.Type_TUPLE,
+				},
+				Expr: &plan.Expr_List{
+					List: &plan.ExprList{
+						List: keys,
+					},
+				},
+			}
+		}
+
 		nodeID = builder.appendNode(&plan.Node{
 			NodeType:    plan.Node_AGG,
 			Children:    []int32{nodeID},
 			GroupBy:     ctx.groups,
+			GroupingSet: groupingSets,
 			AggList:     ctx.aggregates,
 			BindingTags: []int32{ctx.groupTag, ctx.aggregateTag},
 		}, ctx)
@@ -889,7 +1087,7 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 			var expr *plan.Expr
 
 			for _, cond := range havingList {
-				nodeID, expr, err = builder.flattenSubqueries(nodeID, cond, ctx)
+				nodeID, expr, err = builder.flattenSubqueries(nodeID, cond, ctx, true)
 				if err != nil {
 					return 0, err
 				}
@@ -909,24 +1107,33 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		for name, id := range ctx.groupByAst {
 			builder.nameByColRef[[2]int32{ctx.groupTag, id}] = name
 		}
+		if len(ctx.groupingSets) > 0 {
+			builder.nameByColRef[[2]int32{ctx.groupTag, 0}] = "grouping_id"
+		}
 
 		for name, id := range ctx.aggregateByAst {
 			builder.nameByColRef[[2]int32{ctx.aggregateTag, id}] = name
 		}
 	}
 
+	// append WINDOW node
+	for _, w := range ctx.windows {
+		nodeID = builder.appendNode(&plan.Node{
+			NodeType:    plan.Node_WINDOW,
+			Children:    []int32{nodeID},
+			WinSpec:     w.spec,
+			AggList:     w.funcs,
+			BindingTags: []int32{w.tag},
+		}, ctx)
+	}
+
 	// append PROJECT node
 	for i, proj := range ctx.projects {
-		nodeID, proj, err = builder.flattenSubqueries(nodeID, proj, ctx)
+		nodeID, proj, err = builder.flattenSubqueries(nodeID, proj, ctx, false)
 		if err != nil {
 			return 0, err
 		}
 
-		if proj == nil {
-			// TODO: implement MARK join to better support non-scalar subqueries
-			return 0, errors.New("", "non-scalar subquery in SELECT clause will be supported in future version.")
-		}
-
 		ctx.projects[i] = proj
 	}
 
@@ -989,11 +1196,441 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 
 	if isRoot {
 		builder.qry.Headings = append(builder.qry.Headings, ctx.headings...)
+		return builder.appendEnumResultProject(nodeID, ctx)
 	}
 
 	return nodeID, nil
 }
 
+// buildUnion builds the set operations UNION, INTERSECT and EXCEPT. The columns of both
+// sides are cast to the same types, and the ORDER BY and LIMIT of the statement apply to
+// the result of the set operation, which is built as a derived table for them.
+func (builder *QueryBuilder) buildUnion(stmt *tree.Select, clause *tree.UnionClause, ctx *BindContext, isRoot bool) (int32, error) {
+	if stmt.OrderBy != nil || stmt.Limit != nil {
+		return builder.buildSelect(&tree.Select{
+			Select: &tree.SelectClause{
+				Exprs: tree.SelectExprs{{Expr: tree.UnqualifiedStar{}}},
+				From: &tree.From{
+					Tables: tree.TableExprs{&tree.AliasedTableExpr{
+						Expr: &tree.Select{Select: clause},
+						As:   tree.AliasClause{Alias: tree.Identifier(clause.Type.String())},
+					}},
+				},
+			},
+			OrderBy: stmt.OrderBy,
+			Limit:   stmt.Limit,
+		}, ctx, isRoot)
+	}
+
+	leftCtx := NewBindContext(builder, ctx)
+	leftID, err := builder.buildSelect(&tree.Select{Select: clause.Left}, leftCtx, false)
+	if err != nil {
+		return 0, err
+	}
+
+	rightCtx := NewBindContext(builder, ctx)
+	rightID, err := builder.buildSelect(&tree.Select{Select: clause.Right}, rightCtx, false)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(leftCtx.results) != len(rightCtx.results) {
+		return 0, errors.New("", "The used SELECT statements have a different number of columns")
+	}
+
+	ctx.isCorrelated = leftCtx.isCorrelated || rightCtx.isCorrelated
+
+	headings := leftCtx.headings
+	typs := make([]*plan.Type, len(headings))
+	for i := range typs {
+		typs[i], err = unionColumnType(leftCtx.results[i].Typ, rightCtx.results[i].Typ)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	leftID, err = builder.appendCastProject(leftID, leftCtx, headings, typs)
+	if err != nil {
+		return 0, err
+	}
+	rightID, err = builder.appendCastProject(rightID, rightCtx, headings, typs)
+	if err != nil {
+		return 0, err
+	}
+
+	var nodeType plan.Node_NodeType
+	switch clause.Type {
+	case tree.UNION:
+		nodeType = plan.Node_UNION
+		if clause.All {
+			nodeType = plan.Node_UNION_ALL
+		}
+	case tree.INTERSECT:
+		nodeType = plan.Node_INTERSECT
+		if clause.All {
+			nodeType = plan.Node_INTERSECT_ALL
+		}
+	case tree.EXCEPT:
+		nodeType = plan.Node_MINUS
+		if clause.All {
+			nodeType = plan.Node_MINUS_ALL
+		}
+	default:
+		return 0, errors.New("", fmt.Sprintf("'%s' will be supported in future version.", clause.Type.String()))
+	}
+
+	unionTag := builder.genNewTag()
+	for i, heading := range headings {
+		builder.nameByColRef[[2]int32{unionTag, int32(i)}] = heading
+	}
+
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    nodeType,
+		Children:    []int32{leftID, rightID},
+		BindingTags: []int32{unionTag},
+	}, ctx)
+
+	ctx.projectTag = builder.genNewTag()
+	for i, typ := range typs {
+		builder.nameByColRef[[2]int32{ctx.projectTag, int32(i)}] = headings[i]
+		ctx.projects = append(ctx.projects, &plan.Expr{
+			Typ: typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: unionTag,
+					ColPos: int32(i),
+				},
+			},
+		})
+	}
+	ctx.results = ctx.projects
+	ctx.headings = headings
+
+	nodeID = builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_PROJECT,
+		ProjectList: ctx.projects,
+		Children:    []int32{nodeID},
+		BindingTags: []int32{ctx.projectTag},
+	}, ctx)
+
+	if isRoot {
+		builder.qry.Headings = append(builder.qry.Headings, ctx.headings...)
+		return builder.appendEnumResultProject(nodeID, ctx)
+	}
+
+	return nodeID, nil
+}
+
+// appendEnumResultProject converts the ENUM and SET results of the statement to
+// the names of their members, which are sent to the client.
+func (builder *QueryBuilder) appendEnumResultProject(nodeID int32, ctx *BindContext) (int32, error) {
+	typs := make([]*plan.Type, len(ctx.results))
+	for i, expr := range ctx.results {
+		typs[i] = expr.Typ
+		if isEnumType(expr.Typ) {
+			typs[i] = &plan.Type{Id: plan.Type_VARCHAR, Size: 4}
+		}
+	}
+	return builder.appendCastProject(nodeID, ctx, ctx.headings, typs)
+}
+
+// appendCastProject casts the results of the query block to the types, it returns the
+// node itself if the types of the results are the same.
+func (builder *QueryBuilder) appendCastProject(nodeID int32, ctx *BindContext, headings []string, typs []*plan.Type) (int32, error) {
+	var err error
+
+	needCast := false
+	castList := make([]*plan.Expr, len(ctx.results))
+	tag := ctx.rootTag()
+	for i, expr := range ctx.results {
+		castList[i] = &plan.Expr{
+			Typ: expr.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: tag,
+					ColPos: int32(i),
+				},
+			},
+		}
+		if !isSameColumnType(expr.Typ, typs[i]) {
+			castList[i], err = makePlan2CastExpr(castList[i], typs[i])
+			if err != nil {
+				return 0, err
+			}
+			needCast = true
+		} else if isDecimalType(expr.Typ) && (expr.Typ.Width != typs[i].Width || expr.Typ.Scale != typs[i].Scale) {
+			// the scale of a decimal is in its type
+			castList[i], err = appendCastBeforeExpr(castList[i], typs[i])
+			if err != nil {
+				return 0, err
+			}
+			needCast = true
+		}
+	}
+
+	if !needCast {
+		return nodeID, nil
+	}
+
+	castTag := builder.genNewTag()
+	for i, heading := range headings {
+		builder.nameByColRef[[2]int32{castTag, int32(i)}] = heading
+	}
+
+	return builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_PROJECT,
+		ProjectList: castList,
+		Children:    []int32{nodeID},
+		BindingTags: []int32{castTag},
+	}, ctx), nil
+}
+
+// buildRecursiveCTE builds a CTE of WITH RECURSIVE. If the CTE references itself in
+// the right side of the UNION, the left side is the non-recursive query block and the
+// right side is run iteratively over the rows produced by the last iteration.
+// Otherwise the UNION is built as a plain set operation.
+func (builder *QueryBuilder) buildRecursiveCTE(stmt *tree.Select, cols tree.IdentifierList, ctx *BindContext) (int32, error) {
+	clause, ok := stmt.Select.(*tree.UnionClause)
+	if !ok || clause.Type != tree.UNION {
+		return builder.buildSelect(stmt, ctx, false)
+	}
+
+	// the chain of UNION is split into the non-recursive query blocks and the
+	// recursive ones following them, so one more block is taken as non-recursive
+	// until the first of the others references the CTE
+	blocks, unions := flattenUnion(clause)
+	nodeCnt := len(builder.qry.Nodes)
+	for k := 1; k < len(blocks); k++ {
+		nodeID, ok, err := builder.buildRecursiveBlocks(stmt, blocks, unions, k, cols, ctx)
+		if err != nil || ok {
+			return nodeID, err
+		}
+		// drop the nodes of the query blocks and build them again
+		builder.qry.Nodes = builder.qry.Nodes[:nodeCnt]
+		builder.ctxByNode = builder.ctxByNode[:nodeCnt]
+	}
+	return builder.buildSelect(stmt, ctx, false)
+}
+
+// flattenUnion returns the query blocks of a chain of UNION, unions[i] joins
+// blocks[i+1] to the blocks before it
+func flattenUnion(clause *tree.UnionClause) ([]tree.SelectStatement, []*tree.UnionClause) {
+	var blocks []tree.SelectStatement
+	var unions []*tree.UnionClause
+	var stmt tree.SelectStatement = clause
+	for {
+		c, ok := stmt.(*tree.UnionClause)
+		if !ok || c.Type != tree.UNION {
+			blocks = append(blocks, stmt)
+			break
+		}
+		blocks = append(blocks, c.Right)
+		unions = append(unions, c)
+		stmt = c.Left
+	}
+	for i, j := 0, len(blocks)-1; i < j; i, j = i+1, j-1 {
+		blocks[i], blocks[j] = blocks[j], blocks[i]
+	}
+	for i, j := 0, len(unions)-1; i < j; i, j = i+1, j-1 {
+		unions[i], unions[j] = unions[j], unions[i]
+	}
+	return blocks, unions
+}
+
+// buildRecursiveBlocks builds the recursive CTE of the first k query blocks as the
+// non-recursive part and the others as the recursive part, each of which references
+// the CTE once. It returns false if the first recursive block does not reference the
+// CTE.
+func (builder *QueryBuilder) buildRecursiveBlocks(stmt *tree.Select, blocks []tree.SelectStatement, unions []*tree.UnionClause, k int, cols tree.IdentifierList, ctx *BindContext) (int32, bool, error) {
+	name := ctx.cteName
+
+	// build the non-recursive query blocks
+	initCtx := NewBindContext(builder, ctx)
+	initCtx.maskedCTEs = ctx.maskedCTEs
+	initCtx.cteName = name
+
+	initStmt := blocks[0]
+	if k > 1 {
+		initStmt = unions[k-2]
+	}
+	initID, err := builder.buildSelect(&tree.Select{Select: initStmt}, initCtx, false)
+	if err != nil {
+		return 0, false, err
+	}
+
+	headings := make([]string, len(initCtx.headings))
+	copy(headings, initCtx.headings)
+	if len(cols) > len(headings) {
+		return 0, false, errors.New("", fmt.Sprintf("table %q has %d columns available but %d columns specified", name, len(headings), len(cols)))
+	}
+	for i, col := range cols {
+		headings[i] = string(col)
+	}
+
+	tableDef := &plan.TableDef{
+		Name: name,
+		Cols: make([]*plan.ColDef, len(headings)),
+	}
+	for i, heading := range headings {
+		tableDef.Cols[i] = &plan.ColDef{
+			Name: heading,
+			Typ:  initCtx.results[i].Typ,
+		}
+	}
+
+	// the rows of the recursive query blocks are cast to the types of the non-recursive ones
+	typs := make([]*plan.Type, len(tableDef.Cols))
+	for i, col := range tableDef.Cols {
+		typs[i] = col.Typ
+	}
+
+	// build the recursive query blocks
+	var recursiveID int32
+	isCorrelated := initCtx.isCorrelated
+	unionType := plan.Node_UNION_ALL
+	for i := k; i < len(blocks); i++ {
+		recursiveCtx := NewBindContext(builder, ctx)
+		recursiveCtx.maskedCTEs = ctx.maskedCTEs
+		recursiveCtx.cteName = name
+		recursiveCtx.recursiveRef = &RecursiveRef{
+			name:     name,
+			tableDef: tableDef,
+		}
+
+		blockID, err := builder.buildSelect(&tree.Select{Select: blocks[i]}, recursiveCtx, false)
+		if err != nil {
+			return 0, false, err
+		}
+
+		if recursiveCtx.recursiveRef.refCnt == 0 {
+			if i == k {
+				return 0, false, nil
+			}
+			return 0, false, errors.New("", fmt.Sprintf("In Recursive Common Table Expression %q, the non-recursive query blocks must precede the recursive ones", name))
+		}
+
+		if stmt.OrderBy != nil || stmt.Limit != nil {
+			return 0, false, errors.New("", fmt.Sprintf("ORDER BY and LIMIT in recursive Common Table Expression %q will be supported in future version.", name))
+		}
+
+		if len(recursiveCtx.results) != len(headings) {
+			return 0, false, errors.New("", "The used SELECT statements have a different number of columns")
+		}
+
+		if len(recursiveCtx.groups) > 0 || len(recursiveCtx.aggregates) > 0 || len(recursiveCtx.windows) > 0 || recursiveCtx.isDistinct {
+			return 0, false, errors.New("", fmt.Sprintf("Recursive Common Table Expression %q can contain neither aggregation nor window functions nor DISTINCT in recursive query block", name))
+		}
+
+		isCorrelated = isCorrelated || recursiveCtx.isCorrelated
+
+		blockID, err = builder.appendCastProject(blockID, recursiveCtx, headings, typs)
+		if err != nil {
+			return 0, false, err
+		}
+
+		// the rows of any UNION DISTINCT are distinct
+		if !unions[i-1].All {
+			unionType = plan.Node_UNION
+		}
+
+		if i == k {
+			recursiveID = blockID
+		} else {
+			recursiveID = builder.appendUnionAll(recursiveID, blockID, headings, typs, ctx)
+		}
+	}
+
+	ctx.isCorrelated = isCorrelated
+
+	unionTag := builder.genNewTag()
+	for i, heading := range headings {
+		builder.nameByColRef[[2]int32{unionTag, int32(i)}] = heading
+	}
+
+	unionID := builder.appendNode(&plan.Node{
+		NodeType:    unionType,
+		Children:    []int32{initID, recursiveID},
+		BindingTags: []int32{unionTag},
+	}, ctx)
+
+	ctx.projectTag = builder.genNewTag()
+	for i, col := range tableDef.Cols {
+		ctx.projects = append(ctx.projects, &plan.Expr{
+			Typ: col.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: unionTag,
+					ColPos: int32(i),
+				},
+			},
+		})
+	}
+	ctx.results = ctx.projects
+	ctx.headings = headings
+
+	// the LIMIT of RECURSIVE_CTE is the max number of the iterations
+	return builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_RECURSIVE_CTE,
+		ProjectList: ctx.projects,
+		Children:    []int32{unionID},
+		Limit:       makePlan2Int64ConstExprWithType(builder.getCTEMaxRecursionDepth()),
+		BindingTags: []int32{ctx.projectTag},
+	}, ctx), true, nil
+}
+
+// appendUnionAll appends the UNION ALL of two query blocks of the same columns
+func (builder *QueryBuilder) appendUnionAll(leftID, rightID int32, headings []string, typs []*plan.Type, ctx *BindContext) int32 {
+	unionTag := builder.genNewTag()
+	for i, heading := range headings {
+		builder.nameByColRef[[2]int32{unionTag, int32(i)}] = heading
+	}
+
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_UNION_ALL,
+		Children:    []int32{leftID, rightID},
+		BindingTags: []int32{unionTag},
+	}, ctx)
+
+	projectTag := builder.genNewTag()
+	projects := make([]*plan.Expr, len(typs))
+	for i, typ := range typs {
+		builder.nameByColRef[[2]int32{projectTag, int32(i)}] = headings[i]
+		projects[i] = &plan.Expr{
+			Typ: typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: unionTag,
+					ColPos: int32(i),
+				},
+			},
+		}
+	}
+
+	return builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_PROJECT,
+		ProjectList: projects,
+		Children:    []int32{nodeID},
+		BindingTags: []int32{projectTag},
+	}, ctx)
+}
+
+// getCTEMaxRecursionDepth returns the value of @@cte_max_recursion_depth
+func (builder *QueryBuilder) getCTEMaxRecursionDepth() int64 {
+	val, err := builder.compCtx.ResolveVariable("cte_max_recursion_depth", true, false)
+	if err != nil {
+		return defaultCTEMaxRecursionDepth
+	}
+	switch v := val.(type) {
+	case int64:
+		return v
+	case int:
+		return int64(v)
+	case uint64:
+		return int64(v)
+	}
+	return defaultCTEMaxRecursionDepth
+}
+
 func (builder *QueryBuilder) appendNode(node *plan.Node, ctx *BindContext) int32 {
 	nodeID := int32(len(builder.qry.Nodes))
 	node.NodeId = nodeID
@@ -1088,45 +1725,80 @@ func (builder *QueryBuilder) buildFrom(stmt tree.TableExprs, ctx *BindContext) (
 	}
 
 	for i := 1; i < len(stmt); i++ {
-		rightCtx := NewBindContext(builder, ctx)
+		rightCtx := builder.newRightContext(stmt[i], leftCtx, ctx)
 		rightChildID, err := builder.buildTable(stmt[i], rightCtx)
 		if err != nil {
 			return 0, err
 		}
 
+		joinType := plan.Node_INNER
+		var onList, filterList []*plan.Expr
+		if isLateral(stmt[i]) {
+			rightChildID, joinType, onList, filterList, err = builder.flattenLateral(rightChildID, joinType)
+			if err != nil {
+				return 0, err
+			}
+		}
+
 		leftChildID = builder.appendNode(&plan.Node{
 			NodeType: plan.Node_JOIN,
 			Children: []int32{leftChildID, rightChildID},
-			JoinType: plan.Node_INNER,
+			JoinType: joinType,
+			OnList:   onList,
 		}, nil)
 
-		if i == len(stmt)-1 {
-			builder.ctxByNode[leftChildID] = ctx
-			err = ctx.mergeContexts(leftCtx, rightCtx)
-			if err != nil {
-				return 0, err
-			}
-		} else {
-			newCtx := NewBindContext(builder, ctx)
-			builder.ctxByNode[leftChildID] = newCtx
-			err = newCtx.mergeContexts(leftCtx, rightCtx)
-			if err != nil {
-				return 0, err
-			}
-			leftCtx = newCtx
+		joinCtx := ctx
+		if i < len(stmt)-1 {
+			joinCtx = NewBindContext(builder, ctx)
+		}
+
+		builder.ctxByNode[leftChildID] = joinCtx
+		err = joinCtx.mergeContexts(leftCtx, rightCtx)
+		if err != nil {
+			return 0, err
+		}
+		leftCtx = joinCtx
+
+		if len(filterList) > 0 {
+			leftChildID = builder.appendNode(&plan.Node{
+				NodeType:   plan.Node_FILTER,
+				Children:   []int32{leftChildID},
+				FilterList: filterList,
+			}, joinCtx)
 		}
 	}
 
 	return leftChildID, err
 }
 
+// isLateral reports whether tbl is a LATERAL derived table
+func isLateral(tbl tree.TableExpr) bool {
+	aliased, ok := tbl.(*tree.AliasedTableExpr)
+	return ok && aliased.Lateral
+}
+
+// newRightContext returns the context to build the right side tbl of a join
+// in. A LATERAL derived table binds the columns of the left side as
+// correlated columns one level up, which are then decorrelated by
+// flattenLateral.
+func (builder *QueryBuilder) newRightContext(tbl tree.TableExpr, leftCtx, ctx *BindContext) *BindContext {
+	if !isLateral(tbl) {
+		return NewBindContext(builder, ctx)
+	}
+
+	leftCtx.binder = NewTableBinder(builder, leftCtx)
+	return NewBindContext(builder, leftCtx)
+}
+
 func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext) (nodeID int32, err error) {
 	switch tbl := stmt.(type) {
 	case *tree.Select:
 		subCtx := NewBindContext(builder, ctx)
 		nodeID, err = builder.buildSelect(tbl, subCtx, false)
+		// a derived table correlated with an outer query is decorrelated
+		// with the subquery of its FROM clause
 		if subCtx.isCorrelated {
-			return 0, errors.New("", "correlated subquery in FROM clause is will be supported in future version")
+			ctx.isCorrelated = true
 		}
 
 		if subCtx.hasSingleRow {
@@ -1155,7 +1827,11 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext) (
 
 				switch stmt := cteRef.ast.Stmt.(type) {
 				case *tree.Select:
-					nodeID, err = builder.buildSelect(stmt, subCtx, false)
+					if cteRef.isRecursive {
+						nodeID, err = builder.buildRecursiveCTE(stmt, cteRef.ast.Name.Cols, subCtx)
+					} else {
+						nodeID, err = builder.buildSelect(stmt, subCtx, false)
+					}
 
 				case *tree.ParenSelect:
 					nodeID, err = builder.buildSelect(stmt.Select, subCtx, false)
@@ -1188,6 +1864,27 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext) (
 
 				break
 			}
+
+			recursiveRef, err := ctx.findRecursiveRef(table)
+			if err != nil {
+				return 0, err
+			}
+			if recursiveRef != nil {
+				recursiveRef.refCnt++
+				if recursiveRef.refCnt > 1 {
+					return 0, errors.New("", fmt.Sprintf("In recursive query block of Recursive Common Table Expression %q, the recursive table must be referenced only once", table))
+				}
+
+				nodeID = builder.appendNode(&plan.Node{
+					NodeType:    plan.Node_SINK_SCAN,
+					TableDef:    recursiveRef.tableDef,
+					BindingTags: []int32{builder.genNewTag()},
+				}, ctx)
+
+				break
+			}
+
+			schema = ctx.defaultDatabase
 		}
 
 		obj, tableDef := builder.compCtx.Resolve(schema, table)
@@ -1195,6 +1892,10 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext) (
 			return 0, errors.New("", fmt.Sprintf("table %q does not exist", table))
 		}
 
+		if tableDef.View != nil {
+			return builder.buildView(obj, tableDef, ctx)
+		}
+
 		nodeID = builder.appendNode(&plan.Node{
 			NodeType:    plan.Node_TABLE_SCAN,
 			Cost:        builder.compCtx.Cost(obj, nil),
@@ -1242,6 +1943,77 @@ func (builder *QueryBuilder) genNewTag() int32 {
 	return builder.nextTag
 }
 
+// buildView expands a view into the query stored in its definition. The
+// unqualified table names in the view are bound in the database of the view.
+func (builder *QueryBuilder) buildView(obj *ObjectRef, tableDef *TableDef, ctx *BindContext) (int32, error) {
+	name := obj.SchemaName + "." + tableDef.Name
+	for _, view := range ctx.views {
+		if view == name {
+			return 0, errors.New("", fmt.Sprintf("view %q references itself", name))
+		}
+	}
+
+	stmt, err := mysql.ParseOne(tableDef.View.View)
+	if err != nil {
+		return 0, err
+	}
+	createView, ok := stmt.(*tree.CreateView)
+	if !ok {
+		return 0, errors.New("", fmt.Sprintf("invalid definition of view %q", name))
+	}
+
+	// a view can't see the CTEs and the columns of the query that refers to it
+	subCtx := NewBindContext(builder, nil)
+	subCtx.cteName = tableDef.Name
+	subCtx.defaultDatabase = obj.SchemaName
+	subCtx.views = append(append([]string{}, ctx.views...), name)
+
+	nodeID, err := builder.buildSelect(createView.AsSource, subCtx, false)
+	if err != nil {
+		return 0, err
+	}
+
+	if subCtx.hasSingleRow {
+		ctx.hasSingleRow = true
+	}
+
+	if len(createView.ColNames) > len(subCtx.headings) {
+		return 0, errors.New("", fmt.Sprintf("view %q has %d columns available but %d columns specified", name, len(subCtx.headings), len(createView.ColNames)))
+	}
+	for i, col := range createView.ColNames {
+		subCtx.headings[i] = string(col)
+	}
+
+	return nodeID, nil
+}
+
+// markFullTextFilter marks the full-text search of a scan whose relevance is
+// a filter condition, the blocks without any matching row are skipped
+func (builder *QueryBuilder) markFullTextFilter(cond *plan.Expr, ctx *BindContext) {
+	// the relevance is cast to bool by splitAndBindCondition
+	f, ok := cond.Expr.(*plan.Expr_F)
+	if !ok || len(f.F.Args) != 2 || f.F.Args[1].GetT() == nil {
+		return
+	}
+	col, ok := f.F.Args[0].Expr.(*plan.Expr_Col)
+	if !ok {
+		return
+	}
+	binding, ok := ctx.bindingByTag[col.Col.RelPos]
+	if !ok {
+		return
+	}
+	node := builder.qry.Nodes[binding.nodeId]
+	if node.NodeType != plan.Node_TABLE_SCAN || int(col.Col.ColPos) >= len(node.TableDef.Cols) {
+		return
+	}
+	for _, ft := range node.FullText {
+		if ft.Attr == node.TableDef.Cols[col.Col.ColPos].Name {
+			ft.Filter = true
+		}
+	}
+}
+
 func (builder *QueryBuilder) addBinding(nodeID int32, alias tree.AliasClause, ctx *BindContext) error {
 	node := builder.qry.Nodes[nodeID]
 
@@ -1253,7 +2025,7 @@ func (builder *QueryBuilder) addBinding(nodeID int32, alias tree.AliasClause, ct
 	var types []*plan.Type
 	var binding *Binding
 
-	if node.NodeType == plan.Node_TABLE_SCAN || node.NodeType == plan.Node_MATERIAL_SCAN {
+	if node.NodeType == plan.Node_TABLE_SCAN || node.NodeType == plan.Node_MATERIAL_SCAN || node.NodeType == plan.Node_SINK_SCAN {
 		if len(alias.Cols) > len(node.TableDef.Cols) {
 			return errors.New("", fmt.Sprintf("table %q has %d columns available but %d columns specified", alias.Alias, len(node.TableDef.Cols), len(alias.Cols)))
 		}
@@ -1359,18 +2131,26 @@ func (builder *QueryBuilder) buildJoinTable(tbl *tree.JoinTableExpr, ctx *BindCo
 	}
 
 	leftCtx := NewBindContext(builder, ctx)
-	rightCtx := NewBindContext(builder, ctx)
 
 	leftChildID, err := builder.buildTable(tbl.Left, leftCtx)
 	if err != nil {
 		return 0, err
 	}
 
+	rightCtx := builder.newRightContext(tbl.Right, leftCtx, ctx)
 	rightChildID, err := builder.buildTable(tbl.Right, rightCtx)
 	if err != nil {
 		return 0, err
 	}
 
+	var lateralList, filterList []*plan.Expr
+	if isLateral(tbl.Right) {
+		rightChildID, joinType, lateralList, filterList, err = builder.flattenLateral(rightChildID, joinType)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	err = ctx.mergeContexts(leftCtx, rightCtx)
 	if err != nil {
 		return 0, err
@@ -1433,6 +2213,16 @@ func (builder *QueryBuilder) buildJoinTable(tbl *tree.JoinTableExpr, ctx *BindCo
 		}
 	}
 
+	node.OnList = append(node.OnList, lateralList...)
+
+	if len(filterList) > 0 {
+		nodeID = builder.appendNode(&plan.Node{
+			NodeType:   plan.Node_FILTER,
+			Children:   []int32{nodeID},
+			FilterList: filterList,
+		}, ctx)
+	}
+
 	return nodeID, nil
 }
 
@@ -1446,8 +2236,20 @@ func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr)
 		groupTag := node.BindingTags[0]
 		aggregateTag := node.BindingTags[1]
 
+		// the keys rolled up by grouping sets and the index of the sets are
+		// only known after the aggregation
+		rolledUp := make(map[[2]int32]bool)
+		if len(node.GroupingSet) > 0 {
+			rolledUp[[2]int32{groupTag, 0}] = true
+			for _, set := range node.GroupingSet {
+				for _, key := range set.Expr.(*plan.Expr_List).List.List {
+					rolledUp[[2]int32{groupTag, int32(key.Expr.(*plan.Expr_C).C.Value.(*plan.Const_Ival).Ival)}] = true
+				}
+			}
+		}
+
 		for _, filter := range filters {
-			if !containsTag(filter, aggregateTag) {
+			if !containsTag(filter, aggregateTag) && !containsColRefs(filter, rolledUp) {
 				canPushdown = append(canPushdown, replaceColRefs(filter, groupTag, node.GroupBy))
 			} else {
 				cantPushdown = append(cantPushdown, filter)
@@ -1509,6 +2311,9 @@ func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr)
 			canTurnInner := true
 
 			joinSides[i] = getJoinSide(filter, leftTags, rightTags)
+			if node.JoinType == plan.Node_MARK && containsTag(filter, node.BindingTags[0]) {
+				joinSides[i] = JoinSideBoth
+			}
 			if f, ok := filter.Expr.(*plan.Expr_F); ok {
 				for _, arg := range f.F.Args {
 					if getJoinSide(arg, leftTags, rightTags) == JoinSideBoth {
@@ -1572,7 +2377,7 @@ func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr)
 					leftPushdown = append(leftPushdown, DeepCopyExpr(filter))
 					rightPushdown = append(rightPushdown, filter)
 
-				case plan.Node_LEFT, plan.Node_SEMI, plan.Node_ANTI:
+				case plan.Node_LEFT, plan.Node_SEMI, plan.Node_ANTI, plan.Node_SINGLE, plan.Node_MARK:
 					leftPushdown = append(leftPushdown, filter)
 
 				default:
@@ -1663,6 +2468,40 @@ func (builder *QueryBuilder) pushdownFilters(nodeID int32, filters []*plan.Expr)
 	case plan.Node_TABLE_SCAN:
 		node.FilterList = append(node.FilterList, filters...)
 
+	case plan.Node_RECURSIVE_CTE, plan.Node_UNION, plan.Node_UNION_ALL, plan.Node_INTERSECT, plan.Node_INTERSECT_ALL, plan.Node_MINUS, plan.Node_MINUS_ALL:
+		// the filters are applied to the combined rows
+		cantPushdown = filters
+
+		for i, childID := range node.Children {
+			newChildID, cantPushdownChild := builder.pushdownFilters(childID, nil)
+
+			if len(cantPushdownChild) > 0 {
+				newChildID = builder.appendNode(&plan.Node{
+					NodeType:   plan.Node_FILTER,
+					Children:   []int32{newChildID},
+					FilterList: cantPushdownChild,
+				}, nil)
+			}
+
+			node.Children[i] = newChildID
+		}
+
+	case plan.Node_WINDOW:
+		// filters change the rows of the windows, so they can't be pushed down
+		cantPushdown = filters
+
+		childID, cantPushdownChild := builder.pushdownFilters(node.Children[0], nil)
+
+		if len(cantPushdownChild) > 0 {
+			childID = builder.appendNode(&plan.Node{
+				NodeType:   plan.Node_FILTER,
+				Children:   []int32{node.Children[0]},
+				FilterList: cantPushdownChild,
+			}, nil)
+		}
+
+		node.Children[0] = childID
+
 	default:
 		if len(node.Children) > 0 {
 			childID, cantPushdownChild := builder.pushdownFilters(node.Children[0], filters)
@@ -1944,6 +2783,11 @@ func (builder *QueryBuilder) gatherJoinLeavesAndConds(joinNode *plan.Node, leave
 
 func (builder *QueryBuilder) enumerateTags(nodeID int32) []int32 {
 	node := builder.qry.Nodes[nodeID]
+	if node.NodeType == plan.Node_JOIN && node.JoinType == plan.Node_MARK {
+		tags := append([]int32{}, builder.enumerateTags(node.Children[0])...)
+		return append(tags, node.BindingTags...)
+	}
+
 	if len(node.BindingTags) > 0 {
 		return node.BindingTags
 	}
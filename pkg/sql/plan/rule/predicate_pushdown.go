@@ -45,6 +45,13 @@ func (r *PredicatePushdown) pushdown(e *plan.Expr, n *plan.Node, qry *plan.Query
 		n.FilterList = append(n.FilterList, e)
 		return false
 	}
+	switch n.NodeType {
+	case plan.Node_VALUE_SCAN, plan.Node_SINK_SCAN, plan.Node_RECURSIVE_CTE, plan.Node_UNION, plan.Node_UNION_ALL,
+		plan.Node_INTERSECT, plan.Node_INTERSECT_ALL, plan.Node_MINUS, plan.Node_MINUS_ALL:
+		// the filters are kept by the parent, they can't be pushed into
+		// the value scan or the set operations
+		return false
+	}
 	if n.NodeType == plan.Node_TABLE_SCAN || n.NodeType == plan.Node_AGG {
 		n.FilterList = append(n.FilterList, e)
 		return false
@@ -59,7 +66,7 @@ func (r *PredicatePushdown) pushdown(e *plan.Expr, n *plan.Node, qry *plan.Query
 		n.FilterList = append(n.FilterList, e)
 		return false
 	}
-	if !r.pushdown(ne, qry.Nodes[relPos], qry) {
+	if !r.pushdown(ne, qry.Nodes[n.Children[relPos]], qry) {
 		n.FilterList = append(n.FilterList, e)
 		return false
 	}
@@ -31,6 +31,7 @@ const (
 
 type TableDef = plan.TableDef
 type ColDef = plan.ColDef
+type ViewDef = plan.ViewDef
 type ObjectRef = plan.ObjectRef
 type ColRef = plan.ColRef
 type Cost = plan.Cost
@@ -92,8 +93,25 @@ type QueryBuilder struct {
 }
 
 type CTERef struct {
-	ast        *tree.CTE
-	maskedCTEs map[string]any
+	ast         *tree.CTE
+	maskedCTEs  map[string]any
+	isRecursive bool
+}
+
+// RecursiveRef is the reference of a recursive CTE in its recursive query block,
+// which is resolved to a SINK_SCAN of the rows produced by the last iteration.
+type RecursiveRef struct {
+	name     string
+	tableDef *plan.TableDef
+	refCnt   int
+}
+
+// WindowRef is the window functions sharing the same window specification,
+// they are computed by one WINDOW node.
+type WindowRef struct {
+	tag   int32
+	spec  *plan.WindowSpec
+	funcs []*plan.Expr
 }
 
 type BindContext struct {
@@ -102,8 +120,14 @@ type BindContext struct {
 	cteByName  map[string]*CTERef
 	maskedCTEs map[string]any
 
-	cteName  string
-	headings []string
+	cteName      string
+	headings     []string
+	recursiveRef *RecursiveRef
+
+	// defaultDatabase binds the unqualified table names in the body of a view
+	defaultDatabase string
+	// views are the views being expanded, outermost first
+	views []string
 
 	groupTag     int32
 	aggregateTag int32
@@ -115,10 +139,18 @@ type BindContext struct {
 	projects   []*plan.Expr
 	results    []*plan.Expr
 
+	// the positions of groups rolled up to null by each grouping set of
+	// ROLLUP, CUBE or GROUPING SETS, groups[0] is then the index of the set
+	groupingSets [][]int32
+
 	groupByAst     map[string]int32
 	aggregateByAst map[string]int32
 	projectByExpr  map[string]int32
 
+	windows      []*WindowRef
+	windowByAst  map[string]*plan.Expr
+	windowBySpec map[string]*WindowRef
+
 	aliasMap map[string]int32
 
 	bindings       []*Binding
@@ -132,6 +164,9 @@ type BindContext struct {
 	isDistinct   bool
 	isCorrelated bool
 	hasSingleRow bool
+	// innerSemiJoin is set if the semi join of a subquery correlated with
+	// an outer query is built as an INNER join, which duplicates the rows
+	innerSemiJoin bool
 
 	parent     *BindContext
 	leftChild  *BindContext
@@ -187,6 +222,7 @@ type HavingBinder struct {
 type ProjectionBinder struct {
 	baseBinder
 	havingBinder *HavingBinder
+	insideWin    bool
 }
 
 type OrderBinder struct {
@@ -224,3 +260,17 @@ const (
 	maxLengthOfTableComment  int = 2048
 	maxLengthOfColumnComment int = 1024
 )
+
+// AutoIncrementProperty is the key of the table property holding the first
+// value of the auto increment column
+const AutoIncrementProperty = "auto_increment"
+
+// defaultCTEMaxRecursionDepth is the default value of @@cte_max_recursion_depth
+const defaultCTEMaxRecursionDepth int64 = 1000
+
+const (
+	// maxCubeKeys is the maximum number of expressions of a CUBE
+	maxCubeKeys = 12
+	// maxGroupingSets is the maximum number of grouping sets of a GROUP BY
+	maxGroupingSets = 4096
+)
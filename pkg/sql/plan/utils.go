@@ -249,6 +249,22 @@ func containsTag(expr *plan.Expr, tag int32) bool {
 	return ret
 }
 
+func containsColRefs(expr *plan.Expr, colRefs map[[2]int32]bool) bool {
+	var ret bool
+
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			ret = ret || containsColRefs(arg, colRefs)
+		}
+
+	case *plan.Expr_Col:
+		return colRefs[[2]int32{exprImpl.Col.RelPos, exprImpl.Col.ColPos}]
+	}
+
+	return ret
+}
+
 func replaceColRefs(expr *plan.Expr, tag int32, projects []*plan.Expr) *plan.Expr {
 	switch exprImpl := expr.Expr.(type) {
 	case *plan.Expr_F:
@@ -18,7 +18,6 @@ import (
 	"fmt"
 	"sort"
 
-	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 )
@@ -310,42 +309,48 @@ func (rule *ResetVarRefRule) resetVarRef(e *plan.Expr) (*plan.Expr, error) {
 			return nil, err
 		}
 
-		switch val := getVal.(type) {
-		case string:
-			expr = makePlan2StringConstExprWithType(val)
-		case int:
-			expr = makePlan2Int64ConstExprWithType(int64(val))
-		case uint8:
-			expr = makePlan2Int64ConstExprWithType(int64(val))
-		case uint16:
-			expr = makePlan2Int64ConstExprWithType(int64(val))
-		case uint32:
-			expr = makePlan2Int64ConstExprWithType(int64(val))
-		case int8:
-			expr = makePlan2Int64ConstExprWithType(int64(val))
-		case int16:
-			expr = makePlan2Int64ConstExprWithType(int64(val))
-		case int32:
-			expr = makePlan2Int64ConstExprWithType(int64(val))
-		case int64:
-			expr = makePlan2Int64ConstExprWithType(val)
-		case uint64:
-			expr = makePlan2Uint64ConstExprWithType(val)
-		case float32:
-			expr = makePlan2Float64ConstExprWithType(float64(val))
-		case float64:
-			expr = makePlan2Float64ConstExprWithType(val)
-		case bool:
-			expr = makePlan2BoolConstExprWithType(val)
-		case nil:
-			expr = makePlan2NullConstExprWithType()
-		case types.Decimal64, types.Decimal128:
-			err = errors.New("", "decimal var not support now")
-		default:
-			err = errors.New("", fmt.Sprintf("type of var %q is not supported now", exprImpl.V.Name))
+		expr, err = MakeConstExprByValue(getVal)
+		if err != nil {
+			return nil, errors.New("", fmt.Sprintf("type of var %q is not supported now", exprImpl.V.Name))
 		}
-		return expr, err
+		return expr, nil
 	default:
 		return e, nil
 	}
 }
+
+// MakeConstExprByValue makes the constant expression of the value of a variable or a parameter
+func MakeConstExprByValue(val interface{}) (*Expr, error) {
+	switch v := val.(type) {
+	case string:
+		return makePlan2StringConstExprWithType(v), nil
+	case int:
+		return makePlan2Int64ConstExprWithType(int64(v)), nil
+	case uint8:
+		return makePlan2Int64ConstExprWithType(int64(v)), nil
+	case uint16:
+		return makePlan2Int64ConstExprWithType(int64(v)), nil
+	case uint32:
+		return makePlan2Int64ConstExprWithType(int64(v)), nil
+	case int8:
+		return makePlan2Int64ConstExprWithType(int64(v)), nil
+	case int16:
+		return makePlan2Int64ConstExprWithType(int64(v)), nil
+	case int32:
+		return makePlan2Int64ConstExprWithType(int64(v)), nil
+	case int64:
+		return makePlan2Int64ConstExprWithType(v), nil
+	case uint64:
+		return makePlan2Uint64ConstExprWithType(v), nil
+	case float32:
+		return makePlan2Float64ConstExprWithType(float64(v)), nil
+	case float64:
+		return makePlan2Float64ConstExprWithType(v), nil
+	case bool:
+		return makePlan2BoolConstExprWithType(v), nil
+	case nil:
+		return makePlan2NullConstExprWithType(), nil
+	default:
+		return nil, errors.New("", fmt.Sprintf("type %T is not supported now", val))
+	}
+}
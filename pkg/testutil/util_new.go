@@ -82,7 +82,7 @@ func NewVector(n int, typ types.Type, m *mheap.Mheap, random bool, Values interf
 			return NewUInt8Vector(n, typ, m, random, vs)
 		}
 		return NewUInt8Vector(n, typ, m, random, nil)
-	case types.T_uint16:
+	case types.T_uint16, types.T_enum:
 		if vs, ok := Values.([]uint16); ok {
 			return NewUInt16Vector(n, typ, m, random, vs)
 		}
@@ -92,7 +92,7 @@ func NewVector(n int, typ types.Type, m *mheap.Mheap, random bool, Values interf
 			return NewUInt32Vector(n, typ, m, random, vs)
 		}
 		return NewUInt32Vector(n, typ, m, random, nil)
-	case types.T_uint64:
+	case types.T_uint64, types.T_set, types.T_bit:
 		if vs, ok := Values.([]uint64); ok {
 			return NewUInt64Vector(n, typ, m, random, vs)
 		}
@@ -0,0 +1,32 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ascii
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// Ascii returns the value of the first byte of every string of xs,
+// the empty string gives 0
+func Ascii(xs *types.Bytes, rs []int64) []int64 {
+	for i := range rs {
+		if xs.Lengths[i] == 0 {
+			rs[i] = 0
+		} else {
+			rs[i] = int64(xs.Data[xs.Offsets[i]])
+		}
+	}
+	return rs
+}
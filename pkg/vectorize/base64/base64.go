@@ -0,0 +1,66 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base64
+
+import (
+	"encoding/base64"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// LineLength is the number of characters after which Encode breaks the lines
+const LineLength = 76
+
+// Encode writes the base64 encoding of every string of xs to rs, a newline
+// is inserted after every LineLength characters. rs holds the offsets and
+// lengths of all the rows, the data is appended.
+func Encode(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
+	var buf []byte
+	for i := range rs.Offsets {
+		s := xs.Get(int64(i))
+		buf = append(buf[:0], make([]byte, base64.StdEncoding.EncodedLen(len(s)))...)
+		base64.StdEncoding.Encode(buf, s)
+		rs.Offsets[i] = uint32(len(rs.Data))
+		for len(buf) > LineLength {
+			rs.Data = append(rs.Data, buf[:LineLength]...)
+			rs.Data = append(rs.Data, '\n')
+			buf = buf[LineLength:]
+		}
+		rs.Data = append(rs.Data, buf...)
+		rs.Lengths[i] = uint32(len(rs.Data)) - rs.Offsets[i]
+	}
+	return rs
+}
+
+// Decode writes the bytes of the base64 encoded strings of xs to rs, the
+// newlines are ignored. The rows which are not valid base64
+// are added to nsp.
+func Decode(xs *types.Bytes, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
+	for i := range rs.Offsets {
+		s := xs.Get(int64(i))
+		rs.Offsets[i] = uint32(len(rs.Data))
+		n := len(rs.Data)
+		rs.Data = append(rs.Data, make([]byte, base64.StdEncoding.DecodedLen(len(s)))...)
+		m, err := base64.StdEncoding.Decode(rs.Data[n:], s)
+		if err != nil {
+			nulls.Add(nsp, uint64(i))
+			m = 0
+		}
+		rs.Data = rs.Data[:n+m]
+		rs.Lengths[i] = uint32(m)
+	}
+	return rs
+}
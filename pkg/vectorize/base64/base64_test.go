@@ -0,0 +1,65 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base64
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	long := make([]byte, 60)
+	for i := range long {
+		long[i] = 'a'
+	}
+	rs := Encode(makeBytes("abc", "", "数据库", string(long)), newResult(4))
+	encoded := strings(rs)
+	require.Equal(t, []string{"YWJj", "", "5pWw5o2u5bqT"}, encoded[:3])
+	require.Equal(t, byte('\n'), encoded[3][LineLength])
+	require.Equal(t, 81, len(encoded[3]))
+
+	nsp := new(nulls.Nulls)
+	rs = Decode(makeBytes(append(encoded, "!!")...), newResult(5), nsp)
+	require.Equal(t, []string{"abc", "", "数据库", string(long), ""}, strings(rs))
+	for i := 0; i < 5; i++ {
+		require.Equal(t, i == 4, nulls.Contains(nsp, uint64(i)))
+	}
+}
+
+func makeBytes(strs ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, s := range strs {
+		xs.AppendOnce([]byte(s))
+	}
+	return xs
+}
+
+func newResult(n int) *types.Bytes {
+	return &types.Bytes{
+		Offsets: make([]uint32, n),
+		Lengths: make([]uint32, n),
+	}
+}
+
+func strings(xs *types.Bytes) []string {
+	ss := make([]string, len(xs.Offsets))
+	for i := range ss {
+		ss[i] = string(xs.Get(int64(i)))
+	}
+	return ss
+}
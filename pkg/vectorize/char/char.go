@@ -0,0 +1,53 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package char
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// Char writes the string of every row of the integer codes xs to rs, a code is
+// truncated to 32 bits and written as its big-endian bytes without the leading
+// zero bytes, the codes in nsps are skipped. An argument of a single value is
+// used for all the rows, rs holds the offsets and lengths of all the rows and
+// the data is appended.
+func Char(xs [][]int64, nsps []*nulls.Nulls, rs *types.Bytes) *types.Bytes {
+	for i := range rs.Offsets {
+		rs.Offsets[i] = uint32(len(rs.Data))
+		for j, x := range xs {
+			row := i
+			if len(x) == 1 {
+				row = 0
+			}
+			if nulls.Contains(nsps[j], uint64(row)) {
+				continue
+			}
+			c := uint32(x[row])
+			switch {
+			case c > 0xFFFFFF:
+				rs.Data = append(rs.Data, byte(c>>24), byte(c>>16), byte(c>>8), byte(c))
+			case c > 0xFFFF:
+				rs.Data = append(rs.Data, byte(c>>16), byte(c>>8), byte(c))
+			case c > 0xFF:
+				rs.Data = append(rs.Data, byte(c>>8), byte(c))
+			default:
+				rs.Data = append(rs.Data, byte(c))
+			}
+		}
+		rs.Lengths[i] = uint32(len(rs.Data)) - rs.Offsets[i]
+	}
+	return rs
+}
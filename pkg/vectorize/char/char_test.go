@@ -0,0 +1,36 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package char
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChar(t *testing.T) {
+	xs := [][]int64{{77, 256, 0x10203}, {121}, {83, 0, -1}}
+	nsps := []*nulls.Nulls{new(nulls.Nulls), new(nulls.Nulls), new(nulls.Nulls)}
+	nulls.Add(nsps[2], 1)
+	rs := Char(xs, nsps, &types.Bytes{
+		Offsets: make([]uint32, 3),
+		Lengths: make([]uint32, 3),
+	})
+	require.Equal(t, "MyS", string(rs.Get(0)))
+	require.Equal(t, "\x01\x00y", string(rs.Get(1)))
+	require.Equal(t, "\x01\x02\x03y\xff\xff\xff\xff", string(rs.Get(2)))
+}
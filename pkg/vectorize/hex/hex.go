@@ -0,0 +1,90 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hex
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+const digits = "0123456789ABCDEF"
+
+// HexString writes the upper case hexadecimal digits of the bytes of every
+// string of xs to rs, rs holds the offsets and lengths of all the rows and the
+// data is appended.
+func HexString(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
+	for i := range rs.Offsets {
+		rs.Offsets[i] = uint32(len(rs.Data))
+		for _, c := range xs.Get(int64(i)) {
+			rs.Data = append(rs.Data, digits[c>>4], digits[c&0x0F])
+		}
+		rs.Lengths[i] = uint32(len(rs.Data)) - rs.Offsets[i]
+	}
+	return rs
+}
+
+// HexInt64 writes the upper case hexadecimal digits of every value of xs to rs,
+// a negative value is written as its 64 bits two's complement.
+func HexInt64(xs []int64, rs *types.Bytes) *types.Bytes {
+	for i := range rs.Offsets {
+		appendUint(rs, i, uint64(xs[i]))
+	}
+	return rs
+}
+
+// HexUint64 writes the upper case hexadecimal digits of every value of xs to rs.
+func HexUint64(xs []uint64, rs *types.Bytes) *types.Bytes {
+	for i := range rs.Offsets {
+		appendUint(rs, i, xs[i])
+	}
+	return rs
+}
+
+func appendUint(rs *types.Bytes, i int, x uint64) {
+	rs.Offsets[i] = uint32(len(rs.Data))
+	rs.Data = strconv.AppendUint(rs.Data, x, 16)
+	for j := rs.Offsets[i]; j < uint32(len(rs.Data)); j++ {
+		if c := rs.Data[j]; c >= 'a' {
+			rs.Data[j] = c - ('a' - 'A')
+		}
+	}
+	rs.Lengths[i] = uint32(len(rs.Data)) - rs.Offsets[i]
+}
+
+// Unhex writes the bytes given by the pairs of hexadecimal digits of every
+// string of xs to rs, an odd number of digits is read with a leading 0. The
+// rows which are not hexadecimal digits are added to nsp.
+func Unhex(xs *types.Bytes, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
+	var buf []byte
+	for i := range rs.Offsets {
+		s := xs.Get(int64(i))
+		rs.Offsets[i] = uint32(len(rs.Data))
+		if len(s)%2 == 1 {
+			buf = append(append(buf[:0], '0'), s...)
+			s = buf
+		}
+		n := len(rs.Data)
+		rs.Data = append(rs.Data, make([]byte, len(s)/2)...)
+		if _, err := hex.Decode(rs.Data[n:], s); err != nil {
+			nulls.Add(nsp, uint64(i))
+			rs.Data = rs.Data[:n]
+		}
+		rs.Lengths[i] = uint32(len(rs.Data)) - rs.Offsets[i]
+	}
+	return rs
+}
@@ -0,0 +1,69 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hex
+
+import (
+	"math"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHexString(t *testing.T) {
+	rs := HexString(makeBytes("abc", "", "数", "\x00\xff"), newResult(4))
+	require.Equal(t, []string{"616263", "", "E695B0", "00FF"}, strings(rs))
+}
+
+func TestHexInt(t *testing.T) {
+	rs := HexInt64([]int64{255, 0, -1, math.MinInt64}, newResult(4))
+	require.Equal(t, []string{"FF", "0", "FFFFFFFFFFFFFFFF", "8000000000000000"}, strings(rs))
+
+	rs = HexUint64([]uint64{10, math.MaxUint64}, newResult(2))
+	require.Equal(t, []string{"A", "FFFFFFFFFFFFFFFF"}, strings(rs))
+}
+
+func TestUnhex(t *testing.T) {
+	nsp := new(nulls.Nulls)
+	rs := Unhex(makeBytes("4D7953514C", "e695b0", "", "abc", "GG", "41"), newResult(6), nsp)
+	require.Equal(t, []string{"MySQL", "数", "", "\x0a\xbc", "", "A"}, strings(rs))
+	for i := 0; i < 6; i++ {
+		require.Equal(t, i == 4, nulls.Contains(nsp, uint64(i)))
+	}
+}
+
+func makeBytes(strs ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, s := range strs {
+		xs.AppendOnce([]byte(s))
+	}
+	return xs
+}
+
+func newResult(n int) *types.Bytes {
+	return &types.Bytes{
+		Offsets: make([]uint32, n),
+		Lengths: make([]uint32, n),
+	}
+}
+
+func strings(xs *types.Bytes) []string {
+	ss := make([]string, len(xs.Offsets))
+	for i := range ss {
+		ss[i] = string(xs.Get(int64(i)))
+	}
+	return ss
+}
@@ -0,0 +1,74 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instr
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// Instr returns the position of the first occurrence of subs in every string
+// of xs, positions are counted in characters from 1 and 0 means not found.
+// An argument of a single value is used for all the rows of rs.
+func Instr(xs, subs *types.Bytes, rs []int64) []int64 {
+	for i := range rs {
+		rs[i] = locate(value(xs, i), value(subs, i), 1)
+	}
+	return rs
+}
+
+// Locate is Instr with the arguments in the order of LOCATE(substr, str, pos),
+// the search starts at the character ps, or at the first character if ps is nil.
+func Locate(subs, xs *types.Bytes, ps []int64, rs []int64) []int64 {
+	for i := range rs {
+		pos := int64(1)
+		switch {
+		case len(ps) == 1:
+			pos = ps[0]
+		case len(ps) > 1:
+			pos = ps[i]
+		}
+		rs[i] = locate(value(xs, i), value(subs, i), pos)
+	}
+	return rs
+}
+
+func locate(s, sub []byte, pos int64) int64 {
+	if pos < 1 {
+		return 0
+	}
+	start := 0
+	for n := int64(1); n < pos; n++ {
+		if start >= len(s) {
+			return 0
+		}
+		_, size := utf8.DecodeRune(s[start:])
+		start += size
+	}
+	j := bytes.Index(s[start:], sub)
+	if j < 0 {
+		return 0
+	}
+	return pos + int64(utf8.RuneCount(s[start:start+j]))
+}
+
+func value(xs *types.Bytes, i int) []byte {
+	if len(xs.Offsets) == 1 {
+		return xs.Get(0)
+	}
+	return xs.Get(int64(i))
+}
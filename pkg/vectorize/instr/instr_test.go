@@ -0,0 +1,64 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package instr
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstr(t *testing.T) {
+	xs := makeBytes("foobarbar", "xbar", "abc", "abc", "数据库数据", "")
+	subs := makeBytes("bar", "foobar", "", "C", "库", "")
+	rs := Instr(xs, subs, make([]int64, 6))
+	require.Equal(t, []int64{4, 0, 1, 0, 3, 1}, rs)
+
+	rs = Instr(makeBytes("banana"), makeBytes("an", "na", "x"), make([]int64, 3))
+	require.Equal(t, []int64{2, 3, 0}, rs)
+}
+
+func TestLocate(t *testing.T) {
+	subs := makeBytes("bar")
+	xs := makeBytes("foobarbar")
+	tt := []struct {
+		pos  int64
+		want int64
+	}{
+		{-1, 0}, {0, 0}, {1, 4}, {4, 4}, {5, 7}, {8, 0}, {100, 0},
+	}
+	for _, tc := range tt {
+		rs := Locate(subs, xs, []int64{tc.pos}, make([]int64, 1))
+		require.Equal(t, tc.want, rs[0], "pos %d", tc.pos)
+	}
+
+	rs := Locate(makeBytes(""), makeBytes("abc"), []int64{1, 4, 5}, make([]int64, 3))
+	require.Equal(t, []int64{1, 4, 0}, rs)
+
+	rs = Locate(makeBytes("据"), makeBytes("数据库数据", "据", "数"), nil, make([]int64, 3))
+	require.Equal(t, []int64{2, 1, 0}, rs)
+
+	rs = Locate(makeBytes("据"), makeBytes("数据库数据"), []int64{3}, make([]int64, 1))
+	require.Equal(t, []int64{5}, rs)
+}
+
+func makeBytes(strs ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, s := range strs {
+		xs.AppendOnce([]byte(s))
+	}
+	return xs
+}
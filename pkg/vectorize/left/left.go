@@ -0,0 +1,46 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package left
+
+import (
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// Left writes the first ns characters of every string of xs to rs, a length
+// less than 1 gives the empty string. An argument of a single value is used
+// for all the rows, rs holds the offsets and lengths of all the rows and the
+// data is appended.
+func Left(xs *types.Bytes, ns []int64, rs *types.Bytes) *types.Bytes {
+	for i := range rs.Offsets {
+		s, n := xs.Get(0), ns[0]
+		if len(xs.Offsets) > 1 {
+			s = xs.Get(int64(i))
+		}
+		if len(ns) > 1 {
+			n = ns[i]
+		}
+		end := 0
+		for ; n > 0 && end < len(s); n-- {
+			_, size := utf8.DecodeRune(s[end:])
+			end += size
+		}
+		rs.Offsets[i] = uint32(len(rs.Data))
+		rs.Data = append(rs.Data, s[:end]...)
+		rs.Lengths[i] = uint32(end)
+	}
+	return rs
+}
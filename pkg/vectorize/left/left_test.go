@@ -0,0 +1,57 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package left
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeft(t *testing.T) {
+	xs := makeBytes("foobarbar", "abc", "abc", "数据库", "数据库", "")
+	rs := Left(xs, []int64{5, 0, -1, 2, 10, 3}, newResult(6))
+	require.Equal(t, []string{"fooba", "", "", "数据", "数据库", ""}, strings(rs))
+
+	rs = Left(makeBytes("数据库"), []int64{1, 2}, newResult(2))
+	require.Equal(t, []string{"数", "数据"}, strings(rs))
+
+	rs = Left(makeBytes("abc", "de"), []int64{1}, newResult(2))
+	require.Equal(t, []string{"a", "d"}, strings(rs))
+}
+
+func makeBytes(strs ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, s := range strs {
+		xs.AppendOnce([]byte(s))
+	}
+	return xs
+}
+
+func newResult(n int) *types.Bytes {
+	return &types.Bytes{
+		Offsets: make([]uint32, n),
+		Lengths: make([]uint32, n),
+	}
+}
+
+func strings(xs *types.Bytes) []string {
+	ss := make([]string, len(xs.Offsets))
+	for i := range ss {
+		ss[i] = string(xs.Get(int64(i)))
+	}
+	return ss
+}
@@ -0,0 +1,51 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lower
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLower(t *testing.T) {
+	xs := makeBytes("ABC", "", "MatrixOne 1.0", "ÀÉÎ", "ПРИВЕТ", "\xffA")
+	rs := Lower(xs, newResult(6))
+	require.Equal(t, []string{"abc", "", "matrixone 1.0", "àéî", "привет", "\xffa"}, strings(rs))
+}
+
+func makeBytes(strs ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, s := range strs {
+		xs.AppendOnce([]byte(s))
+	}
+	return xs
+}
+
+func newResult(n int) *types.Bytes {
+	return &types.Bytes{
+		Offsets: make([]uint32, n),
+		Lengths: make([]uint32, n),
+	}
+}
+
+func strings(xs *types.Bytes) []string {
+	ss := make([]string, len(xs.Offsets))
+	for i := range ss {
+		ss[i] = string(xs.Get(int64(i)))
+	}
+	return ss
+}
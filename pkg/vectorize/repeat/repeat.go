@@ -0,0 +1,54 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repeat
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// MaxResultLength is the maximum length in bytes of a result of Repeat,
+// a longer result is NULL
+var MaxResultLength = 16 * 1024 * 1024
+
+// Repeat writes every string of xs repeated ns times to rs, a count less than 1
+// gives the empty string and the rows whose result is too long are added to nsp.
+// An argument of a single value is used for all the rows, rs holds the offsets
+// and lengths of all the rows and the data is appended.
+func Repeat(xs *types.Bytes, ns []int64, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
+	for i := range rs.Offsets {
+		s, n := xs.Get(0), ns[0]
+		if len(xs.Offsets) > 1 {
+			s = xs.Get(int64(i))
+		}
+		if len(ns) > 1 {
+			n = ns[i]
+		}
+		rs.Offsets[i] = uint32(len(rs.Data))
+		switch {
+		case n < 1 || len(s) == 0:
+			rs.Lengths[i] = 0
+		case n > int64(MaxResultLength/len(s)):
+			nulls.Add(nsp, uint64(i))
+			rs.Lengths[i] = 0
+		default:
+			rs.Data = append(rs.Data, bytes.Repeat(s, int(n))...)
+			rs.Lengths[i] = uint32(len(s) * int(n))
+		}
+	}
+	return rs
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repeat
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepeat(t *testing.T) {
+	xs := makeBytes("ab", "数", "ab", "ab", "", "ab")
+	nsp := new(nulls.Nulls)
+	rs := Repeat(xs, []int64{3, 2, 0, -1, 10, int64(MaxResultLength)}, newResult(6), nsp)
+	require.Equal(t, []string{"ababab", "数数", "", "", "", ""}, strings(rs))
+	for i := 0; i < 6; i++ {
+		require.Equal(t, i == 5, nulls.Contains(nsp, uint64(i)))
+	}
+
+	rs = Repeat(makeBytes("x"), []int64{1, 2}, newResult(2), new(nulls.Nulls))
+	require.Equal(t, []string{"x", "xx"}, strings(rs))
+}
+
+func makeBytes(strs ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, s := range strs {
+		xs.AppendOnce([]byte(s))
+	}
+	return xs
+}
+
+func newResult(n int) *types.Bytes {
+	return &types.Bytes{
+		Offsets: make([]uint32, n),
+		Lengths: make([]uint32, n),
+	}
+}
+
+func strings(xs *types.Bytes) []string {
+	ss := make([]string, len(xs.Offsets))
+	for i := range ss {
+		ss[i] = string(xs.Get(int64(i)))
+	}
+	return ss
+}
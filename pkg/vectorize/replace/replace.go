@@ -0,0 +1,49 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replace
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// Replace writes every string of xs with all the occurrences of froms replaced
+// by tos to rs, an empty from string leaves the string as it is. An argument of
+// a single string is used for all the rows, rs holds the offsets and lengths of
+// all the rows and the data is appended.
+func Replace(xs, froms, tos *types.Bytes, rs *types.Bytes) *types.Bytes {
+	for i := range rs.Offsets {
+		s, from, to := value(xs, i), value(froms, i), value(tos, i)
+		rs.Offsets[i] = uint32(len(rs.Data))
+		if len(from) > 0 {
+			for j := bytes.Index(s, from); j >= 0; j = bytes.Index(s, from) {
+				rs.Data = append(rs.Data, s[:j]...)
+				rs.Data = append(rs.Data, to...)
+				s = s[j+len(from):]
+			}
+		}
+		rs.Data = append(rs.Data, s...)
+		rs.Lengths[i] = uint32(len(rs.Data)) - rs.Offsets[i]
+	}
+	return rs
+}
+
+func value(xs *types.Bytes, i int) []byte {
+	if len(xs.Offsets) == 1 {
+		return xs.Get(0)
+	}
+	return xs.Get(int64(i))
+}
@@ -0,0 +1,62 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replace
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplace(t *testing.T) {
+	xs := makeBytes("www.mysql.com", "aaa", "abc", "", "数据库数据")
+	froms := makeBytes("w", "aa", "", "a", "数据")
+	tos := makeBytes("Ww", "b", "x", "b", "")
+	rs := Replace(xs, froms, tos, newResult(5))
+	require.Equal(t, []string{"WwWwWw.mysql.com", "ba", "abc", "", "库"}, strings(rs))
+}
+
+func TestReplaceWithConst(t *testing.T) {
+	xs := makeBytes("abcabc", "bca", "xyz")
+	rs := Replace(xs, makeBytes("bc"), makeBytes("_"), newResult(3))
+	require.Equal(t, []string{"a_a_", "_a", "xyz"}, strings(rs))
+
+	rs = Replace(makeBytes("a-b"), makeBytes("-"), makeBytes("+", "", "--"), newResult(3))
+	require.Equal(t, []string{"a+b", "ab", "a--b"}, strings(rs))
+}
+
+func makeBytes(strs ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, s := range strs {
+		xs.AppendOnce([]byte(s))
+	}
+	return xs
+}
+
+func newResult(n int) *types.Bytes {
+	return &types.Bytes{
+		Offsets: make([]uint32, n),
+		Lengths: make([]uint32, n),
+	}
+}
+
+func strings(xs *types.Bytes) []string {
+	ss := make([]string, len(xs.Offsets))
+	for i := range ss {
+		ss[i] = string(xs.Get(int64(i)))
+	}
+	return ss
+}
@@ -0,0 +1,57 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package right
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRight(t *testing.T) {
+	xs := makeBytes("foobarbar", "abc", "abc", "数据库", "数据库", "")
+	rs := Right(xs, []int64{5, 0, -1, 2, 10, 3}, newResult(6))
+	require.Equal(t, []string{"arbar", "", "", "据库", "数据库", ""}, strings(rs))
+
+	rs = Right(makeBytes("数据库"), []int64{1, 2}, newResult(2))
+	require.Equal(t, []string{"库", "据库"}, strings(rs))
+
+	rs = Right(makeBytes("abc", "de"), []int64{1}, newResult(2))
+	require.Equal(t, []string{"c", "e"}, strings(rs))
+}
+
+func makeBytes(strs ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, s := range strs {
+		xs.AppendOnce([]byte(s))
+	}
+	return xs
+}
+
+func newResult(n int) *types.Bytes {
+	return &types.Bytes{
+		Offsets: make([]uint32, n),
+		Lengths: make([]uint32, n),
+	}
+}
+
+func strings(xs *types.Bytes) []string {
+	ss := make([]string, len(xs.Offsets))
+	for i := range ss {
+		ss[i] = string(xs.Get(int64(i)))
+	}
+	return ss
+}
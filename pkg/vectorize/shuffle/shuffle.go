@@ -37,6 +37,8 @@ var (
 	Decimal64Shuffle  = fixedLengthShuffle[types.Decimal64]
 	Decimal128Shuffle = fixedLengthShuffle[types.Decimal128]
 
+	UuidShuffle = fixedLengthShuffle[types.Uuid]
+
 	DateShuffle      = fixedLengthShuffle[types.Date]
 	DatetimeShuffle  = fixedLengthShuffle[types.Datetime]
 	TimestampShuffle = fixedLengthShuffle[types.Timestamp]
@@ -47,7 +49,7 @@ var (
 )
 
 type fixedLength interface {
-	constraints.Integer | constraints.Float | types.Decimal64 | types.Decimal128 | bool
+	constraints.Integer | constraints.Float | types.Decimal64 | types.Decimal128 | types.Uuid | bool
 }
 
 func fixedLengthShuffle[T fixedLength](vs, ws []T, sels []int64) []T {
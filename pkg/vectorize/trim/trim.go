@@ -0,0 +1,51 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trim
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// Trim writes every string of xs to rs without the repeated occurrences of rems
+// at its start if leading is set and at its end if trailing is set, an empty
+// rem string leaves the string as it is. An argument of a single string is used
+// for all the rows, rs holds the offsets and lengths of all the rows and the
+// data is appended.
+func Trim(xs, rems *types.Bytes, leading, trailing bool, rs *types.Bytes) *types.Bytes {
+	for i := range rs.Offsets {
+		s, rem := value(xs, i), value(rems, i)
+		if len(rem) > 0 {
+			for leading && bytes.HasPrefix(s, rem) {
+				s = s[len(rem):]
+			}
+			for trailing && bytes.HasSuffix(s, rem) {
+				s = s[:len(s)-len(rem)]
+			}
+		}
+		rs.Offsets[i] = uint32(len(rs.Data))
+		rs.Data = append(rs.Data, s...)
+		rs.Lengths[i] = uint32(len(s))
+	}
+	return rs
+}
+
+func value(xs *types.Bytes, i int) []byte {
+	if len(xs.Offsets) == 1 {
+		return xs.Get(0)
+	}
+	return xs.Get(int64(i))
+}
@@ -0,0 +1,67 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trim
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrim(t *testing.T) {
+	xs := makeBytes("  bar  ", "xxbarxxx", "xyxbarxy", "", "测试测试", "barxx")
+	rems := makeBytes(" ", "x", "xy", "x", "测试", "")
+	tt := []struct {
+		leading, trailing bool
+		want              []string
+	}{
+		{true, true, []string{"bar", "bar", "xbar", "", "", "barxx"}},
+		{true, false, []string{"bar  ", "barxxx", "xbarxy", "", "", "barxx"}},
+		{false, true, []string{"  bar", "xxbar", "xyxbar", "", "", "barxx"}},
+	}
+	for _, tc := range tt {
+		rs := Trim(xs, rems, tc.leading, tc.trailing, newResult(6))
+		require.Equal(t, tc.want, strings(rs))
+	}
+}
+
+func TestTrimWithConst(t *testing.T) {
+	rs := Trim(makeBytes("aXa", "aa", "b"), makeBytes("a"), true, true, newResult(3))
+	require.Equal(t, []string{"X", "", "b"}, strings(rs))
+}
+
+func makeBytes(strs ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, s := range strs {
+		xs.AppendOnce([]byte(s))
+	}
+	return xs
+}
+
+func newResult(n int) *types.Bytes {
+	return &types.Bytes{
+		Offsets: make([]uint32, n),
+		Lengths: make([]uint32, n),
+	}
+}
+
+func strings(xs *types.Bytes) []string {
+	ss := make([]string, len(xs.Offsets))
+	for i := range ss {
+		ss[i] = string(xs.Get(int64(i)))
+	}
+	return ss
+}
@@ -0,0 +1,55 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upper
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// Upper writes the upper case of every string of xs to rs, the characters are
+// mapped according to unicode and the bytes which are not valid utf-8 are kept.
+// rs holds the offsets and lengths of all the rows, the data is appended.
+func Upper(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
+	for i := range rs.Offsets {
+		rs.Offsets[i] = uint32(len(rs.Data))
+		rs.Data = appendUpper(rs.Data, xs.Get(int64(i)))
+		rs.Lengths[i] = uint32(len(rs.Data)) - rs.Offsets[i]
+	}
+	return rs
+}
+
+func appendUpper(dst, s []byte) []byte {
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if 'a' <= c && c <= 'z' {
+				c -= 'a' - 'A'
+			}
+			dst = append(dst, c)
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRune(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			dst = append(dst, s[i])
+		} else {
+			dst = utf8.AppendRune(dst, unicode.ToUpper(r))
+		}
+		i += size
+	}
+	return dst
+}
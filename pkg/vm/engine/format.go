@@ -68,6 +68,9 @@ func (node *PrimaryIndexDef) Format(buf *bytes.Buffer) {
 }
 
 func (node *IndexTableDef) Format(buf *bytes.Buffer) {
+	if node.Typ == Unique {
+		buf.WriteString("UNIQUE ")
+	}
 	buf.WriteString("KEY")
 	buf.WriteString(" `")
 	buf.WriteString(node.Name)
@@ -83,6 +86,9 @@ func (node *IndexTableDef) Format(buf *bytes.Buffer) {
 	}
 	buf.WriteString(")")
 
+	if node.Typ == Unique {
+		return
+	}
 	buf.WriteString(" USING ")
 	buf.WriteString(node.Typ.ToString())
 }
@@ -96,7 +102,7 @@ func makeVal2Str(typ types.Type, value interface{}, isNull bool) string {
 		res := value.(int64)
 		str := strconv.FormatInt(res, 10)
 		return str
-	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64, types.T_bit:
 		res := value.(uint64)
 		str := strconv.FormatUint(res, 10)
 		return str
@@ -104,7 +110,8 @@ func makeVal2Str(typ types.Type, value interface{}, isNull bool) string {
 		res := value.(float64)
 		str := strconv.FormatFloat(res, 'f', 10, 64)
 		return str
-	case types.T_char, types.T_varchar:
+	case types.T_char, types.T_varchar, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_blob:
 		res := value.(string)
 		return res
 	case types.T_date:
@@ -16,14 +16,24 @@ package memEngine
 
 import (
 	"bytes"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine/meta"
 )
 
 func (d *database) Relations(_ engine.Snapshot) []string {
-	names, _ := d.db.Range()
+	keys, _ := d.db.Range()
+	// the keys of the segments are named after the tables with a dot
+	names := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if !strings.Contains(key, ".") {
+			names = append(names, key)
+		}
+	}
 	return names
 }
 
@@ -54,6 +64,14 @@ func (d *database) Create(_ uint64, name string, defs []engine.TableDef, _ engin
 			md.Attrs = append(md.Attrs, d.Attr)
 		case *engine.IndexTableDef:
 			md.Index = append(md.Index, engine.IndexTableDef{Typ: d.Typ, ColNames: d.ColNames, Name: d.Name})
+		case *engine.ViewDef:
+			md.View = d.View
+		case *engine.CheckDef:
+			md.Checks = append(md.Checks, *d)
+		case *engine.ForeignKeyDef:
+			md.ForeignKeys = append(md.ForeignKeys, *d)
+		case *engine.PartitionDef:
+			return errors.New(errno.FeatureNotSupported, "memory engine does not support partitioned tables")
 		}
 	}
 	data, err := encoding.Encode(md)
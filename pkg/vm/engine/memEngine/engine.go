@@ -69,11 +69,16 @@ func (e *MemEngine) Resolve(_ string, tableName string) (*plan.ObjectRef, *plan.
 	}
 	rel, err := db.Relation(tableName, nil)
 	if err != nil {
-		panic(err)
+		return nil, nil
 	}
 	defs := rel.TableDefs(nil)
 	cols := make([]*plan.ColDef, 0, len(defs))
+	var view *plan.ViewDef
 	for _, def := range defs {
+		if v, ok := def.(*engine.ViewDef); ok {
+			view = &plan.ViewDef{View: v.View}
+			continue
+		}
 		attr, ok := def.(*engine.AttributeDef)
 		if !ok {
 			continue
@@ -81,13 +86,15 @@ func (e *MemEngine) Resolve(_ string, tableName string) (*plan.ObjectRef, *plan.
 		cols = append(cols, &plan.ColDef{
 			Name: attr.Attr.Name,
 			Typ: &plan.Type{
-				Id:    plan.Type_TypeId(attr.Attr.Type.Oid),
-				Width: attr.Attr.Type.Width,
-				Size:  attr.Attr.Type.Size,
+				Id:         plan.Type_TypeId(attr.Attr.Type.Oid),
+				Width:      attr.Attr.Type.Width,
+				Size:       attr.Attr.Type.Size,
+				Enumvalues: attr.Attr.EnumValues,
 			},
+			AutoIncr: attr.Attr.AutoIncrement,
 		})
 	}
-	return &plan.ObjectRef{SchemaName: schemaName, ObjName: schemaName}, &plan.TableDef{Name: tableName, Cols: cols}
+	return &plan.ObjectRef{SchemaName: schemaName, ObjName: schemaName}, &plan.TableDef{Name: tableName, Cols: cols, View: view}
 }
 
 func (e *MemEngine) ResolveVariable(_ string, _, _ bool) (interface{}, error) {
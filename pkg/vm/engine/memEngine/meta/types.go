@@ -17,9 +17,12 @@ package meta
 import "github.com/matrixorigin/matrixone/pkg/vm/engine"
 
 type Metadata struct {
-	Segs  int64
-	Rows  int64
-	Name  string
-	Attrs []engine.Attribute
-	Index []engine.IndexTableDef
+	Segs        int64
+	Rows        int64
+	Name        string
+	Attrs       []engine.Attribute
+	Index       []engine.IndexTableDef
+	View        string
+	Checks      []engine.CheckDef
+	ForeignKeys []engine.ForeignKeyDef
 }
@@ -15,6 +15,7 @@
 package memEngine
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -78,6 +79,15 @@ func (r *relation) TableDefs(_ engine.Snapshot) []engine.TableDef {
 		defs[j] = &localIndex
 		j++
 	}
+	if r.md.View != "" {
+		defs = append(defs, &engine.ViewDef{View: r.md.View})
+	}
+	for i := range r.md.Checks {
+		defs = append(defs, &r.md.Checks[i])
+	}
+	for i := range r.md.ForeignKeys {
+		defs = append(defs, &r.md.ForeignKeys[i])
+	}
 	return defs
 }
 
@@ -173,12 +183,88 @@ func (r *relation) DropIndex(epoch uint64, name string) error {
 	return nil
 }
 
-func (r *relation) AddTableDef(_ uint64, _ engine.TableDef, _ engine.Snapshot) error {
-	return nil
+func (r *relation) AddTableDef(_ uint64, def engine.TableDef, _ engine.Snapshot) error {
+	switch d := def.(type) {
+	case *engine.AttributeDef:
+		if r.md.Segs > 0 {
+			return fmt.Errorf("add column to non-empty relation '%s' not supported", r.id)
+		}
+		r.md.Attrs = append(r.md.Attrs, d.Attr)
+	case *engine.RenameColumnDef:
+		i := r.attrIndex(d.OldName)
+		if i < 0 {
+			return fmt.Errorf("column '%s' not exist", d.OldName)
+		}
+		for j := 0; j < int(r.md.Segs); j++ {
+			if err := r.moveKey(sKey(j, r.id)+"."+d.OldName, sKey(j, r.id)+"."+d.NewName); err != nil {
+				return err
+			}
+		}
+		r.md.Attrs[i].Name = d.NewName
+	case *engine.RenameTableDef:
+		for j := 0; j < int(r.md.Segs); j++ {
+			for _, attr := range r.md.Attrs {
+				if err := r.moveKey(sKey(j, r.id)+"."+attr.Name, sKey(j, d.Name)+"."+attr.Name); err != nil {
+					return err
+				}
+			}
+		}
+		if err := r.db.Del(r.id); err != nil {
+			return err
+		}
+		r.id = d.Name
+	default:
+		return nil
+	}
+	return r.flushMetadata()
 }
 
-func (r *relation) DelTableDef(_ uint64, _ engine.TableDef, _ engine.Snapshot) error {
-	return nil
+func (r *relation) DelTableDef(_ uint64, def engine.TableDef, _ engine.Snapshot) error {
+	d, ok := def.(*engine.AttributeDef)
+	if !ok {
+		return nil
+	}
+	i := r.attrIndex(d.Attr.Name)
+	if i < 0 {
+		return fmt.Errorf("column '%s' not exist", d.Attr.Name)
+	}
+	for j := 0; j < int(r.md.Segs); j++ {
+		if err := r.db.Del(sKey(j, r.id) + "." + d.Attr.Name); err != nil {
+			return err
+		}
+	}
+	r.md.Attrs = append(r.md.Attrs[:i], r.md.Attrs[i+1:]...)
+	return r.flushMetadata()
+}
+
+func (r *relation) attrIndex(name string) int {
+	for i, attr := range r.md.Attrs {
+		if attr.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (r *relation) moveKey(from, to string) error {
+	var buf bytes.Buffer
+
+	data, err := r.db.Get(from, &buf)
+	if err != nil {
+		return err
+	}
+	if err := r.db.Set(to, data); err != nil {
+		return err
+	}
+	return r.db.Del(from)
+}
+
+func (r *relation) flushMetadata() error {
+	data, err := encoding.Encode(r.md)
+	if err != nil {
+		return err
+	}
+	return r.db.Set(r.id, data)
 }
 
 func sKey(num int, id string) string {
@@ -0,0 +1,424 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// The functions allowed in the partitioning expression
+const (
+	PartitionFuncYear      = "year"
+	PartitionFuncMonth     = "month"
+	PartitionFuncToDays    = "to_days"
+	PartitionFuncToSeconds = "to_seconds"
+)
+
+const partitionSep = "#P#"
+
+// daysBeforeYear1 is the number of days from year 0 to 0001-01-01, which is
+// the zero value of types.Date
+const daysBeforeYear1 = 366
+
+func (typ PartitionType) String() string {
+	switch typ {
+	case RangePartition:
+		return "RANGE"
+	case ListPartition:
+		return "LIST"
+	case HashPartition:
+		return "HASH"
+	case KeyPartition:
+		return "KEY"
+	default:
+		return "INVALID"
+	}
+}
+
+// PartitionTableName returns the name of the relation storing the rows of
+// the partition part of the relation table
+func PartitionTableName(table, part string) string {
+	return table + partitionSep + part
+}
+
+// IsPartitionTable returns true if name is the relation of a partition
+func IsPartitionTable(name string) bool {
+	return strings.Contains(name, partitionSep)
+}
+
+// Partitioner places the rows of a partitioned relation into its partitions
+type Partitioner struct {
+	def *PartitionDef
+	// bounds is the upper bounds of the RANGE partitions, nil for MAXVALUE
+	bounds []interface{}
+	// lists maps the values of the LIST partitions to the partitions
+	lists map[interface{}]int
+}
+
+// NewPartitioner returns the Partitioner of def, typs is the types of the
+// partition columns
+func NewPartitioner(def *PartitionDef, typs []types.Type) (*Partitioner, error) {
+	if len(typs) != len(def.Cols) || len(def.Partitions) == 0 {
+		return nil, errors.New(errno.InvalidTableDefinition, "invalid partition definition")
+	}
+	p := &Partitioner{def: def}
+	if def.Typ == KeyPartition {
+		return p, nil
+	}
+	oid, err := PartitionValueType(def.Func, typs[0])
+	if err != nil {
+		return nil, err
+	}
+	switch def.Typ {
+	case RangePartition:
+		p.bounds = make([]interface{}, len(def.Partitions))
+		for i, part := range def.Partitions {
+			if len(part.Values) == 0 {
+				if i != len(def.Partitions)-1 {
+					return nil, errors.New(errno.InvalidTableDefinition, "MAXVALUE can only be used in last partition definition")
+				}
+				continue
+			}
+			if p.bounds[i], err = ParsePartitionValue(oid, part.Values[0]); err != nil {
+				return nil, err
+			}
+			if i > 0 {
+				if r, ok := comparePartitionValues(p.bounds[i-1], p.bounds[i]); !ok || r >= 0 {
+					return nil, errors.New(errno.InvalidTableDefinition, "VALUES LESS THAN value must be strictly increasing for each partition")
+				}
+			}
+		}
+	case ListPartition:
+		p.lists = make(map[interface{}]int)
+		for i, part := range def.Partitions {
+			for _, text := range part.Values {
+				v, err := ParsePartitionValue(oid, text)
+				if err != nil {
+					return nil, err
+				}
+				if _, ok := p.lists[v]; ok {
+					return nil, errors.New(errno.InvalidTableDefinition, "Multiple definition of same constant in list partitioning")
+				}
+				p.lists[v] = i
+			}
+		}
+	case HashPartition:
+		if oid != types.T_int64 {
+			return nil, errors.New(errno.InvalidTableDefinition, "the partitioning function of HASH partitions must return an integer")
+		}
+	}
+	return p, nil
+}
+
+// Locate returns the partition of the row whose partition columns hold vals,
+// or -1 if no partition takes the row
+func (p *Partitioner) Locate(vals []interface{}) int {
+	n := len(p.def.Partitions)
+	if p.def.Typ == KeyPartition {
+		h := fnv.New64a()
+		for _, v := range vals {
+			if v = normalizePartitionValue(v); v == nil {
+				h.Write([]byte{0})
+				continue
+			}
+			fmt.Fprintf(h, "%v", v)
+			h.Write([]byte{1})
+		}
+		return int(h.Sum64() % uint64(n))
+	}
+	v := normalizePartitionValue(vals[0])
+	if v != nil && p.def.Func != "" {
+		var ok bool
+		if v, ok = ApplyPartitionFunc(p.def.Func, v); !ok {
+			return -1
+		}
+	}
+	return p.locate(v)
+}
+
+func (p *Partitioner) locate(v interface{}) int {
+	switch p.def.Typ {
+	case RangePartition:
+		// null is less than any value
+		if v == nil {
+			return 0
+		}
+		for i, bound := range p.bounds {
+			if bound == nil {
+				return i
+			}
+			if r, ok := comparePartitionValues(v, bound); !ok {
+				return -1
+			} else if r < 0 {
+				return i
+			}
+		}
+	case ListPartition:
+		if i, ok := p.lists[v]; ok {
+			return i
+		}
+	case HashPartition:
+		if v == nil {
+			return 0
+		}
+		if x, ok := v.(int64); ok {
+			if x < 0 {
+				x = -x
+			}
+			return int(x % int64(len(p.def.Partitions)))
+		}
+	}
+	return -1
+}
+
+// Prune returns the partitions which may hold the rows satisfying the
+// predicate "col op v", where col is the partition column. The result is nil
+// if the predicate prunes no partition
+func (p *Partitioner) Prune(op string, v interface{}) []bool {
+	if v = normalizePartitionValue(v); v == nil || len(p.def.Cols) != 1 {
+		return nil
+	}
+	if p.def.Func != "" {
+		var ok bool
+		if v, ok = ApplyPartitionFunc(p.def.Func, v); !ok {
+			return nil
+		}
+		// the functions are nondecreasing except month, a strict comparison
+		// of the column turns into a loose one of the partition value
+		switch op {
+		case "=":
+		case "<", "<=":
+			op = "<="
+		case ">", ">=":
+			op = ">="
+		default:
+			return nil
+		}
+		if op != "=" && p.def.Func == PartitionFuncMonth {
+			return nil
+		}
+	}
+	parts := make([]bool, len(p.def.Partitions))
+	if op == "=" {
+		if p.def.Typ == KeyPartition {
+			parts[p.Locate([]interface{}{v})] = true
+			return parts
+		}
+		if i := p.locate(v); i >= 0 {
+			parts[i] = true
+		}
+		return parts
+	}
+	switch p.def.Typ {
+	case RangePartition:
+		for i := range parts {
+			var ok bool
+			switch op {
+			case "<", "<=":
+				// the lower bound of the partition is the upper bound of the
+				// previous one
+				if i == 0 {
+					parts[i] = true
+					continue
+				}
+				var r int
+				if r, ok = comparePartitionValues(p.bounds[i-1], v); ok {
+					parts[i] = r < 0 || (r == 0 && op == "<=")
+				}
+			case ">", ">=":
+				if p.bounds[i] == nil {
+					parts[i] = true
+					continue
+				}
+				var r int
+				if r, ok = comparePartitionValues(p.bounds[i], v); ok {
+					parts[i] = r > 0
+				}
+			}
+			if !ok {
+				return nil
+			}
+		}
+	case ListPartition:
+		for x, i := range p.lists {
+			r, ok := comparePartitionValues(x, v)
+			if !ok {
+				return nil
+			}
+			switch op {
+			case "<":
+				parts[i] = parts[i] || r < 0
+			case "<=":
+				parts[i] = parts[i] || r <= 0
+			case ">":
+				parts[i] = parts[i] || r > 0
+			case ">=":
+				parts[i] = parts[i] || r >= 0
+			default:
+				return nil
+			}
+		}
+	default:
+		return nil
+	}
+	return parts
+}
+
+// PartitionValueType returns the type of the values placing the rows into
+// partitions, which is the value of the partitioning function fn applied to
+// the column of type typ
+func PartitionValueType(fn string, typ types.Type) (types.T, error) {
+	if fn != "" {
+		if typ.Oid != types.T_date && typ.Oid != types.T_datetime {
+			return 0, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("partitioning function %s requires a date or datetime column", fn))
+		}
+		return types.T_int64, nil
+	}
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return types.T_int64, nil
+	case types.T_date, types.T_datetime, types.T_char, types.T_varchar:
+		return typ.Oid, nil
+	}
+	return 0, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("type %s is not allowed in the partitioning expression", typ))
+}
+
+// ParsePartitionValue parses the canonical text of a partition value of
+// type oid
+func ParsePartitionValue(oid types.T, text string) (interface{}, error) {
+	switch oid {
+	case types.T_int64:
+		return strconv.ParseInt(text, 10, 64)
+	case types.T_date:
+		return types.ParseDate(text)
+	case types.T_datetime:
+		return types.ParseDatetime(text, 6)
+	case types.T_char, types.T_varchar:
+		return text, nil
+	}
+	return nil, errors.New(errno.InvalidTableDefinition, fmt.Sprintf("invalid partition value '%s'", text))
+}
+
+// FormatPartitionValue returns the canonical text of a partition value
+func FormatPartitionValue(v interface{}) string {
+	switch x := normalizePartitionValue(v).(type) {
+	case int64:
+		return strconv.FormatInt(x, 10)
+	case string:
+		return x
+	case types.Date:
+		return x.String()
+	case types.Datetime:
+		return x.String2(6)
+	case nil:
+		return "NULL"
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// ApplyPartitionFunc returns the value of the partitioning function fn
+// applied to the date or datetime v
+func ApplyPartitionFunc(fn string, v interface{}) (interface{}, bool) {
+	var d types.Date
+	var hour, min, sec int8
+	switch x := v.(type) {
+	case types.Date:
+		d = x
+	case types.Datetime:
+		d = x.ToDate()
+		hour, min, sec = x.Clock()
+	default:
+		return nil, false
+	}
+	switch fn {
+	case PartitionFuncYear:
+		return int64(d.Year()), true
+	case PartitionFuncMonth:
+		return int64(d.Month()), true
+	case PartitionFuncToDays:
+		return int64(d) + daysBeforeYear1, true
+	case PartitionFuncToSeconds:
+		return (int64(d)+daysBeforeYear1)*86400 + int64(hour)*3600 + int64(min)*60 + int64(sec), true
+	}
+	return nil, false
+}
+
+func normalizePartitionValue(v interface{}) interface{} {
+	switch x := v.(type) {
+	case int8:
+		return int64(x)
+	case int16:
+		return int64(x)
+	case int32:
+		return int64(x)
+	case uint8:
+		return int64(x)
+	case uint16:
+		return int64(x)
+	case uint32:
+		return int64(x)
+	case uint64:
+		return int64(x)
+	case []byte:
+		return string(x)
+	}
+	return v
+}
+
+func comparePartitionValues(a, b interface{}) (int, bool) {
+	switch x := a.(type) {
+	case int64:
+		if y, ok := b.(int64); ok {
+			return compareOrdered(x, y), true
+		}
+	case string:
+		if y, ok := b.(string); ok {
+			return strings.Compare(x, y), true
+		}
+	case types.Date:
+		switch y := b.(type) {
+		case types.Date:
+			return compareOrdered(x, y), true
+		case types.Datetime:
+			return compareOrdered(x.ToTime(), y), true
+		}
+	case types.Datetime:
+		switch y := b.(type) {
+		case types.Datetime:
+			return compareOrdered(x, y), true
+		case types.Date:
+			return compareOrdered(x, y.ToTime()), true
+		}
+	}
+	return 0, false
+}
+
+func compareOrdered[T int64 | types.Date | types.Datetime](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,102 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangePartitioner(t *testing.T) {
+	def := &PartitionDef{
+		Typ:  RangePartition,
+		Func: PartitionFuncYear,
+		Cols: []string{"d"},
+		Partitions: []Partition{
+			{Name: "p0", Values: []string{"2020"}},
+			{Name: "p1", Values: []string{"2021"}},
+			{Name: "p2"},
+		},
+	}
+	p, err := NewPartitioner(def, []types.Type{types.T_date.ToType()})
+	require.NoError(t, err)
+
+	date := func(s string) types.Date {
+		d, err := types.ParseDate(s)
+		require.NoError(t, err)
+		return d
+	}
+	require.Equal(t, 0, p.Locate([]interface{}{date("2019-12-31")}))
+	require.Equal(t, 1, p.Locate([]interface{}{date("2020-01-01")}))
+	require.Equal(t, 2, p.Locate([]interface{}{date("2022-06-01")}))
+	require.Equal(t, 0, p.Locate([]interface{}{nil}))
+
+	require.Equal(t, []bool{false, true, false}, p.Prune("=", date("2020-05-01")))
+	require.Equal(t, []bool{true, true, false}, p.Prune("<", date("2020-05-01")))
+	require.Equal(t, []bool{false, true, true}, p.Prune(">=", date("2020-05-01")))
+	require.Nil(t, p.Prune("<>", date("2020-05-01")))
+
+	def.Partitions[0], def.Partitions[1] = def.Partitions[1], def.Partitions[0]
+	_, err = NewPartitioner(def, []types.Type{types.T_date.ToType()})
+	require.Error(t, err)
+}
+
+func TestListAndHashPartitioner(t *testing.T) {
+	def := &PartitionDef{
+		Typ:  ListPartition,
+		Cols: []string{"a"},
+		Partitions: []Partition{
+			{Name: "p0", Values: []string{"1", "3"}},
+			{Name: "p1", Values: []string{"2", "4"}},
+		},
+	}
+	p, err := NewPartitioner(def, []types.Type{types.T_int32.ToType()})
+	require.NoError(t, err)
+	require.Equal(t, 0, p.Locate([]interface{}{int32(3)}))
+	require.Equal(t, 1, p.Locate([]interface{}{int32(2)}))
+	require.Equal(t, -1, p.Locate([]interface{}{int32(5)}))
+	require.Equal(t, []bool{true, false}, p.Prune("<", int64(2)))
+
+	def.Partitions[1].Values = append(def.Partitions[1].Values, "3")
+	_, err = NewPartitioner(def, []types.Type{types.T_int32.ToType()})
+	require.Error(t, err)
+
+	def = &PartitionDef{
+		Typ:        HashPartition,
+		Cols:       []string{"a"},
+		Partitions: []Partition{{Name: "p0"}, {Name: "p1"}, {Name: "p2"}},
+	}
+	p, err = NewPartitioner(def, []types.Type{types.T_int64.ToType()})
+	require.NoError(t, err)
+	require.Equal(t, 1, p.Locate([]interface{}{int64(-7)}))
+	require.Equal(t, []bool{false, false, true}, p.Prune("=", int64(5)))
+	require.Nil(t, p.Prune(">", int64(5)))
+}
+
+func TestApplyPartitionFunc(t *testing.T) {
+	d, err := types.ParseDate("2007-10-07")
+	require.NoError(t, err)
+	v, ok := ApplyPartitionFunc(PartitionFuncToDays, d)
+	require.True(t, ok)
+	require.Equal(t, int64(733321), v)
+
+	dt, err := types.ParseDatetime("2009-11-29 13:43:32", 6)
+	require.NoError(t, err)
+	v, ok = ApplyPartitionFunc(PartitionFuncToSeconds, dt)
+	require.True(t, ok)
+	require.Equal(t, int64(63426721412), v)
+}
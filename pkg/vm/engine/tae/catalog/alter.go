@@ -0,0 +1,181 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
+)
+
+// TableAlterEntry is the txn entry of an uncommitted schema change. All the
+// changes made to a table in one txn are staged on a private copy of the table
+// schema, which replaces the table schema when the txn commits
+type TableAlterEntry struct {
+	*sync.RWMutex
+	table  *TableEntry
+	txn    txnif.AsyncTxn
+	schema *Schema
+
+	// prev is the replaced table schema, kept to rollback a prepared alter
+	prev       *Schema
+	prevAt     uint64
+	prevIndex  *wal.Index
+	isPrepared bool
+}
+
+// PrepareAlter registers txn as the only writer of the table schema until it
+// commits or rollbacks
+func (entry *TableEntry) PrepareAlter(txn txnif.AsyncTxn) (alter *TableAlterEntry, err error) {
+	entry.Lock()
+	defer entry.Unlock()
+	if entry.Txn != nil && entry.Txn.GetID() != txn.GetID() {
+		err = txnif.ErrTxnWWConflict
+		return
+	}
+	if entry.alterTxn != nil || entry.alterAt > txn.GetStartTS() {
+		err = txnif.ErrTxnWWConflict
+		return
+	}
+	entry.alterTxn = txn
+	alter = &TableAlterEntry{
+		RWMutex: new(sync.RWMutex),
+		table:   entry,
+		txn:     txn,
+		schema:  entry.schema.Clone(),
+	}
+	return
+}
+
+// ApplyAlter replaces the table schema with a committed one
+func (entry *TableEntry) ApplyAlter(schema *Schema, ts uint64, index *wal.Index) {
+	db := entry.db
+	db.Lock()
+	entry.Lock()
+	prev := entry.schema
+	entry.schema = schema
+	entry.alterAt = ts
+	entry.alterIndex = index
+	entry.Unlock()
+	if prev.Name != schema.Name {
+		db.renameTableLocked(entry.GetID(), prev.Name, schema.Name)
+	}
+	db.Unlock()
+	db.catalog.AddColumnCnt(len(schema.ColDefs) - len(prev.ColDefs))
+	logutil.Info("[Catalog]", common.OperationField("alter"),
+		common.OperandField(entry.String()),
+		common.AnyField("version", schema.Version))
+}
+
+func (entry *TableEntry) releaseAlter(txn txnif.AsyncTxn) {
+	entry.Lock()
+	defer entry.Unlock()
+	if entry.alterTxn != nil && entry.alterTxn.GetID() == txn.GetID() {
+		entry.alterTxn = nil
+	}
+}
+
+// IsAlteredBy returns true if txn has uncommitted schema changes on the table
+func (entry *TableEntry) IsAlteredBy(txn txnif.AsyncTxn) bool {
+	entry.RLock()
+	defer entry.RUnlock()
+	return entry.alterTxn != nil && entry.alterTxn.GetID() == txn.GetID()
+}
+
+func (alter *TableAlterEntry) GetTable() *TableEntry { return alter.table }
+
+// GetSchema returns the staged schema
+func (alter *TableAlterEntry) GetSchema() *Schema {
+	alter.RLock()
+	defer alter.RUnlock()
+	return alter.schema
+}
+
+// Alter applies fn on a copy of the staged schema. The staged schema is left
+// unchanged if fn fails
+func (alter *TableAlterEntry) Alter(fn func(*Schema) error) (err error) {
+	alter.Lock()
+	defer alter.Unlock()
+	schema := alter.schema.Clone()
+	if err = fn(schema); err != nil {
+		return
+	}
+	if schema.Name != alter.schema.Name {
+		if _, err = alter.table.db.GetTableEntry(schema.Name, alter.txn); err == nil {
+			err = fmt.Errorf("%w: table \"%s\"", ErrDuplicate, schema.Name)
+			return
+		} else if err != ErrNotFound {
+			return
+		}
+		err = nil
+	}
+	alter.schema = schema
+	return
+}
+
+// PrepareCommit makes the staged schema the table schema. It is done in the
+// prepare phase so that txns prepared later see the new layout
+func (alter *TableAlterEntry) PrepareCommit() error {
+	alter.Lock()
+	defer alter.Unlock()
+	table := alter.table
+	table.RLock()
+	alter.prev, alter.prevAt, alter.prevIndex = table.schema, table.alterAt, table.alterIndex
+	table.RUnlock()
+	table.ApplyAlter(alter.schema, alter.txn.GetCommitTS(), nil)
+	alter.isPrepared = true
+	return nil
+}
+
+func (alter *TableAlterEntry) PrepareRollback() error {
+	alter.Lock()
+	defer alter.Unlock()
+	if alter.isPrepared {
+		alter.table.ApplyAlter(alter.prev, alter.prevAt, alter.prevIndex)
+		alter.isPrepared = false
+	}
+	alter.table.releaseAlter(alter.txn)
+	return nil
+}
+
+func (alter *TableAlterEntry) ApplyRollback() error { return nil }
+
+func (alter *TableAlterEntry) ApplyCommit(index *wal.Index) error {
+	table := alter.table
+	table.Lock()
+	table.alterIndex = index
+	table.alterTxn = nil
+	table.Unlock()
+	return nil
+}
+
+func (alter *TableAlterEntry) MakeCommand(id uint32) (txnif.TxnCmd, error) {
+	return newAlterTableCmd(id, alter.table, alter.txn.GetCommitTS(), alter.GetSchema()), nil
+}
+
+// MakeAlterLogEntry returns the command of the last schema change committed
+// in [minTs, maxTs], if any, together with its log index
+func (entry *TableEntry) MakeAlterLogEntry(minTs, maxTs uint64) (cmd *EntryCommand, index *wal.Index) {
+	entry.RLock()
+	defer entry.RUnlock()
+	if entry.alterAt < minTs || entry.alterAt > maxTs || entry.alterAt == 0 {
+		return
+	}
+	return newAlterTableCmd(0, entry, entry.alterAt, entry.schema), entry.alterIndex
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
@@ -33,6 +34,20 @@ type BlockEntry struct {
 	segment *SegmentEntry
 	state   EntryState
 	blkData data.Block
+	// colCnt is the number of table columns when the block was created.
+	// Columns added later are not physically stored in this block
+	colCnt uint16
+	view   atomic.Value
+}
+
+// blockStateV1 leads the encoding of a block state followed by the column
+// count. The blocks written before have the state only, which is never
+// blockStateV1
+const blockStateV1 = EntryState(0x7f)
+
+type blockSchemaView struct {
+	base *Schema
+	view *Schema
 }
 
 func NewReplayBlockEntry() *BlockEntry {
@@ -54,6 +69,7 @@ func NewBlockEntry(segment *SegmentEntry, txn txnif.AsyncTxn, state EntryState,
 		},
 		segment: segment,
 		state:   state,
+		colCnt:  uint16(len(segment.GetTable().GetSchema().ColDefs)),
 	}
 	if dataFactory != nil {
 		e.blkData = dataFactory(e)
@@ -73,6 +89,7 @@ func NewStandaloneBlock(segment *SegmentEntry, id uint64, ts uint64) *BlockEntry
 		},
 		segment: segment,
 		state:   ES_Appendable,
+		colCnt:  uint16(len(segment.GetTable().GetSchema().ColDefs)),
 	}
 	return e
 }
@@ -89,6 +106,7 @@ func NewSysBlockEntry(segment *SegmentEntry, id uint64) *BlockEntry {
 		},
 		segment: segment,
 		state:   ES_Appendable,
+		colCnt:  uint16(len(segment.GetTable().GetSchema().ColDefs)),
 	}
 	return e
 }
@@ -154,7 +172,31 @@ func (entry *BlockEntry) InitData(factory DataFactory) {
 	entry.blkData = dataFactory(entry)
 }
 func (entry *BlockEntry) GetBlockData() data.Block { return entry.blkData }
-func (entry *BlockEntry) GetSchema() *Schema       { return entry.GetSegment().GetTable().GetSchema() }
+func (entry *BlockEntry) GetColumnCnt() int        { return len(entry.GetSchema().ColDefs) }
+
+// GetSchema returns the physical schema of the block, which is the table
+// schema without the columns added after the block was created
+func (entry *BlockEntry) GetSchema() *Schema {
+	base := entry.GetSegment().GetTable().GetSchema()
+	if cached, ok := entry.view.Load().(blockSchemaView); ok && cached.base == base {
+		return cached.view
+	}
+	if entry.colCnt == 0 {
+		return base
+	}
+	view := base.View(int(entry.colCnt))
+	entry.view.Store(blockSchemaView{base: base, view: view})
+	return view
+}
+
+// IsSchemaOutdated returns true if columns were added to the table after the
+// block was created
+func (entry *BlockEntry) IsSchemaOutdated() bool {
+	if entry.colCnt == 0 {
+		return false
+	}
+	return int(entry.colCnt) < len(entry.GetSegment().GetTable().GetSchema().ColDefs)
+}
 func (entry *BlockEntry) GetFileTs() (uint64, error) {
 	return entry.GetBlockData().GetBlockFile().ReadTS()
 }
@@ -177,10 +219,8 @@ func (entry *BlockEntry) WriteTo(w io.Writer) (n int64, err error) {
 	if n, err = entry.BaseEntry.WriteTo(w); err != nil {
 		return
 	}
-	if err = binary.Write(w, binary.BigEndian, entry.state); err != nil {
-		return
-	}
-	n += 1
+	sn, err := writeBlockState(w, entry.state, entry.colCnt)
+	n += sn
 	return
 }
 
@@ -188,8 +228,43 @@ func (entry *BlockEntry) ReadFrom(r io.Reader) (n int64, err error) {
 	if n, err = entry.BaseEntry.ReadFrom(r); err != nil {
 		return
 	}
-	err = binary.Read(r, binary.BigEndian, &entry.state)
-	n += 1
+	var sn int64
+	entry.state, entry.colCnt, sn, err = readBlockState(r)
+	n += sn
+	return
+}
+
+func writeBlockState(w io.Writer, state EntryState, colCnt uint16) (n int64, err error) {
+	if err = binary.Write(w, binary.BigEndian, blockStateV1); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, state); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, colCnt); err != nil {
+		return
+	}
+	n = 1 + 1 + 2
+	return
+}
+
+// readBlockState reads a block state and its column count, which is 0 for
+// the blocks written without it
+func readBlockState(r io.Reader) (state EntryState, colCnt uint16, n int64, err error) {
+	if err = binary.Read(r, binary.BigEndian, &state); err != nil {
+		return
+	}
+	n = 1
+	if state != blockStateV1 {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &state); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &colCnt); err != nil {
+		return
+	}
+	n += 1 + 2
 	return
 }
 
@@ -202,6 +277,7 @@ func (entry *BlockEntry) Clone() CheckpointItem {
 		BaseEntry: entry.BaseEntry.Clone(),
 		state:     entry.state,
 		segment:   entry.segment,
+		colCnt:    entry.colCnt,
 	}
 	return cloned
 }
@@ -211,6 +287,7 @@ func (entry *BlockEntry) CloneCreate() CheckpointItem {
 		BaseEntry: entry.BaseEntry.CloneCreate(),
 		state:     entry.state,
 		segment:   entry.segment,
+		colCnt:    entry.colCnt,
 	}
 	return cloned
 }
@@ -148,6 +148,9 @@ func (catalog *Catalog) ReplayCmd(txncmd txnif.TxnCmd, dataFactory DataFactory,
 	case CmdLogDatabase:
 		cmd := txncmd.(*EntryCommand)
 		catalog.onReplayDatabase(cmd)
+	case CmdAlterTable:
+		cmd := txncmd.(*EntryCommand)
+		catalog.onReplayAlterTable(cmd, idxCtx, observer)
 	case CmdCreateDatabase:
 		cmd := txncmd.(*EntryCommand)
 		catalog.onReplayCreateDatabase(cmd, idxCtx, observer)
@@ -341,6 +344,30 @@ func (catalog *Catalog) onReplayTable(cmd *EntryCommand, dataFactory DataFactory
 	}
 }
 
+func (catalog *Catalog) onReplayAlterTable(cmd *EntryCommand, idx *wal.Index, observer wal.ReplayObserver) {
+	if cmd.AlterAt <= catalog.GetCheckpointed().MaxTS {
+		if observer != nil {
+			observer.OnStaleIndex(idx)
+		}
+	}
+	db, err := catalog.GetDatabaseByID(cmd.DBID)
+	if err != nil {
+		panic(err)
+	}
+	tbl, err := db.GetTableEntryByID(cmd.TableID)
+	if err != nil {
+		panic(err)
+	}
+	// The table schema may already be newer if it was replayed from a
+	// checkpoint
+	if cmd.Schema.Version > tbl.GetSchema().Version {
+		tbl.ApplyAlter(cmd.Schema, cmd.AlterAt, idx)
+	}
+	if observer != nil {
+		observer.OnTimeStamp(cmd.AlterAt)
+	}
+}
+
 func (catalog *Catalog) onReplayCreateSegment(cmd *EntryCommand, dataFactory DataFactory, idx *wal.Index, observer wal.ReplayObserver, cache *bytes.Buffer) {
 	catalog.OnReplaySegmentID(cmd.Segment.ID)
 	if cmd.entry.CreateAt <= catalog.GetCheckpointed().MaxTS {
@@ -795,6 +822,10 @@ func (catalog *Catalog) PrepareCheckpoint(startTs, endTs uint64) *CheckpointEntr
 		}
 		entry := table.BaseEntry
 		CheckpointOp(ckpEntry, entry, table, startTs, endTs)
+		if cmd, index := table.MakeAlterLogEntry(startTs, endTs); cmd != nil {
+			ckpEntry.AddIndex(index)
+			ckpEntry.AddCommand(cmd)
+		}
 		return
 	}
 	processor.DatabaseFn = func(database *DBEntry) (err error) {
@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -460,6 +461,44 @@ func TestCommand(t *testing.T) {
 	assert.Equal(t, tb.db.ID, eCmd.DBID)
 }
 
+func TestBlockEncoding(t *testing.T) {
+	blk := &BlockEntry{
+		BaseEntry: &BaseEntry{
+			CommitInfo: CommitInfo{CurrOp: OpCreate, LogIndex: new(wal.Index)},
+			ID:         1,
+			CreateAt:   common.NextGlobalSeqNum(),
+		},
+		state:  ES_NotAppendable,
+		colCnt: 3,
+	}
+	var w bytes.Buffer
+	n, err := blk.WriteTo(&w)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(w.Len()), n)
+	size := n
+	replayed := NewReplayBlockEntry()
+	n, err = replayed.ReadFrom(&w)
+	assert.Nil(t, err)
+	assert.Equal(t, size, n)
+	assert.Equal(t, 0, w.Len())
+	assert.Equal(t, blk.state, replayed.state)
+	assert.Equal(t, blk.colCnt, replayed.colCnt)
+
+	// A block written before the column count was kept has the state only
+	w.Reset()
+	_, err = blk.BaseEntry.WriteTo(&w)
+	assert.Nil(t, err)
+	_ = w.WriteByte(byte(ES_NotAppendable))
+	size = int64(w.Len())
+	replayed = NewReplayBlockEntry()
+	n, err = replayed.ReadFrom(&w)
+	assert.Nil(t, err)
+	assert.Equal(t, size, n)
+	assert.Equal(t, 0, w.Len())
+	assert.Equal(t, ES_NotAppendable, replayed.state)
+	assert.Equal(t, uint16(0), replayed.colCnt)
+}
+
 // UT Steps
 // 1. Start Txn1, create a database "db", table "tb" and segment "seg1", then commit Txn1
 // 1. Start Txn2, create a segment "seg2". Txn2 scan "tb" and "seg1, seg2" found
@@ -39,6 +39,7 @@ const (
 	CmdLogTable
 	CmdLogSegment
 	CmdLogBlock
+	CmdAlterTable
 )
 
 var cmdNames = map[int16]string{
@@ -54,6 +55,7 @@ var cmdNames = map[int16]string{
 	CmdLogTable:       "LTBL",
 	CmdLogSegment:     "LSEG",
 	CmdLogBlock:       "LBLK",
+	CmdAlterTable:     "ATBL",
 }
 
 func CmdName(t int16) string {
@@ -97,6 +99,9 @@ func init() {
 	txnif.RegisterCmdFactory(CmdLogDatabase, func(cmdType int16) txnif.TxnCmd {
 		return newEmptyEntryCmd(cmdType)
 	})
+	txnif.RegisterCmdFactory(CmdAlterTable, func(cmdType int16) txnif.TxnCmd {
+		return newEmptyEntryCmd(cmdType)
+	})
 }
 
 type EntryCommand struct {
@@ -110,6 +115,8 @@ type EntryCommand struct {
 	Table     *TableEntry
 	Segment   *SegmentEntry
 	Block     *BlockEntry
+	AlterAt   uint64
+	Schema    *Schema
 }
 
 func newEmptyEntryCmd(cmdType int16) *EntryCommand {
@@ -152,6 +159,19 @@ func newTableCmd(id uint32, cmdType int16, entry *TableEntry) *EntryCommand {
 	return impl
 }
 
+func newAlterTableCmd(id uint32, entry *TableEntry, alterAt uint64, schema *Schema) *EntryCommand {
+	impl := &EntryCommand{
+		DB:      entry.GetDB(),
+		Table:   entry,
+		cmdType: CmdAlterTable,
+		entry:   entry.BaseEntry,
+		AlterAt: alterAt,
+		Schema:  schema,
+	}
+	impl.BaseCustomizedCmd = txnbase.NewBaseCustomizedCmd(id, impl)
+	return impl
+}
+
 func newDBCmd(id uint32, cmdType int16, entry *DBEntry) *EntryCommand {
 	impl := &EntryCommand{
 		DB:      entry,
@@ -183,6 +203,8 @@ func (cmd *EntryCommand) GetTs() uint64 {
 		ts = cmd.entry.CreateAt
 	case CmdDropDatabase, CmdDropTable, CmdDropSegment, CmdDropBlock:
 		ts = cmd.entry.DeleteAt
+	case CmdAlterTable:
+		ts = cmd.AlterAt
 	case CmdLogDatabase:
 	case CmdLogTable:
 	case CmdLogSegment:
@@ -196,7 +218,7 @@ func (cmd *EntryCommand) IDString() string {
 	switch cmd.cmdType {
 	case CmdCreateDatabase, CmdDropDatabase, CmdLogDatabase:
 		s = fmt.Sprintf("%sDB=%d", s, dbid)
-	case CmdCreateTable, CmdDropTable, CmdLogTable:
+	case CmdCreateTable, CmdDropTable, CmdLogTable, CmdAlterTable:
 		s = fmt.Sprintf("%sDB=%d;CommonID=%s", s, dbid, id.TableString())
 	case CmdCreateSegment, CmdDropSegment, CmdLogSegment:
 		s = fmt.Sprintf("%sDB=%d;CommonID=%s", s, dbid, id.SegmentString())
@@ -279,6 +301,14 @@ func (cmd *EntryCommand) GetID() (uint64, *common.ID) {
 		}
 	case CmdLogDatabase:
 		dbid = cmd.DB.ID
+	case CmdAlterTable:
+		if cmd.DBID != 0 {
+			dbid = cmd.DBID
+			id.TableID = cmd.TableID
+		} else {
+			dbid = cmd.DB.ID
+			id.TableID = cmd.Table.ID
+		}
 	case CmdLogTable:
 		if cmd.DBID != 0 {
 			dbid = cmd.DBID
@@ -323,6 +353,8 @@ func (cmd *EntryCommand) VerboseString() string {
 	switch cmd.cmdType {
 	case CmdCreateTable, CmdLogTable:
 		s = fmt.Sprintf("%s;Schema=%v", s, cmd.Table.schema.String())
+	case CmdAlterTable:
+		s = fmt.Sprintf("%s;Schema=%v", s, cmd.Schema.String())
 	}
 	return s
 }
@@ -372,6 +404,25 @@ func (cmd *EntryCommand) WriteTo(w io.Writer) (n int64, err error) {
 		sn, err = cmd.DB.WriteTo(w)
 		n += sn
 		return
+	case CmdAlterTable:
+		if err = binary.Write(w, binary.BigEndian, cmd.DB.ID); err != nil {
+			return
+		}
+		if err = binary.Write(w, binary.BigEndian, cmd.Table.ID); err != nil {
+			return
+		}
+		if err = binary.Write(w, binary.BigEndian, cmd.AlterAt); err != nil {
+			return
+		}
+		var schemaBuf []byte
+		if schemaBuf, err = cmd.Schema.Marshal(); err != nil {
+			return
+		}
+		if _, err = w.Write(schemaBuf); err != nil {
+			return
+		}
+		n += 8 + 8 + 8 + int64(len(schemaBuf))
+		return
 	}
 
 	if err = binary.Write(w, binary.BigEndian, cmd.entry.GetID()); err != nil {
@@ -436,7 +487,8 @@ func (cmd *EntryCommand) WriteTo(w io.Writer) (n int64, err error) {
 		if err = binary.Write(w, binary.BigEndian, cmd.Segment.ID); err != nil {
 			return
 		}
-		if err = binary.Write(w, binary.BigEndian, cmd.Block.state); err != nil {
+		var sn int64
+		if sn, err = writeBlockState(w, cmd.Block.state, cmd.Block.colCnt); err != nil {
 			return
 		}
 		if err = binary.Write(w, binary.BigEndian, cmd.entry.ID); err != nil {
@@ -445,7 +497,7 @@ func (cmd *EntryCommand) WriteTo(w io.Writer) (n int64, err error) {
 		if err = binary.Write(w, binary.BigEndian, cmd.entry.CreateAt); err != nil {
 			return
 		}
-		n += 8 + 8 + 8 + 8 + 8
+		n += 8 + 8 + 8 + 8 + 8 + sn
 	case CmdDropTable:
 		if err = binary.Write(w, binary.BigEndian, cmd.Table.db.ID); err != nil {
 			return
@@ -553,6 +605,24 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 		cn, err = cmd.DB.ReadFrom(r)
 		n += cn
 		return
+	case CmdAlterTable:
+		if err = binary.Read(r, binary.BigEndian, &cmd.DBID); err != nil {
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &cmd.TableID); err != nil {
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &cmd.AlterAt); err != nil {
+			return
+		}
+		cmd.entry = NewReplayBaseEntry()
+		cmd.entry.ID = cmd.TableID
+		cmd.Schema = NewEmptySchema("")
+		if cn, err = cmd.Schema.ReadFrom(r); err != nil {
+			return
+		}
+		n += cn + 8 + 8 + 8
+		return
 	}
 
 	cmd.entry = NewReplayBaseEntry()
@@ -628,7 +698,9 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		var state EntryState
-		if err = binary.Read(r, binary.BigEndian, &state); err != nil {
+		var colCnt uint16
+		var sn int64
+		if state, colCnt, sn, err = readBlockState(r); err != nil {
 			return
 		}
 		if err = binary.Read(r, binary.BigEndian, &cmd.entry.ID); err != nil {
@@ -641,8 +713,9 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 		cmd.Block = &BlockEntry{
 			BaseEntry: cmd.entry,
 			state:     state,
+			colCnt:    colCnt,
 		}
-		n += 8 + 8 + 8 + 8
+		n += 8 + 8 + 8 + 8 + sn
 	case CmdDropTable:
 		if err = binary.Read(r, binary.BigEndian, &cmd.DBID); err != nil {
 			return
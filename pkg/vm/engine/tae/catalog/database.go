@@ -233,6 +233,20 @@ func (e *DBEntry) RemoveEntry(table *TableEntry) (err error) {
 	return
 }
 
+func (e *DBEntry) renameTableLocked(id uint64, oldName, newName string) {
+	if nn := e.nameNodes[oldName]; nn != nil {
+		if _, empty := nn.DeleteNode(id); empty {
+			delete(e.nameNodes, oldName)
+		}
+	}
+	nn := e.nameNodes[newName]
+	if nn == nil {
+		nn = newNodeList(e, &e.nodesMu, newName)
+		e.nameNodes[newName] = nn
+	}
+	nn.CreateNode(id)
+}
+
 func (e *DBEntry) AddEntryLocked(table *TableEntry) (err error) {
 	defer func() {
 		if err == nil {
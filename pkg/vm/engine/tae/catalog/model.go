@@ -33,6 +33,7 @@ var (
 const (
 	HiddenColumnName    = "PADDR"
 	HiddenColumnComment = "Physical address"
+	DroppedColumnPrefix = "__mo_dropped_"
 	SortKeyNamePrefx    = "_SORT_"
 
 	SystemDBID               = uint64(1)
@@ -33,6 +33,12 @@ type IndexT uint16
 
 const (
 	ZoneMap IndexT = iota
+	// Unique rejects the rows duplicating the key of another row. The keys
+	// with a null column never duplicate
+	Unique
+	// FullText is the inverted index of the words of text columns, it is
+	// built for each column when a block is persisted
+	FullText
 )
 
 type IndexInfo struct {
@@ -42,6 +48,36 @@ type IndexInfo struct {
 	Columns []uint16
 }
 
+// CheckInfo is a CHECK constraint, Expr is the text of the check expression
+type CheckInfo struct {
+	Name string
+	Expr string
+}
+
+// ForeignKeyInfo is a foreign key referring to a table of the same database,
+// the columns are kept by name. The actions are the values of
+// engine.ForeignKeyAction
+type ForeignKeyInfo struct {
+	Name        string
+	Cols        []string
+	ParentTable string
+	ParentCols  []string
+	OnDelete    int8
+	OnUpdate    int8
+}
+
+// PartitionInfo is the partitioning of a table, the rows of each partition
+// are stored in a table of its own. Type is the value of
+// engine.PartitionType and Values holds the values of each partition as in
+// engine.Partition
+type PartitionInfo struct {
+	Type   int8
+	Func   string
+	Cols   []string
+	Names  []string
+	Values [][]string
+}
+
 type Default struct {
 	Set   bool
 	Null  bool
@@ -70,17 +106,40 @@ type ColDef struct {
 	SortIdx       int8
 	SortKey       int8
 	Primary       int8
+	Dropped       int8
+	NotNull       int8
 	Comment       string
+	EnumValues    string
 	Default       Default
 }
 
 func (def *ColDef) GetName() string     { return def.Name }
 func (def *ColDef) GetType() types.Type { return def.Type }
 
-func (def *ColDef) Nullable() bool  { return def.NullAbility == int8(1) }
-func (def *ColDef) IsHidden() bool  { return def.Hidden == int8(1) }
-func (def *ColDef) IsPrimary() bool { return def.Primary == int8(1) }
-func (def *ColDef) IsSortKey() bool { return def.SortKey == int8(1) }
+func (def *ColDef) Nullable() bool        { return def.NullAbility == int8(1) }
+func (def *ColDef) IsHidden() bool        { return def.Hidden == int8(1) }
+func (def *ColDef) IsPrimary() bool       { return def.Primary == int8(1) }
+func (def *ColDef) IsSortKey() bool       { return def.SortKey == int8(1) }
+func (def *ColDef) IsDropped() bool       { return def.Dropped == int8(1) }
+func (def *ColDef) IsAutoIncrement() bool { return def.AutoIncrement == int8(1) }
+func (def *ColDef) IsNotNull() bool       { return def.NotNull == int8(1) }
+
+// DefaultValue returns the value used to fill this column for rows that
+// were written before the column existed
+func (def *ColDef) DefaultValue() any {
+	if def.Default.Set && !def.Default.Null && def.Default.Value != nil {
+		return def.Default.Value
+	}
+	if def.Nullable() {
+		return types.Null{}
+	}
+	// A column without default that cannot hold nulls, such as a dropped
+	// column, reads the zero value of its type
+	if def.Type.IsString() {
+		return []byte{}
+	}
+	return types.DecodeValue(make([]byte, def.Type.Size), def.Type)
+}
 
 type SortKey struct {
 	Defs      []*ColDef
@@ -122,10 +181,27 @@ type Schema struct {
 	NameIndex        map[string]int
 	BlockMaxRows     uint32
 	SegmentMaxBlocks uint16
-	Comment          string
+	// BlockMaxBytes bounds the bytes of the rows in an appendable block, 0 for
+	// no bound. The block is full once it reaches either bound
+	BlockMaxBytes uint64
+	Comment       string
+	// ViewDef is the create view statement if the table is a view
+	ViewDef string
+	// Version is increased by every committed schema change
+	Version uint32
 
 	SortKey   *SortKey
 	HiddenKey *ColDef
+	// UniqueKeys are the unique keys besides the primary key
+	UniqueKeys []*IndexInfo
+	// FullTextKeys are the full-text indexes of the table
+	FullTextKeys []*IndexInfo
+	// Checks are the CHECK constraints of the table
+	Checks []*CheckInfo
+	// ForeignKeys are the foreign keys of the table
+	ForeignKeys []*ForeignKeyInfo
+	// Partition is set if the table is partitioned
+	Partition *PartitionInfo
 }
 
 func NewEmptySchema(name string) *Schema {
@@ -163,6 +239,16 @@ func (s *Schema) IsCompoundSortKey() bool { return s.SortKey != nil && s.SortKey
 func (s *Schema) HasPK() bool             { return s.SortKey != nil && s.SortKey.IsPrimary() }
 func (s *Schema) HasSortKey() bool        { return s.SortKey != nil }
 
+// HasLob returns true if any column stores large objects, TEXT or BLOB
+func (s *Schema) HasLob() bool {
+	for _, def := range s.ColDefs {
+		if def.Type.Oid.IsLob() {
+			return true
+		}
+	}
+	return false
+}
+
 // GetSingleSortKey should be call only if IsSinglePK is checked
 func (s *Schema) GetSingleSortKey() *ColDef { return s.SortKey.Defs[0] }
 func (s *Schema) GetSingleSortKeyIdx() int  { return s.SortKey.Defs[0].Idx }
@@ -232,7 +318,10 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 	if err = binary.Read(r, binary.BigEndian, &s.SegmentMaxBlocks); err != nil {
 		return
 	}
-	n = 4 + 4
+	if err = binary.Read(r, binary.BigEndian, &s.BlockMaxBytes); err != nil {
+		return
+	}
+	n = 4 + 4 + 8
 	var sn int64
 	if s.Name, sn, err = common.ReadString(r); err != nil {
 		return
@@ -242,6 +331,14 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 		return
 	}
 	n += sn
+	if s.ViewDef, sn, err = common.ReadString(r); err != nil {
+		return
+	}
+	n += sn
+	if err = binary.Read(r, binary.BigEndian, &s.Version); err != nil {
+		return
+	}
+	n += 4
 	colCnt := uint16(0)
 	if err = binary.Read(r, binary.BigEndian, &colCnt); err != nil {
 		return
@@ -263,6 +360,10 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += sn
+		if def.EnumValues, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
 		if err = binary.Read(r, binary.BigEndian, &def.NullAbility); err != nil {
 			return
 		}
@@ -287,6 +388,14 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += 1
+		if err = binary.Read(r, binary.BigEndian, &def.Dropped); err != nil {
+			return
+		}
+		n += 1
+		if err = binary.Read(r, binary.BigEndian, &def.NotNull); err != nil {
+			return
+		}
+		n += 1
 		def.Default = Default{}
 		if sn, err = UnMarshalDefault(r, def.Type, &def.Default); err != nil {
 			return
@@ -296,6 +405,131 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 	}
+	keyCnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &keyCnt); err != nil {
+		return
+	}
+	n += 2
+	for i := uint16(0); i < keyCnt; i++ {
+		key := &IndexInfo{Type: Unique}
+		if key.Name, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		if err = binary.Read(r, binary.BigEndian, &colCnt); err != nil {
+			return
+		}
+		n += 2
+		key.Columns = make([]uint16, colCnt)
+		if err = binary.Read(r, binary.BigEndian, key.Columns); err != nil {
+			return
+		}
+		n += 2 * int64(colCnt)
+		s.UniqueKeys = append(s.UniqueKeys, key)
+	}
+	if err = binary.Read(r, binary.BigEndian, &keyCnt); err != nil {
+		return
+	}
+	n += 2
+	for i := uint16(0); i < keyCnt; i++ {
+		key := &IndexInfo{Type: FullText}
+		if key.Name, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		if err = binary.Read(r, binary.BigEndian, &colCnt); err != nil {
+			return
+		}
+		n += 2
+		key.Columns = make([]uint16, colCnt)
+		if err = binary.Read(r, binary.BigEndian, key.Columns); err != nil {
+			return
+		}
+		n += 2 * int64(colCnt)
+		s.FullTextKeys = append(s.FullTextKeys, key)
+	}
+	checkCnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &checkCnt); err != nil {
+		return
+	}
+	n += 2
+	for i := uint16(0); i < checkCnt; i++ {
+		check := &CheckInfo{}
+		if check.Name, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		if check.Expr, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		s.Checks = append(s.Checks, check)
+	}
+	fkCnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &fkCnt); err != nil {
+		return
+	}
+	n += 2
+	for i := uint16(0); i < fkCnt; i++ {
+		fk := &ForeignKeyInfo{}
+		if fk.Name, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		if fk.Cols, sn, err = readStrings(r); err != nil {
+			return
+		}
+		n += sn
+		if fk.ParentTable, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		if fk.ParentCols, sn, err = readStrings(r); err != nil {
+			return
+		}
+		n += sn
+		if err = binary.Read(r, binary.BigEndian, &fk.OnDelete); err != nil {
+			return
+		}
+		n += 1
+		if err = binary.Read(r, binary.BigEndian, &fk.OnUpdate); err != nil {
+			return
+		}
+		n += 1
+		s.ForeignKeys = append(s.ForeignKeys, fk)
+	}
+	partitioned := false
+	if err = binary.Read(r, binary.BigEndian, &partitioned); err != nil {
+		return
+	}
+	n += 1
+	if partitioned {
+		part := &PartitionInfo{}
+		if err = binary.Read(r, binary.BigEndian, &part.Type); err != nil {
+			return
+		}
+		n += 1
+		if part.Func, sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+		if part.Cols, sn, err = readStrings(r); err != nil {
+			return
+		}
+		n += sn
+		if part.Names, sn, err = readStrings(r); err != nil {
+			return
+		}
+		n += sn
+		part.Values = make([][]string, len(part.Names))
+		for i := range part.Values {
+			if part.Values[i], sn, err = readStrings(r); err != nil {
+				return
+			}
+			n += sn
+		}
+		s.Partition = part
+	}
 	err = s.Finalize(true)
 	return
 }
@@ -308,12 +542,21 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 	if err = binary.Write(&w, binary.BigEndian, s.SegmentMaxBlocks); err != nil {
 		return
 	}
+	if err = binary.Write(&w, binary.BigEndian, s.BlockMaxBytes); err != nil {
+		return
+	}
 	if _, err = common.WriteString(s.Name, &w); err != nil {
 		return
 	}
 	if _, err = common.WriteString(s.Comment, &w); err != nil {
 		return
 	}
+	if _, err = common.WriteString(s.ViewDef, &w); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, s.Version); err != nil {
+		return
+	}
 	if err = binary.Write(&w, binary.BigEndian, uint16(len(s.ColDefs))); err != nil {
 		return
 	}
@@ -327,6 +570,9 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 		if _, err = common.WriteString(def.Comment, &w); err != nil {
 			return
 		}
+		if _, err = common.WriteString(def.EnumValues, &w); err != nil {
+			return
+		}
 		if err = binary.Write(&w, binary.BigEndian, def.NullAbility); err != nil {
 			return
 		}
@@ -345,14 +591,133 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 		if err = binary.Write(&w, binary.BigEndian, def.SortKey); err != nil {
 			return
 		}
+		if err = binary.Write(&w, binary.BigEndian, def.Dropped); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, def.NotNull); err != nil {
+			return
+		}
 		if err = MarshalDefault(&w, def.Type, def.Default); err != nil {
 			return
 		}
 	}
+	if err = binary.Write(&w, binary.BigEndian, uint16(len(s.UniqueKeys))); err != nil {
+		return
+	}
+	for _, key := range s.UniqueKeys {
+		if _, err = common.WriteString(key.Name, &w); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, uint16(len(key.Columns))); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, key.Columns); err != nil {
+			return
+		}
+	}
+	if err = binary.Write(&w, binary.BigEndian, uint16(len(s.FullTextKeys))); err != nil {
+		return
+	}
+	for _, key := range s.FullTextKeys {
+		if _, err = common.WriteString(key.Name, &w); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, uint16(len(key.Columns))); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, key.Columns); err != nil {
+			return
+		}
+	}
+	if err = binary.Write(&w, binary.BigEndian, uint16(len(s.Checks))); err != nil {
+		return
+	}
+	for _, check := range s.Checks {
+		if _, err = common.WriteString(check.Name, &w); err != nil {
+			return
+		}
+		if _, err = common.WriteString(check.Expr, &w); err != nil {
+			return
+		}
+	}
+	if err = binary.Write(&w, binary.BigEndian, uint16(len(s.ForeignKeys))); err != nil {
+		return
+	}
+	for _, fk := range s.ForeignKeys {
+		if _, err = common.WriteString(fk.Name, &w); err != nil {
+			return
+		}
+		if err = writeStrings(fk.Cols, &w); err != nil {
+			return
+		}
+		if _, err = common.WriteString(fk.ParentTable, &w); err != nil {
+			return
+		}
+		if err = writeStrings(fk.ParentCols, &w); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, fk.OnDelete); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, fk.OnUpdate); err != nil {
+			return
+		}
+	}
+	if err = binary.Write(&w, binary.BigEndian, s.Partition != nil); err != nil {
+		return
+	}
+	if part := s.Partition; part != nil {
+		if err = binary.Write(&w, binary.BigEndian, part.Type); err != nil {
+			return
+		}
+		if _, err = common.WriteString(part.Func, &w); err != nil {
+			return
+		}
+		if err = writeStrings(part.Cols, &w); err != nil {
+			return
+		}
+		if err = writeStrings(part.Names, &w); err != nil {
+			return
+		}
+		for _, values := range part.Values {
+			if err = writeStrings(values, &w); err != nil {
+				return
+			}
+		}
+	}
 	buf = w.Bytes()
 	return
 }
 
+func writeStrings(strs []string, w io.Writer) (err error) {
+	if err = binary.Write(w, binary.BigEndian, uint16(len(strs))); err != nil {
+		return
+	}
+	for _, str := range strs {
+		if _, err = common.WriteString(str, w); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func readStrings(r io.Reader) (strs []string, n int64, err error) {
+	cnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &cnt); err != nil {
+		return
+	}
+	n += 2
+	strs = make([]string, cnt)
+	for i := range strs {
+		var sn int64
+		if strs[i], sn, err = common.ReadString(r); err != nil {
+			return
+		}
+		n += sn
+	}
+	return
+}
+
 func (s *Schema) AppendColDef(def *ColDef) (err error) {
 	def.Idx = len(s.ColDefs)
 	s.ColDefs = append(s.ColDefs, def)
@@ -391,16 +756,163 @@ func (s *Schema) AppendPKCol(name string, typ types.Type, idx int) error {
 
 func (s *Schema) AppendPKColWithAttribute(attr engine.Attribute, idx int) error {
 	def := &ColDef{
-		Name:    attr.Name,
-		Type:    attr.Type,
-		SortIdx: int8(idx),
-		SortKey: int8(1),
-		Primary: int8(1),
-		Comment: attr.Comment,
+		Name:       attr.Name,
+		Type:       attr.Type,
+		SortIdx:    int8(idx),
+		SortKey:    int8(1),
+		Primary:    int8(1),
+		Comment:    attr.Comment,
+		EnumValues: attr.EnumValues,
+	}
+	if attr.AutoIncrement {
+		def.AutoIncrement = int8(1)
+	}
+	if attr.NotNull {
+		def.NotNull = int8(1)
 	}
 	return s.AppendColDef(def)
 }
 
+// AppendUniqueKey adds a unique key on the named columns, which should be
+// appended before
+func (s *Schema) AppendUniqueKey(name string, colNames ...string) error {
+	for _, key := range s.UniqueKeys {
+		if key.Name == name {
+			return fmt.Errorf("%w: duplicate key \"%s\"", ErrSchemaValidation, name)
+		}
+	}
+	colIdx := make([]int, len(colNames))
+	for i, colName := range colNames {
+		idx, ok := s.NameIndex[colName]
+		if !ok || s.ColDefs[idx].IsHidden() {
+			return fmt.Errorf("%w: key column \"%s\" not found", ErrSchemaValidation, colName)
+		}
+		colIdx[i] = idx
+	}
+	s.UniqueKeys = append(s.UniqueKeys, NewIndexInfo(name, Unique, colIdx...))
+	return nil
+}
+
+// AppendFullTextKey adds a full-text index on the named char, varchar or
+// text columns, which should be appended before
+func (s *Schema) AppendFullTextKey(name string, colNames ...string) error {
+	for _, key := range s.FullTextKeys {
+		if key.Name == name {
+			return fmt.Errorf("%w: duplicate key \"%s\"", ErrSchemaValidation, name)
+		}
+	}
+	colIdx := make([]int, len(colNames))
+	for i, colName := range colNames {
+		idx, ok := s.NameIndex[colName]
+		if !ok || s.ColDefs[idx].IsHidden() {
+			return fmt.Errorf("%w: key column \"%s\" not found", ErrSchemaValidation, colName)
+		}
+		switch s.ColDefs[idx].Type.Oid {
+		case types.Type_CHAR, types.Type_VARCHAR, types.Type_TEXT:
+		default:
+			return fmt.Errorf("%w: full-text key column \"%s\" is not a text column", ErrSchemaValidation, colName)
+		}
+		colIdx[i] = idx
+	}
+	s.FullTextKeys = append(s.FullTextKeys, NewIndexInfo(name, FullText, colIdx...))
+	return nil
+}
+
+// AppendCheck adds a CHECK constraint. The expression is kept as text and
+// evaluated by the sql layer
+func (s *Schema) AppendCheck(name, expr string) error {
+	for _, check := range s.Checks {
+		if check.Name == name {
+			return fmt.Errorf("%w: duplicate check constraint \"%s\"", ErrSchemaValidation, name)
+		}
+	}
+	s.Checks = append(s.Checks, &CheckInfo{Name: name, Expr: expr})
+	return nil
+}
+
+// AppendForeignKey adds a foreign key. The columns of the foreign key should
+// be appended before
+func (s *Schema) AppendForeignKey(fk *ForeignKeyInfo) error {
+	for _, key := range s.ForeignKeys {
+		if key.Name == fk.Name {
+			return fmt.Errorf("%w: duplicate foreign key \"%s\"", ErrSchemaValidation, fk.Name)
+		}
+	}
+	for _, colName := range fk.Cols {
+		idx, ok := s.NameIndex[colName]
+		if !ok || s.ColDefs[idx].IsHidden() {
+			return fmt.Errorf("%w: foreign key column \"%s\" not found", ErrSchemaValidation, colName)
+		}
+	}
+	s.ForeignKeys = append(s.ForeignKeys, fk)
+	return nil
+}
+
+// SetPartition partitions the table, or replaces its partitions. The
+// partition columns should be appended before
+func (s *Schema) SetPartition(part *PartitionInfo) error {
+	for _, colName := range part.Cols {
+		idx, ok := s.NameIndex[colName]
+		if !ok || s.ColDefs[idx].IsHidden() {
+			return fmt.Errorf("%w: partition column \"%s\" not found", ErrSchemaValidation, colName)
+		}
+	}
+	if len(part.Names) == 0 || len(part.Names) != len(part.Values) {
+		return fmt.Errorf("%w: invalid partitions", ErrSchemaValidation)
+	}
+	s.Partition = part
+	s.Version++
+	return nil
+}
+
+// IsPartitionColumn returns true if the table is partitioned by the column
+func (s *Schema) IsPartitionColumn(name string) bool {
+	if s.Partition == nil {
+		return false
+	}
+	for _, col := range s.Partition.Cols {
+		if col == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsUniqueKeyColumn returns true if the column is a part of a unique key
+func (s *Schema) IsUniqueKeyColumn(idx int) bool {
+	for _, key := range s.UniqueKeys {
+		for _, col := range key.Columns {
+			if int(col) == idx {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsFullTextColumn returns true if the column is a part of a full-text key
+func (s *Schema) IsFullTextColumn(idx int) bool {
+	for _, key := range s.FullTextKeys {
+		for _, col := range key.Columns {
+			if int(col) == idx {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// FullTextColumns returns the columns of the full-text keys in order, each
+// of them gets an inverted index in the persisted blocks
+func (s *Schema) FullTextColumns() (cols []int) {
+	for _, def := range s.ColDefs {
+		if s.IsFullTextColumn(def.Idx) {
+			cols = append(cols, def.Idx)
+		}
+	}
+	return
+}
+
 func (s *Schema) AppendCol(name string, typ types.Type) error {
 	def := &ColDef{
 		Name:    name,
@@ -430,11 +942,18 @@ func (s *Schema) AppendColWithAttribute(attr engine.Attribute) error {
 		}
 	}
 	def := &ColDef{
-		Name:    attr.Name,
-		Type:    attr.Type,
-		SortIdx: -1,
-		Comment: attr.Comment,
-		Default: attrDefault,
+		Name:       attr.Name,
+		Type:       attr.Type,
+		SortIdx:    -1,
+		Comment:    attr.Comment,
+		EnumValues: attr.EnumValues,
+		Default:    attrDefault,
+	}
+	if attr.AutoIncrement {
+		def.AutoIncrement = int8(1)
+	}
+	if attr.NotNull {
+		def.NotNull = int8(1)
 	}
 	return s.AppendColDef(def)
 }
@@ -451,7 +970,7 @@ func (s *Schema) IsPartOfPK(idx int) bool {
 func (s *Schema) Attrs() []string {
 	attrs := make([]string, 0, len(s.ColDefs)-1)
 	for _, def := range s.ColDefs {
-		if def.IsHidden() {
+		if def.IsHidden() || def.IsDropped() {
 			continue
 		}
 		attrs = append(attrs, def.Name)
@@ -462,7 +981,7 @@ func (s *Schema) Attrs() []string {
 func (s *Schema) Types() []types.Type {
 	ts := make([]types.Type, 0, len(s.ColDefs)-1)
 	for _, def := range s.ColDefs {
-		if def.IsHidden() {
+		if def.IsHidden() || def.IsDropped() {
 			continue
 		}
 		ts = append(ts, def.Type)
@@ -473,7 +992,7 @@ func (s *Schema) Types() []types.Type {
 func (s *Schema) Nullables() []bool {
 	nulls := make([]bool, 0, len(s.ColDefs)-1)
 	for _, def := range s.ColDefs {
-		if def.IsHidden() {
+		if def.IsHidden() || def.IsDropped() {
 			continue
 		}
 		nulls = append(nulls, def.Nullable())
@@ -604,6 +1123,100 @@ func (s *Schema) GetColIdx(attr string) int {
 	return idx
 }
 
+// AddColumn appends a new column to the schema. Column indexes are never
+// reused, so blocks created before the change keep their layout and read the
+// new column as its default value.
+func (s *Schema) AddColumn(attr engine.Attribute) (err error) {
+	if attr.Primary {
+		err = fmt.Errorf("%w: cannot add primary key column \"%s\"", ErrSchemaValidation, attr.Name)
+		return
+	}
+	if err = s.AppendColWithAttribute(attr); err != nil {
+		s.ColDefs = s.ColDefs[:len(s.ColDefs)-1]
+		return
+	}
+	s.ColDefs[len(s.ColDefs)-1].NullAbility = int8(1)
+	s.Version++
+	return
+}
+
+// DropColumn marks the named column as dropped. The column keeps its physical
+// slot in existing blocks and gets an internal name so that the original name
+// can be used again.
+func (s *Schema) DropColumn(name string) (err error) {
+	idx := s.GetColIdx(name)
+	if idx < 0 || s.ColDefs[idx].IsDropped() {
+		err = fmt.Errorf("%w: column \"%s\" not found", ErrSchemaValidation, name)
+		return
+	}
+	def := s.ColDefs[idx]
+	if def.IsHidden() || def.IsSortKey() || s.IsUniqueKeyColumn(idx) || s.IsFullTextColumn(idx) || s.IsPartitionColumn(name) {
+		err = fmt.Errorf("%w: cannot drop key column \"%s\"", ErrSchemaValidation, name)
+		return
+	}
+	if len(s.Attrs()) == 1 {
+		err = fmt.Errorf("%w: cannot drop the last column \"%s\"", ErrSchemaValidation, name)
+		return
+	}
+	delete(s.NameIndex, name)
+	def.Name = fmt.Sprintf("%s%d", DroppedColumnPrefix, idx)
+	def.Dropped = int8(1)
+	s.NameIndex[def.Name] = idx
+	s.Version++
+	return
+}
+
+// RenameColumn changes the name of a column. It only touches the schema, the
+// column data is located by index.
+func (s *Schema) RenameColumn(oldName, newName string) (err error) {
+	idx := s.GetColIdx(oldName)
+	if idx < 0 || s.ColDefs[idx].IsDropped() {
+		err = fmt.Errorf("%w: column \"%s\" not found", ErrSchemaValidation, oldName)
+		return
+	}
+	if s.ColDefs[idx].IsHidden() {
+		err = fmt.Errorf("%w: cannot rename column \"%s\"", ErrSchemaValidation, oldName)
+		return
+	}
+	if _, existed := s.NameIndex[newName]; existed {
+		err = fmt.Errorf("%w: duplicate column \"%s\"", ErrSchemaValidation, newName)
+		return
+	}
+	delete(s.NameIndex, oldName)
+	s.ColDefs[idx].Name = newName
+	s.NameIndex[newName] = idx
+	if s.Partition != nil {
+		for i, col := range s.Partition.Cols {
+			if col == oldName {
+				s.Partition.Cols[i] = newName
+			}
+		}
+	}
+	s.Version++
+	return
+}
+
+// Rename changes the name of the table
+func (s *Schema) Rename(name string) {
+	s.Name = name
+	s.Version++
+}
+
+// View returns the schema of the first colCnt columns. It is the physical
+// layout of a block created when the table had colCnt columns.
+func (s *Schema) View(colCnt int) *Schema {
+	if colCnt >= len(s.ColDefs) {
+		return s
+	}
+	view := *s
+	view.ColDefs = s.ColDefs[:colCnt]
+	view.NameIndex = make(map[string]int, colCnt)
+	for _, def := range view.ColDefs {
+		view.NameIndex[def.Name] = def.Idx
+	}
+	return &view
+}
+
 func MockCompoundSchema(colCnt int, pkIdx ...int) *Schema {
 	rand.Seed(time.Now().UnixNano())
 	schema := NewEmptySchema(fmt.Sprintf("%d", rand.Intn(1000000)))
@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
 )
 
 type TableDataFactory = func(meta *TableEntry) data.Table
@@ -37,6 +38,10 @@ type TableEntry struct {
 	link      *common.Link
 	tableData data.Table
 	rows      uint64
+
+	alterTxn   txnif.AsyncTxn
+	alterAt    uint64
+	alterIndex *wal.Index
 }
 
 func NewTableEntry(db *DBEntry, schema *Schema, txnCtx txnif.AsyncTxn, dataFactory TableDataFactory) *TableEntry {
@@ -55,7 +55,8 @@ const (
 
 func EstimateColumnBlockSize(colIdx int, rows uint32, meta *BlockEntry) uint32 {
 	switch meta.GetSegment().GetTable().GetSchema().ColDefs[colIdx].Type.Oid {
-	case types.Type_JSON, types.Type_CHAR, types.Type_VARCHAR:
+	case types.Type_JSON, types.Type_CHAR, types.Type_VARCHAR, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		return rows * 2 * 4
 	default:
 		return rows * uint32(meta.GetSegment().GetTable().GetSchema().ColDefs[colIdx].Type.Size)
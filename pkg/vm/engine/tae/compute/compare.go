@@ -53,6 +53,10 @@ func CompareBytes(a, b any) int64 {
 	}
 }
 
+func CompareUuid(a, b types.Uuid) int64 {
+	return int64(a.Compare(b))
+}
+
 func CompareGeneric(a, b any, t types.Type) int64 {
 	switch t.Oid {
 	case types.Type_BOOL:
@@ -67,16 +71,18 @@ func CompareGeneric(a, b any, t types.Type) int64 {
 		return CompareOrdered[int64](a, b)
 	case types.Type_UINT8:
 		return CompareOrdered[uint8](a, b)
-	case types.Type_UINT16:
+	case types.Type_UINT16, types.Type_ENUM:
 		return CompareOrdered[uint16](a, b)
 	case types.Type_UINT32:
 		return CompareOrdered[uint32](a, b)
-	case types.Type_UINT64:
+	case types.Type_UINT64, types.Type_SET, types.Type_BIT:
 		return CompareOrdered[uint64](a, b)
 	case types.Type_DECIMAL64:
 		return wtf.CompareDecimal64Decimal64Aligned(a.(types.Decimal64), b.(types.Decimal64))
 	case types.Type_DECIMAL128:
 		return wtf.CompareDecimal128Decimal128Aligned(a.(types.Decimal128), b.(types.Decimal128))
+	case types.Type_UUID:
+		return CompareUuid(a.(types.Uuid), b.(types.Uuid))
 	case types.Type_FLOAT32:
 		return CompareOrdered[float32](a, b)
 	case types.Type_FLOAT64:
@@ -87,7 +93,8 @@ func CompareGeneric(a, b any, t types.Type) int64 {
 		return CompareOrdered[types.Date](a, b)
 	case types.Type_DATETIME:
 		return CompareOrdered[types.Datetime](a, b)
-	case types.Type_CHAR, types.Type_VARCHAR:
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		return CompareBytes(a, b)
 	default:
 		panic("unsupported type")
@@ -171,6 +171,20 @@ func EstimateSize(bat *containers.Batch, offset, length uint32) uint64 {
 	return size
 }
 
+// EstimateRowSize returns the bytes of a row in the batch. A value of the
+// variable length types takes its length
+func EstimateRowSize(bat *containers.Batch, row int) uint64 {
+	size := uint64(0)
+	for _, vec := range bat.Vecs {
+		if v, ok := vec.Get(row).([]byte); ok {
+			size += uint64(len(v))
+		} else {
+			size += uint64(vec.GetType().Size)
+		}
+	}
+	return size
+}
+
 func GetOffsetByVal(data containers.Vector, v any, skipmask *roaring.Bitmap) (offset int, exist bool) {
 	switch data.GetType().Oid {
 	case types.Type_BOOL:
@@ -185,11 +199,11 @@ func GetOffsetByVal(data containers.Vector, v any, skipmask *roaring.Bitmap) (of
 		return GetOffsetOfOrdered[int64](data.Slice(), v, skipmask)
 	case types.Type_UINT8:
 		return GetOffsetOfOrdered[uint8](data.Slice(), v, skipmask)
-	case types.Type_UINT16:
+	case types.Type_UINT16, types.Type_ENUM:
 		return GetOffsetOfOrdered[uint16](data.Slice(), v, skipmask)
 	case types.Type_UINT32:
 		return GetOffsetOfOrdered[uint32](data.Slice(), v, skipmask)
-	case types.Type_UINT64:
+	case types.Type_UINT64, types.Type_SET, types.Type_BIT:
 		return GetOffsetOfOrdered[uint64](data.Slice(), v, skipmask)
 	case types.Type_FLOAT32:
 		return GetOffsetOfOrdered[float32](data.Slice(), v, skipmask)
@@ -213,7 +227,14 @@ func GetOffsetByVal(data containers.Vector, v any, skipmask *roaring.Bitmap) (of
 			v.(types.Decimal128),
 			wtf.CompareDecimal128Decimal128Aligned,
 			skipmask)
-	case types.Type_CHAR, types.Type_VARCHAR:
+	case types.Type_UUID:
+		return GetOffsetWithFunc(
+			data.Slice().([]types.Uuid),
+			v.(types.Uuid),
+			CompareUuid,
+			skipmask)
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		// column := data.Slice().(*containers.Bytes)
 		val := v.([]byte)
 		start, end := 0, data.Length()-1
@@ -43,6 +43,11 @@ func (bat *Batch) AddVector(attr string, vec Vector) {
 	bat.Vecs = append(bat.Vecs, vec)
 }
 
+func (bat *Batch) HasAttr(name string) bool {
+	_, ok := bat.nameidx[name]
+	return ok
+}
+
 func (bat *Batch) GetVectorByName(name string) Vector {
 	pos := bat.nameidx[name]
 	return bat.Vecs[pos]
@@ -32,16 +32,18 @@ func MakeVector(typ types.Type, nullable bool, opts ...*Options) (vec Vector) {
 		vec = NewVector[int64](typ, nullable, opts...)
 	case types.Type_UINT8:
 		vec = NewVector[uint8](typ, nullable, opts...)
-	case types.Type_UINT16:
+	case types.Type_UINT16, types.Type_ENUM:
 		vec = NewVector[uint16](typ, nullable, opts...)
 	case types.Type_UINT32:
 		vec = NewVector[uint32](typ, nullable, opts...)
-	case types.Type_UINT64:
+	case types.Type_UINT64, types.Type_SET, types.Type_BIT:
 		vec = NewVector[uint64](typ, nullable, opts...)
 	case types.Type_DECIMAL64:
 		vec = NewVector[types.Decimal64](typ, nullable, opts...)
 	case types.Type_DECIMAL128:
 		vec = NewVector[types.Decimal128](typ, nullable, opts...)
+	case types.Type_UUID:
+		vec = NewVector[types.Uuid](typ, nullable, opts...)
 	case types.Type_FLOAT32:
 		vec = NewVector[float32](typ, nullable, opts...)
 	case types.Type_FLOAT64:
@@ -52,7 +54,8 @@ func MakeVector(typ types.Type, nullable bool, opts ...*Options) (vec Vector) {
 		vec = NewVector[types.Timestamp](typ, nullable, opts...)
 	case types.Type_DATETIME:
 		vec = NewVector[types.Datetime](typ, nullable, opts...)
-	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON:
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		vec = NewVector[[]byte](typ, nullable, opts...)
 	default:
 		panic("not support")
@@ -129,7 +129,7 @@ func MockVector(t types.Type, rows int, unique, nullable bool, provider Vector)
 				vec.Append(uint8(ival))
 			}
 		}
-	case types.Type_UINT16:
+	case types.Type_UINT16, types.Type_ENUM:
 		if unique {
 			for i := 0; i < rows; i++ {
 				vec.Append(uint16(i))
@@ -151,7 +151,7 @@ func MockVector(t types.Type, rows int, unique, nullable bool, provider Vector)
 				vec.Append(uint32(ival))
 			}
 		}
-	case types.Type_UINT64:
+	case types.Type_UINT64, types.Type_SET, types.Type_BIT:
 		if unique {
 			for i := 0; i < rows; i++ {
 				vec.Append(uint64(i))
@@ -174,7 +174,8 @@ func MockVector(t types.Type, rows int, unique, nullable bool, provider Vector)
 			v2 := rand.Intn(math.MaxInt32) + 1
 			vec.Append(float64(v1) / float64(v2))
 		}
-	case types.Type_VARCHAR, types.Type_CHAR:
+	case types.Type_VARCHAR, types.Type_CHAR, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		if unique {
 			for i := 0; i < rows; i++ {
 				s := fmt.Sprintf("%d-%d", i, 0)
@@ -206,6 +207,10 @@ func MockVector(t types.Type, rows int, unique, nullable bool, provider Vector)
 		for i := int32(1); i <= int32(rows); i++ {
 			vec.Append(wtf.InitDecimal128UsingUint(common.NextGlobalSeqNum()))
 		}
+	case types.Type_UUID:
+		for i := int32(1); i <= int32(rows); i++ {
+			vec.Append(wtf.InitUuid(common.NextGlobalSeqNum()))
+		}
 	default:
 		panic("not supported")
 	}
@@ -243,7 +248,7 @@ func MockVector2(typ types.Type, rows int, offset int) Vector {
 		for i := 0; i < rows; i++ {
 			vec.Append(uint8(i + offset))
 		}
-	case types.Type_UINT16:
+	case types.Type_UINT16, types.Type_ENUM:
 		for i := 0; i < rows; i++ {
 			vec.Append(uint16(i + offset))
 		}
@@ -251,7 +256,7 @@ func MockVector2(typ types.Type, rows int, offset int) Vector {
 		for i := 0; i < rows; i++ {
 			vec.Append(uint32(i + offset))
 		}
-	case types.Type_UINT64:
+	case types.Type_UINT64, types.Type_SET, types.Type_BIT:
 		for i := 0; i < rows; i++ {
 			vec.Append(uint64(i + offset))
 		}
@@ -271,6 +276,10 @@ func MockVector2(typ types.Type, rows int, offset int) Vector {
 		for i := 0; i < rows; i++ {
 			vec.Append(wtf.InitDecimal128(int64(i + offset)))
 		}
+	case types.Type_UUID:
+		for i := 0; i < rows; i++ {
+			vec.Append(wtf.InitUuid(uint64(i + offset)))
+		}
 	case types.Type_TIMESTAMP:
 		for i := 0; i < rows; i++ {
 			vec.Append(types.Timestamp(i + offset))
@@ -283,7 +292,8 @@ func MockVector2(typ types.Type, rows int, offset int) Vector {
 		for i := 0; i < rows; i++ {
 			vec.Append(types.Datetime(i + offset))
 		}
-	case types.Type_CHAR, types.Type_VARCHAR:
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		for i := 0; i < rows; i++ {
 			vec.Append([]byte(strconv.Itoa(i + offset)))
 		}
@@ -296,6 +306,10 @@ func MockVector2(typ types.Type, rows int, offset int) Vector {
 func MockBatchWithAttrs(vecTypes []types.Type, attrs []string, nullables []bool, rows int, uniqueIdx int, provider *MockDataProvider) (bat *Batch) {
 	bat = MockNullableBatch(vecTypes, nullables, rows, uniqueIdx, provider)
 	bat.Attrs = attrs
+	bat.nameidx = make(map[string]int, len(attrs))
+	for i, attr := range attrs {
+		bat.nameidx[attr] = i
+	}
 	return
 }
 
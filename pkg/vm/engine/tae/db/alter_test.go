@@ -0,0 +1,242 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnimpl"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func alterRelation(rel handle.Relation, fn func(*catalog.Schema) error) error {
+	return rel.Alter(func(schema any) error { return fn(schema.(*catalog.Schema)) })
+}
+
+func mockAddedAttr(name string, v int32) engine.Attribute {
+	return engine.Attribute{
+		Name:    name,
+		Type:    types.Type_INT32.ToType(),
+		Default: engine.MakeDefaultExpr(true, v, false),
+	}
+}
+
+func checkColumnValues(t *testing.T, rel handle.Relation, colIdx int, v any) (rows int) {
+	forEachColumnView(rel, colIdx, func(view *model.ColumnView) (err error) {
+		for i := 0; i < view.Length(); i++ {
+			assert.Equal(t, v, view.GetData().Get(i))
+		}
+		rows += view.Length()
+		return
+	})
+	return
+}
+
+func TestAlterTable1(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(4, 0)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+	bat := catalog.MockBatch(schema, 20)
+	defer bat.Close()
+	tae.createRelAndAppend(bat.Window(0, 15), true)
+
+	txn, rel := tae.getRelation()
+	err := alterRelation(rel, func(s *catalog.Schema) error {
+		if err := s.AddColumn(mockAddedAttr("added", 7)); err != nil {
+			return err
+		}
+		if err := s.DropColumn("mock_1"); err != nil {
+			return err
+		}
+		if err := s.RenameColumn("mock_3", "renamed"); err != nil {
+			return err
+		}
+		s.Rename("altered")
+		return nil
+	})
+	assert.NoError(t, err)
+	// The change is not visible before commit
+	assert.Equal(t, schema.Name, rel.GetMeta().(*catalog.TableEntry).GetSchema().Name)
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = getDefaultRelation(t, tae.DB, "altered")
+	altered := rel.GetMeta().(*catalog.TableEntry).GetSchema()
+	assert.Equal(t, []string{"mock_0", "mock_2", "renamed", "added"}, altered.Attrs())
+	assert.Equal(t, uint32(4), altered.Version)
+	addedIdx := altered.GetColIdx("added")
+	checkAllColRowsByScan(t, rel, 15, false)
+	assert.Equal(t, 15, checkColumnValues(t, rel, addedIdx, int32(7)))
+	v, err := rel.GetValue(getOneBlock(rel).Fingerprint(), 0, uint16(addedIdx))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(7), v)
+	assert.NoError(t, txn.Commit())
+	tae.bindSchema(altered)
+
+	// Rows written after the change use the new layout
+	added := containers.MakeVector(types.Type_INT32.ToType(), true)
+	for i := 0; i < 5; i++ {
+		added.Append(int32(8))
+	}
+	win := bat.CloneWindow(15, 5)
+	defer win.Close()
+	newBat := containers.NewBatch()
+	newBat.AddVector("mock_0", win.Vecs[0])
+	newBat.AddVector("mock_2", win.Vecs[2])
+	newBat.AddVector("renamed", win.Vecs[3])
+	newBat.AddVector("added", added)
+	tae.doAppend(newBat)
+	added.Close()
+
+	txn, rel = tae.getRelation()
+	checkAllColRowsByScan(t, rel, 20, false)
+	assert.NoError(t, txn.Commit())
+
+	tae.compactBlocks(false)
+	tae.mergeBlocks(false)
+
+	txn, rel = tae.getRelation()
+	checkAllColRowsByScan(t, rel, 20, false)
+	sevens := 0
+	forEachColumnView(rel, addedIdx, func(view *model.ColumnView) (err error) {
+		for i := 0; i < view.Length(); i++ {
+			if view.GetData().Get(i) == int32(7) {
+				sevens++
+			}
+		}
+		return
+	})
+	assert.Equal(t, 15, sevens)
+	assert.NoError(t, txn.Commit())
+}
+
+func TestAlterTable2(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(4, 0)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+	bat := catalog.MockBatch(schema, 15)
+	defer bat.Close()
+	tae.createRelAndAppend(bat, true)
+
+	txn, rel := tae.getRelation()
+	err := alterRelation(rel, func(s *catalog.Schema) error {
+		if err := s.AddColumn(mockAddedAttr("added", 7)); err != nil {
+			return err
+		}
+		return s.DropColumn("mock_1")
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, txn.Commit())
+
+	check := func() {
+		txn, rel := tae.getRelation()
+		schema := rel.GetMeta().(*catalog.TableEntry).GetSchema()
+		assert.Equal(t, []string{"mock_0", "mock_2", "mock_3", "added"}, schema.Attrs())
+		assert.Equal(t, uint32(2), schema.Version)
+		checkAllColRowsByScan(t, rel, 15, false)
+		assert.Equal(t, 15, checkColumnValues(t, rel, schema.GetColIdx("added"), int32(7)))
+		assert.NoError(t, txn.Commit())
+	}
+	check()
+
+	// Replay the alter from the log
+	tae.restart()
+	check()
+
+	// Replay the alter from the checkpoint
+	tae.compactBlocks(false)
+	tae.checkpointCatalog()
+	tae.restart()
+	check()
+}
+
+func TestAlterTable3(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(4, 0)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	tae.bindSchema(schema)
+	bat := catalog.MockBatch(schema, 20)
+	defer bat.Close()
+	tae.createRelAndAppend(bat.Window(0, 5), true)
+
+	addColumn := func(name string) func(*catalog.Schema) error {
+		return func(s *catalog.Schema) error { return s.AddColumn(mockAddedAttr(name, 0)) }
+	}
+
+	// A table cannot be written and altered in one txn
+	txn, rel := tae.getRelation()
+	assert.NoError(t, rel.Append(bat.Window(5, 5)))
+	assert.ErrorIs(t, alterRelation(rel, addColumn("c1")), txnimpl.ErrDDLAfterDML)
+	assert.NoError(t, txn.Rollback())
+
+	txn, rel = tae.getRelation()
+	assert.NoError(t, alterRelation(rel, addColumn("c1")))
+	assert.ErrorIs(t, rel.Append(bat.Window(5, 5)), txnimpl.ErrDMLAfterDDL)
+	assert.NoError(t, txn.Rollback())
+
+	// Concurrent alters conflict
+	txn1, rel1 := tae.getRelation()
+	txn2, rel2 := tae.getRelation()
+	assert.NoError(t, alterRelation(rel1, addColumn("c1")))
+	assert.ErrorIs(t, alterRelation(rel2, addColumn("c2")), txnif.ErrTxnWWConflict)
+	assert.NoError(t, txn2.Rollback())
+
+	// Rows appended with the replaced layout cannot be committed
+	txn3, rel3 := tae.getRelation()
+	assert.NoError(t, rel3.Append(bat.Window(5, 5)))
+	assert.NoError(t, txn1.Commit())
+	assert.Error(t, txn3.Commit())
+
+	// An alter based on a stale schema is rejected
+	txn4, rel4 := tae.getRelation()
+	txn5, rel5 := tae.getRelation()
+	assert.NoError(t, alterRelation(rel5, addColumn("c2")))
+	assert.NoError(t, txn5.Commit())
+	assert.ErrorIs(t, alterRelation(rel4, addColumn("c3")), txnif.ErrTxnWWConflict)
+	assert.NoError(t, txn4.Rollback())
+
+	// Fail to alter the schema
+	txn, rel = tae.getRelation()
+	assert.Error(t, alterRelation(rel, addColumn("c1")))
+	assert.Error(t, alterRelation(rel, func(s *catalog.Schema) error { return s.DropColumn("mock_0") }))
+	assert.NoError(t, txn.Commit())
+
+	txn, rel = tae.getRelation()
+	checkAllColRowsByScan(t, rel, 5, false)
+	assert.Equal(t, uint32(2), rel.GetMeta().(*catalog.TableEntry).GetSchema().Version)
+	assert.NoError(t, txn.Commit())
+}
@@ -262,6 +262,60 @@ func testCRUD(t *testing.T, tae *DB, schema *catalog.Schema) {
 	dropRelation(t, tae, defaultTestDB, schema.Name)
 }
 
+func TestAppendLob(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := catalog.NewEmptySchema("lob")
+	_ = schema.AppendPKCol("id", types.Type_INT32.ToType(), 0)
+	docType := types.Type_TEXT.ToType()
+	docType.Width = math.MaxInt32
+	_ = schema.AppendCol("doc", docType)
+	assert.NoError(t, schema.Finalize(false))
+	schema.BlockMaxRows = 1000
+	schema.BlockMaxBytes = 1024 * 1024
+	schema.SegmentMaxBlocks = 10
+	tae.bindSchema(schema)
+
+	// 100 rows of 100KB, the blocks fill up by bytes long before by rows
+	valueSize := 100 * 1024
+	rows := 100
+	mockBatch := func(from, to int) *containers.Batch {
+		bat := containers.NewBatch()
+		ids := containers.MakeVector(schema.ColDefs[0].Type, false)
+		docs := containers.MakeVector(schema.ColDefs[1].Type, false)
+		for i := from; i < to; i++ {
+			ids.Append(int32(i))
+			docs.Append(bytes.Repeat([]byte{byte('a' + i%26)}, valueSize))
+		}
+		bat.AddVector(schema.ColDefs[0].Name, ids)
+		bat.AddVector(schema.ColDefs[1].Name, docs)
+		return bat
+	}
+	bat := mockBatch(0, rows/2)
+	defer bat.Close()
+	tae.createRelAndAppend(bat, true)
+
+	// The bytes of the last appendable block are counted again on restart
+	tae.restart()
+	bat2 := mockBatch(rows/2, rows)
+	defer bat2.Close()
+	tae.doAppend(bat2)
+	tae.checkRowsByScan(rows, false)
+
+	txn, rel := tae.getRelation()
+	blocks := 0
+	forEachBlock(rel, func(blk handle.Block) error {
+		blocks++
+		// A block overshoots the bound by at most one row
+		assert.LessOrEqual(t, (blk.Rows()-1)*valueSize, int(schema.BlockMaxBytes))
+		return nil
+	})
+	assert.Greater(t, blocks, rows*valueSize/int(schema.BlockMaxBytes))
+	assert.NoError(t, txn.Commit())
+}
+
 func TestCRUD(t *testing.T) {
 	testutils.EnsureNoLeak(t)
 	opts := config.WithLongScanAndCKPOpts(nil)
@@ -0,0 +1,90 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// checkFullTextIndexes returns the rows containing the word in the full-text
+// indexes of the persisted blocks and the count of the blocks without index
+func checkFullTextIndexes(t *testing.T, tae *testEngine, colIdx int, word string) (rows, noIdx int) {
+	txn, rel := tae.getRelation()
+	defer func() { assert.NoError(t, txn.Commit()) }()
+	it := rel.MakeBlockIt()
+	for ; it.Valid(); it.Next() {
+		idx, err := it.GetBlock().GetFullTextIndex(colIdx)
+		assert.NoError(t, err)
+		if idx == nil {
+			noIdx++
+			continue
+		}
+		rows += len(idx.Postings(word))
+	}
+	return
+}
+
+func TestFullTextIndex(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13, 3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	assert.NoError(t, schema.AppendFullTextKey("ft", "mock_12"))
+	assert.Error(t, schema.AppendFullTextKey("ft_int", "mock_2"))
+	tae.bindSchema(schema)
+	bat := catalog.MockBatch(schema, 40)
+	defer bat.Close()
+	for i := 0; i < 40; i++ {
+		if i%4 == 0 {
+			bat.Vecs[12].Update(i, []byte(fmt.Sprintf("MySQL tutorial %d", i)))
+		} else {
+			bat.Vecs[12].Update(i, []byte(fmt.Sprintf("database tuning %d", i)))
+		}
+	}
+	tae.createRelAndAppend(bat, true)
+
+	// The appendable blocks have no index
+	rows, noIdx := checkFullTextIndexes(t, tae, 12, "mysql")
+	assert.Equal(t, 0, rows)
+	assert.Equal(t, 4, noIdx)
+
+	tae.compactBlocks(false)
+	rows, noIdx = checkFullTextIndexes(t, tae, 12, "mysql")
+	assert.Equal(t, 10, rows)
+	assert.Equal(t, 0, noIdx)
+	rows, _ = checkFullTextIndexes(t, tae, 12, "tuning")
+	assert.Equal(t, 30, rows)
+	rows, _ = checkFullTextIndexes(t, tae, 2, "mysql")
+	assert.Equal(t, 0, rows)
+
+	tae.mergeBlocks(false)
+	rows, noIdx = checkFullTextIndexes(t, tae, 12, "mysql")
+	assert.Equal(t, 10, rows)
+	assert.Equal(t, 0, noIdx)
+
+	tae.restart()
+	rows, noIdx = checkFullTextIndexes(t, tae, 12, "mysql")
+	assert.Equal(t, 10, rows)
+	assert.Equal(t, 0, noIdx)
+}
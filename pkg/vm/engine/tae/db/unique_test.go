@@ -0,0 +1,176 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockUniqueSchema(t *testing.T) *catalog.Schema {
+	schema := catalog.MockSchemaAll(4, 3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 2
+	assert.NoError(t, schema.AppendUniqueKey("uk_1", "mock_1"))
+	assert.NoError(t, schema.AppendUniqueKey("uk_0_2", "mock_0", "mock_2"))
+	return schema
+}
+
+// mockUniqueBatch makes the rows with mock_1 = i and (mock_0, mock_2) = (i % 2, i)
+func mockUniqueBatch(schema *catalog.Schema, rows int) *containers.Batch {
+	bat := catalog.MockBatch(schema, rows)
+	for i := 0; i < rows; i++ {
+		bat.Vecs[0].Update(i, int8(i%2))
+		bat.Vecs[1].Update(i, int16(i))
+		bat.Vecs[2].Update(i, int32(i))
+	}
+	return bat
+}
+
+func assertDuplicateEntry(t *testing.T, err error) {
+	assert.True(t, moerr.IsMoErrCode(err, moerr.DUPLICATE_ENTRY), "unexpected error: %v", err)
+}
+
+func TestUniqueKey1(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := mockUniqueSchema(t)
+	tae.bindSchema(schema)
+	bat := mockUniqueBatch(schema, 30)
+	defer bat.Close()
+	tae.createRelAndAppend(bat.Window(0, 15), true)
+
+	// Duplicate the single column key of a committed row
+	dup := bat.CloneWindow(15, 1)
+	defer dup.Close()
+	dup.Vecs[1].Update(0, int16(3))
+	txn, rel := tae.getRelation()
+	assertDuplicateEntry(t, rel.Append(dup))
+	assert.NoError(t, txn.Rollback())
+
+	// Duplicate the compound key of a committed row
+	dup.Vecs[1].Update(0, int16(15))
+	dup.Vecs[0].Update(0, int8(1))
+	dup.Vecs[2].Update(0, int32(5))
+	txn, rel = tae.getRelation()
+	assertDuplicateEntry(t, rel.Append(dup))
+	assert.NoError(t, txn.Rollback())
+
+	// Duplicate a row appended by the txn itself
+	txn, rel = tae.getRelation()
+	assert.NoError(t, rel.Append(bat.Window(15, 2)))
+	dup.Vecs[0].Update(0, int8(0))
+	dup.Vecs[1].Update(0, int16(16))
+	dup.Vecs[2].Update(0, int32(100))
+	dup.Vecs[3].Update(0, bat.Vecs[3].Get(20))
+	assertDuplicateEntry(t, rel.Append(dup))
+	assert.NoError(t, txn.Rollback())
+
+	// The key of a row deleted by the txn is free
+	txn, rel = tae.getRelation()
+	filter := handle.NewEQFilter(bat.Vecs[3].Get(3))
+	assert.NoError(t, rel.DeleteByFilter(filter))
+	dup.Vecs[1].Update(0, int16(3))
+	assert.NoError(t, rel.Append(dup))
+	assert.NoError(t, txn.Commit())
+	tae.checkRowsByScan(15, true)
+}
+
+func TestUniqueKey2(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := mockUniqueSchema(t)
+	tae.bindSchema(schema)
+	bat := mockUniqueBatch(schema, 30)
+	defer bat.Close()
+	tae.createRelAndAppend(bat.Window(0, 10), true)
+
+	// Two txns append different rows with the same key. The first one
+	// committed wins and the other one fails at commit
+	dup := bat.CloneWindow(11, 1)
+	defer dup.Close()
+	dup.Vecs[1].Update(0, int16(10))
+	txn1, rel1 := tae.getRelation()
+	txn2, rel2 := tae.getRelation()
+	assert.NoError(t, rel1.Append(bat.Window(10, 1)))
+	assert.NoError(t, rel2.Append(dup))
+	assert.NoError(t, txn1.Commit())
+	assertDuplicateEntry(t, txn2.Commit())
+	tae.checkRowsByScan(11, true)
+
+	// The key is checked against the blocks compacted
+	tae.compactBlocks(false)
+	txn, rel := tae.getRelation()
+	assertDuplicateEntry(t, rel.Append(dup))
+	assert.NoError(t, txn.Rollback())
+}
+
+func TestUniqueKey3(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := newTestEngine(t, opts)
+	defer tae.Close()
+	schema := mockUniqueSchema(t)
+	tae.bindSchema(schema)
+	bat := mockUniqueBatch(schema, 30)
+	defer bat.Close()
+	tae.createRelAndAppend(bat.Window(0, 25), true)
+
+	// mayContain returns whether each block may contain mock_1 = v
+	mayContain := func(v int16) (found []bool) {
+		vec := containers.MakeVector(schema.ColDefs[1].Type, false)
+		defer vec.Close()
+		vec.Append(v)
+		var w bytes.Buffer
+		key, _ := model.EncodeKey([]containers.Vector{vec}, 0, &w)
+		txn, rel := tae.getRelation()
+		it := rel.MakeBlockIt()
+		for ; it.Valid(); it.Next() {
+			ok, err := it.GetBlock().MayContainKeys([]int{1}, map[string]struct{}{key: {}})
+			assert.NoError(t, err)
+			found = append(found, ok)
+		}
+		assert.NoError(t, txn.Commit())
+		return
+	}
+	assert.Equal(t, []bool{true, false, false}, mayContain(3))
+	assert.Equal(t, []bool{false, false, true}, mayContain(24))
+	assert.Equal(t, []bool{false, false, false}, mayContain(27))
+
+	// The index of the appendable block covers the rows appended since
+	txn, rel := tae.getRelation()
+	assert.NoError(t, rel.Append(bat.Window(25, 5)))
+	assert.NoError(t, txn.Commit())
+	assert.Equal(t, []bool{false, false, true}, mayContain(27))
+
+	// The blocks compacted are indexed too
+	tae.compactBlocks(false)
+	assert.Equal(t, []bool{true, false, false}, mayContain(3))
+	assert.Equal(t, []bool{false, false, true}, mayContain(27))
+	assert.Equal(t, []bool{false, false, false}, mayContain(30))
+}
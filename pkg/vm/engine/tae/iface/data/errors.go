@@ -22,6 +22,7 @@ var (
 	ErrNotAppendable             = errors.New("tae data: not appendable")
 	ErrUpdateUniqueKey           = errors.New("tae data: update unique key")
 	ErrUpdateHiddenKey           = errors.New("tae data: update hidden key")
+	ErrColumnNotMaterialized     = errors.New("tae data: column not materialized")
 	ErrStaleRequest              = errors.New("tae data: stale request")
 
 	ErrPossibleDuplicate = errors.New("tae data: possible duplicate")
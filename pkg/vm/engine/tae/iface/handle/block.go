@@ -21,6 +21,7 @@ import (
 	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 )
 
@@ -57,6 +58,12 @@ type BlockReader interface {
 	GetByFilter(filter *Filter) (uint32, error)
 	GetColumnDataByName(string, *bytes.Buffer) (*model.ColumnView, error)
 	GetColumnDataById(int, *bytes.Buffer) (*model.ColumnView, error)
+	// GetFullTextIndex returns the persisted full-text index of a column, or
+	// nil if the rows of the block should be tokenized instead
+	GetFullTextIndex(int) (*index.FullTextIndex, error)
+	// MayContainKeys returns false if no row of the block has any of the
+	// keys of the columns, the keys are encoded by model.EncodeKey
+	MayContainKeys([]int, map[string]struct{}) (bool, error)
 	GetMeta() any
 	Fingerprint() *common.ID
 	Rows() int
@@ -50,6 +50,19 @@ type Relation interface {
 	BatchDedup(cols ...containers.Vector) error
 	Append(data *containers.Batch) error
 
+	// RequireKeys fails the commit of the txn with err if any of the keys of
+	// the columns is missing in the relation then. The keys are encoded by
+	// model.EncodeKey
+	RequireKeys(cols []int, keys map[string]struct{}, err error)
+	// ForbidKeys fails the commit of the txn with err if a row of the
+	// relation has any of the keys of the columns missing in the columns
+	// parentCols of the parent relation then
+	ForbidKeys(cols []int, keys map[string]struct{}, parent Relation, parentCols []int, err error)
+
+	// Alter applies fn on the schema of the relation. The change is visible
+	// to others once the txn commits
+	Alter(fn func(schema any) error) error
+
 	GetMeta() any
 	CreateSegment() (Segment, error)
 	CreateNonAppendableSegment() (Segment, error)
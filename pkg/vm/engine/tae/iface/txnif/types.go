@@ -189,6 +189,7 @@ type TxnStore interface {
 	Update(dbId uint64, id *common.ID, row uint32, col uint16, v any) error
 	GetByFilter(dbId uint64, id uint64, filter *handle.Filter) (*common.ID, uint32, error)
 	GetValue(dbId uint64, id *common.ID, row uint32, col uint16) (any, error)
+	AlterTable(dbId, id uint64, fn func(schema any) error) error
 
 	CreateRelation(dbId uint64, def any) (handle.Relation, error)
 	DropRelationByName(dbId uint64, name string) (handle.Relation, error)
@@ -0,0 +1,381 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+)
+
+const (
+	// FullTextMinTokenSize and FullTextMaxTokenSize are the bounds of the
+	// length of the indexed words, as innodb_ft_min_token_size and
+	// innodb_ft_max_token_size
+	FullTextMinTokenSize = 3
+	FullTextMaxTokenSize = 84
+)
+
+// fullTextStopwords is the default stopword list of InnoDB
+var fullTextStopwords = map[string]bool{
+	"a": true, "about": true, "an": true, "are": true, "as": true, "at": true,
+	"be": true, "by": true, "com": true, "de": true, "en": true, "for": true,
+	"from": true, "how": true, "i": true, "in": true, "is": true, "it": true,
+	"la": true, "of": true, "on": true, "or": true, "that": true, "the": true,
+	"this": true, "to": true, "was": true, "what": true, "when": true,
+	"where": true, "who": true, "will": true, "with": true, "und": true,
+	"www": true,
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func isIndexedWord(word string) bool {
+	n := utf8.RuneCountInString(word)
+	return n >= FullTextMinTokenSize && n <= FullTextMaxTokenSize && !fullTextStopwords[word]
+}
+
+// Tokenize splits a text into lower case words. A word is a run of
+// letters, digits and underscores, the stopwords and the words out of the
+// token size bounds are dropped. A word appears as many times as in the text
+func Tokenize(text []byte) []string {
+	var words []string
+	for _, word := range strings.FieldsFunc(strings.ToLower(string(text)), func(r rune) bool {
+		return !isWordRune(r)
+	}) {
+		if isIndexedWord(word) {
+			words = append(words, word)
+		}
+	}
+	return words
+}
+
+// Posting is a row containing a word and the times the word appears in it
+type Posting struct {
+	Row  uint32
+	Freq uint32
+}
+
+// FullTextIndex is the inverted index of a text column of a block. It maps
+// each word to the rows containing it, ordered by row
+type FullTextIndex struct {
+	rows     uint32
+	terms    []string
+	postings map[string][]Posting
+}
+
+// NewFullTextIndex tokenizes the values of a char, varchar or text vector,
+// the null values contain no word
+func NewFullTextIndex(data containers.Vector) (*FullTextIndex, error) {
+	idx := &FullTextIndex{
+		rows:     uint32(data.Length()),
+		postings: make(map[string][]Posting),
+	}
+	freqs := make(map[string]uint32)
+	op := func(v any, row int) error {
+		text, ok := v.([]byte)
+		if !ok {
+			return nil
+		}
+		for _, word := range Tokenize(text) {
+			freqs[word]++
+		}
+		for word, freq := range freqs {
+			idx.postings[word] = append(idx.postings[word], Posting{Row: uint32(row), Freq: freq})
+			delete(freqs, word)
+		}
+		return nil
+	}
+	if err := data.Foreach(op, nil); err != nil {
+		return nil, err
+	}
+	idx.sortTerms()
+	return idx, nil
+}
+
+func NewFullTextIndexFromSource(data []byte) (*FullTextIndex, error) {
+	idx := new(FullTextIndex)
+	if err := idx.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+func (idx *FullTextIndex) sortTerms() {
+	idx.terms = make([]string, 0, len(idx.postings))
+	for term := range idx.postings {
+		idx.terms = append(idx.terms, term)
+	}
+	sort.Strings(idx.terms)
+}
+
+// Rows returns the row count of the indexed block
+func (idx *FullTextIndex) Rows() uint32 { return idx.rows }
+
+// Postings returns the rows containing the word
+func (idx *FullTextIndex) Postings(word string) []Posting {
+	return idx.postings[word]
+}
+
+// PrefixPostings calls fn with the rows of each word starting with prefix
+func (idx *FullTextIndex) PrefixPostings(prefix string, fn func([]Posting)) {
+	i := sort.SearchStrings(idx.terms, prefix)
+	for ; i < len(idx.terms) && strings.HasPrefix(idx.terms[i], prefix); i++ {
+		fn(idx.postings[idx.terms[i]])
+	}
+}
+
+func (idx *FullTextIndex) Marshal() (buf []byte, err error) {
+	var w bytes.Buffer
+	if err = binary.Write(&w, binary.BigEndian, idx.rows); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, uint32(len(idx.terms))); err != nil {
+		return
+	}
+	for _, term := range idx.terms {
+		postings := idx.postings[term]
+		if err = binary.Write(&w, binary.BigEndian, uint16(len(term))); err != nil {
+			return
+		}
+		if _, err = w.WriteString(term); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, uint32(len(postings))); err != nil {
+			return
+		}
+		if err = binary.Write(&w, binary.BigEndian, postings); err != nil {
+			return
+		}
+	}
+	buf = w.Bytes()
+	return
+}
+
+func (idx *FullTextIndex) Unmarshal(buf []byte) (err error) {
+	r := bytes.NewReader(buf)
+	if err = binary.Read(r, binary.BigEndian, &idx.rows); err != nil {
+		return
+	}
+	termCnt := uint32(0)
+	if err = binary.Read(r, binary.BigEndian, &termCnt); err != nil {
+		return
+	}
+	idx.terms = make([]string, termCnt)
+	idx.postings = make(map[string][]Posting, termCnt)
+	for i := range idx.terms {
+		length := uint16(0)
+		if err = binary.Read(r, binary.BigEndian, &length); err != nil {
+			return
+		}
+		term := make([]byte, length)
+		if _, err = io.ReadFull(r, term); err != nil {
+			return
+		}
+		cnt := uint32(0)
+		if err = binary.Read(r, binary.BigEndian, &cnt); err != nil {
+			return
+		}
+		postings := make([]Posting, cnt)
+		if err = binary.Read(r, binary.BigEndian, postings); err != nil {
+			return
+		}
+		idx.terms[i] = string(term)
+		idx.postings[idx.terms[i]] = postings
+	}
+	return
+}
+
+func (idx *FullTextIndex) GetMemoryUsage() uint32 {
+	size := uint32(8)
+	for term, postings := range idx.postings {
+		size += uint32(2*len(term)) + 8*uint32(len(postings))
+	}
+	return size
+}
+
+// FullTextOp is the operator of a word in a boolean mode search
+type FullTextOp int8
+
+const (
+	// FullTextOptional words raise the relevance of the rows containing them
+	FullTextOptional FullTextOp = iota
+	// FullTextRequired words must be in every returned row
+	FullTextRequired
+	// FullTextExcluded words must not be in any returned row
+	FullTextExcluded
+)
+
+// FullTextTerm is a word of a search, a prefix term matches all the words
+// starting with it
+type FullTextTerm struct {
+	Word   string
+	Prefix bool
+	Op     FullTextOp
+}
+
+// FullTextQuery is a MATCH ... AGAINST search
+type FullTextQuery struct {
+	Terms   []FullTextTerm
+	Boolean bool
+}
+
+// ParseFullTextQuery parses the search string of a natural language or a
+// boolean mode search. In boolean mode a word may be led by + (required) or
+// - (excluded) and followed by * (prefix). The words of a quoted phrase are
+// all required, their order is not checked. The operators ~, < and > and
+// the grouping by parentheses are accepted and ignored
+func ParseFullTextQuery(query string, boolean bool) *FullTextQuery {
+	q := &FullTextQuery{Boolean: boolean}
+	seen := make(map[FullTextTerm]bool)
+	add := func(term FullTextTerm) {
+		if !seen[term] {
+			seen[term] = true
+			q.Terms = append(q.Terms, term)
+		}
+	}
+	if !boolean {
+		for _, word := range Tokenize([]byte(query)) {
+			add(FullTextTerm{Word: word})
+		}
+		return q
+	}
+	query = strings.ToLower(query)
+	inPhrase := false
+	for _, field := range strings.FieldsFunc(query, unicode.IsSpace) {
+		op := FullTextOptional
+		if inPhrase {
+			op = FullTextRequired
+		}
+		for len(field) > 0 && strings.ContainsRune("+-~<>()\"", rune(field[0])) {
+			switch field[0] {
+			case '+':
+				op = FullTextRequired
+			case '-':
+				op = FullTextExcluded
+			case '"':
+				inPhrase, op = true, FullTextRequired
+			}
+			field = field[1:]
+		}
+		if end := strings.IndexByte(field, '"'); end >= 0 {
+			inPhrase = false
+			field = field[:end]
+		}
+		prefix := strings.HasSuffix(field, "*")
+		words := strings.FieldsFunc(field, func(r rune) bool { return !isWordRune(r) })
+		for i, word := range words {
+			term := FullTextTerm{Word: word, Op: op, Prefix: prefix && i == len(words)-1}
+			if term.Prefix || isIndexedWord(word) {
+				add(term)
+			}
+		}
+	}
+	return q
+}
+
+// FullTextStats are the statistics of the search terms over all the rows
+// searched, the relevance of a word is lower if more rows contain it
+type FullTextStats struct {
+	Rows    uint64
+	DocFreq []uint64
+}
+
+func (q *FullTextQuery) NewStats() *FullTextStats {
+	return &FullTextStats{DocFreq: make([]uint64, len(q.Terms))}
+}
+
+// hits returns the frequency of the term in each row of a block, summed
+// over the indexes of the searched columns
+func (q *FullTextQuery) hits(term FullTextTerm, idxes []*FullTextIndex) map[uint32]uint32 {
+	hits := make(map[uint32]uint32)
+	add := func(postings []Posting) {
+		for _, p := range postings {
+			hits[p.Row] += p.Freq
+		}
+	}
+	for _, idx := range idxes {
+		if term.Prefix {
+			idx.PrefixPostings(term.Word, add)
+		} else {
+			add(idx.Postings(term.Word))
+		}
+	}
+	return hits
+}
+
+// Collect adds the rows of a block to the statistics, idxes are the
+// indexes of the searched columns in the block
+func (q *FullTextQuery) Collect(stats *FullTextStats, idxes []*FullTextIndex) {
+	if len(idxes) == 0 {
+		return
+	}
+	stats.Rows += uint64(idxes[0].Rows())
+	for i, term := range q.Terms {
+		stats.DocFreq[i] += uint64(len(q.hits(term, idxes)))
+	}
+}
+
+// Score returns the relevance of each row of a block and the count of the
+// rows matching the search. The relevance of a row not matching is 0, the
+// relevance of a matching row is the sum of tf * idf * idf of its words,
+// with idf = log10(1 + rows / rows containing the word)
+func (q *FullTextQuery) Score(stats *FullTextStats, idxes []*FullTextIndex) (scores []float64, matched int) {
+	if len(idxes) == 0 {
+		return
+	}
+	rows := idxes[0].Rows()
+	scores = make([]float64, rows)
+	required := make([]uint16, rows)
+	excluded := make([]bool, rows)
+	present := make([]bool, rows)
+	requiredCnt := uint16(0)
+	for i, term := range q.Terms {
+		if term.Op == FullTextRequired {
+			requiredCnt++
+		}
+		if stats.DocFreq[i] == 0 {
+			continue
+		}
+		idf := math.Log10(1 + float64(stats.Rows)/float64(stats.DocFreq[i]))
+		for row, freq := range q.hits(term, idxes) {
+			switch term.Op {
+			case FullTextExcluded:
+				excluded[row] = true
+				continue
+			case FullTextRequired:
+				required[row]++
+			}
+			present[row] = true
+			scores[row] += float64(freq) * idf * idf
+		}
+	}
+	for row := range scores {
+		if !present[row] || excluded[row] || required[row] < requiredCnt {
+			scores[row] = 0
+			continue
+		}
+		matched++
+	}
+	return
+}
@@ -0,0 +1,129 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/types"
+	"github.com/stretchr/testify/require"
+)
+
+func mockTextVector(texts ...any) containers.Vector {
+	vec := containers.MakeVector(types.Type_VARCHAR.ToType(), true)
+	for _, text := range texts {
+		if s, ok := text.(string); ok {
+			vec.Append([]byte(s))
+		} else {
+			vec.Append(types.Null{})
+		}
+	}
+	return vec
+}
+
+func TestTokenize(t *testing.T) {
+	require.Equal(t, []string{"quick", "brown", "fox", "jumps", "over", "lazy", "dog_2", "fox"},
+		Tokenize([]byte("The quick BROWN fox, jumps over-the lazy dog_2; fox")))
+	require.Empty(t, Tokenize([]byte("a is to be")))
+}
+
+func TestFullTextIndex(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	vec := mockTextVector(
+		"MySQL tutorial for beginners",
+		nil,
+		"Database tuning, mysql vs mysql",
+		"Security tips",
+	)
+	defer vec.Close()
+	idx, err := NewFullTextIndex(vec)
+	require.NoError(t, err)
+	require.Equal(t, uint32(4), idx.Rows())
+	require.Equal(t, []Posting{{Row: 0, Freq: 1}, {Row: 2, Freq: 2}}, idx.Postings("mysql"))
+	require.Empty(t, idx.Postings("for"))
+
+	buf, err := idx.Marshal()
+	require.NoError(t, err)
+	idx2, err := NewFullTextIndexFromSource(buf)
+	require.NoError(t, err)
+	require.Equal(t, idx.Rows(), idx2.Rows())
+	require.Equal(t, idx.Postings("mysql"), idx2.Postings("mysql"))
+	var rows []uint32
+	idx2.PrefixPostings("tu", func(postings []Posting) {
+		for _, p := range postings {
+			rows = append(rows, p.Row)
+		}
+	})
+	require.ElementsMatch(t, []uint32{0, 2}, rows)
+}
+
+func TestParseFullTextQuery(t *testing.T) {
+	q := ParseFullTextQuery("The MySQL tutorial, mysql", false)
+	require.Equal(t, []FullTextTerm{{Word: "mysql"}, {Word: "tutorial"}}, q.Terms)
+
+	q = ParseFullTextQuery(`+mysql -yoursql tut* "data base" ~noise`, true)
+	require.Equal(t, []FullTextTerm{
+		{Word: "mysql", Op: FullTextRequired},
+		{Word: "yoursql", Op: FullTextExcluded},
+		{Word: "tut", Prefix: true},
+		{Word: "data", Op: FullTextRequired},
+		{Word: "base", Op: FullTextRequired},
+		{Word: "noise"},
+	}, q.Terms)
+}
+
+func TestFullTextScore(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	titles := mockTextVector("MySQL tutorial", "Database tuning", "Security tips", "MySQL security")
+	defer titles.Close()
+	bodies := mockTextVector("start here", "mysql optimizer", "never store passwords", nil)
+	defer bodies.Close()
+	titleIdx, err := NewFullTextIndex(titles)
+	require.NoError(t, err)
+	bodyIdx, err := NewFullTextIndex(bodies)
+	require.NoError(t, err)
+	idxes := []*FullTextIndex{titleIdx, bodyIdx}
+
+	q := ParseFullTextQuery("mysql", false)
+	stats := q.NewStats()
+	q.Collect(stats, idxes)
+	require.Equal(t, uint64(4), stats.Rows)
+	require.Equal(t, []uint64{3}, stats.DocFreq)
+	scores, matched := q.Score(stats, idxes)
+	require.Equal(t, 3, matched)
+	require.Zero(t, scores[2])
+	require.Greater(t, scores[0], 0.0)
+	require.Equal(t, scores[0], scores[1])
+
+	q = ParseFullTextQuery("+mysql -security", true)
+	stats = q.NewStats()
+	q.Collect(stats, idxes)
+	scores, matched = q.Score(stats, idxes)
+	require.Equal(t, 2, matched)
+	require.Greater(t, scores[0], 0.0)
+	require.Greater(t, scores[1], 0.0)
+	require.Zero(t, scores[3])
+
+	// a rare word is more relevant than a frequent one
+	q = ParseFullTextQuery("mysql security", false)
+	stats = q.NewStats()
+	q.Collect(stats, idxes)
+	scores, matched = q.Score(stats, idxes)
+	require.Equal(t, 4, matched)
+	require.Greater(t, scores[3], scores[2])
+	require.Greater(t, scores[2], scores[0])
+}
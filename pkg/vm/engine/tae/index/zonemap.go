@@ -164,7 +164,8 @@ func (zm *ZoneMap) Marshal() (buf []byte, err error) {
 		return
 	}
 	switch zm.typ.Oid {
-	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON:
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		minv := zm.min.([]byte)
 		maxv := zm.max.([]byte)
 		if _, err = types.WriteValues(
@@ -226,7 +227,7 @@ func (zm *ZoneMap) Unmarshal(buf []byte) error {
 		buf = buf[1:]
 		zm.max = types.DecodeFixed[uint8](buf[:1])
 		return nil
-	case types.Type_UINT16:
+	case types.Type_UINT16, types.Type_ENUM:
 		zm.min = types.DecodeFixed[uint16](buf[:2])
 		buf = buf[2:]
 		zm.max = types.DecodeFixed[uint16](buf[:2])
@@ -236,7 +237,7 @@ func (zm *ZoneMap) Unmarshal(buf []byte) error {
 		buf = buf[4:]
 		zm.max = types.DecodeFixed[uint32](buf[:4])
 		return nil
-	case types.Type_UINT64:
+	case types.Type_UINT64, types.Type_SET, types.Type_BIT:
 		zm.min = types.DecodeFixed[uint64](buf[:8])
 		buf = buf[8:]
 		zm.max = types.DecodeFixed[uint64](buf[:8])
@@ -276,7 +277,13 @@ func (zm *ZoneMap) Unmarshal(buf []byte) error {
 		buf = buf[16:]
 		zm.max = types.DecodeFixed[types.Decimal128](buf[:16])
 		return nil
-	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON:
+	case types.Type_UUID:
+		zm.min = types.DecodeFixed[types.Uuid](buf[:16])
+		buf = buf[16:]
+		zm.max = types.DecodeFixed[types.Uuid](buf[:16])
+		return nil
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		lenminv := types.DecodeFixed[int16](buf[:2])
 		buf = buf[2:]
 		minBuf := make([]byte, int(lenminv))
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/decimal128s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/decimal64s"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/numerics"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/uuids"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/mergesort/varchar"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/types"
 )
@@ -42,11 +43,11 @@ func SortBlockColumns(cols []containers.Vector, pk int) ([]uint32, error) {
 		numerics.Sort[int64](cols[pk], sortedIdx)
 	case types.Type_UINT8:
 		numerics.Sort[uint8](cols[pk], sortedIdx)
-	case types.Type_UINT16:
+	case types.Type_UINT16, types.Type_ENUM:
 		numerics.Sort[uint16](cols[pk], sortedIdx)
 	case types.Type_UINT32:
 		numerics.Sort[uint32](cols[pk], sortedIdx)
-	case types.Type_UINT64:
+	case types.Type_UINT64, types.Type_SET, types.Type_BIT:
 		numerics.Sort[uint64](cols[pk], sortedIdx)
 	case types.Type_FLOAT32:
 		numerics.Sort[float32](cols[pk], sortedIdx)
@@ -60,9 +61,12 @@ func SortBlockColumns(cols []containers.Vector, pk int) ([]uint32, error) {
 		decimal64s.Sort(cols[pk], sortedIdx)
 	case types.Type_DECIMAL128:
 		decimal128s.Sort(cols[pk], sortedIdx)
+	case types.Type_UUID:
+		uuids.Sort(cols[pk], sortedIdx)
 	case types.Type_TIMESTAMP:
 		numerics.Sort[types.Timestamp](cols[pk], sortedIdx)
-	case types.Type_CHAR, types.Type_JSON, types.Type_VARCHAR:
+	case types.Type_CHAR, types.Type_JSON, types.Type_VARCHAR, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		varchar.Sort(cols[pk], sortedIdx)
 	default:
 		panic(fmt.Sprintf("%s not supported", cols[pk].GetType().String()))
@@ -91,11 +95,11 @@ func MergeSortedColumn(column []containers.Vector, sortedIdx *[]uint32, fromLayo
 		ret, mapping = numerics.Merge[int64](column, sortedIdx, fromLayout, toLayout)
 	case types.Type_UINT8:
 		ret, mapping = numerics.Merge[uint8](column, sortedIdx, fromLayout, toLayout)
-	case types.Type_UINT16:
+	case types.Type_UINT16, types.Type_ENUM:
 		ret, mapping = numerics.Merge[uint16](column, sortedIdx, fromLayout, toLayout)
 	case types.Type_UINT32:
 		ret, mapping = numerics.Merge[uint32](column, sortedIdx, fromLayout, toLayout)
-	case types.Type_UINT64:
+	case types.Type_UINT64, types.Type_SET, types.Type_BIT:
 		ret, mapping = numerics.Merge[uint64](column, sortedIdx, fromLayout, toLayout)
 	case types.Type_FLOAT32:
 		ret, mapping = numerics.Merge[float32](column, sortedIdx, fromLayout, toLayout)
@@ -109,9 +113,12 @@ func MergeSortedColumn(column []containers.Vector, sortedIdx *[]uint32, fromLayo
 		ret, mapping = decimal64s.Merge(column, sortedIdx, fromLayout, toLayout)
 	case types.Type_DECIMAL128:
 		ret, mapping = decimal128s.Merge(column, sortedIdx, fromLayout, toLayout)
+	case types.Type_UUID:
+		ret, mapping = uuids.Merge(column, sortedIdx, fromLayout, toLayout)
 	case types.Type_TIMESTAMP:
 		ret, mapping = numerics.Merge[types.Timestamp](column, sortedIdx, fromLayout, toLayout)
-	case types.Type_CHAR, types.Type_JSON, types.Type_VARCHAR:
+	case types.Type_CHAR, types.Type_JSON, types.Type_VARCHAR, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		ret, mapping = varchar.Merge(column, sortedIdx, fromLayout, toLayout)
 	default:
 		panic(fmt.Sprintf("%s not supported", column[0].GetType().String()))
@@ -114,6 +114,8 @@ func EncodeTypedVals(w *bytes.Buffer, vals ...any) []byte {
 			_, _ = w.Write(types.EncodeFixed(v))
 		case types.Decimal128:
 			_, _ = w.Write(types.EncodeFixed(v))
+		case types.Uuid:
+			_, _ = w.Write(types.EncodeFixed(v))
 		default:
 			panic(fmt.Errorf("%T:%v not supported", v, v))
 		}
@@ -145,3 +147,21 @@ func EncodeCompoundColumn(cols ...containers.Vector) (cc containers.Vector) {
 	}
 	return cc
 }
+
+// EncodeKey encodes the parts of a key of the row, each part is prefixed by
+// its length. A key with any null part is not encoded as nulls never equal
+// each other
+func EncodeKey(vecs []containers.Vector, row int, w *bytes.Buffer) (key string, ok bool) {
+	w.Reset()
+	var lenBuf [binary.MaxVarintLen64]byte
+	for _, vec := range vecs {
+		if vec.IsNull(row) {
+			return
+		}
+		part := types.EncodeValue(vec.Get(row), vec.GetType())
+		n := binary.PutUvarint(lenBuf[:], uint64(len(part)))
+		w.Write(lenBuf[:n])
+		w.Write(part)
+	}
+	return w.String(), true
+}
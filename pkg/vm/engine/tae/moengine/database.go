@@ -33,7 +33,10 @@ func newDatabase(h handle.Database) *txnDatabase {
 func (db *txnDatabase) Relations(_ engine.Snapshot) (names []string) {
 	it := db.handle.MakeRelationIt()
 	for it.Valid() {
-		names = append(names, it.GetRelation().GetMeta().(*catalog.TableEntry).GetSchema().Name)
+		name := it.GetRelation().GetMeta().(*catalog.TableEntry).GetSchema().Name
+		if !engine.IsPartitionTable(name) {
+			names = append(names, name)
+		}
 		it.Next()
 	}
 	return
@@ -48,6 +51,9 @@ func (db *txnDatabase) Relation(name string, _ engine.Snapshot) (rel engine.Rela
 		rel = newSysRelation(h)
 		return
 	}
+	if h.GetMeta().(*catalog.TableEntry).GetSchema().Partition != nil {
+		return newPartitionRelation(db.handle, h)
+	}
 	rel = newRelation(h)
 	return
 }
@@ -58,12 +64,55 @@ func (db *txnDatabase) Create(_ uint64, name string, defs []engine.TableDef, _ e
 		return err
 	}
 	schema.BlockMaxRows = 40000
+	if schema.HasLob() {
+		// A row of large objects may be megabytes, bound the bytes of an
+		// appendable block so that it does not bloat the memory
+		schema.BlockMaxBytes = 64 * 1024 * 1024
+	}
 	schema.SegmentMaxBlocks = 20
-	_, err = db.handle.CreateRelation(schema)
-	return err
+	if _, err = db.handle.CreateRelation(schema); err != nil {
+		return err
+	}
+	for _, def := range defs {
+		if partDef, ok := def.(*engine.PartitionDef); ok {
+			return db.createPartitions(name, defs, partDef.Partitions)
+		}
+	}
+	return nil
+}
+
+// createPartitions creates the relations storing the partitions of the
+// relation name, defs is the definition of the relation
+func (db *txnDatabase) createPartitions(name string, defs []engine.TableDef, parts []engine.Partition) error {
+	partDefs := make([]engine.TableDef, 0, len(defs))
+	for _, def := range defs {
+		if _, ok := def.(*engine.PartitionDef); !ok {
+			partDefs = append(partDefs, def)
+		}
+	}
+	for _, part := range parts {
+		if err := db.Create(0, engine.PartitionTableName(name, part.Name), partDefs, nil); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (db *txnDatabase) Delete(_ uint64, name string, _ engine.Snapshot) error {
-	_, err := db.handle.DropRelationByName(name)
-	return err
+	h, err := db.handle.GetRelationByName(name)
+	if err != nil {
+		return err
+	}
+	part := h.GetMeta().(*catalog.TableEntry).GetSchema().Partition
+	if _, err = db.handle.DropRelationByName(name); err != nil {
+		return err
+	}
+	if part != nil {
+		for _, partName := range part.Names {
+			if _, err = db.handle.DropRelationByName(engine.PartitionTableName(name, partName)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
@@ -15,6 +15,9 @@
 package moengine
 
 import (
+	"fmt"
+	"sort"
+
 	mobat "github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
@@ -22,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/types"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
@@ -422,6 +426,117 @@ func TestTxnRelation_Update(t *testing.T) {
 	assert.Equal(t, data.ErrUpdateUniqueKey, err)
 }
 
+func TestTxnRelation_AlterTable(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	err = e.Create(0, "db", 0, txn.GetCtx())
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+
+	schema := catalog.MockSchema(4, 0)
+	defs, err := SchemaToDefs(schema)
+	assert.NoError(t, err)
+	err = dbase.Create(0, schema.Name, defs, txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation(schema.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	bat := catalog.MockBatch(schema, 4)
+	defer bat.Close()
+	newbat := mobat.New(true, bat.Attrs)
+	newbat.Vecs = CopyToMoVectors(bat.Vecs)
+	err = rel.Write(0, newbat, txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err = dbase.Relation(schema.Name, txn.GetCtx())
+	assert.Nil(t, err)
+	added := engine.Attribute{
+		Name:    "added",
+		Type:    types.Type_INT32.ToType(),
+		Default: engine.MakeDefaultExpr(true, int32(7), false),
+	}
+	err = rel.AddTableDef(0, &engine.AttributeDef{Attr: added}, nil)
+	assert.Nil(t, err)
+	err = rel.DelTableDef(0, &engine.AttributeDef{Attr: engine.Attribute{Name: "mock_1"}}, nil)
+	assert.Nil(t, err)
+	err = rel.AddTableDef(0, &engine.RenameColumnDef{OldName: "mock_2", NewName: "renamed"}, nil)
+	assert.Nil(t, err)
+	err = rel.AddTableDef(0, &engine.RenameTableDef{Name: "altered"}, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	read := func(attrs []string) (bats []*mobat.Batch) {
+		for _, reader := range rel.NewReader(10, nil, nil, nil) {
+			refs := make([]uint64, len(attrs))
+			for {
+				bat, err := reader.Read(refs, attrs)
+				assert.Nil(t, err)
+				if bat == nil {
+					break
+				}
+				bats = append(bats, bat)
+			}
+		}
+		return
+	}
+
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	_, err = dbase.Relation(schema.Name, txn.GetCtx())
+	assert.NotNil(t, err)
+	rel, err = dbase.Relation("altered", txn.GetCtx())
+	assert.Nil(t, err)
+	var names []string
+	for _, def := range rel.TableDefs(nil) {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			names = append(names, attr.Attr.Name)
+		}
+	}
+	assert.Equal(t, []string{"mock_0", "renamed", "mock_3", "added"}, names)
+
+	// Update the added column of a row written before the change
+	hidden := rel.GetHideKey(nil).Name
+	bats := read([]string{hidden, "added"})
+	assert.Equal(t, 1, len(bats))
+	assert.Equal(t, []int32{7, 7, 7, 7}, bats[0].Vecs[1].Col.([]int32))
+	update := mobat.New(true, []string{hidden, "added"})
+	update.Vecs[0] = vector.New(bats[0].Vecs[0].Typ)
+	update.Vecs[1] = vector.New(bats[0].Vecs[1].Typ)
+	AppendValue(update.Vecs[0], GetValue(bats[0].Vecs[0], 0))
+	AppendValue(update.Vecs[1], int32(9))
+	err = rel.Update(0, update, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err = dbase.Relation("altered", txn.GetCtx())
+	assert.Nil(t, err)
+	rows, sum := 0, int32(0)
+	for _, bat := range read([]string{"renamed", "added"}) {
+		rows += vector.Length(bat.Vecs[0])
+		for _, v := range bat.Vecs[1].Col.([]int32) {
+			sum += v
+		}
+	}
+	assert.Equal(t, 4, rows)
+	assert.Equal(t, int32(7*3+9), sum)
+	assert.Nil(t, txn.Commit())
+}
+
 func TestCopy1(t *testing.T) {
 	testutils.EnsureNoLeak(t)
 	t1 := types.Type_VARCHAR.ToType()
@@ -508,3 +623,282 @@ func TestSysRelation(t *testing.T) {
 	checkSysTable(t, catalog.SystemTable_Columns_Name, dbase, txn, 3, schema)
 	assert.Nil(t, txn.Commit())
 }
+
+func TestPartitionRelation(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	err = e.Create(0, "db", 0, txn.GetCtx())
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+
+	partDef := &engine.PartitionDef{
+		Typ:  engine.RangePartition,
+		Cols: []string{"a"},
+		Partitions: []engine.Partition{
+			{Name: "p0", Values: []string{"10"}},
+			{Name: "p1", Values: []string{"20"}},
+		},
+	}
+	defs := []engine.TableDef{
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "a", Type: types.Type_INT32.ToType()}},
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "b", Type: types.Type_INT32.ToType()}},
+		partDef,
+	}
+	err = dbase.Create(0, "t", defs, txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"t"}, dbase.Relations(txn.GetCtx()))
+
+	write := func(rel engine.Relation, vals ...int32) error {
+		bat := mobat.New(true, []string{"a", "b"})
+		for i := range bat.Vecs {
+			bat.Vecs[i] = vector.New(types.Type_INT32.ToType())
+			for _, v := range vals {
+				AppendValue(bat.Vecs[i], v)
+			}
+		}
+		return rel.Write(0, bat, nil)
+	}
+	partRows := func(name string) int64 {
+		rel, err := dbase.Relation(engine.PartitionTableName("t", name), nil)
+		assert.Nil(t, err)
+		return rel.Rows()
+	}
+	rel, err := dbase.Relation("t", txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Nil(t, write(rel, 1, 12, 5, 15))
+	assert.NotNil(t, write(rel, 25))
+	assert.Nil(t, txn.Commit())
+
+	// Move a row to another partition by updating its partition column
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err = dbase.Relation("t", txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), rel.Rows())
+	assert.Equal(t, int64(2), partRows("p0"))
+	hidden := rel.GetHideKey(nil).Name
+	update := mobat.New(true, []string{hidden, "a"})
+	update.Vecs[0] = vector.New(rel.GetHideKey(nil).Type)
+	update.Vecs[1] = vector.New(types.Type_INT32.ToType())
+	for _, reader := range rel.NewReader(2, nil, nil, nil) {
+		for {
+			bat, err := reader.Read([]uint64{0, 0}, []string{hidden, "a"})
+			assert.Nil(t, err)
+			if bat == nil {
+				break
+			}
+			for i, v := range bat.Vecs[1].Col.([]int32) {
+				if v == 1 {
+					AppendValue(update.Vecs[0], GetValue(bat.Vecs[0], uint32(i)))
+					AppendValue(update.Vecs[1], int32(11))
+				}
+			}
+		}
+	}
+	assert.Equal(t, 1, vector.Length(update.Vecs[0]))
+	assert.Nil(t, rel.Update(0, update, nil))
+	assert.Nil(t, txn.Commit())
+
+	// Drop p0 and add p2
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err = dbase.Relation("t", txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), partRows("p0"))
+	assert.Equal(t, int64(3), partRows("p1"))
+	err = rel.AddTableDef(0, &engine.PartitionDef{
+		Typ:  engine.RangePartition,
+		Cols: []string{"a"},
+		Partitions: []engine.Partition{
+			{Name: "p1", Values: []string{"20"}},
+			{Name: "p2", Values: []string{"30"}},
+		},
+	}, nil)
+	assert.Nil(t, err)
+	assert.Nil(t, txn.Commit())
+
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err = dbase.Relation("t", txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), rel.Rows())
+	_, err = dbase.Relation(engine.PartitionTableName("t", "p0"), nil)
+	assert.NotNil(t, err)
+	assert.Nil(t, write(rel, 25))
+	assert.Nil(t, txn.Commit())
+
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), partRows("p2"))
+	assert.Nil(t, dbase.Delete(0, "t", nil))
+	_, err = dbase.Relation(engine.PartitionTableName("t", "p1"), nil)
+	assert.NotNil(t, err)
+	assert.Nil(t, txn.Commit())
+}
+
+func TestSelectRows(t *testing.T) {
+	bat := mobat.New(true, []string{"a", "b"})
+	bat.Vecs[0] = vector.New(types.Type_INT32.ToType())
+	bat.Vecs[1] = vector.New(types.Type_VARCHAR.ToType())
+	for i, s := range []string{"x", "yy", "", "zzz"} {
+		AppendValue(bat.Vecs[0], int32(i))
+		AppendValue(bat.Vecs[1], []byte(s))
+	}
+	nulls.Add(bat.Vecs[1].Nsp, 2)
+
+	sel := selectRows(bat, []int{1, 2, 3})
+	assert.Equal(t, []int32{1, 2, 3}, sel.Vecs[0].Col)
+	col := sel.Vecs[1].Col.(*types.Bytes)
+	assert.Equal(t, "yy", string(col.Get(0)))
+	assert.Equal(t, "zzz", string(col.Get(2)))
+	assert.True(t, nulls.Contains(sel.Vecs[1].Nsp, 1))
+	assert.False(t, nulls.Contains(sel.Vecs[1].Nsp, 2))
+}
+
+func TestFullTextReader(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	err = e.Create(0, "db", 0, txn.GetCtx())
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	defs := []engine.TableDef{
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "a", Type: types.Type_INT32.ToType()}},
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "b", Type: types.Type_VARCHAR.ToType()}},
+		&engine.IndexTableDef{Typ: engine.FullText, Name: "b", ColNames: []string{"b"}},
+	}
+	err = dbase.Create(0, "t", defs, txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation("t", txn.GetCtx())
+	assert.Nil(t, err)
+	bat := mobat.New(true, []string{"a", "b"})
+	bat.Vecs[0] = vector.New(types.Type_INT32.ToType())
+	bat.Vecs[1] = vector.New(types.Type_VARCHAR.ToType())
+	for i, text := range []string{"MySQL tutorial", "Database tuning", "Security tips", "MySQL security"} {
+		AppendValue(bat.Vecs[0], int32(i))
+		AppendValue(bat.Vecs[1], []byte(text))
+	}
+	assert.Nil(t, rel.Write(0, bat, nil))
+	assert.Nil(t, txn.Commit())
+
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err = dbase.Relation("t", txn.GetCtx())
+	assert.Nil(t, err)
+	assert.Equal(t, engine.FullText, rel.TableDefs(nil)[2].(*engine.IndexTableDef).Typ)
+	searches := []engine.FullTextSearch{
+		{Attr: "__mo_fulltext_0", Cols: []string{"b"}, Query: "mysql"},
+		{Attr: "__mo_fulltext_1", Cols: []string{"b"}, Query: "+mysql -security", Boolean: true, Filter: true},
+	}
+	readers, err := rel.(engine.FullTextRelation).NewFullTextReader(2, searches, nil)
+	assert.Nil(t, err)
+	scores := make(map[int32][2]float64)
+	for _, reader := range readers {
+		for {
+			bat, err := reader.Read([]uint64{0, 0, 0}, []string{"a", "__mo_fulltext_0", "__mo_fulltext_1"})
+			assert.Nil(t, err)
+			if bat == nil {
+				break
+			}
+			for i, a := range bat.Vecs[0].Col.([]int32) {
+				scores[a] = [2]float64{bat.Vecs[1].Col.([]float64)[i], bat.Vecs[2].Col.([]float64)[i]}
+			}
+		}
+	}
+	assert.Equal(t, 4, len(scores))
+	assert.Greater(t, scores[0][0], 0.0)
+	assert.Equal(t, scores[0][0], scores[3][0])
+	assert.Zero(t, scores[1][0])
+	assert.Greater(t, scores[0][1], 0.0)
+	assert.Zero(t, scores[3][1])
+
+	_, err = rel.(engine.FullTextRelation).NewFullTextReader(1, []engine.FullTextSearch{{Attr: "x", Cols: []string{"a"}, Query: "mysql"}}, nil)
+	assert.NotNil(t, err)
+	assert.Nil(t, txn.Commit())
+}
+
+func TestKeyReader(t *testing.T) {
+	testutils.EnsureNoLeak(t)
+	tae := initDB(t, nil)
+	defer tae.Close()
+	e := NewEngine(tae)
+	txn, err := e.StartTxn(nil)
+	assert.Nil(t, err)
+	err = e.Create(0, "db", 0, txn.GetCtx())
+	assert.Nil(t, err)
+	dbase, err := e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	defs := []engine.TableDef{
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "a", Type: types.Type_INT32.ToType()}},
+		&engine.AttributeDef{Attr: engine.Attribute{Name: "b", Type: types.Type_VARCHAR.ToType()}},
+	}
+	err = dbase.Create(0, "t", defs, txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err := dbase.Relation("t", txn.GetCtx())
+	assert.Nil(t, err)
+	bat := mobat.New(true, []string{"a", "b"})
+	bat.Vecs[0] = vector.New(types.Type_INT32.ToType())
+	bat.Vecs[1] = vector.New(types.Type_VARCHAR.ToType())
+	for i := 0; i < 100; i++ {
+		AppendValue(bat.Vecs[0], int32(i))
+		AppendValue(bat.Vecs[1], []byte(fmt.Sprintf("b%d", i%10)))
+	}
+	assert.Nil(t, rel.Write(0, bat, nil))
+	assert.Nil(t, txn.Commit())
+
+	txn, err = e.StartTxn(nil)
+	assert.Nil(t, err)
+	dbase, err = e.Database("db", txn.GetCtx())
+	assert.Nil(t, err)
+	rel, err = dbase.Relation("t", txn.GetCtx())
+	assert.Nil(t, err)
+	keys := mobat.New(true, []string{"a", "b"})
+	keys.Vecs[0] = vector.New(types.Type_INT32.ToType())
+	keys.Vecs[1] = vector.New(types.Type_VARCHAR.ToType())
+	for _, key := range []struct {
+		a int32
+		b string
+	}{{3, "b3"}, {13, "b3"}, {14, "b3"}, {200, "b0"}} {
+		AppendValue(keys.Vecs[0], key.a)
+		AppendValue(keys.Vecs[1], []byte(key.b))
+	}
+	readers, err := rel.(engine.KeyRelation).NewKeyReader(2, keys, nil)
+	assert.Nil(t, err)
+	var found []int32
+	for _, reader := range readers {
+		for {
+			bat, err := reader.Read([]uint64{0, 0}, []string{"b", "a"})
+			assert.Nil(t, err)
+			if bat == nil {
+				break
+			}
+			found = append(found, bat.Vecs[1].Col.([]int32)...)
+		}
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i] < found[j] })
+	assert.Equal(t, []int32{3, 13}, found)
+
+	keys.Attrs[0] = "x"
+	_, err = rel.(engine.KeyRelation).NewKeyReader(1, keys, nil)
+	assert.NotNil(t, err)
+	assert.Nil(t, txn.Commit())
+}
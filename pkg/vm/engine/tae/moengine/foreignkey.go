@@ -0,0 +1,68 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+)
+
+var (
+	_ engine.ForeignKeyRelation = (*txnRelation)(nil)
+	_ engine.ForeignKeyRelation = (*partitionRelation)(nil)
+)
+
+// RequireKeys makes the txn check the keys again when it is prepared, the
+// txns are prepared one by one
+func (rel *txnRelation) RequireKeys(keys *batch.Batch, keyErr error, _ engine.Snapshot) error {
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	cols, err := keyColumns(schema, keys.Attrs)
+	if err != nil {
+		return err
+	}
+	rel.handle.RequireKeys(cols, encodeKeys(keys), keyErr)
+	return nil
+}
+
+func (rel *txnRelation) ForbidKeys(keys *batch.Batch, parent engine.ForeignKeyRelation, parentAttrs []string, keyErr error, _ engine.Snapshot) error {
+	p, ok := parent.(*txnRelation)
+	if !ok {
+		return fmt.Errorf("tae moengine: the parent relation is not a table of the engine")
+	}
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	cols, err := keyColumns(schema, keys.Attrs)
+	if err != nil {
+		return err
+	}
+	parentSchema := p.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	parentCols, err := keyColumns(parentSchema, parentAttrs)
+	if err != nil {
+		return err
+	}
+	rel.handle.ForbidKeys(cols, encodeKeys(keys), p.handle, parentCols, keyErr)
+	return nil
+}
+
+// RequireKeys fails as the foreign keys don't refer to the partitioned tables
+func (rel *partitionRelation) RequireKeys(*batch.Batch, error, engine.Snapshot) error {
+	return fmt.Errorf("tae moengine: foreign keys of the partitioned tables are not supported")
+}
+
+func (rel *partitionRelation) ForbidKeys(*batch.Batch, engine.ForeignKeyRelation, []string, error, engine.Snapshot) error {
+	return fmt.Errorf("tae moengine: foreign keys of the partitioned tables are not supported")
+}
@@ -0,0 +1,267 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/types"
+)
+
+var (
+	_ engine.FullTextRelation = (*txnRelation)(nil)
+	_ engine.FullTextRelation = (*partitionRelation)(nil)
+	_ engine.Reader           = (*fullTextReader)(nil)
+)
+
+// fullTextSearch is a search with the statistics of its words over all the
+// blocks searched
+type fullTextSearch struct {
+	engine.FullTextSearch
+	query *index.FullTextQuery
+	stats *index.FullTextStats
+}
+
+// fullTextBlock is a block to read with the indexes of the columns of each
+// search
+type fullTextBlock struct {
+	handle handle.Block
+	idxes  [][]*index.FullTextIndex
+}
+
+// fullTextScan is the blocks shared by the readers of a full-text scan
+type fullTextScan struct {
+	sync.Mutex
+	searches []*fullTextSearch
+	blocks   []*fullTextBlock
+	pos      int
+}
+
+type fullTextReader struct {
+	scan   *fullTextScan
+	buffer []*bytes.Buffer
+	zs     []int64
+}
+
+func (rel *baseRelation) NewFullTextReader(num int, searches []engine.FullTextSearch, _ engine.Snapshot) ([]engine.Reader, error) {
+	return newFullTextReaders(num, []handle.Relation{rel.handle}, searches)
+}
+
+// NewFullTextReader searches all the partitions, the statistics of the
+// words are collected over the whole table
+func (rel *partitionRelation) NewFullTextReader(num int, searches []engine.FullTextSearch, _ engine.Snapshot) ([]engine.Reader, error) {
+	handles := make([]handle.Relation, len(rel.parts))
+	for i, part := range rel.parts {
+		handles[i] = part.handle
+	}
+	return newFullTextReaders(num, handles, searches)
+}
+
+func newFullTextReaders(num int, handles []handle.Relation, searches []engine.FullTextSearch) ([]engine.Reader, error) {
+	scan, err := newFullTextScan(handles, searches)
+	if err != nil {
+		return nil, err
+	}
+	rds := make([]engine.Reader, num)
+	for i := range rds {
+		rds[i] = &fullTextReader{scan: scan}
+	}
+	return rds, nil
+}
+
+// newFullTextScan loads the indexes of the searched columns of all the
+// blocks and collects the statistics of the searches. The appendable and the
+// updated blocks have no usable index, their columns are tokenized instead.
+// The deleted rows are counted in the statistics as InnoDB does until the
+// index is optimized
+func newFullTextScan(handles []handle.Relation, searches []engine.FullTextSearch) (*fullTextScan, error) {
+	scan := &fullTextScan{searches: make([]*fullTextSearch, len(searches))}
+	for i, search := range searches {
+		query := index.ParseFullTextQuery(search.Query, search.Boolean)
+		scan.searches[i] = &fullTextSearch{
+			FullTextSearch: search,
+			query:          query,
+			stats:          query.NewStats(),
+		}
+	}
+	for _, h := range handles {
+		schema := h.GetMeta().(*catalog.TableEntry).GetSchema()
+		cols := make([][]int, len(searches))
+		for i, search := range searches {
+			for _, name := range search.Cols {
+				idx := schema.GetColIdx(name)
+				if idx < 0 || !schema.IsFullTextColumn(idx) {
+					return nil, fmt.Errorf("tae moengine: column %s has no full-text index", name)
+				}
+				cols[i] = append(cols[i], idx)
+			}
+		}
+		it := h.MakeBlockIt()
+		for ; it.Valid(); it.Next() {
+			blk := &fullTextBlock{
+				handle: it.GetBlock(),
+				idxes:  make([][]*index.FullTextIndex, len(searches)),
+			}
+			for i, search := range scan.searches {
+				for _, idx := range cols[i] {
+					ftIdx, err := loadFullTextIndex(blk.handle, idx)
+					if err != nil {
+						return nil, err
+					}
+					blk.idxes[i] = append(blk.idxes[i], ftIdx)
+				}
+				search.query.Collect(search.stats, blk.idxes[i])
+			}
+			scan.blocks = append(scan.blocks, blk)
+		}
+	}
+	return scan, nil
+}
+
+func loadFullTextIndex(blk handle.Block, colIdx int) (*index.FullTextIndex, error) {
+	idx, err := blk.GetFullTextIndex(colIdx)
+	if err != nil || idx != nil {
+		return idx, err
+	}
+	view, err := blk.GetColumnDataById(colIdx, nil)
+	if err != nil {
+		if view != nil {
+			view.Close()
+		}
+		return nil, err
+	}
+	defer view.Close()
+	return index.NewFullTextIndex(view.GetData())
+}
+
+func (scan *fullTextScan) nextBlock() *fullTextBlock {
+	scan.Lock()
+	defer scan.Unlock()
+	if scan.pos >= len(scan.blocks) {
+		return nil
+	}
+	blk := scan.blocks[scan.pos]
+	scan.blocks[scan.pos] = nil
+	scan.pos++
+	return blk
+}
+
+func (r *fullTextReader) Read(_ []uint64, attrs []string) (*batch.Batch, error) {
+	if r.buffer == nil {
+		r.buffer = make([]*bytes.Buffer, len(attrs))
+		for i := range r.buffer {
+			r.buffer[i] = new(bytes.Buffer)
+		}
+	}
+	for {
+		blk := r.scan.nextBlock()
+		if blk == nil {
+			return nil, nil
+		}
+		scores := make(map[string][]float64, len(r.scan.searches))
+		skip := false
+		for i, search := range r.scan.searches {
+			var matched int
+			scores[search.Attr], matched = search.query.Score(search.stats, blk.idxes[i])
+			if search.Filter && matched == 0 {
+				skip = true
+				break
+			}
+		}
+		if skip {
+			continue
+		}
+		bat, err := r.readBlock(blk.handle, attrs, scores)
+		if err != nil || bat == nil {
+			return bat, err
+		}
+		n := vector.Length(bat.Vecs[0])
+		if n > cap(r.zs) {
+			r.zs = make([]int64, n)
+		}
+		bat.Zs = r.zs[:n]
+		for i := 0; i < n; i++ {
+			bat.Zs[i] = 1
+		}
+		return bat, nil
+	}
+}
+
+// readBlock reads the columns of a block and the relevance of its rows, the
+// scores are of all the rows of the block and the deleted ones are removed
+func (r *fullTextReader) readBlock(h handle.Block, attrs []string, scores map[string][]float64) (*batch.Batch, error) {
+	var deletes *roaring.Bitmap
+	var view *model.ColumnView
+	var err error
+	bat := batch.New(true, attrs)
+	bat.Vecs = make([]*vector.Vector, len(attrs))
+	read := false
+	for i, attr := range attrs {
+		if _, ok := scores[attr]; ok {
+			continue
+		}
+		if view, err = h.GetColumnDataByName(attr, r.buffer[i]); err != nil {
+			if view != nil {
+				view.Close()
+			}
+			return nil, err
+		}
+		deletes, read = view.DeleteMask, true
+		view.ApplyDeletes()
+		if view.GetData().Allocated() > 0 {
+			bat.Vecs[i] = CopyToMoVector(view.GetData())
+		} else {
+			bat.Vecs[i] = VectorsToMO(view.GetData())
+		}
+		view.Close()
+	}
+	if !read {
+		schema := h.GetMeta().(*catalog.BlockEntry).GetSchema()
+		if view, err = h.GetColumnDataById(schema.HiddenKey.Idx, nil); err != nil {
+			if view != nil {
+				view.Close()
+			}
+			return nil, err
+		}
+		deletes = view.DeleteMask
+		view.Close()
+	}
+	for i, attr := range attrs {
+		rows, ok := scores[attr]
+		if !ok {
+			continue
+		}
+		vals := make([]float64, 0, len(rows))
+		for row, score := range rows {
+			if deletes == nil || !deletes.Contains(uint32(row)) {
+				vals = append(vals, score)
+			}
+		}
+		bat.Vecs[i] = vector.NewWithData(types.Type_FLOAT64.ToType(), encoding.EncodeFloat64Slice(vals), vals, &nulls.Nulls{})
+	}
+	return bat, nil
+}
@@ -28,16 +28,22 @@ func SchemaToDefs(schema *catalog.Schema) (defs []engine.TableDef, err error) {
 		commentDef.Comment = schema.Comment
 		defs = append(defs, commentDef)
 	}
+	if schema.ViewDef != "" {
+		defs = append(defs, &engine.ViewDef{View: schema.ViewDef})
+	}
 	for _, col := range schema.ColDefs {
-		if col.IsHidden() {
+		if col.IsHidden() || col.IsDropped() {
 			continue
 		}
 		def := &engine.AttributeDef{
 			Attr: engine.Attribute{
-				Name:    col.Name,
-				Type:    col.Type,
-				Primary: col.IsPrimary(),
-				Default: engine.MakeDefaultExpr(col.Default.Set, col.Default.Value, col.Default.Null),
+				Name:          col.Name,
+				Type:          col.Type,
+				Primary:       col.IsPrimary(),
+				Default:       engine.MakeDefaultExpr(col.Default.Set, col.Default.Value, col.Default.Null),
+				AutoIncrement: col.IsAutoIncrement(),
+				NotNull:       col.IsNotNull(),
+				EnumValues:    col.EnumValues,
 			},
 		}
 		defs = append(defs, def)
@@ -49,12 +55,51 @@ func SchemaToDefs(schema *catalog.Schema) (defs []engine.TableDef, err error) {
 		}
 		defs = append(defs, pk)
 	}
+	for _, key := range schema.UniqueKeys {
+		def := &engine.IndexTableDef{
+			Typ:  engine.Unique,
+			Name: key.Name,
+		}
+		for _, idx := range key.Columns {
+			def.ColNames = append(def.ColNames, schema.ColDefs[idx].Name)
+		}
+		defs = append(defs, def)
+	}
+	for _, key := range schema.FullTextKeys {
+		def := &engine.IndexTableDef{
+			Typ:  engine.FullText,
+			Name: key.Name,
+		}
+		for _, idx := range key.Columns {
+			def.ColNames = append(def.ColNames, schema.ColDefs[idx].Name)
+		}
+		defs = append(defs, def)
+	}
+	for _, check := range schema.Checks {
+		defs = append(defs, &engine.CheckDef{Name: check.Name, Expr: check.Expr})
+	}
+	for _, fk := range schema.ForeignKeys {
+		defs = append(defs, &engine.ForeignKeyDef{
+			Name:        fk.Name,
+			Cols:        fk.Cols,
+			ParentTable: fk.ParentTable,
+			ParentCols:  fk.ParentCols,
+			OnDelete:    engine.ForeignKeyAction(fk.OnDelete),
+			OnUpdate:    engine.ForeignKeyAction(fk.OnUpdate),
+		})
+	}
+	if schema.Partition != nil {
+		defs = append(defs, infoToPartitionDef(schema.Partition))
+	}
 	return
 }
 
 func DefsToSchema(name string, defs []engine.TableDef) (schema *catalog.Schema, err error) {
 	schema = catalog.NewEmptySchema(name)
 	pkMap := make(map[string]int)
+	var uniqueDefs, fullTextDefs []*engine.IndexTableDef
+	var fkDefs []*engine.ForeignKeyDef
+	var partDef *engine.PartitionDef
 	for _, def := range defs {
 		if pkDef, ok := def.(*engine.PrimaryIndexDef); ok {
 			for i, name := range pkDef.Names {
@@ -81,10 +126,54 @@ func DefsToSchema(name string, defs []engine.TableDef) (schema *catalog.Schema,
 					schema.Comment = property.Value
 				}
 			}
+		case *engine.ViewDef:
+			schema.ViewDef = defVal.View
+		case *engine.IndexTableDef:
+			switch defVal.Typ {
+			case engine.Unique:
+				uniqueDefs = append(uniqueDefs, defVal)
+			case engine.FullText:
+				fullTextDefs = append(fullTextDefs, defVal)
+			}
+		case *engine.CheckDef:
+			if err = schema.AppendCheck(defVal.Name, defVal.Expr); err != nil {
+				return
+			}
+		case *engine.ForeignKeyDef:
+			fkDefs = append(fkDefs, defVal)
+		case *engine.PartitionDef:
+			partDef = defVal
 		default:
 			// We will not deal with other cases for the time being
 		}
 	}
+	for _, def := range uniqueDefs {
+		if err = schema.AppendUniqueKey(def.Name, def.ColNames...); err != nil {
+			return
+		}
+	}
+	for _, def := range fullTextDefs {
+		if err = schema.AppendFullTextKey(def.Name, def.ColNames...); err != nil {
+			return
+		}
+	}
+	for _, def := range fkDefs {
+		if err = schema.AppendForeignKey(&catalog.ForeignKeyInfo{
+			Name:        def.Name,
+			Cols:        def.Cols,
+			ParentTable: def.ParentTable,
+			ParentCols:  def.ParentCols,
+			OnDelete:    int8(def.OnDelete),
+			OnUpdate:    int8(def.OnUpdate),
+		}); err != nil {
+			return
+		}
+	}
+	if partDef != nil {
+		if err = schema.SetPartition(partitionDefToInfo(partDef)); err != nil {
+			return
+		}
+	}
 	if err = schema.Finalize(false); err != nil {
 		return
 	}
@@ -93,3 +182,31 @@ func DefsToSchema(name string, defs []engine.TableDef) (schema *catalog.Schema,
 	}
 	return
 }
+
+func partitionDefToInfo(def *engine.PartitionDef) *catalog.PartitionInfo {
+	info := &catalog.PartitionInfo{
+		Type: int8(def.Typ),
+		Func: def.Func,
+		Cols: def.Cols,
+	}
+	for _, part := range def.Partitions {
+		info.Names = append(info.Names, part.Name)
+		info.Values = append(info.Values, part.Values)
+	}
+	return info
+}
+
+func infoToPartitionDef(info *catalog.PartitionInfo) *engine.PartitionDef {
+	def := &engine.PartitionDef{
+		Typ:  engine.PartitionType(info.Type),
+		Func: info.Func,
+		Cols: info.Cols,
+	}
+	for i, name := range info.Names {
+		def.Partitions = append(def.Partitions, engine.Partition{
+			Name:   name,
+			Values: info.Values[i],
+		})
+	}
+	return def
+}
@@ -0,0 +1,246 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/types"
+)
+
+var (
+	_ engine.KeyRelation = (*txnRelation)(nil)
+	_ engine.KeyRelation = (*partitionRelation)(nil)
+	_ engine.Reader      = (*keyReader)(nil)
+)
+
+// keyBlock is a block that may contain the keys with the indexes of the key
+// columns in its table
+type keyBlock struct {
+	handle handle.Block
+	cols   []int
+}
+
+// keyScan is the blocks shared by the readers of a key lookup
+type keyScan struct {
+	sync.Mutex
+	keys   map[string]struct{}
+	blocks []*keyBlock
+	pos    int
+}
+
+type keyReader struct {
+	scan   *keyScan
+	buffer []*bytes.Buffer
+	zs     []int64
+}
+
+func (rel *baseRelation) NewKeyReader(num int, keys *batch.Batch, _ engine.Snapshot) ([]engine.Reader, error) {
+	return newKeyReaders(num, []handle.Relation{rel.handle}, keys)
+}
+
+// NewKeyReader looks up the keys in all the partitions
+func (rel *partitionRelation) NewKeyReader(num int, keys *batch.Batch, _ engine.Snapshot) ([]engine.Reader, error) {
+	handles := make([]handle.Relation, len(rel.parts))
+	for i, part := range rel.parts {
+		handles[i] = part.handle
+	}
+	return newKeyReaders(num, handles, keys)
+}
+
+func newKeyReaders(num int, handles []handle.Relation, keys *batch.Batch) ([]engine.Reader, error) {
+	scan, err := newKeyScan(handles, keys)
+	if err != nil {
+		return nil, err
+	}
+	rds := make([]engine.Reader, num)
+	for i := range rds {
+		rds[i] = &keyReader{scan: scan}
+	}
+	return rds, nil
+}
+
+// newKeyScan encodes the keys and keeps the blocks whose key index may
+// contain any of them
+func newKeyScan(handles []handle.Relation, keys *batch.Batch) (*keyScan, error) {
+	scan := &keyScan{keys: encodeKeys(keys)}
+	if len(scan.keys) == 0 {
+		return scan, nil
+	}
+	for _, h := range handles {
+		schema := h.GetMeta().(*catalog.TableEntry).GetSchema()
+		cols, err := keyColumns(schema, keys.Attrs)
+		if err != nil {
+			return nil, err
+		}
+		// The hidden keys tell their blocks
+		var blkIds map[uint64]bool
+		if len(cols) == 1 && cols[0] == schema.HiddenKey.Idx {
+			blkIds = hiddenKeyBlocks(keys.Vecs[0])
+		}
+		it := h.MakeBlockIt()
+		for ; it.Valid(); it.Next() {
+			blk := it.GetBlock()
+			found := blkIds[blk.ID()]
+			if blkIds == nil {
+				if found, err = blk.MayContainKeys(cols, scan.keys); err != nil {
+					return nil, err
+				}
+			}
+			if found {
+				scan.blocks = append(scan.blocks, &keyBlock{handle: blk, cols: cols})
+			}
+		}
+	}
+	return scan, nil
+}
+
+// keyColumns returns the indexes of the key columns
+func keyColumns(schema *catalog.Schema, attrs []string) ([]int, error) {
+	cols := make([]int, len(attrs))
+	for i, attr := range attrs {
+		if cols[i] = schema.GetColIdx(attr); cols[i] < 0 {
+			return nil, fmt.Errorf("tae moengine: column %s not found", attr)
+		}
+	}
+	return cols, nil
+}
+
+func hiddenKeyBlocks(vec *vector.Vector) map[uint64]bool {
+	blkIds := make(map[uint64]bool)
+	for i, key := range vector.MustTCols[types.Decimal128](vec) {
+		if nulls.Contains(vec.Nsp, uint64(i)) {
+			continue
+		}
+		_, blkId, _ := model.DecodeHiddenKey(types.EncodeFixed(key))
+		blkIds[blkId] = true
+	}
+	return blkIds
+}
+
+func encodeKeys(bat *batch.Batch) map[string]struct{} {
+	keys := make(map[string]struct{})
+	if len(bat.Vecs) == 0 {
+		return keys
+	}
+	vecs := make([]containers.Vector, len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		vecs[i] = MOToVectorTmp(vec, true)
+	}
+	var w bytes.Buffer
+	for row := 0; row < vecs[0].Length(); row++ {
+		if key, ok := model.EncodeKey(vecs, row, &w); ok {
+			keys[key] = struct{}{}
+		}
+	}
+	closeVectors(vecs)
+	return keys
+}
+
+func closeVectors(vecs []containers.Vector) {
+	for _, vec := range vecs {
+		if vec != nil {
+			vec.Close()
+		}
+	}
+}
+
+func (scan *keyScan) nextBlock() *keyBlock {
+	scan.Lock()
+	defer scan.Unlock()
+	if scan.pos >= len(scan.blocks) {
+		return nil
+	}
+	blk := scan.blocks[scan.pos]
+	scan.blocks[scan.pos] = nil
+	scan.pos++
+	return blk
+}
+
+// matchRows returns the rows of the block having any of the keys. The
+// deleted rows are removed first as the columns read skip them too
+func (scan *keyScan) matchRows(blk *keyBlock) ([]int, error) {
+	vecs := make([]containers.Vector, len(blk.cols))
+	defer closeVectors(vecs)
+	for i, col := range blk.cols {
+		view, err := blk.handle.GetColumnDataById(col, nil)
+		if err != nil {
+			if view != nil {
+				view.Close()
+			}
+			return nil, err
+		}
+		view.ApplyDeletes()
+		vecs[i] = view.Orphan()
+	}
+	var rows []int
+	var w bytes.Buffer
+	for row := 0; row < vecs[0].Length(); row++ {
+		key, ok := model.EncodeKey(vecs, row, &w)
+		if !ok {
+			continue
+		}
+		if _, ok = scan.keys[key]; ok {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (r *keyReader) Read(_ []uint64, attrs []string) (*batch.Batch, error) {
+	if r.buffer == nil {
+		r.buffer = make([]*bytes.Buffer, len(attrs))
+		for i := range r.buffer {
+			r.buffer[i] = new(bytes.Buffer)
+		}
+	}
+	for {
+		blk := r.scan.nextBlock()
+		if blk == nil {
+			return nil, nil
+		}
+		rows, err := r.scan.matchRows(blk)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			continue
+		}
+		bat, err := newBlock(blk.handle).Read(nil, attrs, nil, r.buffer)
+		if err != nil {
+			return nil, err
+		}
+		bat = selectRows(bat, rows)
+		n := vector.Length(bat.Vecs[0])
+		if n > cap(r.zs) {
+			r.zs = make([]int64, n)
+		}
+		bat.Zs = r.zs[:n]
+		for i := 0; i < n; i++ {
+			bat.Zs[i] = 1
+		}
+		return bat, nil
+	}
+}
@@ -0,0 +1,497 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/types"
+)
+
+var (
+	_ engine.Relation = (*partitionRelation)(nil)
+	_ engine.Reader   = (*partitionReader)(nil)
+)
+
+func newPartitionRelation(db handle.Database, h handle.Relation) (*partitionRelation, error) {
+	rel := &partitionRelation{db: db}
+	rel.handle = h
+	rel.nodes = append(rel.nodes, engine.Node{
+		Addr: ADDR,
+	})
+	schema := rel.schema()
+	if err := rel.openPartitions(schema.Name, schema.Partition.Names); err != nil {
+		return nil, err
+	}
+	return rel, nil
+}
+
+func (rel *partitionRelation) schema() *catalog.Schema {
+	return rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+}
+
+func (rel *partitionRelation) openPartitions(table string, names []string) error {
+	rel.parts = rel.parts[:0]
+	for _, name := range names {
+		h, err := rel.db.GetRelationByName(engine.PartitionTableName(table, name))
+		if err != nil {
+			return err
+		}
+		rel.parts = append(rel.parts, newRelation(h))
+	}
+	return nil
+}
+
+func (rel *partitionRelation) partitioner() (*engine.Partitioner, *engine.PartitionDef, error) {
+	schema := rel.schema()
+	def := infoToPartitionDef(schema.Partition)
+	typs := make([]types.Type, len(def.Cols))
+	for i, col := range def.Cols {
+		typs[i] = schema.ColDefs[schema.GetColIdx(col)].Type
+	}
+	p, err := engine.NewPartitioner(def, typs)
+	return p, def, err
+}
+
+// segmentOwners maps the segments to the partitions storing them, the
+// segment ids are unique among all the tables
+func (rel *partitionRelation) segmentOwners() map[uint64]int {
+	owners := make(map[uint64]int)
+	for i, part := range rel.parts {
+		it := part.handle.MakeSegmentIt()
+		for it.Valid() {
+			owners[it.GetSegment().GetID()] = i
+			it.Next()
+		}
+	}
+	return owners
+}
+
+func (rel *partitionRelation) Rows() (rows int64) {
+	for _, part := range rel.parts {
+		rows += part.Rows()
+	}
+	return
+}
+
+func (rel *partitionRelation) Write(ts uint64, bat *batch.Batch, snapshot engine.Snapshot) error {
+	if len(bat.Vecs) == 0 {
+		return nil
+	}
+	p, def, err := rel.partitioner()
+	if err != nil {
+		return err
+	}
+	cols := make([]func(int) any, len(def.Cols))
+	for i, col := range def.Cols {
+		if idx := attrIndex(bat.Attrs, col); idx >= 0 {
+			cols[i] = partitionColumn(bat.Vecs[idx])
+		}
+	}
+	n := vector.Length(bat.Vecs[0])
+	sels := make([][]int, len(rel.parts))
+	vals := make([]interface{}, len(cols))
+	for row := 0; row < n; row++ {
+		for i, col := range cols {
+			vals[i] = nil
+			if col != nil {
+				vals[i] = col(row)
+			}
+		}
+		i := p.Locate(vals)
+		if i < 0 {
+			return moerr.NewNoPartitionForValue(engine.FormatPartitionValue(vals[0]))
+		}
+		sels[i] = append(sels[i], row)
+	}
+	return rel.forEachPartition(bat, sels, func(part *txnRelation, bat *batch.Batch) error {
+		return part.Write(ts, bat, snapshot)
+	})
+}
+
+func (rel *partitionRelation) Update(ts uint64, bat *batch.Batch, snapshot engine.Snapshot) error {
+	schema := rel.schema()
+	keyIdx := attrIndex(bat.Attrs, schema.HiddenKey.Name)
+	p, def, err := rel.partitioner()
+	if err != nil {
+		return err
+	}
+	// A row changing its partition columns may be moved to another partition
+	cols := make([]func(int) any, len(def.Cols))
+	repartition := false
+	for i, col := range def.Cols {
+		if idx := attrIndex(bat.Attrs, col); idx >= 0 {
+			cols[i] = partitionColumn(bat.Vecs[idx])
+			repartition = true
+		}
+	}
+	owners := rel.segmentOwners()
+	keys := vector.MustTCols[types.Decimal128](bat.Vecs[keyIdx])
+	sels := make([][]int, len(rel.parts))
+	vals := make([]interface{}, len(cols))
+	for row, key := range keys {
+		segmentId, _, _ := model.DecodeHiddenKey(types.EncodeFixed(key))
+		from, ok := owners[segmentId]
+		if !ok {
+			return fmt.Errorf("tae moengine: segment %d not found in the partitions of %s", segmentId, schema.Name)
+		}
+		if repartition {
+			part := rel.parts[from]
+			partSchema := part.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+			for i, col := range cols {
+				if col != nil {
+					vals[i] = col(row)
+				} else if vals[i], err = part.handle.GetValueByHiddenKey(key, partSchema.GetColIdx(def.Cols[i])); err != nil {
+					return err
+				}
+			}
+			to := p.Locate(vals)
+			if to < 0 {
+				return moerr.NewNoPartitionForValue(engine.FormatPartitionValue(vals[0]))
+			}
+			if to != from {
+				updates := make(map[int]any, len(bat.Attrs))
+				for i, attr := range bat.Attrs {
+					if i != keyIdx {
+						updates[partSchema.GetColIdx(attr)] = vector.GetValue(bat.Vecs[i], row)
+					}
+				}
+				values, err := part.readRow(partSchema, key, updates)
+				if err != nil {
+					return err
+				}
+				if err = part.handle.DeleteByHiddenKey(key); err != nil {
+					return err
+				}
+				if err = rel.parts[to].appendRow(values); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		sels[from] = append(sels[from], row)
+	}
+	return rel.forEachPartition(bat, sels, func(part *txnRelation, bat *batch.Batch) error {
+		return part.Update(ts, bat, snapshot)
+	})
+}
+
+func (rel *partitionRelation) Delete(ts uint64, data *vector.Vector, col string, snapshot engine.Snapshot) error {
+	n := vector.Length(data)
+	sels := make([][]int, len(rel.parts))
+	if col == rel.schema().HiddenKey.Name {
+		owners := rel.segmentOwners()
+		for row, key := range vector.MustTCols[types.Decimal128](data) {
+			segmentId, _, _ := model.DecodeHiddenKey(types.EncodeFixed(key))
+			if i, ok := owners[segmentId]; ok {
+				sels[i] = append(sels[i], row)
+			}
+		}
+	} else {
+		// The primary key includes the partition columns, a single column
+		// primary key is the partition column
+		p, _, err := rel.partitioner()
+		if err != nil {
+			return err
+		}
+		values := partitionColumn(data)
+		for row := 0; row < n; row++ {
+			if i := p.Locate([]interface{}{values(row)}); i >= 0 {
+				sels[i] = append(sels[i], row)
+			}
+		}
+	}
+	bat := batch.New(true, []string{col})
+	bat.Vecs[0] = data
+	return rel.forEachPartition(bat, sels, func(part *txnRelation, bat *batch.Batch) error {
+		return part.Delete(ts, bat.Vecs[0], col, snapshot)
+	})
+}
+
+// forEachPartition applies fn on the rows of bat selected for each partition
+func (rel *partitionRelation) forEachPartition(bat *batch.Batch, sels [][]int, fn func(*txnRelation, *batch.Batch) error) error {
+	for i, rows := range sels {
+		if len(rows) == 0 {
+			continue
+		}
+		partBat := bat
+		if len(rows) < vector.Length(bat.Vecs[0]) {
+			partBat = selectRows(bat, rows)
+		}
+		if err := fn(rel.parts[i], partBat); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rel *partitionRelation) Truncate(snapshot engine.Snapshot) (rows uint64, err error) {
+	for _, part := range rel.parts {
+		var n uint64
+		if n, err = part.Truncate(snapshot); err != nil {
+			return
+		}
+		rows += n
+	}
+	return
+}
+
+func (rel *partitionRelation) AddTableDef(ts uint64, def engine.TableDef, snapshot engine.Snapshot) error {
+	switch d := def.(type) {
+	case *engine.PartitionDef:
+		return rel.repartition(d)
+	case *engine.RenameTableDef:
+		schema := rel.schema()
+		for i, part := range rel.parts {
+			name := engine.PartitionTableName(d.Name, schema.Partition.Names[i])
+			if err := part.AddTableDef(ts, &engine.RenameTableDef{Name: name}, snapshot); err != nil {
+				return err
+			}
+		}
+	default:
+		for _, part := range rel.parts {
+			if err := part.AddTableDef(ts, def, snapshot); err != nil {
+				return err
+			}
+		}
+	}
+	return rel.txnRelation.AddTableDef(ts, def, snapshot)
+}
+
+func (rel *partitionRelation) DelTableDef(ts uint64, def engine.TableDef, snapshot engine.Snapshot) error {
+	for _, part := range rel.parts {
+		if err := part.DelTableDef(ts, def, snapshot); err != nil {
+			return err
+		}
+	}
+	return rel.txnRelation.DelTableDef(ts, def, snapshot)
+}
+
+// repartition replaces the partitions of the relation with def, the rows of
+// the dropped partitions are dropped with them
+func (rel *partitionRelation) repartition(def *engine.PartitionDef) error {
+	schema := rel.schema()
+	olds := make(map[string]bool, len(schema.Partition.Names))
+	for _, name := range schema.Partition.Names {
+		olds[name] = true
+	}
+	news := make(map[string]bool, len(def.Partitions))
+	var adds []engine.Partition
+	for _, part := range def.Partitions {
+		news[part.Name] = true
+		if !olds[part.Name] {
+			adds = append(adds, part)
+		}
+	}
+	for _, name := range schema.Partition.Names {
+		if news[name] {
+			continue
+		}
+		if _, err := rel.db.DropRelationByName(engine.PartitionTableName(schema.Name, name)); err != nil {
+			return err
+		}
+	}
+	if len(adds) > 0 {
+		defs, err := SchemaToDefs(schema)
+		if err != nil {
+			return err
+		}
+		if err = newDatabase(rel.db).createPartitions(schema.Name, defs, adds); err != nil {
+			return err
+		}
+	}
+	err := rel.handle.Alter(func(schema any) error {
+		return schema.(*catalog.Schema).SetPartition(partitionDefToInfo(def))
+	})
+	if err != nil {
+		return err
+	}
+	names := make([]string, len(def.Partitions))
+	for i, part := range def.Partitions {
+		names[i] = part.Name
+	}
+	// The schema change is visible after commit
+	return rel.openPartitions(schema.Name, names)
+}
+
+func (rel *partitionRelation) NewReader(num int, expr *plan.Expr, payload []byte, snapshot engine.Snapshot) []engine.Reader {
+	rds := make([]engine.Reader, num)
+	for i := range rds {
+		rds[i] = &partitionReader{}
+	}
+	for _, part := range rel.parts {
+		for i, r := range part.NewReader(num, expr, payload, snapshot) {
+			rd := rds[i].(*partitionReader)
+			rd.readers = append(rd.readers, r)
+		}
+	}
+	return rds
+}
+
+func (r *partitionReader) Read(refCount []uint64, attrs []string) (*batch.Batch, error) {
+	for len(r.readers) > 0 {
+		bat, err := r.readers[0].Read(refCount, attrs)
+		if bat != nil || err != nil {
+			return bat, err
+		}
+		r.readers = r.readers[1:]
+	}
+	return nil, nil
+}
+
+// selectRows returns a batch of the rows of bat
+func selectRows(bat *batch.Batch, rows []int) *batch.Batch {
+	sel := batch.New(true, bat.Attrs)
+	for i, vec := range bat.Vecs {
+		sel.Vecs[i] = selectVector(vec, rows)
+	}
+	return sel
+}
+
+func selectVector(vec *vector.Vector, rows []int) *vector.Vector {
+	sel := vector.New(vec.Typ)
+	switch vec.Typ.Oid {
+	case types.Type_BOOL:
+		sel.Col = selectFixed(vec.Col.([]bool), rows)
+	case types.Type_INT8:
+		sel.Col = selectFixed(vec.Col.([]int8), rows)
+	case types.Type_INT16:
+		sel.Col = selectFixed(vec.Col.([]int16), rows)
+	case types.Type_INT32:
+		sel.Col = selectFixed(vec.Col.([]int32), rows)
+	case types.Type_INT64:
+		sel.Col = selectFixed(vec.Col.([]int64), rows)
+	case types.Type_UINT8:
+		sel.Col = selectFixed(vec.Col.([]uint8), rows)
+	case types.Type_UINT16, types.Type_ENUM:
+		sel.Col = selectFixed(vec.Col.([]uint16), rows)
+	case types.Type_UINT32:
+		sel.Col = selectFixed(vec.Col.([]uint32), rows)
+	case types.Type_UINT64, types.Type_SET, types.Type_BIT:
+		sel.Col = selectFixed(vec.Col.([]uint64), rows)
+	case types.Type_FLOAT32:
+		sel.Col = selectFixed(vec.Col.([]float32), rows)
+	case types.Type_FLOAT64:
+		sel.Col = selectFixed(vec.Col.([]float64), rows)
+	case types.Type_DATE:
+		sel.Col = selectFixed(vec.Col.([]types.Date), rows)
+	case types.Type_DATETIME:
+		sel.Col = selectFixed(vec.Col.([]types.Datetime), rows)
+	case types.Type_TIMESTAMP:
+		sel.Col = selectFixed(vec.Col.([]types.Timestamp), rows)
+	case types.Type_DECIMAL64:
+		sel.Col = selectFixed(vec.Col.([]types.Decimal64), rows)
+	case types.Type_DECIMAL128:
+		sel.Col = selectFixed(vec.Col.([]types.Decimal128), rows)
+	case types.Type_UUID:
+		sel.Col = selectFixed(vec.Col.([]types.Uuid), rows)
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
+		data := vec.Col.(*types.Bytes)
+		col := &types.Bytes{
+			Offsets: make([]uint32, len(rows)),
+			Lengths: make([]uint32, len(rows)),
+		}
+		for i, row := range rows {
+			col.Offsets[i] = uint32(len(col.Data))
+			col.Lengths[i] = data.Lengths[row]
+			col.Data = append(col.Data, data.Get(int64(row))...)
+		}
+		sel.Col = col
+	default:
+		panic(any(fmt.Errorf("%s not supported", vec.Typ.String())))
+	}
+	for i, row := range rows {
+		if nulls.Contains(vec.Nsp, uint64(row)) {
+			nulls.Add(sel.Nsp, uint64(i))
+		}
+	}
+	return sel
+}
+
+func selectFixed[T any](col []T, rows []int) []T {
+	sel := make([]T, len(rows))
+	for i, row := range rows {
+		sel[i] = col[row]
+	}
+	return sel
+}
+
+// partitionColumn returns the function giving the value of a row of the
+// partition column vec as the Partitioner takes it, nil for null
+func partitionColumn(vec *vector.Vector) func(int) any {
+	switch vec.Typ.Oid {
+	case types.Type_INT8:
+		return partitionFixedColumn(vec, vec.Col.([]int8))
+	case types.Type_INT16:
+		return partitionFixedColumn(vec, vec.Col.([]int16))
+	case types.Type_INT32:
+		return partitionFixedColumn(vec, vec.Col.([]int32))
+	case types.Type_INT64:
+		return partitionFixedColumn(vec, vec.Col.([]int64))
+	case types.Type_UINT8:
+		return partitionFixedColumn(vec, vec.Col.([]uint8))
+	case types.Type_UINT16:
+		return partitionFixedColumn(vec, vec.Col.([]uint16))
+	case types.Type_UINT32:
+		return partitionFixedColumn(vec, vec.Col.([]uint32))
+	case types.Type_UINT64:
+		return partitionFixedColumn(vec, vec.Col.([]uint64))
+	case types.Type_DATE:
+		return partitionFixedColumn(vec, vec.Col.([]types.Date))
+	case types.Type_DATETIME:
+		return partitionFixedColumn(vec, vec.Col.([]types.Datetime))
+	case types.Type_TIMESTAMP:
+		return partitionFixedColumn(vec, vec.Col.([]types.Timestamp))
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_TEXT:
+		data := vec.Col.(*types.Bytes)
+		return func(row int) any {
+			if nulls.Contains(vec.Nsp, uint64(row)) {
+				return nil
+			}
+			return data.Get(int64(row))
+		}
+	}
+	return func(row int) any {
+		return vector.GetValue(vec, row)
+	}
+}
+
+func partitionFixedColumn[T any](vec *vector.Vector, col []T) func(int) any {
+	return func(row int) any {
+		if nulls.Contains(vec.Nsp, uint64(row)) {
+			return nil
+		}
+		return col[row]
+	}
+}
+
+func attrIndex(attrs []string, name string) int {
+	for i, attr := range attrs {
+		if attr == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -115,11 +115,15 @@ func (rel *baseRelation) GetPriKeyOrHideKey(_ engine.Snapshot) ([]engine.Attribu
 
 func (rel *baseRelation) Attribute() []engine.Attribute {
 	meta := rel.handle.GetMeta().(*catalog.TableEntry)
-	attrs := make([]engine.Attribute, len(meta.GetSchema().ColDefs))
-	for idx, attr := range attrs {
-		attr.Name = meta.GetSchema().ColDefs[idx].Name
-		attr.Type = meta.GetSchema().ColDefs[idx].Type
-		attrs[idx] = attr
+	attrs := make([]engine.Attribute, 0, len(meta.GetSchema().ColDefs))
+	for _, def := range meta.GetSchema().ColDefs {
+		if def.IsDropped() {
+			continue
+		}
+		attrs = append(attrs, engine.Attribute{
+			Name: def.Name,
+			Type: def.Type,
+		})
 	}
 	return attrs
 }
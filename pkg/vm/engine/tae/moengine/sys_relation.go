@@ -59,3 +59,11 @@ func (s *sysRelation) Delete(_ uint64, _ *vector.Vector, _ string, _ engine.Snap
 func (s *sysRelation) Truncate(_ engine.Snapshot) (uint64, error) {
 	return 0, ErrReadOnly
 }
+
+func (s *sysRelation) AddTableDef(_ uint64, _ engine.TableDef, _ engine.Snapshot) error {
+	return ErrReadOnly
+}
+
+func (s *sysRelation) DelTableDef(_ uint64, _ engine.TableDef, _ engine.Snapshot) error {
+	return ErrReadOnly
+}
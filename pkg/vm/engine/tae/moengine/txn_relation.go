@@ -15,6 +15,8 @@
 package moengine
 
 import (
+	"sort"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
@@ -22,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/types"
 )
@@ -41,41 +44,83 @@ func newRelation(h handle.Relation) *txnRelation {
 
 func (rel *txnRelation) Write(_ uint64, bat *batch.Batch, _ engine.Snapshot) error {
 	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
-	allNullables := schema.AllNullables()
 	taeBatch := containers.NewEmptyBatch()
 	defer taeBatch.Close()
-	for i, vec := range bat.Vecs {
-		v := MOToVectorTmp(vec, allNullables[i])
-		//v := MOToVector(vec, allNullables[i])
-		taeBatch.AddVector(bat.Attrs[i], v)
+	rows := 0
+	if len(bat.Vecs) > 0 {
+		rows = vector.Length(bat.Vecs[0])
+	}
+	// The batch is laid out by the column indexes of the schema. Columns not
+	// written, such as the dropped ones, are filled with their defaults
+	attrIdx := make(map[string]int, len(bat.Attrs))
+	for i, attr := range bat.Attrs {
+		attrIdx[attr] = i
+	}
+	for _, def := range schema.ColDefs {
+		if def.IsHidden() {
+			continue
+		}
+		if i, ok := attrIdx[def.Name]; ok && !def.IsDropped() {
+			v := MOToVectorTmp(bat.Vecs[i], def.Nullable())
+			//v := MOToVector(bat.Vecs[i], def.Nullable())
+			taeBatch.AddVector(def.Name, v)
+			continue
+		}
+		v := containers.MakeVector(def.Type, def.Nullable())
+		val := def.DefaultValue()
+		for j := 0; j < rows; j++ {
+			v.Append(val)
+		}
+		taeBatch.AddVector(def.Name, v)
 	}
 	return rel.handle.Append(taeBatch)
 }
 
-func (rel *txnRelation) Update(_ uint64, data *batch.Batch, _ engine.Snapshot) error {
+func (rel *txnRelation) Update(_ uint64, mobat *batch.Batch, _ engine.Snapshot) error {
 	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
 	allNullables := schema.AllNullables()
 	bat := containers.NewEmptyBatch()
 	defer bat.Close()
-	for i, vec := range data.Vecs {
-		idx := catalog.GetAttrIdx(schema.AllNames(), data.Attrs[i])
+	for i, vec := range mobat.Vecs {
+		idx := catalog.GetAttrIdx(schema.AllNames(), mobat.Attrs[i])
 		if vec.Typ.Oid == types.Type_ANY {
 			vec.Typ = schema.ColDefs[idx].Type
 			logutil.Warn("[Moengine]", common.OperationField("Update"),
 				common.OperandField("Col type is any"))
 		}
 		v := MOToVectorTmp(vec, allNullables[idx])
-		bat.AddVector(data.Attrs[i], v)
+		bat.AddVector(mobat.Attrs[i], v)
 	}
-	hiddenIdx := catalog.GetAttrIdx(data.Attrs, schema.HiddenKey.Name)
+	hiddenIdx := catalog.GetAttrIdx(mobat.Attrs, schema.HiddenKey.Name)
 	for idx := 0; idx < bat.Vecs[hiddenIdx].Length(); idx++ {
 		v := bat.Vecs[hiddenIdx].Get(idx)
+		updates := make(map[int]any, len(bat.Attrs))
+		colIdxes := make([]int, 0, len(bat.Attrs))
 		for i, attr := range bat.Attrs {
 			if schema.HiddenKey.Name == attr {
 				continue
 			}
 			colIdx := schema.GetColIdx(attr)
-			err := rel.handle.UpdateByHiddenKey(v, colIdx, bat.Vecs[i].Get(idx))
+			updates[colIdx] = bat.Vecs[i].Get(idx)
+			colIdxes = append(colIdxes, colIdx)
+		}
+		// A row changing its unique key is moved so that the new key is
+		// checked against the other rows on append
+		if updatesUniqueKey(schema, colIdxes) {
+			if err := rel.moveRow(schema, v, updates); err != nil {
+				return err
+			}
+			continue
+		}
+		// Columns added after the row was written come last. Update them
+		// first so that a row without them is moved before any change
+		sort.Sort(sort.Reverse(sort.IntSlice(colIdxes)))
+		for i, colIdx := range colIdxes {
+			err := rel.handle.UpdateByHiddenKey(v, colIdx, updates[colIdx])
+			if err == data.ErrColumnNotMaterialized && i == 0 {
+				err = rel.moveRow(schema, v, updates)
+				break
+			}
 			if err != nil {
 				return err
 			}
@@ -84,6 +129,68 @@ func (rel *txnRelation) Update(_ uint64, data *batch.Batch, _ engine.Snapshot) e
 	return nil
 }
 
+func updatesUniqueKey(schema *catalog.Schema, colIdxes []int) bool {
+	for _, colIdx := range colIdxes {
+		if schema.IsUniqueKeyColumn(colIdx) {
+			return true
+		}
+	}
+	return false
+}
+
+// moveRow updates a row stored in a block that misses some columns of the
+// table by deleting it and appending the updated row
+func (rel *txnRelation) moveRow(schema *catalog.Schema, key any, updates map[int]any) (err error) {
+	row, err := rel.readRow(schema, key, updates)
+	if err != nil {
+		return
+	}
+	if err = rel.handle.DeleteByHiddenKey(key); err != nil {
+		return
+	}
+	return rel.appendRow(row)
+}
+
+// readRow returns the values of a row by column name, the values in updates
+// take the place of the stored ones
+func (rel *txnRelation) readRow(schema *catalog.Schema, key any, updates map[int]any) (row map[string]any, err error) {
+	row = make(map[string]any, len(schema.ColDefs))
+	for _, def := range schema.ColDefs {
+		if def.IsHidden() || def.IsDropped() {
+			continue
+		}
+		v, ok := updates[def.Idx]
+		if !ok {
+			if v, err = rel.handle.GetValueByHiddenKey(key, def.Idx); err != nil {
+				return
+			}
+		}
+		row[def.Name] = v
+	}
+	return
+}
+
+// appendRow appends a row got by readRow, the missing columns are filled
+// with their defaults
+func (rel *txnRelation) appendRow(row map[string]any) error {
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	bat := containers.NewEmptyBatch()
+	defer bat.Close()
+	for _, def := range schema.ColDefs {
+		if def.IsHidden() {
+			continue
+		}
+		v, ok := row[def.Name]
+		if !ok || def.IsDropped() {
+			v = def.DefaultValue()
+		}
+		vec := containers.MakeVector(def.Type, def.Nullable())
+		vec.Append(v)
+		bat.AddVector(def.Name, vec)
+	}
+	return rel.handle.Append(bat)
+}
+
 func (rel *txnRelation) Delete(_ uint64, data *vector.Vector, col string, _ engine.Snapshot) error {
 	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
 	logutil.Debugf("Delete col: %v", col)
@@ -115,6 +222,34 @@ func (rel *txnRelation) Delete(_ uint64, data *vector.Vector, col string, _ engi
 	panic(any("Key not found"))
 }
 
+func (rel *txnRelation) AddTableDef(_ uint64, def engine.TableDef, _ engine.Snapshot) error {
+	var fn func(*catalog.Schema) error
+	switch d := def.(type) {
+	case *engine.AttributeDef:
+		fn = func(schema *catalog.Schema) error { return schema.AddColumn(d.Attr) }
+	case *engine.RenameColumnDef:
+		fn = func(schema *catalog.Schema) error { return schema.RenameColumn(d.OldName, d.NewName) }
+	case *engine.RenameTableDef:
+		fn = func(schema *catalog.Schema) error {
+			schema.Rename(d.Name)
+			return nil
+		}
+	default:
+		return nil
+	}
+	return rel.handle.Alter(func(schema any) error { return fn(schema.(*catalog.Schema)) })
+}
+
+func (rel *txnRelation) DelTableDef(_ uint64, def engine.TableDef, _ engine.Snapshot) error {
+	d, ok := def.(*engine.AttributeDef)
+	if !ok {
+		return nil
+	}
+	return rel.handle.Alter(func(schema any) error {
+		return schema.(*catalog.Schema).DropColumn(d.Attr.Name)
+	})
+}
+
 func (rel *txnRelation) Truncate(_ engine.Snapshot) (uint64, error) {
 	rows := uint64(rel.Rows())
 	name := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema().Name
@@ -54,6 +54,15 @@ type txnRelation struct {
 	baseRelation
 }
 
+// partitionRelation is a partitioned relation, the relation of its own
+// holds the schema and each partition stores its rows in a relation of its
+// own
+type partitionRelation struct {
+	txnRelation
+	db    handle.Database
+	parts []*txnRelation
+}
+
 type sysRelation struct {
 	baseRelation
 }
@@ -67,6 +76,11 @@ type txnBlock struct {
 	handle handle.Block
 }
 
+// partitionReader reads the partitions of a relation one by one
+type partitionReader struct {
+	readers []engine.Reader
+}
+
 type txnReader struct {
 	handle handle.Relation
 	it     handle.BlockIt
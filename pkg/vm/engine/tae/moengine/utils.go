@@ -80,7 +80,7 @@ func MockVec(typ types.Type, rows int, offset int) *vector.Vector {
 			data = append(data, uint8(i+offset))
 		}
 		_ = vector.Append(vec, data)
-	case types.Type_UINT16:
+	case types.Type_UINT16, types.Type_ENUM:
 		data := make([]uint16, 0)
 		for i := 0; i < rows; i++ {
 			data = append(data, uint16(i+offset))
@@ -92,7 +92,7 @@ func MockVec(typ types.Type, rows int, offset int) *vector.Vector {
 			data = append(data, uint32(i+offset))
 		}
 		_ = vector.Append(vec, data)
-	case types.Type_UINT64:
+	case types.Type_UINT64, types.Type_SET, types.Type_BIT:
 		data := make([]uint64, 0)
 		for i := 0; i < rows; i++ {
 			data = append(data, uint64(i+offset))
@@ -122,6 +122,12 @@ func MockVec(typ types.Type, rows int, offset int) *vector.Vector {
 			data = append(data, wtf.InitDecimal128(int64(i+offset)))
 		}
 		_ = vector.Append(vec, data)
+	case types.Type_UUID:
+		data := make([]types.Uuid, 0)
+		for i := 0; i < rows; i++ {
+			data = append(data, wtf.InitUuid(uint64(i+offset)))
+		}
+		_ = vector.Append(vec, data)
 	case types.Type_TIMESTAMP:
 		data := make([]types.Timestamp, 0)
 		for i := 0; i < rows; i++ {
@@ -140,7 +146,8 @@ func MockVec(typ types.Type, rows int, offset int) *vector.Vector {
 			data = append(data, types.Datetime(i+offset))
 		}
 		_ = vector.Append(vec, data)
-	case types.Type_CHAR, types.Type_VARCHAR:
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		data := make([][]byte, 0)
 		for i := 0; i < rows; i++ {
 			data = append(data, []byte(strconv.Itoa(i+offset)))
@@ -253,16 +260,18 @@ func AppendValue(vec *vector.Vector, v any) {
 		AppendFixedValue[int64](vec, v)
 	case types.Type_UINT8:
 		AppendFixedValue[uint8](vec, v)
-	case types.Type_UINT16:
+	case types.Type_UINT16, types.Type_ENUM:
 		AppendFixedValue[uint16](vec, v)
 	case types.Type_UINT32:
 		AppendFixedValue[uint32](vec, v)
-	case types.Type_UINT64:
+	case types.Type_UINT64, types.Type_SET, types.Type_BIT:
 		AppendFixedValue[uint64](vec, v)
 	case types.Type_DECIMAL64:
 		AppendFixedValue[types.Decimal64](vec, v)
 	case types.Type_DECIMAL128:
 		AppendFixedValue[types.Decimal128](vec, v)
+	case types.Type_UUID:
+		AppendFixedValue[types.Uuid](vec, v)
 	case types.Type_FLOAT32:
 		AppendFixedValue[float32](vec, v)
 	case types.Type_FLOAT64:
@@ -273,7 +282,8 @@ func AppendValue(vec *vector.Vector, v any) {
 		AppendFixedValue[types.Timestamp](vec, v)
 	case types.Type_DATETIME:
 		AppendFixedValue[types.Datetime](vec, v)
-	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON:
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		vvals := vec.Col.(*types.Bytes)
 		offset := len(vvals.Data)
 		var val []byte
@@ -315,13 +325,13 @@ func GetValue(col *vector.Vector, row uint32) any {
 	case types.Type_UINT8:
 		data := vals.([]uint8)
 		return data[row]
-	case types.Type_UINT16:
+	case types.Type_UINT16, types.Type_ENUM:
 		data := vals.([]uint16)
 		return data[row]
 	case types.Type_UINT32:
 		data := vals.([]uint32)
 		return data[row]
-	case types.Type_UINT64:
+	case types.Type_UINT64, types.Type_SET, types.Type_BIT:
 		data := vals.([]uint64)
 		return data[row]
 	case types.Type_DECIMAL64:
@@ -330,6 +340,9 @@ func GetValue(col *vector.Vector, row uint32) any {
 	case types.Type_DECIMAL128:
 		data := vals.([]types.Decimal128)
 		return data[row]
+	case types.Type_UUID:
+		data := vals.([]types.Uuid)
+		return data[row]
 	case types.Type_FLOAT32:
 		data := vals.([]float32)
 		return data[row]
@@ -345,7 +358,8 @@ func GetValue(col *vector.Vector, row uint32) any {
 	case types.Type_TIMESTAMP:
 		data := vals.([]types.Timestamp)
 		return data[row]
-	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON:
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		data := vals.(*types.Bytes)
 		s := data.Offsets[row]
 		e := data.Lengths[row]
@@ -371,16 +385,18 @@ func UpdateValue(col *vector.Vector, row uint32, val any) {
 		GenericUpdateFixedValue[int64](col, row, val)
 	case types.Type_UINT8:
 		GenericUpdateFixedValue[uint8](col, row, val)
-	case types.Type_UINT16:
+	case types.Type_UINT16, types.Type_ENUM:
 		GenericUpdateFixedValue[uint16](col, row, val)
 	case types.Type_UINT32:
 		GenericUpdateFixedValue[uint32](col, row, val)
-	case types.Type_UINT64:
+	case types.Type_UINT64, types.Type_SET, types.Type_BIT:
 		GenericUpdateFixedValue[uint64](col, row, val)
 	case types.Type_DECIMAL64:
 		GenericUpdateFixedValue[types.Decimal64](col, row, val)
 	case types.Type_DECIMAL128:
 		GenericUpdateFixedValue[types.Decimal128](col, row, val)
+	case types.Type_UUID:
+		GenericUpdateFixedValue[types.Uuid](col, row, val)
 	case types.Type_FLOAT32:
 		GenericUpdateFixedValue[float32](col, row, val)
 	case types.Type_FLOAT64:
@@ -391,7 +407,8 @@ func UpdateValue(col *vector.Vector, row uint32, val any) {
 		GenericUpdateFixedValue[types.Datetime](col, row, val)
 	case types.Type_TIMESTAMP:
 		GenericUpdateFixedValue[types.Timestamp](col, row, val)
-	case types.Type_VARCHAR, types.Type_CHAR, types.Type_JSON:
+	case types.Type_VARCHAR, types.Type_CHAR, types.Type_JSON, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		v := val.([]byte)
 		data := col.Col.(*types.Bytes)
 		tail := data.Data[data.Offsets[row]+data.Lengths[row]:]
@@ -514,7 +531,7 @@ func ApplyDeleteToVector(vec *vector.Vector, deletes *roaring.Bitmap) *vector.Ve
 	switch vec.Typ.Oid {
 	case types.Type_BOOL, types.Type_INT8, types.Type_INT16, types.Type_INT32, types.Type_INT64,
 		types.Type_UINT8, types.Type_UINT16, types.Type_UINT32, types.Type_UINT64,
-		types.Type_DECIMAL64, types.Type_DECIMAL128, types.Type_FLOAT32, types.Type_FLOAT64,
+		types.Type_DECIMAL64, types.Type_DECIMAL128, types.Type_UUID, types.Type_FLOAT32, types.Type_FLOAT64,
 		types.Type_DATE, types.Type_DATETIME, types.Type_TIMESTAMP:
 		vec.Col = compute.InplaceDeleteRows(vec.Col, deletesIterator)
 		deletesIterator = deletes.Iterator()
@@ -545,7 +562,8 @@ func ApplyDeleteToVector(vec *vector.Vector, deletes *roaring.Bitmap) *vector.Ve
 				np.Add(n - uint64(deleted))
 			}
 		}
-	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON:
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		data := col.(*types.Bytes)
 		pre := -1
 		for deletesIterator.HasNext() {
@@ -610,13 +628,14 @@ func ApplyUpdateToVector(vec *vector.Vector, mask *roaring.Bitmap, vals map[uint
 	switch vec.Typ.Oid {
 	case types.Type_BOOL, types.Type_INT8, types.Type_INT16, types.Type_INT32, types.Type_INT64,
 		types.Type_UINT8, types.Type_UINT16, types.Type_UINT32, types.Type_UINT64,
-		types.Type_DECIMAL64, types.Type_DECIMAL128, types.Type_FLOAT32, types.Type_FLOAT64,
+		types.Type_DECIMAL64, types.Type_DECIMAL128, types.Type_UUID, types.Type_FLOAT32, types.Type_FLOAT64,
 		types.Type_DATE, types.Type_DATETIME, types.Type_TIMESTAMP:
 		for iterator.HasNext() {
 			row := iterator.Next()
 			UpdateValue(vec, row, vals[row])
 		}
-	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON:
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		data := col.(*types.Bytes)
 		pre := -1
 		for iterator.HasNext() {
@@ -655,11 +674,11 @@ func MOToVector(v *vector.Vector, nullable bool) containers.Vector {
 		bs.Data = encoding.EncodeFixedSlice(v.Col.([]int64), 8)
 	case types.Type_UINT8:
 		bs.Data = encoding.EncodeFixedSlice(v.Col.([]uint8), 1)
-	case types.Type_UINT16:
+	case types.Type_UINT16, types.Type_ENUM:
 		bs.Data = encoding.EncodeFixedSlice(v.Col.([]uint16), 2)
 	case types.Type_UINT32:
 		bs.Data = encoding.EncodeFixedSlice(v.Col.([]uint32), 4)
-	case types.Type_UINT64:
+	case types.Type_UINT64, types.Type_SET, types.Type_BIT:
 		bs.Data = encoding.EncodeFixedSlice(v.Col.([]uint64), 8)
 	case types.Type_FLOAT32:
 		bs.Data = encoding.EncodeFixedSlice(v.Col.([]float32), 4)
@@ -675,7 +694,10 @@ func MOToVector(v *vector.Vector, nullable bool) containers.Vector {
 		bs.Data = encoding.EncodeFixedSlice(v.Col.([]types.Decimal64), 8)
 	case types.Type_DECIMAL128:
 		bs.Data = encoding.EncodeFixedSlice(v.Col.([]types.Decimal128), 16)
-	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON:
+	case types.Type_UUID:
+		bs.Data = encoding.EncodeFixedSlice(v.Col.([]types.Uuid), 16)
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		vbs := v.Col.(*types.Bytes)
 		bs.Data = vbs.Data
 		bs.Offset = vbs.Offsets
@@ -746,7 +768,7 @@ func MOToVectorTmp(v *vector.Vector, nullable bool) containers.Vector {
 		} else {
 			bs.Data = encoding.EncodeFixedSlice(v.Col.([]uint8), 1)
 		}
-	case types.Type_UINT16:
+	case types.Type_UINT16, types.Type_ENUM:
 		if v.Col == nil || len(v.Col.([]uint16)) == 0 {
 			bs.Data = make([]byte, v.Length*2)
 			logutil.Warn("[Moengine]", common.OperationField("MOToVector"),
@@ -762,7 +784,7 @@ func MOToVectorTmp(v *vector.Vector, nullable bool) containers.Vector {
 		} else {
 			bs.Data = encoding.EncodeFixedSlice(v.Col.([]uint32), 4)
 		}
-	case types.Type_UINT64:
+	case types.Type_UINT64, types.Type_SET, types.Type_BIT:
 		if v.Col == nil || len(v.Col.([]uint64)) == 0 {
 			bs.Data = make([]byte, v.Length*8)
 			logutil.Warn("[Moengine]", common.OperationField("MOToVector"),
@@ -826,7 +848,16 @@ func MOToVectorTmp(v *vector.Vector, nullable bool) containers.Vector {
 		} else {
 			bs.Data = encoding.EncodeFixedSlice(v.Col.([]types.Decimal128), 16)
 		}
-	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON:
+	case types.Type_UUID:
+		if v.Col == nil || len(v.Col.([]types.Uuid)) == 0 {
+			bs.Data = make([]byte, v.Length*16)
+			logutil.Warn("[Moengine]", common.OperationField("MOToVector"),
+				common.OperandField("Col length is 0"))
+		} else {
+			bs.Data = encoding.EncodeFixedSlice(v.Col.([]types.Uuid), 16)
+		}
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		if v.Col == nil {
 			bs.Data = make([]byte, 0)
 		} else {
@@ -864,7 +895,8 @@ func CopyToMoVector(vec containers.Vector) *vector.Vector {
 		_, _ = w.Write(types.EncodeFixed(uint32(0)))
 	}
 	switch vec.GetType().Oid {
-	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON:
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		_, _ = w.Write(types.EncodeFixed(uint32(vec.Length())))
 		if vec.Length() > 0 {
 			bs := vec.Bytes()
@@ -906,11 +938,11 @@ func VectorsToMO(vec containers.Vector) *vector.Vector {
 		mov.Col = encoding.DecodeInt64Slice(data)
 	case types.Type_UINT8:
 		mov.Col = encoding.DecodeUint8Slice(data)
-	case types.Type_UINT16:
+	case types.Type_UINT16, types.Type_ENUM:
 		mov.Col = encoding.DecodeUint16Slice(data)
 	case types.Type_UINT32:
 		mov.Col = encoding.DecodeUint32Slice(data)
-	case types.Type_UINT64:
+	case types.Type_UINT64, types.Type_SET, types.Type_BIT:
 		mov.Col = encoding.DecodeUint64Slice(data)
 	case types.Type_FLOAT32:
 		mov.Col = encoding.DecodeFloat32Slice(data)
@@ -926,6 +958,8 @@ func VectorsToMO(vec containers.Vector) *vector.Vector {
 		mov.Col = encoding.DecodeDecimal64Slice(data)
 	case types.Type_DECIMAL128:
 		mov.Col = encoding.DecodeDecimal128Slice(data)
+	case types.Type_UUID:
+		mov.Col = encoding.DecodeUuidSlice(data)
 	case types.Type_TUPLE:
 		cnt := encoding.DecodeInt32(data)
 		if cnt == 0 {
@@ -934,7 +968,8 @@ func VectorsToMO(vec containers.Vector) *vector.Vector {
 		if err := encoding.Decode(data, &mov.Col); err != nil {
 			panic(any(err))
 		}
-	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON:
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		Col := mov.Col.(*types.Bytes)
 		Col.Reset()
 		bs := vec.Bytes()
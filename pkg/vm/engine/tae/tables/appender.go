@@ -26,13 +26,23 @@ type blockAppender struct {
 	node        *appendableNode
 	placeholder uint32
 	rows        uint32
+	// bytes is the bytes of the rows in the block and the rows prepared to
+	// append, if the schema bounds the bytes of the block
+	bytes uint64
 }
 
-func newAppender(node *appendableNode) *blockAppender {
+func newAppender(node *appendableNode) (*blockAppender, error) {
 	appender := new(blockAppender)
 	appender.node = node
 	appender.rows = node.Rows(nil, true)
-	return appender
+	if node.isBytesBounded() {
+		bytes, err := node.Bytes()
+		if err != nil {
+			return nil, err
+		}
+		appender.bytes = bytes
+	}
+	return appender, nil
 }
 
 func (appender *blockAppender) GetMeta() any {
@@ -44,13 +54,26 @@ func (appender *blockAppender) GetID() *common.ID {
 }
 
 func (appender *blockAppender) IsAppendable() bool {
-	return appender.rows+appender.placeholder < appender.node.block.meta.GetSchema().BlockMaxRows
+	if appender.node.block.meta.IsSchemaOutdated() {
+		return false
+	}
+	schema := appender.node.block.meta.GetSchema()
+	if schema.BlockMaxBytes != 0 && appender.bytes >= schema.BlockMaxBytes {
+		return false
+	}
+	return appender.rows+appender.placeholder < schema.BlockMaxRows
 }
 
+// PrepareAppend reserves the space for at most rows rows in the block.
+// rowSize returns the bytes of the i-th row to append. If the schema bounds
+// the bytes of the block, the rows are taken until the block reaches the bound,
+// so that the bytes of the block exceed it by one row at most.
 func (appender *blockAppender) PrepareAppend(
 	rows uint32,
+	rowSize func(i uint32) uint64,
 	txn txnif.AsyncTxn) (node txnif.AppendNode, created bool, n uint32, err error) {
-	left := appender.node.block.meta.GetSchema().BlockMaxRows - appender.rows - appender.placeholder
+	schema := appender.node.block.meta.GetSchema()
+	left := schema.BlockMaxRows - appender.rows - appender.placeholder
 	if left == 0 {
 		// n = rows
 		return
@@ -60,6 +83,19 @@ func (appender *blockAppender) PrepareAppend(
 	} else {
 		n = rows
 	}
+	if schema.BlockMaxBytes != 0 {
+		if appender.bytes >= schema.BlockMaxBytes {
+			n = 0
+			return
+		}
+		for i := uint32(0); i < n; i++ {
+			appender.bytes += rowSize(i)
+			if appender.bytes >= schema.BlockMaxBytes {
+				n = i + 1
+				break
+			}
+		}
+	}
 	appender.placeholder += n
 	appender.node.block.mvcc.Lock()
 	defer appender.node.block.mvcc.Unlock()
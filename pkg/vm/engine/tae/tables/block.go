@@ -23,6 +23,7 @@ import (
 	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/stl"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
@@ -57,6 +58,8 @@ type dataBlock struct {
 	bufMgr    base.INodeManager
 	scheduler tasks.TaskScheduler
 	index     indexwrapper.Index
+	ftReaders map[int]*indexwrapper.FTReader
+	keyIdxes  map[string]*keyIndex
 	mvcc      *updates.MVCCHandle
 	nice      uint32
 	ckpTs     uint64
@@ -69,6 +72,11 @@ func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeM
 	if meta.GetSchema().HasSortKey() {
 		indexCnt[meta.GetSchema().SortKey.Defs[0].Idx] = 2
 	}
+	for _, idx := range meta.GetSchema().FullTextColumns() {
+		if idx < colCnt {
+			indexCnt[idx]++
+		}
+	}
 	file, err := segFile.OpenBlock(meta.GetID(), colCnt, indexCnt)
 	if err != nil {
 		panic(err)
@@ -91,6 +99,8 @@ func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeM
 		meta:      meta,
 		file:      file,
 		colFiles:  colFiles,
+		ftReaders: make(map[int]*indexwrapper.FTReader),
+		keyIdxes:  make(map[string]*keyIndex),
 		mvcc:      updates.NewMVCCHandle(meta),
 		scheduler: scheduler,
 		bufMgr:    bufMgr,
@@ -166,6 +176,11 @@ func (blk *dataBlock) Destroy() (err error) {
 			return
 		}
 	}
+	for _, reader := range blk.ftReaders {
+		if err = reader.Destroy(); err != nil {
+			return
+		}
+	}
 	if blk.file != nil {
 		if err = blk.file.Close(); err != nil {
 			return
@@ -195,8 +210,17 @@ func (blk *dataBlock) resetNice() {
 	atomic.StoreUint32(&blk.nice, uint32(0))
 }
 
+// isFull returns true if the appendable block reaches the max rows or the max bytes
+func (blk *dataBlock) isFull() bool {
+	schema := blk.meta.GetSchema()
+	if blk.Rows(nil, true) == int(schema.BlockMaxRows) {
+		return true
+	}
+	return schema.BlockMaxBytes != 0 && atomic.LoadUint64(&blk.node.bytes) >= schema.BlockMaxBytes
+}
+
 func (blk *dataBlock) estimateRawScore() int {
-	if blk.Rows(nil, true) == int(blk.meta.GetSchema().BlockMaxRows) && blk.meta.IsAppendable() {
+	if blk.meta.IsAppendable() && blk.isFull() {
 		return 100
 	}
 
@@ -258,7 +282,7 @@ func (blk *dataBlock) MutationInfo() string {
 }
 
 func (blk *dataBlock) EstimateScore() int {
-	if blk.meta.IsAppendable() && blk.Rows(nil, true) == int(blk.meta.GetSchema().BlockMaxRows) {
+	if blk.meta.IsAppendable() && blk.isFull() {
 		blk.meta.RLock()
 		if blk.meta.IsDroppedCommitted() || blk.meta.IsDroppedUncommitted() {
 			blk.meta.RUnlock()
@@ -289,7 +313,7 @@ func (blk *dataBlock) BuildCompactionTaskFactory() (
 	if dropped || inTxn {
 		return
 	}
-	if !blk.meta.IsAppendable() || (blk.meta.IsAppendable() && blk.Rows(nil, true) == int(blk.meta.GetSchema().BlockMaxRows)) {
+	if !blk.meta.IsAppendable() || (blk.meta.IsAppendable() && blk.isFull()) {
 		factory = jobs.CompactBlockTaskFactory(blk.meta, blk.scheduler)
 		taskType = tasks.DataCompactionTask
 	} else if blk.meta.IsAppendable() {
@@ -304,7 +328,10 @@ func (blk *dataBlock) IsAppendable() bool {
 	if !blk.meta.IsAppendable() {
 		return false
 	}
-	if blk.node.Rows(nil, true) == blk.meta.GetSegment().GetTable().GetSchema().BlockMaxRows {
+	if blk.isFull() {
+		return false
+	}
+	if blk.meta.IsSchemaOutdated() {
 		return false
 	}
 	return true
@@ -388,15 +415,59 @@ func (blk *dataBlock) MakeAppender() (appender data.BlockAppender, err error) {
 	if !blk.meta.IsAppendable() {
 		panic("can not create appender on non-appendable block")
 	}
-	appender = newAppender(blk.node)
-	return
+	return newAppender(blk.node)
+}
+
+// GetFullTextIndex returns the full-text index of a column persisted with
+// the block. It returns nil if the block is appendable or the column was
+// updated, the index does not cover the changed rows then
+func (blk *dataBlock) GetFullTextIndex(colIdx int) (*index.FullTextIndex, error) {
+	schema := blk.meta.GetSchema()
+	if blk.meta.IsAppendable() || colIdx >= blk.meta.GetColumnCnt() || !schema.IsFullTextColumn(colIdx) {
+		return nil, nil
+	}
+	chain := blk.mvcc.GetColumnChain(uint16(colIdx))
+	chain.RLock()
+	updated := chain.DepthLocked() > 0
+	chain.RUnlock()
+	if updated {
+		return nil, nil
+	}
+	blk.Lock()
+	reader := blk.ftReaders[colIdx]
+	if reader == nil {
+		colFile, err := blk.file.OpenColumn(colIdx)
+		if err != nil {
+			blk.Unlock()
+			return nil, err
+		}
+		slot := indexwrapper.FullTextIndexSlot(schema, colIdx)
+		idxFile, err := colFile.OpenIndexFile(slot)
+		colFile.Close()
+		if err != nil {
+			blk.Unlock()
+			return nil, err
+		}
+		if idxFile.Stat().Size() == 0 {
+			idxFile.Unref()
+			blk.Unlock()
+			return nil, nil
+		}
+		id := blk.meta.AsCommonID()
+		id.PartID = uint32(slot) + 1000
+		id.Idx = uint16(colIdx)
+		reader = indexwrapper.NewFTReader(blk.bufMgr, idxFile, id)
+		blk.ftReaders[colIdx] = reader
+	}
+	blk.Unlock()
+	return reader.GetIndex(), nil
 }
 
 func (blk *dataBlock) GetColumnDataByName(
 	txn txnif.AsyncTxn,
 	attr string,
 	buffer *bytes.Buffer) (view *model.ColumnView, err error) {
-	colIdx := blk.meta.GetSchema().GetColIdx(attr)
+	colIdx := blk.meta.GetSegment().GetTable().GetSchema().GetColIdx(attr)
 	return blk.GetColumnDataById(txn, colIdx, buffer)
 }
 
@@ -404,6 +475,9 @@ func (blk *dataBlock) GetColumnDataById(
 	txn txnif.AsyncTxn,
 	colIdx int,
 	buffer *bytes.Buffer) (view *model.ColumnView, err error) {
+	if colIdx >= blk.meta.GetColumnCnt() {
+		return blk.resolveMissingColumn(txn, colIdx)
+	}
 	if blk.meta.IsAppendable() {
 		return blk.ResolveABlkColumnMVCCData(txn.GetStartTS(), colIdx, buffer, false)
 	}
@@ -411,6 +485,72 @@ func (blk *dataBlock) GetColumnDataById(
 	return
 }
 
+// GetLatestColumnDataById makes the view of the column with the rows of the
+// committed and committing txns. It is only used by a txn being prepared
+func (blk *dataBlock) GetLatestColumnDataById(
+	txn txnif.AsyncTxn,
+	colIdx int,
+	buffer *bytes.Buffer) (view *model.ColumnView, err error) {
+	if colIdx >= blk.meta.GetColumnCnt() {
+		return blk.resolveMissingColumn(txn, colIdx)
+	}
+	view = model.NewColumnView(txn.GetStartTS(), colIdx)
+	if blk.meta.IsAppendable() {
+		blk.mvcc.RLock()
+		maxRow := blk.node.rows
+		blk.mvcc.RUnlock()
+		err = blk.node.DoWithPin(func() (err error) {
+			vec, err := blk.node.GetColumnDataCopy(maxRow, colIdx, buffer)
+			if err != nil {
+				return
+			}
+			view.SetData(vec)
+			return
+		})
+	} else {
+		var vec containers.Vector
+		if vec, err = blk.LoadColumnData(colIdx, buffer); err == nil {
+			view.SetData(vec)
+		}
+	}
+	if err != nil {
+		return
+	}
+
+	blk.mvcc.RLock()
+	err = blk.FillColumnUpdates(view)
+	if dnode := blk.mvcc.GetDeleteChain().CollectCommittingDeletesLocked(txn); dnode != nil {
+		view.DeleteMask = dnode.GetDeleteMaskLocked()
+	}
+	blk.mvcc.RUnlock()
+	if err != nil {
+		return
+	}
+	err = view.Eval(true)
+	return
+}
+
+// resolveMissingColumn makes the view of a column added to the table after
+// the block was created. All the visible rows read the column default
+func (blk *dataBlock) resolveMissingColumn(
+	txn txnif.AsyncTxn,
+	colIdx int) (view *model.ColumnView, err error) {
+	view, err = blk.GetColumnDataById(txn, blk.meta.GetSchema().HiddenKey.Idx, nil)
+	if err != nil || view == nil {
+		return
+	}
+	def := blk.meta.GetSegment().GetTable().GetSchema().ColDefs[colIdx]
+	vec := containers.MakeVector(def.Type, def.Nullable())
+	val := def.DefaultValue()
+	for i := 0; i < view.Length(); i++ {
+		vec.Append(val)
+	}
+	view.GetData().Close()
+	view.SetData(vec)
+	view.ColIdx = colIdx
+	return
+}
+
 func (blk *dataBlock) ResolveColumnMVCCData(
 	ts uint64,
 	idx int,
@@ -500,6 +640,10 @@ func (blk *dataBlock) Update(txn txnif.AsyncTxn, row uint32, colIdx uint16, v an
 		err = data.ErrUpdateHiddenKey
 		return
 	}
+	if int(colIdx) >= blk.meta.GetColumnCnt() {
+		err = data.ErrColumnNotMaterialized
+		return
+	}
 	return blk.updateWithFineLock(txn, row, colIdx, v)
 }
 
@@ -564,6 +708,9 @@ func (blk *dataBlock) RangeDelete(
 
 func (blk *dataBlock) GetValue(txn txnif.AsyncTxn, row, col int) (v any, err error) {
 	ts := txn.GetStartTS()
+	if col >= blk.meta.GetColumnCnt() {
+		return blk.getDefaultValue(ts, row, col)
+	}
 	blk.mvcc.RLock()
 	deleted, err := blk.mvcc.IsDeletedLocked(uint32(row), ts, blk.mvcc.RWMutex)
 	if err != nil {
@@ -602,6 +749,21 @@ func (blk *dataBlock) GetValue(txn txnif.AsyncTxn, row, col int) (v any, err err
 	return
 }
 
+func (blk *dataBlock) getDefaultValue(ts uint64, row, col int) (v any, err error) {
+	blk.mvcc.RLock()
+	deleted, err := blk.mvcc.IsDeletedLocked(uint32(row), ts, blk.mvcc.RWMutex)
+	blk.mvcc.RUnlock()
+	if err != nil {
+		return
+	}
+	if deleted {
+		err = data.ErrNotFound
+		return
+	}
+	v = blk.meta.GetSegment().GetTable().GetSchema().ColDefs[col].DefaultValue()
+	return
+}
+
 func (blk *dataBlock) LoadColumnData(
 	colIdx int,
 	buffer *bytes.Buffer) (vec containers.Vector, err error) {
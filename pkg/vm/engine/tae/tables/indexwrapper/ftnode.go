@@ -0,0 +1,165 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexwrapper
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+)
+
+// FullTextIndexSlot returns the index file of a column holding its full-text
+// index. The sort key column holds the zonemap and the bloom filter first
+func FullTextIndexSlot(schema *catalog.Schema, colIdx int) int {
+	if schema.HasSortKey() && schema.SortKey.Defs[0].Idx == colIdx {
+		return 2
+	}
+	return 0
+}
+
+type fullTextNode struct {
+	*buffer.Node
+	mgr  base.INodeManager
+	file common.IVFile
+	impl *index.FullTextIndex
+}
+
+func newFullTextNode(mgr base.INodeManager, file common.IVFile, id *common.ID) *fullTextNode {
+	impl := new(fullTextNode)
+	impl.Node = buffer.NewNode(impl, mgr, *id, uint64(file.Stat().Size()))
+	impl.LoadFunc = impl.OnLoad
+	impl.UnloadFunc = impl.OnUnload
+	impl.DestroyFunc = impl.OnDestroy
+	impl.file = file
+	impl.mgr = mgr
+	mgr.RegisterNode(impl)
+	return impl
+}
+
+func (n *fullTextNode) OnLoad() {
+	if n.impl != nil {
+		// no-op
+		return
+	}
+	var err error
+	stat := n.file.Stat()
+	data := make([]byte, stat.Size())
+	if _, err = n.file.Read(data); err != nil {
+		panic(err)
+	}
+	buf := make([]byte, stat.OriginSize())
+	if err = Decompress(data, buf, CompressType(stat.CompressAlgo())); err != nil {
+		panic(err)
+	}
+	if n.impl, err = index.NewFullTextIndexFromSource(buf); err != nil {
+		panic(err)
+	}
+}
+
+func (n *fullTextNode) OnUnload() {
+	n.impl = nil
+}
+
+func (n *fullTextNode) OnDestroy() {
+	n.file.Unref()
+}
+
+func (n *fullTextNode) Close() (err error) {
+	if err = n.Node.Close(); err != nil {
+		return err
+	}
+	n.impl = nil
+	return nil
+}
+
+// FTReader reads the full-text index of a column of a persisted block
+type FTReader struct {
+	node *fullTextNode
+}
+
+func NewFTReader(mgr base.INodeManager, file common.IVFile, id *common.ID) *FTReader {
+	return &FTReader{
+		node: newFullTextNode(mgr, file, id),
+	}
+}
+
+func (reader *FTReader) Destroy() (err error) {
+	return reader.node.Close()
+}
+
+// GetIndex returns the loaded index, it stays valid after the buffer node
+// is unloaded
+func (reader *FTReader) GetIndex() *index.FullTextIndex {
+	handle := reader.node.mgr.Pin(reader.node)
+	defer handle.Close()
+	return reader.node.impl
+}
+
+type FTWriter struct {
+	cType       CompressType
+	file        common.IRWFile
+	data        containers.Vector
+	colIdx      uint16
+	internalIdx uint16
+}
+
+func NewFTWriter() *FTWriter {
+	return &FTWriter{}
+}
+
+func (writer *FTWriter) Init(file common.IRWFile, cType CompressType, colIdx uint16, internalIdx uint16) error {
+	writer.file = file
+	writer.cType = cType
+	writer.colIdx = colIdx
+	writer.internalIdx = internalIdx
+	return nil
+}
+
+func (writer *FTWriter) AddValues(values containers.Vector) error {
+	if writer.data == nil {
+		writer.data = values
+		return nil
+	}
+	writer.data.Extend(values)
+	return nil
+}
+
+func (writer *FTWriter) Finalize() (*IndexMeta, error) {
+	idx, err := index.NewFullTextIndex(writer.data)
+	if err != nil {
+		return nil, err
+	}
+	writer.data = nil
+
+	meta := NewEmptyIndexMeta()
+	meta.SetIndexType(FullTextIndex)
+	meta.SetCompressType(writer.cType)
+	meta.SetIndexedColumn(writer.colIdx)
+	meta.SetInternalIndex(writer.internalIdx)
+
+	iBuf, err := idx.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	compressed := Compress(iBuf, writer.cType)
+	meta.SetSize(uint32(len(iBuf)), uint32(len(compressed)))
+	if _, err = writer.file.Write(compressed); err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
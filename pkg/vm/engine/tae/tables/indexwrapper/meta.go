@@ -28,6 +28,7 @@ const (
 	SegmentZoneMapIndex
 	StaticFilterIndex
 	ARTIndex
+	FullTextIndex
 )
 
 type CompressType uint8
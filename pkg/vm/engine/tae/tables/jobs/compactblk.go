@@ -84,7 +84,10 @@ func (task *compactBlockTask) PrepareData(blkKey []byte) (preparer *model.Prepar
 	preparer = model.NewPreparedCompactedBlockData()
 	preparer.Columns = containers.NewBatch()
 
-	schema := task.meta.GetSchema()
+	// The compacted block is rewritten with the latest table layout. Columns
+	// added after the compacted block was created are read as default
+	schema := task.meta.GetSegment().GetTable().GetSchema()
+	columns := containers.NewBatch()
 	var view *model.ColumnView
 	for _, def := range schema.ColDefs {
 		if def.IsHidden() {
@@ -97,7 +100,7 @@ func (task *compactBlockTask) PrepareData(blkKey []byte) (preparer *model.Prepar
 		task.deletes = view.DeleteMask
 		view.ApplyDeletes()
 		vec := view.Orphan()
-		preparer.Columns.AddVector(def.Name, vec)
+		columns.AddVector(def.Name, vec)
 	}
 	// Sort only if sort key is defined
 	if schema.HasSortKey() {
@@ -105,20 +108,23 @@ func (task *compactBlockTask) PrepareData(blkKey []byte) (preparer *model.Prepar
 		var vecs []containers.Vector
 		if schema.IsSingleSortKey() {
 			idx = schema.SortKey.Defs[0].Idx
-			preparer.SortKey = preparer.Columns.Vecs[idx]
-			vecs = preparer.Columns.Vecs
+			preparer.SortKey = columns.Vecs[idx]
+			vecs = columns.Vecs
 		} else {
-			vecs = preparer.Columns.Vecs
+			vecs = columns.Vecs
 			cols := make([]containers.Vector, schema.SortKey.Size())
 			for i := range cols {
-				cols[i] = preparer.Columns.Vecs[schema.SortKey.Defs[i].Idx]
+				cols[i] = columns.Vecs[schema.SortKey.Defs[i].Idx]
 			}
 			preparer.SortKey = model.EncodeCompoundColumn(cols...)
-			idx = len(preparer.Columns.Vecs)
+			idx = len(columns.Vecs)
 			vecs = append(vecs, preparer.SortKey)
 			// preparer.Columns.AddVector(catalog.SortKeyNamePrefx, preparer.SortKey)
 		}
 		if task.mapping, err = mergesort.SortBlockColumns(vecs, idx); err != nil {
+			for _, vec := range columns.Vecs {
+				vec.Close()
+			}
 			return preparer, err
 		}
 	}
@@ -127,11 +133,21 @@ func (task *compactBlockTask) PrepareData(blkKey []byte) (preparer *model.Prepar
 		catalog.HiddenColumnType,
 		blkKey,
 		0,
-		uint32(preparer.Columns.Length()))
+		uint32(columns.Length()))
 	if err != nil {
+		for _, vec := range columns.Vecs {
+			vec.Close()
+		}
 		return
 	}
-	preparer.Columns.AddVector(catalog.HiddenColumnName, hidden)
+	// Columns are flushed by position, keep them in the schema order
+	for _, def := range schema.ColDefs {
+		if def.IsHidden() {
+			preparer.Columns.AddVector(def.Name, hidden)
+		} else {
+			preparer.Columns.AddVector(def.Name, columns.GetVectorByName(def.Name))
+		}
+	}
 	return
 }
 
@@ -157,6 +173,10 @@ func (task *compactBlockTask) Execute() (err error) {
 		return
 	}
 	defer preparer.Close()
+	if len(preparer.Columns.Vecs) != newMeta.GetColumnCnt() {
+		err = catalog.ErrSchemaValidation
+		return
+	}
 	if err = seg.SoftDeleteBlock(task.compacted.Fingerprint().BlockID); err != nil {
 		return err
 	}
@@ -57,6 +57,11 @@ func (task *flushBlkTask) Execute() (err error) {
 			return
 		}
 	}
+	for _, idx := range task.meta.GetSchema().FullTextColumns() {
+		if err = BuildAndFlushFullTextIndex(task.file, task.meta, idx, task.data.Vecs[idx]); err != nil {
+			return
+		}
+	}
 	if err = task.file.WriteBatch(task.data, task.ts); err != nil {
 		return
 	}
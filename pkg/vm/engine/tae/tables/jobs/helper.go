@@ -83,3 +83,29 @@ func BuildAndFlushIndex(file file.Block, meta *catalog.BlockEntry, columnData co
 	}
 	return nil
 }
+
+// BuildAndFlushFullTextIndex writes the full-text index of a column. The
+// index is not a part of the index meta of the block, it is loaded when the
+// column is searched
+func BuildAndFlushFullTextIndex(file file.Block, meta *catalog.BlockEntry, colIdx int, columnData containers.Vector) (err error) {
+	col, err := file.OpenColumn(colIdx)
+	if err != nil {
+		return
+	}
+	defer col.Close()
+	slot := indexwrapper.FullTextIndexSlot(meta.GetSchema(), colIdx)
+	idxFile, err := col.OpenIndexFile(slot)
+	if err != nil {
+		return
+	}
+	defer idxFile.Unref()
+	writer := indexwrapper.NewFTWriter()
+	if err = writer.Init(idxFile, indexwrapper.Plain, uint16(colIdx), uint16(slot)); err != nil {
+		return
+	}
+	if err = writer.AddValues(columnData); err != nil {
+		return
+	}
+	_, err = writer.Finalize()
+	return
+}
@@ -158,7 +158,7 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		}
 	}
 
-	schema := task.mergedBlks[0].GetSchema()
+	schema := task.mergedBlks[0].GetSegment().GetTable().GetSchema()
 	var view *model.ColumnView
 	vecs := make([]containers.Vector, 0)
 	rows := make([]uint32, len(task.compacted))
@@ -269,6 +269,11 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		if err = BuildAndFlushIndex(meta.GetBlockData().GetBlockFile(), meta, vec); err != nil {
 			return
 		}
+		if schema.SortKey.Size() == 1 && schema.IsFullTextColumn(def.Idx) {
+			if err = BuildAndFlushFullTextIndex(meta.GetBlockData().GetBlockFile(), meta, def.Idx, vec); err != nil {
+				return
+			}
+		}
 		// Replay index
 		if err = meta.GetBlockData().ReplayIndex(); err != nil {
 			return
@@ -292,6 +297,11 @@ func (task *mergeBlocksTask) Execute() (err error) {
 			return err
 		}
 	}
+	// Created blocks may have more columns than the merged blocks if the
+	// table was altered. Missing columns are read as default
+	if len(task.createdBlks) > 0 {
+		schema = task.createdBlks[0].GetSchema()
+	}
 	for _, def := range schema.ColDefs {
 		// Skip
 		// Hidden column was processed before
@@ -316,6 +326,11 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		}
 		for i := range vecs {
 			blk := task.createdBlks[i]
+			if schema.IsFullTextColumn(def.Idx) {
+				if err = BuildAndFlushFullTextIndex(blk.GetBlockData().GetBlockFile(), blk, def.Idx, vecs[i]); err != nil {
+					return
+				}
+			}
 			closure := blk.GetBlockData().FlushColumnDataClosure(ts, def.Idx, vecs[i], false)
 			flushTask, err = task.scheduler.ScheduleScopedFn(
 				tasks.WaitableCtx,
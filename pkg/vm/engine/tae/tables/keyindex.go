@@ -0,0 +1,134 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tables
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/types"
+)
+
+// keyIndex indexes the keys of some columns of the rows [0, rows) of a
+// block. It only grows: the rows deleted or rolled back keep their keys, so
+// a key found may be gone but a key of the block is never missed
+type keyIndex struct {
+	sync.Mutex
+	art  index.SecondaryIndex
+	rows uint32
+}
+
+func newKeyIndex() *keyIndex {
+	return &keyIndex{
+		art: index.NewSimpleARTMap(types.Type_VARCHAR.ToType()),
+	}
+}
+
+// MayContainKeys returns false if no row of the block has any of the keys of
+// the columns. The keys are indexed on the first call and the rows appended
+// since are added on the next ones. A block missing some of the columns or
+// having updates on them is not indexed and may contain any key
+func (blk *dataBlock) MayContainKeys(cols []int, keys map[string]struct{}) (bool, error) {
+	for _, col := range cols {
+		if col >= blk.meta.GetColumnCnt() {
+			return true, nil
+		}
+		chain := blk.mvcc.GetColumnChain(uint16(col))
+		chain.RLock()
+		updated := chain.DepthLocked() > 0
+		chain.RUnlock()
+		if updated {
+			return true, nil
+		}
+	}
+	name := fmt.Sprint(cols)
+	blk.Lock()
+	idx := blk.keyIdxes[name]
+	if idx == nil {
+		idx = newKeyIndex()
+		blk.keyIdxes[name] = idx
+	}
+	blk.Unlock()
+
+	idx.Lock()
+	defer idx.Unlock()
+	if err := blk.extendKeyIndex(idx, cols); err != nil {
+		return false, err
+	}
+	for key := range keys {
+		if idx.art.Contains([]byte(key)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// extendKeyIndex adds the keys of the rows appended after the rows indexed.
+// A non-appendable block is indexed once
+func (blk *dataBlock) extendKeyIndex(idx *keyIndex, cols []int) (err error) {
+	appendable := blk.meta.IsAppendable()
+	var rows uint32
+	if appendable {
+		blk.mvcc.RLock()
+		rows = blk.node.rows
+		blk.mvcc.RUnlock()
+		if rows <= idx.rows {
+			return
+		}
+	} else if idx.rows > 0 {
+		return
+	}
+	vecs := make([]containers.Vector, len(cols))
+	defer func() {
+		for _, vec := range vecs {
+			if vec != nil {
+				vec.Close()
+			}
+		}
+	}()
+	for i, col := range cols {
+		if appendable {
+			err = blk.node.DoWithPin(func() (err error) {
+				vecs[i], err = blk.node.GetColumnDataWindowCopy(idx.rows, rows, col)
+				return
+			})
+		} else {
+			vecs[i], err = blk.LoadColumnData(col, nil)
+		}
+		if err != nil {
+			return
+		}
+	}
+	var w bytes.Buffer
+	n := vecs[0].Length()
+	for row := 0; row < n; row++ {
+		key, ok := model.EncodeKey(vecs, row, &w)
+		if !ok {
+			continue
+		}
+		if err = idx.art.Insert([]byte(key), idx.rows+uint32(row)); err == index.ErrDuplicate {
+			err = nil
+		}
+		if err != nil {
+			return
+		}
+	}
+	idx.rows += uint32(n)
+	return
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
@@ -42,6 +43,10 @@ type appendableNode struct {
 	rows    uint32
 	mgr     base.INodeManager
 	flushTS uint64
+	// bytes is the bytes of the rows if the schema bounds the bytes of the block.
+	// sized is 0 until the bytes of the rows flushed before the restart are counted
+	bytes uint64
+	sized int32
 	// ckpTs     uint64 // unused
 	exception *atomic.Value
 }
@@ -63,10 +68,41 @@ func newNode(mgr base.INodeManager, block *dataBlock, file file.Block) *appendab
 	impl.block = block
 	impl.flushTS = flushTS
 	impl.rows = file.ReadRows()
+	if impl.rows == 0 {
+		impl.sized = 1
+	}
 	mgr.RegisterNode(impl)
 	return impl
 }
 
+// isBytesBounded returns true if the schema bounds the bytes of the block
+func (node *appendableNode) isBytesBounded() bool {
+	return node.block.meta.GetSchema().BlockMaxBytes != 0
+}
+
+// Bytes returns the bytes of the rows in the block. The block is loaded to count
+// the rows flushed before the restart if it was not loaded since then
+func (node *appendableNode) Bytes() (uint64, error) {
+	if atomic.LoadInt32(&node.sized) == 0 {
+		if err := node.DoWithPin(func() error { return nil }); err != nil {
+			return 0, err
+		}
+	}
+	return atomic.LoadUint64(&node.bytes), nil
+}
+
+// addBytes counts the bytes of the rows appended to the block
+func (node *appendableNode) addBytes(bat *containers.Batch) {
+	if !node.isBytesBounded() {
+		return
+	}
+	size := uint64(0)
+	for i := 0; i < bat.Length(); i++ {
+		size += compute.EstimateRowSize(bat, i)
+	}
+	atomic.AddUint64(&node.bytes, size)
+}
+
 func (node *appendableNode) TryPin() (base.INodeHandle, error) {
 	return node.mgr.TryPin(node.Node, time.Second)
 }
@@ -131,6 +167,20 @@ func (node *appendableNode) GetColumnDataCopy(
 	return
 }
 
+// GetColumnDataWindowCopy copies the rows [start, end) of a column
+func (node *appendableNode) GetColumnDataWindowCopy(
+	start, end uint32,
+	colIdx int) (vec containers.Vector, err error) {
+	if exception := node.exception.Load(); exception != nil {
+		err = exception.(error)
+		return
+	}
+	node.block.RLock()
+	vec = node.data.Vecs[colIdx].CloneWindow(int(start), int(end-start), containers.DefaultAllocator)
+	node.block.RUnlock()
+	return
+}
+
 func (node *appendableNode) SetBlockMaxflushTS(ts uint64) {
 	atomic.StoreUint64(&node.flushTS, ts)
 }
@@ -159,6 +209,11 @@ func (node *appendableNode) OnLoad() {
 	if node.data.Length() != int(node.rows) {
 		logutil.Fatalf("Load %d rows but %d expected: %s", node.data.Length(), node.rows, node.block.meta.String())
 	}
+	if atomic.LoadInt32(&node.sized) == 0 {
+		atomic.StoreUint64(&node.bytes, 0)
+		node.addBytes(node.data)
+		atomic.StoreInt32(&node.sized, 1)
+	}
 }
 
 func (node *appendableNode) flushData(ts uint64, colsData *containers.Batch, opCtx Operation) (err error) {
@@ -311,8 +366,10 @@ func (node *appendableNode) ApplyAppend(bat *containers.Batch, txn txnif.AsyncTx
 	}
 	schema := node.block.meta.GetSchema()
 	from = int(node.rows)
-	for srcPos, attr := range bat.Attrs {
-		def := schema.ColDefs[schema.GetColIdx(attr)]
+	// The batch is laid out by column index. Names are not used because they
+	// could have been changed since the batch was logged
+	for srcPos := range bat.Attrs {
+		def := schema.ColDefs[srcPos]
 		if def.IsHidden() {
 			continue
 		}
@@ -324,6 +381,7 @@ func (node *appendableNode) ApplyAppend(bat *containers.Batch, txn txnif.AsyncTx
 	if err = node.FillHiddenColumn(uint32(from), uint32(bat.Length())); err != nil {
 		return
 	}
+	node.addBytes(bat)
 	node.rows += uint32(bat.Length())
 	return
 }
@@ -224,6 +224,37 @@ func (chain *DeleteChain) CollectDeletesInRange(
 	return
 }
 
+// CollectCommittingDeletesLocked merges the deletes of the committed and
+// committing txns and the deletes of txn itself. Txns are prepared one by one,
+// so it is the latest state of the deletes to a txn being prepared
+func (chain *DeleteChain) CollectCommittingDeletesLocked(txn txnif.TxnReader) *DeleteNode {
+	var merged *DeleteNode
+	chain.LoopChainLocked(func(n *DeleteNode) bool {
+		// Merged node is a loop breaker
+		if n.IsMerged() {
+			if merged == nil {
+				merged = NewMergedNode(n.GetCommitTSLocked())
+			}
+			merged.MergeLocked(n, false)
+			return false
+		}
+		n.RLock()
+		defer n.RUnlock()
+		if n.txn != nil && !n.txn.SameTxn(txn.GetStartTS()) {
+			state := n.txn.GetTxnState(false)
+			if state != txnif.TxnStateCommitting && state != txnif.TxnStateCommitted {
+				return true
+			}
+		}
+		if merged == nil {
+			merged = NewMergedNode(n.GetCommitTSLocked())
+		}
+		merged.MergeLocked(n, false)
+		return true
+	}, false)
+	return merged
+}
+
 func (chain *DeleteChain) CollectDeletesLocked(
 	ts uint64,
 	collectIndex bool,
@@ -64,6 +64,7 @@ func (rel *TxnRelation) MakeSegmentIt() handle.SegmentIt
 func (rel *TxnRelation) MakeBlockIt() handle.BlockIt                                 { return nil }
 func (rel *TxnRelation) BatchDedup(cols ...containers.Vector) error                  { return nil }
 func (rel *TxnRelation) Append(data *containers.Batch) error                         { return nil }
+func (rel *TxnRelation) Alter(func(any) error) error                                 { return nil }
 func (rel *TxnRelation) GetMeta() any                                                { return nil }
 func (rel *TxnRelation) GetSegment(id uint64) (seg handle.Segment, err error)        { return }
 func (rel *TxnRelation) SoftDeleteSegment(id uint64) (err error)                     { return }
@@ -85,6 +86,9 @@ func (rel *TxnRelation) DeleteByFilter(filter *handle.Filter) (err error)
 func (rel *TxnRelation) LogTxnEntry(entry txnif.TxnEntry, readed []*common.ID) (err error) {
 	return
 }
+func (rel *TxnRelation) RequireKeys(cols []int, keys map[string]struct{}, err error) {}
+func (rel *TxnRelation) ForbidKeys(cols []int, keys map[string]struct{}, parent handle.Relation, parentCols []int, err error) {
+}
 
 func (seg *TxnSegment) GetMeta() any                     { return nil }
 func (seg *TxnSegment) String() string                   { return "" }
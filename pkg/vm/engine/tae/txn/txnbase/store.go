@@ -70,6 +70,9 @@ func (store *NoopTxnStore) BatchDedup(uint64, uint64, ...containers.Vector) (err
 func (store *NoopTxnStore) Update(uint64, *common.ID, uint32, uint16, any) (err error) {
 	return
 }
+func (store *NoopTxnStore) AlterTable(uint64, uint64, func(any) error) (err error) {
+	return
+}
 func (store *NoopTxnStore) RangeDelete(uint64, *common.ID, uint32, uint32, handle.DeleteType) (err error) {
 	return
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 )
@@ -190,6 +191,18 @@ func (blk *txnBlock) GetColumnDataById(colIdx int, buffer *bytes.Buffer) (*model
 	}
 	return blk.entry.GetBlockData().GetColumnDataById(blk.Txn, colIdx, buffer)
 }
+func (blk *txnBlock) GetFullTextIndex(colIdx int) (*index.FullTextIndex, error) {
+	if blk.isUncommitted {
+		return nil, nil
+	}
+	return blk.entry.GetBlockData().GetFullTextIndex(colIdx)
+}
+func (blk *txnBlock) MayContainKeys(cols []int, keys map[string]struct{}) (bool, error) {
+	if blk.isUncommitted {
+		return true, nil
+	}
+	return blk.entry.GetBlockData().MayContainKeys(cols, keys)
+}
 func (blk *txnBlock) GetColumnDataByName(attr string, buffer *bytes.Buffer) (*model.ColumnView, error) {
 	if blk.isUncommitted {
 		attrId := blk.table.entry.GetSchema().GetColIdx(attr)
@@ -82,7 +82,8 @@ func InsertOp[T comparable](input any, start, count int, fromRow uint32, dedupIn
 func (idx *simpleTableIndex) KeyToVector(kType types.Type) containers.Vector {
 	vec := containers.MakeVector(kType, false)
 	switch kType.Oid {
-	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON:
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		for k := range idx.tree {
 			vec.Append([]byte(k.(string)))
 		}
@@ -160,16 +161,18 @@ func (idx *simpleTableIndex) BatchInsert(col containers.Vector, start, count int
 		return InsertOp[int64](col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.Type_UINT8:
 		return InsertOp[uint8](col.Slice(), start, count, row, dedupInput, idx.tree)
-	case types.Type_UINT16:
+	case types.Type_UINT16, types.Type_ENUM:
 		return InsertOp[uint16](col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.Type_UINT32:
 		return InsertOp[uint32](col.Slice(), start, count, row, dedupInput, idx.tree)
-	case types.Type_UINT64:
+	case types.Type_UINT64, types.Type_SET, types.Type_BIT:
 		return InsertOp[uint64](col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.Type_DECIMAL64:
 		return InsertOp[types.Decimal64](col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.Type_DECIMAL128:
 		return InsertOp[types.Decimal128](col.Slice(), start, count, row, dedupInput, idx.tree)
+	case types.Type_UUID:
+		return InsertOp[types.Uuid](col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.Type_FLOAT32:
 		return InsertOp[float32](col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.Type_FLOAT64:
@@ -180,7 +183,8 @@ func (idx *simpleTableIndex) BatchInsert(col containers.Vector, start, count int
 		return InsertOp[types.Timestamp](col.Slice(), start, count, row, dedupInput, idx.tree)
 	case types.Type_DATETIME:
 		return InsertOp[types.Datetime](col.Slice(), start, count, row, dedupInput, idx.tree)
-	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON:
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		vs := col.Slice().(*containers.Bytes)
 		if dedupInput {
 			set := make(map[string]bool)
@@ -227,16 +231,18 @@ func (idx *simpleTableIndex) BatchDedup(col containers.Vector) error {
 		return DedupOp[int64](vals, idx.tree)
 	case types.Type_UINT8:
 		return DedupOp[uint8](vals, idx.tree)
-	case types.Type_UINT16:
+	case types.Type_UINT16, types.Type_ENUM:
 		return DedupOp[uint16](vals, idx.tree)
 	case types.Type_UINT32:
 		return DedupOp[uint32](vals, idx.tree)
-	case types.Type_UINT64:
+	case types.Type_UINT64, types.Type_SET, types.Type_BIT:
 		return DedupOp[uint64](vals, idx.tree)
 	case types.Type_DECIMAL64:
 		return DedupOp[types.Decimal64](vals, idx.tree)
 	case types.Type_DECIMAL128:
 		return DedupOp[types.Decimal128](vals, idx.tree)
+	case types.Type_UUID:
+		return DedupOp[types.Uuid](vals, idx.tree)
 	case types.Type_FLOAT32:
 		return DedupOp[float32](vals, idx.tree)
 	case types.Type_FLOAT64:
@@ -247,7 +253,8 @@ func (idx *simpleTableIndex) BatchDedup(col containers.Vector) error {
 		return DedupOp[types.Datetime](vals, idx.tree)
 	case types.Type_TIMESTAMP:
 		return DedupOp[types.Timestamp](vals, idx.tree)
-	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON:
+	case types.Type_CHAR, types.Type_VARCHAR, types.Type_JSON, types.Type_TEXT,
+		types.Type_BINARY, types.Type_VARBINARY, types.Type_BLOB:
 		vals := vals.(*containers.Bytes)
 		for i, s := range vals.Offset {
 			e := s + vals.Length[i]
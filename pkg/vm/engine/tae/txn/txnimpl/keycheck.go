@@ -0,0 +1,121 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnimpl
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+)
+
+// keyCheck is a check of the keys of the columns of a table done when the
+// txn commits. The keys are required in the table if parent is nil.
+// Otherwise the keys missing in the columns parentCols of parent are
+// forbidden in the table
+type keyCheck struct {
+	cols       []int
+	keys       map[string]struct{}
+	parent     *txnTable
+	parentCols []int
+	err        error
+}
+
+// RequireKeys fails the commit with err if any of the keys is missing in the
+// table then
+func (tbl *txnTable) RequireKeys(cols []int, keys map[string]struct{}, err error) {
+	if len(keys) == 0 {
+		return
+	}
+	tbl.keyChecks = append(tbl.keyChecks, &keyCheck{
+		cols: cols,
+		keys: keys,
+		err:  err,
+	})
+}
+
+// ForbidKeys fails the commit with err if a row of the table has any of the
+// keys missing in the columns parentCols of parent then
+func (tbl *txnTable) ForbidKeys(cols []int, keys map[string]struct{}, parent *txnTable, parentCols []int, err error) {
+	if len(keys) == 0 {
+		return
+	}
+	tbl.keyChecks = append(tbl.keyChecks, &keyCheck{
+		cols:       cols,
+		keys:       keys,
+		parent:     parent,
+		parentCols: parentCols,
+		err:        err,
+	})
+}
+
+// PreCommitKeyChecks does the key checks against the rows of the txns
+// committed or committing before the txn and the rows of the txn itself.
+// The checks done by the txn in its snapshot hold unless another txn changed
+// the keys concurrently
+func (tbl *txnTable) PreCommitKeyChecks() (err error) {
+	for _, check := range tbl.keyChecks {
+		if check.parent == nil {
+			var missing map[string]struct{}
+			if missing, err = tbl.missingKeys(check.cols, check.keys); err != nil {
+				return
+			}
+			if len(missing) > 0 {
+				return check.err
+			}
+			continue
+		}
+		var missing map[string]struct{}
+		if missing, err = check.parent.missingKeys(check.parentCols, check.keys); err != nil {
+			return
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		forbid := func(k string, _ []containers.Vector, _ int) error {
+			if _, ok := missing[k]; ok {
+				return check.err
+			}
+			return nil
+		}
+		if err = tbl.scanLocalKey(check.cols, forbid); err != nil {
+			return
+		}
+		if err = tbl.scanCommittedKey(check.cols, missing, true, forbid); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// missingKeys returns the keys missing in the rows of the txns committed or
+// committing and the rows of the txn itself
+func (tbl *txnTable) missingKeys(cols []int, keys map[string]struct{}) (map[string]struct{}, error) {
+	missing := make(map[string]struct{}, len(keys))
+	for k := range keys {
+		missing[k] = struct{}{}
+	}
+	found := func(k string, _ []containers.Vector, _ int) error {
+		delete(missing, k)
+		return nil
+	}
+	if err := tbl.scanLocalKey(cols, found); err != nil {
+		return nil, err
+	}
+	if len(missing) == 0 {
+		return missing, nil
+	}
+	if err := tbl.scanCommittedKey(cols, missing, true, found); err != nil {
+		return nil, err
+	}
+	return missing, nil
+}
@@ -147,8 +147,10 @@ func (seg *localSegment) prepareApplyNode(node InsertNode) (err error) {
 			}
 			appender = seg.tableHandle.SetAppender(blk.Fingerprint())
 		}
+		start := appended
 		anode, created, toAppend, err := appender.PrepareAppend(
 			node.RowsWithoutDeletes()-appended,
+			func(i uint32) uint64 { return node.RowSize(node.OffsetWithDeletes(start + i)) },
 			seg.table.store.txn)
 		if err != nil {
 			return err
@@ -27,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
@@ -63,6 +64,7 @@ type InsertNode interface {
 	RowsWithoutDeletes() uint32
 	LengthWithDeletes(appended, toAppend uint32) uint32
 	OffsetWithDeletes(count uint32) uint32
+	RowSize(row uint32) uint64
 	GetAppends() []*appendInfo
 	GetTxn() txnif.AsyncTxn
 }
@@ -340,7 +342,7 @@ func (n *insertNode) PrepareAppend(data *containers.Batch, offset uint32) uint32
 }
 
 func (n *insertNode) Append(data *containers.Batch, offset uint32) (an uint32, err error) {
-	schema := n.table.entry.GetSchema()
+	schema := n.table.schema
 	if n.data == nil {
 		n.data = containers.BuildBatch(
 			schema.AllNames(),
@@ -351,14 +353,25 @@ func (n *insertNode) Append(data *containers.Batch, offset uint32) (an uint32, e
 
 	from := uint32(n.data.Length())
 	an = n.PrepareAppend(data, offset)
-	for _, attr := range data.Attrs {
+	for srcPos, attr := range data.Attrs {
 		if attr == catalog.HiddenColumnName {
 			continue
 		}
 		def := schema.ColDefs[schema.GetColIdx(attr)]
 		destVec := n.data.Vecs[def.Idx]
 		// logutil.Infof("destVec: %s, %d, %d", destVec.String(), cnt, data.Length())
-		destVec.ExtendWithOffset(data.Vecs[def.Idx], int(offset), int(an))
+		destVec.ExtendWithOffset(data.Vecs[srcPos], int(offset), int(an))
+	}
+	// Columns not provided, such as the dropped ones, are filled with defaults
+	for _, def := range schema.ColDefs {
+		if def.IsHidden() || data.HasAttr(def.Name) {
+			continue
+		}
+		destVec := n.data.Vecs[def.Idx]
+		v := def.DefaultValue()
+		for i := uint32(0); i < an; i++ {
+			destVec.Append(v)
+		}
 	}
 	n.rows = uint32(n.data.Length())
 	err = n.FillHiddenColumn(from, uint32(data.Length())-offset)
@@ -429,6 +442,11 @@ func (n *insertNode) OffsetWithDeletes(count uint32) uint32 {
 	return offset
 }
 
+// RowSize returns the bytes of the row
+func (n *insertNode) RowSize(row uint32) uint64 {
+	return compute.EstimateRowSize(n.data, int(row))
+}
+
 func (n *insertNode) GetValue(col int, row uint32) any {
 	return n.data.Vecs[col].Get(int(row))
 }
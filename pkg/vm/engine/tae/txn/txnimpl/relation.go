@@ -250,6 +250,18 @@ func (h *txnRelation) UpdateByHiddenKey(key any, col int, v any) error {
 	return h.Txn.GetStore().Update(h.table.entry.GetDB().ID, id, row, uint16(col), v)
 }
 
+func (h *txnRelation) RequireKeys(cols []int, keys map[string]struct{}, err error) {
+	h.table.RequireKeys(cols, keys, err)
+}
+
+func (h *txnRelation) ForbidKeys(cols []int, keys map[string]struct{}, parent handle.Relation, parentCols []int, err error) {
+	h.table.ForbidKeys(cols, keys, parent.(*txnRelation).table, parentCols, err)
+}
+
+func (h *txnRelation) Alter(fn func(schema any) error) error {
+	return h.Txn.GetStore().AlterTable(h.table.entry.GetDB().ID, h.table.entry.ID, fn)
+}
+
 func (h *txnRelation) Update(id *common.ID, row uint32, col uint16, v any) error {
 	return h.Txn.GetStore().Update(h.table.entry.GetDB().ID, id, row, col, v)
 }
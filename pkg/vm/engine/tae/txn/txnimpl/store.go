@@ -177,6 +177,14 @@ func (store *txnStore) Update(dbId uint64, id *common.ID, row uint32, colIdx uin
 	return db.Update(id, row, colIdx, v)
 }
 
+func (store *txnStore) AlterTable(dbId, id uint64, fn func(schema any) error) (err error) {
+	db, err := store.getOrSetDB(dbId)
+	if err != nil {
+		return err
+	}
+	return db.AlterTable(id, fn)
+}
+
 func (store *txnStore) DatabaseNames() (names []string) {
 	it := newDBIt(store.txn, store.catalog)
 	for it.Valid() {
@@ -18,6 +18,7 @@ import (
 	"bytes"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
@@ -80,11 +81,15 @@ func (blk *txnSysBlock) dbRows() int {
 
 func (blk *txnSysBlock) tableRows() int {
 	rows := 0
-	fn := func(db *catalog.DBEntry) error {
-		rows += db.CoarseTableCnt()
+	fn := func(_ *catalog.TableEntry) error {
+		rows++
 		return nil
 	}
-	_ = blk.processDB(fn, true)
+	dbFn := func(db *catalog.DBEntry) error {
+		_ = blk.processTable(db, fn, true)
+		return nil
+	}
+	_ = blk.processDB(dbFn, true)
 	return rows
 }
 
@@ -115,6 +120,12 @@ func (blk *txnSysBlock) processTable(entry *catalog.DBEntry, fn func(*catalog.Ta
 	tableIt := entry.MakeTableIt(true)
 	for tableIt.Valid() {
 		table := tableIt.Get().GetPayload().(*catalog.TableEntry)
+		// the tables storing the partitions are a part of their
+		// partitioned table
+		if engine.IsPartitionTable(table.GetSchema().Name) {
+			tableIt.Next()
+			continue
+		}
 		table.RLock()
 		canRead, err = table.TxnCanRead(blk.Txn, table.RWMutex)
 		table.RUnlock()
@@ -135,7 +146,11 @@ func (blk *txnSysBlock) processTable(entry *catalog.DBEntry, fn func(*catalog.Ta
 func (blk *txnSysBlock) columnRows() int {
 	rows := 0
 	fn := func(table *catalog.TableEntry) error {
-		rows += len(table.GetSchema().ColDefs)
+		for _, def := range table.GetSchema().ColDefs {
+			if !def.IsDropped() {
+				rows++
+			}
+		}
 		return nil
 	}
 	dbFn := func(db *catalog.DBEntry) error {
@@ -180,6 +195,9 @@ func (blk *txnSysBlock) getColumnTableData(colIdx int) (view *model.ColumnView,
 	colData := containers.MakeVector(col.Type, col.Nullable())
 	tableFn := func(table *catalog.TableEntry) error {
 		for i, colDef := range table.GetSchema().ColDefs {
+			if colDef.IsDropped() {
+				continue
+			}
 			switch col.Name {
 			case catalog.SystemColAttr_Name:
 				colData.Append([]byte(colDef.Name))
@@ -252,9 +270,17 @@ func (blk *txnSysBlock) getRelTableData(colIdx int) (view *model.ColumnView, err
 		case catalog.SystemRelAttr_Persistence:
 			colData.Append([]byte(catalog.SystemPersistRel))
 		case catalog.SystemRelAttr_Kind:
-			colData.Append([]byte(catalog.SystemOrdinaryRel))
+			if table.GetSchema().ViewDef != "" {
+				colData.Append([]byte(catalog.SystemViewRel))
+			} else {
+				colData.Append([]byte(catalog.SystemOrdinaryRel))
+			}
 		case catalog.SystemRelAttr_CreateSQL:
-			colData.Append([]byte("todosql"))
+			if view := table.GetSchema().ViewDef; view != "" {
+				colData.Append([]byte(view))
+			} else {
+				colData.Append([]byte("todosql"))
+			}
 		default:
 			panic("unexpected")
 		}
@@ -35,6 +35,8 @@ import (
 
 var (
 	ErrDuplicateNode = errors.New("tae: duplicate node")
+	ErrDDLAfterDML   = errors.New("tae: alter table with uncommitted changes")
+	ErrDMLAfterDDL   = errors.New("tae: write table with uncommitted alter")
 )
 
 type txnTable struct {
@@ -42,9 +44,11 @@ type txnTable struct {
 	createEntry  txnif.TxnEntry
 	createIdx    int
 	dropEntry    txnif.TxnEntry
+	alterEntry   *catalog.TableAlterEntry
 	localSegment *localSegment
 	updateNodes  map[common.ID]txnif.UpdateNode
 	deleteNodes  map[common.ID]txnif.DeleteNode
+	keyChecks    []*keyCheck
 	entry        *catalog.TableEntry
 	schema       *catalog.Schema
 	logs         []wal.LogEntry
@@ -270,6 +274,22 @@ func (tbl *txnTable) SetDropEntry(e txnif.TxnEntry) error {
 	return nil
 }
 
+// Alter stages a schema change of the table. A table cannot be both altered
+// and written in one txn
+func (tbl *txnTable) Alter(fn func(*catalog.Schema) error) (err error) {
+	if tbl.localSegment != nil || len(tbl.updateNodes) > 0 || len(tbl.deleteNodes) > 0 {
+		return ErrDDLAfterDML
+	}
+	if tbl.alterEntry == nil {
+		if tbl.alterEntry, err = tbl.entry.PrepareAlter(tbl.store.txn); err != nil {
+			return
+		}
+		tbl.store.IncreateWriteCnt()
+		tbl.txnEntries = append(tbl.txnEntries, tbl.alterEntry)
+	}
+	return tbl.alterEntry.Alter(fn)
+}
+
 func (tbl *txnTable) IsDeleted() bool {
 	return tbl.dropEntry != nil
 }
@@ -335,6 +355,12 @@ func (tbl *txnTable) GetSortColumns(data *containers.Batch) []containers.Vector
 // func (tbl *txnTable)
 
 func (tbl *txnTable) Append(data *containers.Batch) (err error) {
+	if tbl.alterEntry != nil {
+		return ErrDMLAfterDDL
+	}
+	if tbl.entry.GetSchema() != tbl.schema {
+		return txnif.ErrTxnWWConflict
+	}
 	if tbl.schema.IsSinglePK() {
 		if err = tbl.DoBatchDedup(data.Vecs[tbl.schema.GetSingleSortKeyIdx()]); err != nil {
 			return
@@ -344,6 +370,11 @@ func (tbl *txnTable) Append(data *containers.Batch) (err error) {
 			return
 		}
 	}
+	if len(tbl.schema.UniqueKeys) > 0 {
+		if err = tbl.DoUniqueDedup(data); err != nil {
+			return
+		}
+	}
 	if tbl.localSegment == nil {
 		tbl.localSegment = newLocalSegment(tbl)
 	}
@@ -373,6 +404,9 @@ func (tbl *txnTable) IsLocalDeleted(row uint32) bool {
 }
 
 func (tbl *txnTable) RangeDelete(id *common.ID, start, end uint32, dt handle.DeleteType) (err error) {
+	if tbl.alterEntry != nil {
+		return ErrDMLAfterDDL
+	}
 	if isLocalSegment(id) {
 		return tbl.RangeDeleteLocalRows(start, end)
 	}
@@ -483,6 +517,9 @@ func (tbl *txnTable) updateWithFineLock(node txnif.UpdateNode, txn txnif.AsyncTx
 }
 
 func (tbl *txnTable) Update(id *common.ID, row uint32, col uint16, v any) (err error) {
+	if tbl.alterEntry != nil {
+		return ErrDMLAfterDDL
+	}
 	if tbl.entry.GetSchema().IsPartOfPK(int(col)) {
 		err = data.ErrUpdateUniqueKey
 		return
@@ -541,6 +578,16 @@ func (tbl *txnTable) UncommittedRows() uint32 {
 }
 
 func (tbl *txnTable) PreCommitDedup() (err error) {
+	// The local rows are laid out by the schema the txn started with
+	if tbl.localSegment != nil && tbl.entry.GetSchema() != tbl.schema {
+		return txnif.ErrTxnWWConflict
+	}
+	if err = tbl.PreCommitUniqueDedup(); err != nil {
+		return
+	}
+	if err = tbl.PreCommitKeyChecks(); err != nil {
+		return
+	}
 	if tbl.localSegment == nil || !tbl.schema.HasPK() {
 		return
 	}
@@ -163,6 +163,17 @@ func (db *txnDB) Update(id *common.ID, row uint32, colIdx uint16, v any) (err er
 	return table.Update(id, row, colIdx, v)
 }
 
+func (db *txnDB) AlterTable(id uint64, fn func(schema any) error) (err error) {
+	table, err := db.getOrSetTable(id)
+	if err != nil {
+		return err
+	}
+	if table.IsDeleted() {
+		return data.ErrNotFound
+	}
+	return table.Alter(func(schema *catalog.Schema) error { return fn(schema) })
+}
+
 func (db *txnDB) CreateRelation(def any) (relation handle.Relation, err error) {
 	schema := def.(*catalog.Schema)
 	var factory catalog.TableDataFactory
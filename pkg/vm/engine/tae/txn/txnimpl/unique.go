@@ -0,0 +1,239 @@
+// Copyright 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnimpl
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
+)
+
+// keyFunc is called with the encoded key of each row scanned
+type keyFunc = func(key string, vecs []containers.Vector, row int) error
+
+func scanKey(vecs []containers.Vector, deletes *roaring.Bitmap, fn keyFunc) (err error) {
+	var w bytes.Buffer
+	for row := 0; row < vecs[0].Length(); row++ {
+		if deletes != nil && deletes.ContainsInt(row) {
+			continue
+		}
+		key, ok := model.EncodeKey(vecs, row, &w)
+		if !ok {
+			continue
+		}
+		if err = fn(key, vecs, row); err != nil {
+			return
+		}
+	}
+	return
+}
+
+func closeVectors(vecs []containers.Vector) {
+	for _, vec := range vecs {
+		if vec != nil {
+			vec.Close()
+		}
+	}
+}
+
+func keyColumns(key *catalog.IndexInfo) []int {
+	cols := make([]int, len(key.Columns))
+	for i, col := range key.Columns {
+		cols[i] = int(col)
+	}
+	return cols
+}
+
+func (tbl *txnTable) newDuplicateEntry(key *catalog.IndexInfo, vecs []containers.Vector, row int) error {
+	parts := make([]string, len(vecs))
+	for i, vec := range vecs {
+		switch v := vec.Get(row).(type) {
+		case []byte:
+			parts[i] = string(v)
+		default:
+			parts[i] = fmt.Sprintf("%v", v)
+		}
+	}
+	return moerr.NewDuplicateEntry(strings.Join(parts, "-"), tbl.schema.Name+"."+key.Name)
+}
+
+// scanLocalKey scans the key of the columns of the rows appended by the txn
+func (tbl *txnTable) scanLocalKey(cols []int, fn keyFunc) (err error) {
+	if tbl.localSegment == nil {
+		return
+	}
+	for _, n := range tbl.localSegment.nodes {
+		h, err := tbl.store.nodesMgr.TryPin(n, time.Second)
+		if err != nil {
+			return err
+		}
+		vecs := make([]containers.Vector, len(cols))
+		var deletes *roaring.Bitmap
+		for i, col := range cols {
+			view := model.NewColumnView(tbl.store.txn.GetStartTS(), col)
+			if err = n.FillColumnView(view, nil); err != nil {
+				break
+			}
+			vecs[i] = view.Orphan()
+			deletes = view.DeleteMask
+		}
+		h.Close()
+		if err == nil {
+			err = scanKey(vecs, deletes, fn)
+		}
+		closeVectors(vecs)
+		if err != nil {
+			return err
+		}
+	}
+	return
+}
+
+// scanCommittedKey scans the key of the columns of the rows in the table
+// blocks that may contain any of the keys. The rows visible to the txn are
+// scanned if latest is false. Otherwise the rows of all the committed and
+// committing txns are scanned
+func (tbl *txnTable) scanCommittedKey(cols []int, keys map[string]struct{}, latest bool, fn keyFunc) (err error) {
+	segIt := tbl.entry.MakeSegmentIt(false)
+	for ; segIt.Valid(); segIt.Next() {
+		seg := segIt.Get().GetPayload().(*catalog.SegmentEntry)
+		seg.RLock()
+		invalid := seg.IsDroppedCommitted() || seg.InTxnOrRollbacked()
+		seg.RUnlock()
+		if invalid {
+			continue
+		}
+		blkIt := seg.MakeBlockIt(false)
+		for ; blkIt.Valid(); blkIt.Next() {
+			blk := blkIt.Get().GetPayload().(*catalog.BlockEntry)
+			blk.RLock()
+			invalid = blk.IsDroppedCommitted() || blk.InTxnOrRollbacked()
+			blk.RUnlock()
+			if invalid {
+				continue
+			}
+			blkData := blk.GetBlockData()
+			var found bool
+			if found, err = blkData.MayContainKeys(cols, keys); err != nil {
+				return
+			}
+			if !found {
+				continue
+			}
+			vecs := make([]containers.Vector, len(cols))
+			var deletes *roaring.Bitmap
+			visible := true
+			for i, col := range cols {
+				var view *model.ColumnView
+				if latest {
+					view, err = blkData.GetLatestColumnDataById(tbl.store.txn, col, nil)
+				} else {
+					view, err = blkData.GetColumnDataById(tbl.store.txn, col, nil)
+				}
+				if err != nil || view == nil {
+					visible = false
+					break
+				}
+				vecs[i] = view.Orphan()
+				deletes = view.DeleteMask
+			}
+			if visible {
+				err = scanKey(vecs, deletes, fn)
+			}
+			closeVectors(vecs)
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// DoUniqueDedup checks the unique keys of the rows to append against the
+// other rows of the batch, the rows appended by the txn and the committed
+// rows visible to the txn
+func (tbl *txnTable) DoUniqueDedup(data *containers.Batch) (err error) {
+	for _, key := range tbl.schema.UniqueKeys {
+		vecs := make([]containers.Vector, len(key.Columns))
+		for i, col := range key.Columns {
+			vecs[i] = data.Vecs[col]
+		}
+		keys := make(map[string]struct{})
+		if err = scanKey(vecs, data.Deletes, func(k string, vecs []containers.Vector, row int) error {
+			if _, ok := keys[k]; ok {
+				return tbl.newDuplicateEntry(key, vecs, row)
+			}
+			keys[k] = struct{}{}
+			return nil
+		}); err != nil {
+			return
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		dedup := func(k string, vecs []containers.Vector, row int) error {
+			if _, ok := keys[k]; ok {
+				return tbl.newDuplicateEntry(key, vecs, row)
+			}
+			return nil
+		}
+		if err = tbl.scanLocalKey(keyColumns(key), dedup); err != nil {
+			return
+		}
+		if err = tbl.scanCommittedKey(keyColumns(key), keys, false, dedup); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// PreCommitUniqueDedup checks the unique keys of the rows appended by the
+// txn against the rows of the txns committed or committing before it
+func (tbl *txnTable) PreCommitUniqueDedup() (err error) {
+	if tbl.localSegment == nil {
+		return
+	}
+	for _, key := range tbl.schema.UniqueKeys {
+		keys := make(map[string]struct{})
+		if err = tbl.scanLocalKey(keyColumns(key), func(k string, vecs []containers.Vector, row int) error {
+			if _, ok := keys[k]; ok {
+				return tbl.newDuplicateEntry(key, vecs, row)
+			}
+			keys[k] = struct{}{}
+			return nil
+		}); err != nil {
+			return
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		if err = tbl.scanCommittedKey(keyColumns(key), keys, true, func(k string, vecs []containers.Vector, row int) error {
+			if _, ok := keys[k]; ok {
+				return tbl.newDuplicateEntry(key, vecs, row)
+			}
+			return nil
+		}); err != nil {
+			return
+		}
+	}
+	return
+}
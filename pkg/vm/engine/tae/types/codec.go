@@ -30,6 +30,7 @@ var DatetimeSize = encoding.DatetimeSize
 var TimestampSize = encoding.TimestampSize
 var Decimal64Size = encoding.Decimal64Size
 var Decimal128Size = encoding.Decimal128Size
+var UuidSize = encoding.UuidSize
 
 var EncodeType = encoding.EncodeType
 var DecodeType = encoding.DecodeType
@@ -51,6 +52,7 @@ var EncodeDatetimeSlice = encoding.EncodeDatetimeSlice
 var EncodeTimestampSlice = encoding.EncodeTimestampSlice
 var EncodeDecimal64Slice = encoding.EncodeDecimal64Slice
 var EncodeDecimal128Slice = encoding.EncodeDecimal128Slice
+var EncodeUuidSlice = encoding.EncodeUuidSlice
 
 var DecodeBoolSlice = encoding.DecodeBoolSlice
 var DecodeInt8Slice = encoding.DecodeInt8Slice
@@ -69,6 +71,7 @@ var DecodeDatetimeSlice = encoding.DecodeDatetimeSlice
 var DecodeTimestampSlice = encoding.DecodeTimestampSlice
 var DecodeDecimal64Slice = encoding.DecodeDecimal64Slice
 var DecodeDecimal128Slice = encoding.DecodeDecimal128Slice
+var DecodeUuidSlice = encoding.DecodeUuidSlice
 
 func EncodeFixed[T any](v T) []byte {
 	sz := unsafe.Sizeof(v)
@@ -112,11 +115,11 @@ func DecodeValue(val []byte, typ Type) any {
 		return DecodeFixed[int64](val)
 	case Type_UINT8:
 		return DecodeFixed[uint8](val)
-	case Type_UINT16:
+	case Type_UINT16, Type_ENUM:
 		return DecodeFixed[uint16](val)
 	case Type_UINT32:
 		return DecodeFixed[uint32](val)
-	case Type_UINT64:
+	case Type_UINT64, Type_SET, Type_BIT:
 		return DecodeFixed[uint64](val)
 	case Type_FLOAT32:
 		return DecodeFixed[float32](val)
@@ -132,7 +135,10 @@ func DecodeValue(val []byte, typ Type) any {
 		return DecodeFixed[Decimal64](val)
 	case Type_DECIMAL128:
 		return DecodeFixed[Decimal128](val)
-	case Type_CHAR, Type_VARCHAR:
+	case Type_UUID:
+		return DecodeFixed[Uuid](val)
+	case Type_CHAR, Type_VARCHAR, Type_TEXT,
+		Type_BINARY, Type_VARBINARY, Type_BLOB:
 		return val
 	default:
 		panic("unsupported type")
@@ -153,16 +159,18 @@ func EncodeValue(val any, typ Type) []byte {
 		return EncodeFixed(val.(int64))
 	case Type_UINT8:
 		return EncodeFixed(val.(uint8))
-	case Type_UINT16:
+	case Type_UINT16, Type_ENUM:
 		return EncodeFixed(val.(uint16))
 	case Type_UINT32:
 		return EncodeFixed(val.(uint32))
-	case Type_UINT64:
+	case Type_UINT64, Type_SET, Type_BIT:
 		return EncodeFixed(val.(uint64))
 	case Type_DECIMAL64:
 		return EncodeFixed(val.(Decimal64))
 	case Type_DECIMAL128:
 		return EncodeFixed(val.(Decimal128))
+	case Type_UUID:
+		return EncodeFixed(val.(Uuid))
 	case Type_FLOAT32:
 		return EncodeFixed(val.(float32))
 	case Type_FLOAT64:
@@ -173,7 +181,8 @@ func EncodeValue(val any, typ Type) []byte {
 		return EncodeFixed(val.(Timestamp))
 	case Type_DATETIME:
 		return EncodeFixed(val.(Datetime))
-	case Type_CHAR, Type_VARCHAR:
+	case Type_CHAR, Type_VARCHAR, Type_TEXT,
+		Type_BINARY, Type_VARBINARY, Type_BLOB:
 		return val.([]byte)
 	default:
 		panic("unsupported type")
@@ -274,6 +283,11 @@ func WriteValues(w io.Writer, vals ...any) (n int64, err error) {
 				return
 			}
 			n += int64(nr)
+		case types.Uuid:
+			if nr, err = w.Write(EncodeFixed(v)); err != nil {
+				return
+			}
+			n += int64(nr)
 		default:
 			panic(fmt.Errorf("%T:%v not supported", v, v))
 		}
@@ -45,9 +45,20 @@ const (
 
 	Type_CHAR    = types.T_char
 	Type_VARCHAR = types.T_varchar
+	Type_TEXT    = types.T_text
+
+	Type_BINARY    = types.T_binary
+	Type_VARBINARY = types.T_varbinary
+	Type_BLOB      = types.T_blob
 
 	Type_JSON = types.T_json
 
+	Type_BIT  = types.T_bit
+	Type_ENUM = types.T_enum
+	Type_SET  = types.T_set
+
+	Type_UUID = types.T_uuid
+
 	Type_DECIMAL64  = types.T_decimal64
 	Type_DECIMAL128 = types.T_decimal128
 
@@ -62,10 +73,12 @@ type Datetime = types.Datetime
 type Timestamp = types.Timestamp
 type Decimal64 = types.Decimal64
 type Decimal128 = types.Decimal128
+type Uuid = types.Uuid
 type Bytes = types.Bytes
 type Null struct{}
 
 var CompareDecimal128 = types.CompareDecimal128
+var CompareUuid = types.CompareUuid
 var FromClock = types.FromClock
 var FromCalendar = types.FromCalendar
 
@@ -78,7 +91,7 @@ type DecimalT interface {
 }
 
 type FixedSizeT interface {
-	bool | constraints.Ordered | Date | Datetime | Timestamp | Decimal64 | Decimal128
+	bool | constraints.Ordered | Date | Datetime | Timestamp | Decimal64 | Decimal128 | Uuid
 }
 
 type VarSizeT interface {
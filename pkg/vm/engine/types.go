@@ -40,6 +40,13 @@ type Attribute struct {
 	Default DefaultExpr // default value of this attribute.
 	Primary bool        // if true, it is primary key
 	Comment string      // comment of attribute
+	// AutoIncrement is true if the values of the attribute are allocated
+	// by the server
+	AutoIncrement bool
+	// NotNull is true if the attribute was declared NOT NULL
+	NotNull bool
+	// EnumValues keeps the members of ENUM and SET, see types.JoinEnumMembers
+	EnumValues string
 }
 
 type DefaultExpr struct {
@@ -86,6 +93,10 @@ func (node IndexT) ToString() string {
 		return "ZONEMAP"
 	case BsiIndex:
 		return "BSI"
+	case Unique:
+		return "UNIQUE"
+	case FullText:
+		return "FULLTEXT"
 	default:
 		return "INVAILD"
 	}
@@ -95,6 +106,10 @@ const (
 	Invalid IndexT = iota
 	ZoneMap
 	BsiIndex
+	// Unique is the index rejecting the duplicate keys
+	Unique
+	// FullText is the inverted index of the words of text columns
+	FullText
 )
 
 type AttributeDef struct {
@@ -105,14 +120,102 @@ type CommentDef struct {
 	Comment string
 }
 
+// ViewDef is the definition of a view, the relation of a view stores no data
+type ViewDef struct {
+	View string
+}
+
+// CheckDef is a CHECK constraint of the relation, Expr is the text of
+// the check expression
+type CheckDef struct {
+	Name string
+	Expr string
+}
+
+// ForeignKeyAction is the referential action of a foreign key on the delete
+// or update of the referenced key
+type ForeignKeyAction int8
+
+const (
+	// Restrict rejects the change of a referenced key
+	Restrict ForeignKeyAction = iota
+	// Cascade applies the change of the referenced key to the referencing rows
+	Cascade
+	// SetNull sets the referencing columns to null
+	SetNull
+)
+
+// ForeignKeyDef is a foreign key of the relation. The parent table is in the
+// database of the relation
+type ForeignKeyDef struct {
+	Name        string
+	Cols        []string
+	ParentTable string
+	ParentCols  []string
+	OnDelete    ForeignKeyAction
+	OnUpdate    ForeignKeyAction
+}
+
+// PartitionType is the partitioning method of a partitioned relation
+type PartitionType int8
+
+const (
+	// RangePartition places a row into the first partition whose upper bound
+	// is greater than the partition value
+	RangePartition PartitionType = iota
+	// ListPartition places a row into the partition listing its value
+	ListPartition
+	// HashPartition places a row by the modulus of the partition value
+	HashPartition
+	// KeyPartition places a row by the hash of the partition columns
+	KeyPartition
+)
+
+// PartitionDef is the partitioning of a relation, the rows are placed by
+// the value of Func applied to Cols, or by the value of Cols if Func is
+// empty. Each partition stores its rows in a relation of its own named by
+// PartitionTableName. Used by Relation.AddTableDef, it replaces the
+// partitions of the relation
+type PartitionDef struct {
+	Typ        PartitionType
+	Func       string
+	Cols       []string
+	Partitions []Partition
+}
+
+// Partition is a partition of a relation. Values holds the upper bound of
+// a RANGE partition, none for MAXVALUE, or the values of a LIST partition,
+// all in their canonical text
+type Partition struct {
+	Name   string
+	Values []string
+}
+
+// RenameColumnDef is used by Relation.AddTableDef to rename a column
+type RenameColumnDef struct {
+	OldName string
+	NewName string
+}
+
+// RenameTableDef is used by Relation.AddTableDef to rename the relation
+type RenameTableDef struct {
+	Name string
+}
+
 type TableDef interface {
 	tableDef()
 }
 
-func (*CommentDef) tableDef()    {}
-func (*AttributeDef) tableDef()  {}
-func (*IndexTableDef) tableDef() {}
-func (*PropertiesDef) tableDef() {}
+func (*CommentDef) tableDef()      {}
+func (*AttributeDef) tableDef()    {}
+func (*IndexTableDef) tableDef()   {}
+func (*PropertiesDef) tableDef()   {}
+func (*RenameColumnDef) tableDef() {}
+func (*RenameTableDef) tableDef()  {}
+func (*ViewDef) tableDef()         {}
+func (*CheckDef) tableDef()        {}
+func (*ForeignKeyDef) tableDef()   {}
+func (*PartitionDef) tableDef()    {}
 
 type Relation interface {
 	Statistics
@@ -139,13 +242,59 @@ type Relation interface {
 
 	Truncate(Snapshot) (uint64, error)
 
+	// AddTableDef adds a column (AttributeDef), renames a column
+	// (RenameColumnDef) or the relation itself (RenameTableDef), or
+	// repartitions the relation (PartitionDef)
 	AddTableDef(uint64, TableDef, Snapshot) error
+	// DelTableDef drops a column (AttributeDef)
 	DelTableDef(uint64, TableDef, Snapshot) error
 
 	// first argument is the number of reader, second argument is the filter extend,  third parameter is the payload required by the engine
 	NewReader(int, *plan.Expr, []byte, Snapshot) []Reader
 }
 
+// FullTextSearch is a MATCH ... AGAINST search of a scan, the relevance of
+// each row is read as the float64 column Attr
+type FullTextSearch struct {
+	Attr    string
+	Cols    []string
+	Query   string
+	Boolean bool
+	// Filter is set if only the rows matching the search are needed
+	Filter bool
+}
+
+// FullTextRelation is a relation supporting the full-text searches
+type FullTextRelation interface {
+	Relation
+	// NewFullTextReader makes the readers of the relation which read the
+	// relevance columns of the searches too. The readers may skip the rows
+	// not matching a filter search
+	NewFullTextReader(int, []FullTextSearch, Snapshot) ([]Reader, error)
+}
+
+// KeyRelation is a relation looking up the rows by the values of their keys
+type KeyRelation interface {
+	Relation
+	// NewKeyReader makes the readers of the rows whose columns named by the
+	// attributes of the batch equal a row of the batch. A key with a null
+	// part matches no row
+	NewKeyReader(int, *batch.Batch, Snapshot) ([]Reader, error)
+}
+
+// ForeignKeyRelation is a relation checking again the foreign keys when the
+// transaction commits, as the transactions committed since the snapshot may
+// have changed the keys checked in it
+type ForeignKeyRelation interface {
+	KeyRelation
+	// RequireKeys fails the commit with the error if any key of the batch is
+	// missing in the relation then
+	RequireKeys(*batch.Batch, error, Snapshot) error
+	// ForbidKeys fails the commit with the error if a row of the relation has
+	// a key of the batch missing in the columns of the parent relation then
+	ForbidKeys(*batch.Batch, ForeignKeyRelation, []string, error, Snapshot) error
+}
+
 type Reader interface {
 	Read([]uint64, []string) (*batch.Batch, error)
 }
@@ -22,24 +22,30 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopcomplement"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopjoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopleft"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopmark"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopsemi"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopsingle"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/update"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/complement"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/dispatch"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/fixpoint"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/group"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/insert"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/intersect"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/join"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/left"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/limit"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mark"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/merge"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergegroup"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergelimit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeoffset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergeorder"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergetop"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/minus"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/offset"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
@@ -47,7 +53,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/projection"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/restrict"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/semi"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/single"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/top"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/union"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/window"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
@@ -68,11 +77,20 @@ var stringFunc = [...]func(interface{}, *bytes.Buffer){
 	Connector:  connector.String,
 	Projection: projection.String,
 	Complement: complement.String,
+	Window:     window.String,
+	Fixpoint:   fixpoint.String,
+	Union:      union.String,
+	Minus:      minus.String,
+	Intersect:  intersect.String,
+	Single:     single.String,
+	Mark:       mark.String,
 
 	LoopJoin:       loopjoin.String,
 	LoopLeft:       loopleft.String,
 	LoopSemi:       loopsemi.String,
 	LoopComplement: loopcomplement.String,
+	LoopSingle:     loopsingle.String,
+	LoopMark:       loopmark.String,
 
 	MergeTop:    mergetop.String,
 	MergeLimit:  mergelimit.String,
@@ -102,11 +120,20 @@ var prepareFunc = [...]func(*process.Process, interface{}) error{
 	Connector:  connector.Prepare,
 	Projection: projection.Prepare,
 	Complement: complement.Prepare,
+	Window:     window.Prepare,
+	Fixpoint:   fixpoint.Prepare,
+	Union:      union.Prepare,
+	Minus:      minus.Prepare,
+	Intersect:  intersect.Prepare,
+	Single:     single.Prepare,
+	Mark:       mark.Prepare,
 
 	LoopJoin:       loopjoin.Prepare,
 	LoopLeft:       loopleft.Prepare,
 	LoopSemi:       loopsemi.Prepare,
 	LoopComplement: loopcomplement.Prepare,
+	LoopSingle:     loopsingle.Prepare,
+	LoopMark:       loopmark.Prepare,
 
 	MergeTop:    mergetop.Prepare,
 	MergeLimit:  mergelimit.Prepare,
@@ -136,11 +163,20 @@ var execFunc = [...]func(int, *process.Process, interface{}) (bool, error){
 	Connector:  connector.Call,
 	Projection: projection.Call,
 	Complement: complement.Call,
+	Window:     window.Call,
+	Fixpoint:   fixpoint.Call,
+	Union:      union.Call,
+	Minus:      minus.Call,
+	Intersect:  intersect.Call,
+	Single:     single.Call,
+	Mark:       mark.Call,
 
 	LoopJoin:       loopjoin.Call,
 	LoopLeft:       loopleft.Call,
 	LoopSemi:       loopsemi.Call,
 	LoopComplement: loopcomplement.Call,
+	LoopSingle:     loopsingle.Call,
+	LoopMark:       loopmark.Call,
 
 	MergeTop:    mergetop.Call,
 	MergeLimit:  mergelimit.Call,
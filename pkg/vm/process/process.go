@@ -41,6 +41,8 @@ func NewFromProc(m *mheap.Mheap, p *Process, regNumber int) *Process {
 	proc.Snapshot = p.Snapshot
 	proc.AnalInfos = p.AnalInfos
 	proc.SessionInfo = p.SessionInfo
+	proc.FileService = p.FileService
+	proc.IncrService = p.IncrService
 
 	// reg and cancel
 	proc.Cancel = cancel
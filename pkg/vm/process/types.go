@@ -19,6 +19,8 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/incrservice"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 )
 
@@ -71,6 +73,11 @@ type SessionInfo struct {
 	ConnectionID uint64
 	Database     string
 	Version      string
+	// LastInsertID is the first value allocated for an auto increment
+	// column by the last statement of the session that allocated one
+	LastInsertID uint64
+	// NoForeignKeyChecks is set if @@foreign_key_checks is off
+	NoForeignKeyChecks bool
 }
 
 // AnalyzeInfo  analyze information for query
@@ -111,6 +118,14 @@ type Process struct {
 
 	SessionInfo SessionInfo
 
+	// FileService, stores the temporary files spilled by the operators
+	// under memory pressure, nil if spilling is disabled.
+	FileService fileservice.FileService
+
+	// IncrService, allocates the values of the auto increment columns,
+	// nil if the engine has no auto increment support.
+	IncrService *incrservice.Service
+
 	// snapshot is transaction context
 	Cancel context.CancelFunc
 }
@@ -31,11 +31,20 @@ const (
 	Connector
 	Projection
 	Complement
+	Window
+	Fixpoint
+	Union
+	Minus
+	Intersect
+	Single
+	Mark
 
 	LoopJoin
 	LoopLeft
 	LoopSemi
 	LoopComplement
+	LoopSingle
+	LoopMark
 
 	MergeTop
 	MergeLimit